@@ -15,21 +15,39 @@
 package admin
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"gopkg.in/yaml.v3"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
 	"github.com/multigres/multigres/go/provisioner/local"
 	"github.com/multigres/multigres/go/tools/grpccommon"
 )
 
+// retryPolicy replays the calls to the multiadmin server that fail
+// transiently, e.g. while the server restarts or a shard fails over.
+var retryPolicy = mterrors.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 250 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// retryInterceptor runs the unary calls under retryPolicy.
+func retryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return retryPolicy.Do(ctx, func(ctx context.Context) error {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+}
+
 // Conn wraps a gRPC connection to the multiadmin server.
 type Conn struct {
 	multiadminpb.MultiAdminServiceClient
@@ -47,7 +65,9 @@ func NewClient(cmd *cobra.Command) (*Conn, error) {
 	if err != nil {
 		return nil, err
 	}
-	conn, err := grpccommon.NewClient(addr, grpccommon.WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())))
+	conn, err := grpccommon.NewClient(addr, grpccommon.WithDialOptions(
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(retryInterceptor)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to admin server at %s: %w", addr, err)
 	}
@@ -15,13 +15,18 @@
 package admin
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetServerFromConfig(t *testing.T) {
@@ -138,3 +143,30 @@ provisioner-config:
 		assert.Contains(t, err.Error(), "either --admin-server flag or --config-path must be provided")
 	})
 }
+
+func TestRetryInterceptor(t *testing.T) {
+	saved := retryPolicy
+	defer func() { retryPolicy = saved }()
+	retryPolicy.InitialBackoff = time.Millisecond
+
+	var calls int
+	invoker := func(err error) grpc.UnaryInvoker {
+		return func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			if calls < 2 {
+				return status.Error(codes.Unavailable, "connection refused")
+			}
+			return err
+		}
+	}
+
+	err := retryInterceptor(context.Background(), "/multiadmin.MultiAdminService/GetCell", nil, nil, nil, invoker(nil))
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "the unavailable server is retried")
+
+	calls = 1
+	notFound := status.Error(codes.NotFound, "no such cell")
+	err = retryInterceptor(context.Background(), "/multiadmin.MultiAdminService/GetCell", nil, nil, nil, invoker(notFound))
+	assert.Equal(t, notFound, err)
+	assert.Equal(t, 2, calls, "other errors are not retried")
+}
@@ -36,7 +36,13 @@ func AddClusterCommand(root *cobra.Command, mc *MultigresCommand) {
 	cluster.AddStatusCommand(clusterCmd)
 	cluster.AddBackupCommand(clusterCmd)
 	cluster.AddListBackupsCommand(clusterCmd)
+	cluster.AddRestoreCommand(clusterCmd)
+	cluster.AddVerifyBackupCommand(clusterCmd)
+	cluster.AddDeleteBackupCommand(clusterCmd)
 	cluster.AddCheckBackupConfigCommand(clusterCmd)
+	cluster.AddPlannedReparentCommand(clusterCmd)
+	cluster.AddUpgradeCommand(clusterCmd)
+	cluster.AddInstallExtensionCommand(clusterCmd)
 	cluster.AddRefreshCredentialsCommand(clusterCmd)
 
 	// Register cluster command with root
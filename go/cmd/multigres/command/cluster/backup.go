@@ -17,6 +17,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -125,10 +126,12 @@ func (bcmd *backupCmd) runBackup(cmd *cobra.Command, args []string) error {
 	cmd.Printf("Backup job started: %s\n", jobID)
 
 	// Poll for completion
-	return pollBackupJobStatus(cmd, client, jobID)
+	return pollBackupJobStatus(cmd, client, jobID, "Backup")
 }
 
-func pollBackupJobStatus(cmd *cobra.Command, client multiadminpb.MultiAdminServiceClient, jobID string) error {
+// pollBackupJobStatus waits for a backup or restore job to finish. operation
+// names the job in progress messages.
+func pollBackupJobStatus(cmd *cobra.Command, client multiadminpb.MultiAdminServiceClient, jobID, operation string) error {
 	ticker := time.NewTicker(backupPollInterval)
 	defer ticker.Stop()
 
@@ -137,7 +140,7 @@ func pollBackupJobStatus(cmd *cobra.Command, client multiadminpb.MultiAdminServi
 	for {
 		select {
 		case <-timeout:
-			return fmt.Errorf("backup job %s timed out after %v", jobID, backupPollTimeout)
+			return fmt.Errorf("%s job %s timed out after %v", strings.ToLower(operation), jobID, backupPollTimeout)
 		case <-ticker.C:
 			// Create a fresh timeout context for each GetBackupJobStatus call
 			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
@@ -151,14 +154,14 @@ func pollBackupJobStatus(cmd *cobra.Command, client multiadminpb.MultiAdminServi
 
 			switch resp.Status {
 			case multiadminpb.JobStatus_JOB_STATUS_COMPLETED:
-				cmd.Printf("Backup completed successfully. Backup ID: %s\n", resp.BackupId)
+				cmd.Printf("%s completed successfully. Backup ID: %s\n", operation, resp.BackupId)
 				return nil
 			case multiadminpb.JobStatus_JOB_STATUS_FAILED:
-				return fmt.Errorf("backup failed: %s", resp.ErrorMessage)
+				return fmt.Errorf("%s failed: %s", strings.ToLower(operation), resp.ErrorMessage)
 			case multiadminpb.JobStatus_JOB_STATUS_RUNNING:
-				cmd.Printf("Backup in progress...\n")
+				cmd.Printf("%s in progress...\n", operation)
 			case multiadminpb.JobStatus_JOB_STATUS_PENDING:
-				cmd.Printf("Backup pending...\n")
+				cmd.Printf("%s pending...\n", operation)
 			default:
 				cmd.Printf("Unexpected job status: %v, continuing to poll...\n", resp.Status)
 			}
@@ -0,0 +1,71 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/cmd/multigres/command/admin"
+	"github.com/multigres/multigres/go/common/constants"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+)
+
+// AddDeleteBackupCommand adds the delete-backup subcommand to the cluster command
+func AddDeleteBackupCommand(clusterCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "delete-backup <backup-id>",
+		Short: "Delete a backup",
+		Long: "Delete a backup from the backup repository via the multiadmin API. " +
+			"Differential and incremental backups that depend on it are deleted as well.",
+		Args: cobra.ExactArgs(1),
+		RunE: runDeleteBackup,
+	}
+
+	cmd.Flags().String("database", "postgres", "Database name the backup belongs to")
+	cmd.Flags().String("admin-server", "", "Address of the multiadmin server (overrides config)")
+
+	clusterCmd.AddCommand(cmd)
+}
+
+func runDeleteBackup(cmd *cobra.Command, args []string) error {
+	backupID := args[0]
+	database, _ := cmd.Flags().GetString("database")
+
+	client, err := admin.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Minute)
+	defer cancel()
+
+	_, err = client.DeleteBackup(ctx, &multiadminpb.DeleteBackupRequest{
+		Database:   database,
+		TableGroup: constants.DefaultTableGroup,
+		Shard:      constants.DefaultShard,
+		BackupId:   backupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete backup %s: %w", backupID, err)
+	}
+
+	cmd.Printf("Backup %s deleted.\n", backupID)
+	return nil
+}
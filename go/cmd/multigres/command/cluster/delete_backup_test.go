@@ -0,0 +1,52 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getClusterSubcommand creates a cluster command with the backup management
+// subcommands and returns the named one
+func getClusterSubcommand(name string) *cobra.Command {
+	clusterCmd := &cobra.Command{Use: "cluster"}
+	AddVerifyBackupCommand(clusterCmd)
+	AddDeleteBackupCommand(clusterCmd)
+	cmd, _, _ := clusterCmd.Find([]string{name})
+	return cmd
+}
+
+func TestBackupManagementCommands(t *testing.T) {
+	for _, name := range []string{"verify-backup", "delete-backup"} {
+		t.Run(name, func(t *testing.T) {
+			cmd := getClusterSubcommand(name)
+			require.NotNil(t, cmd)
+			assert.Equal(t, name, cmd.Name())
+
+			databaseFlag := cmd.Flag("database")
+			require.NotNil(t, databaseFlag)
+			assert.Equal(t, "postgres", databaseFlag.DefValue)
+			assert.NotNil(t, cmd.Flag("admin-server"))
+
+			// The backup ID is a required positional argument
+			require.Error(t, cmd.Args(cmd, nil))
+			require.NoError(t, cmd.Args(cmd, []string{"20251203-143045F"}))
+		})
+	}
+}
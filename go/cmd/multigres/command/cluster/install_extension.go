@@ -0,0 +1,165 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/cmd/multigres/command/admin"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	"github.com/multigres/multigres/go/tools/viperutil"
+)
+
+// installExtensionCmd holds the install-extension command configuration
+type installExtensionCmd struct {
+	database viperutil.Value[string]
+	name     viperutil.Value[string]
+	version  viperutil.Value[string]
+	schema   viperutil.Value[string]
+	cascade  viperutil.Value[bool]
+	check    viperutil.Value[bool]
+	timeout  viperutil.Value[time.Duration]
+}
+
+// AddInstallExtensionCommand adds the install-extension subcommand to the cluster command
+func AddInstallExtensionCommand(clusterCmd *cobra.Command) {
+	// Create a viperutil registry for install-extension command flags
+	reg := viperutil.NewRegistry()
+
+	icmd := &installExtensionCmd{
+		database: viperutil.Configure(reg, "database", viperutil.Options[string]{
+			Default:  "postgres",
+			FlagName: "database",
+			Dynamic:  false,
+		}),
+		name: viperutil.Configure(reg, "name", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "name",
+			Dynamic:  false,
+		}),
+		version: viperutil.Configure(reg, "version", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "version",
+			Dynamic:  false,
+		}),
+		schema: viperutil.Configure(reg, "schema", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "schema",
+			Dynamic:  false,
+		}),
+		cascade: viperutil.Configure(reg, "cascade", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "cascade",
+			Dynamic:  false,
+		}),
+		check: viperutil.Configure(reg, "check", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "check",
+			Dynamic:  false,
+		}),
+		timeout: viperutil.Configure(reg, "timeout", viperutil.Options[time.Duration]{
+			Default:  5 * time.Minute,
+			FlagName: "timeout",
+			Dynamic:  false,
+		}),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "install-extension",
+		Short: "Create or update a PostgreSQL extension on every shard",
+		Long: "Create or update a PostgreSQL extension on every shard of a database via the multiadmin API. " +
+			"Every pooler, replicas included, first checks that the files of the extension and its shared library are installed, " +
+			"and nothing is installed unless they all are. The extension is then created on the primaries and replicates.",
+		RunE: icmd.runInstallExtension,
+	}
+
+	cmd.Flags().String("database", icmd.database.Default(), "Database name")
+	cmd.Flags().String("name", icmd.name.Default(), "Name of the extension")
+	cmd.Flags().String("version", icmd.version.Default(), "Version to create or update to (default: the default version of the extension)")
+	cmd.Flags().String("schema", icmd.schema.Default(), "Schema to create the extension in")
+	cmd.Flags().Bool("cascade", icmd.cascade.Default(), "Also create the extensions it requires")
+	cmd.Flags().Bool("check", icmd.check.Default(), "Only check that every pooler can run the extension")
+	cmd.Flags().Duration("timeout", icmd.timeout.Default(), "Timeout for the whole operation")
+	cmd.Flags().String("admin-server", "", "host:port of the multiadmin server (overrides config)")
+
+	viperutil.BindFlags(cmd.Flags(), icmd.database, icmd.name, icmd.version, icmd.schema, icmd.cascade, icmd.check, icmd.timeout)
+
+	clusterCmd.AddCommand(cmd)
+}
+
+func (icmd *installExtensionCmd) runInstallExtension(cmd *cobra.Command, args []string) error {
+	database := icmd.database.Get()
+	name := icmd.name.Get()
+	if name == "" {
+		return errors.New("--name is required")
+	}
+
+	// Create admin client
+	client, err := admin.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if icmd.check.Get() {
+		cmd.Printf("Checking extension %s for database=%s...\n", name, database)
+	} else {
+		cmd.Printf("Installing extension %s for database=%s...\n", name, database)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), icmd.timeout.Get())
+	defer cancel()
+
+	resp, err := client.InstallExtension(ctx, &multiadminpb.InstallExtensionRequest{
+		Database:  database,
+		Name:      name,
+		Version:   icmd.version.Get(),
+		Schema:    icmd.schema.Get(),
+		Cascade:   icmd.cascade.Get(),
+		CheckOnly: icmd.check.Get(),
+	})
+	if err != nil {
+		return fmt.Errorf("install extension failed: %w", err)
+	}
+
+	for _, shard := range resp.Shards {
+		cmd.Printf("  %s/%s: %s\n", shard.TableGroup, shard.Shard, extensionVersionChange(shard))
+	}
+	if icmd.check.Get() {
+		cmd.Printf("Extension %s can be installed on all poolers\n", name)
+	} else {
+		cmd.Printf("Extension %s installed on %d shard(s)\n", name, len(resp.Shards))
+	}
+	return nil
+}
+
+// extensionVersionChange describes the version of an extension on a shard.
+func extensionVersionChange(shard *multiadminpb.ExtensionShardResult) string {
+	switch {
+	case shard.NewVersion == "":
+		return "not installed"
+	case shard.OldVersion == "":
+		return "created " + shard.NewVersion
+	case shard.OldVersion == shard.NewVersion:
+		return shard.NewVersion
+	default:
+		return fmt.Sprintf("updated %s -> %s", shard.OldVersion, shard.NewVersion)
+	}
+}
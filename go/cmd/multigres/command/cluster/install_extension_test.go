@@ -0,0 +1,62 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+)
+
+// getInstallExtensionCommand creates a cluster command and adds install-extension to it for testing
+func getInstallExtensionCommand() *cobra.Command {
+	clusterCmd := &cobra.Command{Use: "cluster"}
+	AddInstallExtensionCommand(clusterCmd)
+	cmd, _, _ := clusterCmd.Find([]string{"install-extension"})
+	return cmd
+}
+
+func TestInstallExtensionCommandFlags(t *testing.T) {
+	cmd := getInstallExtensionCommand()
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, "postgres", cmd.Flag("database").DefValue)
+	assert.Equal(t, "", cmd.Flag("name").DefValue)
+	assert.Equal(t, "", cmd.Flag("version").DefValue)
+	assert.Equal(t, "", cmd.Flag("schema").DefValue)
+	assert.Equal(t, "false", cmd.Flag("cascade").DefValue)
+	assert.Equal(t, "false", cmd.Flag("check").DefValue)
+	assert.Equal(t, "5m0s", cmd.Flag("timeout").DefValue)
+	assert.NotNil(t, cmd.Flag("admin-server"))
+}
+
+func TestInstallExtensionRequiresName(t *testing.T) {
+	cmd := getInstallExtensionCommand()
+	require.NotNil(t, cmd)
+
+	err := cmd.RunE(cmd, nil)
+	require.ErrorContains(t, err, "--name is required")
+}
+
+func TestExtensionVersionChange(t *testing.T) {
+	assert.Equal(t, "not installed", extensionVersionChange(&multiadminpb.ExtensionShardResult{}))
+	assert.Equal(t, "created 1.10", extensionVersionChange(&multiadminpb.ExtensionShardResult{NewVersion: "1.10"}))
+	assert.Equal(t, "1.10", extensionVersionChange(&multiadminpb.ExtensionShardResult{OldVersion: "1.10", NewVersion: "1.10"}))
+	assert.Equal(t, "updated 1.9 -> 1.10", extensionVersionChange(&multiadminpb.ExtensionShardResult{OldVersion: "1.9", NewVersion: "1.10"}))
+}
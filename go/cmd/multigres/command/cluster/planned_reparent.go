@@ -0,0 +1,135 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/multigres/multigres/go/cmd/multigres/command/admin"
+	"github.com/multigres/multigres/go/common/constants"
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	"github.com/multigres/multigres/go/tools/viperutil"
+)
+
+// plannedReparentCmd holds the planned-reparent command configuration
+type plannedReparentCmd struct {
+	database     viperutil.Value[string]
+	newPrimary   viperutil.Value[string]
+	drainTimeout viperutil.Value[time.Duration]
+	timeout      viperutil.Value[time.Duration]
+}
+
+// AddPlannedReparentCommand adds the planned-reparent subcommand to the cluster command
+func AddPlannedReparentCommand(clusterCmd *cobra.Command) {
+	// Create a viperutil registry for planned-reparent command flags
+	reg := viperutil.NewRegistry()
+
+	pcmd := &plannedReparentCmd{
+		database: viperutil.Configure(reg, "database", viperutil.Options[string]{
+			Default:  "postgres",
+			FlagName: "database",
+			Dynamic:  false,
+		}),
+		newPrimary: viperutil.Configure(reg, "new-primary", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "new-primary",
+			Dynamic:  false,
+		}),
+		drainTimeout: viperutil.Configure(reg, "drain-timeout", viperutil.Options[time.Duration]{
+			Default:  5 * time.Second,
+			FlagName: "drain-timeout",
+			Dynamic:  false,
+		}),
+		timeout: viperutil.Configure(reg, "timeout", viperutil.Options[time.Duration]{
+			Default:  2 * time.Minute,
+			FlagName: "timeout",
+			Dynamic:  false,
+		}),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "planned-reparent",
+		Short: "Move the primary to a replica",
+		Long: "Demote the primary cleanly and promote one of its replicas via the multiadmin API. " +
+			"The gateways buffer statements during the switchover, and the old primary rejoins as a replica.",
+		RunE: pcmd.runPlannedReparent,
+	}
+
+	cmd.Flags().String("database", pcmd.database.Default(), "Database name")
+	cmd.Flags().String("new-primary", pcmd.newPrimary.Default(), "Pooler to promote, as name or cell/name (defaults to the most advanced replica)")
+	cmd.Flags().Duration("drain-timeout", pcmd.drainTimeout.Default(), "How long the primary waits for write transactions to finish")
+	cmd.Flags().Duration("timeout", pcmd.timeout.Default(), "Timeout for the whole operation")
+	cmd.Flags().String("admin-server", "", "host:port of the multiadmin server (overrides config)")
+
+	viperutil.BindFlags(cmd.Flags(), pcmd.database, pcmd.newPrimary, pcmd.drainTimeout, pcmd.timeout)
+
+	clusterCmd.AddCommand(cmd)
+}
+
+func (pcmd *plannedReparentCmd) runPlannedReparent(cmd *cobra.Command, args []string) error {
+	database := pcmd.database.Get()
+
+	// Create admin client
+	client, err := admin.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	cmd.Printf("Starting planned reparent for database=%s...\n", database)
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), pcmd.timeout.Get())
+	defer cancel()
+
+	resp, err := client.PlannedReparentShard(ctx, &multiadminpb.PlannedReparentShardRequest{
+		Database:     database,
+		TableGroup:   constants.DefaultTableGroup,
+		Shard:        constants.DefaultShard,
+		NewPrimary:   parsePoolerID(pcmd.newPrimary.Get()),
+		DrainTimeout: durationpb.New(pcmd.drainTimeout.Get()),
+	})
+	if err != nil {
+		return fmt.Errorf("planned reparent failed: %w", err)
+	}
+
+	cmd.Printf("Primary moved from %s to %s (term %d)\n",
+		topoclient.ClusterIDString(resp.PreviousPrimary),
+		topoclient.ClusterIDString(resp.NewPrimary),
+		resp.Term)
+	return nil
+}
+
+// parsePoolerID parses a pooler given as "name" or "cell/name".
+// It returns nil for an empty string.
+func parsePoolerID(s string) *clustermetadatapb.ID {
+	if s == "" {
+		return nil
+	}
+	id := &clustermetadatapb.ID{Component: clustermetadatapb.ID_MULTIPOOLER}
+	if cell, name, ok := strings.Cut(s, "/"); ok {
+		id.Cell, id.Name = cell, name
+	} else {
+		id.Name = s
+	}
+	return id
+}
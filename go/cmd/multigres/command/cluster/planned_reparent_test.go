@@ -0,0 +1,53 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getPlannedReparentCommand creates a cluster command and adds planned-reparent to it for testing
+func getPlannedReparentCommand() *cobra.Command {
+	clusterCmd := &cobra.Command{Use: "cluster"}
+	AddPlannedReparentCommand(clusterCmd)
+	cmd, _, _ := clusterCmd.Find([]string{"planned-reparent"})
+	return cmd
+}
+
+func TestPlannedReparentCommandFlags(t *testing.T) {
+	cmd := getPlannedReparentCommand()
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, "postgres", cmd.Flag("database").DefValue)
+	assert.Equal(t, "", cmd.Flag("new-primary").DefValue)
+	assert.Equal(t, "5s", cmd.Flag("drain-timeout").DefValue)
+	assert.NotNil(t, cmd.Flag("admin-server"))
+}
+
+func TestParsePoolerID(t *testing.T) {
+	assert.Nil(t, parsePoolerID(""))
+
+	id := parsePoolerID("pooler-2")
+	assert.Equal(t, "", id.Cell)
+	assert.Equal(t, "pooler-2", id.Name)
+
+	id = parsePoolerID("zone2/pooler-2")
+	assert.Equal(t, "zone2", id.Cell)
+	assert.Equal(t, "pooler-2", id.Name)
+}
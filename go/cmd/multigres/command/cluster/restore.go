@@ -0,0 +1,168 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/multigres/multigres/go/cmd/multigres/command/admin"
+	"github.com/multigres/multigres/go/common/constants"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	"github.com/multigres/multigres/go/tools/viperutil"
+)
+
+// restoreCmd holds the restore command configuration
+type restoreCmd struct {
+	database   viperutil.Value[string]
+	pooler     viperutil.Value[string]
+	backupID   viperutil.Value[string]
+	targetTime viperutil.Value[string]
+	targetLSN  viperutil.Value[string]
+	timeout    viperutil.Value[time.Duration]
+}
+
+// AddRestoreCommand adds the restore subcommand to the cluster command
+func AddRestoreCommand(clusterCmd *cobra.Command) {
+	// Create a viperutil registry for restore command flags
+	reg := viperutil.NewRegistry()
+
+	rcmd := &restoreCmd{
+		database: viperutil.Configure(reg, "database", viperutil.Options[string]{
+			Default:  "postgres",
+			FlagName: "database",
+			Dynamic:  false,
+		}),
+		pooler: viperutil.Configure(reg, "pooler", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "pooler",
+			Dynamic:  false,
+		}),
+		backupID: viperutil.Configure(reg, "backup-id", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "backup-id",
+			Dynamic:  false,
+		}),
+		targetTime: viperutil.Configure(reg, "target-time", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "target-time",
+			Dynamic:  false,
+		}),
+		targetLSN: viperutil.Configure(reg, "target-lsn", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "target-lsn",
+			Dynamic:  false,
+		}),
+		timeout: viperutil.Configure(reg, "timeout", viperutil.Options[time.Duration]{
+			Default:  30 * time.Second,
+			FlagName: "timeout",
+			Dynamic:  false,
+		}),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a standby from a backup",
+		Long: "Restore a standby pooler from a backup via the multiadmin API and wait for completion. " +
+			"PostgreSQL must be stopped and its data directory removed on the target pooler first. " +
+			"With --target-time or --target-lsn, archived WAL is replayed up to that point and recovery pauses there.",
+		RunE: rcmd.runRestore,
+	}
+
+	cmd.Flags().String("database", rcmd.database.Default(), "Database name")
+	cmd.Flags().String("pooler", rcmd.pooler.Default(), "Standby pooler to restore, as cell/name (required)")
+	cmd.Flags().String("backup-id", rcmd.backupID.Default(), "Backup to restore (defaults to the latest backup before the target)")
+	cmd.Flags().String("target-time", rcmd.targetTime.Default(), "Recover to this point in time (RFC 3339, e.g. 2026-01-02T15:04:05Z)")
+	cmd.Flags().String("target-lsn", rcmd.targetLSN.Default(), "Recover to this LSN (e.g. 0/3000060)")
+	cmd.Flags().Duration("timeout", rcmd.timeout.Default(), "Timeout for initial restore call")
+	cmd.Flags().String("admin-server", "", "host:port of the multiadmin server (overrides config)")
+
+	viperutil.BindFlags(cmd.Flags(), rcmd.database, rcmd.pooler, rcmd.backupID, rcmd.targetTime, rcmd.targetLSN, rcmd.timeout)
+
+	clusterCmd.AddCommand(cmd)
+}
+
+// buildRestoreRequest validates the flag values and builds the restore request
+func buildRestoreRequest(database, pooler, backupID, targetTime, targetLSN string) (*multiadminpb.RestoreFromBackupRequest, error) {
+	poolerID := parsePoolerID(pooler)
+	if poolerID == nil || poolerID.Cell == "" {
+		return nil, errors.New("--pooler is required and must be given as cell/name")
+	}
+
+	req := &multiadminpb.RestoreFromBackupRequest{
+		Database:   database,
+		TableGroup: constants.DefaultTableGroup,
+		Shard:      constants.DefaultShard,
+		BackupId:   backupID,
+		PoolerId:   poolerID,
+		TargetLsn:  targetLSN,
+	}
+
+	if targetTime != "" {
+		if targetLSN != "" {
+			return nil, errors.New("--target-time and --target-lsn cannot be used together")
+		}
+		t, err := time.Parse(time.RFC3339Nano, targetTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --target-time %q: %w", targetTime, err)
+		}
+		req.TargetTime = timestamppb.New(t)
+	}
+
+	return req, nil
+}
+
+func (rcmd *restoreCmd) runRestore(cmd *cobra.Command, args []string) error {
+	req, err := buildRestoreRequest(rcmd.database.Get(), rcmd.pooler.Get(), rcmd.backupID.Get(),
+		rcmd.targetTime.Get(), rcmd.targetLSN.Get())
+	if err != nil {
+		return err
+	}
+
+	// Create admin client
+	client, err := admin.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch {
+	case req.TargetTime != nil:
+		cmd.Printf("Starting point-in-time restore of %s to %s...\n", rcmd.pooler.Get(), req.TargetTime.AsTime().Format(time.RFC3339Nano))
+	case req.TargetLsn != "":
+		cmd.Printf("Starting point-in-time restore of %s to LSN %s...\n", rcmd.pooler.Get(), req.TargetLsn)
+	default:
+		cmd.Printf("Starting restore of %s...\n", rcmd.pooler.Get())
+	}
+
+	// Create context with timeout for the initial RestoreFromBackup call
+	ctx, cancel := context.WithTimeout(cmd.Context(), rcmd.timeout.Get())
+	defer cancel()
+
+	resp, err := client.RestoreFromBackup(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to start restore: %w", err)
+	}
+
+	cmd.Printf("Restore job started: %s\n", resp.JobId)
+
+	// Poll for completion
+	return pollBackupJobStatus(cmd, client, resp.JobId, "Restore")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getRestoreCommand creates a cluster command and adds restore to it for testing
+func getRestoreCommand() *cobra.Command {
+	clusterCmd := &cobra.Command{Use: "cluster"}
+	AddRestoreCommand(clusterCmd)
+	cmd, _, _ := clusterCmd.Find([]string{"restore"})
+	return cmd
+}
+
+func TestRestoreCommandFlags(t *testing.T) {
+	cmd := getRestoreCommand()
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, "postgres", cmd.Flag("database").DefValue)
+	assert.Equal(t, "", cmd.Flag("pooler").DefValue)
+	assert.Equal(t, "", cmd.Flag("backup-id").DefValue)
+	assert.Equal(t, "", cmd.Flag("target-time").DefValue)
+	assert.Equal(t, "", cmd.Flag("target-lsn").DefValue)
+	assert.NotNil(t, cmd.Flag("admin-server"))
+}
+
+func TestBuildRestoreRequest(t *testing.T) {
+	t.Run("point in time", func(t *testing.T) {
+		req, err := buildRestoreRequest("postgres", "zone1/pooler-2", "", "2026-10-16T11:30:00Z", "")
+		require.NoError(t, err)
+		assert.Equal(t, "zone1", req.PoolerId.Cell)
+		assert.Equal(t, "pooler-2", req.PoolerId.Name)
+		assert.Equal(t, time.Date(2026, 10, 16, 11, 30, 0, 0, time.UTC), req.TargetTime.AsTime())
+		assert.Empty(t, req.TargetLsn)
+	})
+
+	t.Run("lsn with backup", func(t *testing.T) {
+		req, err := buildRestoreRequest("postgres", "zone1/pooler-2", "20261016-100000F", "", "0/3000060")
+		require.NoError(t, err)
+		assert.Equal(t, "20261016-100000F", req.BackupId)
+		assert.Equal(t, "0/3000060", req.TargetLsn)
+		assert.Nil(t, req.TargetTime)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := buildRestoreRequest("postgres", "", "", "", "")
+		assert.ErrorContains(t, err, "--pooler is required")
+
+		_, err = buildRestoreRequest("postgres", "pooler-2", "", "", "")
+		assert.ErrorContains(t, err, "cell/name")
+
+		_, err = buildRestoreRequest("postgres", "zone1/pooler-2", "", "2026-10-16T11:30:00Z", "0/3000060")
+		assert.ErrorContains(t, err, "cannot be used together")
+
+		_, err = buildRestoreRequest("postgres", "zone1/pooler-2", "", "yesterday", "")
+		assert.ErrorContains(t, err, "invalid --target-time")
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/cmd/multigres/command/admin"
+	"github.com/multigres/multigres/go/common/constants"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	"github.com/multigres/multigres/go/tools/viperutil"
+)
+
+// upgradeCmd holds the upgrade command configuration
+type upgradeCmd struct {
+	database  viperutil.Value[string]
+	newBinDir viperutil.Value[string]
+	check     viperutil.Value[bool]
+	finalize  viperutil.Value[bool]
+	rollback  viperutil.Value[bool]
+	timeout   viperutil.Value[time.Duration]
+}
+
+// AddUpgradeCommand adds the upgrade subcommand to the cluster command
+func AddUpgradeCommand(clusterCmd *cobra.Command) {
+	// Create a viperutil registry for upgrade command flags
+	reg := viperutil.NewRegistry()
+
+	ucmd := &upgradeCmd{
+		database: viperutil.Configure(reg, "database", viperutil.Options[string]{
+			Default:  "postgres",
+			FlagName: "database",
+			Dynamic:  false,
+		}),
+		newBinDir: viperutil.Configure(reg, "new-bin-dir", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "new-bin-dir",
+			Dynamic:  false,
+		}),
+		check: viperutil.Configure(reg, "check", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "check",
+			Dynamic:  false,
+		}),
+		finalize: viperutil.Configure(reg, "finalize", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "finalize",
+			Dynamic:  false,
+		}),
+		rollback: viperutil.Configure(reg, "rollback", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "rollback",
+			Dynamic:  false,
+		}),
+		timeout: viperutil.Configure(reg, "timeout", viperutil.Options[time.Duration]{
+			Default:  time.Hour,
+			FlagName: "timeout",
+			Dynamic:  false,
+		}),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade PostgreSQL to a new major version",
+		Long: "Upgrade PostgreSQL to a new major version via the multiadmin API. " +
+			"The primary is upgraded in place with pg_upgrade and the replicas are cloned again from it. " +
+			"The old data directories are kept until the upgrade is finalized with --finalize or undone with --rollback.",
+		RunE: ucmd.runUpgrade,
+	}
+
+	cmd.Flags().String("database", ucmd.database.Default(), "Database name")
+	cmd.Flags().String("new-bin-dir", ucmd.newBinDir.Default(), "Directory of the binaries of the new major version on the pooler hosts")
+	cmd.Flags().Bool("check", ucmd.check.Default(), "Only check that the shard can be upgraded")
+	cmd.Flags().Bool("finalize", ucmd.finalize.Default(), "Remove the old data directories of the last upgrade")
+	cmd.Flags().Bool("rollback", ucmd.rollback.Default(), "Restore the old data directories of the last upgrade")
+	cmd.Flags().Duration("timeout", ucmd.timeout.Default(), "Timeout for the whole operation")
+	cmd.Flags().String("admin-server", "", "host:port of the multiadmin server (overrides config)")
+
+	viperutil.BindFlags(cmd.Flags(), ucmd.database, ucmd.newBinDir, ucmd.check, ucmd.finalize, ucmd.rollback, ucmd.timeout)
+
+	clusterCmd.AddCommand(cmd)
+}
+
+func (ucmd *upgradeCmd) runUpgrade(cmd *cobra.Command, args []string) error {
+	database := ucmd.database.Get()
+	newBinDir := ucmd.newBinDir.Get()
+
+	action, err := upgradeAction(ucmd.check.Get(), ucmd.finalize.Get(), ucmd.rollback.Get())
+	if err != nil {
+		return err
+	}
+	needsBinDir := action == multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_UPGRADE ||
+		action == multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK
+	if needsBinDir && newBinDir == "" {
+		return errors.New("--new-bin-dir is required")
+	}
+
+	// Create admin client
+	client, err := admin.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch action {
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK:
+		cmd.Printf("Checking upgrade for database=%s to %s...\n", database, newBinDir)
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE:
+		cmd.Printf("Finalizing upgrade for database=%s...\n", database)
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK:
+		cmd.Printf("Rolling back upgrade for database=%s...\n", database)
+	default:
+		cmd.Printf("Starting upgrade for database=%s to %s...\n", database, newBinDir)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), ucmd.timeout.Get())
+	defer cancel()
+
+	resp, err := client.UpgradeShard(ctx, &multiadminpb.UpgradeShardRequest{
+		Database:   database,
+		TableGroup: constants.DefaultTableGroup,
+		Shard:      constants.DefaultShard,
+		NewBinDir:  newBinDir,
+		Action:     action,
+	})
+	if err != nil {
+		return fmt.Errorf("upgrade failed: %w", err)
+	}
+
+	switch action {
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK:
+		cmd.Printf("All poolers can be upgraded from PostgreSQL %s to %s\n", resp.OldVersion, resp.NewVersion)
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE:
+		cmd.Println("Upgrade finalized, old data directories removed")
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK:
+		cmd.Println("Upgrade rolled back")
+	default:
+		cmd.Printf("Upgraded from PostgreSQL %s to %s\n", resp.OldVersion, resp.NewVersion)
+		cmd.Println("Run with --finalize once the cluster is verified, or --rollback to undo the upgrade")
+	}
+	return nil
+}
+
+// upgradeAction returns the step of the upgrade selected by the flags,
+// of which at most one may be set.
+func upgradeAction(check, finalize, rollback bool) (multiadminpb.UpgradeShardAction, error) {
+	action := multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_UPGRADE
+	set := 0
+	if check {
+		action = multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK
+		set++
+	}
+	if finalize {
+		action = multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE
+		set++
+	}
+	if rollback {
+		action = multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK
+		set++
+	}
+	if set > 1 {
+		return action, errors.New("only one of --check, --finalize and --rollback can be set")
+	}
+	return action, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+)
+
+// getUpgradeCommand creates a cluster command and adds upgrade to it for testing
+func getUpgradeCommand() *cobra.Command {
+	clusterCmd := &cobra.Command{Use: "cluster"}
+	AddUpgradeCommand(clusterCmd)
+	cmd, _, _ := clusterCmd.Find([]string{"upgrade"})
+	return cmd
+}
+
+func TestUpgradeCommandFlags(t *testing.T) {
+	cmd := getUpgradeCommand()
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, "postgres", cmd.Flag("database").DefValue)
+	assert.Equal(t, "", cmd.Flag("new-bin-dir").DefValue)
+	assert.Equal(t, "false", cmd.Flag("check").DefValue)
+	assert.Equal(t, "false", cmd.Flag("finalize").DefValue)
+	assert.Equal(t, "false", cmd.Flag("rollback").DefValue)
+	assert.Equal(t, "1h0m0s", cmd.Flag("timeout").DefValue)
+	assert.NotNil(t, cmd.Flag("admin-server"))
+}
+
+func TestUpgradeAction(t *testing.T) {
+	action, err := upgradeAction(false, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_UPGRADE, action)
+
+	action, err = upgradeAction(true, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK, action)
+
+	action, err = upgradeAction(false, true, false)
+	require.NoError(t, err)
+	assert.Equal(t, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE, action)
+
+	action, err = upgradeAction(false, false, true)
+	require.NoError(t, err)
+	assert.Equal(t, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK, action)
+
+	_, err = upgradeAction(false, true, true)
+	require.ErrorContains(t, err, "only one of")
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/cmd/multigres/command/admin"
+	"github.com/multigres/multigres/go/common/constants"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+)
+
+// AddVerifyBackupCommand adds the verify-backup subcommand to the cluster command
+func AddVerifyBackupCommand(clusterCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "verify-backup <backup-id>",
+		Short: "Verify a backup",
+		Long:  "Check the files and WAL of an existing backup against the backup repository via the multiadmin API.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runVerifyBackup,
+	}
+
+	cmd.Flags().String("database", "postgres", "Database name the backup belongs to")
+	cmd.Flags().Duration("timeout", 15*time.Minute, "Timeout for the verification")
+	cmd.Flags().String("admin-server", "", "Address of the multiadmin server (overrides config)")
+
+	clusterCmd.AddCommand(cmd)
+}
+
+func runVerifyBackup(cmd *cobra.Command, args []string) error {
+	backupID := args[0]
+	database, _ := cmd.Flags().GetString("database")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	client, err := admin.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+
+	resp, err := client.VerifyBackup(ctx, &multiadminpb.VerifyBackupRequest{
+		Database:   database,
+		TableGroup: constants.DefaultTableGroup,
+		Shard:      constants.DefaultShard,
+		BackupId:   backupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify backup %s: %w", backupID, err)
+	}
+
+	if output := strings.TrimSpace(resp.Output); output != "" {
+		cmd.Println(output)
+	}
+	cmd.Printf("Backup %s verified.\n", backupID)
+	return nil
+}
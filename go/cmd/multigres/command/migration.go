@@ -0,0 +1,41 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"github.com/multigres/multigres/go/cmd/multigres/command/migration"
+
+	"github.com/spf13/cobra"
+)
+
+// AddMigrationCommand adds the migration subcommand and its subcommands to the root command
+func AddMigrationCommand(root *cobra.Command, mc *MultigresCommand) {
+	migrationCmd := &cobra.Command{
+		Use:   "migration",
+		Short: "Manage online schema changes",
+		Long:  "Commands for submitting online schema changes to the queue run by the multigateways, following their progress, and throttling, pausing, resuming or cancelling them.",
+	}
+
+	// Register migration subcommands
+	migration.AddSubmitCommand(migrationCmd)
+	migration.AddShowCommand(migrationCmd)
+	migration.AddPauseCommand(migrationCmd)
+	migration.AddResumeCommand(migrationCmd)
+	migration.AddCancelCommand(migrationCmd)
+	migration.AddThrottleCommand(migrationCmd)
+
+	// Register migration command with root
+	root.AddCommand(migrationCmd)
+}
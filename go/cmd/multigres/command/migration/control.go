@@ -0,0 +1,83 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/services/multigateway/onlineddl"
+)
+
+// AddPauseCommand adds the pause subcommand
+func AddPauseCommand(parent *cobra.Command) {
+	addUpdateCommand(parent, "pause", "Pause an online schema change",
+		"Pause the online schema change id: a queued change does not start, a running one stops copying rows until resumed.",
+		onlineddl.PauseQuery)
+}
+
+// AddResumeCommand adds the resume subcommand
+func AddResumeCommand(parent *cobra.Command) {
+	addUpdateCommand(parent, "resume", "Resume a paused online schema change",
+		"Resume the paused online schema change id.",
+		onlineddl.ResumeQuery)
+}
+
+// AddCancelCommand adds the cancel subcommand
+func AddCancelCommand(parent *cobra.Command) {
+	addUpdateCommand(parent, "cancel", "Cancel an online schema change",
+		"Cancel the online schema change id. A running change stops at its next batch of rows and drops its new table; the shards it completed on keep it.",
+		onlineddl.CancelQuery)
+}
+
+// addUpdateCommand adds a subcommand running the statement query returns
+// for the change id given as argument.
+func addUpdateCommand(parent *cobra.Command, use, short, long string, query func(id string) string) {
+	cmd := &cobra.Command{
+		Use:   use + " <id>",
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateMigration(cmd, args[0], query(args[0]))
+		},
+	}
+	addConnectionFlags(cmd)
+	parent.AddCommand(cmd)
+}
+
+// AddThrottleCommand adds the throttle subcommand
+func AddThrottleCommand(parent *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "throttle <id> <max-rows-per-second>",
+		Short: "Throttle an online schema change",
+		Long:  "Limit the rows the online schema change id copies per second, or remove the limit with 0.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runThrottle,
+	}
+	addConnectionFlags(cmd)
+	parent.AddCommand(cmd)
+}
+
+// runThrottle handles the throttle command
+func runThrottle(cmd *cobra.Command, args []string) error {
+	maxRowsPerSecond, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || maxRowsPerSecond < 0 {
+		return fmt.Errorf("invalid rows per second %q", args[1])
+	}
+	return updateMigration(cmd, args[0], onlineddl.ThrottleQuery(args[0], maxRowsPerSecond))
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migration implements the multigres migration commands, which
+// manage the queue of online schema changes through a multigateway.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	// Registers the postgres driver.
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+)
+
+// addConnectionFlags adds the flags connecting to a multigateway.
+func addConnectionFlags(cmd *cobra.Command) {
+	cmd.Flags().String("dsn", "", "connection string of a multigateway, e.g. 'host=localhost port=15432 user=postgres' (defaults to the PG* environment variables)")
+}
+
+// openDB connects to the multigateway of the command's flags.
+func openDB(cmd *cobra.Command) (*sql.DB, error) {
+	dsn, _ := cmd.Flags().GetString("dsn")
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the multigateway: %w", err)
+	}
+	return db, nil
+}
+
+// updateMigration runs the statement updating the schema change id, which
+// must be queued or running.
+func updateMigration(cmd *cobra.Command, id, query string) error {
+	db, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+	defer cancel()
+	result, err := db.ExecContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to update schema change %s: %w", id, err)
+	}
+	if n, err := result.RowsAffected(); err != nil || n != 1 {
+		return fmt.Errorf("no queued or running schema change %s", id)
+	}
+	return nil
+}
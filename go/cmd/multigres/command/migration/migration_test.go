@@ -0,0 +1,87 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationCommands(t *testing.T) {
+	parent := &cobra.Command{Use: "migration"}
+	AddSubmitCommand(parent)
+	AddShowCommand(parent)
+	AddPauseCommand(parent)
+	AddResumeCommand(parent)
+	AddCancelCommand(parent)
+	AddThrottleCommand(parent)
+
+	for _, name := range []string{"submit", "show", "pause", "resume", "cancel", "throttle"} {
+		t.Run(name, func(t *testing.T) {
+			cmd, _, err := parent.Find([]string{name})
+			require.NoError(t, err)
+			assert.Equal(t, name, cmd.Name())
+			require.NotNil(t, cmd.Flag("dsn"))
+			assert.Equal(t, "", cmd.Flag("dsn").DefValue)
+			assert.NotNil(t, cmd.RunE)
+		})
+	}
+
+	submit, _, err := parent.Find([]string{"submit"})
+	require.NoError(t, err)
+	assert.NotNil(t, submit.Flag("sql"))
+	assert.Equal(t, "0", submit.Flag("max-rows-per-second").DefValue)
+}
+
+func TestMigrationArgs(t *testing.T) {
+	parent := &cobra.Command{Use: "migration"}
+	AddShowCommand(parent)
+	AddPauseCommand(parent)
+	AddThrottleCommand(parent)
+
+	show, _, _ := parent.Find([]string{"show"})
+	assert.NoError(t, show.Args(show, nil))
+	assert.NoError(t, show.Args(show, []string{"id"}))
+	assert.Error(t, show.Args(show, []string{"a", "b"}))
+
+	pause, _, _ := parent.Find([]string{"pause"})
+	assert.Error(t, pause.Args(pause, nil))
+	assert.NoError(t, pause.Args(pause, []string{"id"}))
+
+	throttle, _, _ := parent.Find([]string{"throttle"})
+	assert.Error(t, throttle.Args(throttle, []string{"id"}))
+	err := runThrottle(throttle, []string{"id", "-5"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid rows per second")
+}
+
+func TestSubmitRejectsInvalidChange(t *testing.T) {
+	parent := &cobra.Command{Use: "migration"}
+	AddSubmitCommand(parent)
+	submit, _, _ := parent.Find([]string{"submit"})
+
+	require.NoError(t, submit.Flags().Set("sql", "DROP TABLE users"))
+	err := runSubmit(submit, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only ALTER TABLE statements run online")
+}
+
+func TestSplitShards(t *testing.T) {
+	assert.Equal(t, []string{}, splitShards(""))
+	assert.Equal(t, []string{"-80", "80-"}, splitShards("-80,80-"))
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/services/multigateway/onlineddl"
+)
+
+// migrationStatus is the progress of a schema change, as printed.
+type migrationStatus struct {
+	ID               string    `json:"id"`
+	Table            string    `json:"table"`
+	State            string    `json:"state"`
+	Paused           bool      `json:"paused"`
+	MaxRowsPerSecond int64     `json:"max_rows_per_second"`
+	ShardsDone       []string  `json:"shards_done"`
+	CurrentShard     string    `json:"current_shard,omitempty"`
+	RowsCopied       int64     `json:"rows_copied"`
+	Error            string    `json:"error,omitempty"`
+	SubmittedAt      time.Time `json:"submitted_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// AddShowCommand adds the show subcommand
+func AddShowCommand(parent *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:     "show [id]",
+		Aliases: []string{"list"},
+		Short:   "Show online schema changes",
+		Long:    "Show the progress of the online schema change id, or of every change of the queue.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    runShow,
+	}
+	addConnectionFlags(cmd)
+	parent.AddCommand(cmd)
+}
+
+// runShow handles the show command
+func runShow(cmd *cobra.Command, args []string) error {
+	var id string
+	if len(args) > 0 {
+		id = args[0]
+	}
+
+	db, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+	defer cancel()
+	rows, err := db.QueryContext(ctx, onlineddl.ListQuery(id))
+	if err != nil {
+		return fmt.Errorf("failed to list schema changes: %w", err)
+	}
+	defer rows.Close()
+
+	statuses := []migrationStatus{}
+	for rows.Next() {
+		var status migrationStatus
+		var shardsDone string
+		if err := rows.Scan(&status.ID, &status.Table, &status.State, &status.Paused, &status.MaxRowsPerSecond,
+			&shardsDone, &status.CurrentShard, &status.RowsCopied, &status.Error, &status.SubmittedAt, &status.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to list schema changes: %w", err)
+		}
+		status.ShardsDone = splitShards(shardsDone)
+		statuses = append(statuses, status)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to list schema changes: %w", err)
+	}
+	if id != "" && len(statuses) == 0 {
+		return fmt.Errorf("no schema change %s", id)
+	}
+
+	jsonData, err := json.MarshalIndent(statuses, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal response to JSON: %w", err)
+	}
+	cmd.Print(string(jsonData))
+	return nil
+}
+
+// splitShards splits the shards of a change separated by commas.
+func splitShards(shards string) []string {
+	if shards == "" {
+		return []string{}
+	}
+	return strings.Split(shards, ",")
+}
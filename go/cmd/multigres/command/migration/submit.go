@@ -0,0 +1,78 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/services/multigateway/onlineddl"
+)
+
+// AddSubmitCommand adds the submit subcommand
+func AddSubmitCommand(parent *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Submit an online schema change",
+		Long: `Submit an ALTER TABLE to the queue of online schema changes.
+
+The change runs on each shard of the table, one after the other: the rows
+are copied to a new table the change is applied to, and the tables are
+swapped once the copy catches up, locking the table only for the swap. The
+original table is kept, renamed _mg_<id>_old, until dropped.
+
+Prints the identifier of the change, for the other migration commands.`,
+		Args: cobra.NoArgs,
+		RunE: runSubmit,
+	}
+
+	cmd.Flags().String("sql", "", "ALTER TABLE statement to run (required)")
+	cmd.Flags().Int64("max-rows-per-second", 0, "rows copied per second at most (0 = no limit)")
+	addConnectionFlags(cmd)
+	_ = cmd.MarkFlagRequired("sql")
+
+	parent.AddCommand(cmd)
+}
+
+// runSubmit handles the submit command
+func runSubmit(cmd *cobra.Command, args []string) error {
+	ddl, _ := cmd.Flags().GetString("sql")
+	maxRowsPerSecond, _ := cmd.Flags().GetInt64("max-rows-per-second")
+	if ddl == "" {
+		return errors.New("--sql is required")
+	}
+	m, err := onlineddl.NewMigration(ddl, maxRowsPerSecond)
+	if err != nil {
+		return err
+	}
+
+	db, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+	defer cancel()
+	if _, err := db.ExecContext(ctx, onlineddl.SubmitQuery(m)); err != nil {
+		return fmt.Errorf("failed to submit schema change: %w", err)
+	}
+	cmd.Println(m.ID)
+	return nil
+}
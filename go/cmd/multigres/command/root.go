@@ -120,6 +120,7 @@ Configuration:
 	AddClusterCommand(root, mc)
 	AddTopoCommands(root, mc)
 	AddPoolerCommands(root, mc)
+	AddMigrationCommand(root, mc)
 
 	return root
 }
@@ -31,4 +31,8 @@ func AddTopoCommands(root *cobra.Command, mc *MultigresCommand) {
 	root.AddCommand(topo.AddGetPoolersCommand())
 	root.AddCommand(topo.AddGetOrchsCommand())
 	root.AddCommand(topo.CreateClusterMetadataCommand())
+	root.AddCommand(topo.AddGetRoutingRulesCommand())
+	root.AddCommand(topo.AddApplyRoutingRulesCommand())
+	root.AddCommand(topo.AddGetShardingSchemaCommand())
+	root.AddCommand(topo.AddApplyShardingSchemaCommand())
 }
@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/multigres/multigres/go/common/topoclient"
 	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
@@ -39,6 +41,7 @@ func CreateClusterMetadataCommand() *cobra.Command {
 	cmd.Flags().String("global-topo-address", "localhost:2379", "Address of the of the global topo server (e.g., localhost:2379)")
 	cmd.Flags().String("global-topo-root", "/multigres/test/global", "Root path for the cluster in the global topo server")
 	cmd.Flags().StringSlice("cells", []string{"zone1"}, "List of cells")
+	cmd.Flags().StringSlice("cell-regions", nil, "Regions of the cells, as cell=region; gateways may send reads to the other cells of their region")
 	cmd.Flags().String("durability-policy", "none", "Cluster Durability Policy")
 	cmd.Flags().String("backup-location", "", "Backup location")
 
@@ -54,9 +57,15 @@ func createClusterMetadata(cmd *cobra.Command, args []string) error {
 	globalTopoAddress, _ := cmd.Flags().GetString("global-topo-address")
 	globalTopoRoot, _ := cmd.Flags().GetString("global-topo-root")
 	cells, _ := cmd.Flags().GetStringSlice("cells")
+	cellRegions, _ := cmd.Flags().GetStringSlice("cell-regions")
 	durabilityPolicy, _ := cmd.Flags().GetString("durability-policy")
 	backupLocation, _ := cmd.Flags().GetString("backup-location")
 
+	regions, err := parseCellRegions(cellRegions, cells)
+	if err != nil {
+		return err
+	}
+
 	// Create topology store using configured backend
 	ts, err := topoclient.OpenServer("etcd", globalTopoRoot, []string{globalTopoAddress}, topoclient.NewDefaultTopoConfig())
 	if err != nil {
@@ -66,7 +75,7 @@ func createClusterMetadata(cmd *cobra.Command, args []string) error {
 
 	// Create each cell
 	for _, cellName := range cells {
-		if err := createCell(ctx, ts, cellName, globalTopoAddress, globalTopoRoot); err != nil {
+		if err := createCell(ctx, ts, cellName, regions[cellName], globalTopoAddress, globalTopoRoot); err != nil {
 			return fmt.Errorf("failed to create cell %s: %w", cellName, err)
 		}
 	}
@@ -79,14 +88,39 @@ func createClusterMetadata(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// parseCellRegions parses the regions of the cells, given as cell=region.
+func parseCellRegions(cellRegions, cells []string) (map[string]string, error) {
+	regions := make(map[string]string, len(cellRegions))
+	for _, entry := range cellRegions {
+		cell, region, ok := strings.Cut(entry, "=")
+		if !ok || cell == "" || region == "" {
+			return nil, fmt.Errorf("invalid cell region %q: must be cell=region", entry)
+		}
+		if !slices.Contains(cells, cell) {
+			return nil, fmt.Errorf("invalid cell region %q: %q is not one of the cells", entry, cell)
+		}
+		regions[cell] = region
+	}
+	return regions, nil
+}
+
 // createCell creates a single cell in the topology
-func createCell(ctx context.Context, ts topoclient.Store, cellName, etcdAddress, globalTopoRoot string) error {
+func createCell(ctx context.Context, ts topoclient.Store, cellName, region, etcdAddress, globalTopoRoot string) error {
 	fmt.Printf("Configuring cell: %s\n", cellName)
 
 	// Check if cell already exists
 	cell, err := ts.GetCell(ctx, cellName)
 	if err == nil {
 		fmt.Printf("Cell \"%s\" detected — reusing existing cell:\n%s\n", cellName, prototext.Format(cell))
+		if region != "" && region != cell.GetRegion() {
+			if err := ts.UpdateCellFields(ctx, cellName, func(ci *clustermetadatapb.Cell) error {
+				ci.Region = region
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to set the region of cell '%s': %w", cellName, err)
+			}
+			fmt.Printf("Cell \"%s\" moved to region \"%s\"\n", cellName, region)
+		}
 		return nil
 	}
 
@@ -101,6 +135,7 @@ func createCell(ctx context.Context, ts topoclient.Store, cellName, etcdAddress,
 			Name:            cellName,
 			ServerAddresses: []string{etcdAddress},
 			Root:            cellRoot,
+			Region:          region,
 		}
 
 		if err := ts.CreateCell(ctx, cellName, cellConfig); err != nil {
@@ -0,0 +1,61 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+)
+
+func TestParseCellRegions(t *testing.T) {
+	cells := []string{"zone1", "zone2"}
+
+	regions, err := parseCellRegions([]string{"zone1=us-east", "zone2=us-west"}, cells)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"zone1": "us-east", "zone2": "us-west"}, regions)
+
+	regions, err = parseCellRegions(nil, cells)
+	require.NoError(t, err)
+	assert.Empty(t, regions)
+
+	for _, invalid := range []string{"zone1", "zone1=", "=us-east", "zone3=us-east"} {
+		_, err := parseCellRegions([]string{invalid}, cells)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCreateCell_SetsRegion(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	// A new cell gets its region.
+	require.NoError(t, createCell(ctx, ts, "zone2", "us-west", "localhost:2379", "/multigres/global"))
+	cell, err := ts.GetCell(ctx, "zone2")
+	require.NoError(t, err)
+	assert.Equal(t, "us-west", cell.Region)
+
+	// An existing cell moves to the region given, and keeps it otherwise.
+	require.NoError(t, createCell(ctx, ts, "zone1", "us-east", "localhost:2379", "/multigres/global"))
+	require.NoError(t, createCell(ctx, ts, "zone1", "", "localhost:2379", "/multigres/global"))
+	cell, err = ts.GetCell(ctx, "zone1")
+	require.NoError(t, err)
+	assert.Equal(t, "us-east", cell.Region)
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// AddGetRoutingRulesCommand adds the getroutingrules subcommand
+func AddGetRoutingRulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "getroutingrules",
+		Short: "Get the routing rules of a database",
+		Long:  "Print the routing rules of a database and their version as JSON, in the format taken by applyroutingrules.",
+		RunE:  runGetRoutingRules,
+	}
+
+	addDatabaseTopoFlags(cmd)
+
+	return cmd
+}
+
+// AddApplyRoutingRulesCommand adds the applyroutingrules subcommand
+func AddApplyRoutingRulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "applyroutingrules",
+		Short: "Replace the routing rules of a database",
+		Long: `Replace all the routing rules of a database at once by the rules of a JSON file, as printed by getroutingrules.
+
+The version of the file must be the current version of the rules: the rules are
+not applied if they changed since the file was made from them.`,
+		RunE: runApplyRoutingRules,
+	}
+
+	addDatabaseTopoFlags(cmd)
+	cmd.Flags().String("rules-file", "", "JSON file of the routing rules (required)")
+	cmd.Flags().Bool("dry-run", false, "Print the changes to the rules without applying them")
+
+	_ = cmd.MarkFlagRequired("rules-file")
+
+	return cmd
+}
+
+// addDatabaseTopoFlags adds the flags of the commands on a database in the
+// global topo server.
+func addDatabaseTopoFlags(cmd *cobra.Command) {
+	cmd.Flags().String("global-topo-address", "localhost:2379", "Address of the of the global topo server (e.g., localhost:2379)")
+	cmd.Flags().String("global-topo-root", "/multigres/test/global", "Root path for the cluster in the global topo server")
+	cmd.Flags().String("database", "", "Name of the database (required)")
+
+	_ = cmd.MarkFlagRequired("database")
+}
+
+// openGlobalTopo opens the global topo server of the flags of cmd.
+func openGlobalTopo(cmd *cobra.Command) (topoclient.Store, error) {
+	globalTopoAddress, _ := cmd.Flags().GetString("global-topo-address")
+	globalTopoRoot, _ := cmd.Flags().GetString("global-topo-root")
+
+	ts, err := topoclient.OpenServer("etcd", globalTopoRoot, []string{globalTopoAddress}, topoclient.NewDefaultTopoConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to topology server: %w", err)
+	}
+	return ts, nil
+}
+
+// runGetRoutingRules executes the getroutingrules command
+func runGetRoutingRules(cmd *cobra.Command, args []string) error {
+	database, _ := cmd.Flags().GetString("database")
+
+	ts, err := openGlobalTopo(cmd)
+	if err != nil {
+		return err
+	}
+	defer ts.Close()
+
+	rules, err := ts.GetRoutingRules(cmd.Context(), database)
+	if err != nil {
+		return fmt.Errorf("failed to get the routing rules of database '%s': %w", database, err)
+	}
+	jsonData, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal routing rules to JSON: %w", err)
+	}
+
+	cmd.Println(string(jsonData))
+	return nil
+}
+
+// runApplyRoutingRules executes the applyroutingrules command
+func runApplyRoutingRules(cmd *cobra.Command, args []string) error {
+	database, _ := cmd.Flags().GetString("database")
+	rulesFile, _ := cmd.Flags().GetString("rules-file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	data, err := os.ReadFile(rulesFile)
+	if err != nil {
+		return fmt.Errorf("failed to read routing rules: %w", err)
+	}
+	rules := &clustermetadatapb.RoutingRules{}
+	if err := protojson.Unmarshal(data, rules); err != nil {
+		return fmt.Errorf("failed to parse routing rules %s: %w", rulesFile, err)
+	}
+
+	ts, err := openGlobalTopo(cmd)
+	if err != nil {
+		return err
+	}
+	defer ts.Close()
+
+	return applyRoutingRules(cmd.Context(), ts, database, rules, dryRun, cmd.OutOrStdout())
+}
+
+// applyRoutingRules replaces the routing rules of database by rules,
+// printing the changes to out, unless dryRun.
+func applyRoutingRules(ctx context.Context, ts topoclient.Store, database string, rules *clustermetadatapb.RoutingRules, dryRun bool, out io.Writer) error {
+	if _, err := ts.GetDatabase(ctx, database); err != nil {
+		return fmt.Errorf("failed to check database '%s': %w", database, err)
+	}
+	if err := topoclient.ValidateRoutingRules(rules); err != nil {
+		return fmt.Errorf("invalid routing rules: %w", err)
+	}
+	current, err := ts.GetRoutingRules(ctx, database)
+	if err != nil {
+		return fmt.Errorf("failed to get the routing rules of database '%s': %w", database, err)
+	}
+	if rules.Version != current.Version {
+		return fmt.Errorf("routing rules of database '%s' are at version %d, not %d: get them again and reapply the changes", database, current.Version, rules.Version)
+	}
+
+	changes := diffRoutingRules(current, rules)
+	if len(changes) == 0 {
+		fmt.Fprintf(out, "Routing rules of database \"%s\" unchanged at version %d\n", database, current.Version)
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Fprintln(out, change)
+	}
+	if dryRun {
+		return nil
+	}
+
+	saved, err := ts.SaveRoutingRules(ctx, database, rules)
+	if errors.Is(err, &topoclient.TopoError{Code: topoclient.BadVersion}) {
+		return fmt.Errorf("routing rules of database '%s' changed while applying: get them again and reapply the changes", database)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save the routing rules of database '%s': %w", database, err)
+	}
+	fmt.Fprintf(out, "Routing rules of database \"%s\" applied at version %d\n", database, saved.Version)
+	return nil
+}
+
+// diffRoutingRules describes the rules that next adds, changes and removes
+// from current, in the order of their tables in next then in current.
+func diffRoutingRules(current, next *clustermetadatapb.RoutingRules) []string {
+	before := make(map[string]*clustermetadatapb.RoutingRule, len(current.GetRules()))
+	for _, rule := range current.GetRules() {
+		before[rule.FromTable] = rule
+	}
+	var changes []string
+	after := make(map[string]bool, len(next.GetRules()))
+	for _, rule := range next.GetRules() {
+		after[rule.FromTable] = true
+		previous, ok := before[rule.FromTable]
+		switch {
+		case !ok:
+			changes = append(changes, "+ "+formatRoutingRule(rule))
+		case !proto.Equal(previous, rule):
+			changes = append(changes, "- "+formatRoutingRule(previous), "+ "+formatRoutingRule(rule))
+		}
+	}
+	for _, rule := range current.GetRules() {
+		if !after[rule.FromTable] {
+			changes = append(changes, "- "+formatRoutingRule(rule))
+		}
+	}
+	return changes
+}
+
+// formatRoutingRule describes rule on one line, such as
+// "orders -> orders_v2 tablegroup=archive shard=0-inf pooler_type=REPLICA".
+func formatRoutingRule(rule *clustermetadatapb.RoutingRule) string {
+	var b strings.Builder
+	b.WriteString(rule.FromTable)
+	if rule.ToTable != "" {
+		b.WriteString(" -> " + rule.ToTable)
+	}
+	if rule.Tablegroup != "" {
+		b.WriteString(" tablegroup=" + rule.Tablegroup)
+	}
+	if rule.Shard != "" {
+		b.WriteString(" shard=" + rule.Shard)
+	}
+	if rule.PoolerType != clustermetadatapb.PoolerType_UNKNOWN {
+		b.WriteString(" pooler_type=" + rule.PoolerType.String())
+	}
+	return b.String()
+}
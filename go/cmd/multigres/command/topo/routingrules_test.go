@@ -0,0 +1,74 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topo
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestApplyRoutingRules(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+	require.NoError(t, ts.CreateDatabase(ctx, "db1", &clustermetadatapb.Database{Name: "db1"}))
+
+	rules := &clustermetadatapb.RoutingRules{Rules: []*clustermetadatapb.RoutingRule{
+		{FromTable: "orders", ToTable: "orders_v2"},
+	}}
+
+	// A dry run prints the changes without applying them.
+	var out bytes.Buffer
+	require.NoError(t, applyRoutingRules(ctx, ts, "db1", rules, true, &out))
+	assert.Contains(t, out.String(), "+ orders -> orders_v2\n")
+	current, err := ts.GetRoutingRules(ctx, "db1")
+	require.NoError(t, err)
+	assert.Empty(t, current.Rules)
+
+	out.Reset()
+	require.NoError(t, applyRoutingRules(ctx, ts, "db1", rules, false, &out))
+	assert.Contains(t, out.String(), "applied at version 1")
+
+	// Rules made from an older version are refused.
+	err = applyRoutingRules(ctx, ts, "db1", rules, false, &out)
+	require.ErrorContains(t, err, "are at version 1, not 0")
+
+	rules = &clustermetadatapb.RoutingRules{Version: 1, Rules: []*clustermetadatapb.RoutingRule{
+		{FromTable: "events", Tablegroup: "archive"},
+	}}
+	out.Reset()
+	require.NoError(t, applyRoutingRules(ctx, ts, "db1", rules, false, &out))
+	assert.Contains(t, out.String(), "+ events tablegroup=archive\n")
+	assert.Contains(t, out.String(), "- orders -> orders_v2\n")
+	assert.Contains(t, out.String(), "applied at version 2")
+
+	// Unchanged rules keep their version.
+	rules.Version = 2
+	out.Reset()
+	require.NoError(t, applyRoutingRules(ctx, ts, "db1", rules, false, &out))
+	assert.Contains(t, out.String(), "unchanged at version 2")
+
+	// Invalid rules and unknown databases are refused.
+	invalid := &clustermetadatapb.RoutingRules{Version: 2, Rules: []*clustermetadatapb.RoutingRule{{FromTable: "orders"}}}
+	require.ErrorContains(t, applyRoutingRules(ctx, ts, "db1", invalid, false, &out), "the rule changes nothing")
+	require.ErrorContains(t, applyRoutingRules(ctx, ts, "db2", rules, false, &out), "failed to check database 'db2'")
+}
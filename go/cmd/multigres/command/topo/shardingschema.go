@@ -0,0 +1,296 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	// Registers the postgres driver.
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
+)
+
+// AddGetShardingSchemaCommand adds the getshardingschema subcommand
+func AddGetShardingSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "getshardingschema",
+		Short: "Get the sharding schema of a database",
+		Long:  "Print the version of the sharding schema of a database, then its YAML document, as taken by applyshardingschema.",
+		RunE:  runGetShardingSchema,
+	}
+
+	addDatabaseTopoFlags(cmd)
+
+	return cmd
+}
+
+// AddApplyShardingSchemaCommand adds the applyshardingschema subcommand
+func AddApplyShardingSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "applyshardingschema",
+		Short: "Replace the sharding schema of a database",
+		Long: `Replace the sharding schema of a database by the YAML document of a file, in
+the format of the --sharding-schema-file flag of multigateway, and print the
+differences with the current schema.
+
+The schema is checked before it is applied and, with --dsn, cross-checked
+against the tables of the database: its sharded tables must have their shard
+key column, its reference tables and lookup tables must exist, and so on.
+With --dry-run, the differences are printed without applying the schema.`,
+		RunE: runApplyShardingSchema,
+	}
+
+	addDatabaseTopoFlags(cmd)
+	cmd.Flags().String("schema-file", "", "YAML file of the sharding schema (required)")
+	cmd.Flags().String("dsn", "", "connection string of a multigateway serving the database, e.g. 'host=localhost port=15432 user=postgres dbname=postgres', to check the schema against its tables")
+	cmd.Flags().Bool("dry-run", false, "Print the differences with the current schema without applying it")
+
+	_ = cmd.MarkFlagRequired("schema-file")
+
+	return cmd
+}
+
+// runGetShardingSchema executes the getshardingschema command
+func runGetShardingSchema(cmd *cobra.Command, args []string) error {
+	database, _ := cmd.Flags().GetString("database")
+
+	ts, err := openGlobalTopo(cmd)
+	if err != nil {
+		return err
+	}
+	defer ts.Close()
+
+	schema, err := ts.GetShardingSchema(cmd.Context(), database)
+	if err != nil {
+		return fmt.Errorf("failed to get the sharding schema of database '%s': %w", database, err)
+	}
+	cmd.Printf("# version: %d\n%s", schema.Version, schema.Document)
+	return nil
+}
+
+// runApplyShardingSchema executes the applyshardingschema command
+func runApplyShardingSchema(cmd *cobra.Command, args []string) error {
+	database, _ := cmd.Flags().GetString("database")
+	schemaFile, _ := cmd.Flags().GetString("schema-file")
+	dsn, _ := cmd.Flags().GetString("dsn")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	document, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return fmt.Errorf("failed to read sharding schema: %w", err)
+	}
+
+	var tables planner.TableDefinitions
+	if dsn != "" {
+		if tables, err = readTableDefinitions(cmd.Context(), dsn); err != nil {
+			return err
+		}
+	}
+
+	ts, err := openGlobalTopo(cmd)
+	if err != nil {
+		return err
+	}
+	defer ts.Close()
+
+	return applyShardingSchema(cmd.Context(), ts, database, string(document), tables, dryRun, cmd.OutOrStdout())
+}
+
+// readTableDefinitions reads the columns of the tables of the database
+// served at dsn.
+func readTableDefinitions(ctx context.Context, dsn string) (planner.TableDefinitions, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the database: %w", err)
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rows, err := db.QueryContext(ctx, planner.TableDefinitionsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the tables of the database: %w", err)
+	}
+	defer rows.Close()
+	tables := make(planner.TableDefinitions)
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return nil, fmt.Errorf("failed to read the tables of the database: %w", err)
+		}
+		tables[table] = append(tables[table], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the tables of the database: %w", err)
+	}
+	return tables, nil
+}
+
+// applyShardingSchema replaces the sharding schema of database by the
+// schema of document, printing the differences to out, unless dryRun. The
+// schema is checked against tables unless they are nil.
+func applyShardingSchema(ctx context.Context, ts topoclient.Store, database, document string, tables planner.TableDefinitions, dryRun bool, out io.Writer) error {
+	if _, err := ts.GetDatabase(ctx, database); err != nil {
+		return fmt.Errorf("failed to check database '%s': %w", database, err)
+	}
+	config, err := planner.ParseShardingSchemaConfig([]byte(document))
+	if err != nil {
+		return fmt.Errorf("failed to parse sharding schema: %w", err)
+	}
+	if tables != nil {
+		err = config.Validate(tables)
+	} else {
+		_, err = config.Build()
+	}
+	if err != nil {
+		return fmt.Errorf("invalid sharding schema: %w", err)
+	}
+
+	current, err := ts.GetShardingSchema(ctx, database)
+	if err != nil {
+		return fmt.Errorf("failed to get the sharding schema of database '%s': %w", database, err)
+	}
+	currentConfig, err := planner.ParseShardingSchemaConfig([]byte(current.Document))
+	if err != nil {
+		return fmt.Errorf("failed to parse the current sharding schema of database '%s': %w", database, err)
+	}
+
+	changes := diffShardingSchemas(currentConfig, config)
+	if len(changes) == 0 {
+		fmt.Fprintf(out, "Sharding schema of database \"%s\" unchanged at version %d\n", database, current.Version)
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Fprintln(out, change)
+	}
+	if dryRun {
+		return nil
+	}
+
+	saved, err := ts.SaveShardingSchema(ctx, database, &clustermetadatapb.ShardingSchema{
+		Document: document,
+		Version:  current.Version,
+	})
+	if errors.Is(err, &topoclient.TopoError{Code: topoclient.BadVersion}) {
+		return fmt.Errorf("sharding schema of database '%s' changed while applying: apply it again", database)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save the sharding schema of database '%s': %w", database, err)
+	}
+	fmt.Fprintf(out, "Sharding schema of database \"%s\" applied at version %d\n", database, saved.Version)
+	return nil
+}
+
+// diffShardingSchemas describes what next adds ("+"), changes ("~") and
+// removes ("-") from current.
+func diffShardingSchemas(current, next *planner.ShardingSchemaConfig) []string {
+	var changes []string
+	if !slices.Equal(current.Shards, next.Shards) {
+		changes = append(changes, fmt.Sprintf("~ shards: %v -> %v", current.Shards, next.Shards))
+	}
+	if functionName(current.Function) != functionName(next.Function) || !maps.Equal(current.Params, next.Params) {
+		changes = append(changes, fmt.Sprintf("~ function: %s -> %s",
+			formatFunction(current.Function, current.Params), formatFunction(next.Function, next.Params)))
+	}
+	changes = append(changes, diffEntries("table", current.Tables, next.Tables, func(table planner.ShardedTableConfig) string {
+		if table.Function == "" {
+			return "column " + table.Column
+		}
+		return fmt.Sprintf("column %s, function %s", table.Column, formatFunction(table.Function, table.Params))
+	})...)
+	changes = append(changes, diffEntries("reference table", setOf(current.ReferenceTables), setOf(next.ReferenceTables), func(struct{}) string {
+		return ""
+	})...)
+	changes = append(changes, diffEntries("sequence of table", current.Sequences, next.Sequences, func(seq planner.TableSequence) string {
+		return fmt.Sprintf("column %s, sequence %s", seq.Column, seq.Sequence)
+	})...)
+	changes = append(changes, diffEntries("lookup indexes of table", current.LookupIndexes, next.LookupIndexes, func(indexes []planner.LookupIndex) string {
+		formatted := make([]string, len(indexes))
+		for i, index := range indexes {
+			formatted[i] = fmt.Sprintf("column %s in %s", index.Column, index.Table)
+		}
+		return strings.Join(formatted, ", ")
+	})...)
+	return changes
+}
+
+// diffEntries describes the entries of kind that next adds, changes and
+// removes from current, by name, described by format.
+func diffEntries[V any](kind string, current, next map[string]V, format func(V) string) []string {
+	var changes []string
+	describe := func(sign, name string, value V) string {
+		if text := format(value); text != "" {
+			return fmt.Sprintf("%s %s %s: %s", sign, kind, name, text)
+		}
+		return fmt.Sprintf("%s %s %s", sign, kind, name)
+	}
+	for _, name := range slices.Sorted(maps.Keys(next)) {
+		previous, ok := current[name]
+		switch {
+		case !ok:
+			changes = append(changes, describe("+", name, next[name]))
+		case format(previous) != format(next[name]):
+			changes = append(changes, fmt.Sprintf("~ %s %s: %s -> %s", kind, name, format(previous), format(next[name])))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := next[name]; !ok {
+			changes = append(changes, describe("-", name, current[name]))
+		}
+	}
+	return changes
+}
+
+// setOf returns the set of names.
+func setOf(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// functionName returns the name of a sharding function, hash if empty.
+func functionName(function string) string {
+	if function == "" {
+		return "hash"
+	}
+	return function
+}
+
+// formatFunction describes a sharding function and its parameters.
+func formatFunction(function string, params map[string]string) string {
+	if len(params) == 0 {
+		return functionName(function)
+	}
+	formatted := make([]string, 0, len(params))
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		formatted = append(formatted, name+"="+params[name])
+	}
+	return fmt.Sprintf("%s(%s)", functionName(function), strings.Join(formatted, ", "))
+}
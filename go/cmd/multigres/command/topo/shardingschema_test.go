@@ -0,0 +1,86 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topo
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
+)
+
+const usersSchema = `shards: ["-80", "80-"]
+tables:
+  users: {column: id}
+reference_tables: [countries]
+`
+
+func TestApplyShardingSchema(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+	require.NoError(t, ts.CreateDatabase(ctx, "db1", &clustermetadatapb.Database{Name: "db1"}))
+
+	// A dry run prints the differences without applying the schema.
+	var out bytes.Buffer
+	require.NoError(t, applyShardingSchema(ctx, ts, "db1", usersSchema, nil, true, &out))
+	assert.Contains(t, out.String(), "~ shards: [] -> [-80 80-]\n")
+	assert.Contains(t, out.String(), "+ table users: column id\n")
+	assert.Contains(t, out.String(), "+ reference table countries\n")
+	schema, err := ts.GetShardingSchema(ctx, "db1")
+	require.NoError(t, err)
+	assert.Empty(t, schema.Document)
+
+	out.Reset()
+	require.NoError(t, applyShardingSchema(ctx, ts, "db1", usersSchema, nil, false, &out))
+	assert.Contains(t, out.String(), "applied at version 1")
+	schema, err = ts.GetShardingSchema(ctx, "db1")
+	require.NoError(t, err)
+	assert.Equal(t, usersSchema, schema.Document)
+
+	// The same schema is left alone.
+	out.Reset()
+	require.NoError(t, applyShardingSchema(ctx, ts, "db1", usersSchema, nil, false, &out))
+	assert.Contains(t, out.String(), "unchanged at version 1")
+
+	// The schema is checked against the tables when given.
+	next := `shards: ["-80", "80-"]
+tables:
+  users: {column: uid}
+  orders: {column: user_id, function: range, params: {boundaries: "100"}}
+`
+	tables := planner.TableDefinitions{"users": {"id", "name"}, "orders": {"id", "user_id"}}
+	err = applyShardingSchema(ctx, ts, "db1", next, tables, false, &out)
+	require.ErrorContains(t, err, "sharded table users has no shard key column uid")
+
+	tables["users"] = append(tables["users"], "uid")
+	out.Reset()
+	require.NoError(t, applyShardingSchema(ctx, ts, "db1", next, tables, false, &out))
+	assert.Contains(t, out.String(), "+ table orders: column user_id, function range(boundaries=100)\n")
+	assert.Contains(t, out.String(), "~ table users: column id -> column uid\n")
+	assert.Contains(t, out.String(), "- reference table countries\n")
+	assert.Contains(t, out.String(), "applied at version 2")
+
+	// Invalid schemas and unknown databases are refused.
+	require.ErrorContains(t, applyShardingSchema(ctx, ts, "db1", "tables: {}", nil, false, &out), "no shards")
+	require.ErrorContains(t, applyShardingSchema(ctx, ts, "db1", "shards: {", nil, false, &out), "failed to parse")
+	require.ErrorContains(t, applyShardingSchema(ctx, ts, "db2", usersSchema, nil, false, &out), "failed to check database 'db2'")
+}
@@ -0,0 +1,385 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/pgctld"
+	"github.com/multigres/multigres/go/tools/viperutil"
+
+	"github.com/spf13/cobra"
+)
+
+// ApplyConfigResult contains the result of applying the managed parameters
+type ApplyConfigResult struct {
+	Message string
+	// Changed lists the parameters set or reset in postgresql.auto.conf.
+	Changed []string
+	// PendingRestart lists the changed parameters that only take effect
+	// when PostgreSQL restarts.
+	PendingRestart []string
+	Restarted      bool
+}
+
+// ConfigDriftResult contains the drift of the managed parameters
+type ConfigDriftResult struct {
+	PoolerType     string
+	Drift          []pgctld.ParameterDrift
+	PendingRestart []string
+}
+
+// PgCtldApplyConfigCmd holds the apply-config command configuration
+type PgCtldApplyConfigCmd struct {
+	pgCtlCmd   *PgCtlCommand
+	poolerType viperutil.Value[string]
+	restart    viperutil.Value[bool]
+	checkDrift viperutil.Value[bool]
+}
+
+// AddApplyConfigCommand adds the apply-config subcommand to the root command
+func AddApplyConfigCommand(root *cobra.Command, pc *PgCtlCommand) {
+	applyCmd := &PgCtldApplyConfigCmd{
+		pgCtlCmd: pc,
+		poolerType: viperutil.Configure(pc.reg, "pooler-type", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "pooler-type",
+			Dynamic:  false,
+		}),
+		restart: viperutil.Configure(pc.reg, "restart", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "restart",
+			Dynamic:  false,
+		}),
+		checkDrift: viperutil.Configure(pc.reg, "check-drift", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "check-drift",
+			Dynamic:  false,
+		}),
+	}
+
+	root.AddCommand(applyCmd.createCommand())
+}
+
+func (a *PgCtldApplyConfigCmd) createCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply-config",
+		Short: "Apply the managed PostgreSQL parameters",
+		Long: `Apply the managed PostgreSQL parameters of a pooler type to postgresql.auto.conf.
+
+The managed parameters are declared in the YAML file given by
+--managed-parameters, with a default section and primary and replica sections
+that override it:
+
+  default:
+    log_min_duration_statement: 1s
+  replica:
+    hot_standby_feedback: "on"
+
+While PostgreSQL runs, changed parameters are applied with ALTER SYSTEM and the
+configuration is reloaded. Parameters that only take effect on restart are
+reported, and applied by restarting PostgreSQL with --restart. While
+PostgreSQL is stopped, postgresql.auto.conf is written directly. Parameters
+dropped from the file since the last apply are reset.
+
+--check-drift reports the managed parameters whose value in
+postgresql.auto.conf was changed outside of pgctld, e.g. by a manual ALTER
+SYSTEM, without changing anything. It compares with the last applied pooler
+type unless --pooler-type is set.
+
+Examples:
+  # Apply the parameters of a primary
+  pgctld apply-config --pooler-dir /var/lib/pooler-dir --managed-parameters params.yaml --pooler-type primary
+
+  # Apply and restart if needed
+  pgctld apply-config -d /var/lib/pooler-dir --managed-parameters params.yaml --pooler-type replica --restart
+
+  # Report manual changes
+  pgctld apply-config -d /var/lib/pooler-dir --managed-parameters params.yaml --check-drift`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := a.pgCtlCmd.validateInitialized(cmd, args); err != nil {
+				return err
+			}
+			if !a.checkDrift.Get() && a.poolerType.Get() == "" {
+				return errors.New("--pooler-type is required")
+			}
+			if a.checkDrift.Get() && a.restart.Get() {
+				return errors.New("--check-drift and --restart are mutually exclusive")
+			}
+			return nil
+		},
+		RunE: a.runApplyConfig,
+	}
+
+	cmd.Flags().String("pooler-type", a.poolerType.Default(), "Pooler type whose parameters to apply: primary or replica")
+	cmd.Flags().Bool("restart", a.restart.Default(), "Restart PostgreSQL if a changed parameter only takes effect on restart")
+	cmd.Flags().Bool("check-drift", a.checkDrift.Default(), "Only report parameters changed outside of pgctld")
+	viperutil.BindFlags(cmd.Flags(), a.poolerType, a.restart, a.checkDrift)
+
+	return cmd
+}
+
+func (a *PgCtldApplyConfigCmd) runApplyConfig(cmd *cobra.Command, args []string) error {
+	params, err := a.pgCtlCmd.loadManagedParameters()
+	if err != nil {
+		return err
+	}
+	config, err := NewPostgresCtlConfigFromDefaults(a.pgCtlCmd.GetPoolerDir(), a.pgCtlCmd.pgPort.Get(), a.pgCtlCmd.pgListenAddresses.Get(), a.pgCtlCmd.pgUser.Get(), a.pgCtlCmd.pgDatabase.Get(), a.pgCtlCmd.timeout.Get())
+	if err != nil {
+		return err
+	}
+	logger := a.pgCtlCmd.lg.GetLogger()
+
+	if a.checkDrift.Get() {
+		result, err := CheckConfigDriftWithResult(cmd.Context(), logger, config, params, a.poolerType.Get())
+		if err != nil {
+			return err
+		}
+		if len(result.Drift) == 0 {
+			fmt.Printf("No drift from the managed %s parameters\n", result.PoolerType)
+		}
+		for _, d := range result.Drift {
+			fmt.Printf("%s: managed %q, postgresql.auto.conf %q\n", d.Name, d.Expected, d.Actual)
+		}
+		if len(result.PendingRestart) > 0 {
+			fmt.Printf("Pending restart: %s\n", strings.Join(result.PendingRestart, ", "))
+		}
+		return nil
+	}
+
+	result, err := ApplyManagedConfigWithResult(cmd.Context(), logger, config, params, a.poolerType.Get(), a.restart.Get())
+	if err != nil {
+		return err
+	}
+	fmt.Println(result.Message)
+	return nil
+}
+
+// loadManagedParameters loads the file given by --managed-parameters.
+func (pc *PgCtlCommand) loadManagedParameters() (*pgctld.ManagedParameters, error) {
+	file := pc.managedParameters.Get()
+	if file == "" {
+		return nil, errors.New("--managed-parameters is not set")
+	}
+	return pgctld.LoadManagedParameters(file)
+}
+
+// ApplyManagedConfigWithResult applies the managed parameters of a pooler
+// type to postgresql.auto.conf.
+//
+// While PostgreSQL runs, the parameters are applied with ALTER SYSTEM, which
+// validates them, and the configuration is reloaded. Parameters with the
+// postmaster context only take effect on restart: they are reported in
+// PendingRestart, and PostgreSQL is restarted if restart is set. While
+// PostgreSQL is stopped, postgresql.auto.conf is written directly and every
+// parameter takes effect on the next start.
+func ApplyManagedConfigWithResult(ctx context.Context, logger *slog.Logger, config *pgctld.PostgresCtlConfig, params *pgctld.ManagedParameters, poolerType string, restart bool) (*ApplyConfigResult, error) {
+	desired, err := params.Resolve(poolerType)
+	if err != nil {
+		return nil, err
+	}
+	state, err := pgctld.ReadManagedConfigState(config.PoolerDir)
+	if err != nil {
+		return nil, err
+	}
+	autoConfFile := pgctld.PostgresAutoConfFile(config.PoolerDir)
+	current, err := pgctld.ReadAutoConf(autoConfFile)
+	if err != nil {
+		return nil, err
+	}
+
+	set := map[string]string{}
+	for name, value := range desired {
+		if actual, ok := current[name]; !ok || actual != value {
+			set[name] = value
+		}
+	}
+	// Parameters dropped from the declaration go back to their default.
+	var reset []string
+	if state != nil {
+		for name := range state.Parameters {
+			if _, ok := desired[name]; ok {
+				continue
+			}
+			if _, ok := current[name]; ok {
+				reset = append(reset, name)
+			}
+		}
+	}
+	slices.Sort(reset)
+	result := &ApplyConfigResult{Changed: append(slices.Collect(maps.Keys(set)), reset...)}
+	slices.Sort(result.Changed)
+	if len(result.Changed) > 0 {
+		if isPostgreSQLRunning(config.PostgresDataDir) {
+			result.PendingRestart, err = restartRequiredParameters(ctx, config, result.Changed)
+			if err != nil {
+				return nil, err
+			}
+			if err := alterSystem(ctx, config, set, reset); err != nil {
+				return nil, err
+			}
+		} else if err := pgctld.WriteAutoConf(autoConfFile, set, reset); err != nil {
+			return nil, err
+		}
+		logger.InfoContext(ctx, "Applied managed parameters",
+			"pooler_type", poolerType,
+			"changed", result.Changed,
+			"pending_restart", result.PendingRestart)
+	}
+
+	if err := pgctld.WriteManagedConfigState(config.PoolerDir, &pgctld.ManagedConfigState{
+		PoolerType: poolerType,
+		Parameters: desired,
+	}); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(result.Changed) == 0:
+		result.Message = fmt.Sprintf("Managed %s parameters are up to date", poolerType)
+	case len(result.PendingRestart) == 0:
+		result.Message = fmt.Sprintf("Applied managed %s parameters: %s", poolerType, strings.Join(result.Changed, ", "))
+	case restart:
+		if _, err := RestartPostgreSQLWithResult(logger, config, "fast", false); err != nil {
+			return nil, fmt.Errorf("failed to restart PostgreSQL to apply %s: %w", strings.Join(result.PendingRestart, ", "), err)
+		}
+		result.Restarted = true
+		result.Message = fmt.Sprintf("Applied managed %s parameters and restarted PostgreSQL: %s", poolerType, strings.Join(result.Changed, ", "))
+	default:
+		result.Message = fmt.Sprintf("Applied managed %s parameters: %s; restart PostgreSQL to apply %s",
+			poolerType, strings.Join(result.Changed, ", "), strings.Join(result.PendingRestart, ", "))
+	}
+	return result, nil
+}
+
+// CheckConfigDriftWithResult compares postgresql.auto.conf with the managed
+// parameters of a pooler type, or of the last applied one if poolerType is
+// empty. It also reports the managed parameters that are pending a restart
+// of the running server.
+func CheckConfigDriftWithResult(ctx context.Context, logger *slog.Logger, config *pgctld.PostgresCtlConfig, params *pgctld.ManagedParameters, poolerType string) (*ConfigDriftResult, error) {
+	if poolerType == "" {
+		state, err := pgctld.ReadManagedConfigState(config.PoolerDir)
+		if err != nil {
+			return nil, err
+		}
+		if state == nil {
+			return nil, errors.New("no managed parameters were applied, set the pooler type")
+		}
+		poolerType = state.PoolerType
+	}
+	desired, err := params.Resolve(poolerType)
+	if err != nil {
+		return nil, err
+	}
+	current, err := pgctld.ReadAutoConf(pgctld.PostgresAutoConfFile(config.PoolerDir))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigDriftResult{
+		PoolerType: poolerType,
+		Drift:      pgctld.DetectDrift(desired, current),
+	}
+	if len(desired) > 0 && isPostgreSQLRunning(config.PostgresDataDir) {
+		result.PendingRestart, err = queryParameterNames(ctx, config,
+			"SELECT name FROM pg_settings WHERE pending_restart AND name IN ("+quoteList(slices.Sorted(maps.Keys(desired)))+") ORDER BY name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range result.Drift {
+		logger.WarnContext(ctx, "Managed parameter drifted",
+			"parameter", d.Name,
+			"expected", d.Expected,
+			"actual", d.Actual)
+	}
+	return result, nil
+}
+
+// restartRequiredParameters returns the parameters that only take effect on
+// restart.
+func restartRequiredParameters(ctx context.Context, config *pgctld.PostgresCtlConfig, names []string) ([]string, error) {
+	return queryParameterNames(ctx, config,
+		"SELECT name FROM pg_settings WHERE context = 'postmaster' AND name IN ("+quoteList(names)+") ORDER BY name")
+}
+
+// alterSystem sets and resets parameters with ALTER SYSTEM, then reloads the
+// configuration. ALTER SYSTEM cannot run in a transaction block, so every
+// statement is sent on its own.
+func alterSystem(ctx context.Context, config *pgctld.PostgresCtlConfig, set map[string]string, reset []string) error {
+	var args []string
+	for _, name := range slices.Sorted(maps.Keys(set)) {
+		args = append(args, "-c", fmt.Sprintf("ALTER SYSTEM SET %s = %s", name, ast.QuoteStringLiteral(set[name])))
+	}
+	for _, name := range reset {
+		args = append(args, "-c", "ALTER SYSTEM RESET "+name)
+	}
+	args = append(args, "-c", "SELECT pg_reload_conf()")
+	if _, err := runPsql(ctx, config, args...); err != nil {
+		return fmt.Errorf("failed to apply managed parameters: %w", err)
+	}
+	return nil
+}
+
+// queryParameterNames runs a query returning one parameter name per row.
+func queryParameterNames(ctx context.Context, config *pgctld.PostgresCtlConfig, query string) ([]string, error) {
+	output, err := runPsql(ctx, config, "-c", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parameters: %w", err)
+	}
+	var names []string
+	for line := range strings.Lines(output) {
+		if name := strings.TrimSpace(line); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// runPsql runs psql on the local server over its Unix socket, stopping at the
+// first error, and returns its unaligned output.
+func runPsql(ctx context.Context, config *pgctld.PostgresCtlConfig, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, pgctld.PostgresBinary(config.PoolerDir, "psql"), append([]string{
+		"-h", pgctld.PostgresSocketDir(config.PoolerDir),
+		"-p", strconv.Itoa(config.Port), // Need port even for socket connections
+		"-U", config.User,
+		"-d", config.Database,
+		"-X", "-q", "-A", "-t",
+		"-v", "ON_ERROR_STOP=1",
+	}, args...)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return string(output), nil
+}
+
+// quoteList returns names as a list of SQL string literals.
+func quoteList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = ast.QuoteStringLiteral(name)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/cmd/pgctld/testutil"
+	pb "github.com/multigres/multigres/go/pb/pgctldservice"
+	"github.com/multigres/multigres/go/services/pgctld"
+)
+
+// setupApplyConfig creates an initialized, stopped data directory and
+// returns its config.
+func setupApplyConfig(t *testing.T) *pgctld.PostgresCtlConfig {
+	t.Helper()
+
+	baseDir, cleanup := testutil.TempDir(t, "pgctld_apply_config_test")
+	t.Cleanup(cleanup)
+	testutil.CreateDataDir(t, baseDir, true)
+
+	config, err := NewPostgresCtlConfigFromDefaults(baseDir, 5432, "localhost", "postgres", "postgres", 30)
+	require.NoError(t, err)
+	return config
+}
+
+func TestApplyManagedConfigWithResult_Stopped(t *testing.T) {
+	config := setupApplyConfig(t)
+	ctx := context.Background()
+	autoConfFile := pgctld.PostgresAutoConfFile(config.PoolerDir)
+	require.NoError(t, os.WriteFile(autoConfFile, []byte("work_mem = '4MB'\nlog_min_messages = 'debug1'\n"), 0o600))
+
+	params := &pgctld.ManagedParameters{
+		Default: map[string]string{"work_mem": "64MB", "max_connections": "200"},
+		Replica: map[string]string{"hot_standby_feedback": "on"},
+	}
+	result, err := ApplyManagedConfigWithResult(ctx, slog.Default(), config, params, pgctld.PoolerTypeReplica, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hot_standby_feedback", "max_connections", "work_mem"}, result.Changed)
+	assert.Empty(t, result.PendingRestart)
+	assert.False(t, result.Restarted)
+
+	current, err := pgctld.ReadAutoConf(autoConfFile)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"work_mem":             "64MB",
+		"max_connections":      "200",
+		"hot_standby_feedback": "on",
+		"log_min_messages":     "debug1",
+	}, current)
+
+	// Applying again changes nothing.
+	result, err = ApplyManagedConfigWithResult(ctx, slog.Default(), config, params, pgctld.PoolerTypeReplica, false)
+	require.NoError(t, err)
+	assert.Empty(t, result.Changed)
+	assert.Contains(t, result.Message, "up to date")
+
+	// Parameters dropped from the declaration are reset, the unmanaged ones
+	// are kept.
+	result, err = ApplyManagedConfigWithResult(ctx, slog.Default(), config, params, pgctld.PoolerTypePrimary, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hot_standby_feedback"}, result.Changed)
+
+	current, err = pgctld.ReadAutoConf(autoConfFile)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"work_mem":         "64MB",
+		"max_connections":  "200",
+		"log_min_messages": "debug1",
+	}, current)
+
+	state, err := pgctld.ReadManagedConfigState(config.PoolerDir)
+	require.NoError(t, err)
+	assert.Equal(t, pgctld.PoolerTypePrimary, state.PoolerType)
+
+	_, err = ApplyManagedConfigWithResult(ctx, slog.Default(), config, params, "rdonly", false)
+	assert.ErrorContains(t, err, "invalid pooler type")
+}
+
+func TestCheckConfigDriftWithResult(t *testing.T) {
+	config := setupApplyConfig(t)
+	ctx := context.Background()
+	params := &pgctld.ManagedParameters{
+		Default: map[string]string{"work_mem": "64MB", "max_connections": "200"},
+	}
+
+	_, err := CheckConfigDriftWithResult(ctx, slog.Default(), config, params, "")
+	assert.ErrorContains(t, err, "no managed parameters were applied")
+
+	_, err = ApplyManagedConfigWithResult(ctx, slog.Default(), config, params, pgctld.PoolerTypePrimary, false)
+	require.NoError(t, err)
+
+	result, err := CheckConfigDriftWithResult(ctx, slog.Default(), config, params, "")
+	require.NoError(t, err)
+	assert.Equal(t, pgctld.PoolerTypePrimary, result.PoolerType)
+	assert.Empty(t, result.Drift)
+
+	// Simulate a manual ALTER SYSTEM.
+	autoConfFile := pgctld.PostgresAutoConfFile(config.PoolerDir)
+	require.NoError(t, pgctld.WriteAutoConf(autoConfFile, map[string]string{"work_mem": "1MB"}, []string{"max_connections"}))
+
+	result, err = CheckConfigDriftWithResult(ctx, slog.Default(), config, params, "")
+	require.NoError(t, err)
+	assert.Equal(t, []pgctld.ParameterDrift{
+		{Name: "max_connections", Expected: "200", Actual: ""},
+		{Name: "work_mem", Expected: "64MB", Actual: "1MB"},
+	}, result.Drift)
+}
+
+func TestPgCtldServiceApplyConfig(t *testing.T) {
+	config := setupApplyConfig(t)
+	service := &PgCtldService{
+		logger:    slog.Default(),
+		poolerDir: config.PoolerDir,
+		config:    config,
+	}
+
+	_, err := service.ApplyConfig(context.Background(), &pb.ApplyConfigRequest{PoolerType: pgctld.PoolerTypePrimary})
+	assert.ErrorContains(t, err, "no managed parameters configured")
+
+	file := filepath.Join(t.TempDir(), "managed.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("default:\n  work_mem: 64MB\n"), 0o644))
+	service.SetManagedParametersFile(file)
+
+	resp, err := service.ApplyConfig(context.Background(), &pb.ApplyConfigRequest{PoolerType: pgctld.PoolerTypePrimary})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work_mem"}, resp.Changed)
+
+	require.NoError(t, os.WriteFile(file, []byte("default:\n  work_mem: 128MB\n"), 0o644))
+	drift, err := service.CheckConfigDrift(context.Background(), &pb.CheckConfigDriftRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, pgctld.PoolerTypePrimary, drift.PoolerType)
+	require.Len(t, drift.Drift, 1)
+	assert.Equal(t, "work_mem", drift.Drift[0].Name)
+	assert.Equal(t, "128MB", drift.Drift[0].Expected)
+	assert.Equal(t, "64MB", drift.Drift[0].Actual)
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/pgctld"
+	"github.com/multigres/multigres/go/tools/viperutil"
+
+	"github.com/spf13/cobra"
+)
+
+// ExtensionResult contains the result of checking or installing an extension
+type ExtensionResult struct {
+	Message        string
+	DefaultVersion string
+	// OldVersion is the version created in the database before, "" if the
+	// extension was not created. NewVersion is the version after an install.
+	OldVersion string
+	NewVersion string
+}
+
+// PgCtldInstallExtensionCmd holds the install-extension command configuration
+type PgCtldInstallExtensionCmd struct {
+	pgCtlCmd *PgCtlCommand
+	name     viperutil.Value[string]
+	version  viperutil.Value[string]
+	schema   viperutil.Value[string]
+	cascade  viperutil.Value[bool]
+	check    viperutil.Value[bool]
+}
+
+// AddInstallExtensionCommand adds the install-extension subcommand to the root command
+func AddInstallExtensionCommand(root *cobra.Command, pc *PgCtlCommand) {
+	extCmd := &PgCtldInstallExtensionCmd{
+		pgCtlCmd: pc,
+		name: viperutil.Configure(pc.reg, "extension-name", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "name",
+			Dynamic:  false,
+		}),
+		version: viperutil.Configure(pc.reg, "extension-version", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "version",
+			Dynamic:  false,
+		}),
+		schema: viperutil.Configure(pc.reg, "extension-schema", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "schema",
+			Dynamic:  false,
+		}),
+		cascade: viperutil.Configure(pc.reg, "extension-cascade", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "cascade",
+			Dynamic:  false,
+		}),
+		check: viperutil.Configure(pc.reg, "extension-check", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "check",
+			Dynamic:  false,
+		}),
+	}
+
+	root.AddCommand(extCmd.createCommand())
+}
+
+func (e *PgCtldInstallExtensionCmd) createCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install-extension",
+		Short: "Create or update a PostgreSQL extension",
+		Long: `Create or update a PostgreSQL extension in the database.
+
+The files of the extension are checked first: its control file, the scripts of
+the requested version, the control files of the extensions it requires and its
+shared library, which CREATE EXTENSION would otherwise only miss once it loads
+it. The extension is then created, or updated with ALTER EXTENSION UPDATE if it
+already exists. PostgreSQL must run as a primary; replicas get the extension
+through replication, but they need its files too, which --check verifies
+without changing anything.
+
+Extensions such as pg_stat_statements also need their library in
+shared_preload_libraries, which is not changed by this command.
+
+Examples:
+  # Create pg_stat_statements
+  pgctld install-extension --pooler-dir /var/lib/pooler-dir --name pg_stat_statements
+
+  # Update postgis to a version
+  pgctld install-extension -d /var/lib/pooler-dir --name postgis --version 3.5.0
+
+  # Check that a replica can run an extension
+  pgctld install-extension -d /var/lib/pooler-dir --name postgis --check`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := e.pgCtlCmd.validateInitialized(cmd, args); err != nil {
+				return err
+			}
+			if e.name.Get() == "" {
+				return errors.New("--name is required")
+			}
+			return nil
+		},
+		RunE: e.runInstallExtension,
+	}
+
+	cmd.Flags().String("name", e.name.Default(), "Name of the extension")
+	cmd.Flags().String("version", e.version.Default(), "Version to create or update to (default: the default version of the extension)")
+	cmd.Flags().String("schema", e.schema.Default(), "Schema to create the extension in")
+	cmd.Flags().Bool("cascade", e.cascade.Default(), "Also create the extensions it requires")
+	cmd.Flags().Bool("check", e.check.Default(), "Only check that the files of the extension are installed")
+	viperutil.BindFlags(cmd.Flags(), e.name, e.version, e.schema, e.cascade, e.check)
+
+	return cmd
+}
+
+func (e *PgCtldInstallExtensionCmd) runInstallExtension(cmd *cobra.Command, args []string) error {
+	config, err := NewPostgresCtlConfigFromDefaults(e.pgCtlCmd.GetPoolerDir(), e.pgCtlCmd.pgPort.Get(), e.pgCtlCmd.pgListenAddresses.Get(), e.pgCtlCmd.pgUser.Get(), e.pgCtlCmd.pgDatabase.Get(), e.pgCtlCmd.timeout.Get())
+	if err != nil {
+		return err
+	}
+	logger := e.pgCtlCmd.lg.GetLogger()
+
+	var result *ExtensionResult
+	if e.check.Get() {
+		result, err = CheckExtensionWithResult(cmd.Context(), logger, config, e.name.Get(), e.version.Get())
+	} else {
+		result, err = InstallExtensionWithResult(cmd.Context(), logger, config, e.name.Get(), e.version.Get(), e.schema.Get(), e.cascade.Get())
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(result.Message)
+	return nil
+}
+
+// CheckExtensionWithResult checks that the files needed to create an
+// extension, or update it to version, are installed for the PostgreSQL
+// binaries of the pooler. It also reports the version created in the
+// database if PostgreSQL runs.
+func CheckExtensionWithResult(ctx context.Context, logger *slog.Logger, config *pgctld.PostgresCtlConfig, name, version string) (*ExtensionResult, error) {
+	shareDir, pkgLibDir, err := extensionDirs(ctx, config.PoolerDir)
+	if err != nil {
+		return nil, err
+	}
+	control, err := pgctld.CheckExtensionFiles(shareDir, pkgLibDir, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExtensionResult{DefaultVersion: control.DefaultVersion}
+	if isPostgreSQLRunning(config.PostgresDataDir) {
+		if result.OldVersion, err = installedExtensionVersion(ctx, config, name); err != nil {
+			return nil, err
+		}
+	}
+	if version == "" {
+		version = control.DefaultVersion
+	}
+	result.Message = fmt.Sprintf("Extension %s %s can be installed", name, version)
+	if result.OldVersion != "" {
+		result.Message += fmt.Sprintf(" (version %s is installed)", result.OldVersion)
+	}
+	logger.InfoContext(ctx, "Extension files are installed", "extension", name, "version", version, "installed_version", result.OldVersion)
+	return result, nil
+}
+
+// InstallExtensionWithResult creates an extension, or updates it to version
+// if it already exists, after checking that its files are installed. The
+// default version of the extension is used if version is empty.
+func InstallExtensionWithResult(ctx context.Context, logger *slog.Logger, config *pgctld.PostgresCtlConfig, name, version, schema string, cascade bool) (*ExtensionResult, error) {
+	if !isPostgreSQLRunning(config.PostgresDataDir) {
+		return nil, errors.New("PostgreSQL is not running")
+	}
+	result, err := CheckExtensionWithResult(ctx, logger, config, name, version)
+	if err != nil {
+		return nil, err
+	}
+	inRecovery, err := runPsql(ctx, config, "-c", "SELECT pg_is_in_recovery()")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the role of PostgreSQL: %w", err)
+	}
+	if strings.TrimSpace(inRecovery) == "t" {
+		return nil, errors.New("PostgreSQL is a standby: extensions are installed on the primary and replicate")
+	}
+
+	var stmt string
+	if result.OldVersion == "" {
+		stmt = "CREATE EXTENSION IF NOT EXISTS " + ast.QuoteIdentifier(name)
+		if schema != "" {
+			stmt += " SCHEMA " + ast.QuoteIdentifier(schema)
+		}
+		if version != "" {
+			stmt += " VERSION " + ast.QuoteStringLiteral(version)
+		}
+		if cascade {
+			stmt += " CASCADE"
+		}
+	} else {
+		stmt = "ALTER EXTENSION " + ast.QuoteIdentifier(name) + " UPDATE"
+		if version != "" {
+			stmt += " TO " + ast.QuoteStringLiteral(version)
+		}
+	}
+	if _, err := runPsql(ctx, config, "-c", stmt); err != nil {
+		return nil, fmt.Errorf("failed to install extension %s: %w", name, err)
+	}
+	if result.NewVersion, err = installedExtensionVersion(ctx, config, name); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case result.OldVersion == "":
+		result.Message = fmt.Sprintf("Created extension %s %s", name, result.NewVersion)
+	case result.OldVersion == result.NewVersion:
+		result.Message = fmt.Sprintf("Extension %s %s is up to date", name, result.NewVersion)
+	default:
+		result.Message = fmt.Sprintf("Updated extension %s from %s to %s", name, result.OldVersion, result.NewVersion)
+	}
+	logger.InfoContext(ctx, "Installed extension", "extension", name, "old_version", result.OldVersion, "new_version", result.NewVersion)
+	return result, nil
+}
+
+// installedExtensionVersion returns the version of an extension created in
+// the database, or "" if it was not created.
+func installedExtensionVersion(ctx context.Context, config *pgctld.PostgresCtlConfig, name string) (string, error) {
+	output, err := runPsql(ctx, config, "-c", "SELECT extversion FROM pg_extension WHERE extname = "+ast.QuoteStringLiteral(name))
+	if err != nil {
+		return "", fmt.Errorf("failed to query extension %s: %w", name, err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// extensionDirs returns the directories of the extension files and shared
+// libraries of the pooler's PostgreSQL binaries, as reported by pg_config.
+func extensionDirs(ctx context.Context, poolerDir string) (string, string, error) {
+	output, err := exec.CommandContext(ctx, pgctld.PostgresBinary(poolerDir, "pg_config"), "--sharedir", "--pkglibdir").Output()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to run pg_config: %w", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) != 2 {
+		return "", "", fmt.Errorf("unexpected pg_config output: %q", string(output))
+	}
+	return lines[0], lines[1], nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/cmd/pgctld/testutil"
+	pb "github.com/multigres/multigres/go/pb/pgctldservice"
+)
+
+// setupExtensionFiles installs a mock pg_config in PATH whose directories
+// hold pg_stat_statements, and returns the directory of its shared library.
+func setupExtensionFiles(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	binDir := filepath.Join(dir, "bin")
+	shareDir := filepath.Join(dir, "share")
+	pkgLibDir := filepath.Join(dir, "lib")
+	require.NoError(t, os.MkdirAll(binDir, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(shareDir, "extension"), 0o755))
+	require.NoError(t, os.MkdirAll(pkgLibDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(shareDir, "extension", "pg_stat_statements.control"),
+		[]byte("default_version = '1.11'\nmodule_pathname = '$libdir/pg_stat_statements'\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(shareDir, "extension", "pg_stat_statements--1.11.sql"), []byte{}, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(pkgLibDir, "pg_stat_statements.so"), []byte{}, 0o755))
+
+	testutil.MockBinary(t, binDir, "pg_config", `echo "`+shareDir+`"
+echo "`+pkgLibDir+`"`)
+	t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+	return pkgLibDir
+}
+
+func TestCheckExtensionWithResult(t *testing.T) {
+	pkgLibDir := setupExtensionFiles(t)
+	config := setupApplyConfig(t)
+
+	result, err := CheckExtensionWithResult(context.Background(), slog.Default(), config, "pg_stat_statements", "")
+	require.NoError(t, err)
+	assert.Equal(t, "1.11", result.DefaultVersion)
+	assert.Empty(t, result.OldVersion, "PostgreSQL is not running")
+	assert.Contains(t, result.Message, "pg_stat_statements 1.11 can be installed")
+
+	require.NoError(t, os.Remove(filepath.Join(pkgLibDir, "pg_stat_statements.so")))
+	_, err = CheckExtensionWithResult(context.Background(), slog.Default(), config, "pg_stat_statements", "")
+	assert.ErrorContains(t, err, "shared library $libdir/pg_stat_statements of extension pg_stat_statements not found")
+}
+
+func TestInstallExtensionWithResult_NotRunning(t *testing.T) {
+	setupExtensionFiles(t)
+	config := setupApplyConfig(t)
+
+	_, err := InstallExtensionWithResult(context.Background(), slog.Default(), config, "pg_stat_statements", "", "", false)
+	assert.ErrorContains(t, err, "PostgreSQL is not running")
+}
+
+func TestPgCtldServiceCheckExtension(t *testing.T) {
+	setupExtensionFiles(t)
+	config := setupApplyConfig(t)
+	service := &PgCtldService{
+		logger:    slog.Default(),
+		poolerDir: config.PoolerDir,
+		config:    config,
+	}
+
+	resp, err := service.CheckExtension(context.Background(), &pb.CheckExtensionRequest{Name: "pg_stat_statements"})
+	require.NoError(t, err)
+	assert.Equal(t, "1.11", resp.DefaultVersion)
+
+	_, err = service.CheckExtension(context.Background(), &pb.CheckExtensionRequest{Name: "postgis"})
+	assert.ErrorContains(t, err, "extension postgis is not available")
+}
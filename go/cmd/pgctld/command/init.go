@@ -127,8 +127,11 @@ func (i *PgCtldInitCmd) runInit(cmd *cobra.Command, args []string) error {
 
 func initializeDataDir(logger *slog.Logger, poolerDir string, pgUser string) error {
 	// Derive dataDir from poolerDir using the standard convention
-	dataDir := pgctld.PostgresDataDir(poolerDir)
+	return runInitdb(logger, poolerDir, pgctld.PostgresDataDir(poolerDir), pgctld.PostgresBinary(poolerDir, "initdb"), pgUser)
+}
 
+// runInitdb creates a cluster in dataDir with the given initdb binary.
+func runInitdb(logger *slog.Logger, poolerDir, dataDir, initdb, pgUser string) error {
 	// Note: initdb will create the data directory itself if it doesn't exist.
 	// We don't create it beforehand to avoid leaving empty directories if initdb fails.
 
@@ -180,7 +183,7 @@ func initializeDataDir(logger *slog.Logger, poolerDir string, pgUser string) err
 		logger.Warn("No password provided - skipping password setup", "user", pgUser, "warning", "PostgreSQL user will not have password authentication enabled")
 	}
 
-	cmd := exec.Command("initdb", args...)
+	cmd := exec.Command(initdb, args...)
 
 	// Capture both stdout and stderr to include in error messages
 	output, err := cmd.CombinedOutput()
@@ -0,0 +1,214 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+
+	"github.com/multigres/multigres/go/services/pgctld"
+	"github.com/multigres/multigres/go/tools/viperutil"
+
+	"github.com/spf13/cobra"
+)
+
+// InitReplicaResult contains the result of provisioning a replica data directory
+type InitReplicaResult struct {
+	AlreadyInitialized bool
+	Message            string
+	Output             string
+}
+
+// PgCtldInitReplicaCmd holds the init-replica command configuration
+type PgCtldInitReplicaCmd struct {
+	pgCtlCmd        *PgCtlCommand
+	sourceHost      viperutil.Value[string]
+	sourcePort      viperutil.Value[int]
+	applicationName viperutil.Value[string]
+}
+
+// AddInitReplicaCommand adds the init-replica subcommand to the root command
+func AddInitReplicaCommand(root *cobra.Command, pc *PgCtlCommand) {
+	initReplicaCmd := &PgCtldInitReplicaCmd{
+		pgCtlCmd: pc,
+		sourceHost: viperutil.Configure(pc.reg, "source-host", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "source-host",
+			Dynamic:  false,
+		}),
+		sourcePort: viperutil.Configure(pc.reg, "source-port", viperutil.Options[int]{
+			Default:  5432,
+			FlagName: "source-port",
+			Dynamic:  false,
+		}),
+		applicationName: viperutil.Configure(pc.reg, "application-name", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "application-name",
+			Dynamic:  false,
+		}),
+	}
+
+	root.AddCommand(initReplicaCmd.createCommand())
+}
+
+func (i *PgCtldInitReplicaCmd) createCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-replica",
+		Short: "Provision a replica from a running primary and start it",
+		Long: `Provision a PostgreSQL replica by copying a running primary with pg_basebackup.
+
+The init-replica command clones the primary into an empty data directory,
+writes standby.signal and primary_conninfo so the copy starts as a streaming
+standby, regenerates the local postgresql.conf and pg_hba.conf, and starts
+PostgreSQL. The command fails if the data directory is already initialized.
+
+The replica appears in the topology once the multipooler serving the same
+pooler directory is started; it registers itself and reports as a replica.
+
+Password can be set via PGPASSWORD environment variable or by placing a
+password file at <pooler-dir>/pgpassword.txt. The same password is used to
+connect to the primary.
+
+Examples:
+  # Clone the primary listening on pg-primary:5432
+  pgctld init-replica --pooler-dir /var/lib/pooler-dir --source-host pg-primary
+
+  # Clone a primary on a custom port and name the standby
+  pgctld init-replica -d /var/lib/pooler-dir --source-host 10.0.0.5 --source-port 5433 --application-name zone1-replica2`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := i.pgCtlCmd.validateGlobalFlags(cmd, args); err != nil {
+				return err
+			}
+			if pgctld.IsDataDirInitialized(i.pgCtlCmd.GetPoolerDir()) {
+				return fmt.Errorf("data directory already initialized: %s", pgctld.PostgresDataDir(i.pgCtlCmd.GetPoolerDir()))
+			}
+			return nil
+		},
+		RunE: i.runInitReplica,
+	}
+
+	cmd.Flags().String("source-host", i.sourceHost.Default(), "Hostname of the primary to clone")
+	cmd.Flags().Int("source-port", i.sourcePort.Default(), "Port of the primary to clone")
+	cmd.Flags().String("application-name", i.applicationName.Default(), "application_name the standby reports to the primary")
+	viperutil.BindFlags(cmd.Flags(), i.sourceHost, i.sourcePort, i.applicationName)
+
+	return cmd
+}
+
+func (i *PgCtldInitReplicaCmd) runInitReplica(cmd *cobra.Command, args []string) error {
+	logger := i.pgCtlCmd.lg.GetLogger()
+	poolerDir := i.pgCtlCmd.GetPoolerDir()
+
+	if _, err := InitReplicaWithResult(cmd.Context(), logger, poolerDir, i.pgCtlCmd.pgPort.Get(), i.pgCtlCmd.pgUser.Get(),
+		i.sourceHost.Get(), i.sourcePort.Get(), i.applicationName.Get(), nil); err != nil {
+		return err
+	}
+	fmt.Printf("Replica data directory provisioned from %s:%d: %s\n", i.sourceHost.Get(), i.sourcePort.Get(), pgctld.PostgresDataDir(poolerDir))
+
+	config, err := NewPostgresCtlConfigFromDefaults(poolerDir, i.pgCtlCmd.pgPort.Get(), i.pgCtlCmd.pgListenAddresses.Get(), i.pgCtlCmd.pgUser.Get(), i.pgCtlCmd.pgDatabase.Get(), i.pgCtlCmd.timeout.Get())
+	if err != nil {
+		return err
+	}
+	result, err := StartPostgreSQLWithResult(logger, config)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("PostgreSQL replica started successfully (PID: %d)\n", result.PID)
+
+	return nil
+}
+
+// InitReplicaWithResult provisions an empty data directory as a standby of the
+// primary at sourceHost:sourcePort. pg_basebackup -R writes standby.signal and
+// primary_conninfo; the configuration files copied from the primary are then
+// replaced with ones generated for this pooler. The server is not started.
+func InitReplicaWithResult(ctx context.Context, logger *slog.Logger, poolerDir string, pgPort int, pgUser, sourceHost string, sourcePort int, applicationName string, extraArgs []string) (*InitReplicaResult, error) {
+	result := &InitReplicaResult{}
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+
+	if sourceHost == "" {
+		return nil, errors.New("source host is required")
+	}
+	if sourcePort <= 0 {
+		return nil, fmt.Errorf("invalid source port: %d", sourcePort)
+	}
+
+	// Never clone over an existing cluster
+	if pgctld.IsDataDirInitialized(poolerDir) {
+		logger.InfoContext(ctx, "Data directory is already initialized", "data_dir", dataDir)
+		result.AlreadyInitialized = true
+		result.Message = "Data directory is already initialized"
+		return result, nil
+	}
+
+	password, err := resolvePassword(poolerDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve password: %w", err)
+	}
+
+	// Connection string without the password; pg_basebackup reads it from PGPASSWORD
+	sourceServer := fmt.Sprintf("host=%s port=%d user=%s dbname=postgres", sourceHost, sourcePort, pgUser)
+	if applicationName != "" {
+		sourceServer += " application_name=" + applicationName
+	}
+
+	args := []string{
+		"--pgdata", dataDir,
+		"--dbname", sourceServer,
+		"--wal-method=stream",
+		"--checkpoint=fast",
+		"--write-recovery-conf",
+	}
+	args = append(args, extraArgs...)
+
+	logger.InfoContext(ctx, "executing pg_basebackup command",
+		"command", "pg_basebackup",
+		"args", args,
+		"source_host", sourceHost,
+		"source_port", sourcePort,
+		"target_pgdata", dataDir)
+
+	cmd := exec.CommandContext(ctx, pgctld.PostgresBinary(poolerDir, "pg_basebackup"), args...)
+	if password != "" {
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	}
+
+	// pg_basebackup removes whatever it created when it fails, so a failed
+	// attempt leaves the data directory uninitialized and can be retried
+	output, err := cmd.CombinedOutput()
+	result.Output = string(output)
+	if err != nil {
+		result.Message = "Replica provisioning failed"
+		logger.ErrorContext(ctx, "pg_basebackup command failed",
+			"error", err,
+			"output", string(output))
+		return result, fmt.Errorf("pg_basebackup failed: %w", err)
+	}
+
+	// postgresql.conf and pg_hba.conf live inside the data directory and were
+	// copied from the primary; regenerate them from this pooler's templates
+	if _, err := pgctld.GeneratePostgresServerConfig(poolerDir, pgPort, pgUser); err != nil {
+		return result, fmt.Errorf("failed to create postgres config: %w", err)
+	}
+
+	result.Message = "Replica provisioned successfully"
+	logger.InfoContext(ctx, "pg_basebackup command completed successfully",
+		"output", string(output))
+	return result, nil
+}
@@ -20,6 +20,8 @@ import (
 	"log/slog"
 	"os"
 	"os/exec"
+
+	"github.com/multigres/multigres/go/services/pgctld"
 )
 
 type PgRewindResult struct {
@@ -47,7 +49,7 @@ func PgRewindWithResult(ctx context.Context, logger *slog.Logger, poolerDir, sou
 		"target_pgdata", poolerDir+"/pg_data",
 		"dry_run", dryRun)
 
-	cmd := exec.CommandContext(ctx, "pg_rewind", args...)
+	cmd := exec.CommandContext(ctx, pgctld.PostgresBinary(poolerDir, "pg_rewind"), args...)
 
 	// Set PGPASSWORD environment variable for pg_rewind to use
 	// pg_rewind doesn't reliably use passwords from connection strings
@@ -45,6 +45,7 @@ type PgCtlCommand struct {
 	pgListenAddresses  viperutil.Value[string]
 	pgHbaTemplate      viperutil.Value[string]
 	postgresConfigTmpl viperutil.Value[string]
+	managedParameters  viperutil.Value[string]
 	vc                 *viperutil.ViperConfig
 	lg                 *servenv.Logger
 	telemetry          *telemetry.Telemetry
@@ -96,6 +97,11 @@ func GetRootCommand() (*cobra.Command, *PgCtlCommand) {
 			FlagName: "postgres-config-template",
 			Dynamic:  false,
 		}),
+		managedParameters: viperutil.Configure(reg, "managed-parameters", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "managed-parameters",
+			Dynamic:  false,
+		}),
 		vc:        viperutil.NewViperConfig(reg),
 		lg:        servenv.NewLogger(reg, telemetry),
 		telemetry: telemetry,
@@ -142,6 +148,7 @@ management for PostgreSQL servers.`,
 	root.PersistentFlags().String("pg-listen-addresses", pc.pgListenAddresses.Default(), "PostgreSQL listen addresses")
 	root.PersistentFlags().String("pg-hba-template", pc.pgHbaTemplate.Default(), "Path to custom pg_hba.conf template file")
 	root.PersistentFlags().String("postgres-config-template", pc.postgresConfigTmpl.Default(), "Path to custom postgresql.conf template file")
+	root.PersistentFlags().String("managed-parameters", pc.managedParameters.Default(), "Path to the YAML file of the PostgreSQL parameters managed per pooler type")
 	pc.vc.RegisterFlags(root.PersistentFlags())
 	pc.lg.RegisterFlags(root.PersistentFlags())
 
@@ -154,17 +161,22 @@ management for PostgreSQL servers.`,
 		pc.pgListenAddresses,
 		pc.pgHbaTemplate,
 		pc.postgresConfigTmpl,
+		pc.managedParameters,
 	)
 
 	// Add all subcommands
 	AddServerCommand(root, pc)
 	AddInitCommand(root, pc)
+	AddInitReplicaCommand(root, pc)
+	AddUpgradeCommand(root, pc)
 	AddStartCommand(root, pc)
 	AddStopCommand(root, pc)
 	AddRestartCommand(root, pc)
 	AddStatusCommand(root, pc)
 	AddVersionCommand(root, pc)
 	AddReloadCommand(root, pc)
+	AddApplyConfigCommand(root, pc)
+	AddInstallExtensionCommand(root, pc)
 
 	return root, pc
 }
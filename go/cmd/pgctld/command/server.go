@@ -22,12 +22,14 @@ import (
 	"math"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/multigres/multigres/go/common/constants"
 	"github.com/multigres/multigres/go/common/servenv"
 	"github.com/multigres/multigres/go/services/pgctld"
+	"github.com/multigres/multigres/go/tools/viperutil"
 
 	"github.com/spf13/cobra"
 
@@ -47,9 +49,10 @@ func intToInt32(v int) (int32, error) {
 
 // PgCtldServerCmd holds the server command configuration
 type PgCtldServerCmd struct {
-	pgCtlCmd   *PgCtlCommand
-	grpcServer *servenv.GrpcServer
-	senv       *servenv.ServEnv
+	pgCtlCmd           *PgCtlCommand
+	grpcServer         *servenv.GrpcServer
+	senv               *servenv.ServEnv
+	driftCheckInterval viperutil.Value[time.Duration]
 }
 
 // AddServerCommand adds the server subcommand to the root command
@@ -58,6 +61,11 @@ func AddServerCommand(root *cobra.Command, pc *PgCtlCommand) {
 		pgCtlCmd:   pc,
 		grpcServer: servenv.NewGrpcServer(pc.reg),
 		senv:       servenv.NewServEnvWithConfig(pc.reg, pc.lg, pc.vc, pc.telemetry),
+		driftCheckInterval: viperutil.Configure(pc.reg, "config-drift-check-interval", viperutil.Options[time.Duration]{
+			Default:  5 * time.Minute,
+			FlagName: "config-drift-check-interval",
+			Dynamic:  false,
+		}),
 	}
 	serverCmd.senv.InitServiceMap("grpc", constants.ServicePgctld)
 	root.AddCommand(serverCmd.createCommand())
@@ -89,6 +97,8 @@ func (s *PgCtldServerCmd) createCommand() *cobra.Command {
 	// Don't register logger and viper config flags since they're already registered
 	// as persistent flags in the root command and we're sharing those instances
 	s.senv.RegisterFlagsWithoutLoggerAndConfig(cmd.Flags())
+	cmd.Flags().Duration("config-drift-check-interval", s.driftCheckInterval.Default(), "How often to check the managed PostgreSQL parameters for drift (0 disables the check)")
+	viperutil.BindFlags(cmd.Flags(), s.driftCheckInterval)
 
 	return cmd
 }
@@ -116,6 +126,8 @@ func (s *PgCtldServerCmd) runServer(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	pgctldService.SetManagedParametersFile(s.pgCtlCmd.managedParameters.Get())
+	driftCtx, stopDriftCheck := context.WithCancel(context.Background())
 
 	s.senv.OnRun(func() {
 		logger.Info("pgctld server starting up",
@@ -127,10 +139,13 @@ func (s *PgCtldServerCmd) runServer(cmd *cobra.Command, args []string) error {
 			pb.RegisterPgCtldServer(s.grpcServer.Server, pgctldService)
 		}
 		// TODO(sougou): Add http server
+
+		go pgctldService.watchConfigDrift(driftCtx, s.driftCheckInterval.Get())
 	})
 
 	s.senv.OnClose(func() {
 		logger.Info("pgctld server shutting down")
+		stopDriftCheck()
 		// TODO: add closing hooks
 	})
 
@@ -172,6 +187,9 @@ type PgCtldService struct {
 	timeout    int
 	poolerDir  string
 	config     *pgctld.PostgresCtlConfig
+	// managedParametersFile declares the parameters applied by ApplyConfig.
+	// It is read on every use, so that it can change while pgctld runs.
+	managedParametersFile string
 }
 
 // validatePortConsistency is no longer needed because port, listen_addresses, and unix_socket_directories
@@ -431,3 +449,205 @@ func (s *PgCtldService) PgRewind(ctx context.Context, req *pb.PgRewindRequest) (
 		Output:  result.Output,
 	}, nil
 }
+
+func (s *PgCtldService) InitReplica(ctx context.Context, req *pb.InitReplicaRequest) (*pb.InitReplicaResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC InitReplica request",
+		"source_host", req.GetSourceHost(),
+		"source_port", req.GetSourcePort(),
+		"application_name", req.GetApplicationName())
+
+	result, err := InitReplicaWithResult(ctx, s.logger, s.poolerDir, s.pgPort, s.pgUser,
+		req.GetSourceHost(), int(req.GetSourcePort()), req.GetApplicationName(), req.GetExtraArgs())
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize replica: %w", err)
+	}
+	if result.AlreadyInitialized {
+		return nil, fmt.Errorf("data directory already initialized: %s", pgctld.PostgresDataDir(s.poolerDir))
+	}
+
+	return &pb.InitReplicaResponse{
+		Message: result.Message,
+		Output:  result.Output,
+	}, nil
+}
+
+func (s *PgCtldService) UpgradeDataDir(ctx context.Context, req *pb.UpgradeDataDirRequest) (*pb.UpgradeDataDirResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC UpgradeDataDir request",
+		"new_bin_dir", req.GetNewBinDir(),
+		"check_only", req.GetCheckOnly(),
+		"source_host", req.GetSourceHost(),
+		"source_port", req.GetSourcePort())
+
+	var result *UpgradeResult
+	var err error
+	if req.GetSourceHost() != "" {
+		result, err = UpgradeReplicaDataDirWithResult(ctx, s.logger, s.poolerDir, s.pgPort, s.pgUser, req.GetNewBinDir(), req.GetCheckOnly(),
+			req.GetSourceHost(), int(req.GetSourcePort()), req.GetApplicationName())
+	} else {
+		result, err = UpgradeDataDirWithResult(ctx, s.logger, s.poolerDir, s.pgPort, s.pgUser, req.GetNewBinDir(), req.GetCheckOnly())
+	}
+	if err != nil {
+		// The output of pg_upgrade explains failed compatibility checks
+		if result != nil && result.Output != "" {
+			return nil, fmt.Errorf("failed to upgrade data directory: %w\n%s", err, strings.TrimSpace(result.Output))
+		}
+		return nil, fmt.Errorf("failed to upgrade data directory: %w", err)
+	}
+
+	return &pb.UpgradeDataDirResponse{
+		Message:    result.Message,
+		Output:     result.Output,
+		OldVersion: result.OldVersion,
+		NewVersion: result.NewVersion,
+	}, nil
+}
+
+func (s *PgCtldService) RollbackUpgrade(ctx context.Context, req *pb.RollbackUpgradeRequest) (*pb.RollbackUpgradeResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC RollbackUpgrade request")
+
+	result, err := RollbackUpgradeWithResult(ctx, s.logger, s.poolerDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back upgrade: %w", err)
+	}
+
+	return &pb.RollbackUpgradeResponse{
+		Message: result.Message,
+	}, nil
+}
+
+func (s *PgCtldService) FinalizeUpgrade(ctx context.Context, req *pb.FinalizeUpgradeRequest) (*pb.FinalizeUpgradeResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC FinalizeUpgrade request")
+
+	result, err := FinalizeUpgradeWithResult(ctx, s.logger, s.poolerDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize upgrade: %w", err)
+	}
+
+	return &pb.FinalizeUpgradeResponse{
+		Message: result.Message,
+	}, nil
+}
+
+// SetManagedParametersFile sets the YAML file declaring the parameters
+// managed by ApplyConfig.
+func (s *PgCtldService) SetManagedParametersFile(file string) {
+	s.managedParametersFile = file
+}
+
+func (s *PgCtldService) managedParameters() (*pgctld.ManagedParameters, error) {
+	if s.managedParametersFile == "" {
+		return nil, errors.New("no managed parameters configured, see --managed-parameters")
+	}
+	return pgctld.LoadManagedParameters(s.managedParametersFile)
+}
+
+func (s *PgCtldService) ApplyConfig(ctx context.Context, req *pb.ApplyConfigRequest) (*pb.ApplyConfigResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC ApplyConfig request",
+		"pooler_type", req.GetPoolerType(),
+		"restart", req.GetRestart())
+
+	params, err := s.managedParameters()
+	if err != nil {
+		return nil, err
+	}
+	result, err := ApplyManagedConfigWithResult(ctx, s.logger, s.config, params, req.GetPoolerType(), req.GetRestart())
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply managed parameters: %w", err)
+	}
+
+	return &pb.ApplyConfigResponse{
+		Message:        result.Message,
+		Changed:        result.Changed,
+		PendingRestart: result.PendingRestart,
+		Restarted:      result.Restarted,
+	}, nil
+}
+
+func (s *PgCtldService) CheckConfigDrift(ctx context.Context, req *pb.CheckConfigDriftRequest) (*pb.CheckConfigDriftResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC CheckConfigDrift request", "pooler_type", req.GetPoolerType())
+
+	params, err := s.managedParameters()
+	if err != nil {
+		return nil, err
+	}
+	result, err := CheckConfigDriftWithResult(ctx, s.logger, s.config, params, req.GetPoolerType())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check managed parameters: %w", err)
+	}
+
+	resp := &pb.CheckConfigDriftResponse{
+		PoolerType:     result.PoolerType,
+		PendingRestart: result.PendingRestart,
+	}
+	for _, d := range result.Drift {
+		resp.Drift = append(resp.Drift, &pb.ParameterDrift{
+			Name:     d.Name,
+			Expected: d.Expected,
+			Actual:   d.Actual,
+		})
+	}
+	return resp, nil
+}
+
+func (s *PgCtldService) CheckExtension(ctx context.Context, req *pb.CheckExtensionRequest) (*pb.CheckExtensionResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC CheckExtension request", "name", req.GetName(), "version", req.GetVersion())
+
+	result, err := CheckExtensionWithResult(ctx, s.logger, s.config, req.GetName(), req.GetVersion())
+	if err != nil {
+		return nil, fmt.Errorf("extension check failed: %w", err)
+	}
+
+	return &pb.CheckExtensionResponse{
+		DefaultVersion:   result.DefaultVersion,
+		InstalledVersion: result.OldVersion,
+	}, nil
+}
+
+func (s *PgCtldService) InstallExtension(ctx context.Context, req *pb.InstallExtensionRequest) (*pb.InstallExtensionResponse, error) {
+	s.logger.InfoContext(ctx, "gRPC InstallExtension request",
+		"name", req.GetName(),
+		"version", req.GetVersion(),
+		"schema", req.GetSchema(),
+		"cascade", req.GetCascade())
+
+	result, err := InstallExtensionWithResult(ctx, s.logger, s.config, req.GetName(), req.GetVersion(), req.GetSchema(), req.GetCascade())
+	if err != nil {
+		return nil, fmt.Errorf("failed to install extension: %w", err)
+	}
+
+	return &pb.InstallExtensionResponse{
+		Message:    result.Message,
+		OldVersion: result.OldVersion,
+		NewVersion: result.NewVersion,
+	}, nil
+}
+
+// watchConfigDrift periodically checks the managed parameters last applied
+// for drift until ctx is done. Drifted parameters are logged as warnings by
+// CheckConfigDriftWithResult. Nothing is checked until parameters were
+// applied.
+func (s *PgCtldService) watchConfigDrift(ctx context.Context, interval time.Duration) {
+	if interval <= 0 || s.managedParametersFile == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		state, err := pgctld.ReadManagedConfigState(s.poolerDir)
+		if err != nil || state == nil || !pgctld.IsDataDirInitialized(s.poolerDir) {
+			continue
+		}
+		params, err := s.managedParameters()
+		if err == nil {
+			_, err = CheckConfigDriftWithResult(ctx, s.logger, s.config, params, state.PoolerType)
+		}
+		if err != nil {
+			s.logger.WarnContext(ctx, "Failed to check managed parameters for drift", "error", err)
+		}
+	}
+}
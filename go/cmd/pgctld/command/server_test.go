@@ -31,6 +31,7 @@ import (
 
 	"github.com/multigres/multigres/go/cmd/pgctld/testutil"
 	pb "github.com/multigres/multigres/go/pb/pgctldservice"
+	"github.com/multigres/multigres/go/services/pgctld"
 	"github.com/multigres/multigres/go/tools/viperutil"
 )
 
@@ -458,6 +459,127 @@ func TestPgCtldServiceInitDataDir(t *testing.T) {
 	})
 }
 
+func TestPgCtldServiceInitReplica(t *testing.T) {
+	t.Run("successful provisioning", func(t *testing.T) {
+		baseDir, cleanup := testutil.TempDir(t, "pgctld_grpc_init_replica_test")
+		defer cleanup()
+
+		binDir := filepath.Join(baseDir, "bin")
+		require.NoError(t, os.MkdirAll(binDir, 0o755))
+		testutil.CreateMockPostgreSQLBinaries(t, binDir)
+		t.Setenv("PATH", binDir+":"+os.Getenv("PATH"))
+
+		poolerDir := baseDir
+		service, err := NewPgCtldService(testLogger(), 5433, "postgres", "postgres", 30, poolerDir, "localhost")
+		require.NoError(t, err)
+
+		resp, err := service.InitReplica(context.Background(), &pb.InitReplicaRequest{
+			SourceHost:      "primary.example",
+			SourcePort:      5432,
+			ApplicationName: "zone1-replica",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Contains(t, resp.Message, "provisioned successfully")
+
+		dataDir := filepath.Join(poolerDir, "pg_data")
+		assert.FileExists(t, filepath.Join(dataDir, "standby.signal"))
+		autoConf, err := os.ReadFile(filepath.Join(dataDir, "postgresql.auto.conf"))
+		require.NoError(t, err)
+		assert.Contains(t, string(autoConf), "host=primary.example port=5432")
+		assert.Contains(t, string(autoConf), "application_name=zone1-replica")
+
+		// The configuration is generated for this pooler, not copied from the primary
+		conf, err := os.ReadFile(filepath.Join(dataDir, "postgresql.conf"))
+		require.NoError(t, err)
+		assert.Contains(t, string(conf), "Generated by Multigres")
+	})
+
+	t.Run("already initialized", func(t *testing.T) {
+		baseDir, cleanup := testutil.TempDir(t, "pgctld_grpc_init_replica_test")
+		defer cleanup()
+
+		_ = testutil.CreateDataDir(t, baseDir, true)
+
+		service, err := NewPgCtldService(testLogger(), 5432, "postgres", "postgres", 30, baseDir, "localhost")
+		require.NoError(t, err)
+
+		_, err = service.InitReplica(context.Background(), &pb.InitReplicaRequest{SourceHost: "primary.example", SourcePort: 5432})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already initialized")
+	})
+
+	t.Run("missing source host", func(t *testing.T) {
+		baseDir, cleanup := testutil.TempDir(t, "pgctld_grpc_init_replica_test")
+		defer cleanup()
+
+		service, err := NewPgCtldService(testLogger(), 5432, "postgres", "postgres", 30, baseDir, "localhost")
+		require.NoError(t, err)
+
+		_, err = service.InitReplica(context.Background(), &pb.InitReplicaRequest{SourcePort: 5432})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "source host is required")
+	})
+}
+
+func TestPgCtldServiceUpgradeDataDir(t *testing.T) {
+	t.Run("failed check reports pg_upgrade output", func(t *testing.T) {
+		poolerDir, newBinDir := setupUpgrade(t, `echo "Checking for presence of required libraries    fatal"
+exit 1`)
+		service, err := NewPgCtldService(testLogger(), 5432, "postgres", "postgres", 30, poolerDir, "localhost")
+		require.NoError(t, err)
+
+		_, err = service.UpgradeDataDir(context.Background(), &pb.UpgradeDataDirRequest{NewBinDir: newBinDir, CheckOnly: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "required libraries")
+	})
+
+	t.Run("replica clones upgraded primary", func(t *testing.T) {
+		poolerDir, newBinDir := setupUpgrade(t, "")
+		testutil.MockBinary(t, newBinDir, "pg_basebackup", `
+mkdir -p "$2/base"
+echo "17" > "$2/PG_VERSION"
+touch "$2/standby.signal"
+echo "primary_conninfo = '$4'" > "$2/postgresql.auto.conf"`)
+		service, err := NewPgCtldService(testLogger(), 5432, "postgres", "postgres", 30, poolerDir, "localhost")
+		require.NoError(t, err)
+
+		resp, err := service.UpgradeDataDir(context.Background(), &pb.UpgradeDataDirRequest{
+			NewBinDir:  newBinDir,
+			SourceHost: "primary.example",
+			SourcePort: 5432,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "15", resp.OldVersion)
+		assert.Equal(t, "17", resp.NewVersion)
+
+		dataDir := pgctld.PostgresDataDir(poolerDir)
+		version, err := os.ReadFile(filepath.Join(dataDir, "PG_VERSION"))
+		require.NoError(t, err)
+		assert.Equal(t, "17\n", string(version))
+		assert.FileExists(t, filepath.Join(dataDir, "standby.signal"))
+
+		// The old data directory was not linked and stays a rollback point
+		_, err = service.RollbackUpgrade(context.Background(), &pb.RollbackUpgradeRequest{})
+		require.NoError(t, err)
+		version, err = os.ReadFile(filepath.Join(dataDir, "PG_VERSION"))
+		require.NoError(t, err)
+		assert.Equal(t, "15.0\n", string(version))
+	})
+
+	t.Run("finalize without upgrade", func(t *testing.T) {
+		baseDir, cleanup := testutil.TempDir(t, "pgctld_grpc_upgrade_test")
+		defer cleanup()
+
+		service, err := NewPgCtldService(testLogger(), 5432, "postgres", "postgres", 30, baseDir, "localhost")
+		require.NoError(t, err)
+
+		_, err = service.FinalizeUpgrade(context.Background(), &pb.FinalizeUpgradeRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no upgrade to finalize")
+	})
+}
+
 func TestGetPoolerDir(t *testing.T) {
 	// Set up poolerDir for testing using temporary directory
 	tempDir := t.TempDir()
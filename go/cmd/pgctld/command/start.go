@@ -176,6 +176,12 @@ func StartPostgreSQLWithResult(logger *slog.Logger, config *pgctld.PostgresCtlCo
 		logger.Info("Ensured Unix socket directory exists", "socket_dir", config.UnixSocketDirectories)
 	}
 
+	// Starting an upgraded cluster ends the rollback window of a link-mode
+	// upgrade, so it must be recorded before the server runs
+	if err := markUpgradeStarted(config.PoolerDir); err != nil {
+		return nil, fmt.Errorf("failed to record start of upgraded cluster: %w", err)
+	}
+
 	// Start PostgreSQL
 	logger.Info("Starting PostgreSQL server", "data_dir", config.PostgresDataDir)
 	if err := startPostgreSQLWithConfig(logger, config); err != nil {
@@ -245,7 +251,7 @@ func startPostgreSQLWithConfig(logger *slog.Logger, config *pgctld.PostgresCtlCo
 
 	logger.Info("Starting PostgreSQL with configuration", "port", config.Port, "dataDir", config.PostgresDataDir, "configFile", config.PostgresConfigFile)
 
-	cmd := exec.Command("pg_ctl", args...)
+	cmd := exec.Command(pgctld.PostgresBinary(config.PoolerDir, "pg_ctl"), args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
@@ -270,7 +276,7 @@ func startPostgreSQLWithConfig(logger *slog.Logger, config *pgctld.PostgresCtlCo
 		logger.Info("Spawning watchdog process for orphan detection")
 		watchdogCmd := exec.Command(
 			"run_command_if_parent_dies.sh",
-			"pg_ctl", "stop",
+			pgctld.PostgresBinary(config.PoolerDir, "pg_ctl"), "stop",
 			"-D", config.PostgresDataDir,
 			"-m", "fast",
 		)
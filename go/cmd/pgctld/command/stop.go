@@ -179,7 +179,7 @@ func stopWithPgCtlWithConfig(logger *slog.Logger, config *pgctld.PostgresCtlConf
 		"-t", strconv.Itoa(config.Timeout),
 	}
 
-	cmd := exec.Command("pg_ctl", args...)
+	cmd := exec.Command(pgctld.PostgresBinary(config.PoolerDir, "pg_ctl"), args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
@@ -0,0 +1,581 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/services/pgctld"
+	"github.com/multigres/multigres/go/tools/viperutil"
+
+	"github.com/spf13/cobra"
+)
+
+// UpgradeResult contains the result of a major version upgrade step
+type UpgradeResult struct {
+	Message    string
+	Output     string
+	OldVersion string
+	NewVersion string
+}
+
+// PgCtldUpgradeCmd holds the upgrade command configuration
+type PgCtldUpgradeCmd struct {
+	pgCtlCmd  *PgCtlCommand
+	newBinDir viperutil.Value[string]
+	check     viperutil.Value[bool]
+	rollback  viperutil.Value[bool]
+	finalize  viperutil.Value[bool]
+}
+
+// AddUpgradeCommand adds the upgrade subcommand to the root command
+func AddUpgradeCommand(root *cobra.Command, pc *PgCtlCommand) {
+	upgradeCmd := &PgCtldUpgradeCmd{
+		pgCtlCmd: pc,
+		newBinDir: viperutil.Configure(pc.reg, "new-bin-dir", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "new-bin-dir",
+			Dynamic:  false,
+		}),
+		check: viperutil.Configure(pc.reg, "check", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "check",
+			Dynamic:  false,
+		}),
+		rollback: viperutil.Configure(pc.reg, "rollback", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "rollback",
+			Dynamic:  false,
+		}),
+		finalize: viperutil.Configure(pc.reg, "finalize", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "finalize",
+			Dynamic:  false,
+		}),
+	}
+
+	root.AddCommand(upgradeCmd.createCommand())
+}
+
+func (u *PgCtldUpgradeCmd) createCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the data directory to a new PostgreSQL major version",
+		Long: `Upgrade the PostgreSQL data directory in place to a new major version with pg_upgrade.
+
+The upgrade runs pg_upgrade in link mode against the binaries of the new
+version, found in --new-bin-dir. PostgreSQL must be stopped. pg_upgrade first
+checks that the clusters are compatible, including that the libraries of the
+installed extensions are available to the new version; --check runs only
+these checks and can be used while PostgreSQL is running.
+
+The old data directory is kept as a rollback point. --rollback restores it as
+long as the upgraded cluster has not been started: link mode shares the data
+files, so the old cluster is unusable once the new one ran. --finalize
+removes the rollback point once the upgrade is verified.
+
+Standby data directories cannot be upgraded with pg_upgrade; replicas are
+cloned from their upgraded primary instead (see the UpgradeDataDir RPC).
+
+Run ANALYZE once the upgraded server is started: pg_upgrade does not carry
+over planner statistics.
+
+Examples:
+  # Check that the data directory can be upgraded to PostgreSQL 17
+  pgctld upgrade --pooler-dir /var/lib/pooler-dir --new-bin-dir /usr/lib/postgresql/17/bin --check
+
+  # Upgrade, then start the server on the new version
+  pgctld upgrade -d /var/lib/pooler-dir --new-bin-dir /usr/lib/postgresql/17/bin
+  pgctld start -d /var/lib/pooler-dir
+
+  # Go back to the old version before the upgraded server was started
+  pgctld upgrade -d /var/lib/pooler-dir --rollback`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := u.pgCtlCmd.validateInitialized(cmd, args); err != nil {
+				return err
+			}
+			modes := 0
+			for _, set := range []bool{u.check.Get(), u.rollback.Get(), u.finalize.Get()} {
+				if set {
+					modes++
+				}
+			}
+			if modes > 1 {
+				return errors.New("--check, --rollback and --finalize are mutually exclusive")
+			}
+			if !u.rollback.Get() && !u.finalize.Get() && u.newBinDir.Get() == "" {
+				return errors.New("--new-bin-dir is required")
+			}
+			return nil
+		},
+		RunE: u.runUpgrade,
+	}
+
+	cmd.Flags().String("new-bin-dir", u.newBinDir.Default(), "Directory of the binaries of the new PostgreSQL major version")
+	cmd.Flags().Bool("check", u.check.Default(), "Only check that the data directory can be upgraded")
+	cmd.Flags().Bool("rollback", u.rollback.Default(), "Restore the data directory from before the last upgrade")
+	cmd.Flags().Bool("finalize", u.finalize.Default(), "Remove the rollback point of the last upgrade")
+	viperutil.BindFlags(cmd.Flags(), u.newBinDir, u.check, u.rollback, u.finalize)
+
+	return cmd
+}
+
+func (u *PgCtldUpgradeCmd) runUpgrade(cmd *cobra.Command, args []string) error {
+	logger := u.pgCtlCmd.lg.GetLogger()
+	poolerDir := u.pgCtlCmd.GetPoolerDir()
+
+	var result *UpgradeResult
+	var err error
+	switch {
+	case u.rollback.Get():
+		result, err = RollbackUpgradeWithResult(cmd.Context(), logger, poolerDir)
+	case u.finalize.Get():
+		result, err = FinalizeUpgradeWithResult(cmd.Context(), logger, poolerDir)
+	default:
+		result, err = UpgradeDataDirWithResult(cmd.Context(), logger, poolerDir, u.pgCtlCmd.pgPort.Get(), u.pgCtlCmd.pgUser.Get(),
+			u.newBinDir.Get(), u.check.Get())
+	}
+	if result != nil && result.Output != "" {
+		fmt.Println(result.Output)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(result.Message)
+	return nil
+}
+
+// UpgradeDataDirWithResult upgrades the data directory in place to the major
+// version of the binaries in newBinDir with pg_upgrade --link. With
+// checkOnly, only the compatibility checks of pg_upgrade are run and the data
+// directory is left untouched.
+//
+// On success the old data directory is kept at PreUpgradeDataDir as the
+// rollback point and the pooler switches to the new binaries. The server is
+// not started. A data directory already on the new version is left as is.
+func UpgradeDataDirWithResult(ctx context.Context, logger *slog.Logger, poolerDir string, pgPort int, pgUser, newBinDir string, checkOnly bool) (*UpgradeResult, error) {
+	result := &UpgradeResult{}
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+	newDataDir := dataDir + ".upgrade"
+
+	oldBinDir, newBinDir, err := prepareUpgrade(poolerDir, newBinDir, result)
+	if errors.Is(err, errAlreadyUpgraded) {
+		result.Message = fmt.Sprintf("Data directory is already on PostgreSQL %s", result.NewVersion)
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !checkOnly {
+		if isPostgreSQLRunning(dataDir) {
+			return nil, errors.New("PostgreSQL must be stopped to upgrade the data directory")
+		}
+		state, err := clusterState(filepath.Join(oldBinDir, "pg_controldata"), dataDir)
+		if err != nil {
+			return nil, err
+		}
+		if state != "shut down" {
+			return nil, fmt.Errorf("data directory was not shut down cleanly as a primary (state %q): standby data directories are cloned from the upgraded primary instead", state)
+		}
+	}
+
+	// The new cluster is created next to the old one, with the same options
+	if err := os.RemoveAll(newDataDir); err != nil {
+		return nil, fmt.Errorf("failed to remove leftover upgrade data directory: %w", err)
+	}
+	if err := runInitdb(logger, poolerDir, newDataDir, filepath.Join(newBinDir, "initdb"), pgUser); err != nil {
+		return nil, err
+	}
+
+	socketDir := pgctld.PostgresSocketDir(poolerDir)
+	if err := os.MkdirAll(socketDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create Unix socket directory %s: %w", socketDir, err)
+	}
+
+	args := []string{
+		"--old-bindir", oldBinDir,
+		"--new-bindir", newBinDir,
+		"--old-datadir", dataDir,
+		"--new-datadir", newDataDir,
+		"--username", pgUser,
+		"--old-port", strconv.Itoa(pgPort),
+		"--new-port", strconv.Itoa(pgPort + 1),
+		"--socketdir", socketDir,
+	}
+	if checkOnly {
+		args = append(args, "--check")
+	} else {
+		args = append(args, "--link")
+	}
+
+	logger.InfoContext(ctx, "executing pg_upgrade command",
+		"command", "pg_upgrade",
+		"args", args,
+		"old_version", result.OldVersion,
+		"new_version", result.NewVersion)
+
+	password, err := resolvePassword(poolerDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve password: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, filepath.Join(newBinDir, "pg_upgrade"), args...)
+	// pg_upgrade writes its logs and scripts to the working directory
+	cmd.Dir = poolerDir
+	if password != "" {
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	}
+
+	output, err := cmd.CombinedOutput()
+	result.Output = string(output)
+	if err != nil || checkOnly {
+		if removeErr := os.RemoveAll(newDataDir); removeErr != nil {
+			logger.WarnContext(ctx, "failed to remove upgrade data directory", "data_dir", newDataDir, "error", removeErr)
+		}
+	}
+	if err != nil {
+		// A failure after linking started leaves the control file of the old
+		// cluster renamed; restoring it makes the old cluster usable again
+		if restoreErr := restoreControlFile(dataDir); restoreErr != nil {
+			logger.ErrorContext(ctx, "failed to restore control file of the old cluster", "error", restoreErr)
+		}
+		result.Message = "Upgrade failed"
+		logger.ErrorContext(ctx, "pg_upgrade command failed",
+			"error", err,
+			"output", string(output))
+		return result, fmt.Errorf("pg_upgrade failed: %w", err)
+	}
+	if checkOnly {
+		result.Message = fmt.Sprintf("Data directory can be upgraded from PostgreSQL %s to %s", result.OldVersion, result.NewVersion)
+		return result, nil
+	}
+
+	if err := switchToUpgradedDataDir(poolerDir, newDataDir, &pgctld.UpgradeState{
+		OldVersion: result.OldVersion,
+		NewVersion: result.NewVersion,
+		OldBinDir:  currentBinDir(poolerDir),
+		NewBinDir:  newBinDir,
+		Link:       true,
+	}); err != nil {
+		return result, err
+	}
+
+	// pg_upgrade does not carry over the configuration of the old cluster
+	if _, err := pgctld.GeneratePostgresServerConfig(poolerDir, pgPort, pgUser); err != nil {
+		return result, fmt.Errorf("failed to create postgres config: %w", err)
+	}
+	if err := copyFileIfExists(
+		filepath.Join(pgctld.PreUpgradeDataDir(poolerDir), "postgresql.auto.conf"),
+		filepath.Join(dataDir, "postgresql.auto.conf")); err != nil {
+		return result, err
+	}
+
+	result.Message = fmt.Sprintf("Data directory upgraded from PostgreSQL %s to %s", result.OldVersion, result.NewVersion)
+	logger.InfoContext(ctx, "pg_upgrade command completed successfully",
+		"old_version", result.OldVersion,
+		"new_version", result.NewVersion)
+	return result, nil
+}
+
+// UpgradeReplicaDataDirWithResult moves a standby to the major version of the
+// binaries in newBinDir by cloning its primary, which must already run that
+// version, since pg_upgrade cannot upgrade standbys. The old data directory
+// is kept as the rollback point like for in-place upgrades. With checkOnly,
+// only the new binaries are validated.
+func UpgradeReplicaDataDirWithResult(ctx context.Context, logger *slog.Logger, poolerDir string, pgPort int, pgUser, newBinDir string, checkOnly bool, sourceHost string, sourcePort int, applicationName string) (*UpgradeResult, error) {
+	result := &UpgradeResult{}
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+
+	_, newBinDir, err := prepareUpgrade(poolerDir, newBinDir, result)
+	if errors.Is(err, errAlreadyUpgraded) {
+		result.Message = fmt.Sprintf("Replica data directory is already on PostgreSQL %s", result.NewVersion)
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if checkOnly {
+		result.Message = fmt.Sprintf("Replica data directory can be cloned on PostgreSQL %s", result.NewVersion)
+		return result, nil
+	}
+	if isPostgreSQLRunning(dataDir) {
+		return nil, errors.New("PostgreSQL must be stopped to upgrade the data directory")
+	}
+
+	// Move the old data directory aside; the clone goes into an empty one
+	if err := switchToUpgradedDataDir(poolerDir, "", &pgctld.UpgradeState{
+		OldVersion: result.OldVersion,
+		NewVersion: result.NewVersion,
+		OldBinDir:  currentBinDir(poolerDir),
+		NewBinDir:  newBinDir,
+	}); err != nil {
+		return nil, err
+	}
+
+	cloneResult, err := InitReplicaWithResult(ctx, logger, poolerDir, pgPort, pgUser, sourceHost, sourcePort, applicationName, nil)
+	if cloneResult != nil {
+		result.Output = cloneResult.Output
+	}
+	if err != nil {
+		result.Message = "Upgrade failed"
+		return result, fmt.Errorf("failed to clone upgraded primary: %w", err)
+	}
+
+	result.Message = fmt.Sprintf("Replica data directory upgraded from PostgreSQL %s to %s", result.OldVersion, result.NewVersion)
+	return result, nil
+}
+
+// RollbackUpgradeWithResult restores the data directory and binaries from
+// before the last upgrade. It fails once the cluster of a link-mode upgrade
+// was started, as the old cluster shares its data files.
+func RollbackUpgradeWithResult(ctx context.Context, logger *slog.Logger, poolerDir string) (*UpgradeResult, error) {
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+	state, err := pgctld.ReadUpgradeState(poolerDir)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errors.New("no upgrade to roll back")
+	}
+	if !state.CanRollback() {
+		return nil, fmt.Errorf("upgrade to PostgreSQL %s can no longer be rolled back: the upgraded cluster was started and shares its data files with the old one; restore from a backup instead", state.NewVersion)
+	}
+	if isPostgreSQLRunning(dataDir) {
+		return nil, errors.New("PostgreSQL must be stopped to roll back an upgrade")
+	}
+
+	preUpgradeDir := pgctld.PreUpgradeDataDir(poolerDir)
+	if _, err := os.Stat(preUpgradeDir); err != nil {
+		return nil, fmt.Errorf("rollback point %s is missing: %w", preUpgradeDir, err)
+	}
+	if err := os.RemoveAll(dataDir); err != nil {
+		return nil, fmt.Errorf("failed to remove upgraded data directory: %w", err)
+	}
+	if err := os.Rename(preUpgradeDir, dataDir); err != nil {
+		return nil, fmt.Errorf("failed to restore data directory: %w", err)
+	}
+	if err := restoreControlFile(dataDir); err != nil {
+		return nil, err
+	}
+	if err := pgctld.SetPostgresBinDir(poolerDir, state.OldBinDir); err != nil {
+		return nil, err
+	}
+	if err := pgctld.RemoveUpgradeState(poolerDir); err != nil {
+		return nil, err
+	}
+
+	logger.InfoContext(ctx, "Upgrade rolled back", "version", state.OldVersion)
+	return &UpgradeResult{
+		Message:    fmt.Sprintf("Upgrade rolled back to PostgreSQL %s", state.OldVersion),
+		OldVersion: state.OldVersion,
+		NewVersion: state.NewVersion,
+	}, nil
+}
+
+// FinalizeUpgradeWithResult removes the rollback point of the last upgrade.
+func FinalizeUpgradeWithResult(ctx context.Context, logger *slog.Logger, poolerDir string) (*UpgradeResult, error) {
+	state, err := pgctld.ReadUpgradeState(poolerDir)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errors.New("no upgrade to finalize")
+	}
+
+	if err := os.RemoveAll(pgctld.PreUpgradeDataDir(poolerDir)); err != nil {
+		return nil, fmt.Errorf("failed to remove rollback point: %w", err)
+	}
+	// Generated by pg_upgrade for the same purpose
+	if err := os.Remove(filepath.Join(poolerDir, "delete_old_cluster.sh")); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove delete_old_cluster.sh: %w", err)
+	}
+	if err := pgctld.RemoveUpgradeState(poolerDir); err != nil {
+		return nil, err
+	}
+
+	logger.InfoContext(ctx, "Upgrade finalized", "version", state.NewVersion)
+	return &UpgradeResult{
+		Message:    fmt.Sprintf("Upgrade to PostgreSQL %s finalized", state.NewVersion),
+		OldVersion: state.OldVersion,
+		NewVersion: state.NewVersion,
+	}, nil
+}
+
+// markUpgradeStarted records that the cluster of a pending upgrade is about
+// to run, which ends the rollback window of link-mode upgrades.
+func markUpgradeStarted(poolerDir string) error {
+	state, err := pgctld.ReadUpgradeState(poolerDir)
+	if err != nil || state == nil || state.Started {
+		return err
+	}
+	state.Started = true
+	return pgctld.WriteUpgradeState(poolerDir, state)
+}
+
+// errAlreadyUpgraded is returned by prepareUpgrade when the data directory
+// already runs the major version of the new binaries, e.g. when an upgrade
+// is retried after it succeeded on this pooler.
+var errAlreadyUpgraded = errors.New("data directory is already upgraded")
+
+// prepareUpgrade validates an upgrade to the binaries in newBinDir and fills
+// in the versions involved. It returns the absolute old and new binary
+// directories.
+func prepareUpgrade(poolerDir, newBinDir string, result *UpgradeResult) (string, string, error) {
+	if newBinDir == "" {
+		return "", "", errors.New("new binary directory is required")
+	}
+	newBinDir, err := filepath.Abs(newBinDir)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid new binary directory: %w", err)
+	}
+	if !pgctld.IsDataDirInitialized(poolerDir) {
+		return "", "", fmt.Errorf("data directory is not initialized: %s", pgctld.PostgresDataDir(poolerDir))
+	}
+
+	versionFile, err := os.ReadFile(filepath.Join(pgctld.PostgresDataDir(poolerDir), "PG_VERSION"))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read data directory version: %w", err)
+	}
+	result.OldVersion = majorVersion(strings.TrimSpace(string(versionFile)))
+
+	result.NewVersion, err = binaryMajorVersion(filepath.Join(newBinDir, "postgres"))
+	if err != nil {
+		return "", "", err
+	}
+	if result.NewVersion == result.OldVersion {
+		return "", newBinDir, errAlreadyUpgraded
+	}
+
+	state, err := pgctld.ReadUpgradeState(poolerDir)
+	if err != nil {
+		return "", "", err
+	}
+	if state != nil {
+		return "", "", fmt.Errorf("upgrade to PostgreSQL %s is still pending: finalize or roll it back first", state.NewVersion)
+	}
+
+	oldMajor, _ := strconv.Atoi(result.OldVersion)
+	newMajor, _ := strconv.Atoi(result.NewVersion)
+	if newMajor < oldMajor {
+		return "", "", fmt.Errorf("binaries in %s are PostgreSQL %s, which is older than the data directory's PostgreSQL %s",
+			newBinDir, result.NewVersion, result.OldVersion)
+	}
+
+	oldBinDir := currentBinDir(poolerDir)
+	if oldBinDir == "" {
+		postgres, err := exec.LookPath("postgres")
+		if err != nil {
+			return "", "", fmt.Errorf("failed to find the current PostgreSQL binaries: %w", err)
+		}
+		oldBinDir = filepath.Dir(postgres)
+	}
+	return oldBinDir, newBinDir, nil
+}
+
+// switchToUpgradedDataDir records the upgrade, moves the old data directory
+// to the rollback point and, unless newDataDir is empty, moves the upgraded
+// data directory in its place. The pooler then runs on the new binaries.
+func switchToUpgradedDataDir(poolerDir, newDataDir string, state *pgctld.UpgradeState) error {
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+	if err := pgctld.WriteUpgradeState(poolerDir, state); err != nil {
+		return err
+	}
+	if err := os.Rename(dataDir, pgctld.PreUpgradeDataDir(poolerDir)); err != nil {
+		return fmt.Errorf("failed to move old data directory: %w", err)
+	}
+	if newDataDir != "" {
+		if err := os.Rename(newDataDir, dataDir); err != nil {
+			return fmt.Errorf("failed to move upgraded data directory: %w", err)
+		}
+	}
+	return pgctld.SetPostgresBinDir(poolerDir, state.NewBinDir)
+}
+
+// currentBinDir returns the binary directory the pooler runs on, "" for PATH.
+func currentBinDir(poolerDir string) string {
+	binDir, _ := pgctld.PostgresBinDir(poolerDir)
+	return binDir
+}
+
+var versionRegexp = regexp.MustCompile(`\(PostgreSQL\) (\d+(\.\d+)?)`)
+
+// binaryMajorVersion returns the major version of a postgres binary.
+func binaryMajorVersion(postgres string) (string, error) {
+	output, err := exec.Command(postgres, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get version of %s: %w", postgres, err)
+	}
+	m := versionRegexp.FindStringSubmatch(string(output))
+	if m == nil {
+		return "", fmt.Errorf("unexpected version output of %s: %q", postgres, strings.TrimSpace(string(output)))
+	}
+	return majorVersion(m[1]), nil
+}
+
+// majorVersion strips the minor version, e.g. "17.2" becomes "17".
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
+
+// clusterState returns the "Database cluster state" reported by pg_controldata.
+func clusterState(pgControldata, dataDir string) (string, error) {
+	output, err := exec.Command(pgControldata, dataDir).Output()
+	if err != nil {
+		return "", fmt.Errorf("pg_controldata failed: %w", err)
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		if value, ok := strings.CutPrefix(line, "Database cluster state:"); ok {
+			return strings.TrimSpace(value), nil
+		}
+	}
+	return "", errors.New("pg_controldata did not report the cluster state")
+}
+
+// restoreControlFile undoes the renaming of the old cluster's control file
+// that pg_upgrade --link does before linking the data files.
+func restoreControlFile(dataDir string) error {
+	controlFile := filepath.Join(dataDir, "global", "pg_control")
+	if _, err := os.Stat(controlFile + ".old"); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err := os.Rename(controlFile+".old", controlFile); err != nil {
+		return fmt.Errorf("failed to restore control file: %w", err)
+	}
+	return nil
+}
+
+func copyFileIfExists(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	if err := os.WriteFile(dst, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/cmd/pgctld/testutil"
+	"github.com/multigres/multigres/go/services/pgctld"
+)
+
+// setupUpgrade creates an initialized PostgreSQL 15 data directory running on
+// the binaries in PATH, and mock PostgreSQL 17 binaries in the returned
+// directory. pg_upgrade records its arguments in pg_upgrade.args in the
+// pooler directory and runs the given script.
+func setupUpgrade(t *testing.T, pgUpgrade string) (string, string) {
+	t.Helper()
+
+	baseDir, cleanup := testutil.TempDir(t, "pgctld_upgrade_test")
+	t.Cleanup(cleanup)
+
+	oldBinDir := filepath.Join(baseDir, "bin15")
+	require.NoError(t, os.MkdirAll(oldBinDir, 0o755))
+	testutil.CreateMockPostgreSQLBinaries(t, oldBinDir)
+	t.Setenv("PATH", oldBinDir+":"+os.Getenv("PATH"))
+
+	poolerDir := filepath.Join(baseDir, "pooler")
+	testutil.CreateDataDir(t, poolerDir, true)
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "global"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "global", "pg_control"), []byte("control"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "postgresql.auto.conf"), []byte("work_mem = '64MB'\n"), 0o600))
+
+	newBinDir := filepath.Join(baseDir, "bin17")
+	require.NoError(t, os.MkdirAll(newBinDir, 0o755))
+	testutil.MockBinary(t, newBinDir, "initdb", `
+mkdir -p "$2/global"
+echo "17" > "$2/PG_VERSION"
+touch "$2/postgresql.conf"
+`)
+	testutil.MockBinary(t, newBinDir, "postgres", `echo "postgres (PostgreSQL) 17.2"`)
+	testutil.MockBinary(t, newBinDir, "pg_upgrade", `echo "$@" > pg_upgrade.args
+`+pgUpgrade)
+
+	return poolerDir, newBinDir
+}
+
+func TestUpgradeDataDirWithResult(t *testing.T) {
+	poolerDir, newBinDir := setupUpgrade(t, `echo "Upgrade Complete"`)
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+
+	result, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.NoError(t, err)
+	assert.Equal(t, "15", result.OldVersion)
+	assert.Equal(t, "17", result.NewVersion)
+	assert.Contains(t, result.Output, "Upgrade Complete")
+
+	args, err := os.ReadFile(filepath.Join(poolerDir, "pg_upgrade.args"))
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "--link")
+	assert.Contains(t, string(args), "--new-bindir "+newBinDir)
+	assert.Contains(t, string(args), "--old-port 5432 --new-port 5433")
+
+	// The upgraded data directory replaces the old one, which is kept
+	version, err := os.ReadFile(filepath.Join(dataDir, "PG_VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "17\n", string(version))
+	assert.FileExists(t, filepath.Join(pgctld.PreUpgradeDataDir(poolerDir), "PG_VERSION"))
+	assert.NoDirExists(t, dataDir+".upgrade")
+
+	// Configuration carries over
+	assert.FileExists(t, filepath.Join(dataDir, "postgresql.conf"))
+	autoConf, err := os.ReadFile(filepath.Join(dataDir, "postgresql.auto.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "work_mem = '64MB'\n", string(autoConf))
+
+	binDir, err := pgctld.PostgresBinDir(poolerDir)
+	require.NoError(t, err)
+	assert.Equal(t, newBinDir, binDir)
+
+	state, err := pgctld.ReadUpgradeState(poolerDir)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.True(t, state.Link)
+	assert.False(t, state.Started)
+	assert.Empty(t, state.OldBinDir)
+
+	// Retrying the upgrade leaves the upgraded data directory as is
+	require.NoError(t, os.Remove(filepath.Join(poolerDir, "pg_upgrade.args")))
+	result, err = UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.NoError(t, err)
+	assert.Contains(t, result.Message, "already")
+	assert.NoFileExists(t, filepath.Join(poolerDir, "pg_upgrade.args"))
+
+	// Another major version waits for this upgrade to be finalized
+	testutil.MockBinary(t, newBinDir, "postgres", `echo "postgres (PostgreSQL) 18.0"`)
+	_, err = UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "still pending")
+}
+
+func TestUpgradeDataDirWithResult_CheckOnly(t *testing.T) {
+	poolerDir, newBinDir := setupUpgrade(t, `echo "Clusters are compatible"`)
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+
+	result, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, true)
+	require.NoError(t, err)
+	assert.Contains(t, result.Message, "can be upgraded")
+
+	args, err := os.ReadFile(filepath.Join(poolerDir, "pg_upgrade.args"))
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "--check")
+	assert.NotContains(t, string(args), "--link")
+
+	version, err := os.ReadFile(filepath.Join(dataDir, "PG_VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "15.0\n", string(version))
+	assert.NoDirExists(t, dataDir+".upgrade")
+	assert.NoDirExists(t, pgctld.PreUpgradeDataDir(poolerDir))
+	state, err := pgctld.ReadUpgradeState(poolerDir)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestUpgradeDataDirWithResult_Failure(t *testing.T) {
+	// pg_upgrade renames the old control file before linking
+	poolerDir, newBinDir := setupUpgrade(t, `
+mv pg_data/global/pg_control pg_data/global/pg_control.old
+echo "could not link file"
+exit 1`)
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+
+	result, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.Error(t, err)
+	assert.Contains(t, result.Output, "could not link file")
+
+	// The old cluster is usable again
+	assert.FileExists(t, filepath.Join(dataDir, "global", "pg_control"))
+	assert.NoFileExists(t, filepath.Join(dataDir, "global", "pg_control.old"))
+	assert.NoDirExists(t, dataDir+".upgrade")
+	binDir, err := pgctld.PostgresBinDir(poolerDir)
+	require.NoError(t, err)
+	assert.Empty(t, binDir)
+}
+
+func TestUpgradeDataDirWithResult_Validation(t *testing.T) {
+	t.Run("rejects older major version", func(t *testing.T) {
+		poolerDir, newBinDir := setupUpgrade(t, "")
+		testutil.MockBinary(t, newBinDir, "postgres", `echo "postgres (PostgreSQL) 14.13"`)
+
+		_, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "older")
+	})
+
+	t.Run("requires stopped server", func(t *testing.T) {
+		poolerDir, newBinDir := setupUpgrade(t, "")
+		testutil.CreatePIDFile(t, pgctld.PostgresDataDir(poolerDir), os.Getpid())
+
+		_, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be stopped")
+	})
+
+	t.Run("rejects standby data directory", func(t *testing.T) {
+		poolerDir, newBinDir := setupUpgrade(t, "")
+		oldBinDir := filepath.Join(filepath.Dir(poolerDir), "bin15")
+		testutil.MockBinary(t, oldBinDir, "pg_controldata", `echo "Database cluster state:               shut down in recovery"`)
+
+		_, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "standby")
+	})
+}
+
+func TestRollbackUpgradeWithResult(t *testing.T) {
+	poolerDir, newBinDir := setupUpgrade(t, "")
+	dataDir := pgctld.PostgresDataDir(poolerDir)
+
+	_, err := RollbackUpgradeWithResult(context.Background(), slog.Default(), poolerDir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no upgrade")
+
+	_, err = UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.NoError(t, err)
+
+	result, err := RollbackUpgradeWithResult(context.Background(), slog.Default(), poolerDir)
+	require.NoError(t, err)
+	assert.Contains(t, result.Message, "15")
+
+	version, err := os.ReadFile(filepath.Join(dataDir, "PG_VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "15.0\n", string(version))
+	assert.NoDirExists(t, pgctld.PreUpgradeDataDir(poolerDir))
+	assert.NoFileExists(t, pgctld.PostgresBinDirFile(poolerDir))
+	assert.NoFileExists(t, pgctld.UpgradeStateFile(poolerDir))
+}
+
+func TestRollbackUpgradeWithResult_AfterStart(t *testing.T) {
+	poolerDir, newBinDir := setupUpgrade(t, "")
+
+	_, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.NoError(t, err)
+	require.NoError(t, markUpgradeStarted(poolerDir))
+
+	// The old cluster shares its data files with the started one
+	_, err = RollbackUpgradeWithResult(context.Background(), slog.Default(), poolerDir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can no longer be rolled back")
+	assert.DirExists(t, pgctld.PreUpgradeDataDir(poolerDir))
+}
+
+func TestFinalizeUpgradeWithResult(t *testing.T) {
+	poolerDir, newBinDir := setupUpgrade(t, "touch delete_old_cluster.sh")
+
+	_, err := UpgradeDataDirWithResult(context.Background(), slog.Default(), poolerDir, 5432, "postgres", newBinDir, false)
+	require.NoError(t, err)
+
+	result, err := FinalizeUpgradeWithResult(context.Background(), slog.Default(), poolerDir)
+	require.NoError(t, err)
+	assert.Contains(t, result.Message, "17")
+
+	assert.NoDirExists(t, pgctld.PreUpgradeDataDir(poolerDir))
+	assert.NoFileExists(t, filepath.Join(poolerDir, "delete_old_cluster.sh"))
+	assert.NoFileExists(t, pgctld.UpgradeStateFile(poolerDir))
+
+	// The pooler keeps running on the new binaries
+	assert.Equal(t, filepath.Join(newBinDir, "pg_ctl"), pgctld.PostgresBinary(poolerDir, "pg_ctl"))
+}
@@ -32,35 +32,57 @@ import (
 // MockPgCtldService implements a mock version of the PgCtld gRPC service for testing
 type MockPgCtldService struct {
 	pb.UnimplementedPgCtldServer
-	mu            sync.Mutex
-	StartCalls    []*pb.StartRequest
-	StopCalls     []*pb.StopRequest
-	RestartCalls  []*pb.RestartRequest
-	ReloadCalls   []*pb.ReloadConfigRequest
-	StatusCalls   []*pb.StatusRequest
-	VersionCalls  []*pb.VersionRequest
-	InitDirCalls  []*pb.InitDataDirRequest
-	PgRewindCalls []*pb.PgRewindRequest
+	mu                   sync.Mutex
+	StartCalls           []*pb.StartRequest
+	StopCalls            []*pb.StopRequest
+	RestartCalls         []*pb.RestartRequest
+	ReloadCalls          []*pb.ReloadConfigRequest
+	StatusCalls          []*pb.StatusRequest
+	VersionCalls         []*pb.VersionRequest
+	InitDirCalls         []*pb.InitDataDirRequest
+	PgRewindCalls        []*pb.PgRewindRequest
+	InitReplicaCalls     []*pb.InitReplicaRequest
+	UpgradeCalls         []*pb.UpgradeDataDirRequest
+	RollbackUpgradeCalls []*pb.RollbackUpgradeRequest
+	FinalizeUpgradeCalls []*pb.FinalizeUpgradeRequest
+	ApplyConfigCalls     []*pb.ApplyConfigRequest
+	CheckDriftCalls      []*pb.CheckConfigDriftRequest
+	CheckExtensionCalls  []*pb.CheckExtensionRequest
+	InstallExtCalls      []*pb.InstallExtensionRequest
 
 	// Response configurations
-	StartResponse    *pb.StartResponse
-	StopResponse     *pb.StopResponse
-	RestartResponse  *pb.RestartResponse
-	ReloadResponse   *pb.ReloadConfigResponse
-	StatusResponse   *pb.StatusResponse
-	VersionResponse  *pb.VersionResponse
-	InitDirResponse  *pb.InitDataDirResponse
-	PgRewindResponse *pb.PgRewindResponse
+	StartResponse       *pb.StartResponse
+	StopResponse        *pb.StopResponse
+	RestartResponse     *pb.RestartResponse
+	ReloadResponse      *pb.ReloadConfigResponse
+	StatusResponse      *pb.StatusResponse
+	VersionResponse     *pb.VersionResponse
+	InitDirResponse     *pb.InitDataDirResponse
+	PgRewindResponse    *pb.PgRewindResponse
+	InitReplicaResponse *pb.InitReplicaResponse
+	UpgradeResponse     *pb.UpgradeDataDirResponse
+	ApplyConfigResponse *pb.ApplyConfigResponse
+	CheckDriftResponse  *pb.CheckConfigDriftResponse
+	CheckExtResponse    *pb.CheckExtensionResponse
+	InstallExtResponse  *pb.InstallExtensionResponse
 
 	// Error configurations
-	StartError    error
-	StopError     error
-	RestartError  error
-	ReloadError   error
-	StatusError   error
-	VersionError  error
-	InitDirError  error
-	PgRewindError error
+	StartError           error
+	StopError            error
+	RestartError         error
+	ReloadError          error
+	StatusError          error
+	VersionError         error
+	InitDirError         error
+	PgRewindError        error
+	InitReplicaError     error
+	UpgradeError         error
+	RollbackUpgradeError error
+	FinalizeUpgradeError error
+	ApplyConfigError     error
+	CheckDriftError      error
+	CheckExtensionError  error
+	InstallExtError      error
 }
 
 func (m *MockPgCtldService) Start(ctx context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
@@ -186,6 +208,104 @@ func (m *MockPgCtldService) PgRewind(ctx context.Context, req *pb.PgRewindReques
 	}, nil
 }
 
+func (m *MockPgCtldService) InitReplica(ctx context.Context, req *pb.InitReplicaRequest) (*pb.InitReplicaResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.InitReplicaCalls = append(m.InitReplicaCalls, req)
+	if m.InitReplicaError != nil {
+		return nil, m.InitReplicaError
+	}
+	if m.InitReplicaResponse != nil {
+		return m.InitReplicaResponse, nil
+	}
+	return &pb.InitReplicaResponse{Message: "Mock replica initialized"}, nil
+}
+
+func (m *MockPgCtldService) UpgradeDataDir(ctx context.Context, req *pb.UpgradeDataDirRequest) (*pb.UpgradeDataDirResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.UpgradeCalls = append(m.UpgradeCalls, req)
+	if m.UpgradeError != nil {
+		return nil, m.UpgradeError
+	}
+	if m.UpgradeResponse != nil {
+		return m.UpgradeResponse, nil
+	}
+	return &pb.UpgradeDataDirResponse{Message: "Mock data directory upgraded", OldVersion: "16", NewVersion: "17"}, nil
+}
+
+func (m *MockPgCtldService) RollbackUpgrade(ctx context.Context, req *pb.RollbackUpgradeRequest) (*pb.RollbackUpgradeResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RollbackUpgradeCalls = append(m.RollbackUpgradeCalls, req)
+	if m.RollbackUpgradeError != nil {
+		return nil, m.RollbackUpgradeError
+	}
+	return &pb.RollbackUpgradeResponse{Message: "Mock upgrade rolled back"}, nil
+}
+
+func (m *MockPgCtldService) FinalizeUpgrade(ctx context.Context, req *pb.FinalizeUpgradeRequest) (*pb.FinalizeUpgradeResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.FinalizeUpgradeCalls = append(m.FinalizeUpgradeCalls, req)
+	if m.FinalizeUpgradeError != nil {
+		return nil, m.FinalizeUpgradeError
+	}
+	return &pb.FinalizeUpgradeResponse{Message: "Mock upgrade finalized"}, nil
+}
+
+func (m *MockPgCtldService) ApplyConfig(ctx context.Context, req *pb.ApplyConfigRequest) (*pb.ApplyConfigResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ApplyConfigCalls = append(m.ApplyConfigCalls, req)
+	if m.ApplyConfigError != nil {
+		return nil, m.ApplyConfigError
+	}
+	if m.ApplyConfigResponse != nil {
+		return m.ApplyConfigResponse, nil
+	}
+	return &pb.ApplyConfigResponse{Message: "Mock managed parameters applied"}, nil
+}
+
+func (m *MockPgCtldService) CheckConfigDrift(ctx context.Context, req *pb.CheckConfigDriftRequest) (*pb.CheckConfigDriftResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.CheckDriftCalls = append(m.CheckDriftCalls, req)
+	if m.CheckDriftError != nil {
+		return nil, m.CheckDriftError
+	}
+	if m.CheckDriftResponse != nil {
+		return m.CheckDriftResponse, nil
+	}
+	return &pb.CheckConfigDriftResponse{PoolerType: req.PoolerType}, nil
+}
+
+func (m *MockPgCtldService) CheckExtension(ctx context.Context, req *pb.CheckExtensionRequest) (*pb.CheckExtensionResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.CheckExtensionCalls = append(m.CheckExtensionCalls, req)
+	if m.CheckExtensionError != nil {
+		return nil, m.CheckExtensionError
+	}
+	if m.CheckExtResponse != nil {
+		return m.CheckExtResponse, nil
+	}
+	return &pb.CheckExtensionResponse{DefaultVersion: "1.0"}, nil
+}
+
+func (m *MockPgCtldService) InstallExtension(ctx context.Context, req *pb.InstallExtensionRequest) (*pb.InstallExtensionResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.InstallExtCalls = append(m.InstallExtCalls, req)
+	if m.InstallExtError != nil {
+		return nil, m.InstallExtError
+	}
+	if m.InstallExtResponse != nil {
+		return m.InstallExtResponse, nil
+	}
+	return &pb.InstallExtensionResponse{Message: "Mock extension installed", NewVersion: "1.0"}, nil
+}
+
 // TestGRPCServer provides utilities for testing gRPC services
 type TestGRPCServer struct {
 	server   *grpc.Server
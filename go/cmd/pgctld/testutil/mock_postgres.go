@@ -105,6 +105,15 @@ mkdir -p "$2/base"
 echo "15.0" > "$2/PG_VERSION"
 touch "$2/postgresql.conf"
 touch "$2/pg_hba.conf"
+`)
+
+	// Mock pg_basebackup (invoked as: pg_basebackup --pgdata <dir> ...)
+	MockBinary(t, binDir, "pg_basebackup", `
+mkdir -p "$2/base"
+echo "15.0" > "$2/PG_VERSION"
+touch "$2/standby.signal"
+echo "primary_conninfo = '$4'" > "$2/postgresql.auto.conf"
+echo "pg_basebackup: base backup completed"
 `)
 
 	// Mock pg_controldata
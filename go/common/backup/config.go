@@ -16,6 +16,9 @@ package backup
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/multigres/multigres/go/common/safepath"
@@ -48,6 +51,10 @@ func (c *Config) Type() string {
 		return "filesystem"
 	case *clustermetadatapb.BackupLocation_S3:
 		return "s3"
+	case *clustermetadatapb.BackupLocation_Gcs:
+		return "gcs"
+	case *clustermetadatapb.BackupLocation_Azure:
+		return "azure"
 	default:
 		return "unknown"
 	}
@@ -70,6 +77,10 @@ func (c *Config) FullPath(database, tableGroup, shard string) (string, error) {
 		return filesystemFullPath(loc.Filesystem.Path, database, tableGroup, shard)
 	case *clustermetadatapb.BackupLocation_S3:
 		return s3FullPath(loc.S3, database, tableGroup, shard)
+	case *clustermetadatapb.BackupLocation_Gcs:
+		return objectStoreFullPath("gs://"+loc.Gcs.Bucket+"/", loc.Gcs.KeyPrefix, database, tableGroup, shard), nil
+	case *clustermetadatapb.BackupLocation_Azure:
+		return objectStoreFullPath("azure://"+loc.Azure.Account+"/"+loc.Azure.Container+"/", loc.Azure.KeyPrefix, database, tableGroup, shard), nil
 	default:
 		return "", errors.New("unknown backup location type")
 	}
@@ -82,36 +93,49 @@ func filesystemFullPath(basePath, database, tableGroup, shard string) (string, e
 
 // s3FullPath builds an S3 backup path
 func s3FullPath(s3 *clustermetadatapb.S3Backup, database, tableGroup, shard string) (string, error) {
-	// Start with bucket
-	path := "s3://" + s3.Bucket + "/"
+	return objectStoreFullPath("s3://"+s3.Bucket+"/", s3.KeyPrefix, database, tableGroup, shard), nil
+}
+
+// objectStoreFullPath builds an object storage backup path below root
+func objectStoreFullPath(root, keyPrefix, database, tableGroup, shard string) string {
+	path := root
 
 	// Add prefix if set
-	if s3.KeyPrefix != "" {
-		path += strings.TrimSuffix(s3.KeyPrefix, "/") + "/"
+	if keyPrefix != "" {
+		path += strings.TrimSuffix(keyPrefix, "/") + "/"
 	}
 
 	// Add database/tablegroup/shard
-	path += database + "/" + tableGroup + "/" + shard
-
-	return path, nil
+	return path + database + "/" + tableGroup + "/" + shard
 }
 
-// UsesEnvCredentials returns true if S3 backup uses environment credentials
+// UsesEnvCredentials returns true if S3 or Azure backup uses environment credentials
 func (c *Config) UsesEnvCredentials() bool {
-	if s3, ok := c.proto.Location.(*clustermetadatapb.BackupLocation_S3); ok {
-		return s3.S3.UseEnvCredentials
+	switch loc := c.proto.Location.(type) {
+	case *clustermetadatapb.BackupLocation_S3:
+		return loc.S3.UseEnvCredentials
+	case *clustermetadatapb.BackupLocation_Azure:
+		return loc.Azure.UseEnvCredentials
 	}
 	return false
 }
 
 // PgBackRestCredentials returns credentials for pgBackRest from environment variables.
-// Returns nil for non-S3 backups or when UseEnvCredentials is false.
+// Returns nil for filesystem and GCS backups or when UseEnvCredentials is false.
 // Returns an error if UseEnvCredentials is true but required env vars are missing.
 func (c *Config) PgBackRestCredentials() (map[string]string, error) {
 	if !c.UsesEnvCredentials() {
 		return nil, nil
 	}
 
+	if _, ok := c.proto.Location.(*clustermetadatapb.BackupLocation_Azure); ok {
+		key := os.Getenv(AzureStorageKeyEnv)
+		if key == "" {
+			return nil, fmt.Errorf("%s environment variable is not set", AzureStorageKeyEnv)
+		}
+		return map[string]string{"repo1-azure-key": key}, nil
+	}
+
 	awsCreds, err := s3tools.ReadCredentialsFromEnv()
 	if err != nil {
 		return nil, err
@@ -142,28 +166,25 @@ func (c *Config) GetS3Config() *clustermetadatapb.S3Backup {
 func (c *Config) PgBackRestConfig(stanzaName string) (map[string]string, error) {
 	switch loc := c.proto.Location.(type) {
 	case *clustermetadatapb.BackupLocation_Filesystem:
-		return map[string]string{
+		config := map[string]string{
 			"repo1-type": "posix",
 			"repo1-path": loc.Filesystem.Path,
-		}, nil
+			// Block incremental keeps differential and incremental backups
+			// small by storing only changed blocks; it requires bundling
+			"repo1-block":  "y",
+			"repo1-bundle": "y",
+		}
+		// Filesystem repositories keep every backup unless a policy is configured
+		if c.proto.Retention != nil {
+			addRetentionConfig(config, c.proto.Retention)
+		}
+		return config, nil
 
 	case *clustermetadatapb.BackupLocation_S3:
 		config := map[string]string{
 			"repo1-type":      "s3",
 			"repo1-s3-bucket": loc.S3.Bucket,
 			"repo1-s3-region": loc.S3.Region,
-
-			// S3-specific performance and efficiency settings
-			"repo1-block":                     "y",
-			"repo1-bundle":                    "y",
-			"repo1-storage-upload-chunk-size": S3UploadChunkSize,
-			"repo1-symlink":                   "n",
-
-			// S3-specific retention policies
-			"repo1-retention-diff":      RetentionDifferential,
-			"repo1-retention-full":      RetentionFull,
-			"repo1-retention-full-type": "time",
-			"repo1-retention-history":   RetentionHistory,
 		}
 
 		// Set credential type based on configuration
@@ -189,13 +210,55 @@ func (c *Config) PgBackRestConfig(stanzaName string) (map[string]string, error)
 			config["repo1-s3-uri-style"] = "path"
 		}
 
-		// Repo path includes prefix if set
-		path := "/" + stanzaName
-		if loc.S3.KeyPrefix != "" {
-			path = "/" + strings.TrimSuffix(loc.S3.KeyPrefix, "/") + path
+		c.addObjectStoreConfig(config, loc.S3.KeyPrefix, stanzaName)
+		return config, nil
+
+	case *clustermetadatapb.BackupLocation_Gcs:
+		config := map[string]string{
+			"repo1-type":       "gcs",
+			"repo1-gcs-bucket": loc.Gcs.Bucket,
+		}
+
+		if loc.Gcs.KeyFile != "" {
+			config["repo1-gcs-key-type"] = "service"
+			config["repo1-gcs-key"] = loc.Gcs.KeyFile
+		} else {
+			// Use automatic detection (e.g. workload identity)
+			config["repo1-gcs-key-type"] = "auto"
+		}
+
+		if loc.Gcs.Endpoint != "" {
+			// Custom endpoint (fake-gcs-server, etc.)
+			config["repo1-gcs-endpoint"] = loc.Gcs.Endpoint
+			config["repo1-storage-verify-tls"] = "n"
 		}
-		config["repo1-path"] = path
 
+		c.addObjectStoreConfig(config, loc.Gcs.KeyPrefix, stanzaName)
+		return config, nil
+
+	case *clustermetadatapb.BackupLocation_Azure:
+		config := map[string]string{
+			"repo1-type":            "azure",
+			"repo1-azure-account":   loc.Azure.Account,
+			"repo1-azure-container": loc.Azure.Container,
+		}
+
+		if loc.Azure.UseEnvCredentials {
+			// The shared key is added from the environment, see PgBackRestCredentials
+			config["repo1-azure-key-type"] = "shared"
+		} else {
+			// Use automatic detection (e.g. managed identity)
+			config["repo1-azure-key-type"] = "auto"
+		}
+
+		if loc.Azure.Endpoint != "" {
+			// Custom endpoint (Azurite, etc.)
+			config["repo1-azure-endpoint"] = loc.Azure.Endpoint
+			config["repo1-azure-uri-style"] = "path"
+			config["repo1-storage-verify-tls"] = "n"
+		}
+
+		c.addObjectStoreConfig(config, loc.Azure.KeyPrefix, stanzaName)
 		return config, nil
 
 	default:
@@ -203,6 +266,44 @@ func (c *Config) PgBackRestConfig(stanzaName string) (map[string]string, error)
 	}
 }
 
+// addObjectStoreConfig adds the settings shared by all object storage
+// repositories: bundling, retention, and the repo path below the key prefix.
+func (c *Config) addObjectStoreConfig(config map[string]string, keyPrefix, stanzaName string) {
+	// Performance and efficiency settings
+	config["repo1-block"] = "y"
+	config["repo1-bundle"] = "y"
+	config["repo1-storage-upload-chunk-size"] = S3UploadChunkSize
+	config["repo1-symlink"] = "n"
+
+	// Retention policies
+	config["repo1-retention-diff"] = RetentionDifferential
+	config["repo1-retention-full"] = RetentionFull
+	config["repo1-retention-full-type"] = "time"
+	config["repo1-retention-history"] = RetentionHistory
+	if c.proto.Retention != nil {
+		addRetentionConfig(config, c.proto.Retention)
+	}
+
+	// Repo path includes prefix if set
+	path := "/" + stanzaName
+	if keyPrefix != "" {
+		path = "/" + strings.TrimSuffix(keyPrefix, "/") + path
+	}
+	config["repo1-path"] = path
+}
+
+// addRetentionConfig applies the configured retention policy. Unset fields
+// leave the existing settings alone.
+func addRetentionConfig(config map[string]string, retention *clustermetadatapb.BackupRetention) {
+	if retention.FullDays > 0 {
+		config["repo1-retention-full"] = strconv.FormatUint(uint64(retention.FullDays), 10)
+		config["repo1-retention-full-type"] = "time"
+	}
+	if retention.DifferentialCount > 0 {
+		config["repo1-retention-diff"] = strconv.FormatUint(uint64(retention.DifferentialCount), 10)
+	}
+}
+
 // validate checks that the backup location is properly configured
 func validate(loc *clustermetadatapb.BackupLocation) error {
 	if loc.Location == nil {
@@ -227,6 +328,23 @@ func validate(loc *clustermetadatapb.BackupLocation) error {
 		if v.S3.Region == "" {
 			return errors.New("s3 region is required")
 		}
+	case *clustermetadatapb.BackupLocation_Gcs:
+		if v.Gcs == nil {
+			return errors.New("gcs backup config is nil")
+		}
+		if v.Gcs.Bucket == "" {
+			return errors.New("gcs bucket is required")
+		}
+	case *clustermetadatapb.BackupLocation_Azure:
+		if v.Azure == nil {
+			return errors.New("azure backup config is nil")
+		}
+		if v.Azure.Account == "" {
+			return errors.New("azure account is required")
+		}
+		if v.Azure.Container == "" {
+			return errors.New("azure container is required")
+		}
 	default:
 		return errors.New("unknown backup location type")
 	}
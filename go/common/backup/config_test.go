@@ -68,6 +68,102 @@ func TestConfig_PgBackRestConfig_Filesystem(t *testing.T) {
 
 	assert.Equal(t, "posix", pgbrCfg["repo1-type"])
 	assert.Equal(t, "/var/backups", pgbrCfg["repo1-path"])
+	assert.Equal(t, "y", pgbrCfg["repo1-block"])
+	assert.Equal(t, "y", pgbrCfg["repo1-bundle"])
+}
+
+func TestConfig_PgBackRestConfig_GCS(t *testing.T) {
+	loc := utils.GCSBackupLocation("my-backups", "prod/", "/etc/gcs/key.json")
+
+	cfg, err := backup.NewConfig(loc)
+	require.NoError(t, err)
+	assert.Equal(t, "gcs", cfg.Type())
+
+	path, err := cfg.FullPath("mydb", "default", "0")
+	require.NoError(t, err)
+	assert.Equal(t, "gs://my-backups/prod/mydb/default/0", path)
+
+	pgbrCfg, err := cfg.PgBackRestConfig("multigres")
+	require.NoError(t, err)
+
+	assert.Equal(t, "gcs", pgbrCfg["repo1-type"])
+	assert.Equal(t, "my-backups", pgbrCfg["repo1-gcs-bucket"])
+	assert.Equal(t, "service", pgbrCfg["repo1-gcs-key-type"])
+	assert.Equal(t, "/etc/gcs/key.json", pgbrCfg["repo1-gcs-key"])
+	assert.Equal(t, "/prod/multigres", pgbrCfg["repo1-path"])
+	assert.Equal(t, "28", pgbrCfg["repo1-retention-full"])
+	assert.NotContains(t, pgbrCfg, "repo1-gcs-endpoint")
+}
+
+func TestConfig_PgBackRestConfig_Azure(t *testing.T) {
+	loc := utils.AzureBackupLocation("myaccount", "backups", false)
+
+	cfg, err := backup.NewConfig(loc)
+	require.NoError(t, err)
+	assert.Equal(t, "azure", cfg.Type())
+	assert.False(t, cfg.UsesEnvCredentials())
+
+	path, err := cfg.FullPath("mydb", "default", "0")
+	require.NoError(t, err)
+	assert.Equal(t, "azure://myaccount/backups/mydb/default/0", path)
+
+	pgbrCfg, err := cfg.PgBackRestConfig("multigres")
+	require.NoError(t, err)
+
+	assert.Equal(t, "azure", pgbrCfg["repo1-type"])
+	assert.Equal(t, "myaccount", pgbrCfg["repo1-azure-account"])
+	assert.Equal(t, "backups", pgbrCfg["repo1-azure-container"])
+	assert.Equal(t, "auto", pgbrCfg["repo1-azure-key-type"])
+	assert.Equal(t, "/multigres", pgbrCfg["repo1-path"])
+}
+
+func TestConfig_PgBackRestConfig_Retention(t *testing.T) {
+	t.Run("overrides object store defaults", func(t *testing.T) {
+		loc := utils.WithBackupRetention(utils.S3BackupLocation("my-backups", "us-east-1"), 7, 3)
+
+		cfg, err := backup.NewConfig(loc)
+		require.NoError(t, err)
+
+		pgbrCfg, err := cfg.PgBackRestConfig("multigres")
+		require.NoError(t, err)
+		assert.Equal(t, "7", pgbrCfg["repo1-retention-full"])
+		assert.Equal(t, "time", pgbrCfg["repo1-retention-full-type"])
+		assert.Equal(t, "3", pgbrCfg["repo1-retention-diff"])
+	})
+
+	t.Run("partial policy keeps defaults", func(t *testing.T) {
+		loc := utils.WithBackupRetention(utils.GCSBackupLocation("my-backups", "", ""), 0, 5)
+
+		cfg, err := backup.NewConfig(loc)
+		require.NoError(t, err)
+
+		pgbrCfg, err := cfg.PgBackRestConfig("multigres")
+		require.NoError(t, err)
+		assert.Equal(t, backup.RetentionFull, pgbrCfg["repo1-retention-full"])
+		assert.Equal(t, "5", pgbrCfg["repo1-retention-diff"])
+	})
+
+	t.Run("filesystem without policy keeps everything", func(t *testing.T) {
+		cfg, err := backup.NewConfig(utils.FilesystemBackupLocation("/var/backups"))
+		require.NoError(t, err)
+
+		pgbrCfg, err := cfg.PgBackRestConfig("multigres")
+		require.NoError(t, err)
+		assert.NotContains(t, pgbrCfg, "repo1-retention-full")
+		assert.NotContains(t, pgbrCfg, "repo1-retention-diff")
+	})
+
+	t.Run("filesystem with policy", func(t *testing.T) {
+		loc := utils.WithBackupRetention(utils.FilesystemBackupLocation("/var/backups"), 14, 0)
+
+		cfg, err := backup.NewConfig(loc)
+		require.NoError(t, err)
+
+		pgbrCfg, err := cfg.PgBackRestConfig("multigres")
+		require.NoError(t, err)
+		assert.Equal(t, "14", pgbrCfg["repo1-retention-full"])
+		assert.NotContains(t, pgbrCfg, "repo1-retention-diff")
+	})
 }
 
 func TestConfig_PgBackRestConfig_S3_Basic(t *testing.T) {
@@ -216,6 +312,21 @@ func TestNewConfig_Validation(t *testing.T) {
 			loc:     utils.S3BackupLocation("my-backups", ""),
 			wantErr: "s3 region is required",
 		},
+		{
+			name:    "gcs missing bucket",
+			loc:     utils.GCSBackupLocation("", "", ""),
+			wantErr: "gcs bucket is required",
+		},
+		{
+			name:    "azure missing account",
+			loc:     utils.AzureBackupLocation("", "backups", false),
+			wantErr: "azure account is required",
+		},
+		{
+			name:    "azure missing container",
+			loc:     utils.AzureBackupLocation("myaccount", "", false),
+			wantErr: "azure container is required",
+		},
 	}
 
 	for _, tt := range tests {
@@ -446,6 +557,20 @@ func TestPgBackRestCredentials(t *testing.T) {
 			want:     nil,
 			wantErr:  false,
 		},
+		{
+			name:     "Azure with env credentials",
+			location: utils.AzureBackupLocation("myaccount", "backups", true),
+			envVars:  map[string]string{"AZURE_STORAGE_KEY": "c2VjcmV0"},
+			want:     map[string]string{"repo1-azure-key": "c2VjcmV0"},
+			wantErr:  false,
+		},
+		{
+			name:     "Azure with env credentials - missing key",
+			location: utils.AzureBackupLocation("myaccount", "backups", true),
+			envVars:  map[string]string{},
+			want:     nil,
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -454,6 +579,7 @@ func TestPgBackRestCredentials(t *testing.T) {
 			os.Unsetenv("AWS_ACCESS_KEY_ID")
 			os.Unsetenv("AWS_SECRET_ACCESS_KEY")
 			os.Unsetenv("AWS_SESSION_TOKEN")
+			os.Unsetenv("AZURE_STORAGE_KEY")
 
 			// Set test env vars
 			for k, v := range tt.envVars {
@@ -17,9 +17,13 @@ package backup
 import "time"
 
 const (
-	// S3 upload chunk size for pgBackRest
+	// S3 upload chunk size for pgBackRest, also used for GCS and Azure
 	S3UploadChunkSize = "10MiB"
 
+	// AzureStorageKeyEnv is the environment variable holding the Azure shared
+	// account key when AzureBackup.use_env_credentials is set
+	AzureStorageKeyEnv = "AZURE_STORAGE_KEY"
+
 	// Retention policies for backups
 	// RetentionDifferential: Keep the most recent differential backup
 	RetentionDifferential = "1"
@@ -29,6 +33,10 @@ const (
 	// When set to 0, pgBackRest does not enforce WAL retention separately from backup retention
 	RetentionHistory = "0"
 
+	// ArchiveTimeout forces a WAL segment switch on an idle primary so that
+	// point-in-time recovery never lags the latest commit by more than this
+	ArchiveTimeout = "60s"
+
 	// Operation timeouts
 	// BackupTimeout is the maximum time allowed for a backup operation
 	BackupTimeout = 30 * time.Minute
@@ -0,0 +1,120 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+// PgAnnotation is a PostgreSQL error annotated with where it was routed:
+// the shard, the pooler that returned it, and the attempts made. The
+// annotation does not change the diagnostic sent to the client, unless the
+// client asks for it with DebugPg.
+type PgAnnotation struct {
+	err      error
+	shard    string
+	tablet   string
+	attempts int
+}
+
+// AnnotatePg annotates err with the shard and the alias of the pooler that
+// returned it, as one attempt. Errors that do not carry a PostgreSQL
+// diagnostic, and errors already annotated, are returned unchanged.
+func AnnotatePg(err error, shard, tablet string) error {
+	var diag *sqltypes.PgDiagnostic
+	if !errors.As(err, &diag) || PgAnnotationOf(err) != nil {
+		return err
+	}
+	return &PgAnnotation{err: err, shard: shard, tablet: tablet, attempts: 1}
+}
+
+// PgAnnotationOf returns the annotation of err, or nil if it has none.
+func PgAnnotationOf(err error) *PgAnnotation {
+	var a *PgAnnotation
+	if errors.As(err, &a) {
+		return a
+	}
+	return nil
+}
+
+// SetPgAttempts records that err was returned by the last of attempts
+// attempts, if it is annotated.
+func SetPgAttempts(err error, attempts int) {
+	if a := PgAnnotationOf(err); a != nil {
+		a.attempts = attempts
+	}
+}
+
+// Shard returns the shard the error came from.
+func (a *PgAnnotation) Shard() string { return a.shard }
+
+// Tablet returns the alias of the pooler that returned the error.
+func (a *PgAnnotation) Tablet() string { return a.tablet }
+
+// Attempts returns the number of attempts made, the last of which failed
+// with the error.
+func (a *PgAnnotation) Attempts() int { return a.attempts }
+
+// Error returns the message of the annotated error, unchanged.
+func (a *PgAnnotation) Error() string { return a.err.Error() }
+
+// Unwrap returns the annotated error.
+func (a *PgAnnotation) Unwrap() error { return a.err }
+
+// ErrorCode returns the code of the annotated error.
+func (a *PgAnnotation) ErrorCode() mtrpcpb.Code {
+	var withCode ErrorWithCode
+	if errors.As(a.err, &withCode) {
+		return withCode.ErrorCode()
+	}
+	var diag *sqltypes.PgDiagnostic
+	errors.As(a.err, &diag)
+	return CodeFromSQLState(diag.Code)
+}
+
+// DebugPg returns err with its routing context appended to the Detail of
+// its diagnostic, for the sessions debugging which shard errors come from.
+// Errors without an annotation are returned unchanged. The diagnostic of
+// err itself is not modified.
+func DebugPg(err error) error {
+	a := PgAnnotationOf(err)
+	var diag *sqltypes.PgDiagnostic
+	if a == nil || !errors.As(err, &diag) {
+		return err
+	}
+	debug := *diag
+	routing := fmt.Sprintf("Returned by shard %s on pooler %s (attempt %d).", a.shard, a.tablet, a.attempts)
+	if debug.Detail == "" {
+		debug.Detail = routing
+	} else {
+		debug.Detail += "\n" + routing
+	}
+	return &debugPgError{diag: &debug, err: err}
+}
+
+// debugPgError is an annotated error whose diagnostic shows its routing
+// context. The diagnostic is found first by errors.As, and the code and
+// annotation of the original error are kept.
+type debugPgError struct {
+	diag *sqltypes.PgDiagnostic
+	err  error
+}
+
+func (e *debugPgError) Error() string   { return e.diag.Error() }
+func (e *debugPgError) Unwrap() []error { return []error{e.diag, e.err} }
@@ -0,0 +1,82 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+func TestAnnotatePg(t *testing.T) {
+	diag := sqlstate.NewError(sqlstate.UndefinedTable).Msg(`relation "t" does not exist`).Err()
+	err := AnnotatePg(diag, "0-80", "zone1-pooler-1")
+
+	a := PgAnnotationOf(fmt.Errorf("query failed: %w", err))
+	require.NotNil(t, a)
+	assert.Equal(t, "0-80", a.Shard())
+	assert.Equal(t, "zone1-pooler-1", a.Tablet())
+	assert.Equal(t, 1, a.Attempts())
+	SetPgAttempts(err, 3)
+	assert.Equal(t, 3, a.Attempts())
+
+	// The error is unchanged for the client and the callers.
+	assert.Equal(t, diag.Error(), err.Error())
+	var got *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &got)
+	assert.Same(t, diag, got)
+	assert.Equal(t, mtrpcpb.Code_NOT_FOUND, Code(err))
+
+	// Errors without a diagnostic are not annotated, and annotations are
+	// not stacked.
+	plain := errors.New("connection refused")
+	assert.Same(t, plain, AnnotatePg(plain, "0-80", "zone1-pooler-1"))
+	assert.Nil(t, PgAnnotationOf(plain))
+	assert.Same(t, err, AnnotatePg(err, "80-", "zone1-pooler-2"))
+	assert.Nil(t, AnnotatePg(nil, "0-80", "zone1-pooler-1"))
+}
+
+func TestDebugPg(t *testing.T) {
+	diag := sqlstate.NewError(sqlstate.TooManyConnections).
+		Msg("no connection available on the pooler").
+		Detail("pool exhausted").
+		Err()
+	err := AnnotatePg(FromGRPC(ToGRPC(diag)), "80-", "zone1-pooler-2")
+	SetPgAttempts(err, 2)
+
+	debug := DebugPg(fmt.Errorf("query execution failed: %w", err))
+	var got *sqltypes.PgDiagnostic
+	require.ErrorAs(t, debug, &got)
+	assert.Equal(t, "pool exhausted\nReturned by shard 80- on pooler zone1-pooler-2 (attempt 2).", got.Detail)
+	assert.Equal(t, sqlstate.TooManyConnections, got.Code)
+	var original *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &original)
+	assert.Equal(t, "pool exhausted", original.Detail, "the original diagnostic is not modified")
+	assert.NotNil(t, PgAnnotationOf(debug))
+	var withCode ErrorWithCode
+	require.ErrorAs(t, debug, &withCode)
+	assert.Equal(t, mtrpcpb.Code_RESOURCE_EXHAUSTED, withCode.ErrorCode())
+
+	// Errors without an annotation are unchanged.
+	plain := sqlstate.NewError(sqlstate.SyntaxError).Msg("syntax error").Err()
+	assert.Same(t, plain, DebugPg(plain))
+}
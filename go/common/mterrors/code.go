@@ -32,11 +32,19 @@ var (
 	// MT13002 Pooler Type Mismatch
 	MT13002 = errorWithoutState("MT13002", mtrpcpb.Code_FAILED_PRECONDITION, "pooler type mismatch: topology says %s but PostgreSQL is %s", "The pooler type in the topology does not match the actual PostgreSQL role. This indicates the pooler is in an inconsistent state and requires intervention.")
 
+	// MT13003 Replica Lagging
+	MT13003 = errorWithoutState("MT13003", mtrpcpb.Code_FAILED_PRECONDITION, "replica is lagging: %s", "The replica is further behind the primary than the statement allows, or its replication lag is unknown. The gateway runs the statement on the primary instead.")
+
+	// MT13004 Primary Fenced
+	MT13004 = errorWithoutState("MT13004", mtrpcpb.Code_CLUSTER_EVENT, "primary is fenced: %s", "The pooler does not hold a valid primary lease for its shard, e.g. because a new primary was appointed while it was partitioned from the topology. It refuses the statement to prevent a split brain; the gateway retries it on the new primary.")
+
 	// Errors is a list of errors that must match all the variables
 	// defined above to enable auto-documentation of error codes.
 	Errors = []func(args ...any) *MultigresError{
 		MT13001,
 		MT13002,
+		MT13003,
+		MT13004,
 	}
 
 	ErrorsWithNoCode = []func(code mtrpcpb.Code, args ...any) *MultigresError{}
@@ -57,6 +65,10 @@ func (o *MultigresError) Cause() error {
 	return o.Err
 }
 
+func (o *MultigresError) Unwrap() error {
+	return o.Err
+}
+
 var _ error = (*MultigresError)(nil)
 
 // errorWithoutState is an error that does not have any state, e.g. the state will be unknown
@@ -16,13 +16,16 @@
 package mterrors
 
 import (
+	"errors"
 	"fmt"
 	"io"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/multigres/multigres/go/common/sqltypes"
 	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	"github.com/multigres/multigres/go/pb/query"
 )
 
 // This file contains functions to convert errors to and from gRPC codes.
@@ -45,11 +48,27 @@ func truncateError(err error) string {
 }
 
 // ToGRPC returns an error as a gRPC error, with the appropriate error code.
+// A PostgreSQL error gets the code of its SQLSTATE, unless it is wrapped in
+// an error with a code, and travels with its diagnostic so that FromGRPC
+// restores it.
 func ToGRPC(err error) error {
 	if err == nil {
 		return nil
 	}
-	return status.Errorf(codes.Code(Code(err)), "%v", truncateError(err))
+	var diag *sqltypes.PgDiagnostic
+	if !errors.As(err, &diag) {
+		return status.Errorf(codes.Code(Code(err)), "%v", truncateError(err))
+	}
+	code := CodeFromSQLState(diag.Code)
+	var withCode ErrorWithCode
+	if errors.As(err, &withCode) {
+		code = withCode.ErrorCode()
+	}
+	s := status.New(codes.Code(code), truncateError(err))
+	if withDiag, detailErr := s.WithDetails(sqltypes.NoticeToProto(diag.ToNotice())); detailErr == nil {
+		s = withDiag
+	}
+	return s.Err()
 }
 
 // FromGRPC returns a gRPC error as a vtError, translating between error codes.
@@ -67,6 +86,35 @@ func FromGRPC(err error) error {
 	code := codes.Unknown
 	if s, ok := status.FromError(err); ok {
 		code = s.Code()
+		if diag := diagnosticFromStatus(s); diag != nil {
+			return &pgError{code: mtrpcpb.Code(code), diag: diag}
+		}
 	}
 	return New(mtrpcpb.Code(code), err.Error())
 }
+
+// PgErrorFromGRPC returns the PostgreSQL error a gRPC error carries, as
+// FromGRPC does, or nil if it carries none.
+func PgErrorFromGRPC(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	diag := diagnosticFromStatus(s)
+	if diag == nil {
+		return nil
+	}
+	return &pgError{code: mtrpcpb.Code(s.Code()), diag: diag}
+}
+
+// diagnosticFromStatus returns the diagnostic in the details of s, or nil.
+func diagnosticFromStatus(s *status.Status) *sqltypes.PgDiagnostic {
+	for _, detail := range s.Details() {
+		if notice, ok := detail.(*query.Notice); ok {
+			diag := sqltypes.DiagnosticFromNotice(sqltypes.NoticeFromProto(notice))
+			diag.MessageType = sqltypes.DiagnosticError
+			return diag
+		}
+	}
+	return nil
+}
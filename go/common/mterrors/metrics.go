@@ -0,0 +1,63 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Metrics holds all OpenTelemetry metrics for the mterrors package.
+type Metrics struct {
+	meter  metric.Meter
+	panics metric.Int64Counter
+}
+
+// metrics is the singleton instance of Metrics for the mterrors package.
+var metrics *Metrics
+
+func init() {
+	metrics = newMetrics()
+}
+
+// newMetrics initializes OpenTelemetry metrics for the mterrors package.
+func newMetrics() *Metrics {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/common/mterrors"),
+	}
+
+	var err error
+
+	// Counter for the panics recovered instead of crashing the process
+	m.panics, err = m.meter.Int64Counter(
+		"mterrors.panics",
+		metric.WithDescription("Number of panics recovered by client sessions and RPC handlers"),
+		metric.WithUnit("{panic}"),
+	)
+	if err != nil {
+		m.panics = noop.Int64Counter{}
+	}
+
+	return m
+}
+
+// recordPanic counts a panic recovered by component.
+func (m *Metrics) recordPanic(ctx context.Context, component string) {
+	m.panics.Add(ctx, 1, metric.WithAttributes(attribute.String("component", component)))
+}
@@ -95,6 +95,7 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/multigres/multigres/go/common/sqltypes"
 	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
 )
 
@@ -203,6 +204,9 @@ func Code(err error) mtrpcpb.Code {
 	if err, ok := err.(ErrorWithCode); ok {
 		return err.ErrorCode()
 	}
+	if diag, ok := err.(*sqltypes.PgDiagnostic); ok {
+		return CodeFromSQLState(diag.Code)
+	}
 
 	cause := Cause(err)
 	if cause != err && cause != nil {
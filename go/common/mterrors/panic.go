@@ -0,0 +1,81 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+// PanicError is a panic recovered by a client session or an RPC handler.
+// The client gets it as an internal_error (XX000) whose detail holds the ID
+// of the error, which is logged along with the panic and its stack trace
+// for the operators to correlate the two. The panic value is not sent to
+// the client.
+type PanicError struct {
+	// ID identifies the error in the logs.
+	ID string
+	// Value is the value the code panicked with.
+	Value any
+
+	diag *sqltypes.PgDiagnostic
+}
+
+// RecoverPanic turns x, the value recovered from a panic in component,
+// into a PanicError. It logs the panic with the stack trace of the
+// goroutine, and counts it in the mterrors.panics metric. It must be
+// called from the deferred function that recovered x, for the stack to
+// show where the panic happened.
+func RecoverPanic(ctx context.Context, logger *slog.Logger, component string, x any) *PanicError {
+	id := newErrorID()
+	logger.ErrorContext(ctx, "recovered from panic",
+		"component", component,
+		"error_id", id,
+		"panic", x,
+		"stack", string(debug.Stack()))
+	metrics.recordPanic(ctx, component)
+
+	diag := sqlstate.NewError(sqlstate.InternalError).
+		Msg("internal error").
+		Detail("The error was logged with ID %s.", id).
+		Build()
+	return &PanicError{ID: id, Value: x, diag: diag}
+}
+
+// newErrorID returns a random ID for an error.
+func newErrorID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Error returns the message of the diagnostic the client gets.
+func (e *PanicError) Error() string { return e.diag.Error() }
+
+// Unwrap returns the diagnostic the client gets.
+func (e *PanicError) Unwrap() error { return e.diag }
+
+// ErrorCode returns INTERNAL.
+func (e *PanicError) ErrorCode() mtrpcpb.Code { return mtrpcpb.Code_INTERNAL }
+
+// Diagnostic returns the diagnostic the client gets.
+func (e *PanicError) Diagnostic() *sqltypes.PgDiagnostic { return e.diag }
@@ -0,0 +1,71 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+func TestRecoverPanic(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	var err *PanicError
+	func() {
+		defer func() {
+			err = RecoverPanic(context.Background(), logger, "test", recover())
+		}()
+		panicInHandler()
+	}()
+
+	require.NotNil(t, err)
+	assert.Len(t, err.ID, 16)
+	assert.Equal(t, "handler bug", err.Value)
+	assert.Equal(t, mtrpcpb.Code_INTERNAL, Code(err))
+
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.InternalError, diag.Code)
+	assert.Equal(t, "internal error", diag.Message)
+	assert.Contains(t, diag.Detail, err.ID)
+	assert.NotContains(t, err.Error(), "handler bug", "the panic value is not sent to clients")
+
+	// The log correlates the ID with the panic and where it happened.
+	assert.Contains(t, logs.String(), "error_id="+err.ID)
+	assert.Contains(t, logs.String(), "handler bug")
+	assert.Contains(t, logs.String(), "panicInHandler")
+
+	// Over gRPC, the panic is an internal error keeping the diagnostic.
+	grpcErr := ToGRPC(err)
+	assert.Equal(t, codes.Internal, status.Code(grpcErr))
+	require.ErrorAs(t, FromGRPC(grpcErr), &diag)
+	assert.Contains(t, diag.Detail, err.ID)
+}
+
+func panicInHandler() {
+	panic("handler bug")
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 The Vitess Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Modifications Copyright 2025 Supabase, Inc.
+package mterrors
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+// transientSQLStates are the SQLSTATEs of the errors a statement that
+// changes nothing may be run again after: the server went away or is
+// failing over, or the statement lost a conflict with another. The
+// connection exceptions (class 08) are transient as well.
+var transientSQLStates = map[string]bool{
+	sqlstate.SerializationFailure: true,
+	sqlstate.DeadlockDetected:     true,
+	sqlstate.AdminShutdown:        true,
+	sqlstate.CrashShutdown:        true,
+	sqlstate.CannotConnectNow:     true,
+}
+
+// IsRetryable returns true if err is a transient failure of a shard, such
+// as a connection reset, a failover in progress or a serialization failure,
+// after which running the statement again may succeed. Whether it may be
+// run again without changing its outcome, as for a read outside a
+// transaction, is up to the caller.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var diag *sqltypes.PgDiagnostic
+	if errors.As(err, &diag) {
+		return IsTransientPg(err)
+	}
+	code := errorCode(err)
+	return code == mtrpcpb.Code_UNAVAILABLE || code == mtrpcpb.Code_CLUSTER_EVENT
+}
+
+// IsTransientPg returns true if err is a PostgreSQL error after which
+// running the statement again may succeed: a connection exception (08xxx),
+// a serialization failure (40001), a deadlock (40P01), or a server shutting
+// down or starting up (57P01, 57P02, 57P03).
+func IsTransientPg(err error) bool {
+	var diag *sqltypes.PgDiagnostic
+	if !errors.As(err, &diag) {
+		return false
+	}
+	return transientSQLStates[diag.Code] || strings.HasPrefix(diag.Code, sqlstate.ConnectionException[:2])
+}
+
+// RetryPolicy decides whether and when an operation that failed is run
+// again. The zero value runs operations once.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times an operation runs, including the
+	// first. One or less disables the retries.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry, doubled for each
+	// of the next ones up to MaxBackoff, if set.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// Retryable returns true for the errors worth a retry. IsRetryable is
+	// used if it is nil.
+	Retryable func(error) bool
+}
+
+// Enabled returns true if the policy retries anything.
+func (p RetryPolicy) Enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+// ShouldRetry returns true if an operation that failed with err on its
+// attempt-th run, counting from 1, may run again.
+func (p RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	if err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// Backoff returns the wait after the attempt-th run of an operation,
+// counting from 1, before the next one.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff > 0; i++ {
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+		if backoff > math.MaxInt64/2 {
+			return backoff
+		}
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Do runs op until it succeeds, fails with an error ShouldRetry rejects, or
+// ctx is done, waiting the backoff between the runs. It returns the error of
+// the last run.
+func (p RetryPolicy) Do(ctx context.Context, op func(context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		err := op(ctx)
+		if !p.ShouldRetry(attempt, err) || ctx.Err() != nil {
+			return err
+		}
+		timer := time.NewTimer(p.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// IsFailover returns true if err tells that a shard rejected a statement
+// without running it because its primary is failing over, which only the
+// pooler reports, with a cluster event. The statement may then be run again
+// on the new primary, whatever it does. The errors of PostgreSQL itself,
+// such as a write refused in a read-only transaction (25006), may be the
+// client's own doing and are never taken for a failover.
+func IsFailover(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errorCode(err) == mtrpcpb.Code_CLUSTER_EVENT
+}
+
+// IsReplicaLagging returns true if err tells that a replica refused a
+// statement because it lags behind the primary by more than the statement
+// allows (MT13003). The statement did not run and may be sent to the
+// primary instead.
+func IsReplicaLagging(err error) bool {
+	return IsError(err, "MT13003")
+}
+
+// errorCode returns the code of err, found in the errors it wraps or in
+// its gRPC status.
+func errorCode(err error) mtrpcpb.Code {
+	var withCode ErrorWithCode
+	if errors.As(err, &withCode) {
+		return withCode.ErrorCode()
+	}
+	if s, ok := status.FromError(err); ok {
+		return mtrpcpb.Code(s.Code())
+	}
+	return Code(err)
+}
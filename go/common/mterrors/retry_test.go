@@ -0,0 +1,201 @@
+// Copyright 2022 The Vitess Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Modifications Copyright 2025 Supabase, Inc.
+package mterrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+		{name: "canceled", err: context.Canceled, want: false},
+		{name: "unavailable", err: New(mtrpcpb.Code_UNAVAILABLE, "pooler gone"), want: true},
+		{name: "cluster event", err: New(mtrpcpb.Code_CLUSTER_EVENT, "failover in progress"), want: true},
+		{name: "wrapped unavailable", err: fmt.Errorf("query execution failed: %w", New(mtrpcpb.Code_UNAVAILABLE, "reset")), want: true},
+		{name: "internal", err: New(mtrpcpb.Code_INTERNAL, "bug"), want: false},
+		{name: "grpc unavailable", err: fmt.Errorf("stream receive error: %w", status.Error(codes.Unavailable, "connection reset by peer")), want: true},
+		{name: "grpc invalid argument", err: status.Error(codes.InvalidArgument, "bad"), want: false},
+		{name: "serialization failure", err: sqlstate.NewError(sqlstate.SerializationFailure).Msg("could not serialize access").Err(), want: true},
+		{name: "connection failure", err: sqlstate.NewError(sqlstate.ConnectionFailure).Msg("connection lost").Err(), want: true},
+		{name: "cannot connect now", err: sqlstate.NewError(sqlstate.CannotConnectNow).Msg("the database system is starting up").Err(), want: true},
+		{name: "unique violation", err: sqlstate.NewError(sqlstate.UniqueViolation).Msg("duplicate key").Err(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestIsFailover(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "cluster event", err: fmt.Errorf("query execution failed: %w", New(mtrpcpb.Code_CLUSTER_EVENT, "primary is demoting")), want: true},
+		{name: "grpc cluster event", err: status.Error(codes.Code(mtrpcpb.Code_CLUSTER_EVENT), "failover in progress"), want: true},
+		{name: "read-only transaction", err: sqlstate.NewError(sqlstate.ReadOnlySQLTransaction).Msg("cannot execute INSERT in a read-only transaction").Err(), want: false},
+		{name: "admin shutdown", err: sqlstate.NewError(sqlstate.AdminShutdown).Msg("terminating connection due to administrator command").Err(), want: false},
+		{name: "unavailable", err: New(mtrpcpb.Code_UNAVAILABLE, "connection reset"), want: false},
+		{name: "serialization failure", err: sqlstate.NewError(sqlstate.SerializationFailure).Msg("could not serialize access").Err(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsFailover(tt.err))
+		})
+	}
+}
+
+func TestIsReplicaLagging(t *testing.T) {
+	lagging := MT13003("lag 12s exceeds the maximum of 5s")
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "lagging", err: lagging, want: true},
+		{name: "wrapped", err: fmt.Errorf("query execution failed: %w", lagging), want: true},
+		{name: "grpc", err: status.Error(codes.FailedPrecondition, lagging.Error()), want: true},
+		{name: "pooler type mismatch", err: MT13002("REPLICA", "PRIMARY"), want: false},
+		{name: "unavailable", err: New(mtrpcpb.Code_UNAVAILABLE, "connection reset"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsReplicaLagging(tt.err))
+		})
+	}
+}
+
+func TestIsTransientPg(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "unavailable", err: New(mtrpcpb.Code_UNAVAILABLE, "pooler gone"), want: false},
+		{name: "connection exception", err: sqlstate.NewError(sqlstate.ConnectionException).Msg("connection lost").Err(), want: true},
+		{name: "connection failure", err: sqlstate.NewError(sqlstate.ConnectionFailure).Msg("connection lost").Err(), want: true},
+		{name: "serialization failure", err: sqlstate.NewError(sqlstate.SerializationFailure).Msg("could not serialize access").Err(), want: true},
+		{name: "deadlock", err: fmt.Errorf("query execution failed: %w", sqlstate.NewError(sqlstate.DeadlockDetected).Msg("deadlock detected").Err()), want: true},
+		{name: "admin shutdown", err: sqlstate.NewError(sqlstate.AdminShutdown).Msg("terminating connection").Err(), want: true},
+		{name: "cannot connect now", err: sqlstate.NewError(sqlstate.CannotConnectNow).Msg("the database system is starting up").Err(), want: true},
+		{name: "database dropped", err: sqlstate.NewError(sqlstate.DatabaseDropped).Msg("database dropped").Err(), want: false},
+		{name: "syntax error", err: sqlstate.NewError(sqlstate.SyntaxError).Msg("syntax error").Err(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTransientPg(tt.err))
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	transient := New(mtrpcpb.Code_UNAVAILABLE, "connection reset")
+	permanent := New(mtrpcpb.Code_INVALID_ARGUMENT, "bad")
+
+	t.Run("zero value runs once", func(t *testing.T) {
+		var p RetryPolicy
+		assert.False(t, p.Enabled())
+		assert.False(t, p.ShouldRetry(1, transient))
+	})
+
+	t.Run("should retry", func(t *testing.T) {
+		p := RetryPolicy{MaxAttempts: 3}
+		assert.True(t, p.Enabled())
+		assert.True(t, p.ShouldRetry(1, transient))
+		assert.True(t, p.ShouldRetry(2, transient))
+		assert.False(t, p.ShouldRetry(3, transient), "the attempts ran out")
+		assert.False(t, p.ShouldRetry(1, permanent))
+		assert.False(t, p.ShouldRetry(1, nil))
+
+		p.Retryable = func(err error) bool { return errors.Is(err, permanent) }
+		assert.True(t, p.ShouldRetry(1, permanent))
+		assert.False(t, p.ShouldRetry(1, transient))
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		p := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 35 * time.Millisecond}
+		assert.Equal(t, 10*time.Millisecond, p.Backoff(1))
+		assert.Equal(t, 20*time.Millisecond, p.Backoff(2))
+		assert.Equal(t, 35*time.Millisecond, p.Backoff(3))
+		assert.Equal(t, 35*time.Millisecond, p.Backoff(100))
+
+		p.MaxBackoff = 0
+		assert.Equal(t, 40*time.Millisecond, p.Backoff(3))
+		assert.Positive(t, p.Backoff(200), "no overflow")
+	})
+
+	t.Run("do", func(t *testing.T) {
+		p := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+		var runs int
+		err := p.Do(context.Background(), func(context.Context) error {
+			runs++
+			if runs < 2 {
+				return transient
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, runs)
+
+		runs = 0
+		err = p.Do(context.Background(), func(context.Context) error {
+			runs++
+			return transient
+		})
+		assert.Equal(t, transient, err)
+		assert.Equal(t, 3, runs)
+
+		runs = 0
+		err = p.Do(context.Background(), func(context.Context) error {
+			runs++
+			return permanent
+		})
+		assert.Equal(t, permanent, err)
+		assert.Equal(t, 1, runs)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		runs = 0
+		err = p.Do(ctx, func(context.Context) error {
+			runs++
+			return transient
+		})
+		assert.Equal(t, transient, err)
+		assert.Equal(t, 1, runs, "a done context stops the retries")
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"errors"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+// This file maps the SQLSTATEs of the PostgreSQL errors to the codes of
+// the internal RPCs and back, so that the callers of a pooler can branch on
+// the code of an error without parsing its SQLSTATE, while the clients
+// still get the diagnostic PostgreSQL sent.
+
+// sqlStateCodes are the codes of the SQLSTATEs that do not get the code of
+// their class.
+var sqlStateCodes = map[string]mtrpcpb.Code{
+	sqlstate.QueryCanceled:           mtrpcpb.Code_DEADLINE_EXCEEDED,
+	sqlstate.ReadOnlySQLTransaction:  mtrpcpb.Code_READ_ONLY,
+	sqlstate.InsufficientPrivilege:   mtrpcpb.Code_PERMISSION_DENIED,
+	sqlstate.UniqueViolation:         mtrpcpb.Code_ALREADY_EXISTS,
+	sqlstate.DuplicateDatabase:       mtrpcpb.Code_ALREADY_EXISTS,
+	sqlstate.DuplicateSchema:         mtrpcpb.Code_ALREADY_EXISTS,
+	sqlstate.DuplicateTable:          mtrpcpb.Code_ALREADY_EXISTS,
+	sqlstate.DuplicateColumn:         mtrpcpb.Code_ALREADY_EXISTS,
+	sqlstate.DuplicateObject:         mtrpcpb.Code_ALREADY_EXISTS,
+	sqlstate.UndefinedTable:          mtrpcpb.Code_NOT_FOUND,
+	sqlstate.UndefinedColumn:         mtrpcpb.Code_NOT_FOUND,
+	sqlstate.UndefinedFunction:       mtrpcpb.Code_NOT_FOUND,
+	sqlstate.UndefinedObject:         mtrpcpb.Code_NOT_FOUND,
+	sqlstate.InvalidSQLStatementName: mtrpcpb.Code_NOT_FOUND,
+	sqlstate.InvalidCursorName:       mtrpcpb.Code_NOT_FOUND,
+	sqlstate.NumericValueOutOfRange:  mtrpcpb.Code_OUT_OF_RANGE,
+	sqlstate.LockNotAvailable:        mtrpcpb.Code_ABORTED,
+	sqlstate.DataCorrupted:           mtrpcpb.Code_DATA_LOSS,
+	sqlstate.IndexCorrupted:          mtrpcpb.Code_DATA_LOSS,
+}
+
+// sqlStateClassCodes are the codes of the SQLSTATE classes. The classes
+// missing, such as the warnings, have the code UNKNOWN.
+var sqlStateClassCodes = map[string]mtrpcpb.Code{
+	"08": mtrpcpb.Code_UNAVAILABLE,         // connection exception
+	"0A": mtrpcpb.Code_UNIMPLEMENTED,       // feature not supported
+	"0B": mtrpcpb.Code_FAILED_PRECONDITION, // invalid transaction initiation
+	"21": mtrpcpb.Code_INVALID_ARGUMENT,    // cardinality violation
+	"22": mtrpcpb.Code_INVALID_ARGUMENT,    // data exception
+	"23": mtrpcpb.Code_FAILED_PRECONDITION, // integrity constraint violation
+	"24": mtrpcpb.Code_FAILED_PRECONDITION, // invalid cursor state
+	"25": mtrpcpb.Code_FAILED_PRECONDITION, // invalid transaction state
+	"28": mtrpcpb.Code_UNAUTHENTICATED,     // invalid authorization specification
+	"3D": mtrpcpb.Code_NOT_FOUND,           // invalid catalog name
+	"3F": mtrpcpb.Code_NOT_FOUND,           // invalid schema name
+	"40": mtrpcpb.Code_ABORTED,             // transaction rollback
+	"42": mtrpcpb.Code_INVALID_ARGUMENT,    // syntax error or access rule violation
+	"53": mtrpcpb.Code_RESOURCE_EXHAUSTED,  // insufficient resources
+	"54": mtrpcpb.Code_RESOURCE_EXHAUSTED,  // program limit exceeded
+	"55": mtrpcpb.Code_FAILED_PRECONDITION, // object not in prerequisite state
+	"57": mtrpcpb.Code_UNAVAILABLE,         // operator intervention
+	"58": mtrpcpb.Code_INTERNAL,            // system error
+	"XX": mtrpcpb.Code_INTERNAL,            // internal error
+}
+
+// codeSQLStates are the SQLSTATEs standing for the codes, for the errors
+// without a diagnostic.
+var codeSQLStates = map[mtrpcpb.Code]string{
+	mtrpcpb.Code_OK:                  sqlstate.SuccessfulCompletion,
+	mtrpcpb.Code_CANCELED:            sqlstate.QueryCanceled,
+	mtrpcpb.Code_DEADLINE_EXCEEDED:   sqlstate.QueryCanceled,
+	mtrpcpb.Code_INVALID_ARGUMENT:    sqlstate.InvalidParameterValue,
+	mtrpcpb.Code_NOT_FOUND:           sqlstate.UndefinedObject,
+	mtrpcpb.Code_ALREADY_EXISTS:      sqlstate.DuplicateObject,
+	mtrpcpb.Code_PERMISSION_DENIED:   sqlstate.InsufficientPrivilege,
+	mtrpcpb.Code_RESOURCE_EXHAUSTED:  sqlstate.InsufficientResources,
+	mtrpcpb.Code_FAILED_PRECONDITION: sqlstate.ObjectNotInPrerequisiteState,
+	mtrpcpb.Code_ABORTED:             sqlstate.SerializationFailure,
+	mtrpcpb.Code_OUT_OF_RANGE:        sqlstate.NumericValueOutOfRange,
+	mtrpcpb.Code_UNIMPLEMENTED:       sqlstate.FeatureNotSupported,
+	mtrpcpb.Code_UNAVAILABLE:         sqlstate.ConnectionFailure,
+	mtrpcpb.Code_DATA_LOSS:           sqlstate.DataCorrupted,
+	mtrpcpb.Code_UNAUTHENTICATED:     sqlstate.InvalidAuthorizationSpecification,
+	mtrpcpb.Code_CLUSTER_EVENT:       sqlstate.AdminShutdown,
+	mtrpcpb.Code_READ_ONLY:           sqlstate.ReadOnlySQLTransaction,
+}
+
+// CodeFromSQLState returns the code of the errors with the given SQLSTATE,
+// e.g. RESOURCE_EXHAUSTED for too_many_connections (53300).
+func CodeFromSQLState(code string) mtrpcpb.Code {
+	if c, ok := sqlStateCodes[code]; ok {
+		return c
+	}
+	if c, ok := sqlStateClassCodes[sqlstate.Class(code)]; ok {
+		return c
+	}
+	return mtrpcpb.Code_UNKNOWN
+}
+
+// SQLStateFromCode returns the SQLSTATE standing for code, e.g.
+// connection_failure (08006) for UNAVAILABLE, or internal_error (XX000) for
+// the codes without a counterpart.
+func SQLStateFromCode(code mtrpcpb.Code) string {
+	if s, ok := codeSQLStates[code]; ok {
+		return s
+	}
+	return sqlstate.InternalError
+}
+
+// SQLState returns the SQLSTATE of err: that of the diagnostic it wraps,
+// or the one standing for its code.
+func SQLState(err error) string {
+	var diag *sqltypes.PgDiagnostic
+	if errors.As(err, &diag) {
+		return diag.Code
+	}
+	return SQLStateFromCode(Code(err))
+}
+
+// pgError is a PostgreSQL error received from an internal RPC, with the
+// code the RPC carried it with.
+type pgError struct {
+	code mtrpcpb.Code
+	diag *sqltypes.PgDiagnostic
+}
+
+func (e *pgError) Error() string           { return e.diag.Error() }
+func (e *pgError) Unwrap() error           { return e.diag }
+func (e *pgError) ErrorCode() mtrpcpb.Code { return e.code }
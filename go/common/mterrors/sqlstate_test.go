@@ -0,0 +1,115 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mterrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+)
+
+func TestCodeFromSQLState(t *testing.T) {
+	tests := []struct {
+		sqlState string
+		want     mtrpcpb.Code
+	}{
+		{sqlstate.QueryCanceled, mtrpcpb.Code_DEADLINE_EXCEEDED},
+		{sqlstate.TooManyConnections, mtrpcpb.Code_RESOURCE_EXHAUSTED},
+		{sqlstate.SerializationFailure, mtrpcpb.Code_ABORTED},
+		{sqlstate.DeadlockDetected, mtrpcpb.Code_ABORTED},
+		{sqlstate.ConnectionFailure, mtrpcpb.Code_UNAVAILABLE},
+		{sqlstate.CannotConnectNow, mtrpcpb.Code_UNAVAILABLE},
+		{sqlstate.ReadOnlySQLTransaction, mtrpcpb.Code_READ_ONLY},
+		{sqlstate.UniqueViolation, mtrpcpb.Code_ALREADY_EXISTS},
+		{sqlstate.ForeignKeyViolation, mtrpcpb.Code_FAILED_PRECONDITION},
+		{sqlstate.UndefinedTable, mtrpcpb.Code_NOT_FOUND},
+		{sqlstate.InvalidCatalogName, mtrpcpb.Code_NOT_FOUND},
+		{sqlstate.SyntaxError, mtrpcpb.Code_INVALID_ARGUMENT},
+		{sqlstate.InsufficientPrivilege, mtrpcpb.Code_PERMISSION_DENIED},
+		{sqlstate.InvalidPassword, mtrpcpb.Code_UNAUTHENTICATED},
+		{sqlstate.FeatureNotSupported, mtrpcpb.Code_UNIMPLEMENTED},
+		{sqlstate.DataCorrupted, mtrpcpb.Code_DATA_LOSS},
+		{sqlstate.InternalError, mtrpcpb.Code_INTERNAL},
+		{"P0001", mtrpcpb.Code_UNKNOWN},
+		{"", mtrpcpb.Code_UNKNOWN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sqlState, func(t *testing.T) {
+			assert.Equal(t, tt.want, CodeFromSQLState(tt.sqlState))
+		})
+	}
+}
+
+func TestSQLStateFromCode(t *testing.T) {
+	// Every code maps to a SQLSTATE that maps back to it, except for the
+	// codes that share their SQLSTATE with another.
+	for code := range mtrpcpb.Code_name {
+		c := mtrpcpb.Code(code)
+		sqlState := SQLStateFromCode(c)
+		switch c {
+		case mtrpcpb.Code_OK, mtrpcpb.Code_CANCELED, mtrpcpb.Code_UNKNOWN, mtrpcpb.Code_CLUSTER_EVENT:
+			continue
+		}
+		assert.Equal(t, c, CodeFromSQLState(sqlState), "%v maps to %s", c, sqlState)
+	}
+	assert.Equal(t, sqlstate.InternalError, SQLStateFromCode(mtrpcpb.Code_UNKNOWN))
+}
+
+func TestSQLState(t *testing.T) {
+	diag := sqlstate.NewError(sqlstate.UndefinedColumn).Msg(`column "x" does not exist`).Err()
+	assert.Equal(t, sqlstate.UndefinedColumn, SQLState(fmt.Errorf("query failed: %w", diag)))
+	assert.Equal(t, sqlstate.ConnectionFailure, SQLState(New(mtrpcpb.Code_UNAVAILABLE, "pooler gone")))
+	assert.Equal(t, mtrpcpb.Code_NOT_FOUND, Code(diag))
+}
+
+func TestGRPCCarriesDiagnostic(t *testing.T) {
+	diag := sqlstate.NewError(sqlstate.UniqueViolation).
+		Msg(`duplicate key value violates unique constraint "t_pkey"`).
+		Detail("Key (id)=(1) already exists.").
+		Err()
+
+	grpcErr := ToGRPC(fmt.Errorf("query failed: %w", diag))
+	assert.Equal(t, codes.AlreadyExists, status.Code(grpcErr))
+
+	err := FromGRPC(grpcErr)
+	var got *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &got)
+	assert.Equal(t, sqltypes.DiagnosticError, got.MessageType)
+	assert.Equal(t, "ERROR", got.Severity)
+	assert.Equal(t, sqlstate.UniqueViolation, got.Code)
+	assert.Equal(t, "Key (id)=(1) already exists.", got.Detail)
+	assert.Equal(t, got.Error(), err.Error())
+	assert.Equal(t, mtrpcpb.Code_ALREADY_EXISTS, Code(err))
+
+	// A code wrapping the diagnostic wins over that of its SQLSTATE.
+	grpcErr = ToGRPC(Wrapf(NewError(mtrpcpb.Code_CLUSTER_EVENT, Undefined, "demoting"), "%v", diag))
+	assert.Equal(t, codes.Code(mtrpcpb.Code_CLUSTER_EVENT), status.Code(grpcErr))
+
+	// Errors without a diagnostic are unchanged.
+	err = FromGRPC(status.Error(codes.Unavailable, "connection reset"))
+	assert.False(t, errors.As(err, &got))
+	assert.Equal(t, mtrpcpb.Code_UNAVAILABLE, Code(err))
+	assert.Nil(t, PgErrorFromGRPC(status.Error(codes.Unavailable, "connection reset")))
+	assert.Nil(t, PgErrorFromGRPC(errors.New("plain")))
+}
@@ -386,6 +386,29 @@ func (ctx *ParseContext) putBackInternal(n int) {
 	ctx.currentPosition -= n
 }
 
+// Rewind moves the scan position back to pos, an earlier scan position,
+// keeping the current position, line and column in step with it.
+func (ctx *ParseContext) Rewind(pos int) {
+	if pos < 0 || pos >= ctx.scanPos {
+		return
+	}
+
+	crossedNewline := false
+	for ctx.scanPos > pos {
+		ctx.scanPos--
+		ctx.currentPosition--
+		b := ctx.scanBuf[ctx.scanPos]
+		if b == '\n' {
+			crossedNewline = true
+		} else if utf8.RuneStart(b) {
+			ctx.columnNumber--
+		}
+	}
+	if crossedNewline {
+		ctx.recalculateLineColumn()
+	}
+}
+
 // recalculateLineColumn recalculates line and column numbers from current position
 // Used after position restoration to ensure accuracy
 func (ctx *ParseContext) recalculateLineColumn() {
@@ -49,6 +49,12 @@ import (
 type Lexer struct {
 	context   *ParseContext // Thread-safe unified context
 	parseTree []ast.Stmt    // Parse tree result from parsing
+
+	// stmtSeparators holds the positions of the semicolons separating
+	// top-level statements, and stmtSegments, for each statement of the
+	// parse tree, the number of separators before it.
+	stmtSeparators []int
+	stmtSegments   []int
 }
 
 // Import ast package (will be added at the top)
@@ -1739,6 +1745,43 @@ func (l *Lexer) GetParseTree() []ast.Stmt {
 	return l.parseTree
 }
 
+// AddStatementSeparator records the position of a semicolon separating
+// top-level statements
+func (l *Lexer) AddStatementSeparator(pos int) {
+	l.stmtSeparators = append(l.stmtSeparators, pos)
+}
+
+// AddStatement records that a statement follows the last separator
+func (l *Lexer) AddStatement() {
+	l.stmtSegments = append(l.stmtSegments, len(l.stmtSeparators))
+}
+
+// GetRawStatements returns the parse tree result with the location of each
+// statement in input, trimmed of surrounding whitespace
+func (l *Lexer) GetRawStatements(input string) []*ast.RawStmt {
+	raw := make([]*ast.RawStmt, 0, len(l.parseTree))
+	for i, stmt := range l.parseTree {
+		start, end := 0, len(input)
+		if i < len(l.stmtSegments) {
+			segment := l.stmtSegments[i]
+			if segment > 0 {
+				start = l.stmtSeparators[segment-1] + 1
+			}
+			if segment < len(l.stmtSeparators) {
+				end = l.stmtSeparators[segment]
+			}
+		}
+		for start < end && IsWhitespace(input[start]) {
+			start++
+		}
+		for end > start && IsWhitespace(input[end-1]) {
+			end--
+		}
+		raw = append(raw, ast.NewRawStmt(stmt, start, end-start))
+	}
+	return raw
+}
+
 // GetPosition returns the current position in the input
 func (l *Lexer) GetPosition() int {
 	return l.context.CurrentPosition()
@@ -38,6 +38,7 @@
 package parser
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -424,6 +425,35 @@ func TestStringConcatenation(t *testing.T) {
 	}
 }
 
+// TestTokenPositionsAfterStrings tests that the tokens following a string
+// literal are located exactly, whether or not the lexer looked past the
+// literal for a continuation.
+func TestTokenPositionsAfterStrings(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"no whitespace", "'a';"},
+		{"spaces", "'a'  ;"},
+		{"spaces and newline", "'a' \n ;"},
+		{"continuation", "'a'\n'b' ;"},
+		{"extended string", "E'a' ;"},
+		{"octal escape", "E'\\101';"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := NewLexer(tt.input)
+			token := lexer.NextToken()
+			require.Equal(t, SCONST, token.Type)
+
+			token = lexer.NextToken()
+			require.Equal(t, int(';'), token.Type)
+			assert.Equal(t, strings.LastIndexByte(tt.input, ';'), token.Position)
+		})
+	}
+}
+
 // TestDollarTokens tests parameter tokens vs dollar-quoted strings
 func TestDollarTokens(t *testing.T) {
 	tests := []struct {
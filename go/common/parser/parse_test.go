@@ -242,3 +242,38 @@ func (s *parseTestSuite) TestPostgresTestsParsing() {
 		}
 	}
 }
+
+// TestParseRawSQL checks that the statements of a multi-statement query
+// string are located exactly, whatever they contain.
+func TestParseRawSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		expected []string
+	}{
+		{"single statement", "SELECT 1", []string{"SELECT 1"}},
+		{"trailing semicolon", "SELECT 1;", []string{"SELECT 1"}},
+		{"several statements", "SELECT 1; SELECT 2;SELECT 3", []string{"SELECT 1", "SELECT 2", "SELECT 3"}},
+		{"empty statements", " ;; SELECT 1 ;\n; ", []string{"SELECT 1"}},
+		{"semicolons in literals", "SELECT 'a;b' ; SELECT $$c;d$$; SELECT \"e;f\"", []string{"SELECT 'a;b'", "SELECT $$c;d$$", "SELECT \"e;f\""}},
+		{"comments", "SELECT 1; -- first\nSELECT /* ; */ 2", []string{"SELECT 1", "-- first\nSELECT /* ; */ 2"}},
+		{
+			"function body",
+			"CREATE FUNCTION f() RETURNS int LANGUAGE sql BEGIN ATOMIC SELECT 1; SELECT 2; END; SELECT f()",
+			[]string{"CREATE FUNCTION f() RETURNS int LANGUAGE sql BEGIN ATOMIC SELECT 1; SELECT 2; END", "SELECT f()"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raws, err := ParseRawSQL(tt.sql)
+			require.NoError(t, err)
+			stmts := make([]string, len(raws))
+			for i, raw := range raws {
+				require.NotNil(t, raw.Stmt)
+				stmts[i] = tt.sql[raw.StmtLocation : raw.StmtLocation+raw.StmtLen]
+			}
+			require.Equal(t, tt.expected, stmts)
+		})
+	}
+}
@@ -637,8 +637,15 @@ parse_toplevel:
 stmtmulti:
 			stmtmulti ';' toplevel_stmt
 			{
+				l, ok := yylex.(interface{ AddStatementSeparator(int); AddStatement() })
+				if ok {
+					l.AddStatementSeparator($<location>2)
+				}
 				if $3 != nil {
 					$$ = append($1, $3)
+					if ok {
+						l.AddStatement()
+					}
 				} else {
 					$$ = $1
 				}
@@ -647,6 +654,9 @@ stmtmulti:
 			{
 				if $1 != nil {
 					$$ = []ast.Stmt{$1}
+					if l, ok := yylex.(interface{ AddStatement() }); ok {
+						l.AddStatement()
+					}
 				} else {
 					$$ = []ast.Stmt{}
 				}
@@ -15635,3 +15645,18 @@ func ParseSQL(input string) ([]ast.Stmt, error) {
 
 	return lexer.GetParseTree(), nil
 }
+
+// ParseRawSQL parses SQL input like ParseSQL, and wraps each statement in a
+// RawStmt giving its location and length in input, without the separating
+// semicolons and surrounding whitespace. This lets a multi-statement query
+// string be split into the text of its statements.
+func ParseRawSQL(input string) ([]*ast.RawStmt, error) {
+	lexer := NewLexer(input)
+	yyParse(lexer)
+
+	if lexer.HasErrors() {
+		return nil, lexer.GetErrors()[0]
+	}
+
+	return lexer.GetRawStatements(input), nil
+}
@@ -380,7 +380,7 @@ func (l *Lexer) scanEscapeSequence() error {
 	default:
 		if ch >= '0' && ch <= '7' {
 			// Octal escape \nnn - postgres/src/backend/parser/scan.l:278
-			ctx.SetScanPos(ctx.ScanPos() - 1) // Back up to reprocess first octal digit
+			ctx.Rewind(ctx.ScanPos() - 1) // Back up to reprocess first octal digit
 			return l.scanOctalEscape()
 		} else {
 			// Literal character after backslash
@@ -552,7 +552,7 @@ func (l *Lexer) checkStringContinuation(tokenType TokenType, startPos, startScan
 
 		// If no newline was found, string concatenation is not allowed
 		if !hasNewline {
-			ctx.SetScanPos(savedPos)
+			ctx.Rewind(savedPos)
 			ctx.SetState(StateInitial)
 
 			// Set final literal and return
@@ -578,7 +578,7 @@ func (l *Lexer) checkStringContinuation(tokenType TokenType, startPos, startScan
 				ctx.AdvanceBy(1) // Skip '
 			} else {
 				// No continuation found
-				ctx.SetScanPos(savedPos)
+				ctx.Rewind(savedPos)
 				ctx.SetState(StateInitial)
 
 				// Set final literal and return
@@ -642,7 +642,7 @@ func (l *Lexer) checkStringContinuation(tokenType TokenType, startPos, startScan
 		}
 
 		// No continuation found - restore position and return final token
-		ctx.SetScanPos(savedPos)
+		ctx.Rewind(savedPos)
 		ctx.SetState(StateInitial)
 
 		// Set final literal and return
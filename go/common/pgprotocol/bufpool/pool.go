@@ -83,7 +83,12 @@ func (p *Pool) findPool(size int) *sizedPool {
 	if size > p.maxSize {
 		return nil
 	}
+	return p.pools[p.findIndex(size)]
+}
 
+// findIndex returns the index of the smallest bucket that can hold size
+// bytes. size must not exceed maxSize.
+func (p *Pool) findIndex(size int) int {
 	// Calculate the bucket index based on size.
 	// We need to find the smallest bucket that can hold 'size' bytes.
 	div, rem := bits.Div64(0, uint64(size), uint64(p.minSize))
@@ -99,7 +104,7 @@ func (p *Pool) findPool(size int) *sizedPool {
 		idx = len(p.pools) - 1
 	}
 
-	return p.pools[idx]
+	return idx
 }
 
 // Get returns a pointer to a byte slice with at least 'size' bytes.
@@ -121,18 +126,26 @@ func (p *Pool) Get(size int) *[]byte {
 }
 
 // Put returns a buffer to the pool for reuse.
-// The buffer's capacity determines which pool bucket it goes to.
+// The buffer goes to the largest bucket whose size does not exceed its
+// capacity, so buffers that were grown by append can be recycled too.
 //
-// If the buffer's capacity doesn't match any pool bucket, it's discarded.
+// Buffers smaller than minSize or larger than maxSize are discarded, so a
+// single huge message cannot pin a large buffer in the pool.
 // After calling Put(), the caller should not use the buffer anymore.
 func (p *Pool) Put(buf *[]byte) {
-	sp := p.findPool(cap(*buf))
-	if sp == nil {
+	size := cap(*buf)
+	if size < p.minSize || size > p.maxSize {
 		// Buffer too large or too small, discard it.
 		return
 	}
 
+	// findPool rounds up; step down to a bucket the buffer can serve.
+	idx := p.findIndex(size)
+	if p.pools[idx].size > size {
+		idx--
+	}
+
 	// Reset length to capacity before returning to pool.
-	*buf = (*buf)[:cap(*buf)]
-	sp.pool.Put(buf)
+	*buf = (*buf)[:size]
+	p.pools[idx].pool.Put(buf)
 }
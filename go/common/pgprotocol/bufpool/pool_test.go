@@ -191,6 +191,22 @@ func TestPoolPutUndersizedBuffer(t *testing.T) {
 	}, "putting undersized buffer should not panic")
 }
 
+func TestPoolPutGrownBuffer(t *testing.T) {
+	pool := New(1024, 16384)
+
+	// A buffer whose capacity falls between buckets must only be handed out
+	// for sizes it can hold. Every Get must be able to use the full length.
+	for _, size := range []int{1024, 1500, 3000, 5000, 16000} {
+		grown := make([]byte, size)
+		pool.Put(&grown)
+	}
+	for _, size := range []int{1024, 2048, 4096, 8192, 16384} {
+		buf := pool.Get(size)
+		require.Len(t, *buf, size)
+		assert.GreaterOrEqual(t, cap(*buf), size)
+	}
+}
+
 func BenchmarkPoolGet(b *testing.B) {
 	pool := New(1024, 16384)
 
@@ -0,0 +1,88 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// Cancel asks the server to cancel the query currently running on this
+// connection. Following the protocol, it opens a new connection to the same
+// server and sends a CancelRequest carrying the backend key data received
+// during startup.
+//
+// Cancel is safe to call concurrently with a query running on c. As in
+// PostgreSQL, a successful return only means the request was delivered;
+// the server may not cancel anything if the query has already finished.
+func (c *Conn) Cancel(ctx context.Context) error {
+	if c.processID == 0 && c.secretKey == 0 {
+		return errors.New("cannot cancel: no backend key data received from server")
+	}
+
+	netConn, err := dial(ctx, c.config)
+	if err != nil {
+		return fmt.Errorf("cancel: %w", err)
+	}
+	defer netConn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = netConn.SetDeadline(deadline)
+	}
+
+	cancelConn := &Conn{
+		conn:           netConn,
+		bufferedReader: bufio.NewReaderSize(netConn, 64),
+		bufferedWriter: bufio.NewWriterSize(netConn, 64),
+		config:         c.config,
+	}
+
+	// The cancel connection is encrypted the same way as the main one.
+	if mode := c.sslMode; mode != SSLModeDisable && mode != "" {
+		if err := cancelConn.negotiateSSL(ctx, mode); err != nil {
+			return fmt.Errorf("cancel: SSL negotiation failed: %w", err)
+		}
+	}
+
+	if err := cancelConn.writeCancelRequest(c.processID, c.secretKey); err != nil {
+		return fmt.Errorf("cancel: failed to send cancel request: %w", err)
+	}
+
+	// The server closes the connection without replying. Wait for that so
+	// the request is known to have been processed before returning; like
+	// libpq, the outcome of the read is ignored.
+	_, _ = cancelConn.bufferedReader.ReadByte()
+	return nil
+}
+
+// writeCancelRequest writes a CancelRequest message and flushes it.
+func (c *Conn) writeCancelRequest(processID, secretKey uint32) error {
+	// CancelRequest message format:
+	// - Length (4 bytes): 16
+	// - CancelRequestCode (4 bytes)
+	// - Process ID (4 bytes)
+	// - Secret key (4 bytes)
+
+	c.traceSend(0, 12)
+	for _, v := range []uint32{16, protocol.CancelRequestCode, processID, secretKey} {
+		if err := c.writeUint32(v); err != nil {
+			return err
+		}
+	}
+	return c.flush()
+}
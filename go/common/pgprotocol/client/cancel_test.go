@@ -0,0 +1,72 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+func TestCancel(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 16)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		received <- buf
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	c := &Conn{
+		config:    &Config{Host: "127.0.0.1", Port: addr.Port},
+		processID: 4242,
+		secretKey: 0xdeadbeef,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, c.Cancel(ctx))
+
+	buf := <-received
+	assert.Equal(t, uint32(16), binary.BigEndian.Uint32(buf[0:4]))
+	assert.Equal(t, uint32(protocol.CancelRequestCode), binary.BigEndian.Uint32(buf[4:8]))
+	assert.Equal(t, uint32(4242), binary.BigEndian.Uint32(buf[8:12]))
+	assert.Equal(t, uint32(0xdeadbeef), binary.BigEndian.Uint32(buf[12:16]))
+}
+
+func TestCancelWithoutBackendKeyData(t *testing.T) {
+	c := &Conn{config: &Config{Host: "127.0.0.1", Port: 1}}
+	err := c.Cancel(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backend key data")
+}
@@ -64,12 +64,68 @@ type Config struct {
 	// Parameters are additional connection parameters.
 	Parameters map[string]string
 
-	// TLSConfig is the TLS configuration for SSL connections.
-	// Only used for TCP connections. If nil, SSL is not used.
+	// TLSConfig is the base TLS configuration for SSL connections.
+	// Only used for TCP connections. If SSLMode is empty, a non-nil
+	// TLSConfig requires TLS, verifying the server certificate as it is
+	// configured, and a nil one behaves like SSLModeDisable.
 	TLSConfig *tls.Config
 
+	// SSLMode selects whether TLS is used and how the server certificate is
+	// verified, with libpq sslmode semantics.
+	SSLMode SSLMode
+
+	// SSLRootCert is the path to a PEM file of trusted CA certificates used
+	// to verify the server. If empty, the system roots are used.
+	SSLRootCert string
+
+	// SSLCert and SSLKey are paths to the PEM client certificate and private
+	// key presented to the server. They must be set together.
+	SSLCert string
+	SSLKey  string
+
 	// DialTimeout is the timeout for establishing the connection.
 	DialTimeout time.Duration
+
+	// KeepAlive is the interval between TCP keepalive probes on the
+	// connection. Zero uses the Go default (15s); negative disables TCP
+	// keepalives.
+	KeepAlive time.Duration
+
+	// IdleProbeInterval, if positive, starts a background goroutine that
+	// pings the server at this interval while the connection is not in use
+	// and closes the connection if a ping fails. See Ping and IsAlive.
+	IdleProbeInterval time.Duration
+
+	// MessageTimeout, if positive, bounds how long the connection waits
+	// for each message from the server and for each write to complete.
+	// A connection that times out is closed and ErrMessageTimeout is
+	// returned. Context deadlines apply independently.
+	MessageTimeout time.Duration
+
+	// MessageTracer, if set, is told about every protocol message sent and
+	// received, including those of Cancel. See protocol.NewLogTracer for a
+	// tracer that logs the wire traffic.
+	MessageTracer protocol.MessageTracer
+
+	// AllowMD5 permits MD5 password authentication when the server requests
+	// it. MD5 is rejected by default because it is vulnerable to replay and
+	// pass-the-hash attacks; only enable it for servers that cannot use
+	// SCRAM-SHA-256 (e.g. some managed PostgreSQL offerings).
+	AllowMD5 bool
+
+	// AllowCleartext permits cleartext password authentication when the
+	// server requests it. The password is sent unencrypted unless the
+	// connection uses TLS, so this is rejected by default.
+	AllowCleartext bool
+
+	// NewGSSProvider, if set, creates the GSSAPI mechanism used when the
+	// server requests Kerberos authentication (pg_hba.conf methods gss and
+	// sspi). If nil, such requests fail with ErrGSSNotConfigured.
+	NewGSSProvider func() (GSSProvider, error)
+
+	// KerberosServiceName is the Kerberos service name of the server, used
+	// with Host to form its service principal. Defaults to "postgres".
+	KerberosServiceName string
 }
 
 // Conn represents a client connection to a PostgreSQL server.
@@ -90,6 +146,10 @@ type Conn struct {
 	// config is the connection configuration.
 	config *Config
 
+	// sslMode is the SSL mode the connection was established with:
+	// SSLModeDisable after falling back to plaintext from SSLModePrefer.
+	sslMode SSLMode
+
 	// Backend key data received from the server.
 	processID uint32
 	secretKey uint32
@@ -100,6 +160,31 @@ type Conn struct {
 	// txnStatus is the current transaction status.
 	txnStatus byte
 
+	// gss is the GSSAPI mechanism of an in-progress Kerberos
+	// authentication, and gssDone whether its context is established.
+	gss     GSSProvider
+	gssDone bool
+
+	// notificationHandler receives LISTEN/NOTIFY notifications.
+	// Protected by bufmu.
+	notificationHandler NotificationHandler
+
+	// opCtx is the context of the operation holding bufmu, if any. It
+	// bounds and interrupts I/O (see lockContext). idleWait disables
+	// Config.MessageTimeout while waiting for notifications on an idle
+	// connection. readDeadlineSet and writeDeadlineSet track whether a
+	// deadline is currently applied to conn, to avoid resetting it on every
+	// message. All protected by deadlineMu.
+	deadlineMu       sync.Mutex
+	opCtx            context.Context
+	idleWait         bool
+	readDeadlineSet  bool
+	writeDeadlineSet bool
+
+	// readingType is the type of the message being read, remembered for
+	// tracing once its length is known. Protected by bufmu.
+	readingType byte
+
 	// state stores connection-specific information.
 	// Callers can store their own state here by calling SetConnectionState.
 	state any
@@ -116,26 +201,25 @@ type Conn struct {
 // If config.SocketFile is set, connects via Unix socket.
 // Otherwise, connects via TCP to config.Host:config.Port.
 func Connect(ctx context.Context, config *Config) (*Conn, error) {
-	dialer := &net.Dialer{
-		Timeout: config.DialTimeout,
+	if _, err := ParseSSLMode(string(config.SSLMode)); err != nil {
+		return nil, err
 	}
+	mode := config.effectiveSSLMode()
+	c, err := connect(ctx, config, mode)
+	if err != nil && mode == SSLModePrefer && errors.Is(err, errTLSHandshake) {
+		// Like libpq, retry in plaintext on a new connection: the failed
+		// handshake left the first one unusable.
+		return connect(ctx, config, SSLModeDisable)
+	}
+	return c, err
+}
 
-	var netConn net.Conn
-	var err error
-
-	if config.SocketFile != "" {
-		// Unix socket connection.
-		netConn, err = dialer.DialContext(ctx, "unix", config.SocketFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to connect to Unix socket %s: %w", config.SocketFile, err)
-		}
-	} else {
-		// TCP connection.
-		address := fmt.Sprintf("%s:%d", config.Host, config.Port)
-		netConn, err = dialer.DialContext(ctx, "tcp", address)
-		if err != nil {
-			return nil, fmt.Errorf("failed to connect to %s: %w", address, err)
-		}
+// connect establishes a new connection to a PostgreSQL server, using TLS as
+// mode says.
+func connect(ctx context.Context, config *Config, mode SSLMode) (*Conn, error) {
+	netConn, err := dial(ctx, config)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create the connection object.
@@ -143,23 +227,60 @@ func Connect(ctx context.Context, config *Config) (*Conn, error) {
 	c := &Conn{
 		conn:           netConn,
 		bufferedReader: bufio.NewReaderSize(netConn, connBufferSize),
-		bufferedWriter: bufio.NewWriterSize(netConn, connBufferSize),
 		config:         config,
 		serverParams:   make(map[string]string),
 		txnStatus:      protocol.TxnStatusIdle,
 		ctx:            connCtx,
 		cancel:         cancel,
 	}
+	c.bufferedWriter = bufio.NewWriterSize(deadlineWriter{c}, connBufferSize)
 
-	// Perform the startup handshake.
-	if err := c.startup(ctx); err != nil {
+	// Perform the startup handshake, bounded by ctx.
+	stop, err := c.watchContext(ctx)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	err = c.startup(ctx, mode)
+	stop()
+	if err != nil {
 		c.Close()
 		return nil, fmt.Errorf("startup failed: %w", err)
 	}
 
+	if config.IdleProbeInterval > 0 {
+		go c.probeIdle(config.IdleProbeInterval)
+	}
+
 	return c, nil
 }
 
+// dial opens the network connection described by config: a Unix socket if
+// config.SocketFile is set, TCP to config.Host:config.Port otherwise.
+func dial(ctx context.Context, config *Config) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout:   config.DialTimeout,
+		KeepAlive: config.KeepAlive,
+	}
+
+	if config.SocketFile != "" {
+		// Unix socket connection.
+		netConn, err := dialer.DialContext(ctx, "unix", config.SocketFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to Unix socket %s: %w", config.SocketFile, err)
+		}
+		return netConn, nil
+	}
+
+	// TCP connection.
+	address := fmt.Sprintf("%s:%d", config.Host, config.Port)
+	netConn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	return netConn, nil
+}
+
 // Close closes the connection.
 func (c *Conn) Close() error {
 	if !c.closed.CompareAndSwap(false, true) {
@@ -243,6 +364,9 @@ func (c *Conn) SetConnectionState(state any) {
 
 // flush flushes any buffered writes.
 func (c *Conn) flush() error {
+	if err := c.armDeadline(false); err != nil {
+		return c.ioError(err)
+	}
 	return c.bufferedWriter.Flush()
 }
 
@@ -255,6 +379,7 @@ func (c *Conn) WriteCopyData(data []byte) error {
 
 	// Write message: 'd' + length (4 bytes) + data
 	msgLen := 4 + len(data)
+	c.traceSend(protocol.MsgCopyData, len(data))
 	if err := c.bufferedWriter.WriteByte(protocol.MsgCopyData); err != nil {
 		return fmt.Errorf("failed to write CopyData type: %w", err)
 	}
@@ -274,6 +399,7 @@ func (c *Conn) WriteCopyDone() error {
 	c.bufmu.Lock()
 	defer c.bufmu.Unlock()
 
+	c.traceSend(protocol.MsgCopyDone, 0)
 	if err := c.bufferedWriter.WriteByte(protocol.MsgCopyDone); err != nil {
 		return fmt.Errorf("failed to write CopyDone type: %w", err)
 	}
@@ -290,8 +416,14 @@ func (c *Conn) WriteCopyFail(errorMsg string) error {
 	c.bufmu.Lock()
 	defer c.bufmu.Unlock()
 
+	return c.writeCopyFailNoLock(errorMsg)
+}
+
+// writeCopyFailNoLock is WriteCopyFail for callers that hold bufmu.
+func (c *Conn) writeCopyFailNoLock(errorMsg string) error {
 	msgBytes := append([]byte(errorMsg), 0) // Null-terminated
 	msgLen := 4 + len(msgBytes)
+	c.traceSend(protocol.MsgCopyFail, len(msgBytes))
 
 	if err := c.bufferedWriter.WriteByte(protocol.MsgCopyFail); err != nil {
 		return fmt.Errorf("failed to write CopyFail type: %w", err)
@@ -311,28 +443,22 @@ func (c *Conn) WriteCopyFail(errorMsg string) error {
 // Note: In simple query protocol, PostgreSQL sends CommandComplete followed by ReadyForQuery
 // We need to consume both messages to clear the buffer
 func (c *Conn) ReadCopyDoneResponse(ctx context.Context) (string, uint64, error) {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	defer unlock()
 
 	var commandTag string
 	var rowsAffected uint64
 	gotCommandComplete := false
+	var firstErr error
 
 	// Read messages until we get both CommandComplete and ReadyForQuery
 	for {
-		msgType, err := c.readMessageType()
-		if err != nil {
-			return "", 0, fmt.Errorf("failed to read message type: %w", err)
-		}
-
-		length, err := c.readMessageLength()
-		if err != nil {
-			return "", 0, fmt.Errorf("failed to read message length: %w", err)
-		}
-
-		body, err := c.readMessageBody(length)
+		msgType, body, err := c.readMessage()
 		if err != nil {
-			return "", 0, fmt.Errorf("failed to read message body: %w", err)
+			return "", 0, fmt.Errorf("failed to read message: %w", err)
 		}
 
 		switch msgType {
@@ -359,14 +485,24 @@ func (c *Conn) ReadCopyDoneResponse(ctx context.Context) (string, uint64, error)
 			// Continue reading to get ReadyForQuery
 
 		case protocol.MsgErrorResponse:
-			// Parse error message
-			return "", 0, c.parseError(body)
+			// Capture the error but keep reading until ReadyForQuery so the
+			// connection is left in a clean state.
+			if firstErr == nil {
+				firstErr = c.parseError(body)
+			}
 
 		case protocol.MsgNoticeResponse:
 			// Ignore notices
 			continue
 
+		case protocol.MsgParameterStatus:
+			_ = c.handleParameterStatus(body)
+
 		case protocol.MsgReadyForQuery:
+			c.txnStatus = body[0]
+			if firstErr != nil {
+				return "", 0, firstErr
+			}
 			// End of response - if we got CommandComplete, return success
 			if gotCommandComplete {
 				return commandTag, rowsAffected, nil
@@ -433,11 +569,15 @@ func (c *Conn) ReadCopyInResponse() (format int16, columnFormats []int16, err er
 // This is a special operation that doesn't follow the normal query flow.
 // Returns the COPY format and column formats from the CopyInResponse.
 func (c *Conn) InitiateCopyFromStdin(ctx context.Context, copyQuery string) (format int16, columnFormats []int16, err error) {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer unlock()
 
 	// Send the COPY query
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteString(copyQuery)
 	if err := c.writeMessage(protocol.MsgQuery, w.Bytes()); err != nil {
 		return 0, nil, fmt.Errorf("failed to send COPY query: %w", err)
@@ -446,20 +586,11 @@ func (c *Conn) InitiateCopyFromStdin(ctx context.Context, copyQuery string) (for
 	// Loop through messages until we get CopyInResponse or an error
 	// We need to skip NoticeResponse and ParameterStatus messages
 	// This is similar to processQueryResponses but simplified for COPY initiation
+	var queryErr error
 	for {
-		msgType, err := c.readMessageType()
+		msgType, body, err := c.readMessage()
 		if err != nil {
-			return 0, nil, fmt.Errorf("failed to read message type: %w", err)
-		}
-
-		bodyLen, err := c.readMessageLength()
-		if err != nil {
-			return 0, nil, fmt.Errorf("failed to read message length: %w", err)
-		}
-
-		body, err := c.readMessageBody(bodyLen)
-		if err != nil {
-			return 0, nil, fmt.Errorf("failed to read message body: %w", err)
+			return 0, nil, fmt.Errorf("failed to read message: %w", err)
 		}
 
 		switch msgType {
@@ -490,8 +621,11 @@ func (c *Conn) InitiateCopyFromStdin(ctx context.Context, copyQuery string) (for
 			return format, columnFormats, nil
 
 		case protocol.MsgErrorResponse:
-			// Parse and return the error
-			return 0, nil, c.parseError(body)
+			// Capture the error but keep reading until ReadyForQuery so the
+			// connection is left in a clean state.
+			if queryErr == nil {
+				queryErr = c.parseError(body)
+			}
 
 		case protocol.MsgNoticeResponse:
 			// Skip notices (PostgreSQL might send notices before CopyInResponse)
@@ -503,6 +637,10 @@ func (c *Conn) InitiateCopyFromStdin(ctx context.Context, copyQuery string) (for
 			continue
 
 		case protocol.MsgReadyForQuery:
+			c.txnStatus = body[0]
+			if queryErr != nil {
+				return 0, nil, queryErr
+			}
 			// If we get ReadyForQuery before CopyInResponse, the query failed
 			// but we didn't get an ErrorResponse (which shouldn't happen)
 			return 0, nil, errors.New("received ReadyForQuery before CopyInResponse - query may have failed without error")
@@ -0,0 +1,222 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// copyChunkSize is the maximum amount of data sent in a single CopyData
+// message by CopyFrom.
+const copyChunkSize = 64 * 1024
+
+// CopyFrom executes a COPY ... FROM STDIN statement, streaming the data read
+// from r to the server until r returns io.EOF.
+//
+// If reading from r fails, the COPY is aborted with CopyFail and the read
+// error is returned. If ctx is done, the connection is closed, as with any
+// other interrupted operation. The returned result carries the
+// command tag (e.g. "COPY 100") and the number of rows copied.
+func (c *Conn) CopyFrom(ctx context.Context, copyQuery string, r io.Reader) (*sqltypes.Result, error) {
+	if _, _, err := c.InitiateCopyFromStdin(ctx, copyQuery); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, copyChunkSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			c.closeBroken()
+			return nil, err
+		}
+
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			if err := c.WriteCopyData(buf[:n]); err != nil {
+				return nil, fmt.Errorf("failed to send COPY data: %w", err)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, c.abortCopyFrom(ctx, readErr)
+		}
+	}
+
+	if err := c.WriteCopyDone(); err != nil {
+		return nil, fmt.Errorf("failed to send CopyDone: %w", err)
+	}
+	commandTag, rowsAffected, err := c.ReadCopyDoneResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{CommandTag: commandTag, RowsAffected: rowsAffected}, nil
+}
+
+// abortCopyFrom sends CopyFail for an in-progress COPY FROM STDIN and waits
+// for the server to acknowledge it, leaving the connection ready for the
+// next query. cause is returned so callers see why the COPY was aborted.
+func (c *Conn) abortCopyFrom(ctx context.Context, cause error) error {
+	if err := c.WriteCopyFail(cause.Error()); err != nil {
+		return fmt.Errorf("failed to abort COPY after %w: %w", cause, err)
+	}
+	// The server answers CopyFail with an ErrorResponse; that error is
+	// expected and cause is more useful to the caller.
+	if _, _, err := c.ReadCopyDoneResponse(ctx); err != nil {
+		var pgErr *Error
+		if !errors.As(err, &pgErr) {
+			return fmt.Errorf("failed to abort COPY after %w: %w", cause, err)
+		}
+	}
+	return cause
+}
+
+// CopyTo executes a COPY ... TO STDOUT statement and writes the data the
+// server sends to w.
+//
+// If writing to w fails, the remaining data is drained so the connection
+// stays usable and the write error is returned. The returned result carries
+// the command tag (e.g. "COPY 100") and the number of rows copied.
+func (c *Conn) CopyTo(ctx context.Context, copyQuery string, w io.Writer) (*sqltypes.Result, error) {
+	return c.CopyOut(ctx, copyQuery, nil, func(data []byte) error {
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("failed to write COPY data: %w", err)
+		}
+		return nil
+	})
+}
+
+// CopyOut executes a COPY ... TO STDOUT statement. onResponse, if not nil, is
+// called with the overall and per-column formats of the CopyOutResponse
+// before any data; onData is then called with each CopyData chunk. The chunk
+// is only valid until onData returns.
+//
+// If a callback fails, the remaining data is drained so the connection stays
+// usable and the callback error is returned. The returned result carries the
+// command tag (e.g. "COPY 100") and the number of rows copied.
+func (c *Conn) CopyOut(
+	ctx context.Context,
+	copyQuery string,
+	onResponse func(format int16, columnFormats []int16) error,
+	onData func(data []byte) error,
+) (*sqltypes.Result, error) {
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := c.writeQueryMessage(copyQuery); err != nil {
+		return nil, fmt.Errorf("failed to send COPY query: %w", err)
+	}
+
+	result := &sqltypes.Result{}
+	gotCopyOut := false
+	var firstErr error
+	for {
+		msgType, body, err := c.readMessage()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read message: %w", err)
+		}
+
+		switch msgType {
+		case protocol.MsgCopyOutResponse:
+			gotCopyOut = true
+			if onResponse != nil && firstErr == nil {
+				format, columnFormats, err := parseCopyResponse(body)
+				if err == nil {
+					err = onResponse(format, columnFormats)
+				}
+				firstErr = err
+			}
+
+		case protocol.MsgCopyData:
+			if firstErr == nil {
+				firstErr = onData(body)
+			}
+
+		case protocol.MsgCopyDone:
+			// Data stream finished; CommandComplete follows.
+
+		case protocol.MsgCommandComplete:
+			tag, err := c.parseCommandComplete(body)
+			if err != nil {
+				return nil, err
+			}
+			result.CommandTag = tag
+			result.RowsAffected = parseRowsAffected(tag)
+
+		case protocol.MsgErrorResponse:
+			if firstErr == nil {
+				firstErr = c.parseError(body)
+			}
+
+		case protocol.MsgNoticeResponse:
+			// Ignore notices.
+
+		case protocol.MsgParameterStatus:
+			_ = c.handleParameterStatus(body)
+
+		case protocol.MsgCopyInResponse:
+			// The statement was COPY FROM STDIN; abort it.
+			if err := c.writeCopyFailNoLock("COPY FROM STDIN is not supported by CopyOut; use CopyFrom"); err != nil {
+				return nil, fmt.Errorf("failed to abort COPY: %w", err)
+			}
+
+		case protocol.MsgReadyForQuery:
+			if err := c.handleReadyForQuery(body); err != nil {
+				return nil, err
+			}
+			if firstErr != nil {
+				return nil, firstErr
+			}
+			if !gotCopyOut {
+				return nil, errors.New("statement did not start COPY TO STDOUT")
+			}
+			return result, nil
+
+		default:
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unexpected message type during COPY: %c (0x%02x)", msgType, msgType)
+			}
+		}
+	}
+}
+
+// parseCopyResponse parses the body of a CopyInResponse or CopyOutResponse:
+// an Int8 overall format, an Int16 column count and an Int16 format per
+// column.
+func parseCopyResponse(body []byte) (format int16, columnFormats []int16, err error) {
+	if len(body) < 3 {
+		return 0, nil, fmt.Errorf("COPY response body too short: %d bytes", len(body))
+	}
+	format = int16(body[0])
+	numCols := int(binary.BigEndian.Uint16(body[1:3]))
+	if len(body) < 3+2*numCols {
+		return 0, nil, errors.New("COPY response body too short for column formats")
+	}
+	columnFormats = make([]int16, numCols)
+	for i := range columnFormats {
+		columnFormats[i] = int16(binary.BigEndian.Uint16(body[3+2*i:]))
+	}
+	return format, columnFormats, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// copyResponseBody builds a CopyInResponse/CopyOutResponse body for a
+// text-format COPY with no per-column formats.
+func copyResponseBody() []byte {
+	return []byte{0, 0, 0}
+}
+
+func copyErrorBody(code, message string) []byte {
+	w := NewMessageWriter()
+	w.WriteByte(protocol.FieldSeverity)
+	w.WriteString("ERROR")
+	w.WriteByte(protocol.FieldCode)
+	w.WriteString(code)
+	w.WriteByte(protocol.FieldMessage)
+	w.WriteString(message)
+	w.WriteByte(0)
+	return w.Bytes()
+}
+
+func TestCopyFrom(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCopyInResponse, copyResponseBody())
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("COPY 2"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, frontend := newScriptedConn(&backend)
+	result, err := conn.CopyFrom(context.Background(), "COPY t FROM STDIN", strings.NewReader("1\tone\n2\ttwo\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "COPY 2", result.CommandTag)
+	assert.Equal(t, uint64(2), result.RowsAffected)
+
+	msgType, body := readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgQuery), msgType)
+	assert.Equal(t, "COPY t FROM STDIN\x00", string(body))
+
+	msgType, body = readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgCopyData), msgType)
+	assert.Equal(t, "1\tone\n2\ttwo\n", string(body))
+
+	msgType, _ = readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgCopyDone), msgType)
+	assert.Zero(t, frontend.Len())
+}
+
+func TestCopyFromReaderError(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCopyInResponse, copyResponseBody())
+	writeBackendMessage(&backend, protocol.MsgErrorResponse, copyErrorBody("57014", "COPY from stdin failed: disk gone"))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, frontend := newScriptedConn(&backend)
+	readErr := errors.New("disk gone")
+	r := io.MultiReader(strings.NewReader("1\tone\n"), iotest.ErrReader(readErr))
+	_, err := conn.CopyFrom(context.Background(), "COPY t FROM STDIN", r)
+	require.ErrorIs(t, err, readErr)
+	assert.Zero(t, backend.Len(), "ReadyForQuery should be consumed")
+
+	readWrittenMessage(t, frontend) // Query
+	msgType, _ := readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgCopyData), msgType)
+	msgType, body := readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgCopyFail), msgType)
+	assert.Equal(t, "disk gone\x00", string(body))
+}
+
+func TestCopyFromServerError(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgErrorResponse, copyErrorBody("42P01", `relation "t" does not exist`))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	_, err := conn.CopyFrom(context.Background(), "COPY t FROM STDIN", strings.NewReader(""))
+	var pgErr *Error
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "42P01", pgErr.Code)
+	assert.Zero(t, backend.Len(), "ReadyForQuery should be consumed")
+}
+
+func TestCopyTo(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCopyOutResponse, copyResponseBody())
+	writeBackendMessage(&backend, protocol.MsgCopyData, []byte("1\tone\n"))
+	writeBackendMessage(&backend, protocol.MsgCopyData, []byte("2\ttwo\n"))
+	writeBackendMessage(&backend, protocol.MsgCopyDone, nil)
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("COPY 2"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	var out bytes.Buffer
+	result, err := conn.CopyTo(context.Background(), "COPY t TO STDOUT", &out)
+	require.NoError(t, err)
+	assert.Equal(t, "1\tone\n2\ttwo\n", out.String())
+	assert.Equal(t, "COPY 2", result.CommandTag)
+	assert.Equal(t, uint64(2), result.RowsAffected)
+}
+
+func TestCopyToWriterErrorDrains(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCopyOutResponse, copyResponseBody())
+	writeBackendMessage(&backend, protocol.MsgCopyData, []byte("1\tone\n"))
+	writeBackendMessage(&backend, protocol.MsgCopyData, []byte("2\ttwo\n"))
+	writeBackendMessage(&backend, protocol.MsgCopyDone, nil)
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("COPY 2"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	writeErr := errors.New("pipe closed")
+	_, err := conn.CopyTo(context.Background(), "COPY t TO STDOUT", failingWriter{writeErr})
+	require.ErrorIs(t, err, writeErr)
+	assert.Zero(t, backend.Len(), "remaining messages should be drained")
+}
+
+func TestCopyOutReportsFormats(t *testing.T) {
+	var backend bytes.Buffer
+	// Binary COPY of two columns.
+	writeBackendMessage(&backend, protocol.MsgCopyOutResponse, []byte{1, 0, 2, 0, 1, 0, 1})
+	writeBackendMessage(&backend, protocol.MsgCopyData, []byte("PGCOPY"))
+	writeBackendMessage(&backend, protocol.MsgCopyDone, nil)
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("COPY 0"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	var gotFormat int16
+	var gotColumnFormats []int16
+	var chunks []string
+	result, err := conn.CopyOut(context.Background(), "COPY t TO STDOUT (FORMAT binary)",
+		func(format int16, columnFormats []int16) error {
+			gotFormat, gotColumnFormats = format, columnFormats
+			return nil
+		},
+		func(data []byte) error {
+			chunks = append(chunks, string(data))
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, int16(1), gotFormat)
+	assert.Equal(t, []int16{1, 1}, gotColumnFormats)
+	assert.Equal(t, []string{"PGCOPY"}, chunks)
+	assert.Equal(t, "COPY 0", result.CommandTag)
+}
+
+func TestQueryAbortsCopyFromStdin(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCopyInResponse, copyResponseBody())
+	writeBackendMessage(&backend, protocol.MsgErrorResponse, copyErrorBody("57014", "COPY from stdin failed"))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, frontend := newScriptedConn(&backend)
+	_, err := conn.Query(context.Background(), "COPY t FROM STDIN")
+	require.Error(t, err)
+	assert.Zero(t, backend.Len(), "ReadyForQuery should be consumed")
+
+	readWrittenMessage(t, frontend) // Query
+	msgType, _ := readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgCopyFail), msgType)
+}
+
+func TestQueryRejectsCopyToStdout(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCopyOutResponse, copyResponseBody())
+	writeBackendMessage(&backend, protocol.MsgCopyData, []byte("1\n"))
+	writeBackendMessage(&backend, protocol.MsgCopyDone, nil)
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("COPY 1"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	_, err := conn.Query(context.Background(), "COPY t TO STDOUT")
+	require.ErrorContains(t, err, "use CopyTo")
+	assert.Zero(t, backend.Len(), "remaining messages should be drained")
+}
+
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
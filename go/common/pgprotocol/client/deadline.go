@@ -0,0 +1,209 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ErrMessageTimeout is returned when the server does not send or accept a
+// message within Config.MessageTimeout.
+var ErrMessageTimeout = errors.New("timed out waiting for server")
+
+// lockContext acquires bufmu and applies ctx to all I/O done until the
+// returned unlock function is called: the context's deadline bounds every
+// read and write, and cancelling it interrupts blocked I/O.
+//
+// An operation interrupted this way leaves the protocol stream in an
+// unknown state, so the connection is closed and ctx's error returned.
+func (c *Conn) lockContext(ctx context.Context) (unlock func(), err error) {
+	c.bufmu.Lock()
+	stop, err := c.watchContext(ctx)
+	if err != nil {
+		c.bufmu.Unlock()
+		return nil, err
+	}
+	return func() {
+		stop()
+		c.bufmu.Unlock()
+	}, nil
+}
+
+// watchContext makes ctx the context of the operation holding bufmu. The
+// returned function must be called when the operation completes. Callers
+// must hold bufmu.
+func (c *Conn) watchContext(ctx context.Context) (func(), error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c.deadlineMu.Lock()
+	c.opCtx = ctx
+	c.deadlineMu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		c.deadlineMu.Lock()
+		defer c.deadlineMu.Unlock()
+		if c.opCtx == ctx {
+			c.readDeadlineSet, c.writeDeadlineSet = true, true
+			_ = c.conn.SetDeadline(time.Now())
+		}
+	})
+	return func() {
+		stop()
+		c.deadlineMu.Lock()
+		defer c.deadlineMu.Unlock()
+		c.opCtx = nil
+		if c.readDeadlineSet || c.writeDeadlineSet {
+			c.readDeadlineSet, c.writeDeadlineSet = false, false
+			_ = c.conn.SetDeadline(time.Time{})
+		}
+	}, nil
+}
+
+// armDeadline sets the read or write deadline for the next message: the
+// earlier of the operation context's deadline and Config.MessageTimeout
+// from now. It fails if the operation context is already done.
+func (c *Conn) armDeadline(read bool) error {
+	if c.conn == nil {
+		return nil
+	}
+
+	c.deadlineMu.Lock()
+	defer c.deadlineMu.Unlock()
+
+	var deadline time.Time
+	if c.opCtx != nil {
+		if err := c.opCtx.Err(); err != nil {
+			return err
+		}
+		deadline, _ = c.opCtx.Deadline()
+	}
+	if timeout := c.messageTimeout(); timeout > 0 && !c.idleWait {
+		if d := time.Now().Add(timeout); deadline.IsZero() || d.Before(deadline) {
+			deadline = d
+		}
+	}
+
+	if read {
+		if deadline.IsZero() && !c.readDeadlineSet {
+			return nil
+		}
+		c.readDeadlineSet = !deadline.IsZero()
+		return c.conn.SetReadDeadline(deadline)
+	}
+	if deadline.IsZero() && !c.writeDeadlineSet {
+		return nil
+	}
+	c.writeDeadlineSet = !deadline.IsZero()
+	return c.conn.SetWriteDeadline(deadline)
+}
+
+// messageTimeout returns the configured per-message timeout.
+func (c *Conn) messageTimeout() time.Duration {
+	if c.config == nil {
+		return 0
+	}
+	return c.config.MessageTimeout
+}
+
+// ioError translates an I/O error caused by the operation context or the
+// message timeout into ctx.Err() or ErrMessageTimeout, closing the
+// connection since the protocol stream can no longer be trusted. Other
+// errors are returned unchanged.
+func (c *Conn) ioError(err error) error {
+	interrupted, cause := c.translateIOError(err)
+	if interrupted {
+		c.closeBroken()
+	}
+	return cause
+}
+
+// translateIOError is ioError without closing the connection. It reports
+// whether err was caused by the operation context or the message timeout.
+func (c *Conn) translateIOError(err error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+
+	c.deadlineMu.Lock()
+	ctx := c.opCtx
+	c.deadlineMu.Unlock()
+
+	switch {
+	case ctx != nil && ctx.Err() != nil:
+		return true, ctx.Err()
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		// Returned by armDeadline.
+		return true, err
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		// The socket deadline can fire just before ctx notices its own.
+		if ctx != nil {
+			if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+				return true, context.DeadlineExceeded
+			}
+		}
+		return true, fmt.Errorf("%w after %v", ErrMessageTimeout, c.messageTimeout())
+	}
+	return false, err
+}
+
+// setIdleWait marks the connection as waiting for unsolicited messages
+// rather than for a response (see WaitForNotification).
+func (c *Conn) setIdleWait(idle bool) {
+	c.deadlineMu.Lock()
+	defer c.deadlineMu.Unlock()
+	c.idleWait = idle
+}
+
+// isIdleWait reports whether the connection is waiting for unsolicited
+// messages.
+func (c *Conn) isIdleWait() bool {
+	c.deadlineMu.Lock()
+	defer c.deadlineMu.Unlock()
+	return c.idleWait
+}
+
+// closeBroken closes a connection whose protocol stream was interrupted.
+// It must not write to the connection, since the caller holds bufmu and a
+// Terminate message could block or interleave with a partial message.
+func (c *Conn) closeBroken() {
+	if !c.closed.CompareAndSwap(false, true) {
+		return
+	}
+	if c.cancel != nil {
+		c.cancel()
+	}
+	_ = c.conn.Close()
+}
+
+// deadlineWriter arms the write deadline before each write to the
+// underlying connection, so writes that bypass flush (large messages that
+// do not fit in the buffer) are bounded too.
+type deadlineWriter struct {
+	c *Conn
+}
+
+func (w deadlineWriter) Write(p []byte) (int, error) {
+	if err := w.c.armDeadline(false); err != nil {
+		return 0, w.c.ioError(err)
+	}
+	n, err := w.c.conn.Write(p)
+	return n, w.c.ioError(err)
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// readFrontendMessage reads one message sent by the client from the server
+// end of a pipe.
+func readFrontendMessage(t *testing.T, server net.Conn) byte {
+	t.Helper()
+	var header [5]byte
+	_, err := io.ReadFull(server, header[:])
+	require.NoError(t, err)
+	body := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+	_, err = io.ReadFull(server, body)
+	require.NoError(t, err)
+	return header[0]
+}
+
+// answerPings answers n empty queries on the server end of a pipe.
+func answerPings(t *testing.T, server net.Conn, n int) {
+	t.Helper()
+	var resp bytes.Buffer
+	writeBackendMessage(&resp, protocol.MsgEmptyQueryResponse, nil)
+	writeBackendMessage(&resp, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+	for range n {
+		assert.Equal(t, byte(protocol.MsgQuery), readFrontendMessage(t, server))
+		_, err := server.Write(resp.Bytes())
+		assert.NoError(t, err)
+	}
+}
+
+func TestQueryContextCancelClosesConn(t *testing.T) {
+	conn, server := newPipeConn(t)
+	// Read the query but never answer it.
+	go func() { _, _ = io.Copy(io.Discard, server) }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(30*time.Millisecond, cancel)
+
+	_, err := conn.Query(ctx, "SELECT pg_sleep(10)")
+	require.ErrorIs(t, err, context.Canceled)
+	assert.True(t, conn.IsClosed(), "an interrupted connection must not be reused")
+}
+
+func TestQueryContextDeadline(t *testing.T) {
+	conn, server := newPipeConn(t)
+	go func() { _, _ = io.Copy(io.Discard, server) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	_, err := conn.Query(ctx, "SELECT pg_sleep(10)")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, conn.IsClosed())
+}
+
+func TestQueryAlreadyCancelledContext(t *testing.T) {
+	conn, _ := newPipeConn(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := conn.Query(ctx, "SELECT 1")
+	require.ErrorIs(t, err, context.Canceled)
+	assert.False(t, conn.IsClosed(), "nothing was sent, so the connection is intact")
+}
+
+func TestMessageTimeout(t *testing.T) {
+	conn, server := newPipeConn(t)
+	conn.config = &Config{MessageTimeout: 30 * time.Millisecond}
+	go func() { _, _ = io.Copy(io.Discard, server) }()
+
+	_, err := conn.Query(context.Background(), "SELECT pg_sleep(10)")
+	require.ErrorIs(t, err, ErrMessageTimeout)
+	assert.True(t, conn.IsClosed())
+}
+
+func TestDeadlineClearedAfterOperation(t *testing.T) {
+	conn, server := newPipeConn(t)
+	go answerPings(t, server, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.NoError(t, conn.Ping(ctx))
+
+	// A later operation without a deadline must not inherit the old one.
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, conn.Ping(context.Background()))
+}
+
+func TestWaitForNotificationCancelKeepsConn(t *testing.T) {
+	conn, server := newPipeConn(t)
+	conn.config = &Config{MessageTimeout: 10 * time.Millisecond}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := conn.WaitForNotification(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "MessageTimeout does not apply while idle")
+	assert.False(t, conn.IsClosed())
+
+	go answerPings(t, server, 1)
+	require.NoError(t, conn.Ping(context.Background()))
+}
@@ -31,8 +31,11 @@ import (
 // queryStr is the SQL query.
 // paramTypes are the OIDs of parameter types (0 for unspecified).
 func (c *Conn) Parse(ctx context.Context, name, queryStr string, paramTypes []uint32) error {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if err := c.writeParse(name, queryStr, paramTypes); err != nil {
 		return fmt.Errorf("failed to write Parse: %w", err)
@@ -61,8 +64,11 @@ func (c *Conn) Parse(ctx context.Context, name, queryStr string, paramTypes []ui
 // maxRows is the maximum number of rows to return (0 for unlimited).
 // Returns true if the execution completed (CommandComplete), false if suspended (PortalSuspended).
 func (c *Conn) BindAndExecute(ctx context.Context, stmtName string, params [][]byte, paramFormats, resultFormats []int16, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) (completed bool, err error) {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
 
 	// Use the same name for portal as the statement for consistency.
 	if err := c.writeBind(stmtName, stmtName, params, paramFormats, resultFormats); err != nil {
@@ -92,8 +98,11 @@ func (c *Conn) BindAndExecute(ctx context.Context, stmtName string, params [][]b
 // paramFormats are format codes for parameters (0=text, 1=binary).
 // resultFormats are format codes for result columns (0=text, 1=binary).
 func (c *Conn) BindAndDescribe(ctx context.Context, stmtName string, params [][]byte, paramFormats, resultFormats []int16) (*query.StatementDescription, error) {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
 
 	// Use the same name for portal as the statement for consistency.
 	if err := c.writeBind(stmtName, stmtName, params, paramFormats, resultFormats); err != nil {
@@ -120,8 +129,11 @@ func (c *Conn) BindAndDescribe(ctx context.Context, stmtName string, params [][]
 // This sends Describe('S') → Sync.
 // name is the prepared statement name (empty for unnamed statement).
 func (c *Conn) DescribePrepared(ctx context.Context, name string) (*query.StatementDescription, error) {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
 
 	if err := c.writeDescribe('S', name); err != nil {
 		return nil, fmt.Errorf("failed to write Describe: %w", err)
@@ -151,8 +163,11 @@ func (c *Conn) ClosePortal(ctx context.Context, name string) error {
 
 // closeTarget sends a Close message for a statement or portal.
 func (c *Conn) closeTarget(ctx context.Context, typ byte, name string) error {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if err := c.writeClose(typ, name); err != nil {
 		return fmt.Errorf("failed to write Close: %w", err)
@@ -173,8 +188,11 @@ func (c *Conn) closeTarget(ctx context.Context, typ byte, name string) error {
 
 // Sync sends a Sync message to synchronize the extended query protocol.
 func (c *Conn) Sync(ctx context.Context) error {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if err := c.writeSync(); err != nil {
 		return fmt.Errorf("failed to write Sync: %w", err)
@@ -190,8 +208,11 @@ func (c *Conn) Sync(ctx context.Context) error {
 
 // Flush sends a Flush message to request the server to flush its output buffer.
 func (c *Conn) Flush(ctx context.Context) error {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if err := c.writeFlush(); err != nil {
 		return fmt.Errorf("failed to write Flush: %w", err)
@@ -205,8 +226,11 @@ func (c *Conn) Flush(ctx context.Context) error {
 // name is the statement/portal name (use "" for unnamed, which is cleared after Sync).
 // A named statement persists until explicitly closed or the session ends.
 func (c *Conn) PrepareAndExecute(ctx context.Context, name, queryStr string, params [][]byte, callback func(ctx context.Context, result *sqltypes.Result) error) error {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// Write all messages without flushing.
 	if err := c.writeParse(name, queryStr, nil); err != nil {
@@ -288,8 +312,11 @@ func (c *Conn) QueryArgs(ctx context.Context, queryStr string, args ...any) ([]*
 // maxRows is the maximum number of rows to return (0 for unlimited).
 // Returns true if the portal completed (CommandComplete), false if suspended (PortalSuspended).
 func (c *Conn) Execute(ctx context.Context, portalName string, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) (completed bool, err error) {
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
 
 	if err := c.writeExecute(portalName, maxRows); err != nil {
 		return false, fmt.Errorf("failed to write Execute: %w", err)
@@ -370,9 +397,9 @@ func (c *Conn) processExecuteResponses(ctx context.Context, callback func(ctx co
 	var notices []*sqltypes.Notice
 	var firstErr error
 
-	// flushBatch sends accumulated rows via callback and resets the batch.
+	// flushBatch sends accumulated rows and notices via callback and resets the batch.
 	flushBatch := func() {
-		if len(batchedRows) == 0 || callback == nil {
+		if (len(batchedRows) == 0 && len(notices) == 0) || callback == nil {
 			return
 		}
 		result := &sqltypes.Result{
@@ -462,12 +489,18 @@ func (c *Conn) processExecuteResponses(ctx context.Context, callback func(ctx co
 			return completed, firstErr
 
 		case protocol.MsgErrorResponse:
+			// The notices received before the error are sent first.
+			flushBatch()
 			if firstErr == nil {
 				firstErr = c.parseError(body)
 			}
 
 		case protocol.MsgNoticeResponse:
-			// Parse and accumulate notices to be included in the Result.
+			// The notices of a Result precede its rows, so the rows
+			// received before the notice are sent first.
+			if len(batchedRows) > 0 {
+				flushBatch()
+			}
 			notices = append(notices, c.parseNotice(body))
 
 		case protocol.MsgParameterStatus:
@@ -488,6 +521,7 @@ func (c *Conn) processExecuteResponses(ctx context.Context, callback func(ctx co
 // writeParse writes a Parse message.
 func (c *Conn) writeParse(name, queryStr string, paramTypes []uint32) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteString(name)
 	w.WriteString(queryStr)
 	w.WriteInt16(int16(len(paramTypes)))
@@ -500,6 +534,7 @@ func (c *Conn) writeParse(name, queryStr string, paramTypes []uint32) error {
 // writeBind writes a Bind message.
 func (c *Conn) writeBind(portalName, stmtName string, params [][]byte, paramFormats, resultFormats []int16) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteString(portalName)
 	w.WriteString(stmtName)
 
@@ -527,6 +562,7 @@ func (c *Conn) writeBind(portalName, stmtName string, params [][]byte, paramForm
 // writeExecute writes an Execute message.
 func (c *Conn) writeExecute(portalName string, maxRows int32) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteString(portalName)
 	w.WriteInt32(maxRows)
 	return c.writeMessageNoFlush(protocol.MsgExecute, w.Bytes())
@@ -535,6 +571,7 @@ func (c *Conn) writeExecute(portalName string, maxRows int32) error {
 // writeDescribe writes a Describe message.
 func (c *Conn) writeDescribe(typ byte, name string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteByte(typ)
 	w.WriteString(name)
 	return c.writeMessageNoFlush(protocol.MsgDescribe, w.Bytes())
@@ -543,6 +580,7 @@ func (c *Conn) writeDescribe(typ byte, name string) error {
 // writeClose writes a Close message.
 func (c *Conn) writeClose(typ byte, name string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteByte(typ)
 	w.WriteString(name)
 	return c.writeMessageNoFlush(protocol.MsgClose, w.Bytes())
@@ -695,7 +733,8 @@ func (c *Conn) waitForReadyForQuery(_ context.Context) error {
 }
 
 // processDescribeResponses processes responses to a Describe('S') command.
-// This only expects ParameterDescription and RowDescription (no BindComplete).
+// This only expects ParameterDescription and RowDescription (no BindComplete),
+// preceded by ParseComplete when the Describe follows a Parse (see Prepare).
 // Always reads until ReadyForQuery to keep the connection in a clean state.
 func (c *Conn) processDescribeResponses(_ context.Context) (*query.StatementDescription, error) {
 	desc := &query.StatementDescription{}
@@ -708,6 +747,9 @@ func (c *Conn) processDescribeResponses(_ context.Context) (*query.StatementDesc
 		}
 
 		switch msgType {
+		case protocol.MsgParseComplete:
+			// Sent by Prepare, which issues Parse before Describe.
+
 		case protocol.MsgParameterDescription:
 			params, err := c.parseParameterDescription(body)
 			if err != nil {
@@ -776,10 +818,10 @@ func (c *Conn) processBindAndExecuteResponses(ctx context.Context, callback func
 	var notices []*sqltypes.Notice
 	var firstErr error
 
-	// flushBatch sends accumulated rows via callback and resets the batch.
+	// flushBatch sends accumulated rows and notices via callback and resets the batch.
 	// Does not reset currentFields as they may be needed for subsequent batches.
 	flushBatch := func() {
-		if len(batchedRows) == 0 || callback == nil {
+		if (len(batchedRows) == 0 && len(notices) == 0) || callback == nil {
 			return
 		}
 		result := &sqltypes.Result{
@@ -884,12 +926,18 @@ func (c *Conn) processBindAndExecuteResponses(ctx context.Context, callback func
 			return completed, nil
 
 		case protocol.MsgErrorResponse:
+			// The notices received before the error are sent first.
+			flushBatch()
 			if firstErr == nil {
 				firstErr = c.parseError(body)
 			}
 
 		case protocol.MsgNoticeResponse:
-			// Parse and accumulate notices to be included in the Result.
+			// The notices of a Result precede its rows, so the rows
+			// received before the notice are sent first.
+			if len(batchedRows) > 0 {
+				flushBatch()
+			}
 			notices = append(notices, c.parseNotice(body))
 
 		case protocol.MsgParameterStatus:
@@ -1007,10 +1055,10 @@ func (c *Conn) processPrepareAndExecuteResponses(ctx context.Context, callback f
 	var notices []*sqltypes.Notice
 	var firstErr error
 
-	// flushBatch sends accumulated rows via callback and resets the batch.
+	// flushBatch sends accumulated rows and notices via callback and resets the batch.
 	// Does not reset currentFields as they may be needed for subsequent batches.
 	flushBatch := func() {
-		if len(batchedRows) == 0 || callback == nil {
+		if (len(batchedRows) == 0 && len(notices) == 0) || callback == nil {
 			return
 		}
 		result := &sqltypes.Result{
@@ -1112,12 +1160,18 @@ func (c *Conn) processPrepareAndExecuteResponses(ctx context.Context, callback f
 			return nil
 
 		case protocol.MsgErrorResponse:
+			// The notices received before the error are sent first.
+			flushBatch()
 			if firstErr == nil {
 				firstErr = c.parseError(body)
 			}
 
 		case protocol.MsgNoticeResponse:
-			// Parse and accumulate notices to be included in the Result.
+			// The notices of a Result precede its rows, so the rows
+			// received before the notice are sent first.
+			if len(batchedRows) > 0 {
+				flushBatch()
+			}
 			notices = append(notices, c.parseNotice(body))
 
 		case protocol.MsgParameterStatus:
@@ -0,0 +1,97 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// defaultKerberosServiceName is the default Kerberos service name of a
+// PostgreSQL server, as in libpq's krbsrvname.
+const defaultKerberosServiceName = "postgres"
+
+// GSSProvider implements a GSSAPI mechanism, normally Kerberos, for servers
+// whose pg_hba.conf uses the gss or sspi methods. The client does not link
+// a GSSAPI implementation itself; applications that need one supply it
+// through Config.NewGSSProvider.
+type GSSProvider interface {
+	// InitSecContext starts a security context with the service principal
+	// service/host and returns the first token to send to the server.
+	InitSecContext(service, host string) ([]byte, error)
+
+	// Continue processes a token from the server. It returns the token to
+	// send back, if any, and whether the context is now established.
+	Continue(token []byte) (done bool, outToken []byte, err error)
+}
+
+// ErrGSSNotConfigured is returned when the server requests GSSAPI or SSPI
+// authentication and Config.NewGSSProvider is not set.
+var ErrGSSNotConfigured = errors.New("server requested GSSAPI (Kerberos) authentication, but no GSS provider is configured: " +
+	"set Config.NewGSSProvider, or change the server's pg_hba.conf entry for this client to scram-sha-256")
+
+// startGSSAuth handles AuthenticationGSS and AuthenticationSSPI by starting
+// a security context and sending the first token. SSPI is handled with
+// GSSAPI, as libpq does when built without native SSPI support; Kerberos
+// tokens are accepted by SSPI servers.
+func (c *Conn) startGSSAuth() error {
+	if c.config == nil || c.config.NewGSSProvider == nil {
+		return ErrGSSNotConfigured
+	}
+	if c.config.Host == "" {
+		return errors.New("GSSAPI authentication requires Config.Host to name the server's Kerberos principal")
+	}
+
+	provider, err := c.config.NewGSSProvider()
+	if err != nil {
+		return fmt.Errorf("failed to create GSS provider: %w", err)
+	}
+
+	service := c.config.KerberosServiceName
+	if service == "" {
+		service = defaultKerberosServiceName
+	}
+	token, err := provider.InitSecContext(service, c.config.Host)
+	if err != nil {
+		return fmt.Errorf("GSSAPI: failed to initialize security context for %s/%s: %w", service, c.config.Host, err)
+	}
+
+	c.gss = provider
+	c.gssDone = false
+	return c.writeMessage(protocol.MsgPasswordMsg, token)
+}
+
+// continueGSSAuth handles AuthenticationGSSContinue, passing the server's
+// token to the provider and sending its answer, if any.
+func (c *Conn) continueGSSAuth(token []byte) error {
+	if c.gss == nil {
+		return errors.New("received GSSAPI continuation without a GSSAPI authentication request")
+	}
+	if c.gssDone {
+		return errors.New("received GSSAPI continuation after the security context was established")
+	}
+
+	done, outToken, err := c.gss.Continue(token)
+	if err != nil {
+		return fmt.Errorf("GSSAPI: %w", err)
+	}
+	c.gssDone = done
+	if len(outToken) == 0 {
+		return nil
+	}
+	return c.writeMessage(protocol.MsgPasswordMsg, outToken)
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// fakeGSSProvider completes a context after one server round trip.
+type fakeGSSProvider struct {
+	principal string
+	received  [][]byte
+}
+
+func (p *fakeGSSProvider) InitSecContext(service, host string) ([]byte, error) {
+	p.principal = service + "/" + host
+	return []byte("init-token"), nil
+}
+
+func (p *fakeGSSProvider) Continue(token []byte) (bool, []byte, error) {
+	p.received = append(p.received, token)
+	return true, []byte("final-token"), nil
+}
+
+func gssAuthBody(authType int32, token []byte) []byte {
+	w := NewMessageWriter()
+	w.WriteInt32(authType)
+	w.WriteBytes(token)
+	return w.Bytes()
+}
+
+func TestGSSAuthentication(t *testing.T) {
+	provider := &fakeGSSProvider{}
+	var buf bytes.Buffer
+	conn := &Conn{
+		bufferedWriter: bufio.NewWriter(&buf),
+		config: &Config{
+			Host:           "db.example.com",
+			NewGSSProvider: func() (GSSProvider, error) { return provider, nil },
+		},
+	}
+
+	require.NoError(t, conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthGSS, nil)))
+	assert.Equal(t, "postgres/db.example.com", provider.principal)
+	msgType, body := readWrittenMessage(t, &buf)
+	assert.Equal(t, byte(protocol.MsgPasswordMsg), msgType)
+	assert.Equal(t, "init-token", string(body))
+
+	require.NoError(t, conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthGSSContinue, []byte("server-token"))))
+	assert.Equal(t, [][]byte{[]byte("server-token")}, provider.received)
+	msgType, body = readWrittenMessage(t, &buf)
+	assert.Equal(t, byte(protocol.MsgPasswordMsg), msgType)
+	assert.Equal(t, "final-token", string(body))
+
+	// The context is established; a further continuation is a protocol error.
+	err := conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthGSSContinue, []byte("again")))
+	assert.ErrorContains(t, err, "after the security context was established")
+
+	require.NoError(t, conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthOk, nil)))
+	assert.Nil(t, conn.gss)
+}
+
+func TestGSSAuthenticationServiceName(t *testing.T) {
+	provider := &fakeGSSProvider{}
+	var buf bytes.Buffer
+	conn := &Conn{
+		bufferedWriter: bufio.NewWriter(&buf),
+		config: &Config{
+			Host:                "db.example.com",
+			KerberosServiceName: "pgsql",
+			NewGSSProvider:      func() (GSSProvider, error) { return provider, nil },
+		},
+	}
+
+	// SSPI requests are answered with GSSAPI.
+	require.NoError(t, conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthSSPI, nil)))
+	assert.Equal(t, "pgsql/db.example.com", provider.principal)
+}
+
+func TestGSSAuthenticationNotConfigured(t *testing.T) {
+	conn := &Conn{config: &Config{Host: "db.example.com"}}
+	for _, authType := range []int32{protocol.AuthGSS, protocol.AuthSSPI} {
+		err := conn.handleAuthenticationRequest(gssAuthBody(authType, nil))
+		require.ErrorIs(t, err, ErrGSSNotConfigured)
+		assert.Contains(t, err.Error(), "pg_hba.conf")
+	}
+}
+
+func TestGSSAuthenticationErrors(t *testing.T) {
+	// A continuation without a preceding request.
+	conn := &Conn{config: &Config{}}
+	err := conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthGSSContinue, []byte("token")))
+	assert.ErrorContains(t, err, "without a GSSAPI authentication request")
+
+	// The provider cannot be created.
+	conn = &Conn{config: &Config{
+		Host:           "db.example.com",
+		NewGSSProvider: func() (GSSProvider, error) { return nil, errors.New("no credentials cache") },
+	}}
+	err = conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthGSS, nil))
+	assert.ErrorContains(t, err, "no credentials cache")
+
+	// No host to build the service principal from.
+	conn = &Conn{config: &Config{
+		SocketFile:     "/var/run/postgresql/.s.PGSQL.5432",
+		NewGSSProvider: func() (GSSProvider, error) { return &fakeGSSProvider{}, nil },
+	}}
+	err = conn.handleAuthenticationRequest(gssAuthBody(protocol.AuthGSS, nil))
+	assert.ErrorContains(t, err, "Config.Host")
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// isAlivePollTimeout bounds how long IsAlive waits for the socket to report
+// pending data or a closed peer.
+const isAlivePollTimeout = time.Millisecond
+
+// Ping checks that the server is responsive by sending an empty query and
+// waiting for ReadyForQuery. It does not change the transaction state and
+// works inside a failed transaction.
+func (c *Conn) Ping(ctx context.Context) error {
+	if c.IsClosed() {
+		return errors.New("connection is closed")
+	}
+
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := c.writeQueryMessage(""); err != nil {
+		return fmt.Errorf("failed to send ping: %w", err)
+	}
+
+	var firstErr error
+	for {
+		msgType, body, err := c.readMessage()
+		if err != nil {
+			return fmt.Errorf("failed to read ping response: %w", err)
+		}
+
+		switch msgType {
+		case protocol.MsgEmptyQueryResponse, protocol.MsgNoticeResponse:
+			// Expected response to an empty query.
+
+		case protocol.MsgParameterStatus:
+			if firstErr == nil {
+				firstErr = c.handleParameterStatus(body)
+			}
+
+		case protocol.MsgErrorResponse:
+			if firstErr == nil {
+				firstErr = c.parseError(body)
+			}
+
+		case protocol.MsgReadyForQuery:
+			if err := c.handleReadyForQuery(body); err != nil {
+				return err
+			}
+			return firstErr
+
+		default:
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unexpected message type in ping response: %c (0x%02x)", msgType, msgType)
+			}
+		}
+	}
+}
+
+// IsAlive reports, without waiting on the server, whether the connection
+// still appears usable: it has not been closed and the server has not closed
+// its end or sent an unsolicited error (for example a FATAL admin shutdown).
+//
+// IsAlive is cheaper than Ping but cannot detect a backend that is hung or
+// unreachable without a FIN/RST; use Ping for that. A connection that is
+// currently in use by another goroutine is reported as alive.
+func (c *Conn) IsAlive() bool {
+	if c.IsClosed() {
+		return false
+	}
+	if !c.bufmu.TryLock() {
+		return true
+	}
+	defer c.bufmu.Unlock()
+
+	if c.bufferedReader.Buffered() == 0 {
+		// Poll the socket: a short deadline makes Peek return a timeout if
+		// no data is pending, or EOF if the peer hung up. (A deadline that
+		// has already passed would fail before checking the socket at all.)
+		if err := c.conn.SetReadDeadline(time.Now().Add(isAlivePollTimeout)); err != nil {
+			return false
+		}
+		_, err := c.bufferedReader.Peek(1)
+		_ = c.conn.SetReadDeadline(time.Time{})
+		if err != nil {
+			var netErr net.Error
+			return errors.As(err, &netErr) && netErr.Timeout()
+		}
+	}
+
+	// Data arrived while the connection was idle. Notifications, notices
+	// and parameter updates are harmless; anything else (in practice an
+	// ErrorResponse before the server closes the connection) is not.
+	msgType, err := c.bufferedReader.Peek(1)
+	if err != nil {
+		return false
+	}
+	switch msgType[0] {
+	case protocol.MsgNotificationResponse, protocol.MsgNoticeResponse, protocol.MsgParameterStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// probeIdle pings the connection every interval while it is not in use and
+// closes it if a ping fails, so dead backends are noticed before the
+// connection is handed out. It returns when the connection is closed.
+func (c *Conn) probeIdle(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Skip connections that are busy; they are evidently alive.
+		if !c.bufmu.TryLock() {
+			continue
+		}
+		c.bufmu.Unlock()
+
+		ctx, cancel := context.WithTimeout(c.ctx, interval)
+		err := c.Ping(ctx)
+		cancel()
+		if err != nil && !c.IsClosed() {
+			_ = c.Close()
+			return
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// newPipeConn returns a Conn backed by one end of a net.Pipe and the other
+// end for the test to act as the server.
+func newPipeConn(t *testing.T) (*Conn, net.Conn) {
+	t.Helper()
+	client, server := net.Pipe()
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &Conn{
+		conn:           client,
+		bufferedReader: bufio.NewReader(client),
+		bufferedWriter: bufio.NewWriter(client),
+		serverParams:   make(map[string]string),
+		ctx:            ctx,
+		cancel:         cancel,
+	}, server
+}
+
+func TestPing(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgEmptyQueryResponse, nil)
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusFailed})
+
+	conn, frontend := newScriptedConn(&backend)
+	require.NoError(t, conn.Ping(context.Background()))
+	assert.Equal(t, byte(protocol.TxnStatusFailed), conn.TxnStatus())
+
+	msgType, body := readWrittenMessage(t, frontend)
+	assert.Equal(t, byte(protocol.MsgQuery), msgType)
+	assert.Equal(t, []byte{0}, body)
+}
+
+func TestPingError(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgErrorResponse, copyErrorBody("57P01", "terminating connection due to administrator command"))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	err := conn.Ping(context.Background())
+	var pgErr *Error
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "57P01", pgErr.Code)
+}
+
+func TestPingContextTimeout(t *testing.T) {
+	conn, server := newPipeConn(t)
+
+	// Consume the ping but never answer it.
+	go func() {
+		buf := make([]byte, 64)
+		_, _ = server.Read(buf)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := conn.Ping(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestIsAlive(t *testing.T) {
+	t.Run("idle connection", func(t *testing.T) {
+		conn, _ := newPipeConn(t)
+		assert.True(t, conn.IsAlive())
+		// The poll must not leave a deadline behind.
+		assert.True(t, conn.IsAlive())
+	})
+
+	t.Run("closed connection", func(t *testing.T) {
+		conn, server := newPipeConn(t)
+		go func() { _, _ = io.Copy(io.Discard, server) }()
+		require.NoError(t, conn.Close())
+		assert.False(t, conn.IsAlive())
+	})
+
+	t.Run("server hung up", func(t *testing.T) {
+		conn, server := newPipeConn(t)
+		require.NoError(t, server.Close())
+		assert.False(t, conn.IsAlive())
+	})
+
+	t.Run("pending notification", func(t *testing.T) {
+		conn, server := newPipeConn(t)
+		var msg bytes.Buffer
+		writeBackendMessage(&msg, protocol.MsgNotificationResponse, notificationBody(1, "jobs", ""))
+		go func() { _, _ = server.Write(msg.Bytes()) }()
+		require.Eventually(t, func() bool {
+			return conn.bufferedReader.Buffered() > 0 || !conn.IsAlive()
+		}, time.Second, time.Millisecond)
+		assert.True(t, conn.IsAlive())
+	})
+
+	t.Run("pending fatal error", func(t *testing.T) {
+		conn, server := newPipeConn(t)
+		var msg bytes.Buffer
+		writeBackendMessage(&msg, protocol.MsgErrorResponse, copyErrorBody("57P01", "terminating connection"))
+		go func() { _, _ = server.Write(msg.Bytes()) }()
+		assert.Eventually(t, func() bool { return !conn.IsAlive() }, time.Second, time.Millisecond)
+	})
+}
+
+func TestProbeIdleClosesDeadConnection(t *testing.T) {
+	conn, server := newPipeConn(t)
+	// Swallow everything, including pings, without ever answering.
+	go func() { _, _ = io.Copy(io.Discard, server) }()
+
+	go conn.probeIdle(20 * time.Millisecond)
+	assert.Eventually(t, conn.IsClosed, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// Notification is an asynchronous LISTEN/NOTIFY event delivered by the
+// server in a NotificationResponse ('A') message.
+type Notification struct {
+	// PID is the process ID of the notifying backend.
+	PID uint32
+
+	// Channel is the name of the channel the notification was raised on.
+	Channel string
+
+	// Payload is the notification payload (empty if none was given).
+	Payload string
+}
+
+// NotificationHandler is called for every notification received on a
+// connection. It runs on the goroutine reading from the connection and must
+// not call back into the Conn.
+type NotificationHandler func(*Notification)
+
+// SetNotificationHandler registers the handler that receives notifications
+// arriving while the connection reads server responses. Passing nil
+// discards notifications, which is the default.
+//
+// PostgreSQL delivers notifications between commands, so they usually show
+// up while reading the response to the next query. Use WaitForNotification
+// to receive them on an otherwise idle connection.
+func (c *Conn) SetNotificationHandler(handler NotificationHandler) {
+	c.bufmu.Lock()
+	defer c.bufmu.Unlock()
+	c.notificationHandler = handler
+}
+
+// WaitForNotification blocks until a notification arrives on an idle
+// connection or ctx is done. Notifications are also passed to the handler
+// set with SetNotificationHandler, if any.
+//
+// Only call this on a connection that is not running a query; any other
+// message received while waiting is reported as an error.
+func (c *Conn) WaitForNotification(ctx context.Context) (*Notification, error) {
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// Waiting can take arbitrarily long, so MessageTimeout does not apply,
+	// and giving up on an idle connection leaves it usable.
+	c.setIdleWait(true)
+	defer c.setIdleWait(false)
+
+	for {
+		msgType, body, err := c.readRawMessage()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read message: %w", err)
+		}
+
+		switch msgType {
+		case protocol.MsgNotificationResponse:
+			n, err := parseNotification(body)
+			if err != nil {
+				return nil, err
+			}
+			if c.notificationHandler != nil {
+				c.notificationHandler(n)
+			}
+			return n, nil
+
+		case protocol.MsgNoticeResponse:
+			// Ignore notices.
+
+		case protocol.MsgParameterStatus:
+			if err := c.handleParameterStatus(body); err != nil {
+				return nil, err
+			}
+
+		case protocol.MsgErrorResponse:
+			// Typically a FATAL error such as an administrator shutdown.
+			return nil, c.parseError(body)
+
+		default:
+			return nil, fmt.Errorf("unexpected message type while waiting for notification: %c (0x%02x)", msgType, msgType)
+		}
+	}
+}
+
+// handleNotification parses a NotificationResponse and passes it to the
+// registered handler.
+func (c *Conn) handleNotification(body []byte) error {
+	n, err := parseNotification(body)
+	if err != nil {
+		return err
+	}
+	if c.notificationHandler != nil {
+		c.notificationHandler(n)
+	}
+	return nil
+}
+
+// parseNotification parses a NotificationResponse message body.
+func parseNotification(body []byte) (*Notification, error) {
+	if len(body) < 4 {
+		return nil, errors.New("notification message too short")
+	}
+
+	reader := NewMessageReader(body)
+	pid, err := reader.ReadUint32()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification PID: %w", err)
+	}
+	channel, err := reader.ReadString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification channel: %w", err)
+	}
+	payload, err := reader.ReadString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification payload: %w", err)
+	}
+
+	return &Notification{PID: pid, Channel: channel, Payload: payload}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+func notificationBody(pid uint32, channel, payload string) []byte {
+	w := NewMessageWriter()
+	w.WriteUint32(pid)
+	w.WriteString(channel)
+	w.WriteString(payload)
+	return w.Bytes()
+}
+
+func TestParseNotification(t *testing.T) {
+	n, err := parseNotification(notificationBody(42, "jobs", "job 7 done"))
+	require.NoError(t, err)
+	assert.Equal(t, &Notification{PID: 42, Channel: "jobs", Payload: "job 7 done"}, n)
+
+	_, err = parseNotification([]byte{0, 0})
+	assert.Error(t, err)
+
+	_, err = parseNotification([]byte{0, 0, 0, 1, 'x'})
+	assert.Error(t, err, "unterminated channel name")
+}
+
+func TestNotificationDuringQuery(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("NOTIFY"), 0))
+	writeBackendMessage(&backend, protocol.MsgNotificationResponse, notificationBody(7, "jobs", "hello"))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	var got []*Notification
+	conn.SetNotificationHandler(func(n *Notification) { got = append(got, n) })
+
+	results, err := conn.Query(context.Background(), "NOTIFY jobs, 'hello'")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "NOTIFY", results[0].CommandTag)
+	require.Len(t, got, 1)
+	assert.Equal(t, &Notification{PID: 7, Channel: "jobs", Payload: "hello"}, got[0])
+}
+
+func TestNotificationWithoutHandler(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgNotificationResponse, notificationBody(7, "jobs", ""))
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("LISTEN"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	results, err := conn.Query(context.Background(), "LISTEN jobs")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "LISTEN", results[0].CommandTag)
+}
+
+func TestWaitForNotification(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgParameterStatus, append(append([]byte("TimeZone"), 0), append([]byte("UTC"), 0)...))
+	writeBackendMessage(&backend, protocol.MsgNotificationResponse, notificationBody(9, "jobs", "ready"))
+
+	conn, _ := newScriptedConn(&backend)
+	var handled *Notification
+	conn.SetNotificationHandler(func(n *Notification) { handled = n })
+
+	n, err := conn.WaitForNotification(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Notification{PID: 9, Channel: "jobs", Payload: "ready"}, n)
+	assert.Equal(t, n, handled)
+	assert.Equal(t, "UTC", conn.serverParams["TimeZone"])
+}
+
+func TestWaitForNotificationContextCancel(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	conn := &Conn{
+		conn:           client,
+		bufferedReader: bufio.NewReader(client),
+		bufferedWriter: bufio.NewWriter(client),
+		serverParams:   make(map[string]string),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := conn.WaitForNotification(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -19,14 +19,39 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/multigres/multigres/go/common/pgprotocol/bufpool"
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 )
 
+const (
+	// minWriterBufferSize is the initial capacity of a MessageWriter buffer.
+	minWriterBufferSize = 256
+
+	// maxWriterBufferSize is the largest MessageWriter buffer kept for
+	// reuse. Buffers grown beyond it by large messages are left to the
+	// garbage collector rather than pinned in the pool.
+	maxWriterBufferSize = 64 * 1024
+)
+
+// writerBufPool recycles MessageWriter buffers, bucketed by size.
+var writerBufPool = bufpool.New(minWriterBufferSize, maxWriterBufferSize)
+
 // Reading utilities
 
 // readMessageType reads a single byte message type from the connection.
+// It arms the read deadline for the message (see armDeadline).
 func (c *Conn) readMessageType() (byte, error) {
-	return c.bufferedReader.ReadByte()
+	if err := c.armDeadline(true); err != nil {
+		return 0, c.ioError(err)
+	}
+	msgType, err := c.bufferedReader.ReadByte()
+	c.readingType = msgType
+	if err != nil && c.isIdleWait() {
+		// Nothing was read, so the stream is intact.
+		_, err = c.translateIOError(err)
+		return 0, err
+	}
+	return msgType, c.ioError(err)
 }
 
 // readMessageLength reads the 4-byte message length from the connection.
@@ -36,7 +61,7 @@ func (c *Conn) readMessageLength() (int, error) {
 	var lenBuf [4]byte
 	_, err := io.ReadFull(c.bufferedReader, lenBuf[:])
 	if err != nil {
-		return 0, err
+		return 0, c.ioError(err)
 	}
 
 	length := binary.BigEndian.Uint32(lenBuf[:])
@@ -44,6 +69,7 @@ func (c *Conn) readMessageLength() (int, error) {
 		return 0, fmt.Errorf("invalid message length: %d", length)
 	}
 
+	c.traceReceive(c.readingType, int(length-4))
 	return int(length - 4), nil
 }
 
@@ -56,14 +82,33 @@ func (c *Conn) readMessageBody(length int) ([]byte, error) {
 	buf := make([]byte, length)
 	_, err := io.ReadFull(c.bufferedReader, buf)
 	if err != nil {
-		return nil, err
+		return nil, c.ioError(err)
 	}
 
 	return buf, nil
 }
 
 // readMessage reads a complete message (type, length, body).
+// NotificationResponse messages can arrive at any point and are passed to
+// the notification handler instead of being returned.
 func (c *Conn) readMessage() (byte, []byte, error) {
+	for {
+		msgType, body, err := c.readRawMessage()
+		if err != nil {
+			return 0, nil, err
+		}
+		if msgType != protocol.MsgNotificationResponse {
+			return msgType, body, nil
+		}
+		if err := c.handleNotification(body); err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+// readRawMessage reads a complete message (type, length, body) without
+// intercepting notifications.
+func (c *Conn) readRawMessage() (byte, []byte, error) {
 	msgType, err := c.readMessageType()
 	if err != nil {
 		return 0, nil, err
@@ -86,6 +131,8 @@ func (c *Conn) readMessage() (byte, []byte, error) {
 
 // writeMessage writes a complete message with type, length, and body.
 func (c *Conn) writeMessage(msgType byte, body []byte) error {
+	c.traceSend(msgType, len(body))
+
 	// Write message type.
 	if err := c.writeByte(msgType); err != nil {
 		return err
@@ -109,6 +156,8 @@ func (c *Conn) writeMessage(msgType byte, body []byte) error {
 
 // writeMessageNoFlush writes a message without flushing.
 func (c *Conn) writeMessageNoFlush(msgType byte, body []byte) error {
+	c.traceSend(msgType, len(body))
+
 	// Write message type.
 	if err := c.writeByte(msgType); err != nil {
 		return err
@@ -143,6 +192,21 @@ func (c *Conn) writeUint32(v uint32) error {
 	return err
 }
 
+// traceSend reports a message being sent to the configured tracer, if any.
+// msgType is 0 for untyped startup packets.
+func (c *Conn) traceSend(msgType byte, length int) {
+	if c.config != nil && c.config.MessageTracer != nil {
+		c.config.MessageTracer.OnSend(msgType, length)
+	}
+}
+
+// traceReceive reports a message received to the configured tracer, if any.
+func (c *Conn) traceReceive(msgType byte, length int) {
+	if c.config != nil && c.config.MessageTracer != nil {
+		c.config.MessageTracer.OnReceive(msgType, length)
+	}
+}
+
 // writeTerminate writes a Terminate message.
 func (c *Conn) writeTerminate() error {
 	return c.writeMessageNoFlush(protocol.MsgTerminate, nil)
@@ -247,13 +311,29 @@ func (r *MessageReader) ReadByteString() ([]byte, error) {
 }
 
 // MessageWriter provides helper methods for building message bodies.
+// Its buffer is taken from a pool; call Release once the message has been
+// written to make the buffer available to the next writer.
 type MessageWriter struct {
-	buf []byte
+	buf    []byte
+	pooled *[]byte
 }
 
 // NewMessageWriter creates a new message writer.
 func NewMessageWriter() *MessageWriter {
-	return &MessageWriter{buf: make([]byte, 0, 256)}
+	pooled := writerBufPool.Get(minWriterBufferSize)
+	return &MessageWriter{buf: (*pooled)[:0], pooled: pooled}
+}
+
+// Release returns the writer's buffer to the pool. Neither the writer nor
+// any slice returned by Bytes may be used afterwards. Writers that are
+// never released are simply garbage collected.
+func (w *MessageWriter) Release() {
+	if w.pooled == nil {
+		return
+	}
+	*w.pooled = w.buf
+	writerBufPool.Put(w.pooled)
+	w.buf, w.pooled = nil, nil
 }
 
 // Bytes returns the accumulated message bytes.
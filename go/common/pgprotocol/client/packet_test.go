@@ -17,6 +17,7 @@ package client
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"io"
 	"net"
 	"testing"
@@ -231,6 +232,42 @@ func TestMessageWriterReset(t *testing.T) {
 	assert.Empty(t, w.Bytes())
 }
 
+func TestMessageWriterRelease(t *testing.T) {
+	w := NewMessageWriter()
+	w.WriteString("hello")
+	w.Release()
+	assert.Nil(t, w.Bytes())
+	w.Release() // Releasing twice is a no-op.
+
+	// A recycled buffer must come back empty.
+	w = NewMessageWriter()
+	assert.Equal(t, 0, w.Len())
+	w.WriteString("world")
+	assert.Equal(t, []byte("world\x00"), w.Bytes())
+	w.Release()
+}
+
+func TestMessageWriterReleaseOversized(t *testing.T) {
+	w := NewMessageWriter()
+	w.WriteBytes(make([]byte, 2*maxWriterBufferSize))
+	w.Release()
+
+	// The oversized buffer is not pooled, so a new writer gets a small one.
+	w = NewMessageWriter()
+	defer w.Release()
+	assert.LessOrEqual(t, cap(w.Bytes()), maxWriterBufferSize)
+}
+
+func BenchmarkWriteQueryMessage(b *testing.B) {
+	conn := &Conn{bufferedWriter: bufio.NewWriter(io.Discard)}
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := conn.writeQueryMessage("SELECT * FROM users WHERE id = 1"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestConnWriteAndReadMessage(t *testing.T) {
 	// Create a mock connection using a bytes buffer.
 	var buf bytes.Buffer
@@ -317,3 +354,44 @@ func (m *mockNetConn) RemoteAddr() net.Addr {
 func (m *mockNetConn) SetDeadline(t time.Time) error      { return nil }
 func (m *mockNetConn) SetReadDeadline(t time.Time) error  { return nil }
 func (m *mockNetConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// tracedMessage is a message reported to a recordingTracer.
+type tracedMessage struct {
+	send    bool
+	msgType byte
+	length  int
+}
+
+// recordingTracer is a protocol.MessageTracer that records every message.
+type recordingTracer struct {
+	messages []tracedMessage
+}
+
+func (r *recordingTracer) OnSend(msgType byte, length int) {
+	r.messages = append(r.messages, tracedMessage{send: true, msgType: msgType, length: length})
+}
+
+func (r *recordingTracer) OnReceive(msgType byte, length int) {
+	r.messages = append(r.messages, tracedMessage{msgType: msgType, length: length})
+}
+
+func TestMessageTracer(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("LISTEN"), 0))
+	writeBackendMessage(&backend, protocol.MsgNotificationResponse, notificationBody(7, "jobs", ""))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	tracer := &recordingTracer{}
+	conn, _ := newScriptedConn(&backend)
+	conn.config = &Config{MessageTracer: tracer}
+
+	_, err := conn.Query(context.Background(), "LISTEN jobs")
+	require.NoError(t, err)
+
+	assert.Equal(t, []tracedMessage{
+		{send: true, msgType: protocol.MsgQuery, length: len("LISTEN jobs") + 1},
+		{msgType: protocol.MsgCommandComplete, length: len("LISTEN") + 1},
+		{msgType: protocol.MsgNotificationResponse, length: len(notificationBody(7, "jobs", ""))},
+		{msgType: protocol.MsgReadyForQuery, length: 1},
+	}, tracer.messages)
+}
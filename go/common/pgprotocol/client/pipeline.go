@@ -0,0 +1,325 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// ErrPipelineAborted is reported for pipeline operations that the server
+// skipped because an earlier operation in the same batch failed.
+var ErrPipelineAborted = errors.New("pipeline operation skipped due to an earlier error")
+
+// PipelineOp identifies the kind of a queued pipeline operation.
+type PipelineOp int
+
+const (
+	// PipelineParse is a queued Parse.
+	PipelineParse PipelineOp = iota
+	// PipelineBind is a queued Bind.
+	PipelineBind
+	// PipelineDescribe is a queued Describe.
+	PipelineDescribe
+	// PipelineExecute is a queued Execute.
+	PipelineExecute
+)
+
+// String returns the protocol message name of the operation.
+func (op PipelineOp) String() string {
+	switch op {
+	case PipelineParse:
+		return "Parse"
+	case PipelineBind:
+		return "Bind"
+	case PipelineDescribe:
+		return "Describe"
+	case PipelineExecute:
+		return "Execute"
+	default:
+		return fmt.Sprintf("PipelineOp(%d)", int(op))
+	}
+}
+
+// PipelineResult is the outcome of one queued operation.
+type PipelineResult struct {
+	// Op is the kind of operation.
+	Op PipelineOp
+
+	// Result holds the rows, command tag and notices of an Execute. Its
+	// Fields are set if the same batch described the portal first.
+	Result *sqltypes.Result
+
+	// Suspended is true if an Execute stopped at its row limit
+	// (PortalSuspended) rather than completing.
+	Suspended bool
+
+	// Description holds the result of a Describe.
+	Description *query.StatementDescription
+
+	// Err is the error returned by the server for this operation, or
+	// ErrPipelineAborted if it was skipped because of an earlier error.
+	Err error
+}
+
+// pipelineEntry records a queued operation.
+type pipelineEntry struct {
+	op PipelineOp
+	// describeType is 'S' or 'P' for Describe.
+	describeType byte
+	// name is the portal name for Describe('P') and Execute.
+	name string
+}
+
+// Pipeline batches extended-protocol messages and sends them to the server
+// with a single Sync, so that a sequence of statements costs one round
+// trip. Queue operations with the Queue* methods, send them with Flush, then
+// collect one PipelineResult per queued operation with ReadResults.
+//
+// As in PostgreSQL, an error aborts the rest of the batch: the server skips
+// every following operation up to the Sync. Without an explicit transaction
+// the whole batch runs in one implicit transaction.
+//
+// A Pipeline must not be used concurrently with other operations on its Conn
+// between the first Queue call and ReadResults.
+type Pipeline struct {
+	conn    *Conn
+	entries []pipelineEntry
+	flushed bool
+}
+
+// Pipeline starts a new pipeline on the connection.
+func (c *Conn) Pipeline() *Pipeline {
+	return &Pipeline{conn: c}
+}
+
+// QueueParse queues a Parse message.
+func (p *Pipeline) QueueParse(name, queryStr string, paramTypes []uint32) error {
+	return p.queue(pipelineEntry{op: PipelineParse}, func() error {
+		return p.conn.writeParse(name, queryStr, paramTypes)
+	})
+}
+
+// QueueBind queues a Bind message creating portalName from stmtName.
+func (p *Pipeline) QueueBind(portalName, stmtName string, params [][]byte, paramFormats, resultFormats []int16) error {
+	return p.queue(pipelineEntry{op: PipelineBind}, func() error {
+		return p.conn.writeBind(portalName, stmtName, params, paramFormats, resultFormats)
+	})
+}
+
+// QueueDescribe queues a Describe message for a statement ('S') or portal ('P').
+func (p *Pipeline) QueueDescribe(typ byte, name string) error {
+	if typ != 'S' && typ != 'P' {
+		return fmt.Errorf("invalid describe type %q", typ)
+	}
+	return p.queue(pipelineEntry{op: PipelineDescribe, describeType: typ, name: name}, func() error {
+		return p.conn.writeDescribe(typ, name)
+	})
+}
+
+// QueueExecute queues an Execute message for portalName (maxRows 0 for unlimited).
+func (p *Pipeline) QueueExecute(portalName string, maxRows int32) error {
+	return p.queue(pipelineEntry{op: PipelineExecute, name: portalName}, func() error {
+		return p.conn.writeExecute(portalName, maxRows)
+	})
+}
+
+// queue writes a message to the connection buffer and records it.
+func (p *Pipeline) queue(entry pipelineEntry, write func() error) error {
+	if p.flushed {
+		return errors.New("pipeline already flushed")
+	}
+	p.conn.bufmu.Lock()
+	defer p.conn.bufmu.Unlock()
+	if err := write(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", entry.op, err)
+	}
+	p.entries = append(p.entries, entry)
+	return nil
+}
+
+// Flush ends the batch with a Sync and sends everything queued to the server.
+func (p *Pipeline) Flush(ctx context.Context) error {
+	if p.flushed {
+		return errors.New("pipeline already flushed")
+	}
+	unlock, err := p.conn.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := p.conn.writeSync(); err != nil {
+		return fmt.Errorf("failed to write Sync: %w", err)
+	}
+	if err := p.conn.flush(); err != nil {
+		return fmt.Errorf("failed to flush: %w", err)
+	}
+	p.flushed = true
+	return nil
+}
+
+// ReadResults reads the server's responses up to ReadyForQuery and returns
+// one result per queued operation, in queue order. The returned error is the
+// first operation error, if any; every result carries its own Err. A
+// connection-level failure returns a nil slice.
+func (p *Pipeline) ReadResults(ctx context.Context) ([]*PipelineResult, error) {
+	if !p.flushed {
+		return nil, errors.New("pipeline not flushed")
+	}
+	c := p.conn
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	results := make([]*PipelineResult, len(p.entries))
+	for i, e := range p.entries {
+		results[i] = &PipelineResult{Op: e.op}
+	}
+	// portalFields remembers Describe('P') results for later Executes.
+	portalFields := make(map[string][]*query.Field)
+	var firstErr error
+	next := 0
+
+	// current returns the result awaiting a response of the given kind.
+	current := func(op PipelineOp) (*PipelineResult, error) {
+		if next >= len(results) || results[next].Op != op {
+			return nil, fmt.Errorf("unexpected %s response in pipeline", op)
+		}
+		return results[next], nil
+	}
+
+	for {
+		msgType, body, err := c.readMessage()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read message: %w", err)
+		}
+
+		var protoErr error
+		switch msgType {
+		case protocol.MsgParseComplete:
+			if _, protoErr = current(PipelineParse); protoErr == nil {
+				next++
+			}
+
+		case protocol.MsgBindComplete:
+			if _, protoErr = current(PipelineBind); protoErr == nil {
+				next++
+			}
+
+		case protocol.MsgParameterDescription:
+			var r *PipelineResult
+			if r, protoErr = current(PipelineDescribe); protoErr == nil {
+				r.Description = &query.StatementDescription{}
+				r.Description.Parameters, protoErr = c.parseParameterDescription(body)
+			}
+
+		case protocol.MsgRowDescription, protocol.MsgNoData:
+			var r *PipelineResult
+			if r, protoErr = current(PipelineDescribe); protoErr == nil {
+				if r.Description == nil {
+					r.Description = &query.StatementDescription{}
+				}
+				if msgType == protocol.MsgRowDescription {
+					r.Description.Fields, protoErr = c.parseRowDescription(body)
+				}
+				if e := p.entries[next]; e.describeType == 'P' {
+					portalFields[e.name] = r.Description.Fields
+				}
+				next++
+			}
+
+		case protocol.MsgDataRow:
+			if _, protoErr = current(PipelineExecute); protoErr == nil {
+				var row *sqltypes.Row
+				if row, protoErr = c.parseDataRow(body); protoErr == nil {
+					res := p.executeResult(results, next, portalFields)
+					res.Rows = append(res.Rows, row)
+				}
+			}
+
+		case protocol.MsgCommandComplete:
+			if _, protoErr = current(PipelineExecute); protoErr == nil {
+				var tag string
+				if tag, protoErr = c.parseCommandComplete(body); protoErr == nil {
+					res := p.executeResult(results, next, portalFields)
+					res.CommandTag = tag
+					res.RowsAffected = parseRowsAffected(tag)
+				}
+				next++
+			}
+
+		case protocol.MsgEmptyQueryResponse, protocol.MsgPortalSuspended:
+			var r *PipelineResult
+			if r, protoErr = current(PipelineExecute); protoErr == nil {
+				p.executeResult(results, next, portalFields)
+				r.Suspended = msgType == protocol.MsgPortalSuspended
+				next++
+			}
+
+		case protocol.MsgErrorResponse:
+			pgErr := c.parseError(body)
+			if next < len(results) {
+				results[next].Err = pgErr
+				next++
+			}
+			if firstErr == nil {
+				firstErr = pgErr
+			}
+
+		case protocol.MsgNoticeResponse:
+			notice := c.parseNotice(body)
+			if next < len(results) && results[next].Op == PipelineExecute {
+				res := p.executeResult(results, next, portalFields)
+				res.Notices = append(res.Notices, notice)
+			}
+
+		case protocol.MsgParameterStatus:
+			protoErr = c.handleParameterStatus(body)
+
+		case protocol.MsgReadyForQuery:
+			c.txnStatus = body[0]
+			// Anything not answered was skipped by the server.
+			for _, r := range results[next:] {
+				r.Err = ErrPipelineAborted
+			}
+			return results, firstErr
+
+		default:
+			protoErr = fmt.Errorf("unexpected message type: %c (0x%02x)", msgType, msgType)
+		}
+
+		if protoErr != nil && firstErr == nil {
+			firstErr = protoErr
+		}
+	}
+}
+
+// executeResult returns the Result of the Execute at index i, creating it
+// on first use with the fields of its portal if the batch described it.
+func (p *Pipeline) executeResult(results []*PipelineResult, i int, portalFields map[string][]*query.Field) *sqltypes.Result {
+	r := results[i]
+	if r.Result == nil {
+		r.Result = &sqltypes.Result{Fields: portalFields[p.entries[i].name]}
+	}
+	return r.Result
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestPipeline(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgParseComplete, nil)
+	writeBackendMessage(&backend, protocol.MsgBindComplete, nil)
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("SET"), 0))
+	writeBackendMessage(&backend, protocol.MsgParseComplete, nil)
+	writeBackendMessage(&backend, protocol.MsgBindComplete, nil)
+	writeBackendMessage(&backend, protocol.MsgRowDescription, rowDescriptionBody([]string{"n"}, []uint32{23}))
+	row := NewMessageWriter()
+	row.WriteInt16(1)
+	row.WriteInt32(1)
+	row.WriteBytes([]byte("5"))
+	writeBackendMessage(&backend, protocol.MsgDataRow, row.Bytes())
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, append([]byte("SELECT 1"), 0))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, frontend := newScriptedConn(&backend)
+	ctx := context.Background()
+
+	p := conn.Pipeline()
+	require.NoError(t, p.QueueParse("", "SET search_path = app", nil))
+	require.NoError(t, p.QueueBind("", "", nil, nil, nil))
+	require.NoError(t, p.QueueExecute("", 0))
+	require.NoError(t, p.QueueParse("q", "SELECT $1::int", nil))
+	require.NoError(t, p.QueueBind("q", "q", [][]byte{[]byte("5")}, nil, nil))
+	require.NoError(t, p.QueueDescribe('P', "q"))
+	require.NoError(t, p.QueueExecute("q", 0))
+
+	_, err := p.ReadResults(ctx)
+	require.ErrorContains(t, err, "not flushed")
+	require.NoError(t, p.Flush(ctx))
+	require.Error(t, p.QueueExecute("q", 0))
+
+	// Everything was sent in one batch ending with a single Sync.
+	var sent []byte
+	reader := bufio.NewReader(frontend)
+	for {
+		msgType, err := reader.ReadByte()
+		if err != nil {
+			break
+		}
+		sent = append(sent, msgType)
+		lenBuf := make([]byte, 4)
+		_, _ = reader.Read(lenBuf)
+		length, _ := NewMessageReader(lenBuf).ReadUint32()
+		_, _ = reader.Discard(int(length) - 4)
+	}
+	assert.Equal(t, "PBEPBDES", string(sent))
+
+	results, err := p.ReadResults(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 7)
+	assert.Equal(t, "SET", results[2].Result.CommandTag)
+	assert.Equal(t, PipelineDescribe, results[5].Op)
+	require.Len(t, results[5].Description.Fields, 1)
+	exec := results[6].Result
+	require.Len(t, exec.Fields, 1)
+	assert.Equal(t, "n", exec.Fields[0].Name)
+	assert.Equal(t, []sqltypes.Value{sqltypes.Value("5")}, exec.Rows[0].Values)
+	for _, r := range results {
+		assert.NoError(t, r.Err)
+	}
+}
+
+func TestPipelineErrorAbortsBatch(t *testing.T) {
+	var backend bytes.Buffer
+	writeBackendMessage(&backend, protocol.MsgParseComplete, nil)
+	errBody := NewMessageWriter()
+	errBody.WriteByte(protocol.FieldSeverity)
+	errBody.WriteString("ERROR")
+	errBody.WriteByte(protocol.FieldCode)
+	errBody.WriteString("22P02")
+	errBody.WriteByte(protocol.FieldMessage)
+	errBody.WriteString("invalid input syntax for type integer")
+	errBody.WriteByte(0)
+	writeBackendMessage(&backend, protocol.MsgErrorResponse, errBody.Bytes())
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	ctx := context.Background()
+
+	p := conn.Pipeline()
+	require.NoError(t, p.QueueParse("", "SELECT $1::int", nil))
+	require.NoError(t, p.QueueBind("", "", [][]byte{[]byte("x")}, nil, nil))
+	require.NoError(t, p.QueueExecute("", 0))
+	require.NoError(t, p.Flush(ctx))
+
+	results, err := p.ReadResults(ctx)
+	var pgErr *Error
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "22P02", pgErr.Code)
+	require.Len(t, results, 3)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, pgErr, results[1].Err)
+	assert.ErrorIs(t, results[2].Err, ErrPipelineAborted)
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// PreparedStatement is a handle to a statement prepared on a Conn with
+// Prepare. It records the parameter types the server inferred and the
+// result columns, so that it can validate Bind arguments and attach the
+// column descriptions to results (Execute does not send RowDescription).
+type PreparedStatement struct {
+	conn *Conn
+
+	name      string
+	query     string
+	paramOIDs []uint32
+	fields    []*query.Field
+}
+
+// Prepare parses a statement and describes it in a single round trip
+// (Parse → Describe('S') → Sync).
+// name is the statement name (empty for the unnamed statement, which is
+// replaced by the next Parse).
+// paramTypes are the OIDs of parameter types (0 to let the server infer them).
+func (c *Conn) Prepare(ctx context.Context, name, queryStr string, paramTypes []uint32) (*PreparedStatement, error) {
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := c.writeParse(name, queryStr, paramTypes); err != nil {
+		return nil, fmt.Errorf("failed to write Parse: %w", err)
+	}
+
+	if err := c.writeDescribe('S', name); err != nil {
+		return nil, fmt.Errorf("failed to write Describe: %w", err)
+	}
+
+	if err := c.writeSync(); err != nil {
+		return nil, fmt.Errorf("failed to write Sync: %w", err)
+	}
+
+	if err := c.flush(); err != nil {
+		return nil, fmt.Errorf("failed to flush: %w", err)
+	}
+
+	desc, err := c.processDescribeResponses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paramOIDs := make([]uint32, len(desc.Parameters))
+	for i, p := range desc.Parameters {
+		paramOIDs[i] = p.DataTypeOid
+	}
+	return &PreparedStatement{
+		conn:      c,
+		name:      name,
+		query:     queryStr,
+		paramOIDs: paramOIDs,
+		fields:    desc.Fields,
+	}, nil
+}
+
+// Name returns the statement name.
+func (ps *PreparedStatement) Name() string {
+	return ps.name
+}
+
+// Query returns the SQL text of the statement.
+func (ps *PreparedStatement) Query() string {
+	return ps.query
+}
+
+// ParamOIDs returns the type OIDs of the statement's parameters.
+func (ps *PreparedStatement) ParamOIDs() []uint32 {
+	return ps.paramOIDs
+}
+
+// Fields returns the result columns in text format, or nil if the
+// statement returns no rows.
+func (ps *PreparedStatement) Fields() []*query.Field {
+	return ps.fields
+}
+
+// ResultFields returns the result columns as they will be sent for the
+// given result format codes, following the Bind message rules: no codes
+// means all text, one code applies to every column, otherwise there must be
+// one code per column.
+func (ps *PreparedStatement) ResultFields(resultFormats []int16) ([]*query.Field, error) {
+	if err := checkFormatCodes("result", resultFormats, len(ps.fields)); err != nil {
+		return nil, err
+	}
+	if len(resultFormats) == 0 {
+		return ps.fields, nil
+	}
+	fields := make([]*query.Field, len(ps.fields))
+	for i, f := range ps.fields {
+		format := resultFormats[0]
+		if len(resultFormats) > 1 {
+			format = resultFormats[i]
+		}
+		fields[i] = proto.Clone(f).(*query.Field)
+		fields[i].Format = int32(format)
+	}
+	return fields, nil
+}
+
+// Execute binds params to the statement and executes it (Bind → Execute →
+// Sync). Results are passed to callback with Fields set according to
+// resultFormats. The arguments follow BindAndExecute, except that the
+// number of params and format codes is validated against the statement
+// before anything is sent.
+// Returns true if the execution completed (CommandComplete), false if suspended (PortalSuspended).
+func (ps *PreparedStatement) Execute(ctx context.Context, params [][]byte, paramFormats, resultFormats []int16, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) (completed bool, err error) {
+	if len(params) != len(ps.paramOIDs) {
+		return false, fmt.Errorf("statement %q expects %d parameters, got %d", ps.name, len(ps.paramOIDs), len(params))
+	}
+	if err := checkFormatCodes("parameter", paramFormats, len(params)); err != nil {
+		return false, err
+	}
+	fields, err := ps.ResultFields(resultFormats)
+	if err != nil {
+		return false, err
+	}
+
+	return ps.conn.BindAndExecute(ctx, ps.name, params, paramFormats, resultFormats, maxRows,
+		func(ctx context.Context, result *sqltypes.Result) error {
+			if result.Fields == nil {
+				result.Fields = fields
+			}
+			if callback == nil {
+				return nil
+			}
+			return callback(ctx, result)
+		})
+}
+
+// Close closes the statement on the server.
+func (ps *PreparedStatement) Close(ctx context.Context) error {
+	return ps.conn.CloseStatement(ctx, ps.name)
+}
+
+// checkFormatCodes validates a list of format codes for n values: there
+// may be none (all text), one (applies to all) or exactly n, and each code
+// must be 0 (text) or 1 (binary).
+func checkFormatCodes(kind string, formats []int16, n int) error {
+	if len(formats) > 1 && len(formats) != n {
+		return fmt.Errorf("got %d %s format codes for %d values", len(formats), kind, n)
+	}
+	for _, f := range formats {
+		if f != int16(sqltypes.FormatText) && f != int16(sqltypes.FormatBinary) {
+			return fmt.Errorf("invalid %s format code %d", kind, f)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// newScriptedConn returns a Conn that reads the given backend messages and
+// records everything it writes in the returned buffer.
+func newScriptedConn(backend *bytes.Buffer) (*Conn, *bytes.Buffer) {
+	var frontend bytes.Buffer
+	return &Conn{
+		conn:           &mockNetConn{buf: &frontend},
+		bufferedReader: bufio.NewReader(backend),
+		bufferedWriter: bufio.NewWriter(&frontend),
+		serverParams:   make(map[string]string),
+	}, &frontend
+}
+
+// writeBackendMessage appends a backend message to buf.
+func writeBackendMessage(buf *bytes.Buffer, msgType byte, body []byte) {
+	w := NewMessageWriter()
+	w.WriteUint32(uint32(4 + len(body)))
+	buf.WriteByte(msgType)
+	buf.Write(w.Bytes())
+	buf.Write(body)
+}
+
+// rowDescriptionBody builds a RowDescription body for text columns.
+func rowDescriptionBody(names []string, oids []uint32) []byte {
+	w := NewMessageWriter()
+	w.WriteInt16(int16(len(names)))
+	for i, name := range names {
+		w.WriteString(name)
+		w.WriteUint32(0) // table OID
+		w.WriteInt16(0)  // attribute number
+		w.WriteUint32(oids[i])
+		w.WriteInt16(4)  // type size
+		w.WriteInt32(-1) // type modifier
+		w.WriteInt16(0)  // format
+	}
+	return w.Bytes()
+}
+
+func TestPrepareAndExecute(t *testing.T) {
+	var backend bytes.Buffer
+	// Responses to Parse → Describe('S') → Sync.
+	writeBackendMessage(&backend, protocol.MsgParseComplete, nil)
+	params := NewMessageWriter()
+	params.WriteInt16(1)
+	params.WriteUint32(23)
+	writeBackendMessage(&backend, protocol.MsgParameterDescription, params.Bytes())
+	writeBackendMessage(&backend, protocol.MsgRowDescription, rowDescriptionBody([]string{"id", "n"}, []uint32{23, 20}))
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+	// Responses to Bind → Execute → Sync.
+	writeBackendMessage(&backend, protocol.MsgBindComplete, nil)
+	row := NewMessageWriter()
+	row.WriteInt16(2)
+	row.WriteInt32(4)
+	row.WriteBytes([]byte{0, 0, 0, 7})
+	row.WriteInt32(-1)
+	writeBackendMessage(&backend, protocol.MsgDataRow, row.Bytes())
+	tag := NewMessageWriter()
+	tag.WriteString("SELECT 1")
+	writeBackendMessage(&backend, protocol.MsgCommandComplete, tag.Bytes())
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	ctx := context.Background()
+
+	ps, err := conn.Prepare(ctx, "s1", "SELECT $1::int4, NULL::int8", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "s1", ps.Name())
+	assert.Equal(t, []uint32{23}, ps.ParamOIDs())
+	require.Len(t, ps.Fields(), 2)
+
+	// Validation happens before anything is sent.
+	_, err = ps.Execute(ctx, nil, nil, nil, 0, nil)
+	assert.ErrorContains(t, err, "expects 1 parameters")
+	_, err = ps.Execute(ctx, [][]byte{[]byte("7")}, nil, []int16{1, 0, 1}, 0, nil)
+	assert.ErrorContains(t, err, "3 result format codes for 2 values")
+	_, err = ps.Execute(ctx, [][]byte{[]byte("7")}, []int16{2}, nil, 0, nil)
+	assert.ErrorContains(t, err, "invalid parameter format code 2")
+
+	var results []*sqltypes.Result
+	completed, err := ps.Execute(ctx, [][]byte{[]byte("7")}, nil, []int16{1, 0}, 0,
+		func(_ context.Context, r *sqltypes.Result) error {
+			results = append(results, r)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.True(t, completed)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Fields, 2)
+	assert.Equal(t, int32(1), results[0].Fields[0].Format)
+	assert.Equal(t, int32(0), results[0].Fields[1].Format)
+	// The statement's own fields are not modified.
+	assert.Equal(t, int32(0), ps.Fields()[0].Format)
+	assert.Equal(t, []sqltypes.Value{{0, 0, 0, 7}, nil}, results[0].Rows[0].Values)
+}
+
+func TestPrepareError(t *testing.T) {
+	var backend bytes.Buffer
+	errBody := NewMessageWriter()
+	errBody.WriteByte(protocol.FieldSeverity)
+	errBody.WriteString("ERROR")
+	errBody.WriteByte(protocol.FieldCode)
+	errBody.WriteString("42601")
+	errBody.WriteByte(protocol.FieldMessage)
+	errBody.WriteString("syntax error")
+	errBody.WriteByte(0)
+	writeBackendMessage(&backend, protocol.MsgErrorResponse, errBody.Bytes())
+	writeBackendMessage(&backend, protocol.MsgReadyForQuery, []byte{protocol.TxnStatusIdle})
+
+	conn, _ := newScriptedConn(&backend)
+	_, err := conn.Prepare(context.Background(), "", "SELEC", nil)
+	var pgErr *Error
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "42601", pgErr.Code)
+}
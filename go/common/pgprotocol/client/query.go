@@ -16,8 +16,8 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
 
 	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
@@ -130,6 +130,8 @@ func (c *Conn) Query(ctx context.Context, queryStr string) ([]*sqltypes.Result,
 // - On CommandComplete: remaining rows + CommandTag sent together (signals end of result set)
 // For small result sets, this means a single callback with Fields, Rows, and CommandTag.
 // For large result sets, multiple callbacks with rows, final one includes CommandTag.
+// Notices are sent in order with the rows: those of a Result were received
+// before its rows, and those received before an error are sent before it.
 // For multi-statement queries, this pattern repeats for each statement.
 //
 // A span is always created for query execution with database semantic conventions.
@@ -154,8 +156,11 @@ func (c *Conn) QueryStreaming(ctx context.Context, queryStr string, callback fun
 	ctx, span := telemetry.Tracer().Start(ctx, opName+" postgresql", attrs...)
 	defer span.End()
 
-	c.bufmu.Lock()
-	defer c.bufmu.Unlock()
+	unlock, err := c.lockContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// Send the Query message.
 	if err := c.writeQueryMessage(queryStr); err != nil {
@@ -165,7 +170,7 @@ func (c *Conn) QueryStreaming(ctx context.Context, queryStr string, callback fun
 	}
 
 	// Process responses.
-	err := c.processQueryResponses(ctx, callback)
+	err = c.processQueryResponses(ctx, callback)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "query failed")
@@ -176,6 +181,7 @@ func (c *Conn) QueryStreaming(ctx context.Context, queryStr string, callback fun
 // writeQueryMessage writes a 'Q' (Query) message.
 func (c *Conn) writeQueryMessage(queryStr string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteString(queryStr)
 	return c.writeMessage(protocol.MsgQuery, w.Bytes())
 }
@@ -202,11 +208,11 @@ func (c *Conn) processQueryResponses(ctx context.Context, callback func(ctx cont
 	// the connection, then return this error after ReadyForQuery.
 	var firstErr error
 
-	// flushBatch sends accumulated rows via callback and resets the batch.
+	// flushBatch sends accumulated rows and notices via callback and resets the batch.
 	// Does not reset currentFields as they may be needed for subsequent batches.
 	// Captures errors but does not return them - we continue draining.
 	flushBatch := func() {
-		if len(batchedRows) == 0 || callback == nil {
+		if (len(batchedRows) == 0 && len(notices) == 0) || callback == nil {
 			return
 		}
 		result := &sqltypes.Result{
@@ -290,13 +296,20 @@ func (c *Conn) processQueryResponses(ctx context.Context, callback func(ctx cont
 			return firstErr
 
 		case protocol.MsgErrorResponse:
-			// Capture the error but continue draining until ReadyForQuery.
+			// The rows and notices received before the error are sent
+			// first. Capture the error but continue draining until
+			// ReadyForQuery.
+			flushBatch()
 			if firstErr == nil {
 				firstErr = c.parseError(body)
 			}
 
 		case protocol.MsgNoticeResponse:
-			// Parse and accumulate notices to be included in the Result.
+			// The notices of a Result precede its rows, so the rows
+			// received before the notice are sent first.
+			if len(batchedRows) > 0 {
+				flushBatch()
+			}
 			notices = append(notices, c.parseNotice(body))
 
 		case protocol.MsgParameterStatus:
@@ -305,6 +318,23 @@ func (c *Conn) processQueryResponses(ctx context.Context, callback func(ctx cont
 				firstErr = c.handleParameterStatus(body)
 			}
 
+		case protocol.MsgCopyInResponse:
+			// The server is waiting for COPY data that Query cannot supply.
+			// Abort the COPY so the server answers with an error and
+			// ReadyForQuery instead of waiting forever.
+			if err := c.writeCopyFailNoLock("COPY FROM STDIN is not supported by Query; use CopyFrom"); err != nil {
+				return fmt.Errorf("failed to abort COPY: %w", err)
+			}
+
+		case protocol.MsgCopyOutResponse:
+			// Drain the COPY data below and report an error.
+			if firstErr == nil {
+				firstErr = errors.New("COPY TO STDOUT is not supported by Query; use CopyTo")
+			}
+
+		case protocol.MsgCopyData, protocol.MsgCopyDone:
+			// COPY TO STDOUT data being drained (see MsgCopyOutResponse).
+
 		default:
 			// Unexpected message type. Capture error but continue draining.
 			if firstErr == nil {
@@ -463,131 +493,42 @@ func parseRowsAffected(tag string) uint64 {
 
 // parseError parses an ErrorResponse message into an error.
 func (c *Conn) parseError(body []byte) error {
-	reader := NewMessageReader(body)
-
-	var severity, code, message, detail, hint string
-
-	for reader.Remaining() > 0 {
-		fieldType, err := reader.ReadByte()
-		if err != nil {
-			break
-		}
-		if fieldType == 0 {
-			break // End of fields.
-		}
-
-		value, err := reader.ReadString()
-		if err != nil {
-			break
-		}
-
-		switch fieldType {
-		case protocol.FieldSeverity:
-			severity = value
-		case protocol.FieldCode:
-			code = value
-		case protocol.FieldMessage:
-			message = value
-		case protocol.FieldDetail:
-			detail = value
-		case protocol.FieldHint:
-			hint = value
-		}
+	diag, err := protocol.ParseDiagnostic(body)
+	if err != nil {
+		return fmt.Errorf("malformed error response: %w", err)
 	}
 
 	return &Error{
-		Severity: severity,
-		Code:     code,
-		Message:  message,
-		Detail:   detail,
-		Hint:     hint,
+		Severity:             diag.Severity,
+		SeverityNonLocalized: diag.SeverityNonLocalized,
+		Code:                 diag.Code,
+		Message:              diag.Message,
+		Detail:               diag.Detail,
+		Hint:                 diag.Hint,
 	}
 }
 
 // parseNotice parses a NoticeResponse message into a sqltypes.Notice.
 func (c *Conn) parseNotice(body []byte) *sqltypes.Notice {
-	reader := NewMessageReader(body)
-
-	var severity, code, message, detail, hint string
-	var internalQuery, where string
-	var schema, table, column, dataType, constraint string
-	var position, internalPosition int32
-
-	for reader.Remaining() > 0 {
-		fieldType, err := reader.ReadByte()
-		if err != nil {
-			break
-		}
-		if fieldType == 0 {
-			break // End of fields.
-		}
-
-		value, err := reader.ReadString()
-		if err != nil {
-			break
-		}
-
-		switch fieldType {
-		case protocol.FieldSeverity:
-			severity = value
-		case protocol.FieldCode:
-			code = value
-		case protocol.FieldMessage:
-			message = value
-		case protocol.FieldDetail:
-			detail = value
-		case protocol.FieldHint:
-			hint = value
-		case protocol.FieldPosition:
-			if pos, err := strconv.ParseInt(value, 10, 32); err == nil {
-				position = int32(pos)
-			}
-		case protocol.FieldInternalPosition:
-			if pos, err := strconv.ParseInt(value, 10, 32); err == nil {
-				internalPosition = int32(pos)
-			}
-		case protocol.FieldInternalQuery:
-			internalQuery = value
-		case protocol.FieldWhere:
-			where = value
-		case protocol.FieldSchema:
-			schema = value
-		case protocol.FieldTable:
-			table = value
-		case protocol.FieldColumn:
-			column = value
-		case protocol.FieldDataType:
-			dataType = value
-		case protocol.FieldConstraint:
-			constraint = value
-		}
-	}
-
-	return &sqltypes.Notice{
-		Severity:         severity,
-		Code:             code,
-		Message:          message,
-		Detail:           detail,
-		Hint:             hint,
-		Position:         position,
-		InternalPosition: internalPosition,
-		InternalQuery:    internalQuery,
-		Where:            where,
-		Schema:           schema,
-		Table:            table,
-		Column:           column,
-		DataType:         dataType,
-		Constraint:       constraint,
+	diag, err := protocol.ParseDiagnostic(body)
+	if err != nil {
+		return &sqltypes.Notice{}
 	}
+	return diag.ToNotice()
 }
 
 // Error represents a PostgreSQL error response.
 type Error struct {
+	// Severity is the 'S' field, which may be localized.
 	Severity string
-	Code     string
-	Message  string
-	Detail   string
-	Hint     string
+	// SeverityNonLocalized is the 'V' field (PostgreSQL 9.6+), which is
+	// never translated.
+	SeverityNonLocalized string
+
+	Code    string
+	Message string
+	Detail  string
+	Hint    string
 }
 
 // Error implements the error interface.
@@ -602,3 +543,13 @@ func (e *Error) Error() string {
 func (e *Error) IsSQLState(code string) bool {
 	return e.Code == code
 }
+
+// IsFatal checks if the error terminated the session (FATAL or PANIC),
+// preferring the non-localized severity when the server sent one.
+func (e *Error) IsFatal() bool {
+	severity := e.SeverityNonLocalized
+	if severity == "" {
+		severity = e.Severity
+	}
+	return severity == "FATAL" || severity == "PANIC"
+}
@@ -132,6 +132,32 @@ func TestParseCommandComplete(t *testing.T) {
 	assert.Equal(t, "SELECT 42", tag)
 }
 
+func TestParseErrorLocalizedSeverity(t *testing.T) {
+	// A backend running with lc_messages=de_DE sends a translated 'S' field
+	// alongside the untranslated 'V' field.
+	w := NewMessageWriter()
+	w.WriteByte(protocol.FieldSeverity)
+	w.WriteString("FATAL")
+	w.WriteByte(protocol.FieldSeverityV)
+	w.WriteString("FATAL")
+	w.WriteByte(protocol.FieldCode)
+	w.WriteString("57P01")
+	w.WriteByte(protocol.FieldMessage)
+	w.WriteString("Verbindung wird abgebrochen")
+	w.WriteByte(0)
+
+	conn := &Conn{}
+	var pgErr *Error
+	require.True(t, errors.As(conn.parseError(w.Bytes()), &pgErr))
+	assert.Equal(t, "FATAL", pgErr.SeverityNonLocalized)
+	assert.True(t, pgErr.IsFatal())
+
+	pgErr.Severity = "SCHWERWIEGEND"
+	assert.True(t, pgErr.IsFatal())
+	pgErr.SeverityNonLocalized = ""
+	assert.False(t, pgErr.IsFatal())
+}
+
 func TestParseError(t *testing.T) {
 	// Build an ErrorResponse message body.
 	w := NewMessageWriter()
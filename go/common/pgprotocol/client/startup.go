@@ -16,6 +16,9 @@ package client
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"slices"
@@ -24,12 +27,13 @@ import (
 )
 
 // startup performs the connection startup handshake.
-// This includes SSL negotiation (if configured), sending the startup message,
-// and handling authentication.
-func (c *Conn) startup(ctx context.Context) error {
+// This includes SSL negotiation (unless mode disables it), sending the
+// startup message, and handling authentication.
+func (c *Conn) startup(ctx context.Context, mode SSLMode) error {
 	// Handle SSL if configured.
-	if c.config.TLSConfig != nil {
-		if err := c.negotiateSSL(); err != nil {
+	c.sslMode = mode
+	if mode != SSLModeDisable {
+		if err := c.negotiateSSL(ctx, mode); err != nil {
 			return fmt.Errorf("SSL negotiation failed: %w", err)
 		}
 	}
@@ -47,8 +51,16 @@ func (c *Conn) startup(ctx context.Context) error {
 	return nil
 }
 
-// negotiateSSL requests SSL from the server.
-func (c *Conn) negotiateSSL() error {
+// negotiateSSL requests SSL from the server and upgrades the connection to
+// TLS. In SSLModePrefer, a server that declines SSL is not an error and the
+// connection continues in plaintext. A failed TLS handshake returns an
+// error wrapping errTLSHandshake.
+func (c *Conn) negotiateSSL(ctx context.Context, mode SSLMode) error {
+	tlsConfig, err := c.config.buildTLSConfig(mode)
+	if err != nil {
+		return err
+	}
+
 	// Send SSLRequest message.
 	if err := c.writeSSLRequest(); err != nil {
 		return fmt.Errorf("failed to send SSL request: %w", err)
@@ -64,15 +76,30 @@ func (c *Conn) negotiateSSL() error {
 	}
 
 	if response == 'N' {
+		if mode == SSLModePrefer {
+			return nil
+		}
 		return errors.New("server does not support SSL")
 	}
 	if response != 'S' {
 		return fmt.Errorf("unexpected SSL response: %c", response)
 	}
 
+	// Any bytes received after 'S' and before the TLS handshake were not
+	// protected by TLS and could have been injected (CVE-2021-23222).
+	if c.bufferedReader.Buffered() > 0 {
+		return errors.New("received unencrypted data after SSL response")
+	}
+
 	// Upgrade to TLS.
-	// TODO: Implement TLS upgrade when needed.
-	return errors.New("TLS upgrade not yet implemented")
+	tlsConn := tls.Client(c.conn, tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return fmt.Errorf("%w: %w", errTLSHandshake, err)
+	}
+	c.conn = tlsConn
+	c.bufferedReader.Reset(tlsConn)
+	c.bufferedWriter.Reset(deadlineWriter{c})
+	return nil
 }
 
 // sendStartupMessage sends the startup message to the server.
@@ -104,6 +131,7 @@ func (c *Conn) sendStartupMessage() error {
 	// Write the startup packet (no message type, just length + body).
 	body := w.Bytes()
 	length := uint32(4 + len(body)) // length includes itself
+	c.traceSend(0, len(body))
 
 	// Write length.
 	if err := c.writeUint32(length); err != nil {
@@ -185,13 +213,24 @@ func (c *Conn) handleAuthenticationRequest(body []byte) error {
 	switch authType {
 	case protocol.AuthOk:
 		// Authentication successful, nothing more to do.
+		c.gss = nil
 		return nil
 
 	case protocol.AuthCleartextPassword:
-		return errors.New("server requested cleartext password authentication, which is not supported for security reasons")
+		if c.config == nil || !c.config.AllowCleartext {
+			return errors.New("server requested cleartext password authentication, which is not supported for security reasons (set AllowCleartext to enable)")
+		}
+		return c.sendPasswordMessage(c.config.Password)
 
 	case protocol.AuthMD5Password:
-		return errors.New("server requested MD5 password authentication, which is not supported for security reasons")
+		if c.config == nil || !c.config.AllowMD5 {
+			return errors.New("server requested MD5 password authentication, which is not supported for security reasons (set AllowMD5 to enable)")
+		}
+		salt, err := reader.ReadBytes(4)
+		if err != nil {
+			return fmt.Errorf("failed to read MD5 salt: %w", err)
+		}
+		return c.sendPasswordMessage(md5Password(c.config.User, c.config.Password, salt))
 
 	case protocol.AuthSASL:
 		// Read available SASL mechanisms.
@@ -216,11 +255,37 @@ func (c *Conn) handleAuthenticationRequest(body []byte) error {
 		scram := newScramClient(c, c.config.User, c.config.Password)
 		return scram.authenticate()
 
+	case protocol.AuthGSS, protocol.AuthSSPI:
+		return c.startGSSAuth()
+
+	case protocol.AuthGSSContinue:
+		token, err := reader.ReadBytes(reader.Remaining())
+		if err != nil {
+			return fmt.Errorf("failed to read GSSAPI token: %w", err)
+		}
+		return c.continueGSSAuth(token)
+
 	default:
 		return fmt.Errorf("unsupported authentication method: %d", authType)
 	}
 }
 
+// sendPasswordMessage sends a PasswordMessage containing the given password
+// (already hashed, for MD5 authentication).
+func (c *Conn) sendPasswordMessage(password string) error {
+	w := NewMessageWriter()
+	w.WriteString(password)
+	return c.writeMessage(protocol.MsgPasswordMsg, w.Bytes())
+}
+
+// md5Password computes the response to an MD5 authentication request:
+// "md5" + hex(md5(hex(md5(password + user)) + salt)).
+func md5Password(user, password string, salt []byte) string {
+	inner := md5.Sum([]byte(password + user))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
 // handleBackendKeyData handles a BackendKeyData message.
 func (c *Conn) handleBackendKeyData(body []byte) error {
 	if len(body) < 8 {
@@ -278,6 +343,7 @@ func (c *Conn) writeSSLRequest() error {
 	// - Length (4 bytes): 8
 	// - SSLRequestCode (4 bytes)
 
+	c.traceSend(0, 4)
 	if err := c.writeUint32(8); err != nil {
 		return err
 	}
@@ -15,6 +15,8 @@
 package client
 
 import (
+	"bufio"
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -60,6 +62,67 @@ func TestHandleAuthenticationRequest_RejectsMD5Password(t *testing.T) {
 	assert.Contains(t, err.Error(), "security")
 }
 
+func TestHandleAuthenticationRequest_AllowsCleartextPassword(t *testing.T) {
+	w := NewMessageWriter()
+	w.WriteInt32(protocol.AuthCleartextPassword)
+
+	var buf bytes.Buffer
+	conn := &Conn{
+		bufferedWriter: bufio.NewWriter(&buf),
+		config:         &Config{User: "postgres", Password: "secret", AllowCleartext: true},
+	}
+	require.NoError(t, conn.handleAuthenticationRequest(w.Bytes()))
+
+	msgType, body := readWrittenMessage(t, &buf)
+	assert.Equal(t, byte(protocol.MsgPasswordMsg), msgType)
+	assert.Equal(t, "secret\x00", string(body))
+}
+
+func TestHandleAuthenticationRequest_AllowsMD5Password(t *testing.T) {
+	w := NewMessageWriter()
+	w.WriteInt32(protocol.AuthMD5Password)
+	w.WriteBytes([]byte{0x01, 0x02, 0x03, 0x04})
+
+	var buf bytes.Buffer
+	conn := &Conn{
+		bufferedWriter: bufio.NewWriter(&buf),
+		config:         &Config{User: "postgres", Password: "secret", AllowMD5: true},
+	}
+	require.NoError(t, conn.handleAuthenticationRequest(w.Bytes()))
+
+	msgType, body := readWrittenMessage(t, &buf)
+	assert.Equal(t, byte(protocol.MsgPasswordMsg), msgType)
+	assert.Equal(t, "md5bb41a296aab6baccb36ff243a562abff\x00", string(body))
+
+	// Opting into MD5 does not enable cleartext.
+	w = NewMessageWriter()
+	w.WriteInt32(protocol.AuthCleartextPassword)
+	err := conn.handleAuthenticationRequest(w.Bytes())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AllowCleartext")
+}
+
+func TestHandleAuthenticationRequest_MD5MissingSalt(t *testing.T) {
+	w := NewMessageWriter()
+	w.WriteInt32(protocol.AuthMD5Password)
+
+	conn := &Conn{config: &Config{AllowMD5: true}}
+	err := conn.handleAuthenticationRequest(w.Bytes())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "salt")
+}
+
+// readWrittenMessage reads a single message written by the client.
+func readWrittenMessage(t *testing.T, buf *bytes.Buffer) (byte, []byte) {
+	t.Helper()
+	msgType, err := buf.ReadByte()
+	require.NoError(t, err)
+	reader := NewMessageReader(buf.Next(4))
+	length, err := reader.ReadUint32()
+	require.NoError(t, err)
+	return msgType, buf.Next(int(length) - 4)
+}
+
 func TestHandleAuthenticationRequest_RejectsUnsupportedMethod(t *testing.T) {
 	w := NewMessageWriter()
 	w.WriteInt32(99) // Unknown auth type
@@ -0,0 +1,166 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// errTLSHandshake marks the failure of the TLS handshake, after which the
+// connection cannot be used any more.
+var errTLSHandshake = errors.New("TLS handshake failed")
+
+// SSLMode controls whether and how the client uses TLS. The values and
+// semantics match libpq's sslmode connection parameter.
+type SSLMode string
+
+const (
+	// SSLModeDisable never uses TLS.
+	SSLModeDisable SSLMode = "disable"
+
+	// SSLModePrefer uses TLS if the server supports it, without verifying
+	// the server certificate, and falls back to plaintext otherwise,
+	// including on a new connection if the TLS handshake fails.
+	SSLModePrefer SSLMode = "prefer"
+
+	// SSLModeRequire always uses TLS. The server certificate is only
+	// verified if root certificates are configured, by sslrootcert or by
+	// the RootCAs of the TLSConfig (as libpq does for sslrootcert).
+	SSLModeRequire SSLMode = "require"
+
+	// SSLModeVerifyCA always uses TLS and verifies that the server
+	// certificate is signed by a trusted CA.
+	SSLModeVerifyCA SSLMode = "verify-ca"
+
+	// SSLModeVerifyFull always uses TLS, verifies the server certificate
+	// chain and checks that the certificate matches the server host name.
+	SSLModeVerifyFull SSLMode = "verify-full"
+)
+
+// ParseSSLMode parses a libpq sslmode value. An empty string is returned
+// as-is so that Config defaults apply.
+func ParseSSLMode(s string) (SSLMode, error) {
+	switch mode := SSLMode(s); mode {
+	case "", SSLModeDisable, SSLModePrefer, SSLModeRequire, SSLModeVerifyCA, SSLModeVerifyFull:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid sslmode %q", s)
+	}
+}
+
+// effectiveSSLMode returns the SSL mode to use for the connection. Unix
+// socket connections never use TLS. When SSLMode is unset, a non-nil
+// TLSConfig means TLS is required, with the server certificate verified as
+// the TLSConfig says, and otherwise TLS is disabled.
+func (c *Config) effectiveSSLMode() SSLMode {
+	if c.SocketFile != "" {
+		return SSLModeDisable
+	}
+	if c.SSLMode != "" {
+		return c.SSLMode
+	}
+	if c.TLSConfig != nil {
+		return SSLModeRequire
+	}
+	return SSLModeDisable
+}
+
+// buildTLSConfig returns the TLS configuration for the given mode. An
+// explicit TLSConfig is used as the base; the sslrootcert, sslcert and
+// sslkey options are layered on top of a copy of it, leaving it unchanged.
+func (c *Config) buildTLSConfig(mode SSLMode) (*tls.Config, error) {
+	var cfg *tls.Config
+	if c.TLSConfig != nil {
+		cfg = c.TLSConfig.Clone()
+	} else {
+		cfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = c.Host
+	}
+
+	if c.SSLCert != "" || c.SSLKey != "" {
+		if c.SSLCert == "" || c.SSLKey == "" {
+			return nil, errors.New("sslcert and sslkey must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(c.SSLCert, c.SSLKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		// Clone shares the slice of the caller's config.
+		cfg.Certificates = append(slices.Clone(cfg.Certificates), cert)
+	}
+
+	if c.SSLRootCert != "" {
+		pem, err := os.ReadFile(c.SSLRootCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sslrootcert: %w", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in sslrootcert %s", c.SSLRootCert)
+		}
+		cfg.RootCAs = roots
+	}
+
+	switch mode {
+	case SSLModePrefer:
+		cfg.InsecureSkipVerify = true
+	case SSLModeRequire:
+		if c.SSLMode == "" {
+			// A TLSConfig alone is used as it is, verifying the server
+			// certificate unless it says otherwise.
+			break
+		}
+		// libpq treats require as verify-ca when a root certificate is
+		// given. Verification is only skipped without any roots.
+		if cfg.RootCAs == nil {
+			cfg.InsecureSkipVerify = true
+		} else {
+			verifyChainOnly(cfg)
+		}
+	case SSLModeVerifyCA:
+		verifyChainOnly(cfg)
+	case SSLModeVerifyFull:
+		// Standard Go verification checks both the chain and the host name.
+	}
+	return cfg, nil
+}
+
+// verifyChainOnly configures cfg to verify the server certificate chain
+// against cfg.RootCAs (or the system roots) without checking the host name.
+func verifyChainOnly(cfg *tls.Config) {
+	roots := cfg.RootCAs
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("server did not present a certificate")
+		}
+		opts := x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, cert := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := cs.PeerCertificates[0].Verify(opts)
+		return err
+	}
+}
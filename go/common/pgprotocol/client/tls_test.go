@@ -0,0 +1,260 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// testCert returns a self-signed server certificate for "db.example.com" and
+// the path of a PEM file containing it, for use as sslrootcert.
+func testCert(t *testing.T) (tls.Certificate, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "db.example.com"},
+		DNSNames:              []string{"db.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	rootPath := filepath.Join(t.TempDir(), "root.crt")
+	require.NoError(t, os.WriteFile(rootPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, rootPath
+}
+
+// negotiateWithFakeServer runs negotiateSSL against an in-memory server that
+// answers the SSLRequest with response and, for 'S', completes a TLS
+// handshake and sends "ok" over the encrypted connection.
+func negotiateWithFakeServer(t *testing.T, config *Config, response byte, cert tls.Certificate) error {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		serverConn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer serverConn.Close()
+		request := make([]byte, 8)
+		if _, err := io.ReadFull(serverConn, request); err != nil {
+			return
+		}
+		if _, err := serverConn.Write([]byte{response}); err != nil || response != 'S' {
+			return
+		}
+		tlsConn := tls.Server(serverConn, &tls.Config{Certificates: []tls.Certificate{cert}})
+		if err := tlsConn.Handshake(); err != nil {
+			return
+		}
+		_, _ = tlsConn.Write([]byte("ok"))
+	}()
+
+	clientConn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	c := &Conn{
+		conn:           clientConn,
+		bufferedReader: bufio.NewReader(clientConn),
+		bufferedWriter: bufio.NewWriter(clientConn),
+		config:         config,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.negotiateSSL(ctx, config.effectiveSSLMode()); err != nil {
+		return err
+	}
+	if response == 'S' {
+		_, isTLS := c.conn.(*tls.Conn)
+		require.True(t, isTLS)
+		got := make([]byte, 2)
+		_, err := io.ReadFull(c.bufferedReader, got)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(got))
+	}
+	return nil
+}
+
+func TestNegotiateSSL(t *testing.T) {
+	cert, rootPath := testCert(t)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	trusted := x509.NewCertPool()
+	trusted.AddCert(leaf)
+
+	tests := []struct {
+		name     string
+		config   *Config
+		response byte
+		wantErr  string
+	}{
+		{name: "prefer accepts any certificate", config: &Config{Host: "10.0.0.1", SSLMode: SSLModePrefer}, response: 'S'},
+		{name: "prefer falls back to plaintext", config: &Config{Host: "10.0.0.1", SSLMode: SSLModePrefer}, response: 'N'},
+		{name: "require rejects plaintext", config: &Config{Host: "10.0.0.1", SSLMode: SSLModeRequire}, response: 'N', wantErr: "does not support SSL"},
+		{name: "require without root", config: &Config{Host: "10.0.0.1", SSLMode: SSLModeRequire}, response: 'S'},
+		{name: "legacy TLSConfig means require", config: &Config{Host: "10.0.0.1", TLSConfig: &tls.Config{InsecureSkipVerify: true}}, response: 'N', wantErr: "does not support SSL"},
+		{name: "legacy TLSConfig verifies its roots", config: &Config{Host: "db.example.com", TLSConfig: &tls.Config{RootCAs: trusted}}, response: 'S'},
+		{name: "legacy TLSConfig verifies the host name", config: &Config{Host: "other.example.com", TLSConfig: &tls.Config{RootCAs: trusted}}, response: 'S', wantErr: "other.example.com"},
+		{name: "legacy TLSConfig rejects untrusted", config: &Config{Host: "10.0.0.1", TLSConfig: &tls.Config{RootCAs: x509.NewCertPool()}}, response: 'S', wantErr: "certificate"},
+		{name: "legacy TLSConfig without roots verifies", config: &Config{Host: "db.example.com", TLSConfig: &tls.Config{}}, response: 'S', wantErr: "certificate"},
+		{name: "verify-ca ignores host name", config: &Config{Host: "10.0.0.1", SSLMode: SSLModeVerifyCA, SSLRootCert: rootPath}, response: 'S'},
+		{name: "verify-ca rejects untrusted", config: &Config{Host: "db.example.com", SSLMode: SSLModeVerifyCA}, response: 'S', wantErr: "certificate"},
+		{name: "verify-full", config: &Config{Host: "db.example.com", SSLMode: SSLModeVerifyFull, SSLRootCert: rootPath}, response: 'S'},
+		{name: "verify-full rejects host mismatch", config: &Config{Host: "other.example.com", SSLMode: SSLModeVerifyFull, SSLRootCert: rootPath}, response: 'S', wantErr: "other.example.com"},
+		{name: "client cert requires key", config: &Config{Host: "db.example.com", SSLMode: SSLModeRequire, SSLCert: rootPath}, response: 'S', wantErr: "sslcert and sslkey"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := negotiateWithFakeServer(t, tc.config, tc.response, cert)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBuildTLSConfigLeavesTLSConfigUnchanged(t *testing.T) {
+	cert, rootPath := testCert(t)
+	keyPath := filepath.Join(t.TempDir(), "client.key")
+	der, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	// Spare capacity would let append write into the caller's array.
+	certificates := make([]tls.Certificate, 1, 2)
+	certificates[0] = cert
+	base := &tls.Config{Certificates: certificates}
+	config := &Config{TLSConfig: base, SSLMode: SSLModeRequire, SSLRootCert: rootPath, SSLCert: rootPath, SSLKey: keyPath}
+
+	cfg, err := config.buildTLSConfig(SSLModeRequire)
+	require.NoError(t, err)
+	assert.Len(t, cfg.Certificates, 2)
+	assert.NotNil(t, cfg.RootCAs)
+	assert.Nil(t, base.RootCAs)
+	assert.False(t, base.InsecureSkipVerify)
+	assert.Nil(t, base.VerifyConnection)
+	assert.Len(t, base.Certificates, 1)
+	assert.Empty(t, certificates[:2][1].Certificate)
+}
+
+// TestConnectPreferFallsBack checks that, like libpq, prefer connects in
+// plaintext when the TLS handshake fails.
+func TestConnectPreferFallsBack(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	sslRequests := make(chan bool, 2)
+	go func() {
+		// The first connection accepts SSL but fails the handshake.
+		serverConn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		request := make([]byte, 8)
+		if _, err := io.ReadFull(serverConn, request); err != nil {
+			return
+		}
+		sslRequests <- true
+		_, _ = serverConn.Write([]byte{'S'})
+		serverConn.Close()
+
+		// The second one starts up in plaintext.
+		serverConn, err = listener.Accept()
+		if err != nil {
+			return
+		}
+		defer serverConn.Close()
+		header := make([]byte, 8)
+		if _, err := io.ReadFull(serverConn, header); err != nil {
+			return
+		}
+		sslRequests <- binary.BigEndian.Uint32(header[4:]) == protocol.SSLRequestCode
+		if _, err := io.ReadFull(serverConn, make([]byte, binary.BigEndian.Uint32(header)-8)); err != nil {
+			return
+		}
+		_, _ = serverConn.Write([]byte{'R', 0, 0, 0, 8, 0, 0, 0, 0, 'Z', 0, 0, 0, 5, 'I'})
+		_, _ = io.Copy(io.Discard, serverConn)
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := Connect(ctx, &Config{Host: "127.0.0.1", Port: addr.Port, User: "postgres", SSLMode: SSLModePrefer})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	assert.True(t, <-sslRequests)
+	assert.False(t, <-sslRequests, "the second connection must not request SSL")
+	assert.Equal(t, SSLModeDisable, conn.sslMode)
+}
+
+func TestEffectiveSSLMode(t *testing.T) {
+	assert.Equal(t, SSLModeDisable, (&Config{}).effectiveSSLMode())
+	assert.Equal(t, SSLModeRequire, (&Config{TLSConfig: &tls.Config{}}).effectiveSSLMode())
+	assert.Equal(t, SSLModeVerifyFull, (&Config{SSLMode: SSLModeVerifyFull}).effectiveSSLMode())
+	// Unix sockets never use TLS.
+	assert.Equal(t, SSLModeDisable, (&Config{SocketFile: "/tmp/.s.PGSQL.5432", SSLMode: SSLModeRequire}).effectiveSSLMode())
+}
+
+func TestParseSSLMode(t *testing.T) {
+	for _, s := range []string{"", "disable", "prefer", "require", "verify-ca", "verify-full"} {
+		mode, err := ParseSSLMode(s)
+		require.NoError(t, err)
+		assert.Equal(t, SSLMode(s), mode)
+	}
+	_, err := ParseSSLMode("allow-ish")
+	assert.Error(t, err)
+}
+
+func TestConnectRejectsInvalidSSLMode(t *testing.T) {
+	_, err := Connect(context.Background(), &Config{Host: "127.0.0.1", Port: 1, SSLMode: "allow-ish"})
+	assert.EqualError(t, err, `invalid sslmode "allow-ish"`)
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// MaxDiagnosticFieldLength is the maximum length in bytes of a single
+// ErrorResponse/NoticeResponse field value kept by ParseDiagnostic. Longer
+// values are truncated so a misbehaving peer cannot make us hold on to
+// arbitrarily large strings.
+const MaxDiagnosticFieldLength = 64 * 1024
+
+// diagnosticFieldOrder is the order in which EncodeDiagnostic writes fields.
+var diagnosticFieldOrder = []byte{
+	FieldSeverity,
+	FieldSeverityV,
+	FieldCode,
+	FieldMessage,
+	FieldDetail,
+	FieldHint,
+	FieldPosition,
+	FieldInternalPosition,
+	FieldInternalQuery,
+	FieldWhere,
+	FieldSchema,
+	FieldTable,
+	FieldColumn,
+	FieldDataType,
+	FieldConstraint,
+	FieldFile,
+	FieldLine,
+	FieldRoutine,
+}
+
+// ParseDiagnostic parses the body of an ErrorResponse or NoticeResponse
+// message. The returned diagnostic's MessageType is left unset; callers know
+// which message they read and should fill it in.
+//
+// Parsing is lenient, as the protocol requires of frontends: unknown field
+// codes are skipped, a missing final terminator is accepted, values longer
+// than MaxDiagnosticFieldLength are truncated and unparsable numeric fields
+// are left as zero. An error is returned only for a body without any field.
+func ParseDiagnostic(body []byte) (*sqltypes.PgDiagnostic, error) {
+	if len(body) == 0 || body[0] == 0 {
+		return nil, errors.New("diagnostic message has no fields")
+	}
+
+	d := &sqltypes.PgDiagnostic{}
+	for len(body) > 0 {
+		fieldType := body[0]
+		if fieldType == 0 {
+			break // End of fields.
+		}
+		body = body[1:]
+
+		var value []byte
+		if i := bytes.IndexByte(body, 0); i >= 0 {
+			value, body = body[:i], body[i+1:]
+		} else {
+			// Unterminated last field: take the rest of the message.
+			value, body = body, nil
+		}
+		setDiagnosticField(d, fieldType, truncateField(value))
+	}
+	return d, nil
+}
+
+// EncodeDiagnostic returns the body of an ErrorResponse or NoticeResponse
+// message carrying every non-empty field of d, including the final
+// terminator. The 'V' field is always sent, falling back to Severity when
+// the non-localized severity is unknown. Parsing the result with
+// ParseDiagnostic yields the same diagnostic.
+func EncodeDiagnostic(d *sqltypes.PgDiagnostic) []byte {
+	var buf bytes.Buffer
+	for _, fieldType := range diagnosticFieldOrder {
+		value, ok := diagnosticField(d, fieldType)
+		if !ok {
+			continue
+		}
+		buf.WriteByte(fieldType)
+		buf.WriteString(value)
+		buf.WriteByte(0)
+	}
+	buf.WriteByte(0)
+	return buf.Bytes()
+}
+
+// diagnosticField returns the encoded value of a field of d, and whether it
+// should be sent. Severity, code and message are always sent.
+func diagnosticField(d *sqltypes.PgDiagnostic, fieldType byte) (string, bool) {
+	switch fieldType {
+	case FieldSeverity:
+		return d.Severity, true
+	case FieldSeverityV:
+		return d.EffectiveSeverity(), true
+	case FieldCode:
+		return d.Code, true
+	case FieldMessage:
+		return d.Message, true
+	case FieldDetail:
+		return d.Detail, d.Detail != ""
+	case FieldHint:
+		return d.Hint, d.Hint != ""
+	case FieldPosition:
+		return strconv.Itoa(int(d.Position)), d.Position != 0
+	case FieldInternalPosition:
+		return strconv.Itoa(int(d.InternalPosition)), d.InternalPosition != 0
+	case FieldInternalQuery:
+		return d.InternalQuery, d.InternalQuery != ""
+	case FieldWhere:
+		return d.Where, d.Where != ""
+	case FieldSchema:
+		return d.Schema, d.Schema != ""
+	case FieldTable:
+		return d.Table, d.Table != ""
+	case FieldColumn:
+		return d.Column, d.Column != ""
+	case FieldDataType:
+		return d.DataType, d.DataType != ""
+	case FieldConstraint:
+		return d.Constraint, d.Constraint != ""
+	case FieldFile:
+		return d.File, d.File != ""
+	case FieldLine:
+		return strconv.Itoa(int(d.Line)), d.Line != 0
+	case FieldRoutine:
+		return d.Routine, d.Routine != ""
+	}
+	return "", false
+}
+
+// setDiagnosticField stores a parsed field value in d. Unknown field types
+// are ignored.
+func setDiagnosticField(d *sqltypes.PgDiagnostic, fieldType byte, value string) {
+	switch fieldType {
+	case FieldSeverity:
+		d.Severity = value
+	case FieldSeverityV:
+		d.SeverityNonLocalized = value
+	case FieldCode:
+		d.Code = value
+	case FieldMessage:
+		d.Message = value
+	case FieldDetail:
+		d.Detail = value
+	case FieldHint:
+		d.Hint = value
+	case FieldPosition:
+		d.Position = parseInt32Field(value)
+	case FieldInternalPosition:
+		d.InternalPosition = parseInt32Field(value)
+	case FieldInternalQuery:
+		d.InternalQuery = value
+	case FieldWhere:
+		d.Where = value
+	case FieldSchema:
+		d.Schema = value
+	case FieldTable:
+		d.Table = value
+	case FieldColumn:
+		d.Column = value
+	case FieldDataType:
+		d.DataType = value
+	case FieldConstraint:
+		d.Constraint = value
+	case FieldFile:
+		d.File = value
+	case FieldLine:
+		d.Line = parseInt32Field(value)
+	case FieldRoutine:
+		d.Routine = value
+	}
+}
+
+// parseInt32Field parses a numeric field, returning 0 if it is malformed.
+func parseInt32Field(value string) int32 {
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
+
+// truncateField converts a field value to a string of at most
+// MaxDiagnosticFieldLength bytes, without splitting a UTF-8 sequence.
+func truncateField(value []byte) string {
+	if len(value) <= MaxDiagnosticFieldLength {
+		return string(value)
+	}
+	value = value[:MaxDiagnosticFieldLength]
+	// Drop a trailing partial rune, if any.
+	for i := 0; i < utf8.UTFMax && len(value) > 0; i++ {
+		r, size := utf8.DecodeLastRune(value)
+		if r != utf8.RuneError || size != 1 {
+			break
+		}
+		value = value[:len(value)-1]
+	}
+	return string(value)
+}
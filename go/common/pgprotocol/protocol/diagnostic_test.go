@@ -0,0 +1,141 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func fullDiagnostic() *sqltypes.PgDiagnostic {
+	return &sqltypes.PgDiagnostic{
+		Severity:             "ERREUR",
+		SeverityNonLocalized: "ERROR",
+		Code:                 "23505",
+		Message:              "duplicate key value violates unique constraint",
+		Detail:               "Key (id)=(1) already exists.",
+		Hint:                 "Use ON CONFLICT.",
+		Position:             15,
+		InternalPosition:     3,
+		InternalQuery:        "SELECT 1",
+		Where:                "PL/pgSQL function f() line 3",
+		Schema:               "public",
+		Table:                "users",
+		Column:               "id",
+		DataType:             "integer",
+		Constraint:           "users_pkey",
+		File:                 "nbtinsert.c",
+		Line:                 666,
+		Routine:              "_bt_check_unique",
+	}
+}
+
+func TestDiagnosticRoundTrip(t *testing.T) {
+	want := fullDiagnostic()
+	got, err := ParseDiagnostic(EncodeDiagnostic(want))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestEncodeDiagnosticSeverityFallback(t *testing.T) {
+	got, err := ParseDiagnostic(EncodeDiagnostic(&sqltypes.PgDiagnostic{
+		Severity: "ERROR",
+		Code:     "XX000",
+		Message:  "boom",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "ERROR", got.SeverityNonLocalized)
+}
+
+func TestParseDiagnosticLenient(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want *sqltypes.PgDiagnostic
+	}{
+		{
+			name: "unknown field codes are skipped",
+			body: "SERROR\x00Zmystery\x00C42601\x00Msyntax error\x00\x00",
+			want: &sqltypes.PgDiagnostic{Severity: "ERROR", Code: "42601", Message: "syntax error"},
+		},
+		{
+			name: "missing final terminator",
+			body: "SERROR\x00C42601\x00Msyntax error\x00",
+			want: &sqltypes.PgDiagnostic{Severity: "ERROR", Code: "42601", Message: "syntax error"},
+		},
+		{
+			name: "unterminated last field",
+			body: "SERROR\x00Msyntax error",
+			want: &sqltypes.PgDiagnostic{Severity: "ERROR", Message: "syntax error"},
+		},
+		{
+			name: "malformed numeric fields",
+			body: "SERROR\x00Pabc\x00L99999999999\x00\x00",
+			want: &sqltypes.PgDiagnostic{Severity: "ERROR"},
+		},
+		{
+			name: "data after terminator is ignored",
+			body: "SERROR\x00\x00Mignored\x00",
+			want: &sqltypes.PgDiagnostic{Severity: "ERROR"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDiagnostic([]byte(tt.body))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseDiagnosticEmpty(t *testing.T) {
+	_, err := ParseDiagnostic(nil)
+	assert.Error(t, err)
+	_, err = ParseDiagnostic([]byte{0})
+	assert.Error(t, err)
+}
+
+func TestParseDiagnosticOversizedField(t *testing.T) {
+	// A 3-byte rune straddles the limit and must not be split.
+	long := strings.Repeat("a", MaxDiagnosticFieldLength-1) + "€" + "tail"
+	got, err := ParseDiagnostic([]byte("M" + long + "\x00\x00"))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", MaxDiagnosticFieldLength-1), got.Message)
+}
+
+func FuzzParseDiagnostic(f *testing.F) {
+	f.Add(EncodeDiagnostic(fullDiagnostic()))
+	f.Add([]byte("SERROR\x00C42601\x00Msyntax error\x00\x00"))
+	f.Add([]byte("SERROR\x00Pabc"))
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		d, err := ParseDiagnostic(body)
+		if err != nil {
+			return
+		}
+
+		// Encoding always sends 'V', so compare against the effective value.
+		d.SeverityNonLocalized = d.EffectiveSeverity()
+		again, err := ParseDiagnostic(EncodeDiagnostic(d))
+		require.NoError(t, err)
+		assert.Equal(t, d, again)
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// MessageTracer observes protocol messages as a connection sends and
+// receives them. msgType is the message type byte, or 0 for the untyped
+// packets of the startup phase (StartupMessage, SSLRequest, CancelRequest,
+// ...). length is the size of the message body, excluding the type byte and
+// the length field.
+//
+// Tracers are called synchronously on the connection's I/O path and must
+// be cheap.
+type MessageTracer interface {
+	OnSend(msgType byte, length int)
+	OnReceive(msgType byte, length int)
+}
+
+// Side identifies which end of a connection a tracer is attached to, since
+// the same type byte means different messages in each direction.
+type Side int
+
+const (
+	// Frontend is the client end: it sends frontend messages and receives
+	// backend messages.
+	Frontend Side = iota
+
+	// Backend is the server end: it sends backend messages and receives
+	// frontend messages.
+	Backend
+)
+
+var frontendMessageNames = map[byte]string{
+	0:               "StartupPacket",
+	MsgBind:         "Bind",
+	MsgClose:        "Close",
+	MsgDescribe:     "Describe",
+	MsgExecute:      "Execute",
+	MsgFunctionCall: "FunctionCall",
+	MsgFlush:        "Flush",
+	MsgParse:        "Parse",
+	MsgQuery:        "Query",
+	MsgSync:         "Sync",
+	MsgTerminate:    "Terminate",
+	MsgCopyFail:     "CopyFail",
+	MsgCopyData:     "CopyData",
+	MsgCopyDone:     "CopyDone",
+	MsgPasswordMsg:  "PasswordMessage",
+}
+
+var backendMessageNames = map[byte]string{
+	MsgParseComplete:         "ParseComplete",
+	MsgBindComplete:          "BindComplete",
+	MsgCloseComplete:         "CloseComplete",
+	MsgNotificationResponse:  "NotificationResponse",
+	MsgCommandComplete:       "CommandComplete",
+	MsgCopyData:              "CopyData",
+	MsgCopyDone:              "CopyDone",
+	MsgDataRow:               "DataRow",
+	MsgErrorResponse:         "ErrorResponse",
+	MsgCopyInResponse:        "CopyInResponse",
+	MsgCopyOutResponse:       "CopyOutResponse",
+	MsgEmptyQueryResponse:    "EmptyQueryResponse",
+	MsgBackendKeyData:        "BackendKeyData",
+	MsgNoticeResponse:        "NoticeResponse",
+	MsgAuthenticationRequest: "Authentication",
+	MsgParameterStatus:       "ParameterStatus",
+	MsgRowDescription:        "RowDescription",
+	MsgFunctionCallResponse:  "FunctionCallResponse",
+	MsgCopyBothResponse:      "CopyBothResponse",
+	MsgReadyForQuery:         "ReadyForQuery",
+	MsgNoData:                "NoData",
+	MsgPortalSuspended:       "PortalSuspended",
+	MsgParameterDescription:  "ParameterDescription",
+}
+
+// FrontendMessageName returns the name of a message sent by a client, or a
+// placeholder naming the type byte if it is unknown.
+func FrontendMessageName(msgType byte) string {
+	return messageName(frontendMessageNames, msgType)
+}
+
+// BackendMessageName returns the name of a message sent by a server, or a
+// placeholder naming the type byte if it is unknown.
+func BackendMessageName(msgType byte) string {
+	return messageName(backendMessageNames, msgType)
+}
+
+func messageName(names map[byte]string, msgType byte) string {
+	if name, ok := names[msgType]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(0x%02x)", msgType)
+}
+
+// LogTracer is a MessageTracer that logs every message at debug level with
+// its name, type byte and length, for diagnosing compatibility problems
+// with unusual drivers.
+type LogTracer struct {
+	logger *slog.Logger
+	side   Side
+}
+
+// NewLogTracer returns a LogTracer for the given end of a connection.
+func NewLogTracer(logger *slog.Logger, side Side) *LogTracer {
+	return &LogTracer{logger: logger, side: side}
+}
+
+// OnSend implements MessageTracer.
+func (t *LogTracer) OnSend(msgType byte, length int) {
+	t.log("send", msgType, length, t.side == Frontend)
+}
+
+// OnReceive implements MessageTracer.
+func (t *LogTracer) OnReceive(msgType byte, length int) {
+	t.log("receive", msgType, length, t.side == Backend)
+}
+
+func (t *LogTracer) log(direction string, msgType byte, length int, fromFrontend bool) {
+	if !t.logger.Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
+	name := BackendMessageName(msgType)
+	if fromFrontend {
+		name = FrontendMessageName(msgType)
+	}
+	t.logger.Debug("pg protocol message",
+		"direction", direction,
+		"message", name,
+		"type", fmt.Sprintf("%q", msgType),
+		"length", length)
+}
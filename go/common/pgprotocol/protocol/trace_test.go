@@ -0,0 +1,54 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageNames(t *testing.T) {
+	// The same type byte names different messages in each direction.
+	assert.Equal(t, "Describe", FrontendMessageName('D'))
+	assert.Equal(t, "DataRow", BackendMessageName('D'))
+	assert.Equal(t, "Sync", FrontendMessageName('S'))
+	assert.Equal(t, "ParameterStatus", BackendMessageName('S'))
+	assert.Equal(t, "StartupPacket", FrontendMessageName(0))
+	assert.Equal(t, "Unknown(0x7e)", BackendMessageName('~'))
+}
+
+func TestLogTracer(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	tracer := NewLogTracer(logger, Backend)
+	tracer.OnReceive(MsgSync, 0)
+	tracer.OnSend(MsgParameterStatus, 12)
+
+	out := buf.String()
+	assert.Contains(t, out, "direction=receive message=Sync type='S' length=0")
+	assert.Contains(t, out, "direction=send message=ParameterStatus type='S' length=12")
+}
+
+func TestLogTracerDisabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	NewLogTracer(logger, Frontend).OnSend(MsgQuery, 9)
+	assert.Empty(t, buf.String())
+}
@@ -109,6 +109,9 @@
 //
 //	SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>
 //
+// NewScramSHA256Hash derives such a verifier from a password with a random
+// salt, and ScramHash.Encode formats it for storage.
+//
 // The PasswordHashProvider interface abstracts the storage mechanism:
 //
 //	type PasswordHashProvider interface {
@@ -15,6 +15,7 @@
 package scram
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -32,6 +33,13 @@ const (
 
 	// MinSaltLength is the minimum salt length in bytes accepted for security.
 	MinSaltLength = 8
+
+	// DefaultIterationCount is the iteration count PostgreSQL uses for new
+	// verifiers (the default of scram_iterations).
+	DefaultIterationCount = 4096
+
+	// defaultSaltLength is the salt length PostgreSQL uses for new verifiers.
+	defaultSaltLength = 16
 )
 
 // ScramHash contains the parsed components of a PostgreSQL SCRAM-SHA-256 password hash.
@@ -128,6 +136,39 @@ func ParseScramSHA256Hash(hash string) (*ScramHash, error) {
 	}, nil
 }
 
+// NewScramSHA256Hash derives a SCRAM-SHA-256 verifier for password using a
+// fresh random salt, as PostgreSQL does for CREATE ROLE ... PASSWORD. Only
+// the verifier needs to be stored; the password cannot be recovered from it.
+func NewScramSHA256Hash(password string, iterations int) (*ScramHash, error) {
+	if iterations < MinIterationCount {
+		return nil, fmt.Errorf("iteration count %d below minimum %d (insecure)", iterations, MinIterationCount)
+	}
+
+	salt := make([]byte, defaultSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	saltedPassword := ComputeSaltedPassword(password, salt, iterations)
+	return &ScramHash{
+		Iterations: iterations,
+		Salt:       salt,
+		StoredKey:  ComputeStoredKey(ComputeClientKey(saltedPassword)),
+		ServerKey:  ComputeServerKey(saltedPassword),
+	}, nil
+}
+
+// Encode returns the verifier in PostgreSQL's format, as stored in
+// pg_authid.rolpassword and accepted by ParseScramSHA256Hash.
+func (h *ScramHash) Encode() string {
+	return fmt.Sprintf("%s$%d:%s$%s:%s",
+		ScramSHA256Prefix,
+		h.Iterations,
+		base64.StdEncoding.EncodeToString(h.Salt),
+		base64.StdEncoding.EncodeToString(h.StoredKey),
+		base64.StdEncoding.EncodeToString(h.ServerKey))
+}
+
 // IsScramSHA256Hash returns true if the hash string appears to be a SCRAM-SHA-256 hash.
 // This is a quick check based on the prefix; it does not validate the entire format.
 func IsScramSHA256Hash(hash string) bool {
@@ -196,6 +196,36 @@ func TestScramHashStruct(t *testing.T) {
 	})
 }
 
+func TestNewScramSHA256Hash(t *testing.T) {
+	hash, err := NewScramSHA256Hash("pencil", DefaultIterationCount)
+	require.NoError(t, err)
+	assert.Len(t, hash.Salt, defaultSaltLength)
+
+	// The verifier matches one derived from the same password and salt.
+	assert.Equal(t, createTestHash("pencil", hash.Salt, DefaultIterationCount), hash)
+
+	// Each verifier gets its own salt.
+	other, err := NewScramSHA256Hash("pencil", DefaultIterationCount)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash.Salt, other.Salt)
+
+	_, err = NewScramSHA256Hash("pencil", 1000)
+	assert.ErrorContains(t, err, "below minimum")
+}
+
+func TestScramHashEncode(t *testing.T) {
+	const encoded = "SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Oln6rNiYzlYY42lUpMtdJ7U90=:HKZfkuYXDxJboM9DFNR0yFNHpRx/rbdVdNOTk/V0v0Q="
+	hash, err := ParseScramSHA256Hash(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, encoded, hash.Encode())
+
+	generated, err := NewScramSHA256Hash("secret", DefaultIterationCount)
+	require.NoError(t, err)
+	parsed, err := ParseScramSHA256Hash(generated.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, generated, parsed)
+}
+
 func TestIsScramSHA256Hash(t *testing.T) {
 	t.Run("valid SCRAM-SHA-256 prefix", func(t *testing.T) {
 		assert.True(t, IsScramSHA256Hash("SCRAM-SHA-256$4096:salt$storedkey:serverkey"))
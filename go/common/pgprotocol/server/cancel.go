@@ -0,0 +1,164 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// Query cancellation
+//
+// Clients learn a (process ID, secret key) pair from the BackendKeyData
+// message sent at startup. The process ID is the connection ID and the
+// secret key is random, so neither reveals anything about the backends the
+// gateway talks to. To cancel a query, a client opens a new connection and
+// sends a CancelRequest with that pair. The listener looks up the
+// connection in its registry, checks the secret and cancels the context of
+// the query in flight, if any. The cancellation propagates through the
+// handler to the backend connection executing the query, which cancels it
+// on the PostgreSQL server.
+//
+// As in PostgreSQL, a cancel request gets no response, and one that names
+// an unknown connection, carries the wrong secret or arrives while no query
+// is running is silently ignored.
+
+// errCancelRequested is the cause of a query context cancelled by a
+// CancelRequest.
+var errCancelRequested = errors.New("cancel requested")
+
+// queryAbortedError is the cause of a query context cancelled by AbortQuery.
+type queryAbortedError struct {
+	err error
+}
+
+func (e *queryAbortedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *queryAbortedError) Unwrap() error {
+	return e.err
+}
+
+// registerConn adds c to the connections that cancel requests can target.
+func (l *Listener) registerConn(c *Conn) {
+	l.connsMu.Lock()
+	defer l.connsMu.Unlock()
+	l.conns[c.connectionID] = c
+}
+
+// unregisterConn removes c from the connections that cancel requests can
+// target.
+func (l *Listener) unregisterConn(c *Conn) {
+	l.connsMu.Lock()
+	defer l.connsMu.Unlock()
+	delete(l.conns, c.connectionID)
+}
+
+// cancelQuery cancels the query running on the connection identified by
+// processID, if secretKey matches its backend key. It reports whether a
+// query was cancelled.
+func (l *Listener) cancelQuery(processID, secretKey uint32) bool {
+	l.connsMu.Lock()
+	target, ok := l.conns[processID]
+	l.connsMu.Unlock()
+	if !ok {
+		return false
+	}
+
+	var want, got [4]byte
+	binary.BigEndian.PutUint32(want[:], target.backendKeyData)
+	binary.BigEndian.PutUint32(got[:], secretKey)
+	if subtle.ConstantTimeCompare(want[:], got[:]) != 1 {
+		return false
+	}
+	return target.cancelCurrentQuery()
+}
+
+// beginQuery returns the context in which to run a query and a function to
+// call once the query is done. Until then, a CancelRequest for this
+// connection cancels the context.
+func (c *Conn) beginQuery() (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(c.ctx)
+
+	c.queryMu.Lock()
+	c.queryCancel = cancel
+	c.queryMu.Unlock()
+
+	return ctx, func() {
+		c.queryMu.Lock()
+		c.queryCancel = nil
+		c.queryMu.Unlock()
+		cancel(nil)
+	}
+}
+
+// cancelCurrentQuery cancels the query in flight, if any, and reports
+// whether there was one.
+func (c *Conn) cancelCurrentQuery() bool {
+	return c.cancelQueryWithCause(errCancelRequested)
+}
+
+// CancelQuery cancels the query in flight, if any, as a CancelRequest for
+// the connection would, and reports whether there was one.
+func (c *Conn) CancelQuery() bool {
+	return c.cancelCurrentQuery()
+}
+
+// AbortQuery cancels the query in flight, if any, and reports whether there
+// was one. The query fails with err, whatever error the cancellation
+// surfaced as; e.g. the gateway aborts a query to resolve a deadlock it
+// detected across backends.
+func (c *Conn) AbortQuery(err error) bool {
+	return c.cancelQueryWithCause(&queryAbortedError{err: err})
+}
+
+func (c *Conn) cancelQueryWithCause(cause error) bool {
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+
+	if c.queryCancel == nil {
+		return false
+	}
+	c.queryCancel(cause)
+	return true
+}
+
+// queryError returns the error to report for a query that failed with err.
+// A query cancelled by a CancelRequest is reported as PostgreSQL does, and
+// one aborted by AbortQuery with the error it was aborted with, whatever
+// error the cancellation surfaced as.
+func queryError(ctx context.Context, err error) error {
+	cause := context.Cause(ctx)
+	var aborted *queryAbortedError
+	if errors.As(cause, &aborted) {
+		return aborted.err
+	}
+	if !errors.Is(cause, errCancelRequested) {
+		return err
+	}
+	return &sqltypes.PgDiagnostic{
+		MessageType:          sqltypes.DiagnosticError,
+		Severity:             "ERROR",
+		SeverityNonLocalized: "ERROR",
+		Code:                 sqlstate.QueryCanceled,
+		Message:              "canceling statement due to user request",
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestCancelQuery(t *testing.T) {
+	listener := testListener(t)
+	target := newConn(newMockConn(), listener, 7)
+	listener.registerConn(target)
+
+	// Nothing to cancel between queries.
+	assert.False(t, listener.cancelQuery(7, target.backendKeyData))
+
+	ctx, endQuery := target.beginQuery()
+	defer endQuery()
+
+	assert.False(t, listener.cancelQuery(7, target.backendKeyData+1), "wrong secret key")
+	assert.False(t, listener.cancelQuery(8, target.backendKeyData), "unknown connection")
+	require.NoError(t, ctx.Err())
+
+	assert.True(t, listener.cancelQuery(7, target.backendKeyData))
+	assert.ErrorIs(t, context.Cause(ctx), errCancelRequested)
+
+	listener.unregisterConn(target)
+	assert.False(t, listener.cancelQuery(7, target.backendKeyData), "closed connection")
+}
+
+func TestCancelRequestCancelsExecute(t *testing.T) {
+	var readBuf, writeBuf bytes.Buffer
+	started := make(chan struct{})
+	handler := &testHandler{
+		executeFunc: func(ctx context.Context, _ *Conn, _ string, _ int32, _ func(context.Context, *sqltypes.Result) error) error {
+			close(started)
+			<-ctx.Done()
+			return errors.New("rpc error: code = Canceled desc = context canceled")
+		},
+	}
+	conn := createExtendedQueryTestConn(t, &readBuf, &writeBuf, handler)
+	conn.connectionID = 42
+	conn.backendKeyData = 1234
+
+	listener := testListener(t)
+	listener.registerConn(conn)
+
+	writeTestInt32(&readBuf, int32(4+1+4))
+	writeTestString(&readBuf, "")
+	writeTestInt32(&readBuf, 0)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.handleExecute()
+	}()
+
+	// Send a CancelRequest on a separate connection, as clients do.
+	<-started
+	cancelConn := newConn(newMockConn(), listener, 43)
+	cancelPacket := cancelConn.conn.(*mockConn).readBuf
+	_ = binary.Write(cancelPacket, binary.BigEndian, uint32(16))
+	_ = binary.Write(cancelPacket, binary.BigEndian, uint32(protocol.CancelRequestCode))
+	_ = binary.Write(cancelPacket, binary.BigEndian, uint32(42))
+	_ = binary.Write(cancelPacket, binary.BigEndian, uint32(1234))
+	require.NoError(t, cancelConn.handleStartup())
+
+	require.NoError(t, <-errCh)
+
+	// The client sees the error PostgreSQL reports for a cancelled query.
+	msgType, _, body := readMessageTypeAndLength(t, &writeBuf)
+	assert.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+	assert.Contains(t, string(body), sqlstate.QueryCanceled)
+	assert.Contains(t, string(body), "canceling statement due to user request")
+}
+
+func TestQueryErrorWithoutCancelRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A query whose context ended for another reason keeps its error.
+	err := errors.New("connection closed")
+	assert.Equal(t, err, queryError(ctx, err))
+}
+
+func TestAbortQuery(t *testing.T) {
+	listener := testListener(t)
+	target := newConn(newMockConn(), listener, 7)
+
+	// Nothing to abort between queries.
+	assert.False(t, target.AbortQuery(errors.New("deadlock detected")))
+
+	ctx, endQuery := target.beginQuery()
+	defer endQuery()
+
+	abortErr := sqlstate.NewError(sqlstate.DeadlockDetected).Msg("deadlock detected").Err()
+	assert.True(t, target.AbortQuery(abortErr))
+	require.Error(t, ctx.Err())
+
+	// The query fails with the abort error, not the cancellation's.
+	assert.Equal(t, abortErr, queryError(ctx, errors.New("rpc error: code = Canceled desc = context canceled")))
+}
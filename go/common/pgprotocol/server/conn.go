@@ -18,11 +18,13 @@ import (
 	"bufio"
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -31,12 +33,19 @@ import (
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 	"github.com/multigres/multigres/go/common/pgprotocol/scram"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 )
 
 const (
 	// connBufferSize is the size of read and write buffers.
 	connBufferSize = 16 * 1024
 
+	// minReadBufferSize and maxReadBufferSize bound the buckets of the pool
+	// of message body buffers. Larger bodies are allocated directly and not
+	// pooled, so a single large Bind message cannot pin a huge buffer.
+	minReadBufferSize = 512
+	maxReadBufferSize = 1024 * 1024
+
 	// defaultFlushDelay is the default delay before auto-flushing buffered writes.
 	defaultFlushDelay = 100 * time.Millisecond
 )
@@ -69,15 +78,37 @@ type Conn struct {
 	// When set and AllowTrustAuth() returns true, password auth is skipped.
 	trustAuthProvider TrustAuthProvider
 
+	// tlsConfig, if set, is used to accept SSLRequests.
+	tlsConfig *tls.Config
+
+	// certAuthProvider, if set, authenticates clients that present a
+	// verified TLS client certificate.
+	certAuthProvider CertAuthProvider
+
 	// logger for connection-specific logging.
 	logger *slog.Logger
 
+	// tracer, if set, is told about every protocol message sent and received.
+	tracer protocol.MessageTracer
+
+	// readingType is the type of the message being read, remembered for
+	// tracing once its length is known.
+	readingType byte
+
 	// connectionID is a unique identifier for this connection.
 	connectionID uint32
 
+	// connectedAt is when the client connected.
+	connectedAt time.Time
+
 	// backendKeyData is the secret key for this backend, used for cancellation.
 	backendKeyData uint32
 
+	// queryCancel cancels the query in flight, if any. It is set while a
+	// query runs so that a CancelRequest from another connection can stop it.
+	queryMu     sync.Mutex
+	queryCancel context.CancelCauseFunc
+
 	// Startup parameters sent by the client.
 	user     string
 	database string
@@ -89,6 +120,37 @@ type Conn struct {
 	// Current transaction state.
 	txnStatus byte
 
+	// ignoreTillSync is set when an extended query message fails. As in
+	// PostgreSQL, the messages that follow are then discarded up to the next
+	// Sync, so that a pipelined batch stops at its first error.
+	ignoreTillSync bool
+
+	// notifyMu protects idle and pendingNotifications, and serializes
+	// writing notifications with the start of the next command.
+	notifyMu sync.Mutex
+
+	// idle is set while the connection waits for a command outside a
+	// transaction block, when notifications can be sent right away.
+	idle bool
+
+	// waiting is set while the connection waits for a command, in a
+	// transaction block or not. It is protected by notifyMu.
+	waiting bool
+
+	// pendingNotifications are the notifications that arrived while the
+	// connection was not idle (see SendNotification).
+	pendingNotifications []pendingNotification
+
+	// idleInTransactionTimeout is how long the connection may stay idle in
+	// a transaction block (see SetIdleInTransactionTimeout). It is
+	// protected by notifyMu, as are idleInTransactionTimer, running while
+	// the connection is idle in a transaction block, and
+	// idleInTransactionTimerGen, which tells a stale timer from the current
+	// one.
+	idleInTransactionTimeout  time.Duration
+	idleInTransactionTimer    *time.Timer
+	idleInTransactionTimerGen uint64
+
 	// state holds handler-specific connection state.
 	// Handlers can store their own state here by calling SetConnectionState.
 	// This allows different handler implementations to maintain their own state.
@@ -116,6 +178,7 @@ func newConn(netConn net.Conn, listener *Listener, connectionID uint32) *Conn {
 		conn:           netConn,
 		listener:       listener,
 		connectionID:   connectionID,
+		connectedAt:    time.Now(),
 		backendKeyData: generateBackendKey(),
 		logger:         listener.logger.With("connection_id", connectionID),
 		txnStatus:      protocol.TxnStatusIdle,
@@ -142,6 +205,10 @@ func (c *Conn) Close() error {
 
 	c.cancel()
 
+	if h, ok := c.handler.(ConnectionCloseHandler); ok {
+		h.HandleConnectionClose(c)
+	}
+
 	// Clean up handler-specific state (if any).
 	// The state is set to nil so handlers should handle nil-checking.
 	c.state = nil
@@ -169,6 +236,11 @@ func (c *Conn) ConnectionID() uint32 {
 	return c.connectionID
 }
 
+// ConnectedAt returns when the client connected.
+func (c *Conn) ConnectedAt() time.Time {
+	return c.connectedAt
+}
+
 // User returns the authenticated user.
 func (c *Conn) User() string {
 	return c.user
@@ -179,11 +251,29 @@ func (c *Conn) Database() string {
 	return c.database
 }
 
+// StartupParams returns a copy of the parameters the client sent in its
+// startup message, including user and database.
+func (c *Conn) StartupParams() map[string]string {
+	return maps.Clone(c.params)
+}
+
 // Context returns the connection's context.
 func (c *Conn) Context() context.Context {
 	return c.ctx
 }
 
+// TxnStatus returns the transaction status reported in ReadyForQuery.
+func (c *Conn) TxnStatus() byte {
+	return c.txnStatus
+}
+
+// SetTxnStatus sets the transaction status reported in the next
+// ReadyForQuery messages: protocol.TxnStatusIdle, TxnStatusInBlock or
+// TxnStatusFailed. Handlers call it while handling a message.
+func (c *Conn) SetTxnStatus(status byte) {
+	c.txnStatus = status
+}
+
 // GetConnectionState returns the handler-specific connection state.
 // Returns nil if no state has been set.
 func (c *Conn) GetConnectionState() any {
@@ -285,11 +375,15 @@ func (c *Conn) serve() error {
 	if err := c.handleStartup(); err != nil {
 		c.logger.Error("startup failed", "error", err)
 		// Try to send an error response before closing.
-		_ = c.writeErrorResponse("FATAL", "08P01", "connection startup failed", err.Error(), "")
+		_ = c.writeErrorResponse("FATAL", sqlstate.ProtocolViolation, "connection startup failed", err.Error(), "")
 		_ = c.flush()
 		return err
 	}
 
+	if err := c.setIdle(); err != nil {
+		return err
+	}
+
 	// Main command loop.
 	for {
 		// Check if connection is closed.
@@ -300,8 +394,10 @@ func (c *Conn) serve() error {
 		// Read the message type (1 byte).
 		msgType, err := c.ReadMessageType()
 		if err != nil {
-			// EOF or connection error - close gracefully.
-			if errors.Is(err, io.EOF) {
+			// EOF or connection error - close gracefully. The network
+			// connection may also have been closed under us, e.g. for
+			// staying idle in a transaction block for too long.
+			if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
 				c.logger.Debug("client closed connection")
 				return nil
 			}
@@ -309,21 +405,37 @@ func (c *Conn) serve() error {
 			return err
 		}
 
+		// Notifications must not interleave with the response, so they are
+		// queued until the command is done.
+		c.setBusy()
+
 		// Process the message based on type.
 		if err := c.handleMessage(msgType); err != nil {
 			c.logger.Error("error handling message", "type", string(msgType), "error", err)
 			// Send error response and continue (unless it's a fatal error).
-			_ = c.writeErrorResponse("ERROR", "XX000", "internal error", err.Error(), "")
+			_ = c.writeErrorResponse("ERROR", sqlstate.InternalError, "internal error", err.Error(), "")
 			_ = c.writeReadyForQuery()
 			_ = c.flush()
 			// For now, close connection on any error.
 			return err
 		}
+
+		// A Query or Sync is answered with ReadyForQuery, after which the
+		// client waits for the next command.
+		if msgType == protocol.MsgQuery || msgType == protocol.MsgSync {
+			if err := c.setIdle(); err != nil {
+				return err
+			}
+		}
 	}
 }
 
 // handleMessage processes a single message from the client.
 func (c *Conn) handleMessage(msgType byte) error {
+	if c.ignoreTillSync && msgType != protocol.MsgSync && msgType != protocol.MsgTerminate {
+		return c.discardMessage(msgType)
+	}
+
 	switch msgType {
 	case protocol.MsgQuery:
 		return c.handleQuery()
@@ -385,7 +497,9 @@ func (c *Conn) handleQuery() error {
 	// The callback will be invoked multiple times for:
 	// 1. Large result sets (streamed in chunks)
 	// 2. Multiple statements in a single query (each potentially with large result sets)
-	err = c.handler.HandleQuery(c.ctx, c, queryStr, func(ctx context.Context, result *sqltypes.Result) error {
+	queryCtx, endQuery := c.beginQuery()
+	defer endQuery()
+	err = c.handler.HandleQuery(queryCtx, c, queryStr, func(ctx context.Context, result *sqltypes.Result) error {
 		// Handle empty query (nil result signals empty query).
 		if result == nil {
 			return c.writeEmptyQueryResponse()
@@ -399,23 +513,26 @@ func (c *Conn) handleQuery() error {
 			sentRowDescription = true
 		}
 
+		// The notices of a chunk were received before its rows.
+		if err := c.writeNotices(result.Notices); err != nil {
+			return err
+		}
+
 		// Send all data rows in this chunk.
 		for _, row := range result.Rows {
 			if err := c.writeDataRow(row); err != nil {
 				return fmt.Errorf("writing data row: %w", err)
 			}
 		}
+		for _, row := range result.RawRows {
+			if err := c.writeRawDataRow(row); err != nil {
+				return fmt.Errorf("writing data row: %w", err)
+			}
+		}
 
 		// If CommandTag is set, this is the last packet of the current result set.
-		// Send notices (if any) before CommandComplete, then reset state for next result set.
+		// Send CommandComplete, then reset state for next result set.
 		if result.CommandTag != "" {
-			// Send any notices before CommandComplete.
-			for _, notice := range result.Notices {
-				if err := c.writeNoticeResponse(notice); err != nil {
-					return fmt.Errorf("writing notice response: %w", err)
-				}
-			}
-
 			if err := c.writeCommandComplete(result.CommandTag); err != nil {
 				return fmt.Errorf("writing command complete: %w", err)
 			}
@@ -427,12 +544,20 @@ func (c *Conn) handleQuery() error {
 		return nil
 	})
 	if err != nil {
+		err = queryError(queryCtx, err)
 		// Send error response with the actual error in the message for better visibility.
 		// lib/pq and other clients often only show the message field, not the detail field.
 		c.logger.Error("query execution failed", "query", queryStr, "error", err)
-		errMsg := fmt.Sprintf("query execution failed: %v", err)
-		if err := c.writeErrorResponse("ERROR", "42000", errMsg, "", ""); err != nil {
-			return err
+		var diag *sqltypes.PgDiagnostic
+		if errors.As(err, &diag) {
+			if err := c.writeDiagnosticResponse(diag); err != nil {
+				return err
+			}
+		} else {
+			errMsg := fmt.Sprintf("query execution failed: %v", err)
+			if err := c.writeErrorResponse("ERROR", sqlstate.SyntaxErrorOrAccessRuleViolation, errMsg, "", ""); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -499,13 +624,7 @@ func (c *Conn) handleParse() error {
 	// Call the handler to validate and prepare the statement.
 	// The handler is responsible for storing any state it needs.
 	if err := c.handler.HandleParse(c.ctx, c, stmtName, queryStr, paramTypes); err != nil {
-		if writeErr := c.writeErrorResponse("ERROR", "42000", "parse failed", err.Error(), ""); writeErr != nil {
-			return writeErr
-		}
-		if writeErr := c.writeReadyForQuery(); writeErr != nil {
-			return writeErr
-		}
-		return c.flush()
+		return c.writeExtendedQueryError(err, sqlstate.SyntaxErrorOrAccessRuleViolation, "parse failed")
 	}
 
 	// Send ParseComplete message.
@@ -593,13 +712,7 @@ func (c *Conn) handleBind() error {
 
 	// Call the handler to create and bind the portal with parameters.
 	if err := c.handler.HandleBind(c.ctx, c, portalName, stmtName, params, paramFormats, resultFormats); err != nil {
-		if writeErr := c.writeErrorResponse("ERROR", "42000", "bind failed", err.Error(), ""); writeErr != nil {
-			return writeErr
-		}
-		if writeErr := c.writeReadyForQuery(); writeErr != nil {
-			return writeErr
-		}
-		return c.flush()
+		return c.writeExtendedQueryError(err, sqlstate.SyntaxErrorOrAccessRuleViolation, "bind failed")
 	}
 
 	// Send BindComplete message.
@@ -651,7 +764,9 @@ func (c *Conn) handleExecute() error {
 
 	// Call the handler to execute the portal with streaming callback.
 	// The handler is responsible for retrieving the portal and executing it.
-	err = c.handler.HandleExecute(c.ctx, c, portalName, maxRows, func(ctx context.Context, result *sqltypes.Result) error {
+	queryCtx, endQuery := c.beginQuery()
+	defer endQuery()
+	err = c.handler.HandleExecute(queryCtx, c, portalName, maxRows, func(ctx context.Context, result *sqltypes.Result) error {
 		// On first callback with fields, send RowDescription.
 		if !sentRowDescription && len(result.Fields) > 0 {
 			if err := c.writeRowDescription(result.Fields); err != nil {
@@ -660,23 +775,25 @@ func (c *Conn) handleExecute() error {
 			sentRowDescription = true
 		}
 
+		// The notices of a chunk were received before its rows.
+		if err := c.writeNotices(result.Notices); err != nil {
+			return err
+		}
+
 		// Send all data rows in this chunk.
 		for _, row := range result.Rows {
 			if err := c.writeDataRow(row); err != nil {
 				return fmt.Errorf("writing data row: %w", err)
 			}
 		}
+		for _, row := range result.RawRows {
+			if err := c.writeRawDataRow(row); err != nil {
+				return fmt.Errorf("writing data row: %w", err)
+			}
+		}
 
 		// If CommandTag is set, this is the last packet.
-		// Send notices (if any) before CommandComplete.
 		if result.CommandTag != "" {
-			// Send any notices before CommandComplete.
-			for _, notice := range result.Notices {
-				if err := c.writeNoticeResponse(notice); err != nil {
-					return fmt.Errorf("writing notice response: %w", err)
-				}
-			}
-
 			if err := c.writeCommandComplete(result.CommandTag); err != nil {
 				return fmt.Errorf("writing command complete: %w", err)
 			}
@@ -685,10 +802,7 @@ func (c *Conn) handleExecute() error {
 		return nil
 	})
 	if err != nil {
-		if writeErr := c.writeErrorResponse("ERROR", "42000", "execution failed", err.Error(), ""); writeErr != nil {
-			return writeErr
-		}
-		return c.flush()
+		return c.writeExtendedQueryError(queryError(queryCtx, err), sqlstate.SyntaxErrorOrAccessRuleViolation, "execution failed")
 	}
 
 	return c.flush()
@@ -718,7 +832,7 @@ func (c *Conn) handleDescribe() error {
 
 	// Read name (null-terminated string).
 	nameBuf := make([]byte, nameLen)
-	if _, err := c.bufferedReader.Read(nameBuf); err != nil {
+	if _, err := io.ReadFull(c.bufferedReader, nameBuf); err != nil {
 		return fmt.Errorf("failed to read describe name: %w", err)
 	}
 
@@ -730,14 +844,12 @@ func (c *Conn) handleDescribe() error {
 	// Call the handler.
 	desc, err := c.handler.HandleDescribe(c.ctx, c, typ, name)
 	if err != nil {
-		if writeErr := c.writeErrorResponse("ERROR", "42P03", "describe failed", err.Error(), ""); writeErr != nil {
-			return writeErr
-		}
-		return c.flush()
+		return c.writeExtendedQueryError(err, sqlstate.DuplicateCursor, "describe failed")
 	}
 
-	// Send ParameterDescription if there are parameters.
-	if len(desc.Parameters) > 0 {
+	// Send ParameterDescription for a statement, even without parameters, as
+	// PostgreSQL does; portals have their parameters bound already.
+	if typ == 'S' || len(desc.Parameters) > 0 {
 		if err := c.writeParameterDescription(desc.Parameters); err != nil {
 			return fmt.Errorf("failed to write parameter description: %w", err)
 		}
@@ -782,7 +894,7 @@ func (c *Conn) handleClose() error {
 
 	// Read name (null-terminated string).
 	nameBuf := make([]byte, nameLen)
-	if _, err := c.bufferedReader.Read(nameBuf); err != nil {
+	if _, err := io.ReadFull(c.bufferedReader, nameBuf); err != nil {
 		return fmt.Errorf("failed to read close name: %w", err)
 	}
 
@@ -793,10 +905,7 @@ func (c *Conn) handleClose() error {
 
 	// Call the handler.
 	if err := c.handler.HandleClose(c.ctx, c, typ, name); err != nil {
-		if writeErr := c.writeErrorResponse("ERROR", "42P03", "close failed", err.Error(), ""); writeErr != nil {
-			return writeErr
-		}
-		return c.flush()
+		return c.writeExtendedQueryError(err, sqlstate.DuplicateCursor, "close failed")
 	}
 
 	// Send CloseComplete.
@@ -807,6 +916,31 @@ func (c *Conn) handleClose() error {
 	return c.flush()
 }
 
+// writeExtendedQueryError reports the failure of an extended query message
+// and discards the messages that follow up to the next Sync. Unlike the
+// simple query protocol, no ReadyForQuery is sent until that Sync.
+func (c *Conn) writeExtendedQueryError(err error, sqlState, message string) error {
+	c.ignoreTillSync = true
+	if writeErr := c.writeHandlerError(err, sqlState, message); writeErr != nil {
+		return writeErr
+	}
+	return c.flush()
+}
+
+// discardMessage reads and drops the body of a message of type msgType
+// while skipping to the next Sync.
+func (c *Conn) discardMessage(msgType byte) error {
+	bodyLen, err := c.ReadMessageLength()
+	if err != nil {
+		return fmt.Errorf("failed to read message length: %w", err)
+	}
+	if _, err := c.bufferedReader.Discard(bodyLen); err != nil {
+		return fmt.Errorf("failed to discard message: %w", err)
+	}
+	c.logger.Debug("discarded message while skipping to Sync", "type", string(msgType))
+	return nil
+}
+
 // handleSync handles an 'S' (Sync) message - extended query protocol.
 // Sync indicates the end of an extended query cycle and transaction boundary.
 // Always sends ReadyForQuery in response.
@@ -820,11 +954,12 @@ func (c *Conn) handleSync() error {
 	}
 
 	c.logger.Debug("sync")
+	c.ignoreTillSync = false
 
 	// Call the handler.
 	if err := c.handler.HandleSync(c.ctx, c); err != nil {
 		// Even if handler returns error, we still send ReadyForQuery after Sync.
-		if writeErr := c.writeErrorResponse("ERROR", "42000", "sync failed", err.Error(), ""); writeErr != nil {
+		if writeErr := c.writeHandlerError(err, sqlstate.SyntaxErrorOrAccessRuleViolation, "sync failed"); writeErr != nil {
 			return writeErr
 		}
 	}
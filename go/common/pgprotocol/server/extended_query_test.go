@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"log/slog"
 	"net"
 	"sync"
@@ -689,10 +690,11 @@ func TestHandleDescribe(t *testing.T) {
 				return
 			}
 
-			// Verify ParameterDescription message was sent (if statement has parameters).
+			// Verify ParameterDescription message was sent (always for a
+			// statement, for a portal if it has parameters).
 			msgType, _, body := readMessageTypeAndLength(t, &writeBuf)
 
-			if len(tt.paramTypes) > 0 {
+			if tt.describeType == 'S' || len(tt.paramTypes) > 0 {
 				// ParameterDescription message
 				assert.Equal(t, byte(protocol.MsgParameterDescription), msgType)
 
@@ -962,3 +964,54 @@ func TestExtendedQueryProtocolWithNullParameter(t *testing.T) {
 	assert.Equal(t, param1, portal.Parameters[0])
 	assert.Nil(t, portal.Parameters[1]) // NULL parameter
 }
+
+// TestExtendedQueryErrorSkipsToSync tests that after an error in an extended
+// query message, the following messages are discarded up to the next Sync,
+// which alone is answered with ReadyForQuery.
+func TestExtendedQueryErrorSkipsToSync(t *testing.T) {
+	var readBuf bytes.Buffer
+	var writeBuf bytes.Buffer
+	executed := false
+	handler := &testHandler{
+		parseFunc: func(ctx context.Context, conn *Conn, name, queryStr string, paramTypes []uint32) error {
+			return errors.New("syntax error")
+		},
+		executeFunc: func(ctx context.Context, conn *Conn, portalName string, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+			executed = true
+			return nil
+		},
+	}
+	conn := createExtendedQueryTestConn(t, &readBuf, &writeBuf, handler)
+
+	// A pipelined Parse, Bind, Execute, Sync batch whose Parse fails.
+	writeTestInt32(&readBuf, int32(4+1+len("SELEC 1")+1+2))
+	writeTestString(&readBuf, "")
+	writeTestString(&readBuf, "SELEC 1")
+	writeTestInt16(&readBuf, 0)
+	require.NoError(t, conn.handleMessage(protocol.MsgParse))
+
+	writeTestInt32(&readBuf, int32(4+1+1+2+2+2))
+	writeTestString(&readBuf, "")
+	writeTestString(&readBuf, "")
+	writeTestInt16(&readBuf, 0)
+	writeTestInt16(&readBuf, 0)
+	writeTestInt16(&readBuf, 0)
+	require.NoError(t, conn.handleMessage(protocol.MsgBind))
+
+	writeTestInt32(&readBuf, int32(4+1+4))
+	writeTestString(&readBuf, "")
+	writeTestInt32(&readBuf, 0)
+	require.NoError(t, conn.handleMessage(protocol.MsgExecute))
+	assert.False(t, executed, "Execute after a failed Parse should be discarded")
+
+	writeTestInt32(&readBuf, 4)
+	require.NoError(t, conn.handleMessage(protocol.MsgSync))
+	assert.False(t, conn.ignoreTillSync)
+	assert.Zero(t, readBuf.Len(), "all messages should be consumed")
+
+	msgType, _, _ := readMessageTypeAndLength(t, &writeBuf)
+	assert.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+	msgType, _, _ = readMessageTypeAndLength(t, &writeBuf)
+	assert.Equal(t, byte(protocol.MsgReadyForQuery), msgType)
+	assert.Zero(t, writeBuf.Len(), "nothing should be sent for the discarded messages")
+}
@@ -54,7 +54,8 @@ type Handler interface {
 	//   callback(chunk1_q2)        // Query 2, chunk 1, CommandTag=""
 	//   callback(chunk2_q2)        // Query 2, final chunk, CommandTag="SELECT 200" → CommandComplete
 	//
-	// After all callbacks complete, ReadyForQuery ('Z') is sent once.
+	// After all callbacks complete, ReadyForQuery ('Z') is sent once, with
+	// the transaction status the handler set with Conn.SetTxnStatus.
 	//
 	// Returns an error if query execution or result streaming fails.
 	HandleQuery(ctx context.Context, conn *Conn, query string, callback func(ctx context.Context, result *sqltypes.Result) error) error
@@ -94,5 +95,34 @@ type Handler interface {
 
 	// HandleSync processes a Sync message ('S').
 	// Called at the end of an extended query cycle to indicate transaction boundary.
+	// ReadyForQuery follows, with the transaction status the handler set with
+	// Conn.SetTxnStatus.
 	HandleSync(ctx context.Context, conn *Conn) error
 }
+
+// ConnectionCloseHandler may be implemented by a Handler that keeps
+// per-connection state outside of the connection itself, such as prepared
+// statements shared between connections. HandleConnectionClose is called
+// once when the connection closes, after which the connection must not be
+// used.
+type ConnectionCloseHandler interface {
+	HandleConnectionClose(conn *Conn)
+}
+
+// ConnectionStartHandler may be implemented by a Handler that decides
+// whether to admit a client once it has authenticated, for example to
+// enforce connection limits. HandleConnectionStart is called before
+// AuthenticationOk is sent; if it returns an error the error is sent to
+// the client as a FATAL error and the connection is closed.
+type ConnectionStartHandler interface {
+	HandleConnectionStart(ctx context.Context, conn *Conn) error
+}
+
+// NoticeHandler may be implemented by a Handler that filters or annotates
+// the notices sent to its clients, for example to drop those below the
+// client's client_min_messages. HandleNotice is called for each notice of
+// a result before it is sent, and returns the notice to send in its place,
+// or nil to drop it. It must not modify notice, which may be shared.
+type NoticeHandler interface {
+	HandleNotice(conn *Conn, notice *sqltypes.Notice) *sqltypes.Notice
+}
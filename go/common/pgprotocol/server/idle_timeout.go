@@ -0,0 +1,81 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// SetIdleInTransactionTimeout sets how long the connection may wait for a
+// command inside a transaction block before it is terminated, as PostgreSQL
+// does with idle_in_transaction_session_timeout, so that an abandoned
+// transaction does not hold its locks and backend connections forever. A
+// timeout of 0 disables it. The timeout applies from the next time the
+// connection becomes idle.
+func (c *Conn) SetIdleInTransactionTimeout(timeout time.Duration) {
+	c.notifyMu.Lock()
+	defer c.notifyMu.Unlock()
+	c.idleInTransactionTimeout = timeout
+}
+
+// startIdleInTransactionTimer starts the timer that terminates the
+// connection once it has been idle in a transaction block for too long.
+// notifyMu must be held.
+func (c *Conn) startIdleInTransactionTimer() {
+	c.stopIdleInTransactionTimer()
+	if c.idleInTransactionTimeout <= 0 {
+		return
+	}
+	gen := c.idleInTransactionTimerGen
+	c.idleInTransactionTimer = time.AfterFunc(c.idleInTransactionTimeout, func() {
+		c.terminateIdleInTransaction(gen)
+	})
+}
+
+// stopIdleInTransactionTimer stops the idle-in-transaction timer, if
+// running. notifyMu must be held.
+func (c *Conn) stopIdleInTransactionTimer() {
+	if c.idleInTransactionTimer == nil {
+		return
+	}
+	c.idleInTransactionTimer.Stop()
+	c.idleInTransactionTimer = nil
+	// A timer that fired already but has yet to lock notifyMu must not
+	// terminate the connection.
+	c.idleInTransactionTimerGen++
+}
+
+// terminateIdleInTransaction terminates the connection with the error
+// PostgreSQL reports, unless the timer of generation gen was stopped in
+// the meantime.
+func (c *Conn) terminateIdleInTransaction(gen uint64) {
+	c.notifyMu.Lock()
+	if gen != c.idleInTransactionTimerGen || c.closed.Load() {
+		c.notifyMu.Unlock()
+		return
+	}
+	c.idleInTransactionTimer = nil
+	c.logger.Info("terminating connection idle in transaction", "timeout", c.idleInTransactionTimeout)
+	_ = c.writeErrorResponse("FATAL", sqlstate.IdleInTransactionSessionTimeout,
+		"terminating connection due to idle-in-transaction timeout", "", "")
+	_ = c.flush()
+	c.notifyMu.Unlock()
+
+	// Closing the network connection ends the command loop, which then
+	// closes the connection itself.
+	_ = c.conn.Close()
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestIdleInTransactionTimeout(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer clientConn.Close()
+
+	c := newConn(serverConn, testListener(t), 1)
+	c.SetIdleInTransactionTimeout(20 * time.Millisecond)
+	c.SetTxnStatus(protocol.TxnStatusInBlock)
+	require.NoError(t, c.setIdle())
+
+	msgType, body := readMessage(t, clientConn)
+	require.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+	diag, err := protocol.ParseDiagnostic(body)
+	require.NoError(t, err)
+	assert.Equal(t, "FATAL", diag.Severity)
+	assert.Equal(t, sqlstate.IdleInTransactionSessionTimeout, diag.Code)
+	assert.Equal(t, "terminating connection due to idle-in-transaction timeout", diag.Message)
+
+	// The network connection is closed.
+	_, err = clientConn.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestIdleInTransactionTimeoutStopped(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(c *Conn)
+	}{
+		{
+			name: "idle outside a transaction block",
+			run: func(c *Conn) {
+				require.NoError(t, c.setIdle())
+			},
+		},
+		{
+			name: "busy again before the timeout",
+			run: func(c *Conn) {
+				c.SetTxnStatus(protocol.TxnStatusInBlock)
+				require.NoError(t, c.setIdle())
+				c.setBusy()
+			},
+		},
+		{
+			name: "timeout disabled",
+			run: func(c *Conn) {
+				c.SetIdleInTransactionTimeout(0)
+				c.SetTxnStatus(protocol.TxnStatusFailed)
+				require.NoError(t, c.setIdle())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			c := createTestConn(t, &buf)
+			c.logger = testLogger(t)
+			c.SetIdleInTransactionTimeout(10 * time.Millisecond)
+
+			tt.run(c)
+			time.Sleep(50 * time.Millisecond)
+
+			c.notifyMu.Lock()
+			defer c.notifyMu.Unlock()
+			assert.Zero(t, buf.Len(), "connection should not be terminated")
+		})
+	}
+}
@@ -17,6 +17,7 @@ package server
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -25,7 +26,9 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/common/pgprotocol/bufpool"
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 	"github.com/multigres/multigres/go/common/pgprotocol/scram"
 )
 
@@ -44,6 +47,15 @@ type Listener struct {
 	// When set and AllowTrustAuth() returns true, password auth is skipped.
 	trustAuthProvider TrustAuthProvider
 
+	// tlsConfig, if set, enables TLS for clients that send an SSLRequest.
+	tlsConfig *tls.Config
+
+	// certAuthProvider authenticates clients by their TLS client certificate.
+	certAuthProvider CertAuthProvider
+
+	// newMessageTracer creates per-connection protocol message tracers.
+	newMessageTracer func(connectionID uint32) protocol.MessageTracer
+
 	// logger for logging.
 	logger *slog.Logger
 
@@ -59,6 +71,11 @@ type Listener struct {
 	// nextConnectionID is an atomic counter for assigning connection IDs.
 	nextConnectionID atomic.Uint32
 
+	// conns holds the open connections by connection ID, so that cancel
+	// requests can find the connection whose query they cancel.
+	connsMu sync.Mutex
+	conns   map[uint32]*Conn
+
 	// wg tracks active connection handlers.
 	wg sync.WaitGroup
 
@@ -97,8 +114,26 @@ type ListenerConfig struct {
 	// Production code should NOT set this field.
 	TrustAuthProvider TrustAuthProvider
 
+	// TLSConfig, if set, enables TLS: SSLRequests are accepted and the
+	// connection is upgraded using this configuration. Otherwise SSLRequests
+	// are declined and clients continue in plaintext.
+	TLSConfig *tls.Config
+
+	// CertAuthProvider, if set, authenticates clients that present a TLS
+	// client certificate verified against TLSConfig.ClientCAs, without a
+	// password. Clients without a certificate fall back to SCRAM.
+	// Requires TLSConfig with ClientCAs and a ClientAuth that verifies
+	// certificates.
+	CertAuthProvider CertAuthProvider
+
 	// Logger for logging (optional, defaults to slog.Default()).
 	Logger *slog.Logger
+
+	// NewMessageTracer, if set, is called for each accepted connection to
+	// create a tracer that is told about every protocol message the
+	// connection sends and receives. It may return nil to leave a
+	// connection untraced.
+	NewMessageTracer func(connectionID uint32) protocol.MessageTracer
 }
 
 // NewListener creates a new PostgreSQL protocol listener.
@@ -112,6 +147,10 @@ func NewListener(config ListenerConfig) (*Listener, error) {
 		return nil, errors.New("hash provider is required (or TrustAuthProvider for testing)")
 	}
 
+	if err := validateTLSConfig(config); err != nil {
+		return nil, err
+	}
+
 	netListener, err := net.Listen("tcp", config.Address)
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen on %s: %w", config.Address, err)
@@ -129,7 +168,11 @@ func NewListener(config ListenerConfig) (*Listener, error) {
 		handler:           config.Handler,
 		hashProvider:      config.HashProvider,
 		trustAuthProvider: config.TrustAuthProvider,
+		tlsConfig:         config.TLSConfig,
+		certAuthProvider:  config.CertAuthProvider,
+		newMessageTracer:  config.NewMessageTracer,
 		logger:            logger,
+		conns:             make(map[uint32]*Conn),
 		ctx:               ctx,
 		cancel:            cancel,
 	}
@@ -145,7 +188,7 @@ func NewListener(config ListenerConfig) (*Listener, error) {
 			return bufio.NewWriterSize(nil, connBufferSize)
 		},
 	}
-	l.bufPool = bufpool.New(16*1024, 64*1024*1024) // 16 KB to 64 MB
+	l.bufPool = bufpool.New(minReadBufferSize, maxReadBufferSize)
 
 	logger.Info("PostgreSQL listener started", "address", config.Address)
 
@@ -174,6 +217,12 @@ func (l *Listener) Serve() error {
 		conn.handler = l.handler
 		conn.hashProvider = l.hashProvider
 		conn.trustAuthProvider = l.trustAuthProvider
+		conn.tlsConfig = l.tlsConfig
+		conn.certAuthProvider = l.certAuthProvider
+		if l.newMessageTracer != nil {
+			conn.tracer = l.newMessageTracer(connID)
+		}
+		l.registerConn(conn)
 
 		// Handle connection in a new goroutine.
 		l.wg.Go(func() {
@@ -184,15 +233,15 @@ func (l *Listener) Serve() error {
 
 // handleConnection handles a single client connection.
 func (l *Listener) handleConnection(conn *Conn) {
-	// Catch panics and ensure cleanup happens in all cases.
+	// Catch panics and ensure cleanup happens in all cases. A panic ends
+	// the session with an internal error instead of the process.
 	defer func() {
 		if x := recover(); x != nil {
-			conn.logger.Error("panic in connection handler",
-				"panic", x,
-				"remote_addr", conn.RemoteAddr())
+			conn.writePanicError(mterrors.RecoverPanic(l.ctx, conn.logger, "session", x))
 		}
 
 		// Clean up connection resources.
+		l.unregisterConn(conn)
 		if err := conn.Close(); err != nil {
 			conn.logger.Error("error closing connection", "error", err)
 		}
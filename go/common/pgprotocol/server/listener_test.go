@@ -0,0 +1,71 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// panickingHandler is a handler whose queries panic.
+type panickingHandler struct {
+	mockHandler
+}
+
+func (h *panickingHandler) HandleQuery(ctx context.Context, conn *Conn, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	panic("query handler bug")
+}
+
+func TestHandleConnectionPanic(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer clientConn.Close()
+
+	l := testListener(t)
+	c := newConn(serverConn, l, 1)
+	c.handler = &panickingHandler{}
+	c.hashProvider = l.hashProvider
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		l.handleConnection(c)
+	}()
+
+	writeStartupPacketToPipe(t, clientConn, protocol.ProtocolVersionNumber, map[string]string{"user": "postgres"})
+	scramClientHelper(t, clientConn, "postgres", "postgres")
+	writeMessage(t, clientConn, protocol.MsgQuery, []byte("SELECT 1\x00"))
+
+	// The session ends with an internal error, instead of the process.
+	msgType, body := readMessage(t, clientConn)
+	require.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+	diag, err := protocol.ParseDiagnostic(body)
+	require.NoError(t, err)
+	assert.Equal(t, "FATAL", diag.Severity)
+	assert.Equal(t, sqlstate.InternalError, diag.Code)
+	assert.Equal(t, "internal error", diag.Message)
+	assert.Contains(t, diag.Detail, "The error was logged with ID ")
+	assert.NotContains(t, diag.Detail, "query handler bug")
+
+	<-done
+	_, err = clientConn.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// maxPendingNotifications bounds the notifications queued for a connection
+// that is busy or inside a transaction block.
+const maxPendingNotifications = 10000
+
+// ErrNotificationQueueFull is returned by SendNotification when too many
+// notifications are already waiting to be delivered. The notification is
+// dropped.
+var ErrNotificationQueueFull = errors.New("notification queue is full")
+
+// pendingNotification is a notification waiting for the connection to
+// become idle.
+type pendingNotification struct {
+	pid     uint32
+	channel string
+	payload string
+}
+
+// SendNotification delivers a NotificationResponse ('A') to the client.
+// It may be called from any goroutine.
+//
+// As in PostgreSQL, notifications are only sent while the connection is
+// idle outside a transaction block. A notification that arrives while a
+// command is being processed or a transaction is open is queued and sent
+// once the connection becomes idle again.
+func (c *Conn) SendNotification(pid uint32, channel, payload string) error {
+	c.notifyMu.Lock()
+	defer c.notifyMu.Unlock()
+
+	if c.closed.Load() {
+		return nil
+	}
+	if !c.idle {
+		if len(c.pendingNotifications) >= maxPendingNotifications {
+			return ErrNotificationQueueFull
+		}
+		c.pendingNotifications = append(c.pendingNotifications, pendingNotification{pid, channel, payload})
+		return nil
+	}
+	if err := c.writeNotification(pid, channel, payload); err != nil {
+		return err
+	}
+	return c.flush()
+}
+
+// setBusy marks the connection as processing a command. Notifications
+// that arrive from now on are queued.
+func (c *Conn) setBusy() {
+	c.notifyMu.Lock()
+	defer c.notifyMu.Unlock()
+	c.idle = false
+	c.waiting = false
+	c.stopIdleInTransactionTimer()
+}
+
+// setIdle marks the connection as waiting for the next command and sends
+// the notifications queued in the meantime. The connection only counts as
+// idle outside a transaction block.
+func (c *Conn) setIdle() error {
+	c.notifyMu.Lock()
+	defer c.notifyMu.Unlock()
+
+	c.idle = c.txnStatus == protocol.TxnStatusIdle
+	c.waiting = true
+	if !c.idle {
+		c.startIdleInTransactionTimer()
+	}
+	if !c.idle || len(c.pendingNotifications) == 0 {
+		return nil
+	}
+
+	pending := c.pendingNotifications
+	c.pendingNotifications = nil
+	for _, n := range pending {
+		if err := c.writeNotification(n.pid, n.channel, n.payload); err != nil {
+			return err
+		}
+	}
+	return c.flush()
+}
+
+// writeNotification writes an 'A' (NotificationResponse) message.
+// Format:
+//   - Type: 'A'
+//   - Length: int32
+//   - Process ID of the notifying backend: int32
+//   - Channel name: null-terminated string
+//   - Payload: null-terminated string
+func (c *Conn) writeNotification(pid uint32, channel, payload string) error {
+	w := NewMessageWriter()
+	defer w.Release()
+	w.WriteUint32(pid)
+	w.WriteString(channel)
+	w.WriteString(payload)
+	return c.writeMessage(protocol.MsgNotificationResponse, w.Bytes())
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+)
+
+// readNotification reads a NotificationResponse from buf.
+func readNotification(t *testing.T, buf *bytes.Buffer) (uint32, string, string) {
+	t.Helper()
+	msgType, _, body := readMessageTypeAndLength(t, buf)
+	require.Equal(t, byte(protocol.MsgNotificationResponse), msgType)
+
+	r := NewMessageReader(body)
+	pid, err := r.ReadUint32()
+	require.NoError(t, err)
+	channel, err := r.ReadString()
+	require.NoError(t, err)
+	payload, err := r.ReadString()
+	require.NoError(t, err)
+	return pid, channel, payload
+}
+
+func TestSendNotificationWhileIdle(t *testing.T) {
+	var buf bytes.Buffer
+	conn := createTestConn(t, &buf)
+	require.NoError(t, conn.setIdle())
+
+	require.NoError(t, conn.SendNotification(42, "jobs", "hello"))
+
+	pid, channel, payload := readNotification(t, &buf)
+	assert.Equal(t, uint32(42), pid)
+	assert.Equal(t, "jobs", channel)
+	assert.Equal(t, "hello", payload)
+	assert.Zero(t, buf.Len())
+}
+
+func TestSendNotificationQueuedWhileBusy(t *testing.T) {
+	var buf bytes.Buffer
+	conn := createTestConn(t, &buf)
+	conn.setBusy()
+
+	require.NoError(t, conn.SendNotification(1, "a", "first"))
+	require.NoError(t, conn.SendNotification(2, "b", ""))
+	assert.Zero(t, buf.Len(), "notifications must wait for the command to finish")
+
+	require.NoError(t, conn.setIdle())
+	_, channel, payload := readNotification(t, &buf)
+	assert.Equal(t, "a", channel)
+	assert.Equal(t, "first", payload)
+	_, channel, payload = readNotification(t, &buf)
+	assert.Equal(t, "b", channel)
+	assert.Empty(t, payload)
+	assert.Zero(t, buf.Len())
+}
+
+func TestSendNotificationHeldInTransaction(t *testing.T) {
+	var buf bytes.Buffer
+	conn := createTestConn(t, &buf)
+	conn.txnStatus = protocol.TxnStatusInBlock
+	require.NoError(t, conn.setIdle())
+
+	require.NoError(t, conn.SendNotification(1, "jobs", ""))
+	assert.Zero(t, buf.Len(), "notifications are held until the transaction ends")
+
+	conn.txnStatus = protocol.TxnStatusIdle
+	require.NoError(t, conn.setIdle())
+	_, channel, _ := readNotification(t, &buf)
+	assert.Equal(t, "jobs", channel)
+}
+
+func TestSendNotificationQueueFull(t *testing.T) {
+	var buf bytes.Buffer
+	conn := createTestConn(t, &buf)
+	conn.setBusy()
+
+	for range maxPendingNotifications {
+		require.NoError(t, conn.SendNotification(1, "jobs", ""))
+	}
+	require.ErrorIs(t, conn.SendNotification(1, "jobs", ""), ErrNotificationQueueFull)
+}
@@ -19,9 +19,23 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/multigres/multigres/go/common/pgprotocol/bufpool"
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 )
 
+const (
+	// minWriterBufferSize is the initial capacity of a MessageWriter buffer.
+	minWriterBufferSize = 1024
+
+	// maxWriterBufferSize is the largest MessageWriter buffer kept for
+	// reuse. Buffers grown beyond it by large messages are left to the
+	// garbage collector rather than pinned in the pool.
+	maxWriterBufferSize = 64 * 1024
+)
+
+// writerBufPool recycles MessageWriter buffers, bucketed by size.
+var writerBufPool = bufpool.New(minWriterBufferSize, maxWriterBufferSize)
+
 // ReadMessageType reads a single byte message type from the connection.
 // Returns 0 and io.EOF if the connection is closed gracefully.
 func (c *Conn) ReadMessageType() (byte, error) {
@@ -30,6 +44,7 @@ func (c *Conn) ReadMessageType() (byte, error) {
 	if err != nil {
 		return 0, err
 	}
+	c.readingType = msgType[0]
 	return msgType[0], nil
 }
 
@@ -48,6 +63,8 @@ func (c *Conn) ReadMessageLength() (int, error) {
 		return 0, fmt.Errorf("invalid message length: %d", length)
 	}
 
+	c.traceReceive(c.readingType, int(length-4))
+
 	// Return body length (excluding the length field itself).
 	return int(length - 4), nil
 }
@@ -94,6 +111,7 @@ func (c *Conn) returnReadBuffer(buf []byte) {
 // readStartupPacket reads a startup packet (no message type byte).
 // Startup packets only have a length field followed by the body.
 func (c *Conn) readStartupPacket() ([]byte, error) {
+	c.readingType = 0
 	length, err := c.ReadMessageLength()
 	if err != nil {
 		return nil, err
@@ -109,6 +127,7 @@ func (c *Conn) readStartupPacket() ([]byte, error) {
 // writeMessage writes a complete message with type, length, and body.
 // The length is calculated automatically (includes length field, excludes type byte).
 func (c *Conn) writeMessage(msgType byte, body []byte) error {
+	c.traceSend(msgType, len(body))
 	writer := c.getWriter()
 
 	// Write message type.
@@ -133,6 +152,21 @@ func (c *Conn) writeMessage(msgType byte, body []byte) error {
 	return nil
 }
 
+// traceSend reports a message being sent to the connection's tracer, if any.
+func (c *Conn) traceSend(msgType byte, length int) {
+	if c.tracer != nil {
+		c.tracer.OnSend(msgType, length)
+	}
+}
+
+// traceReceive reports a message received to the connection's tracer, if
+// any. msgType is 0 for startup packets.
+func (c *Conn) traceReceive(msgType byte, length int) {
+	if c.tracer != nil {
+		c.tracer.OnReceive(msgType, length)
+	}
+}
+
 // writeByte writes a single byte.
 func (c *Conn) writeByte(w io.Writer, b byte) error {
 	buf := [1]byte{b}
@@ -279,13 +313,29 @@ func (r *MessageReader) ReadByteString() ([]byte, error) {
 }
 
 // MessageWriter provides helper methods for building message bodies.
+// Its buffer is taken from a pool; call Release once the message has been
+// written to make the buffer available to the next writer.
 type MessageWriter struct {
-	buf []byte
+	buf    []byte
+	pooled *[]byte
 }
 
 // NewMessageWriter creates a new message writer.
 func NewMessageWriter() *MessageWriter {
-	return &MessageWriter{buf: make([]byte, 0, 1024)}
+	pooled := writerBufPool.Get(minWriterBufferSize)
+	return &MessageWriter{buf: (*pooled)[:0], pooled: pooled}
+}
+
+// Release returns the writer's buffer to the pool. Neither the writer nor
+// any slice returned by Bytes may be used afterwards. Writers that are
+// never released are simply garbage collected.
+func (w *MessageWriter) Release() {
+	if w.pooled == nil {
+		return
+	}
+	*w.pooled = w.buf
+	writerBufPool.Put(w.pooled)
+	w.buf, w.pooled = nil, nil
 }
 
 // Bytes returns the accumulated message bytes.
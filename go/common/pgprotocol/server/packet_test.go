@@ -109,6 +109,21 @@ func TestMessageReaderEOF(t *testing.T) {
 	assert.ErrorIs(t, err, io.EOF)
 }
 
+func TestMessageWriterRelease(t *testing.T) {
+	w := NewMessageWriter()
+	w.WriteString("hello")
+	w.Release()
+	assert.Nil(t, w.Bytes())
+	w.Release() // Releasing twice is a no-op.
+
+	// A recycled buffer must come back empty.
+	w = NewMessageWriter()
+	assert.Empty(t, w.Bytes())
+	w.WriteString("world")
+	assert.Equal(t, []byte("world\x00"), w.Bytes())
+	w.Release()
+}
+
 func TestMessageWriterWriteByte(t *testing.T) {
 	w := NewMessageWriter()
 	w.WriteByte(0x01)
@@ -220,3 +235,50 @@ func (m *mockNetConn) RemoteAddr() net.Addr               { return nil }
 func (m *mockNetConn) SetDeadline(t time.Time) error      { return nil }
 func (m *mockNetConn) SetReadDeadline(t time.Time) error  { return nil }
 func (m *mockNetConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// tracedMessage is a message reported to a recordingTracer.
+type tracedMessage struct {
+	send    bool
+	msgType byte
+	length  int
+}
+
+// recordingTracer is a protocol.MessageTracer that records every message.
+type recordingTracer struct {
+	messages []tracedMessage
+}
+
+func (r *recordingTracer) OnSend(msgType byte, length int) {
+	r.messages = append(r.messages, tracedMessage{send: true, msgType: msgType, length: length})
+}
+
+func (r *recordingTracer) OnReceive(msgType byte, length int) {
+	r.messages = append(r.messages, tracedMessage{msgType: msgType, length: length})
+}
+
+func TestMessageTracer(t *testing.T) {
+	var readBuf bytes.Buffer
+	var writeBuf bytes.Buffer
+	conn := createExtendedQueryTestConn(t, &readBuf, &writeBuf, &testHandler{})
+	tracer := &recordingTracer{}
+	conn.tracer = tracer
+
+	// An untyped startup packet followed by a Sync.
+	writeTestInt32(&readBuf, 8)
+	writeTestInt32(&readBuf, int32(protocol.SSLRequestCode))
+	readBuf.WriteByte(protocol.MsgSync)
+	writeTestInt32(&readBuf, 4)
+
+	_, err := conn.readStartupPacket()
+	require.NoError(t, err)
+	msgType, err := conn.ReadMessageType()
+	require.NoError(t, err)
+	require.Equal(t, byte(protocol.MsgSync), msgType)
+	require.NoError(t, conn.handleSync())
+
+	assert.Equal(t, []tracedMessage{
+		{msgType: 0, length: 4},
+		{msgType: protocol.MsgSync, length: 0},
+		{send: true, msgType: protocol.MsgReadyForQuery, length: 1},
+	}, tracer.messages)
+}
@@ -19,8 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strconv"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 	"github.com/multigres/multigres/go/common/sqltypes"
 	"github.com/multigres/multigres/go/pb/query"
@@ -32,25 +32,22 @@ import (
 //   - Length: int32 (includes length field itself)
 //   - Query string: null-terminated string
 func (c *Conn) readQueryMessage() (string, error) {
-	// Read the message length (includes itself, 4 bytes).
-	var length int32
-	if err := binary.Read(c.bufferedReader, binary.BigEndian, &length); err != nil {
+	bodySize, err := c.ReadMessageLength()
+	if err != nil {
 		return "", fmt.Errorf("reading query length: %w", err)
 	}
 
-	// Validate length (must be at least 4 for the length field + 1 for null terminator).
-	if length < 5 {
-		return "", fmt.Errorf("invalid query message length: %d", length)
+	// The body must at least hold the null terminator.
+	if bodySize < 1 {
+		return "", fmt.Errorf("invalid query message length: %d", bodySize+4)
 	}
 
-	// Calculate body size (length - 4 bytes for length field).
-	bodySize := length - 4
-
 	// Read the query string.
-	queryBytes := make([]byte, bodySize)
-	if _, err := io.ReadFull(c.bufferedReader, queryBytes); err != nil {
+	queryBytes, err := c.readMessageBody(bodySize)
+	if err != nil {
 		return "", fmt.Errorf("reading query body: %w", err)
 	}
+	defer c.returnReadBuffer(queryBytes)
 
 	// Verify null terminator.
 	if queryBytes[len(queryBytes)-1] != 0 {
@@ -76,6 +73,7 @@ func (c *Conn) writeParameterDescription(params []*query.ParameterDescription) e
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(protocol.MsgParameterDescription, size-4)
 	if err := writeByte(w, protocol.MsgParameterDescription); err != nil {
 		return err
 	}
@@ -133,6 +131,7 @@ func (c *Conn) writeRowDescription(fields []*query.Field) error {
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(protocol.MsgRowDescription, size-4)
 	if err := writeByte(w, protocol.MsgRowDescription); err != nil {
 		return err
 	}
@@ -209,6 +208,7 @@ func (c *Conn) writeDataRow(row *sqltypes.Row) error {
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(protocol.MsgDataRow, size-4)
 	if err := writeByte(w, protocol.MsgDataRow); err != nil {
 		return err
 	}
@@ -244,6 +244,62 @@ func (c *Conn) writeDataRow(row *sqltypes.Row) error {
 	return nil
 }
 
+// writeRawDataRow writes a 'D' (DataRow) message from a row as the pooler
+// sent it, its values packed after their lengths, without splitting them
+// (see sqltypes.Result.RawRows). The format is that of writeDataRow.
+func (c *Conn) writeRawDataRow(row *query.Row) error {
+	// The packed values must hold exactly the non-NULL values.
+	packed := 0
+	for _, length := range row.Lengths {
+		packed += max(int(length), 0)
+	}
+	if packed != len(row.Values) {
+		return fmt.Errorf("data row values of %d bytes do not match their lengths of %d bytes", len(row.Values), packed)
+	}
+
+	// Calculate message size.
+	size := 4 + 2 + 4*len(row.Lengths) + len(row.Values) // length + column count + value lengths + values
+
+	w := c.getWriter()
+
+	// Write message type.
+	c.traceSend(protocol.MsgDataRow, size-4)
+	if err := writeByte(w, protocol.MsgDataRow); err != nil {
+		return err
+	}
+
+	// Write message length.
+	if err := writeInt32(w, int32(size)); err != nil {
+		return err
+	}
+
+	// Write column count.
+	if err := writeInt16(w, int16(len(row.Lengths))); err != nil {
+		return err
+	}
+
+	// Write each column value, sliced from the packed values.
+	offset := 0
+	for _, length := range row.Lengths {
+		if length < 0 {
+			// NULL value.
+			if err := writeInt32(w, -1); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeInt32(w, int32(length)); err != nil {
+			return err
+		}
+		if _, err := w.Write(row.Values[offset : offset+int(length)]); err != nil {
+			return fmt.Errorf("writing data row value: %w", err)
+		}
+		offset += int(length)
+	}
+
+	return nil
+}
+
 // writeCommandComplete writes a 'C' (CommandComplete) message.
 // Format:
 //   - Type: 'C'
@@ -256,6 +312,7 @@ func (c *Conn) writeCommandComplete(tag string) error {
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(protocol.MsgCommandComplete, size-4)
 	if err := writeByte(w, protocol.MsgCommandComplete); err != nil {
 		return err
 	}
@@ -282,6 +339,7 @@ func (c *Conn) writeReadyForQuery() error {
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(protocol.MsgReadyForQuery, 1)
 	if err := writeByte(w, protocol.MsgReadyForQuery); err != nil {
 		return err
 	}
@@ -308,6 +366,7 @@ func (c *Conn) writeEmptyQueryResponse() error {
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(protocol.MsgEmptyQueryResponse, 0)
 	if err := writeByte(w, protocol.MsgEmptyQueryResponse); err != nil {
 		return err
 	}
@@ -335,123 +394,87 @@ func (c *Conn) writeEmptyQueryResponse() error {
 //   - 'D': Detail
 //   - 'H': Hint
 func (c *Conn) writeErrorResponse(severity, sqlState, message, detail, hint string) error {
-	// Build the fields map.
-	fields := make(map[byte]string)
-	fields[protocol.FieldSeverity] = severity
-	fields[protocol.FieldSeverityV] = severity // Non-localized version
-	fields[protocol.FieldCode] = sqlState
-	fields[protocol.FieldMessage] = message
-	if detail != "" {
-		fields[protocol.FieldDetail] = detail
-	}
-	if hint != "" {
-		fields[protocol.FieldHint] = hint
-	}
-
-	return c.writeErrorOrNotice(protocol.MsgErrorResponse, fields)
+	return c.writeDiagnosticMessage(protocol.MsgErrorResponse, &sqltypes.PgDiagnostic{
+		Severity: severity,
+		Code:     sqlState,
+		Message:  message,
+		Detail:   detail,
+		Hint:     hint,
+	})
 }
 
 // writeNoticeResponse writes an 'N' (NoticeResponse) message.
 // Format is identical to ErrorResponse but with different severity levels.
 func (c *Conn) writeNoticeResponse(notice *sqltypes.Notice) error {
-	fields := make(map[byte]string)
-	fields[protocol.FieldSeverity] = notice.Severity
-	fields[protocol.FieldSeverityV] = notice.Severity
-	fields[protocol.FieldCode] = notice.Code
-	fields[protocol.FieldMessage] = notice.Message
-	if notice.Detail != "" {
-		fields[protocol.FieldDetail] = notice.Detail
-	}
-	if notice.Hint != "" {
-		fields[protocol.FieldHint] = notice.Hint
-	}
-	if notice.Position != 0 {
-		fields[protocol.FieldPosition] = strconv.Itoa(int(notice.Position))
-	}
-	if notice.InternalPosition != 0 {
-		fields[protocol.FieldInternalPosition] = strconv.Itoa(int(notice.InternalPosition))
-	}
-	if notice.InternalQuery != "" {
-		fields[protocol.FieldInternalQuery] = notice.InternalQuery
-	}
-	if notice.Where != "" {
-		fields[protocol.FieldWhere] = notice.Where
-	}
-	if notice.Schema != "" {
-		fields[protocol.FieldSchema] = notice.Schema
-	}
-	if notice.Table != "" {
-		fields[protocol.FieldTable] = notice.Table
-	}
-	if notice.Column != "" {
-		fields[protocol.FieldColumn] = notice.Column
-	}
-	if notice.DataType != "" {
-		fields[protocol.FieldDataType] = notice.DataType
-	}
-	if notice.Constraint != "" {
-		fields[protocol.FieldConstraint] = notice.Constraint
+	return c.writeDiagnosticMessage(protocol.MsgNoticeResponse, sqltypes.DiagnosticFromNotice(notice))
+}
+
+// writeNotices writes the notices of a result, each as filtered or annotated
+// by the handler if it is a NoticeHandler.
+func (c *Conn) writeNotices(notices []*sqltypes.Notice) error {
+	h, _ := c.handler.(NoticeHandler)
+	for _, notice := range notices {
+		if h != nil {
+			if notice = h.HandleNotice(c, notice); notice == nil {
+				continue
+			}
+		}
+		if err := c.writeNoticeResponse(notice); err != nil {
+			return fmt.Errorf("writing notice response: %w", err)
+		}
 	}
+	return nil
+}
 
-	return c.writeErrorOrNotice(protocol.MsgNoticeResponse, fields)
+// writeDiagnosticResponse writes an 'E' (ErrorResponse) message carrying
+// every field of the given diagnostic.
+func (c *Conn) writeDiagnosticResponse(diag *sqltypes.PgDiagnostic) error {
+	return c.writeDiagnosticMessage(protocol.MsgErrorResponse, diag)
 }
 
-// writeErrorOrNotice writes an error or notice message with the given fields.
-func (c *Conn) writeErrorOrNotice(msgType byte, fields map[byte]string) error {
-	// Calculate message size.
-	size := 4 + 1 // length + terminator
-	for _, value := range fields {
-		size += 1              // field type
-		size += len(value) + 1 // value + null terminator
+// writeHandlerError writes an error returned by the handler to the client.
+// Errors that carry a PgDiagnostic are sent with all of their fields;
+// anything else is reported with the given SQLSTATE and message, with the
+// error text as detail.
+func (c *Conn) writeHandlerError(err error, sqlState, message string) error {
+	var diag *sqltypes.PgDiagnostic
+	if errors.As(err, &diag) {
+		return c.writeDiagnosticResponse(diag)
 	}
+	return c.writeErrorResponse("ERROR", sqlState, message, err.Error(), "")
+}
+
+// writePanicError tells the client its session ends on an internal error,
+// after the handling of its connection panicked. The message the panic
+// interrupted may have been partially written, so this is a best effort.
+func (c *Conn) writePanicError(err *mterrors.PanicError) {
+	diag := *err.Diagnostic()
+	diag.Severity, diag.SeverityNonLocalized = "FATAL", "FATAL"
+	_ = c.writeDiagnosticResponse(&diag)
+	_ = c.flush()
+}
+
+// writeDiagnosticMessage writes an ErrorResponse or NoticeResponse message
+// carrying the fields of diag.
+func (c *Conn) writeDiagnosticMessage(msgType byte, diag *sqltypes.PgDiagnostic) error {
+	body := protocol.EncodeDiagnostic(diag)
 
 	w := c.getWriter()
 
 	// Write message type.
+	c.traceSend(msgType, len(body))
 	if err := writeByte(w, msgType); err != nil {
 		return err
 	}
 
 	// Write message length.
-	if err := writeInt32(w, int32(size)); err != nil {
+	if err := writeInt32(w, int32(4+len(body))); err != nil {
 		return err
 	}
 
-	// Write fields in a defined order for consistency.
-	// Order: S, V, C, M, D, H, and others
-	fieldOrder := []byte{
-		protocol.FieldSeverity,
-		protocol.FieldSeverityV,
-		protocol.FieldCode,
-		protocol.FieldMessage,
-		protocol.FieldDetail,
-		protocol.FieldHint,
-		protocol.FieldPosition,
-		protocol.FieldWhere,
-		protocol.FieldSchema,
-		protocol.FieldTable,
-		protocol.FieldColumn,
-		protocol.FieldDataType,
-		protocol.FieldConstraint,
-		protocol.FieldFile,
-		protocol.FieldLine,
-		protocol.FieldRoutine,
-	}
-
-	for _, fieldType := range fieldOrder {
-		if value, ok := fields[fieldType]; ok {
-			if err := writeByte(w, fieldType); err != nil {
-				return err
-			}
-			if err := writeString(w, value); err != nil {
-				return err
-			}
-		}
-	}
-
-	// Write terminator.
-	if err := writeByte(w, 0); err != nil {
-		return err
+	// Write fields and terminator.
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("writing diagnostic fields: %w", err)
 	}
 
 	return nil
@@ -460,13 +483,26 @@ func (c *Conn) writeErrorOrNotice(msgType byte, fields map[byte]string) error {
 // WriteCopyInResponse writes a CopyInResponse ('G') message to the client
 // This tells the client that the server is ready to receive COPY data
 func (c *Conn) WriteCopyInResponse(format int16, columnFormats []int16) error {
+	return c.writeCopyResponse(protocol.MsgCopyInResponse, format, columnFormats)
+}
+
+// WriteCopyOutResponse writes a CopyOutResponse ('H') message to the client
+// This tells the client that COPY data will follow
+func (c *Conn) WriteCopyOutResponse(format int16, columnFormats []int16) error {
+	return c.writeCopyResponse(protocol.MsgCopyOutResponse, format, columnFormats)
+}
+
+// writeCopyResponse writes a CopyInResponse or CopyOutResponse message, which
+// share the same layout.
+func (c *Conn) writeCopyResponse(msgType byte, format int16, columnFormats []int16) error {
 	// Calculate message size: 4 (length) + 1 (format as Int8) + 2 (num columns) + 2*numCols (column formats)
 	size := 4 + 1 + 2 + (2 * len(columnFormats))
 
 	w := c.getWriter()
 
 	// Write message type
-	if err := writeByte(w, protocol.MsgCopyInResponse); err != nil {
+	c.traceSend(msgType, size-4)
+	if err := writeByte(w, msgType); err != nil {
 		return err
 	}
 
@@ -495,6 +531,17 @@ func (c *Conn) WriteCopyInResponse(format int16, columnFormats []int16) error {
 	return nil
 }
 
+// WriteCopyData writes a CopyData ('d') message carrying data to the client.
+func (c *Conn) WriteCopyData(data []byte) error {
+	return c.writeMessage(protocol.MsgCopyData, data)
+}
+
+// WriteCopyDone writes a CopyDone ('c') message to the client, ending the
+// COPY data stream.
+func (c *Conn) WriteCopyDone() error {
+	return c.writeMessage(protocol.MsgCopyDone, nil)
+}
+
 // ReadCopyDataMessage reads a CopyData ('d') message body
 // The message type byte has already been read
 // length is the body length (already has 4 subtracted by ReadMessageLength)
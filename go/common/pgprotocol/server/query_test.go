@@ -17,7 +17,9 @@ package server
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"net"
 	"testing"
@@ -338,6 +340,27 @@ func TestWriteDataRow(t *testing.T) {
 	}
 }
 
+// TestWriteRawDataRow tests that rows relayed packed encode as decoded ones.
+func TestWriteRawDataRow(t *testing.T) {
+	rows := []*sqltypes.Row{
+		{Values: []sqltypes.Value{[]byte("1")}},
+		{Values: []sqltypes.Value{[]byte("42"), nil, {}, []byte("John Doe")}},
+		{Values: []sqltypes.Value{}},
+	}
+	for _, row := range rows {
+		var want, got bytes.Buffer
+		require.NoError(t, createTestConn(t, &want).writeDataRow(row))
+		require.NoError(t, createTestConn(t, &got).writeRawDataRow(row.ToProto()))
+		assert.Equal(t, want.Bytes(), got.Bytes())
+	}
+
+	// Lengths that do not match the packed values are rejected unwritten.
+	var buf bytes.Buffer
+	err := createTestConn(t, &buf).writeRawDataRow(&query.Row{Lengths: []int64{3, -1}, Values: []byte("ab")})
+	require.Error(t, err)
+	assert.Zero(t, buf.Len())
+}
+
 // TestWriteCommandComplete tests encoding of CommandComplete messages.
 func TestWriteCommandComplete(t *testing.T) {
 	tests := []struct {
@@ -392,6 +415,27 @@ func TestWriteCommandComplete(t *testing.T) {
 	}
 }
 
+// TestWriteCopyOut tests encoding of the messages of a COPY TO STDOUT.
+func TestWriteCopyOut(t *testing.T) {
+	var buf bytes.Buffer
+	conn := createTestConn(t, &buf)
+
+	require.NoError(t, conn.WriteCopyOutResponse(0, []int16{0, 0}))
+	require.NoError(t, conn.WriteCopyData([]byte("1\tfoo\n")))
+	require.NoError(t, conn.WriteCopyDone())
+
+	// CopyOutResponse: format, column count and per-column formats.
+	assert.Equal(t, []byte{protocol.MsgCopyOutResponse, 0, 0, 0, 11, 0, 0, 2, 0, 0, 0, 0}, buf.Next(12))
+
+	// CopyData carries the data as is.
+	assert.Equal(t, []byte{protocol.MsgCopyData, 0, 0, 0, 10}, buf.Next(5))
+	assert.Equal(t, "1\tfoo\n", string(buf.Next(6)))
+
+	// CopyDone has no body.
+	assert.Equal(t, []byte{protocol.MsgCopyDone, 0, 0, 0, 4}, buf.Next(5))
+	assert.Zero(t, buf.Len())
+}
+
 // TestWriteErrorResponse tests encoding of ErrorResponse messages.
 func TestWriteErrorResponse(t *testing.T) {
 	tests := []struct {
@@ -478,6 +522,54 @@ func TestWriteErrorResponse(t *testing.T) {
 	}
 }
 
+// TestWriteHandlerErrorDiagnostic tests that errors carrying a PgDiagnostic
+// are written with all their fields and a consistent message length.
+func TestWriteHandlerErrorDiagnostic(t *testing.T) {
+	var buf bytes.Buffer
+	conn := createTestConn(t, &buf)
+
+	diag := &sqltypes.PgDiagnostic{
+		MessageType:          sqltypes.DiagnosticError,
+		Severity:             "FEHLER",
+		SeverityNonLocalized: "ERROR",
+		Code:                 "53400",
+		Message:              "result too large",
+		Hint:                 "add a LIMIT",
+		InternalQuery:        "SELECT 1",
+		Line:                 12,
+	}
+	err := conn.writeHandlerError(fmt.Errorf("wrapped: %w", diag), "42000", "execution failed")
+	require.NoError(t, err)
+	require.NoError(t, conn.flush())
+
+	msgType, err := buf.ReadByte()
+	require.NoError(t, err)
+	assert.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+
+	var length int32
+	require.NoError(t, binary.Read(&buf, binary.BigEndian, &length))
+	assert.Equal(t, int(length)-4, buf.Len())
+
+	fields := make(map[byte]string)
+	for {
+		fieldType, err := buf.ReadByte()
+		require.NoError(t, err)
+		if fieldType == 0 {
+			break
+		}
+		value, err := readNullTerminatedString(&buf)
+		require.NoError(t, err)
+		fields[fieldType] = value
+	}
+	assert.Equal(t, "FEHLER", fields[protocol.FieldSeverity])
+	assert.Equal(t, "ERROR", fields[protocol.FieldSeverityV])
+	assert.Equal(t, "53400", fields[protocol.FieldCode])
+	assert.Equal(t, "result too large", fields[protocol.FieldMessage])
+	assert.Equal(t, "add a LIMIT", fields[protocol.FieldHint])
+	assert.Equal(t, "SELECT 1", fields[protocol.FieldInternalQuery])
+	assert.Equal(t, "12", fields[protocol.FieldLine])
+}
+
 // Helper function to read a null-terminated string from a reader.
 func readNullTerminatedString(r io.Reader) (string, error) {
 	var result []byte
@@ -496,3 +588,65 @@ func readNullTerminatedString(r io.Reader) (string, error) {
 
 	return string(result), nil
 }
+
+// noticeTestHandler streams a result whose notices were raised between its
+// rows, and drops the notices with the DEBUG severity.
+type noticeTestHandler struct {
+	mockHandler
+}
+
+func (h *noticeTestHandler) HandleQuery(ctx context.Context, conn *Conn, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	fields := []*query.Field{{Name: "n", DataTypeOid: 23}}
+	row := func(v string) []*sqltypes.Row { return []*sqltypes.Row{{Values: []sqltypes.Value{[]byte(v)}}} }
+	notice := func(severity, msg string) *sqltypes.Notice {
+		return &sqltypes.Notice{Severity: severity, SeverityNonLocalized: severity, Code: "00000", Message: msg}
+	}
+	if err := callback(ctx, &sqltypes.Result{Fields: fields, Rows: row("1")}); err != nil {
+		return err
+	}
+	if err := callback(ctx, &sqltypes.Result{
+		Fields:  fields,
+		Rows:    row("2"),
+		Notices: []*sqltypes.Notice{notice("NOTICE", "after 1"), notice("DEBUG", "dropped")},
+	}); err != nil {
+		return err
+	}
+	return callback(ctx, &sqltypes.Result{
+		Fields:     fields,
+		CommandTag: "SELECT 2",
+		Notices:    []*sqltypes.Notice{notice("WARNING", "after 2")},
+	})
+}
+
+func (h *noticeTestHandler) HandleNotice(conn *Conn, notice *sqltypes.Notice) *sqltypes.Notice {
+	if notice.Severity == "DEBUG" {
+		return nil
+	}
+	return notice
+}
+
+// TestHandleQueryNotices tests that the notices of a streamed result are
+// sent in order with its rows, as filtered by the NoticeHandler.
+func TestHandleQueryNotices(t *testing.T) {
+	var readBuf, writeBuf bytes.Buffer
+	conn := createExtendedQueryTestConn(t, &readBuf, &writeBuf, &noticeTestHandler{})
+	writeTestInt32(&readBuf, int32(4+len("SELECT n")+1))
+	writeTestString(&readBuf, "SELECT n")
+	require.NoError(t, conn.handleQuery())
+
+	var got []string
+	for writeBuf.Len() > 0 {
+		msgType, _, body := readMessageTypeAndLength(t, &writeBuf)
+		switch msgType {
+		case protocol.MsgNoticeResponse:
+			diag, err := protocol.ParseDiagnostic(body)
+			require.NoError(t, err)
+			got = append(got, "notice "+diag.Message)
+		case protocol.MsgDataRow:
+			got = append(got, "row")
+		case protocol.MsgCommandComplete:
+			got = append(got, "complete")
+		}
+	}
+	assert.Equal(t, []string{"row", "notice after 1", "row", "notice after 2", "complete"}, got)
+}
@@ -20,6 +20,8 @@ import (
 
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 	"github.com/multigres/multigres/go/common/pgprotocol/scram"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 )
 
 // StartupMessage represents a parsed startup message from the client.
@@ -32,7 +34,17 @@ type StartupMessage struct {
 // This includes SSL negotiation and processing the startup message.
 // Returns an error if the startup fails.
 func (c *Conn) handleStartup() error {
-	// Read the first startup packet (could be SSL request, startup message, etc.)
+	return c.processStartupPacket(false, false)
+}
+
+// processStartupPacket reads a startup packet and handles it.
+//
+// As in PostgreSQL, the startup message may be preceded by at most one
+// SSLRequest and one GSSENCRequest, in either order: libpq with the default
+// gssencmode=prefer asks for GSSAPI encryption first and falls back to an
+// SSLRequest once that is declined. sslDone and gssDone record which of
+// the two have already been answered.
+func (c *Conn) processStartupPacket(sslDone, gssDone bool) error {
 	buf, err := c.readStartupPacket()
 	if err != nil {
 		return fmt.Errorf("failed to read startup packet: %w", err)
@@ -49,12 +61,24 @@ func (c *Conn) handleStartup() error {
 	// Handle special protocol codes.
 	switch protocolCode {
 	case protocol.SSLRequestCode:
-		// Client is requesting SSL. We don't support SSL yet, so decline.
-		return c.handleSSLRequest()
+		if sslDone {
+			return errors.New("client sent a second SSLRequest")
+		}
+		if err := c.handleSSLRequest(); err != nil {
+			return err
+		}
+		// Once TLS is established, GSSAPI encryption is no longer on offer.
+		return c.processStartupPacket(true, gssDone || c.tlsConfig != nil)
 
 	case protocol.GSSENCRequestCode:
+		if gssDone {
+			return errors.New("client sent a second GSSENCRequest")
+		}
 		// Client is requesting GSSAPI encryption. We don't support it, so decline.
-		return c.handleGSSENCRequest()
+		if err := c.handleGSSENCRequest(); err != nil {
+			return err
+		}
+		return c.processStartupPacket(sslDone, true)
 
 	case protocol.CancelRequestCode:
 		// This is a cancel request, not a regular connection startup.
@@ -70,77 +94,37 @@ func (c *Conn) handleStartup() error {
 }
 
 // handleSSLRequest handles an SSL negotiation request.
-// We currently don't support SSL, so we send 'N' (no SSL) and then
-// wait for the client to send the actual startup message.
+// If the listener has a TLS configuration, TLS is started; otherwise we send
+// 'N' (no SSL) and the client continues in plaintext with its next startup
+// packet.
 func (c *Conn) handleSSLRequest() error {
-	c.logger.Debug("client requested SSL, declining")
-
-	// Send 'N' to decline SSL.
-	writer := c.getWriter()
-	if err := c.writeByte(writer, 'N'); err != nil {
-		return fmt.Errorf("failed to send SSL response: %w", err)
-	}
-
-	// Flush the response immediately.
-	if err := c.flush(); err != nil {
-		return fmt.Errorf("failed to flush SSL response: %w", err)
-	}
-
-	// Now read the actual startup message.
-	buf, err := c.readStartupPacket()
-	if err != nil {
-		return fmt.Errorf("failed to read startup message after SSL: %w", err)
-	}
-	defer c.returnReadBuffer(buf)
-
-	reader := NewMessageReader(buf)
-	protocolCode, err := reader.ReadUint32()
-	if err != nil {
-		return fmt.Errorf("failed to read protocol code: %w", err)
+	if c.tlsConfig != nil {
+		return c.startTLS()
 	}
-
-	if protocolCode != protocol.ProtocolVersionNumber {
-		return fmt.Errorf("expected protocol version %d, got %d", protocol.ProtocolVersionNumber, protocolCode)
-	}
-
-	return c.handleStartupMessage(protocolCode, reader)
+	c.logger.Debug("client requested SSL, declining")
+	return c.declineEncryptionRequest("SSL")
 }
 
 // handleGSSENCRequest handles a GSSAPI encryption request.
-// We don't support GSSAPI encryption, so we send 'N' (no GSSENC) and then
-// wait for the client to send the actual startup message.
+// We don't support GSSAPI encryption, so we send 'N' (no GSSENC); the client
+// then continues with an SSLRequest or the startup message.
 func (c *Conn) handleGSSENCRequest() error {
 	c.logger.Debug("client requested GSSAPI encryption, declining")
+	return c.declineEncryptionRequest("GSSENC")
+}
 
-	// Send 'N' to decline GSSENC.
+// declineEncryptionRequest answers an SSLRequest or GSSENCRequest with 'N'.
+func (c *Conn) declineEncryptionRequest(kind string) error {
 	writer := c.getWriter()
 	if err := c.writeByte(writer, 'N'); err != nil {
-		return fmt.Errorf("failed to send GSSENC response: %w", err)
+		return fmt.Errorf("failed to send %s response: %w", kind, err)
 	}
 
 	// Flush the response immediately.
 	if err := c.flush(); err != nil {
-		return fmt.Errorf("failed to flush GSSENC response: %w", err)
-	}
-
-	// Now read the actual startup message.
-	buf, err := c.readStartupPacket()
-	if err != nil {
-		return fmt.Errorf("failed to read startup message after GSSENC: %w", err)
-	}
-	defer c.returnReadBuffer(buf)
-
-	reader := NewMessageReader(buf)
-	protocolCode, err := reader.ReadUint32()
-	if err != nil {
-		return fmt.Errorf("failed to read protocol code: %w", err)
+		return fmt.Errorf("failed to flush %s response: %w", kind, err)
 	}
-
-	if protocolCode != protocol.ProtocolVersionNumber {
-		return fmt.Errorf("expected protocol version %d, got %d", protocol.ProtocolVersionNumber, protocolCode)
-	}
-
-	return c.handleStartupMessage(protocolCode, reader)
+	return nil
 }
 
 // handleCancelRequest handles a query cancellation request.
@@ -158,10 +142,9 @@ func (c *Conn) handleCancelRequest(reader *MessageReader) error {
 		return fmt.Errorf("failed to read secret key: %w", err)
 	}
 
-	c.logger.Info("received cancel request", "process_id", processID, "secret_key", secretKey)
+	canceled := c.listener.cancelQuery(processID, secretKey)
+	c.logger.Info("received cancel request", "process_id", processID, "canceled", canceled)
 
-	// TODO(GuptaManan100): Implement query cancellation.
-	// For now, we just close the connection as per protocol spec.
 	// The client should not expect a response to a cancel request.
 	return c.Close()
 }
@@ -215,13 +198,21 @@ func (c *Conn) handleStartupMessage(protocolVersion uint32, reader *MessageReade
 
 // authenticate performs authentication with the client.
 // If a TrustAuthProvider is configured and allows the user, trust auth is used.
-// Otherwise, SCRAM-SHA-256 authentication is performed.
+// If a CertAuthProvider is configured and the client presented a verified
+// certificate, the certificate decides. Otherwise, SCRAM-SHA-256
+// authentication is performed.
 func (c *Conn) authenticate() error {
 	// Check if trust auth is allowed for this connection
 	if c.trustAuthProvider != nil && c.trustAuthProvider.AllowTrustAuth(c.ctx, c.user, c.database) {
 		return c.authenticateTrust()
 	}
 
+	if c.certAuthProvider != nil {
+		if cert := c.peerCertificate(); cert != nil {
+			return c.authenticateCert(cert)
+		}
+	}
+
 	return c.authenticateSCRAM()
 }
 
@@ -231,6 +222,19 @@ func (c *Conn) authenticateTrust() error {
 	c.logger.Debug("authenticating client", "method", "trust")
 
 	// For trust auth, we just send AuthenticationOk immediately.
+	return c.completeAuthentication("trust")
+}
+
+// completeAuthentication sends AuthenticationOk and the messages that follow
+// it once the client has been authenticated by method.
+func (c *Conn) completeAuthentication(method string) error {
+	if h, ok := c.handler.(ConnectionStartHandler); ok {
+		if err := h.HandleConnectionStart(c.ctx, c); err != nil {
+			c.logger.Warn("connection rejected", "user", c.user, "database", c.database, "error", err)
+			return c.rejectConnection(err)
+		}
+	}
+
 	if err := c.sendAuthenticationOk(); err != nil {
 		return fmt.Errorf("failed to send AuthenticationOk: %w", err)
 	}
@@ -250,10 +254,32 @@ func (c *Conn) authenticateTrust() error {
 		return fmt.Errorf("failed to send ReadyForQuery: %w", err)
 	}
 
-	c.logger.Info("authentication complete", "user", c.user, "method", "trust")
+	c.logger.Info("authentication complete", "user", c.user, "method", method)
 	return nil
 }
 
+// rejectConnection sends err to the client as a FATAL error and closes the
+// connection. Errors that carry a PgDiagnostic are sent with all of their
+// fields.
+func (c *Conn) rejectConnection(err error) error {
+	var diag *sqltypes.PgDiagnostic
+	if errors.As(err, &diag) {
+		fatal := *diag
+		fatal.Severity = "FATAL"
+		fatal.SeverityNonLocalized = "FATAL"
+		if err := c.writeDiagnosticResponse(&fatal); err != nil {
+			return err
+		}
+	} else if err := c.writeErrorResponse("FATAL", sqlstate.SQLServerRejectedEstablishmentOfSQLConnection,
+		"connection rejected", err.Error(), ""); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+	return c.Close()
+}
+
 // authenticateSCRAM performs SCRAM-SHA-256 authentication with the client.
 func (c *Conn) authenticateSCRAM() error {
 	c.logger.Debug("authenticating client", "method", "scram-sha-256")
@@ -317,33 +343,13 @@ func (c *Conn) authenticateSCRAM() error {
 		return fmt.Errorf("failed to send AuthenticationSASLFinal: %w", err)
 	}
 
-	// Send AuthenticationOk.
-	if err := c.sendAuthenticationOk(); err != nil {
-		return fmt.Errorf("failed to send AuthenticationOk: %w", err)
-	}
-
-	// Send BackendKeyData for query cancellation.
-	if err := c.sendBackendKeyData(); err != nil {
-		return fmt.Errorf("failed to send BackendKeyData: %w", err)
-	}
-
-	// Send initial ParameterStatus messages.
-	if err := c.sendParameterStatuses(); err != nil {
-		return fmt.Errorf("failed to send ParameterStatus messages: %w", err)
-	}
-
-	// Send ReadyForQuery to indicate we're ready to receive commands.
-	if err := c.sendReadyForQuery(); err != nil {
-		return fmt.Errorf("failed to send ReadyForQuery: %w", err)
-	}
-
-	c.logger.Info("authentication complete", "user", c.user, "method", "scram-sha-256")
-	return nil
+	return c.completeAuthentication("scram-sha-256")
 }
 
 // sendAuthenticationSASL sends AuthenticationSASL message with supported mechanisms.
 func (c *Conn) sendAuthenticationSASL(mechanisms []string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteInt32(protocol.AuthSASL)
 	for _, mech := range mechanisms {
 		w.WriteString(mech)
@@ -355,6 +361,7 @@ func (c *Conn) sendAuthenticationSASL(mechanisms []string) error {
 // sendAuthenticationSASLContinue sends AuthenticationSASLContinue with server data.
 func (c *Conn) sendAuthenticationSASLContinue(data string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteInt32(protocol.AuthSASLContinue)
 	w.WriteBytes([]byte(data))
 	return c.writeMessage(protocol.MsgAuthenticationRequest, w.Bytes())
@@ -363,6 +370,7 @@ func (c *Conn) sendAuthenticationSASLContinue(data string) error {
 // sendAuthenticationSASLFinal sends AuthenticationSASLFinal with server signature.
 func (c *Conn) sendAuthenticationSASLFinal(data string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteInt32(protocol.AuthSASLFinal)
 	w.WriteBytes([]byte(data))
 	return c.writeMessage(protocol.MsgAuthenticationRequest, w.Bytes())
@@ -388,6 +396,7 @@ func (c *Conn) readSASLInitialResponse() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to read message body: %w", err)
 	}
+	defer c.returnReadBuffer(body)
 
 	reader := NewMessageReader(body)
 
@@ -444,6 +453,7 @@ func (c *Conn) readSASLResponse() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to read message body: %w", err)
 	}
+	defer c.returnReadBuffer(body)
 
 	// The entire body is the SASL data.
 	return string(body), nil
@@ -451,7 +461,7 @@ func (c *Conn) readSASLResponse() (string, error) {
 
 // sendAuthError sends an authentication error to the client.
 func (c *Conn) sendAuthError(message string) error {
-	if err := c.writeErrorResponse("FATAL", "28P01", message, "", ""); err != nil {
+	if err := c.writeErrorResponse("FATAL", sqlstate.InvalidPassword, message, "", ""); err != nil {
 		return err
 	}
 	return c.flush()
@@ -460,6 +470,7 @@ func (c *Conn) sendAuthError(message string) error {
 // sendAuthenticationOk sends an AuthenticationOk message to the client.
 func (c *Conn) sendAuthenticationOk() error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteInt32(protocol.AuthOk)
 	return c.writeMessage(protocol.MsgAuthenticationRequest, w.Bytes())
 }
@@ -468,17 +479,25 @@ func (c *Conn) sendAuthenticationOk() error {
 // This contains the process ID (connection ID) and secret key for query cancellation.
 func (c *Conn) sendBackendKeyData() error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteUint32(c.connectionID)   // Process ID
 	w.WriteUint32(c.backendKeyData) // Secret key
 	return c.writeMessage(protocol.MsgBackendKeyData, w.Bytes())
 }
 
+// ServerVersion is the server_version reported to clients. Multigres
+// pretends to be PostgreSQL 17.
+const ServerVersion = "17.0 (multigres)"
+
+// ServerVersionNum is ServerVersion in the numeric form of server_version_num.
+const ServerVersionNum = "170000"
+
 // sendParameterStatuses sends initial ParameterStatus messages to the client.
 // These inform the client about server settings.
 func (c *Conn) sendParameterStatuses() error {
 	// Send standard parameters that clients expect.
 	parameters := map[string]string{
-		"server_version":              "17.0 (multigres)", // Pretend to be PostgreSQL 17
+		"server_version":              ServerVersion,
 		"server_encoding":             "UTF8",
 		"client_encoding":             "UTF8",
 		"DateStyle":                   "ISO, MDY",
@@ -499,6 +518,7 @@ func (c *Conn) sendParameterStatuses() error {
 // sendParameterStatus sends a single ParameterStatus message.
 func (c *Conn) sendParameterStatus(name, value string) error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteString(name)
 	w.WriteString(value)
 	return c.writeMessage(protocol.MsgParameterStatus, w.Bytes())
@@ -507,6 +527,7 @@ func (c *Conn) sendParameterStatus(name, value string) error {
 // sendReadyForQuery sends a ReadyForQuery message to indicate the server is ready.
 func (c *Conn) sendReadyForQuery() error {
 	w := NewMessageWriter()
+	defer w.Release()
 	w.WriteByte(c.txnStatus)
 	if err := c.writeMessage(protocol.MsgReadyForQuery, w.Bytes()); err != nil {
 		return err
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"io"
 	"log/slog"
 	"net"
@@ -32,6 +33,7 @@ import (
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
 	"github.com/multigres/multigres/go/common/pgprotocol/scram"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	"github.com/multigres/multigres/go/pb/query"
 )
 
@@ -105,7 +107,7 @@ func newPipeConnPair() (serverConn, clientConn *pipeConn) {
 
 // scramClientHelper performs SCRAM authentication from the client side.
 // It reads server messages from clientConn and responds appropriately.
-func scramClientHelper(t *testing.T, clientConn *pipeConn, username, password string) {
+func scramClientHelper(t *testing.T, clientConn net.Conn, username, password string) {
 	t.Helper()
 	client := scram.NewSCRAMClientWithPassword(username, password)
 
@@ -159,7 +161,7 @@ func scramClientHelper(t *testing.T, clientConn *pipeConn, username, password st
 }
 
 // readMessage reads a PostgreSQL message from the connection.
-func readMessage(t *testing.T, conn *pipeConn) (byte, []byte) {
+func readMessage(t *testing.T, conn net.Conn) (byte, []byte) {
 	t.Helper()
 	header := make([]byte, 5)
 	_, err := io.ReadFull(conn, header)
@@ -176,7 +178,7 @@ func readMessage(t *testing.T, conn *pipeConn) (byte, []byte) {
 }
 
 // writeSASLInitialResponse writes a SASLInitialResponse message.
-func writeSASLInitialResponse(t *testing.T, conn *pipeConn, mechanism, data string) {
+func writeSASLInitialResponse(t *testing.T, conn net.Conn, mechanism, data string) {
 	t.Helper()
 	var buf bytes.Buffer
 	buf.WriteString(mechanism)
@@ -188,13 +190,13 @@ func writeSASLInitialResponse(t *testing.T, conn *pipeConn, mechanism, data stri
 }
 
 // writeSASLResponse writes a SASLResponse message.
-func writeSASLResponse(t *testing.T, conn *pipeConn, data string) {
+func writeSASLResponse(t *testing.T, conn net.Conn, data string) {
 	t.Helper()
 	writeMessage(t, conn, 'p', []byte(data))
 }
 
 // writeMessage writes a PostgreSQL message to the connection.
-func writeMessage(t *testing.T, conn *pipeConn, msgType byte, body []byte) {
+func writeMessage(t *testing.T, conn net.Conn, msgType byte, body []byte) {
 	t.Helper()
 	header := make([]byte, 5)
 	header[0] = msgType
@@ -205,8 +207,8 @@ func writeMessage(t *testing.T, conn *pipeConn, msgType byte, body []byte) {
 	require.NoError(t, err)
 }
 
-// writeStartupPacketToPipe writes a startup packet to a pipeConn.
-func writeStartupPacketToPipe(t *testing.T, conn *pipeConn, protocolCode uint32, params map[string]string) {
+// writeStartupPacketToPipe writes a startup packet to a client connection.
+func writeStartupPacketToPipe(t *testing.T, conn net.Conn, protocolCode uint32, params map[string]string) {
 	t.Helper()
 	var buf bytes.Buffer
 	writeStartupPacket(&buf, protocolCode, params)
@@ -529,6 +531,93 @@ func TestGSSENCRequest(t *testing.T) {
 	assert.Equal(t, "gssdb", c.database)
 }
 
+func TestGSSENCRequestThenSSLRequest(t *testing.T) {
+	// libpq with gssencmode=prefer and sslmode=prefer asks for GSSAPI
+	// encryption first, then falls back to SSL, then plaintext.
+	serverConn, clientConn := newPipeConnPair()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	listener := testListener(t)
+	c := &Conn{
+		conn:           serverConn,
+		listener:       listener,
+		hashProvider:   listener.hashProvider,
+		bufferedReader: bufio.NewReader(serverConn),
+		bufferedWriter: bufio.NewWriter(serverConn),
+		params:         make(map[string]string),
+		txnStatus:      protocol.TxnStatusIdle,
+	}
+	c.ctx = context.Background()
+	c.logger = testLogger(t)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.handleStartup()
+	}()
+
+	for _, code := range []uint32{protocol.GSSENCRequestCode, protocol.SSLRequestCode} {
+		var reqBuf bytes.Buffer
+		_ = binary.Write(&reqBuf, binary.BigEndian, uint32(8))
+		_ = binary.Write(&reqBuf, binary.BigEndian, code)
+		_, err := clientConn.Write(reqBuf.Bytes())
+		require.NoError(t, err)
+
+		response := make([]byte, 1)
+		_, err = io.ReadFull(clientConn, response)
+		require.NoError(t, err)
+		assert.Equal(t, byte('N'), response[0])
+	}
+
+	params := map[string]string{
+		"user":     "gssuser",
+		"database": "gssdb",
+	}
+	writeStartupPacketToPipe(t, clientConn, protocol.ProtocolVersionNumber, params)
+	scramClientHelper(t, clientConn, "gssuser", "postgres")
+
+	require.NoError(t, <-errCh)
+	assert.Equal(t, "gssuser", c.user)
+}
+
+func TestDuplicateGSSENCRequest(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	listener := testListener(t)
+	c := &Conn{
+		conn:           serverConn,
+		listener:       listener,
+		bufferedReader: bufio.NewReader(serverConn),
+		bufferedWriter: bufio.NewWriter(serverConn),
+		params:         make(map[string]string),
+	}
+	c.ctx = context.Background()
+	c.logger = testLogger(t)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.handleStartup()
+	}()
+
+	var reqBuf bytes.Buffer
+	_ = binary.Write(&reqBuf, binary.BigEndian, uint32(8))
+	_ = binary.Write(&reqBuf, binary.BigEndian, uint32(protocol.GSSENCRequestCode))
+	_, err := clientConn.Write(reqBuf.Bytes())
+	require.NoError(t, err)
+
+	response := make([]byte, 1)
+	_, err = io.ReadFull(clientConn, response)
+	require.NoError(t, err)
+	assert.Equal(t, byte('N'), response[0])
+
+	_, err = clientConn.Write(reqBuf.Bytes())
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, <-errCh, "second GSSENCRequest")
+}
+
 func TestSCRAMAuthenticationWrongPassword(t *testing.T) {
 	// Create pipe-based connection for bidirectional communication.
 	serverConn, clientConn := newPipeConnPair()
@@ -600,6 +689,60 @@ func TestSCRAMAuthenticationWrongPassword(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// rejectingHandler is a handler that rejects every connection at startup.
+type rejectingHandler struct {
+	mockHandler
+	err error
+}
+
+func (h *rejectingHandler) HandleConnectionStart(ctx context.Context, conn *Conn) error {
+	return h.err
+}
+
+func TestConnectionStartRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+		wantMsg  string
+	}{
+		{
+			name:     "diagnostic",
+			err:      sqlstate.NewError(sqlstate.TooManyConnections).Msg("too many connections for role \"app\"").Err(),
+			wantCode: sqlstate.TooManyConnections,
+			wantMsg:  "too many connections for role \"app\"",
+		},
+		{
+			name:     "plain error",
+			err:      errors.New("not now"),
+			wantCode: sqlstate.SQLServerRejectedEstablishmentOfSQLConnection,
+			wantMsg:  "connection rejected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockConn()
+			c := newConn(mock, testListener(t), 1)
+			c.handler = &rejectingHandler{err: tt.err}
+
+			require.NoError(t, c.completeAuthentication("trust"))
+			assert.True(t, c.closed.Load(), "rejected connection should be closed")
+
+			// The only message sent is the FATAL error: no AuthenticationOk.
+			output := mock.writeBuf.Bytes()
+			require.NotEmpty(t, output)
+			assert.Equal(t, byte(protocol.MsgErrorResponse), output[0])
+			msgLen := binary.BigEndian.Uint32(output[1:5])
+			require.Len(t, output, 1+int(msgLen))
+			diag, err := protocol.ParseDiagnostic(output[5:])
+			require.NoError(t, err)
+			assert.Equal(t, "FATAL", diag.Severity)
+			assert.Equal(t, tt.wantCode, diag.Code)
+			assert.Equal(t, tt.wantMsg, diag.Message)
+		})
+	}
+}
+
 func TestAuthenticationMessages(t *testing.T) {
 	// Create mock connection.
 	mock := newMockConn()
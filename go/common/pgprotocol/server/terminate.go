@@ -0,0 +1,41 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// Terminate ends the connection, as pg_terminate_backend does in
+// PostgreSQL. The query in flight, if any, is cancelled. A client waiting
+// for its next command is first told why with a FATAL error; one in the
+// middle of a command only sees the connection close, since the error
+// would interleave with the response. It may be called from any goroutine.
+func (c *Conn) Terminate() {
+	c.notifyMu.Lock()
+	if c.waiting && !c.closed.Load() {
+		c.stopIdleInTransactionTimer()
+		c.logger.Info("terminating connection due to administrator command")
+		_ = c.writeErrorResponse("FATAL", sqlstate.AdminShutdown,
+			"terminating connection due to administrator command", "", "")
+		_ = c.flush()
+	}
+	c.notifyMu.Unlock()
+
+	c.cancelCurrentQuery()
+	// Closing the network connection ends the command loop, which then
+	// closes the connection itself.
+	_ = c.conn.Close()
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestTerminateWaitingConnection(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer clientConn.Close()
+
+	c := newConn(serverConn, testListener(t), 1)
+	c.SetTxnStatus(protocol.TxnStatusInBlock)
+	require.NoError(t, c.setIdle())
+
+	go c.Terminate()
+
+	msgType, body := readMessage(t, clientConn)
+	require.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+	diag, err := protocol.ParseDiagnostic(body)
+	require.NoError(t, err)
+	assert.Equal(t, "FATAL", diag.Severity)
+	assert.Equal(t, sqlstate.AdminShutdown, diag.Code)
+	assert.Equal(t, "terminating connection due to administrator command", diag.Message)
+
+	// The network connection is closed.
+	_, err = clientConn.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestTerminateBusyConnection(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer clientConn.Close()
+
+	c := newConn(serverConn, testListener(t), 1)
+	c.setBusy()
+	ctx, endQuery := c.beginQuery()
+	defer endQuery()
+
+	go c.Terminate()
+
+	// The query is cancelled, and the connection closed without an error
+	// interleaving with the response.
+	<-ctx.Done()
+	assert.ErrorIs(t, context.Cause(ctx), errCancelRequested)
+	_, err := clientConn.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -17,6 +17,7 @@ package server
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
 	"net"
 	"time"
@@ -59,6 +60,7 @@ func NewTestConn(readBuf *bytes.Buffer) *TestConn {
 	netConn := &exportedTestNetConn{readBuf: readBuf, writeBuf: writeBuf}
 
 	conn := &Conn{
+		ctx:            context.Background(),
 		conn:           netConn,
 		bufferedReader: bufio.NewReader(readBuf),
 		bufferedWriter: bufio.NewWriter(writeBuf),
@@ -71,6 +73,24 @@ func NewTestConn(readBuf *bytes.Buffer) *TestConn {
 	}
 }
 
+// SetConnectionID sets the connection ID, which NewTestConn leaves at 0.
+func (tc *TestConn) SetConnectionID(id uint32) {
+	tc.connectionID = id
+}
+
+// SetUser sets the user and database the client connected as, which
+// NewTestConn leaves empty.
+func (tc *TestConn) SetUser(user, database string) {
+	tc.user = user
+	tc.database = database
+}
+
+// BeginQuery starts a query as the connection does when it executes one,
+// so that it can be aborted. The returned function ends the query.
+func (tc *TestConn) BeginQuery() (context.Context, func()) {
+	return tc.beginQuery()
+}
+
 // WriteCopyDataMessage writes a CopyData message to the buffer.
 // This simulates a client sending COPY data.
 func WriteCopyDataMessage(buf *bytes.Buffer, data []byte) {
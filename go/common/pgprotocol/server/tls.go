@@ -0,0 +1,115 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// CertAuthProvider authenticates clients by their TLS client certificate,
+// like PostgreSQL's cert authentication method. It is only consulted for
+// connections whose certificate was verified against the listener's
+// TLSConfig.ClientCAs.
+type CertAuthProvider interface {
+	// AllowCertAuth reports whether the holder of the verified client
+	// certificate cert may connect as user without a password.
+	AllowCertAuth(ctx context.Context, cert *x509.Certificate, user string) bool
+}
+
+// validateTLSConfig checks that the TLS settings of a ListenerConfig are
+// usable together.
+func validateTLSConfig(config ListenerConfig) error {
+	if config.CertAuthProvider == nil {
+		return nil
+	}
+	if config.TLSConfig == nil || config.TLSConfig.ClientCAs == nil {
+		return errors.New("certificate authentication requires a TLSConfig with ClientCAs")
+	}
+	if config.TLSConfig.ClientAuth < tls.VerifyClientCertIfGiven {
+		return errors.New("certificate authentication requires TLSConfig.ClientAuth to verify client certificates")
+	}
+	return nil
+}
+
+// startTLS accepts an SSLRequest: it answers 'S' and performs the TLS
+// handshake, after which all further traffic goes over the TLS connection.
+func (c *Conn) startTLS() error {
+	// Like PostgreSQL, refuse plaintext the client pipelined behind the
+	// SSLRequest; it would otherwise be processed as if it had been
+	// received over TLS.
+	if c.bufferedReader.Buffered() > 0 {
+		return errors.New("received unencrypted data after SSL request")
+	}
+
+	if err := c.writeByte(c.getWriter(), 'S'); err != nil {
+		return fmt.Errorf("failed to send SSL response: %w", err)
+	}
+	if err := c.flush(); err != nil {
+		return fmt.Errorf("failed to flush SSL response: %w", err)
+	}
+
+	tlsConn := tls.Server(c.conn, c.tlsConfig)
+	if err := tlsConn.HandshakeContext(c.ctx); err != nil {
+		return fmt.Errorf("TLS handshake failed: %w", err)
+	}
+
+	c.bufMu.Lock()
+	defer c.bufMu.Unlock()
+	c.conn = tlsConn
+	c.bufferedReader.Reset(tlsConn)
+	if c.bufferedWriter != nil {
+		c.bufferedWriter.Reset(tlsConn)
+	}
+
+	c.logger.Debug("TLS established", "version", tls.VersionName(tlsConn.ConnectionState().Version))
+	return nil
+}
+
+// peerCertificate returns the client's certificate if the connection uses
+// TLS and the certificate was verified, or nil otherwise.
+func (c *Conn) peerCertificate() *x509.Certificate {
+	tlsConn, ok := c.conn.(*tls.Conn)
+	if !ok {
+		return nil
+	}
+	chains := tlsConn.ConnectionState().VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil
+	}
+	return chains[0][0]
+}
+
+// authenticateCert authenticates the client by its verified certificate.
+func (c *Conn) authenticateCert(cert *x509.Certificate) error {
+	c.logger.Debug("authenticating client", "method", "cert")
+
+	if !c.certAuthProvider.AllowCertAuth(c.ctx, cert, c.user) {
+		c.logger.Warn("authentication failed: certificate does not map to user",
+			"user", c.user, "subject", cert.Subject.String())
+		if err := c.writeErrorResponse("FATAL", sqlstate.InvalidAuthorizationSpecification,
+			"certificate authentication failed for user \""+c.user+"\"", "", ""); err != nil {
+			return err
+		}
+		return c.flush()
+	}
+
+	return c.completeAuthentication("cert")
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/test/utils"
+)
+
+// issue returns a certificate of ca for commonName.
+func issue(t *testing.T, ca *utils.TestCA, commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+	return ca.Issue(t, &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	})
+}
+
+// mockCertAuthProvider allows certificates whose common name is mapped to
+// the requested user.
+type mockCertAuthProvider map[string]string
+
+func (m mockCertAuthProvider) AllowCertAuth(_ context.Context, cert *x509.Certificate, user string) bool {
+	return m[cert.Subject.CommonName] == user
+}
+
+// newTLSTestConn returns a server Conn that accepts TLS with a certificate
+// from ca and verifies client certificates issued by ca.
+func newTLSTestConn(t *testing.T, serverConn net.Conn, ca *utils.TestCA, certAuth CertAuthProvider) *Conn {
+	t.Helper()
+	listener := testListener(t)
+	c := &Conn{
+		conn:         serverConn,
+		listener:     listener,
+		hashProvider: listener.hashProvider,
+		tlsConfig: &tls.Config{
+			Certificates: []tls.Certificate{issue(t, ca, "gateway.example.com", x509.ExtKeyUsageServerAuth)},
+			ClientCAs:    ca.Pool,
+			ClientAuth:   tls.VerifyClientCertIfGiven,
+			MinVersion:   tls.VersionTLS12,
+		},
+		certAuthProvider: certAuth,
+		bufferedReader:   bufio.NewReader(serverConn),
+		bufferedWriter:   bufio.NewWriter(serverConn),
+		params:           make(map[string]string),
+		txnStatus:        protocol.TxnStatusIdle,
+	}
+	c.ctx = context.Background()
+	c.logger = testLogger(t)
+	return c
+}
+
+// startClientTLS sends an SSLRequest, expects 'S' and performs the client
+// side of the TLS handshake, presenting clientCert if it is non-nil.
+func startClientTLS(t *testing.T, clientConn net.Conn, ca *utils.TestCA, clientCert *tls.Certificate) *tls.Conn {
+	t.Helper()
+	var sslReqBuf bytes.Buffer
+	_ = binary.Write(&sslReqBuf, binary.BigEndian, uint32(8))
+	_ = binary.Write(&sslReqBuf, binary.BigEndian, uint32(protocol.SSLRequestCode))
+	_, err := clientConn.Write(sslReqBuf.Bytes())
+	require.NoError(t, err)
+
+	sslResponse := make([]byte, 1)
+	_, err = io.ReadFull(clientConn, sslResponse)
+	require.NoError(t, err)
+	require.Equal(t, byte('S'), sslResponse[0], "should send 'S' to accept SSL")
+
+	config := &tls.Config{
+		RootCAs:    ca.Pool,
+		ServerName: "gateway.example.com",
+		MinVersion: tls.VersionTLS12,
+	}
+	if clientCert != nil {
+		config.Certificates = []tls.Certificate{*clientCert}
+	}
+	tlsConn := tls.Client(clientConn, config)
+	require.NoError(t, tlsConn.Handshake())
+	return tlsConn
+}
+
+func TestSSLRequestAcceptedWithCertAuth(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	ca := utils.NewTestCA(t, "test ca")
+	c := newTLSTestConn(t, serverConn, ca, mockCertAuthProvider{"svc-billing": "billing"})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.handleStartup()
+	}()
+
+	clientCert := issue(t, ca, "svc-billing", x509.ExtKeyUsageClientAuth)
+	tlsConn := startClientTLS(t, clientConn, ca, &clientCert)
+	writeStartupPacketToPipe(t, tlsConn, protocol.ProtocolVersionNumber, map[string]string{
+		"user":     "billing",
+		"database": "postgres",
+	})
+
+	// The certificate authenticates the client: no SASL exchange.
+	msgType, body := readMessage(t, tlsConn)
+	require.Equal(t, byte(protocol.MsgAuthenticationRequest), msgType)
+	assert.Equal(t, uint32(protocol.AuthOk), binary.BigEndian.Uint32(body[:4]))
+	for msgType != byte(protocol.MsgReadyForQuery) {
+		msgType, _ = readMessage(t, tlsConn)
+	}
+
+	require.NoError(t, <-errCh)
+	assert.Equal(t, "billing", c.user)
+	assert.NotNil(t, c.peerCertificate())
+}
+
+func TestCertAuthRejectsUnmappedUser(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	ca := utils.NewTestCA(t, "test ca")
+	c := newTLSTestConn(t, serverConn, ca, mockCertAuthProvider{"svc-billing": "billing"})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.handleStartup()
+	}()
+
+	clientCert := issue(t, ca, "svc-billing", x509.ExtKeyUsageClientAuth)
+	tlsConn := startClientTLS(t, clientConn, ca, &clientCert)
+	writeStartupPacketToPipe(t, tlsConn, protocol.ProtocolVersionNumber, map[string]string{
+		"user": "postgres",
+	})
+
+	// A verified certificate that does not map to the user is rejected
+	// rather than falling back to a password.
+	msgType, body := readMessage(t, tlsConn)
+	require.Equal(t, byte(protocol.MsgErrorResponse), msgType)
+	assert.Contains(t, string(body), "28000")
+	assert.Contains(t, string(body), `certificate authentication failed for user "postgres"`)
+
+	require.NoError(t, <-errCh)
+}
+
+func TestSSLRequestWithoutClientCertUsesSCRAM(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	ca := utils.NewTestCA(t, "test ca")
+	c := newTLSTestConn(t, serverConn, ca, mockCertAuthProvider{"svc-billing": "billing"})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.handleStartup()
+	}()
+
+	tlsConn := startClientTLS(t, clientConn, ca, nil)
+	writeStartupPacketToPipe(t, tlsConn, protocol.ProtocolVersionNumber, map[string]string{
+		"user": "billing",
+	})
+	scramClientHelper(t, tlsConn, "billing", "postgres")
+
+	require.NoError(t, <-errCh)
+	assert.Nil(t, c.peerCertificate())
+}
+
+func TestSSLRequestRejectsPipelinedPlaintext(t *testing.T) {
+	serverConn, clientConn := newPipeConnPair()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	ca := utils.NewTestCA(t, "test ca")
+	c := newTLSTestConn(t, serverConn, ca, nil)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.handleStartup()
+	}()
+
+	// Send the SSLRequest and a startup packet in a single write.
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.BigEndian, uint32(8))
+	_ = binary.Write(&buf, binary.BigEndian, uint32(protocol.SSLRequestCode))
+	writeStartupPacket(&buf, protocol.ProtocolVersionNumber, map[string]string{"user": "postgres"})
+	_, err := clientConn.Write(buf.Bytes())
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, <-errCh, "unencrypted data after SSL request")
+}
+
+func TestNewListenerValidatesCertAuth(t *testing.T) {
+	ca := utils.NewTestCA(t, "test ca")
+	tests := []struct {
+		name      string
+		tlsConfig *tls.Config
+		wantErr   string
+	}{
+		{name: "no TLS", wantErr: "requires a TLSConfig with ClientCAs"},
+		{name: "no client CAs", tlsConfig: &tls.Config{}, wantErr: "requires a TLSConfig with ClientCAs"},
+		{
+			name:      "client certs not verified",
+			tlsConfig: &tls.Config{ClientCAs: ca.Pool, ClientAuth: tls.RequestClientCert},
+			wantErr:   "ClientAuth",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewListener(ListenerConfig{
+				Address:          "localhost:0",
+				Handler:          &mockHandler{},
+				HashProvider:     newMockHashProvider("postgres"),
+				TLSConfig:        tt.tlsConfig,
+				CertAuthProvider: mockCertAuthProvider{},
+			})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
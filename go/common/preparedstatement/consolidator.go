@@ -15,13 +15,13 @@
 package preparedstatement
 
 import (
-	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/multigres/multigres/go/common/parser"
 	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/protoutil"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	querypb "github.com/multigres/multigres/go/pb/query"
 )
 
@@ -84,7 +84,9 @@ func NewPreparedStatementInfo(ps *querypb.PreparedStatement) (*PreparedStatement
 		return nil, err
 	}
 	if len(asts) != 1 {
-		return nil, errors.New("more than 1 query in prepare statement")
+		return nil, sqlstate.NewError(sqlstate.SyntaxError).
+			Msg("cannot insert multiple commands into a prepared statement").
+			Err()
 	}
 	return &PreparedStatementInfo{
 		PreparedStatement: ps,
@@ -92,6 +94,11 @@ func NewPreparedStatementInfo(ps *querypb.PreparedStatement) (*PreparedStatement
 	}, nil
 }
 
+// AST returns the parsed statement of the prepared statement.
+func (p *PreparedStatementInfo) AST() ast.Stmt {
+	return p.astStruct
+}
+
 // NewPortalInfo creates the PortalInfo.
 func NewPortalInfo(psi *PreparedStatementInfo, portal *querypb.Portal) *PortalInfo {
 	return &PortalInfo{
@@ -123,8 +130,14 @@ func (psc *Consolidator) AddPreparedStatement(connId uint32, name, queryStr stri
 	}
 
 	// If the name is non-empty, and a prepared statement for this name already exists on the connection, we throw an error.
-	if _, exists := psc.incoming[connId][name]; exists && name != "" {
-		return nil, errors.New("Prepared statement with this name exists")
+	// The unnamed statement is instead replaced, as in PostgreSQL.
+	if _, exists := psc.incoming[connId][name]; exists {
+		if name != "" {
+			return nil, sqlstate.NewError(sqlstate.DuplicatePreparedStatement).
+				Msg("prepared statement \"%s\" already exists", name).
+				Err()
+		}
+		psc.removeLocked(connId, name)
 	}
 
 	// Let's check if a prepared statement with this statement already exists.
@@ -164,6 +177,23 @@ func (psc *Consolidator) RemovePreparedStatement(connId uint32, name string) {
 	psc.mu.Lock()
 	defer psc.mu.Unlock()
 
+	psc.removeLocked(connId, name)
+}
+
+// RemoveConnection removes all prepared statements of a connection. It is
+// called when the connection closes.
+func (psc *Consolidator) RemoveConnection(connId uint32) {
+	psc.mu.Lock()
+	defer psc.mu.Unlock()
+
+	for name := range psc.incoming[connId] {
+		psc.removeLocked(connId, name)
+	}
+	delete(psc.incoming, connId)
+}
+
+// removeLocked removes a prepared statement of a connection. psc.mu must be held.
+func (psc *Consolidator) removeLocked(connId uint32, name string) {
 	psi, exists := psc.incoming[connId][name]
 	if exists {
 		psc.usageCount[psi] -= 1
@@ -20,6 +20,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	querypb "github.com/multigres/multigres/go/pb/query"
 )
 
@@ -71,7 +73,8 @@ func TestConsolidator_DuplicateNameOnSameConnectionReturnsError(t *testing.T) {
 	// Try to add another statement with the same name on the same connection
 	_, err = consolidator.AddPreparedStatement(connID, "stmt1", "SELECT 2", nil)
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Prepared statement with this name exists")
+	require.Contains(t, err.Error(), `prepared statement "stmt1" already exists`)
+	require.True(t, err.(*sqltypes.PgDiagnostic).IsSQLState(sqlstate.DuplicatePreparedStatement))
 }
 
 func TestConsolidator_EmptyNameAllowsDuplicates(t *testing.T) {
@@ -84,6 +87,31 @@ func TestConsolidator_EmptyNameAllowsDuplicates(t *testing.T) {
 
 	_, err = consolidator.AddPreparedStatement(connID, "", "SELECT 2", nil)
 	require.NoError(t, err)
+
+	// The new unnamed statement replaces the old one.
+	require.Equal(t, "SELECT 2", consolidator.GetPreparedStatementInfo(connID, "").Query)
+	_, exists := consolidator.stmts["SELECT 1"]
+	require.False(t, exists)
+}
+
+func TestConsolidator_RemoveConnection(t *testing.T) {
+	consolidator := NewConsolidator()
+
+	shared, err := consolidator.AddPreparedStatement(1, "a", "SELECT 1", nil)
+	require.NoError(t, err)
+	_, err = consolidator.AddPreparedStatement(1, "b", "SELECT 2", nil)
+	require.NoError(t, err)
+	_, err = consolidator.AddPreparedStatement(2, "c", "SELECT 1", nil)
+	require.NoError(t, err)
+
+	consolidator.RemoveConnection(1)
+
+	require.Nil(t, consolidator.GetPreparedStatementInfo(1, "a"))
+	require.NotContains(t, consolidator.incoming, uint32(1))
+	// The statement shared with connection 2 is kept; the other is dropped.
+	require.Equal(t, 1, consolidator.usageCount[shared])
+	_, exists := consolidator.stmts["SELECT 2"]
+	require.False(t, exists)
 }
 
 func TestConsolidator_RemovePreparedStatement(t *testing.T) {
@@ -158,7 +186,7 @@ func TestConsolidator_MultipleStatementsInQuery(t *testing.T) {
 	// Prepared statements should only contain a single query
 	_, err := consolidator.AddPreparedStatement(connID, "stmt1", "SELECT 1; SELECT 2", nil)
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "more than 1 query")
+	require.Contains(t, err.Error(), "cannot insert multiple commands into a prepared statement")
 }
 
 func TestConsolidator_ConcurrentAccess(t *testing.T) {
@@ -0,0 +1,35 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryservice
+
+import "context"
+
+// passthroughKey is the context key marking streams relayed as they are.
+type passthroughKey struct{}
+
+// WithPassthrough returns a copy of ctx whose StreamExecute results are
+// only relayed to the client, so they may keep their rows packed in
+// sqltypes.Result.RawRows rather than decoded into Rows. Callers that read
+// the rows of the results must not set it.
+func WithPassthrough(ctx context.Context) context.Context {
+	return context.WithValue(ctx, passthroughKey{}, true)
+}
+
+// PassthroughFromContext returns true if the StreamExecute results of ctx
+// may keep their rows packed (see WithPassthrough).
+func PassthroughFromContext(ctx context.Context) bool {
+	passthrough, _ := ctx.Value(passthroughKey{}).(bool)
+	return passthrough
+}
@@ -30,7 +30,7 @@ import (
 )
 
 // ReservedState contains information about a reserved connection.
-// This is returned by ReserveStreamExecute and should be stored in the shard state
+// This is returned by ReserveConnection, PortalStreamExecute and CopyReady and should be stored in the shard state
 // to ensure subsequent queries in the same session use the same reserved connection.
 type ReservedState struct {
 	// ReservedConnectionId is the ID of the reserved connection on the multipooler.
@@ -164,6 +164,27 @@ type QueryService interface {
 		options *query.ExecuteOptions,
 	) (*sqltypes.Result, error)
 
+	// CopyOut executes a COPY TO STDOUT operation and streams its data.
+	// onReady is called once with the COPY format and per-column formats before
+	// any data, then onData is called with each chunk of data. If
+	// options.ReservedConnectionId is set, the COPY runs on that connection.
+	//
+	// Parameters:
+	//   ctx: Context for cancellation and timeouts
+	//   target: Target specifying tablegroup, shard, and pooler type
+	//   copyQuery: The COPY SQL statement to execute
+	//   options: Execute options including user and session settings
+	//   onReady: Called with the format information of the COPY
+	//   onData: Called with each chunk of COPY data
+	CopyOut(
+		ctx context.Context,
+		target *query.Target,
+		copyQuery string,
+		options *query.ExecuteOptions,
+		onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+		onData func(ctx context.Context, data []byte) error,
+	) (*sqltypes.Result, error)
+
 	// CopyAbort aborts a COPY operation.
 	// options.ReservedConnectionId must be set to route to the correct connection.
 	//
@@ -178,4 +199,57 @@ type QueryService interface {
 		errorMsg string,
 		options *query.ExecuteOptions,
 	) error
+
+	// StreamNotifications listens on a LISTEN/NOTIFY channel. onReady is
+	// called once the multipooler is listening; from then on, callback is
+	// called with each notification raised on the channel until ctx is
+	// cancelled or callback returns an error.
+	//
+	// Parameters:
+	//   ctx: Context for cancellation; cancelling it stops listening
+	//   target: Target specifying tablegroup, shard, and pooler type
+	//   channel: The name of the channel to listen on
+	//   options: Execute options including the user
+	//   onReady: Called once the LISTEN is in effect
+	//   callback: Function called for each notification
+	StreamNotifications(
+		ctx context.Context,
+		target *query.Target,
+		channel string,
+		options *query.ExecuteOptions,
+		onReady func(ctx context.Context) error,
+		callback func(ctx context.Context, notification *query.Notification) error,
+	) error
+
+	// ReserveConnection pins a reserved connection to the client session, so
+	// that session state PostgreSQL keeps on the connection, such as
+	// session-level advisory locks, temporary tables and cursors, is not lost
+	// to pooling. If
+	// options.ReservedConnectionId is set, that connection is pinned instead
+	// of a new one. The pinned connection stays reserved until
+	// ReleaseReservedConnection is called.
+	//
+	// Parameters:
+	//   ctx: Context for cancellation and timeouts
+	//   target: Target specifying tablegroup, shard, and pooler type
+	//   options: Execute options including user, session settings and reserved connection ID
+	ReserveConnection(
+		ctx context.Context,
+		target *query.Target,
+		options *query.ExecuteOptions,
+	) (ReservedState, error)
+
+	// ReleaseReservedConnection unpins the reserved connection in
+	// options.ReservedConnectionId, releasing its session-level advisory locks
+	// and dropping its temporary tables and cursors.
+	//
+	// Parameters:
+	//   ctx: Context for cancellation and timeouts
+	//   target: Target specifying tablegroup, shard, and pooler type
+	//   options: Execute options including reserved connection ID
+	ReleaseReservedConnection(
+		ctx context.Context,
+		target *query.Target,
+		options *query.ExecuteOptions,
+	) error
 }
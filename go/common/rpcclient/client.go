@@ -102,6 +102,8 @@ package rpcclient
 import (
 	"context"
 
+	"google.golang.org/grpc"
+
 	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	consensusdatapb "github.com/multigres/multigres/go/pb/consensusdata"
 	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
@@ -253,6 +255,12 @@ type MultiPoolerClient interface {
 	// GetBackupByJobId queries a multipooler for a backup by its job_id annotation.
 	GetBackupByJobId(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.GetBackupByJobIdRequest) (*multipoolermanagerdatapb.GetBackupByJobIdResponse, error)
 
+	// VerifyBackup checks the integrity of an existing backup.
+	VerifyBackup(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.VerifyBackupRequest) (*multipoolermanagerdatapb.VerifyBackupResponse, error)
+
+	// DeleteBackup removes a backup from the repository.
+	DeleteBackup(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.DeleteBackupRequest) (*multipoolermanagerdatapb.DeleteBackupResponse, error)
+
 	//
 	// Manager Service Methods - Timeline Repair
 	//
@@ -267,6 +275,23 @@ type MultiPoolerClient interface {
 	// SetMonitor enables or disables the PostgreSQL monitoring goroutine on a pooler.
 	SetMonitor(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.SetMonitorRequest) (*multipoolermanagerdatapb.SetMonitorResponse, error)
 
+	//
+	// Manager Service Methods - Major Version Upgrades
+	//
+
+	// UpgradePostgres upgrades PostgreSQL on a pooler to a new major version.
+	UpgradePostgres(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.UpgradePostgresRequest) (*multipoolermanagerdatapb.UpgradePostgresResponse, error)
+
+	// FinishPostgresUpgrade finalizes or rolls back the last upgrade of a pooler.
+	FinishPostgresUpgrade(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.FinishPostgresUpgradeRequest) (*multipoolermanagerdatapb.FinishPostgresUpgradeResponse, error)
+
+	//
+	// Manager Service Methods - Extensions
+	//
+
+	// InstallExtension checks the files of an extension on a pooler, and creates or updates it on a primary.
+	InstallExtension(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.InstallExtensionRequest) (*multipoolermanagerdatapb.InstallExtensionResponse, error)
+
 	//
 	// Connection Management Methods
 	//
@@ -287,6 +312,9 @@ type MultiPoolerClient interface {
 //
 // For multiorch deployments monitoring many poolers, a capacity of 1000 is recommended.
 // For smaller deployments or testing, 100 may be sufficient.
-func NewMultiPoolerClient(capacity int) MultiPoolerClient {
-	return NewClient(capacity)
+//
+// The connections are in plain text unless the dial options set transport
+// credentials, e.g. those of ConnConfig.TransportCredentials.
+func NewMultiPoolerClient(capacity int, opts ...grpc.DialOption) MultiPoolerClient {
+	return NewClient(capacity, opts...)
 }
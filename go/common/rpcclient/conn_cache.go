@@ -18,6 +18,7 @@ package rpcclient
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"sort"
 	"sync"
@@ -26,6 +27,7 @@ import (
 	"github.com/spf13/pflag"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/multigres/multigres/go/tools/grpccommon"
@@ -80,15 +82,31 @@ func NewConnConfig(reg *viperutil.Registry) *ConnConfig {
 
 // RegisterFlags registers all multipooler RPC client flags with the given FlagSet.
 func (cc *ConnConfig) RegisterFlags(fs *pflag.FlagSet) {
-	fs.String("multipooler-grpc-cert", cc.cert.Default(), "the cert to use to connect to multipooler (not yet implemented)")
-	fs.String("multipooler-grpc-key", cc.key.Default(), "the key to use to connect to multipooler (not yet implemented)")
-	fs.String("multipooler-grpc-ca", cc.ca.Default(), "the server ca to use to validate multipooler servers when connecting (not yet implemented)")
-	fs.String("multipooler-grpc-crl", cc.crl.Default(), "the server crl to use to validate multipooler server certificates when connecting (not yet implemented)")
-	fs.String("multipooler-grpc-server-name", cc.name.Default(), "the server name to use to validate multipooler server certificate (not yet implemented)")
+	fs.String("multipooler-grpc-cert", cc.cert.Default(), "the cert to use to connect to multipooler, reloaded when it changes")
+	fs.String("multipooler-grpc-key", cc.key.Default(), "the key to use to connect to multipooler, reloaded when it changes")
+	fs.String("multipooler-grpc-ca", cc.ca.Default(), "the server ca to use to validate multipooler servers when connecting, reloaded when it changes")
+	fs.String("multipooler-grpc-crl", cc.crl.Default(), "the server crl to use to validate multipooler server certificates when connecting")
+	fs.String("multipooler-grpc-server-name", cc.name.Default(), "the server name to use to validate multipooler server certificate")
 
 	viperutil.BindFlags(fs, cc.cert, cc.key, cc.ca, cc.crl, cc.name)
 }
 
+// TransportCredentials returns the credentials of the connections to the
+// multipoolers: TLS if any of the files is set, plain text otherwise.
+func (cc *ConnConfig) TransportCredentials() (credentials.TransportCredentials, error) {
+	creds, err := grpccommon.ClientCredentials(grpccommon.TLSFiles{
+		Cert:       cc.cert.Get(),
+		Key:        cc.key.Get(),
+		CA:         cc.ca.Get(),
+		CRL:        cc.crl.Get(),
+		ServerName: cc.name.Get(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("multipooler gRPC TLS: %w", err)
+	}
+	return creds, nil
+}
+
 // closeFunc allows a standalone function to implement io.Closer, similar to
 // how http.HandlerFunc allows standalone functions to implement http.Handler.
 type closeFunc func() error
@@ -121,6 +139,7 @@ type connCache struct {
 	connWaitSema *semaphore.Weighted
 	capacity     int
 	metrics      *Metrics
+	dialOptions  []grpc.DialOption
 }
 
 // newConnCache creates a new connection cache with the default capacity.
@@ -128,14 +147,17 @@ func newConnCache() *connCache {
 	return newConnCacheWithCapacity(defaultCapacity)
 }
 
-// newConnCacheWithCapacity creates a new connection cache with a specified capacity.
-func newConnCacheWithCapacity(capacity int) *connCache {
+// newConnCacheWithCapacity creates a new connection cache with a specified
+// capacity. The dial options are added to those of every connection, and
+// connect in plain text unless they set transport credentials.
+func newConnCacheWithCapacity(capacity int, opts ...grpc.DialOption) *connCache {
 	cc := &connCache{
 		conns:        make(map[string]*cachedConn, capacity),
 		evict:        make([]*cachedConn, 0, capacity),
 		connWaitSema: semaphore.NewWeighted(int64(capacity)),
 		capacity:     capacity,
 		metrics:      NewMetrics(),
+		dialOptions:  append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...),
 	}
 
 	// Register callback for cache size observable gauge
@@ -291,13 +313,12 @@ func (cc *connCache) pollOnce(ctx context.Context, addr string, poolerID *cluste
 func (cc *connCache) newDial(ctx context.Context, addr string, poolerID *clustermetadatapb.ID) (*cachedConn, closeFunc, error) {
 	// Build client options with multipooler target for telemetry
 	clientOpts := []grpccommon.ClientOption{
-		grpccommon.WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+		grpccommon.WithDialOptions(cc.dialOptions...),
 	}
 	if poolerID != nil {
 		clientOpts = append(clientOpts, grpccommon.WithAttributes(PoolerSpanAttributes(poolerID)...))
 	}
 
-	// TODO: Add proper TLS configuration for production
 	grpcConn, err := grpccommon.NewClient(addr, clientOpts...)
 	if err != nil {
 		cc.connWaitSema.Release(1)
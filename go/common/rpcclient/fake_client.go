@@ -85,8 +85,11 @@ type FakeClient struct {
 	RestoreFromBackupResponses               map[string]*multipoolermanagerdatapb.RestoreFromBackupResponse
 	GetBackupsResponses                      map[string]*multipoolermanagerdatapb.GetBackupsResponse
 	GetBackupByJobIdResponses                map[string]*multipoolermanagerdatapb.GetBackupByJobIdResponse
+	VerifyBackupResponses                    map[string]*multipoolermanagerdatapb.VerifyBackupResponse
 	RewindToSourceResponses                  map[string]*multipoolermanagerdatapb.RewindToSourceResponse
 	SetMonitorResponses                      map[string]*multipoolermanagerdatapb.SetMonitorResponse
+	UpgradePostgresResponses                 map[string]*multipoolermanagerdatapb.UpgradePostgresResponse
+	InstallExtensionResponses                map[string]*multipoolermanagerdatapb.InstallExtensionResponse
 
 	// Errors to return - keyed by pooler ID
 	Errors map[string]error
@@ -95,7 +98,9 @@ type FakeClient struct {
 	CallLog []string
 
 	// Request tracking for verification in tests
-	PromoteRequests map[string]*multipoolermanagerdatapb.PromoteRequest
+	PromoteRequests          map[string]*multipoolermanagerdatapb.PromoteRequest
+	UpgradePostgresRequests  map[string]*multipoolermanagerdatapb.UpgradePostgresRequest
+	InstallExtensionRequests map[string]*multipoolermanagerdatapb.InstallExtensionRequest
 }
 
 // NewFakeClient creates a new FakeClient with empty response maps.
@@ -130,11 +135,16 @@ func NewFakeClient() *FakeClient {
 		RestoreFromBackupResponses:               make(map[string]*multipoolermanagerdatapb.RestoreFromBackupResponse),
 		GetBackupsResponses:                      make(map[string]*multipoolermanagerdatapb.GetBackupsResponse),
 		GetBackupByJobIdResponses:                make(map[string]*multipoolermanagerdatapb.GetBackupByJobIdResponse),
+		VerifyBackupResponses:                    make(map[string]*multipoolermanagerdatapb.VerifyBackupResponse),
 		RewindToSourceResponses:                  make(map[string]*multipoolermanagerdatapb.RewindToSourceResponse),
 		SetMonitorResponses:                      make(map[string]*multipoolermanagerdatapb.SetMonitorResponse),
+		UpgradePostgresResponses:                 make(map[string]*multipoolermanagerdatapb.UpgradePostgresResponse),
+		InstallExtensionResponses:                make(map[string]*multipoolermanagerdatapb.InstallExtensionResponse),
 		Errors:                                   make(map[string]error),
 		CallLog:                                  make([]string, 0),
 		PromoteRequests:                          make(map[string]*multipoolermanagerdatapb.PromoteRequest),
+		UpgradePostgresRequests:                  make(map[string]*multipoolermanagerdatapb.UpgradePostgresRequest),
+		InstallExtensionRequests:                 make(map[string]*multipoolermanagerdatapb.InstallExtensionRequest),
 	}
 }
 
@@ -740,6 +750,33 @@ func (f *FakeClient) GetBackupByJobId(ctx context.Context, pooler *clustermetada
 	return &multipoolermanagerdatapb.GetBackupByJobIdResponse{}, nil
 }
 
+func (f *FakeClient) VerifyBackup(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.VerifyBackupRequest) (*multipoolermanagerdatapb.VerifyBackupResponse, error) {
+	poolerID := f.getPoolerID(pooler)
+	f.logCall("VerifyBackup", poolerID)
+
+	if err := f.checkError(poolerID); err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if resp, ok := f.VerifyBackupResponses[poolerID]; ok {
+		return resp, nil
+	}
+	return &multipoolermanagerdatapb.VerifyBackupResponse{}, nil
+}
+
+func (f *FakeClient) DeleteBackup(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.DeleteBackupRequest) (*multipoolermanagerdatapb.DeleteBackupResponse, error) {
+	poolerID := f.getPoolerID(pooler)
+	f.logCall("DeleteBackup", poolerID)
+
+	if err := f.checkError(poolerID); err != nil {
+		return nil, err
+	}
+
+	return &multipoolermanagerdatapb.DeleteBackupResponse{}, nil
+}
+
 //
 // Manager Service Methods - Timeline Repair
 //
@@ -782,6 +819,67 @@ func (f *FakeClient) SetMonitor(ctx context.Context, pooler *clustermetadatapb.M
 	return &multipoolermanagerdatapb.SetMonitorResponse{}, nil
 }
 
+//
+// Manager Service Methods - Major Version Upgrades
+//
+
+// UpgradePostgres upgrades PostgreSQL on a pooler to a new major version.
+func (f *FakeClient) UpgradePostgres(ctx context.Context, pooler *clustermetadatapb.MultiPooler, req *multipoolermanagerdatapb.UpgradePostgresRequest) (*multipoolermanagerdatapb.UpgradePostgresResponse, error) {
+	poolerID := f.getPoolerID(pooler)
+	f.logCall("UpgradePostgres", poolerID)
+
+	f.mu.Lock()
+	f.UpgradePostgresRequests[poolerID] = req
+	f.mu.Unlock()
+
+	if err := f.checkError(poolerID); err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if resp, ok := f.UpgradePostgresResponses[poolerID]; ok {
+		return resp, nil
+	}
+	return &multipoolermanagerdatapb.UpgradePostgresResponse{}, nil
+}
+
+// FinishPostgresUpgrade finalizes or rolls back the last upgrade of a pooler.
+func (f *FakeClient) FinishPostgresUpgrade(ctx context.Context, pooler *clustermetadatapb.MultiPooler, req *multipoolermanagerdatapb.FinishPostgresUpgradeRequest) (*multipoolermanagerdatapb.FinishPostgresUpgradeResponse, error) {
+	poolerID := f.getPoolerID(pooler)
+	f.logCall("FinishPostgresUpgrade", poolerID)
+
+	if err := f.checkError(poolerID); err != nil {
+		return nil, err
+	}
+	return &multipoolermanagerdatapb.FinishPostgresUpgradeResponse{}, nil
+}
+
+//
+// Manager Service Methods - Extensions
+//
+
+// InstallExtension checks the files of an extension on a pooler, and creates or updates it on a primary.
+func (f *FakeClient) InstallExtension(ctx context.Context, pooler *clustermetadatapb.MultiPooler, req *multipoolermanagerdatapb.InstallExtensionRequest) (*multipoolermanagerdatapb.InstallExtensionResponse, error) {
+	poolerID := f.getPoolerID(pooler)
+	f.logCall("InstallExtension", poolerID)
+
+	f.mu.Lock()
+	f.InstallExtensionRequests[poolerID] = req
+	f.mu.Unlock()
+
+	if err := f.checkError(poolerID); err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if resp, ok := f.InstallExtensionResponses[poolerID]; ok {
+		return resp, nil
+	}
+	return &multipoolermanagerdatapb.InstallExtensionResponse{}, nil
+}
+
 //
 // Connection Management Methods
 //
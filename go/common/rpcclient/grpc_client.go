@@ -17,6 +17,8 @@ package rpcclient
 import (
 	"context"
 
+	"google.golang.org/grpc"
+
 	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	consensusdatapb "github.com/multigres/multigres/go/pb/consensusdata"
 	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
@@ -40,10 +42,11 @@ type Client struct {
 // NewClient creates a new gRPC-based MultiPoolerClient with specified capacity.
 // The capacity parameter determines the maximum number of simultaneous connections
 // to distinct multipoolers. When the cache is full, least-recently-used unreferenced
-// connections are evicted to make room for new connections.
-func NewClient(capacity int) *Client {
+// connections are evicted to make room for new connections. The dial options,
+// such as the transport credentials of ConnConfig, apply to every connection.
+func NewClient(capacity int, opts ...grpc.DialOption) *Client {
 	return &Client{
-		cache: newConnCacheWithCapacity(capacity),
+		cache: newConnCacheWithCapacity(capacity, opts...),
 	}
 }
 
@@ -492,6 +495,32 @@ func (c *Client) GetBackupByJobId(ctx context.Context, pooler *clustermetadatapb
 	return conn.managerClient.GetBackupByJobId(ctx, request)
 }
 
+// VerifyBackup checks the integrity of an existing backup.
+func (c *Client) VerifyBackup(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.VerifyBackupRequest) (*multipoolermanagerdatapb.VerifyBackupResponse, error) {
+	conn, closer, err := c.dialPersistent(ctx, pooler)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = closer()
+	}()
+
+	return conn.managerClient.VerifyBackup(ctx, request)
+}
+
+// DeleteBackup removes a backup from the repository.
+func (c *Client) DeleteBackup(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.DeleteBackupRequest) (*multipoolermanagerdatapb.DeleteBackupResponse, error) {
+	conn, closer, err := c.dialPersistent(ctx, pooler)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = closer()
+	}()
+
+	return conn.managerClient.DeleteBackup(ctx, request)
+}
+
 //
 // Manager Service Methods - Timeline Repair
 //
@@ -526,6 +555,53 @@ func (c *Client) SetMonitor(ctx context.Context, pooler *clustermetadatapb.Multi
 	return conn.managerClient.SetMonitor(ctx, request)
 }
 
+//
+// Manager Service Methods - Major Version Upgrades
+//
+
+// UpgradePostgres upgrades PostgreSQL on a pooler to a new major version.
+func (c *Client) UpgradePostgres(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.UpgradePostgresRequest) (*multipoolermanagerdatapb.UpgradePostgresResponse, error) {
+	conn, closer, err := c.dialPersistent(ctx, pooler)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = closer()
+	}()
+
+	return conn.managerClient.UpgradePostgres(ctx, request)
+}
+
+// FinishPostgresUpgrade finalizes or rolls back the last upgrade of a pooler.
+func (c *Client) FinishPostgresUpgrade(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.FinishPostgresUpgradeRequest) (*multipoolermanagerdatapb.FinishPostgresUpgradeResponse, error) {
+	conn, closer, err := c.dialPersistent(ctx, pooler)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = closer()
+	}()
+
+	return conn.managerClient.FinishPostgresUpgrade(ctx, request)
+}
+
+//
+// Manager Service Methods - Extensions
+//
+
+// InstallExtension checks the files of an extension on a pooler, and creates or updates it on a primary.
+func (c *Client) InstallExtension(ctx context.Context, pooler *clustermetadatapb.MultiPooler, request *multipoolermanagerdatapb.InstallExtensionRequest) (*multipoolermanagerdatapb.InstallExtensionResponse, error) {
+	conn, closer, err := c.dialPersistent(ctx, pooler)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = closer()
+	}()
+
+	return conn.managerClient.InstallExtension(ctx, request)
+}
+
 //
 // Connection Management Methods
 //
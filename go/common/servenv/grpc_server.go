@@ -27,9 +27,11 @@ import (
 	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/tools/grpccommon"
 	"github.com/multigres/multigres/go/tools/viperutil"
 
@@ -38,6 +40,10 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	// Registers the gzip compressor, so that the server decompresses the
+	// requests of the clients compressing them, and answers them likewise.
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
@@ -110,6 +116,9 @@ type GrpcServer struct {
 	// serverCA is the server CA file path
 	serverCA viperutil.Value[string]
 
+	// insecure allows serving gRPC over TCP without TLS
+	insecure viperutil.Value[bool]
+
 	// Server is the actual gRPC server instance
 	Server *grpc.Server
 
@@ -208,6 +217,11 @@ func NewGrpcServer(reg *viperutil.Registry) *GrpcServer {
 			FlagName: "grpc-server-ca",
 			Dynamic:  false,
 		}),
+		insecure: viperutil.Configure(reg, "grpc-insecure", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "grpc-insecure",
+			Dynamic:  false,
+		}),
 		socketFile: viperutil.Configure(reg, "grpc-socket-file", viperutil.Options[string]{
 			Default:  "",
 			FlagName: "grpc-socket-file",
@@ -233,6 +247,7 @@ func (g *GrpcServer) RegisterFlags(fs *pflag.FlagSet) {
 	fs.String("grpc-crl", g.crl.Default(), "path to a certificate revocation list in PEM format, client certificates will be further verified against this file during TLS handshake")
 	fs.Bool("grpc-enable-optional-tls", g.enableOptionalTLS.Default(), "enable optional TLS mode when a server accepts both TLS and plain-text connections on the same port")
 	fs.String("grpc-server-ca", g.serverCA.Default(), "path to server CA in PEM format, which will be combine with server cert, return full certificate chain to clients")
+	fs.Bool("grpc-insecure", g.insecure.Default(), "serve gRPC over TCP in plain text when grpc-cert and grpc-key are not set; for development clusters only")
 	fs.Duration("grpc-server-keepalive-time", g.keepaliveTime.Default(), "After a duration of this time, if the server doesn't see any activity, it pings the client to see if the transport is still alive.")
 	fs.Duration("grpc-server-keepalive-timeout", g.keepaliveTimeout.Default(), "After having pinged for keepalive check, the server waits for a duration of Timeout and if no activity is seen even after that the connection is closed.")
 	fs.String("grpc-socket-file", g.socketFile.Default(), "Local unix socket file to listen on")
@@ -255,6 +270,7 @@ func (g *GrpcServer) RegisterFlags(fs *pflag.FlagSet) {
 		g.crl,
 		g.enableOptionalTLS,
 		g.serverCA,
+		g.insecure,
 		g.socketFile,
 	)
 }
@@ -306,9 +322,9 @@ func (g *GrpcServer) Create() error {
 		return nil
 	}
 
-	var opts []grpc.ServerOption
-	if g.cert.Get() != "" && g.key.Get() != "" {
-		return errors.New("TLS is not implemented yet")
+	opts, err := g.transportOptions()
+	if err != nil {
+		return err
 	}
 	// Override the default max message size for both send and receive
 	// (which is 4 MiB in gRPC 1.0.0).
@@ -360,10 +376,48 @@ func (g *GrpcServer) Create() error {
 	return nil
 }
 
+// transportOptions returns the server options securing the connections.
+// The server uses TLS when grpc-cert and grpc-key are set, and requires the
+// clients to present a certificate signed by grpc-ca when it is set. The
+// certificate files are reloaded when they change, so that rotated
+// certificates apply to the new connections without dropping the existing
+// ones; grpc-max-connection-age moves these over gradually. Without TLS, a
+// server listening on a TCP port requires grpc-insecure.
+func (g *GrpcServer) transportOptions() ([]grpc.ServerOption, error) {
+	if g.cert.Get() == "" && g.key.Get() == "" {
+		if g.ca.Get() != "" || g.crl.Get() != "" || g.serverCA.Get() != "" {
+			return nil, errors.New("grpc-ca, grpc-crl and grpc-server-ca require grpc-cert and grpc-key")
+		}
+		if g.port.Get() != 0 && !g.insecure.Get() {
+			return nil, errors.New("gRPC requires TLS: set grpc-cert and grpc-key, or grpc-insecure for development clusters")
+		}
+		slog.Warn("gRPC server is not using TLS")
+		return nil, nil
+	}
+	if g.insecure.Get() {
+		return nil, errors.New("grpc-insecure cannot be set along with grpc-cert and grpc-key")
+	}
+	config, err := grpccommon.ServerTLSConfig(grpccommon.TLSFiles{
+		Cert:  g.cert.Get(),
+		Key:   g.key.Get(),
+		CA:    g.ca.Get(),
+		CRL:   g.crl.Get(),
+		Chain: g.serverCA.Get(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("grpc TLS: %w", err)
+	}
+	slog.Info("gRPC server is using TLS", "cert", g.cert.Get(), "client_ca", g.ca.Get())
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(config))}, nil
+}
+
 // interceptors builds the list of interceptors for the gRPC server
 func (g *GrpcServer) interceptors() ([]grpc.ServerOption, error) {
 	interceptors := &serverInterceptorBuilder{}
 
+	// The recovery comes first to also cover the interceptors after it.
+	interceptors.Add(recoveryInterceptors())
+
 	if g.auth.Get() != "" {
 		slog.Info("enabling auth plugin", "plugin", g.auth.Get())
 		pluginInitializer, err := GetAuthenticator(g.auth.Get())
@@ -388,6 +442,17 @@ func (g *GrpcServer) interceptors() ([]grpc.ServerOption, error) {
 	return interceptors.Build(), nil
 }
 
+// recoveryInterceptors return the interceptors failing the calls whose
+// handler panics with an internal error, instead of crashing the process.
+// The error carries the XX000 diagnostic of the panic (see
+// mterrors.RecoverPanic).
+func recoveryInterceptors() (grpc.StreamServerInterceptor, grpc.UnaryServerInterceptor) {
+	recovery := grpcrecovery.WithRecoveryHandlerContext(func(ctx context.Context, x any) error {
+		return mterrors.ToGRPC(mterrors.RecoverPanic(ctx, slog.Default(), "grpc", x))
+	})
+	return grpcrecovery.StreamServerInterceptor(recovery), grpcrecovery.UnaryServerInterceptor(recovery)
+}
+
 // Serve starts the gRPC server and begins listening for requests
 func (g *GrpcServer) Serve(sv *ServEnv) error {
 	// skip if not enabled
@@ -37,7 +37,7 @@ var (
 )
 
 func registerGRPCServerAuthMTLSFlags(fs *pflag.FlagSet) {
-	fs.StringVar(&clientCertSubstrings, "grpc-auth-mtls-allowed-substrings", clientCertSubstrings, "List of substrings of at least one of the client certificate names (separated by colon).")
+	fs.StringVar(&clientCertSubstrings, "grpc-auth-mtls-allowed-substrings", clientCertSubstrings, "List of substrings of at least one of the client certificate names or URI SANs such as SPIFFE IDs (separated by colon).")
 }
 
 // MtlsAuthPlugin  implements static username/password authentication for grpc. It contains an array of username/passwords
@@ -62,6 +62,15 @@ func (ma *MtlsAuthPlugin) Authenticate(ctx context.Context, fullMethod string) (
 			if strings.Contains(cert.Subject.String(), substring) {
 				return ctx, nil
 			}
+			// SPIFFE-style identities are URI subject alternative names,
+			// matched by a substring without the scheme, e.g.
+			// //multigres/multiorch, as the substrings are separated by
+			// colons.
+			for _, uri := range cert.URIs {
+				if strings.Contains(uri.String(), substring) {
+					return ctx, nil
+				}
+			}
 		}
 	}
 	return nil, status.Errorf(codes.Unauthenticated, "client certificate not authorized")
@@ -20,7 +20,16 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/tools/viperutil"
 )
 
 func TestEmpty(t *testing.T) {
@@ -60,6 +69,74 @@ func TestDoubleInterceptor(t *testing.T) {
 	}
 }
 
+func TestRecoveryInterceptors(t *testing.T) {
+	stream, unary := recoveryInterceptors()
+
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Unary"},
+		func(ctx context.Context, req any) (any, error) {
+			panic("unary handler bug")
+		})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, mterrors.FromGRPC(err), &diag)
+	assert.Equal(t, sqlstate.InternalError, diag.Code)
+
+	err = stream(nil, &WrappedServerStream{WrappedContext: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/test/Stream"},
+		func(srv any, stream grpc.ServerStream) error {
+			panic("stream handler bug")
+		})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// Calls that do not panic are unchanged.
+	resp, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Unary"},
+		func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestTransportOptions(t *testing.T) {
+	newServer := func() *GrpcServer {
+		g := NewGrpcServer(viperutil.NewRegistry())
+		g.port.Set(15999)
+		return g
+	}
+
+	g := newServer()
+	_, err := g.transportOptions()
+	assert.ErrorContains(t, err, "gRPC requires TLS", "plain text needs grpc-insecure")
+
+	g = newServer()
+	g.insecure.Set(true)
+	opts, err := g.transportOptions()
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+
+	g = newServer()
+	g.port.Set(0)
+	_, err = g.transportOptions()
+	assert.NoError(t, err, "a server only listening on its socket file does not need TLS")
+
+	g = newServer()
+	g.ca.Set("ca.pem")
+	_, err = g.transportOptions()
+	assert.ErrorContains(t, err, "require grpc-cert and grpc-key")
+
+	g = newServer()
+	g.cert.Set("server.pem")
+	g.key.Set("server.key")
+	g.insecure.Set(true)
+	_, err = g.transportOptions()
+	assert.ErrorContains(t, err, "cannot be set along with")
+
+	g = newServer()
+	g.cert.Set("missing.pem")
+	g.key.Set("missing.key")
+	_, err = g.transportOptions()
+	assert.ErrorContains(t, err, "failed to read certificate")
+}
+
 type FakeInterceptor struct {
 	name       string
 	streamSeen any
@@ -59,4 +59,8 @@ func (sv *ServEnv) RegisterCommonHTTPEndpoints() {
 	})
 
 	sv.HTTPHandleFunc("/config", viperdebug.HandlerFunc(sv.reg))
+
+	// The metrics in the Prometheus text format, whatever exporters are
+	// configured.
+	sv.HTTPHandle("/metrics", sv.telemetry.MetricsHandler())
 }
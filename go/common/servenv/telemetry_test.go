@@ -97,6 +97,7 @@ func TestServEnvTelemetryIntegration(t *testing.T) {
 	grpcServer := NewGrpcServer(reg)
 	grpcServer.port.Set(grpcPort)
 	grpcServer.bindAddress.Set("localhost")
+	grpcServer.insecure.Set(true)
 
 	// Start ServEnv in background
 	ready := make(chan struct{})
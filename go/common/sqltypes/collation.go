@@ -0,0 +1,79 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// TextCompare compares two text values by a collation, returning a negative
+// number, zero or a positive number as bytes.Compare.
+type TextCompare func(a, b []byte) int
+
+// NewCollation returns the comparison of text values by the collation
+// named name, as given to COLLATE, or by a locale, as the default collation
+// of a database: "C", "POSIX", "ucs_basic" and the C locales of the builtin
+// provider compare bytewise, libc locales such as "en_US.UTF-8" and ICU
+// locales such as "en-US" or "de-x-icu" by the Unicode collation of their
+// language. Like PostgreSQL, values that the collation finds equal are
+// ordered bytewise.
+func NewCollation(name string) (TextCompare, error) {
+	switch strings.ToLower(name) {
+	case "", "c", "posix", "ucs_basic", "c.utf-8", "c.utf8", "pg_c_utf8", "pg_unicode_fast":
+		return bytes.Compare, nil
+	}
+
+	locale := strings.TrimSuffix(name, "-x-icu")
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported collation %q: %w", name, err)
+	}
+	// Collators keep buffers, and cannot be used concurrently.
+	pool := sync.Pool{New: func() any { return collate.New(tag) }}
+	return func(a, b []byte) int {
+		c := pool.Get().(*collate.Collator)
+		defer pool.Put(c)
+		if r := c.Compare(a, b); r != 0 {
+			return r
+		}
+		return bytes.Compare(a, b)
+	}, nil
+}
+
+// IsCollatable returns whether the values of field are compared by a
+// collation, so that ordering them requires it. Values of type name always
+// compare bytewise.
+func IsCollatable(field *query.Field) bool {
+	if field == nil {
+		return false
+	}
+	switch ast.Oid(field.DataTypeOid) {
+	case ast.TEXTOID, ast.VARCHAROID, ast.BPCHAROID:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestNewCollation(t *testing.T) {
+	for _, name := range []string{"C", "POSIX", "ucs_basic", "C.UTF-8"} {
+		compare, err := NewCollation(name)
+		require.NoError(t, err, name)
+		assert.Negative(t, compare([]byte("Z"), []byte("a")), "%s compares bytewise", name)
+	}
+
+	for _, name := range []string{"en_US.UTF-8", "en_US.utf8", "en-US", "en-US-x-icu", "und-x-icu"} {
+		compare, err := NewCollation(name)
+		require.NoError(t, err, name)
+		assert.Positive(t, compare([]byte("Z"), []byte("a")), name)
+		assert.Negative(t, compare([]byte("éclair"), []byte("zebra")), name)
+		// Lowercase sorts first, and values equal by the collation are not
+		// equal.
+		assert.Negative(t, compare([]byte("a"), []byte("A")), name)
+		assert.Zero(t, compare([]byte("a"), []byte("a")), name)
+	}
+
+	_, err := NewCollation("not a locale")
+	assert.ErrorContains(t, err, "unsupported collation")
+}
+
+func TestMergeSortedCollated(t *testing.T) {
+	fields := mergeTestFields()
+	// Both shards ordered their rows by name in the en_US collation.
+	results := []*Result{
+		{Fields: fields, Rows: textRows([]string{"1", "apple"}, []string{"2", "Éclair"}, []string{"3", "zebra"})},
+		{Fields: fields, Rows: textRows([]string{"4", "Banana"}, []string{"5", "eclair"}, []string{"6", "Zulu"})},
+	}
+	names := func(r *Result) []string {
+		out := make([]string, len(r.Rows))
+		for i, row := range r.Rows {
+			out[i] = string(row.Values[1])
+		}
+		return out
+	}
+
+	enUS, err := NewCollation("en_US.UTF-8")
+	require.NoError(t, err)
+	compare, err := NewCollatedRowComparator(fields, []OrderByColumn{{Column: 1}}, []TextCompare{enUS})
+	require.NoError(t, err)
+	merged, err := MergeSorted(results, compare)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apple", "Banana", "eclair", "Éclair", "zebra", "Zulu"}, names(merged))
+
+	// Bytewise, the merge of these shard orders is not ordered at all.
+	compare, err = NewRowComparator(fields, []OrderByColumn{{Column: 1}})
+	require.NoError(t, err)
+	merged, err = MergeSorted(results, compare)
+	require.NoError(t, err)
+	assert.NotEqual(t, []string{"apple", "Banana", "eclair", "Éclair", "zebra", "Zulu"}, names(merged))
+}
+
+func TestIsCollatable(t *testing.T) {
+	assert.True(t, IsCollatable(&query.Field{DataTypeOid: uint32(ast.TEXTOID)}))
+	assert.True(t, IsCollatable(&query.Field{DataTypeOid: uint32(ast.VARCHAROID)}))
+	assert.False(t, IsCollatable(&query.Field{DataTypeOid: uint32(ast.NAMEOID)}))
+	assert.False(t, IsCollatable(&query.Field{DataTypeOid: uint32(ast.INT4OID)}))
+	assert.False(t, IsCollatable(nil))
+}
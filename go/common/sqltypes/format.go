@@ -0,0 +1,624 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// Format is a PostgreSQL wire format code, as carried in RowDescription
+// and in the result format codes of a Bind message.
+type Format int32
+
+const (
+	// FormatText is the text format code. It is the default for all columns.
+	FormatText Format = 0
+
+	// FormatBinary is the binary format code.
+	FormatBinary Format = 1
+)
+
+// ErrUnsupportedConversion is returned when a value cannot be converted
+// between formats because its type has no known binary representation.
+var ErrUnsupportedConversion = errors.New("unsupported format conversion")
+
+// pgEpoch is the PostgreSQL epoch used by the binary date/time encodings.
+var pgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Special values used by PostgreSQL for infinite dates and timestamps.
+const (
+	dateInfinity      = math.MaxInt32
+	dateNegInfinity   = math.MinInt32
+	timestampInfinity = math.MaxInt64
+	timestampNegInf   = math.MinInt64
+)
+
+// ResolveFormat returns the format requested for column i given the result
+// format codes of a Bind message. An empty list means all text, a single
+// entry applies to every column, otherwise there is one entry per column.
+func ResolveFormat(formats []int32, i int) Format {
+	switch len(formats) {
+	case 0:
+		return FormatText
+	case 1:
+		return Format(formats[0])
+	default:
+		if i < len(formats) {
+			return Format(formats[i])
+		}
+		return FormatText
+	}
+}
+
+// ConvertValue converts a value of the given type OID from one format to another.
+// NULL values are returned unchanged.
+func ConvertValue(oid uint32, v Value, from, to Format) (Value, error) {
+	if v == nil || from == to {
+		return v, nil
+	}
+	switch {
+	case from == FormatText && to == FormatBinary:
+		return TextToBinary(oid, v)
+	case from == FormatBinary && to == FormatText:
+		return BinaryToText(oid, v)
+	default:
+		return nil, fmt.Errorf("invalid format conversion from %d to %d", from, to)
+	}
+}
+
+// ConvertFormats re-encodes the rows of the result in place so that every
+// column is in the format requested by the given result format codes. Fields
+// whose format changes are cloned, so Fields shared with other results are
+// never mutated. Results without Fields are left untouched.
+func (r *Result) ConvertFormats(formats []int32) error {
+	if r == nil || len(r.Fields) == 0 {
+		return nil
+	}
+
+	var fields []*query.Field
+	for i, f := range r.Fields {
+		want := ResolveFormat(formats, i)
+		have := Format(f.Format)
+		if want == have {
+			continue
+		}
+		for _, row := range r.Rows {
+			if i >= len(row.Values) {
+				continue
+			}
+			v, err := ConvertValue(f.DataTypeOid, row.Values[i], have, want)
+			if err != nil {
+				return fmt.Errorf("column %q: %w", f.Name, err)
+			}
+			row.Values[i] = v
+		}
+		if fields == nil {
+			fields = make([]*query.Field, len(r.Fields))
+			copy(fields, r.Fields)
+		}
+		nf := proto.Clone(f).(*query.Field)
+		nf.Format = int32(want)
+		fields[i] = nf
+	}
+	if fields != nil {
+		r.Fields = fields
+	}
+	return nil
+}
+
+// TextToBinary converts a text-format value of the given type OID into its
+// binary wire representation.
+func TextToBinary(oid uint32, v Value) (Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	s := string(v)
+
+	switch ast.Oid(oid) {
+	case ast.TEXTOID, ast.VARCHAROID, ast.BPCHAROID, ast.NAMEOID, ast.CHAROID,
+		ast.JSONOID, ast.XMLOID, ast.InvalidOid:
+		return copyValue(v), nil
+
+	case ast.JSONBOID:
+		// jsonb binary format is a version byte followed by the text.
+		out := make(Value, 0, len(v)+1)
+		out = append(out, 1)
+		return append(out, v...), nil
+
+	case ast.BOOLOID:
+		switch s {
+		case "t":
+			return Value{1}, nil
+		case "f":
+			return Value{0}, nil
+		}
+		return nil, fmt.Errorf("invalid bool value %q", s)
+
+	case ast.BYTEAOID:
+		return parseTextBytea(s)
+
+	case ast.INT2OID:
+		n, err := strconv.ParseInt(s, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int2 value %q: %w", s, err)
+		}
+		return binary.BigEndian.AppendUint16(nil, uint16(n)), nil
+
+	case ast.INT4OID:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int4 value %q: %w", s, err)
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(n)), nil
+
+	case ast.INT8OID:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int8 value %q: %w", s, err)
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(n)), nil
+
+	case ast.OIDOID, ast.XIDOID, ast.CIDOID, ast.REGPROCOID:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", ast.Oid(oid), s, err)
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(n)), nil
+
+	case ast.FLOAT4OID:
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float4 value %q: %w", s, err)
+		}
+		return binary.BigEndian.AppendUint32(nil, math.Float32bits(float32(f))), nil
+
+	case ast.FLOAT8OID:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float8 value %q: %w", s, err)
+		}
+		return binary.BigEndian.AppendUint64(nil, math.Float64bits(f)), nil
+
+	case ast.NUMERICOID:
+		return parseTextNumeric(s)
+
+	case ast.UUIDOID:
+		u, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uuid value %q: %w", s, err)
+		}
+		return Value(u[:]), nil
+
+	case ast.DATEOID:
+		return parseTextDate(s)
+
+	case ast.TIMEOID:
+		t, err := time.Parse("15:04:05.999999", s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time value %q: %w", s, err)
+		}
+		micros := int64(t.Hour())*3600e6 + int64(t.Minute())*60e6 + int64(t.Second())*1e6 + int64(t.Nanosecond()/1000)
+		return binary.BigEndian.AppendUint64(nil, uint64(micros)), nil
+
+	case ast.TIMESTAMPOID, ast.TIMESTAMPTZOID:
+		return parseTextTimestamp(s, ast.Oid(oid) == ast.TIMESTAMPTZOID)
+
+	default:
+		return nil, fmt.Errorf("%w: type %s (oid %d) to binary", ErrUnsupportedConversion, ast.Oid(oid), oid)
+	}
+}
+
+// BinaryToText converts a binary-format value of the given type OID into its
+// text wire representation. Output matches PostgreSQL's defaults
+// (DateStyle=ISO, bytea_output=hex). timestamptz values are rendered in UTC
+// because the session TimeZone is not known at this layer.
+func BinaryToText(oid uint32, v Value) (Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch ast.Oid(oid) {
+	case ast.TEXTOID, ast.VARCHAROID, ast.BPCHAROID, ast.NAMEOID, ast.CHAROID,
+		ast.JSONOID, ast.XMLOID, ast.InvalidOid:
+		return copyValue(v), nil
+
+	case ast.JSONBOID:
+		if len(v) == 0 || v[0] != 1 {
+			return nil, errors.New("invalid jsonb binary value: unsupported version")
+		}
+		return copyValue(v[1:]), nil
+
+	case ast.BOOLOID:
+		if err := checkLen(oid, v, 1); err != nil {
+			return nil, err
+		}
+		if v[0] != 0 {
+			return Value("t"), nil
+		}
+		return Value("f"), nil
+
+	case ast.BYTEAOID:
+		out := make(Value, 2+hex.EncodedLen(len(v)))
+		out[0], out[1] = '\\', 'x'
+		hex.Encode(out[2:], v)
+		return out, nil
+
+	case ast.INT2OID:
+		if err := checkLen(oid, v, 2); err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(nil, int64(int16(binary.BigEndian.Uint16(v))), 10), nil
+
+	case ast.INT4OID:
+		if err := checkLen(oid, v, 4); err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(nil, int64(int32(binary.BigEndian.Uint32(v))), 10), nil
+
+	case ast.INT8OID:
+		if err := checkLen(oid, v, 8); err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(nil, int64(binary.BigEndian.Uint64(v)), 10), nil
+
+	case ast.OIDOID, ast.XIDOID, ast.CIDOID, ast.REGPROCOID:
+		if err := checkLen(oid, v, 4); err != nil {
+			return nil, err
+		}
+		return strconv.AppendUint(nil, uint64(binary.BigEndian.Uint32(v)), 10), nil
+
+	case ast.FLOAT4OID:
+		if err := checkLen(oid, v, 4); err != nil {
+			return nil, err
+		}
+		return Value(FormatFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(v))), 32)), nil
+
+	case ast.FLOAT8OID:
+		if err := checkLen(oid, v, 8); err != nil {
+			return nil, err
+		}
+		return Value(FormatFloat(math.Float64frombits(binary.BigEndian.Uint64(v)), 64)), nil
+
+	case ast.NUMERICOID:
+		return formatBinaryNumeric(v)
+
+	case ast.UUIDOID:
+		if err := checkLen(oid, v, 16); err != nil {
+			return nil, err
+		}
+		return Value(uuid.UUID(v).String()), nil
+
+	case ast.DATEOID:
+		if err := checkLen(oid, v, 4); err != nil {
+			return nil, err
+		}
+		switch days := int32(binary.BigEndian.Uint32(v)); days {
+		case dateInfinity:
+			return Value("infinity"), nil
+		case dateNegInfinity:
+			return Value("-infinity"), nil
+		default:
+			return Value(pgEpoch.AddDate(0, 0, int(days)).Format("2006-01-02")), nil
+		}
+
+	case ast.TIMEOID:
+		if err := checkLen(oid, v, 8); err != nil {
+			return nil, err
+		}
+		micros := int64(binary.BigEndian.Uint64(v))
+		t := pgEpoch.Add(time.Duration(micros) * time.Microsecond)
+		return Value(t.Format("15:04:05.999999")), nil
+
+	case ast.TIMESTAMPOID, ast.TIMESTAMPTZOID:
+		if err := checkLen(oid, v, 8); err != nil {
+			return nil, err
+		}
+		switch micros := int64(binary.BigEndian.Uint64(v)); micros {
+		case timestampInfinity:
+			return Value("infinity"), nil
+		case timestampNegInf:
+			return Value("-infinity"), nil
+		default:
+			t := time.UnixMicro(pgEpoch.UnixMicro() + micros).UTC()
+			if ast.Oid(oid) == ast.TIMESTAMPTZOID {
+				return Value(t.Format("2006-01-02 15:04:05.999999") + "+00"), nil
+			}
+			return Value(t.Format("2006-01-02 15:04:05.999999")), nil
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: type %s (oid %d) to text", ErrUnsupportedConversion, ast.Oid(oid), oid)
+	}
+}
+
+// copyValue returns a copy of v that does not share its backing array.
+func copyValue(v Value) Value {
+	out := make(Value, len(v))
+	copy(out, v)
+	return out
+}
+
+// checkLen verifies that a fixed-width binary value has the expected length.
+func checkLen(oid uint32, v Value, want int) error {
+	if len(v) != want {
+		return fmt.Errorf("invalid %s binary value: expected %d bytes, got %d", ast.Oid(oid), want, len(v))
+	}
+	return nil
+}
+
+// FormatFloat renders a float the way PostgreSQL's float4out/float8out do
+// with the default extra_float_digits: shortest exact representation, using
+// exponent notation outside the range [1e-4, 1e15) (or [1e-4, 1e6) for float4).
+func FormatFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	limit := 15
+	if bitSize == 32 {
+		limit = 6
+	}
+	s := strconv.FormatFloat(f, 'e', -1, bitSize)
+	exp, err := strconv.Atoi(s[strings.IndexByte(s, 'e')+1:])
+	if err == nil && exp >= -4 && exp < limit {
+		return strconv.FormatFloat(f, 'f', -1, bitSize)
+	}
+	return s
+}
+
+// parseTextBytea decodes a bytea in either hex ("\x...") or escape format.
+func parseTextBytea(s string) (Value, error) {
+	if strings.HasPrefix(s, `\x`) {
+		out, err := hex.DecodeString(s[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid bytea hex value: %w", err)
+		}
+		return out, nil
+	}
+
+	out := make(Value, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			out = append(out, s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '\\' {
+			out = append(out, '\\')
+			i++
+			continue
+		}
+		if i+4 > len(s) {
+			return nil, fmt.Errorf("invalid bytea escape sequence at offset %d", i)
+		}
+		n, err := strconv.ParseUint(s[i+1:i+4], 8, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bytea escape sequence at offset %d", i)
+		}
+		out = append(out, byte(n))
+		i += 3
+	}
+	return out, nil
+}
+
+// parseTextDate encodes an ISO date as days since the PostgreSQL epoch.
+func parseTextDate(s string) (Value, error) {
+	var days int32
+	switch s {
+	case "infinity":
+		days = dateInfinity
+	case "-infinity":
+		days = dateNegInfinity
+	default:
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date value %q: %w", s, err)
+		}
+		days = int32((t.Unix() - pgEpoch.Unix()) / 86400)
+	}
+	return binary.BigEndian.AppendUint32(nil, uint32(days)), nil
+}
+
+// timestampLayouts are the ISO layouts accepted when parsing text timestamps.
+// The offset forms are only used for timestamptz.
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999-07:00:00",
+	"2006-01-02 15:04:05.999999-07:00",
+	"2006-01-02 15:04:05.999999-07",
+}
+
+// parseTextTimestamp encodes an ISO timestamp as microseconds since the
+// PostgreSQL epoch. For timestamptz the value is normalized to UTC.
+func parseTextTimestamp(s string, withTZ bool) (Value, error) {
+	var micros int64
+	switch s {
+	case "infinity":
+		micros = timestampInfinity
+	case "-infinity":
+		micros = timestampNegInf
+	default:
+		var t time.Time
+		var err error
+		if withTZ {
+			for _, layout := range timestampLayouts {
+				if t, err = time.Parse(layout, s); err == nil {
+					break
+				}
+			}
+		} else {
+			t, err = time.Parse("2006-01-02 15:04:05.999999", s)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp value %q: %w", s, err)
+		}
+		micros = t.UnixMicro() - pgEpoch.UnixMicro()
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(micros)), nil
+}
+
+// Numeric binary sign values.
+const (
+	numericPos    = 0x0000
+	numericNeg    = 0x4000
+	numericNaN    = 0xC000
+	numericPInf   = 0xD000
+	numericNInf   = 0xF000
+	numericDigits = 4 // decimal digits per base-10000 digit
+)
+
+// parseTextNumeric encodes a numeric in PostgreSQL's base-10000 binary format:
+// ndigits, weight, sign, dscale (all int16) followed by ndigits base-10000 digits.
+func parseTextNumeric(s string) (Value, error) {
+	header := func(ndigits, weight int16, sign uint16, dscale int16) Value {
+		out := binary.BigEndian.AppendUint16(nil, uint16(ndigits))
+		out = binary.BigEndian.AppendUint16(out, uint16(weight))
+		out = binary.BigEndian.AppendUint16(out, sign)
+		return binary.BigEndian.AppendUint16(out, uint16(dscale))
+	}
+
+	switch s {
+	case "NaN":
+		return header(0, 0, numericNaN, 0), nil
+	case "Infinity":
+		return header(0, 0, numericPInf, 0), nil
+	case "-Infinity":
+		return header(0, 0, numericNInf, 0), nil
+	}
+
+	var sign uint16 = numericPos
+	digits := s
+	if strings.HasPrefix(digits, "-") {
+		sign = numericNeg
+		digits = digits[1:]
+	} else if strings.HasPrefix(digits, "+") {
+		digits = digits[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" {
+		return nil, fmt.Errorf("invalid numeric value %q", s)
+	}
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid numeric value %q", s)
+		}
+	}
+	dscale := len(fracPart)
+
+	// Pad the integer part on the left and the fraction on the right so both
+	// split evenly into base-10000 digits.
+	if r := len(intPart) % numericDigits; r != 0 {
+		intPart = strings.Repeat("0", numericDigits-r) + intPart
+	}
+	if r := len(fracPart) % numericDigits; r != 0 {
+		fracPart += strings.Repeat("0", numericDigits-r)
+	}
+	all := intPart + fracPart
+	groups := make([]uint16, 0, len(all)/numericDigits)
+	for i := 0; i < len(all); i += numericDigits {
+		n, _ := strconv.Atoi(all[i : i+numericDigits])
+		groups = append(groups, uint16(n))
+	}
+	weight := len(intPart)/numericDigits - 1
+
+	// Strip leading and trailing zero digits.
+	for len(groups) > 0 && groups[0] == 0 {
+		groups = groups[1:]
+		weight--
+	}
+	for len(groups) > 0 && groups[len(groups)-1] == 0 {
+		groups = groups[:len(groups)-1]
+	}
+	if len(groups) == 0 {
+		weight = 0
+		sign = numericPos
+	}
+
+	out := header(int16(len(groups)), int16(weight), sign, int16(dscale))
+	for _, g := range groups {
+		out = binary.BigEndian.AppendUint16(out, g)
+	}
+	return out, nil
+}
+
+// formatBinaryNumeric renders a binary numeric in PostgreSQL's text format.
+func formatBinaryNumeric(v Value) (Value, error) {
+	if len(v) < 8 {
+		return nil, fmt.Errorf("invalid numeric binary value: expected at least 8 bytes, got %d", len(v))
+	}
+	ndigits := int(int16(binary.BigEndian.Uint16(v[0:])))
+	weight := int(int16(binary.BigEndian.Uint16(v[2:])))
+	sign := binary.BigEndian.Uint16(v[4:])
+	dscale := int(int16(binary.BigEndian.Uint16(v[6:])))
+	if ndigits < 0 || dscale < 0 || len(v) != 8+2*ndigits {
+		return nil, errors.New("invalid numeric binary value: malformed header")
+	}
+
+	switch sign {
+	case numericNaN:
+		return Value("NaN"), nil
+	case numericPInf:
+		return Value("Infinity"), nil
+	case numericNInf:
+		return Value("-Infinity"), nil
+	case numericPos, numericNeg:
+	default:
+		return nil, fmt.Errorf("invalid numeric binary value: unknown sign 0x%04x", sign)
+	}
+
+	digit := func(i int) int {
+		if i < 0 || i >= ndigits {
+			return 0
+		}
+		return int(binary.BigEndian.Uint16(v[8+2*i:]))
+	}
+
+	var sb strings.Builder
+	if sign == numericNeg {
+		sb.WriteByte('-')
+	}
+	if weight < 0 {
+		sb.WriteByte('0')
+	} else {
+		sb.WriteString(strconv.Itoa(digit(0)))
+		for i := 1; i <= weight; i++ {
+			fmt.Fprintf(&sb, "%04d", digit(i))
+		}
+	}
+	if dscale > 0 {
+		var frac strings.Builder
+		for i := weight + 1; frac.Len() < dscale; i++ {
+			fmt.Fprintf(&frac, "%04d", digit(i))
+		}
+		sb.WriteByte('.')
+		sb.WriteString(frac.String()[:dscale])
+	}
+	return Value(sb.String()), nil
+}
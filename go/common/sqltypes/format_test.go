@@ -0,0 +1,142 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestResolveFormat(t *testing.T) {
+	assert.Equal(t, FormatText, ResolveFormat(nil, 3))
+	assert.Equal(t, FormatBinary, ResolveFormat([]int32{1}, 3))
+	assert.Equal(t, FormatBinary, ResolveFormat([]int32{0, 1}, 1))
+	assert.Equal(t, FormatText, ResolveFormat([]int32{0, 1}, 0))
+	assert.Equal(t, FormatText, ResolveFormat([]int32{0, 1}, 5))
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		oid    ast.Oid
+		text   string
+		binary []byte
+	}{
+		{name: "bool true", oid: ast.BOOLOID, text: "t", binary: []byte{1}},
+		{name: "bool false", oid: ast.BOOLOID, text: "f", binary: []byte{0}},
+		{name: "int2", oid: ast.INT2OID, text: "-2", binary: []byte{0xff, 0xfe}},
+		{name: "int4", oid: ast.INT4OID, text: "258", binary: []byte{0, 0, 1, 2}},
+		{name: "int8", oid: ast.INT8OID, text: "-1", binary: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{name: "oid", oid: ast.OIDOID, text: "4294967295", binary: []byte{0xff, 0xff, 0xff, 0xff}},
+		{name: "float8", oid: ast.FLOAT8OID, text: "1.5", binary: []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}},
+		{name: "float4", oid: ast.FLOAT4OID, text: "-2", binary: []byte{0xc0, 0, 0, 0}},
+		{name: "text", oid: ast.TEXTOID, text: "héllo", binary: []byte("héllo")},
+		{name: "jsonb", oid: ast.JSONBOID, text: `{"a": 1}`, binary: append([]byte{1}, `{"a": 1}`...)},
+		{name: "bytea", oid: ast.BYTEAOID, text: `\xdeadbeef`, binary: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{
+			name:   "uuid",
+			oid:    ast.UUIDOID,
+			text:   "00112233-4455-6677-8899-aabbccddeeff",
+			binary: []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+		},
+		{name: "date", oid: ast.DATEOID, text: "2000-01-02", binary: []byte{0, 0, 0, 1}},
+		{name: "date before epoch", oid: ast.DATEOID, text: "1999-12-31", binary: []byte{0xff, 0xff, 0xff, 0xff}},
+		{name: "date infinity", oid: ast.DATEOID, text: "infinity", binary: []byte{0x7f, 0xff, 0xff, 0xff}},
+		{name: "time", oid: ast.TIMEOID, text: "00:00:01.5", binary: []byte{0, 0, 0, 0, 0, 0x16, 0xe3, 0x60}},
+		{name: "timestamp", oid: ast.TIMESTAMPOID, text: "2000-01-01 00:00:01", binary: []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}},
+		{name: "timestamptz", oid: ast.TIMESTAMPTZOID, text: "2000-01-01 00:00:00.000001+00", binary: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "numeric", oid: ast.NUMERICOID, text: "12345.60", binary: []byte{0, 3, 0, 1, 0, 0, 0, 2, 0, 1, 0x09, 0x29, 0x17, 0x70}},
+		{name: "numeric negative fraction", oid: ast.NUMERICOID, text: "-0.00000001", binary: []byte{0, 1, 0xff, 0xfe, 0x40, 0, 0, 8, 0, 1}},
+		{name: "numeric zero", oid: ast.NUMERICOID, text: "0", binary: []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "numeric NaN", oid: ast.NUMERICOID, text: "NaN", binary: []byte{0, 0, 0, 0, 0xc0, 0, 0, 0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bin, err := TextToBinary(uint32(tc.oid), Value(tc.text))
+			require.NoError(t, err)
+			assert.Equal(t, tc.binary, []byte(bin))
+
+			txt, err := BinaryToText(uint32(tc.oid), Value(tc.binary))
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, string(txt))
+		})
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	assert.Equal(t, "123456789", FormatFloat(123456789, 64))
+	assert.Equal(t, "1e+15", FormatFloat(1e15, 64))
+	assert.Equal(t, "0.0001", FormatFloat(0.0001, 64))
+	assert.Equal(t, "1e-05", FormatFloat(0.00001, 64))
+	assert.Equal(t, "1e+06", FormatFloat(1e6, 32))
+	assert.Equal(t, "NaN", FormatFloat(math.NaN(), 64))
+}
+
+func TestTextToBinaryEscapedBytea(t *testing.T) {
+	v, err := TextToBinary(uint32(ast.BYTEAOID), Value(`a\\b\001`))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{'a', '\\', 'b', 1}, []byte(v))
+
+	_, err = TextToBinary(uint32(ast.BYTEAOID), Value(`a\0`))
+	assert.Error(t, err)
+}
+
+func TestConversionErrors(t *testing.T) {
+	_, err := TextToBinary(uint32(ast.INTERVALOID), Value("1 day"))
+	assert.ErrorIs(t, err, ErrUnsupportedConversion)
+
+	_, err = BinaryToText(uint32(ast.INT4OID), Value{1, 2})
+	assert.Error(t, err)
+
+	_, err = TextToBinary(uint32(ast.INT2OID), Value("70000"))
+	assert.Error(t, err)
+}
+
+func TestResultConvertFormats(t *testing.T) {
+	shared := []*query.Field{
+		{Name: "id", DataTypeOid: uint32(ast.INT4OID)},
+		{Name: "name", DataTypeOid: uint32(ast.TEXTOID)},
+	}
+	r := &Result{
+		Fields: shared,
+		Rows: []*Row{
+			{Values: []Value{Value("7"), Value("a")}},
+			{Values: []Value{nil, Value("b")}},
+		},
+	}
+
+	require.NoError(t, r.ConvertFormats([]int32{1, 0}))
+	assert.Equal(t, int32(FormatBinary), r.Fields[0].Format)
+	assert.Equal(t, int32(FormatText), r.Fields[1].Format)
+	assert.Equal(t, Value{0, 0, 0, 7}, r.Rows[0].Values[0])
+	assert.Nil(t, r.Rows[1].Values[0])
+	assert.Equal(t, Value("a"), r.Rows[0].Values[1])
+
+	// The original fields must not be mutated.
+	assert.Equal(t, int32(FormatText), shared[0].Format)
+	assert.Same(t, shared[1], r.Fields[1])
+
+	// Converting back to text restores the original encoding.
+	require.NoError(t, r.ConvertFormats(nil))
+	assert.Equal(t, int32(FormatText), r.Fields[0].Format)
+	assert.Equal(t, Value("7"), r.Rows[0].Values[0])
+}
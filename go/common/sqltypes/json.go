@@ -0,0 +1,281 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// JSON encoding
+//
+// Values are encoded so that NULL and the empty string remain distinct:
+//   - NULL is encoded as JSON null.
+//   - Valid UTF-8 (all text-format values) is encoded as a JSON string.
+//   - Anything else (e.g. binary-format values) is encoded as an object
+//     {"base64": "..."} so the bytes round-trip exactly.
+//
+// Rows are encoded as arrays of values, and Results as objects with
+// snake_case keys matching the proto field names.
+
+// jsonBase64 is the object form used for values that are not valid UTF-8.
+type jsonBase64 struct {
+	Base64 string `json:"base64"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	if utf8.Valid(v) {
+		return json.Marshal(string(v))
+	}
+	return json.Marshal(jsonBase64{Base64: base64.StdEncoding.EncodeToString(v)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*v = nil
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*v = Value(s)
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var b jsonBase64
+		if err := json.Unmarshal(data, &b); err != nil {
+			return err
+		}
+		decoded, err := base64.StdEncoding.DecodeString(b.Base64)
+		if err != nil {
+			return fmt.Errorf("invalid base64 value: %w", err)
+		}
+		*v = Value(decoded)
+		return nil
+	default:
+		return fmt.Errorf("invalid JSON value for sqltypes.Value: %s", data)
+	}
+}
+
+// MarshalJSON implements json.Marshaler. A Row is encoded as an array.
+func (r *Row) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte("null"), nil
+	}
+	values := r.Values
+	if values == nil {
+		values = []Value{}
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Row) UnmarshalJSON(data []byte) error {
+	var values []Value
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	r.Values = values
+	return nil
+}
+
+// jsonField mirrors query.Field with stable snake_case keys.
+type jsonField struct {
+	Name                 string `json:"name"`
+	Type                 string `json:"type,omitempty"`
+	TableOid             uint32 `json:"table_oid,omitempty"`
+	TableAttributeNumber int32  `json:"table_attribute_number,omitempty"`
+	DataTypeOid          uint32 `json:"data_type_oid"`
+	DataTypeSize         int32  `json:"data_type_size,omitempty"`
+	TypeModifier         int32  `json:"type_modifier,omitempty"`
+	Format               int32  `json:"format,omitempty"`
+}
+
+// jsonResult is the JSON representation of a Result.
+type jsonResult struct {
+	Fields       []jsonField     `json:"fields,omitempty"`
+	Rows         []*Row          `json:"rows"`
+	RowsAffected uint64          `json:"rows_affected"`
+	CommandTag   string          `json:"command_tag,omitempty"`
+	Notices      []*PgDiagnostic `json:"notices,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Result) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte("null"), nil
+	}
+	jr := jsonResult{
+		Rows:         r.Rows,
+		RowsAffected: r.RowsAffected,
+		CommandTag:   r.CommandTag,
+	}
+	if jr.Rows == nil {
+		jr.Rows = []*Row{}
+	}
+	for _, f := range r.Fields {
+		jr.Fields = append(jr.Fields, jsonField{
+			Name:                 f.GetName(),
+			Type:                 f.GetType(),
+			TableOid:             f.GetTableOid(),
+			TableAttributeNumber: f.GetTableAttributeNumber(),
+			DataTypeOid:          f.GetDataTypeOid(),
+			DataTypeSize:         f.GetDataTypeSize(),
+			TypeModifier:         f.GetTypeModifier(),
+			Format:               f.GetFormat(),
+		})
+	}
+	for _, n := range r.Notices {
+		jr.Notices = append(jr.Notices, DiagnosticFromNotice(n))
+	}
+	return json.Marshal(jr)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Result) UnmarshalJSON(data []byte) error {
+	var jr jsonResult
+	if err := json.Unmarshal(data, &jr); err != nil {
+		return err
+	}
+	*r = Result{
+		RowsAffected: jr.RowsAffected,
+		CommandTag:   jr.CommandTag,
+	}
+	if len(jr.Rows) > 0 {
+		r.Rows = jr.Rows
+	}
+	for _, f := range jr.Fields {
+		r.Fields = append(r.Fields, &query.Field{
+			Name:                 f.Name,
+			Type:                 f.Type,
+			TableOid:             f.TableOid,
+			TableAttributeNumber: f.TableAttributeNumber,
+			DataTypeOid:          f.DataTypeOid,
+			DataTypeSize:         f.DataTypeSize,
+			TypeModifier:         f.TypeModifier,
+			Format:               f.Format,
+		})
+	}
+	for _, d := range jr.Notices {
+		r.Notices = append(r.Notices, d.ToNotice())
+	}
+	return nil
+}
+
+// jsonDiagnostic is the JSON representation of a PgDiagnostic.
+type jsonDiagnostic struct {
+	MessageType          string `json:"message_type,omitempty"`
+	Severity             string `json:"severity,omitempty"`
+	SeverityNonLocalized string `json:"severity_non_localized,omitempty"`
+	Code                 string `json:"code,omitempty"`
+	Message              string `json:"message"`
+	Detail               string `json:"detail,omitempty"`
+	Hint                 string `json:"hint,omitempty"`
+	Position             int32  `json:"position,omitempty"`
+	InternalPosition     int32  `json:"internal_position,omitempty"`
+	InternalQuery        string `json:"internal_query,omitempty"`
+	Where                string `json:"where,omitempty"`
+	Schema               string `json:"schema,omitempty"`
+	Table                string `json:"table,omitempty"`
+	Column               string `json:"column,omitempty"`
+	DataType             string `json:"data_type,omitempty"`
+	Constraint           string `json:"constraint,omitempty"`
+	File                 string `json:"file,omitempty"`
+	Line                 int32  `json:"line,omitempty"`
+	Routine              string `json:"routine,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. MessageType is encoded as a
+// one-character string ("E" or "N").
+func (d *PgDiagnostic) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		return []byte("null"), nil
+	}
+	jd := jsonDiagnostic{
+		Severity:             d.Severity,
+		SeverityNonLocalized: d.SeverityNonLocalized,
+		Code:                 d.Code,
+		Message:              d.Message,
+		Detail:               d.Detail,
+		Hint:                 d.Hint,
+		Position:             d.Position,
+		InternalPosition:     d.InternalPosition,
+		InternalQuery:        d.InternalQuery,
+		Where:                d.Where,
+		Schema:               d.Schema,
+		Table:                d.Table,
+		Column:               d.Column,
+		DataType:             d.DataType,
+		Constraint:           d.Constraint,
+		File:                 d.File,
+		Line:                 d.Line,
+		Routine:              d.Routine,
+	}
+	if d.MessageType != 0 {
+		jd.MessageType = string(d.MessageType)
+	}
+	return json.Marshal(jd)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *PgDiagnostic) UnmarshalJSON(data []byte) error {
+	var jd jsonDiagnostic
+	if err := json.Unmarshal(data, &jd); err != nil {
+		return err
+	}
+	var msgType byte
+	switch len(jd.MessageType) {
+	case 0:
+	case 1:
+		msgType = jd.MessageType[0]
+	default:
+		return errors.New("invalid diagnostic message_type: must be a single character")
+	}
+	*d = PgDiagnostic{
+		MessageType:          msgType,
+		Severity:             jd.Severity,
+		SeverityNonLocalized: jd.SeverityNonLocalized,
+		Code:                 jd.Code,
+		Message:              jd.Message,
+		Detail:               jd.Detail,
+		Hint:                 jd.Hint,
+		Position:             jd.Position,
+		InternalPosition:     jd.InternalPosition,
+		InternalQuery:        jd.InternalQuery,
+		Where:                jd.Where,
+		Schema:               jd.Schema,
+		Table:                jd.Table,
+		Column:               jd.Column,
+		DataType:             jd.DataType,
+		Constraint:           jd.Constraint,
+		File:                 jd.File,
+		Line:                 jd.Line,
+		Routine:              jd.Routine,
+	}
+	return nil
+}
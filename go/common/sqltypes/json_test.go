@@ -0,0 +1,129 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestValueJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value Value
+		json  string
+	}{
+		{name: "null", value: nil, json: `null`},
+		{name: "empty string", value: Value{}, json: `""`},
+		{name: "text", value: Value(`a"b`), json: `"a\"b"`},
+		{name: "binary", value: Value{0xff, 0x00}, json: `{"base64":"/wA="}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.json, string(data))
+
+			var got Value
+			require.NoError(t, json.Unmarshal(data, &got))
+			assert.Equal(t, tc.value, got)
+			assert.Equal(t, tc.value.IsNull(), got.IsNull())
+		})
+	}
+
+	var v Value
+	assert.Error(t, json.Unmarshal([]byte(`42`), &v))
+}
+
+func TestRowJSON(t *testing.T) {
+	row := &Row{Values: []Value{nil, {}, Value("x")}}
+	data, err := json.Marshal(row)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[null, "", "x"]`, string(data))
+
+	var got Row
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Len(t, got.Values, 3)
+	assert.Nil(t, got.Values[0])
+	assert.NotNil(t, got.Values[1])
+	assert.Equal(t, Value("x"), got.Values[2])
+}
+
+func TestResultJSON(t *testing.T) {
+	result := &Result{
+		Fields: []*query.Field{
+			{Name: "id", Type: "INT4", DataTypeOid: 23, DataTypeSize: 4, TypeModifier: -1},
+			{Name: "note", Type: "TEXT", DataTypeOid: 25, DataTypeSize: -1, TypeModifier: -1},
+		},
+		Rows: []*Row{
+			{Values: []Value{Value("1"), nil}},
+			{Values: []Value{Value("2"), {}}},
+		},
+		RowsAffected: 0,
+		CommandTag:   "SELECT 2",
+		Notices:      []*Notice{{Severity: "NOTICE", Code: "00000", Message: "hello", Position: 3}},
+	}
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fields": [
+			{"name": "id", "type": "INT4", "data_type_oid": 23, "data_type_size": 4, "type_modifier": -1},
+			{"name": "note", "type": "TEXT", "data_type_oid": 25, "data_type_size": -1, "type_modifier": -1}
+		],
+		"rows": [["1", null], ["2", ""]],
+		"rows_affected": 0,
+		"command_tag": "SELECT 2",
+		"notices": [{"message_type": "N", "severity": "NOTICE", "code": "00000", "message": "hello", "position": 3}]
+	}`, string(data))
+
+	var got Result
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, result.CommandTag, got.CommandTag)
+	assert.Equal(t, result.Rows, got.Rows)
+	assert.Equal(t, result.Notices, got.Notices)
+	require.Len(t, got.Fields, 2)
+	for i := range result.Fields {
+		assert.Equal(t, result.Fields[i].String(), got.Fields[i].String())
+	}
+}
+
+func TestPgDiagnosticJSON(t *testing.T) {
+	diag := &PgDiagnostic{
+		MessageType: DiagnosticError,
+		Severity:    "ERROR",
+		Code:        "23505",
+		Message:     "duplicate key value violates unique constraint",
+		Detail:      "Key (id)=(1) already exists.",
+		Schema:      "public",
+		Table:       "t",
+		Constraint:  "t_pkey",
+		Line:        42,
+	}
+	data, err := json.Marshal(diag)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"message_type":"E"`)
+
+	var got PgDiagnostic
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, *diag, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"message_type":"EN"}`), &got))
+}
@@ -0,0 +1,361 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"bytes"
+	"cmp"
+	"container/heap"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// OrderByColumn describes a single ORDER BY term used to order merged rows.
+type OrderByColumn struct {
+	// Column is the index of the column in the result's Fields.
+	Column int
+
+	// Desc orders the column in descending order.
+	Desc bool
+
+	// NullsFirst places NULLs before non-NULL values. PostgreSQL defaults to
+	// NULLS LAST for ascending and NULLS FIRST for descending order.
+	NullsFirst bool
+
+	// Collation is the collation of a COLLATE clause ordering a text
+	// column, rather than the default collation of the database.
+	Collation string
+}
+
+// RowComparator compares two rows and returns a negative number if a sorts
+// before b, a positive number if a sorts after b, and zero if they are equal.
+type RowComparator func(a, b *Row) int
+
+// NewRowComparator builds a RowComparator for the given ORDER BY terms.
+// Values are compared according to the column type: integer, floating point
+// and numeric columns numerically, booleans as false < true, and everything
+// else bytewise (which matches the C collation and ISO date/time output).
+// Binary-format columns are decoded to text before comparison. It returns an
+// error if a term orders by a column beyond fields.
+func NewRowComparator(fields []*query.Field, orderBy []OrderByColumn) (RowComparator, error) {
+	return NewCollatedRowComparator(fields, orderBy, nil)
+}
+
+// NewCollatedRowComparator builds a RowComparator for the given ORDER BY
+// terms like NewRowComparator, except that the text values of the term i
+// are compared by collations[i] when set.
+func NewCollatedRowComparator(fields []*query.Field, orderBy []OrderByColumn, collations []TextCompare) (RowComparator, error) {
+	for _, ob := range orderBy {
+		if ob.Column < 0 || ob.Column >= len(fields) {
+			return nil, fmt.Errorf("order by column %d out of range of %d fields", ob.Column, len(fields))
+		}
+	}
+	return func(a, b *Row) int {
+		for i, ob := range orderBy {
+			va, vb := a.Values[ob.Column], b.Values[ob.Column]
+			var c int
+			switch {
+			case va.IsNull() && vb.IsNull():
+				c = 0
+			case va.IsNull():
+				if ob.NullsFirst {
+					return -1
+				}
+				return 1
+			case vb.IsNull():
+				if ob.NullsFirst {
+					return 1
+				}
+				return -1
+			default:
+				var text TextCompare
+				if i < len(collations) {
+					text = collations[i]
+				}
+				c = compareValues(fields[ob.Column], va, vb, text)
+			}
+			if c != 0 {
+				if ob.Desc {
+					return -c
+				}
+				return c
+			}
+		}
+		return 0
+	}, nil
+}
+
+// compareValues compares two non-NULL values of the given field, by text
+// if set for text values.
+func compareValues(f *query.Field, a, b Value, text TextCompare) int {
+	if Format(f.Format) == FormatBinary {
+		ta, errA := BinaryToText(f.DataTypeOid, a)
+		tb, errB := BinaryToText(f.DataTypeOid, b)
+		if errA != nil || errB != nil {
+			return bytes.Compare(a, b)
+		}
+		a, b = ta, tb
+	}
+
+	switch ast.Oid(f.DataTypeOid) {
+	case ast.INT2OID, ast.INT4OID, ast.INT8OID:
+		ia, errA := strconv.ParseInt(string(a), 10, 64)
+		ib, errB := strconv.ParseInt(string(b), 10, 64)
+		if errA == nil && errB == nil {
+			return cmp.Compare(ia, ib)
+		}
+
+	case ast.OIDOID, ast.XIDOID, ast.CIDOID:
+		ua, errA := strconv.ParseUint(string(a), 10, 32)
+		ub, errB := strconv.ParseUint(string(b), 10, 32)
+		if errA == nil && errB == nil {
+			return cmp.Compare(ua, ub)
+		}
+
+	case ast.FLOAT4OID, ast.FLOAT8OID:
+		fa, errA := strconv.ParseFloat(string(a), 64)
+		fb, errB := strconv.ParseFloat(string(b), 64)
+		if errA == nil && errB == nil {
+			return compareFloats(fa, fb)
+		}
+
+	case ast.NUMERICOID:
+		return compareNumerics(string(a), string(b))
+
+	case ast.BOOLOID:
+		return cmp.Compare(boolRank(a), boolRank(b))
+	}
+	if text != nil && IsCollatable(f) {
+		return text(a, b)
+	}
+	return bytes.Compare(a, b)
+}
+
+// compareFloats compares two floats using PostgreSQL semantics, where NaN
+// sorts after every other value, including +Infinity.
+func compareFloats(a, b float64) int {
+	aNaN, bNaN := math.IsNaN(a), math.IsNaN(b)
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return 1
+	case bNaN:
+		return -1
+	}
+	return cmp.Compare(a, b)
+}
+
+// boolRank maps a text boolean to an integer so that false sorts before true.
+func boolRank(v Value) int {
+	if string(v) == "t" {
+		return 1
+	}
+	return 0
+}
+
+// compareNumerics compares two numeric text values exactly. Like PostgreSQL,
+// NaN sorts above all other values and infinities sort at the extremes.
+func compareNumerics(a, b string) int {
+	rank := func(s string) int {
+		switch s {
+		case "-Infinity":
+			return -1
+		case "Infinity":
+			return 1
+		case "NaN":
+			return 2
+		}
+		return 0
+	}
+	ra, rb := rank(a), rank(b)
+	if ra != 0 || rb != 0 {
+		return cmp.Compare(ra, rb)
+	}
+	na, okA := new(big.Rat).SetString(a)
+	nb, okB := new(big.Rat).SetString(b)
+	if !okA || !okB {
+		return strings.Compare(a, b)
+	}
+	return na.Cmp(nb)
+}
+
+// MergeResults combines the results returned by several shards for the same
+// query into a single Result. Fields must agree across all results that carry
+// them; RowsAffected is summed, Rows and Notices are concatenated in input
+// order, and the CommandTag count is recomputed for the merged result.
+// Nil results are skipped.
+func MergeResults(results []*Result) (*Result, error) {
+	merged := &Result{}
+	var tags []string
+	for i, r := range results {
+		if r == nil {
+			continue
+		}
+		if err := reconcileFields(merged, r, i); err != nil {
+			return nil, err
+		}
+		merged.RowsAffected += r.RowsAffected
+		merged.Rows = append(merged.Rows, r.Rows...)
+		merged.Notices = append(merged.Notices, r.Notices...)
+		if r.CommandTag != "" {
+			tags = append(tags, r.CommandTag)
+		}
+	}
+	tag, err := mergeCommandTags(tags, merged)
+	if err != nil {
+		return nil, err
+	}
+	merged.CommandTag = tag
+	return merged, nil
+}
+
+// MergeSorted performs a k-way merge of results whose rows are each already
+// ordered according to compare, producing a single Result whose rows are ordered
+// by compare. Everything other than row ordering is merged as in MergeResults.
+// The merge is stable: rows that compare equal keep their input order.
+func MergeSorted(results []*Result, compare RowComparator) (*Result, error) {
+	merged, err := MergeResults(results)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &mergeHeap{cmp: compare}
+	for i, r := range results {
+		if r != nil && len(r.Rows) > 0 {
+			h.cursors = append(h.cursors, &mergeCursor{rows: r.Rows, source: i})
+		}
+	}
+	heap.Init(h)
+
+	rows := make([]*Row, 0, len(merged.Rows))
+	for h.Len() > 0 {
+		c := h.cursors[0]
+		rows = append(rows, c.rows[c.pos])
+		c.pos++
+		if c.pos == len(c.rows) {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+	merged.Rows = rows
+	return merged, nil
+}
+
+// mergeCursor tracks the read position within one input of a k-way merge.
+type mergeCursor struct {
+	rows   []*Row
+	pos    int
+	source int
+}
+
+// mergeHeap is a min-heap of cursors ordered by their current row.
+type mergeHeap struct {
+	cursors []*mergeCursor
+	cmp     RowComparator
+}
+
+func (h *mergeHeap) Len() int { return len(h.cursors) }
+
+func (h *mergeHeap) Less(i, j int) bool {
+	ci, cj := h.cursors[i], h.cursors[j]
+	if c := h.cmp(ci.rows[ci.pos], cj.rows[cj.pos]); c != 0 {
+		return c < 0
+	}
+	return ci.source < cj.source
+}
+
+func (h *mergeHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *mergeHeap) Push(x any) { h.cursors = append(h.cursors, x.(*mergeCursor)) }
+
+func (h *mergeHeap) Pop() any {
+	n := len(h.cursors)
+	c := h.cursors[n-1]
+	h.cursors = h.cursors[:n-1]
+	return c
+}
+
+// reconcileFields sets merged.Fields from r, or verifies that r's Fields
+// match the ones already recorded.
+func reconcileFields(merged, r *Result, idx int) error {
+	if len(r.Fields) == 0 {
+		return nil
+	}
+	if len(merged.Fields) == 0 {
+		merged.Fields = r.Fields
+		return nil
+	}
+	if len(merged.Fields) != len(r.Fields) {
+		return fmt.Errorf("result %d has %d fields, expected %d", idx, len(r.Fields), len(merged.Fields))
+	}
+	for i, f := range r.Fields {
+		want := merged.Fields[i]
+		if f.Name != want.Name || f.DataTypeOid != want.DataTypeOid || f.Format != want.Format {
+			return fmt.Errorf("result %d field %d (%s, oid %d, format %d) does not match (%s, oid %d, format %d)",
+				idx, i, f.Name, f.DataTypeOid, f.Format, want.Name, want.DataTypeOid, want.Format)
+		}
+	}
+	return nil
+}
+
+// mergeCommandTags combines the command tags of the individual results.
+// All tags must share the same command; the trailing count is replaced by
+// the merged row count (for SELECT-like commands) or RowsAffected.
+func mergeCommandTags(tags []string, merged *Result) (string, error) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+	cmd := commandTagPrefix(tags[0])
+	for _, t := range tags[1:] {
+		if p := commandTagPrefix(t); p != cmd {
+			return "", fmt.Errorf("cannot merge command tags %q and %q", tags[0], t)
+		}
+	}
+	if cmd == tags[0] {
+		// The command has no count (e.g. "CREATE TABLE").
+		return cmd, nil
+	}
+
+	count := merged.RowsAffected
+	switch cmd {
+	case "SELECT", "FETCH", "MOVE", "COPY":
+		if merged.RowsAffected == 0 {
+			count = uint64(len(merged.Rows))
+		}
+	}
+	return cmd + " " + strconv.FormatUint(count, 10), nil
+}
+
+// commandTagPrefix returns the command tag without its trailing row count.
+// For "INSERT 0 5" it returns "INSERT 0"; for "CREATE TABLE" it returns the
+// tag unchanged.
+func commandTagPrefix(tag string) string {
+	i := strings.LastIndexByte(tag, ' ')
+	if i < 0 {
+		return tag
+	}
+	if _, err := strconv.ParseUint(tag[i+1:], 10, 64); err != nil {
+		return tag
+	}
+	return tag[:i]
+}
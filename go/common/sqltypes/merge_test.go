@@ -0,0 +1,210 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func mergeTestFields() []*query.Field {
+	return []*query.Field{
+		{Name: "id", DataTypeOid: uint32(ast.INT4OID)},
+		{Name: "name", DataTypeOid: uint32(ast.TEXTOID)},
+	}
+}
+
+func textRows(rows ...[]string) []*Row {
+	out := make([]*Row, len(rows))
+	for i, r := range rows {
+		values := make([]Value, len(r))
+		for j, v := range r {
+			if v != "NULL" {
+				values[j] = Value(v)
+			}
+		}
+		out[i] = &Row{Values: values}
+	}
+	return out
+}
+
+func rowIDs(r *Result) []string {
+	ids := make([]string, len(r.Rows))
+	for i, row := range r.Rows {
+		if row.Values[0] == nil {
+			ids[i] = "NULL"
+		} else {
+			ids[i] = string(row.Values[0])
+		}
+	}
+	return ids
+}
+
+func TestMergeResults(t *testing.T) {
+	fields := mergeTestFields()
+	results := []*Result{
+		{
+			Fields:     fields,
+			Rows:       textRows([]string{"1", "a"}),
+			CommandTag: "SELECT 1",
+			Notices:    []*Notice{{Message: "first"}},
+		},
+		nil,
+		{
+			Fields:     mergeTestFields(),
+			Rows:       textRows([]string{"2", "b"}, []string{"3", "c"}),
+			CommandTag: "SELECT 2",
+			Notices:    []*Notice{{Message: "second"}},
+		},
+	}
+
+	merged, err := MergeResults(results)
+	require.NoError(t, err)
+	assert.Equal(t, fields, merged.Fields)
+	assert.Equal(t, []string{"1", "2", "3"}, rowIDs(merged))
+	assert.Equal(t, "SELECT 3", merged.CommandTag)
+	require.Len(t, merged.Notices, 2)
+	assert.Equal(t, "second", merged.Notices[1].Message)
+}
+
+func TestMergeResultsCommandTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*Result
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "insert",
+			results: []*Result{
+				{CommandTag: "INSERT 0 2", RowsAffected: 2},
+				{CommandTag: "INSERT 0 3", RowsAffected: 3},
+			},
+			want: "INSERT 0 5",
+		},
+		{
+			name: "ddl without count",
+			results: []*Result{
+				{CommandTag: "CREATE TABLE"},
+				{CommandTag: "CREATE TABLE"},
+			},
+			want: "CREATE TABLE",
+		},
+		{
+			name: "mismatched commands",
+			results: []*Result{
+				{CommandTag: "UPDATE 1", RowsAffected: 1},
+				{CommandTag: "DELETE 1", RowsAffected: 1},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, err := MergeResults(tc.results)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, merged.CommandTag)
+		})
+	}
+}
+
+func TestMergeResultsFieldMismatch(t *testing.T) {
+	_, err := MergeResults([]*Result{
+		{Fields: mergeTestFields()},
+		{Fields: []*query.Field{{Name: "id", DataTypeOid: uint32(ast.INT8OID)}, {Name: "name"}}},
+	})
+	require.Error(t, err)
+
+	// A column returned in another format does not match either.
+	binary := mergeTestFields()
+	binary[0].Format = int32(FormatBinary)
+	_, err = MergeResults([]*Result{
+		{Fields: mergeTestFields()},
+		{Fields: binary},
+	})
+	assert.EqualError(t, err, "result 1 field 0 (id, oid 23, format 1) does not match (id, oid 23, format 0)")
+
+	_, err = MergeResults([]*Result{
+		{Fields: mergeTestFields()},
+		{Fields: mergeTestFields()[:1]},
+	})
+	require.Error(t, err)
+}
+
+func TestMergeSorted(t *testing.T) {
+	fields := mergeTestFields()
+	results := []*Result{
+		{Fields: fields, Rows: textRows([]string{"1", "a"}, []string{"10", "b"}, []string{"NULL", "c"}), CommandTag: "SELECT 3"},
+		{Fields: fields, Rows: textRows([]string{"2", "d"}, []string{"9", "e"}), CommandTag: "SELECT 2"},
+		{Fields: fields, Rows: textRows([]string{"2", "f"}), CommandTag: "SELECT 1"},
+	}
+
+	cmp, err := NewRowComparator(fields, []OrderByColumn{{Column: 0}})
+	require.NoError(t, err)
+	merged, err := MergeSorted(results, cmp)
+	require.NoError(t, err)
+	// Integers are compared numerically, NULLs sort last and equal keys keep input order.
+	assert.Equal(t, []string{"1", "2", "2", "9", "10", "NULL"}, rowIDs(merged))
+	assert.Equal(t, Value("d"), merged.Rows[1].Values[1])
+	assert.Equal(t, Value("f"), merged.Rows[2].Values[1])
+	assert.Equal(t, "SELECT 6", merged.CommandTag)
+}
+
+func TestRowComparator(t *testing.T) {
+	fields := []*query.Field{
+		{Name: "n", DataTypeOid: uint32(ast.NUMERICOID)},
+		{Name: "f", DataTypeOid: uint32(ast.FLOAT8OID)},
+		{Name: "b", DataTypeOid: uint32(ast.BOOLOID)},
+		{Name: "i", DataTypeOid: uint32(ast.INT4OID), Format: int32(FormatBinary)},
+	}
+	row := func(n, f, b string, i []byte) *Row {
+		return &Row{Values: []Value{Value(n), Value(f), Value(b), Value(i)}}
+	}
+	compare := func(orderBy ...OrderByColumn) RowComparator {
+		cmp, err := NewRowComparator(fields, orderBy)
+		require.NoError(t, err)
+		return cmp
+	}
+	a := row("10.5", "NaN", "f", []byte{0xff, 0xff, 0xff, 0xff})
+	b := row("9.75", "1e+300", "t", []byte{0, 0, 0, 1})
+
+	assert.Positive(t, compare(OrderByColumn{Column: 0})(a, b))
+	assert.Positive(t, compare(OrderByColumn{Column: 1})(a, b))
+	assert.Negative(t, compare(OrderByColumn{Column: 2})(a, b))
+	assert.Negative(t, compare(OrderByColumn{Column: 3})(a, b))
+	assert.Positive(t, compare(OrderByColumn{Column: 3, Desc: true})(a, b))
+
+	null := &Row{Values: []Value{nil, nil, nil, nil}}
+	assert.Positive(t, compare(OrderByColumn{Column: 0})(null, b))
+	assert.Negative(t, compare(OrderByColumn{Column: 0, NullsFirst: true})(null, b))
+
+	// Ties on the first column fall through to the next one.
+	c := row("10.5", "1", "t", []byte{0, 0, 0, 1})
+	assert.Negative(t, compare(OrderByColumn{Column: 0}, OrderByColumn{Column: 1})(c, a))
+
+	// Terms beyond the fields are rejected.
+	_, err := NewRowComparator(fields, []OrderByColumn{{Column: 4}})
+	assert.EqualError(t, err, "order by column 4 out of range of 4 fields")
+}
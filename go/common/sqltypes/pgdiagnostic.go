@@ -0,0 +1,139 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import "fmt"
+
+// Diagnostic message types, matching the PostgreSQL protocol message bytes.
+const (
+	// DiagnosticError marks a diagnostic sent as an ErrorResponse ('E').
+	DiagnosticError byte = 'E'
+
+	// DiagnosticNotice marks a diagnostic sent as a NoticeResponse ('N').
+	DiagnosticNotice byte = 'N'
+)
+
+// PgDiagnostic holds every field of a PostgreSQL ErrorResponse or
+// NoticeResponse message. It implements error so that diagnostics can be
+// propagated to clients without losing any of the wire-level detail.
+type PgDiagnostic struct {
+	// MessageType is DiagnosticError or DiagnosticNotice.
+	MessageType byte
+
+	// Severity is the 'S' field, which may be localized.
+	Severity string
+	// SeverityNonLocalized is the 'V' field, which is never translated. It is
+	// empty when the server did not send it (before PostgreSQL 9.6).
+	SeverityNonLocalized string
+
+	Code             string
+	Message          string
+	Detail           string
+	Hint             string
+	Position         int32
+	InternalPosition int32
+	InternalQuery    string
+	Where            string
+	Schema           string
+	Table            string
+	Column           string
+	DataType         string
+	Constraint       string
+	File             string
+	Line             int32
+	Routine          string
+}
+
+// Error implements the error interface using the same layout as libpq.
+func (d *PgDiagnostic) Error() string {
+	if d.Detail != "" {
+		return fmt.Sprintf("%s: %s (SQLSTATE %s)\nDETAIL: %s", d.Severity, d.Message, d.Code, d.Detail)
+	}
+	return fmt.Sprintf("%s: %s (SQLSTATE %s)", d.Severity, d.Message, d.Code)
+}
+
+// EffectiveSeverity returns the non-localized severity when it is known,
+// falling back to Severity otherwise.
+func (d *PgDiagnostic) EffectiveSeverity() string {
+	if d.SeverityNonLocalized != "" {
+		return d.SeverityNonLocalized
+	}
+	return d.Severity
+}
+
+// IsFatal reports whether the diagnostic terminates the session (FATAL or
+// PANIC). It checks the non-localized severity so that backends running with
+// a non-English lc_messages are classified correctly.
+func (d *PgDiagnostic) IsFatal() bool {
+	switch d.EffectiveSeverity() {
+	case "FATAL", "PANIC":
+		return true
+	}
+	return false
+}
+
+// IsSQLState reports whether the diagnostic has the given SQLSTATE code.
+func (d *PgDiagnostic) IsSQLState(code string) bool {
+	return d.Code == code
+}
+
+// ToNotice converts the diagnostic to a Notice.
+func (d *PgDiagnostic) ToNotice() *Notice {
+	if d == nil {
+		return nil
+	}
+	return &Notice{
+		Severity:             d.Severity,
+		SeverityNonLocalized: d.SeverityNonLocalized,
+		Code:                 d.Code,
+		Message:              d.Message,
+		Detail:               d.Detail,
+		Hint:                 d.Hint,
+		Position:             d.Position,
+		InternalPosition:     d.InternalPosition,
+		InternalQuery:        d.InternalQuery,
+		Where:                d.Where,
+		Schema:               d.Schema,
+		Table:                d.Table,
+		Column:               d.Column,
+		DataType:             d.DataType,
+		Constraint:           d.Constraint,
+	}
+}
+
+// DiagnosticFromNotice converts a Notice to a notice-type PgDiagnostic.
+func DiagnosticFromNotice(n *Notice) *PgDiagnostic {
+	if n == nil {
+		return nil
+	}
+	return &PgDiagnostic{
+		MessageType:          DiagnosticNotice,
+		Severity:             n.Severity,
+		SeverityNonLocalized: n.SeverityNonLocalized,
+		Code:                 n.Code,
+		Message:              n.Message,
+		Detail:               n.Detail,
+		Hint:                 n.Hint,
+		Position:             n.Position,
+		InternalPosition:     n.InternalPosition,
+		InternalQuery:        n.InternalQuery,
+		Where:                n.Where,
+		Schema:               n.Schema,
+		Table:                n.Table,
+		Column:               n.Column,
+		DataType:             n.DataType,
+		Constraint:           n.Constraint,
+	}
+}
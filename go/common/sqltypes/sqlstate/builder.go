@@ -0,0 +1,144 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlstate provides named PostgreSQL SQLSTATE codes and a builder
+// for constructing sqltypes.PgDiagnostic errors from them.
+//
+// Errors that originate in multigres (rather than in a backend PostgreSQL)
+// should be built here so that clients see the same codes and fields that
+// PostgreSQL itself would send:
+//
+//	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+//		Msg("COPY TO STDOUT is not supported").
+//		Err()
+package sqlstate
+
+import (
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// Class returns the two-character class of a SQLSTATE code, e.g. "42" for
+// SyntaxError. Codes shorter than two characters are returned unchanged.
+func Class(code string) string {
+	if len(code) < 2 {
+		return code
+	}
+	return code[:2]
+}
+
+// Builder constructs a PgDiagnostic error field by field.
+type Builder struct {
+	diag sqltypes.PgDiagnostic
+}
+
+// NewError starts building an ERROR-severity diagnostic with the given code.
+func NewError(code string) *Builder {
+	return &Builder{diag: sqltypes.PgDiagnostic{
+		MessageType: sqltypes.DiagnosticError,
+		Severity:    "ERROR",
+		Code:        code,
+	}}
+}
+
+// NewNotice starts building a notice-type diagnostic with the given severity
+// (e.g. "NOTICE" or "WARNING") and code.
+func NewNotice(severity, code string) *Builder {
+	return &Builder{diag: sqltypes.PgDiagnostic{
+		MessageType: sqltypes.DiagnosticNotice,
+		Severity:    severity,
+		Code:        code,
+	}}
+}
+
+// Severity overrides the severity (e.g. "FATAL").
+func (b *Builder) Severity(severity string) *Builder {
+	b.diag.Severity = severity
+	return b
+}
+
+// Msg sets the primary message. Arguments are handled as in fmt.Sprintf.
+func (b *Builder) Msg(format string, args ...any) *Builder {
+	b.diag.Message = sprintf(format, args)
+	return b
+}
+
+// Detail sets the detail message. Arguments are handled as in fmt.Sprintf.
+func (b *Builder) Detail(format string, args ...any) *Builder {
+	b.diag.Detail = sprintf(format, args)
+	return b
+}
+
+// Hint sets the hint message. Arguments are handled as in fmt.Sprintf.
+func (b *Builder) Hint(format string, args ...any) *Builder {
+	b.diag.Hint = sprintf(format, args)
+	return b
+}
+
+// Position sets the 1-based character position in the query string.
+func (b *Builder) Position(pos int32) *Builder {
+	b.diag.Position = pos
+	return b
+}
+
+// Schema sets the schema name associated with the error.
+func (b *Builder) Schema(name string) *Builder {
+	b.diag.Schema = name
+	return b
+}
+
+// Table sets the table name associated with the error.
+func (b *Builder) Table(name string) *Builder {
+	b.diag.Table = name
+	return b
+}
+
+// Column sets the column name associated with the error.
+func (b *Builder) Column(name string) *Builder {
+	b.diag.Column = name
+	return b
+}
+
+// DataType sets the data type name associated with the error.
+func (b *Builder) DataType(name string) *Builder {
+	b.diag.DataType = name
+	return b
+}
+
+// Constraint sets the constraint name associated with the error.
+func (b *Builder) Constraint(name string) *Builder {
+	b.diag.Constraint = name
+	return b
+}
+
+// Build returns a copy of the diagnostic built so far.
+func (b *Builder) Build() *sqltypes.PgDiagnostic {
+	d := b.diag
+	return &d
+}
+
+// Err returns the diagnostic as an error.
+func (b *Builder) Err() error {
+	return b.Build()
+}
+
+// sprintf only formats when arguments are given, so messages containing a
+// literal '%' can be passed through unchanged.
+func sprintf(format string, args []any) string {
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestNewError(t *testing.T) {
+	err := NewError(UndefinedTable).
+		Msg("relation %q does not exist", "t").
+		Detail("100%").
+		Hint("check the search_path").
+		Schema("public").
+		Table("t").
+		Err()
+
+	var diag *sqltypes.PgDiagnostic
+	require.True(t, errors.As(err, &diag))
+	assert.Equal(t, &sqltypes.PgDiagnostic{
+		MessageType: sqltypes.DiagnosticError,
+		Severity:    "ERROR",
+		Code:        "42P01",
+		Message:     `relation "t" does not exist`,
+		Detail:      "100%",
+		Hint:        "check the search_path",
+		Schema:      "public",
+		Table:       "t",
+	}, diag)
+	assert.Equal(t, `ERROR: relation "t" does not exist (SQLSTATE 42P01)`+"\nDETAIL: 100%", err.Error())
+}
+
+func TestBuilderBuildCopies(t *testing.T) {
+	b := NewNotice("WARNING", Warning).Msg("first")
+	d1 := b.Build()
+	d2 := b.Msg("second").Severity("NOTICE").Build()
+	assert.Equal(t, "first", d1.Message)
+	assert.Equal(t, "WARNING", d1.Severity)
+	assert.Equal(t, "second", d2.Message)
+	assert.Equal(t, sqltypes.DiagnosticNotice, d2.MessageType)
+}
+
+func TestClass(t *testing.T) {
+	assert.Equal(t, "42", Class(SyntaxError))
+	assert.Equal(t, "53", Class(ConfigurationLimitExceeded))
+	assert.Equal(t, "", Class(""))
+}
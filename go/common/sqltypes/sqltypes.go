@@ -36,20 +36,23 @@ type Row struct {
 
 // Notice represents a PostgreSQL notice response (non-fatal messages).
 type Notice struct {
-	Severity         string
-	Code             string
-	Message          string
-	Detail           string
-	Hint             string
-	Position         int32
-	InternalPosition int32
-	InternalQuery    string
-	Where            string
-	Schema           string
-	Table            string
-	Column           string
-	DataType         string
-	Constraint       string
+	Severity string
+	// SeverityNonLocalized is the untranslated severity ('V' field), empty
+	// when the server did not send it (before PostgreSQL 9.6).
+	SeverityNonLocalized string
+	Code                 string
+	Message              string
+	Detail               string
+	Hint                 string
+	Position             int32
+	InternalPosition     int32
+	InternalQuery        string
+	Where                string
+	Schema               string
+	Table                string
+	Column               string
+	DataType             string
+	Constraint           string
 }
 
 // Result represents a query result with nullable values.
@@ -63,23 +66,55 @@ type Result struct {
 	// Rows contains the actual data rows.
 	Rows []*Row
 
+	// RawRows contains data rows as the pooler sent them, their values
+	// packed, in place of Rows on results relayed to the client without
+	// being decoded (see RawResultFromProto). They follow the rows of Rows,
+	// if any.
+	RawRows []*query.Row
+
 	// CommandTag is the PostgreSQL command tag for this result set.
 	// Examples: "SELECT 42", "INSERT 0 5", "UPDATE 10", "DELETE 3"
 	CommandTag string
 
 	// Notices contains any PostgreSQL notices received during query execution.
+	// When a result is streamed in several chunks, the notices of a chunk
+	// were received before its rows, and are sent to the client before them.
 	Notices []*Notice
 }
 
+// ByteSize returns the approximate number of bytes the result occupies in
+// memory: the size of all row values plus field names, the command tag and
+// notice messages. It is used to enforce result size limits.
+func (r *Result) ByteSize() int64 {
+	if r == nil {
+		return 0
+	}
+	size := int64(len(r.CommandTag))
+	for _, f := range r.Fields {
+		size += int64(len(f.GetName()))
+	}
+	for _, row := range r.Rows {
+		size += row.ByteSize()
+	}
+	for _, row := range r.RawRows {
+		size += int64(len(row.GetValues()))
+	}
+	for _, n := range r.Notices {
+		size += int64(len(n.Message) + len(n.Detail) + len(n.Hint))
+	}
+	return size
+}
+
 // ToProto converts Result to proto format for gRPC serialization.
 func (r *Result) ToProto() *query.QueryResult {
 	if r == nil {
 		return nil
 	}
-	protoRows := make([]*query.Row, len(r.Rows))
+	protoRows := make([]*query.Row, len(r.Rows), len(r.Rows)+len(r.RawRows))
 	for i, row := range r.Rows {
 		protoRows[i] = row.ToProto()
 	}
+	protoRows = append(protoRows, r.RawRows...)
 	protoNotices := make([]*query.Notice, len(r.Notices))
 	for i, notice := range r.Notices {
 		protoNotices[i] = NoticeToProto(notice)
@@ -115,26 +150,48 @@ func ResultFromProto(pr *query.QueryResult) *Result {
 	}
 }
 
+// RawResultFromProto converts a proto result to sqltypes like
+// ResultFromProto, but keeps its rows packed in RawRows rather than
+// splitting their values into Rows. Results only relayed to the client
+// take it to save the allocations of decoding each row.
+func RawResultFromProto(pr *query.QueryResult) *Result {
+	if pr == nil {
+		return nil
+	}
+	notices := make([]*Notice, len(pr.Notices))
+	for i, notice := range pr.Notices {
+		notices[i] = NoticeFromProto(notice)
+	}
+	return &Result{
+		Fields:       pr.Fields,
+		RowsAffected: pr.RowsAffected,
+		RawRows:      pr.Rows,
+		CommandTag:   pr.CommandTag,
+		Notices:      notices,
+	}
+}
+
 // NoticeToProto converts sqltypes Notice to proto format for gRPC serialization.
 func NoticeToProto(n *Notice) *query.Notice {
 	if n == nil {
 		return nil
 	}
 	return &query.Notice{
-		Severity:         n.Severity,
-		Code:             n.Code,
-		Message:          n.Message,
-		Detail:           n.Detail,
-		Hint:             n.Hint,
-		Position:         n.Position,
-		InternalPosition: n.InternalPosition,
-		InternalQuery:    n.InternalQuery,
-		Where:            n.Where,
-		SchemaName:       n.Schema,
-		TableName:        n.Table,
-		ColumnName:       n.Column,
-		DataTypeName:     n.DataType,
-		ConstraintName:   n.Constraint,
+		Severity:             n.Severity,
+		SeverityNonLocalized: n.SeverityNonLocalized,
+		Code:                 n.Code,
+		Message:              n.Message,
+		Detail:               n.Detail,
+		Hint:                 n.Hint,
+		Position:             n.Position,
+		InternalPosition:     n.InternalPosition,
+		InternalQuery:        n.InternalQuery,
+		Where:                n.Where,
+		SchemaName:           n.Schema,
+		TableName:            n.Table,
+		ColumnName:           n.Column,
+		DataTypeName:         n.DataType,
+		ConstraintName:       n.Constraint,
 	}
 }
 
@@ -144,21 +201,34 @@ func NoticeFromProto(pn *query.Notice) *Notice {
 		return nil
 	}
 	return &Notice{
-		Severity:         pn.Severity,
-		Code:             pn.Code,
-		Message:          pn.Message,
-		Detail:           pn.Detail,
-		Hint:             pn.Hint,
-		Position:         pn.Position,
-		InternalPosition: pn.InternalPosition,
-		InternalQuery:    pn.InternalQuery,
-		Where:            pn.Where,
-		Schema:           pn.SchemaName,
-		Table:            pn.TableName,
-		Column:           pn.ColumnName,
-		DataType:         pn.DataTypeName,
-		Constraint:       pn.ConstraintName,
+		Severity:             pn.Severity,
+		SeverityNonLocalized: pn.SeverityNonLocalized,
+		Code:                 pn.Code,
+		Message:              pn.Message,
+		Detail:               pn.Detail,
+		Hint:                 pn.Hint,
+		Position:             pn.Position,
+		InternalPosition:     pn.InternalPosition,
+		InternalQuery:        pn.InternalQuery,
+		Where:                pn.Where,
+		Schema:               pn.SchemaName,
+		Table:                pn.TableName,
+		Column:               pn.ColumnName,
+		DataType:             pn.DataTypeName,
+		Constraint:           pn.ConstraintName,
+	}
+}
+
+// ByteSize returns the total number of bytes in the row's values.
+func (r *Row) ByteSize() int64 {
+	if r == nil {
+		return 0
+	}
+	var size int64
+	for _, v := range r.Values {
+		size += int64(len(v))
 	}
+	return size
 }
 
 // ToProto converts Row to proto format (lengths+values) for gRPC serialization.
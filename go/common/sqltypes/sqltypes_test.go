@@ -185,6 +185,26 @@ func TestResultFromProtoNil(t *testing.T) {
 	assert.Nil(t, ResultFromProto(nil))
 }
 
+func TestRawResultFromProto(t *testing.T) {
+	assert.Nil(t, RawResultFromProto(nil))
+
+	original := &Result{
+		Fields:     []*query.Field{{Name: "col1", DataTypeOid: 25}},
+		Rows:       []*Row{{Values: []Value{nil}}, {Values: []Value{Value("hello")}}},
+		CommandTag: "SELECT 2",
+		Notices:    []*Notice{{Message: "hi"}},
+	}
+	raw := RawResultFromProto(original.ToProto())
+	assert.Empty(t, raw.Rows)
+	require.Len(t, raw.RawRows, 2)
+	assert.Equal(t, original.CommandTag, raw.CommandTag)
+	assert.Equal(t, original.Notices, raw.Notices)
+	assert.Equal(t, original.ByteSize(), raw.ByteSize())
+
+	// The packed rows decode to the original ones.
+	assert.Equal(t, original.Rows, ResultFromProto(raw.ToProto()).Rows)
+}
+
 func TestResultToProtoNil(t *testing.T) {
 	var r *Result
 	assert.Nil(t, r.ToProto())
@@ -441,3 +461,45 @@ func TestNoticeFromProtoNil(t *testing.T) {
 func TestNoticeToProtoNil(t *testing.T) {
 	assert.Nil(t, NoticeToProto(nil))
 }
+
+func TestResultByteSize(t *testing.T) {
+	var nilResult *Result
+	assert.Equal(t, int64(0), nilResult.ByteSize())
+
+	r := &Result{
+		Fields:     []*query.Field{{Name: "ab"}},
+		Rows:       []*Row{{Values: []Value{Value("abc"), nil, {}}}, {Values: []Value{Value("d")}}},
+		CommandTag: "SELECT 2",
+		Notices:    []*Notice{{Message: "hi"}},
+	}
+	assert.Equal(t, int64(3), r.Rows[0].ByteSize())
+	assert.Equal(t, int64(2+3+1+8+2), r.ByteSize())
+}
+
+func TestPgDiagnosticIsFatal(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity string
+		nonLocal string
+		want     bool
+	}{
+		{name: "fatal", severity: "FATAL", nonLocal: "FATAL", want: true},
+		{name: "panic", severity: "PANIC", want: true},
+		{name: "error", severity: "ERROR", nonLocal: "ERROR", want: false},
+		{name: "localized fatal", severity: "SCHWERWIEGEND", nonLocal: "FATAL", want: true},
+		{name: "localized error", severity: "FEHLER", nonLocal: "ERROR", want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &PgDiagnostic{Severity: tc.severity, SeverityNonLocalized: tc.nonLocal}
+			assert.Equal(t, tc.want, d.IsFatal())
+		})
+	}
+}
+
+func TestNoticeSeverityNonLocalizedProto(t *testing.T) {
+	n := &Notice{Severity: "HINWEIS", SeverityNonLocalized: "NOTICE", Message: "m"}
+	got := NoticeFromProto(NoticeToProto(n))
+	assert.Equal(t, n, got)
+	assert.Equal(t, "NOTICE", DiagnosticFromNotice(got).EffectiveSeverity())
+}
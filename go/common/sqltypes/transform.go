@@ -0,0 +1,99 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"fmt"
+
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// Transform applies fn to every row of the result in place. The row returned
+// by fn replaces the original; returning a nil row drops it. fn may modify
+// and return the row it is given, so no copy is made unless fn makes one.
+//
+// Transform stops at the first error, leaving the result partially
+// transformed. It does not update CommandTag or RowsAffected.
+func (r *Result) Transform(fn func(*Row) (*Row, error)) error {
+	kept := r.Rows[:0]
+	for i, row := range r.Rows {
+		out, err := fn(row)
+		if err != nil {
+			// Keep the rows that have not been visited yet.
+			r.Rows = append(kept, r.Rows[i:]...)
+			return err
+		}
+		if out != nil {
+			kept = append(kept, out)
+		}
+	}
+	// Clear the tail so dropped rows can be garbage collected.
+	clear(r.Rows[len(kept):])
+	r.Rows = kept
+	return nil
+}
+
+// Project selects and reorders the result's columns in place. columns holds
+// indexes into the current Fields; an index may appear more than once. The
+// projected rows share value bytes with the original rows.
+func (r *Result) Project(columns []int) error {
+	for _, c := range columns {
+		if c < 0 || c >= len(r.Fields) {
+			return fmt.Errorf("column index %d out of range [0, %d)", c, len(r.Fields))
+		}
+	}
+	fields := make([]*query.Field, len(columns))
+	for i, c := range columns {
+		fields[i] = r.Fields[c]
+	}
+	if err := r.Transform(func(row *Row) (*Row, error) {
+		return row.Project(columns)
+	}); err != nil {
+		return err
+	}
+	r.Fields = fields
+	return nil
+}
+
+// Project returns a new row containing the values at the given column
+// indexes, in order. The values share their bytes with the original row.
+func (r *Row) Project(columns []int) (*Row, error) {
+	values := make([]Value, len(columns))
+	for i, c := range columns {
+		if c < 0 || c >= len(r.Values) {
+			return nil, fmt.Errorf("column index %d out of range for row with %d values", c, len(r.Values))
+		}
+		values[i] = r.Values[c]
+	}
+	return &Row{Values: values}, nil
+}
+
+// ColumnIndexes returns the indexes of all fields whose names are not in
+// exclude, preserving their order. It is intended for use with Project to
+// strip internal columns (e.g. sharding keys added by query rewriting)
+// before rows are returned to the client.
+func ColumnIndexes(fields []*query.Field, exclude ...string) []int {
+	skip := make(map[string]bool, len(exclude))
+	for _, name := range exclude {
+		skip[name] = true
+	}
+	columns := make([]int, 0, len(fields))
+	for i, f := range fields {
+		if !skip[f.GetName()] {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func newTransformResult() *Result {
+	return &Result{
+		Fields: []*query.Field{{Name: "id"}, {Name: "name"}, {Name: "_shard_key"}},
+		Rows: []*Row{
+			{Values: []Value{Value("1"), Value("a"), Value("k1")}},
+			{Values: []Value{Value("2"), nil, Value("k2")}},
+			{Values: []Value{Value("3"), Value("c"), Value("k3")}},
+		},
+		CommandTag: "SELECT 3",
+	}
+}
+
+func TestResultTransform(t *testing.T) {
+	r := newTransformResult()
+	err := r.Transform(func(row *Row) (*Row, error) {
+		if row.Values[1].IsNull() {
+			return nil, nil
+		}
+		row.Values[0] = append(Value("id-"), row.Values[0]...)
+		return row, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, r.Rows, 2)
+	assert.Equal(t, Value("id-1"), r.Rows[0].Values[0])
+	assert.Equal(t, Value("id-3"), r.Rows[1].Values[0])
+
+	r = newTransformResult()
+	boom := errors.New("boom")
+	err = r.Transform(func(row *Row) (*Row, error) {
+		if string(row.Values[0]) == "2" {
+			return nil, boom
+		}
+		return nil, nil
+	})
+	require.ErrorIs(t, err, boom)
+	// The first row was dropped; the failing row and the rest are kept.
+	require.Len(t, r.Rows, 2)
+	assert.Equal(t, Value("2"), r.Rows[0].Values[0])
+}
+
+func TestResultProject(t *testing.T) {
+	r := newTransformResult()
+	original := r.Rows[0].Values[1]
+
+	require.NoError(t, r.Project(ColumnIndexes(r.Fields, "_shard_key")))
+	require.Len(t, r.Fields, 2)
+	assert.Equal(t, "name", r.Fields[1].Name)
+	assert.Equal(t, []Value{Value("2"), nil}, r.Rows[1].Values)
+	// Value bytes are shared, not copied.
+	assert.Same(t, &original[0], &r.Rows[0].Values[1][0])
+
+	require.NoError(t, r.Project([]int{1, 0, 0}))
+	assert.Equal(t, []string{"name", "id", "id"}, []string{r.Fields[0].Name, r.Fields[1].Name, r.Fields[2].Name})
+	assert.Equal(t, []Value{Value("c"), Value("3"), Value("3")}, r.Rows[2].Values)
+
+	assert.Error(t, r.Project([]int{3}))
+	_, err := (&Row{Values: []Value{nil}}).Project([]int{1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// The constructors below build Values in PostgreSQL's text wire format,
+// matching what the backend sends with default settings (DateStyle=ISO,
+// bytea_output=hex).
+
+// NewNull returns a NULL value.
+func NewNull() Value {
+	return nil
+}
+
+// NewText returns a text value.
+func NewText(s string) Value {
+	return Value(s)
+}
+
+// NewInt64 returns an integer value.
+func NewInt64(i int64) Value {
+	return strconv.AppendInt(nil, i, 10)
+}
+
+// NewInt32 returns an integer value.
+func NewInt32(i int32) Value {
+	return NewInt64(int64(i))
+}
+
+// NewUint32 returns an unsigned integer value, as used by oid and xid columns.
+func NewUint32(i uint32) Value {
+	return strconv.AppendUint(nil, uint64(i), 10)
+}
+
+// NewFloat64 returns a double precision value.
+func NewFloat64(f float64) Value {
+	return Value(FormatFloat(f, 64))
+}
+
+// NewFloat32 returns a real value.
+func NewFloat32(f float32) Value {
+	return Value(FormatFloat(float64(f), 32))
+}
+
+// NewNumeric returns a numeric value from its decimal string representation.
+func NewNumeric(s string) Value {
+	return Value(s)
+}
+
+// NewBool returns a boolean value ("t" or "f").
+func NewBool(b bool) Value {
+	if b {
+		return Value("t")
+	}
+	return Value("f")
+}
+
+// NewBytea returns a bytea value in hex format.
+func NewBytea(b []byte) Value {
+	out := make(Value, 2+hex.EncodedLen(len(b)))
+	out[0], out[1] = '\\', 'x'
+	hex.Encode(out[2:], b)
+	return out
+}
+
+// NewDate returns a date value for the calendar date of t.
+func NewDate(t time.Time) Value {
+	return Value(t.Format("2006-01-02"))
+}
+
+// NewTimestamp returns a timestamp without time zone value.
+func NewTimestamp(t time.Time) Value {
+	return Value(t.Format("2006-01-02 15:04:05.999999"))
+}
+
+// NewTimestampTZ returns a timestamp with time zone value, rendered with
+// the offset of t's location the way PostgreSQL does (e.g. "+00", "+05:30").
+func NewTimestampTZ(t time.Time) Value {
+	s := t.Format("2006-01-02 15:04:05.999999-07:00")
+	if len(s) >= 3 && s[len(s)-3:] == ":00" {
+		s = s[:len(s)-3]
+	}
+	return Value(s)
+}
+
+// RowBuilder builds Rows value by value. When constructed with Fields, each
+// value is encoded in the format of its column, so rows built for
+// binary-format fields carry the binary wire representation.
+type RowBuilder struct {
+	fields []*query.Field
+	values []Value
+	err    error
+}
+
+// NewRowBuilder creates a RowBuilder. fields may be nil, in which case all
+// values are kept in text format.
+func NewRowBuilder(fields []*query.Field) *RowBuilder {
+	return &RowBuilder{fields: fields}
+}
+
+// Append adds a text-format value to the row, converting it to the format
+// of the corresponding field if needed.
+func (b *RowBuilder) Append(v Value) *RowBuilder {
+	idx := len(b.values)
+	if idx < len(b.fields) && Format(b.fields[idx].Format) == FormatBinary && b.err == nil {
+		bin, err := TextToBinary(b.fields[idx].DataTypeOid, v)
+		if err != nil {
+			b.err = fmt.Errorf("column %d: %w", idx, err)
+		}
+		v = bin
+	}
+	b.values = append(b.values, v)
+	return b
+}
+
+// Null appends a NULL value.
+func (b *RowBuilder) Null() *RowBuilder { return b.Append(NewNull()) }
+
+// Text appends a text value.
+func (b *RowBuilder) Text(s string) *RowBuilder { return b.Append(NewText(s)) }
+
+// Int64 appends an integer value.
+func (b *RowBuilder) Int64(i int64) *RowBuilder { return b.Append(NewInt64(i)) }
+
+// Float64 appends a double precision value.
+func (b *RowBuilder) Float64(f float64) *RowBuilder { return b.Append(NewFloat64(f)) }
+
+// Bool appends a boolean value.
+func (b *RowBuilder) Bool(v bool) *RowBuilder { return b.Append(NewBool(v)) }
+
+// Bytea appends a bytea value.
+func (b *RowBuilder) Bytea(v []byte) *RowBuilder { return b.Append(NewBytea(v)) }
+
+// Timestamp appends a timestamp without time zone value.
+func (b *RowBuilder) Timestamp(t time.Time) *RowBuilder { return b.Append(NewTimestamp(t)) }
+
+// Build returns the Row, or the first encoding error encountered.
+// The builder is reset so it can be reused for the next row.
+func (b *RowBuilder) Build() (*Row, error) {
+	row, err := &Row{Values: b.values}, b.err
+	b.values, b.err = nil, nil
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// MustBuild is like Build but panics on error. It is intended for tests.
+func (b *RowBuilder) MustBuild() *Row {
+	row, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return row
+}
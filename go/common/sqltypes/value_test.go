@@ -0,0 +1,82 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqltypes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestValueConstructors(t *testing.T) {
+	ts := time.Date(2024, 3, 5, 6, 7, 8, 123456000, time.UTC)
+	ist := time.FixedZone("IST", 5*3600+1800)
+
+	tests := []struct {
+		name string
+		got  Value
+		want Value
+	}{
+		{name: "null", got: NewNull(), want: nil},
+		{name: "text", got: NewText(""), want: Value{}},
+		{name: "int64", got: NewInt64(-42), want: Value("-42")},
+		{name: "int32", got: NewInt32(7), want: Value("7")},
+		{name: "uint32", got: NewUint32(4294967295), want: Value("4294967295")},
+		{name: "float64", got: NewFloat64(0.5), want: Value("0.5")},
+		{name: "float32", got: NewFloat32(1.25), want: Value("1.25")},
+		{name: "numeric", got: NewNumeric("1.10"), want: Value("1.10")},
+		{name: "bool", got: NewBool(true), want: Value("t")},
+		{name: "bytea", got: NewBytea([]byte{0x01, 0xab}), want: Value(`\x01ab`)},
+		{name: "date", got: NewDate(ts), want: Value("2024-03-05")},
+		{name: "timestamp", got: NewTimestamp(ts), want: Value("2024-03-05 06:07:08.123456")},
+		{name: "timestamptz utc", got: NewTimestampTZ(ts), want: Value("2024-03-05 06:07:08.123456+00")},
+		{name: "timestamptz offset", got: NewTimestampTZ(ts.In(ist)), want: Value("2024-03-05 11:37:08.123456+05:30")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.got)
+		})
+	}
+	assert.True(t, NewNull().IsNull())
+	assert.False(t, NewText("").IsNull())
+}
+
+func TestRowBuilder(t *testing.T) {
+	row := NewRowBuilder(nil).Int64(1).Null().Text("a").MustBuild()
+	assert.Equal(t, []Value{Value("1"), nil, Value("a")}, row.Values)
+
+	fields := []*query.Field{
+		{Name: "id", DataTypeOid: uint32(ast.INT4OID), Format: int32(FormatBinary)},
+		{Name: "ok", DataTypeOid: uint32(ast.BOOLOID)},
+	}
+	b := NewRowBuilder(fields)
+	row, err := b.Int64(258).Bool(true).Build()
+	require.NoError(t, err)
+	assert.Equal(t, []Value{{0, 0, 1, 2}, Value("t")}, row.Values)
+
+	// The builder is reusable after Build.
+	row, err = b.Null().Bool(false).Build()
+	require.NoError(t, err)
+	assert.Equal(t, []Value{nil, Value("f")}, row.Values)
+
+	_, err = b.Text("not a number").Build()
+	require.Error(t, err)
+	assert.Panics(t, func() { b.Text("not a number").MustBuild() })
+}
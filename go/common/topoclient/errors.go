@@ -35,6 +35,7 @@ const (
 	NoReadOnlyImplementation
 	ResourceExhausted
 	BadInput
+	LeaseLost
 )
 
 // TopoError represents a topo error.
@@ -71,6 +72,8 @@ func NewError(code ErrorCode, node string) error {
 		message = "server resource exhausted: " + node
 	case BadInput:
 		message = node
+	case LeaseLost:
+		message = "lease lost: " + node
 	default:
 		message = "unknown code: " + node
 	}
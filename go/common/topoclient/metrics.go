@@ -16,12 +16,15 @@ package topoclient
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/tools/telemetry"
 )
 
 // LockOperation represents the type of lock operation being performed.
@@ -46,10 +49,24 @@ const (
 	LockResultTimeout LockResult = "timeout"
 )
 
+// Operation represents the type of operation on a topology server.
+type Operation string
+
+const (
+	OpListDir    Operation = "list_dir"
+	OpCreate     Operation = "create"
+	OpUpdate     Operation = "update"
+	OpGet        Operation = "get"
+	OpGetVersion Operation = "get_version"
+	OpList       Operation = "list"
+	OpDelete     Operation = "delete"
+)
+
 // Metrics holds all OpenTelemetry metrics for the topo package.
 type Metrics struct {
-	meter        metric.Meter
-	lockDuration metric.Float64Histogram
+	meter             metric.Meter
+	lockDuration      metric.Float64Histogram
+	operationDuration metric.Float64Histogram
 }
 
 // metrics is the singleton instance of Metrics for the topo package.
@@ -77,6 +94,17 @@ func newMetrics() *Metrics {
 		m.lockDuration = noop.Float64Histogram{}
 	}
 
+	// Histogram for the duration of the reads and writes of the topology
+	// servers, by cell
+	m.operationDuration, err = m.meter.Float64Histogram(
+		"topoclient.operation.duration",
+		metric.WithDescription("Duration of topo read and write operations"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		m.operationDuration = noop.Float64Histogram{}
+	}
+
 	return m
 }
 
@@ -90,3 +118,17 @@ func RecordLockOperation(ctx context.Context, op LockOperation, resourceType, re
 
 	metrics.lockDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
+
+// RecordOperation records a read or write of the topology server of cell
+// with its outcome and duration. A missing node is an answer of the
+// server, not an error of the operation.
+func RecordOperation(ctx context.Context, op Operation, cell string, err error, duration time.Duration) {
+	result := "success"
+	if err != nil && !errors.Is(err, &TopoError{Code: NoNode}) {
+		result = "error"
+	}
+	metrics.operationDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("operation", string(op)),
+		attribute.String(telemetry.AttrCell, cell),
+		attribute.String("result", result)))
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topoclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// This file provides the utility methods to acquire / retrieve the
+// PrimaryLease of a shard in the topology server.
+//
+
+// PrimaryLeaseFile is the file name of a shard's primary lease.
+const PrimaryLeaseFile = "PrimaryLease"
+
+// pathForPrimaryLease returns the path for the primary lease of a shard.
+func pathForPrimaryLease(shardKey types.ShardKey) string {
+	return path.Join(DatabasesPath, shardKey.Database, shardKey.TableGroup, shardKey.Shard, PrimaryLeaseFile)
+}
+
+// GetPrimaryLease reads the primary lease of a shard from the global Conn.
+// It returns a NoNode error if no primary ever acquired it.
+func (ts *store) GetPrimaryLease(ctx context.Context, shardKey types.ShardKey) (*clustermetadatapb.PrimaryLease, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	contents, _, err := ts.globalTopo.Get(ctx, pathForPrimaryLease(shardKey))
+	if err != nil {
+		return nil, err
+	}
+	lease := &clustermetadatapb.PrimaryLease{}
+	if err := proto.Unmarshal(contents, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// AcquirePrimaryLease acquires the primary lease of a shard for holder in
+// epoch, or renews it if holder already holds it in that epoch. The lease
+// expires ttl from now unless renewed.
+//
+// A lease of a lower epoch is taken over even if it has not expired: its
+// holder finds out when it next renews. The previous lease is returned, or
+// nil if there was none, so that the new holder can wait for it to expire.
+//
+// It fails with a LeaseLost error if the lease is held in a higher epoch,
+// or by another pooler in the same epoch.
+func (ts *store) AcquirePrimaryLease(ctx context.Context, shardKey types.ShardKey, holder *clustermetadatapb.ID, epoch int64, ttl time.Duration) (*clustermetadatapb.PrimaryLease, error) {
+	filePath := pathForPrimaryLease(shardKey)
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		var previous *clustermetadatapb.PrimaryLease
+		contents, version, err := ts.globalTopo.Get(ctx, filePath)
+		switch {
+		case err == nil:
+			previous = &clustermetadatapb.PrimaryLease{}
+			if err := proto.Unmarshal(contents, previous); err != nil {
+				return nil, err
+			}
+		case errors.Is(err, &TopoError{Code: NoNode}):
+			// Nothing to do.
+		default:
+			return nil, err
+		}
+
+		if previous != nil {
+			switch {
+			case previous.Epoch > epoch:
+				return nil, NewError(LeaseLost, fmt.Sprintf("primary lease of shard %s is held by %s in epoch %d, newer than epoch %d",
+					shardKey, ClusterIDString(previous.Holder), previous.Epoch, epoch))
+			case previous.Epoch == epoch && !proto.Equal(previous.Holder, holder):
+				return nil, NewError(LeaseLost, fmt.Sprintf("primary lease of shard %s is held by %s in epoch %d",
+					shardKey, ClusterIDString(previous.Holder), epoch))
+			}
+		}
+
+		lease := &clustermetadatapb.PrimaryLease{
+			Holder:     holder,
+			Epoch:      epoch,
+			ExpireTime: timestamppb.New(time.Now().Add(ttl)),
+		}
+		contents, err = proto.Marshal(lease)
+		if err != nil {
+			return nil, err
+		}
+		if previous == nil {
+			_, err = ts.globalTopo.Create(ctx, filePath, contents)
+			if !errors.Is(err, &TopoError{Code: NodeExists}) {
+				return nil, err
+			}
+			continue
+		}
+		if _, err = ts.globalTopo.Update(ctx, filePath, contents, version); !errors.Is(err, &TopoError{Code: BadVersion}) {
+			if err != nil {
+				return nil, err
+			}
+			return previous, nil
+		}
+	}
+}
+
+// ReleasePrimaryLease makes the primary lease of a shard expire now, if
+// holder holds it in epoch, so that the next primary does not have to wait
+// for it to expire. It does nothing otherwise.
+func (ts *store) ReleasePrimaryLease(ctx context.Context, shardKey types.ShardKey, holder *clustermetadatapb.ID, epoch int64) error {
+	filePath := pathForPrimaryLease(shardKey)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		contents, version, err := ts.globalTopo.Get(ctx, filePath)
+		switch {
+		case err == nil:
+		case errors.Is(err, &TopoError{Code: NoNode}):
+			return nil
+		default:
+			return err
+		}
+		lease := &clustermetadatapb.PrimaryLease{}
+		if err := proto.Unmarshal(contents, lease); err != nil {
+			return err
+		}
+		if lease.Epoch != epoch || !proto.Equal(lease.Holder, holder) {
+			return nil
+		}
+
+		lease.ExpireTime = timestamppb.Now()
+		contents, err = proto.Marshal(lease)
+		if err != nil {
+			return err
+		}
+		if _, err = ts.globalTopo.Update(ctx, filePath, contents, version); !errors.Is(err, &TopoError{Code: BadVersion}) {
+			return err
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topoclient_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	"github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestPrimaryLease(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	shardKey := types.ShardKey{Database: "db1", TableGroup: "default", Shard: "0-inf"}
+	mp1 := &clustermetadatapb.ID{Component: clustermetadatapb.ID_MULTIPOOLER, Cell: "zone1", Name: "mp1"}
+	mp2 := &clustermetadatapb.ID{Component: clustermetadatapb.ID_MULTIPOOLER, Cell: "zone1", Name: "mp2"}
+	lost := &topoclient.TopoError{Code: topoclient.LeaseLost}
+
+	_, err := ts.GetPrimaryLease(ctx, shardKey)
+	require.True(t, errors.Is(err, &topoclient.TopoError{Code: topoclient.NoNode}))
+
+	// The first primary acquires the lease, then renews it.
+	previous, err := ts.AcquirePrimaryLease(ctx, shardKey, mp1, 3, time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, previous)
+	previous, err = ts.AcquirePrimaryLease(ctx, shardKey, mp1, 3, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), previous.Epoch)
+
+	// Another pooler cannot take it in the same or an older epoch.
+	_, err = ts.AcquirePrimaryLease(ctx, shardKey, mp2, 3, time.Minute)
+	require.ErrorIs(t, err, lost)
+	_, err = ts.AcquirePrimaryLease(ctx, shardKey, mp2, 2, time.Minute)
+	require.ErrorIs(t, err, lost)
+
+	// A newer epoch takes it over, and the stale holder can no longer renew.
+	previous, err = ts.AcquirePrimaryLease(ctx, shardKey, mp2, 4, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "mp1", previous.Holder.Name)
+	_, err = ts.AcquirePrimaryLease(ctx, shardKey, mp1, 3, time.Minute)
+	require.ErrorIs(t, err, lost)
+	require.ErrorContains(t, err, "held by zone1_mp2 in epoch 4")
+
+	lease, err := ts.GetPrimaryLease(ctx, shardKey)
+	require.NoError(t, err)
+	require.Equal(t, "mp2", lease.Holder.Name)
+	require.Equal(t, int64(4), lease.Epoch)
+	require.True(t, lease.ExpireTime.AsTime().After(time.Now()))
+
+	// Only the holder can release it.
+	require.NoError(t, ts.ReleasePrimaryLease(ctx, shardKey, mp1, 3))
+	lease, err = ts.GetPrimaryLease(ctx, shardKey)
+	require.NoError(t, err)
+	require.True(t, lease.ExpireTime.AsTime().After(time.Now()))
+
+	require.NoError(t, ts.ReleasePrimaryLease(ctx, shardKey, mp2, 4))
+	lease, err = ts.GetPrimaryLease(ctx, shardKey)
+	require.NoError(t, err)
+	require.False(t, lease.ExpireTime.AsTime().After(time.Now()))
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topoclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// This file provides the utility methods to read / save the RoutingRules
+// of a database in the topology server.
+//
+
+// RoutingRulesFile is the file name of a database's routing rules.
+const RoutingRulesFile = "RoutingRules"
+
+// pathForRoutingRules returns the path for the routing rules of a database.
+func pathForRoutingRules(database string) string {
+	return path.Join(DatabasesPath, database, RoutingRulesFile)
+}
+
+// GetRoutingRules reads the routing rules of a database from the global
+// Conn. A database without routing rules has no rules at version 0.
+func (ts *store) GetRoutingRules(ctx context.Context, database string) (*clustermetadatapb.RoutingRules, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	contents, _, err := ts.globalTopo.Get(ctx, pathForRoutingRules(database))
+	switch {
+	case err == nil:
+	case errors.Is(err, &TopoError{Code: NoNode}):
+		return &clustermetadatapb.RoutingRules{}, nil
+	default:
+		return nil, err
+	}
+	rules := &clustermetadatapb.RoutingRules{}
+	if err := proto.Unmarshal(contents, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// SaveRoutingRules replaces the routing rules of a database by rules, all
+// at once, and returns them with their new version.
+//
+// rules must have the version of the rules they replace, as returned by
+// GetRoutingRules: it fails with a BadVersion error if the rules changed
+// since, so that concurrent changes are not lost. It fails with a BadInput
+// error if the rules are not valid, see ValidateRoutingRules.
+func (ts *store) SaveRoutingRules(ctx context.Context, database string, rules *clustermetadatapb.RoutingRules) (*clustermetadatapb.RoutingRules, error) {
+	if err := ValidateRoutingRules(rules); err != nil {
+		return nil, NewError(BadInput, fmt.Sprintf("invalid routing rules of database %s: %v", database, err))
+	}
+	filePath := pathForRoutingRules(database)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	current := &clustermetadatapb.RoutingRules{}
+	contents, version, err := ts.globalTopo.Get(ctx, filePath)
+	switch {
+	case err == nil:
+		if err := proto.Unmarshal(contents, current); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, &TopoError{Code: NoNode}):
+		version = nil
+	default:
+		return nil, err
+	}
+	if rules.Version != current.Version {
+		return nil, NewError(BadVersion, fmt.Sprintf("%s: routing rules are at version %d, not %d", filePath, current.Version, rules.Version))
+	}
+
+	saved := proto.Clone(rules).(*clustermetadatapb.RoutingRules)
+	saved.Version = current.Version + 1
+	contents, err = proto.Marshal(saved)
+	if err != nil {
+		return nil, err
+	}
+	// The topo version makes the write fail if the rules changed since
+	// they were read, whoever changed them.
+	if version == nil {
+		_, err = ts.globalTopo.Create(ctx, filePath, contents)
+	} else {
+		_, err = ts.globalTopo.Update(ctx, filePath, contents, version)
+	}
+	if errors.Is(err, &TopoError{Code: NodeExists}) {
+		return nil, NewError(BadVersion, filePath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// ValidateRoutingRules checks that each rule names its table and changes
+// how it is routed, that no two rules are for the same table, that a shard
+// is only given with its tablegroup, and that the pooler type is one that
+// serves queries.
+func ValidateRoutingRules(rules *clustermetadatapb.RoutingRules) error {
+	tables := make(map[string]bool, len(rules.GetRules()))
+	for _, rule := range rules.GetRules() {
+		if rule.FromTable == "" {
+			return errors.New("rule without from_table")
+		}
+		if tables[rule.FromTable] {
+			return fmt.Errorf("table %s: more than one rule", rule.FromTable)
+		}
+		tables[rule.FromTable] = true
+		if rule.Shard != "" && rule.Tablegroup == "" {
+			return fmt.Errorf("table %s: shard %s without a tablegroup", rule.FromTable, rule.Shard)
+		}
+		switch rule.PoolerType {
+		case clustermetadatapb.PoolerType_UNKNOWN, clustermetadatapb.PoolerType_PRIMARY, clustermetadatapb.PoolerType_REPLICA:
+		default:
+			return fmt.Errorf("table %s: pooler type %s does not serve queries", rule.FromTable, rule.PoolerType)
+		}
+		if rule.ToTable == "" && rule.Tablegroup == "" && rule.PoolerType == clustermetadatapb.PoolerType_UNKNOWN {
+			return fmt.Errorf("table %s: the rule changes nothing", rule.FromTable)
+		}
+	}
+	return nil
+}
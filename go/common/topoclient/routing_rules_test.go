@@ -0,0 +1,132 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topoclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestRoutingRules(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	badVersion := &topoclient.TopoError{Code: topoclient.BadVersion}
+
+	// A database starts without rules.
+	rules, err := ts.GetRoutingRules(ctx, "db1")
+	require.NoError(t, err)
+	require.Empty(t, rules.Rules)
+	require.Equal(t, int64(0), rules.Version)
+
+	rules.Rules = []*clustermetadatapb.RoutingRule{{FromTable: "orders", ToTable: "orders_v2"}}
+	saved, err := ts.SaveRoutingRules(ctx, "db1", rules)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), saved.Version)
+	require.Equal(t, int64(0), rules.Version)
+
+	// A change made from an older version is refused.
+	_, err = ts.SaveRoutingRules(ctx, "db1", rules)
+	require.ErrorIs(t, err, badVersion)
+
+	saved.Rules = append(saved.Rules, &clustermetadatapb.RoutingRule{
+		FromTable:  "events",
+		Tablegroup: "archive",
+		Shard:      "0-inf",
+		PoolerType: clustermetadatapb.PoolerType_REPLICA,
+	})
+	saved, err = ts.SaveRoutingRules(ctx, "db1", saved)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), saved.Version)
+
+	rules, err = ts.GetRoutingRules(ctx, "db1")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rules.Version)
+	require.Len(t, rules.Rules, 2)
+	require.Equal(t, "archive", rules.Rules[1].Tablegroup)
+
+	// Databases have their own rules.
+	rules, err = ts.GetRoutingRules(ctx, "db2")
+	require.NoError(t, err)
+	require.Empty(t, rules.Rules)
+
+	// All the rules can be removed.
+	saved, err = ts.SaveRoutingRules(ctx, "db1", &clustermetadatapb.RoutingRules{Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), saved.Version)
+	require.Empty(t, saved.Rules)
+}
+
+func TestValidateRoutingRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []*clustermetadatapb.RoutingRule
+		wantErr string
+	}{
+		{
+			name: "valid",
+			rules: []*clustermetadatapb.RoutingRule{
+				{FromTable: "orders", ToTable: "orders_v2"},
+				{FromTable: "sales.events", Tablegroup: "archive", Shard: "0-inf"},
+				{FromTable: "reports", PoolerType: clustermetadatapb.PoolerType_REPLICA},
+			},
+		},
+		{
+			name:    "no table",
+			rules:   []*clustermetadatapb.RoutingRule{{ToTable: "orders_v2"}},
+			wantErr: "rule without from_table",
+		},
+		{
+			name: "duplicate table",
+			rules: []*clustermetadatapb.RoutingRule{
+				{FromTable: "orders", ToTable: "orders_v2"},
+				{FromTable: "orders", Tablegroup: "archive"},
+			},
+			wantErr: "table orders: more than one rule",
+		},
+		{
+			name:    "shard without tablegroup",
+			rules:   []*clustermetadatapb.RoutingRule{{FromTable: "orders", Shard: "0-inf"}},
+			wantErr: "shard 0-inf without a tablegroup",
+		},
+		{
+			name:    "drained pooler",
+			rules:   []*clustermetadatapb.RoutingRule{{FromTable: "orders", PoolerType: clustermetadatapb.PoolerType_DRAINED}},
+			wantErr: "pooler type DRAINED does not serve queries",
+		},
+		{
+			name:    "no change",
+			rules:   []*clustermetadatapb.RoutingRule{{FromTable: "orders"}},
+			wantErr: "the rule changes nothing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := topoclient.ValidateRoutingRules(&clustermetadatapb.RoutingRules{Rules: tt.rules})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topoclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// This file provides the utility methods to read / save the ShardingSchema
+// of a database in the topology server.
+//
+
+// ShardingSchemaFile is the file name of a database's sharding schema.
+const ShardingSchemaFile = "ShardingSchema"
+
+// pathForShardingSchema returns the path for the sharding schema of a
+// database.
+func pathForShardingSchema(database string) string {
+	return path.Join(DatabasesPath, database, ShardingSchemaFile)
+}
+
+// GetShardingSchema reads the sharding schema of a database from the
+// global Conn. A database without sharding schema has an empty document at
+// version 0.
+func (ts *store) GetShardingSchema(ctx context.Context, database string) (*clustermetadatapb.ShardingSchema, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	contents, _, err := ts.globalTopo.Get(ctx, pathForShardingSchema(database))
+	switch {
+	case err == nil:
+	case errors.Is(err, &TopoError{Code: NoNode}):
+		return &clustermetadatapb.ShardingSchema{}, nil
+	default:
+		return nil, err
+	}
+	schema := &clustermetadatapb.ShardingSchema{}
+	if err := proto.Unmarshal(contents, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// SaveShardingSchema replaces the sharding schema of a database by schema
+// and returns it with its new version. The document is stored as is: it is
+// up to the caller to check that it describes a valid schema.
+//
+// schema must have the version of the schema it replaces, as returned by
+// GetShardingSchema: it fails with a BadVersion error if the schema changed
+// since, so that concurrent changes are not lost.
+func (ts *store) SaveShardingSchema(ctx context.Context, database string, schema *clustermetadatapb.ShardingSchema) (*clustermetadatapb.ShardingSchema, error) {
+	filePath := pathForShardingSchema(database)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	current := &clustermetadatapb.ShardingSchema{}
+	contents, version, err := ts.globalTopo.Get(ctx, filePath)
+	switch {
+	case err == nil:
+		if err := proto.Unmarshal(contents, current); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, &TopoError{Code: NoNode}):
+		version = nil
+	default:
+		return nil, err
+	}
+	if schema.Version != current.Version {
+		return nil, NewError(BadVersion, fmt.Sprintf("%s: sharding schema is at version %d, not %d", filePath, current.Version, schema.Version))
+	}
+
+	saved := &clustermetadatapb.ShardingSchema{
+		Document: schema.Document,
+		Version:  current.Version + 1,
+	}
+	contents, err = proto.Marshal(saved)
+	if err != nil {
+		return nil, err
+	}
+	// The topo version makes the write fail if the schema changed since it
+	// was read, whoever changed it.
+	if version == nil {
+		_, err = ts.globalTopo.Create(ctx, filePath, contents)
+	} else {
+		_, err = ts.globalTopo.Update(ctx, filePath, contents, version)
+	}
+	if errors.Is(err, &TopoError{Code: NodeExists}) {
+		return nil, NewError(BadVersion, filePath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topoclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestShardingSchema(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	badVersion := &topoclient.TopoError{Code: topoclient.BadVersion}
+
+	// A database starts without sharding schema.
+	schema, err := ts.GetShardingSchema(ctx, "db1")
+	require.NoError(t, err)
+	require.Empty(t, schema.Document)
+	require.Equal(t, int64(0), schema.Version)
+
+	schema.Document = "shards: [\"-80\", \"80-\"]\n"
+	saved, err := ts.SaveShardingSchema(ctx, "db1", schema)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), saved.Version)
+
+	// A change made from an older version is refused.
+	_, err = ts.SaveShardingSchema(ctx, "db1", schema)
+	require.ErrorIs(t, err, badVersion)
+
+	saved.Document = "shards: [\"-40\", \"40-80\", \"80-\"]\n"
+	saved, err = ts.SaveShardingSchema(ctx, "db1", saved)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), saved.Version)
+
+	schema, err = ts.GetShardingSchema(ctx, "db1")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), schema.Version)
+	require.Equal(t, saved.Document, schema.Document)
+
+	// Databases have their own schemas.
+	schema, err = ts.GetShardingSchema(ctx, "db2")
+	require.NoError(t, err)
+	require.Empty(t, schema.Document)
+	require.Equal(t, int64(0), schema.Version)
+
+	// Neither the rules nor the schema of a database change the other.
+	rules, err := ts.SaveRoutingRules(ctx, "db1", &clustermetadatapb.RoutingRules{
+		Rules: []*clustermetadatapb.RoutingRule{{FromTable: "orders", ToTable: "orders_v2"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rules.Version)
+	schema, err = ts.GetShardingSchema(ctx, "db1")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), schema.Version)
+}
@@ -211,6 +211,34 @@ type Store interface {
 	// See shard_lock.go for full documentation.
 	TryLockShard(ctx context.Context, shardKey types.ShardKey, action string) (context.Context, func(*error), error)
 
+	// GetPrimaryLease returns the primary lease of the specified shard.
+	// See primary_lease.go for full documentation.
+	GetPrimaryLease(ctx context.Context, shardKey types.ShardKey) (*clustermetadatapb.PrimaryLease, error)
+
+	// AcquirePrimaryLease acquires or renews the primary lease of the specified shard.
+	// See primary_lease.go for full documentation.
+	AcquirePrimaryLease(ctx context.Context, shardKey types.ShardKey, holder *clustermetadatapb.ID, epoch int64, ttl time.Duration) (*clustermetadatapb.PrimaryLease, error)
+
+	// ReleasePrimaryLease gives up the primary lease of the specified shard.
+	// See primary_lease.go for full documentation.
+	ReleasePrimaryLease(ctx context.Context, shardKey types.ShardKey, holder *clustermetadatapb.ID, epoch int64) error
+
+	// GetRoutingRules returns the routing rules of the specified database.
+	// See routing_rules.go for full documentation.
+	GetRoutingRules(ctx context.Context, database string) (*clustermetadatapb.RoutingRules, error)
+
+	// SaveRoutingRules replaces the routing rules of the specified database.
+	// See routing_rules.go for full documentation.
+	SaveRoutingRules(ctx context.Context, database string, rules *clustermetadatapb.RoutingRules) (*clustermetadatapb.RoutingRules, error)
+
+	// GetShardingSchema returns the sharding schema of the specified database.
+	// See sharding_schema.go for full documentation.
+	GetShardingSchema(ctx context.Context, database string) (*clustermetadatapb.ShardingSchema, error)
+
+	// SaveShardingSchema replaces the sharding schema of the specified database.
+	// See sharding_schema.go for full documentation.
+	SaveShardingSchema(ctx context.Context, database string, schema *clustermetadatapb.ShardingSchema) (*clustermetadatapb.ShardingSchema, error)
+
 	// GetRemoteOperationTimeout returns the configured timeout for remote operations.
 	// This should be used for RPCs and database operations that should use a shorter timeout than the parent context.
 	GetRemoteOperationTimeout() time.Duration
@@ -411,6 +439,7 @@ func NewWithFactory(factory Factory, root string, serverAddrs []string, config *
 			ts.setStatus(GlobalCell, s)
 		},
 	)
+	conn.cell = GlobalCell
 	ts.globalTopo = conn
 	return ts
 }
@@ -508,6 +537,7 @@ func (ts *store) ConnForCell(ctx context.Context, cell string) (Conn, error) {
 			ts.setStatus(cell, s)
 		},
 	)
+	conn.cell = cell
 	ts.cellConns[cell] = cellConn{
 		Cell: &clustermetadatapb.Cell{
 			Name:            ci.Name,
@@ -31,6 +31,9 @@ import (
 type WrapperConn struct {
 	newFunc func() (Conn, error)
 	alarm   func(string)
+	// cell is the cell of the topology server, recorded in the metrics of
+	// its operations.
+	cell string
 
 	mu       sync.Mutex
 	wrapped  Conn
@@ -187,7 +190,9 @@ func (c *WrapperConn) ListDir(ctx context.Context, dirPath string, full bool) ([
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	result, err := conn.ListDir(ctx, dirPath, full)
+	RecordOperation(ctx, OpListDir, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return result, err
 }
@@ -198,7 +203,9 @@ func (c *WrapperConn) Create(ctx context.Context, filePath string, contents []by
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	result, err := conn.Create(ctx, filePath, contents)
+	RecordOperation(ctx, OpCreate, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return result, err
 }
@@ -209,7 +216,9 @@ func (c *WrapperConn) Update(ctx context.Context, filePath string, contents []by
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	result, err := conn.Update(ctx, filePath, contents, version)
+	RecordOperation(ctx, OpUpdate, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return result, err
 }
@@ -220,7 +229,9 @@ func (c *WrapperConn) Get(ctx context.Context, filePath string) ([]byte, Version
 	if err != nil {
 		return nil, nil, err
 	}
+	start := time.Now()
 	data, version, err := conn.Get(ctx, filePath)
+	RecordOperation(ctx, OpGet, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return data, version, err
 }
@@ -231,7 +242,9 @@ func (c *WrapperConn) GetVersion(ctx context.Context, filePath string, version i
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	result, err := conn.GetVersion(ctx, filePath, version)
+	RecordOperation(ctx, OpGetVersion, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return result, err
 }
@@ -242,7 +255,9 @@ func (c *WrapperConn) List(ctx context.Context, filePathPrefix string) ([]KVInfo
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	result, err := conn.List(ctx, filePathPrefix)
+	RecordOperation(ctx, OpList, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return result, err
 }
@@ -253,7 +268,9 @@ func (c *WrapperConn) Delete(ctx context.Context, filePath string, version Versi
 	if err != nil {
 		return err
 	}
+	start := time.Now()
 	err = conn.Delete(ctx, filePath, version)
+	RecordOperation(ctx, OpDelete, c.cell, err, time.Since(start))
 	c.handleConnectionError(conn, err)
 	return err
 }
@@ -20,6 +20,8 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+	"github.com/multigres/multigres/go/multipooler/pools/balancer"
 	"github.com/multigres/multigres/go/tools/viperutil"
 )
 
@@ -76,9 +78,59 @@ type Config struct {
 	userReservedIdleTimeout       viperutil.Value[time.Duration] // For underlying pool connections
 	userReservedMaxLifetime       viperutil.Value[time.Duration]
 
+	// Connections kept open in each user's regular and reserved pools, up to
+	// their capacity, so that bursts do not wait for connections to open.
+	userRegularMinConnections  viperutil.Value[int64]
+	userReservedMinConnections viperutil.Value[int64]
+
+	// Max lifetime jitter is the largest fraction of the max lifetime added at
+	// random to each connection's lifetime, so that connections opened together
+	// are not recycled together.
+	maxLifetimeJitter viperutil.Value[float64]
+
+	// Connect rate limits the connections each pool opens per second (0 = no
+	// limit), in bursts of up to connectBurst connections.
+	connectRate  viperutil.Value[float64]
+	connectBurst viperutil.Value[int64]
+
+	// Prefill users are the users whose pools are created, and filled to their
+	// min connections, when the manager opens, instead of on their first
+	// connection: at startup and after PostgreSQL restarts, such as failovers.
+	prefillUsers viperutil.Value[[]string]
+
+	// Warmup statements run on every new connection of the user pools before
+	// it is used, such as to load caches or extensions.
+	warmupStatements viperutil.Value[[]string]
+
+	// Max wait is how long a request waits in its user's queue for a
+	// connection when the pool is exhausted before failing (0 = until the
+	// request is canceled). Max waiters per user caps the requests queued per
+	// user pool (0 = no limit), so that one user cannot fill the queue.
+	maxWait           viperutil.Value[time.Duration]
+	maxWaitersPerUser viperutil.Value[int64]
+
 	// Settings cache size (0 = use default)
 	settingsCacheSize viperutil.Value[int64]
 
+	// Backend hosts are the servers, such as read replicas, the user pools
+	// spread their connections over by the backend policy, as host:port
+	// (empty = the local PostgreSQL). A server that fails to accept a
+	// connection is left out for the backend down time. The admin and
+	// internal pools always use the local PostgreSQL.
+	backendHosts    viperutil.Value[[]string]
+	backendPolicy   viperutil.Value[string]
+	backendDownTime viperutil.Value[time.Duration]
+
+	// SSL mode selects whether the TCP connections to PostgreSQL use TLS and
+	// how the server certificate is verified, with libpq sslmode semantics,
+	// against the CA certificates of the SSL root cert. The SSL cert and key
+	// are the client certificate presented to the server. Unix socket
+	// connections never use TLS.
+	sslMode     viperutil.Value[string]
+	sslRootCert viperutil.Value[string]
+	sslCert     viperutil.Value[string]
+	sslKey      viperutil.Value[string]
+
 	// --- Fair share allocation configuration ---
 
 	// Global capacity is the total number of PostgreSQL connections to manage.
@@ -89,6 +141,13 @@ type Config struct {
 	// Regular pools get (1 - reservedRatio) of the global capacity.
 	reservedRatio viperutil.Value[float64]
 
+	// Superuser reserved connections are held back from the global capacity
+	// for the superuser roles, so that operators can always connect, even when
+	// the applications have exhausted the pools (0 = none, superuser roles
+	// share the global capacity like other users).
+	superuserReservedConnections viperutil.Value[int64]
+	superuserRoles               viperutil.Value[[]string]
+
 	// --- Rebalancer configuration ---
 
 	// Rebalance interval is how often the rebalancer runs to adjust pool capacities.
@@ -106,6 +165,10 @@ type Config struct {
 	// Minimum capacity per user ensures light users always have enough connections
 	// for burst demand that point-in-time sampling might miss.
 	minCapacityPerUser viperutil.Value[int64]
+
+	// Maximum capacity per user keeps a single user from taking most of the
+	// global capacity (0 = no limit).
+	maxCapacityPerUser viperutil.Value[int64]
 }
 
 // NewConfig creates a new Config with all connection pool settings
@@ -124,9 +187,24 @@ func NewConfig(reg *viperutil.Registry) *Config {
 		userReservedIdleTimeout       = 5 * time.Minute  // Less aggressive - for pool size reduction
 		userReservedMaxLifetime       = 1 * time.Hour
 
+		// Connection opening defaults: each connection lives between 1 and 2
+		// max lifetimes, and the connect rate is unlimited.
+		maxLifetimeJitter       = 1.0
+		connectBurst      int64 = 10
+
+		// Queueing defaults: requests wait up to 30s for a connection.
+		maxWait = 30 * time.Second
+
 		// Settings cache size
 		settingsCacheSize int64 = 1024
 
+		// Backend hosts defaults
+		backendPolicy   = string(balancer.RoundRobin)
+		backendDownTime = 10 * time.Second
+
+		// TLS defaults: plaintext, as before sslmode was configurable.
+		sslMode = string(client.SSLModeDisable)
+
 		// Fair share allocation defaults
 		globalCapacity int64 = 100
 		reservedRatio        = 0.2
@@ -143,6 +221,9 @@ func NewConfig(reg *viperutil.Registry) *Config {
 		minCapacityPerUser int64 = 10
 	)
 
+	// The sizing, timeouts and connect rate of the pools can be changed at
+	// runtime (see Manager.Reconfigure).
+
 	return &Config{
 		// Admin credential settings
 		adminUser: viperutil.Configure(reg, "connpool.admin.user", viperutil.Options[string]{
@@ -171,10 +252,12 @@ func NewConfig(reg *viperutil.Registry) *Config {
 		userRegularIdleTimeout: viperutil.Configure(reg, "connpool.user.regular.idle-timeout", viperutil.Options[time.Duration]{
 			Default:  userRegularIdleTimeout,
 			FlagName: "connpool-user-regular-idle-timeout",
+			Dynamic:  true,
 		}),
 		userRegularMaxLifetime: viperutil.Configure(reg, "connpool.user.regular.max-lifetime", viperutil.Options[time.Duration]{
 			Default:  userRegularMaxLifetime,
 			FlagName: "connpool-user-regular-max-lifetime",
+			Dynamic:  true,
 		}),
 
 		// Per-user reserved pool (for transactions)
@@ -185,10 +268,55 @@ func NewConfig(reg *viperutil.Registry) *Config {
 		userReservedIdleTimeout: viperutil.Configure(reg, "connpool.user.reserved.idle-timeout", viperutil.Options[time.Duration]{
 			Default:  userReservedIdleTimeout,
 			FlagName: "connpool-user-reserved-idle-timeout",
+			Dynamic:  true,
 		}),
 		userReservedMaxLifetime: viperutil.Configure(reg, "connpool.user.reserved.max-lifetime", viperutil.Options[time.Duration]{
 			Default:  userReservedMaxLifetime,
 			FlagName: "connpool-user-reserved-max-lifetime",
+			Dynamic:  true,
+		}),
+		userRegularMinConnections: viperutil.Configure(reg, "connpool.user.regular.min-connections", viperutil.Options[int64]{
+			FlagName: "connpool-user-regular-min-connections",
+			Dynamic:  true,
+		}),
+		userReservedMinConnections: viperutil.Configure(reg, "connpool.user.reserved.min-connections", viperutil.Options[int64]{
+			FlagName: "connpool-user-reserved-min-connections",
+			Dynamic:  true,
+		}),
+
+		// Connection opening
+		maxLifetimeJitter: viperutil.Configure(reg, "connpool.max-lifetime-jitter", viperutil.Options[float64]{
+			Default:  maxLifetimeJitter,
+			FlagName: "connpool-max-lifetime-jitter",
+			Dynamic:  true,
+		}),
+		connectRate: viperutil.Configure(reg, "connpool.connect-rate", viperutil.Options[float64]{
+			FlagName: "connpool-connect-rate",
+			Dynamic:  true,
+		}),
+		connectBurst: viperutil.Configure(reg, "connpool.connect-burst", viperutil.Options[int64]{
+			Default:  connectBurst,
+			FlagName: "connpool-connect-burst",
+			Dynamic:  true,
+		}),
+		prefillUsers: viperutil.Configure(reg, "connpool.prefill-users", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "connpool-prefill-users",
+		}),
+		warmupStatements: viperutil.Configure(reg, "connpool.warmup-statements", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "connpool-warmup-statements",
+		}),
+
+		// Queueing
+		maxWait: viperutil.Configure(reg, "connpool.max-wait", viperutil.Options[time.Duration]{
+			Default:  maxWait,
+			FlagName: "connpool-max-wait",
+			Dynamic:  true,
+		}),
+		maxWaitersPerUser: viperutil.Configure(reg, "connpool.max-waiters-per-user", viperutil.Options[int64]{
+			FlagName: "connpool-max-waiters-per-user",
+			Dynamic:  true,
 		}),
 
 		// Settings cache size
@@ -197,14 +325,53 @@ func NewConfig(reg *viperutil.Registry) *Config {
 			FlagName: "connpool-settings-cache-size",
 		}),
 
+		// Backend hosts
+		backendHosts: viperutil.Configure(reg, "connpool.backend-hosts", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "connpool-backend-hosts",
+		}),
+		backendPolicy: viperutil.Configure(reg, "connpool.backend-policy", viperutil.Options[string]{
+			Default:  backendPolicy,
+			FlagName: "connpool-backend-policy",
+		}),
+		backendDownTime: viperutil.Configure(reg, "connpool.backend-down-time", viperutil.Options[time.Duration]{
+			Default:  backendDownTime,
+			FlagName: "connpool-backend-down-time",
+		}),
+
+		// TLS
+		sslMode: viperutil.Configure(reg, "connpool.sslmode", viperutil.Options[string]{
+			Default:  sslMode,
+			FlagName: "connpool-sslmode",
+		}),
+		sslRootCert: viperutil.Configure(reg, "connpool.sslrootcert", viperutil.Options[string]{
+			FlagName: "connpool-sslrootcert",
+		}),
+		sslCert: viperutil.Configure(reg, "connpool.sslcert", viperutil.Options[string]{
+			FlagName: "connpool-sslcert",
+		}),
+		sslKey: viperutil.Configure(reg, "connpool.sslkey", viperutil.Options[string]{
+			FlagName: "connpool-sslkey",
+		}),
+
 		// Fair share allocation
 		globalCapacity: viperutil.Configure(reg, "connpool.global-capacity", viperutil.Options[int64]{
 			Default:  globalCapacity,
 			FlagName: "connpool-global-capacity",
+			Dynamic:  true,
 		}),
 		reservedRatio: viperutil.Configure(reg, "connpool.reserved-ratio", viperutil.Options[float64]{
 			Default:  reservedRatio,
 			FlagName: "connpool-reserved-ratio",
+			Dynamic:  true,
+		}),
+		superuserReservedConnections: viperutil.Configure(reg, "connpool.superuser-reserved-connections", viperutil.Options[int64]{
+			FlagName: "connpool-superuser-reserved-connections",
+			Dynamic:  true,
+		}),
+		superuserRoles: viperutil.Configure(reg, "connpool.superuser-roles", viperutil.Options[[]string]{
+			Default:  []string{"postgres"},
+			FlagName: "connpool-superuser-roles",
 		}),
 
 		// Rebalancer
@@ -223,6 +390,11 @@ func NewConfig(reg *viperutil.Registry) *Config {
 		minCapacityPerUser: viperutil.Configure(reg, "connpool.min-capacity-per-user", viperutil.Options[int64]{
 			Default:  minCapacityPerUser,
 			FlagName: "connpool-min-capacity-per-user",
+			Dynamic:  true,
+		}),
+		maxCapacityPerUser: viperutil.Configure(reg, "connpool.max-capacity-per-user", viperutil.Options[int64]{
+			FlagName: "connpool-max-capacity-per-user",
+			Dynamic:  true,
 		}),
 	}
 }
@@ -245,19 +417,46 @@ func (c *Config) RegisterFlags(fs *pflag.FlagSet) {
 	fs.Duration("connpool-user-reserved-inactivity-timeout", c.userReservedInactivityTimeout.Default(), "How long a reserved connection can be inactive (no client activity) before being killed")
 	fs.Duration("connpool-user-reserved-idle-timeout", c.userReservedIdleTimeout.Default(), "How long a connection in the reserved pool can remain idle before being closed")
 	fs.Duration("connpool-user-reserved-max-lifetime", c.userReservedMaxLifetime.Default(), "Maximum lifetime of a user's reserved connection before recycling")
+	fs.Int64("connpool-user-regular-min-connections", c.userRegularMinConnections.Default(), "Connections kept open in each user's regular pool, up to its capacity")
+	fs.Int64("connpool-user-reserved-min-connections", c.userReservedMinConnections.Default(), "Connections kept open in each user's reserved pool, up to its capacity")
+
+	// Connection opening flags
+	fs.Float64("connpool-max-lifetime-jitter", c.maxLifetimeJitter.Default(), "Largest fraction of the max lifetime added at random to each connection's lifetime, so that connections are not recycled together")
+	fs.Float64("connpool-connect-rate", c.connectRate.Default(), "Connections each pool opens per second at most (0 = no limit)")
+	fs.Int64("connpool-connect-burst", c.connectBurst.Default(), "Connections each pool opens at once at most when the connect rate is limited")
+	fs.StringSlice("connpool-prefill-users", c.prefillUsers.Default(), "Users whose pools are created and filled to their min connections at startup and after PostgreSQL restarts, instead of on their first connection")
+	fs.StringArray("connpool-warmup-statements", c.warmupStatements.Default(), "Statement run on every new connection of the user pools before it is used (repeat the flag for several statements)")
+
+	// Queueing flags
+	fs.Duration("connpool-max-wait", c.maxWait.Default(), "How long a request waits for a connection when its pool is exhausted before failing with SQLSTATE 53300 (0 = no limit)")
+	fs.Int64("connpool-max-waiters-per-user", c.maxWaitersPerUser.Default(), "Maximum requests queued for a connection per user pool; further requests fail with SQLSTATE 53300 (0 = no limit)")
 
 	// Settings cache size flag
 	fs.Int64("connpool-settings-cache-size", c.settingsCacheSize.Default(), "Maximum number of unique settings combinations to cache (0 = use default)")
 
+	// Backend hosts flags
+	fs.StringSlice("connpool-backend-hosts", c.backendHosts.Default(), "Servers (host:port), such as read replicas, the user pools spread their connections over instead of the local PostgreSQL")
+	fs.String("connpool-backend-policy", c.backendPolicy.Default(), "How the user pools choose the backend host of each new connection: round-robin or least-connections")
+	fs.Duration("connpool-backend-down-time", c.backendDownTime.Default(), "How long a backend host that failed to accept a connection is left out")
+
+	// TLS flags
+	fs.String("connpool-sslmode", c.sslMode.Default(), "Whether TCP connections to PostgreSQL use TLS and how the server certificate is verified, as libpq's sslmode: disable, prefer, require, verify-ca or verify-full")
+	fs.String("connpool-sslrootcert", c.sslRootCert.Default(), "PEM file of the CA certificates the PostgreSQL server certificate is verified against (empty = the system roots)")
+	fs.String("connpool-sslcert", c.sslCert.Default(), "PEM client certificate presented to PostgreSQL, with connpool-sslkey")
+	fs.String("connpool-sslkey", c.sslKey.Default(), "PEM private key of the client certificate presented to PostgreSQL")
+
 	// Fair share allocation flags
 	fs.Int64("connpool-global-capacity", c.globalCapacity.Default(), "Total PostgreSQL connections to manage (divided between regular and reserved pools)")
 	fs.Float64("connpool-reserved-ratio", c.reservedRatio.Default(), "Fraction of global capacity allocated to reserved pools (0.0-1.0)")
+	fs.Int64("connpool-superuser-reserved-connections", c.superuserReservedConnections.Default(), "Connections of the global capacity held back for the superuser roles, so that operators can connect when the pools are exhausted (0 = none)")
+	fs.StringSlice("connpool-superuser-roles", c.superuserRoles.Default(), "Users that can use the superuser reserved connections")
 
 	// Rebalancer flags
 	fs.Duration("connpool-rebalance-interval", c.rebalanceInterval.Default(), "How often to rebalance pool capacities")
 	fs.Duration("connpool-demand-window", c.demandWindow.Default(), "Sliding window for peak demand tracking (should be multiple of rebalance-interval)")
 	fs.Duration("connpool-inactive-timeout", c.inactiveTimeout.Default(), "How long a user pool can be inactive before garbage collection")
 	fs.Int64("connpool-min-capacity-per-user", c.minCapacityPerUser.Default(), "Minimum connections per user (protects against aggressive capacity reduction for light users)")
+	fs.Int64("connpool-max-capacity-per-user", c.maxCapacityPerUser.Default(), "Maximum connections per user (0 = no limit)")
 
 	viperutil.BindFlags(fs,
 		c.adminUser,
@@ -269,13 +468,32 @@ func (c *Config) RegisterFlags(fs *pflag.FlagSet) {
 		c.userReservedInactivityTimeout,
 		c.userReservedIdleTimeout,
 		c.userReservedMaxLifetime,
+		c.userRegularMinConnections,
+		c.userReservedMinConnections,
+		c.maxLifetimeJitter,
+		c.connectRate,
+		c.connectBurst,
+		c.prefillUsers,
+		c.warmupStatements,
+		c.maxWait,
+		c.maxWaitersPerUser,
 		c.settingsCacheSize,
+		c.backendHosts,
+		c.backendPolicy,
+		c.backendDownTime,
+		c.sslMode,
+		c.sslRootCert,
+		c.sslCert,
+		c.sslKey,
 		c.globalCapacity,
 		c.reservedRatio,
+		c.superuserReservedConnections,
+		c.superuserRoles,
 		c.rebalanceInterval,
 		c.demandWindow,
 		c.inactiveTimeout,
 		c.minCapacityPerUser,
+		c.maxCapacityPerUser,
 	)
 }
 
@@ -327,11 +545,92 @@ func (c *Config) UserReservedMaxLifetime() time.Duration {
 	return c.userReservedMaxLifetime.Get()
 }
 
+// UserRegularMinConnections returns the connections kept open in each user's regular pool.
+func (c *Config) UserRegularMinConnections() int64 {
+	return c.userRegularMinConnections.Get()
+}
+
+// UserReservedMinConnections returns the connections kept open in each user's reserved pool.
+func (c *Config) UserReservedMinConnections() int64 {
+	return c.userReservedMinConnections.Get()
+}
+
+// MaxLifetimeJitter returns the largest fraction of the max lifetime added to each connection's lifetime.
+func (c *Config) MaxLifetimeJitter() float64 {
+	return c.maxLifetimeJitter.Get()
+}
+
+// ConnectRate returns the connections each pool opens per second at most (0 = no limit).
+func (c *Config) ConnectRate() float64 {
+	return c.connectRate.Get()
+}
+
+// ConnectBurst returns the connections each pool opens at once at most when the connect rate is limited.
+func (c *Config) ConnectBurst() int64 {
+	return c.connectBurst.Get()
+}
+
+// PrefillUsers returns the users whose pools are created and filled when the manager opens.
+func (c *Config) PrefillUsers() []string {
+	return c.prefillUsers.Get()
+}
+
+// WarmupStatements returns the statements run on every new connection of the user pools.
+func (c *Config) WarmupStatements() []string {
+	return c.warmupStatements.Get()
+}
+
+// MaxWait returns how long a request waits for a connection when its pool is exhausted.
+func (c *Config) MaxWait() time.Duration {
+	return c.maxWait.Get()
+}
+
+// MaxWaitersPerUser returns the maximum number of requests queued per user pool.
+func (c *Config) MaxWaitersPerUser() int64 {
+	return c.maxWaitersPerUser.Get()
+}
+
 // SettingsCacheSize returns the settings cache size.
 func (c *Config) SettingsCacheSize() int {
 	return int(c.settingsCacheSize.Get())
 }
 
+// BackendHosts returns the servers the user pools spread their connections over.
+func (c *Config) BackendHosts() []string {
+	return c.backendHosts.Get()
+}
+
+// BackendPolicy returns how the user pools choose the backend host of each new connection.
+func (c *Config) BackendPolicy() string {
+	return c.backendPolicy.Get()
+}
+
+// BackendDownTime returns how long a backend host that failed is left out.
+func (c *Config) BackendDownTime() time.Duration {
+	return c.backendDownTime.Get()
+}
+
+// SSLMode returns whether the TCP connections to PostgreSQL use TLS and how
+// the server certificate is verified, as libpq's sslmode.
+func (c *Config) SSLMode() string {
+	return c.sslMode.Get()
+}
+
+// SSLRootCert returns the PEM file of the CA certificates the server certificate is verified against.
+func (c *Config) SSLRootCert() string {
+	return c.sslRootCert.Get()
+}
+
+// SSLCert returns the PEM client certificate presented to PostgreSQL.
+func (c *Config) SSLCert() string {
+	return c.sslCert.Get()
+}
+
+// SSLKey returns the PEM private key of the client certificate.
+func (c *Config) SSLKey() string {
+	return c.sslKey.Get()
+}
+
 // GlobalCapacity returns the total PostgreSQL connections to manage.
 // This is divided between regular and reserved pools based on ReservedRatio.
 func (c *Config) GlobalCapacity() int64 {
@@ -344,6 +643,16 @@ func (c *Config) ReservedRatio() float64 {
 	return c.reservedRatio.Get()
 }
 
+// SuperuserReservedConnections returns the connections held back for the superuser roles.
+func (c *Config) SuperuserReservedConnections() int64 {
+	return c.superuserReservedConnections.Get()
+}
+
+// SuperuserRoles returns the users that can use the superuser reserved connections.
+func (c *Config) SuperuserRoles() []string {
+	return c.superuserRoles.Get()
+}
+
 // RebalanceInterval returns how often the rebalancer runs to adjust pool capacities.
 func (c *Config) RebalanceInterval() time.Duration {
 	return c.rebalanceInterval.Get()
@@ -366,6 +675,11 @@ func (c *Config) MinCapacityPerUser() int64 {
 	return c.minCapacityPerUser.Get()
 }
 
+// MaxCapacityPerUser returns the maximum connections per user (0 = no limit).
+func (c *Config) MaxCapacityPerUser() int64 {
+	return c.maxCapacityPerUser.Get()
+}
+
 // NewManager creates a new connection pool manager from this config.
 // Call this after flags have been parsed and when you're ready to create the manager.
 // The manager starts in a closed state; call Open() before using it.
@@ -61,6 +61,31 @@ func TestConfig_DefaultValues(t *testing.T) {
 	assert.Equal(t, 1*time.Hour, config.userReservedMaxLifetime.Default())
 
 	assert.Equal(t, int64(1024), config.settingsCacheSize.Default())
+
+	// Connection opening: no minimum, jittered lifetimes, unlimited rate.
+	assert.Equal(t, int64(0), config.userRegularMinConnections.Default())
+	assert.Equal(t, int64(0), config.userReservedMinConnections.Default())
+	assert.Equal(t, 1.0, config.maxLifetimeJitter.Default())
+	assert.Equal(t, 0.0, config.connectRate.Default())
+	assert.Equal(t, int64(10), config.connectBurst.Default())
+	assert.Equal(t, 30*time.Second, config.maxWait.Default())
+	assert.Equal(t, int64(0), config.maxWaitersPerUser.Default())
+	assert.Equal(t, int64(0), config.maxCapacityPerUser.Default())
+	assert.Equal(t, int64(0), config.superuserReservedConnections.Default())
+	assert.Equal(t, []string{"postgres"}, config.superuserRoles.Default())
+
+	// No pools are prefilled and connections are not warmed up by default.
+	assert.Empty(t, config.prefillUsers.Default())
+	assert.Empty(t, config.warmupStatements.Default())
+
+	// The user pools use the local PostgreSQL by default.
+	assert.Empty(t, config.backendHosts.Default())
+	assert.Equal(t, "round-robin", config.backendPolicy.Default())
+	assert.Equal(t, 10*time.Second, config.backendDownTime.Default())
+
+	// Connections to PostgreSQL do not use TLS by default.
+	assert.Equal(t, "disable", config.sslMode.Default())
+	assert.Empty(t, config.sslRootCert.Default())
 }
 
 func TestConfig_RegisterFlags(t *testing.T) {
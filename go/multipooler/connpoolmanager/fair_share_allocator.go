@@ -20,11 +20,13 @@ package connpoolmanager
 // The algorithm ensures:
 //   - Each user gets at least minPerUser connections (floor to handle burst demand)
 //   - No user gets more than their demand (unless demand < minPerUser)
+//   - No user gets more than maxPerUser connections, when set
 //   - Total allocation does not exceed capacity
 //   - Remaining capacity is distributed fairly among unsatisfied users
 type FairShareAllocator struct {
 	capacity   int64
 	minPerUser int64
+	maxPerUser int64
 }
 
 // NewFairShareAllocator creates a new allocator with the given capacity budget.
@@ -41,6 +43,13 @@ func NewFairShareAllocator(capacity int64, minPerUser int64) *FairShareAllocator
 	}
 }
 
+// SetMaxPerUser sets the maximum allocation per user, so that a single user
+// cannot take most of the capacity. 0 does not limit it. The maximum wins
+// over minPerUser.
+func (a *FairShareAllocator) SetMaxPerUser(maxPerUser int64) {
+	a.maxPerUser = maxPerUser
+}
+
 // Capacity returns the total capacity this allocator manages.
 func (a *FairShareAllocator) Capacity() int64 {
 	return a.capacity
@@ -90,7 +99,7 @@ func (a *FairShareAllocator) Allocate(demands map[string]int64) map[string]int64
 
 		for user := range unsatisfied {
 			// Effective demand is at least minPerUser to handle burst demand
-			demand := max(demands[user], a.minPerUser)
+			demand := a.capped(max(demands[user], a.minPerUser))
 			remainingDemand := demand - allocs[user]
 
 			if remainingDemand <= 0 {
@@ -134,10 +143,18 @@ func (a *FairShareAllocator) Allocate(demands map[string]int64) map[string]int64
 		extraPerUser := remaining / int64(numUsers)
 		if extraPerUser > 0 {
 			for user := range allocs {
-				allocs[user] += extraPerUser
+				allocs[user] = a.capped(allocs[user] + extraPerUser)
 			}
 		}
 	}
 
 	return allocs
 }
+
+// capped returns n bounded by the maximum allocation per user.
+func (a *FairShareAllocator) capped(n int64) int64 {
+	if a.maxPerUser > 0 {
+		return min(n, a.maxPerUser)
+	}
+	return n
+}
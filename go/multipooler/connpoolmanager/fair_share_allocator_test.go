@@ -380,3 +380,19 @@ func BenchmarkFairShareAllocator_Allocate(b *testing.B) {
 		_ = alloc.Allocate(demands)
 	}
 }
+
+func TestFairShareAllocator_MaxPerUser(t *testing.T) {
+	alloc := NewFairShareAllocator(100, 5)
+	alloc.SetMaxPerUser(30)
+
+	// The heavy user is capped, and the spare capacity is not given past the cap.
+	result := alloc.Allocate(map[string]int64{"heavy": 90, "light": 2})
+	assert.Equal(t, int64(30), result["heavy"])
+	assert.Equal(t, int64(30), result["light"])
+
+	// The maximum wins over the minimum.
+	alloc = NewFairShareAllocator(100, 5)
+	alloc.SetMaxPerUser(3)
+	result = alloc.Allocate(map[string]int64{"user": 0})
+	assert.Equal(t, int64(3), result["user"])
+}
@@ -17,6 +17,7 @@ package connpoolmanager
 import (
 	"context"
 
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
 	"github.com/multigres/multigres/go/multipooler/pools/admin"
 	"github.com/multigres/multigres/go/multipooler/pools/regular"
 	"github.com/multigres/multigres/go/multipooler/pools/reserved"
@@ -71,10 +72,40 @@ type PoolManager interface {
 	// GetReservedConn retrieves an existing reserved connection by ID for the specified user.
 	GetReservedConn(connID int64, user string) (*reserved.Conn, bool)
 
+	// --- Listener Connection ---
+
+	// NewListenerConn opens a connection outside of the pools for receiving
+	// LISTEN/NOTIFY notifications. The caller owns the connection and must
+	// close it.
+	NewListenerConn(ctx context.Context) (*client.Conn, error)
+
 	// --- Stats ---
 
 	// Stats returns statistics for all pools.
 	Stats() ManagerStats
+
+	// --- Configuration ---
+
+	// Settings returns the current settings of the pools.
+	Settings() PoolSettings
+
+	// Reconfigure changes the settings of the pools without reopening them.
+	Reconfigure(ctx context.Context, settings *PoolSettings) error
+
+	// --- Pause and Drain ---
+
+	// Pause stops the user pools from giving out connections until Resume.
+	Pause(ctx context.Context)
+
+	// Resume lets the user pools give out connections again.
+	Resume(ctx context.Context)
+
+	// IsPaused returns whether the user pools are paused.
+	IsPaused() bool
+
+	// Drain pauses the user pools and closes their connections, killing the
+	// reserved connections still in use when ctx is done.
+	Drain(ctx context.Context) error
 }
 
 // Compile-time check that Manager implements PoolManager.
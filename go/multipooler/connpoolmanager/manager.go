@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"log/slog"
 	"maps"
+	"slices"
 	"sync"
 	"sync/atomic"
 
 	"github.com/multigres/multigres/go/common/pgprotocol/client"
 	"github.com/multigres/multigres/go/multipooler/connstate"
 	"github.com/multigres/multigres/go/multipooler/pools/admin"
+	"github.com/multigres/multigres/go/multipooler/pools/balancer"
 	"github.com/multigres/multigres/go/multipooler/pools/connpool"
 	"github.com/multigres/multigres/go/multipooler/pools/regular"
 	"github.com/multigres/multigres/go/multipooler/pools/reserved"
@@ -65,6 +67,7 @@ type Manager struct {
 	connConfig *ConnectionConfig // Stored for lazy pool creation
 
 	adminPool     *admin.Pool              // Shared admin pool for kill operations
+	balancer      *balancer.Balancer       // Backend hosts of the user pools (nil = local PostgreSQL)
 	settingsCache *connstate.SettingsCache // Shared settings cache for all users
 	metrics       *Metrics                 // OpenTelemetry metrics
 
@@ -80,18 +83,29 @@ type Manager struct {
 	// closed indicates whether the manager has been closed.
 	closed atomic.Bool
 
+	// paused indicates whether the user pools are paused; user pools created
+	// while paused start paused.
+	paused atomic.Bool
+
 	// Rebalancer goroutine management
 	rebalancerCtx    context.Context
 	rebalancerCancel context.CancelFunc
 	rebalancerWg     sync.WaitGroup
 
-	// Fair share allocators (created once in Open)
+	// Fair share allocators (created in Open, replaced by Reconfigure)
+	allocatorMu       sync.Mutex
 	regularAllocator  *FairShareAllocator
 	reservedAllocator *FairShareAllocator
+
+	// Fair share allocators of the superuser reserved connections, shared by
+	// the superuser roles (created and replaced with the allocators above)
+	superuserRegularAllocator  *FairShareAllocator
+	superuserReservedAllocator *FairShareAllocator
 }
 
 // Open initializes the manager and creates the shared admin pool.
-// User pools are created lazily on first connection request.
+// User pools are created lazily on first connection request, except those of
+// the prefill users, which are created here and fill up in the background.
 //
 // Parameters:
 //   - ctx: Context for pool operations
@@ -106,14 +120,21 @@ func (m *Manager) Open(ctx context.Context, connConfig *ConnectionConfig) {
 	m.settingsCache = connstate.NewSettingsCache(m.config.SettingsCacheSize())
 	m.closed.Store(false)
 
+	// Connections fail with an invalid sslmode rather than fall back to
+	// plaintext.
+	if _, err := client.ParseSSLMode(m.config.SSLMode()); err != nil {
+		m.logger.ErrorContext(ctx, "invalid connpool-sslmode, connections to PostgreSQL will fail", "error", err)
+	}
+
 	// Build admin client config
 	adminClientConfig := m.buildClientConfig(m.config.AdminUser(), m.config.AdminPassword())
 
 	// Build admin pool config
 	adminPoolConfig := &connpool.Config{
-		Name:     "admin",
-		Capacity: m.config.AdminCapacity(),
-		Logger:   m.logger,
+		Name:          "admin",
+		Capacity:      m.config.AdminCapacity(),
+		ConnectErrors: m.metrics.ConnectErrors(),
+		Logger:        m.logger,
 	}
 
 	// Create shared admin pool (used by all user pools for kill operations)
@@ -123,46 +144,194 @@ func (m *Manager) Open(ctx context.Context, connConfig *ConnectionConfig) {
 	})
 	m.adminPool.Open()
 
-	// Create fair share allocators based on global capacity and reserved ratio
-	globalCapacity := m.config.GlobalCapacity()
-	reservedRatio := m.config.ReservedRatio()
-	minPerUser := m.config.MinCapacityPerUser()
-	regularCapacity := int64(float64(globalCapacity) * (1 - reservedRatio))
-	reservedCapacity := globalCapacity - regularCapacity
-	regularMinPerUser := max(int64(float64(minPerUser)*(1-reservedRatio)), 1)
-	reservedMinPerUser := max(minPerUser-regularMinPerUser, 1)
+	m.balancer = m.newBalancer(ctx)
 
-	// Use configurable minCapacityPerUser as the minimum per-user floor.
-	// This ensures light users always have enough capacity for burst demand.
-	m.regularAllocator = NewFairShareAllocator(regularCapacity, regularMinPerUser)
-	m.reservedAllocator = NewFairShareAllocator(reservedCapacity, reservedMinPerUser)
+	// Create fair share allocators based on global capacity and reserved ratio
+	m.allocatorMu.Lock()
+	m.regularAllocator, m.reservedAllocator = m.newAllocators()
+	m.superuserRegularAllocator, m.superuserReservedAllocator = m.newSuperuserAllocators()
+	regularCapacity := m.regularAllocator.Capacity()
+	reservedCapacity := m.reservedAllocator.Capacity()
+	m.allocatorMu.Unlock()
 
 	// Start the rebalancer goroutine
 	m.rebalancerCtx, m.rebalancerCancel = context.WithCancel(ctx)
 	m.startRebalancer()
 
+	m.prefillUserPoolsLocked(ctx)
+
 	m.logger.InfoContext(ctx, "connection pool manager opened",
 		"admin_user", m.config.AdminUser(),
 		"admin_capacity", adminPoolConfig.Capacity,
 		"initial_user_capacity", initialUserPoolCapacity,
 		"settings_cache_size", m.config.SettingsCacheSize(),
-		"global_capacity", globalCapacity,
-		"reserved_ratio", reservedRatio,
+		"global_capacity", m.config.GlobalCapacity(),
+		"reserved_ratio", m.config.ReservedRatio(),
 		"regular_allocation", regularCapacity,
 		"reserved_allocation", reservedCapacity,
+		"superuser_reserved_connections", m.config.SuperuserReservedConnections(),
 		"rebalance_interval", m.config.RebalanceInterval(),
+		"backend_hosts", m.config.BackendHosts(),
+		"sslmode", m.config.SSLMode(),
 	)
 }
 
+// newBalancer creates the balancer over the backend hosts, or returns nil if
+// none is configured. If the configuration is invalid, the user pools use
+// the local PostgreSQL.
+func (m *Manager) newBalancer(ctx context.Context) *balancer.Balancer {
+	hosts := m.config.BackendHosts()
+	if len(hosts) == 0 {
+		return nil
+	}
+	policy, err := balancer.ParsePolicy(m.config.BackendPolicy())
+	if err != nil {
+		m.logger.ErrorContext(ctx, "invalid backend policy, using the local PostgreSQL", "error", err)
+		return nil
+	}
+	b, err := balancer.New(balancer.Config{
+		Hosts:    hosts,
+		Policy:   policy,
+		DownTime: m.config.BackendDownTime(),
+	})
+	if err != nil {
+		m.logger.ErrorContext(ctx, "invalid backend hosts, using the local PostgreSQL", "error", err)
+		return nil
+	}
+	return b
+}
+
+// prefillUserPoolsLocked creates the pools of the prefill users, so that their
+// first connections do not wait for the pools to be created and for
+// connections to open. Each pool opens its min connections in the background.
+// Caller must hold createMu.
+func (m *Manager) prefillUserPoolsLocked(ctx context.Context) {
+	users := m.config.PrefillUsers()
+	if len(users) == 0 {
+		return
+	}
+	for _, user := range users {
+		if _, err := m.createUserPoolLocked(ctx, user); err != nil {
+			m.logger.WarnContext(ctx, "failed to prefill user pool", "user", user, "error", err)
+		}
+	}
+	m.logger.InfoContext(ctx, "prefilled user pools",
+		"users", users,
+		"regular_min_connections", m.config.UserRegularMinConnections(),
+		"reserved_min_connections", m.config.UserReservedMinConnections(),
+	)
+}
+
+// newAllocators creates the fair share allocators of the regular and reserved
+// pools, splitting the global capacity, less the superuser reserved
+// connections, and the per-user bounds between them by the reserved ratio.
+func (m *Manager) newAllocators() (*FairShareAllocator, *FairShareAllocator) {
+	globalCapacity := m.config.GlobalCapacity() - m.config.SuperuserReservedConnections()
+	reservedRatio := m.config.ReservedRatio()
+	minPerUser := m.config.MinCapacityPerUser()
+	regularCapacity := int64(float64(globalCapacity) * (1 - reservedRatio))
+	reservedCapacity := globalCapacity - regularCapacity
+	regularMinPerUser := max(int64(float64(minPerUser)*(1-reservedRatio)), 1)
+	reservedMinPerUser := max(minPerUser-regularMinPerUser, 1)
+
+	// Use configurable minCapacityPerUser as the minimum per-user floor.
+	// This ensures light users always have enough capacity for burst demand.
+	regularAllocator := NewFairShareAllocator(regularCapacity, regularMinPerUser)
+	reservedAllocator := NewFairShareAllocator(reservedCapacity, reservedMinPerUser)
+
+	if maxPerUser := m.config.MaxCapacityPerUser(); maxPerUser > 0 {
+		regularMaxPerUser := max(int64(float64(maxPerUser)*(1-reservedRatio)), 1)
+		regularAllocator.SetMaxPerUser(regularMaxPerUser)
+		reservedAllocator.SetMaxPerUser(max(maxPerUser-regularMaxPerUser, 1))
+	}
+	return regularAllocator, reservedAllocator
+}
+
+// newSuperuserAllocators creates the fair share allocators of the regular and
+// reserved pools of the superuser roles, splitting the superuser reserved
+// connections between them by the reserved ratio, with at least one each.
+func (m *Manager) newSuperuserAllocators() (*FairShareAllocator, *FairShareAllocator) {
+	superuserCapacity := m.config.SuperuserReservedConnections()
+	regularCapacity := max(int64(float64(superuserCapacity)*(1-m.config.ReservedRatio())), 1)
+	reservedCapacity := max(superuserCapacity-regularCapacity, 1)
+	return NewFairShareAllocator(regularCapacity, 1), NewFairShareAllocator(reservedCapacity, 1)
+}
+
+// allocators returns the current fair share allocators of the regular and
+// reserved pools.
+func (m *Manager) allocators() (*FairShareAllocator, *FairShareAllocator) {
+	m.allocatorMu.Lock()
+	defer m.allocatorMu.Unlock()
+	return m.regularAllocator, m.reservedAllocator
+}
+
+// superuserAllocators returns the current fair share allocators of the
+// regular and reserved pools of the superuser roles.
+func (m *Manager) superuserAllocators() (*FairShareAllocator, *FairShareAllocator) {
+	m.allocatorMu.Lock()
+	defer m.allocatorMu.Unlock()
+	return m.superuserRegularAllocator, m.superuserReservedAllocator
+}
+
+// isSuperuser returns whether user is a superuser role with superuser reserved
+// connections.
+func (m *Manager) isSuperuser(user string) bool {
+	return m.config.SuperuserReservedConnections() > 0 && slices.Contains(m.config.SuperuserRoles(), user)
+}
+
+// userPoolConfigs returns the configurations of the regular and reserved
+// pools of user, with the given capacities.
+func (m *Manager) userPoolConfigs(user string, regularCap, reservedCap int64) (*connpool.Config, *connpool.Config) {
+	regularConfig := &connpool.Config{
+		Name:              "regular:" + user,
+		Capacity:          regularCap,
+		IdleTimeout:       m.config.UserRegularIdleTimeout(),
+		MaxLifetime:       m.config.UserRegularMaxLifetime(),
+		MaxLifetimeJitter: m.config.MaxLifetimeJitter(),
+		MinActive:         m.config.UserRegularMinConnections(),
+		ConnectRate:       m.config.ConnectRate(),
+		ConnectBurst:      m.config.ConnectBurst(),
+		MaxWait:           m.config.MaxWait(),
+		MaxWaiters:        m.config.MaxWaitersPerUser(),
+		ConnectionCount:   m.metrics.RegularConnCount(),
+		PendingRequests:   m.metrics.PendingRequests(),
+		WaitTime:          m.metrics.WaitTime(),
+		ConnectErrors:     m.metrics.ConnectErrors(),
+		Logger:            m.logger,
+	}
+	reservedConfig := &connpool.Config{
+		Name:              "reserved:" + user,
+		Capacity:          reservedCap,
+		IdleTimeout:       m.config.UserReservedIdleTimeout(),
+		MaxLifetime:       m.config.UserReservedMaxLifetime(),
+		MaxLifetimeJitter: m.config.MaxLifetimeJitter(),
+		MinActive:         m.config.UserReservedMinConnections(),
+		ConnectRate:       m.config.ConnectRate(),
+		ConnectBurst:      m.config.ConnectBurst(),
+		MaxWait:           m.config.MaxWait(),
+		MaxWaiters:        m.config.MaxWaitersPerUser(),
+		ConnectionCount:   m.metrics.ReservedConnCount(),
+		PendingRequests:   m.metrics.PendingRequests(),
+		WaitTime:          m.metrics.WaitTime(),
+		ConnectErrors:     m.metrics.ConnectErrors(),
+		Logger:            m.logger,
+	}
+	return regularConfig, reservedConfig
+}
+
 // buildClientConfig creates a client.Config with the specified user and password.
 func (m *Manager) buildClientConfig(user, password string) *client.Config {
 	return &client.Config{
-		SocketFile: m.connConfig.SocketFile,
-		Host:       m.connConfig.Host,
-		Port:       m.connConfig.Port,
-		Database:   m.connConfig.Database,
-		User:       user,
-		Password:   password,
+		SocketFile:  m.connConfig.SocketFile,
+		Host:        m.connConfig.Host,
+		Port:        m.connConfig.Port,
+		Database:    m.connConfig.Database,
+		User:        user,
+		Password:    password,
+		SSLMode:     client.SSLMode(m.config.SSLMode()),
+		SSLRootCert: m.config.SSLRootCert(),
+		SSLCert:     m.config.SSLCert(),
+		SSLKey:      m.config.SSLKey(),
 	}
 }
 
@@ -198,6 +367,12 @@ func (m *Manager) createUserPoolSlow(ctx context.Context, user string) (*UserPoo
 	m.createMu.Lock()
 	defer m.createMu.Unlock()
 
+	return m.createUserPoolLocked(ctx, user)
+}
+
+// createUserPoolLocked returns the pool of user, creating it if it does not
+// exist. Caller must hold createMu.
+func (m *Manager) createUserPoolLocked(ctx context.Context, user string) (*UserPool, error) {
 	// Double-check after acquiring lock
 	pools := m.userPoolsSnapshot.Load()
 	if pools != nil {
@@ -218,32 +393,26 @@ func (m *Manager) createUserPoolSlow(ctx context.Context, user string) (*UserPoo
 	reservedRatio := m.config.ReservedRatio()
 	initialRegularCap := max(int64(float64(initialUserPoolCapacity)*(1-reservedRatio)), 1)
 	initialReservedCap := max(int64(float64(initialUserPoolCapacity)*reservedRatio), 1)
+	if m.isSuperuser(user) {
+		superuserRegular, superuserReserved := m.superuserAllocators()
+		initialRegularCap = min(initialRegularCap, superuserRegular.Capacity())
+		initialReservedCap = min(initialReservedCap, superuserReserved.Capacity())
+	}
 
 	// Create new user pool with per-user pool names for metric cardinality.
 	// Note: Including username in pool names enables per-user monitoring but increases
 	// metric cardinality. If this becomes an issue with many users, we can make it configurable.
 	// Create new user pool with initial capacity. The rebalancer will adjust
 	// the capacity based on demand within a few seconds.
+	regularConfig, reservedConfig := m.userPoolConfigs(user, initialRegularCap, initialReservedCap)
 	pool, err := NewUserPool(ctx, &UserPoolConfig{
-		ClientConfig: m.buildClientConfig(user, ""), // Trust auth - no password
-		AdminPool:    m.adminPool,
-		RegularPoolConfig: &connpool.Config{
-			Name:            "regular:" + user,
-			Capacity:        initialRegularCap,
-			IdleTimeout:     m.config.UserRegularIdleTimeout(),
-			MaxLifetime:     m.config.UserRegularMaxLifetime(),
-			ConnectionCount: m.metrics.RegularConnCount(),
-			Logger:          m.logger,
-		},
-		ReservedPoolConfig: &connpool.Config{
-			Name:            "reserved:" + user,
-			Capacity:        initialReservedCap,
-			IdleTimeout:     m.config.UserReservedIdleTimeout(),
-			MaxLifetime:     m.config.UserReservedMaxLifetime(),
-			ConnectionCount: m.metrics.ReservedConnCount(),
-			Logger:          m.logger,
-		},
+		ClientConfig:              m.buildClientConfig(user, ""), // Trust auth - no password
+		AdminPool:                 m.adminPool,
+		Balancer:                  m.balancer,
+		RegularPoolConfig:         regularConfig,
+		ReservedPoolConfig:        reservedConfig,
 		ReservedInactivityTimeout: m.config.UserReservedInactivityTimeout(),
+		WarmupStatements:          m.config.WarmupStatements(),
 		DemandWindow:              m.config.DemandWindow(),
 		RebalanceInterval:         m.config.RebalanceInterval(),
 		Logger:                    m.logger,
@@ -251,6 +420,9 @@ func (m *Manager) createUserPoolSlow(ctx context.Context, user string) (*UserPoo
 	if err != nil {
 		return nil, fmt.Errorf("create user pool for %q: %w", user, err)
 	}
+	if m.paused.Load() {
+		pool.Pause()
+	}
 
 	// Copy-on-write: create new map with the new pool
 	newPools := make(map[string]*UserPool, len(currentPools)+1)
@@ -296,10 +468,119 @@ func (m *Manager) Close() {
 		m.adminPool.Close()
 		m.adminPool = nil
 	}
+	m.balancer = nil
 
 	m.logger.Info("connection pool manager closed")
 }
 
+// --- Configuration ---
+
+// Settings returns the current settings of the pools.
+func (m *Manager) Settings() PoolSettings {
+	return m.config.settings()
+}
+
+// Reconfigure changes the settings of the pools without reopening them. The
+// timeouts, lifetimes, minimum connections and connect rate apply at once to
+// the pools of every user; the global capacity, reserved ratio and per-user
+// bounds apply from the next rebalance.
+func (m *Manager) Reconfigure(ctx context.Context, settings *PoolSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	// Hold createMu so that no user pool is created with the old settings.
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+
+	m.config.setSettings(settings)
+	regularAllocator, reservedAllocator := m.newAllocators()
+	superuserRegularAllocator, superuserReservedAllocator := m.newSuperuserAllocators()
+	m.allocatorMu.Lock()
+	m.regularAllocator, m.reservedAllocator = regularAllocator, reservedAllocator
+	m.superuserRegularAllocator, m.superuserReservedAllocator = superuserRegularAllocator, superuserReservedAllocator
+	m.allocatorMu.Unlock()
+
+	if pools := m.userPoolsSnapshot.Load(); pools != nil {
+		for user, pool := range *pools {
+			pool.Reconfigure(m.userPoolConfigs(user, 0, 0))
+		}
+	}
+
+	m.logger.InfoContext(ctx, "connection pools reconfigured", "settings", settings.Values())
+	return nil
+}
+
+// --- Pause and Drain ---
+
+// Pause stops the user pools from giving out connections until Resume, such
+// as for backend maintenance: new requests wait in the queue of their user, for
+// the max wait at most, while open transactions and sessions keep their
+// connections. The admin pool isn't paused.
+func (m *Manager) Pause(ctx context.Context) {
+	// Hold createMu so that no user pool is created unpaused.
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+
+	m.paused.Store(true)
+	if pools := m.userPoolsSnapshot.Load(); pools != nil {
+		for _, pool := range *pools {
+			pool.Pause()
+		}
+	}
+	m.logger.InfoContext(ctx, "connection pools paused")
+}
+
+// Resume lets the user pools give out connections again, first to the
+// requests that waited while they were paused.
+func (m *Manager) Resume(ctx context.Context) {
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+
+	m.paused.Store(false)
+	if pools := m.userPoolsSnapshot.Load(); pools != nil {
+		for _, pool := range *pools {
+			pool.Resume()
+		}
+	}
+	m.logger.InfoContext(ctx, "connection pools resumed")
+}
+
+// IsPaused returns whether the user pools are paused.
+func (m *Manager) IsPaused() bool {
+	return m.paused.Load()
+}
+
+// Drain pauses the user pools and disconnects them from PostgreSQL: idle
+// connections are closed at once, and connections in use when they're
+// returned, such as when their transactions end. The reserved connections
+// still in use when ctx is done are killed. The pools stay paused until
+// Resume, and reconnect on demand once resumed.
+func (m *Manager) Drain(ctx context.Context) error {
+	m.Pause(ctx)
+
+	pools := m.userPoolsSnapshot.Load()
+	if pools == nil {
+		return nil
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, 0, len(*pools))
+	var errsMu sync.Mutex
+	for user, pool := range *pools {
+		wg.Go(func() {
+			if err := pool.Drain(ctx); err != nil {
+				errsMu.Lock()
+				errs = append(errs, fmt.Errorf("user %q: %w", user, err))
+				errsMu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	m.logger.InfoContext(ctx, "connection pools drained", "errors", len(errs))
+	return errors.Join(errs...)
+}
+
 // InternalUser returns the configured internal user for system queries.
 func (m *Manager) InternalUser() string {
 	return m.config.InternalUser()
@@ -372,6 +653,18 @@ func (m *Manager) GetReservedConn(connID int64, user string) (*reserved.Conn, bo
 	return pool.GetReservedConn(connID)
 }
 
+// --- Listener Connection ---
+
+// NewListenerConn opens a connection as the admin user outside of the pools.
+// It is used to LISTEN on behalf of all clients, so it must not be shared
+// with queries; the caller must close it.
+func (m *Manager) NewListenerConn(ctx context.Context) (*client.Conn, error) {
+	if m.closed.Load() {
+		return nil, errors.New("manager is closed")
+	}
+	return client.Connect(ctx, m.buildClientConfig(m.config.AdminUser(), m.config.AdminPassword()))
+}
+
 // --- Stats ---
 
 // Stats returns statistics for all pools.
@@ -389,9 +682,15 @@ func (m *Manager) Stats() ManagerStats {
 		userPoolStats = make(map[string]UserPoolStats)
 	}
 
+	var backendStats []balancer.HostStats
+	if m.balancer != nil {
+		backendStats = m.balancer.Stats()
+	}
+
 	return ManagerStats{
 		Admin:     m.adminPool.Stats(),
 		UserPools: userPoolStats,
+		Backends:  backendStats,
 	}
 }
 
@@ -399,6 +698,7 @@ func (m *Manager) Stats() ManagerStats {
 type ManagerStats struct {
 	Admin     connpool.PoolStats       // Shared admin pool stats
 	UserPools map[string]UserPoolStats // Per-user pool stats
+	Backends  []balancer.HostStats     // Backend hosts of the user pools (nil = local PostgreSQL)
 }
 
 // IsClosed returns whether the manager has been closed.
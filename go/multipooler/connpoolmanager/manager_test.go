@@ -19,6 +19,7 @@ import (
 	"log/slog"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -26,8 +27,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/multigres/multigres/go/common/fakepgserver"
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/multipooler/pools/balancer"
 	"github.com/multigres/multigres/go/multipooler/pools/reserved"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
 	"github.com/multigres/multigres/go/tools/viperutil"
 )
 
@@ -597,3 +602,252 @@ func BenchmarkManager_GetRegularConn_ExistingUser(b *testing.B) {
 		conn.Recycle()
 	}
 }
+
+func TestManager_Reconfigure(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.SetNeverFail(true)
+
+	manager := newTestManager(t, server)
+	defer manager.Close()
+
+	ctx := context.Background()
+
+	// Create a user pool with the initial settings.
+	conn, err := manager.GetRegularConn(ctx, "testuser")
+	require.NoError(t, err)
+	conn.Recycle()
+
+	settings := manager.Settings()
+	require.NoError(t, settings.Set("connpool-global-capacity", "200"))
+	require.NoError(t, settings.Set("connpool-user-regular-idle-timeout", "1m"))
+	require.NoError(t, settings.Set("connpool-user-reserved-max-lifetime", "2h"))
+	require.NoError(t, settings.Set("connpool-connect-rate", "50"))
+	require.NoError(t, settings.Set("connpool-max-wait", "50s"))
+	require.NoError(t, manager.Reconfigure(ctx, &settings))
+
+	assert.Equal(t, settings, manager.Settings())
+	regularAllocator, reservedAllocator := manager.allocators()
+	assert.Equal(t, int64(200), regularAllocator.Capacity()+reservedAllocator.Capacity())
+
+	// The existing user pool picks up the new settings.
+	pool := (*manager.userPoolsSnapshot.Load())["testuser"]
+	require.NotNil(t, pool)
+	assert.Equal(t, time.Minute, pool.regularPool.InnerPool().IdleTimeout())
+	assert.Equal(t, 2*time.Hour, pool.reservedPool.InnerPool().InnerPool().MaxLifetime())
+	assert.Equal(t, 50*time.Second, pool.regularPool.InnerPool().MaxWait())
+
+	// Invalid settings are rejected and leave the settings unchanged.
+	invalid := manager.Settings()
+	require.NoError(t, invalid.Set("connpool-reserved-ratio", "2"))
+	require.Error(t, manager.Reconfigure(ctx, &invalid))
+	assert.Equal(t, settings, manager.Settings())
+}
+
+func TestManager_PrefillUsers(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.AddQuery("SELECT 1", &sqltypes.Result{})
+
+	config := NewConfig(viperutil.NewRegistry())
+	config.prefillUsers.Set([]string{"app", "reporting"})
+	config.warmupStatements.Set([]string{"SELECT 1"})
+	config.userRegularMinConnections.Set(2)
+
+	manager := config.NewManager(slog.Default())
+	manager.Open(context.Background(), &ConnectionConfig{
+		SocketFile: server.ClientConfig().SocketFile,
+		Host:       server.ClientConfig().Host,
+		Port:       server.ClientConfig().Port,
+		Database:   server.ClientConfig().Database,
+	})
+	defer manager.Close()
+
+	// The pools of the prefill users exist before any connection request.
+	assert.Equal(t, 2, manager.UserPoolCount())
+	assert.True(t, manager.HasUserPool("app"))
+	assert.True(t, manager.HasUserPool("reporting"))
+
+	// Each regular pool opens its min connections, warmed up, in the background.
+	pools := *manager.userPoolsSnapshot.Load()
+	require.Eventually(t, func() bool {
+		return pools["app"].regularPool.Stats().Active == 2 &&
+			pools["reporting"].regularPool.Stats().Active == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 4, server.GetQueryCalledNum("SELECT 1"))
+
+	// Prefilled pools are not garbage collected when inactive.
+	pools["app"].lastActivity.Store(time.Now().Add(-10 * time.Minute).UnixNano())
+	manager.garbageCollectInactivePools(context.Background())
+	assert.True(t, manager.HasUserPool("app"))
+}
+
+func TestManager_PoolExhausted(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.SetNeverFail(true)
+
+	config := NewConfig(viperutil.NewRegistry())
+	config.maxWait.Set(50 * time.Millisecond)
+	config.maxWaitersPerUser.Set(1)
+
+	manager := config.NewManager(slog.Default())
+	manager.Open(context.Background(), &ConnectionConfig{
+		SocketFile: server.ClientConfig().SocketFile,
+		Host:       server.ClientConfig().Host,
+		Port:       server.ClientConfig().Port,
+		Database:   server.ClientConfig().Database,
+	})
+	defer manager.Close()
+
+	ctx := context.Background()
+
+	conn, err := manager.GetRegularConn(ctx, "testuser")
+	require.NoError(t, err)
+	defer conn.Recycle()
+	pool := (*manager.userPoolsSnapshot.Load())["testuser"]
+	require.NoError(t, pool.SetCapacity(ctx, 1, 1))
+
+	// A request that waits longer than the max wait fails as resource exhausted.
+	_, err = manager.GetRegularConn(ctx, "testuser")
+	require.Error(t, err)
+	assert.Equal(t, mtrpcpb.Code_RESOURCE_EXHAUSTED, mterrors.Code(err))
+
+	// So does a request beyond the user's queue limit.
+	waiting := make(chan error, 1)
+	go func() {
+		_, err := manager.GetRegularConn(ctx, "testuser")
+		waiting <- err
+	}()
+	require.Eventually(t, func() bool {
+		return pool.regularPool.Stats().Waiting == 1
+	}, time.Second, time.Millisecond)
+	_, err = manager.GetRegularConn(ctx, "testuser")
+	assert.Equal(t, mtrpcpb.Code_RESOURCE_EXHAUSTED, mterrors.Code(err))
+	assert.Contains(t, err.Error(), "queue is full")
+	assert.Equal(t, mtrpcpb.Code_RESOURCE_EXHAUSTED, mterrors.Code(<-waiting))
+}
+
+func TestManager_PauseDrainResume(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.SetNeverFail(true)
+
+	manager := newTestManager(t, server)
+	defer manager.Close()
+
+	ctx := context.Background()
+
+	conn, err := manager.GetRegularConn(ctx, "testuser")
+	require.NoError(t, err)
+	conn.Recycle()
+	rc, err := manager.NewReservedConn(ctx, nil, "testuser")
+	require.NoError(t, err)
+	defer rc.Release(reserved.ReleaseKill)
+
+	manager.Pause(ctx)
+	assert.True(t, manager.IsPaused())
+
+	// New requests wait for the pools to resume, for existing and new users.
+	for _, user := range []string{"testuser", "newuser"} {
+		waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		_, err = manager.GetRegularConn(waitCtx, user)
+		cancel()
+		assert.Equal(t, mtrpcpb.Code_RESOURCE_EXHAUSTED, mterrors.Code(err), user)
+	}
+
+	// The open reserved connection is still usable, until the drain kills it.
+	_, ok := manager.GetReservedConn(rc.ConnID, "testuser")
+	assert.True(t, ok)
+	drainCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.NoError(t, manager.Drain(drainCtx))
+	_, ok = manager.GetReservedConn(rc.ConnID, "testuser")
+	assert.False(t, ok)
+
+	pool := (*manager.userPoolsSnapshot.Load())["testuser"]
+	assert.Equal(t, int64(0), pool.regularPool.InnerPool().Active())
+	assert.Equal(t, int64(0), pool.reservedPool.InnerPool().InnerPool().Active())
+
+	// Once resumed, the pools reconnect on demand.
+	manager.Resume(ctx)
+	assert.False(t, manager.IsPaused())
+	conn, err = manager.GetRegularConn(ctx, "newuser")
+	require.NoError(t, err)
+	conn.Recycle()
+}
+
+func TestManager_BackendHosts(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	replica1 := fakepgserver.New(t)
+	defer replica1.Close()
+	replica2 := fakepgserver.New(t)
+	defer replica2.Close()
+
+	config := NewConfig(viperutil.NewRegistry())
+	config.backendHosts.Set([]string{replica1.Address(), replica2.Address()})
+
+	manager := config.NewManager(slog.Default())
+	manager.Open(context.Background(), &ConnectionConfig{
+		Host:     server.ClientConfig().Host,
+		Port:     server.ClientConfig().Port,
+		Database: server.ClientConfig().Database,
+	})
+	defer manager.Close()
+
+	ctx := context.Background()
+
+	// The regular and reserved connections are spread over the replicas.
+	conn, err := manager.GetRegularConn(ctx, "testuser")
+	require.NoError(t, err)
+	defer conn.Recycle()
+	rc, err := manager.NewReservedConn(ctx, nil, "testuser")
+	require.NoError(t, err)
+	defer rc.Release(reserved.ReleaseKill)
+
+	assert.Equal(t, []balancer.HostStats{
+		{Address: replica1.Address(), Open: 1},
+		{Address: replica2.Address(), Open: 1},
+	}, manager.Stats().Backends)
+}
+
+func TestManager_BackendHosts_Invalid(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+
+	config := NewConfig(viperutil.NewRegistry())
+	config.backendHosts.Set([]string{"replica1"})
+
+	manager := config.NewManager(slog.Default())
+	manager.Open(context.Background(), &ConnectionConfig{
+		Host:     server.ClientConfig().Host,
+		Port:     server.ClientConfig().Port,
+		Database: server.ClientConfig().Database,
+	})
+	defer manager.Close()
+
+	// Invalid backend hosts leave the user pools on the local PostgreSQL.
+	assert.Nil(t, manager.balancer)
+	conn, err := manager.GetRegularConn(context.Background(), "testuser")
+	require.NoError(t, err)
+	conn.Recycle()
+	assert.Nil(t, manager.Stats().Backends)
+}
+
+func TestManager_BuildClientConfig_SSL(t *testing.T) {
+	config := NewConfig(viperutil.NewRegistry())
+	config.sslMode.Set("verify-full")
+	config.sslRootCert.Set("/etc/ssl/ca.pem")
+	config.sslCert.Set("/etc/ssl/client.pem")
+	config.sslKey.Set("/etc/ssl/client.key")
+
+	manager := config.NewManager(slog.Default())
+	manager.connConfig = &ConnectionConfig{Host: "db.example.com", Port: 5432, Database: "postgres"}
+
+	clientConfig := manager.buildClientConfig("testuser", "")
+	assert.Equal(t, client.SSLModeVerifyFull, clientConfig.SSLMode)
+	assert.Equal(t, "/etc/ssl/ca.pem", clientConfig.SSLRootCert)
+	assert.Equal(t, "/etc/ssl/client.pem", clientConfig.SSLCert)
+	assert.Equal(t, "/etc/ssl/client.key", clientConfig.SSLKey)
+}
@@ -30,6 +30,16 @@ type Metrics struct {
 
 	// reservedConnCount tracks PostgreSQL connection states for reserved pools
 	reservedConnCount connpool.ConnectionCount
+
+	// pendingRequests tracks the requests waiting for a connection in all pools
+	pendingRequests connpool.PendingRequests
+
+	// waitTime tracks how long requests waited for a connection in all pools
+	waitTime connpool.WaitTime
+
+	// connectErrors counts the connections to PostgreSQL that failed to open
+	// in all pools
+	connectErrors connpool.ConnectErrors
 }
 
 // NewMetrics initializes OpenTelemetry metrics for connection pool management.
@@ -61,6 +71,31 @@ func NewMetrics() (*Metrics, error) {
 		m.reservedConnCount = reservedCount
 	}
 
+	// PendingRequests and WaitTime for all pools (the pool name tells them apart)
+	pendingRequests, err := connpool.NewPendingRequests(meter)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("PendingRequests: %w", err))
+		m.pendingRequests = connpool.PendingRequests{} // Use zero value (noop) on error
+	} else {
+		m.pendingRequests = pendingRequests
+	}
+
+	waitTime, err := connpool.NewWaitTime(meter)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("WaitTime: %w", err))
+		m.waitTime = connpool.WaitTime{} // Use zero value (noop) on error
+	} else {
+		m.waitTime = waitTime
+	}
+
+	connectErrors, err := connpool.NewConnectErrors(meter)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("ConnectErrors: %w", err))
+		m.connectErrors = connpool.ConnectErrors{} // Use zero value (noop) on error
+	} else {
+		m.connectErrors = connectErrors
+	}
+
 	if len(errs) > 0 {
 		return m, errors.Join(errs...)
 	}
@@ -77,3 +112,18 @@ func (m *Metrics) RegularConnCount() connpool.ConnectionCount {
 func (m *Metrics) ReservedConnCount() connpool.ConnectionCount {
 	return m.reservedConnCount
 }
+
+// PendingRequests returns the PendingRequests metric for all pools.
+func (m *Metrics) PendingRequests() connpool.PendingRequests {
+	return m.pendingRequests
+}
+
+// WaitTime returns the WaitTime metric for all pools.
+func (m *Metrics) WaitTime() connpool.WaitTime {
+	return m.waitTime
+}
+
+// ConnectErrors returns the ConnectErrors metric for all pools.
+func (m *Metrics) ConnectErrors() connpool.ConnectErrors {
+	return m.connectErrors
+}
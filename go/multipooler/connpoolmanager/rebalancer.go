@@ -17,6 +17,7 @@ package connpoolmanager
 import (
 	"context"
 	"maps"
+	"slices"
 	"time"
 )
 
@@ -60,17 +61,33 @@ func (m *Manager) rebalance(ctx context.Context) {
 		return
 	}
 
-	// 1. Collect demands from all user pools
+	// 1. Collect demands from all user pools, apart from the superuser roles,
+	// which share the superuser reserved connections
 	regularDemands := make(map[string]int64, len(*pools))
 	reservedDemands := make(map[string]int64, len(*pools))
+	superuserRegularDemands := make(map[string]int64)
+	superuserReservedDemands := make(map[string]int64)
 	for user, pool := range *pools {
+		if m.isSuperuser(user) {
+			superuserRegularDemands[user] = pool.RegularDemand()
+			superuserReservedDemands[user] = pool.ReservedDemand()
+			continue
+		}
 		regularDemands[user] = pool.RegularDemand()
 		reservedDemands[user] = pool.ReservedDemand()
 	}
 
 	// 2. Compute fair allocations
-	regularAllocs := m.regularAllocator.Allocate(regularDemands)
-	reservedAllocs := m.reservedAllocator.Allocate(reservedDemands)
+	regularAllocator, reservedAllocator := m.allocators()
+	regularAllocs := regularAllocator.Allocate(regularDemands)
+	reservedAllocs := reservedAllocator.Allocate(reservedDemands)
+	superuserRegularAllocator, superuserReservedAllocator := m.superuserAllocators()
+	maps.Copy(regularAllocs, superuserRegularAllocator.Allocate(superuserRegularDemands))
+	maps.Copy(reservedAllocs, superuserReservedAllocator.Allocate(superuserReservedDemands))
+
+	// Log the demands of all users alike.
+	maps.Copy(regularDemands, superuserRegularDemands)
+	maps.Copy(reservedDemands, superuserReservedDemands)
 
 	// 3. Apply new capacities to each pool
 	for user, pool := range *pools {
@@ -98,7 +115,7 @@ func (m *Manager) rebalance(ctx context.Context) {
 }
 
 // garbageCollectInactivePools removes user pools that have been inactive
-// longer than the configured timeout.
+// longer than the configured timeout. The pools of the prefill users are kept.
 func (m *Manager) garbageCollectInactivePools(ctx context.Context) {
 	inactiveTimeout := m.config.InactiveTimeout()
 	if inactiveTimeout <= 0 {
@@ -115,8 +132,9 @@ func (m *Manager) garbageCollectInactivePools(ctx context.Context) {
 
 	// Find inactive pools
 	var inactiveUsers []string
+	prefillUsers := m.config.PrefillUsers()
 	for user, pool := range *pools {
-		if pool.LastActivity() < cutoff {
+		if pool.LastActivity() < cutoff && !slices.Contains(prefillUsers, user) {
 			inactiveUsers = append(inactiveUsers, user)
 		}
 	}
@@ -240,6 +240,48 @@ func TestManager_AllocatorCapacities(t *testing.T) {
 	assert.Equal(t, int64(20), manager.reservedAllocator.Capacity())
 }
 
+func TestManager_SuperuserReservedConnections(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.SetNeverFail(true)
+
+	config := NewConfig(viperutil.NewRegistry())
+	config.globalCapacity.Set(20)
+	config.superuserReservedConnections.Set(4)
+
+	manager := config.NewManager(slog.Default())
+	manager.Open(context.Background(), &ConnectionConfig{
+		SocketFile: server.ClientConfig().SocketFile,
+		Host:       server.ClientConfig().Host,
+		Port:       server.ClientConfig().Port,
+		Database:   server.ClientConfig().Database,
+	})
+	defer manager.Close()
+
+	// The superuser reserved connections are held back from the other users:
+	// regular 80% of 16 = 12, reserved 4; superusers regular 80% of 4 = 3, reserved 1.
+	assert.Equal(t, int64(12), manager.regularAllocator.Capacity())
+	assert.Equal(t, int64(4), manager.reservedAllocator.Capacity())
+	assert.Equal(t, int64(3), manager.superuserRegularAllocator.Capacity())
+	assert.Equal(t, int64(1), manager.superuserReservedAllocator.Capacity())
+
+	ctx := context.Background()
+	for _, user := range []string{"app", "postgres"} {
+		conn, err := manager.GetRegularConn(ctx, user)
+		require.NoError(t, err)
+		conn.Recycle()
+	}
+	manager.rebalance(ctx)
+
+	// The application gets all the capacity but the superuser reserved
+	// connections, which go to the superuser role.
+	pools := *manager.userPoolsSnapshot.Load()
+	assert.Equal(t, int64(12), pools["app"].Stats().Regular.Capacity)
+	assert.Equal(t, int64(4), pools["app"].reservedPool.InnerPool().InnerPool().Capacity())
+	assert.Equal(t, int64(3), pools["postgres"].Stats().Regular.Capacity)
+	assert.Equal(t, int64(1), pools["postgres"].reservedPool.InnerPool().InnerPool().Capacity())
+}
+
 func TestManager_DemandTrackersCreated(t *testing.T) {
 	server := fakepgserver.New(t)
 	defer server.Close()
@@ -0,0 +1,187 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connpoolmanager
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// PoolSettings are the settings of the pools that can be changed while they
+// are open, with Manager.Reconfigure. Each is named after its flag.
+type PoolSettings struct {
+	GlobalCapacity     int64
+	ReservedRatio      float64
+	MinCapacityPerUser int64
+	MaxCapacityPerUser int64
+
+	UserRegularIdleTimeout     time.Duration
+	UserRegularMaxLifetime     time.Duration
+	UserRegularMinConnections  int64
+	UserReservedIdleTimeout    time.Duration
+	UserReservedMaxLifetime    time.Duration
+	UserReservedMinConnections int64
+
+	MaxLifetimeJitter float64
+	ConnectRate       float64
+	ConnectBurst      int64
+
+	MaxWait           time.Duration
+	MaxWaitersPerUser int64
+
+	SuperuserReservedConnections int64
+}
+
+// fields returns the settings by the name of their flag.
+func (s *PoolSettings) fields() map[string]any {
+	return map[string]any{
+		"connpool-global-capacity":                &s.GlobalCapacity,
+		"connpool-reserved-ratio":                 &s.ReservedRatio,
+		"connpool-min-capacity-per-user":          &s.MinCapacityPerUser,
+		"connpool-max-capacity-per-user":          &s.MaxCapacityPerUser,
+		"connpool-user-regular-idle-timeout":      &s.UserRegularIdleTimeout,
+		"connpool-user-regular-max-lifetime":      &s.UserRegularMaxLifetime,
+		"connpool-user-regular-min-connections":   &s.UserRegularMinConnections,
+		"connpool-user-reserved-idle-timeout":     &s.UserReservedIdleTimeout,
+		"connpool-user-reserved-max-lifetime":     &s.UserReservedMaxLifetime,
+		"connpool-user-reserved-min-connections":  &s.UserReservedMinConnections,
+		"connpool-max-lifetime-jitter":            &s.MaxLifetimeJitter,
+		"connpool-connect-rate":                   &s.ConnectRate,
+		"connpool-connect-burst":                  &s.ConnectBurst,
+		"connpool-max-wait":                       &s.MaxWait,
+		"connpool-max-waiters-per-user":           &s.MaxWaitersPerUser,
+		"connpool-superuser-reserved-connections": &s.SuperuserReservedConnections,
+	}
+}
+
+// Names returns the names of the settings, sorted.
+func (s *PoolSettings) Names() []string {
+	return slices.Sorted(maps.Keys(s.fields()))
+}
+
+// Set sets the setting named after its flag to value, given like on the
+// command line.
+func (s *PoolSettings) Set(name, value string) error {
+	field, ok := s.fields()[name]
+	if !ok {
+		return fmt.Errorf("unknown pool setting %q", name)
+	}
+	var err error
+	switch f := field.(type) {
+	case *int64:
+		*f, err = strconv.ParseInt(value, 10, 64)
+	case *float64:
+		*f, err = strconv.ParseFloat(value, 64)
+	case *time.Duration:
+		*f, err = time.ParseDuration(value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q for pool setting %q: %w", value, name, err)
+	}
+	return nil
+}
+
+// Values returns the settings by the name of their flag, formatted like on
+// the command line.
+func (s *PoolSettings) Values() map[string]string {
+	values := make(map[string]string)
+	for name, field := range s.fields() {
+		switch f := field.(type) {
+		case *int64:
+			values[name] = strconv.FormatInt(*f, 10)
+		case *float64:
+			values[name] = strconv.FormatFloat(*f, 'g', -1, 64)
+		case *time.Duration:
+			values[name] = f.String()
+		}
+	}
+	return values
+}
+
+// Validate returns an error if the settings cannot be applied.
+func (s *PoolSettings) Validate() error {
+	var errs []error
+	fields := s.fields()
+	for _, name := range s.Names() {
+		negative := false
+		switch f := fields[name].(type) {
+		case *int64:
+			negative = *f < 0
+		case *float64:
+			negative = *f < 0
+		case *time.Duration:
+			negative = *f < 0
+		}
+		if negative {
+			errs = append(errs, fmt.Errorf("pool setting %q cannot be negative", name))
+		}
+	}
+	if s.ReservedRatio > 1 {
+		errs = append(errs, errors.New("pool setting \"connpool-reserved-ratio\" must be between 0 and 1"))
+	}
+	if s.SuperuserReservedConnections > 0 && s.SuperuserReservedConnections >= s.GlobalCapacity {
+		errs = append(errs, errors.New("pool setting \"connpool-superuser-reserved-connections\" must be less than \"connpool-global-capacity\""))
+	}
+	if s.MaxCapacityPerUser > 0 && s.MaxCapacityPerUser < s.MinCapacityPerUser {
+		errs = append(errs, errors.New("pool setting \"connpool-max-capacity-per-user\" cannot be less than \"connpool-min-capacity-per-user\""))
+	}
+	return errors.Join(errs...)
+}
+
+// settings returns the current settings of the pools.
+func (c *Config) settings() PoolSettings {
+	return PoolSettings{
+		GlobalCapacity:               c.GlobalCapacity(),
+		ReservedRatio:                c.ReservedRatio(),
+		MinCapacityPerUser:           c.MinCapacityPerUser(),
+		MaxCapacityPerUser:           c.MaxCapacityPerUser(),
+		UserRegularIdleTimeout:       c.UserRegularIdleTimeout(),
+		UserRegularMaxLifetime:       c.UserRegularMaxLifetime(),
+		UserRegularMinConnections:    c.UserRegularMinConnections(),
+		UserReservedIdleTimeout:      c.UserReservedIdleTimeout(),
+		UserReservedMaxLifetime:      c.UserReservedMaxLifetime(),
+		UserReservedMinConnections:   c.UserReservedMinConnections(),
+		MaxLifetimeJitter:            c.MaxLifetimeJitter(),
+		ConnectRate:                  c.ConnectRate(),
+		ConnectBurst:                 c.ConnectBurst(),
+		MaxWait:                      c.MaxWait(),
+		MaxWaitersPerUser:            c.MaxWaitersPerUser(),
+		SuperuserReservedConnections: c.SuperuserReservedConnections(),
+	}
+}
+
+// setSettings changes the settings of the pools.
+func (c *Config) setSettings(s *PoolSettings) {
+	c.globalCapacity.Set(s.GlobalCapacity)
+	c.reservedRatio.Set(s.ReservedRatio)
+	c.minCapacityPerUser.Set(s.MinCapacityPerUser)
+	c.maxCapacityPerUser.Set(s.MaxCapacityPerUser)
+	c.userRegularIdleTimeout.Set(s.UserRegularIdleTimeout)
+	c.userRegularMaxLifetime.Set(s.UserRegularMaxLifetime)
+	c.userRegularMinConnections.Set(s.UserRegularMinConnections)
+	c.userReservedIdleTimeout.Set(s.UserReservedIdleTimeout)
+	c.userReservedMaxLifetime.Set(s.UserReservedMaxLifetime)
+	c.userReservedMinConnections.Set(s.UserReservedMinConnections)
+	c.maxLifetimeJitter.Set(s.MaxLifetimeJitter)
+	c.connectRate.Set(s.ConnectRate)
+	c.connectBurst.Set(s.ConnectBurst)
+	c.maxWait.Set(s.MaxWait)
+	c.maxWaitersPerUser.Set(s.MaxWaitersPerUser)
+	c.superuserReservedConnections.Set(s.SuperuserReservedConnections)
+}
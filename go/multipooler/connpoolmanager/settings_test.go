@@ -0,0 +1,69 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connpoolmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/tools/viperutil"
+)
+
+func TestPoolSettings_SetAndValues(t *testing.T) {
+	settings := NewConfig(viperutil.NewRegistry()).settings()
+	assert.Equal(t, "100", settings.Values()["connpool-global-capacity"])
+	assert.Equal(t, "5m0s", settings.Values()["connpool-user-regular-idle-timeout"])
+	assert.Len(t, settings.Names(), len(settings.Values()))
+
+	require.NoError(t, settings.Set("connpool-global-capacity", "200"))
+	require.NoError(t, settings.Set("connpool-reserved-ratio", "0.5"))
+	require.NoError(t, settings.Set("connpool-user-reserved-max-lifetime", "10m"))
+	assert.Equal(t, int64(200), settings.GlobalCapacity)
+	assert.Equal(t, 0.5, settings.ReservedRatio)
+	assert.Equal(t, 10*time.Minute, settings.UserReservedMaxLifetime)
+	assert.Equal(t, "10m0s", settings.Values()["connpool-user-reserved-max-lifetime"])
+
+	assert.ErrorContains(t, settings.Set("connpool-admin-user", "root"), "unknown pool setting")
+	assert.ErrorContains(t, settings.Set("connpool-global-capacity", "many"), "invalid value")
+	assert.ErrorContains(t, settings.Set("connpool-user-regular-idle-timeout", "10"), "invalid value")
+}
+
+func TestPoolSettings_Validate(t *testing.T) {
+	valid := NewConfig(viperutil.NewRegistry()).settings()
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name    string
+		setting string
+		value   string
+		wantErr string
+	}{
+		{"negative capacity", "connpool-global-capacity", "-1", "cannot be negative"},
+		{"negative timeout", "connpool-user-regular-idle-timeout", "-1s", "cannot be negative"},
+		{"ratio above 1", "connpool-reserved-ratio", "1.5", "must be between 0 and 1"},
+		{"max below min", "connpool-max-capacity-per-user", "5", "cannot be less than"},
+		{"superuser reserved above capacity", "connpool-superuser-reserved-connections", "100", "must be less than"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := valid
+			require.NoError(t, settings.Set(tt.setting, tt.value))
+			assert.ErrorContains(t, settings.Validate(), tt.wantErr)
+		})
+	}
+}
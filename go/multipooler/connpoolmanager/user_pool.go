@@ -23,12 +23,15 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/common/pgprotocol/client"
 	"github.com/multigres/multigres/go/multipooler/connstate"
 	"github.com/multigres/multigres/go/multipooler/pools/admin"
+	"github.com/multigres/multigres/go/multipooler/pools/balancer"
 	"github.com/multigres/multigres/go/multipooler/pools/connpool"
 	"github.com/multigres/multigres/go/multipooler/pools/regular"
 	"github.com/multigres/multigres/go/multipooler/pools/reserved"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
 )
 
 // UserPool manages connection pools for a specific user.
@@ -62,6 +65,10 @@ type UserPoolConfig struct {
 	// AdminPool is the shared admin pool for kill operations.
 	AdminPool *admin.Pool
 
+	// Balancer, if set, spreads the connections of the regular and reserved
+	// pools over its backend hosts instead of the server of ClientConfig.
+	Balancer *balancer.Balancer
+
 	// RegularPoolConfig is the configuration for the regular connection pool.
 	RegularPoolConfig *connpool.Config
 
@@ -72,6 +79,10 @@ type UserPoolConfig struct {
 	// before being killed. This is typically more aggressive (e.g., 30s) than pool idle timeout.
 	ReservedInactivityTimeout time.Duration
 
+	// WarmupStatements are run on every new connection of the regular and
+	// reserved pools before it is used.
+	WarmupStatements []string
+
 	// DemandWindow is how far back to consider when calculating peak demand.
 	// Set to 0 to disable demand tracking.
 	// Example: 30s means "allocate based on peak demand over the last 30 seconds"
@@ -97,9 +108,11 @@ func NewUserPool(ctx context.Context, config *UserPoolConfig) (*UserPool, error)
 
 	// Create regular pool for this user
 	regularPool := regular.NewPool(ctx, &regular.PoolConfig{
-		ClientConfig:   config.ClientConfig,
-		ConnPoolConfig: config.RegularPoolConfig,
-		AdminPool:      config.AdminPool,
+		ClientConfig:     config.ClientConfig,
+		ConnPoolConfig:   config.RegularPoolConfig,
+		AdminPool:        config.AdminPool,
+		WarmupStatements: config.WarmupStatements,
+		Balancer:         config.Balancer,
 	})
 	regularPool.Open()
 
@@ -111,9 +124,11 @@ func NewUserPool(ctx context.Context, config *UserPoolConfig) (*UserPool, error)
 		InactivityTimeout: config.ReservedInactivityTimeout,
 		Logger:            logger,
 		RegularPoolConfig: &regular.PoolConfig{
-			ClientConfig:   config.ClientConfig,
-			ConnPoolConfig: config.ReservedPoolConfig,
-			AdminPool:      config.AdminPool,
+			ClientConfig:     config.ClientConfig,
+			ConnPoolConfig:   config.ReservedPoolConfig,
+			AdminPool:        config.AdminPool,
+			WarmupStatements: config.WarmupStatements,
+			Balancer:         config.Balancer,
 		},
 	})
 
@@ -204,21 +219,43 @@ func (p *UserPool) ReservedDemand() int64 {
 // The connection is already authenticated as the pool's user.
 func (p *UserPool) GetRegularConn(ctx context.Context) (regular.PooledConn, error) {
 	p.touchActivity()
-	return p.regularPool.Get(ctx)
+	conn, err := p.regularPool.Get(ctx)
+	if err != nil {
+		return nil, p.exhaustedError(err)
+	}
+	return conn, nil
 }
 
 // GetRegularConnWithSettings acquires a regular connection with the given settings.
 // The connection is already authenticated as the pool's user.
 func (p *UserPool) GetRegularConnWithSettings(ctx context.Context, settings *connstate.Settings) (regular.PooledConn, error) {
 	p.touchActivity()
-	return p.regularPool.GetWithSettings(ctx, settings)
+	conn, err := p.regularPool.GetWithSettings(ctx, settings)
+	if err != nil {
+		return nil, p.exhaustedError(err)
+	}
+	return conn, nil
 }
 
 // NewReservedConn creates a new reserved connection for transactions or portal operations.
 // The connection is already authenticated as the pool's user.
 func (p *UserPool) NewReservedConn(ctx context.Context, settings *connstate.Settings) (*reserved.Conn, error) {
 	p.touchActivity()
-	return p.reservedPool.NewConn(ctx, settings)
+	conn, err := p.reservedPool.NewConn(ctx, settings)
+	if err != nil {
+		return nil, p.exhaustedError(err)
+	}
+	return conn, nil
+}
+
+// exhaustedError gives the RESOURCE_EXHAUSTED code to err if the request
+// waited too long for a connection or the user's queue was full, so that the
+// gateway answers the client with SQLSTATE 53300 (too_many_connections).
+func (p *UserPool) exhaustedError(err error) error {
+	if errors.Is(err, connpool.ErrTimeout) || errors.Is(err, connpool.ErrQueueFull) {
+		return mterrors.Errorf(mtrpcpb.Code_RESOURCE_EXHAUSTED, "no connection available for user %q: %v", p.username, err)
+	}
+	return err
 }
 
 // GetReservedConn retrieves an existing reserved connection by ID.
@@ -296,6 +333,56 @@ func (p *UserPool) SetCapacity(ctx context.Context, regularCap, reservedCap int6
 	return nil
 }
 
+// Reconfigure applies the settings of regularConfig and reservedConfig to the
+// user's pools, leaving their capacities to the rebalancer (see
+// connpool.Pool.Reconfigure).
+func (p *UserPool) Reconfigure(regularConfig, reservedConfig *connpool.Config) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+	p.regularPool.InnerPool().Reconfigure(regularConfig)
+	p.reservedPool.InnerPool().InnerPool().Reconfigure(reservedConfig)
+}
+
+// Pause stops both pools from giving out connections until Resume: new
+// requests wait in the queue, while the reserved connections already given out,
+// such as those of open transactions, are used as usual.
+func (p *UserPool) Pause() {
+	p.regularPool.InnerPool().Pause()
+	p.reservedPool.InnerPool().InnerPool().Pause()
+}
+
+// Resume lets both pools give out connections again.
+func (p *UserPool) Resume() {
+	p.regularPool.InnerPool().Resume()
+	p.reservedPool.InnerPool().InnerPool().Resume()
+}
+
+// Drain pauses both pools and closes their connections as they're returned,
+// waiting until ctx is done. The reserved connections still in use then are
+// killed, and an error is returned if any connection is still in use.
+func (p *UserPool) Drain(ctx context.Context) error {
+	p.Pause()
+
+	var wg sync.WaitGroup
+	var regularErr, reservedErr error
+	wg.Go(func() { regularErr = p.regularPool.InnerPool().Drain(ctx) })
+	wg.Go(func() { reservedErr = p.reservedPool.InnerPool().InnerPool().Drain(ctx) })
+	wg.Wait()
+
+	if reservedErr != nil {
+		killCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		killed := p.reservedPool.KillAll(killCtx)
+		p.logger.WarnContext(ctx, "killed reserved connections still in use after drain", "count", killed)
+		reservedErr = p.reservedPool.InnerPool().InnerPool().Drain(killCtx)
+	}
+	return errors.Join(regularErr, reservedErr)
+}
+
 // UserPoolStats holds statistics for a user's pools.
 type UserPoolStats struct {
 	Username       string
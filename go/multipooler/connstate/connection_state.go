@@ -281,14 +281,41 @@ func (s *Settings) ApplyQuery() string {
 			b.WriteString("; ")
 		}
 		b.WriteString("SET SESSION ")
-		b.WriteString(k)
-		b.WriteString(" = '")
-		b.WriteString(s.Vars[k])
-		b.WriteString("'")
+		b.WriteString(quoteSettingName(k))
+		b.WriteString(" = ")
+		b.WriteString(quoteSettingValue(s.Vars[k]))
 	}
 	return b.String()
 }
 
+// quoteSettingName returns name as it can appear in a SET statement. The
+// names of settings come from clients (including their startup messages),
+// so anything but a plain, possibly qualified, lower-case name is quoted.
+func quoteSettingName(name string) string {
+	plain := name != ""
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' && r != '.' {
+			plain = false
+			break
+		}
+	}
+	if plain {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteSettingValue returns value as a string literal. An escape string is
+// used when it has backslashes, so that it reads the same whatever the
+// connection's standard_conforming_strings.
+func quoteSettingValue(value string) string {
+	if !strings.Contains(value, `\`) {
+		return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return "E'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
 // ResetQuery returns the SQL to reset these settings on a connection.
 func (s *Settings) ResetQuery() string {
 	if s == nil || len(s.Vars) == 0 {
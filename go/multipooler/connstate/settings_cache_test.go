@@ -235,6 +235,43 @@ func TestSettingsQueries(t *testing.T) {
 	assert.Equal(t, "RESET ALL", s.ResetQuery())
 }
 
+func TestSettingsApplyQueryQuoting(t *testing.T) {
+	cache := NewSettingsCache(testCacheSize)
+
+	tests := []struct {
+		name     string
+		vars     map[string]string
+		expected string
+	}{
+		{
+			name:     "qualified name",
+			vars:     map[string]string{"app.user_id": "42"},
+			expected: "SET SESSION app.user_id = '42'",
+		},
+		{
+			name:     "single quote in value",
+			vars:     map[string]string{"application_name": "it's'; DROP TABLE t; --"},
+			expected: "SET SESSION application_name = 'it''s''; DROP TABLE t; --'",
+		},
+		{
+			name:     "backslash in value",
+			vars:     map[string]string{"application_name": `a\'b`},
+			expected: `SET SESSION application_name = E'a\\''b'`,
+		},
+		{
+			name:     "unusual name",
+			vars:     map[string]string{`x = 1; DROP TABLE "t"`: "v"},
+			expected: `SET SESSION "x = 1; DROP TABLE ""t""" = 'v'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cache.GetOrCreate(tt.vars).ApplyQuery())
+		})
+	}
+}
+
 func TestSettingsPointerEqualityForPooling(t *testing.T) {
 	cache := NewSettingsCache(testCacheSize)
 
@@ -22,13 +22,17 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/queryservice"
 	"github.com/multigres/multigres/go/common/sqltypes"
 	"github.com/multigres/multigres/go/multipooler/connpoolmanager"
+	"github.com/multigres/multigres/go/multipooler/notifier"
 	"github.com/multigres/multigres/go/multipooler/pools/regular"
 	"github.com/multigres/multigres/go/multipooler/pools/reserved"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	"github.com/multigres/multigres/go/pb/query"
 )
 
@@ -39,6 +43,15 @@ type Executor struct {
 	logger       *slog.Logger
 	poolManager  connpoolmanager.PoolManager
 	consolidator *preparedstatement.Consolidator
+	notifier     *notifier.Notifier
+
+	// replicationLag reports how far this pooler's PostgreSQL is behind the
+	// primary. It is nil until SetReplicationLagFunc is called.
+	replicationLag func(context.Context) (time.Duration, error)
+
+	// primaryFence reports whether this pooler may run statements sent to
+	// the primary. It is nil until SetPrimaryFenceFunc is called.
+	primaryFence func() error
 }
 
 // NewExecutor creates a new Executor instance.
@@ -47,7 +60,59 @@ func NewExecutor(logger *slog.Logger, poolManager connpoolmanager.PoolManager) *
 		logger:       logger,
 		poolManager:  poolManager,
 		consolidator: preparedstatement.NewConsolidator(),
+		notifier: notifier.NewNotifier(logger, func(ctx context.Context) (notifier.Conn, error) {
+			conn, err := poolManager.NewListenerConn(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return conn, nil
+		}),
+	}
+}
+
+// SetReplicationLagFunc sets the function reporting the replication lag of
+// this pooler, used to refuse queries sent to a replica with a maximum
+// replication lag it does not meet. It must be called before serving.
+func (e *Executor) SetReplicationLagFunc(fn func(context.Context) (time.Duration, error)) {
+	e.replicationLag = fn
+}
+
+// SetPrimaryFenceFunc sets the function checking that this pooler holds
+// the primary lease of its shard, used to refuse statements sent to a
+// primary that was fenced off. It must be called before serving.
+func (e *Executor) SetPrimaryFenceFunc(fn func() error) {
+	e.primaryFence = fn
+}
+
+// checkPrimaryFence returns the error of the primary fence if the query
+// targets the primary, so that a stale primary does not accept writes.
+func (e *Executor) checkPrimaryFence(target *query.Target) error {
+	if e.primaryFence == nil || target.GetPoolerType() != clustermetadatapb.PoolerType_PRIMARY {
+		return nil
 	}
+	return e.primaryFence()
+}
+
+// checkReplicationLag returns MT13003 if the query targets a replica and
+// carries a maximum replication lag this pooler exceeds or cannot tell.
+// Queries on a reserved connection are not checked: the connection was
+// already chosen by an earlier statement.
+func (e *Executor) checkReplicationLag(ctx context.Context, target *query.Target, options *query.ExecuteOptions) error {
+	maxLag := time.Duration(options.GetMaxReplicationLagMs()) * time.Millisecond
+	if maxLag == 0 || target.PoolerType != clustermetadatapb.PoolerType_REPLICA || options.GetReservedConnectionId() > 0 {
+		return nil
+	}
+	if e.replicationLag == nil {
+		return mterrors.MT13003("replication lag is unknown")
+	}
+	lag, err := e.replicationLag(ctx)
+	if err != nil {
+		return mterrors.MT13003(fmt.Sprintf("replication lag is unknown: %v", err))
+	}
+	if lag > maxLag {
+		return mterrors.MT13003(fmt.Sprintf("replication lag %v exceeds the maximum of %v", lag, maxLag))
+	}
+	return nil
 }
 
 // ExecuteQuery implements queryservice.QueryService.
@@ -66,6 +131,13 @@ func (e *Executor) ExecuteQuery(ctx context.Context, target *query.Target, sql s
 		"user", user,
 		"query", sql)
 
+	if err := e.checkPrimaryFence(target); err != nil {
+		return nil, err
+	}
+	if err := e.checkReplicationLag(ctx, target, options); err != nil {
+		return nil, err
+	}
+
 	// Check if we should use an existing reserved connection
 	if options != nil && options.ReservedConnectionId > 0 {
 		reservedConn, _ := e.poolManager.GetReservedConn(int64(options.ReservedConnectionId), user)
@@ -75,6 +147,7 @@ func (e *Executor) ExecuteQuery(ctx context.Context, target *query.Target, sql s
 
 		results, err := reservedConn.Query(ctx, sql)
 		if err != nil {
+			releaseIfClosed(reservedConn)
 			return nil, fmt.Errorf("failed to execute query: %w", err)
 		}
 
@@ -111,6 +184,15 @@ func (e *Executor) ExecuteQuery(ctx context.Context, target *query.Target, sql s
 	return results[0], nil
 }
 
+// releaseIfClosed releases a reserved connection whose backend connection
+// was closed by a failed query, e.g. after a FATAL error, so that the session
+// does not keep a dead connection until its inactivity timeout.
+func releaseIfClosed(reservedConn *reserved.Conn) {
+	if reservedConn.IsClosed() {
+		reservedConn.Release(reserved.ReleaseError)
+	}
+}
+
 // StreamExecute executes a query and streams results back via callback.
 // This implements the queryservice.QueryService interface.
 // If ReservedConnectionId is set in options, uses that reserved connection instead.
@@ -133,6 +215,13 @@ func (e *Executor) StreamExecute(
 		"user", user,
 		"query", sql)
 
+	if err := e.checkPrimaryFence(target); err != nil {
+		return err
+	}
+	if err := e.checkReplicationLag(ctx, target, options); err != nil {
+		return err
+	}
+
 	// Check if we should use an existing reserved connection
 	if options != nil && options.ReservedConnectionId > 0 {
 		reservedConn, _ := e.poolManager.GetReservedConn(int64(options.ReservedConnectionId), user)
@@ -141,6 +230,7 @@ func (e *Executor) StreamExecute(
 		}
 
 		if err := reservedConn.QueryStreaming(ctx, sql, callback); err != nil {
+			releaseIfClosed(reservedConn)
 			return fmt.Errorf("query execution failed: %w", err)
 		}
 		return nil
@@ -170,6 +260,7 @@ func (e *Executor) StreamExecute(
 // Close closes the executor and releases resources.
 // Note: The poolManager is managed by the caller (QueryPoolerServer), not closed here.
 func (e *Executor) Close(_ context.Context) error {
+	e.notifier.Close()
 	return nil
 }
 
@@ -213,6 +304,13 @@ func (e *Executor) PortalStreamExecute(
 		"portal", portal.Name,
 		"max_rows", maxRows)
 
+	if err := e.checkPrimaryFence(target); err != nil {
+		return queryservice.ReservedState{}, err
+	}
+	if err := e.checkReplicationLag(ctx, target, options); err != nil {
+		return queryservice.ReservedState{}, err
+	}
+
 	// Convert formats from int32 to int16
 	paramFormats := int32ToInt16Slice(portal.ParamFormats)
 	resultFormats := int32ToInt16Slice(portal.ResultFormats)
@@ -278,8 +376,9 @@ func (e *Executor) portalExecuteWithReserved(
 	} else {
 		// Portal completed, release this portal's reservation
 		shouldRelease := reservedConn.ReleasePortal(portal.Name)
-		// If no more portal reservations and not in a transaction, release the connection
-		if shouldRelease && !reservedConn.IsInTransaction() {
+		// If no more portal reservations and not in a transaction or pinned
+		// to the session, release the connection
+		if shouldRelease && !reservedConn.IsInTransaction() && !reservedConn.IsPinnedToSession() {
 			reservedConn.Release(reserved.ReleasePortalComplete)
 		}
 	}
@@ -445,6 +544,10 @@ func (e *Executor) CopyReady(
 		"query", copyQuery,
 		"user", user)
 
+	if err := e.checkPrimaryFence(target); err != nil {
+		return 0, nil, queryservice.ReservedState{}, err
+	}
+
 	var reservedConn *reserved.Conn
 	var err error
 
@@ -592,8 +695,11 @@ func (e *Executor) CopyFinalize(
 		RowsAffected: rowsAffected,
 	}
 
-	// Success - release connection back to pool for reuse
-	reservedConn.Release(reserved.ReleasePortalComplete)
+	// Success - release connection back to pool for reuse, unless the
+	// session pinned it
+	if !reservedConn.IsPinnedToSession() {
+		reservedConn.Release(reserved.ReleasePortalComplete)
+	}
 
 	return result, nil
 }
@@ -649,7 +755,7 @@ func (e *Executor) CopyAbort(
 	// If write or read failed, connection might be in bad state - close it
 	if writeFailed || readErr != nil {
 		reservedConn.Close()
-	} else {
+	} else if !reservedConn.IsPinnedToSession() {
 		// Clean abort - release connection back to pool
 		reservedConn.Release(reserved.ReleasePortalComplete)
 	}
@@ -657,6 +763,103 @@ func (e *Executor) CopyAbort(
 	return nil
 }
 
+// CopyOut executes a COPY TO STDOUT operation and streams its data.
+// The whole COPY runs within this call, so unlike COPY FROM STDIN it needs no
+// reserved connection of its own; it uses the reserved connection in options
+// if one is set (e.g. inside a transaction) and a pooled connection otherwise.
+func (e *Executor) CopyOut(
+	ctx context.Context,
+	target *query.Target,
+	copyQuery string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+	onData func(ctx context.Context, data []byte) error,
+) (*sqltypes.Result, error) {
+	user := e.getUserFromOptions(options)
+
+	e.logger.DebugContext(ctx, "executing COPY TO STDOUT",
+		"query", copyQuery,
+		"user", user)
+
+	onResponse := func(format int16, columnFormats []int16) error {
+		return onReady(ctx, format, columnFormats)
+	}
+	onChunk := func(data []byte) error {
+		return onData(ctx, data)
+	}
+
+	var conn *regular.Conn
+	if options != nil && options.ReservedConnectionId > 0 {
+		reservedConn, _ := e.poolManager.GetReservedConn(int64(options.ReservedConnectionId), user)
+		if reservedConn == nil {
+			return nil, fmt.Errorf("reserved connection %d not found for user %s", options.ReservedConnectionId, user)
+		}
+		conn = reservedConn.Conn()
+	} else {
+		var settings map[string]string
+		if options != nil {
+			settings = options.SessionSettings
+		}
+		pooled, err := e.poolManager.GetRegularConnWithSettings(ctx, settings, user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get connection for user %s: %w", user, err)
+		}
+		defer pooled.Recycle()
+		conn = pooled.Conn
+	}
+
+	result, err := conn.CopyOut(ctx, copyQuery, onResponse, onChunk)
+	if err != nil {
+		return nil, fmt.Errorf("COPY TO STDOUT failed: %w", err)
+	}
+
+	e.logger.DebugContext(ctx, "COPY TO STDOUT successful",
+		"rows_affected", result.RowsAffected,
+		"command_tag", result.CommandTag)
+
+	return result, nil
+}
+
+// StreamNotifications listens on a LISTEN/NOTIFY channel and streams the
+// notifications raised on it until ctx is cancelled.
+// All listeners share the notifier's dedicated connection, since pooled
+// connections cannot hold a LISTEN between queries.
+func (e *Executor) StreamNotifications(
+	ctx context.Context,
+	target *query.Target,
+	channel string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context) error,
+	callback func(ctx context.Context, notification *query.Notification) error,
+) error {
+	e.logger.DebugContext(ctx, "listening for notifications",
+		"channel", channel,
+		"user", e.getUserFromOptions(options))
+
+	sub, err := e.notifier.Subscribe(ctx, channel)
+	if err != nil {
+		return fmt.Errorf("failed to listen on channel %s: %w", channel, err)
+	}
+	defer sub.Close()
+
+	if err := onReady(ctx); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case n := <-sub.Notifications():
+			if err := callback(ctx, &query.Notification{Pid: n.PID, Channel: n.Channel, Payload: n.Payload}); err != nil {
+				return err
+			}
+		case <-sub.Done():
+			return fmt.Errorf("stopped listening on channel %s: %w", channel, sub.Err())
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
 // getUserFromOptions extracts the user from ExecuteOptions.
 // Returns "postgres" as default if no user is specified.
 func (e *Executor) getUserFromOptions(options *query.ExecuteOptions) string {
@@ -681,3 +884,82 @@ func int32ToInt16Slice(in []int32) []int16 {
 
 // Ensure Executor implements queryservice.QueryService
 var _ queryservice.QueryService = (*Executor)(nil)
+
+// releasePinnedSessionStateQuery releases the session state that pins a
+// connection to its client session: advisory locks, temporary relations and
+// cursors.
+const releasePinnedSessionStateQuery = "SELECT pg_catalog.pg_advisory_unlock_all(); DISCARD TEMP; CLOSE ALL"
+
+// ReserveConnection pins a reserved connection to the client session.
+// If options.ReservedConnectionId is set, that connection is pinned;
+// otherwise a new reserved connection is created with the session settings.
+func (e *Executor) ReserveConnection(
+	ctx context.Context,
+	target *query.Target,
+	options *query.ExecuteOptions,
+) (queryservice.ReservedState, error) {
+	user := e.getUserFromOptions(options)
+
+	var reservedConn *reserved.Conn
+	if options != nil && options.ReservedConnectionId > 0 {
+		reservedConn, _ = e.poolManager.GetReservedConn(int64(options.ReservedConnectionId), user)
+		if reservedConn == nil {
+			return queryservice.ReservedState{}, fmt.Errorf("reserved connection %d not found for user %s", options.ReservedConnectionId, user)
+		}
+	} else {
+		var settings map[string]string
+		if options != nil {
+			settings = options.SessionSettings
+		}
+		var err error
+		reservedConn, err = e.poolManager.NewReservedConn(ctx, settings, user)
+		if err != nil {
+			return queryservice.ReservedState{}, fmt.Errorf("failed to create reserved connection for user %s: %w", user, err)
+		}
+	}
+	reservedConn.PinToSession()
+
+	e.logger.DebugContext(ctx, "reserved connection pinned to session",
+		"user", user,
+		"conn_id", reservedConn.ConnID)
+
+	return queryservice.ReservedState{
+		ReservedConnectionId: uint64(reservedConn.ConnID),
+	}, nil
+}
+
+// ReleaseReservedConnection unpins a reserved connection from the client
+// session. The session-level advisory locks, temporary relations and cursors
+// it holds are released so they cannot leak to the next user of the connection. The connection returns to
+// the pool unless a transaction or portal still needs it.
+func (e *Executor) ReleaseReservedConnection(
+	ctx context.Context,
+	target *query.Target,
+	options *query.ExecuteOptions,
+) error {
+	if options == nil || options.ReservedConnectionId == 0 {
+		return errors.New("options.ReservedConnectionId is required for ReleaseReservedConnection")
+	}
+
+	user := e.getUserFromOptions(options)
+	reservedConn, ok := e.poolManager.GetReservedConn(int64(options.ReservedConnectionId), user)
+	if !ok || reservedConn == nil {
+		// Already released, e.g. because the connection was killed.
+		e.logger.DebugContext(ctx, "pinned connection already released",
+			"conn_id", options.ReservedConnectionId)
+		return nil
+	}
+
+	if _, err := reservedConn.Query(ctx, releasePinnedSessionStateQuery); err != nil {
+		// The session state must not survive on a pooled connection; drop it.
+		reservedConn.Close()
+		return fmt.Errorf("failed to release session state of pinned connection: %w", err)
+	}
+	reservedConn.UnpinFromSession()
+
+	if reservedConn.IsInTransaction() || reservedConn.IsReservedForPortal() {
+		return nil
+	}
+	reservedConn.Release(reserved.ReleaseUnpin)
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestCheckReplicationLag(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	replica := &query.Target{PoolerType: clustermetadatapb.PoolerType_REPLICA}
+	primary := &query.Target{PoolerType: clustermetadatapb.PoolerType_PRIMARY}
+	lag := func(d time.Duration, err error) func(context.Context) (time.Duration, error) {
+		return func(context.Context) (time.Duration, error) { return d, err }
+	}
+
+	tests := []struct {
+		name    string
+		lag     func(context.Context) (time.Duration, error)
+		target  *query.Target
+		options *query.ExecuteOptions
+		lagging bool
+	}{
+		{name: "no maximum", lag: lag(time.Hour, nil), target: replica, options: &query.ExecuteOptions{}},
+		{name: "nil options", lag: lag(time.Hour, nil), target: replica},
+		{name: "within maximum", lag: lag(time.Second, nil), target: replica, options: &query.ExecuteOptions{MaxReplicationLagMs: 5000}},
+		{name: "exceeds maximum", lag: lag(10*time.Second, nil), target: replica, options: &query.ExecuteOptions{MaxReplicationLagMs: 5000}, lagging: true},
+		{name: "unknown lag", lag: lag(0, errors.New("no heartbeat")), target: replica, options: &query.ExecuteOptions{MaxReplicationLagMs: 5000}, lagging: true},
+		{name: "no lag func", target: replica, options: &query.ExecuteOptions{MaxReplicationLagMs: 5000}, lagging: true},
+		{name: "primary", lag: lag(10*time.Second, nil), target: primary, options: &query.ExecuteOptions{MaxReplicationLagMs: 5000}},
+		{name: "reserved connection", lag: lag(10*time.Second, nil), target: replica, options: &query.ExecuteOptions{MaxReplicationLagMs: 5000, ReservedConnectionId: 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewExecutor(logger, nil)
+			if tt.lag != nil {
+				e.SetReplicationLagFunc(tt.lag)
+			}
+			err := e.checkReplicationLag(context.Background(), tt.target, tt.options)
+			if tt.lagging {
+				assert.True(t, mterrors.IsReplicaLagging(err), "got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckPrimaryFence(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	replica := &query.Target{PoolerType: clustermetadatapb.PoolerType_REPLICA}
+	primary := &query.Target{PoolerType: clustermetadatapb.PoolerType_PRIMARY}
+	fenced := func() error { return mterrors.MT13004("no primary lease held for term 3") }
+
+	e := NewExecutor(logger, nil)
+	assert.NoError(t, e.checkPrimaryFence(primary), "no fence func")
+
+	e.SetPrimaryFenceFunc(fenced)
+	assert.NoError(t, e.checkPrimaryFence(replica))
+	err := e.checkPrimaryFence(primary)
+	assert.True(t, mterrors.IsError(err, "MT13004"), "got %v", err)
+	assert.True(t, mterrors.IsFailover(err), "a fenced primary is a cluster event")
+}
@@ -267,7 +267,12 @@ func (s *managerService) Backup(ctx context.Context, req *multipoolermanagerdata
 
 // RestoreFromBackup restores from a backup
 func (s *managerService) RestoreFromBackup(ctx context.Context, req *multipoolermanagerdatapb.RestoreFromBackupRequest) (*multipoolermanagerdatapb.RestoreFromBackupResponse, error) {
-	err := s.manager.RestoreFromBackup(ctx, req.BackupId)
+	target := manager.RecoveryTarget{LSN: req.TargetLsn}
+	if req.TargetTime != nil {
+		target.Time = req.TargetTime.AsTime()
+	}
+
+	err := s.manager.RestoreFromBackup(ctx, req.BackupId, target)
 	if err != nil {
 		return nil, mterrors.ToGRPC(err)
 	}
@@ -287,6 +292,27 @@ func (s *managerService) GetBackups(ctx context.Context, req *multipoolermanager
 	}, nil
 }
 
+// VerifyBackup checks the integrity of an existing backup
+func (s *managerService) VerifyBackup(ctx context.Context, req *multipoolermanagerdatapb.VerifyBackupRequest) (*multipoolermanagerdatapb.VerifyBackupResponse, error) {
+	output, err := s.manager.VerifyBackup(ctx, req.BackupId)
+	if err != nil {
+		return nil, mterrors.ToGRPC(err)
+	}
+
+	return &multipoolermanagerdatapb.VerifyBackupResponse{
+		Output: output,
+	}, nil
+}
+
+// DeleteBackup removes a backup from the repository
+func (s *managerService) DeleteBackup(ctx context.Context, req *multipoolermanagerdatapb.DeleteBackupRequest) (*multipoolermanagerdatapb.DeleteBackupResponse, error) {
+	if err := s.manager.DeleteBackup(ctx, req.BackupId); err != nil {
+		return nil, mterrors.ToGRPC(err)
+	}
+
+	return &multipoolermanagerdatapb.DeleteBackupResponse{}, nil
+}
+
 // GetBackupByJobId retrieves a backup by its job_id annotation
 func (s *managerService) GetBackupByJobId(ctx context.Context, req *multipoolermanagerdatapb.GetBackupByJobIdRequest) (*multipoolermanagerdatapb.GetBackupByJobIdResponse, error) {
 	backup, err := s.manager.GetBackupByJobId(ctx, req.JobId)
@@ -326,3 +352,30 @@ func (s *managerService) RewindToSource(ctx context.Context, req *multipoolerman
 func (s *managerService) SetMonitor(ctx context.Context, req *multipoolermanagerdatapb.SetMonitorRequest) (*multipoolermanagerdatapb.SetMonitorResponse, error) {
 	return s.manager.SetMonitor(ctx, req)
 }
+
+// UpgradePostgres upgrades PostgreSQL to a new major version
+func (s *managerService) UpgradePostgres(ctx context.Context, req *multipoolermanagerdatapb.UpgradePostgresRequest) (*multipoolermanagerdatapb.UpgradePostgresResponse, error) {
+	resp, err := s.manager.UpgradePostgres(ctx, req)
+	if err != nil {
+		return nil, mterrors.ToGRPC(err)
+	}
+	return resp, nil
+}
+
+// FinishPostgresUpgrade finalizes or rolls back the last major version upgrade
+func (s *managerService) FinishPostgresUpgrade(ctx context.Context, req *multipoolermanagerdatapb.FinishPostgresUpgradeRequest) (*multipoolermanagerdatapb.FinishPostgresUpgradeResponse, error) {
+	resp, err := s.manager.FinishPostgresUpgrade(ctx, req)
+	if err != nil {
+		return nil, mterrors.ToGRPC(err)
+	}
+	return resp, nil
+}
+
+// InstallExtension creates or updates an extension
+func (s *managerService) InstallExtension(ctx context.Context, req *multipoolermanagerdatapb.InstallExtensionRequest) (*multipoolermanagerdatapb.InstallExtensionResponse, error) {
+	resp, err := s.manager.InstallExtension(ctx, req)
+	if err != nil {
+		return nil, mterrors.ToGRPC(err)
+	}
+	return resp, nil
+}
@@ -19,18 +19,49 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/queryservice"
 	"github.com/multigres/multigres/go/common/servenv"
 	"github.com/multigres/multigres/go/common/sqltypes"
 	"github.com/multigres/multigres/go/multipooler/poolerserver"
 	"github.com/multigres/multigres/go/multipooler/pools/admin"
+	"github.com/multigres/multigres/go/multipooler/schematracker"
 	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
 	"github.com/multigres/multigres/go/pb/query"
 )
 
+// queryError returns the error of a query with the gRPC code of the error
+// with a code it wraps, such as RESOURCE_EXHAUSTED when no pooled connection
+// became available in time, so that the gateway can tell it apart. A
+// PostgreSQL error gets the code of its SQLSTATE and carries its diagnostic
+// to the gateway. Other errors are returned unchanged.
+func queryError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var diag *sqltypes.PgDiagnostic
+	if errors.As(err, &diag) {
+		return mterrors.ToGRPC(err)
+	}
+	var withCode mterrors.ErrorWithCode
+	if !errors.As(err, &withCode) {
+		return err
+	}
+	return status.Error(codes.Code(withCode.ErrorCode()), err.Error())
+}
+
+// defaultHealthInterval is how often StreamHealth sends a health report when
+// the caller does not ask for an interval.
+const defaultHealthInterval = time.Second
+
 // poolerService is the gRPC wrapper for MultiPooler
 type poolerService struct {
 	multipoolerpb.UnimplementedMultiPoolerServiceServer
@@ -67,7 +98,7 @@ func (s *poolerService) StreamExecute(req *multipoolerpb.StreamExecuteRequest, s
 		return stream.Send(response)
 	})
 
-	return err
+	return queryError(err)
 }
 
 // ExecuteQuery executes a SQL query and returns the result
@@ -83,7 +114,7 @@ func (s *poolerService) ExecuteQuery(ctx context.Context, req *multipoolerpb.Exe
 	// Execute the query
 	res, err := executor.ExecuteQuery(ctx, req.Target, req.Query, req.Options)
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 	return &multipoolerpb.ExecuteQueryResponse{
 		Result: res.ToProto(),
@@ -152,7 +183,7 @@ func (s *poolerService) Describe(ctx context.Context, req *multipoolerpb.Describ
 	// Call the executor's Describe method
 	desc, err := executor.Describe(ctx, req.Target, req.PreparedStatement, req.Portal, req.Options)
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 
 	return &multipoolerpb.DescribeResponse{
@@ -188,7 +219,7 @@ func (s *poolerService) PortalStreamExecute(req *multipoolerpb.PortalStreamExecu
 		// Note: When PortalStreamExecute returns an error, it also releases any reserved
 		// connection and returns an empty ReservedState. So we don't need to send a
 		// reserved connection ID in the error case.
-		return err
+		return queryError(err)
 	}
 
 	// Send final response with reserved connection ID if one was created
@@ -204,6 +235,7 @@ func (s *poolerService) PortalStreamExecute(req *multipoolerpb.PortalStreamExecu
 // CopyBidiExecute handles bidirectional streaming operations (e.g., COPY commands).
 // The gateway sends: INITIATE → DATA (repeated) → DONE/FAIL
 // The pooler responds: READY → DATA (for COPY TO) → RESULT/ERROR
+// For COPY TO STDOUT the gateway only sends INITIATE, with copy_out set.
 func (s *poolerService) CopyBidiExecute(stream multipoolerpb.MultiPoolerService_CopyBidiExecuteServer) error {
 	ctx := stream.Context()
 
@@ -223,6 +255,10 @@ func (s *poolerService) CopyBidiExecute(stream multipoolerpb.MultiPoolerService_
 		return status.Errorf(codes.Unavailable, "executor not initialized: %v", err)
 	}
 
+	if req.CopyOut {
+		return s.copyOut(stream, exec, req)
+	}
+
 	// Phase 1: INITIATE - Send COPY command and get reserved connection
 	format, columnFormats, reservedState, err := exec.CopyReady(ctx, req.Target, req.Query, req.Options)
 	if err != nil {
@@ -335,3 +371,160 @@ func (s *poolerService) CopyBidiExecute(stream multipoolerpb.MultiPoolerService_
 		}
 	}
 }
+
+// copyOut runs a COPY TO STDOUT for CopyBidiExecute. It sends READY once
+// PostgreSQL has started the COPY, a DATA response for each chunk of data and
+// finally RESULT. gRPC flow control paces PostgreSQL: while the gateway is not
+// receiving, Send blocks and the connection stops reading from PostgreSQL.
+func (s *poolerService) copyOut(
+	stream multipoolerpb.MultiPoolerService_CopyBidiExecuteServer,
+	exec queryservice.QueryService,
+	req *multipoolerpb.CopyBidiExecuteRequest,
+) error {
+	result, err := exec.CopyOut(stream.Context(), req.Target, req.Query, req.Options,
+		func(ctx context.Context, format int16, columnFormats []int16) error {
+			columnFormats32 := make([]int32, len(columnFormats))
+			for i, f := range columnFormats {
+				columnFormats32[i] = int32(f)
+			}
+			return stream.Send(&multipoolerpb.CopyBidiExecuteResponse{
+				Phase:         multipoolerpb.CopyBidiExecuteResponse_READY,
+				Format:        int32(format),
+				ColumnFormats: columnFormats32,
+			})
+		},
+		func(ctx context.Context, data []byte) error {
+			return stream.Send(&multipoolerpb.CopyBidiExecuteResponse{
+				Phase: multipoolerpb.CopyBidiExecuteResponse_DATA,
+				Data:  data,
+			})
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&multipoolerpb.CopyBidiExecuteResponse{
+		Phase:  multipoolerpb.CopyBidiExecuteResponse_RESULT,
+		Result: result.ToProto(),
+	})
+}
+
+// StreamNotifications listens on a LISTEN/NOTIFY channel and streams the
+// notifications raised on it. An empty response is sent first, once the
+// pooler is listening, so the gateway knows no later notification is missed.
+func (s *poolerService) StreamNotifications(req *multipoolerpb.StreamNotificationsRequest, stream multipoolerpb.MultiPoolerService_StreamNotificationsServer) error {
+	if req.Channel == "" {
+		return status.Error(codes.InvalidArgument, "channel is required")
+	}
+
+	executor, err := s.pooler.Executor()
+	if err != nil {
+		return err
+	}
+
+	return executor.StreamNotifications(stream.Context(), req.Target, req.Channel, req.Options,
+		func(ctx context.Context) error {
+			return stream.Send(&multipoolerpb.StreamNotificationsResponse{})
+		},
+		func(ctx context.Context, notification *query.Notification) error {
+			return stream.Send(&multipoolerpb.StreamNotificationsResponse{
+				Notification: notification,
+			})
+		},
+	)
+}
+
+// ReserveConnection pins a reserved connection to the client session.
+func (s *poolerService) ReserveConnection(ctx context.Context, req *multipoolerpb.ReserveConnectionRequest) (*multipoolerpb.ReserveConnectionResponse, error) {
+	executor, err := s.pooler.Executor()
+	if err != nil {
+		return nil, err
+	}
+
+	reservedState, err := executor.ReserveConnection(ctx, req.Target, req.Options)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &multipoolerpb.ReserveConnectionResponse{
+		ReservedConnectionId: reservedState.ReservedConnectionId,
+		PoolerId:             reservedState.PoolerID,
+	}, nil
+}
+
+// ReleaseReservedConnection unpins a reserved connection from the client session.
+func (s *poolerService) ReleaseReservedConnection(ctx context.Context, req *multipoolerpb.ReleaseReservedConnectionRequest) (*multipoolerpb.ReleaseReservedConnectionResponse, error) {
+	executor, err := s.pooler.Executor()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := executor.ReleaseReservedConnection(ctx, req.Target, req.Options); err != nil {
+		return nil, err
+	}
+	return &multipoolerpb.ReleaseReservedConnectionResponse{}, nil
+}
+
+// StreamHealth streams health reports of the pooler, one right away, one
+// as soon as its serving state changes, and otherwise one per interval,
+// until the caller cancels the stream.
+func (s *poolerService) StreamHealth(req *multipoolerpb.StreamHealthRequest, stream multipoolerpb.MultiPoolerService_StreamHealthServer) error {
+	interval := defaultHealthInterval
+	if req.IntervalMs > 0 {
+		interval = time.Duration(req.IntervalMs) * time.Millisecond
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ctx := stream.Context()
+	for {
+		// Taken before the report, so that no change is missed.
+		changed := s.pooler.HealthChanged()
+		if err := stream.Send(s.healthReport(ctx)); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+			ticker.Reset(interval)
+		case <-ticker.C:
+		}
+	}
+}
+
+// healthReport returns the current health of the pooler.
+func (s *poolerService) healthReport(ctx context.Context) *multipoolerpb.StreamHealthResponse {
+	report := &multipoolerpb.StreamHealthResponse{
+		Serving:       s.pooler.IsServing(),
+		ServingStatus: s.pooler.ServingStatus(),
+	}
+	if err := s.pooler.IsHealthy(); err != nil {
+		report.QueryServiceError = err.Error()
+	}
+	lag, err := s.pooler.ReplicationLag(ctx)
+	if err != nil {
+		report.ReplicationLagError = err.Error()
+	} else {
+		report.ReplicationLagMs = uint64(lag.Milliseconds())
+	}
+	return report
+}
+
+// StreamSchema streams the schema of the tables of the pooler's database:
+// all of it right away, then the tables changed since, once per interval
+// until the caller cancels the stream.
+func (s *poolerService) StreamSchema(req *multipoolerpb.StreamSchemaRequest, stream multipoolerpb.MultiPoolerService_StreamSchemaServer) error {
+	interval := schematracker.DefaultInterval
+	if req.IntervalMs > 0 {
+		interval = time.Duration(req.IntervalMs) * time.Millisecond
+	}
+
+	if _, err := s.pooler.Executor(); err != nil {
+		return err
+	}
+
+	return schematracker.Watch(stream.Context(), s.pooler.InternalQueryService(), interval, stream.Send)
+}
@@ -16,13 +16,24 @@ package grpcpoolerservice
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/multipooler/poolerserver"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
 	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
 )
 
@@ -71,3 +82,99 @@ func TestGetAuthCredentials_Validation(t *testing.T) {
 		assert.Contains(t, st.Message(), "pooler not initialized")
 	})
 }
+
+func TestQueryError(t *testing.T) {
+	assert.NoError(t, queryError(nil))
+
+	// The code of a wrapped error with a code is kept.
+	exhausted := mterrors.New(mtrpcpb.Code_RESOURCE_EXHAUSTED, "timed out waiting for a connection")
+	err := queryError(fmt.Errorf("failed to get connection for user app: %w", exhausted))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "failed to get connection for user app")
+
+	// PostgreSQL errors get the code of their SQLSTATE, and carry their
+	// diagnostic.
+	undefined := sqlstate.NewError(sqlstate.UndefinedTable).Msg(`relation "t" does not exist`).Err()
+	err = queryError(fmt.Errorf("query failed: %w", undefined))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, mterrors.FromGRPC(err), &diag)
+	assert.Equal(t, sqlstate.UndefinedTable, diag.Code)
+
+	// Other errors are unchanged.
+	plain := errors.New("syntax error")
+	assert.Equal(t, plain, queryError(plain))
+}
+
+func TestHealthReport(t *testing.T) {
+	pooler := poolerserver.NewQueryPoolerServer(slog.Default(), nil)
+	srv := &poolerService{pooler: pooler}
+
+	// Without a replication lag function, the lag is unknown.
+	report := srv.healthReport(context.Background())
+	assert.False(t, report.Serving)
+	assert.Equal(t, clustermetadatapb.PoolerServingStatus_NOT_SERVING, report.ServingStatus)
+	assert.Equal(t, "replication lag not tracked", report.ReplicationLagError)
+	// Without a pool manager, the query service is not ready.
+	assert.Equal(t, "executor not initialized", report.QueryServiceError)
+
+	require.NoError(t, pooler.StartServiceForTests())
+	pooler.SetReplicationLagFunc(func(context.Context) (time.Duration, error) {
+		return 1500 * time.Millisecond, nil
+	})
+	report = srv.healthReport(context.Background())
+	assert.True(t, report.Serving)
+	assert.Equal(t, clustermetadatapb.PoolerServingStatus_SERVING, report.ServingStatus)
+	assert.Equal(t, uint64(1500), report.ReplicationLagMs)
+	assert.Empty(t, report.ReplicationLagError)
+
+	pooler.SetReplicationLagFunc(func(context.Context) (time.Duration, error) {
+		return 0, errors.New("no heartbeat yet")
+	})
+	report = srv.healthReport(context.Background())
+	assert.Equal(t, "no heartbeat yet", report.ReplicationLagError)
+	assert.Zero(t, report.ReplicationLagMs)
+}
+
+// healthStream is a StreamHealth server stream handing the reports it is
+// sent to a channel.
+type healthStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	reports chan *multipoolerpb.StreamHealthResponse
+}
+
+func (s *healthStream) Context() context.Context { return s.ctx }
+
+func (s *healthStream) Send(report *multipoolerpb.StreamHealthResponse) error {
+	s.reports <- report
+	return nil
+}
+
+func TestStreamHealth_ReportsServingChanges(t *testing.T) {
+	pooler := poolerserver.NewQueryPoolerServer(slog.Default(), nil)
+	srv := &poolerService{pooler: pooler}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &healthStream{ctx: ctx, reports: make(chan *multipoolerpb.StreamHealthResponse)}
+	done := make(chan error)
+	go func() {
+		done <- srv.StreamHealth(&multipoolerpb.StreamHealthRequest{IntervalMs: uint64(time.Hour.Milliseconds())}, stream)
+	}()
+
+	report := <-stream.reports
+	assert.False(t, report.Serving)
+
+	// The change is reported without waiting for the interval.
+	require.NoError(t, pooler.StartServiceForTests())
+	select {
+	case report = <-stream.reports:
+		assert.True(t, report.Serving)
+		assert.Equal(t, clustermetadatapb.PoolerServingStatus_SERVING, report.ServingStatus)
+	case <-time.After(5 * time.Second):
+		t.Fatal("serving change not reported")
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -50,6 +51,7 @@ type MultiPooler struct {
 	poolerDir           viperutil.Value[string]
 	pgPort              viperutil.Value[int]
 	heartbeatIntervalMs viperutil.Value[int]
+	primaryLeaseTTL     viperutil.Value[time.Duration]
 	pgBackRestStanza    viperutil.Value[string] // TODO(sougou): this is deprecated. It's now hardcoded to multigres.
 	// pgBackRest TLS certificate paths (used for both server and client authentication)
 	pgBackRestCertFile viperutil.Value[string]
@@ -66,6 +68,10 @@ type MultiPooler struct {
 	// connPoolConfig holds connection pool configuration (manager created inside MultiPoolerManager)
 	connPoolConfig *connpoolmanager.Config
 
+	// connPoolMgr is the connection pool manager of the MultiPoolerManager,
+	// reconfigured through /admin/connpool.
+	connPoolMgr connpoolmanager.PoolManager
+
 	ts           topoclient.Store
 	tr           *toporeg.TopoReg
 	serverStatus Status
@@ -131,6 +137,11 @@ func NewMultiPooler(telemetry *telemetry.Telemetry) *MultiPooler {
 			FlagName: "heartbeat-interval-milliseconds",
 			Dynamic:  false,
 		}),
+		primaryLeaseTTL: viperutil.Configure(reg, "primary-lease-ttl", viperutil.Options[time.Duration]{
+			Default:  10 * time.Second,
+			FlagName: "primary-lease-ttl",
+			Dynamic:  false,
+		}),
 		pgBackRestStanza: viperutil.Configure(reg, "pgbackrest-stanza", viperutil.Options[string]{
 			Default:  "",
 			FlagName: "pgbackrest-stanza",
@@ -188,6 +199,7 @@ func (mp *MultiPooler) RegisterFlags(flags *pflag.FlagSet) {
 	flags.String("pooler-dir", mp.poolerDir.Default(), "pooler directory path (if empty, socket-file path will be used as-is)")
 	flags.Int("pg-port", mp.pgPort.Default(), "PostgreSQL port number")
 	flags.Int("heartbeat-interval-milliseconds", mp.heartbeatIntervalMs.Default(), "interval in milliseconds between heartbeat writes")
+	flags.Duration("primary-lease-ttl", mp.primaryLeaseTTL.Default(), "how long the primary lease in the topology lasts unless renewed; a primary refuses writes without a valid lease (0 disables fencing)")
 	flags.String("pgbackrest-stanza", mp.pgBackRestStanza.Default(), "pgBackRest stanza name (defaults to service ID if empty)")
 	flags.String("pgbackrest-cert-file", mp.pgBackRestCertFile.Default(), "pgBackRest TLS certificate file path (used for both server and client)")
 	flags.String("pgbackrest-key-file", mp.pgBackRestKeyFile.Default(), "pgBackRest TLS key file path (used for both server and client)")
@@ -205,6 +217,7 @@ func (mp *MultiPooler) RegisterFlags(flags *pflag.FlagSet) {
 		mp.poolerDir,
 		mp.pgPort,
 		mp.heartbeatIntervalMs,
+		mp.primaryLeaseTTL,
 		mp.pgBackRestStanza,
 		mp.pgBackRestCertFile,
 		mp.pgBackRestKeyFile,
@@ -299,6 +312,7 @@ func (mp *MultiPooler) Init(startCtx context.Context) error {
 		SocketFilePath:      mp.socketFilePath.Get(),
 		TopoClient:          mp.ts,
 		HeartbeatIntervalMs: mp.heartbeatIntervalMs.Get(),
+		PrimaryLeaseTTL:     mp.primaryLeaseTTL.Get(),
 		PgctldAddr:          mp.pgctldAddr.Get(),
 		ConsensusEnabled:    mp.grpcServer.CheckServiceMap("consensus", mp.senv),
 		ConnPoolConfig:      mp.connPoolConfig,
@@ -319,6 +333,11 @@ func (mp *MultiPooler) Init(startCtx context.Context) error {
 
 	mp.senv.HTTPHandleFunc("/", mp.handleIndex)
 	mp.senv.HTTPHandleFunc("/ready", mp.handleReady)
+	mp.connPoolMgr = poolerManager.ConnPoolManager()
+	mp.senv.HTTPHandleFunc("/admin/connpool", mp.handleConnPool)
+	mp.senv.HTTPHandleFunc("/admin/connpool/pause", mp.handleConnPoolPause)
+	mp.senv.HTTPHandleFunc("/admin/connpool/resume", mp.handleConnPoolPause)
+	mp.senv.HTTPHandleFunc("/admin/connpool/drain", mp.handleConnPoolPause)
 
 	mp.senv.OnRun(
 		func() {
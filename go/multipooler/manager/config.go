@@ -16,6 +16,8 @@
 package manager
 
 import (
+	"time"
+
 	"github.com/multigres/multigres/go/common/topoclient"
 	"github.com/multigres/multigres/go/multipooler/connpoolmanager"
 )
@@ -25,6 +27,7 @@ type Config struct {
 	SocketFilePath      string
 	TopoClient          topoclient.Store
 	HeartbeatIntervalMs int
+	PrimaryLeaseTTL     time.Duration           // Lifetime of the primary lease in the topology; 0 disables fencing
 	PgctldAddr          string                  // Address of pgctld gRPC service
 	ConsensusEnabled    bool                    // Whether consensus gRPC service is enabled
 	ConnPoolConfig      *connpoolmanager.Config // Connection pool config (manager created in MultiPoolerManager)
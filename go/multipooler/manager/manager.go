@@ -124,6 +124,13 @@ type MultiPoolerManager struct {
 	// pgMonitor manages the PostgreSQL monitoring loop.
 	pgMonitor *timer.PeriodicRunner
 
+	// lease is the primary lease held in the topology while primary.
+	lease primaryLease
+
+	// leaseRunner renews the primary lease. It only runs if
+	// Config.PrimaryLeaseTTL is set.
+	leaseRunner *timer.PeriodicRunner
+
 	// pgMonitorLastLoggedReason tracks the last logged reason in the monitor to avoid duplicate logs.
 	pgMonitorLastLoggedReason string
 
@@ -221,6 +228,7 @@ func NewMultiPoolerManagerWithTimeout(logger *slog.Logger, multiPooler *clusterm
 		connPoolMgr:            connPoolMgr,
 		readyChan:              make(chan struct{}),
 		pgMonitor:              monitorRunner,
+		leaseRunner:            timer.NewPeriodicRunner(ctx, config.PrimaryLeaseTTL/3),
 		// We create a dummy context because some unit tests need them.
 		// These will be overwritten when Open gets called.
 		ctx:    ctx,
@@ -231,7 +239,10 @@ func NewMultiPoolerManagerWithTimeout(logger *slog.Logger, multiPooler *clusterm
 	pm.consensusState = NewConsensusState(pm.multipooler.PoolerDir, pm.serviceID)
 
 	// Create the query service controller with the pool manager
-	pm.qsc = poolerserver.NewQueryPoolerServer(logger, connPoolMgr)
+	qsc := poolerserver.NewQueryPoolerServer(logger, connPoolMgr)
+	qsc.SetReplicationLagFunc(pm.ReplicationLag)
+	qsc.SetPrimaryFenceFunc(pm.checkPrimaryLease)
+	pm.qsc = qsc
 
 	return pm, nil
 }
@@ -309,6 +320,11 @@ func (pm *MultiPoolerManager) Open() error {
 	pm.pgMonitor.Start(pm.monitorPostgresIteration, nil)
 	pm.logger.InfoContext(pm.ctx, "MonitorPostgres enabled successfully")
 
+	// Start renewing the primary lease, if enabled
+	if pm.primaryLeaseEnabled() {
+		pm.leaseRunner.Start(pm.renewPrimaryLease, nil)
+	}
+
 	pm.isOpen = true
 	return nil
 }
@@ -323,6 +339,9 @@ func (pm *MultiPoolerManager) Close() error {
 	}
 
 	pm.pgMonitor.Stop()
+	if pm.leaseRunner != nil {
+		pm.leaseRunner.Stop()
+	}
 	pm.closeConnectionsLocked()
 	pm.cancel()
 	pm.isOpen = false
@@ -427,6 +446,12 @@ func (pm *MultiPoolerManager) reopenConnections(_ context.Context) error {
 	return pm.openConnectionsLocked()
 }
 
+// ConnPoolManager returns the manager of the connection pools, or nil when
+// no connection pool is configured.
+func (pm *MultiPoolerManager) ConnPoolManager() connpoolmanager.PoolManager {
+	return pm.connPoolMgr
+}
+
 // GetState returns the current state of the manager
 func (pm *MultiPoolerManager) GetState() ManagerState {
 	pm.mu.Lock()
@@ -1376,6 +1401,23 @@ func (pm *MultiPoolerManager) ReplicationLag(ctx context.Context) (time.Duration
 	return pm.replTracker.HeartbeatReader().Status()
 }
 
+// replicaLag returns the replication lag measured from the heartbeats of
+// the primary, or false while the pooler is a primary or the lag is
+// unknown.
+func (pm *MultiPoolerManager) replicaLag() (time.Duration, bool) {
+	pm.mu.Lock()
+	replTracker := pm.replTracker
+	pm.mu.Unlock()
+	if replTracker == nil || replTracker.IsPrimary() {
+		return 0, false
+	}
+	lag, err := replTracker.HeartbeatReader().Status()
+	if err != nil {
+		return 0, false
+	}
+	return lag, true
+}
+
 // Start initializes the MultiPoolerManager
 func (pm *MultiPoolerManager) Start(senv *servenv.ServEnv) {
 	// Open the database connections, connection pool manager, and start background operations
@@ -1385,6 +1427,14 @@ func (pm *MultiPoolerManager) Start(senv *servenv.ServEnv) {
 		// Don't fail startup if Open fails - will retry on demand
 	}
 
+	metrics, err := NewMetrics()
+	if err != nil {
+		pm.logger.Error("failed to initialize replication metrics", "error", err)
+	}
+	if err := metrics.RegisterReplicationLagCallback(pm); err != nil {
+		pm.logger.Error("failed to monitor replication lag", "error", err)
+	}
+
 	// Start loading multipooler record from topology asynchronously
 	go pm.loadMultiPoolerFromTopo()
 	// Start loading consensus term from local disk asynchronously (only if consensus is enabled)
@@ -1727,7 +1777,7 @@ func (pm *MultiPoolerManager) restoreAndStartPostgres(ctx context.Context) error
 		"backup_id", latestBackup.BackupId)
 
 	// Perform the restore
-	if err := pm.restoreFromBackupLocked(ctx, latestBackup.BackupId); err != nil {
+	if err := pm.restoreFromBackupLocked(ctx, latestBackup.BackupId, RecoveryTarget{}); err != nil {
 		return fmt.Errorf("failed to restore from backup: %w", err)
 	}
 
@@ -0,0 +1,84 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/tools/telemetry"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// Metrics holds the OpenTelemetry metrics of the replication of the pooler.
+type Metrics struct {
+	meter          metric.Meter
+	replicationLag metric.Float64ObservableGauge
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the replication of the
+// pooler. A metric that fails to initialize uses a noop implementation,
+// and the error is returned along with the usable Metrics instance. Use
+// RegisterReplicationLagCallback() to report the replication lag.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/multipooler/manager"),
+	}
+
+	var err error
+	m.replicationLag, err = m.meter.Float64ObservableGauge(
+		"multipooler.replication.lag",
+		metric.WithDescription("Replication lag of a replica measured from the heartbeats of the primary"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		m.replicationLag = noop.Float64ObservableGauge{}
+		return m, fmt.Errorf("multipooler.replication.lag gauge: %w", err)
+	}
+	return m, nil
+}
+
+// RegisterReplicationLagCallback registers a callback observing the
+// replication lag of pm while it is a replica. Nothing is observed while
+// the lag is unknown. Returns an error if registration fails.
+func (m *Metrics) RegisterReplicationLagCallback(pm *MultiPoolerManager) error {
+	if pm == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			lag, ok := pm.replicaLag()
+			if !ok {
+				return nil
+			}
+			mp := pm.multipooler
+			observer.ObserveFloat64(m.replicationLag, lag.Seconds(), metric.WithAttributes(
+				attribute.String(telemetry.AttrCell, mp.Id.GetCell()),
+				attribute.String(telemetry.AttrDatabase, mp.Database),
+				attribute.String(telemetry.AttrTableGroup, mp.TableGroup),
+				attribute.String(telemetry.AttrShard, mp.Shard),
+				attribute.String(telemetry.AttrTabletType, clustermetadatapb.PoolerType_REPLICA.String())))
+			return nil
+		},
+		m.replicationLag,
+	)
+	return err
+}
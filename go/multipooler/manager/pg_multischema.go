@@ -63,6 +63,22 @@ func (pm *MultiPoolerManager) createSidecarSchema(ctx context.Context) error {
 		return err
 	}
 
+	if err := pm.createTransactionLogTable(ctx); err != nil {
+		return err
+	}
+
+	if err := pm.createSequencesTable(ctx); err != nil {
+		return err
+	}
+
+	if err := pm.createDDLJournalTable(ctx); err != nil {
+		return err
+	}
+
+	if err := pm.createSchemaMigrationsTable(ctx); err != nil {
+		return err
+	}
+
 	// Create multischema global tables for the default tablegroup
 	pm.logger.InfoContext(ctx, "Creating multischema global tables for default tablegroup")
 
@@ -206,6 +222,84 @@ func (pm *MultiPoolerManager) createLeadershipHistoryTable(ctx context.Context)
 	return nil
 }
 
+// createTransactionLogTable creates the transaction_log table, where the
+// multigateway logs the two-phase commits coordinated by this shard so that
+// transactions left in doubt by a gateway crash can be resolved.
+func (pm *MultiPoolerManager) createTransactionLogTable(ctx context.Context) error {
+	execCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	if err := pm.exec(execCtx, `CREATE TABLE IF NOT EXISTS multigres.transaction_log (
+		gid TEXT PRIMARY KEY,
+		state TEXT NOT NULL CHECK (state IN ('prepare', 'commit', 'abort')),
+		participants JSONB NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return mterrors.Wrap(err, "failed to create transaction_log table")
+	}
+	return nil
+}
+
+// createSequencesTable creates the sequences table, from which the
+// multigateway allocates blocks of values of its global sequences. It
+// holds the next value to allocate of each sequence.
+func (pm *MultiPoolerManager) createSequencesTable(ctx context.Context) error {
+	execCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	if err := pm.exec(execCtx, `CREATE TABLE IF NOT EXISTS multigres.sequences (
+		name TEXT PRIMARY KEY,
+		next_value BIGINT NOT NULL
+	)`); err != nil {
+		return mterrors.Wrap(err, "failed to create sequences table")
+	}
+	return nil
+}
+
+// createDDLJournalTable creates the DDL journal table, in which the
+// multigateway records the shards each schema change spanning shards was
+// applied on, so that running a failed change again resumes it.
+func (pm *MultiPoolerManager) createDDLJournalTable(ctx context.Context) error {
+	execCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	if err := pm.exec(execCtx, `CREATE TABLE IF NOT EXISTS multigres.ddl_journal (
+		ddl_id TEXT NOT NULL,
+		shard TEXT NOT NULL,
+		query TEXT NOT NULL,
+		state TEXT NOT NULL CHECK (state IN ('done', 'failed')),
+		error TEXT,
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		PRIMARY KEY (ddl_id, shard)
+	)`); err != nil {
+		return mterrors.Wrap(err, "failed to create ddl_journal table")
+	}
+	return nil
+}
+
+// createSchemaMigrationsTable creates the queue of online schema changes,
+// which the multigateways run one at a time, shard by shard, recording
+// their progress in it.
+func (pm *MultiPoolerManager) createSchemaMigrationsTable(ctx context.Context) error {
+	execCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	if err := pm.exec(execCtx, `CREATE TABLE IF NOT EXISTS multigres.schema_migrations (
+		id TEXT PRIMARY KEY,
+		table_name TEXT NOT NULL,
+		ddl TEXT NOT NULL,
+		state TEXT NOT NULL CHECK (state IN ('queued', 'running', 'complete', 'failed', 'cancelled')),
+		paused BOOLEAN NOT NULL DEFAULT false,
+		max_rows_per_second BIGINT NOT NULL DEFAULT 0,
+		shards_done TEXT[] NOT NULL DEFAULT '{}',
+		current_shard TEXT,
+		rows_copied BIGINT NOT NULL DEFAULT 0,
+		owner TEXT,
+		error TEXT,
+		submitted_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return mterrors.Wrap(err, "failed to create schema_migrations table")
+	}
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // Multischema Global Tables (default tablegroup only)
 // ----------------------------------------------------------------------------
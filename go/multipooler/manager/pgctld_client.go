@@ -27,9 +27,9 @@ import (
 // for state-changing operations. This prevents concurrent modifications to postgres
 // state (e.g., monitor restarting postgres while a manual operation is in progress).
 //
-// State-changing operations (Start, Stop, Restart, InitDataDir, PgRewind, ReloadConfig)
-// require the caller to hold the action lock. Read-only operations (Status, Version)
-// can be called without the lock.
+// State-changing operations (Start, Stop, Restart, InitDataDir, InitReplica, PgRewind,
+// UpgradeDataDir, RollbackUpgrade, FinalizeUpgrade, ApplyConfig, InstallExtension, ReloadConfig) require the caller to hold the action lock.
+// Read-only operations (Status, Version, CheckConfigDrift, CheckExtension) can be called without the lock.
 type protectedPgctldClient struct {
 	client pgctldpb.PgCtldClient
 }
@@ -92,6 +92,55 @@ func (p *protectedPgctldClient) PgRewind(ctx context.Context, req *pgctldpb.PgRe
 	return p.client.PgRewind(ctx, req, opts...)
 }
 
+// InitReplica provisions the data directory from a primary. Requires action lock to be held by caller.
+func (p *protectedPgctldClient) InitReplica(ctx context.Context, req *pgctldpb.InitReplicaRequest, opts ...grpc.CallOption) (*pgctldpb.InitReplicaResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, fmt.Errorf("InitReplica requires action lock to be held: %w", err)
+	}
+	return p.client.InitReplica(ctx, req, opts...)
+}
+
+// UpgradeDataDir upgrades the data directory to a new major version. Requires action lock to be held by caller.
+func (p *protectedPgctldClient) UpgradeDataDir(ctx context.Context, req *pgctldpb.UpgradeDataDirRequest, opts ...grpc.CallOption) (*pgctldpb.UpgradeDataDirResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, fmt.Errorf("UpgradeDataDir requires action lock to be held: %w", err)
+	}
+	return p.client.UpgradeDataDir(ctx, req, opts...)
+}
+
+// RollbackUpgrade restores the data directory from before the last upgrade. Requires action lock to be held by caller.
+func (p *protectedPgctldClient) RollbackUpgrade(ctx context.Context, req *pgctldpb.RollbackUpgradeRequest, opts ...grpc.CallOption) (*pgctldpb.RollbackUpgradeResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, fmt.Errorf("RollbackUpgrade requires action lock to be held: %w", err)
+	}
+	return p.client.RollbackUpgrade(ctx, req, opts...)
+}
+
+// FinalizeUpgrade removes the rollback point of the last upgrade. Requires action lock to be held by caller.
+func (p *protectedPgctldClient) FinalizeUpgrade(ctx context.Context, req *pgctldpb.FinalizeUpgradeRequest, opts ...grpc.CallOption) (*pgctldpb.FinalizeUpgradeResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, fmt.Errorf("FinalizeUpgrade requires action lock to be held: %w", err)
+	}
+	return p.client.FinalizeUpgrade(ctx, req, opts...)
+}
+
+// ApplyConfig applies the managed parameters of a pooler type. Requires action lock to be held by caller,
+// since it may restart PostgreSQL.
+func (p *protectedPgctldClient) ApplyConfig(ctx context.Context, req *pgctldpb.ApplyConfigRequest, opts ...grpc.CallOption) (*pgctldpb.ApplyConfigResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, fmt.Errorf("ApplyConfig requires action lock to be held: %w", err)
+	}
+	return p.client.ApplyConfig(ctx, req, opts...)
+}
+
+// InstallExtension creates or updates an extension. Requires action lock to be held by caller.
+func (p *protectedPgctldClient) InstallExtension(ctx context.Context, req *pgctldpb.InstallExtensionRequest, opts ...grpc.CallOption) (*pgctldpb.InstallExtensionResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, fmt.Errorf("InstallExtension requires action lock to be held: %w", err)
+	}
+	return p.client.InstallExtension(ctx, req, opts...)
+}
+
 // Status returns PostgreSQL status. Does not require action lock (read-only operation).
 func (p *protectedPgctldClient) Status(ctx context.Context, req *pgctldpb.StatusRequest, opts ...grpc.CallOption) (*pgctldpb.StatusResponse, error) {
 	return p.client.Status(ctx, req, opts...)
@@ -101,3 +150,13 @@ func (p *protectedPgctldClient) Status(ctx context.Context, req *pgctldpb.Status
 func (p *protectedPgctldClient) Version(ctx context.Context, req *pgctldpb.VersionRequest, opts ...grpc.CallOption) (*pgctldpb.VersionResponse, error) {
 	return p.client.Version(ctx, req, opts...)
 }
+
+// CheckConfigDrift reports drift of the managed parameters. Does not require action lock (read-only operation).
+func (p *protectedPgctldClient) CheckConfigDrift(ctx context.Context, req *pgctldpb.CheckConfigDriftRequest, opts ...grpc.CallOption) (*pgctldpb.CheckConfigDriftResponse, error) {
+	return p.client.CheckConfigDrift(ctx, req, opts...)
+}
+
+// CheckExtension checks that the files of an extension are installed. Does not require action lock (read-only operation).
+func (p *protectedPgctldClient) CheckExtension(ctx context.Context, req *pgctldpb.CheckExtensionRequest, opts ...grpc.CallOption) (*pgctldpb.CheckExtensionResponse, error) {
+	return p.client.CheckExtension(ctx, req, opts...)
+}
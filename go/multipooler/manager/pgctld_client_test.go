@@ -25,8 +25,8 @@ import (
 )
 
 // TestProtectedPgctldClient_StateChangingOperationsRequireLock verifies that all
-// state-changing operations (Start, Stop, Restart, InitDataDir, PgRewind, ReloadConfig)
-// require the action lock to be held.
+// state-changing operations (Start, Stop, Restart, InitDataDir, InitReplica, PgRewind,
+// UpgradeDataDir, RollbackUpgrade, FinalizeUpgrade, ApplyConfig, InstallExtension, ReloadConfig) require the action lock to be held.
 func TestProtectedPgctldClient_StateChangingOperationsRequireLock(t *testing.T) {
 	ctx := context.Background()
 	mockClient := &mockPgctldClient{
@@ -60,6 +60,42 @@ func TestProtectedPgctldClient_StateChangingOperationsRequireLock(t *testing.T)
 		assert.Contains(t, err.Error(), "action lock")
 	})
 
+	t.Run("InitReplica requires lock", func(t *testing.T) {
+		_, err := protected.InitReplica(ctx, &pgctldpb.InitReplicaRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action lock")
+	})
+
+	t.Run("UpgradeDataDir requires lock", func(t *testing.T) {
+		_, err := protected.UpgradeDataDir(ctx, &pgctldpb.UpgradeDataDirRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action lock")
+	})
+
+	t.Run("RollbackUpgrade requires lock", func(t *testing.T) {
+		_, err := protected.RollbackUpgrade(ctx, &pgctldpb.RollbackUpgradeRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action lock")
+	})
+
+	t.Run("FinalizeUpgrade requires lock", func(t *testing.T) {
+		_, err := protected.FinalizeUpgrade(ctx, &pgctldpb.FinalizeUpgradeRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action lock")
+	})
+
+	t.Run("ApplyConfig requires lock", func(t *testing.T) {
+		_, err := protected.ApplyConfig(ctx, &pgctldpb.ApplyConfigRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action lock")
+	})
+
+	t.Run("InstallExtension requires lock", func(t *testing.T) {
+		_, err := protected.InstallExtension(ctx, &pgctldpb.InstallExtensionRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action lock")
+	})
+
 	t.Run("PgRewind requires lock", func(t *testing.T) {
 		_, err := protected.PgRewind(ctx, &pgctldpb.PgRewindRequest{})
 		require.Error(t, err)
@@ -74,7 +110,7 @@ func TestProtectedPgctldClient_StateChangingOperationsRequireLock(t *testing.T)
 }
 
 // TestProtectedPgctldClient_ReadOnlyOperationsNoLockRequired verifies that read-only
-// operations (Status) can be called without holding the action lock.
+// operations (Status, CheckConfigDrift, CheckExtension) can be called without holding the action lock.
 func TestProtectedPgctldClient_ReadOnlyOperationsNoLockRequired(t *testing.T) {
 	ctx := context.Background()
 	mockClient := &mockPgctldClient{
@@ -89,6 +125,16 @@ func TestProtectedPgctldClient_ReadOnlyOperationsNoLockRequired(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, pgctldpb.ServerStatus_RUNNING, resp.Status)
 	})
+
+	t.Run("CheckConfigDrift does not require lock", func(t *testing.T) {
+		_, err := protected.CheckConfigDrift(ctx, &pgctldpb.CheckConfigDriftRequest{})
+		require.NoError(t, err)
+	})
+
+	t.Run("CheckExtension does not require lock", func(t *testing.T) {
+		_, err := protected.CheckExtension(ctx, &pgctldpb.CheckExtensionRequest{})
+		require.NoError(t, err)
+	})
 }
 
 // TestProtectedPgctldClient_WithLockHeld verifies that state-changing operations
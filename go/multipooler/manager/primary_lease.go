@@ -0,0 +1,187 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/topoclient"
+	commontypes "github.com/multigres/multigres/go/common/types"
+)
+
+// primaryLease tracks the primary lease this pooler holds on its shard in
+// the topology. A primary renews its lease periodically, and refuses the
+// statements sent to it unless it holds an unexpired lease for its primary
+// term. This fences off a primary that was partitioned away while a new
+// primary was appointed: it either cannot renew its lease and lets it
+// expire, or finds that the lease moved to a newer epoch.
+type primaryLease struct {
+	mu sync.Mutex
+
+	// epoch is the primary term the lease is held for, or 0 if none.
+	epoch int64
+
+	// expiresAt is when the lease lapses, as measured from the start of
+	// the last renewal so that it never outlives the lease in the topology.
+	expiresAt time.Time
+
+	// notBefore is when the lease of the previous holder expires, if it
+	// was taken over before expiring. The lease is not valid until then.
+	notBefore time.Time
+
+	// lostEpoch and lostErr record that the lease could not be acquired
+	// for lostEpoch because another pooler holds it in a newer epoch.
+	lostEpoch int64
+	lostErr   error
+
+	// releasedEpoch is the primary term whose lease was given up when
+	// the primary was demoted. It is not renewed anymore.
+	releasedEpoch int64
+}
+
+// shardKey returns the key of the shard this pooler serves.
+func (pm *MultiPoolerManager) shardKey() commontypes.ShardKey {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	return commontypes.ShardKey{
+		Database:   pm.multipooler.Database,
+		TableGroup: pm.multipooler.TableGroup,
+		Shard:      pm.multipooler.Shard,
+	}
+}
+
+// currentPrimaryTerm returns the term in which this pooler was promoted,
+// or 0 if it is not a primary.
+func (pm *MultiPoolerManager) currentPrimaryTerm() int64 {
+	if pm.consensusState == nil {
+		return 0
+	}
+	term, err := pm.consensusState.GetInconsistentTerm()
+	if err != nil {
+		return 0
+	}
+	return term.GetPrimaryTerm()
+}
+
+// primaryLeaseEnabled returns true if primaries must hold a lease.
+func (pm *MultiPoolerManager) primaryLeaseEnabled() bool {
+	return pm.config != nil && pm.config.PrimaryLeaseTTL > 0 && pm.topoClient != nil
+}
+
+// acquirePrimaryLease acquires or renews the primary lease for epoch.
+func (pm *MultiPoolerManager) acquirePrimaryLease(ctx context.Context, epoch int64) error {
+	if !pm.primaryLeaseEnabled() {
+		return nil
+	}
+	ttl := pm.config.PrimaryLeaseTTL
+	start := time.Now()
+	previous, err := pm.topoClient.AcquirePrimaryLease(ctx, pm.shardKey(), pm.serviceID, epoch, ttl)
+
+	pm.lease.mu.Lock()
+	defer pm.lease.mu.Unlock()
+	if err != nil {
+		if errors.Is(err, &topoclient.TopoError{Code: topoclient.LeaseLost}) {
+			pm.lease.lostEpoch = epoch
+			pm.lease.lostErr = err
+		}
+		return err
+	}
+	if pm.lease.epoch != epoch {
+		pm.lease.notBefore = time.Time{}
+		if previous != nil && !proto.Equal(previous.Holder, pm.serviceID) && previous.ExpireTime.AsTime().After(start) {
+			pm.lease.notBefore = previous.ExpireTime.AsTime()
+		}
+	}
+	pm.lease.epoch = epoch
+	pm.lease.expiresAt = start.Add(ttl)
+	return nil
+}
+
+// renewPrimaryLease is the periodic callback renewing the primary lease
+// while this pooler is a primary.
+func (pm *MultiPoolerManager) renewPrimaryLease(ctx context.Context) {
+	epoch := pm.currentPrimaryTerm()
+	pm.lease.mu.Lock()
+	released := epoch == pm.lease.releasedEpoch
+	pm.lease.mu.Unlock()
+	if epoch == 0 || released {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pm.config.PrimaryLeaseTTL/2)
+	defer cancel()
+	if err := pm.acquirePrimaryLease(ctx, epoch); err != nil {
+		if errors.Is(err, &topoclient.TopoError{Code: topoclient.LeaseLost}) {
+			pm.logger.ErrorContext(ctx, "Primary lease lost to a newer primary, refusing writes",
+				"primary_term", epoch, "error", err)
+			return
+		}
+		pm.logger.WarnContext(ctx, "Failed to renew primary lease", "primary_term", epoch, "error", err)
+	}
+}
+
+// releasePrimaryLease gives up the primary lease of epoch, once this
+// pooler no longer accepts writes.
+func (pm *MultiPoolerManager) releasePrimaryLease(ctx context.Context, epoch int64) {
+	if !pm.primaryLeaseEnabled() || epoch == 0 {
+		return
+	}
+	pm.lease.mu.Lock()
+	pm.lease.releasedEpoch = epoch
+	pm.lease.epoch = 0
+	pm.lease.mu.Unlock()
+
+	if err := pm.topoClient.ReleasePrimaryLease(ctx, pm.shardKey(), pm.serviceID, epoch); err != nil {
+		pm.logger.WarnContext(ctx, "Failed to release primary lease", "primary_term", epoch, "error", err)
+	}
+}
+
+// checkPrimaryLease returns MT13004 if this pooler is a primary without a
+// valid lease. It is checked before running statements sent to the primary.
+func (pm *MultiPoolerManager) checkPrimaryLease() error {
+	if !pm.primaryLeaseEnabled() {
+		return nil
+	}
+	epoch := pm.currentPrimaryTerm()
+	if epoch == 0 {
+		return nil
+	}
+
+	pm.lease.mu.Lock()
+	defer pm.lease.mu.Unlock()
+	now := time.Now()
+	switch {
+	case pm.lease.lostErr != nil && pm.lease.lostEpoch == epoch:
+		return mterrors.MT13004(pm.lease.lostErr.Error())
+	case pm.lease.releasedEpoch == epoch:
+		return mterrors.MT13004(fmt.Sprintf("primary of term %d was demoted", epoch))
+	case pm.lease.epoch != epoch:
+		return mterrors.MT13004(fmt.Sprintf("no primary lease held for term %d", epoch))
+	case now.After(pm.lease.expiresAt):
+		return mterrors.MT13004(fmt.Sprintf("primary lease of term %d expired at %s; the topology may be unreachable",
+			epoch, pm.lease.expiresAt.Format(time.RFC3339)))
+	case now.Before(pm.lease.notBefore):
+		return mterrors.MT13004(fmt.Sprintf("waiting until %s for the lease of the previous primary to expire",
+			pm.lease.notBefore.Format(time.RFC3339)))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+// newLeaseTestManager returns a manager of shard postgres/default/0-inf
+// that was promoted in primaryTerm.
+func newLeaseTestManager(t *testing.T, ts topoclient.Store, name string, primaryTerm int64) *MultiPoolerManager {
+	t.Helper()
+	serviceID := &clustermetadatapb.ID{Component: clustermetadatapb.ID_MULTIPOOLER, Cell: "zone1", Name: name}
+	cs := NewConsensusState(t.TempDir(), serviceID)
+	cs.term = &multipoolermanagerdatapb.ConsensusTerm{TermNumber: primaryTerm, PrimaryTerm: primaryTerm}
+	return &MultiPoolerManager{
+		logger:     slog.Default(),
+		config:     &Config{PrimaryLeaseTTL: time.Minute},
+		topoClient: ts,
+		serviceID:  serviceID,
+		multipooler: &clustermetadatapb.MultiPooler{
+			Id:         serviceID,
+			Database:   "postgres",
+			TableGroup: "default",
+			Shard:      "0-inf",
+		},
+		consensusState: cs,
+	}
+}
+
+func requireFenced(t *testing.T, err error, msg string) {
+	t.Helper()
+	require.True(t, mterrors.IsError(err, "MT13004"), "got %v", err)
+	require.ErrorContains(t, err, msg)
+}
+
+func TestPrimaryLease(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled without a ttl", func(t *testing.T) {
+		ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+		defer ts.Close()
+		pm := newLeaseTestManager(t, ts, "mp1", 3)
+		pm.config.PrimaryLeaseTTL = 0
+		require.NoError(t, pm.checkPrimaryLease())
+	})
+
+	t.Run("replicas are not fenced", func(t *testing.T) {
+		ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+		defer ts.Close()
+		pm := newLeaseTestManager(t, ts, "mp1", 0)
+		require.NoError(t, pm.checkPrimaryLease())
+	})
+
+	t.Run("primary accepts writes while holding the lease", func(t *testing.T) {
+		ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+		defer ts.Close()
+		pm := newLeaseTestManager(t, ts, "mp1", 3)
+		requireFenced(t, pm.checkPrimaryLease(), "no primary lease held for term 3")
+
+		require.NoError(t, pm.acquirePrimaryLease(ctx, 3))
+		require.NoError(t, pm.checkPrimaryLease())
+
+		// The lease expires unless renewed.
+		pm.lease.expiresAt = time.Now().Add(-time.Second)
+		requireFenced(t, pm.checkPrimaryLease(), "expired")
+		pm.renewPrimaryLease(ctx)
+		require.NoError(t, pm.checkPrimaryLease())
+	})
+
+	t.Run("stale primary is fenced by a newer one", func(t *testing.T) {
+		ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+		defer ts.Close()
+		stale := newLeaseTestManager(t, ts, "mp1", 3)
+		require.NoError(t, stale.acquirePrimaryLease(ctx, 3))
+
+		// A new primary takes over the unexpired lease, and waits for it
+		// to expire before accepting writes.
+		primary := newLeaseTestManager(t, ts, "mp2", 4)
+		require.NoError(t, primary.acquirePrimaryLease(ctx, 4))
+		requireFenced(t, primary.checkPrimaryLease(), "lease of the previous primary to expire")
+
+		// The stale primary loses the lease when it next renews.
+		stale.renewPrimaryLease(ctx)
+		requireFenced(t, stale.checkPrimaryLease(), "held by zone1_mp2 in epoch 4")
+	})
+
+	t.Run("demoted primary releases the lease", func(t *testing.T) {
+		ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+		defer ts.Close()
+		old := newLeaseTestManager(t, ts, "mp1", 3)
+		require.NoError(t, old.acquirePrimaryLease(ctx, 3))
+		old.releasePrimaryLease(ctx, 3)
+		requireFenced(t, old.checkPrimaryLease(), "was demoted")
+
+		// The released lease is not renewed, and the next primary can
+		// use its lease at once.
+		old.renewPrimaryLease(ctx)
+		primary := newLeaseTestManager(t, ts, "mp2", 4)
+		require.NoError(t, primary.acquirePrimaryLease(ctx, 4))
+		require.NoError(t, primary.checkPrimaryLease())
+	})
+}
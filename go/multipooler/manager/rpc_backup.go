@@ -28,6 +28,9 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/jackc/pglogrepl"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/multigres/multigres/config"
 	"github.com/multigres/multigres/go/common/backup"
 	"github.com/multigres/multigres/go/common/mterrors"
@@ -48,6 +51,59 @@ const (
 	NotForBackup PgBackRestConfigMode = false
 )
 
+// RecoveryTarget is the point that a restore recovers to by replaying archived
+// WAL on top of the base backup. The zero value replays all available WAL.
+type RecoveryTarget struct {
+	// Time recovers up to and including the last transaction committed at or
+	// before this time
+	Time time.Time
+	// LSN recovers up to this WAL location, e.g. "0/3000060"
+	LSN string
+}
+
+// IsZero returns true if no recovery target is set
+func (t RecoveryTarget) IsZero() bool {
+	return t.Time.IsZero() && t.LSN == ""
+}
+
+// validate checks that at most one target is set and that it is well-formed
+func (t RecoveryTarget) validate(now time.Time) error {
+	if !t.Time.IsZero() && t.LSN != "" {
+		return mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "only one of target_time and target_lsn can be set")
+	}
+	if t.Time.After(now) {
+		return mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT,
+			fmt.Sprintf("target_time %s is in the future", t.Time.UTC().Format(time.RFC3339)))
+	}
+	if t.LSN != "" {
+		if _, err := pglogrepl.ParseLSN(t.LSN); err != nil {
+			return mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, fmt.Sprintf("invalid target_lsn %q", t.LSN))
+		}
+	}
+	return nil
+}
+
+// pgBackRestArgs returns the pgbackrest restore options for the target.
+// Recovery pauses at the target so the restored standby can be inspected.
+func (t RecoveryTarget) pgBackRestArgs() []string {
+	switch {
+	case !t.Time.IsZero():
+		return []string{
+			"--type=time",
+			"--target=" + t.Time.UTC().Format("2006-01-02 15:04:05.999999-07"),
+			"--target-action=pause",
+		}
+	case t.LSN != "":
+		return []string{
+			"--type=lsn",
+			"--target=" + t.LSN,
+			"--target-action=pause",
+		}
+	default:
+		return []string{"--type=standby"}
+	}
+}
+
 // initPgBackRest creates the pgBackRest config file and sets up the necessary directories.
 // Returns the path to the backup config file.
 // If mode is NotForBackup and the config file already exists, it returns the path without recreating it.
@@ -331,8 +387,16 @@ func (pm *MultiPoolerManager) backupLocked(ctx context.Context, forcePrimary boo
 // 1. Execute pgbackrest restore to recreate PGDATA
 // 2. Start PostgreSQL in standby mode using Restart (which handles the not-running case)
 // 3. Reopen the pooler manager to establish fresh connections
-func (pm *MultiPoolerManager) RestoreFromBackup(ctx context.Context, backupID string) error {
-	slog.InfoContext(ctx, "RestoreFromBackup called", "backup_id", backupID)
+//
+// If target is set, the standby replays archived WAL up to the target and
+// then pauses recovery instead of catching up with the primary.
+func (pm *MultiPoolerManager) RestoreFromBackup(ctx context.Context, backupID string, target RecoveryTarget) error {
+	slog.InfoContext(ctx, "RestoreFromBackup called", "backup_id", backupID,
+		"target_time", target.Time, "target_lsn", target.LSN)
+
+	if err := target.validate(time.Now()); err != nil {
+		return err
+	}
 
 	// We can't proceed without the topo, which is loaded asynchronously at startup
 	if err := pm.checkReady(); err != nil {
@@ -354,12 +418,12 @@ func (pm *MultiPoolerManager) RestoreFromBackup(ctx context.Context, backupID st
 	}
 	defer resumeMonitor(ctx)
 
-	return pm.restoreFromBackupLocked(ctx, backupID)
+	return pm.restoreFromBackupLocked(ctx, backupID, target)
 }
 
 // restoreFromBackupLocked performs the restore. Caller must hold the action lock
 // and monitoring must be disabled to avoid interference.
-func (pm *MultiPoolerManager) restoreFromBackupLocked(ctx context.Context, backupID string) error {
+func (pm *MultiPoolerManager) restoreFromBackupLocked(ctx context.Context, backupID string, target RecoveryTarget) error {
 	if err := AssertActionLockHeld(ctx); err != nil {
 		return err
 	}
@@ -379,8 +443,13 @@ func (pm *MultiPoolerManager) restoreFromBackupLocked(ctx context.Context, backu
 			"cannot restore: PGDATA already exists; caller must stop PostgreSQL and remove PGDATA first")
 	}
 
+	backupID, err := pm.resolveRestoreBackup(ctx, backupID, target)
+	if err != nil {
+		return err
+	}
+
 	// Restore the backup
-	if err := pm.executePgBackrestRestore(ctx, backupID); err != nil {
+	if err := pm.executePgBackrestRestore(ctx, backupID, target); err != nil {
 		return err
 	}
 
@@ -427,7 +496,7 @@ func (pm *MultiPoolerManager) restoreFromBackupLocked(ctx context.Context, backu
 	return pm.setInitialized()
 }
 
-func (pm *MultiPoolerManager) executePgBackrestRestore(ctx context.Context, backupID string) error {
+func (pm *MultiPoolerManager) executePgBackrestRestore(ctx context.Context, backupID string, target RecoveryTarget) error {
 	configPath, err := pm.initPgBackRest(ctx, NotForBackup)
 	if err != nil {
 		return mterrors.Wrap(err, "failed to initialize pgbackrest")
@@ -439,8 +508,8 @@ func (pm *MultiPoolerManager) executePgBackrestRestore(ctx context.Context, back
 	args := []string{
 		"--stanza=" + pm.stanzaName(),
 		"--config=" + configPath,
-		"--type=standby",
 	}
+	args = append(args, target.pgBackRestArgs()...)
 
 	if backupID != "" {
 		args = append(args, "--set="+backupID)
@@ -458,6 +527,104 @@ func (pm *MultiPoolerManager) executePgBackrestRestore(ctx context.Context, back
 	return nil
 }
 
+// resolveRestoreBackup picks the backup to restore and checks that its backup
+// chain is intact, so that a restore never fails halfway through because a
+// differential or incremental backup lost the backups it builds on.
+// It returns an empty backup ID if pgbackrest should select the backup itself.
+// Caller must hold the action lock.
+func (pm *MultiPoolerManager) resolveRestoreBackup(ctx context.Context, backupID string, target RecoveryTarget) (string, error) {
+	backups, err := pm.listBackups(ctx)
+	if err != nil {
+		return "", mterrors.Wrap(err, "failed to list backups")
+	}
+
+	if backupID == "" {
+		switch {
+		case !target.Time.IsZero():
+			// pgbackrest picks the backup preceding a target time by itself
+			return "", nil
+		case target.LSN != "":
+			// For an LSN target pgbackrest would use the latest backup, which
+			// may be past the target
+			backupID, err = selectBackupBeforeLSN(backups, target.LSN)
+			if err != nil {
+				return "", err
+			}
+			pm.logger.InfoContext(ctx, "Selected backup for point-in-time restore", "backup_id", backupID, "target_lsn", target.LSN)
+		default:
+			if len(backups) == 0 {
+				return "", mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION, "no backups available to restore")
+			}
+			backupID = backups[len(backups)-1].BackupId
+		}
+	}
+
+	if err := validateBackupChain(backups, backupID); err != nil {
+		return "", err
+	}
+	return backupID, nil
+}
+
+// validateBackupChain checks that backupID and every backup it is based on
+// are complete and still in the repository.
+func validateBackupChain(backups []*multipoolermanagerdata.BackupMetadata, backupID string) error {
+	byID := make(map[string]*multipoolermanagerdata.BackupMetadata, len(backups))
+	for _, b := range backups {
+		byID[b.BackupId] = b
+	}
+
+	seen := make(map[string]bool)
+	for id := backupID; id != ""; {
+		if seen[id] {
+			return mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+				fmt.Sprintf("backup chain of %s has a cycle at %s", backupID, id))
+		}
+		seen[id] = true
+
+		b, ok := byID[id]
+		if !ok {
+			if id == backupID {
+				return mterrors.New(mtrpcpb.Code_NOT_FOUND, fmt.Sprintf("backup %s not found", backupID))
+			}
+			return mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+				fmt.Sprintf("backup chain of %s is broken: prior backup %s not found", backupID, id))
+		}
+		if b.Status != multipoolermanagerdata.BackupMetadata_COMPLETE {
+			return mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+				fmt.Sprintf("backup chain of %s is broken: backup %s is not complete", backupID, id))
+		}
+		id = b.PriorBackupId
+	}
+	return nil
+}
+
+// selectBackupBeforeLSN picks the latest complete backup whose final LSN is
+// not past target. backups must be ordered from oldest to newest.
+func selectBackupBeforeLSN(backups []*multipoolermanagerdata.BackupMetadata, target string) (string, error) {
+	targetLSN, err := pglogrepl.ParseLSN(target)
+	if err != nil {
+		return "", mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, fmt.Sprintf("invalid target_lsn %q", target))
+	}
+
+	selected := ""
+	for _, b := range backups {
+		if b.Status != multipoolermanagerdata.BackupMetadata_COMPLETE || b.FinalLsn == "" {
+			continue
+		}
+		finalLSN, err := pglogrepl.ParseLSN(b.FinalLsn)
+		if err != nil || finalLSN > targetLSN {
+			continue
+		}
+		selected = b.BackupId
+	}
+
+	if selected == "" {
+		return "", mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+			fmt.Sprintf("no complete backup ends before target_lsn %s", target))
+	}
+	return selected, nil
+}
+
 func (pm *MultiPoolerManager) startPostgreSQLAfterRestore(ctx context.Context, backupID string) error {
 	pgctldClient := pm.getPgCtldClient()
 	if pgctldClient == nil {
@@ -637,6 +804,12 @@ func (pm *MultiPoolerManager) listBackups(ctx context.Context) ([]*multipoolerma
 			backupSizeBytes = pgBackup.Info.Size
 		}
 
+		// Completion time of finished backups
+		var completedAt *timestamppb.Timestamp
+		if status == multipoolermanagerdata.BackupMetadata_COMPLETE && pgBackup.Timestamp.Stop > 0 {
+			completedAt = timestamppb.New(time.Unix(pgBackup.Timestamp.Stop, 0))
+		}
+
 		backups = append(backups, &multipoolermanagerdata.BackupMetadata{
 			BackupId:        pgBackup.Label,
 			Status:          status,
@@ -648,6 +821,8 @@ func (pm *MultiPoolerManager) listBackups(ctx context.Context) ([]*multipoolerma
 			Type:            pgBackup.Type,
 			MultipoolerId:   multipoolerID,
 			PoolerType:      poolerType,
+			PriorBackupId:   pgBackup.Prior,
+			CompletedAt:     completedAt,
 		})
 	}
 
@@ -664,6 +839,7 @@ type pgBackRestInfo struct {
 type pgBackRestBackup struct {
 	Label      string              `json:"label"`
 	Type       string              `json:"type"`
+	Prior      string              `json:"prior"` // Backup a diff or incr backup is based on, empty for full backups
 	Error      bool                `json:"error"`
 	Timestamp  pgBackRestTimestamp `json:"timestamp"`
 	Annotation map[string]string   `json:"annotation,omitempty"`
@@ -896,6 +1072,134 @@ func (pm *MultiPoolerManager) GetBackupByJobId(ctx context.Context, jobID string
 	return nil, nil
 }
 
+// VerifyBackup checks the integrity of an existing backup against the
+// repository and returns the pgbackrest verify output.
+func (pm *MultiPoolerManager) VerifyBackup(ctx context.Context, backupID string) (string, error) {
+	if backupID == "" {
+		return "", mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "backup_id is required")
+	}
+
+	// We can't proceed without the topo, which is loaded asynchronously at startup
+	if err := pm.checkReady(); err != nil {
+		return "", err
+	}
+
+	// Acquire the action lock to ensure only one operation runs at a time
+	var err error
+	ctx, err = pm.actionLock.Acquire(ctx, "VerifyBackup")
+	if err != nil {
+		return "", err
+	}
+	defer pm.actionLock.Release(ctx)
+
+	return pm.verifyBackupLocked(ctx, backupID)
+}
+
+// verifyBackupLocked runs pgbackrest verify for a single backup set.
+// Caller must hold the action lock.
+func (pm *MultiPoolerManager) verifyBackupLocked(ctx context.Context, backupID string) (string, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return "", err
+	}
+
+	configPath, err := pm.requireBackup(ctx, backupID)
+	if err != nil {
+		return "", err
+	}
+
+	verifyCtx, cancel := context.WithTimeout(ctx, backup.VerifyTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(verifyCtx, "pgbackrest",
+		"--stanza="+pm.stanzaName(),
+		"--config="+configPath,
+		"--set="+backupID,
+		"verify")
+
+	output, err := pm.runLongCommand(verifyCtx, cmd, "pgbackrest verify backup_id="+backupID)
+	if err != nil {
+		return "", mterrors.New(mtrpcpb.Code_INTERNAL,
+			fmt.Sprintf("pgbackrest verify failed for backup %s: %v\nOutput: %s", backupID, err, string(output)))
+	}
+
+	return string(output), nil
+}
+
+// DeleteBackup removes a backup from the repository. Backups that depend on
+// it (differential and incremental backups of a full backup) are removed too.
+func (pm *MultiPoolerManager) DeleteBackup(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "backup_id is required")
+	}
+
+	// We can't proceed without the topo, which is loaded asynchronously at startup
+	if err := pm.checkReady(); err != nil {
+		return err
+	}
+
+	// Acquire the action lock to ensure only one operation runs at a time
+	var err error
+	ctx, err = pm.actionLock.Acquire(ctx, "DeleteBackup")
+	if err != nil {
+		return err
+	}
+	defer pm.actionLock.Release(ctx)
+
+	return pm.deleteBackupLocked(ctx, backupID)
+}
+
+// deleteBackupLocked expires a single backup set with pgbackrest.
+// Caller must hold the action lock.
+func (pm *MultiPoolerManager) deleteBackupLocked(ctx context.Context, backupID string) error {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return err
+	}
+
+	configPath, err := pm.requireBackup(ctx, backupID)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "pgbackrest",
+		"--stanza="+pm.stanzaName(),
+		"--config="+configPath,
+		"--set="+backupID,
+		"expire")
+
+	output, err := pm.runLongCommand(ctx, cmd, "pgbackrest expire backup_id="+backupID)
+	if err != nil {
+		return mterrors.New(mtrpcpb.Code_INTERNAL,
+			fmt.Sprintf("pgbackrest expire failed for backup %s: %v\nOutput: %s", backupID, err, string(output)))
+	}
+
+	pm.logger.InfoContext(ctx, "Deleted backup", "backup_id", backupID)
+	return nil
+}
+
+// requireBackup returns the pgbackrest config path after checking that
+// backupID names a backup in the repository. Caller must hold the action lock.
+func (pm *MultiPoolerManager) requireBackup(ctx context.Context, backupID string) (string, error) {
+	backups, err := pm.listBackups(ctx)
+	if err != nil {
+		return "", mterrors.Wrap(err, "failed to list backups")
+	}
+
+	found := false
+	for _, b := range backups {
+		if b.BackupId == backupID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", mterrors.New(mtrpcpb.Code_NOT_FOUND,
+			fmt.Sprintf("backup %s not found", backupID))
+	}
+
+	// listBackups has already written the config
+	return pm.initPgBackRest(ctx, NotForBackup)
+}
+
 // pgbackrestPath returns the path to the pgbackrest config and data directory
 func (pm *MultiPoolerManager) pgbackrestPath() string {
 	return filepath.Join(pm.multipooler.PoolerDir, "pgbackrest")
@@ -841,7 +841,7 @@ func TestRestoreFromBackup_ActionLock(t *testing.T) {
 	defer cancel()
 
 	// RestoreFromBackup should timeout waiting for the lock
-	err = pm.RestoreFromBackup(timeoutCtx, "test-backup-id")
+	err = pm.RestoreFromBackup(timeoutCtx, "test-backup-id", RecoveryTarget{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "context deadline exceeded")
 
@@ -892,7 +892,7 @@ func TestRestoreFromBackup_ActionLockReleased(t *testing.T) {
 	pm := createTestManagerWithBackupLocation(tmpDir, "", "", clustermetadatapb.PoolerType_REPLICA, tmpDir)
 
 	// Call RestoreFromBackup - it will fail (precondition), but should release the lock
-	_ = pm.RestoreFromBackup(ctx, "test-backup-id")
+	_ = pm.RestoreFromBackup(ctx, "test-backup-id", RecoveryTarget{})
 
 	// Verify lock was released by acquiring it with a short timeout
 	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
@@ -942,6 +942,157 @@ func TestGetBackupByJobId_Found(t *testing.T) {
 	assert.Equal(t, "20251203-143045.123456_mp-cell-1", resp.Backup.JobId)
 }
 
+func TestVerifyAndDeleteBackup_Validation(t *testing.T) {
+	ctx := t.Context()
+	tmpDir := t.TempDir()
+
+	pm := createTestManagerWithBackupLocation(tmpDir, "", "", clustermetadatapb.PoolerType_REPLICA, tmpDir)
+
+	t.Run("verify requires backup_id", func(t *testing.T) {
+		_, err := pm.VerifyBackup(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "backup_id is required")
+	})
+
+	t.Run("delete requires backup_id", func(t *testing.T) {
+		err := pm.DeleteBackup(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "backup_id is required")
+	})
+
+	// The repository has no stanza yet, so every backup is unknown
+	t.Run("verify unknown backup", func(t *testing.T) {
+		_, err := pm.VerifyBackup(ctx, "20251203-143045F")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("delete unknown backup", func(t *testing.T) {
+		err := pm.DeleteBackup(ctx, "20251203-143045F")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	// Verify the lock was released by acquiring it with a short timeout
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	lockCtx, err := pm.actionLock.Acquire(timeoutCtx, "verify-release")
+	require.NoError(t, err, "Lock should be released after VerifyBackup and DeleteBackup return")
+	pm.actionLock.Release(lockCtx)
+}
+
+func TestRecoveryTarget(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	t.Run("validate", func(t *testing.T) {
+		assert.NoError(t, RecoveryTarget{}.validate(now))
+		assert.NoError(t, RecoveryTarget{Time: now.Add(-time.Hour)}.validate(now))
+		assert.NoError(t, RecoveryTarget{LSN: "0/3000060"}.validate(now))
+
+		err := RecoveryTarget{Time: now.Add(-time.Hour), LSN: "0/3000060"}.validate(now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of target_time and target_lsn")
+
+		err = RecoveryTarget{Time: now.Add(time.Minute)}.validate(now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "in the future")
+
+		err = RecoveryTarget{LSN: "not-an-lsn"}.validate(now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid target_lsn")
+	})
+
+	t.Run("pgbackrest args", func(t *testing.T) {
+		assert.Equal(t, []string{"--type=standby"}, RecoveryTarget{}.pgBackRestArgs())
+
+		target := time.Date(2026, 10, 16, 13, 30, 15, 250000000, time.FixedZone("CEST", 2*60*60))
+		assert.Equal(t, []string{
+			"--type=time",
+			"--target=2026-10-16 11:30:15.25+00",
+			"--target-action=pause",
+		}, RecoveryTarget{Time: target}.pgBackRestArgs())
+
+		assert.Equal(t, []string{
+			"--type=lsn",
+			"--target=0/3000060",
+			"--target-action=pause",
+		}, RecoveryTarget{LSN: "0/3000060"}.pgBackRestArgs())
+	})
+}
+
+func TestSelectBackupBeforeLSN(t *testing.T) {
+	backups := []*multipoolermanagerdata.BackupMetadata{
+		{BackupId: "20261016-100000F", Status: multipoolermanagerdata.BackupMetadata_COMPLETE, FinalLsn: "0/2000100"},
+		{BackupId: "20261016-100000F_20261016-110000D", Status: multipoolermanagerdata.BackupMetadata_COMPLETE, FinalLsn: "0/5000100"},
+		{BackupId: "20261016-120000F", Status: multipoolermanagerdata.BackupMetadata_INCOMPLETE, FinalLsn: "0/6000100"},
+		{BackupId: "20261016-130000F", Status: multipoolermanagerdata.BackupMetadata_COMPLETE, FinalLsn: "1/0000100"},
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		want    string
+		wantErr string
+	}{
+		{name: "between backups", target: "0/4000000", want: "20261016-100000F"},
+		{name: "exactly at backup end", target: "0/5000100", want: "20261016-100000F_20261016-110000D"},
+		{name: "skips incomplete backups", target: "0/7000000", want: "20261016-100000F_20261016-110000D"},
+		{name: "after latest backup", target: "2/0", want: "20261016-130000F"},
+		{name: "before first backup", target: "0/1000000", wantErr: "no complete backup ends before"},
+		{name: "invalid target", target: "xyz", wantErr: "invalid target_lsn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectBackupBeforeLSN(backups, tt.target)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateBackupChain(t *testing.T) {
+	complete := multipoolermanagerdata.BackupMetadata_COMPLETE
+	backups := []*multipoolermanagerdata.BackupMetadata{
+		{BackupId: "20261016-100000F", Type: "full", Status: complete},
+		{BackupId: "20261016-100000F_20261016-110000D", Type: "diff", Status: complete, PriorBackupId: "20261016-100000F"},
+		{BackupId: "20261016-100000F_20261016-120000I", Type: "incr", Status: complete, PriorBackupId: "20261016-100000F_20261016-110000D"},
+		{BackupId: "20261016-130000F", Type: "full", Status: multipoolermanagerdata.BackupMetadata_INCOMPLETE},
+		{BackupId: "20261016-130000F_20261016-140000I", Type: "incr", Status: complete, PriorBackupId: "20261016-130000F"},
+		{BackupId: "20261016-090000F_20261016-150000D", Type: "diff", Status: complete, PriorBackupId: "20261016-090000F"},
+	}
+
+	tests := []struct {
+		name     string
+		backupID string
+		wantErr  string
+	}{
+		{name: "full backup", backupID: "20261016-100000F"},
+		{name: "incremental on differential on full", backupID: "20261016-100000F_20261016-120000I"},
+		{name: "unknown backup", backupID: "20261016-160000F", wantErr: "backup 20261016-160000F not found"},
+		{name: "incomplete prior", backupID: "20261016-130000F_20261016-140000I", wantErr: "backup 20261016-130000F is not complete"},
+		{name: "expired prior", backupID: "20261016-090000F_20261016-150000D", wantErr: "prior backup 20261016-090000F not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBackupChain(backups, tt.backupID)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestInitPgBackRest(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,99 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+	pgctldpb "github.com/multigres/multigres/go/pb/pgctldservice"
+	"github.com/multigres/multigres/go/services/pgctld"
+)
+
+// InstallExtension creates or updates an extension. pgctld first checks that
+// the files of the extension, including its shared library, are installed
+// for the PostgreSQL binaries of the pooler. With req.CheckOnly nothing else
+// is done, which is how replicas are checked: they get the extension through
+// replication from their primary, and only need its files.
+func (pm *MultiPoolerManager) InstallExtension(ctx context.Context, req *multipoolermanagerdatapb.InstallExtensionRequest) (*multipoolermanagerdatapb.InstallExtensionResponse, error) {
+	if err := pm.checkReady(); err != nil {
+		return nil, mterrors.Wrap(err, "multipooler not ready")
+	}
+
+	if err := pgctld.ValidateExtensionName(req.Name); err != nil {
+		return nil, mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+
+	pm.logger.InfoContext(ctx, "InstallExtension RPC called",
+		"name", req.Name,
+		"version", req.Version,
+		"check_only", req.CheckOnly)
+
+	if pm.pgctldClient == nil {
+		return nil, mterrors.New(mtrpcpb.Code_UNAVAILABLE, "pgctld client not available")
+	}
+
+	checkResp, err := pm.pgctldClient.CheckExtension(ctx, &pgctldpb.CheckExtensionRequest{
+		Name:    req.Name,
+		Version: req.Version,
+	})
+	if err != nil {
+		return nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION, err.Error())
+	}
+	if req.CheckOnly {
+		return &multipoolermanagerdatapb.InstallExtensionResponse{
+			OldVersion:     checkResp.InstalledVersion,
+			NewVersion:     checkResp.InstalledVersion,
+			DefaultVersion: checkResp.DefaultVersion,
+		}, nil
+	}
+
+	ctx, err = pm.actionLock.Acquire(ctx, "InstallExtension")
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to acquire action lock")
+	}
+	defer pm.actionLock.Release(ctx)
+
+	inRecovery, err := pm.isInRecovery(ctx)
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to determine the role of PostgreSQL")
+	}
+	if inRecovery {
+		return nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+			"PostgreSQL is a standby: extensions are installed on the primary and replicate")
+	}
+
+	installResp, err := pm.pgctldClient.InstallExtension(ctx, &pgctldpb.InstallExtensionRequest{
+		Name:    req.Name,
+		Version: req.Version,
+		Schema:  req.Schema,
+		Cascade: req.Cascade,
+	})
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to install extension")
+	}
+	pm.logger.InfoContext(ctx, "Installed extension",
+		"name", req.Name,
+		"old_version", installResp.OldVersion,
+		"new_version", installResp.NewVersion)
+
+	return &multipoolermanagerdatapb.InstallExtensionResponse{
+		OldVersion:     installResp.OldVersion,
+		NewVersion:     installResp.NewVersion,
+		DefaultVersion: checkResp.DefaultVersion,
+	}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/multipooler/executor/mock"
+
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+func TestInstallExtension_InvalidName(t *testing.T) {
+	mockQueryService := mock.NewQueryService()
+	expectStartupQueries(mockQueryService)
+	pm, _ := setupPromoteTestManager(t, mockQueryService)
+
+	for _, name := range []string{"", "pg_stat_statements; DROP TABLE t", "../postgis"} {
+		_, err := pm.InstallExtension(context.Background(), &multipoolermanagerdatapb.InstallExtensionRequest{Name: name})
+		require.Error(t, err, name)
+		assert.Equal(t, mtrpcpb.Code_INVALID_ARGUMENT, mterrors.Code(err))
+		assert.Contains(t, err.Error(), "invalid extension name")
+	}
+}
+
+func TestInstallExtension_CheckOnly(t *testing.T) {
+	mockQueryService := mock.NewQueryService()
+	expectStartupQueries(mockQueryService)
+	pm, _ := setupPromoteTestManager(t, mockQueryService)
+
+	// Replicas are only checked, without querying their role
+	resp, err := pm.InstallExtension(context.Background(), &multipoolermanagerdatapb.InstallExtensionRequest{
+		Name:      "pg_stat_statements",
+		CheckOnly: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", resp.DefaultVersion)
+}
+
+func TestInstallExtension_NotOnStandby(t *testing.T) {
+	mockQueryService := mock.NewQueryService()
+	expectStartupQueries(mockQueryService)
+	pm, _ := setupPromoteTestManager(t, mockQueryService)
+
+	mockQueryService.AddQueryPatternOnce("SELECT pg_is_in_recovery", mock.MakeQueryResult([]string{"pg_is_in_recovery"}, [][]any{{"t"}}))
+
+	_, err := pm.InstallExtension(context.Background(), &multipoolermanagerdatapb.InstallExtensionRequest{Name: "pg_stat_statements"})
+	require.Error(t, err)
+	assert.Equal(t, mtrpcpb.Code_FAILED_PRECONDITION, mterrors.Code(err))
+	assert.Contains(t, err.Error(), "installed on the primary")
+}
+
+func TestInstallExtension_Primary(t *testing.T) {
+	mockQueryService := mock.NewQueryService()
+	expectStartupQueries(mockQueryService)
+	pm, _ := setupPromoteTestManager(t, mockQueryService)
+
+	mockQueryService.AddQueryPatternOnce("SELECT pg_is_in_recovery", mock.MakeQueryResult([]string{"pg_is_in_recovery"}, [][]any{{"f"}}))
+
+	resp, err := pm.InstallExtension(context.Background(), &multipoolermanagerdatapb.InstallExtensionRequest{Name: "pg_stat_statements"})
+	require.NoError(t, err)
+	assert.Equal(t, "", resp.OldVersion)
+	assert.Equal(t, "1.0", resp.NewVersion)
+}
@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/multigres/multigres/go/common/backup"
 	"github.com/multigres/multigres/go/common/mterrors"
 	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
@@ -442,6 +443,7 @@ func (pm *MultiPoolerManager) removeArchiveConfigFromAutoConf() error {
 		// Skip archive-related lines
 		if strings.HasPrefix(trimmed, "archive_mode") ||
 			strings.HasPrefix(trimmed, "archive_command") ||
+			strings.HasPrefix(trimmed, "archive_timeout") ||
 			trimmed == "# Archive mode for pgbackrest backups" {
 			continue
 		}
@@ -482,7 +484,8 @@ func (pm *MultiPoolerManager) configureArchiveMode(ctx context.Context) error {
 # Archive mode for pgbackrest backups
 archive_mode = 'on'
 archive_command = 'pgbackrest --stanza=%s --config=%s archive-push %%p'
-`, pm.stanzaName(), configPath)
+archive_timeout = '%s'
+`, pm.stanzaName(), configPath, backup.ArchiveTimeout)
 
 	f, err := os.OpenFile(autoConfPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
 	if err != nil {
@@ -880,6 +880,38 @@ func (m *mockPgctldClient) PgRewind(ctx context.Context, req *pgctldpb.PgRewindR
 	return &pgctldpb.PgRewindResponse{}, nil
 }
 
+func (m *mockPgctldClient) InitReplica(ctx context.Context, req *pgctldpb.InitReplicaRequest, opts ...grpc.CallOption) (*pgctldpb.InitReplicaResponse, error) {
+	return &pgctldpb.InitReplicaResponse{}, nil
+}
+
+func (m *mockPgctldClient) UpgradeDataDir(ctx context.Context, req *pgctldpb.UpgradeDataDirRequest, opts ...grpc.CallOption) (*pgctldpb.UpgradeDataDirResponse, error) {
+	return &pgctldpb.UpgradeDataDirResponse{}, nil
+}
+
+func (m *mockPgctldClient) RollbackUpgrade(ctx context.Context, req *pgctldpb.RollbackUpgradeRequest, opts ...grpc.CallOption) (*pgctldpb.RollbackUpgradeResponse, error) {
+	return &pgctldpb.RollbackUpgradeResponse{}, nil
+}
+
+func (m *mockPgctldClient) FinalizeUpgrade(ctx context.Context, req *pgctldpb.FinalizeUpgradeRequest, opts ...grpc.CallOption) (*pgctldpb.FinalizeUpgradeResponse, error) {
+	return &pgctldpb.FinalizeUpgradeResponse{}, nil
+}
+
+func (m *mockPgctldClient) ApplyConfig(ctx context.Context, req *pgctldpb.ApplyConfigRequest, opts ...grpc.CallOption) (*pgctldpb.ApplyConfigResponse, error) {
+	return &pgctldpb.ApplyConfigResponse{}, nil
+}
+
+func (m *mockPgctldClient) CheckConfigDrift(ctx context.Context, req *pgctldpb.CheckConfigDriftRequest, opts ...grpc.CallOption) (*pgctldpb.CheckConfigDriftResponse, error) {
+	return &pgctldpb.CheckConfigDriftResponse{}, nil
+}
+
+func (m *mockPgctldClient) CheckExtension(ctx context.Context, req *pgctldpb.CheckExtensionRequest, opts ...grpc.CallOption) (*pgctldpb.CheckExtensionResponse, error) {
+	return &pgctldpb.CheckExtensionResponse{}, nil
+}
+
+func (m *mockPgctldClient) InstallExtension(ctx context.Context, req *pgctldpb.InstallExtensionRequest, opts ...grpc.CallOption) (*pgctldpb.InstallExtensionResponse, error) {
+	return &pgctldpb.InstallExtensionResponse{}, nil
+}
+
 // mockPgctldClientWithCounter extends mockPgctldClient with call counters
 type mockPgctldClientWithCounter struct {
 	mockPgctldClient
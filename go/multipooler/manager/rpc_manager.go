@@ -918,6 +918,9 @@ func (pm *MultiPoolerManager) emergencyDemoteLocked(ctx context.Context, consens
 		return nil, err
 	}
 
+	// Give up the primary lease so that the next primary need not wait for it
+	pm.releasePrimaryLease(ctx, pm.currentPrimaryTerm())
+
 	pm.logger.InfoContext(ctx, "Demote completed successfully",
 		"final_lsn", finalLSN,
 		"consensus_term", consensusTerm,
@@ -1223,6 +1226,14 @@ func (pm *MultiPoolerManager) Promote(ctx context.Context, consensusTerm int64,
 		pm.replTracker.MakePrimary()
 	}
 
+	// Acquire the primary lease before the gateways route to this pooler
+	if err := pm.acquirePrimaryLease(ctx, consensusTerm); err != nil {
+		pm.logger.ErrorContext(ctx, "Failed to acquire primary lease - promotion failed",
+			"term", consensusTerm,
+			"error", err)
+		return nil, mterrors.Wrap(err, "promotion failed: could not acquire the primary lease")
+	}
+
 	// Update topology if needed
 	if err := pm.updateTopologyAfterPromotion(ctx, state); err != nil {
 		return nil, err
@@ -333,6 +333,22 @@ func TestActionLock_MutationMethodsTimeout(t *testing.T) {
 				return manager.UpdateSynchronousStandbyList(ctx, multipoolermanagerdatapb.StandbyUpdateOperation_STANDBY_UPDATE_OPERATION_ADD, []*clustermetadatapb.ID{serviceID}, true, 0, true)
 			},
 		},
+		{
+			name:       "UpgradePostgres times out when lock is held",
+			poolerType: clustermetadatapb.PoolerType_PRIMARY,
+			callMethod: func(ctx context.Context) error {
+				_, err := manager.UpgradePostgres(ctx, &multipoolermanagerdatapb.UpgradePostgresRequest{NewBinDir: "/usr/lib/postgresql/17/bin"})
+				return err
+			},
+		},
+		{
+			name:       "FinishPostgresUpgrade times out when lock is held",
+			poolerType: clustermetadatapb.PoolerType_PRIMARY,
+			callMethod: func(ctx context.Context) error {
+				_, err := manager.FinishPostgresUpgrade(ctx, &multipoolermanagerdatapb.FinishPostgresUpgradeRequest{})
+				return err
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,235 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+	pgctldpb "github.com/multigres/multigres/go/pb/pgctldservice"
+)
+
+// UpgradePostgres upgrades PostgreSQL to the major version of the binaries in
+// req.NewBinDir. A primary is upgraded in place with pg_upgrade --link and
+// analyzed once restarted, since pg_upgrade does not carry over planner
+// statistics. pg_upgrade cannot upgrade standbys, so a replica is instead
+// cloned from req.Source, its primary, which must already be upgraded.
+//
+// The upgrade is checked first, while PostgreSQL still serves; with
+// req.CheckOnly nothing else is done, and a pooler already on the new version
+// is left as is so that upgrades can be retried. pgctld keeps the old data
+// directory as a rollback point until FinishPostgresUpgrade. If the upgrade
+// fails, the old data directory is restored and PostgreSQL restarted on the
+// old version.
+func (pm *MultiPoolerManager) UpgradePostgres(ctx context.Context, req *multipoolermanagerdatapb.UpgradePostgresRequest) (*multipoolermanagerdatapb.UpgradePostgresResponse, error) {
+	if err := pm.checkReady(); err != nil {
+		return nil, mterrors.Wrap(err, "multipooler not ready")
+	}
+
+	if req.NewBinDir == "" {
+		return nil, mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "new_bin_dir is required")
+	}
+
+	var sourceHost string
+	var sourcePort int32
+	if req.Source != nil {
+		if req.Source.Hostname == "" {
+			return nil, mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "source hostname is required")
+		}
+		port, ok := req.Source.PortMap["postgres"]
+		if !ok {
+			return nil, mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "postgres port not found in source pooler's port map")
+		}
+		sourceHost, sourcePort = req.Source.Hostname, port
+	}
+
+	pm.logger.InfoContext(ctx, "UpgradePostgres RPC called",
+		"new_bin_dir", req.NewBinDir,
+		"check_only", req.CheckOnly,
+		"source_host", sourceHost)
+
+	ctx, err := pm.actionLock.Acquire(ctx, "UpgradePostgres")
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to acquire action lock")
+	}
+	defer pm.actionLock.Release(ctx)
+
+	if pm.pgctldClient == nil {
+		return nil, mterrors.New(mtrpcpb.Code_UNAVAILABLE, "pgctld client not available")
+	}
+
+	return pm.upgradePostgresLocked(ctx, req.NewBinDir, req.CheckOnly, sourceHost, sourcePort)
+}
+
+// upgradePostgresLocked checks the upgrade while PostgreSQL still serves,
+// then stops PostgreSQL, upgrades its data directory and starts it again.
+// The caller must hold the action lock.
+func (pm *MultiPoolerManager) upgradePostgresLocked(ctx context.Context, newBinDir string, checkOnly bool, sourceHost string, sourcePort int32) (*multipoolermanagerdatapb.UpgradePostgresResponse, error) {
+	if err := AssertActionLockHeld(ctx); err != nil {
+		return nil, err
+	}
+
+	asReplica := sourceHost != ""
+	inRecovery, err := pm.isInRecovery(ctx)
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to determine the role of PostgreSQL")
+	}
+	if inRecovery && !asReplica {
+		return nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+			"PostgreSQL is a standby: replicas are upgraded by cloning their upgraded primary")
+	}
+	if !inRecovery && asReplica {
+		return nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+			"PostgreSQL is a primary: primaries are upgraded in place")
+	}
+
+	upgradeReq := &pgctldpb.UpgradeDataDirRequest{NewBinDir: newBinDir, CheckOnly: true}
+	if asReplica {
+		upgradeReq.SourceHost = sourceHost
+		upgradeReq.SourcePort = sourcePort
+		upgradeReq.ApplicationName = generateApplicationName(pm.serviceID)
+	}
+	checkResp, err := pm.pgctldClient.UpgradeDataDir(ctx, upgradeReq)
+	if err != nil {
+		return nil, mterrors.Wrap(err, "upgrade check failed")
+	}
+	// Equal versions mean an earlier attempt already upgraded this pooler
+	if checkOnly || checkResp.OldVersion == checkResp.NewVersion {
+		return &multipoolermanagerdatapb.UpgradePostgresResponse{
+			OldVersion: checkResp.OldVersion,
+			NewVersion: checkResp.NewVersion,
+			Output:     checkResp.Output,
+		}, nil
+	}
+
+	// Pause monitoring so that PostgreSQL is not restarted during the upgrade
+	resumeMonitor, err := pm.PausePostgresMonitor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resumeMonitor(ctx)
+
+	if err := pm.stopPostgresIfRunning(ctx); err != nil {
+		return nil, mterrors.Wrap(err, "failed to stop PostgreSQL before upgrade")
+	}
+
+	upgradeReq.CheckOnly = false
+	upgradeResp, err := pm.pgctldClient.UpgradeDataDir(ctx, upgradeReq)
+	if err != nil {
+		pm.logger.ErrorContext(ctx, "Upgrade failed, restarting PostgreSQL on the old version", "error", err)
+		if _, rollbackErr := pm.pgctldClient.RollbackUpgrade(ctx, &pgctldpb.RollbackUpgradeRequest{}); rollbackErr != nil &&
+			!strings.Contains(rollbackErr.Error(), "no upgrade to roll back") {
+			pm.logger.ErrorContext(ctx, "Failed to roll back upgrade", "error", rollbackErr)
+		}
+		if restartErr := pm.startPostgresAndReopen(ctx); restartErr != nil {
+			pm.logger.ErrorContext(ctx, "Failed to restart PostgreSQL after failed upgrade", "error", restartErr)
+		}
+		return nil, mterrors.Wrap(err, "failed to upgrade data directory")
+	}
+
+	pm.logger.InfoContext(ctx, "Data directory upgraded, starting PostgreSQL",
+		"old_version", upgradeResp.OldVersion,
+		"new_version", upgradeResp.NewVersion)
+	if err := pm.startPostgresAndReopen(ctx); err != nil {
+		return nil, mterrors.Wrap(err, "failed to start upgraded PostgreSQL")
+	}
+
+	// Replicas receive the statistics of their primary through replication
+	if !asReplica {
+		pm.logger.InfoContext(ctx, "Analyzing upgraded database")
+		if err := pm.exec(ctx, "ANALYZE"); err != nil {
+			return nil, mterrors.Wrap(err, "failed to analyze upgraded database")
+		}
+	}
+
+	pm.logger.InfoContext(ctx, "UpgradePostgres completed successfully",
+		"old_version", upgradeResp.OldVersion,
+		"new_version", upgradeResp.NewVersion)
+	return &multipoolermanagerdatapb.UpgradePostgresResponse{
+		OldVersion: upgradeResp.OldVersion,
+		NewVersion: upgradeResp.NewVersion,
+		Output:     upgradeResp.Output,
+	}, nil
+}
+
+// FinishPostgresUpgrade ends the last major version upgrade. It removes the
+// old data directory, or with rollback restores it and restarts PostgreSQL on
+// the old version. pgctld refuses to roll back an in-place upgrade once the
+// upgraded server was started, as both share their data files.
+func (pm *MultiPoolerManager) FinishPostgresUpgrade(ctx context.Context, req *multipoolermanagerdatapb.FinishPostgresUpgradeRequest) (*multipoolermanagerdatapb.FinishPostgresUpgradeResponse, error) {
+	if err := pm.checkReady(); err != nil {
+		return nil, mterrors.Wrap(err, "multipooler not ready")
+	}
+
+	pm.logger.InfoContext(ctx, "FinishPostgresUpgrade RPC called", "rollback", req.Rollback)
+
+	ctx, err := pm.actionLock.Acquire(ctx, "FinishPostgresUpgrade")
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to acquire action lock")
+	}
+	defer pm.actionLock.Release(ctx)
+
+	if pm.pgctldClient == nil {
+		return nil, mterrors.New(mtrpcpb.Code_UNAVAILABLE, "pgctld client not available")
+	}
+
+	if !req.Rollback {
+		if _, err := pm.pgctldClient.FinalizeUpgrade(ctx, &pgctldpb.FinalizeUpgradeRequest{}); err != nil {
+			return nil, mterrors.Wrap(err, "failed to finalize upgrade")
+		}
+		pm.logger.InfoContext(ctx, "FinishPostgresUpgrade completed successfully", "rollback", false)
+		return &multipoolermanagerdatapb.FinishPostgresUpgradeResponse{}, nil
+	}
+
+	resumeMonitor, err := pm.PausePostgresMonitor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resumeMonitor(ctx)
+
+	if err := pm.stopPostgresIfRunning(ctx); err != nil {
+		return nil, mterrors.Wrap(err, "failed to stop PostgreSQL before rollback")
+	}
+
+	_, rollbackErr := pm.pgctldClient.RollbackUpgrade(ctx, &pgctldpb.RollbackUpgradeRequest{})
+	// Whether or not the rollback succeeded, PostgreSQL goes back to serving
+	if err := pm.startPostgresAndReopen(ctx); err != nil {
+		return nil, mterrors.Wrap(err, "failed to start PostgreSQL after rollback")
+	}
+	if rollbackErr != nil {
+		return nil, mterrors.Wrap(rollbackErr, "failed to roll back upgrade")
+	}
+
+	pm.logger.InfoContext(ctx, "FinishPostgresUpgrade completed successfully", "rollback", true)
+	return &multipoolermanagerdatapb.FinishPostgresUpgradeResponse{}, nil
+}
+
+// startPostgresAndReopen starts PostgreSQL and reopens the manager's
+// connections after it was stopped with stopPostgresIfRunning.
+func (pm *MultiPoolerManager) startPostgresAndReopen(ctx context.Context) error {
+	if _, err := pm.pgctldClient.Start(ctx, &pgctldpb.StartRequest{}); err != nil {
+		return mterrors.Wrap(err, "failed to start PostgreSQL")
+	}
+	if err := pm.Open(); err != nil {
+		return mterrors.Wrap(err, "failed to reopen query service controller")
+	}
+	if err := pm.waitForDatabaseConnection(ctx); err != nil {
+		return mterrors.Wrap(err, "failed to reconnect to database")
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/multipooler/executor/mock"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+func TestUpgradePostgres_Validation(t *testing.T) {
+	mockQueryService := mock.NewQueryService()
+	expectStartupQueries(mockQueryService)
+	pm, _ := setupPromoteTestManager(t, mockQueryService)
+
+	tests := []struct {
+		name string
+		req  *multipoolermanagerdatapb.UpgradePostgresRequest
+		want string
+	}{
+		{
+			name: "missing new_bin_dir",
+			req:  &multipoolermanagerdatapb.UpgradePostgresRequest{},
+			want: "new_bin_dir is required",
+		},
+		{
+			name: "source without hostname",
+			req: &multipoolermanagerdatapb.UpgradePostgresRequest{
+				NewBinDir: "/usr/lib/postgresql/17/bin",
+				Source:    &clustermetadatapb.MultiPooler{PortMap: map[string]int32{"postgres": 5432}},
+			},
+			want: "source hostname is required",
+		},
+		{
+			name: "source without postgres port",
+			req: &multipoolermanagerdatapb.UpgradePostgresRequest{
+				NewBinDir: "/usr/lib/postgresql/17/bin",
+				Source:    &clustermetadatapb.MultiPooler{Hostname: "primary.example"},
+			},
+			want: "postgres port not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pm.UpgradePostgres(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, mtrpcpb.Code_INVALID_ARGUMENT, mterrors.Code(err))
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestUpgradePostgres_StandbyNotUpgradedInPlace(t *testing.T) {
+	mockQueryService := mock.NewQueryService()
+	expectStartupQueries(mockQueryService)
+	pm, _ := setupPromoteTestManager(t, mockQueryService)
+
+	// pg_upgrade cannot upgrade a standby: it must be cloned from its primary
+	mockQueryService.AddQueryPatternOnce("SELECT pg_is_in_recovery", mock.MakeQueryResult([]string{"pg_is_in_recovery"}, [][]any{{"t"}}))
+
+	_, err := pm.UpgradePostgres(context.Background(), &multipoolermanagerdatapb.UpgradePostgresRequest{
+		NewBinDir: "/usr/lib/postgresql/17/bin",
+	})
+	require.Error(t, err)
+	assert.Equal(t, mtrpcpb.Code_FAILED_PRECONDITION, mterrors.Code(err))
+	assert.Contains(t, err.Error(), "cloning their upgraded primary")
+}
@@ -0,0 +1,410 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notifier shares a single PostgreSQL connection between all the
+// LISTEN/NOTIFY listeners of a multipooler.
+//
+// Pooled connections are handed to a different session for every query, so
+// they cannot hold a LISTEN. Instead, the Notifier keeps one dedicated
+// connection that listens on the union of the channels subscribed to, and
+// fans the notifications it receives out to the subscribers. If the
+// connection is lost, a new one is opened and the LISTENs are replayed.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+const (
+	// subscriptionBufferSize is the number of notifications buffered for a
+	// subscriber. A subscriber that falls further behind is dropped.
+	subscriptionBufferSize = 1024
+
+	// reconnectDelay is the time to wait before reconnecting after the
+	// listening connection failed.
+	reconnectDelay = time.Second
+)
+
+var (
+	// ErrClosed is the error of subscriptions ended by closing the Notifier.
+	ErrClosed = errors.New("notifier closed")
+
+	// ErrOverflow is the error of a subscription that was dropped because it
+	// did not keep up with its notifications.
+	ErrOverflow = errors.New("too many notifications pending for subscriber")
+)
+
+// Conn is the connection the Notifier listens on. It is implemented by
+// *client.Conn.
+type Conn interface {
+	Query(ctx context.Context, queryStr string) ([]*sqltypes.Result, error)
+	WaitForNotification(ctx context.Context) (*client.Notification, error)
+	Close() error
+}
+
+// Notifier multiplexes LISTEN subscriptions over a single connection.
+// The connection is opened on the first subscription.
+type Notifier struct {
+	logger  *slog.Logger
+	connect func(ctx context.Context) (Conn, error)
+
+	// wake is signalled when the set of subscribed channels changes, to
+	// interrupt the wait for notifications.
+	wake chan struct{}
+
+	mu       sync.Mutex
+	channels map[string]*channelState
+	closed   bool
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// channelState tracks the subscribers of a channel.
+type channelState struct {
+	subs map[*Subscription]struct{}
+	// listening is set once LISTEN has run for the channel on the current
+	// connection.
+	listening bool
+}
+
+// NewNotifier creates a Notifier that opens its connection with connect.
+func NewNotifier(logger *slog.Logger, connect func(ctx context.Context) (Conn, error)) *Notifier {
+	return &Notifier{
+		logger:   logger,
+		connect:  connect,
+		wake:     make(chan struct{}, 1),
+		channels: make(map[string]*channelState),
+	}
+}
+
+// Subscription receives the notifications raised on one channel.
+type Subscription struct {
+	notifier      *Notifier
+	channel       string
+	notifications chan *client.Notification
+
+	// The fields below are protected by notifier.mu.
+	ready   chan struct{}
+	isReady bool
+	done    chan struct{}
+	ended   bool
+	err     error
+}
+
+// Notifications returns the channel the notifications are delivered on.
+func (s *Subscription) Notifications() <-chan *client.Notification {
+	return s.notifications
+}
+
+// Done is closed when the subscription ends without Close being called,
+// for instance because the Notifier was closed. Err then reports why.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason the subscription ended.
+func (s *Subscription) Err() error {
+	s.notifier.mu.Lock()
+	defer s.notifier.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription. The Notifier stops listening on the channel
+// once it has no subscribers left.
+func (s *Subscription) Close() {
+	n := s.notifier
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.removeLocked(s, nil)
+}
+
+// Subscribe starts listening on channel. It returns once the LISTEN is in
+// effect, so no notification raised after Subscribe returns is missed.
+func (n *Notifier) Subscribe(ctx context.Context, channel string) (*Subscription, error) {
+	s := &Subscription{
+		notifier:      n,
+		channel:       channel,
+		notifications: make(chan *client.Notification, subscriptionBufferSize),
+		ready:         make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	n.mu.Lock()
+	if n.closed {
+		n.mu.Unlock()
+		return nil, ErrClosed
+	}
+	if n.done == nil {
+		runCtx, cancel := context.WithCancel(context.Background())
+		n.cancel = cancel
+		n.done = make(chan struct{})
+		go n.run(runCtx)
+	}
+	ch := n.channels[channel]
+	if ch == nil {
+		ch = &channelState{subs: make(map[*Subscription]struct{})}
+		n.channels[channel] = ch
+	}
+	ch.subs[s] = struct{}{}
+	if ch.listening {
+		s.markReadyLocked()
+	}
+	n.mu.Unlock()
+	n.signal()
+
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+		s.Close()
+		return nil, ctx.Err()
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close stops listening and ends all subscriptions with ErrClosed.
+func (n *Notifier) Close() {
+	n.mu.Lock()
+	if n.closed {
+		n.mu.Unlock()
+		return
+	}
+	n.closed = true
+	cancel, done := n.cancel, n.done
+	for _, ch := range n.channels {
+		for s := range ch.subs {
+			n.removeLocked(s, ErrClosed)
+		}
+	}
+	n.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// signal wakes up the run loop to pick up changed subscriptions.
+func (n *Notifier) signal() {
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run owns the listening connection. It keeps the LISTENs on the connection
+// in line with the subscriptions and dispatches the notifications received.
+func (n *Notifier) run(ctx context.Context) {
+	defer close(n.done)
+
+	var conn Conn
+	defer func() {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}()
+	reset := func(err error) {
+		n.logger.WarnContext(ctx, "notification connection failed", "error", err)
+		if conn != nil {
+			_ = conn.Close()
+			conn = nil
+		}
+		n.connectionLost(err)
+	}
+
+	for ctx.Err() == nil {
+		if conn == nil {
+			c, err := n.connect(ctx)
+			if err != nil {
+				reset(fmt.Errorf("failed to connect: %w", err))
+				sleepContext(ctx, reconnectDelay)
+				continue
+			}
+			conn = c
+		}
+
+		if err := n.sync(ctx, conn); err != nil {
+			reset(err)
+			sleepContext(ctx, reconnectDelay)
+			continue
+		}
+
+		notification, woken, err := n.wait(ctx, conn)
+		switch {
+		case woken:
+		case err != nil:
+			if ctx.Err() == nil {
+				reset(err)
+			}
+		default:
+			n.dispatch(notification)
+		}
+	}
+}
+
+// sync runs LISTEN for the channels that gained subscribers and UNLISTEN
+// for the channels that lost all of them.
+func (n *Notifier) sync(ctx context.Context, conn Conn) error {
+	var listen, unlisten []string
+	n.mu.Lock()
+	for name, ch := range n.channels {
+		switch {
+		case len(ch.subs) > 0 && !ch.listening:
+			listen = append(listen, name)
+		case len(ch.subs) == 0:
+			if ch.listening {
+				unlisten = append(unlisten, name)
+			}
+			delete(n.channels, name)
+		}
+	}
+	n.mu.Unlock()
+
+	for _, name := range unlisten {
+		if _, err := conn.Query(ctx, "UNLISTEN "+ast.QuoteIdentifier(name)); err != nil {
+			return fmt.Errorf("failed to unlisten %s: %w", name, err)
+		}
+	}
+	for _, name := range listen {
+		if _, err := conn.Query(ctx, "LISTEN "+ast.QuoteIdentifier(name)); err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", name, err)
+		}
+		n.mu.Lock()
+		ch := n.channels[name]
+		if ch == nil {
+			// All subscribers left meanwhile; the next sync unlistens.
+			ch = &channelState{subs: make(map[*Subscription]struct{})}
+			n.channels[name] = ch
+		}
+		ch.listening = true
+		for s := range ch.subs {
+			s.markReadyLocked()
+		}
+		n.mu.Unlock()
+	}
+	return nil
+}
+
+// wait blocks until a notification arrives or the subscriptions change.
+func (n *Notifier) wait(ctx context.Context, conn Conn) (*client.Notification, bool, error) {
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	woken := false
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-n.wake:
+			woken = true
+			cancel()
+		case <-waitCtx.Done():
+		}
+	}()
+
+	notification, err := conn.WaitForNotification(waitCtx)
+	cancel()
+	<-stopped
+	if woken {
+		if err == nil {
+			// The notification won the race with the wake-up.
+			n.dispatch(notification)
+		}
+		return nil, true, nil
+	}
+	return notification, false, err
+}
+
+// dispatch passes a notification to the subscribers of its channel.
+func (n *Notifier) dispatch(notification *client.Notification) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ch := n.channels[notification.Channel]
+	if ch == nil {
+		return
+	}
+	for s := range ch.subs {
+		select {
+		case s.notifications <- notification:
+		default:
+			n.logger.Warn("dropping slow notification subscriber", "channel", notification.Channel)
+			n.removeLocked(s, ErrOverflow)
+		}
+	}
+}
+
+// connectionLost handles the loss of the listening connection. The LISTENs
+// are replayed on the next connection; subscribers still waiting for their
+// LISTEN fail with err.
+func (n *Notifier) connectionLost(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, ch := range n.channels {
+		ch.listening = false
+		for s := range ch.subs {
+			if !s.isReady {
+				n.removeLocked(s, err)
+			}
+		}
+	}
+}
+
+// removeLocked removes a subscription, ending it with err unless err is
+// nil. n.mu must be held.
+func (n *Notifier) removeLocked(s *Subscription, err error) {
+	if s.ended {
+		return
+	}
+	s.ended = true
+	if ch := n.channels[s.channel]; ch != nil {
+		delete(ch.subs, s)
+		if len(ch.subs) == 0 {
+			n.signal()
+		}
+	}
+	if err != nil {
+		s.err = err
+		close(s.done)
+	}
+	s.markReadyLocked()
+}
+
+// markReadyLocked unblocks Subscribe. n.mu must be held.
+func (s *Subscription) markReadyLocked() {
+	if !s.isReady {
+		s.isReady = true
+		close(s.ready)
+	}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
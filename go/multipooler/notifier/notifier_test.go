@@ -0,0 +1,193 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// fakeConn records the queries run on it and delivers the notifications
+// sent on its notify channel.
+type fakeConn struct {
+	mu      sync.Mutex
+	queries []string
+	closed  bool
+
+	notify chan *client.Notification
+	// broken makes WaitForNotification fail, simulating a lost connection.
+	broken chan struct{}
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{
+		notify: make(chan *client.Notification, 16),
+		broken: make(chan struct{}),
+	}
+}
+
+func (c *fakeConn) Query(ctx context.Context, queryStr string) ([]*sqltypes.Result, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = append(c.queries, queryStr)
+	return nil, nil
+}
+
+func (c *fakeConn) WaitForNotification(ctx context.Context) (*client.Notification, error) {
+	select {
+	case n := <-c.notify:
+		return n, nil
+	case <-c.broken:
+		return nil, errors.New("connection reset")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *fakeConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *fakeConn) Queries() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.queries)
+}
+
+// newTestNotifier returns a Notifier whose connections are taken from conns
+// in order.
+func newTestNotifier(t *testing.T, conns ...*fakeConn) *Notifier {
+	t.Helper()
+	var mu sync.Mutex
+	n := NewNotifier(slog.Default(), func(ctx context.Context) (Conn, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(conns) == 0 {
+			return nil, errors.New("no more connections")
+		}
+		c := conns[0]
+		conns = conns[1:]
+		return c, nil
+	})
+	t.Cleanup(n.Close)
+	return n
+}
+
+func receive(t *testing.T, s *Subscription) *client.Notification {
+	t.Helper()
+	select {
+	case n := <-s.Notifications():
+		return n
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for notification")
+		return nil
+	}
+}
+
+func TestSubscribeListensAndDispatches(t *testing.T) {
+	conn := newFakeConn()
+	n := newTestNotifier(t, conn)
+
+	jobs1, err := n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+	jobs2, err := n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+	mixed, err := n.Subscribe(context.Background(), "Mixed Case")
+	require.NoError(t, err)
+
+	// A channel is only listened on once, however many subscribers it has.
+	assert.ElementsMatch(t, []string{"LISTEN jobs", `LISTEN "Mixed Case"`}, conn.Queries())
+
+	conn.notify <- &client.Notification{PID: 7, Channel: "jobs", Payload: "run"}
+	assert.Equal(t, "run", receive(t, jobs1).Payload)
+	assert.Equal(t, "run", receive(t, jobs2).Payload)
+
+	conn.notify <- &client.Notification{PID: 7, Channel: "Mixed Case"}
+	assert.Equal(t, "Mixed Case", receive(t, mixed).Channel)
+	assert.Empty(t, jobs1.Notifications())
+}
+
+func TestUnlistenAfterLastSubscriber(t *testing.T) {
+	conn := newFakeConn()
+	n := newTestNotifier(t, conn)
+
+	s1, err := n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+	s2, err := n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+
+	s1.Close()
+	s2.Close()
+	require.Eventually(t, func() bool {
+		return slices.Contains(conn.Queries(), "UNLISTEN jobs")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Subscribing again listens again.
+	_, err = n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"LISTEN jobs", "UNLISTEN jobs", "LISTEN jobs"}, conn.Queries())
+}
+
+func TestListensReplayedAfterReconnect(t *testing.T) {
+	first, second := newFakeConn(), newFakeConn()
+	n := newTestNotifier(t, first, second)
+
+	s, err := n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+
+	close(first.broken)
+	require.Eventually(t, func() bool {
+		return slices.Equal(second.Queries(), []string{"LISTEN jobs"})
+	}, 5*time.Second, 10*time.Millisecond)
+
+	second.notify <- &client.Notification{Channel: "jobs", Payload: "after reconnect"}
+	assert.Equal(t, "after reconnect", receive(t, s).Payload)
+}
+
+func TestSubscribeFailsWithoutConnection(t *testing.T) {
+	n := newTestNotifier(t)
+
+	_, err := n.Subscribe(context.Background(), "jobs")
+	require.ErrorContains(t, err, "no more connections")
+}
+
+func TestCloseEndsSubscriptions(t *testing.T) {
+	conn := newFakeConn()
+	n := newTestNotifier(t, conn)
+
+	s, err := n.Subscribe(context.Background(), "jobs")
+	require.NoError(t, err)
+
+	n.Close()
+	<-s.Done()
+	assert.ErrorIs(t, s.Err(), ErrClosed)
+
+	_, err = n.Subscribe(context.Background(), "jobs")
+	assert.ErrorIs(t, err, ErrClosed)
+}
@@ -44,8 +44,11 @@ type QueryPoolerServer struct {
 	poolManager connpoolmanager.PoolManager
 	executor    *executor.Executor
 
-	mu            sync.Mutex
-	servingStatus clustermetadatapb.PoolerServingStatus
+	mu             sync.Mutex
+	servingStatus  clustermetadatapb.PoolerServingStatus
+	replicationLag func(context.Context) (time.Duration, error)
+	// healthChanged is closed, and replaced, when the serving state changes
+	healthChanged chan struct{}
 }
 
 // NewQueryPoolerServer creates a new QueryPoolerServer instance with the given pool manager.
@@ -61,9 +64,45 @@ func NewQueryPoolerServer(logger *slog.Logger, poolManager connpoolmanager.PoolM
 		poolManager:   poolManager,
 		executor:      exec,
 		servingStatus: clustermetadatapb.PoolerServingStatus_NOT_SERVING,
+		healthChanged: make(chan struct{}),
 	}
 }
 
+// SetReplicationLagFunc sets the function reporting the replication lag of
+// this pooler, checked against the maximum lag of queries sent to a replica
+// and reported in the health stream.
+func (s *QueryPoolerServer) SetReplicationLagFunc(fn func(context.Context) (time.Duration, error)) {
+	s.mu.Lock()
+	s.replicationLag = fn
+	s.mu.Unlock()
+
+	if s.executor != nil {
+		s.executor.SetReplicationLagFunc(fn)
+	}
+}
+
+// SetPrimaryFenceFunc sets the function checking that this pooler holds
+// the primary lease of its shard. Statements sent to the primary are refused
+// with its error.
+func (s *QueryPoolerServer) SetPrimaryFenceFunc(fn func() error) {
+	if s.executor != nil {
+		s.executor.SetPrimaryFenceFunc(fn)
+	}
+}
+
+// ReplicationLag returns how far behind the primary this pooler's PostgreSQL
+// replays. It returns an error if the lag is unknown.
+func (s *QueryPoolerServer) ReplicationLag(ctx context.Context) (time.Duration, error) {
+	s.mu.Lock()
+	fn := s.replicationLag
+	s.mu.Unlock()
+
+	if fn == nil {
+		return 0, errors.New("replication lag not tracked")
+	}
+	return fn(ctx)
+}
+
 // SetServingType transitions the serving state.
 // Implements PoolerController interface.
 func (s *QueryPoolerServer) SetServingType(ctx context.Context, servingStatus clustermetadatapb.PoolerServingStatus) error {
@@ -71,6 +110,10 @@ func (s *QueryPoolerServer) SetServingType(ctx context.Context, servingStatus cl
 	defer s.mu.Unlock()
 
 	s.logger.InfoContext(ctx, "Transitioning serving type", "from", s.servingStatus, "to", servingStatus)
+	if s.servingStatus != servingStatus {
+		close(s.healthChanged)
+		s.healthChanged = make(chan struct{})
+	}
 	s.servingStatus = servingStatus
 
 	// TODO: Implement state-specific behavior:
@@ -91,6 +134,21 @@ func (s *QueryPoolerServer) IsServing() bool {
 		s.servingStatus == clustermetadatapb.PoolerServingStatus_SERVING_RDONLY
 }
 
+// ServingStatus returns the current serving state.
+func (s *QueryPoolerServer) ServingStatus() clustermetadatapb.PoolerServingStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.servingStatus
+}
+
+// HealthChanged returns a channel closed at the next change of the serving
+// state, for the health stream to report it right away.
+func (s *QueryPoolerServer) HealthChanged() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.healthChanged
+}
+
 // IsHealthy checks if the controller is healthy.
 // Implements PoolerController interface.
 func (s *QueryPoolerServer) IsHealthy() error {
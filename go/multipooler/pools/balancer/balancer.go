@@ -0,0 +1,230 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package balancer spreads the connections of the pools over several
+// PostgreSQL servers, such as read replicas, so that reads scale without
+// an external load balancer.
+package balancer
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// Policy selects the server each new connection goes to.
+type Policy string
+
+const (
+	// RoundRobin sends new connections to each server in turn.
+	RoundRobin Policy = "round-robin"
+	// LeastConnections sends new connections to the server with the fewest
+	// open connections of the balancer.
+	LeastConnections Policy = "least-connections"
+)
+
+// ParsePolicy returns the policy of the given name.
+func ParsePolicy(name string) (Policy, error) {
+	switch policy := Policy(name); policy {
+	case RoundRobin, LeastConnections:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid balancing policy %q: expected %s or %s", name, RoundRobin, LeastConnections)
+}
+
+// Config holds the configuration of a Balancer.
+type Config struct {
+	// Hosts are the servers to connect to, as host:port.
+	Hosts []string
+
+	// Policy selects the server of each new connection.
+	Policy Policy
+
+	// DownTime is how long a server that failed to accept a connection is
+	// left out, before new connections are tried on it again.
+	DownTime time.Duration
+}
+
+// HostStats describes a server of a Balancer.
+type HostStats struct {
+	Address string `json:"address"`
+	// Open is the number of open connections to the server.
+	Open int `json:"open"`
+	// Down is set while the server is left out after a failure.
+	Down bool `json:"down"`
+}
+
+// host is a server of a Balancer.
+type host struct {
+	address string
+	host    string
+	port    int
+
+	// open is the number of open connections to the server.
+	open int
+	// downUntil is when a server that failed is tried again.
+	downUntil time.Time
+}
+
+// Balancer spreads new connections over several servers by its policy,
+// leaving out for a while the servers that fail to accept them. It is safe
+// for concurrent use by several pools.
+type Balancer struct {
+	policy   Policy
+	downTime time.Duration
+
+	mu    sync.Mutex
+	hosts []*host
+	// next is the index of the host round-robin starts from.
+	next int
+}
+
+// New creates a Balancer over the servers of config.
+func New(config Config) (*Balancer, error) {
+	if len(config.Hosts) == 0 {
+		return nil, errors.New("balancer needs at least one host")
+	}
+	if _, err := ParsePolicy(string(config.Policy)); err != nil {
+		return nil, err
+	}
+	b := &Balancer{
+		policy:   config.Policy,
+		downTime: config.DownTime,
+	}
+	for _, address := range config.Hosts {
+		hostname, portStr, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid host %q: %w", address, err)
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid host %q: invalid port", address)
+		}
+		b.hosts = append(b.hosts, &host{address: address, host: hostname, port: port})
+	}
+	return b, nil
+}
+
+// Connect opens a connection with the settings of config to one of the
+// servers, chosen by the policy among those that are up. If the server
+// fails, it is left out for the down time and the next one is tried. If
+// every server is down, they are all tried.
+//
+// release must be called once the connection is closed; it may be called
+// several times.
+func (b *Balancer) Connect(ctx context.Context, config *client.Config) (conn *client.Conn, release func(), err error) {
+	for _, h := range b.candidates() {
+		hostConfig := *config
+		hostConfig.SocketFile = ""
+		hostConfig.Host = h.host
+		hostConfig.Port = h.port
+
+		b.mu.Lock()
+		h.open++
+		b.mu.Unlock()
+
+		conn, err = client.Connect(ctx, &hostConfig)
+		if err == nil {
+			return conn, sync.OnceFunc(func() { b.release(h) }), nil
+		}
+
+		b.mu.Lock()
+		h.open--
+		if isHostFailure(err) {
+			h.downUntil = time.Now().Add(b.downTime)
+		}
+		b.mu.Unlock()
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, nil, err
+}
+
+// candidates returns the servers to try a new connection on, in order.
+func (b *Balancer) candidates() []*host {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	hosts := make([]*host, 0, len(b.hosts))
+	// Round-robin starts from the next host, and moves on for the next
+	// connection.
+	start := b.next
+	b.next = (b.next + 1) % len(b.hosts)
+	for i := range b.hosts {
+		h := b.hosts[(start+i)%len(b.hosts)]
+		if now.After(h.downUntil) {
+			hosts = append(hosts, h)
+		}
+	}
+	if len(hosts) == 0 {
+		for i := range b.hosts {
+			hosts = append(hosts, b.hosts[(start+i)%len(b.hosts)])
+		}
+	}
+	if b.policy == LeastConnections {
+		// The stable sort keeps round-robin order between servers with as
+		// many connections.
+		slices.SortStableFunc(hosts, func(a, b *host) int {
+			return cmp.Compare(a.open, b.open)
+		})
+	}
+	return hosts
+}
+
+// release uncounts a closed connection to h.
+func (b *Balancer) release(h *host) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	h.open--
+}
+
+// Stats returns the state of the servers.
+func (b *Balancer) Stats() []HostStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	stats := make([]HostStats, 0, len(b.hosts))
+	for _, h := range b.hosts {
+		stats = append(stats, HostStats{
+			Address: h.address,
+			Open:    h.open,
+			Down:    now.Before(h.downUntil),
+		})
+	}
+	return stats
+}
+
+// isHostFailure reports whether a failure to connect means that the server
+// is unavailable, rather than that it rejected this connection, e.g. for
+// its user or database.
+func isHostFailure(err error) bool {
+	var pgErr *client.Error
+	if !errors.As(err, &pgErr) {
+		return true
+	}
+	// The server is starting up, shutting down or in recovery.
+	return pgErr.Code == sqlstate.CannotConnectNow
+}
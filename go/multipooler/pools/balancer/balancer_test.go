@@ -0,0 +1,174 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancer
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/fakepgserver"
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+)
+
+// closedAddress returns an address nothing listens on.
+func closedAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return address
+}
+
+// connect opens a connection through b, closed at the end of the test, and
+// returns the address of its server and its release function.
+func connect(t *testing.T, b *Balancer, config *client.Config) (string, func()) {
+	conn, release, err := b.Connect(context.Background(), config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+		release()
+	})
+	return conn.RemoteAddr().String(), release
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy("round-robin")
+	require.NoError(t, err)
+	assert.Equal(t, RoundRobin, policy)
+
+	policy, err = ParsePolicy("least-connections")
+	require.NoError(t, err)
+	assert.Equal(t, LeastConnections, policy)
+
+	_, err = ParsePolicy("random")
+	assert.ErrorContains(t, err, `invalid balancing policy "random"`)
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New(Config{Policy: RoundRobin})
+	assert.ErrorContains(t, err, "at least one host")
+
+	_, err = New(Config{Hosts: []string{"localhost"}, Policy: RoundRobin})
+	assert.ErrorContains(t, err, `invalid host "localhost"`)
+
+	_, err = New(Config{Hosts: []string{"localhost:0"}, Policy: RoundRobin})
+	assert.ErrorContains(t, err, "invalid port")
+
+	_, err = New(Config{Hosts: []string{"localhost:5432"}, Policy: "random"})
+	assert.ErrorContains(t, err, "invalid balancing policy")
+}
+
+func TestConnect_RoundRobin(t *testing.T) {
+	server1 := fakepgserver.New(t)
+	t.Cleanup(server1.Close)
+	server2 := fakepgserver.New(t)
+	t.Cleanup(server2.Close)
+
+	b, err := New(Config{
+		Hosts:    []string{server1.Address(), server2.Address()},
+		Policy:   RoundRobin,
+		DownTime: time.Minute,
+	})
+	require.NoError(t, err)
+
+	var addresses []string
+	for range 4 {
+		address, _ := connect(t, b, server1.ClientConfig())
+		addresses = append(addresses, address)
+	}
+	assert.Equal(t, []string{server1.Address(), server2.Address(), server1.Address(), server2.Address()}, addresses)
+	assert.Equal(t, []HostStats{
+		{Address: server1.Address(), Open: 2},
+		{Address: server2.Address(), Open: 2},
+	}, b.Stats())
+}
+
+func TestConnect_LeastConnections(t *testing.T) {
+	server1 := fakepgserver.New(t)
+	t.Cleanup(server1.Close)
+	server2 := fakepgserver.New(t)
+	t.Cleanup(server2.Close)
+
+	b, err := New(Config{
+		Hosts:    []string{server1.Address(), server2.Address()},
+		Policy:   LeastConnections,
+		DownTime: time.Minute,
+	})
+	require.NoError(t, err)
+
+	address, _ := connect(t, b, server1.ClientConfig())
+	assert.Equal(t, server1.Address(), address)
+	address, release := connect(t, b, server1.ClientConfig())
+	assert.Equal(t, server2.Address(), address)
+
+	// Once its connection is released, server2 has the fewest connections,
+	// even though round-robin would pick server1. Releasing twice counts
+	// once.
+	release()
+	release()
+	assert.Equal(t, 0, b.Stats()[1].Open)
+	address, _ = connect(t, b, server1.ClientConfig())
+	assert.Equal(t, server2.Address(), address)
+	assert.Equal(t, []HostStats{
+		{Address: server1.Address(), Open: 1},
+		{Address: server2.Address(), Open: 1},
+	}, b.Stats())
+}
+
+func TestConnect_DownHost(t *testing.T) {
+	server := fakepgserver.New(t)
+	t.Cleanup(server.Close)
+	down := closedAddress(t)
+
+	b, err := New(Config{
+		Hosts:    []string{down, server.Address()},
+		Policy:   RoundRobin,
+		DownTime: time.Minute,
+	})
+	require.NoError(t, err)
+
+	// The down host is left out once it failed.
+	for range 3 {
+		address, _ := connect(t, b, server.ClientConfig())
+		assert.Equal(t, server.Address(), address)
+	}
+	assert.Equal(t, []HostStats{
+		{Address: down, Open: 0, Down: true},
+		{Address: server.Address(), Open: 3},
+	}, b.Stats())
+}
+
+func TestConnect_AllHostsDown(t *testing.T) {
+	down := closedAddress(t)
+
+	b, err := New(Config{
+		Hosts:    []string{down},
+		Policy:   RoundRobin,
+		DownTime: time.Minute,
+	})
+	require.NoError(t, err)
+
+	// A down host is still tried when no host is up.
+	for range 2 {
+		_, _, err := b.Connect(context.Background(), &client.Config{User: "test", Database: "testdb"})
+		require.Error(t, err)
+	}
+	assert.Equal(t, []HostStats{{Address: down, Down: true}}, b.Stats())
+}
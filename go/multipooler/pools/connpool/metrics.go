@@ -16,6 +16,7 @@ package connpool
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -60,3 +61,77 @@ func (c ConnectionCount) Add(ctx context.Context, delta int64, poolName string,
 		attribute.String(attrKeyState, string(state)),
 	))
 }
+
+// PendingRequests wraps an Int64UpDownCounter for tracking the clients waiting
+// for a connection, using the standard db.client.connection.pending_requests
+// metric from OTel semconv.
+type PendingRequests struct {
+	counter metric.Int64UpDownCounter
+}
+
+// NewPendingRequests creates a PendingRequests instrument.
+func NewPendingRequests(m metric.Meter) (PendingRequests, error) {
+	counter, err := m.Int64UpDownCounter(
+		"db.client.connection.pending_requests",
+		metric.WithDescription("The number of current pending requests for an open connection."),
+		metric.WithUnit("{request}"),
+	)
+	return PendingRequests{counter: counter}, err
+}
+
+// Add records a change of the clients waiting for a connection of the given pool.
+func (p PendingRequests) Add(ctx context.Context, delta int64, poolName string) {
+	if p.counter == nil {
+		return
+	}
+	p.counter.Add(ctx, delta, metric.WithAttributes(attribute.String(attrKeyPoolName, poolName)))
+}
+
+// WaitTime wraps a Float64Histogram for recording how long clients wait for a
+// connection, using the standard db.client.connection.wait_time metric from
+// OTel semconv.
+type WaitTime struct {
+	histogram metric.Float64Histogram
+}
+
+// NewWaitTime creates a WaitTime instrument.
+func NewWaitTime(m metric.Meter) (WaitTime, error) {
+	histogram, err := m.Float64Histogram(
+		"db.client.connection.wait_time",
+		metric.WithDescription("The time it took to obtain an open connection from the pool."),
+		metric.WithUnit("s"),
+	)
+	return WaitTime{histogram: histogram}, err
+}
+
+// Record records the time a client of the given pool waited for a connection.
+func (w WaitTime) Record(ctx context.Context, d time.Duration, poolName string) {
+	if w.histogram == nil {
+		return
+	}
+	w.histogram.Record(ctx, d.Seconds(), metric.WithAttributes(attribute.String(attrKeyPoolName, poolName)))
+}
+
+// ConnectErrors wraps an Int64Counter for counting the connections to the
+// server that failed to open.
+type ConnectErrors struct {
+	counter metric.Int64Counter
+}
+
+// NewConnectErrors creates a ConnectErrors instrument.
+func NewConnectErrors(m metric.Meter) (ConnectErrors, error) {
+	counter, err := m.Int64Counter(
+		"db.client.connection.connect_errors",
+		metric.WithDescription("The number of connections that failed to open."),
+		metric.WithUnit("{error}"),
+	)
+	return ConnectErrors{counter: counter}, err
+}
+
+// Add records a connection of the given pool that failed to open.
+func (c ConnectErrors) Add(ctx context.Context, poolName string) {
+	if c.counter == nil {
+		return
+	}
+	c.counter.Add(ctx, 1, metric.WithAttributes(attribute.String(attrKeyPoolName, poolName)))
+}
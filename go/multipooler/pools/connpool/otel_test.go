@@ -21,6 +21,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
@@ -303,3 +305,46 @@ func TestOTelConnectionCount_SetCapacityReduction(t *testing.T) {
 	assert.Equal(t, int64(0), getStateCount(sum, "capacity-pool", "used"), "used should still be 0")
 	assert.Equal(t, int64(1), getStateCount(sum, "capacity-pool", "idle"), "idle should be 1 after capacity reduction")
 }
+
+func TestOTelGetSpan(t *testing.T) {
+	recorder := telemetry.SetupSpanRecorder(t)
+	pool := newTestPool(1)
+	defer pool.Close()
+
+	t.Run("not traced without a parent span", func(t *testing.T) {
+		conn, err := pool.Get(t.Context())
+		require.NoError(t, err)
+		conn.Recycle()
+
+		assert.Empty(t, recorder.Ended())
+	})
+
+	t.Run("child of the request span", func(t *testing.T) {
+		ctx, parent := telemetry.Tracer().Start(t.Context(), "request")
+		conn, err := pool.Get(ctx)
+		require.NoError(t, err)
+		conn.Recycle()
+		parent.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		get := spans[0]
+		assert.Equal(t, "connpool/get", get.Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), get.Parent().SpanID())
+		assert.Contains(t, get.Attributes(), attribute.String(attrKeyPoolName, "test"))
+		assert.Equal(t, codes.Unset, get.Status().Code)
+	})
+
+	t.Run("failed checkout", func(t *testing.T) {
+		ctx, parent := telemetry.Tracer().Start(t.Context(), "request")
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := pool.Get(cancelled)
+		require.ErrorIs(t, err, ErrCtxTimeout)
+		parent.End()
+
+		get := recorder.Ended()[2]
+		assert.Equal(t, "connpool/get", get.Name())
+		assert.Equal(t, codes.Error, get.Status().Code)
+	})
+}
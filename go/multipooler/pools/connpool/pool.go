@@ -17,15 +17,20 @@ package connpool
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
 	"math/rand/v2"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/semconv/v1.37.0/dbconv"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/multigres/multigres/go/multipooler/connstate"
+	"github.com/multigres/multigres/go/tools/telemetry"
 )
 
 var (
@@ -38,8 +43,16 @@ var (
 	// ErrPoolClosed is returned when trying to get a connection from a closed pool
 	ErrPoolClosed = errors.New("connection pool is closed")
 
+	// ErrQueueFull is returned if a connection get would wait for a connection
+	// while the pool already has MaxWaiters clients waiting.
+	ErrQueueFull = errors.New("connection pool queue is full")
+
 	// PoolCloseTimeout is how long to wait for all connections to be returned to the pool during close
 	PoolCloseTimeout = 10 * time.Second
+
+	// maintenanceInterval is how often the pool closes the connections idle or
+	// open for too long, and opens the connections missing to keep MinActive
+	maintenanceInterval = time.Second
 )
 
 // Metrics holds pool metrics for monitoring.
@@ -49,6 +62,8 @@ type Metrics struct {
 	getWithStateCount atomic.Int64
 	waitCount         atomic.Int64
 	waitTime          atomic.Int64
+	waitTimeouts      atomic.Int64
+	queueFull         atomic.Int64
 	idleClosed        atomic.Int64
 	diffState         atomic.Int64
 	resetState        atomic.Int64
@@ -59,6 +74,8 @@ func (m *Metrics) GetCount() int64          { return m.getCount.Load() }
 func (m *Metrics) GetStateCount() int64     { return m.getWithStateCount.Load() }
 func (m *Metrics) WaitCount() int64         { return m.waitCount.Load() }
 func (m *Metrics) WaitTime() time.Duration  { return time.Duration(m.waitTime.Load()) }
+func (m *Metrics) WaitTimeouts() int64      { return m.waitTimeouts.Load() }
+func (m *Metrics) QueueFullCount() int64    { return m.queueFull.Load() }
 func (m *Metrics) IdleClosed() int64        { return m.idleClosed.Load() }
 func (m *Metrics) DiffStateCount() int64    { return m.diffState.Load() }
 func (m *Metrics) ResetStateCount() int64   { return m.resetState.Load() }
@@ -83,9 +100,38 @@ type Config struct {
 	LogWait         func(time.Time)
 	Logger          *slog.Logger
 
+	// MinActive is the number of connections the pool keeps open, up to its
+	// capacity: they are opened with the pool, and replaced when closed for
+	// being idle or too old.
+	MinActive int64
+
+	// MaxLifetimeJitter is the largest fraction of MaxLifetime added at random
+	// to the lifetime of each connection, so that the connections opened
+	// together are not all recycled together.
+	MaxLifetimeJitter float64
+
+	// ConnectRate is the number of connections the pool opens per second at
+	// most, in bursts of up to ConnectBurst connections, to spare the server
+	// from storms of connections. 0 does not limit it.
+	ConnectRate  float64
+	ConnectBurst int64
+
+	// MaxWait is how long a client waits for a connection when the pool is
+	// exhausted before ErrTimeout is returned. 0 waits until the context of
+	// the client is done.
+	MaxWait time.Duration
+
+	// MaxWaiters is the number of clients that can wait for a connection at
+	// once; the clients beyond it get ErrQueueFull at once. 0 does not limit
+	// it.
+	MaxWaiters int64
+
 	// OTel metrics instruments (optional, noop if not set).
 	// These are shared across all pools and created by the owner (e.g., connpoolmanager).
 	ConnectionCount ConnectionCount
+	PendingRequests PendingRequests
+	WaitTime        WaitTime
+	ConnectErrors   ConnectErrors
 }
 
 // stackMask is the number of connection state stacks minus one;
@@ -130,6 +176,13 @@ type Pool[C Connection] struct {
 	close      atomic.Pointer[chan struct{}]
 	capacityMu sync.Mutex
 
+	// paused is closed when the pool resumes, or nil if the pool isn't paused;
+	// while paused, clients wait for the pool to resume before getting a connection
+	paused atomic.Pointer[chan struct{}]
+	// drained is set while paused by Drain: connections are closed instead of
+	// being kept idle
+	drained atomic.Bool
+
 	// ctx is the context used for background pool operations
 	ctx context.Context
 
@@ -145,6 +198,17 @@ type Pool[C Connection] struct {
 		maxIdleCount int64
 		// maxLifetime is the maximum time a connection can be open
 		maxLifetime atomic.Int64
+		// maxLifetimeJitter is the largest fraction of maxLifetime added to the
+		// lifetime of each connection, as float64 bits
+		maxLifetimeJitter atomic.Uint64
+		// minActive is the number of connections the pool keeps open
+		minActive atomic.Int64
+		// limiter limits the rate at which connections are opened
+		limiter connectLimiter
+		// maxWait is how long a client waits for a connection at most
+		maxWait atomic.Int64
+		// maxWaiters is the number of clients that can wait at once
+		maxWaiters atomic.Int64
 		// idleTimeout is the maximum time a connection can remain idle
 		idleTimeout atomic.Int64
 		// refreshInterval is how often to call the refresh check
@@ -160,6 +224,13 @@ type Pool[C Connection] struct {
 	// otelConnectionCount tracks connection state counts (idle/used).
 	// Optional, noop if not set. Provided via Config.ConnectionCount.
 	otelConnectionCount ConnectionCount
+	// otelPendingRequests and otelWaitTime track the clients waiting for a
+	// connection. Optional, noop if not set.
+	otelPendingRequests PendingRequests
+	otelWaitTime        WaitTime
+	// otelConnectErrors counts the connections that failed to open.
+	// Optional, noop if not set.
+	otelConnectErrors ConnectErrors
 }
 
 // NewPool creates a new connection pool with the given Config.
@@ -172,6 +243,11 @@ func NewPool[C Connection](ctx context.Context, config *Config) *Pool[C] {
 	pool.config.maxCapacity = config.Capacity
 	pool.config.maxIdleCount = config.MaxIdleCount
 	pool.config.maxLifetime.Store(config.MaxLifetime.Nanoseconds())
+	pool.config.maxLifetimeJitter.Store(math.Float64bits(config.MaxLifetimeJitter))
+	pool.config.minActive.Store(config.MinActive)
+	pool.config.limiter.set(config.ConnectRate, config.ConnectBurst)
+	pool.config.maxWait.Store(config.MaxWait.Nanoseconds())
+	pool.config.maxWaiters.Store(config.MaxWaiters)
 	pool.config.idleTimeout.Store(config.IdleTimeout.Nanoseconds())
 	pool.config.refreshInterval.Store(config.RefreshInterval.Nanoseconds())
 	pool.config.logWait = config.LogWait
@@ -180,6 +256,9 @@ func NewPool[C Connection](ctx context.Context, config *Config) *Pool[C] {
 		pool.logger = slog.Default()
 	}
 	pool.otelConnectionCount = config.ConnectionCount
+	pool.otelPendingRequests = config.PendingRequests
+	pool.otelWaitTime = config.WaitTime
+	pool.otelConnectErrors = config.ConnectErrors
 	pool.wait.init()
 
 	// Set up OTel idle tracking callbacks on all idle stacks.
@@ -236,14 +315,22 @@ func (pool *Pool[C]) open() {
 		return true
 	})
 
-	idleTimeout := pool.IdleTimeout()
-	if idleTimeout != 0 {
-		// The idle worker takes care of closing connections that have been idle too long
-		pool.runWorker(closeChan, idleTimeout/10, func(now time.Time) bool {
-			pool.closeIdleResources(now)
-			return true
-		})
-	}
+	// The maintenance worker takes care of closing connections that have been
+	// idle or open too long, and of keeping MinActive connections open. The
+	// connections it opens are given up when the pool closes.
+	fillCtx, cancelFill := context.WithCancel(pool.ctx)
+	pool.workers.Go(func() {
+		<-closeChan
+		cancelFill()
+	})
+	pool.workers.Go(func() {
+		pool.fill(fillCtx)
+	})
+	pool.runWorker(closeChan, maintenanceInterval, func(now time.Time) bool {
+		pool.closeIdleResources(now)
+		pool.fill(fillCtx)
+		return true
+	})
 
 	refreshInterval := pool.RefreshInterval()
 	if refreshInterval != 0 && pool.config.refresh != nil {
@@ -401,6 +488,150 @@ func (pool *Pool[C]) SetIdleTimeout(duration time.Duration) {
 	pool.config.idleTimeout.Store(duration.Nanoseconds())
 }
 
+// MaxLifetime returns the time a connection can be open before it is
+// recycled, before jitter, or 0 if unlimited.
+func (pool *Pool[C]) MaxLifetime() time.Duration {
+	return time.Duration(pool.config.maxLifetime.Load())
+}
+
+// SetMaxLifetime changes the time a connection can be open, including for the
+// connections already open.
+func (pool *Pool[C]) SetMaxLifetime(duration time.Duration) {
+	pool.config.maxLifetime.Store(duration.Nanoseconds())
+}
+
+// MaxLifetimeJitter returns the largest fraction of MaxLifetime added to the
+// lifetime of each connection.
+func (pool *Pool[C]) MaxLifetimeJitter() float64 {
+	return math.Float64frombits(pool.config.maxLifetimeJitter.Load())
+}
+
+// SetMaxLifetimeJitter changes the largest fraction of MaxLifetime added to the
+// lifetime of each connection.
+func (pool *Pool[C]) SetMaxLifetimeJitter(jitter float64) {
+	pool.config.maxLifetimeJitter.Store(math.Float64bits(jitter))
+}
+
+// MinActive returns the number of connections the pool keeps open.
+func (pool *Pool[C]) MinActive() int64 {
+	return pool.config.minActive.Load()
+}
+
+// SetMinActive changes the number of connections the pool keeps open. Missing
+// connections are opened by the maintenance worker; extra ones are closed
+// once idle for too long.
+func (pool *Pool[C]) SetMinActive(minActive int64) {
+	pool.config.minActive.Store(minActive)
+}
+
+// SetConnectRate changes the number of connections the pool opens per second
+// at most, and the size of its bursts. A rate of 0 does not limit it.
+func (pool *Pool[C]) SetConnectRate(rate float64, burst int64) {
+	pool.config.limiter.set(rate, burst)
+}
+
+// MaxWait returns how long a client waits for a connection at most, or 0 if
+// until its context is done.
+func (pool *Pool[C]) MaxWait() time.Duration {
+	return time.Duration(pool.config.maxWait.Load())
+}
+
+// SetMaxWait changes how long a client waits for a connection at most, for
+// the clients that start waiting from now on.
+func (pool *Pool[C]) SetMaxWait(duration time.Duration) {
+	pool.config.maxWait.Store(duration.Nanoseconds())
+}
+
+// MaxWaiters returns the number of clients that can wait for a connection at
+// once, or 0 if unlimited.
+func (pool *Pool[C]) MaxWaiters() int64 {
+	return pool.config.maxWaiters.Load()
+}
+
+// SetMaxWaiters changes the number of clients that can wait for a connection
+// at once. The clients already waiting keep waiting.
+func (pool *Pool[C]) SetMaxWaiters(maxWaiters int64) {
+	pool.config.maxWaiters.Store(maxWaiters)
+}
+
+// Reconfigure applies the settings of config that can change while the pool
+// is open: the idle timeout, the max lifetime and its jitter, MinActive, the
+// connect rate and the limits of the waitlist. The name and capacity of the
+// pool are left unchanged.
+func (pool *Pool[C]) Reconfigure(config *Config) {
+	pool.SetIdleTimeout(config.IdleTimeout)
+	pool.SetMaxLifetime(config.MaxLifetime)
+	pool.SetMaxLifetimeJitter(config.MaxLifetimeJitter)
+	pool.SetMinActive(config.MinActive)
+	pool.SetConnectRate(config.ConnectRate, config.ConnectBurst)
+	pool.SetMaxWait(config.MaxWait)
+	pool.SetMaxWaiters(config.MaxWaiters)
+}
+
+// Pause stops the pool from giving out connections: clients wait for the pool
+// to resume, for MaxWait at most, while the connections already given out are
+// used as usual. Pausing a paused pool does nothing.
+func (pool *Pool[C]) Pause() {
+	paused := make(chan struct{})
+	pool.paused.CompareAndSwap(nil, &paused)
+}
+
+// Resume lets the pool give out connections again, first to the clients that
+// waited while it was paused. Resuming a pool that isn't paused does nothing.
+func (pool *Pool[C]) Resume() {
+	pool.capacityMu.Lock()
+	defer pool.capacityMu.Unlock()
+
+	paused := pool.paused.Swap(nil)
+	if paused == nil {
+		return
+	}
+	pool.drained.Store(false)
+	close(*paused)
+
+	// Hand the idle connections to the clients that were already waiting for
+	// one when the pool paused, then open connections for the rest.
+	for pool.wait.waiting() > 0 && pool.tryReturnAnyConn() {
+	}
+	pool.satisfyWaitersOnCapacityIncrease()
+}
+
+// IsPaused returns whether the pool is paused.
+func (pool *Pool[C]) IsPaused() bool {
+	return pool.paused.Load() != nil
+}
+
+// Drain pauses the pool and closes its connections: idle connections at once,
+// and connections given out as they're returned. It waits for all connections
+// to be returned until ctx is done, and returns an error if some are still in
+// use. The pool stays paused until Resume, and opens connections on demand
+// once resumed.
+func (pool *Pool[C]) Drain(ctx context.Context) error {
+	pool.Pause()
+	pool.drained.Store(true)
+
+	for {
+		conn := pool.getFromSettingsStack(nil)
+		if conn == nil {
+			conn = pool.pop(&pool.clean)
+		}
+		if conn == nil {
+			break
+		}
+		conn.Close()
+		pool.closedConn()
+	}
+
+	const delay = 10 * time.Millisecond
+	for pool.borrowed.Load() > 0 {
+		if ctx.Err() != nil {
+			return fmt.Errorf("timed out while draining pool %s: %d connections still in use", pool.Name, pool.borrowed.Load())
+		}
+		time.Sleep(delay)
+	}
+	return nil
+}
+
 func (pool *Pool[D]) IdleCount() int64 {
 	return pool.idleCount.Load()
 }
@@ -409,6 +640,74 @@ func (pool *Pool[D]) RefreshInterval() time.Duration {
 	return time.Duration(pool.config.refreshInterval.Load())
 }
 
+// waitForConn queues the client until another client returns a connection
+// with the given settings, or any connection, for MaxWait at most. It
+// returns ErrQueueFull if MaxWaiters clients are already waiting, and
+// ErrTimeout if no connection is returned in time.
+func (pool *Pool[C]) waitForConn(ctx context.Context, settings *connstate.Settings) (*Pooled[C], error) {
+	closeChan := pool.close.Load()
+	if closeChan == nil {
+		return nil, ErrPoolClosed
+	}
+	// Clients racing to wait may exceed MaxWaiters by a few.
+	if maxWaiters := pool.MaxWaiters(); maxWaiters > 0 && int64(pool.wait.waiting()) >= maxWaiters {
+		pool.Metrics.queueFull.Add(1)
+		return nil, ErrQueueFull
+	}
+	if maxWait := pool.MaxWait(); maxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, maxWait)
+		defer cancel()
+	}
+
+	start := time.Now()
+	pool.otelPendingRequests.Add(pool.ctx, 1, pool.Name)
+	conn, err := pool.wait.waitForConn(ctx, settings, *closeChan)
+	pool.otelPendingRequests.Add(pool.ctx, -1, pool.Name)
+	pool.otelWaitTime.Record(pool.ctx, time.Since(start), pool.Name)
+	if err != nil {
+		pool.Metrics.waitTimeouts.Add(1)
+		return nil, ErrTimeout
+	}
+	pool.recordWait(start)
+	return conn, nil
+}
+
+// waitForResume blocks the client while the pool is paused, for MaxWait at
+// most. It returns ErrTimeout if the pool doesn't resume in time.
+func (pool *Pool[C]) waitForResume(ctx context.Context) error {
+	paused := pool.paused.Load()
+	if paused == nil {
+		return nil
+	}
+	closeChan := pool.close.Load()
+	if closeChan == nil {
+		return ErrPoolClosed
+	}
+	if maxWait := pool.MaxWait(); maxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, maxWait)
+		defer cancel()
+	}
+
+	start := time.Now()
+	pool.otelPendingRequests.Add(pool.ctx, 1, pool.Name)
+	defer func() {
+		pool.otelPendingRequests.Add(pool.ctx, -1, pool.Name)
+		pool.otelWaitTime.Record(pool.ctx, time.Since(start), pool.Name)
+	}()
+	select {
+	case <-*paused:
+		pool.recordWait(start)
+		return nil
+	case <-*closeChan:
+		return ErrPoolClosed
+	case <-ctx.Done():
+		pool.Metrics.waitTimeouts.Add(1)
+		return ErrTimeout
+	}
+}
+
 func (pool *Pool[C]) recordWait(start time.Time) {
 	pool.Metrics.waitCount.Add(1)
 	pool.Metrics.waitTime.Add(time.Since(start).Nanoseconds())
@@ -421,13 +720,18 @@ func (pool *Pool[C]) recordWait(start time.Time) {
 // If there are no connections in the pool to be returned, Get blocks until one
 // is returned, or until the given ctx is cancelled.
 // The connection must be returned to the pool once it's not needed by calling Pooled.Recycle.
-func (pool *Pool[C]) Get(ctx context.Context) (*Pooled[C], error) {
+func (pool *Pool[C]) Get(ctx context.Context) (conn *Pooled[C], err error) {
+	ctx, span := pool.startGetSpan(ctx)
+	defer func() { telemetry.EndSpan(span, err) }()
 	if ctx.Err() != nil {
 		return nil, ErrCtxTimeout
 	}
 	if pool.capacity.Load() == 0 {
 		return nil, ErrPoolClosed
 	}
+	if err := pool.waitForResume(ctx); err != nil {
+		return nil, err
+	}
 	return pool.get(ctx)
 }
 
@@ -435,19 +739,37 @@ func (pool *Pool[C]) Get(ctx context.Context) (*Pooled[C], error) {
 // If there are no connections in the pool to be returned, Get blocks until one
 // is returned, or until the given ctx is cancelled.
 // The connection must be returned to the pool once it's not needed by calling Pooled.Recycle.
-func (pool *Pool[C]) GetWithSettings(ctx context.Context, settings *connstate.Settings) (*Pooled[C], error) {
+func (pool *Pool[C]) GetWithSettings(ctx context.Context, settings *connstate.Settings) (conn *Pooled[C], err error) {
+	ctx, span := pool.startGetSpan(ctx)
+	defer func() { telemetry.EndSpan(span, err) }()
 	if ctx.Err() != nil {
 		return nil, ErrCtxTimeout
 	}
 	if pool.capacity.Load() == 0 {
 		return nil, ErrPoolClosed
 	}
+	if err := pool.waitForResume(ctx); err != nil {
+		return nil, err
+	}
 	if settings == nil || settings.IsEmpty() {
 		return pool.get(ctx)
 	}
 	return pool.getWithSettings(ctx, settings)
 }
 
+// startGetSpan starts the span of a checkout of a connection, which lasts
+// as long as the client waits for it, or for a new one to open. Checkouts
+// are only traced within the span of the request they serve: a root span
+// would escape the sampling of the request, and the pool is too busy to
+// trace every checkout.
+func (pool *Pool[C]) startGetSpan(ctx context.Context) (context.Context, trace.Span) {
+	if parent := trace.SpanFromContext(ctx); !parent.SpanContext().IsValid() {
+		return ctx, parent
+	}
+	return telemetry.Tracer().Start(ctx, "connpool/get",
+		trace.WithAttributes(attribute.String(attrKeyPoolName, pool.Name)))
+}
+
 // put returns a connection to the pool. This is a private API.
 // Return connections to the pool by calling Pooled.Recycle.
 func (pool *Pool[C]) put(conn *Pooled[C]) {
@@ -456,6 +778,12 @@ func (pool *Pool[C]) put(conn *Pooled[C]) {
 	pool.otelConnectionCount.Add(pool.ctx, -1, pool.Name, dbconv.ClientConnectionStateUsed)
 
 	if conn == nil {
+		// A paused pool doesn't open connections; they're opened on demand
+		// once it resumes.
+		if pool.IsPaused() {
+			pool.closedConn()
+			return
+		}
 		var err error
 		conn, err = pool.connNew(pool.ctx)
 		if err != nil {
@@ -465,7 +793,7 @@ func (pool *Pool[C]) put(conn *Pooled[C]) {
 	} else {
 		conn.timeUsed.update()
 
-		lifetime := pool.extendedMaxLifetime()
+		lifetime := pool.lifetime(conn)
 		if lifetime > 0 && conn.timeCreated.elapsed() > lifetime {
 			pool.Metrics.maxLifetimeClosed.Add(1)
 			conn.Close()
@@ -485,7 +813,14 @@ func (pool *Pool[C]) tryReturnConn(conn *Pooled[C]) bool {
 	if pool.closeOnOverCapacity(conn) {
 		return false
 	}
-	if pool.wait.tryReturnConn(conn) {
+	// A drained pool closes its connections as they're returned.
+	if pool.drained.Load() {
+		conn.Close()
+		pool.closedConn()
+		return false
+	}
+	// A paused pool keeps its connections until it resumes.
+	if !pool.IsPaused() && pool.wait.tryReturnConn(conn) {
 		// Direct handoff to waiter: used→used, waiter will do otel used +1
 		return true
 	}
@@ -567,16 +902,31 @@ func (pool *Pool[C]) closeOnIdleLimitReached(conn *Pooled[C]) bool {
 	}
 }
 
-func (pool *Pool[D]) extendedMaxLifetime() time.Duration {
-	maxLifetime := pool.config.maxLifetime.Load()
+// lifetime returns the time conn can be open, its share of the jitter added
+// to the max lifetime, or 0 if unlimited.
+func (pool *Pool[C]) lifetime(conn *Pooled[C]) time.Duration {
+	maxLifetime := pool.MaxLifetime()
 	if maxLifetime == 0 {
 		return 0
 	}
-	return time.Duration(maxLifetime) + time.Duration(rand.Uint32N(uint32(maxLifetime)))
+	return maxLifetime + time.Duration(float64(maxLifetime)*pool.MaxLifetimeJitter()*conn.jitter)
+}
+
+// connect opens a connection once the connect rate allows it.
+func (pool *Pool[C]) connect(ctx context.Context) (C, error) {
+	if err := pool.config.limiter.wait(ctx); err != nil {
+		var zero C
+		return zero, ErrTimeout
+	}
+	conn, err := pool.config.connect(ctx)
+	if err != nil {
+		pool.otelConnectErrors.Add(pool.ctx, pool.Name)
+	}
+	return conn, err
 }
 
 func (pool *Pool[C]) connReopen(ctx context.Context, dbconn *Pooled[C], now time.Duration) (err error) {
-	dbconn.Conn, err = pool.config.connect(ctx)
+	dbconn.Conn, err = pool.connect(ctx)
 	if err != nil {
 		return err
 	}
@@ -591,17 +941,19 @@ func (pool *Pool[C]) connReopen(ctx context.Context, dbconn *Pooled[C], now time
 
 	dbconn.timeCreated.set(now)
 	dbconn.timeUsed.set(now)
+	dbconn.jitter = rand.Float64()
 	return nil
 }
 
 func (pool *Pool[C]) connNew(ctx context.Context) (*Pooled[C], error) {
-	conn, err := pool.config.connect(ctx)
+	conn, err := pool.connect(ctx)
 	if err != nil {
 		return nil, err
 	}
 	pooled := &Pooled[C]{
-		pool: pool,
-		Conn: conn,
+		pool:   pool,
+		Conn:   conn,
+		jitter: rand.Float64(),
 	}
 	now := monotonicNow()
 	pooled.timeUsed.set(now)
@@ -685,17 +1037,10 @@ func (pool *Pool[C]) get(ctx context.Context) (*Pooled[C], error) {
 	// if there are no connections in the settings stacks and we've lent out connections
 	// to other clients, wait until one of the connections is returned
 	if conn == nil {
-		closeChan := pool.close.Load()
-		if closeChan == nil {
-			return returnErr(ErrPoolClosed)
-		}
-
-		start := time.Now()
-		conn, err = pool.wait.waitForConn(ctx, nil, *closeChan)
+		conn, err = pool.waitForConn(ctx, nil)
 		if err != nil {
-			return returnErr(ErrTimeout)
+			return returnErr(err)
 		}
-		pool.recordWait(start)
 	}
 	// no connections available and no connections to wait for (pool is closed)
 	if conn == nil {
@@ -764,17 +1109,10 @@ func (pool *Pool[C]) getWithSettings(ctx context.Context, settings *connstate.Se
 	// no connections anywhere in the pool; if we've lent out connections to other clients
 	// wait for one of them
 	if conn == nil {
-		closeChan := pool.close.Load()
-		if closeChan == nil {
-			return returnErr(ErrPoolClosed)
-		}
-
-		start := time.Now()
-		conn, err = pool.wait.waitForConn(ctx, settings, *closeChan)
+		conn, err = pool.waitForConn(ctx, settings)
 		if err != nil {
-			return returnErr(ErrTimeout)
+			return returnErr(err)
 		}
-		pool.recordWait(start)
 	}
 	// no connections available and no connections to wait for (pool is closed)
 	if conn == nil {
@@ -870,6 +1208,9 @@ func (pool *Pool[C]) setCapacity(newcap int64) error {
 // satisfyWaitersOnCapacityIncrease creates new connections for waiting clients
 // when capacity has been increased. This is called from setCapacity.
 func (pool *Pool[C]) satisfyWaitersOnCapacityIncrease() {
+	if pool.IsPaused() {
+		return
+	}
 	// Create connections for waiters while we have capacity and waiters
 	for pool.wait.waiting() > 0 {
 		conn, err := pool.getNew(pool.ctx)
@@ -892,7 +1233,8 @@ func (pool *Pool[C]) satisfyWaitersOnCapacityIncrease() {
 
 func (pool *Pool[C]) closeIdleResources(now time.Time) {
 	timeout := pool.IdleTimeout()
-	if timeout == 0 {
+	maxLifetime := pool.MaxLifetime()
+	if timeout == 0 && maxLifetime == 0 {
 		return
 	}
 	if pool.Capacity() == 0 {
@@ -901,6 +1243,7 @@ func (pool *Pool[C]) closeIdleResources(now time.Time) {
 
 	mono := monotonicFromTime(now)
 
+	var idle, old []*Pooled[C]
 	closeInStack := func(s *connStack[C]) {
 		// Do a read-only best effort iteration of all the connections in this
 		// stack and atomically attempt to mark them as expired.
@@ -910,25 +1253,11 @@ func (pool *Pool[C]) closeIdleResources(now time.Time) {
 		// notice the expired connection and ignore it.
 		// see: timestamp.expired
 		s.ForEach(func(conn *Pooled[C]) bool {
-			if conn.timeUsed.expired(mono, timeout) {
-				pool.Metrics.idleClosed.Add(1)
-
-				conn.Close()
-				pool.closedConn()
-
-				// Try to open a new connection to replace the closed one
-				c, err := pool.getNew(pool.ctx)
-				if err != nil {
-					// If we couldn't open a new connection, just continue
-					return true
-				}
-
-				// Opening a new connection might have raced with other goroutines,
-				// so it's possible that we got back `nil` here
-				if c != nil {
-					// Return the new connection to the pool
-					pool.tryReturnConn(c)
-				}
+			switch {
+			case timeout > 0 && conn.timeUsed.expired(mono, timeout):
+				idle = append(idle, conn)
+			case maxLifetime > 0 && mono-conn.timeCreated.get() > pool.lifetime(conn) && conn.timeUsed.expire():
+				old = append(old, conn)
 			}
 			return true // continue iteration
 		})
@@ -938,6 +1267,40 @@ func (pool *Pool[C]) closeIdleResources(now time.Time) {
 		closeInStack(&pool.states[i])
 	}
 	closeInStack(&pool.clean)
+
+	// The connections are closed once the stacks are unlocked. Those
+	// missing to keep MinActive are opened again by fill.
+	for _, conn := range idle {
+		pool.Metrics.idleClosed.Add(1)
+		conn.Close()
+		pool.closedConn()
+	}
+	for _, conn := range old {
+		pool.Metrics.maxLifetimeClosed.Add(1)
+		conn.Close()
+		pool.closedConn()
+	}
+}
+
+// fill opens the connections missing to keep MinActive connections open, up
+// to the capacity of the pool.
+func (pool *Pool[C]) fill(ctx context.Context) {
+	if pool.IsPaused() {
+		return
+	}
+	for range min(pool.MinActive(), pool.Capacity()) - pool.active.Load() {
+		conn, err := pool.getNew(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				pool.logger.Warn("failed to open connection to keep the pool filled", "pool", pool.Name, "error", err)
+			}
+			return
+		}
+		if conn == nil {
+			return
+		}
+		pool.tryReturnConn(conn)
+	}
 }
 
 // Requested returns the current demand (pending connection requests + borrowed connections).
@@ -963,6 +1326,7 @@ func (pool *Pool[C]) Stats() PoolStats {
 		Capacity:  pool.capacity.Load(),
 		Available: pool.Available(),
 		Requested: pool.requested.Load(),
+		Waiting:   int64(pool.wait.waiting()),
 	}
 }
 
@@ -974,4 +1338,5 @@ type PoolStats struct {
 	Capacity  int64 // Maximum connections
 	Available int64 // Connections available for immediate use
 	Requested int64 // Pending requests + borrowed (demand)
+	Waiting   int64 // Clients waiting for a connection
 }
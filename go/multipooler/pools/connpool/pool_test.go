@@ -475,3 +475,242 @@ func TestPoolSetCapacity_IncreaseUnblocksWaiters(t *testing.T) {
 	// Return the original connection
 	conn1.Recycle()
 }
+
+// newTestPoolWithConfig creates an open pool of mock connections from config.
+func newTestPoolWithConfig(config *Config) *Pool[*mockConnection] {
+	pool := NewPool[*mockConnection](context.Background(), config)
+	pool.Open(func(ctx context.Context) (*mockConnection, error) {
+		return newMockConnection(), nil
+	}, nil)
+	return pool
+}
+
+func TestPoolMinActive(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 5, MinActive: 2, IdleTimeout: time.Minute})
+	defer pool.Close()
+	ctx := context.Background()
+
+	// The pool opens MinActive connections when it opens.
+	require.Eventually(t, func() bool { return pool.Active() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	var conns []*Pooled[*mockConnection]
+	for range 4 {
+		conn, err := pool.Get(ctx)
+		require.NoError(t, err)
+		conns = append(conns, conn)
+	}
+	for _, conn := range conns {
+		conn.Recycle()
+	}
+	assert.Equal(t, int64(4), pool.Active())
+
+	// Idle connections are closed, and replaced only to keep MinActive open.
+	pool.closeIdleResources(time.Now().Add(2 * time.Minute))
+	assert.Equal(t, int64(4), pool.Metrics.IdleClosed())
+	pool.fill(ctx)
+	assert.Equal(t, int64(2), pool.Active())
+
+	// MinActive is bounded by the capacity.
+	pool.SetMinActive(10)
+	pool.fill(ctx)
+	assert.Equal(t, int64(5), pool.Active())
+}
+
+func TestPoolMaxLifetime(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 5, MaxLifetime: time.Minute})
+	defer pool.Close()
+	ctx := context.Background()
+
+	conn, err := pool.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, pool.lifetime(conn))
+	conn.Recycle()
+
+	// Idle connections open for too long are closed.
+	pool.closeIdleResources(time.Now().Add(30 * time.Second))
+	assert.Equal(t, int64(1), pool.Active())
+	pool.closeIdleResources(time.Now().Add(2 * time.Minute))
+	assert.Equal(t, int64(0), pool.Active())
+	assert.Equal(t, int64(1), pool.Metrics.MaxLifetimeClosed())
+
+	// The lifetime of each connection is jittered by its share of the jitter.
+	pool.SetMaxLifetime(time.Hour)
+	pool.SetMaxLifetimeJitter(0.5)
+	conn, err = pool.Get(ctx)
+	require.NoError(t, err)
+	defer conn.Recycle()
+	conn.jitter = 0.5
+	assert.Equal(t, time.Hour+15*time.Minute, pool.lifetime(conn))
+	conn.jitter = 0
+	assert.Equal(t, time.Hour, pool.lifetime(conn))
+}
+
+func TestPoolConnectRate(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 5, ConnectRate: 0.001, ConnectBurst: 1})
+	defer pool.Close()
+
+	conn, err := pool.Get(context.Background())
+	require.NoError(t, err)
+	defer conn.Recycle()
+
+	// The burst is used up: opening another connection waits for the rate.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.Get(ctx)
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, int64(1), pool.Active())
+
+	pool.SetConnectRate(0, 0)
+	conn2, err := pool.Get(context.Background())
+	require.NoError(t, err)
+	conn2.Recycle()
+}
+
+func TestPoolReconfigure(t *testing.T) {
+	pool := newTestPool(5)
+	defer pool.Close()
+
+	pool.Reconfigure(&Config{
+		Capacity:          100,
+		IdleTimeout:       time.Minute,
+		MaxLifetime:       time.Hour,
+		MaxLifetimeJitter: 0.2,
+		MinActive:         3,
+		ConnectRate:       50,
+		ConnectBurst:      5,
+		MaxWait:           time.Second,
+		MaxWaiters:        10,
+	})
+	assert.Equal(t, int64(5), pool.Capacity())
+	assert.Equal(t, time.Minute, pool.IdleTimeout())
+	assert.Equal(t, time.Hour, pool.MaxLifetime())
+	assert.Equal(t, 0.2, pool.MaxLifetimeJitter())
+	assert.Equal(t, int64(3), pool.MinActive())
+	assert.Equal(t, 50.0, pool.config.limiter.rate)
+	assert.Equal(t, 5.0, pool.config.limiter.burst)
+	assert.Equal(t, time.Second, pool.MaxWait())
+	assert.Equal(t, int64(10), pool.MaxWaiters())
+}
+
+func TestPoolMaxWait(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 1, MaxWait: 50 * time.Millisecond})
+	defer pool.Close()
+	ctx := context.Background()
+
+	conn, err := pool.Get(ctx)
+	require.NoError(t, err)
+
+	// With the pool exhausted, the client waits MaxWait and gives up.
+	start := time.Now()
+	_, err = pool.Get(ctx)
+	require.ErrorIs(t, err, ErrTimeout)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, int64(1), pool.Metrics.WaitTimeouts())
+	assert.Equal(t, int64(0), pool.Stats().Waiting)
+
+	// A connection returned within MaxWait is handed to the waiting client.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		conn.Recycle()
+	}()
+	conn, err = pool.Get(ctx)
+	require.NoError(t, err)
+	conn.Recycle()
+	assert.Equal(t, int64(1), pool.Metrics.WaitCount())
+}
+
+func TestPoolMaxWaiters(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 1, MaxWaiters: 1})
+	defer pool.Close()
+	ctx := context.Background()
+
+	conn, err := pool.Get(ctx)
+	require.NoError(t, err)
+
+	waited := make(chan error, 1)
+	go func() {
+		conn, err := pool.Get(ctx)
+		if err == nil {
+			conn.Recycle()
+		}
+		waited <- err
+	}()
+	require.Eventually(t, func() bool { return pool.Stats().Waiting == 1 }, 5*time.Second, time.Millisecond)
+
+	// The waitlist is full: the next client is turned away at once.
+	_, err = pool.Get(ctx)
+	require.ErrorIs(t, err, ErrQueueFull)
+	assert.Equal(t, int64(1), pool.Metrics.QueueFullCount())
+
+	// The waiting client still gets the connection.
+	conn.Recycle()
+	require.NoError(t, <-waited)
+	assert.Equal(t, int64(0), pool.Stats().Requested)
+}
+
+func TestPoolPauseResume(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 2, MaxWait: 50 * time.Millisecond})
+	defer pool.Close()
+	ctx := context.Background()
+
+	conn, err := pool.Get(ctx)
+	require.NoError(t, err)
+
+	pool.Pause()
+	assert.True(t, pool.IsPaused())
+
+	// Connections already given out are used as usual, but new clients wait
+	// for the pool to resume, for MaxWait at most.
+	conn.Recycle()
+	_, err = pool.Get(ctx)
+	require.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, int64(1), pool.Active())
+
+	got := make(chan error, 1)
+	go func() {
+		conn, err := pool.Get(context.Background())
+		if err == nil {
+			conn.Recycle()
+		}
+		got <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	pool.Resume()
+	assert.False(t, pool.IsPaused())
+	require.NoError(t, <-got)
+}
+
+func TestPoolDrain(t *testing.T) {
+	pool := newTestPoolWithConfig(&Config{Name: "test", Capacity: 3})
+	defer pool.Close()
+	ctx := context.Background()
+
+	var conns []*Pooled[*mockConnection]
+	for range 3 {
+		conn, err := pool.Get(ctx)
+		require.NoError(t, err)
+		conns = append(conns, conn)
+	}
+	conns[0].Recycle()
+
+	// The idle connection is closed at once; the drain times out while the
+	// others are in use.
+	drainCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.Error(t, pool.Drain(drainCtx))
+	assert.True(t, pool.IsPaused())
+	assert.Equal(t, int64(2), pool.Active())
+
+	// Connections returned to a drained pool are closed.
+	conns[1].Recycle()
+	conns[2].Taint()
+	require.NoError(t, pool.Drain(ctx))
+	assert.Equal(t, int64(0), pool.Active())
+
+	// Once resumed, the pool opens connections on demand.
+	pool.Resume()
+	conn, err := pool.Get(ctx)
+	require.NoError(t, err)
+	conn.Recycle()
+	assert.Equal(t, int64(1), pool.Active())
+}
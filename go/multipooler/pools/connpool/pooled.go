@@ -31,6 +31,10 @@ type Pooled[C Connection] struct {
 	// This is used for idle timeout tracking.
 	timeUsed timestamp
 
+	// jitter is the share, in [0, 1), of the max lifetime jitter of the pool
+	// added to the lifetime of this connection.
+	jitter float64
+
 	// pool is a reference to the pool that owns this connection.
 	// Used for the Recycle pattern.
 	pool *Pool[C]
@@ -0,0 +1,102 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connpool
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// connectLimiter limits the rate at which a pool opens connections. It is a
+// token bucket refilled with rate tokens per second, holding up to burst
+// tokens; opening a connection takes a token.
+type connectLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// set changes the rate and burst of the limiter. A rate of 0 does not limit
+// the connections opened.
+func (l *connectLimiter) set(rate float64, burst int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.rate <= 0 {
+		// The bucket starts full when the limit is set.
+		l.tokens = float64(burst)
+	} else {
+		l.refill(now)
+	}
+	l.rate = rate
+	l.burst = float64(max(burst, 1))
+	l.tokens = min(l.tokens, l.burst)
+	l.last = now
+}
+
+// refill adds the tokens earned since the last refill; it must be called
+// with l.mu held.
+func (l *connectLimiter) refill(now time.Time) {
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+}
+
+// reserve takes a token, and returns how long to wait before it is earned.
+func (l *connectLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+	l.refill(now)
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by reserve and not used.
+func (l *connectLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens = min(l.burst, l.tokens+1)
+	}
+}
+
+// wait waits until a connection can be opened, or returns an error if ctx
+// is done first.
+func (l *connectLimiter) wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
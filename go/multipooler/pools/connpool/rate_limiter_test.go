@@ -0,0 +1,64 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectLimiterUnlimited(t *testing.T) {
+	var l connectLimiter
+	for range 100 {
+		assert.Zero(t, l.reserve(time.Now()))
+	}
+}
+
+func TestConnectLimiterRate(t *testing.T) {
+	var l connectLimiter
+	l.set(10, 2)
+	now := l.last
+
+	// The burst is available at once, then tokens come every 100ms.
+	assert.Zero(t, l.reserve(now))
+	assert.Zero(t, l.reserve(now))
+	assert.Equal(t, 100*time.Millisecond, l.reserve(now))
+	assert.Equal(t, 200*time.Millisecond, l.reserve(now))
+
+	// Cancelled reservations give back their token.
+	l.cancel()
+	l.cancel()
+	assert.Equal(t, 100*time.Millisecond, l.reserve(now))
+
+	// Tokens accumulate up to the burst.
+	now = now.Add(time.Hour)
+	assert.Zero(t, l.reserve(now))
+	assert.Zero(t, l.reserve(now))
+	assert.Equal(t, 100*time.Millisecond, l.reserve(now))
+}
+
+func TestConnectLimiterWait(t *testing.T) {
+	var l connectLimiter
+	l.set(0.001, 1)
+	assert.NoError(t, l.wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, l.wait(ctx), context.Canceled)
+	assert.InDelta(t, 0.0, l.tokens, 0.001)
+}
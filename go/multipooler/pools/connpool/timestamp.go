@@ -106,3 +106,14 @@ func (t *timestamp) expired(now time.Duration, timeout time.Duration) bool {
 	}
 	return false
 }
+
+// expire attempts to atomically expire this timestamp, whatever its time.
+// It only succeeds if we can ensure the timestamp hasn't been
+// concurrently expired or borrowed.
+func (t *timestamp) expire() bool {
+	stamp := t.nano.Load()
+	if stamp == timestampExpired || stamp == timestampBusy {
+		return false
+	}
+	return t.nano.CompareAndSwap(stamp, timestampExpired)
+}
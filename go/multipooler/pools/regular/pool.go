@@ -21,6 +21,7 @@ import (
 	"github.com/multigres/multigres/go/common/pgprotocol/client"
 	"github.com/multigres/multigres/go/multipooler/connstate"
 	"github.com/multigres/multigres/go/multipooler/pools/admin"
+	"github.com/multigres/multigres/go/multipooler/pools/balancer"
 	"github.com/multigres/multigres/go/multipooler/pools/connpool"
 )
 
@@ -38,6 +39,14 @@ type PoolConfig struct {
 
 	// AdminPool is used for kill operations on connections.
 	AdminPool *admin.Pool
+
+	// WarmupStatements are run on every new connection before it is handed
+	// out. A connection whose warm-up fails is closed.
+	WarmupStatements []string
+
+	// Balancer, if set, spreads the connections over its servers instead of
+	// connecting to the server of ClientConfig.
+	Balancer *balancer.Balancer
 }
 
 // Pool manages regular connections for query execution.
@@ -68,16 +77,39 @@ func NewPool(ctx context.Context, config *PoolConfig) *Pool {
 // Must be called before using the pool.
 func (p *Pool) Open() {
 	connector := func(ctx context.Context) (*Conn, error) {
-		conn, err := client.Connect(ctx, p.config.ClientConfig)
+		regularConn, err := p.connect(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create regular connection: %w", err)
 		}
-		return NewConn(conn, p.config.AdminPool), nil
+		for _, stmt := range p.config.WarmupStatements {
+			if _, err := regularConn.Query(ctx, stmt); err != nil {
+				regularConn.Close()
+				return nil, fmt.Errorf("failed to warm up regular connection: %w", err)
+			}
+		}
+		return regularConn, nil
 	}
 
 	p.pool.Open(connector, nil)
 }
 
+// connect opens a connection to the server of the pool, or to one of the
+// servers of its balancer.
+func (p *Pool) connect(ctx context.Context) (*Conn, error) {
+	if p.config.Balancer != nil {
+		conn, release, err := p.config.Balancer.Connect(ctx, p.config.ClientConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewBalancedConn(conn, release), nil
+	}
+	conn, err := client.Connect(ctx, p.config.ClientConfig)
+	if err != nil {
+		return nil, err
+	}
+	return NewConn(conn, p.config.AdminPool), nil
+}
+
 // Get returns a connection from the pool.
 // The connection is already authenticated as the pool's configured user.
 func (p *Pool) Get(ctx context.Context) (PooledConn, error) {
@@ -16,6 +16,7 @@ package regular
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,7 @@ import (
 	"github.com/multigres/multigres/go/common/fakepgserver"
 	"github.com/multigres/multigres/go/common/sqltypes"
 	"github.com/multigres/multigres/go/multipooler/connstate"
+	"github.com/multigres/multigres/go/multipooler/pools/balancer"
 	"github.com/multigres/multigres/go/multipooler/pools/connpool"
 )
 
@@ -98,6 +100,95 @@ func TestPool_GetWithSettings(t *testing.T) {
 	assert.Greater(t, server.GetPatternCalledNum(`SET SESSION .+ = .+`), 0, "SET command should have been called")
 }
 
+func TestPool_WarmupStatements(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.AddQuery("SELECT 1", &sqltypes.Result{})
+	server.AddQuery("LOAD 'auto_explain'", &sqltypes.Result{})
+
+	pool := NewPool(context.Background(), &PoolConfig{
+		ClientConfig:     server.ClientConfig(),
+		ConnPoolConfig:   &connpool.Config{Capacity: 2, MaxIdleCount: 2},
+		WarmupStatements: []string{"SELECT 1", "LOAD 'auto_explain'"},
+	})
+	pool.Open()
+	defer pool.Close()
+
+	ctx := context.Background()
+
+	// Each new connection runs the warm-up statements once.
+	first, err := pool.Get(ctx)
+	require.NoError(t, err)
+	second, err := pool.Get(ctx)
+	require.NoError(t, err)
+	first.Recycle()
+	second.Recycle()
+
+	pooled, err := pool.Get(ctx)
+	require.NoError(t, err)
+	pooled.Recycle()
+
+	assert.Equal(t, 2, server.GetQueryCalledNum("SELECT 1"))
+	assert.Equal(t, 2, server.GetQueryCalledNum("LOAD 'auto_explain'"))
+}
+
+func TestPool_WarmupStatementsFailure(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.AddRejectedQuery("SELECT warm_up()", errors.New("function warm_up() does not exist"))
+
+	pool := NewPool(context.Background(), &PoolConfig{
+		ClientConfig:     server.ClientConfig(),
+		ConnPoolConfig:   &connpool.Config{Capacity: 1, MaxIdleCount: 1},
+		WarmupStatements: []string{"SELECT warm_up()"},
+	})
+	pool.Open()
+	defer pool.Close()
+
+	// A connection whose warm-up fails is not handed out.
+	_, err := pool.Get(context.Background())
+	require.ErrorContains(t, err, "failed to warm up regular connection")
+	assert.Equal(t, int64(0), pool.Stats().Active)
+}
+
+func TestPool_Balancer(t *testing.T) {
+	server1 := fakepgserver.New(t)
+	defer server1.Close()
+	server2 := fakepgserver.New(t)
+	defer server2.Close()
+
+	b, err := balancer.New(balancer.Config{
+		Hosts:  []string{server1.Address(), server2.Address()},
+		Policy: balancer.RoundRobin,
+	})
+	require.NoError(t, err)
+
+	pool := NewPool(context.Background(), &PoolConfig{
+		ClientConfig:   server1.ClientConfig(),
+		ConnPoolConfig: &connpool.Config{Capacity: 2, MaxIdleCount: 2},
+		Balancer:       b,
+	})
+	pool.Open()
+
+	// The connections are spread over both servers.
+	pooled1, err := pool.Get(context.Background())
+	require.NoError(t, err)
+	pooled2, err := pool.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{server1.Address(), server2.Address()}, []string{
+		pooled1.Conn.conn.RemoteAddr().String(),
+		pooled2.Conn.conn.RemoteAddr().String(),
+	})
+	pooled1.Recycle()
+	pooled2.Recycle()
+
+	// Closing the pool releases its connections from the balancer.
+	pool.Close()
+	for _, stats := range b.Stats() {
+		assert.Equal(t, 0, stats.Open, stats.Address)
+	}
+}
+
 func TestPool_Close(t *testing.T) {
 	server := fakepgserver.New(t)
 	defer server.Close()
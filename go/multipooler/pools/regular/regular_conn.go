@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/multigres/multigres/go/common/pgprotocol/client"
 	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
@@ -43,6 +44,14 @@ type Conn struct {
 	// adminPool is used for kill operations.
 	// This allows the connection to terminate itself if needed.
 	adminPool *admin.Pool
+
+	// balanced is set for connections to a server of a balancer, which the
+	// admin pool does not reach: their queries are cancelled with a
+	// CancelRequest instead.
+	balanced bool
+
+	// release, if set, is called when the connection is closed.
+	release func()
 }
 
 // NewConn creates a new regular connection wrapping the given client connection.
@@ -59,6 +68,17 @@ func NewConn(conn *client.Conn, adminPool *admin.Pool) *Conn {
 	}
 }
 
+// NewBalancedConn creates a new regular connection wrapping a client
+// connection opened by a balancer. release is called when the connection is
+// closed. The admin pool does not reach the servers of a balancer, so the
+// connection cannot be killed, only closed.
+func NewBalancedConn(conn *client.Conn, release func()) *Conn {
+	c := NewConn(conn, nil)
+	c.balanced = true
+	c.release = release
+	return c
+}
+
 // --- connpool.Connection interface ---
 
 // Settings returns the current settings applied to this connection.
@@ -83,7 +103,11 @@ func (c *Conn) Close() error {
 		state.Close()
 	}
 
-	return c.conn.Close()
+	err := c.conn.Close()
+	if c.release != nil {
+		c.release()
+	}
+	return err
 }
 
 // ApplySettings applies the given settings to the connection.
@@ -151,7 +175,7 @@ func (c *Conn) State() *connstate.ConnectionState {
 // Query executes a simple query and returns all results.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) Query(ctx context.Context, sql string) ([]*sqltypes.Result, error) {
-	return execWithContextCancel(c, ctx, func() ([]*sqltypes.Result, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) ([]*sqltypes.Result, error) {
 		return c.conn.Query(ctx, sql)
 	})
 }
@@ -160,7 +184,7 @@ func (c *Conn) Query(ctx context.Context, sql string) ([]*sqltypes.Result, error
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) QueryStreaming(ctx context.Context, sql string, callback func(context.Context, *sqltypes.Result) error) error {
 	// Use a struct{} as the value type since we only care about the error.
-	_, err := execWithContextCancel(c, ctx, func() (struct{}, error) {
+	_, err := execWithContextCancel(c, ctx, func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, c.conn.QueryStreaming(ctx, sql, callback)
 	})
 	return err
@@ -171,7 +195,7 @@ func (c *Conn) QueryStreaming(ctx context.Context, sql string, callback func(con
 // Parse sends a Parse message to prepare a statement.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) Parse(ctx context.Context, name, queryStr string, paramTypes []uint32) error {
-	_, err := execWithContextCancel(c, ctx, func() (struct{}, error) {
+	_, err := execWithContextCancel(c, ctx, func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, c.conn.Parse(ctx, name, queryStr, paramTypes)
 	})
 	return err
@@ -181,7 +205,7 @@ func (c *Conn) Parse(ctx context.Context, name, queryStr string, paramTypes []ui
 // Returns true if the execution completed (CommandComplete), false if suspended (PortalSuspended).
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) BindAndExecute(ctx context.Context, stmtName string, params [][]byte, paramFormats, resultFormats []int16, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) (completed bool, err error) {
-	return execWithContextCancel(c, ctx, func() (bool, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) (bool, error) {
 		return c.conn.BindAndExecute(ctx, stmtName, params, paramFormats, resultFormats, maxRows, callback)
 	})
 }
@@ -189,7 +213,7 @@ func (c *Conn) BindAndExecute(ctx context.Context, stmtName string, params [][]b
 // BindAndDescribe binds parameters and describes the resulting portal.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) BindAndDescribe(ctx context.Context, stmtName string, params [][]byte, paramFormats, resultFormats []int16) (*query.StatementDescription, error) {
-	return execWithContextCancel(c, ctx, func() (*query.StatementDescription, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) (*query.StatementDescription, error) {
 		return c.conn.BindAndDescribe(ctx, stmtName, params, paramFormats, resultFormats)
 	})
 }
@@ -197,7 +221,7 @@ func (c *Conn) BindAndDescribe(ctx context.Context, stmtName string, params [][]
 // DescribePrepared describes a prepared statement.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) DescribePrepared(ctx context.Context, name string) (*query.StatementDescription, error) {
-	return execWithContextCancel(c, ctx, func() (*query.StatementDescription, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) (*query.StatementDescription, error) {
 		return c.conn.DescribePrepared(ctx, name)
 	})
 }
@@ -205,7 +229,7 @@ func (c *Conn) DescribePrepared(ctx context.Context, name string) (*query.Statem
 // CloseStatement closes a prepared statement.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) CloseStatement(ctx context.Context, name string) error {
-	_, err := execWithContextCancel(c, ctx, func() (struct{}, error) {
+	_, err := execWithContextCancel(c, ctx, func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, c.conn.CloseStatement(ctx, name)
 	})
 	return err
@@ -214,7 +238,7 @@ func (c *Conn) CloseStatement(ctx context.Context, name string) error {
 // ClosePortal closes a portal.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) ClosePortal(ctx context.Context, name string) error {
-	_, err := execWithContextCancel(c, ctx, func() (struct{}, error) {
+	_, err := execWithContextCancel(c, ctx, func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, c.conn.ClosePortal(ctx, name)
 	})
 	return err
@@ -223,7 +247,7 @@ func (c *Conn) ClosePortal(ctx context.Context, name string) error {
 // Sync sends a Sync message to synchronize the extended query protocol.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) Sync(ctx context.Context) error {
-	_, err := execWithContextCancel(c, ctx, func() (struct{}, error) {
+	_, err := execWithContextCancel(c, ctx, func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, c.conn.Sync(ctx)
 	})
 	return err
@@ -233,7 +257,7 @@ func (c *Conn) Sync(ctx context.Context) error {
 // name is the statement/portal name (use "" for unnamed, which is cleared after Sync).
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) PrepareAndExecute(ctx context.Context, name, queryStr string, params [][]byte, callback func(ctx context.Context, result *sqltypes.Result) error) error {
-	_, err := execWithContextCancel(c, ctx, func() (struct{}, error) {
+	_, err := execWithContextCancel(c, ctx, func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, c.conn.PrepareAndExecute(ctx, name, queryStr, params, callback)
 	})
 	return err
@@ -244,7 +268,7 @@ func (c *Conn) PrepareAndExecute(ctx context.Context, name, queryStr string, par
 // them to the appropriate text format for PostgreSQL.
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) QueryArgs(ctx context.Context, queryStr string, args ...any) ([]*sqltypes.Result, error) {
-	return execWithContextCancel(c, ctx, func() ([]*sqltypes.Result, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) ([]*sqltypes.Result, error) {
 		return c.conn.QueryArgs(ctx, queryStr, args...)
 	})
 }
@@ -255,7 +279,7 @@ func (c *Conn) QueryArgs(ctx context.Context, queryStr string, args ...any) ([]*
 // Returns true if the portal completed (CommandComplete), false if suspended (PortalSuspended).
 // If the context is cancelled, the backend query is cancelled via adminPool.
 func (c *Conn) Execute(ctx context.Context, portalName string, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) (completed bool, err error) {
-	return execWithContextCancel(c, ctx, func() (bool, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) (bool, error) {
 		return c.conn.Execute(ctx, portalName, maxRows, callback)
 	})
 }
@@ -317,6 +341,7 @@ func (c *Conn) RawConn() *client.Conn {
 // that should be closed (e.g., network errors, read failures).
 //
 // This catches errors from:
+// - FATAL or PANIC error responses, after which the backend closes the session
 // - readMessage(): "failed to read message: ..."
 // - parseRowDescription(), parseDataRow(), etc.: "failed to read field count: EOF", etc.
 // - Write operations: "failed to write: ..."
@@ -328,6 +353,14 @@ func isConnectionError(err error) bool {
 	if err == nil {
 		return false
 	}
+	var pgErr *client.Error
+	if errors.As(err, &pgErr) {
+		return pgErr.IsFatal()
+	}
+	var diag *sqltypes.PgDiagnostic
+	if errors.As(err, &diag) {
+		return diag.IsFatal()
+	}
 	errStr := err.Error()
 	// Check for common connection-level errors.
 	// "failed to read" covers both "failed to read message" (from readMessage())
@@ -340,16 +373,21 @@ func isConnectionError(err error) bool {
 		strings.Contains(errStr, "use of closed network connection")
 }
 
-// handleContextCancellation cancels the backend query if adminPool is available.
+// handleContextCancellation cancels the backend query if adminPool is available,
+// or with a CancelRequest for a connection opened by a balancer.
 // This is called when the context is cancelled while a query is in progress.
 func (c *Conn) handleContextCancellation() {
-	if c.adminPool == nil {
+	if c.adminPool == nil && !c.balanced {
 		return
 	}
 	// Use the connection's context with a timeout for the cancel operation.
 	// If the connection is closed, there's no need to cancel the query.
 	cancelCtx, cancel := context.WithTimeout(c.conn.Context(), admin.DefaultCancelTimeout)
 	defer cancel()
+	if c.balanced {
+		_ = c.conn.Cancel(cancelCtx)
+		return
+	}
 	_, _ = c.adminPool.CancelBackend(cancelCtx, c.ProcessID())
 }
 
@@ -357,15 +395,23 @@ func (c *Conn) handleContextCancellation() {
 // If the context is cancelled while the operation is in progress, the backend
 // query is cancelled via adminPool. If a connection error occurs, the connection
 // is closed.
-func execWithContextCancel[T any](c *Conn, ctx context.Context, op func() (T, error)) (T, error) {
+//
+// The operation runs with a context that is not cancelled along with ctx, so
+// that a cancelled query can drain its error response and the connection can
+// be reused. If the backend does not answer within admin.DefaultCancelTimeout
+// of the cancel, the operation is interrupted, which closes the connection.
+func execWithContextCancel[T any](c *Conn, ctx context.Context, op func(ctx context.Context) (T, error)) (T, error) {
 	type result struct {
 		val T
 		err error
 	}
 
+	opCtx, interrupt := context.WithCancel(context.WithoutCancel(ctx))
+	defer interrupt()
+
 	ch := make(chan result, 1)
 	go func() {
-		val, err := op()
+		val, err := op(opCtx)
 		ch <- result{val: val, err: err}
 	}()
 
@@ -373,8 +419,17 @@ func execWithContextCancel[T any](c *Conn, ctx context.Context, op func() (T, er
 	case <-ctx.Done():
 		// Context cancelled - cancel the backend query.
 		c.handleContextCancellation()
-		// Wait for the operation to complete (it should return quickly after cancel).
-		res := <-ch
+		// Wait for the operation to complete (it should return quickly after
+		// cancel), interrupting it if the backend is unresponsive.
+		timer := time.NewTimer(admin.DefaultCancelTimeout)
+		defer timer.Stop()
+		var res result
+		select {
+		case res = <-ch:
+		case <-timer.C:
+			interrupt()
+			res = <-ch
+		}
 		// If the operation had a connection error, close the connection.
 		if isConnectionError(res.err) {
 			c.conn.Close()
@@ -418,3 +473,19 @@ func (c *Conn) ReadCopyDoneResponse(ctx context.Context) (string, uint64, error)
 func (c *Conn) WriteCopyFail(errorMsg string) error {
 	return c.conn.WriteCopyFail(errorMsg)
 }
+
+// --- COPY TO STDOUT operations ---
+
+// CopyOut executes a COPY TO STDOUT command, calling onResponse with the COPY
+// format and column formats and then onData with each chunk of data.
+// If the context is cancelled, the backend query is cancelled via adminPool.
+func (c *Conn) CopyOut(
+	ctx context.Context,
+	copyQuery string,
+	onResponse func(format int16, columnFormats []int16) error,
+	onData func(data []byte) error,
+) (*sqltypes.Result, error) {
+	return execWithContextCancel(c, ctx, func(ctx context.Context) (*sqltypes.Result, error) {
+		return c.conn.CopyOut(ctx, copyQuery, onResponse, onData)
+	})
+}
@@ -16,9 +16,13 @@ package regular
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+	"github.com/multigres/multigres/go/common/sqltypes"
 )
 
 func TestIsConnectionError(t *testing.T) {
@@ -62,6 +66,31 @@ func TestIsConnectionError(t *testing.T) {
 			err:      errors.New("read tcp: use of closed network connection"),
 			expected: true,
 		},
+		{
+			name:     "FATAL error response",
+			err:      fmt.Errorf("query failed: %w", &client.Error{Severity: "FATAL", Code: "57P01", Message: "terminating connection due to administrator command"}),
+			expected: true,
+		},
+		{
+			name:     "localized FATAL error response",
+			err:      &client.Error{Severity: "SCHWERWIEGEND", SeverityNonLocalized: "FATAL", Code: "57P01"},
+			expected: true,
+		},
+		{
+			name:     "FATAL diagnostic",
+			err:      &sqltypes.PgDiagnostic{Severity: "FATAL", Code: "57P01"},
+			expected: true,
+		},
+		{
+			name:     "ERROR response - not a connection error",
+			err:      &client.Error{Severity: "ERROR", Code: "42P01", Message: "failed to read the table"},
+			expected: false,
+		},
+		{
+			name:     "ERROR diagnostic - not a connection error",
+			err:      &sqltypes.PgDiagnostic{Severity: "ERROR", Code: "42P01"},
+			expected: false,
+		},
 		{
 			name:     "SQL error - not a connection error",
 			err:      errors.New("ERROR: relation \"users\" does not exist"),
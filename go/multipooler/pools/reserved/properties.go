@@ -131,6 +131,9 @@ const (
 
 	// ReleaseError indicates an error occurred.
 	ReleaseError
+
+	// ReleaseUnpin indicates the client unpinned the connection from its session.
+	ReleaseUnpin
 )
 
 // String returns a string representation of the release reason.
@@ -148,6 +151,8 @@ func (r ReleaseReason) String() string {
 		return "kill"
 	case ReleaseError:
 		return "error"
+	case ReleaseUnpin:
+		return "unpin"
 	default:
 		return "unknown"
 	}
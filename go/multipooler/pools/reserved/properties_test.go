@@ -50,6 +50,7 @@ func TestReleaseReason_String(t *testing.T) {
 		{ReleaseTimeout, "timeout"},
 		{ReleaseKill, "kill"},
 		{ReleaseError, "error"},
+		{ReleaseUnpin, "unpin"},
 		{ReleaseReason(999), "unknown"},
 	}
 
@@ -59,6 +59,9 @@ type Conn struct {
 
 	// released indicates whether this connection has been released.
 	released atomic.Bool
+
+	// pinned indicates the connection is pinned to its client session.
+	pinned atomic.Bool
 }
 
 // newConn creates a new reserved connection.
@@ -184,6 +187,26 @@ func (c *Conn) ReservedProps() *ReservationProperties {
 	return c.reservedProps
 }
 
+// --- Session pinning ---
+
+// PinToSession pins the connection to its client session, e.g. because the
+// session holds session-level advisory locks, temporary tables or cursors on it. A pinned connection is
+// not released when its transactions, portals or COPY operations end, and
+// does not time out, until the client unpins it.
+func (c *Conn) PinToSession() {
+	c.pinned.Store(true)
+}
+
+// UnpinFromSession removes the session pin.
+func (c *Conn) UnpinFromSession() {
+	c.pinned.Store(false)
+}
+
+// IsPinnedToSession returns true if the connection is pinned to its session.
+func (c *Conn) IsPinnedToSession() bool {
+	return c.pinned.Load()
+}
+
 // --- Timeout ---
 
 // SetInactivityTimeout sets the inactivity timeout and resets the expiry time.
@@ -201,8 +224,9 @@ func (c *Conn) ResetExpiryTime() {
 }
 
 // IsTimedOut returns true if the connection has exceeded its inactivity timeout.
+// Connections pinned to their session never time out.
 func (c *Conn) IsTimedOut() bool {
-	if c.inactivityTimeout <= 0 {
+	if c.inactivityTimeout <= 0 || c.IsPinnedToSession() {
 		return false
 	}
 	return time.Now().UnixNano() > c.expiryNanos.Load()
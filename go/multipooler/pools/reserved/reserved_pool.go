@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -324,6 +326,12 @@ func (p *Pool) SetCapacity(ctx context.Context, newcap int64) error {
 	return p.conns.SetCapacity(ctx, newcap)
 }
 
+// InnerPool returns the underlying regular pool.
+// Use with caution - prefer the wrapped methods.
+func (p *Pool) InnerPool() *regular.Pool {
+	return p.conns
+}
+
 // Requested returns the number of currently requested connections (borrowed + waiters).
 // Used for demand tracking in the rebalancer.
 func (p *Pool) Requested() int64 {
@@ -401,3 +409,20 @@ func (p *Pool) KillTimedOut(ctx context.Context) int {
 
 	return len(timedOutIDs)
 }
+
+// KillAll kills all reserved connections, such as when draining the pool.
+func (p *Pool) KillAll(ctx context.Context) int {
+	p.mu.Lock()
+	connIDs := slices.Collect(maps.Keys(p.active))
+	p.mu.Unlock()
+
+	for _, connID := range connIDs {
+		if err := p.KillConnection(ctx, connID); err != nil {
+			p.logger.WarnContext(ctx, "failed to kill connection",
+				"conn_id", connID,
+				"error", err)
+		}
+	}
+
+	return len(connIDs)
+}
@@ -310,6 +310,41 @@ func TestConn_Timeout(t *testing.T) {
 	conn.Release(ReleaseTimeout)
 }
 
+func TestConn_PinnedNeverTimesOut(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.SetNeverFail(true)
+
+	pool := NewPool(context.Background(), &PoolConfig{
+		InactivityTimeout: 10 * time.Millisecond,
+		RegularPoolConfig: &regular.PoolConfig{
+			ClientConfig: server.ClientConfig(),
+			ConnPoolConfig: &connpool.Config{
+				Capacity:     4,
+				MaxIdleCount: 4,
+			},
+		},
+	})
+	defer pool.Close()
+
+	ctx := context.Background()
+
+	conn, err := pool.NewConn(ctx, nil)
+	require.NoError(t, err)
+	conn.PinToSession()
+	assert.True(t, conn.IsPinnedToSession())
+
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, conn.IsTimedOut())
+	assert.Zero(t, pool.KillTimedOut(ctx))
+
+	// Once unpinned, the inactivity timeout applies again.
+	conn.UnpinFromSession()
+	assert.True(t, conn.IsTimedOut())
+
+	conn.Release(ReleaseUnpin)
+}
+
 func TestConn_ResetExpiryTime(t *testing.T) {
 	server := fakepgserver.New(t)
 	defer server.Close()
@@ -421,6 +456,33 @@ func TestPool_KillConnection_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestPool_KillAll(t *testing.T) {
+	server := fakepgserver.New(t)
+	defer server.Close()
+	server.SetNeverFail(true)
+
+	pool := newTestPool(t, server)
+	defer pool.Close()
+
+	ctx := context.Background()
+
+	conn1, err := pool.NewConn(ctx, nil)
+	require.NoError(t, err)
+	defer conn1.Release(ReleaseKill)
+	conn2, err := pool.NewConn(ctx, nil)
+	require.NoError(t, err)
+	defer conn2.Release(ReleaseKill)
+
+	assert.Equal(t, 2, pool.KillAll(ctx))
+
+	// The killed connections are gone from the pool.
+	_, ok := pool.Get(conn1.ConnID)
+	assert.False(t, ok)
+	_, ok = pool.Get(conn2.ConnID)
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), pool.InnerPool().InnerPool().InUse())
+}
+
 func TestPool_TimestampBasedConnectionIDs(t *testing.T) {
 	server := fakepgserver.New(t)
 	defer server.Close()
@@ -0,0 +1,157 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schematracker tracks the schema of the tables of the database of
+// a multipooler, so that the gateways learn of its changes.
+//
+// The catalog is read periodically and diffed with the schema last seen,
+// rather than watched with event triggers: these would have to be created
+// in every database, and do not fire on replicas, which only replay the
+// changes of their primary.
+package schematracker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/multigres/multigres/go/multipooler/executor"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+)
+
+// DefaultInterval is how often the catalog is read when the caller does
+// not ask for an interval.
+const DefaultInterval = 5 * time.Second
+
+// schemaQuery reads the columns of the tables, views and materialized
+// views of the database, outside of the system schemas, in order.
+const schemaQuery = `SELECT n.nspname, c.relname, a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod)
+FROM pg_catalog.pg_class c
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+JOIN pg_catalog.pg_attribute a ON a.attrelid = c.oid
+WHERE c.relkind IN ('r', 'p', 'v', 'm', 'f')
+AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+AND n.nspname NOT LIKE 'pg\_toast%' AND n.nspname NOT LIKE 'pg\_temp\_%'
+AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY n.nspname, c.relname, a.attnum`
+
+// Schema maps the tables of a database, by qualified name, to their
+// description.
+type Schema map[string]*multipoolerpb.TableSchema
+
+// Load reads the schema of the tables of the database.
+func Load(ctx context.Context, queryService executor.InternalQueryService) (Schema, error) {
+	result, err := queryService.Query(ctx, schemaQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the schema: %w", err)
+	}
+	schema := make(Schema)
+	for _, row := range result.Rows {
+		if len(row.Values) != 4 {
+			return nil, fmt.Errorf("failed to read the schema: unexpected row of %d columns", len(row.Values))
+		}
+		name := qualifiedName(string(row.Values[0]), string(row.Values[1]))
+		table, ok := schema[name]
+		if !ok {
+			table = &multipoolerpb.TableSchema{Schema: string(row.Values[0]), Name: string(row.Values[1])}
+			schema[name] = table
+		}
+		table.Columns = append(table.Columns, &multipoolerpb.ColumnSchema{
+			Name: string(row.Values[2]),
+			Type: string(row.Values[3]),
+		})
+	}
+	return schema, nil
+}
+
+// Tables returns the tables of the schema, ordered by name.
+func (s Schema) Tables() []*multipoolerpb.TableSchema {
+	tables := make([]*multipoolerpb.TableSchema, 0, len(s))
+	for _, name := range slices.Sorted(maps.Keys(s)) {
+		tables = append(tables, s[name])
+	}
+	return tables
+}
+
+// Diff returns the tables of next created or changed since current, and
+// those of current dropped from next, without their columns, ordered by
+// name.
+func Diff(current, next Schema) (changed, dropped []*multipoolerpb.TableSchema) {
+	for _, name := range slices.Sorted(maps.Keys(next)) {
+		if previous, ok := current[name]; !ok || !sameColumns(previous, next[name]) {
+			changed = append(changed, next[name])
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := next[name]; !ok {
+			dropped = append(dropped, &multipoolerpb.TableSchema{Schema: current[name].Schema, Name: current[name].Name})
+		}
+	}
+	return changed, dropped
+}
+
+// Watch sends the schema of the tables of the database to send: all of it
+// right away, then the tables changed since, reading the catalog once per
+// interval. It returns when ctx is done, or with the error of send or of
+// reading the catalog.
+func Watch(ctx context.Context, queryService executor.InternalQueryService, interval time.Duration, send func(*multipoolerpb.StreamSchemaResponse) error) error {
+	current, err := Load(ctx, queryService)
+	if err != nil {
+		return err
+	}
+	if err := send(&multipoolerpb.StreamSchemaResponse{Full: true, Tables: current.Tables()}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := Load(ctx, queryService)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		changed, dropped := Diff(current, next)
+		if len(changed) == 0 && len(dropped) == 0 {
+			continue
+		}
+		if err := send(&multipoolerpb.StreamSchemaResponse{Tables: changed, DroppedTables: dropped}); err != nil {
+			return err
+		}
+		current = next
+	}
+}
+
+// qualifiedName returns the name of a table qualified by its schema.
+func qualifiedName(schema, table string) string {
+	return schema + "." + table
+}
+
+// sameColumns reports whether two descriptions of a table have the same
+// columns, of the same types, in the same order.
+func sameColumns(a, b *multipoolerpb.TableSchema) bool {
+	return slices.EqualFunc(a.Columns, b.Columns, func(x, y *multipoolerpb.ColumnSchema) bool {
+		return x.Name == y.Name && x.Type == y.Type
+	})
+}
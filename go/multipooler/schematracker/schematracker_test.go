@@ -0,0 +1,140 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schematracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/multipooler/executor/mock"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+)
+
+// schemaResult returns the result of the schema query for rows of schema,
+// table, column and type.
+func schemaResult(rows ...[]any) *mock.QueryService {
+	queryService := mock.NewQueryService()
+	queryService.AddQueryPattern("FROM pg_catalog.pg_class", mock.MakeQueryResult(
+		[]string{"nspname", "relname", "attname", "format_type"}, rows))
+	return queryService
+}
+
+// tableNames returns the qualified names of tables.
+func tableNames(tables []*multipoolerpb.TableSchema) []string {
+	names := make([]string, len(tables))
+	for i, table := range tables {
+		names[i] = qualifiedName(table.Schema, table.Name)
+	}
+	return names
+}
+
+func TestLoad(t *testing.T) {
+	queryService := schemaResult(
+		[]any{"public", "users", "id", "bigint"},
+		[]any{"public", "users", "name", "text"},
+		[]any{"sales", "orders", "id", "integer"},
+	)
+	schema, err := Load(context.Background(), queryService)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"public.users", "sales.orders"}, tableNames(schema.Tables()))
+	users := schema["public.users"]
+	require.Len(t, users.Columns, 2)
+	assert.Equal(t, "name", users.Columns[1].Name)
+	assert.Equal(t, "text", users.Columns[1].Type)
+}
+
+func TestLoadError(t *testing.T) {
+	queryService := mock.NewQueryService()
+	queryService.AddQueryPatternWithError("FROM pg_catalog.pg_class", errors.New("connection refused"))
+	_, err := Load(context.Background(), queryService)
+	assert.ErrorContains(t, err, "failed to read the schema: connection refused")
+}
+
+func TestDiff(t *testing.T) {
+	current, err := Load(context.Background(), schemaResult(
+		[]any{"public", "users", "id", "bigint"},
+		[]any{"public", "orders", "id", "bigint"},
+		[]any{"public", "logs", "id", "bigint"},
+	))
+	require.NoError(t, err)
+	next, err := Load(context.Background(), schemaResult(
+		[]any{"public", "users", "id", "bigint"},
+		[]any{"public", "orders", "id", "integer"},
+		[]any{"public", "events", "id", "bigint"},
+	))
+	require.NoError(t, err)
+
+	changed, dropped := Diff(current, next)
+	assert.Equal(t, []string{"public.events", "public.orders"}, tableNames(changed))
+	assert.Equal(t, []string{"public.logs"}, tableNames(dropped))
+	assert.Empty(t, dropped[0].Columns)
+
+	changed, dropped = Diff(next, next)
+	assert.Empty(t, changed)
+	assert.Empty(t, dropped)
+}
+
+func TestWatch(t *testing.T) {
+	columns := []string{"nspname", "relname", "attname", "format_type"}
+	queryService := mock.NewQueryService()
+	queryService.AddQueryPatternOnce("FROM pg_catalog.pg_class", mock.MakeQueryResult(columns, [][]any{
+		{"public", "users", "id", "bigint"},
+	}))
+	// Unchanged: nothing is sent.
+	queryService.AddQueryPatternOnce("FROM pg_catalog.pg_class", mock.MakeQueryResult(columns, [][]any{
+		{"public", "users", "id", "bigint"},
+	}))
+	queryService.AddQueryPatternOnce("FROM pg_catalog.pg_class", mock.MakeQueryResult(columns, [][]any{
+		{"public", "users", "id", "bigint"},
+		{"public", "users", "name", "text"},
+	}))
+	queryService.AddQueryPatternOnceWithError("FROM pg_catalog.pg_class", errors.New("connection refused"))
+
+	var sent []*multipoolerpb.StreamSchemaResponse
+	err := Watch(context.Background(), queryService, time.Millisecond, func(response *multipoolerpb.StreamSchemaResponse) error {
+		sent = append(sent, response)
+		return nil
+	})
+	assert.ErrorContains(t, err, "connection refused")
+
+	require.Len(t, sent, 2)
+	assert.True(t, sent[0].Full)
+	assert.Equal(t, []string{"public.users"}, tableNames(sent[0].Tables))
+	assert.False(t, sent[1].Full)
+	require.Len(t, sent[1].Tables, 1)
+	assert.Len(t, sent[1].Tables[0].Columns, 2)
+}
+
+func TestWatchCancelled(t *testing.T) {
+	queryService := schemaResult([]any{"public", "users", "id", "bigint"})
+	ctx, cancel := context.WithCancel(context.Background())
+	err := Watch(ctx, queryService, time.Hour, func(*multipoolerpb.StreamSchemaResponse) error {
+		cancel()
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// The error of send ends the watch.
+	err = Watch(context.Background(), queryService, time.Hour, func(*multipoolerpb.StreamSchemaResponse) error {
+		return errors.New("stream closed")
+	})
+	assert.ErrorContains(t, err, "stream closed")
+}
@@ -16,9 +16,13 @@
 package multipooler
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"path"
 	"sync"
+	"time"
 
 	"github.com/multigres/multigres/go/common/web"
 )
@@ -82,3 +86,94 @@ func (mp *MultiPooler) handleReady(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// handleConnPool serves the settings of the connection pools. A GET returns
+// them as JSON; a POST changes the settings given as form values, named like
+// their flags, and returns the new settings.
+func (mp *MultiPooler) handleConnPool(w http.ResponseWriter, r *http.Request) {
+	if mp.connPoolMgr == nil {
+		http.Error(w, "connection pools are not configured", http.StatusServiceUnavailable)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to parse form: %v", err), http.StatusBadRequest)
+			return
+		}
+		settings := mp.connPoolMgr.Settings()
+		for name, values := range r.PostForm {
+			if err := settings.Set(name, values[len(values)-1]); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		if err := mp.connPoolMgr.Reconfigure(r.Context(), &settings); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	settings := mp.connPoolMgr.Settings()
+	if err := json.NewEncoder(w).Encode(settings.Values()); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// defaultDrainTimeout is how long a drain waits for the connections in use
+// when no timeout is given.
+const defaultDrainTimeout = 30 * time.Second
+
+// handleConnPoolPause pauses, resumes or drains the connection pools with a
+// POST to /admin/connpool/pause, /resume or /drain. A drain waits for the
+// connections in use for the "timeout" form value, 30s by default, and kills
+// the transactions still open then. It returns whether the pools are paused
+// as JSON.
+func (mp *MultiPooler) handleConnPoolPause(w http.ResponseWriter, r *http.Request) {
+	if mp.connPoolMgr == nil {
+		http.Error(w, "connection pools are not configured", http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch path.Base(r.URL.Path) {
+	case "pause":
+		mp.connPoolMgr.Pause(r.Context())
+	case "resume":
+		mp.connPoolMgr.Resume(r.Context())
+	case "drain":
+		timeout := defaultDrainTimeout
+		if value := r.FormValue("timeout"); value != "" {
+			var err error
+			if timeout, err = time.ParseDuration(value); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid timeout %q: %v", value, err), http.StatusBadRequest)
+				return
+			}
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		if err := mp.connPoolMgr.Drain(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]bool{"paused": mp.connPoolMgr.IsPaused()}); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+	}
+}
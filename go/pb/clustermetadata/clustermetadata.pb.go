@@ -325,7 +325,7 @@ func (x ID_ComponentType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ID_ComponentType.Descriptor instead.
 func (ID_ComponentType) EnumDescriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{9, 0}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{12, 0}
 }
 
 // TopoConfig defines the connection parameters for a topology service.
@@ -409,7 +409,10 @@ type Cell struct {
 	ServerAddresses []string `protobuf:"bytes,2,rep,name=server_addresses,json=serverAddresses,proto3" json:"server_addresses,omitempty"`
 	// root is the namespace or directory path within the topology service
 	// where this cell's metadata is stored. Used only when connecting to server_addresses.
-	Root          string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Root string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	// region is the region the cell belongs to, e.g. the region of its
+	// availability zone. Gateways may fall back to the cells of their region.
+	Region        string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -465,6 +468,13 @@ func (x *Cell) GetRoot() string {
 	return ""
 }
 
+func (x *Cell) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type Database struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Name of the database
@@ -537,14 +547,19 @@ func (x *Database) GetCells() []string {
 	return nil
 }
 
-// BackupLocation specifies where backups are stored
 type BackupLocation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Location:
 	//
 	//	*BackupLocation_Filesystem
 	//	*BackupLocation_S3
-	Location      isBackupLocation_Location `protobuf_oneof:"location"`
+	//	*BackupLocation_Gcs
+	//	*BackupLocation_Azure
+	Location isBackupLocation_Location `protobuf_oneof:"location"`
+	// Retention policy enforced by pgBackRest after each backup.
+	// If unset, object storage locations use the defaults and filesystem
+	// locations keep every backup.
+	Retention     *BackupRetention `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -604,6 +619,31 @@ func (x *BackupLocation) GetS3() *S3Backup {
 	return nil
 }
 
+func (x *BackupLocation) GetGcs() *GCSBackup {
+	if x != nil {
+		if x, ok := x.Location.(*BackupLocation_Gcs); ok {
+			return x.Gcs
+		}
+	}
+	return nil
+}
+
+func (x *BackupLocation) GetAzure() *AzureBackup {
+	if x != nil {
+		if x, ok := x.Location.(*BackupLocation_Azure); ok {
+			return x.Azure
+		}
+	}
+	return nil
+}
+
+func (x *BackupLocation) GetRetention() *BackupRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
 type isBackupLocation_Location interface {
 	isBackupLocation_Location()
 }
@@ -616,10 +656,22 @@ type BackupLocation_S3 struct {
 	S3 *S3Backup `protobuf:"bytes,2,opt,name=s3,proto3,oneof"`
 }
 
+type BackupLocation_Gcs struct {
+	Gcs *GCSBackup `protobuf:"bytes,3,opt,name=gcs,proto3,oneof"`
+}
+
+type BackupLocation_Azure struct {
+	Azure *AzureBackup `protobuf:"bytes,4,opt,name=azure,proto3,oneof"`
+}
+
 func (*BackupLocation_Filesystem) isBackupLocation_Location() {}
 
 func (*BackupLocation_S3) isBackupLocation_Location() {}
 
+func (*BackupLocation_Gcs) isBackupLocation_Location() {}
+
+func (*BackupLocation_Azure) isBackupLocation_Location() {}
+
 // FilesystemBackup stores backups on local filesystem
 type FilesystemBackup struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -755,6 +807,220 @@ func (x *S3Backup) GetUseEnvCredentials() bool {
 	return false
 }
 
+// GCSBackup stores backups in Google Cloud Storage
+type GCSBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GCS bucket name (required)
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Optional: Key prefix within bucket (e.g., "multigres-prod/")
+	KeyPrefix string `protobuf:"bytes,2,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// Optional: Path to a service account key file, present on every multipooler host.
+	// If empty, credentials are detected automatically (workload identity, instance metadata).
+	KeyFile string `protobuf:"bytes,3,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Optional: GCS-compatible endpoint (e.g., for fake-gcs-server testing)
+	// If empty, uses the standard GCS endpoint
+	Endpoint      string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GCSBackup) Reset() {
+	*x = GCSBackup{}
+	mi := &file_clustermetadata_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GCSBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCSBackup) ProtoMessage() {}
+
+func (x *GCSBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCSBackup.ProtoReflect.Descriptor instead.
+func (*GCSBackup) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GCSBackup) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GCSBackup) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *GCSBackup) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *GCSBackup) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+// AzureBackup stores backups in Azure Blob Storage
+type AzureBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Storage account name (required)
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Blob container name (required)
+	Container string `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	// Optional: Key prefix within container (e.g., "multigres-prod/")
+	KeyPrefix string `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// Optional: Azure-compatible endpoint (e.g., for Azurite testing)
+	// If empty, uses the standard blob.core.windows.net endpoint
+	Endpoint string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// If true, read the shared account key from the AZURE_STORAGE_KEY environment
+	// variable at runtime. If false, use automatic authentication (managed identity).
+	UseEnvCredentials bool `protobuf:"varint,5,opt,name=use_env_credentials,json=useEnvCredentials,proto3" json:"use_env_credentials,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AzureBackup) Reset() {
+	*x = AzureBackup{}
+	mi := &file_clustermetadata_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AzureBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AzureBackup) ProtoMessage() {}
+
+func (x *AzureBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AzureBackup.ProtoReflect.Descriptor instead.
+func (*AzureBackup) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AzureBackup) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AzureBackup) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *AzureBackup) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *AzureBackup) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *AzureBackup) GetUseEnvCredentials() bool {
+	if x != nil {
+		return x.UseEnvCredentials
+	}
+	return false
+}
+
+// BackupRetention controls how long pgBackRest keeps backups
+type BackupRetention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keep full backups for this many days (0 uses the default)
+	FullDays uint32 `protobuf:"varint,1,opt,name=full_days,json=fullDays,proto3" json:"full_days,omitempty"`
+	// Number of differential backups to keep (0 uses the default)
+	DifferentialCount uint32 `protobuf:"varint,2,opt,name=differential_count,json=differentialCount,proto3" json:"differential_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BackupRetention) Reset() {
+	*x = BackupRetention{}
+	mi := &file_clustermetadata_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRetention) ProtoMessage() {}
+
+func (x *BackupRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRetention.ProtoReflect.Descriptor instead.
+func (*BackupRetention) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BackupRetention) GetFullDays() uint32 {
+	if x != nil {
+		return x.FullDays
+	}
+	return 0
+}
+
+func (x *BackupRetention) GetDifferentialCount() uint32 {
+	if x != nil {
+		return x.DifferentialCount
+	}
+	return 0
+}
+
 // MultiPooler represents metadata about a running multipooler component instance in the cluster.
 type MultiPooler struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -785,7 +1051,7 @@ type MultiPooler struct {
 
 func (x *MultiPooler) Reset() {
 	*x = MultiPooler{}
-	mi := &file_clustermetadata_proto_msgTypes[6]
+	mi := &file_clustermetadata_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +1063,7 @@ func (x *MultiPooler) String() string {
 func (*MultiPooler) ProtoMessage() {}
 
 func (x *MultiPooler) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[6]
+	mi := &file_clustermetadata_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1076,7 @@ func (x *MultiPooler) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiPooler.ProtoReflect.Descriptor instead.
 func (*MultiPooler) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{6}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MultiPooler) GetId() *ID {
@@ -898,7 +1164,7 @@ type MultiGateway struct {
 
 func (x *MultiGateway) Reset() {
 	*x = MultiGateway{}
-	mi := &file_clustermetadata_proto_msgTypes[7]
+	mi := &file_clustermetadata_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -910,7 +1176,7 @@ func (x *MultiGateway) String() string {
 func (*MultiGateway) ProtoMessage() {}
 
 func (x *MultiGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[7]
+	mi := &file_clustermetadata_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1189,7 @@ func (x *MultiGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiGateway.ProtoReflect.Descriptor instead.
 func (*MultiGateway) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{7}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MultiGateway) GetId() *ID {
@@ -962,7 +1228,7 @@ type MultiOrch struct {
 
 func (x *MultiOrch) Reset() {
 	*x = MultiOrch{}
-	mi := &file_clustermetadata_proto_msgTypes[8]
+	mi := &file_clustermetadata_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -974,7 +1240,7 @@ func (x *MultiOrch) String() string {
 func (*MultiOrch) ProtoMessage() {}
 
 func (x *MultiOrch) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[8]
+	mi := &file_clustermetadata_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,7 +1253,7 @@ func (x *MultiOrch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiOrch.ProtoReflect.Descriptor instead.
 func (*MultiOrch) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{8}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MultiOrch) GetId() *ID {
@@ -1027,7 +1293,7 @@ type ID struct {
 
 func (x *ID) Reset() {
 	*x = ID{}
-	mi := &file_clustermetadata_proto_msgTypes[9]
+	mi := &file_clustermetadata_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1305,7 @@ func (x *ID) String() string {
 func (*ID) ProtoMessage() {}
 
 func (x *ID) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[9]
+	mi := &file_clustermetadata_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1318,7 @@ func (x *ID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ID.ProtoReflect.Descriptor instead.
 func (*ID) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{9}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ID) GetComponent() ID_ComponentType {
@@ -1089,7 +1355,7 @@ type KeyRange struct {
 
 func (x *KeyRange) Reset() {
 	*x = KeyRange{}
-	mi := &file_clustermetadata_proto_msgTypes[10]
+	mi := &file_clustermetadata_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1101,7 +1367,7 @@ func (x *KeyRange) String() string {
 func (*KeyRange) ProtoMessage() {}
 
 func (x *KeyRange) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[10]
+	mi := &file_clustermetadata_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +1380,7 @@ func (x *KeyRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyRange.ProtoReflect.Descriptor instead.
 func (*KeyRange) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{10}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *KeyRange) GetStart() []byte {
@@ -1153,7 +1419,7 @@ type DurabilityPolicy struct {
 
 func (x *DurabilityPolicy) Reset() {
 	*x = DurabilityPolicy{}
-	mi := &file_clustermetadata_proto_msgTypes[11]
+	mi := &file_clustermetadata_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1165,7 +1431,7 @@ func (x *DurabilityPolicy) String() string {
 func (*DurabilityPolicy) ProtoMessage() {}
 
 func (x *DurabilityPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[11]
+	mi := &file_clustermetadata_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,7 +1444,7 @@ func (x *DurabilityPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurabilityPolicy.ProtoReflect.Descriptor instead.
 func (*DurabilityPolicy) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{11}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DurabilityPolicy) GetPolicyName() string {
@@ -1244,7 +1510,7 @@ type QuorumRule struct {
 
 func (x *QuorumRule) Reset() {
 	*x = QuorumRule{}
-	mi := &file_clustermetadata_proto_msgTypes[12]
+	mi := &file_clustermetadata_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1256,7 +1522,7 @@ func (x *QuorumRule) String() string {
 func (*QuorumRule) ProtoMessage() {}
 
 func (x *QuorumRule) ProtoReflect() protoreflect.Message {
-	mi := &file_clustermetadata_proto_msgTypes[12]
+	mi := &file_clustermetadata_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1535,7 @@ func (x *QuorumRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuorumRule.ProtoReflect.Descriptor instead.
 func (*QuorumRule) Descriptor() ([]byte, []int) {
-	return file_clustermetadata_proto_rawDescGZIP(), []int{12}
+	return file_clustermetadata_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QuorumRule) GetQuorumType() QuorumType {
@@ -1300,6 +1566,275 @@ func (x *QuorumRule) GetAsyncFallback() AsyncReplicationFallbackMode {
 	return AsyncReplicationFallbackMode_ASYNC_REPLICATION_FALLBACK_MODE_UNKNOWN
 }
 
+// PrimaryLease is the lease a primary holds on its shard in the global
+// topology. Poolers accept writes only while they hold an unexpired lease
+// for their primary term, which fences off a stale primary.
+type PrimaryLease struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// holder is the pooler holding the lease
+	Holder *ID `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// epoch is the consensus term in which the holder was promoted
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// expire_time is when the lease lapses unless the holder renews it
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrimaryLease) Reset() {
+	*x = PrimaryLease{}
+	mi := &file_clustermetadata_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrimaryLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimaryLease) ProtoMessage() {}
+
+func (x *PrimaryLease) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimaryLease.ProtoReflect.Descriptor instead.
+func (*PrimaryLease) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PrimaryLease) GetHolder() *ID {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+func (x *PrimaryLease) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *PrimaryLease) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// RoutingRule redirects the statements on a table to another tablegroup
+// and shard, e.g. while its data migrates there. It may also alias the
+// table, so that statements use another table in its place.
+type RoutingRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from_table is the table the rule applies to, as statements name it:
+	// "orders", or "sales.orders" for a table of schema sales
+	FromTable string `protobuf:"bytes,1,opt,name=from_table,json=fromTable,proto3" json:"from_table,omitempty"`
+	// to_table is the table statements use in place of from_table, or
+	// empty to keep it
+	ToTable string `protobuf:"bytes,2,opt,name=to_table,json=toTable,proto3" json:"to_table,omitempty"`
+	// tablegroup is where the statements on the table go, or empty to
+	// route them as without the rule
+	Tablegroup string `protobuf:"bytes,3,opt,name=tablegroup,proto3" json:"tablegroup,omitempty"`
+	// shard is the shard of tablegroup the statements go to
+	Shard string `protobuf:"bytes,4,opt,name=shard,proto3" json:"shard,omitempty"`
+	// pooler_type, unless UNKNOWN, is the type of pooler reading the table
+	// outside transactions, whatever the session targets
+	PoolerType    PoolerType `protobuf:"varint,5,opt,name=pooler_type,json=poolerType,proto3,enum=clustermetadata.PoolerType" json:"pooler_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutingRule) Reset() {
+	*x = RoutingRule{}
+	mi := &file_clustermetadata_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingRule) ProtoMessage() {}
+
+func (x *RoutingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingRule.ProtoReflect.Descriptor instead.
+func (*RoutingRule) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RoutingRule) GetFromTable() string {
+	if x != nil {
+		return x.FromTable
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetToTable() string {
+	if x != nil {
+		return x.ToTable
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetTablegroup() string {
+	if x != nil {
+		return x.Tablegroup
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetPoolerType() PoolerType {
+	if x != nil {
+		return x.PoolerType
+	}
+	return PoolerType_UNKNOWN
+}
+
+// RoutingRules are the routing rules of a database.
+type RoutingRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rules are the rules, at most one per table
+	Rules []*RoutingRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// version is incremented by every change of the rules, which only
+	// applies on top of the version it was made from
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutingRules) Reset() {
+	*x = RoutingRules{}
+	mi := &file_clustermetadata_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutingRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingRules) ProtoMessage() {}
+
+func (x *RoutingRules) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingRules.ProtoReflect.Descriptor instead.
+func (*RoutingRules) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RoutingRules) GetRules() []*RoutingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RoutingRules) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ShardingSchema is the sharding schema of a database: its sharded tables
+// and their shard keys, sharding functions, lookup indexes, reference
+// tables and sequences.
+type ShardingSchema struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// document is the YAML document describing the schema, as taken by the
+	// --sharding-schema-file flag of multigateway
+	Document string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// version is incremented by every change of the schema, which only
+	// applies on top of the version it was made from
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardingSchema) Reset() {
+	*x = ShardingSchema{}
+	mi := &file_clustermetadata_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardingSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardingSchema) ProtoMessage() {}
+
+func (x *ShardingSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_clustermetadata_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardingSchema.ProtoReflect.Descriptor instead.
+func (*ShardingSchema) Descriptor() ([]byte, []int) {
+	return file_clustermetadata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ShardingSchema) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *ShardingSchema) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_clustermetadata_proto protoreflect.FileDescriptor
 
 const file_clustermetadata_proto_rawDesc = "" +
@@ -1308,21 +1843,25 @@ const file_clustermetadata_proto_rawDesc = "" +
 	"\x10GlobalTopoConfig\x12&\n" +
 	"\x0eimplementation\x18\x01 \x01(\tR\x0eimplementation\x12)\n" +
 	"\x10server_addresses\x18\x02 \x03(\tR\x0fserverAddresses\x12\x12\n" +
-	"\x04root\x18\x03 \x01(\tR\x04root\"Y\n" +
+	"\x04root\x18\x03 \x01(\tR\x04root\"q\n" +
 	"\x04Cell\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
 	"\x10server_addresses\x18\x02 \x03(\tR\x0fserverAddresses\x12\x12\n" +
-	"\x04root\x18\x03 \x01(\tR\x04root\"\xab\x01\n" +
+	"\x04root\x18\x03 \x01(\tR\x04root\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\"\xab\x01\n" +
 	"\bDatabase\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12H\n" +
 	"\x0fbackup_location\x18\x02 \x01(\v2\x1f.clustermetadata.BackupLocationR\x0ebackupLocation\x12+\n" +
 	"\x11durability_policy\x18\x03 \x01(\tR\x10durabilityPolicy\x12\x14\n" +
-	"\x05cells\x18\x04 \x03(\tR\x05cells\"\x8e\x01\n" +
+	"\x05cells\x18\x04 \x03(\tR\x05cells\"\xb4\x02\n" +
 	"\x0eBackupLocation\x12C\n" +
 	"\n" +
 	"filesystem\x18\x01 \x01(\v2!.clustermetadata.FilesystemBackupH\x00R\n" +
 	"filesystem\x12+\n" +
-	"\x02s3\x18\x02 \x01(\v2\x19.clustermetadata.S3BackupH\x00R\x02s3B\n" +
+	"\x02s3\x18\x02 \x01(\v2\x19.clustermetadata.S3BackupH\x00R\x02s3\x12.\n" +
+	"\x03gcs\x18\x03 \x01(\v2\x1a.clustermetadata.GCSBackupH\x00R\x03gcs\x124\n" +
+	"\x05azure\x18\x04 \x01(\v2\x1c.clustermetadata.AzureBackupH\x00R\x05azure\x12>\n" +
+	"\tretention\x18\x05 \x01(\v2 .clustermetadata.BackupRetentionR\tretentionB\n" +
 	"\n" +
 	"\blocation\"&\n" +
 	"\x10FilesystemBackup\x12\x12\n" +
@@ -1333,7 +1872,23 @@ const file_clustermetadata_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x1d\n" +
 	"\n" +
 	"key_prefix\x18\x04 \x01(\tR\tkeyPrefix\x12.\n" +
-	"\x13use_env_credentials\x18\x05 \x01(\bR\x11useEnvCredentials\"\xf8\x03\n" +
+	"\x13use_env_credentials\x18\x05 \x01(\bR\x11useEnvCredentials\"y\n" +
+	"\tGCSBackup\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x02 \x01(\tR\tkeyPrefix\x12\x19\n" +
+	"\bkey_file\x18\x03 \x01(\tR\akeyFile\x12\x1a\n" +
+	"\bendpoint\x18\x04 \x01(\tR\bendpoint\"\xb0\x01\n" +
+	"\vAzureBackup\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1c\n" +
+	"\tcontainer\x18\x02 \x01(\tR\tcontainer\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x03 \x01(\tR\tkeyPrefix\x12\x1a\n" +
+	"\bendpoint\x18\x04 \x01(\tR\bendpoint\x12.\n" +
+	"\x13use_env_credentials\x18\x05 \x01(\bR\x11useEnvCredentials\"]\n" +
+	"\x0fBackupRetention\x12\x1b\n" +
+	"\tfull_days\x18\x01 \x01(\rR\bfullDays\x12-\n" +
+	"\x12differential_count\x18\x02 \x01(\rR\x11differentialCount\"\xf8\x03\n" +
 	"\vMultiPooler\x12#\n" +
 	"\x02id\x18\x01 \x01(\v2\x13.clustermetadata.IDR\x02id\x12\x1a\n" +
 	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x12\x1f\n" +
@@ -1394,7 +1949,28 @@ const file_clustermetadata_proto_rawDesc = "" +
 	"quorumType\x12%\n" +
 	"\x0erequired_count\x18\x02 \x01(\x05R\rrequiredCount\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12T\n" +
-	"\x0easync_fallback\x18\x04 \x01(\x0e2-.clustermetadata.AsyncReplicationFallbackModeR\rasyncFallback*@\n" +
+	"\x0easync_fallback\x18\x04 \x01(\x0e2-.clustermetadata.AsyncReplicationFallbackModeR\rasyncFallback\"\x8e\x01\n" +
+	"\fPrimaryLease\x12+\n" +
+	"\x06holder\x18\x01 \x01(\v2\x13.clustermetadata.IDR\x06holder\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x03R\x05epoch\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xbb\x01\n" +
+	"\vRoutingRule\x12\x1d\n" +
+	"\n" +
+	"from_table\x18\x01 \x01(\tR\tfromTable\x12\x19\n" +
+	"\bto_table\x18\x02 \x01(\tR\atoTable\x12\x1e\n" +
+	"\n" +
+	"tablegroup\x18\x03 \x01(\tR\n" +
+	"tablegroup\x12\x14\n" +
+	"\x05shard\x18\x04 \x01(\tR\x05shard\x12<\n" +
+	"\vpooler_type\x18\x05 \x01(\x0e2\x1b.clustermetadata.PoolerTypeR\n" +
+	"poolerType\"\\\n" +
+	"\fRoutingRules\x122\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1c.clustermetadata.RoutingRuleR\x05rules\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"F\n" +
+	"\x0eShardingSchema\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion*@\n" +
 	"\n" +
 	"PoolerType\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
@@ -1431,7 +2007,7 @@ func file_clustermetadata_proto_rawDescGZIP() []byte {
 }
 
 var file_clustermetadata_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_clustermetadata_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_clustermetadata_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_clustermetadata_proto_goTypes = []any{
 	(PoolerType)(0),                   // 0: clustermetadata.PoolerType
 	(PoolerServingStatus)(0),          // 1: clustermetadata.PoolerServingStatus
@@ -1444,42 +2020,56 @@ var file_clustermetadata_proto_goTypes = []any{
 	(*BackupLocation)(nil),            // 8: clustermetadata.BackupLocation
 	(*FilesystemBackup)(nil),          // 9: clustermetadata.FilesystemBackup
 	(*S3Backup)(nil),                  // 10: clustermetadata.S3Backup
-	(*MultiPooler)(nil),               // 11: clustermetadata.MultiPooler
-	(*MultiGateway)(nil),              // 12: clustermetadata.MultiGateway
-	(*MultiOrch)(nil),                 // 13: clustermetadata.MultiOrch
-	(*ID)(nil),                        // 14: clustermetadata.ID
-	(*KeyRange)(nil),                  // 15: clustermetadata.KeyRange
-	(*DurabilityPolicy)(nil),          // 16: clustermetadata.DurabilityPolicy
-	(*QuorumRule)(nil),                // 17: clustermetadata.QuorumRule
-	nil,                               // 18: clustermetadata.MultiPooler.PortMapEntry
-	nil,                               // 19: clustermetadata.MultiGateway.PortMapEntry
-	nil,                               // 20: clustermetadata.MultiOrch.PortMapEntry
-	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
+	(*GCSBackup)(nil),                 // 11: clustermetadata.GCSBackup
+	(*AzureBackup)(nil),               // 12: clustermetadata.AzureBackup
+	(*BackupRetention)(nil),           // 13: clustermetadata.BackupRetention
+	(*MultiPooler)(nil),               // 14: clustermetadata.MultiPooler
+	(*MultiGateway)(nil),              // 15: clustermetadata.MultiGateway
+	(*MultiOrch)(nil),                 // 16: clustermetadata.MultiOrch
+	(*ID)(nil),                        // 17: clustermetadata.ID
+	(*KeyRange)(nil),                  // 18: clustermetadata.KeyRange
+	(*DurabilityPolicy)(nil),          // 19: clustermetadata.DurabilityPolicy
+	(*QuorumRule)(nil),                // 20: clustermetadata.QuorumRule
+	(*PrimaryLease)(nil),              // 21: clustermetadata.PrimaryLease
+	(*RoutingRule)(nil),               // 22: clustermetadata.RoutingRule
+	(*RoutingRules)(nil),              // 23: clustermetadata.RoutingRules
+	(*ShardingSchema)(nil),            // 24: clustermetadata.ShardingSchema
+	nil,                               // 25: clustermetadata.MultiPooler.PortMapEntry
+	nil,                               // 26: clustermetadata.MultiGateway.PortMapEntry
+	nil,                               // 27: clustermetadata.MultiOrch.PortMapEntry
+	(*timestamppb.Timestamp)(nil),     // 28: google.protobuf.Timestamp
 }
 var file_clustermetadata_proto_depIdxs = []int32{
 	8,  // 0: clustermetadata.Database.backup_location:type_name -> clustermetadata.BackupLocation
 	9,  // 1: clustermetadata.BackupLocation.filesystem:type_name -> clustermetadata.FilesystemBackup
 	10, // 2: clustermetadata.BackupLocation.s3:type_name -> clustermetadata.S3Backup
-	14, // 3: clustermetadata.MultiPooler.id:type_name -> clustermetadata.ID
-	15, // 4: clustermetadata.MultiPooler.key_range:type_name -> clustermetadata.KeyRange
-	0,  // 5: clustermetadata.MultiPooler.type:type_name -> clustermetadata.PoolerType
-	1,  // 6: clustermetadata.MultiPooler.serving_status:type_name -> clustermetadata.PoolerServingStatus
-	18, // 7: clustermetadata.MultiPooler.port_map:type_name -> clustermetadata.MultiPooler.PortMapEntry
-	14, // 8: clustermetadata.MultiGateway.id:type_name -> clustermetadata.ID
-	19, // 9: clustermetadata.MultiGateway.port_map:type_name -> clustermetadata.MultiGateway.PortMapEntry
-	14, // 10: clustermetadata.MultiOrch.id:type_name -> clustermetadata.ID
-	20, // 11: clustermetadata.MultiOrch.port_map:type_name -> clustermetadata.MultiOrch.PortMapEntry
-	4,  // 12: clustermetadata.ID.component:type_name -> clustermetadata.ID.ComponentType
-	17, // 13: clustermetadata.DurabilityPolicy.quorum_rule:type_name -> clustermetadata.QuorumRule
-	21, // 14: clustermetadata.DurabilityPolicy.created_at:type_name -> google.protobuf.Timestamp
-	21, // 15: clustermetadata.DurabilityPolicy.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 16: clustermetadata.QuorumRule.quorum_type:type_name -> clustermetadata.QuorumType
-	3,  // 17: clustermetadata.QuorumRule.async_fallback:type_name -> clustermetadata.AsyncReplicationFallbackMode
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	11, // 3: clustermetadata.BackupLocation.gcs:type_name -> clustermetadata.GCSBackup
+	12, // 4: clustermetadata.BackupLocation.azure:type_name -> clustermetadata.AzureBackup
+	13, // 5: clustermetadata.BackupLocation.retention:type_name -> clustermetadata.BackupRetention
+	17, // 6: clustermetadata.MultiPooler.id:type_name -> clustermetadata.ID
+	18, // 7: clustermetadata.MultiPooler.key_range:type_name -> clustermetadata.KeyRange
+	0,  // 8: clustermetadata.MultiPooler.type:type_name -> clustermetadata.PoolerType
+	1,  // 9: clustermetadata.MultiPooler.serving_status:type_name -> clustermetadata.PoolerServingStatus
+	25, // 10: clustermetadata.MultiPooler.port_map:type_name -> clustermetadata.MultiPooler.PortMapEntry
+	17, // 11: clustermetadata.MultiGateway.id:type_name -> clustermetadata.ID
+	26, // 12: clustermetadata.MultiGateway.port_map:type_name -> clustermetadata.MultiGateway.PortMapEntry
+	17, // 13: clustermetadata.MultiOrch.id:type_name -> clustermetadata.ID
+	27, // 14: clustermetadata.MultiOrch.port_map:type_name -> clustermetadata.MultiOrch.PortMapEntry
+	4,  // 15: clustermetadata.ID.component:type_name -> clustermetadata.ID.ComponentType
+	20, // 16: clustermetadata.DurabilityPolicy.quorum_rule:type_name -> clustermetadata.QuorumRule
+	28, // 17: clustermetadata.DurabilityPolicy.created_at:type_name -> google.protobuf.Timestamp
+	28, // 18: clustermetadata.DurabilityPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 19: clustermetadata.QuorumRule.quorum_type:type_name -> clustermetadata.QuorumType
+	3,  // 20: clustermetadata.QuorumRule.async_fallback:type_name -> clustermetadata.AsyncReplicationFallbackMode
+	17, // 21: clustermetadata.PrimaryLease.holder:type_name -> clustermetadata.ID
+	28, // 22: clustermetadata.PrimaryLease.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 23: clustermetadata.RoutingRule.pooler_type:type_name -> clustermetadata.PoolerType
+	22, // 24: clustermetadata.RoutingRules.rules:type_name -> clustermetadata.RoutingRule
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_clustermetadata_proto_init() }
@@ -1490,6 +2080,8 @@ func file_clustermetadata_proto_init() {
 	file_clustermetadata_proto_msgTypes[3].OneofWrappers = []any{
 		(*BackupLocation_Filesystem)(nil),
 		(*BackupLocation_S3)(nil),
+		(*BackupLocation_Gcs)(nil),
+		(*BackupLocation_Azure)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1497,7 +2089,7 @@ func file_clustermetadata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_clustermetadata_proto_rawDesc), len(file_clustermetadata_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -198,6 +199,64 @@ func (BackupStatus) EnumDescriptor() ([]byte, []int) {
 	return file_multiadminservice_proto_rawDescGZIP(), []int{2}
 }
 
+// UpgradeShardAction selects the step of a major version upgrade
+type UpgradeShardAction int32
+
+const (
+	// Upgrade the shard
+	UpgradeShardAction_UPGRADE_SHARD_ACTION_UPGRADE UpgradeShardAction = 0
+	// Only check that the primary can be upgraded
+	UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK UpgradeShardAction = 1
+	// Remove the rollback points of the last upgrade
+	UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE UpgradeShardAction = 2
+	// Restore the data directories from before the last upgrade. Only possible
+	// until the upgraded primary was started.
+	UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK UpgradeShardAction = 3
+)
+
+// Enum value maps for UpgradeShardAction.
+var (
+	UpgradeShardAction_name = map[int32]string{
+		0: "UPGRADE_SHARD_ACTION_UPGRADE",
+		1: "UPGRADE_SHARD_ACTION_CHECK",
+		2: "UPGRADE_SHARD_ACTION_FINALIZE",
+		3: "UPGRADE_SHARD_ACTION_ROLLBACK",
+	}
+	UpgradeShardAction_value = map[string]int32{
+		"UPGRADE_SHARD_ACTION_UPGRADE":  0,
+		"UPGRADE_SHARD_ACTION_CHECK":    1,
+		"UPGRADE_SHARD_ACTION_FINALIZE": 2,
+		"UPGRADE_SHARD_ACTION_ROLLBACK": 3,
+	}
+)
+
+func (x UpgradeShardAction) Enum() *UpgradeShardAction {
+	p := new(UpgradeShardAction)
+	*p = x
+	return p
+}
+
+func (x UpgradeShardAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpgradeShardAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiadminservice_proto_enumTypes[3].Descriptor()
+}
+
+func (UpgradeShardAction) Type() protoreflect.EnumType {
+	return &file_multiadminservice_proto_enumTypes[3]
+}
+
+func (x UpgradeShardAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpgradeShardAction.Descriptor instead.
+func (UpgradeShardAction) EnumDescriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{3}
+}
+
 // GetCellRequest specifies the cell to retrieve
 type GetCellRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -981,7 +1040,12 @@ type RestoreFromBackupRequest struct {
 	// pooler_id identifies which multipooler to restore to (required).
 	// This is needed because a cell can have multiple poolers for the same
 	// database/table_group/shard combination. Restores are only allowed to standbys.
-	PoolerId      *clustermetadata.ID `protobuf:"bytes,5,opt,name=pooler_id,json=poolerId,proto3" json:"pooler_id,omitempty"`
+	PoolerId *clustermetadata.ID `protobuf:"bytes,5,opt,name=pooler_id,json=poolerId,proto3" json:"pooler_id,omitempty"`
+	// target_time recovers to a point in time (optional). The restored standby
+	// pauses recovery once the target is reached.
+	TargetTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=target_time,json=targetTime,proto3" json:"target_time,omitempty"`
+	// target_lsn recovers to an LSN (optional, exclusive with target_time)
+	TargetLsn     string `protobuf:"bytes,7,opt,name=target_lsn,json=targetLsn,proto3" json:"target_lsn,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1051,6 +1115,20 @@ func (x *RestoreFromBackupRequest) GetPoolerId() *clustermetadata.ID {
 	return nil
 }
 
+func (x *RestoreFromBackupRequest) GetTargetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetTime
+	}
+	return nil
+}
+
+func (x *RestoreFromBackupRequest) GetTargetLsn() string {
+	if x != nil {
+		return x.TargetLsn
+	}
+	return ""
+}
+
 // RestoreFromBackupResponse contains the job ID for tracking the async restore
 type RestoreFromBackupResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1437,7 +1515,10 @@ type BackupInfo struct {
 	// multipooler_service_id is the ID of the multipooler that reported the backup
 	MultipoolerServiceId string `protobuf:"bytes,9,opt,name=multipooler_service_id,json=multipoolerServiceId,proto3" json:"multipooler_service_id,omitempty"`
 	// pooler_type is the type of the multipooler (PRIMARY or REPLICA)
-	PoolerType    clustermetadata.PoolerType `protobuf:"varint,10,opt,name=pooler_type,json=poolerType,proto3,enum=clustermetadata.PoolerType" json:"pooler_type,omitempty"`
+	PoolerType clustermetadata.PoolerType `protobuf:"varint,10,opt,name=pooler_type,json=poolerType,proto3,enum=clustermetadata.PoolerType" json:"pooler_type,omitempty"`
+	// prior_backup_id is the backup that a differential or incremental backup
+	// is based on. Empty for full backups.
+	PriorBackupId string `protobuf:"bytes,11,opt,name=prior_backup_id,json=priorBackupId,proto3" json:"prior_backup_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1542,6 +1623,242 @@ func (x *BackupInfo) GetPoolerType() clustermetadata.PoolerType {
 	return clustermetadata.PoolerType(0)
 }
 
+func (x *BackupInfo) GetPriorBackupId() string {
+	if x != nil {
+		return x.PriorBackupId
+	}
+	return ""
+}
+
+// VerifyBackupRequest requests verification of a backup artifact
+type VerifyBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// database name (required)
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// table_group name (required)
+	TableGroup string `protobuf:"bytes,2,opt,name=table_group,json=tableGroup,proto3" json:"table_group,omitempty"`
+	// shard name (required)
+	Shard string `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// backup_id of the backup to verify (required)
+	BackupId      string `protobuf:"bytes,4,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBackupRequest) Reset() {
+	*x = VerifyBackupRequest{}
+	mi := &file_multiadminservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBackupRequest) ProtoMessage() {}
+
+func (x *VerifyBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBackupRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBackupRequest) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerifyBackupRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *VerifyBackupRequest) GetTableGroup() string {
+	if x != nil {
+		return x.TableGroup
+	}
+	return ""
+}
+
+func (x *VerifyBackupRequest) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *VerifyBackupRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+// VerifyBackupResponse contains the result of a backup verification
+type VerifyBackupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output of the verification, as reported by the multipooler
+	Output        string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBackupResponse) Reset() {
+	*x = VerifyBackupResponse{}
+	mi := &file_multiadminservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBackupResponse) ProtoMessage() {}
+
+func (x *VerifyBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBackupResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBackupResponse) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VerifyBackupResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// DeleteBackupRequest requests removal of a backup artifact
+type DeleteBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// database name (required)
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// table_group name (required)
+	TableGroup string `protobuf:"bytes,2,opt,name=table_group,json=tableGroup,proto3" json:"table_group,omitempty"`
+	// shard name (required)
+	Shard string `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// backup_id of the backup to delete (required)
+	BackupId      string `protobuf:"bytes,4,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBackupRequest) Reset() {
+	*x = DeleteBackupRequest{}
+	mi := &file_multiadminservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackupRequest) ProtoMessage() {}
+
+func (x *DeleteBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteBackupRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetTableGroup() string {
+	if x != nil {
+		return x.TableGroup
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+// DeleteBackupResponse confirms the backup was deleted
+type DeleteBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBackupResponse) Reset() {
+	*x = DeleteBackupResponse{}
+	mi := &file_multiadminservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackupResponse) ProtoMessage() {}
+
+func (x *DeleteBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBackupResponse) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{26}
+}
+
 // GetPoolerStatusRequest requests the status of a specific pooler
 type GetPoolerStatusRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1553,7 +1870,7 @@ type GetPoolerStatusRequest struct {
 
 func (x *GetPoolerStatusRequest) Reset() {
 	*x = GetPoolerStatusRequest{}
-	mi := &file_multiadminservice_proto_msgTypes[23]
+	mi := &file_multiadminservice_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1565,7 +1882,7 @@ func (x *GetPoolerStatusRequest) String() string {
 func (*GetPoolerStatusRequest) ProtoMessage() {}
 
 func (x *GetPoolerStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_multiadminservice_proto_msgTypes[23]
+	mi := &file_multiadminservice_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,7 +1895,7 @@ func (x *GetPoolerStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPoolerStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetPoolerStatusRequest) Descriptor() ([]byte, []int) {
-	return file_multiadminservice_proto_rawDescGZIP(), []int{23}
+	return file_multiadminservice_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetPoolerStatusRequest) GetPoolerId() *clustermetadata.ID {
@@ -1599,7 +1916,7 @@ type GetPoolerStatusResponse struct {
 
 func (x *GetPoolerStatusResponse) Reset() {
 	*x = GetPoolerStatusResponse{}
-	mi := &file_multiadminservice_proto_msgTypes[24]
+	mi := &file_multiadminservice_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1611,7 +1928,7 @@ func (x *GetPoolerStatusResponse) String() string {
 func (*GetPoolerStatusResponse) ProtoMessage() {}
 
 func (x *GetPoolerStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_multiadminservice_proto_msgTypes[24]
+	mi := &file_multiadminservice_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,7 +1941,7 @@ func (x *GetPoolerStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPoolerStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetPoolerStatusResponse) Descriptor() ([]byte, []int) {
-	return file_multiadminservice_proto_rawDescGZIP(), []int{24}
+	return file_multiadminservice_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetPoolerStatusResponse) GetStatus() *multipoolermanagerdata.Status {
@@ -1647,7 +1964,7 @@ type SetPostgresMonitorRequest struct {
 
 func (x *SetPostgresMonitorRequest) Reset() {
 	*x = SetPostgresMonitorRequest{}
-	mi := &file_multiadminservice_proto_msgTypes[25]
+	mi := &file_multiadminservice_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1659,7 +1976,7 @@ func (x *SetPostgresMonitorRequest) String() string {
 func (*SetPostgresMonitorRequest) ProtoMessage() {}
 
 func (x *SetPostgresMonitorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_multiadminservice_proto_msgTypes[25]
+	mi := &file_multiadminservice_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,7 +1989,7 @@ func (x *SetPostgresMonitorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPostgresMonitorRequest.ProtoReflect.Descriptor instead.
 func (*SetPostgresMonitorRequest) Descriptor() ([]byte, []int) {
-	return file_multiadminservice_proto_rawDescGZIP(), []int{25}
+	return file_multiadminservice_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SetPostgresMonitorRequest) GetPoolerId() *clustermetadata.ID {
@@ -1699,7 +2016,7 @@ type SetPostgresMonitorResponse struct {
 
 func (x *SetPostgresMonitorResponse) Reset() {
 	*x = SetPostgresMonitorResponse{}
-	mi := &file_multiadminservice_proto_msgTypes[26]
+	mi := &file_multiadminservice_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +2028,7 @@ func (x *SetPostgresMonitorResponse) String() string {
 func (*SetPostgresMonitorResponse) ProtoMessage() {}
 
 func (x *SetPostgresMonitorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_multiadminservice_proto_msgTypes[26]
+	mi := &file_multiadminservice_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,28 +2041,524 @@ func (x *SetPostgresMonitorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPostgresMonitorResponse.ProtoReflect.Descriptor instead.
 func (*SetPostgresMonitorResponse) Descriptor() ([]byte, []int) {
-	return file_multiadminservice_proto_rawDescGZIP(), []int{26}
+	return file_multiadminservice_proto_rawDescGZIP(), []int{30}
 }
 
-var File_multiadminservice_proto protoreflect.FileDescriptor
+// PlannedReparentShardRequest specifies the shard to reparent
+type PlannedReparentShardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// database name (required)
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// table_group name (required)
+	TableGroup string `protobuf:"bytes,2,opt,name=table_group,json=tableGroup,proto3" json:"table_group,omitempty"`
+	// shard name (required)
+	Shard string `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// new_primary is the replica to promote. When unset, the most advanced
+	// healthy replica is chosen. The cell may be left empty.
+	NewPrimary *clustermetadata.ID `protobuf:"bytes,4,opt,name=new_primary,json=newPrimary,proto3" json:"new_primary,omitempty"`
+	// drain_timeout is how long the primary waits for its write transactions
+	// to finish before it is demoted (default: 5s)
+	DrainTimeout  *durationpb.Duration `protobuf:"bytes,5,opt,name=drain_timeout,json=drainTimeout,proto3" json:"drain_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_multiadminservice_proto_rawDesc = "" +
-	"\n" +
-	"\x17multiadminservice.proto\x12\n" +
-	"multiadmin\x1a\x15clustermetadata.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmultipoolermanagerdata.proto\"$\n" +
-	"\x0eGetCellRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"<\n" +
-	"\x0fGetCellResponse\x12)\n" +
-	"\x04cell\x18\x01 \x01(\v2\x15.clustermetadata.CellR\x04cell\"(\n" +
-	"\x12GetDatabaseRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
-	"\x13GetDatabaseResponse\x125\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x19.clustermetadata.DatabaseR\bdatabase\"\x15\n" +
-	"\x13GetCellNamesRequest\",\n" +
-	"\x14GetCellNamesResponse\x12\x14\n" +
-	"\x05names\x18\x01 \x03(\tR\x05names\"\x19\n" +
-	"\x17GetDatabaseNamesRequest\"0\n" +
-	"\x18GetDatabaseNamesResponse\x12\x14\n" +
+func (x *PlannedReparentShardRequest) Reset() {
+	*x = PlannedReparentShardRequest{}
+	mi := &file_multiadminservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedReparentShardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedReparentShardRequest) ProtoMessage() {}
+
+func (x *PlannedReparentShardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedReparentShardRequest.ProtoReflect.Descriptor instead.
+func (*PlannedReparentShardRequest) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PlannedReparentShardRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PlannedReparentShardRequest) GetTableGroup() string {
+	if x != nil {
+		return x.TableGroup
+	}
+	return ""
+}
+
+func (x *PlannedReparentShardRequest) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *PlannedReparentShardRequest) GetNewPrimary() *clustermetadata.ID {
+	if x != nil {
+		return x.NewPrimary
+	}
+	return nil
+}
+
+func (x *PlannedReparentShardRequest) GetDrainTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DrainTimeout
+	}
+	return nil
+}
+
+// PlannedReparentShardResponse describes the completed reparent
+type PlannedReparentShardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// previous_primary is the pooler that was the primary
+	PreviousPrimary *clustermetadata.ID `protobuf:"bytes,1,opt,name=previous_primary,json=previousPrimary,proto3" json:"previous_primary,omitempty"`
+	// new_primary is the pooler promoted in its place
+	NewPrimary *clustermetadata.ID `protobuf:"bytes,2,opt,name=new_primary,json=newPrimary,proto3" json:"new_primary,omitempty"`
+	// term is the consensus term of the new primary
+	Term          int64 `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedReparentShardResponse) Reset() {
+	*x = PlannedReparentShardResponse{}
+	mi := &file_multiadminservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedReparentShardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedReparentShardResponse) ProtoMessage() {}
+
+func (x *PlannedReparentShardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedReparentShardResponse.ProtoReflect.Descriptor instead.
+func (*PlannedReparentShardResponse) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlannedReparentShardResponse) GetPreviousPrimary() *clustermetadata.ID {
+	if x != nil {
+		return x.PreviousPrimary
+	}
+	return nil
+}
+
+func (x *PlannedReparentShardResponse) GetNewPrimary() *clustermetadata.ID {
+	if x != nil {
+		return x.NewPrimary
+	}
+	return nil
+}
+
+func (x *PlannedReparentShardResponse) GetTerm() int64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+// UpgradeShardRequest specifies the shard to upgrade
+type UpgradeShardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// database name (required)
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// table_group name (required)
+	TableGroup string `protobuf:"bytes,2,opt,name=table_group,json=tableGroup,proto3" json:"table_group,omitempty"`
+	// shard name (required)
+	Shard string `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// new_bin_dir is the directory of the binaries of the new major version on
+	// the hosts of the poolers (required to upgrade or check)
+	NewBinDir string `protobuf:"bytes,4,opt,name=new_bin_dir,json=newBinDir,proto3" json:"new_bin_dir,omitempty"`
+	// action is the step of the upgrade to run
+	Action        UpgradeShardAction `protobuf:"varint,5,opt,name=action,proto3,enum=multiadmin.UpgradeShardAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeShardRequest) Reset() {
+	*x = UpgradeShardRequest{}
+	mi := &file_multiadminservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeShardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeShardRequest) ProtoMessage() {}
+
+func (x *UpgradeShardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeShardRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeShardRequest) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpgradeShardRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *UpgradeShardRequest) GetTableGroup() string {
+	if x != nil {
+		return x.TableGroup
+	}
+	return ""
+}
+
+func (x *UpgradeShardRequest) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *UpgradeShardRequest) GetNewBinDir() string {
+	if x != nil {
+		return x.NewBinDir
+	}
+	return ""
+}
+
+func (x *UpgradeShardRequest) GetAction() UpgradeShardAction {
+	if x != nil {
+		return x.Action
+	}
+	return UpgradeShardAction_UPGRADE_SHARD_ACTION_UPGRADE
+}
+
+// UpgradeShardResponse describes the upgrade
+type UpgradeShardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// old_version is the major version the shard is upgraded from
+	OldVersion string `protobuf:"bytes,1,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+	// new_version is the major version the shard is upgraded to
+	NewVersion    string `protobuf:"bytes,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeShardResponse) Reset() {
+	*x = UpgradeShardResponse{}
+	mi := &file_multiadminservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeShardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeShardResponse) ProtoMessage() {}
+
+func (x *UpgradeShardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeShardResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeShardResponse) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpgradeShardResponse) GetOldVersion() string {
+	if x != nil {
+		return x.OldVersion
+	}
+	return ""
+}
+
+func (x *UpgradeShardResponse) GetNewVersion() string {
+	if x != nil {
+		return x.NewVersion
+	}
+	return ""
+}
+
+// InstallExtensionRequest specifies the extension to install
+type InstallExtensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// database name (required)
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// name of the extension (required)
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// version to create or update to, the default version if empty
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// schema to create the extension in
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// cascade also creates the extensions it requires
+	Cascade bool `protobuf:"varint,5,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	// check_only only checks that every pooler can run the extension
+	CheckOnly     bool `protobuf:"varint,6,opt,name=check_only,json=checkOnly,proto3" json:"check_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallExtensionRequest) Reset() {
+	*x = InstallExtensionRequest{}
+	mi := &file_multiadminservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallExtensionRequest) ProtoMessage() {}
+
+func (x *InstallExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallExtensionRequest.ProtoReflect.Descriptor instead.
+func (*InstallExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InstallExtensionRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *InstallExtensionRequest) GetCheckOnly() bool {
+	if x != nil {
+		return x.CheckOnly
+	}
+	return false
+}
+
+// ExtensionShardResult describes the extension on a shard
+type ExtensionShardResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// table_group of the shard
+	TableGroup string `protobuf:"bytes,1,opt,name=table_group,json=tableGroup,proto3" json:"table_group,omitempty"`
+	// shard name
+	Shard string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// old_version is the version before, empty if the extension was created
+	OldVersion string `protobuf:"bytes,3,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+	// new_version is the version now created in the database
+	NewVersion    string `protobuf:"bytes,4,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionShardResult) Reset() {
+	*x = ExtensionShardResult{}
+	mi := &file_multiadminservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionShardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionShardResult) ProtoMessage() {}
+
+func (x *ExtensionShardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionShardResult.ProtoReflect.Descriptor instead.
+func (*ExtensionShardResult) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExtensionShardResult) GetTableGroup() string {
+	if x != nil {
+		return x.TableGroup
+	}
+	return ""
+}
+
+func (x *ExtensionShardResult) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *ExtensionShardResult) GetOldVersion() string {
+	if x != nil {
+		return x.OldVersion
+	}
+	return ""
+}
+
+func (x *ExtensionShardResult) GetNewVersion() string {
+	if x != nil {
+		return x.NewVersion
+	}
+	return ""
+}
+
+// InstallExtensionResponse describes the extension on every shard
+type InstallExtensionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shards are sorted by table group and shard
+	Shards        []*ExtensionShardResult `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallExtensionResponse) Reset() {
+	*x = InstallExtensionResponse{}
+	mi := &file_multiadminservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallExtensionResponse) ProtoMessage() {}
+
+func (x *InstallExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiadminservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallExtensionResponse.ProtoReflect.Descriptor instead.
+func (*InstallExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_multiadminservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InstallExtensionResponse) GetShards() []*ExtensionShardResult {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+var File_multiadminservice_proto protoreflect.FileDescriptor
+
+const file_multiadminservice_proto_rawDesc = "" +
+	"\n" +
+	"\x17multiadminservice.proto\x12\n" +
+	"multiadmin\x1a\x15clustermetadata.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmultipoolermanagerdata.proto\"$\n" +
+	"\x0eGetCellRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"<\n" +
+	"\x0fGetCellResponse\x12)\n" +
+	"\x04cell\x18\x01 \x01(\v2\x15.clustermetadata.CellR\x04cell\"(\n" +
+	"\x12GetDatabaseRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x13GetDatabaseResponse\x125\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x19.clustermetadata.DatabaseR\bdatabase\"\x15\n" +
+	"\x13GetCellNamesRequest\",\n" +
+	"\x14GetCellNamesResponse\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"\x19\n" +
+	"\x17GetDatabaseNamesRequest\"0\n" +
+	"\x18GetDatabaseNamesResponse\x12\x14\n" +
 	"\x05names\x18\x01 \x03(\tR\x05names\"*\n" +
 	"\x12GetGatewaysRequest\x12\x14\n" +
 	"\x05cells\x18\x01 \x03(\tR\x05cells\"P\n" +
@@ -1769,14 +2582,18 @@ const file_multiadminservice_proto_rawDesc = "" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x12#\n" +
 	"\rforce_primary\x18\x05 \x01(\bR\fforcePrimary\"'\n" +
 	"\x0eBackupResponse\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xbc\x01\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x98\x02\n" +
 	"\x18RestoreFromBackupRequest\x12\x1a\n" +
 	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x1f\n" +
 	"\vtable_group\x18\x02 \x01(\tR\n" +
 	"tableGroup\x12\x14\n" +
 	"\x05shard\x18\x03 \x01(\tR\x05shard\x12\x1b\n" +
 	"\tbackup_id\x18\x04 \x01(\tR\bbackupId\x120\n" +
-	"\tpooler_id\x18\x05 \x01(\v2\x13.clustermetadata.IDR\bpoolerId\"2\n" +
+	"\tpooler_id\x18\x05 \x01(\v2\x13.clustermetadata.IDR\bpoolerId\x12;\n" +
+	"\vtarget_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"targetTime\x12\x1d\n" +
+	"\n" +
+	"target_lsn\x18\a \x01(\tR\ttargetLsn\"2\n" +
 	"\x19RestoreFromBackupResponse\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x85\x01\n" +
 	"\x19GetBackupJobStatusRequest\x12\x15\n" +
@@ -1806,7 +2623,7 @@ const file_multiadminservice_proto_rawDesc = "" +
 	"\x05shard\x18\x03 \x01(\tR\x05shard\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\rR\x05limit\"F\n" +
 	"\x12GetBackupsResponse\x120\n" +
-	"\abackups\x18\x01 \x03(\v2\x16.multiadmin.BackupInfoR\abackups\"\x9f\x03\n" +
+	"\abackups\x18\x01 \x03(\v2\x16.multiadmin.BackupInfoR\abackups\"\xc7\x03\n" +
 	"\n" +
 	"BackupInfo\x12\x1b\n" +
 	"\tbackup_id\x18\x01 \x01(\tR\bbackupId\x12\x1a\n" +
@@ -1822,7 +2639,23 @@ const file_multiadminservice_proto_rawDesc = "" +
 	"\x16multipooler_service_id\x18\t \x01(\tR\x14multipoolerServiceId\x12<\n" +
 	"\vpooler_type\x18\n" +
 	" \x01(\x0e2\x1b.clustermetadata.PoolerTypeR\n" +
-	"poolerType\"J\n" +
+	"poolerType\x12&\n" +
+	"\x0fprior_backup_id\x18\v \x01(\tR\rpriorBackupId\"\x85\x01\n" +
+	"\x13VerifyBackupRequest\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x1f\n" +
+	"\vtable_group\x18\x02 \x01(\tR\n" +
+	"tableGroup\x12\x14\n" +
+	"\x05shard\x18\x03 \x01(\tR\x05shard\x12\x1b\n" +
+	"\tbackup_id\x18\x04 \x01(\tR\bbackupId\".\n" +
+	"\x14VerifyBackupResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"\x85\x01\n" +
+	"\x13DeleteBackupRequest\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x1f\n" +
+	"\vtable_group\x18\x02 \x01(\tR\n" +
+	"tableGroup\x12\x14\n" +
+	"\x05shard\x18\x03 \x01(\tR\x05shard\x12\x1b\n" +
+	"\tbackup_id\x18\x04 \x01(\tR\bbackupId\"\x16\n" +
+	"\x14DeleteBackupResponse\"J\n" +
 	"\x16GetPoolerStatusRequest\x120\n" +
 	"\tpooler_id\x18\x01 \x01(\v2\x13.clustermetadata.IDR\bpoolerId\"Q\n" +
 	"\x17GetPoolerStatusResponse\x126\n" +
@@ -1830,7 +2663,50 @@ const file_multiadminservice_proto_rawDesc = "" +
 	"\x19SetPostgresMonitorRequest\x120\n" +
 	"\tpooler_id\x18\x01 \x01(\v2\x13.clustermetadata.IDR\bpoolerId\x12\x18\n" +
 	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x1c\n" +
-	"\x1aSetPostgresMonitorResponse*J\n" +
+	"\x1aSetPostgresMonitorResponse\"\xe6\x01\n" +
+	"\x1bPlannedReparentShardRequest\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x1f\n" +
+	"\vtable_group\x18\x02 \x01(\tR\n" +
+	"tableGroup\x12\x14\n" +
+	"\x05shard\x18\x03 \x01(\tR\x05shard\x124\n" +
+	"\vnew_primary\x18\x04 \x01(\v2\x13.clustermetadata.IDR\n" +
+	"newPrimary\x12>\n" +
+	"\rdrain_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fdrainTimeout\"\xa8\x01\n" +
+	"\x1cPlannedReparentShardResponse\x12>\n" +
+	"\x10previous_primary\x18\x01 \x01(\v2\x13.clustermetadata.IDR\x0fpreviousPrimary\x124\n" +
+	"\vnew_primary\x18\x02 \x01(\v2\x13.clustermetadata.IDR\n" +
+	"newPrimary\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\x03R\x04term\"\xc0\x01\n" +
+	"\x13UpgradeShardRequest\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x1f\n" +
+	"\vtable_group\x18\x02 \x01(\tR\n" +
+	"tableGroup\x12\x14\n" +
+	"\x05shard\x18\x03 \x01(\tR\x05shard\x12\x1e\n" +
+	"\vnew_bin_dir\x18\x04 \x01(\tR\tnewBinDir\x126\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x1e.multiadmin.UpgradeShardActionR\x06action\"X\n" +
+	"\x14UpgradeShardResponse\x12\x1f\n" +
+	"\vold_version\x18\x01 \x01(\tR\n" +
+	"oldVersion\x12\x1f\n" +
+	"\vnew_version\x18\x02 \x01(\tR\n" +
+	"newVersion\"\xb4\x01\n" +
+	"\x17InstallExtensionRequest\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x16\n" +
+	"\x06schema\x18\x04 \x01(\tR\x06schema\x12\x18\n" +
+	"\acascade\x18\x05 \x01(\bR\acascade\x12\x1d\n" +
+	"\n" +
+	"check_only\x18\x06 \x01(\bR\tcheckOnly\"\x8f\x01\n" +
+	"\x14ExtensionShardResult\x12\x1f\n" +
+	"\vtable_group\x18\x01 \x01(\tR\n" +
+	"tableGroup\x12\x14\n" +
+	"\x05shard\x18\x02 \x01(\tR\x05shard\x12\x1f\n" +
+	"\vold_version\x18\x03 \x01(\tR\n" +
+	"oldVersion\x12\x1f\n" +
+	"\vnew_version\x18\x04 \x01(\tR\n" +
+	"newVersion\"T\n" +
+	"\x18InstallExtensionResponse\x128\n" +
+	"\x06shards\x18\x01 \x03(\v2 .multiadmin.ExtensionShardResultR\x06shards*J\n" +
 	"\aJobType\x12\x14\n" +
 	"\x10JOB_TYPE_UNKNOWN\x10\x00\x12\x13\n" +
 	"\x0fJOB_TYPE_BACKUP\x10\x01\x12\x14\n" +
@@ -1845,7 +2721,12 @@ const file_multiadminservice_proto_rawDesc = "" +
 	"\x15BACKUP_STATUS_UNKNOWN\x10\x00\x12\x1c\n" +
 	"\x18BACKUP_STATUS_INCOMPLETE\x10\x01\x12\x1a\n" +
 	"\x16BACKUP_STATUS_COMPLETE\x10\x02\x12\x18\n" +
-	"\x14BACKUP_STATUS_FAILED\x10\x032\x94\f\n" +
+	"\x14BACKUP_STATUS_FAILED\x10\x03*\x9c\x01\n" +
+	"\x12UpgradeShardAction\x12 \n" +
+	"\x1cUPGRADE_SHARD_ACTION_UPGRADE\x10\x00\x12\x1e\n" +
+	"\x1aUPGRADE_SHARD_ACTION_CHECK\x10\x01\x12!\n" +
+	"\x1dUPGRADE_SHARD_ACTION_FINALIZE\x10\x02\x12!\n" +
+	"\x1dUPGRADE_SHARD_ACTION_ROLLBACK\x10\x032\xa4\x11\n" +
 	"\x11MultiAdminService\x12`\n" +
 	"\aGetCell\x12\x1a.multiadmin.GetCellRequest\x1a\x1b.multiadmin.GetCellResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/cells/{name}\x12p\n" +
 	"\vGetDatabase\x12\x1e.multiadmin.GetDatabaseRequest\x1a\x1f.multiadmin.GetDatabaseResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/databases/{name}\x12h\n" +
@@ -1859,9 +2740,14 @@ const file_multiadminservice_proto_rawDesc = "" +
 	"\x11RestoreFromBackup\x12$.multiadmin.RestoreFromBackupRequest\x1a%.multiadmin.RestoreFromBackupResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/restores\x12\x82\x01\n" +
 	"\x12GetBackupJobStatus\x12%.multiadmin.GetBackupJobStatusRequest\x1a&.multiadmin.GetBackupJobStatusResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/jobs/{job_id}\x12d\n" +
 	"\n" +
-	"GetBackups\x12\x1d.multiadmin.GetBackupsRequest\x1a\x1e.multiadmin.GetBackupsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/backups\x12\x9c\x01\n" +
+	"GetBackups\x12\x1d.multiadmin.GetBackupsRequest\x1a\x1e.multiadmin.GetBackupsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/backups\x12\x80\x01\n" +
+	"\fVerifyBackup\x12\x1f.multiadmin.VerifyBackupRequest\x1a .multiadmin.VerifyBackupResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/backups/{backup_id}/verify\x12v\n" +
+	"\fDeleteBackup\x12\x1f.multiadmin.DeleteBackupRequest\x1a .multiadmin.DeleteBackupResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/api/v1/backups/{backup_id}\x12\x9c\x01\n" +
 	"\x0fGetPoolerStatus\x12\".multiadmin.GetPoolerStatusRequest\x1a#.multiadmin.GetPoolerStatusResponse\"@\x82\xd3\xe4\x93\x02:\x128/api/v1/poolers/{pooler_id.cell}/{pooler_id.name}/status\x12\xb2\x01\n" +
-	"\x12SetPostgresMonitor\x12%.multiadmin.SetPostgresMonitorRequest\x1a&.multiadmin.SetPostgresMonitorResponse\"M\x82\xd3\xe4\x93\x02G:\x01*\"B/api/v1/poolers/{pooler_id.cell}/{pooler_id.name}/postgres-monitorB1Z/github.com/multigres/multigres/go/pb/multiadminb\x06proto3"
+	"\x12SetPostgresMonitor\x12%.multiadmin.SetPostgresMonitorRequest\x1a&.multiadmin.SetPostgresMonitorResponse\"M\x82\xd3\xe4\x93\x02G:\x01*\"B/api/v1/poolers/{pooler_id.cell}/{pooler_id.name}/postgres-monitor\x12\x95\x01\n" +
+	"\x14PlannedReparentShard\x12'.multiadmin.PlannedReparentShardRequest\x1a(.multiadmin.PlannedReparentShardResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/shards/planned-reparent\x12t\n" +
+	"\fUpgradeShard\x12\x1f.multiadmin.UpgradeShardRequest\x1a .multiadmin.UpgradeShardResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/shards/upgrade\x12\x84\x01\n" +
+	"\x10InstallExtension\x12#.multiadmin.InstallExtensionRequest\x1a$.multiadmin.InstallExtensionResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/extensions/installB1Z/github.com/multigres/multigres/go/pb/multiadminb\x06proto3"
 
 var (
 	file_multiadminservice_proto_rawDescOnce sync.Once
@@ -1875,96 +2761,126 @@ func file_multiadminservice_proto_rawDescGZIP() []byte {
 	return file_multiadminservice_proto_rawDescData
 }
 
-var file_multiadminservice_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_multiadminservice_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_multiadminservice_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_multiadminservice_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_multiadminservice_proto_goTypes = []any{
 	(JobType)(0),                          // 0: multiadmin.JobType
 	(JobStatus)(0),                        // 1: multiadmin.JobStatus
 	(BackupStatus)(0),                     // 2: multiadmin.BackupStatus
-	(*GetCellRequest)(nil),                // 3: multiadmin.GetCellRequest
-	(*GetCellResponse)(nil),               // 4: multiadmin.GetCellResponse
-	(*GetDatabaseRequest)(nil),            // 5: multiadmin.GetDatabaseRequest
-	(*GetDatabaseResponse)(nil),           // 6: multiadmin.GetDatabaseResponse
-	(*GetCellNamesRequest)(nil),           // 7: multiadmin.GetCellNamesRequest
-	(*GetCellNamesResponse)(nil),          // 8: multiadmin.GetCellNamesResponse
-	(*GetDatabaseNamesRequest)(nil),       // 9: multiadmin.GetDatabaseNamesRequest
-	(*GetDatabaseNamesResponse)(nil),      // 10: multiadmin.GetDatabaseNamesResponse
-	(*GetGatewaysRequest)(nil),            // 11: multiadmin.GetGatewaysRequest
-	(*GetGatewaysResponse)(nil),           // 12: multiadmin.GetGatewaysResponse
-	(*GetPoolersRequest)(nil),             // 13: multiadmin.GetPoolersRequest
-	(*GetPoolersResponse)(nil),            // 14: multiadmin.GetPoolersResponse
-	(*GetOrchsRequest)(nil),               // 15: multiadmin.GetOrchsRequest
-	(*GetOrchsResponse)(nil),              // 16: multiadmin.GetOrchsResponse
-	(*BackupRequest)(nil),                 // 17: multiadmin.BackupRequest
-	(*BackupResponse)(nil),                // 18: multiadmin.BackupResponse
-	(*RestoreFromBackupRequest)(nil),      // 19: multiadmin.RestoreFromBackupRequest
-	(*RestoreFromBackupResponse)(nil),     // 20: multiadmin.RestoreFromBackupResponse
-	(*GetBackupJobStatusRequest)(nil),     // 21: multiadmin.GetBackupJobStatusRequest
-	(*GetBackupJobStatusResponse)(nil),    // 22: multiadmin.GetBackupJobStatusResponse
-	(*GetBackupsRequest)(nil),             // 23: multiadmin.GetBackupsRequest
-	(*GetBackupsResponse)(nil),            // 24: multiadmin.GetBackupsResponse
-	(*BackupInfo)(nil),                    // 25: multiadmin.BackupInfo
-	(*GetPoolerStatusRequest)(nil),        // 26: multiadmin.GetPoolerStatusRequest
-	(*GetPoolerStatusResponse)(nil),       // 27: multiadmin.GetPoolerStatusResponse
-	(*SetPostgresMonitorRequest)(nil),     // 28: multiadmin.SetPostgresMonitorRequest
-	(*SetPostgresMonitorResponse)(nil),    // 29: multiadmin.SetPostgresMonitorResponse
-	(*clustermetadata.Cell)(nil),          // 30: clustermetadata.Cell
-	(*clustermetadata.Database)(nil),      // 31: clustermetadata.Database
-	(*clustermetadata.MultiGateway)(nil),  // 32: clustermetadata.MultiGateway
-	(*clustermetadata.MultiPooler)(nil),   // 33: clustermetadata.MultiPooler
-	(*clustermetadata.MultiOrch)(nil),     // 34: clustermetadata.MultiOrch
-	(*clustermetadata.ID)(nil),            // 35: clustermetadata.ID
-	(*timestamppb.Timestamp)(nil),         // 36: google.protobuf.Timestamp
-	(clustermetadata.PoolerType)(0),       // 37: clustermetadata.PoolerType
-	(*multipoolermanagerdata.Status)(nil), // 38: multipoolermanagerdata.Status
+	(UpgradeShardAction)(0),               // 3: multiadmin.UpgradeShardAction
+	(*GetCellRequest)(nil),                // 4: multiadmin.GetCellRequest
+	(*GetCellResponse)(nil),               // 5: multiadmin.GetCellResponse
+	(*GetDatabaseRequest)(nil),            // 6: multiadmin.GetDatabaseRequest
+	(*GetDatabaseResponse)(nil),           // 7: multiadmin.GetDatabaseResponse
+	(*GetCellNamesRequest)(nil),           // 8: multiadmin.GetCellNamesRequest
+	(*GetCellNamesResponse)(nil),          // 9: multiadmin.GetCellNamesResponse
+	(*GetDatabaseNamesRequest)(nil),       // 10: multiadmin.GetDatabaseNamesRequest
+	(*GetDatabaseNamesResponse)(nil),      // 11: multiadmin.GetDatabaseNamesResponse
+	(*GetGatewaysRequest)(nil),            // 12: multiadmin.GetGatewaysRequest
+	(*GetGatewaysResponse)(nil),           // 13: multiadmin.GetGatewaysResponse
+	(*GetPoolersRequest)(nil),             // 14: multiadmin.GetPoolersRequest
+	(*GetPoolersResponse)(nil),            // 15: multiadmin.GetPoolersResponse
+	(*GetOrchsRequest)(nil),               // 16: multiadmin.GetOrchsRequest
+	(*GetOrchsResponse)(nil),              // 17: multiadmin.GetOrchsResponse
+	(*BackupRequest)(nil),                 // 18: multiadmin.BackupRequest
+	(*BackupResponse)(nil),                // 19: multiadmin.BackupResponse
+	(*RestoreFromBackupRequest)(nil),      // 20: multiadmin.RestoreFromBackupRequest
+	(*RestoreFromBackupResponse)(nil),     // 21: multiadmin.RestoreFromBackupResponse
+	(*GetBackupJobStatusRequest)(nil),     // 22: multiadmin.GetBackupJobStatusRequest
+	(*GetBackupJobStatusResponse)(nil),    // 23: multiadmin.GetBackupJobStatusResponse
+	(*GetBackupsRequest)(nil),             // 24: multiadmin.GetBackupsRequest
+	(*GetBackupsResponse)(nil),            // 25: multiadmin.GetBackupsResponse
+	(*BackupInfo)(nil),                    // 26: multiadmin.BackupInfo
+	(*VerifyBackupRequest)(nil),           // 27: multiadmin.VerifyBackupRequest
+	(*VerifyBackupResponse)(nil),          // 28: multiadmin.VerifyBackupResponse
+	(*DeleteBackupRequest)(nil),           // 29: multiadmin.DeleteBackupRequest
+	(*DeleteBackupResponse)(nil),          // 30: multiadmin.DeleteBackupResponse
+	(*GetPoolerStatusRequest)(nil),        // 31: multiadmin.GetPoolerStatusRequest
+	(*GetPoolerStatusResponse)(nil),       // 32: multiadmin.GetPoolerStatusResponse
+	(*SetPostgresMonitorRequest)(nil),     // 33: multiadmin.SetPostgresMonitorRequest
+	(*SetPostgresMonitorResponse)(nil),    // 34: multiadmin.SetPostgresMonitorResponse
+	(*PlannedReparentShardRequest)(nil),   // 35: multiadmin.PlannedReparentShardRequest
+	(*PlannedReparentShardResponse)(nil),  // 36: multiadmin.PlannedReparentShardResponse
+	(*UpgradeShardRequest)(nil),           // 37: multiadmin.UpgradeShardRequest
+	(*UpgradeShardResponse)(nil),          // 38: multiadmin.UpgradeShardResponse
+	(*InstallExtensionRequest)(nil),       // 39: multiadmin.InstallExtensionRequest
+	(*ExtensionShardResult)(nil),          // 40: multiadmin.ExtensionShardResult
+	(*InstallExtensionResponse)(nil),      // 41: multiadmin.InstallExtensionResponse
+	(*clustermetadata.Cell)(nil),          // 42: clustermetadata.Cell
+	(*clustermetadata.Database)(nil),      // 43: clustermetadata.Database
+	(*clustermetadata.MultiGateway)(nil),  // 44: clustermetadata.MultiGateway
+	(*clustermetadata.MultiPooler)(nil),   // 45: clustermetadata.MultiPooler
+	(*clustermetadata.MultiOrch)(nil),     // 46: clustermetadata.MultiOrch
+	(*clustermetadata.ID)(nil),            // 47: clustermetadata.ID
+	(*timestamppb.Timestamp)(nil),         // 48: google.protobuf.Timestamp
+	(clustermetadata.PoolerType)(0),       // 49: clustermetadata.PoolerType
+	(*multipoolermanagerdata.Status)(nil), // 50: multipoolermanagerdata.Status
+	(*durationpb.Duration)(nil),           // 51: google.protobuf.Duration
 }
 var file_multiadminservice_proto_depIdxs = []int32{
-	30, // 0: multiadmin.GetCellResponse.cell:type_name -> clustermetadata.Cell
-	31, // 1: multiadmin.GetDatabaseResponse.database:type_name -> clustermetadata.Database
-	32, // 2: multiadmin.GetGatewaysResponse.gateways:type_name -> clustermetadata.MultiGateway
-	33, // 3: multiadmin.GetPoolersResponse.poolers:type_name -> clustermetadata.MultiPooler
-	34, // 4: multiadmin.GetOrchsResponse.orchs:type_name -> clustermetadata.MultiOrch
-	35, // 5: multiadmin.RestoreFromBackupRequest.pooler_id:type_name -> clustermetadata.ID
-	0,  // 6: multiadmin.GetBackupJobStatusResponse.job_type:type_name -> multiadmin.JobType
-	1,  // 7: multiadmin.GetBackupJobStatusResponse.status:type_name -> multiadmin.JobStatus
-	25, // 8: multiadmin.GetBackupsResponse.backups:type_name -> multiadmin.BackupInfo
-	2,  // 9: multiadmin.BackupInfo.status:type_name -> multiadmin.BackupStatus
-	36, // 10: multiadmin.BackupInfo.backup_time:type_name -> google.protobuf.Timestamp
-	37, // 11: multiadmin.BackupInfo.pooler_type:type_name -> clustermetadata.PoolerType
-	35, // 12: multiadmin.GetPoolerStatusRequest.pooler_id:type_name -> clustermetadata.ID
-	38, // 13: multiadmin.GetPoolerStatusResponse.status:type_name -> multipoolermanagerdata.Status
-	35, // 14: multiadmin.SetPostgresMonitorRequest.pooler_id:type_name -> clustermetadata.ID
-	3,  // 15: multiadmin.MultiAdminService.GetCell:input_type -> multiadmin.GetCellRequest
-	5,  // 16: multiadmin.MultiAdminService.GetDatabase:input_type -> multiadmin.GetDatabaseRequest
-	7,  // 17: multiadmin.MultiAdminService.GetCellNames:input_type -> multiadmin.GetCellNamesRequest
-	9,  // 18: multiadmin.MultiAdminService.GetDatabaseNames:input_type -> multiadmin.GetDatabaseNamesRequest
-	11, // 19: multiadmin.MultiAdminService.GetGateways:input_type -> multiadmin.GetGatewaysRequest
-	13, // 20: multiadmin.MultiAdminService.GetPoolers:input_type -> multiadmin.GetPoolersRequest
-	15, // 21: multiadmin.MultiAdminService.GetOrchs:input_type -> multiadmin.GetOrchsRequest
-	17, // 22: multiadmin.MultiAdminService.Backup:input_type -> multiadmin.BackupRequest
-	19, // 23: multiadmin.MultiAdminService.RestoreFromBackup:input_type -> multiadmin.RestoreFromBackupRequest
-	21, // 24: multiadmin.MultiAdminService.GetBackupJobStatus:input_type -> multiadmin.GetBackupJobStatusRequest
-	23, // 25: multiadmin.MultiAdminService.GetBackups:input_type -> multiadmin.GetBackupsRequest
-	26, // 26: multiadmin.MultiAdminService.GetPoolerStatus:input_type -> multiadmin.GetPoolerStatusRequest
-	28, // 27: multiadmin.MultiAdminService.SetPostgresMonitor:input_type -> multiadmin.SetPostgresMonitorRequest
-	4,  // 28: multiadmin.MultiAdminService.GetCell:output_type -> multiadmin.GetCellResponse
-	6,  // 29: multiadmin.MultiAdminService.GetDatabase:output_type -> multiadmin.GetDatabaseResponse
-	8,  // 30: multiadmin.MultiAdminService.GetCellNames:output_type -> multiadmin.GetCellNamesResponse
-	10, // 31: multiadmin.MultiAdminService.GetDatabaseNames:output_type -> multiadmin.GetDatabaseNamesResponse
-	12, // 32: multiadmin.MultiAdminService.GetGateways:output_type -> multiadmin.GetGatewaysResponse
-	14, // 33: multiadmin.MultiAdminService.GetPoolers:output_type -> multiadmin.GetPoolersResponse
-	16, // 34: multiadmin.MultiAdminService.GetOrchs:output_type -> multiadmin.GetOrchsResponse
-	18, // 35: multiadmin.MultiAdminService.Backup:output_type -> multiadmin.BackupResponse
-	20, // 36: multiadmin.MultiAdminService.RestoreFromBackup:output_type -> multiadmin.RestoreFromBackupResponse
-	22, // 37: multiadmin.MultiAdminService.GetBackupJobStatus:output_type -> multiadmin.GetBackupJobStatusResponse
-	24, // 38: multiadmin.MultiAdminService.GetBackups:output_type -> multiadmin.GetBackupsResponse
-	27, // 39: multiadmin.MultiAdminService.GetPoolerStatus:output_type -> multiadmin.GetPoolerStatusResponse
-	29, // 40: multiadmin.MultiAdminService.SetPostgresMonitor:output_type -> multiadmin.SetPostgresMonitorResponse
-	28, // [28:41] is the sub-list for method output_type
-	15, // [15:28] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	42, // 0: multiadmin.GetCellResponse.cell:type_name -> clustermetadata.Cell
+	43, // 1: multiadmin.GetDatabaseResponse.database:type_name -> clustermetadata.Database
+	44, // 2: multiadmin.GetGatewaysResponse.gateways:type_name -> clustermetadata.MultiGateway
+	45, // 3: multiadmin.GetPoolersResponse.poolers:type_name -> clustermetadata.MultiPooler
+	46, // 4: multiadmin.GetOrchsResponse.orchs:type_name -> clustermetadata.MultiOrch
+	47, // 5: multiadmin.RestoreFromBackupRequest.pooler_id:type_name -> clustermetadata.ID
+	48, // 6: multiadmin.RestoreFromBackupRequest.target_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: multiadmin.GetBackupJobStatusResponse.job_type:type_name -> multiadmin.JobType
+	1,  // 8: multiadmin.GetBackupJobStatusResponse.status:type_name -> multiadmin.JobStatus
+	26, // 9: multiadmin.GetBackupsResponse.backups:type_name -> multiadmin.BackupInfo
+	2,  // 10: multiadmin.BackupInfo.status:type_name -> multiadmin.BackupStatus
+	48, // 11: multiadmin.BackupInfo.backup_time:type_name -> google.protobuf.Timestamp
+	49, // 12: multiadmin.BackupInfo.pooler_type:type_name -> clustermetadata.PoolerType
+	47, // 13: multiadmin.GetPoolerStatusRequest.pooler_id:type_name -> clustermetadata.ID
+	50, // 14: multiadmin.GetPoolerStatusResponse.status:type_name -> multipoolermanagerdata.Status
+	47, // 15: multiadmin.SetPostgresMonitorRequest.pooler_id:type_name -> clustermetadata.ID
+	47, // 16: multiadmin.PlannedReparentShardRequest.new_primary:type_name -> clustermetadata.ID
+	51, // 17: multiadmin.PlannedReparentShardRequest.drain_timeout:type_name -> google.protobuf.Duration
+	47, // 18: multiadmin.PlannedReparentShardResponse.previous_primary:type_name -> clustermetadata.ID
+	47, // 19: multiadmin.PlannedReparentShardResponse.new_primary:type_name -> clustermetadata.ID
+	3,  // 20: multiadmin.UpgradeShardRequest.action:type_name -> multiadmin.UpgradeShardAction
+	40, // 21: multiadmin.InstallExtensionResponse.shards:type_name -> multiadmin.ExtensionShardResult
+	4,  // 22: multiadmin.MultiAdminService.GetCell:input_type -> multiadmin.GetCellRequest
+	6,  // 23: multiadmin.MultiAdminService.GetDatabase:input_type -> multiadmin.GetDatabaseRequest
+	8,  // 24: multiadmin.MultiAdminService.GetCellNames:input_type -> multiadmin.GetCellNamesRequest
+	10, // 25: multiadmin.MultiAdminService.GetDatabaseNames:input_type -> multiadmin.GetDatabaseNamesRequest
+	12, // 26: multiadmin.MultiAdminService.GetGateways:input_type -> multiadmin.GetGatewaysRequest
+	14, // 27: multiadmin.MultiAdminService.GetPoolers:input_type -> multiadmin.GetPoolersRequest
+	16, // 28: multiadmin.MultiAdminService.GetOrchs:input_type -> multiadmin.GetOrchsRequest
+	18, // 29: multiadmin.MultiAdminService.Backup:input_type -> multiadmin.BackupRequest
+	20, // 30: multiadmin.MultiAdminService.RestoreFromBackup:input_type -> multiadmin.RestoreFromBackupRequest
+	22, // 31: multiadmin.MultiAdminService.GetBackupJobStatus:input_type -> multiadmin.GetBackupJobStatusRequest
+	24, // 32: multiadmin.MultiAdminService.GetBackups:input_type -> multiadmin.GetBackupsRequest
+	27, // 33: multiadmin.MultiAdminService.VerifyBackup:input_type -> multiadmin.VerifyBackupRequest
+	29, // 34: multiadmin.MultiAdminService.DeleteBackup:input_type -> multiadmin.DeleteBackupRequest
+	31, // 35: multiadmin.MultiAdminService.GetPoolerStatus:input_type -> multiadmin.GetPoolerStatusRequest
+	33, // 36: multiadmin.MultiAdminService.SetPostgresMonitor:input_type -> multiadmin.SetPostgresMonitorRequest
+	35, // 37: multiadmin.MultiAdminService.PlannedReparentShard:input_type -> multiadmin.PlannedReparentShardRequest
+	37, // 38: multiadmin.MultiAdminService.UpgradeShard:input_type -> multiadmin.UpgradeShardRequest
+	39, // 39: multiadmin.MultiAdminService.InstallExtension:input_type -> multiadmin.InstallExtensionRequest
+	5,  // 40: multiadmin.MultiAdminService.GetCell:output_type -> multiadmin.GetCellResponse
+	7,  // 41: multiadmin.MultiAdminService.GetDatabase:output_type -> multiadmin.GetDatabaseResponse
+	9,  // 42: multiadmin.MultiAdminService.GetCellNames:output_type -> multiadmin.GetCellNamesResponse
+	11, // 43: multiadmin.MultiAdminService.GetDatabaseNames:output_type -> multiadmin.GetDatabaseNamesResponse
+	13, // 44: multiadmin.MultiAdminService.GetGateways:output_type -> multiadmin.GetGatewaysResponse
+	15, // 45: multiadmin.MultiAdminService.GetPoolers:output_type -> multiadmin.GetPoolersResponse
+	17, // 46: multiadmin.MultiAdminService.GetOrchs:output_type -> multiadmin.GetOrchsResponse
+	19, // 47: multiadmin.MultiAdminService.Backup:output_type -> multiadmin.BackupResponse
+	21, // 48: multiadmin.MultiAdminService.RestoreFromBackup:output_type -> multiadmin.RestoreFromBackupResponse
+	23, // 49: multiadmin.MultiAdminService.GetBackupJobStatus:output_type -> multiadmin.GetBackupJobStatusResponse
+	25, // 50: multiadmin.MultiAdminService.GetBackups:output_type -> multiadmin.GetBackupsResponse
+	28, // 51: multiadmin.MultiAdminService.VerifyBackup:output_type -> multiadmin.VerifyBackupResponse
+	30, // 52: multiadmin.MultiAdminService.DeleteBackup:output_type -> multiadmin.DeleteBackupResponse
+	32, // 53: multiadmin.MultiAdminService.GetPoolerStatus:output_type -> multiadmin.GetPoolerStatusResponse
+	34, // 54: multiadmin.MultiAdminService.SetPostgresMonitor:output_type -> multiadmin.SetPostgresMonitorResponse
+	36, // 55: multiadmin.MultiAdminService.PlannedReparentShard:output_type -> multiadmin.PlannedReparentShardResponse
+	38, // 56: multiadmin.MultiAdminService.UpgradeShard:output_type -> multiadmin.UpgradeShardResponse
+	41, // 57: multiadmin.MultiAdminService.InstallExtension:output_type -> multiadmin.InstallExtensionResponse
+	40, // [40:58] is the sub-list for method output_type
+	22, // [22:40] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_multiadminservice_proto_init() }
@@ -1977,8 +2893,8 @@ func file_multiadminservice_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_multiadminservice_proto_rawDesc), len(file_multiadminservice_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   27,
+			NumEnums:      4,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,19 +33,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MultiAdminService_GetCell_FullMethodName            = "/multiadmin.MultiAdminService/GetCell"
-	MultiAdminService_GetDatabase_FullMethodName        = "/multiadmin.MultiAdminService/GetDatabase"
-	MultiAdminService_GetCellNames_FullMethodName       = "/multiadmin.MultiAdminService/GetCellNames"
-	MultiAdminService_GetDatabaseNames_FullMethodName   = "/multiadmin.MultiAdminService/GetDatabaseNames"
-	MultiAdminService_GetGateways_FullMethodName        = "/multiadmin.MultiAdminService/GetGateways"
-	MultiAdminService_GetPoolers_FullMethodName         = "/multiadmin.MultiAdminService/GetPoolers"
-	MultiAdminService_GetOrchs_FullMethodName           = "/multiadmin.MultiAdminService/GetOrchs"
-	MultiAdminService_Backup_FullMethodName             = "/multiadmin.MultiAdminService/Backup"
-	MultiAdminService_RestoreFromBackup_FullMethodName  = "/multiadmin.MultiAdminService/RestoreFromBackup"
-	MultiAdminService_GetBackupJobStatus_FullMethodName = "/multiadmin.MultiAdminService/GetBackupJobStatus"
-	MultiAdminService_GetBackups_FullMethodName         = "/multiadmin.MultiAdminService/GetBackups"
-	MultiAdminService_GetPoolerStatus_FullMethodName    = "/multiadmin.MultiAdminService/GetPoolerStatus"
-	MultiAdminService_SetPostgresMonitor_FullMethodName = "/multiadmin.MultiAdminService/SetPostgresMonitor"
+	MultiAdminService_GetCell_FullMethodName              = "/multiadmin.MultiAdminService/GetCell"
+	MultiAdminService_GetDatabase_FullMethodName          = "/multiadmin.MultiAdminService/GetDatabase"
+	MultiAdminService_GetCellNames_FullMethodName         = "/multiadmin.MultiAdminService/GetCellNames"
+	MultiAdminService_GetDatabaseNames_FullMethodName     = "/multiadmin.MultiAdminService/GetDatabaseNames"
+	MultiAdminService_GetGateways_FullMethodName          = "/multiadmin.MultiAdminService/GetGateways"
+	MultiAdminService_GetPoolers_FullMethodName           = "/multiadmin.MultiAdminService/GetPoolers"
+	MultiAdminService_GetOrchs_FullMethodName             = "/multiadmin.MultiAdminService/GetOrchs"
+	MultiAdminService_Backup_FullMethodName               = "/multiadmin.MultiAdminService/Backup"
+	MultiAdminService_RestoreFromBackup_FullMethodName    = "/multiadmin.MultiAdminService/RestoreFromBackup"
+	MultiAdminService_GetBackupJobStatus_FullMethodName   = "/multiadmin.MultiAdminService/GetBackupJobStatus"
+	MultiAdminService_GetBackups_FullMethodName           = "/multiadmin.MultiAdminService/GetBackups"
+	MultiAdminService_VerifyBackup_FullMethodName         = "/multiadmin.MultiAdminService/VerifyBackup"
+	MultiAdminService_DeleteBackup_FullMethodName         = "/multiadmin.MultiAdminService/DeleteBackup"
+	MultiAdminService_GetPoolerStatus_FullMethodName      = "/multiadmin.MultiAdminService/GetPoolerStatus"
+	MultiAdminService_SetPostgresMonitor_FullMethodName   = "/multiadmin.MultiAdminService/SetPostgresMonitor"
+	MultiAdminService_PlannedReparentShard_FullMethodName = "/multiadmin.MultiAdminService/PlannedReparentShard"
+	MultiAdminService_UpgradeShard_FullMethodName         = "/multiadmin.MultiAdminService/UpgradeShard"
+	MultiAdminService_InstallExtension_FullMethodName     = "/multiadmin.MultiAdminService/InstallExtension"
 )
 
 // MultiAdminServiceClient is the client API for MultiAdminService service.
@@ -76,12 +81,32 @@ type MultiAdminServiceClient interface {
 	GetBackupJobStatus(ctx context.Context, in *GetBackupJobStatusRequest, opts ...grpc.CallOption) (*GetBackupJobStatusResponse, error)
 	// GetBackups lists backup artifacts with optional filtering
 	GetBackups(ctx context.Context, in *GetBackupsRequest, opts ...grpc.CallOption) (*GetBackupsResponse, error)
+	// VerifyBackup checks the integrity of a backup artifact
+	VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*VerifyBackupResponse, error)
+	// DeleteBackup removes a backup artifact and the backups that depend on it
+	DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error)
 	// GetPoolerStatus retrieves the unified status of a specific pooler.
 	// This proxies the request to the target pooler's MultiPoolerManager.Status RPC.
 	GetPoolerStatus(ctx context.Context, in *GetPoolerStatusRequest, opts ...grpc.CallOption) (*GetPoolerStatusResponse, error)
 	// SetPostgresMonitor enables or disables PostgreSQL monitoring on a pooler.
 	// This proxies the request to the target pooler's MultiPoolerManager.SetMonitor RPC.
 	SetPostgresMonitor(ctx context.Context, in *SetPostgresMonitorRequest, opts ...grpc.CallOption) (*SetPostgresMonitorResponse, error)
+	// PlannedReparentShard moves the primary of a shard to one of its replicas.
+	// The primary is drained and demoted, the new primary is promoted once it
+	// has replayed all the WAL of the old one, and the old primary rejoins as
+	// a replica. The gateways buffer the statements of the shard meanwhile.
+	PlannedReparentShard(ctx context.Context, in *PlannedReparentShardRequest, opts ...grpc.CallOption) (*PlannedReparentShardResponse, error)
+	// UpgradeShard upgrades PostgreSQL on the poolers of a shard to the major
+	// version of new binaries. The primary is upgraded in place with pg_upgrade
+	// while the shard is unavailable, then the replicas are cloned from it. The
+	// old data directories are kept as rollback points until the upgrade is
+	// finalized or rolled back.
+	UpgradeShard(ctx context.Context, in *UpgradeShardRequest, opts ...grpc.CallOption) (*UpgradeShardResponse, error)
+	// InstallExtension creates or updates an extension on every shard of a
+	// database. The files of the extension are checked on every pooler first,
+	// replicas included, and nothing is installed unless they are all ready.
+	// The extension is then created on the primaries, from which it replicates.
+	InstallExtension(ctx context.Context, in *InstallExtensionRequest, opts ...grpc.CallOption) (*InstallExtensionResponse, error)
 }
 
 type multiAdminServiceClient struct {
@@ -202,6 +227,26 @@ func (c *multiAdminServiceClient) GetBackups(ctx context.Context, in *GetBackups
 	return out, nil
 }
 
+func (c *multiAdminServiceClient) VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*VerifyBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyBackupResponse)
+	err := c.cc.Invoke(ctx, MultiAdminService_VerifyBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiAdminServiceClient) DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBackupResponse)
+	err := c.cc.Invoke(ctx, MultiAdminService_DeleteBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *multiAdminServiceClient) GetPoolerStatus(ctx context.Context, in *GetPoolerStatusRequest, opts ...grpc.CallOption) (*GetPoolerStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPoolerStatusResponse)
@@ -222,6 +267,36 @@ func (c *multiAdminServiceClient) SetPostgresMonitor(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *multiAdminServiceClient) PlannedReparentShard(ctx context.Context, in *PlannedReparentShardRequest, opts ...grpc.CallOption) (*PlannedReparentShardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlannedReparentShardResponse)
+	err := c.cc.Invoke(ctx, MultiAdminService_PlannedReparentShard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiAdminServiceClient) UpgradeShard(ctx context.Context, in *UpgradeShardRequest, opts ...grpc.CallOption) (*UpgradeShardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeShardResponse)
+	err := c.cc.Invoke(ctx, MultiAdminService_UpgradeShard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiAdminServiceClient) InstallExtension(ctx context.Context, in *InstallExtensionRequest, opts ...grpc.CallOption) (*InstallExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallExtensionResponse)
+	err := c.cc.Invoke(ctx, MultiAdminService_InstallExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MultiAdminServiceServer is the server API for MultiAdminService service.
 // All implementations must embed UnimplementedMultiAdminServiceServer
 // for forward compatibility.
@@ -250,12 +325,32 @@ type MultiAdminServiceServer interface {
 	GetBackupJobStatus(context.Context, *GetBackupJobStatusRequest) (*GetBackupJobStatusResponse, error)
 	// GetBackups lists backup artifacts with optional filtering
 	GetBackups(context.Context, *GetBackupsRequest) (*GetBackupsResponse, error)
+	// VerifyBackup checks the integrity of a backup artifact
+	VerifyBackup(context.Context, *VerifyBackupRequest) (*VerifyBackupResponse, error)
+	// DeleteBackup removes a backup artifact and the backups that depend on it
+	DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error)
 	// GetPoolerStatus retrieves the unified status of a specific pooler.
 	// This proxies the request to the target pooler's MultiPoolerManager.Status RPC.
 	GetPoolerStatus(context.Context, *GetPoolerStatusRequest) (*GetPoolerStatusResponse, error)
 	// SetPostgresMonitor enables or disables PostgreSQL monitoring on a pooler.
 	// This proxies the request to the target pooler's MultiPoolerManager.SetMonitor RPC.
 	SetPostgresMonitor(context.Context, *SetPostgresMonitorRequest) (*SetPostgresMonitorResponse, error)
+	// PlannedReparentShard moves the primary of a shard to one of its replicas.
+	// The primary is drained and demoted, the new primary is promoted once it
+	// has replayed all the WAL of the old one, and the old primary rejoins as
+	// a replica. The gateways buffer the statements of the shard meanwhile.
+	PlannedReparentShard(context.Context, *PlannedReparentShardRequest) (*PlannedReparentShardResponse, error)
+	// UpgradeShard upgrades PostgreSQL on the poolers of a shard to the major
+	// version of new binaries. The primary is upgraded in place with pg_upgrade
+	// while the shard is unavailable, then the replicas are cloned from it. The
+	// old data directories are kept as rollback points until the upgrade is
+	// finalized or rolled back.
+	UpgradeShard(context.Context, *UpgradeShardRequest) (*UpgradeShardResponse, error)
+	// InstallExtension creates or updates an extension on every shard of a
+	// database. The files of the extension are checked on every pooler first,
+	// replicas included, and nothing is installed unless they are all ready.
+	// The extension is then created on the primaries, from which it replicates.
+	InstallExtension(context.Context, *InstallExtensionRequest) (*InstallExtensionResponse, error)
 	mustEmbedUnimplementedMultiAdminServiceServer()
 }
 
@@ -299,12 +394,27 @@ func (UnimplementedMultiAdminServiceServer) GetBackupJobStatus(context.Context,
 func (UnimplementedMultiAdminServiceServer) GetBackups(context.Context, *GetBackupsRequest) (*GetBackupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBackups not implemented")
 }
+func (UnimplementedMultiAdminServiceServer) VerifyBackup(context.Context, *VerifyBackupRequest) (*VerifyBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (UnimplementedMultiAdminServiceServer) DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackup not implemented")
+}
 func (UnimplementedMultiAdminServiceServer) GetPoolerStatus(context.Context, *GetPoolerStatusRequest) (*GetPoolerStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPoolerStatus not implemented")
 }
 func (UnimplementedMultiAdminServiceServer) SetPostgresMonitor(context.Context, *SetPostgresMonitorRequest) (*SetPostgresMonitorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPostgresMonitor not implemented")
 }
+func (UnimplementedMultiAdminServiceServer) PlannedReparentShard(context.Context, *PlannedReparentShardRequest) (*PlannedReparentShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlannedReparentShard not implemented")
+}
+func (UnimplementedMultiAdminServiceServer) UpgradeShard(context.Context, *UpgradeShardRequest) (*UpgradeShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeShard not implemented")
+}
+func (UnimplementedMultiAdminServiceServer) InstallExtension(context.Context, *InstallExtensionRequest) (*InstallExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallExtension not implemented")
+}
 func (UnimplementedMultiAdminServiceServer) mustEmbedUnimplementedMultiAdminServiceServer() {}
 func (UnimplementedMultiAdminServiceServer) testEmbeddedByValue()                           {}
 
@@ -524,6 +634,42 @@ func _MultiAdminService_GetBackups_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MultiAdminService_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAdminServiceServer).VerifyBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAdminService_VerifyBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAdminServiceServer).VerifyBackup(ctx, req.(*VerifyBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiAdminService_DeleteBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAdminServiceServer).DeleteBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAdminService_DeleteBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAdminServiceServer).DeleteBackup(ctx, req.(*DeleteBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MultiAdminService_GetPoolerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPoolerStatusRequest)
 	if err := dec(in); err != nil {
@@ -560,6 +706,60 @@ func _MultiAdminService_SetPostgresMonitor_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MultiAdminService_PlannedReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlannedReparentShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAdminServiceServer).PlannedReparentShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAdminService_PlannedReparentShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAdminServiceServer).PlannedReparentShard(ctx, req.(*PlannedReparentShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiAdminService_UpgradeShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAdminServiceServer).UpgradeShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAdminService_UpgradeShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAdminServiceServer).UpgradeShard(ctx, req.(*UpgradeShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiAdminService_InstallExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAdminServiceServer).InstallExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAdminService_InstallExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAdminServiceServer).InstallExtension(ctx, req.(*InstallExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MultiAdminService_ServiceDesc is the grpc.ServiceDesc for MultiAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -611,6 +811,14 @@ var MultiAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBackups",
 			Handler:    _MultiAdminService_GetBackups_Handler,
 		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _MultiAdminService_VerifyBackup_Handler,
+		},
+		{
+			MethodName: "DeleteBackup",
+			Handler:    _MultiAdminService_DeleteBackup_Handler,
+		},
 		{
 			MethodName: "GetPoolerStatus",
 			Handler:    _MultiAdminService_GetPoolerStatus_Handler,
@@ -619,6 +827,18 @@ var MultiAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPostgresMonitor",
 			Handler:    _MultiAdminService_SetPostgresMonitor_Handler,
 		},
+		{
+			MethodName: "PlannedReparentShard",
+			Handler:    _MultiAdminService_PlannedReparentShard_Handler,
+		},
+		{
+			MethodName: "UpgradeShard",
+			Handler:    _MultiAdminService_UpgradeShard_Handler,
+		},
+		{
+			MethodName: "InstallExtension",
+			Handler:    _MultiAdminService_InstallExtension_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "multiadminservice.proto",
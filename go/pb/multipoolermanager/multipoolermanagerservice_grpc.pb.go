@@ -59,9 +59,14 @@ const (
 	MultiPoolerManager_Backup_FullMethodName                          = "/multipoolermanager.MultiPoolerManager/Backup"
 	MultiPoolerManager_RestoreFromBackup_FullMethodName               = "/multipoolermanager.MultiPoolerManager/RestoreFromBackup"
 	MultiPoolerManager_GetBackups_FullMethodName                      = "/multipoolermanager.MultiPoolerManager/GetBackups"
+	MultiPoolerManager_VerifyBackup_FullMethodName                    = "/multipoolermanager.MultiPoolerManager/VerifyBackup"
+	MultiPoolerManager_DeleteBackup_FullMethodName                    = "/multipoolermanager.MultiPoolerManager/DeleteBackup"
 	MultiPoolerManager_GetBackupByJobId_FullMethodName                = "/multipoolermanager.MultiPoolerManager/GetBackupByJobId"
 	MultiPoolerManager_RewindToSource_FullMethodName                  = "/multipoolermanager.MultiPoolerManager/RewindToSource"
 	MultiPoolerManager_SetMonitor_FullMethodName                      = "/multipoolermanager.MultiPoolerManager/SetMonitor"
+	MultiPoolerManager_UpgradePostgres_FullMethodName                 = "/multipoolermanager.MultiPoolerManager/UpgradePostgres"
+	MultiPoolerManager_FinishPostgresUpgrade_FullMethodName           = "/multipoolermanager.MultiPoolerManager/FinishPostgresUpgrade"
+	MultiPoolerManager_InstallExtension_FullMethodName                = "/multipoolermanager.MultiPoolerManager/InstallExtension"
 )
 
 // MultiPoolerManagerClient is the client API for MultiPoolerManager service.
@@ -131,6 +136,10 @@ type MultiPoolerManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *multipoolermanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.RestoreFromBackupResponse, error)
 	// GetBackups retrieves backup information
 	GetBackups(ctx context.Context, in *multipoolermanagerdata.GetBackupsRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.GetBackupsResponse, error)
+	// VerifyBackup checks the integrity of a backup in the repository
+	VerifyBackup(ctx context.Context, in *multipoolermanagerdata.VerifyBackupRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.VerifyBackupResponse, error)
+	// DeleteBackup removes a backup, and the backups that depend on it, from the repository
+	DeleteBackup(ctx context.Context, in *multipoolermanagerdata.DeleteBackupRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.DeleteBackupResponse, error)
 	// GetBackupByJobId queries a backup by its job_id annotation.
 	// Returns the backup metadata if found, or nil backup if not.
 	GetBackupByJobId(ctx context.Context, in *multipoolermanagerdata.GetBackupByJobIdRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.GetBackupByJobIdResponse, error)
@@ -140,6 +149,15 @@ type MultiPoolerManagerClient interface {
 	RewindToSource(ctx context.Context, in *multipoolermanagerdata.RewindToSourceRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.RewindToSourceResponse, error)
 	// SetMonitor enables or disables the PostgreSQL monitoring goroutine
 	SetMonitor(ctx context.Context, in *multipoolermanagerdata.SetMonitorRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.SetMonitorResponse, error)
+	// UpgradePostgres upgrades PostgreSQL to the major version of new binaries.
+	// A primary is upgraded in place with pg_upgrade, a replica is cloned from
+	// its upgraded primary. The old data directory is kept as a rollback point.
+	UpgradePostgres(ctx context.Context, in *multipoolermanagerdata.UpgradePostgresRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.UpgradePostgresResponse, error)
+	// FinishPostgresUpgrade finalizes or rolls back the last upgrade
+	FinishPostgresUpgrade(ctx context.Context, in *multipoolermanagerdata.FinishPostgresUpgradeRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.FinishPostgresUpgradeResponse, error)
+	// InstallExtension checks that the files of an extension are installed and
+	// creates or updates the extension on a primary
+	InstallExtension(ctx context.Context, in *multipoolermanagerdata.InstallExtensionRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.InstallExtensionResponse, error)
 }
 
 type multiPoolerManagerClient struct {
@@ -400,6 +418,26 @@ func (c *multiPoolerManagerClient) GetBackups(ctx context.Context, in *multipool
 	return out, nil
 }
 
+func (c *multiPoolerManagerClient) VerifyBackup(ctx context.Context, in *multipoolermanagerdata.VerifyBackupRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.VerifyBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(multipoolermanagerdata.VerifyBackupResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerManager_VerifyBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiPoolerManagerClient) DeleteBackup(ctx context.Context, in *multipoolermanagerdata.DeleteBackupRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.DeleteBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(multipoolermanagerdata.DeleteBackupResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerManager_DeleteBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *multiPoolerManagerClient) GetBackupByJobId(ctx context.Context, in *multipoolermanagerdata.GetBackupByJobIdRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.GetBackupByJobIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(multipoolermanagerdata.GetBackupByJobIdResponse)
@@ -430,6 +468,36 @@ func (c *multiPoolerManagerClient) SetMonitor(ctx context.Context, in *multipool
 	return out, nil
 }
 
+func (c *multiPoolerManagerClient) UpgradePostgres(ctx context.Context, in *multipoolermanagerdata.UpgradePostgresRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.UpgradePostgresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(multipoolermanagerdata.UpgradePostgresResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerManager_UpgradePostgres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiPoolerManagerClient) FinishPostgresUpgrade(ctx context.Context, in *multipoolermanagerdata.FinishPostgresUpgradeRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.FinishPostgresUpgradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(multipoolermanagerdata.FinishPostgresUpgradeResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerManager_FinishPostgresUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiPoolerManagerClient) InstallExtension(ctx context.Context, in *multipoolermanagerdata.InstallExtensionRequest, opts ...grpc.CallOption) (*multipoolermanagerdata.InstallExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(multipoolermanagerdata.InstallExtensionResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerManager_InstallExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MultiPoolerManagerServer is the server API for MultiPoolerManager service.
 // All implementations must embed UnimplementedMultiPoolerManagerServer
 // for forward compatibility.
@@ -497,6 +565,10 @@ type MultiPoolerManagerServer interface {
 	RestoreFromBackup(context.Context, *multipoolermanagerdata.RestoreFromBackupRequest) (*multipoolermanagerdata.RestoreFromBackupResponse, error)
 	// GetBackups retrieves backup information
 	GetBackups(context.Context, *multipoolermanagerdata.GetBackupsRequest) (*multipoolermanagerdata.GetBackupsResponse, error)
+	// VerifyBackup checks the integrity of a backup in the repository
+	VerifyBackup(context.Context, *multipoolermanagerdata.VerifyBackupRequest) (*multipoolermanagerdata.VerifyBackupResponse, error)
+	// DeleteBackup removes a backup, and the backups that depend on it, from the repository
+	DeleteBackup(context.Context, *multipoolermanagerdata.DeleteBackupRequest) (*multipoolermanagerdata.DeleteBackupResponse, error)
 	// GetBackupByJobId queries a backup by its job_id annotation.
 	// Returns the backup metadata if found, or nil backup if not.
 	GetBackupByJobId(context.Context, *multipoolermanagerdata.GetBackupByJobIdRequest) (*multipoolermanagerdata.GetBackupByJobIdResponse, error)
@@ -506,6 +578,15 @@ type MultiPoolerManagerServer interface {
 	RewindToSource(context.Context, *multipoolermanagerdata.RewindToSourceRequest) (*multipoolermanagerdata.RewindToSourceResponse, error)
 	// SetMonitor enables or disables the PostgreSQL monitoring goroutine
 	SetMonitor(context.Context, *multipoolermanagerdata.SetMonitorRequest) (*multipoolermanagerdata.SetMonitorResponse, error)
+	// UpgradePostgres upgrades PostgreSQL to the major version of new binaries.
+	// A primary is upgraded in place with pg_upgrade, a replica is cloned from
+	// its upgraded primary. The old data directory is kept as a rollback point.
+	UpgradePostgres(context.Context, *multipoolermanagerdata.UpgradePostgresRequest) (*multipoolermanagerdata.UpgradePostgresResponse, error)
+	// FinishPostgresUpgrade finalizes or rolls back the last upgrade
+	FinishPostgresUpgrade(context.Context, *multipoolermanagerdata.FinishPostgresUpgradeRequest) (*multipoolermanagerdata.FinishPostgresUpgradeResponse, error)
+	// InstallExtension checks that the files of an extension are installed and
+	// creates or updates the extension on a primary
+	InstallExtension(context.Context, *multipoolermanagerdata.InstallExtensionRequest) (*multipoolermanagerdata.InstallExtensionResponse, error)
 	mustEmbedUnimplementedMultiPoolerManagerServer()
 }
 
@@ -591,6 +672,12 @@ func (UnimplementedMultiPoolerManagerServer) RestoreFromBackup(context.Context,
 func (UnimplementedMultiPoolerManagerServer) GetBackups(context.Context, *multipoolermanagerdata.GetBackupsRequest) (*multipoolermanagerdata.GetBackupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBackups not implemented")
 }
+func (UnimplementedMultiPoolerManagerServer) VerifyBackup(context.Context, *multipoolermanagerdata.VerifyBackupRequest) (*multipoolermanagerdata.VerifyBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (UnimplementedMultiPoolerManagerServer) DeleteBackup(context.Context, *multipoolermanagerdata.DeleteBackupRequest) (*multipoolermanagerdata.DeleteBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackup not implemented")
+}
 func (UnimplementedMultiPoolerManagerServer) GetBackupByJobId(context.Context, *multipoolermanagerdata.GetBackupByJobIdRequest) (*multipoolermanagerdata.GetBackupByJobIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBackupByJobId not implemented")
 }
@@ -600,6 +687,15 @@ func (UnimplementedMultiPoolerManagerServer) RewindToSource(context.Context, *mu
 func (UnimplementedMultiPoolerManagerServer) SetMonitor(context.Context, *multipoolermanagerdata.SetMonitorRequest) (*multipoolermanagerdata.SetMonitorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMonitor not implemented")
 }
+func (UnimplementedMultiPoolerManagerServer) UpgradePostgres(context.Context, *multipoolermanagerdata.UpgradePostgresRequest) (*multipoolermanagerdata.UpgradePostgresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePostgres not implemented")
+}
+func (UnimplementedMultiPoolerManagerServer) FinishPostgresUpgrade(context.Context, *multipoolermanagerdata.FinishPostgresUpgradeRequest) (*multipoolermanagerdata.FinishPostgresUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPostgresUpgrade not implemented")
+}
+func (UnimplementedMultiPoolerManagerServer) InstallExtension(context.Context, *multipoolermanagerdata.InstallExtensionRequest) (*multipoolermanagerdata.InstallExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallExtension not implemented")
+}
 func (UnimplementedMultiPoolerManagerServer) mustEmbedUnimplementedMultiPoolerManagerServer() {}
 func (UnimplementedMultiPoolerManagerServer) testEmbeddedByValue()                            {}
 
@@ -1071,6 +1167,42 @@ func _MultiPoolerManager_GetBackups_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MultiPoolerManager_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(multipoolermanagerdata.VerifyBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerManagerServer).VerifyBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerManager_VerifyBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerManagerServer).VerifyBackup(ctx, req.(*multipoolermanagerdata.VerifyBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiPoolerManager_DeleteBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(multipoolermanagerdata.DeleteBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerManagerServer).DeleteBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerManager_DeleteBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerManagerServer).DeleteBackup(ctx, req.(*multipoolermanagerdata.DeleteBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MultiPoolerManager_GetBackupByJobId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(multipoolermanagerdata.GetBackupByJobIdRequest)
 	if err := dec(in); err != nil {
@@ -1125,6 +1257,60 @@ func _MultiPoolerManager_SetMonitor_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MultiPoolerManager_UpgradePostgres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(multipoolermanagerdata.UpgradePostgresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerManagerServer).UpgradePostgres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerManager_UpgradePostgres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerManagerServer).UpgradePostgres(ctx, req.(*multipoolermanagerdata.UpgradePostgresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiPoolerManager_FinishPostgresUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(multipoolermanagerdata.FinishPostgresUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerManagerServer).FinishPostgresUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerManager_FinishPostgresUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerManagerServer).FinishPostgresUpgrade(ctx, req.(*multipoolermanagerdata.FinishPostgresUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiPoolerManager_InstallExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(multipoolermanagerdata.InstallExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerManagerServer).InstallExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerManager_InstallExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerManagerServer).InstallExtension(ctx, req.(*multipoolermanagerdata.InstallExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MultiPoolerManager_ServiceDesc is the grpc.ServiceDesc for MultiPoolerManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1232,6 +1418,14 @@ var MultiPoolerManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBackups",
 			Handler:    _MultiPoolerManager_GetBackups_Handler,
 		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _MultiPoolerManager_VerifyBackup_Handler,
+		},
+		{
+			MethodName: "DeleteBackup",
+			Handler:    _MultiPoolerManager_DeleteBackup_Handler,
+		},
 		{
 			MethodName: "GetBackupByJobId",
 			Handler:    _MultiPoolerManager_GetBackupByJobId_Handler,
@@ -1244,6 +1438,18 @@ var MultiPoolerManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMonitor",
 			Handler:    _MultiPoolerManager_SetMonitor_Handler,
 		},
+		{
+			MethodName: "UpgradePostgres",
+			Handler:    _MultiPoolerManager_UpgradePostgres_Handler,
+		},
+		{
+			MethodName: "FinishPostgresUpgrade",
+			Handler:    _MultiPoolerManager_FinishPostgresUpgrade_Handler,
+		},
+		{
+			MethodName: "InstallExtension",
+			Handler:    _MultiPoolerManager_InstallExtension_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "multipoolermanagerservice.proto",
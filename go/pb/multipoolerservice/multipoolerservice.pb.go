@@ -791,7 +791,10 @@ type CopyBidiExecuteRequest struct {
 	// data contains the data chunk (for DATA and DONE phases in COPY FROM STDIN)
 	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 	// error_message contains the error message (for FAIL phase)
-	ErrorMessage  string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// copy_out is set on INITIATE for COPY TO STDOUT. The pooler then responds
+	// READY → DATA (repeated) → RESULT/ERROR without waiting for further messages.
+	CopyOut       bool `protobuf:"varint,8,opt,name=copy_out,json=copyOut,proto3" json:"copy_out,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -875,6 +878,13 @@ func (x *CopyBidiExecuteRequest) GetErrorMessage() string {
 	return ""
 }
 
+func (x *CopyBidiExecuteRequest) GetCopyOut() bool {
+	if x != nil {
+		return x.CopyOut
+	}
+	return false
+}
+
 // CopyBidiExecuteResponse represents a message in the bidirectional execute stream from pooler to gateway.
 // Used for responses to commands that require bidirectional streaming.
 type CopyBidiExecuteResponse struct {
@@ -987,6 +997,710 @@ func (x *CopyBidiExecuteResponse) GetError() string {
 	return ""
 }
 
+// StreamNotificationsRequest represents a request to listen on a notification channel
+type StreamNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target specifies the routing destination (tablegroup, shard, pooler type)
+	Target *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// caller_id identifies the caller
+	CallerId *mtrpc.CallerID `protobuf:"bytes,2,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// options contains execution options including the user
+	Options *query.ExecuteOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// channel is the name of the channel to listen on
+	Channel       string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsRequest) Reset() {
+	*x = StreamNotificationsRequest{}
+	mi := &file_multipoolerservice_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsRequest) ProtoMessage() {}
+
+func (x *StreamNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamNotificationsRequest) GetTarget() *query.Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *StreamNotificationsRequest) GetCallerId() *mtrpc.CallerID {
+	if x != nil {
+		return x.CallerId
+	}
+	return nil
+}
+
+func (x *StreamNotificationsRequest) GetOptions() *query.ExecuteOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *StreamNotificationsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// StreamNotificationsResponse represents a response in the notification stream
+type StreamNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notification is the notification raised on the channel
+	// Unset in the first response, which signals that the pooler is listening.
+	Notification  *query.Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsResponse) Reset() {
+	*x = StreamNotificationsResponse{}
+	mi := &file_multipoolerservice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsResponse) ProtoMessage() {}
+
+func (x *StreamNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamNotificationsResponse) GetNotification() *query.Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// ReserveConnectionRequest represents a request to pin a reserved connection to a session
+type ReserveConnectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target specifies the routing destination (tablegroup, shard, pooler type)
+	Target *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// caller_id identifies the caller
+	CallerId *mtrpc.CallerID `protobuf:"bytes,2,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// options contains execution options including the user, session settings
+	// and, to pin an existing connection, the reserved connection ID
+	Options       *query.ExecuteOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveConnectionRequest) Reset() {
+	*x = ReserveConnectionRequest{}
+	mi := &file_multipoolerservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveConnectionRequest) ProtoMessage() {}
+
+func (x *ReserveConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ReserveConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReserveConnectionRequest) GetTarget() *query.Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ReserveConnectionRequest) GetCallerId() *mtrpc.CallerID {
+	if x != nil {
+		return x.CallerId
+	}
+	return nil
+}
+
+func (x *ReserveConnectionRequest) GetOptions() *query.ExecuteOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ReserveConnectionResponse represents the response from pinning a reserved connection
+type ReserveConnectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// reserved_connection_id is the ID of the pinned reserved connection
+	ReservedConnectionId uint64 `protobuf:"varint,1,opt,name=reserved_connection_id,json=reservedConnectionId,proto3" json:"reserved_connection_id,omitempty"`
+	// pooler_id identifies which multipooler instance owns the reserved connection
+	PoolerId      *clustermetadata.ID `protobuf:"bytes,2,opt,name=pooler_id,json=poolerId,proto3" json:"pooler_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveConnectionResponse) Reset() {
+	*x = ReserveConnectionResponse{}
+	mi := &file_multipoolerservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveConnectionResponse) ProtoMessage() {}
+
+func (x *ReserveConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveConnectionResponse.ProtoReflect.Descriptor instead.
+func (*ReserveConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReserveConnectionResponse) GetReservedConnectionId() uint64 {
+	if x != nil {
+		return x.ReservedConnectionId
+	}
+	return 0
+}
+
+func (x *ReserveConnectionResponse) GetPoolerId() *clustermetadata.ID {
+	if x != nil {
+		return x.PoolerId
+	}
+	return nil
+}
+
+// ReleaseReservedConnectionRequest represents a request to unpin a reserved connection
+type ReleaseReservedConnectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target specifies the routing destination (tablegroup, shard, pooler type)
+	Target *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// caller_id identifies the caller
+	CallerId *mtrpc.CallerID `protobuf:"bytes,2,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// options contains execution options including the reserved connection ID
+	Options       *query.ExecuteOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservedConnectionRequest) Reset() {
+	*x = ReleaseReservedConnectionRequest{}
+	mi := &file_multipoolerservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservedConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservedConnectionRequest) ProtoMessage() {}
+
+func (x *ReleaseReservedConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservedConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservedConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseReservedConnectionRequest) GetTarget() *query.Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ReleaseReservedConnectionRequest) GetCallerId() *mtrpc.CallerID {
+	if x != nil {
+		return x.CallerId
+	}
+	return nil
+}
+
+func (x *ReleaseReservedConnectionRequest) GetOptions() *query.ExecuteOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ReleaseReservedConnectionResponse represents the response from unpinning a reserved connection
+type ReleaseReservedConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservedConnectionResponse) Reset() {
+	*x = ReleaseReservedConnectionResponse{}
+	mi := &file_multipoolerservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservedConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservedConnectionResponse) ProtoMessage() {}
+
+func (x *ReleaseReservedConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservedConnectionResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservedConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{17}
+}
+
+// StreamHealthRequest represents a request to stream the health reports of a pooler
+type StreamHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// interval_ms is how often the pooler sends a report, in milliseconds.
+	// 0 uses the pooler's default.
+	IntervalMs    uint64 `protobuf:"varint,1,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamHealthRequest) Reset() {
+	*x = StreamHealthRequest{}
+	mi := &file_multipoolerservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamHealthRequest) ProtoMessage() {}
+
+func (x *StreamHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamHealthRequest.ProtoReflect.Descriptor instead.
+func (*StreamHealthRequest) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamHealthRequest) GetIntervalMs() uint64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+// StreamHealthResponse represents a health report in the health stream
+type StreamHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// serving is true if the pooler serves queries
+	Serving bool `protobuf:"varint,1,opt,name=serving,proto3" json:"serving,omitempty"`
+	// replication_lag_ms is how far behind the primary the pooler's PostgreSQL
+	// replays, in milliseconds. Only set on a replica, and only valid if
+	// replication_lag_error is empty.
+	ReplicationLagMs uint64 `protobuf:"varint,2,opt,name=replication_lag_ms,json=replicationLagMs,proto3" json:"replication_lag_ms,omitempty"`
+	// replication_lag_error tells why the replication lag is unknown
+	ReplicationLagError string `protobuf:"bytes,3,opt,name=replication_lag_error,json=replicationLagError,proto3" json:"replication_lag_error,omitempty"`
+	// serving_status is the serving state of the pooler
+	ServingStatus clustermetadata.PoolerServingStatus `protobuf:"varint,4,opt,name=serving_status,json=servingStatus,proto3,enum=clustermetadata.PoolerServingStatus" json:"serving_status,omitempty"`
+	// query_service_error tells why the query service of the pooler cannot
+	// serve queries, and is empty when it is ready to.
+	QueryServiceError string `protobuf:"bytes,5,opt,name=query_service_error,json=queryServiceError,proto3" json:"query_service_error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StreamHealthResponse) Reset() {
+	*x = StreamHealthResponse{}
+	mi := &file_multipoolerservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamHealthResponse) ProtoMessage() {}
+
+func (x *StreamHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamHealthResponse.ProtoReflect.Descriptor instead.
+func (*StreamHealthResponse) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamHealthResponse) GetServing() bool {
+	if x != nil {
+		return x.Serving
+	}
+	return false
+}
+
+func (x *StreamHealthResponse) GetReplicationLagMs() uint64 {
+	if x != nil {
+		return x.ReplicationLagMs
+	}
+	return 0
+}
+
+func (x *StreamHealthResponse) GetReplicationLagError() string {
+	if x != nil {
+		return x.ReplicationLagError
+	}
+	return ""
+}
+
+func (x *StreamHealthResponse) GetServingStatus() clustermetadata.PoolerServingStatus {
+	if x != nil {
+		return x.ServingStatus
+	}
+	return clustermetadata.PoolerServingStatus(0)
+}
+
+func (x *StreamHealthResponse) GetQueryServiceError() string {
+	if x != nil {
+		return x.QueryServiceError
+	}
+	return ""
+}
+
+// StreamSchemaRequest represents a request to stream the schema of the tables
+type StreamSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// interval_ms is how often the pooler checks its catalog for changes, in
+	// milliseconds. 0 uses the pooler's default.
+	IntervalMs    uint64 `protobuf:"varint,1,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSchemaRequest) Reset() {
+	*x = StreamSchemaRequest{}
+	mi := &file_multipoolerservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSchemaRequest) ProtoMessage() {}
+
+func (x *StreamSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSchemaRequest.ProtoReflect.Descriptor instead.
+func (*StreamSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamSchemaRequest) GetIntervalMs() uint64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+// ColumnSchema describes a column of a table
+type ColumnSchema struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the column
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the type of the column, as given by format_type()
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnSchema) Reset() {
+	*x = ColumnSchema{}
+	mi := &file_multipoolerservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnSchema) ProtoMessage() {}
+
+func (x *ColumnSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnSchema.ProtoReflect.Descriptor instead.
+func (*ColumnSchema) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ColumnSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColumnSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// TableSchema describes a table, view or materialized view
+type TableSchema struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema is the schema of the table
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// name is the name of the table
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// columns are the columns of the table, in order
+	Columns       []*ColumnSchema `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableSchema) Reset() {
+	*x = TableSchema{}
+	mi := &file_multipoolerservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSchema) ProtoMessage() {}
+
+func (x *TableSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSchema.ProtoReflect.Descriptor instead.
+func (*TableSchema) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TableSchema) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *TableSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableSchema) GetColumns() []*ColumnSchema {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// StreamSchemaResponse represents a change of the schema in the schema stream
+type StreamSchemaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// full is true if tables are all the tables, replacing those known
+	// before. It is only set on the first response.
+	Full bool `protobuf:"varint,1,opt,name=full,proto3" json:"full,omitempty"`
+	// tables are the tables created or changed
+	Tables []*TableSchema `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	// dropped_tables are the tables dropped, without their columns
+	DroppedTables []*TableSchema `protobuf:"bytes,3,rep,name=dropped_tables,json=droppedTables,proto3" json:"dropped_tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSchemaResponse) Reset() {
+	*x = StreamSchemaResponse{}
+	mi := &file_multipoolerservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSchemaResponse) ProtoMessage() {}
+
+func (x *StreamSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multipoolerservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSchemaResponse.ProtoReflect.Descriptor instead.
+func (*StreamSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_multipoolerservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamSchemaResponse) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *StreamSchemaResponse) GetTables() []*TableSchema {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *StreamSchemaResponse) GetDroppedTables() []*TableSchema {
+	if x != nil {
+		return x.DroppedTables
+	}
+	return nil
+}
+
 var File_multipoolerservice_proto protoreflect.FileDescriptor
 
 const file_multipoolerservice_proto_rawDesc = "" +
@@ -1030,7 +1744,7 @@ const file_multipoolerservice_proto_rawDesc = "" +
 	"\busername\x18\x02 \x01(\tR\busername\";\n" +
 	"\x1aGetAuthCredentialsResponse\x12\x1d\n" +
 	"\n" +
-	"scram_hash\x18\x01 \x01(\tR\tscramHash\"\xea\x02\n" +
+	"scram_hash\x18\x01 \x01(\tR\tscramHash\"\x85\x03\n" +
 	"\x16CopyBidiExecuteRequest\x12F\n" +
 	"\x05phase\x18\x01 \x01(\x0e20.multipoolerservice.CopyBidiExecuteRequest.PhaseR\x05phase\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\x12%\n" +
@@ -1038,7 +1752,8 @@ const file_multipoolerservice_proto_rawDesc = "" +
 	"\tcaller_id\x18\x04 \x01(\v2\x0f.mtrpc.CallerIDR\bcallerId\x12/\n" +
 	"\aoptions\x18\x05 \x01(\v2\x15.query.ExecuteOptionsR\aoptions\x12\x12\n" +
 	"\x04data\x18\x06 \x01(\fR\x04data\x12#\n" +
-	"\rerror_message\x18\a \x01(\tR\ferrorMessage\"3\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\x12\x19\n" +
+	"\bcopy_out\x18\b \x01(\bR\acopyOut\"3\n" +
 	"\x05Phase\x12\f\n" +
 	"\bINITIATE\x10\x00\x12\b\n" +
 	"\x04DATA\x10\x01\x12\b\n" +
@@ -1058,14 +1773,61 @@ const file_multipoolerservice_proto_rawDesc = "" +
 	"\x04DATA\x10\x01\x12\n" +
 	"\n" +
 	"\x06RESULT\x10\x02\x12\t\n" +
-	"\x05ERROR\x10\x032\x95\x05\n" +
+	"\x05ERROR\x10\x03\"\xbc\x01\n" +
+	"\x1aStreamNotificationsRequest\x12%\n" +
+	"\x06target\x18\x01 \x01(\v2\r.query.TargetR\x06target\x12,\n" +
+	"\tcaller_id\x18\x02 \x01(\v2\x0f.mtrpc.CallerIDR\bcallerId\x12/\n" +
+	"\aoptions\x18\x03 \x01(\v2\x15.query.ExecuteOptionsR\aoptions\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\"V\n" +
+	"\x1bStreamNotificationsResponse\x127\n" +
+	"\fnotification\x18\x01 \x01(\v2\x13.query.NotificationR\fnotification\"\xa0\x01\n" +
+	"\x18ReserveConnectionRequest\x12%\n" +
+	"\x06target\x18\x01 \x01(\v2\r.query.TargetR\x06target\x12,\n" +
+	"\tcaller_id\x18\x02 \x01(\v2\x0f.mtrpc.CallerIDR\bcallerId\x12/\n" +
+	"\aoptions\x18\x03 \x01(\v2\x15.query.ExecuteOptionsR\aoptions\"\x83\x01\n" +
+	"\x19ReserveConnectionResponse\x124\n" +
+	"\x16reserved_connection_id\x18\x01 \x01(\x04R\x14reservedConnectionId\x120\n" +
+	"\tpooler_id\x18\x02 \x01(\v2\x13.clustermetadata.IDR\bpoolerId\"\xa8\x01\n" +
+	" ReleaseReservedConnectionRequest\x12%\n" +
+	"\x06target\x18\x01 \x01(\v2\r.query.TargetR\x06target\x12,\n" +
+	"\tcaller_id\x18\x02 \x01(\v2\x0f.mtrpc.CallerIDR\bcallerId\x12/\n" +
+	"\aoptions\x18\x03 \x01(\v2\x15.query.ExecuteOptionsR\aoptions\"#\n" +
+	"!ReleaseReservedConnectionResponse\"6\n" +
+	"\x13StreamHealthRequest\x12\x1f\n" +
+	"\vinterval_ms\x18\x01 \x01(\x04R\n" +
+	"intervalMs\"\x8f\x02\n" +
+	"\x14StreamHealthResponse\x12\x18\n" +
+	"\aserving\x18\x01 \x01(\bR\aserving\x12,\n" +
+	"\x12replication_lag_ms\x18\x02 \x01(\x04R\x10replicationLagMs\x122\n" +
+	"\x15replication_lag_error\x18\x03 \x01(\tR\x13replicationLagError\x12K\n" +
+	"\x0eserving_status\x18\x04 \x01(\x0e2$.clustermetadata.PoolerServingStatusR\rservingStatus\x12.\n" +
+	"\x13query_service_error\x18\x05 \x01(\tR\x11queryServiceError\"6\n" +
+	"\x13StreamSchemaRequest\x12\x1f\n" +
+	"\vinterval_ms\x18\x01 \x01(\x04R\n" +
+	"intervalMs\"6\n" +
+	"\fColumnSchema\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"u\n" +
+	"\vTableSchema\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
+	"\acolumns\x18\x03 \x03(\v2 .multipoolerservice.ColumnSchemaR\acolumns\"\xab\x01\n" +
+	"\x14StreamSchemaResponse\x12\x12\n" +
+	"\x04full\x18\x01 \x01(\bR\x04full\x127\n" +
+	"\x06tables\x18\x02 \x03(\v2\x1f.multipoolerservice.TableSchemaR\x06tables\x12F\n" +
+	"\x0edropped_tables\x18\x03 \x03(\v2\x1f.multipoolerservice.TableSchemaR\rdroppedTables2\xd6\t\n" +
 	"\x12MultiPoolerService\x12a\n" +
 	"\fExecuteQuery\x12'.multipoolerservice.ExecuteQueryRequest\x1a(.multipoolerservice.ExecuteQueryResponse\x12f\n" +
 	"\rStreamExecute\x12(.multipoolerservice.StreamExecuteRequest\x1a).multipoolerservice.StreamExecuteResponse0\x01\x12x\n" +
 	"\x13PortalStreamExecute\x12..multipoolerservice.PortalStreamExecuteRequest\x1a/.multipoolerservice.PortalStreamExecuteResponse0\x01\x12U\n" +
 	"\bDescribe\x12#.multipoolerservice.DescribeRequest\x1a$.multipoolerservice.DescribeResponse\x12s\n" +
 	"\x12GetAuthCredentials\x12-.multipoolerservice.GetAuthCredentialsRequest\x1a..multipoolerservice.GetAuthCredentialsResponse\x12n\n" +
-	"\x0fCopyBidiExecute\x12*.multipoolerservice.CopyBidiExecuteRequest\x1a+.multipoolerservice.CopyBidiExecuteResponse(\x010\x01B9Z7github.com/multigres/multigres/go/pb/multipoolerserviceb\x06proto3"
+	"\x0fCopyBidiExecute\x12*.multipoolerservice.CopyBidiExecuteRequest\x1a+.multipoolerservice.CopyBidiExecuteResponse(\x010\x01\x12x\n" +
+	"\x13StreamNotifications\x12..multipoolerservice.StreamNotificationsRequest\x1a/.multipoolerservice.StreamNotificationsResponse0\x01\x12p\n" +
+	"\x11ReserveConnection\x12,.multipoolerservice.ReserveConnectionRequest\x1a-.multipoolerservice.ReserveConnectionResponse\x12\x88\x01\n" +
+	"\x19ReleaseReservedConnection\x124.multipoolerservice.ReleaseReservedConnectionRequest\x1a5.multipoolerservice.ReleaseReservedConnectionResponse\x12c\n" +
+	"\fStreamHealth\x12'.multipoolerservice.StreamHealthRequest\x1a(.multipoolerservice.StreamHealthResponse0\x01\x12c\n" +
+	"\fStreamSchema\x12'.multipoolerservice.StreamSchemaRequest\x1a(.multipoolerservice.StreamSchemaResponse0\x01B9Z7github.com/multigres/multigres/go/pb/multipoolerserviceb\x06proto3"
 
 var (
 	file_multipoolerservice_proto_rawDescOnce sync.Once
@@ -1080,77 +1842,116 @@ func file_multipoolerservice_proto_rawDescGZIP() []byte {
 }
 
 var file_multipoolerservice_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_multipoolerservice_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_multipoolerservice_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_multipoolerservice_proto_goTypes = []any{
-	(CopyBidiExecuteRequest_Phase)(0),   // 0: multipoolerservice.CopyBidiExecuteRequest.Phase
-	(CopyBidiExecuteResponse_Phase)(0),  // 1: multipoolerservice.CopyBidiExecuteResponse.Phase
-	(*ExecuteQueryRequest)(nil),         // 2: multipoolerservice.ExecuteQueryRequest
-	(*ExecuteQueryResponse)(nil),        // 3: multipoolerservice.ExecuteQueryResponse
-	(*StreamExecuteRequest)(nil),        // 4: multipoolerservice.StreamExecuteRequest
-	(*StreamExecuteResponse)(nil),       // 5: multipoolerservice.StreamExecuteResponse
-	(*PortalStreamExecuteRequest)(nil),  // 6: multipoolerservice.PortalStreamExecuteRequest
-	(*PortalStreamExecuteResponse)(nil), // 7: multipoolerservice.PortalStreamExecuteResponse
-	(*DescribeRequest)(nil),             // 8: multipoolerservice.DescribeRequest
-	(*DescribeResponse)(nil),            // 9: multipoolerservice.DescribeResponse
-	(*GetAuthCredentialsRequest)(nil),   // 10: multipoolerservice.GetAuthCredentialsRequest
-	(*GetAuthCredentialsResponse)(nil),  // 11: multipoolerservice.GetAuthCredentialsResponse
-	(*CopyBidiExecuteRequest)(nil),      // 12: multipoolerservice.CopyBidiExecuteRequest
-	(*CopyBidiExecuteResponse)(nil),     // 13: multipoolerservice.CopyBidiExecuteResponse
-	(*query.Target)(nil),                // 14: query.Target
-	(*mtrpc.CallerID)(nil),              // 15: mtrpc.CallerID
-	(*query.ExecuteOptions)(nil),        // 16: query.ExecuteOptions
-	(*query.QueryResult)(nil),           // 17: query.QueryResult
-	(*query.PreparedStatement)(nil),     // 18: query.PreparedStatement
-	(*query.Portal)(nil),                // 19: query.Portal
-	(*clustermetadata.ID)(nil),          // 20: clustermetadata.ID
-	(*query.StatementDescription)(nil),  // 21: query.StatementDescription
+	(CopyBidiExecuteRequest_Phase)(0),         // 0: multipoolerservice.CopyBidiExecuteRequest.Phase
+	(CopyBidiExecuteResponse_Phase)(0),        // 1: multipoolerservice.CopyBidiExecuteResponse.Phase
+	(*ExecuteQueryRequest)(nil),               // 2: multipoolerservice.ExecuteQueryRequest
+	(*ExecuteQueryResponse)(nil),              // 3: multipoolerservice.ExecuteQueryResponse
+	(*StreamExecuteRequest)(nil),              // 4: multipoolerservice.StreamExecuteRequest
+	(*StreamExecuteResponse)(nil),             // 5: multipoolerservice.StreamExecuteResponse
+	(*PortalStreamExecuteRequest)(nil),        // 6: multipoolerservice.PortalStreamExecuteRequest
+	(*PortalStreamExecuteResponse)(nil),       // 7: multipoolerservice.PortalStreamExecuteResponse
+	(*DescribeRequest)(nil),                   // 8: multipoolerservice.DescribeRequest
+	(*DescribeResponse)(nil),                  // 9: multipoolerservice.DescribeResponse
+	(*GetAuthCredentialsRequest)(nil),         // 10: multipoolerservice.GetAuthCredentialsRequest
+	(*GetAuthCredentialsResponse)(nil),        // 11: multipoolerservice.GetAuthCredentialsResponse
+	(*CopyBidiExecuteRequest)(nil),            // 12: multipoolerservice.CopyBidiExecuteRequest
+	(*CopyBidiExecuteResponse)(nil),           // 13: multipoolerservice.CopyBidiExecuteResponse
+	(*StreamNotificationsRequest)(nil),        // 14: multipoolerservice.StreamNotificationsRequest
+	(*StreamNotificationsResponse)(nil),       // 15: multipoolerservice.StreamNotificationsResponse
+	(*ReserveConnectionRequest)(nil),          // 16: multipoolerservice.ReserveConnectionRequest
+	(*ReserveConnectionResponse)(nil),         // 17: multipoolerservice.ReserveConnectionResponse
+	(*ReleaseReservedConnectionRequest)(nil),  // 18: multipoolerservice.ReleaseReservedConnectionRequest
+	(*ReleaseReservedConnectionResponse)(nil), // 19: multipoolerservice.ReleaseReservedConnectionResponse
+	(*StreamHealthRequest)(nil),               // 20: multipoolerservice.StreamHealthRequest
+	(*StreamHealthResponse)(nil),              // 21: multipoolerservice.StreamHealthResponse
+	(*StreamSchemaRequest)(nil),               // 22: multipoolerservice.StreamSchemaRequest
+	(*ColumnSchema)(nil),                      // 23: multipoolerservice.ColumnSchema
+	(*TableSchema)(nil),                       // 24: multipoolerservice.TableSchema
+	(*StreamSchemaResponse)(nil),              // 25: multipoolerservice.StreamSchemaResponse
+	(*query.Target)(nil),                      // 26: query.Target
+	(*mtrpc.CallerID)(nil),                    // 27: mtrpc.CallerID
+	(*query.ExecuteOptions)(nil),              // 28: query.ExecuteOptions
+	(*query.QueryResult)(nil),                 // 29: query.QueryResult
+	(*query.PreparedStatement)(nil),           // 30: query.PreparedStatement
+	(*query.Portal)(nil),                      // 31: query.Portal
+	(*clustermetadata.ID)(nil),                // 32: clustermetadata.ID
+	(*query.StatementDescription)(nil),        // 33: query.StatementDescription
+	(*query.Notification)(nil),                // 34: query.Notification
+	(clustermetadata.PoolerServingStatus)(0),  // 35: clustermetadata.PoolerServingStatus
 }
 var file_multipoolerservice_proto_depIdxs = []int32{
-	14, // 0: multipoolerservice.ExecuteQueryRequest.target:type_name -> query.Target
-	15, // 1: multipoolerservice.ExecuteQueryRequest.caller_id:type_name -> mtrpc.CallerID
-	16, // 2: multipoolerservice.ExecuteQueryRequest.options:type_name -> query.ExecuteOptions
-	17, // 3: multipoolerservice.ExecuteQueryResponse.result:type_name -> query.QueryResult
-	14, // 4: multipoolerservice.StreamExecuteRequest.target:type_name -> query.Target
-	15, // 5: multipoolerservice.StreamExecuteRequest.caller_id:type_name -> mtrpc.CallerID
-	16, // 6: multipoolerservice.StreamExecuteRequest.options:type_name -> query.ExecuteOptions
-	17, // 7: multipoolerservice.StreamExecuteResponse.result:type_name -> query.QueryResult
-	14, // 8: multipoolerservice.PortalStreamExecuteRequest.target:type_name -> query.Target
-	18, // 9: multipoolerservice.PortalStreamExecuteRequest.prepared_statement:type_name -> query.PreparedStatement
-	19, // 10: multipoolerservice.PortalStreamExecuteRequest.portal:type_name -> query.Portal
-	15, // 11: multipoolerservice.PortalStreamExecuteRequest.caller_id:type_name -> mtrpc.CallerID
-	16, // 12: multipoolerservice.PortalStreamExecuteRequest.options:type_name -> query.ExecuteOptions
-	17, // 13: multipoolerservice.PortalStreamExecuteResponse.result:type_name -> query.QueryResult
-	20, // 14: multipoolerservice.PortalStreamExecuteResponse.pooler_id:type_name -> clustermetadata.ID
-	14, // 15: multipoolerservice.DescribeRequest.target:type_name -> query.Target
-	18, // 16: multipoolerservice.DescribeRequest.prepared_statement:type_name -> query.PreparedStatement
-	19, // 17: multipoolerservice.DescribeRequest.portal:type_name -> query.Portal
-	15, // 18: multipoolerservice.DescribeRequest.caller_id:type_name -> mtrpc.CallerID
-	16, // 19: multipoolerservice.DescribeRequest.options:type_name -> query.ExecuteOptions
-	21, // 20: multipoolerservice.DescribeResponse.description:type_name -> query.StatementDescription
+	26, // 0: multipoolerservice.ExecuteQueryRequest.target:type_name -> query.Target
+	27, // 1: multipoolerservice.ExecuteQueryRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 2: multipoolerservice.ExecuteQueryRequest.options:type_name -> query.ExecuteOptions
+	29, // 3: multipoolerservice.ExecuteQueryResponse.result:type_name -> query.QueryResult
+	26, // 4: multipoolerservice.StreamExecuteRequest.target:type_name -> query.Target
+	27, // 5: multipoolerservice.StreamExecuteRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 6: multipoolerservice.StreamExecuteRequest.options:type_name -> query.ExecuteOptions
+	29, // 7: multipoolerservice.StreamExecuteResponse.result:type_name -> query.QueryResult
+	26, // 8: multipoolerservice.PortalStreamExecuteRequest.target:type_name -> query.Target
+	30, // 9: multipoolerservice.PortalStreamExecuteRequest.prepared_statement:type_name -> query.PreparedStatement
+	31, // 10: multipoolerservice.PortalStreamExecuteRequest.portal:type_name -> query.Portal
+	27, // 11: multipoolerservice.PortalStreamExecuteRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 12: multipoolerservice.PortalStreamExecuteRequest.options:type_name -> query.ExecuteOptions
+	29, // 13: multipoolerservice.PortalStreamExecuteResponse.result:type_name -> query.QueryResult
+	32, // 14: multipoolerservice.PortalStreamExecuteResponse.pooler_id:type_name -> clustermetadata.ID
+	26, // 15: multipoolerservice.DescribeRequest.target:type_name -> query.Target
+	30, // 16: multipoolerservice.DescribeRequest.prepared_statement:type_name -> query.PreparedStatement
+	31, // 17: multipoolerservice.DescribeRequest.portal:type_name -> query.Portal
+	27, // 18: multipoolerservice.DescribeRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 19: multipoolerservice.DescribeRequest.options:type_name -> query.ExecuteOptions
+	33, // 20: multipoolerservice.DescribeResponse.description:type_name -> query.StatementDescription
 	0,  // 21: multipoolerservice.CopyBidiExecuteRequest.phase:type_name -> multipoolerservice.CopyBidiExecuteRequest.Phase
-	14, // 22: multipoolerservice.CopyBidiExecuteRequest.target:type_name -> query.Target
-	15, // 23: multipoolerservice.CopyBidiExecuteRequest.caller_id:type_name -> mtrpc.CallerID
-	16, // 24: multipoolerservice.CopyBidiExecuteRequest.options:type_name -> query.ExecuteOptions
+	26, // 22: multipoolerservice.CopyBidiExecuteRequest.target:type_name -> query.Target
+	27, // 23: multipoolerservice.CopyBidiExecuteRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 24: multipoolerservice.CopyBidiExecuteRequest.options:type_name -> query.ExecuteOptions
 	1,  // 25: multipoolerservice.CopyBidiExecuteResponse.phase:type_name -> multipoolerservice.CopyBidiExecuteResponse.Phase
-	20, // 26: multipoolerservice.CopyBidiExecuteResponse.pooler_id:type_name -> clustermetadata.ID
-	17, // 27: multipoolerservice.CopyBidiExecuteResponse.result:type_name -> query.QueryResult
-	2,  // 28: multipoolerservice.MultiPoolerService.ExecuteQuery:input_type -> multipoolerservice.ExecuteQueryRequest
-	4,  // 29: multipoolerservice.MultiPoolerService.StreamExecute:input_type -> multipoolerservice.StreamExecuteRequest
-	6,  // 30: multipoolerservice.MultiPoolerService.PortalStreamExecute:input_type -> multipoolerservice.PortalStreamExecuteRequest
-	8,  // 31: multipoolerservice.MultiPoolerService.Describe:input_type -> multipoolerservice.DescribeRequest
-	10, // 32: multipoolerservice.MultiPoolerService.GetAuthCredentials:input_type -> multipoolerservice.GetAuthCredentialsRequest
-	12, // 33: multipoolerservice.MultiPoolerService.CopyBidiExecute:input_type -> multipoolerservice.CopyBidiExecuteRequest
-	3,  // 34: multipoolerservice.MultiPoolerService.ExecuteQuery:output_type -> multipoolerservice.ExecuteQueryResponse
-	5,  // 35: multipoolerservice.MultiPoolerService.StreamExecute:output_type -> multipoolerservice.StreamExecuteResponse
-	7,  // 36: multipoolerservice.MultiPoolerService.PortalStreamExecute:output_type -> multipoolerservice.PortalStreamExecuteResponse
-	9,  // 37: multipoolerservice.MultiPoolerService.Describe:output_type -> multipoolerservice.DescribeResponse
-	11, // 38: multipoolerservice.MultiPoolerService.GetAuthCredentials:output_type -> multipoolerservice.GetAuthCredentialsResponse
-	13, // 39: multipoolerservice.MultiPoolerService.CopyBidiExecute:output_type -> multipoolerservice.CopyBidiExecuteResponse
-	34, // [34:40] is the sub-list for method output_type
-	28, // [28:34] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	32, // 26: multipoolerservice.CopyBidiExecuteResponse.pooler_id:type_name -> clustermetadata.ID
+	29, // 27: multipoolerservice.CopyBidiExecuteResponse.result:type_name -> query.QueryResult
+	26, // 28: multipoolerservice.StreamNotificationsRequest.target:type_name -> query.Target
+	27, // 29: multipoolerservice.StreamNotificationsRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 30: multipoolerservice.StreamNotificationsRequest.options:type_name -> query.ExecuteOptions
+	34, // 31: multipoolerservice.StreamNotificationsResponse.notification:type_name -> query.Notification
+	26, // 32: multipoolerservice.ReserveConnectionRequest.target:type_name -> query.Target
+	27, // 33: multipoolerservice.ReserveConnectionRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 34: multipoolerservice.ReserveConnectionRequest.options:type_name -> query.ExecuteOptions
+	32, // 35: multipoolerservice.ReserveConnectionResponse.pooler_id:type_name -> clustermetadata.ID
+	26, // 36: multipoolerservice.ReleaseReservedConnectionRequest.target:type_name -> query.Target
+	27, // 37: multipoolerservice.ReleaseReservedConnectionRequest.caller_id:type_name -> mtrpc.CallerID
+	28, // 38: multipoolerservice.ReleaseReservedConnectionRequest.options:type_name -> query.ExecuteOptions
+	35, // 39: multipoolerservice.StreamHealthResponse.serving_status:type_name -> clustermetadata.PoolerServingStatus
+	23, // 40: multipoolerservice.TableSchema.columns:type_name -> multipoolerservice.ColumnSchema
+	24, // 41: multipoolerservice.StreamSchemaResponse.tables:type_name -> multipoolerservice.TableSchema
+	24, // 42: multipoolerservice.StreamSchemaResponse.dropped_tables:type_name -> multipoolerservice.TableSchema
+	2,  // 43: multipoolerservice.MultiPoolerService.ExecuteQuery:input_type -> multipoolerservice.ExecuteQueryRequest
+	4,  // 44: multipoolerservice.MultiPoolerService.StreamExecute:input_type -> multipoolerservice.StreamExecuteRequest
+	6,  // 45: multipoolerservice.MultiPoolerService.PortalStreamExecute:input_type -> multipoolerservice.PortalStreamExecuteRequest
+	8,  // 46: multipoolerservice.MultiPoolerService.Describe:input_type -> multipoolerservice.DescribeRequest
+	10, // 47: multipoolerservice.MultiPoolerService.GetAuthCredentials:input_type -> multipoolerservice.GetAuthCredentialsRequest
+	12, // 48: multipoolerservice.MultiPoolerService.CopyBidiExecute:input_type -> multipoolerservice.CopyBidiExecuteRequest
+	14, // 49: multipoolerservice.MultiPoolerService.StreamNotifications:input_type -> multipoolerservice.StreamNotificationsRequest
+	16, // 50: multipoolerservice.MultiPoolerService.ReserveConnection:input_type -> multipoolerservice.ReserveConnectionRequest
+	18, // 51: multipoolerservice.MultiPoolerService.ReleaseReservedConnection:input_type -> multipoolerservice.ReleaseReservedConnectionRequest
+	20, // 52: multipoolerservice.MultiPoolerService.StreamHealth:input_type -> multipoolerservice.StreamHealthRequest
+	22, // 53: multipoolerservice.MultiPoolerService.StreamSchema:input_type -> multipoolerservice.StreamSchemaRequest
+	3,  // 54: multipoolerservice.MultiPoolerService.ExecuteQuery:output_type -> multipoolerservice.ExecuteQueryResponse
+	5,  // 55: multipoolerservice.MultiPoolerService.StreamExecute:output_type -> multipoolerservice.StreamExecuteResponse
+	7,  // 56: multipoolerservice.MultiPoolerService.PortalStreamExecute:output_type -> multipoolerservice.PortalStreamExecuteResponse
+	9,  // 57: multipoolerservice.MultiPoolerService.Describe:output_type -> multipoolerservice.DescribeResponse
+	11, // 58: multipoolerservice.MultiPoolerService.GetAuthCredentials:output_type -> multipoolerservice.GetAuthCredentialsResponse
+	13, // 59: multipoolerservice.MultiPoolerService.CopyBidiExecute:output_type -> multipoolerservice.CopyBidiExecuteResponse
+	15, // 60: multipoolerservice.MultiPoolerService.StreamNotifications:output_type -> multipoolerservice.StreamNotificationsResponse
+	17, // 61: multipoolerservice.MultiPoolerService.ReserveConnection:output_type -> multipoolerservice.ReserveConnectionResponse
+	19, // 62: multipoolerservice.MultiPoolerService.ReleaseReservedConnection:output_type -> multipoolerservice.ReleaseReservedConnectionResponse
+	21, // 63: multipoolerservice.MultiPoolerService.StreamHealth:output_type -> multipoolerservice.StreamHealthResponse
+	25, // 64: multipoolerservice.MultiPoolerService.StreamSchema:output_type -> multipoolerservice.StreamSchemaResponse
+	54, // [54:65] is the sub-list for method output_type
+	43, // [43:54] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_multipoolerservice_proto_init() }
@@ -1164,7 +1965,7 @@ func file_multipoolerservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_multipoolerservice_proto_rawDesc), len(file_multipoolerservice_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   12,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,12 +36,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MultiPoolerService_ExecuteQuery_FullMethodName        = "/multipoolerservice.MultiPoolerService/ExecuteQuery"
-	MultiPoolerService_StreamExecute_FullMethodName       = "/multipoolerservice.MultiPoolerService/StreamExecute"
-	MultiPoolerService_PortalStreamExecute_FullMethodName = "/multipoolerservice.MultiPoolerService/PortalStreamExecute"
-	MultiPoolerService_Describe_FullMethodName            = "/multipoolerservice.MultiPoolerService/Describe"
-	MultiPoolerService_GetAuthCredentials_FullMethodName  = "/multipoolerservice.MultiPoolerService/GetAuthCredentials"
-	MultiPoolerService_CopyBidiExecute_FullMethodName     = "/multipoolerservice.MultiPoolerService/CopyBidiExecute"
+	MultiPoolerService_ExecuteQuery_FullMethodName              = "/multipoolerservice.MultiPoolerService/ExecuteQuery"
+	MultiPoolerService_StreamExecute_FullMethodName             = "/multipoolerservice.MultiPoolerService/StreamExecute"
+	MultiPoolerService_PortalStreamExecute_FullMethodName       = "/multipoolerservice.MultiPoolerService/PortalStreamExecute"
+	MultiPoolerService_Describe_FullMethodName                  = "/multipoolerservice.MultiPoolerService/Describe"
+	MultiPoolerService_GetAuthCredentials_FullMethodName        = "/multipoolerservice.MultiPoolerService/GetAuthCredentials"
+	MultiPoolerService_CopyBidiExecute_FullMethodName           = "/multipoolerservice.MultiPoolerService/CopyBidiExecute"
+	MultiPoolerService_StreamNotifications_FullMethodName       = "/multipoolerservice.MultiPoolerService/StreamNotifications"
+	MultiPoolerService_ReserveConnection_FullMethodName         = "/multipoolerservice.MultiPoolerService/ReserveConnection"
+	MultiPoolerService_ReleaseReservedConnection_FullMethodName = "/multipoolerservice.MultiPoolerService/ReleaseReservedConnection"
+	MultiPoolerService_StreamHealth_FullMethodName              = "/multipoolerservice.MultiPoolerService/StreamHealth"
+	MultiPoolerService_StreamSchema_FullMethodName              = "/multipoolerservice.MultiPoolerService/StreamSchema"
 )
 
 // MultiPoolerServiceClient is the client API for MultiPoolerService service.
@@ -73,6 +78,31 @@ type MultiPoolerServiceClient interface {
 	// The gateway sends the initial command and then streams data/messages.
 	// The pooler responds with protocol-specific messages and final result.
 	CopyBidiExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CopyBidiExecuteRequest, CopyBidiExecuteResponse], error)
+	// StreamNotifications listens on a LISTEN/NOTIFY channel and streams the
+	// notifications raised on it until the caller cancels the stream.
+	// The first response carries no notification; it is sent once the pooler
+	// is listening on the channel.
+	StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamNotificationsResponse], error)
+	// ReserveConnection pins a reserved connection to the client session, e.g.
+	// because the session takes session-level advisory locks. If
+	// options.reserved_connection_id is set, that connection is pinned instead
+	// of a new one. A pinned connection stays reserved, and is exempt from the
+	// reserved connection inactivity timeout, until ReleaseReservedConnection.
+	ReserveConnection(ctx context.Context, in *ReserveConnectionRequest, opts ...grpc.CallOption) (*ReserveConnectionResponse, error)
+	// ReleaseReservedConnection unpins the reserved connection in
+	// options.reserved_connection_id. Its advisory locks are released and,
+	// unless a transaction or portal still needs it, it returns to the pool.
+	ReleaseReservedConnection(ctx context.Context, in *ReleaseReservedConnectionRequest, opts ...grpc.CallOption) (*ReleaseReservedConnectionResponse, error)
+	// StreamHealth streams health reports of the pooler, including its
+	// serving state, the readiness of its query service and the replication
+	// lag of its PostgreSQL, until the caller cancels the stream. The first
+	// report is sent right away, and another as soon as the serving state
+	// changes.
+	StreamHealth(ctx context.Context, in *StreamHealthRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamHealthResponse], error)
+	// StreamSchema streams the schema of the tables of the pooler's database:
+	// all of it right away, then the tables changed since, checking the
+	// catalog once per interval until the caller cancels the stream.
+	StreamSchema(ctx context.Context, in *StreamSchemaRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamSchemaResponse], error)
 }
 
 type multiPoolerServiceClient struct {
@@ -164,6 +194,83 @@ func (c *multiPoolerServiceClient) CopyBidiExecute(ctx context.Context, opts ...
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MultiPoolerService_CopyBidiExecuteClient = grpc.BidiStreamingClient[CopyBidiExecuteRequest, CopyBidiExecuteResponse]
 
+func (c *multiPoolerServiceClient) StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MultiPoolerService_ServiceDesc.Streams[3], MultiPoolerService_StreamNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamNotificationsRequest, StreamNotificationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiPoolerService_StreamNotificationsClient = grpc.ServerStreamingClient[StreamNotificationsResponse]
+
+func (c *multiPoolerServiceClient) ReserveConnection(ctx context.Context, in *ReserveConnectionRequest, opts ...grpc.CallOption) (*ReserveConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveConnectionResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerService_ReserveConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiPoolerServiceClient) ReleaseReservedConnection(ctx context.Context, in *ReleaseReservedConnectionRequest, opts ...grpc.CallOption) (*ReleaseReservedConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservedConnectionResponse)
+	err := c.cc.Invoke(ctx, MultiPoolerService_ReleaseReservedConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiPoolerServiceClient) StreamHealth(ctx context.Context, in *StreamHealthRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamHealthResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MultiPoolerService_ServiceDesc.Streams[4], MultiPoolerService_StreamHealth_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamHealthRequest, StreamHealthResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiPoolerService_StreamHealthClient = grpc.ServerStreamingClient[StreamHealthResponse]
+
+func (c *multiPoolerServiceClient) StreamSchema(ctx context.Context, in *StreamSchemaRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamSchemaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MultiPoolerService_ServiceDesc.Streams[5], MultiPoolerService_StreamSchema_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSchemaRequest, StreamSchemaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiPoolerService_StreamSchemaClient = grpc.ServerStreamingClient[StreamSchemaResponse]
+
 // MultiPoolerServiceServer is the server API for MultiPoolerService service.
 // All implementations must embed UnimplementedMultiPoolerServiceServer
 // for forward compatibility.
@@ -193,6 +300,31 @@ type MultiPoolerServiceServer interface {
 	// The gateway sends the initial command and then streams data/messages.
 	// The pooler responds with protocol-specific messages and final result.
 	CopyBidiExecute(grpc.BidiStreamingServer[CopyBidiExecuteRequest, CopyBidiExecuteResponse]) error
+	// StreamNotifications listens on a LISTEN/NOTIFY channel and streams the
+	// notifications raised on it until the caller cancels the stream.
+	// The first response carries no notification; it is sent once the pooler
+	// is listening on the channel.
+	StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[StreamNotificationsResponse]) error
+	// ReserveConnection pins a reserved connection to the client session, e.g.
+	// because the session takes session-level advisory locks. If
+	// options.reserved_connection_id is set, that connection is pinned instead
+	// of a new one. A pinned connection stays reserved, and is exempt from the
+	// reserved connection inactivity timeout, until ReleaseReservedConnection.
+	ReserveConnection(context.Context, *ReserveConnectionRequest) (*ReserveConnectionResponse, error)
+	// ReleaseReservedConnection unpins the reserved connection in
+	// options.reserved_connection_id. Its advisory locks are released and,
+	// unless a transaction or portal still needs it, it returns to the pool.
+	ReleaseReservedConnection(context.Context, *ReleaseReservedConnectionRequest) (*ReleaseReservedConnectionResponse, error)
+	// StreamHealth streams health reports of the pooler, including its
+	// serving state, the readiness of its query service and the replication
+	// lag of its PostgreSQL, until the caller cancels the stream. The first
+	// report is sent right away, and another as soon as the serving state
+	// changes.
+	StreamHealth(*StreamHealthRequest, grpc.ServerStreamingServer[StreamHealthResponse]) error
+	// StreamSchema streams the schema of the tables of the pooler's database:
+	// all of it right away, then the tables changed since, checking the
+	// catalog once per interval until the caller cancels the stream.
+	StreamSchema(*StreamSchemaRequest, grpc.ServerStreamingServer[StreamSchemaResponse]) error
 	mustEmbedUnimplementedMultiPoolerServiceServer()
 }
 
@@ -221,6 +353,21 @@ func (UnimplementedMultiPoolerServiceServer) GetAuthCredentials(context.Context,
 func (UnimplementedMultiPoolerServiceServer) CopyBidiExecute(grpc.BidiStreamingServer[CopyBidiExecuteRequest, CopyBidiExecuteResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method CopyBidiExecute not implemented")
 }
+func (UnimplementedMultiPoolerServiceServer) StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[StreamNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNotifications not implemented")
+}
+func (UnimplementedMultiPoolerServiceServer) ReserveConnection(context.Context, *ReserveConnectionRequest) (*ReserveConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveConnection not implemented")
+}
+func (UnimplementedMultiPoolerServiceServer) ReleaseReservedConnection(context.Context, *ReleaseReservedConnectionRequest) (*ReleaseReservedConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservedConnection not implemented")
+}
+func (UnimplementedMultiPoolerServiceServer) StreamHealth(*StreamHealthRequest, grpc.ServerStreamingServer[StreamHealthResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamHealth not implemented")
+}
+func (UnimplementedMultiPoolerServiceServer) StreamSchema(*StreamSchemaRequest, grpc.ServerStreamingServer[StreamSchemaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSchema not implemented")
+}
 func (UnimplementedMultiPoolerServiceServer) mustEmbedUnimplementedMultiPoolerServiceServer() {}
 func (UnimplementedMultiPoolerServiceServer) testEmbeddedByValue()                            {}
 
@@ -325,6 +472,75 @@ func _MultiPoolerService_CopyBidiExecute_Handler(srv interface{}, stream grpc.Se
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MultiPoolerService_CopyBidiExecuteServer = grpc.BidiStreamingServer[CopyBidiExecuteRequest, CopyBidiExecuteResponse]
 
+func _MultiPoolerService_StreamNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MultiPoolerServiceServer).StreamNotifications(m, &grpc.GenericServerStream[StreamNotificationsRequest, StreamNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiPoolerService_StreamNotificationsServer = grpc.ServerStreamingServer[StreamNotificationsResponse]
+
+func _MultiPoolerService_ReserveConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerServiceServer).ReserveConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerService_ReserveConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerServiceServer).ReserveConnection(ctx, req.(*ReserveConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiPoolerService_ReleaseReservedConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservedConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiPoolerServiceServer).ReleaseReservedConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiPoolerService_ReleaseReservedConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiPoolerServiceServer).ReleaseReservedConnection(ctx, req.(*ReleaseReservedConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiPoolerService_StreamHealth_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamHealthRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MultiPoolerServiceServer).StreamHealth(m, &grpc.GenericServerStream[StreamHealthRequest, StreamHealthResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiPoolerService_StreamHealthServer = grpc.ServerStreamingServer[StreamHealthResponse]
+
+func _MultiPoolerService_StreamSchema_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSchemaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MultiPoolerServiceServer).StreamSchema(m, &grpc.GenericServerStream[StreamSchemaRequest, StreamSchemaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiPoolerService_StreamSchemaServer = grpc.ServerStreamingServer[StreamSchemaResponse]
+
 // MultiPoolerService_ServiceDesc is the grpc.ServiceDesc for MultiPoolerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,6 +560,14 @@ var MultiPoolerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthCredentials",
 			Handler:    _MultiPoolerService_GetAuthCredentials_Handler,
 		},
+		{
+			MethodName: "ReserveConnection",
+			Handler:    _MultiPoolerService_ReserveConnection_Handler,
+		},
+		{
+			MethodName: "ReleaseReservedConnection",
+			Handler:    _MultiPoolerService_ReleaseReservedConnection_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -362,6 +586,21 @@ var MultiPoolerService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamNotifications",
+			Handler:       _MultiPoolerService_StreamNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamHealth",
+			Handler:       _MultiPoolerService_StreamHealth_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamSchema",
+			Handler:       _MultiPoolerService_StreamSchema_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "multipoolerservice.proto",
 }
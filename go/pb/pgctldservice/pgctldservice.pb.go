@@ -1034,6 +1034,995 @@ func (x *PgRewindResponse) GetOutput() string {
 	return ""
 }
 
+// InitReplica provisions an empty data directory as a standby of a running primary
+type InitReplicaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Primary server hostname
+	SourceHost string `protobuf:"bytes,1,opt,name=source_host,json=sourceHost,proto3" json:"source_host,omitempty"`
+	// Primary server port
+	SourcePort int32 `protobuf:"varint,2,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// application_name the standby reports to the primary (defaults to the pooler name)
+	ApplicationName string `protobuf:"bytes,3,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Additional pg_basebackup command line arguments
+	ExtraArgs     []string `protobuf:"bytes,4,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitReplicaRequest) Reset() {
+	*x = InitReplicaRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitReplicaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitReplicaRequest) ProtoMessage() {}
+
+func (x *InitReplicaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitReplicaRequest.ProtoReflect.Descriptor instead.
+func (*InitReplicaRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InitReplicaRequest) GetSourceHost() string {
+	if x != nil {
+		return x.SourceHost
+	}
+	return ""
+}
+
+func (x *InitReplicaRequest) GetSourcePort() int32 {
+	if x != nil {
+		return x.SourcePort
+	}
+	return 0
+}
+
+func (x *InitReplicaRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *InitReplicaRequest) GetExtraArgs() []string {
+	if x != nil {
+		return x.ExtraArgs
+	}
+	return nil
+}
+
+type InitReplicaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status message
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Output        string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitReplicaResponse) Reset() {
+	*x = InitReplicaResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitReplicaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitReplicaResponse) ProtoMessage() {}
+
+func (x *InitReplicaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitReplicaResponse.ProtoReflect.Descriptor instead.
+func (*InitReplicaResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InitReplicaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InitReplicaResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// UpgradeDataDir upgrades the data directory to a new PostgreSQL major version
+type UpgradeDataDirRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Directory of the binaries of the new major version
+	NewBinDir string `protobuf:"bytes,1,opt,name=new_bin_dir,json=newBinDir,proto3" json:"new_bin_dir,omitempty"`
+	// Only check that the data directory can be upgraded, with pg_upgrade --check
+	// in place and by validating the new binaries for standbys
+	CheckOnly bool `protobuf:"varint,2,opt,name=check_only,json=checkOnly,proto3" json:"check_only,omitempty"`
+	// Upgraded primary to clone instead of upgrading in place, for standbys
+	SourceHost string `protobuf:"bytes,3,opt,name=source_host,json=sourceHost,proto3" json:"source_host,omitempty"`
+	// Upgraded primary port
+	SourcePort int32 `protobuf:"varint,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// application_name the standby reports to the primary (defaults to the pooler name)
+	ApplicationName string `protobuf:"bytes,5,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpgradeDataDirRequest) Reset() {
+	*x = UpgradeDataDirRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeDataDirRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeDataDirRequest) ProtoMessage() {}
+
+func (x *UpgradeDataDirRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeDataDirRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeDataDirRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpgradeDataDirRequest) GetNewBinDir() string {
+	if x != nil {
+		return x.NewBinDir
+	}
+	return ""
+}
+
+func (x *UpgradeDataDirRequest) GetCheckOnly() bool {
+	if x != nil {
+		return x.CheckOnly
+	}
+	return false
+}
+
+func (x *UpgradeDataDirRequest) GetSourceHost() string {
+	if x != nil {
+		return x.SourceHost
+	}
+	return ""
+}
+
+func (x *UpgradeDataDirRequest) GetSourcePort() int32 {
+	if x != nil {
+		return x.SourcePort
+	}
+	return 0
+}
+
+func (x *UpgradeDataDirRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+type UpgradeDataDirResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status message
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Output  string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	// Major versions the data directory is upgraded from and to, equal if it
+	// was already upgraded
+	OldVersion    string `protobuf:"bytes,3,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+	NewVersion    string `protobuf:"bytes,4,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeDataDirResponse) Reset() {
+	*x = UpgradeDataDirResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeDataDirResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeDataDirResponse) ProtoMessage() {}
+
+func (x *UpgradeDataDirResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeDataDirResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeDataDirResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpgradeDataDirResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpgradeDataDirResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *UpgradeDataDirResponse) GetOldVersion() string {
+	if x != nil {
+		return x.OldVersion
+	}
+	return ""
+}
+
+func (x *UpgradeDataDirResponse) GetNewVersion() string {
+	if x != nil {
+		return x.NewVersion
+	}
+	return ""
+}
+
+// RollbackUpgrade restores the data directory from before the last upgrade
+type RollbackUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackUpgradeRequest) Reset() {
+	*x = RollbackUpgradeRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackUpgradeRequest) ProtoMessage() {}
+
+func (x *RollbackUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*RollbackUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{20}
+}
+
+type RollbackUpgradeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status message
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackUpgradeResponse) Reset() {
+	*x = RollbackUpgradeResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackUpgradeResponse) ProtoMessage() {}
+
+func (x *RollbackUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*RollbackUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RollbackUpgradeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// FinalizeUpgrade removes the rollback point of the last upgrade
+type FinalizeUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeUpgradeRequest) Reset() {
+	*x = FinalizeUpgradeRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeUpgradeRequest) ProtoMessage() {}
+
+func (x *FinalizeUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{22}
+}
+
+type FinalizeUpgradeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status message
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeUpgradeResponse) Reset() {
+	*x = FinalizeUpgradeResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeUpgradeResponse) ProtoMessage() {}
+
+func (x *FinalizeUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FinalizeUpgradeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ApplyConfig applies the managed parameters of a pooler type
+type ApplyConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pooler type whose parameters to apply: primary or replica
+	PoolerType string `protobuf:"bytes,1,opt,name=pooler_type,json=poolerType,proto3" json:"pooler_type,omitempty"`
+	// Restart PostgreSQL if a changed parameter only takes effect on restart
+	Restart       bool `protobuf:"varint,2,opt,name=restart,proto3" json:"restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApplyConfigRequest) GetPoolerType() string {
+	if x != nil {
+		return x.PoolerType
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+type ApplyConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status message
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Parameters set or reset in postgresql.auto.conf
+	Changed []string `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed,omitempty"`
+	// Changed parameters that take effect on the next restart
+	PendingRestart []string `protobuf:"bytes,3,rep,name=pending_restart,json=pendingRestart,proto3" json:"pending_restart,omitempty"`
+	// Whether PostgreSQL was restarted
+	Restarted     bool `protobuf:"varint,4,opt,name=restarted,proto3" json:"restarted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigResponse) Reset() {
+	*x = ApplyConfigResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigResponse) ProtoMessage() {}
+
+func (x *ApplyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApplyConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApplyConfigResponse) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *ApplyConfigResponse) GetPendingRestart() []string {
+	if x != nil {
+		return x.PendingRestart
+	}
+	return nil
+}
+
+func (x *ApplyConfigResponse) GetRestarted() bool {
+	if x != nil {
+		return x.Restarted
+	}
+	return false
+}
+
+// CheckConfigDrift compares postgresql.auto.conf with the managed parameters
+type CheckConfigDriftRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pooler type to compare with (defaults to the last applied one)
+	PoolerType    string `protobuf:"bytes,1,opt,name=pooler_type,json=poolerType,proto3" json:"pooler_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConfigDriftRequest) Reset() {
+	*x = CheckConfigDriftRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConfigDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConfigDriftRequest) ProtoMessage() {}
+
+func (x *CheckConfigDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConfigDriftRequest.ProtoReflect.Descriptor instead.
+func (*CheckConfigDriftRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CheckConfigDriftRequest) GetPoolerType() string {
+	if x != nil {
+		return x.PoolerType
+	}
+	return ""
+}
+
+// ParameterDrift is a managed parameter whose value differs from the managed one
+type ParameterDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Managed value
+	Expected string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	// Value in postgresql.auto.conf, empty if it is not set there
+	Actual        string `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParameterDrift) Reset() {
+	*x = ParameterDrift{}
+	mi := &file_pgctldservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterDrift) ProtoMessage() {}
+
+func (x *ParameterDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterDrift.ProtoReflect.Descriptor instead.
+func (*ParameterDrift) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ParameterDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParameterDrift) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *ParameterDrift) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+type CheckConfigDriftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pooler type compared with
+	PoolerType string            `protobuf:"bytes,1,opt,name=pooler_type,json=poolerType,proto3" json:"pooler_type,omitempty"`
+	Drift      []*ParameterDrift `protobuf:"bytes,2,rep,name=drift,proto3" json:"drift,omitempty"`
+	// Managed parameters that take effect on the next restart
+	PendingRestart []string `protobuf:"bytes,3,rep,name=pending_restart,json=pendingRestart,proto3" json:"pending_restart,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckConfigDriftResponse) Reset() {
+	*x = CheckConfigDriftResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConfigDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConfigDriftResponse) ProtoMessage() {}
+
+func (x *CheckConfigDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConfigDriftResponse.ProtoReflect.Descriptor instead.
+func (*CheckConfigDriftResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CheckConfigDriftResponse) GetPoolerType() string {
+	if x != nil {
+		return x.PoolerType
+	}
+	return ""
+}
+
+func (x *CheckConfigDriftResponse) GetDrift() []*ParameterDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *CheckConfigDriftResponse) GetPendingRestart() []string {
+	if x != nil {
+		return x.PendingRestart
+	}
+	return nil
+}
+
+// CheckExtension checks that an extension can be created or updated
+type CheckExtensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the extension
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version to check, the default version if empty
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckExtensionRequest) Reset() {
+	*x = CheckExtensionRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckExtensionRequest) ProtoMessage() {}
+
+func (x *CheckExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckExtensionRequest.ProtoReflect.Descriptor instead.
+func (*CheckExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CheckExtensionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckExtensionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type CheckExtensionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version created when no version is given
+	DefaultVersion string `protobuf:"bytes,1,opt,name=default_version,json=defaultVersion,proto3" json:"default_version,omitempty"`
+	// Version created in the database, empty if the extension was not created
+	// or PostgreSQL is not running
+	InstalledVersion string `protobuf:"bytes,2,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CheckExtensionResponse) Reset() {
+	*x = CheckExtensionResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckExtensionResponse) ProtoMessage() {}
+
+func (x *CheckExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckExtensionResponse.ProtoReflect.Descriptor instead.
+func (*CheckExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CheckExtensionResponse) GetDefaultVersion() string {
+	if x != nil {
+		return x.DefaultVersion
+	}
+	return ""
+}
+
+func (x *CheckExtensionResponse) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+// InstallExtension creates or updates an extension. PostgreSQL must run as a
+// primary; replicas get the extension through replication
+type InstallExtensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the extension
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version to create or update to, the default version if empty
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Schema to create the extension in
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Also create the extensions it requires
+	Cascade       bool `protobuf:"varint,4,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallExtensionRequest) Reset() {
+	*x = InstallExtensionRequest{}
+	mi := &file_pgctldservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallExtensionRequest) ProtoMessage() {}
+
+func (x *InstallExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallExtensionRequest.ProtoReflect.Descriptor instead.
+func (*InstallExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InstallExtensionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *InstallExtensionRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type InstallExtensionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status message
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Version before, empty if the extension was created
+	OldVersion string `protobuf:"bytes,2,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+	// Version now created in the database
+	NewVersion    string `protobuf:"bytes,3,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallExtensionResponse) Reset() {
+	*x = InstallExtensionResponse{}
+	mi := &file_pgctldservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallExtensionResponse) ProtoMessage() {}
+
+func (x *InstallExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pgctldservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallExtensionResponse.ProtoReflect.Descriptor instead.
+func (*InstallExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_pgctldservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InstallExtensionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InstallExtensionResponse) GetOldVersion() string {
+	if x != nil {
+		return x.OldVersion
+	}
+	return ""
+}
+
+func (x *InstallExtensionResponse) GetNewVersion() string {
+	if x != nil {
+		return x.NewVersion
+	}
+	return ""
+}
+
 var File_pgctldservice_proto protoreflect.FileDescriptor
 
 const file_pgctldservice_proto_rawDesc = "" +
@@ -1102,14 +2091,86 @@ const file_pgctldservice_proto_rawDesc = "" +
 	"extra_args\x18\x04 \x03(\tR\textraArgs\"D\n" +
 	"\x10PgRewindResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
-	"\x06output\x18\x02 \x01(\tR\x06output*f\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\"\xa0\x01\n" +
+	"\x12InitReplicaRequest\x12\x1f\n" +
+	"\vsource_host\x18\x01 \x01(\tR\n" +
+	"sourceHost\x12\x1f\n" +
+	"\vsource_port\x18\x02 \x01(\x05R\n" +
+	"sourcePort\x12)\n" +
+	"\x10application_name\x18\x03 \x01(\tR\x0fapplicationName\x12\x1d\n" +
+	"\n" +
+	"extra_args\x18\x04 \x03(\tR\textraArgs\"G\n" +
+	"\x13InitReplicaResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\"\xc3\x01\n" +
+	"\x15UpgradeDataDirRequest\x12\x1e\n" +
+	"\vnew_bin_dir\x18\x01 \x01(\tR\tnewBinDir\x12\x1d\n" +
+	"\n" +
+	"check_only\x18\x02 \x01(\bR\tcheckOnly\x12\x1f\n" +
+	"\vsource_host\x18\x03 \x01(\tR\n" +
+	"sourceHost\x12\x1f\n" +
+	"\vsource_port\x18\x04 \x01(\x05R\n" +
+	"sourcePort\x12)\n" +
+	"\x10application_name\x18\x05 \x01(\tR\x0fapplicationName\"\x8c\x01\n" +
+	"\x16UpgradeDataDirResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\x12\x1f\n" +
+	"\vold_version\x18\x03 \x01(\tR\n" +
+	"oldVersion\x12\x1f\n" +
+	"\vnew_version\x18\x04 \x01(\tR\n" +
+	"newVersion\"\x18\n" +
+	"\x16RollbackUpgradeRequest\"3\n" +
+	"\x17RollbackUpgradeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x18\n" +
+	"\x16FinalizeUpgradeRequest\"3\n" +
+	"\x17FinalizeUpgradeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"O\n" +
+	"\x12ApplyConfigRequest\x12\x1f\n" +
+	"\vpooler_type\x18\x01 \x01(\tR\n" +
+	"poolerType\x12\x18\n" +
+	"\arestart\x18\x02 \x01(\bR\arestart\"\x90\x01\n" +
+	"\x13ApplyConfigResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\achanged\x18\x02 \x03(\tR\achanged\x12'\n" +
+	"\x0fpending_restart\x18\x03 \x03(\tR\x0ependingRestart\x12\x1c\n" +
+	"\trestarted\x18\x04 \x01(\bR\trestarted\":\n" +
+	"\x17CheckConfigDriftRequest\x12\x1f\n" +
+	"\vpooler_type\x18\x01 \x01(\tR\n" +
+	"poolerType\"X\n" +
+	"\x0eParameterDrift\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bexpected\x18\x02 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\x03 \x01(\tR\x06actual\"\x99\x01\n" +
+	"\x18CheckConfigDriftResponse\x12\x1f\n" +
+	"\vpooler_type\x18\x01 \x01(\tR\n" +
+	"poolerType\x123\n" +
+	"\x05drift\x18\x02 \x03(\v2\x1d.pgctldservice.ParameterDriftR\x05drift\x12'\n" +
+	"\x0fpending_restart\x18\x03 \x03(\tR\x0ependingRestart\"E\n" +
+	"\x15CheckExtensionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"n\n" +
+	"\x16CheckExtensionResponse\x12'\n" +
+	"\x0fdefault_version\x18\x01 \x01(\tR\x0edefaultVersion\x12+\n" +
+	"\x11installed_version\x18\x02 \x01(\tR\x10installedVersion\"y\n" +
+	"\x17InstallExtensionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x18\n" +
+	"\acascade\x18\x04 \x01(\bR\acascade\"v\n" +
+	"\x18InstallExtensionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1f\n" +
+	"\vold_version\x18\x02 \x01(\tR\n" +
+	"oldVersion\x12\x1f\n" +
+	"\vnew_version\x18\x03 \x01(\tR\n" +
+	"newVersion*f\n" +
 	"\fServerStatus\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
 	"\aSTOPPED\x10\x01\x12\f\n" +
 	"\bSTARTING\x10\x02\x12\v\n" +
 	"\aRUNNING\x10\x03\x12\f\n" +
 	"\bSTOPPING\x10\x04\x12\x13\n" +
-	"\x0fNOT_INITIALIZED\x10\x052\xe4\x04\n" +
+	"\x0fNOT_INITIALIZED\x10\x052\xdc\n" +
+	"\n" +
 	"\x06PgCtld\x12B\n" +
 	"\x05Start\x12\x1b.pgctldservice.StartRequest\x1a\x1c.pgctldservice.StartResponse\x12?\n" +
 	"\x04Stop\x12\x1a.pgctldservice.StopRequest\x1a\x1b.pgctldservice.StopResponse\x12H\n" +
@@ -1118,7 +2179,15 @@ const file_pgctldservice_proto_rawDesc = "" +
 	"\x06Status\x12\x1c.pgctldservice.StatusRequest\x1a\x1d.pgctldservice.StatusResponse\x12H\n" +
 	"\aVersion\x12\x1d.pgctldservice.VersionRequest\x1a\x1e.pgctldservice.VersionResponse\x12T\n" +
 	"\vInitDataDir\x12!.pgctldservice.InitDataDirRequest\x1a\".pgctldservice.InitDataDirResponse\x12K\n" +
-	"\bPgRewind\x12\x1e.pgctldservice.PgRewindRequest\x1a\x1f.pgctldservice.PgRewindResponseB4Z2github.com/multigres/multigres/go/pb/pgctldserviceb\x06proto3"
+	"\bPgRewind\x12\x1e.pgctldservice.PgRewindRequest\x1a\x1f.pgctldservice.PgRewindResponse\x12T\n" +
+	"\vInitReplica\x12!.pgctldservice.InitReplicaRequest\x1a\".pgctldservice.InitReplicaResponse\x12]\n" +
+	"\x0eUpgradeDataDir\x12$.pgctldservice.UpgradeDataDirRequest\x1a%.pgctldservice.UpgradeDataDirResponse\x12`\n" +
+	"\x0fRollbackUpgrade\x12%.pgctldservice.RollbackUpgradeRequest\x1a&.pgctldservice.RollbackUpgradeResponse\x12`\n" +
+	"\x0fFinalizeUpgrade\x12%.pgctldservice.FinalizeUpgradeRequest\x1a&.pgctldservice.FinalizeUpgradeResponse\x12T\n" +
+	"\vApplyConfig\x12!.pgctldservice.ApplyConfigRequest\x1a\".pgctldservice.ApplyConfigResponse\x12c\n" +
+	"\x10CheckConfigDrift\x12&.pgctldservice.CheckConfigDriftRequest\x1a'.pgctldservice.CheckConfigDriftResponse\x12]\n" +
+	"\x0eCheckExtension\x12$.pgctldservice.CheckExtensionRequest\x1a%.pgctldservice.CheckExtensionResponse\x12c\n" +
+	"\x10InstallExtension\x12&.pgctldservice.InstallExtensionRequest\x1a'.pgctldservice.InstallExtensionResponseB4Z2github.com/multigres/multigres/go/pb/pgctldserviceb\x06proto3"
 
 var (
 	file_pgctldservice_proto_rawDescOnce sync.Once
@@ -1133,53 +2202,87 @@ func file_pgctldservice_proto_rawDescGZIP() []byte {
 }
 
 var file_pgctldservice_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pgctldservice_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_pgctldservice_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_pgctldservice_proto_goTypes = []any{
-	(ServerStatus)(0),            // 0: pgctldservice.ServerStatus
-	(*StartRequest)(nil),         // 1: pgctldservice.StartRequest
-	(*StartResponse)(nil),        // 2: pgctldservice.StartResponse
-	(*StopRequest)(nil),          // 3: pgctldservice.StopRequest
-	(*StopResponse)(nil),         // 4: pgctldservice.StopResponse
-	(*RestartRequest)(nil),       // 5: pgctldservice.RestartRequest
-	(*RestartResponse)(nil),      // 6: pgctldservice.RestartResponse
-	(*ReloadConfigRequest)(nil),  // 7: pgctldservice.ReloadConfigRequest
-	(*ReloadConfigResponse)(nil), // 8: pgctldservice.ReloadConfigResponse
-	(*StatusRequest)(nil),        // 9: pgctldservice.StatusRequest
-	(*StatusResponse)(nil),       // 10: pgctldservice.StatusResponse
-	(*VersionRequest)(nil),       // 11: pgctldservice.VersionRequest
-	(*VersionResponse)(nil),      // 12: pgctldservice.VersionResponse
-	(*InitDataDirRequest)(nil),   // 13: pgctldservice.InitDataDirRequest
-	(*InitDataDirResponse)(nil),  // 14: pgctldservice.InitDataDirResponse
-	(*PgRewindRequest)(nil),      // 15: pgctldservice.PgRewindRequest
-	(*PgRewindResponse)(nil),     // 16: pgctldservice.PgRewindResponse
-	(*durationpb.Duration)(nil),  // 17: google.protobuf.Duration
+	(ServerStatus)(0),                // 0: pgctldservice.ServerStatus
+	(*StartRequest)(nil),             // 1: pgctldservice.StartRequest
+	(*StartResponse)(nil),            // 2: pgctldservice.StartResponse
+	(*StopRequest)(nil),              // 3: pgctldservice.StopRequest
+	(*StopResponse)(nil),             // 4: pgctldservice.StopResponse
+	(*RestartRequest)(nil),           // 5: pgctldservice.RestartRequest
+	(*RestartResponse)(nil),          // 6: pgctldservice.RestartResponse
+	(*ReloadConfigRequest)(nil),      // 7: pgctldservice.ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),     // 8: pgctldservice.ReloadConfigResponse
+	(*StatusRequest)(nil),            // 9: pgctldservice.StatusRequest
+	(*StatusResponse)(nil),           // 10: pgctldservice.StatusResponse
+	(*VersionRequest)(nil),           // 11: pgctldservice.VersionRequest
+	(*VersionResponse)(nil),          // 12: pgctldservice.VersionResponse
+	(*InitDataDirRequest)(nil),       // 13: pgctldservice.InitDataDirRequest
+	(*InitDataDirResponse)(nil),      // 14: pgctldservice.InitDataDirResponse
+	(*PgRewindRequest)(nil),          // 15: pgctldservice.PgRewindRequest
+	(*PgRewindResponse)(nil),         // 16: pgctldservice.PgRewindResponse
+	(*InitReplicaRequest)(nil),       // 17: pgctldservice.InitReplicaRequest
+	(*InitReplicaResponse)(nil),      // 18: pgctldservice.InitReplicaResponse
+	(*UpgradeDataDirRequest)(nil),    // 19: pgctldservice.UpgradeDataDirRequest
+	(*UpgradeDataDirResponse)(nil),   // 20: pgctldservice.UpgradeDataDirResponse
+	(*RollbackUpgradeRequest)(nil),   // 21: pgctldservice.RollbackUpgradeRequest
+	(*RollbackUpgradeResponse)(nil),  // 22: pgctldservice.RollbackUpgradeResponse
+	(*FinalizeUpgradeRequest)(nil),   // 23: pgctldservice.FinalizeUpgradeRequest
+	(*FinalizeUpgradeResponse)(nil),  // 24: pgctldservice.FinalizeUpgradeResponse
+	(*ApplyConfigRequest)(nil),       // 25: pgctldservice.ApplyConfigRequest
+	(*ApplyConfigResponse)(nil),      // 26: pgctldservice.ApplyConfigResponse
+	(*CheckConfigDriftRequest)(nil),  // 27: pgctldservice.CheckConfigDriftRequest
+	(*ParameterDrift)(nil),           // 28: pgctldservice.ParameterDrift
+	(*CheckConfigDriftResponse)(nil), // 29: pgctldservice.CheckConfigDriftResponse
+	(*CheckExtensionRequest)(nil),    // 30: pgctldservice.CheckExtensionRequest
+	(*CheckExtensionResponse)(nil),   // 31: pgctldservice.CheckExtensionResponse
+	(*InstallExtensionRequest)(nil),  // 32: pgctldservice.InstallExtensionRequest
+	(*InstallExtensionResponse)(nil), // 33: pgctldservice.InstallExtensionResponse
+	(*durationpb.Duration)(nil),      // 34: google.protobuf.Duration
 }
 var file_pgctldservice_proto_depIdxs = []int32{
-	17, // 0: pgctldservice.StopRequest.timeout:type_name -> google.protobuf.Duration
-	17, // 1: pgctldservice.RestartRequest.timeout:type_name -> google.protobuf.Duration
+	34, // 0: pgctldservice.StopRequest.timeout:type_name -> google.protobuf.Duration
+	34, // 1: pgctldservice.RestartRequest.timeout:type_name -> google.protobuf.Duration
 	0,  // 2: pgctldservice.StatusResponse.status:type_name -> pgctldservice.ServerStatus
-	17, // 3: pgctldservice.StatusResponse.uptime:type_name -> google.protobuf.Duration
-	1,  // 4: pgctldservice.PgCtld.Start:input_type -> pgctldservice.StartRequest
-	3,  // 5: pgctldservice.PgCtld.Stop:input_type -> pgctldservice.StopRequest
-	5,  // 6: pgctldservice.PgCtld.Restart:input_type -> pgctldservice.RestartRequest
-	7,  // 7: pgctldservice.PgCtld.ReloadConfig:input_type -> pgctldservice.ReloadConfigRequest
-	9,  // 8: pgctldservice.PgCtld.Status:input_type -> pgctldservice.StatusRequest
-	11, // 9: pgctldservice.PgCtld.Version:input_type -> pgctldservice.VersionRequest
-	13, // 10: pgctldservice.PgCtld.InitDataDir:input_type -> pgctldservice.InitDataDirRequest
-	15, // 11: pgctldservice.PgCtld.PgRewind:input_type -> pgctldservice.PgRewindRequest
-	2,  // 12: pgctldservice.PgCtld.Start:output_type -> pgctldservice.StartResponse
-	4,  // 13: pgctldservice.PgCtld.Stop:output_type -> pgctldservice.StopResponse
-	6,  // 14: pgctldservice.PgCtld.Restart:output_type -> pgctldservice.RestartResponse
-	8,  // 15: pgctldservice.PgCtld.ReloadConfig:output_type -> pgctldservice.ReloadConfigResponse
-	10, // 16: pgctldservice.PgCtld.Status:output_type -> pgctldservice.StatusResponse
-	12, // 17: pgctldservice.PgCtld.Version:output_type -> pgctldservice.VersionResponse
-	14, // 18: pgctldservice.PgCtld.InitDataDir:output_type -> pgctldservice.InitDataDirResponse
-	16, // 19: pgctldservice.PgCtld.PgRewind:output_type -> pgctldservice.PgRewindResponse
-	12, // [12:20] is the sub-list for method output_type
-	4,  // [4:12] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	34, // 3: pgctldservice.StatusResponse.uptime:type_name -> google.protobuf.Duration
+	28, // 4: pgctldservice.CheckConfigDriftResponse.drift:type_name -> pgctldservice.ParameterDrift
+	1,  // 5: pgctldservice.PgCtld.Start:input_type -> pgctldservice.StartRequest
+	3,  // 6: pgctldservice.PgCtld.Stop:input_type -> pgctldservice.StopRequest
+	5,  // 7: pgctldservice.PgCtld.Restart:input_type -> pgctldservice.RestartRequest
+	7,  // 8: pgctldservice.PgCtld.ReloadConfig:input_type -> pgctldservice.ReloadConfigRequest
+	9,  // 9: pgctldservice.PgCtld.Status:input_type -> pgctldservice.StatusRequest
+	11, // 10: pgctldservice.PgCtld.Version:input_type -> pgctldservice.VersionRequest
+	13, // 11: pgctldservice.PgCtld.InitDataDir:input_type -> pgctldservice.InitDataDirRequest
+	15, // 12: pgctldservice.PgCtld.PgRewind:input_type -> pgctldservice.PgRewindRequest
+	17, // 13: pgctldservice.PgCtld.InitReplica:input_type -> pgctldservice.InitReplicaRequest
+	19, // 14: pgctldservice.PgCtld.UpgradeDataDir:input_type -> pgctldservice.UpgradeDataDirRequest
+	21, // 15: pgctldservice.PgCtld.RollbackUpgrade:input_type -> pgctldservice.RollbackUpgradeRequest
+	23, // 16: pgctldservice.PgCtld.FinalizeUpgrade:input_type -> pgctldservice.FinalizeUpgradeRequest
+	25, // 17: pgctldservice.PgCtld.ApplyConfig:input_type -> pgctldservice.ApplyConfigRequest
+	27, // 18: pgctldservice.PgCtld.CheckConfigDrift:input_type -> pgctldservice.CheckConfigDriftRequest
+	30, // 19: pgctldservice.PgCtld.CheckExtension:input_type -> pgctldservice.CheckExtensionRequest
+	32, // 20: pgctldservice.PgCtld.InstallExtension:input_type -> pgctldservice.InstallExtensionRequest
+	2,  // 21: pgctldservice.PgCtld.Start:output_type -> pgctldservice.StartResponse
+	4,  // 22: pgctldservice.PgCtld.Stop:output_type -> pgctldservice.StopResponse
+	6,  // 23: pgctldservice.PgCtld.Restart:output_type -> pgctldservice.RestartResponse
+	8,  // 24: pgctldservice.PgCtld.ReloadConfig:output_type -> pgctldservice.ReloadConfigResponse
+	10, // 25: pgctldservice.PgCtld.Status:output_type -> pgctldservice.StatusResponse
+	12, // 26: pgctldservice.PgCtld.Version:output_type -> pgctldservice.VersionResponse
+	14, // 27: pgctldservice.PgCtld.InitDataDir:output_type -> pgctldservice.InitDataDirResponse
+	16, // 28: pgctldservice.PgCtld.PgRewind:output_type -> pgctldservice.PgRewindResponse
+	18, // 29: pgctldservice.PgCtld.InitReplica:output_type -> pgctldservice.InitReplicaResponse
+	20, // 30: pgctldservice.PgCtld.UpgradeDataDir:output_type -> pgctldservice.UpgradeDataDirResponse
+	22, // 31: pgctldservice.PgCtld.RollbackUpgrade:output_type -> pgctldservice.RollbackUpgradeResponse
+	24, // 32: pgctldservice.PgCtld.FinalizeUpgrade:output_type -> pgctldservice.FinalizeUpgradeResponse
+	26, // 33: pgctldservice.PgCtld.ApplyConfig:output_type -> pgctldservice.ApplyConfigResponse
+	29, // 34: pgctldservice.PgCtld.CheckConfigDrift:output_type -> pgctldservice.CheckConfigDriftResponse
+	31, // 35: pgctldservice.PgCtld.CheckExtension:output_type -> pgctldservice.CheckExtensionResponse
+	33, // 36: pgctldservice.PgCtld.InstallExtension:output_type -> pgctldservice.InstallExtensionResponse
+	21, // [21:37] is the sub-list for method output_type
+	5,  // [5:21] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_pgctldservice_proto_init() }
@@ -1193,7 +2296,7 @@ func file_pgctldservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pgctldservice_proto_rawDesc), len(file_pgctldservice_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
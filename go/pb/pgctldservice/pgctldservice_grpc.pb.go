@@ -33,14 +33,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PgCtld_Start_FullMethodName        = "/pgctldservice.PgCtld/Start"
-	PgCtld_Stop_FullMethodName         = "/pgctldservice.PgCtld/Stop"
-	PgCtld_Restart_FullMethodName      = "/pgctldservice.PgCtld/Restart"
-	PgCtld_ReloadConfig_FullMethodName = "/pgctldservice.PgCtld/ReloadConfig"
-	PgCtld_Status_FullMethodName       = "/pgctldservice.PgCtld/Status"
-	PgCtld_Version_FullMethodName      = "/pgctldservice.PgCtld/Version"
-	PgCtld_InitDataDir_FullMethodName  = "/pgctldservice.PgCtld/InitDataDir"
-	PgCtld_PgRewind_FullMethodName     = "/pgctldservice.PgCtld/PgRewind"
+	PgCtld_Start_FullMethodName            = "/pgctldservice.PgCtld/Start"
+	PgCtld_Stop_FullMethodName             = "/pgctldservice.PgCtld/Stop"
+	PgCtld_Restart_FullMethodName          = "/pgctldservice.PgCtld/Restart"
+	PgCtld_ReloadConfig_FullMethodName     = "/pgctldservice.PgCtld/ReloadConfig"
+	PgCtld_Status_FullMethodName           = "/pgctldservice.PgCtld/Status"
+	PgCtld_Version_FullMethodName          = "/pgctldservice.PgCtld/Version"
+	PgCtld_InitDataDir_FullMethodName      = "/pgctldservice.PgCtld/InitDataDir"
+	PgCtld_PgRewind_FullMethodName         = "/pgctldservice.PgCtld/PgRewind"
+	PgCtld_InitReplica_FullMethodName      = "/pgctldservice.PgCtld/InitReplica"
+	PgCtld_UpgradeDataDir_FullMethodName   = "/pgctldservice.PgCtld/UpgradeDataDir"
+	PgCtld_RollbackUpgrade_FullMethodName  = "/pgctldservice.PgCtld/RollbackUpgrade"
+	PgCtld_FinalizeUpgrade_FullMethodName  = "/pgctldservice.PgCtld/FinalizeUpgrade"
+	PgCtld_ApplyConfig_FullMethodName      = "/pgctldservice.PgCtld/ApplyConfig"
+	PgCtld_CheckConfigDrift_FullMethodName = "/pgctldservice.PgCtld/CheckConfigDrift"
+	PgCtld_CheckExtension_FullMethodName   = "/pgctldservice.PgCtld/CheckExtension"
+	PgCtld_InstallExtension_FullMethodName = "/pgctldservice.PgCtld/InstallExtension"
 )
 
 // PgCtldClient is the client API for PgCtld service.
@@ -66,6 +74,27 @@ type PgCtldClient interface {
 	// PgRewind rewinds a PostgreSQL data directory to an earlier point in the timeline
 	// This is used to resynchronize a server that diverged from the primary after a failback
 	PgRewind(ctx context.Context, in *PgRewindRequest, opts ...grpc.CallOption) (*PgRewindResponse, error)
+	// InitReplica provisions an empty data directory as a standby of a running
+	// primary using pg_basebackup, writing standby.signal and primary_conninfo
+	InitReplica(ctx context.Context, in *InitReplicaRequest, opts ...grpc.CallOption) (*InitReplicaResponse, error)
+	// UpgradeDataDir upgrades the data directory to the PostgreSQL major version
+	// of new binaries, in place with pg_upgrade or, for standbys, by cloning the
+	// upgraded primary. The old data directory is kept as a rollback point
+	UpgradeDataDir(ctx context.Context, in *UpgradeDataDirRequest, opts ...grpc.CallOption) (*UpgradeDataDirResponse, error)
+	// RollbackUpgrade restores the data directory from before the last upgrade
+	RollbackUpgrade(ctx context.Context, in *RollbackUpgradeRequest, opts ...grpc.CallOption) (*RollbackUpgradeResponse, error)
+	// FinalizeUpgrade removes the rollback point of the last upgrade
+	FinalizeUpgrade(ctx context.Context, in *FinalizeUpgradeRequest, opts ...grpc.CallOption) (*FinalizeUpgradeResponse, error)
+	// ApplyConfig resolves the managed parameters of a pooler type into
+	// postgresql.auto.conf, applying reloadable ones with ALTER SYSTEM
+	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
+	// CheckConfigDrift reports managed parameters changed outside of pgctld
+	CheckConfigDrift(ctx context.Context, in *CheckConfigDriftRequest, opts ...grpc.CallOption) (*CheckConfigDriftResponse, error)
+	// CheckExtension checks that the files of an extension are installed,
+	// including its shared library
+	CheckExtension(ctx context.Context, in *CheckExtensionRequest, opts ...grpc.CallOption) (*CheckExtensionResponse, error)
+	// InstallExtension creates an extension, or updates it, in the database
+	InstallExtension(ctx context.Context, in *InstallExtensionRequest, opts ...grpc.CallOption) (*InstallExtensionResponse, error)
 }
 
 type pgCtldClient struct {
@@ -156,6 +185,86 @@ func (c *pgCtldClient) PgRewind(ctx context.Context, in *PgRewindRequest, opts .
 	return out, nil
 }
 
+func (c *pgCtldClient) InitReplica(ctx context.Context, in *InitReplicaRequest, opts ...grpc.CallOption) (*InitReplicaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitReplicaResponse)
+	err := c.cc.Invoke(ctx, PgCtld_InitReplica_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) UpgradeDataDir(ctx context.Context, in *UpgradeDataDirRequest, opts ...grpc.CallOption) (*UpgradeDataDirResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeDataDirResponse)
+	err := c.cc.Invoke(ctx, PgCtld_UpgradeDataDir_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) RollbackUpgrade(ctx context.Context, in *RollbackUpgradeRequest, opts ...grpc.CallOption) (*RollbackUpgradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackUpgradeResponse)
+	err := c.cc.Invoke(ctx, PgCtld_RollbackUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) FinalizeUpgrade(ctx context.Context, in *FinalizeUpgradeRequest, opts ...grpc.CallOption) (*FinalizeUpgradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalizeUpgradeResponse)
+	err := c.cc.Invoke(ctx, PgCtld_FinalizeUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyConfigResponse)
+	err := c.cc.Invoke(ctx, PgCtld_ApplyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) CheckConfigDrift(ctx context.Context, in *CheckConfigDriftRequest, opts ...grpc.CallOption) (*CheckConfigDriftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckConfigDriftResponse)
+	err := c.cc.Invoke(ctx, PgCtld_CheckConfigDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) CheckExtension(ctx context.Context, in *CheckExtensionRequest, opts ...grpc.CallOption) (*CheckExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckExtensionResponse)
+	err := c.cc.Invoke(ctx, PgCtld_CheckExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pgCtldClient) InstallExtension(ctx context.Context, in *InstallExtensionRequest, opts ...grpc.CallOption) (*InstallExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallExtensionResponse)
+	err := c.cc.Invoke(ctx, PgCtld_InstallExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PgCtldServer is the server API for PgCtld service.
 // All implementations must embed UnimplementedPgCtldServer
 // for forward compatibility.
@@ -179,6 +288,27 @@ type PgCtldServer interface {
 	// PgRewind rewinds a PostgreSQL data directory to an earlier point in the timeline
 	// This is used to resynchronize a server that diverged from the primary after a failback
 	PgRewind(context.Context, *PgRewindRequest) (*PgRewindResponse, error)
+	// InitReplica provisions an empty data directory as a standby of a running
+	// primary using pg_basebackup, writing standby.signal and primary_conninfo
+	InitReplica(context.Context, *InitReplicaRequest) (*InitReplicaResponse, error)
+	// UpgradeDataDir upgrades the data directory to the PostgreSQL major version
+	// of new binaries, in place with pg_upgrade or, for standbys, by cloning the
+	// upgraded primary. The old data directory is kept as a rollback point
+	UpgradeDataDir(context.Context, *UpgradeDataDirRequest) (*UpgradeDataDirResponse, error)
+	// RollbackUpgrade restores the data directory from before the last upgrade
+	RollbackUpgrade(context.Context, *RollbackUpgradeRequest) (*RollbackUpgradeResponse, error)
+	// FinalizeUpgrade removes the rollback point of the last upgrade
+	FinalizeUpgrade(context.Context, *FinalizeUpgradeRequest) (*FinalizeUpgradeResponse, error)
+	// ApplyConfig resolves the managed parameters of a pooler type into
+	// postgresql.auto.conf, applying reloadable ones with ALTER SYSTEM
+	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
+	// CheckConfigDrift reports managed parameters changed outside of pgctld
+	CheckConfigDrift(context.Context, *CheckConfigDriftRequest) (*CheckConfigDriftResponse, error)
+	// CheckExtension checks that the files of an extension are installed,
+	// including its shared library
+	CheckExtension(context.Context, *CheckExtensionRequest) (*CheckExtensionResponse, error)
+	// InstallExtension creates an extension, or updates it, in the database
+	InstallExtension(context.Context, *InstallExtensionRequest) (*InstallExtensionResponse, error)
 	mustEmbedUnimplementedPgCtldServer()
 }
 
@@ -213,6 +343,30 @@ func (UnimplementedPgCtldServer) InitDataDir(context.Context, *InitDataDirReques
 func (UnimplementedPgCtldServer) PgRewind(context.Context, *PgRewindRequest) (*PgRewindResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PgRewind not implemented")
 }
+func (UnimplementedPgCtldServer) InitReplica(context.Context, *InitReplicaRequest) (*InitReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitReplica not implemented")
+}
+func (UnimplementedPgCtldServer) UpgradeDataDir(context.Context, *UpgradeDataDirRequest) (*UpgradeDataDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeDataDir not implemented")
+}
+func (UnimplementedPgCtldServer) RollbackUpgrade(context.Context, *RollbackUpgradeRequest) (*RollbackUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackUpgrade not implemented")
+}
+func (UnimplementedPgCtldServer) FinalizeUpgrade(context.Context, *FinalizeUpgradeRequest) (*FinalizeUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeUpgrade not implemented")
+}
+func (UnimplementedPgCtldServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfig not implemented")
+}
+func (UnimplementedPgCtldServer) CheckConfigDrift(context.Context, *CheckConfigDriftRequest) (*CheckConfigDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConfigDrift not implemented")
+}
+func (UnimplementedPgCtldServer) CheckExtension(context.Context, *CheckExtensionRequest) (*CheckExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckExtension not implemented")
+}
+func (UnimplementedPgCtldServer) InstallExtension(context.Context, *InstallExtensionRequest) (*InstallExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallExtension not implemented")
+}
 func (UnimplementedPgCtldServer) mustEmbedUnimplementedPgCtldServer() {}
 func (UnimplementedPgCtldServer) testEmbeddedByValue()                {}
 
@@ -378,6 +532,150 @@ func _PgCtld_PgRewind_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PgCtld_InitReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).InitReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_InitReplica_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).InitReplica(ctx, req.(*InitReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_UpgradeDataDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeDataDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).UpgradeDataDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_UpgradeDataDir_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).UpgradeDataDir(ctx, req.(*UpgradeDataDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_RollbackUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).RollbackUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_RollbackUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).RollbackUpgrade(ctx, req.(*RollbackUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_FinalizeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).FinalizeUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_FinalizeUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).FinalizeUpgrade(ctx, req.(*FinalizeUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_ApplyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).ApplyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_ApplyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).ApplyConfig(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_CheckConfigDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConfigDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).CheckConfigDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_CheckConfigDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).CheckConfigDrift(ctx, req.(*CheckConfigDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_CheckExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).CheckExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_CheckExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).CheckExtension(ctx, req.(*CheckExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PgCtld_InstallExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PgCtldServer).InstallExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PgCtld_InstallExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PgCtldServer).InstallExtension(ctx, req.(*InstallExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PgCtld_ServiceDesc is the grpc.ServiceDesc for PgCtld service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -417,6 +715,38 @@ var PgCtld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PgRewind",
 			Handler:    _PgCtld_PgRewind_Handler,
 		},
+		{
+			MethodName: "InitReplica",
+			Handler:    _PgCtld_InitReplica_Handler,
+		},
+		{
+			MethodName: "UpgradeDataDir",
+			Handler:    _PgCtld_UpgradeDataDir_Handler,
+		},
+		{
+			MethodName: "RollbackUpgrade",
+			Handler:    _PgCtld_RollbackUpgrade_Handler,
+		},
+		{
+			MethodName: "FinalizeUpgrade",
+			Handler:    _PgCtld_FinalizeUpgrade_Handler,
+		},
+		{
+			MethodName: "ApplyConfig",
+			Handler:    _PgCtld_ApplyConfig_Handler,
+		},
+		{
+			MethodName: "CheckConfigDrift",
+			Handler:    _PgCtld_CheckConfigDrift_Handler,
+		},
+		{
+			MethodName: "CheckExtension",
+			Handler:    _PgCtld_CheckExtension_Handler,
+		},
+		{
+			MethodName: "InstallExtension",
+			Handler:    _PgCtld_InstallExtension_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pgctldservice.proto",
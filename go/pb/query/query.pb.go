@@ -325,8 +325,11 @@ type Notice struct {
 	DataTypeName string `protobuf:"bytes,13,opt,name=data_type_name,json=dataTypeName,proto3" json:"data_type_name,omitempty"`
 	// constraint_name is the name of the constraint associated with the notice (optional)
 	ConstraintName string `protobuf:"bytes,14,opt,name=constraint_name,json=constraintName,proto3" json:"constraint_name,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// severity_non_localized is the severity as sent in the 'V' field, which is
+	// never translated (PostgreSQL 9.6+, empty if not available)
+	SeverityNonLocalized string `protobuf:"bytes,15,opt,name=severity_non_localized,json=severityNonLocalized,proto3" json:"severity_non_localized,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Notice) Reset() {
@@ -457,6 +460,78 @@ func (x *Notice) GetConstraintName() string {
 	return ""
 }
 
+func (x *Notice) GetSeverityNonLocalized() string {
+	if x != nil {
+		return x.SeverityNonLocalized
+	}
+	return ""
+}
+
+// Notification represents a PostgreSQL NotificationResponse, the
+// asynchronous message delivered to sessions that LISTEN on a channel.
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pid is the process ID of the backend that raised the notification
+	Pid uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// channel is the name of the channel the notification was raised on
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// payload is the notification payload (empty if none was given)
+	Payload       string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_query_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Notification) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Notification) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Notification) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
 // StatementDescription describes a prepared statement or portal.
 // Used for the Describe message ('D') response in the extended query protocol.
 type StatementDescription struct {
@@ -473,7 +548,7 @@ type StatementDescription struct {
 
 func (x *StatementDescription) Reset() {
 	*x = StatementDescription{}
-	mi := &file_query_proto_msgTypes[4]
+	mi := &file_query_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +560,7 @@ func (x *StatementDescription) String() string {
 func (*StatementDescription) ProtoMessage() {}
 
 func (x *StatementDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[4]
+	mi := &file_query_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +573,7 @@ func (x *StatementDescription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatementDescription.ProtoReflect.Descriptor instead.
 func (*StatementDescription) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{4}
+	return file_query_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StatementDescription) GetParameters() []*ParameterDescription {
@@ -526,7 +601,7 @@ type ParameterDescription struct {
 
 func (x *ParameterDescription) Reset() {
 	*x = ParameterDescription{}
-	mi := &file_query_proto_msgTypes[5]
+	mi := &file_query_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +613,7 @@ func (x *ParameterDescription) String() string {
 func (*ParameterDescription) ProtoMessage() {}
 
 func (x *ParameterDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[5]
+	mi := &file_query_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +626,7 @@ func (x *ParameterDescription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParameterDescription.ProtoReflect.Descriptor instead.
 func (*ParameterDescription) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{5}
+	return file_query_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ParameterDescription) GetDataTypeOid() uint32 {
@@ -581,7 +656,7 @@ type Target struct {
 
 func (x *Target) Reset() {
 	*x = Target{}
-	mi := &file_query_proto_msgTypes[6]
+	mi := &file_query_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +668,7 @@ func (x *Target) String() string {
 func (*Target) ProtoMessage() {}
 
 func (x *Target) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[6]
+	mi := &file_query_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +681,7 @@ func (x *Target) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target.ProtoReflect.Descriptor instead.
 func (*Target) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{6}
+	return file_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Target) GetTableGroup() string {
@@ -649,7 +724,7 @@ type PreparedStatement struct {
 
 func (x *PreparedStatement) Reset() {
 	*x = PreparedStatement{}
-	mi := &file_query_proto_msgTypes[7]
+	mi := &file_query_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +736,7 @@ func (x *PreparedStatement) String() string {
 func (*PreparedStatement) ProtoMessage() {}
 
 func (x *PreparedStatement) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[7]
+	mi := &file_query_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +749,7 @@ func (x *PreparedStatement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreparedStatement.ProtoReflect.Descriptor instead.
 func (*PreparedStatement) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{7}
+	return file_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PreparedStatement) GetName() string {
@@ -729,7 +804,7 @@ type Portal struct {
 
 func (x *Portal) Reset() {
 	*x = Portal{}
-	mi := &file_query_proto_msgTypes[8]
+	mi := &file_query_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +816,7 @@ func (x *Portal) String() string {
 func (*Portal) ProtoMessage() {}
 
 func (x *Portal) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[8]
+	mi := &file_query_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +829,7 @@ func (x *Portal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Portal.ProtoReflect.Descriptor instead.
 func (*Portal) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{8}
+	return file_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Portal) GetName() string {
@@ -819,13 +894,17 @@ type ExecuteOptions struct {
 	// Used for connection pinning - this tells the multipooler which specific
 	// connection to use for executing this query.
 	ReservedConnectionId uint64 `protobuf:"varint,5,opt,name=reserved_connection_id,json=reservedConnectionId,proto3" json:"reserved_connection_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// max_replication_lag_ms is the maximum replication lag, in milliseconds,
+	// a replica may have to serve the query. 0 means no limit. Only applies to
+	// queries targeting a REPLICA pooler.
+	MaxReplicationLagMs uint64 `protobuf:"varint,6,opt,name=max_replication_lag_ms,json=maxReplicationLagMs,proto3" json:"max_replication_lag_ms,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ExecuteOptions) Reset() {
 	*x = ExecuteOptions{}
-	mi := &file_query_proto_msgTypes[9]
+	mi := &file_query_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +916,7 @@ func (x *ExecuteOptions) String() string {
 func (*ExecuteOptions) ProtoMessage() {}
 
 func (x *ExecuteOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[9]
+	mi := &file_query_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +929,7 @@ func (x *ExecuteOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteOptions.ProtoReflect.Descriptor instead.
 func (*ExecuteOptions) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{9}
+	return file_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExecuteOptions) GetSessionSettings() map[string]string {
@@ -881,6 +960,13 @@ func (x *ExecuteOptions) GetReservedConnectionId() uint64 {
 	return 0
 }
 
+func (x *ExecuteOptions) GetMaxReplicationLagMs() uint64 {
+	if x != nil {
+		return x.MaxReplicationLagMs
+	}
+	return 0
+}
+
 var File_query_proto protoreflect.FileDescriptor
 
 const file_query_proto_rawDesc = "" +
@@ -905,7 +991,7 @@ const file_query_proto_rawDesc = "" +
 	"\x06format\x18\b \x01(\x05R\x06format\"7\n" +
 	"\x03Row\x12\x18\n" +
 	"\alengths\x18\x01 \x03(\x12R\alengths\x12\x16\n" +
-	"\x06values\x18\x02 \x01(\fR\x06values\"\xb4\x03\n" +
+	"\x06values\x18\x02 \x01(\fR\x06values\"\xea\x03\n" +
 	"\x06Notice\x12\x1a\n" +
 	"\bseverity\x18\x01 \x01(\tR\bseverity\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
@@ -924,7 +1010,12 @@ const file_query_proto_rawDesc = "" +
 	"\vcolumn_name\x18\f \x01(\tR\n" +
 	"columnName\x12$\n" +
 	"\x0edata_type_name\x18\r \x01(\tR\fdataTypeName\x12'\n" +
-	"\x0fconstraint_name\x18\x0e \x01(\tR\x0econstraintName\"y\n" +
+	"\x0fconstraint_name\x18\x0e \x01(\tR\x0econstraintName\x124\n" +
+	"\x16severity_non_localized\x18\x0f \x01(\tR\x14severityNonLocalized\"T\n" +
+	"\fNotification\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\rR\x03pid\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\tR\apayload\"y\n" +
 	"\x14StatementDescription\x12;\n" +
 	"\n" +
 	"parameters\x18\x01 \x03(\v2\x1b.query.ParameterDescriptionR\n" +
@@ -949,12 +1040,13 @@ const file_query_proto_rawDesc = "" +
 	"\rparam_lengths\x18\x03 \x03(\x12R\fparamLengths\x12!\n" +
 	"\fparam_values\x18\x04 \x01(\fR\vparamValues\x12#\n" +
 	"\rparam_formats\x18\x05 \x03(\x05R\fparamFormats\x12%\n" +
-	"\x0eresult_formats\x18\x06 \x03(\x05R\rresultFormats\"\x90\x02\n" +
+	"\x0eresult_formats\x18\x06 \x03(\x05R\rresultFormats\"\xc5\x02\n" +
 	"\x0eExecuteOptions\x12U\n" +
 	"\x10session_settings\x18\x01 \x03(\v2*.query.ExecuteOptions.SessionSettingsEntryR\x0fsessionSettings\x12\x12\n" +
 	"\x04user\x18\x02 \x01(\tR\x04user\x12\x19\n" +
 	"\bmax_rows\x18\x04 \x01(\x04R\amaxRows\x124\n" +
-	"\x16reserved_connection_id\x18\x05 \x01(\x04R\x14reservedConnectionId\x1aB\n" +
+	"\x16reserved_connection_id\x18\x05 \x01(\x04R\x14reservedConnectionId\x123\n" +
+	"\x16max_replication_lag_ms\x18\x06 \x01(\x04R\x13maxReplicationLagMs\x1aB\n" +
 	"\x14SessionSettingsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B,Z*github.com/multigres/multigres/go/pb/queryb\x06proto3"
@@ -971,29 +1063,30 @@ func file_query_proto_rawDescGZIP() []byte {
 	return file_query_proto_rawDescData
 }
 
-var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_query_proto_goTypes = []any{
 	(*QueryResult)(nil),             // 0: query.QueryResult
 	(*Field)(nil),                   // 1: query.Field
 	(*Row)(nil),                     // 2: query.Row
 	(*Notice)(nil),                  // 3: query.Notice
-	(*StatementDescription)(nil),    // 4: query.StatementDescription
-	(*ParameterDescription)(nil),    // 5: query.ParameterDescription
-	(*Target)(nil),                  // 6: query.Target
-	(*PreparedStatement)(nil),       // 7: query.PreparedStatement
-	(*Portal)(nil),                  // 8: query.Portal
-	(*ExecuteOptions)(nil),          // 9: query.ExecuteOptions
-	nil,                             // 10: query.ExecuteOptions.SessionSettingsEntry
-	(clustermetadata.PoolerType)(0), // 11: clustermetadata.PoolerType
+	(*Notification)(nil),            // 4: query.Notification
+	(*StatementDescription)(nil),    // 5: query.StatementDescription
+	(*ParameterDescription)(nil),    // 6: query.ParameterDescription
+	(*Target)(nil),                  // 7: query.Target
+	(*PreparedStatement)(nil),       // 8: query.PreparedStatement
+	(*Portal)(nil),                  // 9: query.Portal
+	(*ExecuteOptions)(nil),          // 10: query.ExecuteOptions
+	nil,                             // 11: query.ExecuteOptions.SessionSettingsEntry
+	(clustermetadata.PoolerType)(0), // 12: clustermetadata.PoolerType
 }
 var file_query_proto_depIdxs = []int32{
 	1,  // 0: query.QueryResult.fields:type_name -> query.Field
 	2,  // 1: query.QueryResult.rows:type_name -> query.Row
 	3,  // 2: query.QueryResult.notices:type_name -> query.Notice
-	5,  // 3: query.StatementDescription.parameters:type_name -> query.ParameterDescription
+	6,  // 3: query.StatementDescription.parameters:type_name -> query.ParameterDescription
 	1,  // 4: query.StatementDescription.fields:type_name -> query.Field
-	11, // 5: query.Target.pooler_type:type_name -> clustermetadata.PoolerType
-	10, // 6: query.ExecuteOptions.session_settings:type_name -> query.ExecuteOptions.SessionSettingsEntry
+	12, // 5: query.Target.pooler_type:type_name -> clustermetadata.PoolerType
+	11, // 6: query.ExecuteOptions.session_settings:type_name -> query.ExecuteOptions.SessionSettingsEntry
 	7,  // [7:7] is the sub-list for method output_type
 	7,  // [7:7] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
@@ -1012,7 +1105,7 @@ func file_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_query_proto_rawDesc), len(file_query_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,11 +26,14 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// BackupConfig holds backup configuration (local or S3)
+// BackupConfig holds backup configuration (local, S3, GCS or Azure)
 type BackupConfig struct {
-	Type  string       `yaml:"type"` // "local", "s3", "azure", etc.
-	Local *LocalBackup `yaml:"local,omitempty"`
-	S3    *S3Backup    `yaml:"s3,omitempty"`
+	Type      string           `yaml:"type"` // "local", "s3", "gcs" or "azure"
+	Local     *LocalBackup     `yaml:"local,omitempty"`
+	S3        *S3Backup        `yaml:"s3,omitempty"`
+	GCS       *GCSBackup       `yaml:"gcs,omitempty"`
+	Azure     *AzureBackup     `yaml:"azure,omitempty"`
+	Retention *BackupRetention `yaml:"retention,omitempty"`
 }
 
 // LocalBackup holds filesystem backup configuration
@@ -47,6 +50,30 @@ type S3Backup struct {
 	UseEnvCredentials bool   `yaml:"use-env-credentials,omitempty"`
 }
 
+// GCSBackup holds Google Cloud Storage backup configuration
+type GCSBackup struct {
+	Bucket    string `yaml:"bucket"`
+	KeyPrefix string `yaml:"key-prefix,omitempty"`
+	KeyFile   string `yaml:"key-file,omitempty"`
+	Endpoint  string `yaml:"endpoint,omitempty"`
+}
+
+// AzureBackup holds Azure Blob Storage backup configuration
+type AzureBackup struct {
+	Account           string `yaml:"account"`
+	Container         string `yaml:"container"`
+	KeyPrefix         string `yaml:"key-prefix,omitempty"`
+	Endpoint          string `yaml:"endpoint,omitempty"`
+	UseEnvCredentials bool   `yaml:"use-env-credentials,omitempty"`
+}
+
+// BackupRetention holds the backup retention policy. Zero values keep the
+// defaults of the backup location.
+type BackupRetention struct {
+	FullDays          uint32 `yaml:"full-days,omitempty"`
+	DifferentialCount uint32 `yaml:"differential-count,omitempty"`
+}
+
 // CellConfig holds the configuration for a single cell
 type CellConfig struct {
 	Name     string `yaml:"name"`
@@ -223,6 +250,21 @@ func buildBackupConfig(backupConfig map[string]string, baseDir string) BackupCon
 			KeyPrefix:         backupConfig["s3-key-prefix"],
 			UseEnvCredentials: backupConfig["s3-use-env-credentials"] == "true",
 		}
+	case "gcs":
+		config.GCS = &GCSBackup{
+			Bucket:    backupConfig["gcs-bucket"],
+			KeyPrefix: backupConfig["gcs-key-prefix"],
+			KeyFile:   backupConfig["gcs-key-file"],
+			Endpoint:  backupConfig["gcs-endpoint"],
+		}
+	case "azure":
+		config.Azure = &AzureBackup{
+			Account:           backupConfig["azure-account"],
+			Container:         backupConfig["azure-container"],
+			KeyPrefix:         backupConfig["azure-key-prefix"],
+			Endpoint:          backupConfig["azure-endpoint"],
+			UseEnvCredentials: backupConfig["azure-use-env-credentials"] == "true",
+		}
 	}
 
 	return config
@@ -98,6 +98,40 @@ func TestBuildBackupConfig_S3UseEnvCredentials(t *testing.T) {
 	}
 }
 
+func TestBuildBackupConfig_ObjectStores(t *testing.T) {
+	t.Run("gcs", func(t *testing.T) {
+		result := buildBackupConfig(map[string]string{
+			"type":           "gcs",
+			"gcs-bucket":     "test-bucket",
+			"gcs-key-prefix": "backups/",
+			"gcs-key-file":   "/etc/gcs/key.json",
+		}, "/tmp/test")
+		assert.Equal(t, "gcs", result.Type)
+		assert.Equal(t, &GCSBackup{
+			Bucket:    "test-bucket",
+			KeyPrefix: "backups/",
+			KeyFile:   "/etc/gcs/key.json",
+		}, result.GCS)
+		assert.Nil(t, result.S3)
+	})
+
+	t.Run("azure", func(t *testing.T) {
+		result := buildBackupConfig(map[string]string{
+			"type":                      "azure",
+			"azure-account":             "myaccount",
+			"azure-container":           "backups",
+			"azure-use-env-credentials": "true",
+		}, "/tmp/test")
+		assert.Equal(t, "azure", result.Type)
+		assert.Equal(t, &AzureBackup{
+			Account:           "myaccount",
+			Container:         "backups",
+			UseEnvCredentials: true,
+		}, result.Azure)
+		assert.Nil(t, result.GCS)
+	})
+}
+
 func TestBuildBackupConfig_NestedStructs(t *testing.T) {
 	tests := []struct {
 		name      string
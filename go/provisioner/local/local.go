@@ -467,6 +467,7 @@ func (p *localProvisioner) provisionMultigateway(ctx context.Context, req *provi
 	args := []string{
 		"--http-port", strconv.Itoa(httpPort),
 		"--grpc-port", strconv.Itoa(grpcPort),
+		"--grpc-insecure",
 		"--pg-port", strconv.Itoa(pgPort),
 		"--topo-global-server-addresses", etcdAddress,
 		"--topo-global-root", topoGlobalRoot,
@@ -601,6 +602,7 @@ func (p *localProvisioner) provisionMultiadmin(ctx context.Context, req *provisi
 	args := []string{
 		"--http-port", strconv.Itoa(httpPort),
 		"--grpc-port", strconv.Itoa(grpcPort),
+		"--grpc-insecure",
 		"--topo-global-server-addresses", etcdAddress,
 		"--topo-global-root", topoGlobalRoot,
 		"--log-level", logLevel,
@@ -792,6 +794,7 @@ func (p *localProvisioner) provisionMultipooler(ctx context.Context, req *provis
 	args := []string{
 		"--http-port", strconv.Itoa(httpPort),
 		"--grpc-port", strconv.Itoa(grpcPort),
+		"--grpc-insecure",
 		"--topo-global-server-addresses", etcdAddress,
 		"--topo-global-root", topoGlobalRoot,
 		"--cell", cell,
@@ -972,6 +975,7 @@ func (p *localProvisioner) provisionMultiOrch(ctx context.Context, req *provisio
 	args := []string{
 		"--http-port", strconv.Itoa(httpPort),
 		"--grpc-port", strconv.Itoa(grpcPort),
+		"--grpc-insecure",
 		"--topo-global-server-addresses", etcdAddress,
 		"--topo-global-root", topoGlobalRoot,
 		"--cell", cell,
@@ -1758,6 +1762,22 @@ func (p *localProvisioner) ProvisionDatabase(ctx context.Context, databaseName s
 
 // buildBackupLocation creates a BackupLocation proto from config
 func (p *localProvisioner) buildBackupLocation() (*clustermetadatapb.BackupLocation, error) {
+	location, err := p.buildBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	if r := p.config.Backup.Retention; r != nil {
+		location.Retention = &clustermetadatapb.BackupRetention{
+			FullDays:          r.FullDays,
+			DifferentialCount: r.DifferentialCount,
+		}
+	}
+	return location, nil
+}
+
+// buildBackupStorage creates the storage part of the BackupLocation proto
+func (p *localProvisioner) buildBackupStorage() (*clustermetadatapb.BackupLocation, error) {
 	switch p.config.Backup.Type {
 	case "":
 		// No backup type configured - use default filesystem backup location
@@ -1809,6 +1829,45 @@ func (p *localProvisioner) buildBackupLocation() (*clustermetadatapb.BackupLocat
 			},
 		}, nil
 
+	case "gcs":
+		if p.config.Backup.GCS == nil {
+			return nil, errors.New("GCS backup not configured")
+		}
+		if p.config.Backup.GCS.Bucket == "" {
+			return nil, errors.New("GCS bucket not configured")
+		}
+
+		return &clustermetadatapb.BackupLocation{
+			Location: &clustermetadatapb.BackupLocation_Gcs{
+				Gcs: &clustermetadatapb.GCSBackup{
+					Bucket:    p.config.Backup.GCS.Bucket,
+					KeyPrefix: p.config.Backup.GCS.KeyPrefix,
+					KeyFile:   p.config.Backup.GCS.KeyFile,
+					Endpoint:  p.config.Backup.GCS.Endpoint,
+				},
+			},
+		}, nil
+
+	case "azure":
+		if p.config.Backup.Azure == nil {
+			return nil, errors.New("Azure backup not configured")
+		}
+		if p.config.Backup.Azure.Account == "" || p.config.Backup.Azure.Container == "" {
+			return nil, errors.New("Azure account and container must be configured")
+		}
+
+		return &clustermetadatapb.BackupLocation{
+			Location: &clustermetadatapb.BackupLocation_Azure{
+				Azure: &clustermetadatapb.AzureBackup{
+					Account:           p.config.Backup.Azure.Account,
+					Container:         p.config.Backup.Azure.Container,
+					KeyPrefix:         p.config.Backup.Azure.KeyPrefix,
+					Endpoint:          p.config.Backup.Azure.Endpoint,
+					UseEnvCredentials: p.config.Backup.Azure.UseEnvCredentials,
+				},
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown backup type: %s", p.config.Backup.Type)
 	}
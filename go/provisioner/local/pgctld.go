@@ -250,6 +250,7 @@ func (p *localProvisioner) provisionPgctld(ctx context.Context, dbName, tableGro
 		"server",
 		"--pooler-dir", poolerDir,
 		"--grpc-port", strconv.Itoa(grpcPort),
+		"--grpc-insecure",
 		"--pg-port", strconv.Itoa(pgPort),
 		"--pg-database", pgDatabase,
 		"--pg-user", pgUser,
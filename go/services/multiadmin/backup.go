@@ -157,7 +157,9 @@ func (s *MultiAdminServer) RestoreFromBackup(ctx context.Context, req *multiadmi
 		"table_group", req.TableGroup,
 		"shard", req.Shard,
 		"backup_id", req.BackupId,
-		"pooler_id", req.PoolerId)
+		"pooler_id", req.PoolerId,
+		"target_time", req.TargetTime,
+		"target_lsn", req.TargetLsn)
 
 	// Validate request
 	if req.Database == "" {
@@ -175,6 +177,9 @@ func (s *MultiAdminServer) RestoreFromBackup(ctx context.Context, req *multiadmi
 	if req.PoolerId == nil {
 		return nil, status.Error(codes.InvalidArgument, "pooler_id cannot be empty")
 	}
+	if req.TargetTime != nil && req.TargetLsn != "" {
+		return nil, status.Error(codes.InvalidArgument, "only one of target_time and target_lsn can be set")
+	}
 
 	// Create job
 	jobID := s.backupJobTracker.CreateJob(multiadminpb.JobType_JOB_TYPE_RESTORE, req.Database, req.TableGroup, req.Shard)
@@ -216,7 +221,9 @@ func (s *MultiAdminServer) executeRestore(ctx context.Context, jobID string, req
 
 	// Call restore on the pooler
 	restoreReq := &multipoolermanagerdata.RestoreFromBackupRequest{
-		BackupId: req.BackupId,
+		BackupId:   req.BackupId,
+		TargetTime: req.TargetTime,
+		TargetLsn:  req.TargetLsn,
 	}
 
 	_, err = s.rpcClient.RestoreFromBackup(ctx, poolerInfo.MultiPooler, restoreReq)
@@ -372,6 +379,7 @@ func (s *MultiAdminServer) GetBackups(ctx context.Context, req *multiadminpb.Get
 			BackupSizeBytes:      b.BackupSizeBytes,
 			MultipoolerServiceId: b.MultipoolerId,
 			PoolerType:           b.PoolerType,
+			PriorBackupId:        b.PriorBackupId,
 		}
 	}
 
@@ -381,3 +389,83 @@ func (s *MultiAdminServer) GetBackups(ctx context.Context, req *multiadminpb.Get
 		Backups: backups,
 	}, nil
 }
+
+// VerifyBackup checks the integrity of an existing backup
+func (s *MultiAdminServer) VerifyBackup(ctx context.Context, req *multiadminpb.VerifyBackupRequest) (*multiadminpb.VerifyBackupResponse, error) {
+	s.logger.DebugContext(ctx, "VerifyBackup request received",
+		"database", req.Database,
+		"table_group", req.TableGroup,
+		"shard", req.Shard,
+		"backup_id", req.BackupId)
+
+	pooler, err := s.findPoolerForBackupID(ctx, req.Database, req.TableGroup, req.Shard, req.BackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.rpcClient.VerifyBackup(ctx, pooler, &multipoolermanagerdata.VerifyBackupRequest{
+		BackupId: req.BackupId,
+	})
+	if err != nil {
+		return nil, status.Errorf(poolerErrorCode(err), "failed to verify backup on pooler: %v", err)
+	}
+
+	return &multiadminpb.VerifyBackupResponse{
+		Output: resp.Output,
+	}, nil
+}
+
+// DeleteBackup removes a backup from the shard's backup repository
+func (s *MultiAdminServer) DeleteBackup(ctx context.Context, req *multiadminpb.DeleteBackupRequest) (*multiadminpb.DeleteBackupResponse, error) {
+	s.logger.InfoContext(ctx, "DeleteBackup request received",
+		"database", req.Database,
+		"table_group", req.TableGroup,
+		"shard", req.Shard,
+		"backup_id", req.BackupId)
+
+	pooler, err := s.findPoolerForBackupID(ctx, req.Database, req.TableGroup, req.Shard, req.BackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.rpcClient.DeleteBackup(ctx, pooler, &multipoolermanagerdata.DeleteBackupRequest{
+		BackupId: req.BackupId,
+	})
+	if err != nil {
+		return nil, status.Errorf(poolerErrorCode(err), "failed to delete backup on pooler: %v", err)
+	}
+
+	return &multiadminpb.DeleteBackupResponse{}, nil
+}
+
+// findPoolerForBackupID validates a request that targets a single backup and
+// returns a replica pooler that can reach the shard's backup repository.
+func (s *MultiAdminServer) findPoolerForBackupID(ctx context.Context, database, tableGroup, shard, backupID string) (*clustermetadatapb.MultiPooler, error) {
+	if database == "" {
+		return nil, status.Error(codes.InvalidArgument, "database cannot be empty")
+	}
+	if tableGroup == "" {
+		return nil, status.Error(codes.InvalidArgument, "table_group cannot be empty")
+	}
+	if backupID == "" {
+		return nil, status.Error(codes.InvalidArgument, "backup_id cannot be empty")
+	}
+
+	// All replicas for a shard share the same pgbackrest repo
+	pooler, err := s.findPoolerForBackup(ctx, database, tableGroup, shard, false)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to find replica pooler: %v", err)
+	}
+	return pooler, nil
+}
+
+// poolerErrorCode keeps the status code reported by the pooler so that
+// callers can tell a missing backup from a failed operation.
+func poolerErrorCode(err error) codes.Code {
+	switch code := status.Code(err); code {
+	case codes.NotFound, codes.InvalidArgument:
+		return code
+	default:
+		return codes.Internal
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/multigres/multigres/go/common/rpcclient"
 	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
@@ -108,6 +109,18 @@ func TestRestoreFromBackup_ValidationErrors(t *testing.T) {
 			req:     &multiadminpb.RestoreFromBackupRequest{Database: "postgres", TableGroup: "test", PoolerId: nil},
 			wantErr: codes.InvalidArgument,
 		},
+		{
+			name: "both recovery targets",
+			req: &multiadminpb.RestoreFromBackupRequest{
+				Database:   "postgres",
+				TableGroup: "test",
+				Shard:      "0",
+				PoolerId:   &clustermetadatapb.ID{Cell: "cell1", Name: "replica-pooler"},
+				TargetTime: timestamppb.Now(),
+				TargetLsn:  "0/3000060",
+			},
+			wantErr: codes.InvalidArgument,
+		},
 	}
 
 	for _, tt := range tests {
@@ -389,3 +402,76 @@ func TestBackup_ForcePrimary(t *testing.T) {
 		require.Contains(t, err.Error(), "replica pooler not found")
 	})
 }
+
+func TestVerifyAndDeleteBackup(t *testing.T) {
+	ctx := t.Context()
+	ts := memorytopo.NewServer(ctx, "cell1")
+	logger := slog.Default()
+	server := NewMultiAdminServer(ts, logger)
+	defer server.backupJobTracker.Stop()
+
+	replicaPooler := &clustermetadatapb.MultiPooler{
+		Id: &clustermetadatapb.ID{
+			Component: clustermetadatapb.ID_MULTIPOOLER,
+			Cell:      "cell1",
+			Name:      "replica-pooler",
+		},
+		Hostname:   "replica-pooler.cell1",
+		PortMap:    map[string]int32{"grpc": 8081},
+		Database:   "testdb",
+		TableGroup: "default",
+		Type:       clustermetadatapb.PoolerType_REPLICA,
+	}
+	require.NoError(t, ts.CreateMultiPooler(ctx, replicaPooler))
+
+	fakeClient := rpcclient.NewFakeClient()
+	poolerKey := "multipooler-cell1-replica-pooler"
+	fakeClient.VerifyBackupResponses[poolerKey] = &multipoolermanagerdata.VerifyBackupResponse{
+		Output: "verify command end: completed successfully",
+	}
+	server.SetRPCClient(fakeClient)
+
+	t.Run("validation errors", func(t *testing.T) {
+		_, err := server.VerifyBackup(ctx, &multiadminpb.VerifyBackupRequest{Database: "testdb", TableGroup: "default"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = server.DeleteBackup(ctx, &multiadminpb.DeleteBackupRequest{TableGroup: "default", BackupId: "20251203-143045F"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("verify returns pooler output", func(t *testing.T) {
+		resp, err := server.VerifyBackup(ctx, &multiadminpb.VerifyBackupRequest{
+			Database:   "testdb",
+			TableGroup: "default",
+			Shard:      "0",
+			BackupId:   "20251203-143045F",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "verify command end: completed successfully", resp.Output)
+		require.Contains(t, fakeClient.GetCallLog(), "VerifyBackup(multipooler-cell1-replica-pooler)")
+	})
+
+	t.Run("delete forwards to pooler", func(t *testing.T) {
+		_, err := server.DeleteBackup(ctx, &multiadminpb.DeleteBackupRequest{
+			Database:   "testdb",
+			TableGroup: "default",
+			Shard:      "0",
+			BackupId:   "20251203-143045F",
+		})
+		require.NoError(t, err)
+		require.Contains(t, fakeClient.GetCallLog(), "DeleteBackup(multipooler-cell1-replica-pooler)")
+	})
+
+	t.Run("not found from pooler is preserved", func(t *testing.T) {
+		fakeClient.Errors[poolerKey] = status.Error(codes.NotFound, "backup 20251203-000000F not found")
+		defer delete(fakeClient.Errors, poolerKey)
+
+		_, err := server.DeleteBackup(ctx, &multiadminpb.DeleteBackupRequest{
+			Database:   "testdb",
+			TableGroup: "default",
+			Shard:      "0",
+			BackupId:   "20251203-000000F",
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
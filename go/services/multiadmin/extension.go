@@ -0,0 +1,178 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiadmin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+// extensionShard holds the poolers of a shard of a database.
+type extensionShard struct {
+	tableGroup string
+	shard      string
+	primary    *clustermetadatapb.MultiPooler
+	poolers    []*clustermetadatapb.MultiPooler
+}
+
+// InstallExtension creates or updates an extension on every shard of a
+// database. Every pooler, replicas included, first checks that the files of
+// the extension are installed, and nothing is installed unless all of them
+// are: a replica missing the shared library of an extension would fail as
+// soon as it replays the extension's creation and a query uses it. The
+// extension is then created on the primary of every shard, and replicates.
+//
+// Creating an extension is idempotent, so a partially failed install can be
+// retried.
+func (s *MultiAdminServer) InstallExtension(ctx context.Context, req *multiadminpb.InstallExtensionRequest) (*multiadminpb.InstallExtensionResponse, error) {
+	s.logger.InfoContext(ctx, "InstallExtension request received",
+		"database", req.Database,
+		"name", req.Name,
+		"version", req.Version,
+		"check_only", req.CheckOnly)
+
+	if req.Database == "" {
+		return nil, status.Error(codes.InvalidArgument, "database cannot be empty")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+
+	shards, err := s.databaseShards(ctx, req.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get poolers: %v", err)
+	}
+	if len(shards) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no poolers found for database=%s", req.Database)
+	}
+
+	// Step 1: check every pooler before changing anything.
+	resp := &multiadminpb.InstallExtensionResponse{}
+	var failed []string
+	for _, shard := range shards {
+		if shard.primary == nil {
+			failed = append(failed, fmt.Sprintf("%s/%s: no primary", shard.tableGroup, shard.shard))
+		}
+		result := &multiadminpb.ExtensionShardResult{TableGroup: shard.tableGroup, Shard: shard.shard}
+		for _, pooler := range shard.poolers {
+			checkResp, err := s.rpcClient.InstallExtension(ctx, pooler, &multipoolermanagerdatapb.InstallExtensionRequest{
+				Name:      req.Name,
+				Version:   req.Version,
+				CheckOnly: true,
+			})
+			if err != nil {
+				s.logger.WarnContext(ctx, "Extension cannot be installed on pooler",
+					"pooler", pooler.Id.Name,
+					"extension", req.Name,
+					"error", err)
+				failed = append(failed, fmt.Sprintf("%s: %v", pooler.Id.Name, err))
+				continue
+			}
+			if pooler == shard.primary {
+				result.OldVersion = checkResp.OldVersion
+				result.NewVersion = checkResp.NewVersion
+			}
+		}
+		resp.Shards = append(resp.Shards, result)
+	}
+	if len(failed) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "extension %s cannot be installed: %s", req.Name, strings.Join(failed, "; "))
+	}
+	if req.CheckOnly {
+		return resp, nil
+	}
+
+	// Step 2: install on the primaries.
+	for i, shard := range shards {
+		installResp, err := s.rpcClient.InstallExtension(ctx, shard.primary, &multipoolermanagerdatapb.InstallExtensionRequest{
+			Name:    req.Name,
+			Version: req.Version,
+			Schema:  req.Schema,
+			Cascade: req.Cascade,
+		})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to install extension",
+				"table_group", shard.tableGroup,
+				"shard", shard.shard,
+				"extension", req.Name,
+				"error", err)
+			failed = append(failed, fmt.Sprintf("%s/%s: %v", shard.tableGroup, shard.shard, err))
+			continue
+		}
+		resp.Shards[i].OldVersion = installResp.OldVersion
+		resp.Shards[i].NewVersion = installResp.NewVersion
+	}
+	if len(failed) > 0 {
+		return nil, status.Errorf(codes.Internal, "failed to install extension %s: %s", req.Name, strings.Join(failed, "; "))
+	}
+
+	s.logger.InfoContext(ctx, "Extension installed", "database", req.Database, "extension", req.Name, "shards", len(resp.Shards))
+	return resp, nil
+}
+
+// databaseShards returns the poolers of every shard of a database in all
+// cells, sorted by table group and shard.
+func (s *MultiAdminServer) databaseShards(ctx context.Context, database string) ([]*extensionShard, error) {
+	allCells, err := s.ts.GetCellNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cell names: %w", err)
+	}
+
+	byKey := map[[2]string]*extensionShard{}
+	for _, cellName := range allCells {
+		opts := &topoclient.GetMultiPoolersByCellOptions{
+			DatabaseShard: &topoclient.DatabaseShard{Database: database},
+		}
+		poolerInfos, err := s.ts.GetMultiPoolersByCell(ctx, cellName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get poolers for cell %s: %w", cellName, err)
+		}
+		for _, info := range poolerInfos {
+			pooler := info.MultiPooler
+			key := [2]string{pooler.TableGroup, pooler.Shard}
+			shard, ok := byKey[key]
+			if !ok {
+				shard = &extensionShard{tableGroup: pooler.TableGroup, shard: pooler.Shard}
+				byKey[key] = shard
+			}
+			shard.poolers = append(shard.poolers, pooler)
+			if pooler.Type == clustermetadatapb.PoolerType_PRIMARY {
+				shard.primary = pooler
+			}
+		}
+	}
+
+	shards := make([]*extensionShard, 0, len(byKey))
+	for _, shard := range byKey {
+		shards = append(shards, shard)
+	}
+	slices.SortFunc(shards, func(a, b *extensionShard) int {
+		if c := strings.Compare(a.tableGroup, b.tableGroup); c != 0 {
+			return c
+		}
+		return strings.Compare(a.shard, b.shard)
+	})
+	return shards, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiadmin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/rpcclient"
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+func TestInstallExtension_Validation(t *testing.T) {
+	server := NewMultiAdminServer(nil, slog.Default())
+	defer server.Stop()
+
+	for _, tt := range []struct {
+		req *multiadminpb.InstallExtensionRequest
+		msg string
+	}{
+		{req: &multiadminpb.InstallExtensionRequest{Name: "postgis"}, msg: "database cannot be empty"},
+		{req: &multiadminpb.InstallExtensionRequest{Database: "postgres"}, msg: "name cannot be empty"},
+	} {
+		_, err := server.InstallExtension(t.Context(), tt.req)
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+		require.Contains(t, st.Message(), tt.msg)
+	}
+}
+
+// setupExtensionShards creates a primary and a replica for two shards of the
+// postgres database.
+func setupExtensionShards(t *testing.T, ctx context.Context) topoclient.Store {
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	t.Cleanup(func() { ts.Close() })
+
+	for i, name := range []string{"a-primary", "a-replica", "b-primary", "b-replica"} {
+		pooler := &clustermetadatapb.MultiPooler{
+			Id: &clustermetadatapb.ID{
+				Component: clustermetadatapb.ID_MULTIPOOLER,
+				Cell:      "zone1",
+				Name:      name,
+			},
+			Database:   "postgres",
+			TableGroup: "default",
+			Shard:      "0-80",
+			Type:       clustermetadatapb.PoolerType_REPLICA,
+			Hostname:   "localhost",
+			PortMap:    map[string]int32{"grpc": int32(9000 + i)},
+		}
+		if i >= 2 {
+			pooler.Shard = "80-inf"
+		}
+		if i%2 == 0 {
+			pooler.Type = clustermetadatapb.PoolerType_PRIMARY
+		}
+		require.NoError(t, ts.CreateMultiPooler(ctx, pooler))
+	}
+	return ts
+}
+
+func TestInstallExtension_Success(t *testing.T) {
+	ctx := t.Context()
+	ts := setupExtensionShards(t, ctx)
+
+	fakeClient := rpcclient.NewFakeClient()
+	for _, name := range []string{"multipooler-zone1-a-primary", "multipooler-zone1-b-primary"} {
+		fakeClient.InstallExtensionResponses[name] = &multipoolermanagerdatapb.InstallExtensionResponse{NewVersion: "3.5.0"}
+	}
+
+	server := NewMultiAdminServer(ts, slog.Default())
+	defer server.Stop()
+	server.SetRPCClient(fakeClient)
+
+	resp, err := server.InstallExtension(ctx, &multiadminpb.InstallExtensionRequest{
+		Database: "postgres",
+		Name:     "postgis",
+		Cascade:  true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Shards, 2)
+	require.Equal(t, "0-80", resp.Shards[0].Shard)
+	require.Equal(t, "80-inf", resp.Shards[1].Shard)
+	require.Equal(t, "3.5.0", resp.Shards[1].NewVersion)
+
+	// The replicas are only checked, the primaries are installed last.
+	require.True(t, fakeClient.InstallExtensionRequests["multipooler-zone1-a-replica"].CheckOnly)
+	require.False(t, fakeClient.InstallExtensionRequests["multipooler-zone1-a-primary"].CheckOnly)
+	require.True(t, fakeClient.InstallExtensionRequests["multipooler-zone1-b-primary"].Cascade)
+	require.Len(t, fakeClient.GetCallLog(), 6)
+}
+
+func TestInstallExtension_MissingOnReplica(t *testing.T) {
+	ctx := t.Context()
+	ts := setupExtensionShards(t, ctx)
+
+	fakeClient := rpcclient.NewFakeClient()
+	fakeClient.Errors["multipooler-zone1-b-replica"] = errors.New("shared library $libdir/postgis-3 of extension postgis not found")
+
+	server := NewMultiAdminServer(ts, slog.Default())
+	defer server.Stop()
+	server.SetRPCClient(fakeClient)
+
+	_, err := server.InstallExtension(ctx, &multiadminpb.InstallExtensionRequest{
+		Database: "postgres",
+		Name:     "postgis",
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Contains(t, st.Message(), "b-replica")
+
+	// Nothing was installed.
+	for _, req := range fakeClient.InstallExtensionRequests {
+		require.True(t, req.CheckOnly)
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/multigres/multigres/go/common/constants"
+	"github.com/multigres/multigres/go/common/rpcclient"
 	"github.com/multigres/multigres/go/common/servenv"
 	"github.com/multigres/multigres/go/common/topoclient"
 	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
@@ -42,7 +44,9 @@ type MultiAdmin struct {
 	senv *servenv.ServEnv
 
 	// topoConfig holds topology configuration
-	topoConfig   *topoclient.TopoConfig
+	topoConfig *topoclient.TopoConfig
+	// connConfig holds multipooler RPC client configuration
+	connConfig   *rpcclient.ConnConfig
 	ts           topoclient.Store
 	serverStatus Status
 }
@@ -61,6 +65,7 @@ func NewMultiAdmin() *MultiAdmin {
 		grpcServer: servenv.NewGrpcServer(reg),
 		senv:       servenv.NewServEnv(reg),
 		topoConfig: topoclient.NewTopoConfig(reg),
+		connConfig: rpcclient.NewConnConfig(reg),
 		serverStatus: Status{
 			Title: "Multiadmin",
 			Links: []Link{
@@ -78,6 +83,7 @@ func (ma *MultiAdmin) RegisterFlags(fs *pflag.FlagSet) {
 	ma.senv.RegisterFlags(fs)
 	ma.grpcServer.RegisterFlags(fs)
 	ma.topoConfig.RegisterFlags(fs)
+	ma.connConfig.RegisterFlags(fs)
 }
 
 // Init initializes the multiadmin. If any services fail to start,
@@ -98,6 +104,11 @@ func (ma *MultiAdmin) Init(ctx context.Context) error {
 		return fmt.Errorf("topo open: %w", err)
 	}
 
+	creds, err := ma.connConfig.TransportCredentials()
+	if err != nil {
+		return err
+	}
+
 	logger.InfoContext(ctx, "multiadmin starting up",
 		"http_port", ma.senv.GetHTTPPort(),
 		"grpc_port", ma.grpcServer.Port(),
@@ -107,6 +118,7 @@ func (ma *MultiAdmin) Init(ctx context.Context) error {
 		// Register multiadmin gRPC and HTTP API services if enabled in service map
 		if ma.grpcServer.CheckServiceMap(constants.ServiceMultiadmin, ma.senv) {
 			ma.adminServer = NewMultiAdminServer(ma.ts, logger)
+			ma.adminServer.SetRPCClient(rpcclient.NewMultiPoolerClient(100, grpc.WithTransportCredentials(creds)))
 			ma.adminServer.RegisterWithGRPCServer(ma.grpcServer.Server)
 
 			// Set up grpc-gateway for REST API
@@ -0,0 +1,112 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiadmin
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/topoclient"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	multiorchdatapb "github.com/multigres/multigres/go/pb/multiorchdata"
+	"github.com/multigres/multigres/go/services/multiorch/coordinator"
+)
+
+// PlannedReparentShard moves the primary of a shard to one of its replicas.
+// The switchover runs synchronously under the shard lock; see
+// coordinator.PlannedReparent for the steps involved.
+func (s *MultiAdminServer) PlannedReparentShard(ctx context.Context, req *multiadminpb.PlannedReparentShardRequest) (*multiadminpb.PlannedReparentShardResponse, error) {
+	s.logger.InfoContext(ctx, "PlannedReparentShard request received",
+		"database", req.Database,
+		"table_group", req.TableGroup,
+		"shard", req.Shard,
+		"new_primary", topoclient.ClusterIDString(req.NewPrimary))
+
+	if req.Database == "" {
+		return nil, status.Error(codes.InvalidArgument, "database cannot be empty")
+	}
+	if req.TableGroup == "" {
+		return nil, status.Error(codes.InvalidArgument, "table_group cannot be empty")
+	}
+	if req.Shard == "" {
+		return nil, status.Error(codes.InvalidArgument, "shard cannot be empty")
+	}
+
+	cohort, err := s.shardCohort(ctx, req.Database, req.TableGroup, req.Shard)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get poolers: %v", err)
+	}
+	if len(cohort) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no poolers found for database=%s, table_group=%s, shard=%s", req.Database, req.TableGroup, req.Shard)
+	}
+
+	coordID := &clustermetadatapb.ID{
+		Component: clustermetadatapb.ID_UNKNOWN,
+		Name:      "multiadmin",
+	}
+	c := coordinator.NewCoordinator(coordID, s.ts, s.rpcClient, s.logger)
+	shardKey := commontypes.ShardKey{
+		Database:   req.Database,
+		TableGroup: req.TableGroup,
+		Shard:      req.Shard,
+	}
+	result, err := c.PlannedReparent(ctx, shardKey, cohort, req.NewPrimary, req.DrainTimeout.AsDuration())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Planned reparent failed", "shard_key", shardKey.String(), "error", err)
+		return nil, mterrors.ToGRPC(err)
+	}
+
+	return &multiadminpb.PlannedReparentShardResponse{
+		PreviousPrimary: result.PreviousPrimary.MultiPooler.Id,
+		NewPrimary:      result.NewPrimary.MultiPooler.Id,
+		Term:            result.Term,
+	}, nil
+}
+
+// shardCohort returns the poolers of a shard across all cells.
+func (s *MultiAdminServer) shardCohort(ctx context.Context, database, tableGroup, shard string) ([]*multiorchdatapb.PoolerHealthState, error) {
+	allCells, err := s.ts.GetCellNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cell names: %w", err)
+	}
+
+	var cohort []*multiorchdatapb.PoolerHealthState
+	for _, cellName := range allCells {
+		opts := &topoclient.GetMultiPoolersByCellOptions{
+			DatabaseShard: &topoclient.DatabaseShard{
+				Database:   database,
+				TableGroup: tableGroup,
+			},
+		}
+		poolerInfos, err := s.ts.GetMultiPoolersByCell(ctx, cellName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get poolers for cell %s: %w", cellName, err)
+		}
+		for _, info := range poolerInfos {
+			// Multipoolers that don't set Shard belong to the only shard.
+			if info.MultiPooler.Shard != "" && info.MultiPooler.Shard != shard {
+				continue
+			}
+			cohort = append(cohort, &multiorchdatapb.PoolerHealthState{MultiPooler: info.MultiPooler})
+		}
+	}
+	return cohort, nil
+}
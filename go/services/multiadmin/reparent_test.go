@@ -0,0 +1,151 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiadmin
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/rpcclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	consensusdatapb "github.com/multigres/multigres/go/pb/consensusdata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+func TestPlannedReparentShard_Validation(t *testing.T) {
+	server := NewMultiAdminServer(nil, slog.Default())
+	defer server.Stop()
+
+	tests := []struct {
+		name string
+		req  *multiadminpb.PlannedReparentShardRequest
+		msg  string
+	}{
+		{
+			name: "missing database",
+			req:  &multiadminpb.PlannedReparentShardRequest{TableGroup: "default", Shard: "0-inf"},
+			msg:  "database cannot be empty",
+		},
+		{
+			name: "missing table group",
+			req:  &multiadminpb.PlannedReparentShardRequest{Database: "postgres", Shard: "0-inf"},
+			msg:  "table_group cannot be empty",
+		},
+		{
+			name: "missing shard",
+			req:  &multiadminpb.PlannedReparentShardRequest{Database: "postgres", TableGroup: "default"},
+			msg:  "shard cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.PlannedReparentShard(t.Context(), tt.req)
+			require.Error(t, err)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Contains(t, st.Message(), tt.msg)
+		})
+	}
+}
+
+func TestPlannedReparentShard_NoPoolers(t *testing.T) {
+	ts, _ := memorytopo.NewServerAndFactory(t.Context(), "zone1")
+	defer ts.Close()
+
+	server := NewMultiAdminServer(ts, slog.Default())
+	defer server.Stop()
+
+	_, err := server.PlannedReparentShard(t.Context(), &multiadminpb.PlannedReparentShardRequest{
+		Database:   "postgres",
+		TableGroup: "default",
+		Shard:      "0-inf",
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestPlannedReparentShard_Success(t *testing.T) {
+	ctx := t.Context()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	fakeClient := rpcclient.NewFakeClient()
+	for i, name := range []string{"primary", "replica1", "replica2"} {
+		pooler := &clustermetadatapb.MultiPooler{
+			Id: &clustermetadatapb.ID{
+				Component: clustermetadatapb.ID_MULTIPOOLER,
+				Cell:      "zone1",
+				Name:      name,
+			},
+			Database:   "postgres",
+			TableGroup: "default",
+			Shard:      "0-inf",
+			Type:       clustermetadatapb.PoolerType_REPLICA,
+			Hostname:   "localhost",
+			PortMap:    map[string]int32{"grpc": int32(9000 + i)},
+		}
+		role := "standby"
+		if i == 0 {
+			pooler.Type = clustermetadatapb.PoolerType_PRIMARY
+			role = "primary"
+		}
+		require.NoError(t, ts.CreateMultiPooler(ctx, pooler))
+
+		key := "multipooler-zone1-" + name
+		fakeClient.ConsensusStatusResponses[key] = &consensusdatapb.StatusResponse{
+			CurrentTerm: 3,
+			IsHealthy:   true,
+			Role:        role,
+			WalPosition: &consensusdatapb.WALPosition{
+				CurrentLsn:     "0/1000000",
+				LastReceiveLsn: "0/1000000",
+				LastReplayLsn:  "0/1000000",
+			},
+		}
+		fakeClient.BeginTermResponses[key] = &consensusdatapb.BeginTermResponse{Accepted: true}
+		fakeClient.StateResponses[key] = &multipoolermanagerdatapb.StateResponse{State: "ready"}
+		fakeClient.PromoteResponses[key] = &multipoolermanagerdatapb.PromoteResponse{}
+		fakeClient.SetPrimaryConnInfoResponses[key] = &multipoolermanagerdatapb.SetPrimaryConnInfoResponse{}
+	}
+
+	server := NewMultiAdminServer(ts, slog.Default())
+	defer server.Stop()
+	server.SetRPCClient(fakeClient)
+
+	resp, err := server.PlannedReparentShard(ctx, &multiadminpb.PlannedReparentShardRequest{
+		Database:   "postgres",
+		TableGroup: "default",
+		Shard:      "0-inf",
+		NewPrimary: &clustermetadatapb.ID{Cell: "zone1", Name: "replica2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "primary", resp.PreviousPrimary.Name)
+	require.Equal(t, "replica2", resp.NewPrimary.Name)
+	require.Equal(t, int64(4), resp.Term)
+
+	info, err := ts.GetMultiPooler(ctx, resp.NewPrimary)
+	require.NoError(t, err)
+	require.Equal(t, clustermetadatapb.PoolerType_PRIMARY, info.Type)
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiadmin
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	"github.com/multigres/multigres/go/services/multiorch/coordinator"
+)
+
+// UpgradeShard runs a step of a PostgreSQL major version upgrade of a shard.
+// The step runs synchronously under the shard lock; see
+// coordinator.UpgradeShard for how the poolers are upgraded.
+func (s *MultiAdminServer) UpgradeShard(ctx context.Context, req *multiadminpb.UpgradeShardRequest) (*multiadminpb.UpgradeShardResponse, error) {
+	s.logger.InfoContext(ctx, "UpgradeShard request received",
+		"database", req.Database,
+		"table_group", req.TableGroup,
+		"shard", req.Shard,
+		"new_bin_dir", req.NewBinDir,
+		"action", req.Action.String())
+
+	if req.Database == "" {
+		return nil, status.Error(codes.InvalidArgument, "database cannot be empty")
+	}
+	if req.TableGroup == "" {
+		return nil, status.Error(codes.InvalidArgument, "table_group cannot be empty")
+	}
+	if req.Shard == "" {
+		return nil, status.Error(codes.InvalidArgument, "shard cannot be empty")
+	}
+	switch req.Action {
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_UPGRADE, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK:
+		if req.NewBinDir == "" {
+			return nil, status.Error(codes.InvalidArgument, "new_bin_dir cannot be empty")
+		}
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown action %v", req.Action)
+	}
+
+	cohort, err := s.shardCohort(ctx, req.Database, req.TableGroup, req.Shard)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get poolers: %v", err)
+	}
+	if len(cohort) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no poolers found for database=%s, table_group=%s, shard=%s", req.Database, req.TableGroup, req.Shard)
+	}
+
+	coordID := &clustermetadatapb.ID{
+		Component: clustermetadatapb.ID_UNKNOWN,
+		Name:      "multiadmin",
+	}
+	c := coordinator.NewCoordinator(coordID, s.ts, s.rpcClient, s.logger)
+	shardKey := commontypes.ShardKey{
+		Database:   req.Database,
+		TableGroup: req.TableGroup,
+		Shard:      req.Shard,
+	}
+
+	switch req.Action {
+	case multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE, multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK:
+		rollback := req.Action == multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_ROLLBACK
+		if err := c.FinishShardUpgrade(ctx, shardKey, cohort, rollback); err != nil {
+			s.logger.ErrorContext(ctx, "Finishing upgrade failed", "shard_key", shardKey.String(), "rollback", rollback, "error", err)
+			return nil, mterrors.ToGRPC(err)
+		}
+		return &multiadminpb.UpgradeShardResponse{}, nil
+	}
+
+	checkOnly := req.Action == multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK
+	result, err := c.UpgradeShard(ctx, shardKey, cohort, req.NewBinDir, checkOnly)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Upgrade failed", "shard_key", shardKey.String(), "check_only", checkOnly, "error", err)
+		return nil, mterrors.ToGRPC(err)
+	}
+
+	return &multiadminpb.UpgradeShardResponse{
+		OldVersion: result.OldVersion,
+		NewVersion: result.NewVersion,
+	}, nil
+}
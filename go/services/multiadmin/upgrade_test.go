@@ -0,0 +1,143 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiadmin
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multigres/multigres/go/common/rpcclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiadminpb "github.com/multigres/multigres/go/pb/multiadmin"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+func TestUpgradeShard_Validation(t *testing.T) {
+	server := NewMultiAdminServer(nil, slog.Default())
+	defer server.Stop()
+
+	tests := []struct {
+		name string
+		req  *multiadminpb.UpgradeShardRequest
+		msg  string
+	}{
+		{
+			name: "missing database",
+			req:  &multiadminpb.UpgradeShardRequest{TableGroup: "default", Shard: "0-inf", NewBinDir: "/pg17/bin"},
+			msg:  "database cannot be empty",
+		},
+		{
+			name: "missing table group",
+			req:  &multiadminpb.UpgradeShardRequest{Database: "postgres", Shard: "0-inf", NewBinDir: "/pg17/bin"},
+			msg:  "table_group cannot be empty",
+		},
+		{
+			name: "missing shard",
+			req:  &multiadminpb.UpgradeShardRequest{Database: "postgres", TableGroup: "default", NewBinDir: "/pg17/bin"},
+			msg:  "shard cannot be empty",
+		},
+		{
+			name: "missing new bin dir",
+			req: &multiadminpb.UpgradeShardRequest{
+				Database:   "postgres",
+				TableGroup: "default",
+				Shard:      "0-inf",
+				Action:     multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_CHECK,
+			},
+			msg: "new_bin_dir cannot be empty",
+		},
+		{
+			name: "unknown action",
+			req: &multiadminpb.UpgradeShardRequest{
+				Database:   "postgres",
+				TableGroup: "default",
+				Shard:      "0-inf",
+				Action:     multiadminpb.UpgradeShardAction(42),
+			},
+			msg: "unknown action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.UpgradeShard(t.Context(), tt.req)
+			require.Error(t, err)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Contains(t, st.Message(), tt.msg)
+		})
+	}
+}
+
+func TestUpgradeShard_Success(t *testing.T) {
+	ctx := t.Context()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	fakeClient := rpcclient.NewFakeClient()
+	for i, name := range []string{"primary", "replica1"} {
+		pooler := &clustermetadatapb.MultiPooler{
+			Id: &clustermetadatapb.ID{
+				Component: clustermetadatapb.ID_MULTIPOOLER,
+				Cell:      "zone1",
+				Name:      name,
+			},
+			Database:   "postgres",
+			TableGroup: "default",
+			Shard:      "0-inf",
+			Type:       clustermetadatapb.PoolerType_REPLICA,
+			Hostname:   "localhost",
+			PortMap:    map[string]int32{"grpc": int32(9000 + i)},
+		}
+		if i == 0 {
+			pooler.Type = clustermetadatapb.PoolerType_PRIMARY
+		}
+		require.NoError(t, ts.CreateMultiPooler(ctx, pooler))
+	}
+	fakeClient.UpgradePostgresResponses["multipooler-zone1-primary"] = &multipoolermanagerdatapb.UpgradePostgresResponse{
+		OldVersion: "16",
+		NewVersion: "17",
+	}
+
+	server := NewMultiAdminServer(ts, slog.Default())
+	defer server.Stop()
+	server.SetRPCClient(fakeClient)
+
+	resp, err := server.UpgradeShard(ctx, &multiadminpb.UpgradeShardRequest{
+		Database:   "postgres",
+		TableGroup: "default",
+		Shard:      "0-inf",
+		NewBinDir:  "/pg17/bin",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "16", resp.OldVersion)
+	require.Equal(t, "17", resp.NewVersion)
+	require.Equal(t, "primary", fakeClient.UpgradePostgresRequests["multipooler-zone1-replica1"].Source.Id.Name)
+
+	_, err = server.UpgradeShard(ctx, &multiadminpb.UpgradeShardRequest{
+		Database:   "postgres",
+		TableGroup: "default",
+		Shard:      "0-inf",
+		Action:     multiadminpb.UpgradeShardAction_UPGRADE_SHARD_ACTION_FINALIZE,
+	})
+	require.NoError(t, err)
+	require.Contains(t, fakeClient.GetCallLog(), "FinishPostgresUpgrade(multipooler-zone1-replica1)")
+}
@@ -0,0 +1,309 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/executor"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
+	"github.com/multigres/multigres/go/services/multigateway/poolergateway"
+)
+
+// adminAPIPrefix is the path under which the admin API is served on the
+// HTTP port.
+const adminAPIPrefix = "/api/admin/"
+
+// sessionManager lists and controls the client sessions of the gateway.
+type sessionManager interface {
+	Sessions() []handler.SessionInfo
+	CancelSession(connectionID uint32) bool
+	TerminateSession(connectionID uint32) bool
+}
+
+// ReplicaStatus is the health of a replica pooler in the admin API.
+type ReplicaStatus struct {
+	Name                string        `json:"name"`
+	Cell                string        `json:"cell"`
+	Database            string        `json:"database"`
+	Serving             bool          `json:"serving"`
+	ServingStatus       string        `json:"serving_status"`
+	QueryServiceError   string        `json:"query_service_error,omitempty"`
+	ReplicationLag      time.Duration `json:"replication_lag"`
+	ReplicationLagError string        `json:"replication_lag_error,omitempty"`
+	LastReport          time.Time     `json:"last_report"`
+	Healthy             bool          `json:"healthy"`
+}
+
+// PoolStats are the connections of the gateway to its poolers, and the
+// health of the replicas among them.
+type PoolStats struct {
+	// PoolerConnections is the number of poolers the gateway has a gRPC
+	// connection to.
+	PoolerConnections int `json:"pooler_connections"`
+	// PoolersDiscovered is the number of poolers found in the topology.
+	PoolersDiscovered int `json:"poolers_discovered"`
+	// ReservedConnections is the number of backend connections reserved
+	// by the client sessions.
+	ReservedConnections int `json:"reserved_connections"`
+	// Replicas is the health of the replicas, empty unless the health
+	// check runs.
+	Replicas []ReplicaStatus `json:"replicas"`
+}
+
+// PlanCacheStatus is the content of the plan cache in the admin API.
+type PlanCacheStatus struct {
+	Enabled   bool                     `json:"enabled"`
+	Size      int                      `json:"size"`
+	MaxSize   int                      `json:"max_size"`
+	Hits      int64                    `json:"hits"`
+	Misses    int64                    `json:"misses"`
+	Evictions int64                    `json:"evictions"`
+	Plans     []planner.PlanCacheEntry `json:"plans"`
+}
+
+// SlowQueryStatus is the content of the slow query log in the admin API.
+type SlowQueryStatus struct {
+	Enabled   bool                 `json:"enabled"`
+	Threshold time.Duration        `json:"threshold"`
+	Queries   []executor.SlowQuery `json:"queries"`
+}
+
+// QueryStatsStatus is the content of the statistics of the queries in the
+// admin API.
+type QueryStatsStatus struct {
+	Enabled    bool                    `json:"enabled"`
+	Size       int                     `json:"size"`
+	MaxSize    int                     `json:"max_size"`
+	Evictions  int64                   `json:"evictions"`
+	Statements []engine.QueryStatement `json:"statements"`
+}
+
+// adminAPI serves the admin HTTP API of the gateway: the client sessions,
+// which can be cancelled or terminated, the connections to the poolers,
+// the plan cache, the slow queries, the statistics of the queries and the
+// poolers discovered. Every request must carry the token of the API as a
+// bearer token.
+type adminAPI struct {
+	token       string
+	sessions    sessionManager
+	planCache   *planner.PlanCache
+	slowQueries *executor.SlowQueryLog
+	queryStats  *engine.QueryStats
+	pools       func() PoolStats
+	topology    func() []CellStatus
+	mux         *http.ServeMux
+}
+
+func newAdminAPI(
+	token string,
+	sessions sessionManager,
+	planCache *planner.PlanCache,
+	slowQueries *executor.SlowQueryLog,
+	queryStats *engine.QueryStats,
+	pools func() PoolStats,
+	topology func() []CellStatus,
+) *adminAPI {
+	api := &adminAPI{
+		token:       token,
+		sessions:    sessions,
+		planCache:   planCache,
+		slowQueries: slowQueries,
+		queryStats:  queryStats,
+		pools:       pools,
+		topology:    topology,
+		mux:         http.NewServeMux(),
+	}
+	api.mux.HandleFunc("GET "+adminAPIPrefix+"sessions", api.handleSessions)
+	api.mux.HandleFunc("POST "+adminAPIPrefix+"sessions/{id}/cancel", api.handleCancelSession)
+	api.mux.HandleFunc("POST "+adminAPIPrefix+"sessions/{id}/terminate", api.handleTerminateSession)
+	api.mux.HandleFunc("GET "+adminAPIPrefix+"pools", api.handlePools)
+	api.mux.HandleFunc("GET "+adminAPIPrefix+"plans", api.handlePlans)
+	api.mux.HandleFunc("GET "+adminAPIPrefix+"slow_queries", api.handleSlowQueries)
+	api.mux.HandleFunc("GET "+adminAPIPrefix+"stat_statements", api.handleStatStatements)
+	api.mux.HandleFunc("POST "+adminAPIPrefix+"stat_statements/reset", api.handleResetStatStatements)
+	api.mux.HandleFunc("GET "+adminAPIPrefix+"topology", api.handleTopology)
+	return api
+}
+
+// loadAdminAPIToken reads the token of the admin API from path.
+func loadAdminAPIToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read admin API token: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("admin API token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// ServeHTTP authenticates the request and serves it.
+func (api *adminAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(api.token)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="multigateway"`)
+		writeAdminError(w, http.StatusUnauthorized, errors.New("invalid or missing bearer token"))
+		return
+	}
+	api.mux.ServeHTTP(w, r)
+}
+
+// handleSessions lists the client sessions.
+func (api *adminAPI) handleSessions(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, api.sessions.Sessions())
+}
+
+// handleCancelSession cancels the query a session runs.
+func (api *adminAPI) handleCancelSession(w http.ResponseWriter, r *http.Request) {
+	api.controlSession(w, r, api.sessions.CancelSession)
+}
+
+// handleTerminateSession closes the connection of a session.
+func (api *adminAPI) handleTerminateSession(w http.ResponseWriter, r *http.Request) {
+	api.controlSession(w, r, api.sessions.TerminateSession)
+}
+
+// controlSession applies control to the session of the connection ID of
+// the request path.
+func (api *adminAPI) controlSession(w http.ResponseWriter, r *http.Request, control func(uint32) bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		writeAdminError(w, http.StatusBadRequest, fmt.Errorf("invalid connection ID %q", r.PathValue("id")))
+		return
+	}
+	if !control(uint32(id)) {
+		writeAdminError(w, http.StatusNotFound, fmt.Errorf("no session with connection ID %d", id))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handlePools serves the connections to the poolers.
+func (api *adminAPI) handlePools(w http.ResponseWriter, r *http.Request) {
+	stats := api.pools()
+	for _, session := range api.sessions.Sessions() {
+		stats.ReservedConnections += session.ReservedConnections
+	}
+	writeAdminJSON(w, stats)
+}
+
+// replicaStatuses returns the health of replicas for the admin API.
+func replicaStatuses(replicas []poolergateway.ReplicaHealth) []ReplicaStatus {
+	statuses := make([]ReplicaStatus, 0, len(replicas))
+	for _, replica := range replicas {
+		statuses = append(statuses, ReplicaStatus{
+			Name:                topoclient.MultiPoolerIDString(replica.Pooler.Id),
+			Cell:                replica.Pooler.Id.GetCell(),
+			Database:            replica.Pooler.GetDatabase(),
+			Serving:             replica.Serving,
+			ServingStatus:       replica.ServingStatus.String(),
+			QueryServiceError:   replica.QueryServiceError,
+			ReplicationLag:      replica.ReplicationLag,
+			ReplicationLagError: replica.ReplicationLagError,
+			LastReport:          replica.LastReport,
+			Healthy:             replica.Healthy,
+		})
+	}
+	slices.SortFunc(statuses, func(a, b ReplicaStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return statuses
+}
+
+// handlePlans serves the content of the plan cache.
+func (api *adminAPI) handlePlans(w http.ResponseWriter, r *http.Request) {
+	status := PlanCacheStatus{Plans: []planner.PlanCacheEntry{}}
+	if api.planCache != nil {
+		status.Enabled = true
+		status.MaxSize = api.planCache.MaxSize()
+		status.Hits = api.planCache.Hits()
+		status.Misses = api.planCache.Misses()
+		status.Evictions = api.planCache.Evictions()
+		status.Plans = api.planCache.Entries()
+		status.Size = len(status.Plans)
+	}
+	writeAdminJSON(w, status)
+}
+
+// handleSlowQueries serves the slow query log, the most recent query
+// first.
+func (api *adminAPI) handleSlowQueries(w http.ResponseWriter, r *http.Request) {
+	status := SlowQueryStatus{Queries: []executor.SlowQuery{}}
+	if api.slowQueries != nil {
+		status.Enabled = true
+		status.Threshold = api.slowQueries.Threshold()
+		status.Queries = api.slowQueries.Entries()
+	}
+	writeAdminJSON(w, status)
+}
+
+// handleStatStatements serves the statistics of the queries, the most
+// time consuming first.
+func (api *adminAPI) handleStatStatements(w http.ResponseWriter, r *http.Request) {
+	status := QueryStatsStatus{Statements: []engine.QueryStatement{}}
+	if api.queryStats != nil {
+		status.Enabled = true
+		status.MaxSize = api.queryStats.MaxSize()
+		status.Evictions = api.queryStats.Evictions()
+		status.Statements = api.queryStats.Entries()
+		status.Size = len(status.Statements)
+	}
+	writeAdminJSON(w, status)
+}
+
+// handleResetStatStatements discards the statistics of the queries, as
+// pg_stat_statements_reset() does.
+func (api *adminAPI) handleResetStatStatements(w http.ResponseWriter, r *http.Request) {
+	if api.queryStats == nil {
+		writeAdminError(w, http.StatusNotFound, errors.New("query statistics are not kept"))
+		return
+	}
+	api.queryStats.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleTopology serves the poolers discovered in each cell.
+func (api *adminAPI) handleTopology(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, api.topology())
+}
+
+// writeAdminJSON writes v as the JSON response.
+func writeAdminJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// writeAdminError writes err as a JSON error response with status.
+func writeAdminError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
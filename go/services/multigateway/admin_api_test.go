@@ -0,0 +1,257 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/executor"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
+	"github.com/multigres/multigres/go/services/multigateway/poolergateway"
+)
+
+// fakeSessions is a sessionManager recording the sessions controlled.
+type fakeSessions struct {
+	sessions   []handler.SessionInfo
+	cancelled  []uint32
+	terminated []uint32
+}
+
+func (f *fakeSessions) Sessions() []handler.SessionInfo { return f.sessions }
+
+func (f *fakeSessions) exists(id uint32) bool {
+	for _, session := range f.sessions {
+		if session.ConnectionID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeSessions) CancelSession(id uint32) bool {
+	f.cancelled = append(f.cancelled, id)
+	return f.exists(id)
+}
+
+func (f *fakeSessions) TerminateSession(id uint32) bool {
+	f.terminated = append(f.terminated, id)
+	return f.exists(id)
+}
+
+func newTestAdminAPI(sessions *fakeSessions, planCache *planner.PlanCache) *adminAPI {
+	return newAdminAPI("secret", sessions, planCache, nil, nil,
+		func() PoolStats {
+			return PoolStats{PoolerConnections: 2, PoolersDiscovered: 3}
+		},
+		func() []CellStatus {
+			return []CellStatus{{Cell: "zone1", Poolers: []PoolerStatus{{Name: "pooler-1", Type: "PRIMARY"}}}}
+		})
+}
+
+// adminRequest serves a request of the admin API with the token.
+func adminRequest(api *adminAPI, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	api.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAdminAPI_Authentication(t *testing.T) {
+	api := newTestAdminAPI(&fakeSessions{}, nil)
+
+	rec := adminRequest(api, http.MethodGet, adminAPIPrefix+"sessions", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Bearer")
+
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"sessions", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"sessions", "secret")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAdminAPI_Sessions(t *testing.T) {
+	sessions := &fakeSessions{sessions: []handler.SessionInfo{
+		{ConnectionID: 1, User: "app", Database: "db1", State: "active", Query: "SELECT pg_sleep(10)", ReservedConnections: 1},
+		{ConnectionID: 2, User: "app", Database: "db1", State: "idle"},
+	}}
+	api := newTestAdminAPI(sessions, nil)
+
+	rec := adminRequest(api, http.MethodGet, adminAPIPrefix+"sessions", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed []handler.SessionInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	require.Len(t, listed, 2)
+	assert.Equal(t, "SELECT pg_sleep(10)", listed[0].Query)
+
+	rec = adminRequest(api, http.MethodPost, adminAPIPrefix+"sessions/1/cancel", "secret")
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	rec = adminRequest(api, http.MethodPost, adminAPIPrefix+"sessions/2/terminate", "secret")
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, []uint32{1}, sessions.cancelled)
+	assert.Equal(t, []uint32{2}, sessions.terminated)
+
+	rec = adminRequest(api, http.MethodPost, adminAPIPrefix+"sessions/9/terminate", "secret")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = adminRequest(api, http.MethodPost, adminAPIPrefix+"sessions/abc/cancel", "secret")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"sessions/1/cancel", "secret")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestAdminAPI_Pools(t *testing.T) {
+	sessions := &fakeSessions{sessions: []handler.SessionInfo{
+		{ConnectionID: 1, ReservedConnections: 2},
+		{ConnectionID: 2, ReservedConnections: 1},
+	}}
+	api := newTestAdminAPI(sessions, nil)
+
+	rec := adminRequest(api, http.MethodGet, adminAPIPrefix+"pools", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var stats PoolStats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	assert.Equal(t, 2, stats.PoolerConnections)
+	assert.Equal(t, 3, stats.PoolersDiscovered)
+	assert.Equal(t, 3, stats.ReservedConnections)
+}
+
+func TestAdminAPI_PlansAndTopology(t *testing.T) {
+	api := newTestAdminAPI(&fakeSessions{}, nil)
+	rec := adminRequest(api, http.MethodGet, adminAPIPrefix+"plans", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var plans PlanCacheStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &plans))
+	assert.False(t, plans.Enabled)
+	assert.Empty(t, plans.Plans)
+
+	api = newTestAdminAPI(&fakeSessions{}, planner.NewPlanCache(5))
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"plans", "secret")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &plans))
+	assert.True(t, plans.Enabled)
+	assert.Equal(t, 5, plans.MaxSize)
+
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"topology", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var cells []CellStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &cells))
+	require.Len(t, cells, 1)
+	assert.Equal(t, "pooler-1", cells[0].Poolers[0].Name)
+}
+
+func TestAdminAPI_SlowQueries(t *testing.T) {
+	api := newTestAdminAPI(&fakeSessions{}, nil)
+	rec := adminRequest(api, http.MethodGet, adminAPIPrefix+"slow_queries", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var slow SlowQueryStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &slow))
+	assert.False(t, slow.Enabled)
+	assert.Empty(t, slow.Queries)
+
+	api.slowQueries = executor.NewSlowQueryLog(time.Second, 10)
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"slow_queries", "secret")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &slow))
+	assert.True(t, slow.Enabled)
+	assert.Equal(t, time.Second, slow.Threshold)
+	assert.NotNil(t, slow.Queries)
+}
+
+func TestAdminAPI_StatStatements(t *testing.T) {
+	api := newTestAdminAPI(&fakeSessions{}, nil)
+	rec := adminRequest(api, http.MethodGet, adminAPIPrefix+"stat_statements", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var stats QueryStatsStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	assert.False(t, stats.Enabled)
+	rec = adminRequest(api, http.MethodPost, adminAPIPrefix+"stat_statements/reset", "secret")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	api.queryStats = engine.NewQueryStats(10)
+	api.queryStats.Record(engine.QueryStatsKey{User: "alice", Database: "db", Query: "SELECT $1"}, time.Millisecond, 1, 1, nil)
+	rec = adminRequest(api, http.MethodGet, adminAPIPrefix+"stat_statements", "secret")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	assert.True(t, stats.Enabled)
+	assert.Equal(t, 1, stats.Size)
+	assert.Equal(t, 10, stats.MaxSize)
+	require.Len(t, stats.Statements, 1)
+	assert.Equal(t, "SELECT $1", stats.Statements[0].Query)
+	assert.Equal(t, int64(1), stats.Statements[0].Calls)
+
+	rec = adminRequest(api, http.MethodPost, adminAPIPrefix+"stat_statements/reset", "secret")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, 0, api.queryStats.Size())
+}
+
+func TestReplicaStatuses(t *testing.T) {
+	now := time.Now()
+	statuses := replicaStatuses([]poolergateway.ReplicaHealth{
+		{
+			Pooler: &clustermetadatapb.MultiPooler{
+				Id:       &clustermetadatapb.ID{Component: clustermetadatapb.ID_MULTIPOOLER, Cell: "zone1", Name: "replica-b"},
+				Database: "db1",
+			},
+			Serving:        true,
+			ServingStatus:  clustermetadatapb.PoolerServingStatus_SERVING,
+			ReplicationLag: time.Second,
+			LastReport:     now,
+			Healthy:        true,
+		},
+		{
+			Pooler: &clustermetadatapb.MultiPooler{
+				Id: &clustermetadatapb.ID{Component: clustermetadatapb.ID_MULTIPOOLER, Cell: "zone1", Name: "replica-a"},
+			},
+			ServingStatus:     clustermetadatapb.PoolerServingStatus_NOT_SERVING,
+			QueryServiceError: "executor not initialized",
+		},
+	})
+	require.Len(t, statuses, 2)
+	assert.Contains(t, statuses[0].Name, "replica-a")
+	assert.Equal(t, "NOT_SERVING", statuses[0].ServingStatus)
+	assert.Equal(t, "executor not initialized", statuses[0].QueryServiceError)
+	assert.Equal(t, "zone1", statuses[1].Cell)
+	assert.Equal(t, "db1", statuses[1].Database)
+	assert.True(t, statuses[1].Healthy)
+}
+
+func TestLoadAdminAPIToken(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(path, []byte("secret\n"), 0o600))
+	token, err := loadAdminAPIToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", token)
+
+	empty := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+	_, err = loadAdminAPIToken(empty)
+	assert.ErrorContains(t, err, "is empty")
+
+	_, err = loadAdminAPIToken(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "failed to read admin API token")
+}
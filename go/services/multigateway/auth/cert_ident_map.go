@@ -0,0 +1,157 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+)
+
+// CertIdentMap implements server.CertAuthProvider by mapping the identities
+// in a client certificate to PostgreSQL roles, in the style of PostgreSQL's
+// pg_ident.conf.
+//
+// Each line of the map file holds a certificate identity and the role it may
+// connect as, separated by whitespace:
+//
+//	# identity                     role
+//	svc-billing                    billing
+//	/^(.*)\.svc\.example\.com$     \1
+//	ops-admin                      all
+//
+// The identities of a certificate are its subject common name and its DNS,
+// email and URI subject alternative names. An identity starting with '/' is
+// a regular expression; the role of a regular expression line may refer to
+// its first capture group as \1. The role "all" matches every role. Blank
+// lines and text after '#' are ignored.
+type CertIdentMap struct {
+	entries []identMapEntry
+}
+
+// identMapEntry is one line of a CertIdentMap.
+type identMapEntry struct {
+	// identity is the literal identity, if re is nil.
+	identity string
+	// re matches identities for a regular expression line.
+	re *regexp.Regexp
+	// role is the role the identity may connect as.
+	role string
+}
+
+// NewCertIdentMap loads the certificate ident map at path.
+func NewCertIdentMap(path string) (*CertIdentMap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open certificate ident map: %w", err)
+	}
+	defer f.Close()
+
+	entries, err := parseIdentMap(f)
+	if err != nil {
+		return nil, fmt.Errorf("certificate ident map %s: %w", path, err)
+	}
+	return &CertIdentMap{entries: entries}, nil
+}
+
+// AllowCertAuth reports whether any identity of cert maps to user.
+func (m *CertIdentMap) AllowCertAuth(_ context.Context, cert *x509.Certificate, user string) bool {
+	identities := certIdentities(cert)
+	for _, entry := range m.entries {
+		for _, identity := range identities {
+			if entry.allows(identity, user) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allows reports whether the entry lets identity connect as user.
+func (e *identMapEntry) allows(identity, user string) bool {
+	if e.re == nil {
+		return e.identity == identity && (e.role == "all" || e.role == user)
+	}
+
+	match := e.re.FindStringSubmatch(identity)
+	if match == nil {
+		return false
+	}
+	if e.role == "all" {
+		return true
+	}
+	role := e.role
+	if len(match) > 1 {
+		role = strings.Replace(role, `\1`, match[1], 1)
+	}
+	return role == user
+}
+
+// certIdentities returns the identities of cert that map entries are
+// matched against.
+func certIdentities(cert *x509.Certificate) []string {
+	var identities []string
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	identities = append(identities, cert.DNSNames...)
+	identities = append(identities, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	return identities
+}
+
+// parseIdentMap parses the contents of a certificate ident map file.
+func parseIdentMap(r io.Reader) ([]identMapEntry, error) {
+	var entries []identMapEntry
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected an identity and a role, got %d fields", lineNum, len(fields))
+		}
+
+		entry := identMapEntry{identity: fields[0], role: fields[1]}
+		if pattern, ok := strings.CutPrefix(fields[0], "/"); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid regular expression: %w", lineNum, err)
+			}
+			entry.re = re
+		} else if strings.Contains(entry.role, `\1`) {
+			return nil, fmt.Errorf(`line %d: role refers to \1 but the identity is not a regular expression`, lineNum)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Ensure CertIdentMap implements server.CertAuthProvider.
+var _ server.CertAuthProvider = (*CertIdentMap)(nil)
@@ -0,0 +1,91 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeIdentMap(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cert_ident.conf")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestCertIdentMap(t *testing.T) {
+	identMap, err := NewCertIdentMap(writeIdentMap(t, `
+# identity                      role
+svc-billing                     billing   # the billing service
+/^(.*)\.svc\.example\.com$      \1
+ops-admin                       all
+spiffe://example.com/reporting  reporting
+`))
+	require.NoError(t, err)
+
+	spiffe, err := url.Parse("spiffe://example.com/reporting")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		cert *x509.Certificate
+		user string
+		want bool
+	}{
+		{name: "common name", cert: &x509.Certificate{Subject: pkix.Name{CommonName: "svc-billing"}}, user: "billing", want: true},
+		{name: "common name other role", cert: &x509.Certificate{Subject: pkix.Name{CommonName: "svc-billing"}}, user: "postgres", want: false},
+		{name: "regexp on DNS name", cert: &x509.Certificate{DNSNames: []string{"orders.svc.example.com"}}, user: "orders", want: true},
+		{name: "regexp other role", cert: &x509.Certificate{DNSNames: []string{"orders.svc.example.com"}}, user: "billing", want: false},
+		{name: "all", cert: &x509.Certificate{EmailAddresses: []string{"ops-admin"}}, user: "anyone", want: true},
+		{name: "URI", cert: &x509.Certificate{URIs: []*url.URL{spiffe}}, user: "reporting", want: true},
+		{name: "unknown identity", cert: &x509.Certificate{Subject: pkix.Name{CommonName: "intruder"}}, user: "billing", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, identMap.AllowCertAuth(context.Background(), tt.cert, tt.user))
+		})
+	}
+}
+
+func TestCertIdentMapRejectsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{name: "missing role", contents: "svc-billing", wantErr: "line 1: expected an identity and a role"},
+		{name: "extra field", contents: "map svc-billing billing", wantErr: "got 3 fields"},
+		{name: "bad regexp", contents: "/(unclosed  billing", wantErr: "invalid regular expression"},
+		{name: "backreference without regexp", contents: `svc-billing \1`, wantErr: "not a regular expression"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCertIdentMap(writeIdentMap(t, tt.contents))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := NewCertIdentMap(filepath.Join(t.TempDir(), "missing.conf"))
+	assert.Error(t, err)
+}
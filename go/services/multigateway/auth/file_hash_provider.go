@@ -0,0 +1,165 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/scram"
+)
+
+// FileHashProvider implements scram.PasswordHashProvider from a credentials
+// file, for deployments where gateway clients authenticate with credentials
+// managed separately from the backend's roles.
+//
+// The file uses the format of PgBouncer's auth_file: each line holds a
+// double-quoted user name and its SCRAM-SHA-256 verifier, as produced by
+// scram.NewScramSHA256Hash or found in pg_authid.rolpassword. A double quote
+// inside a field is written twice. Blank lines and lines starting with '#'
+// or ';' are ignored.
+//
+//	"alice" "SCRAM-SHA-256$4096:<salt>$<StoredKey>:<ServerKey>"
+//
+// The credentials apply to every database. Plaintext and MD5 passwords are
+// rejected, so the file never holds anything that can be replayed.
+type FileHashProvider struct {
+	path string
+
+	mu     sync.RWMutex
+	hashes map[string]*scram.ScramHash
+}
+
+// NewFileHashProvider loads the credentials file at path.
+func NewFileHashProvider(path string) (*FileHashProvider, error) {
+	hashes, err := loadCredentials(path)
+	if err != nil {
+		return nil, err
+	}
+	return &FileHashProvider{path: path, hashes: hashes}, nil
+}
+
+// Reload loads the credentials file again. If it is invalid, the
+// credentials loaded before are kept.
+func (p *FileHashProvider) Reload() error {
+	hashes, err := loadCredentials(p.path)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.hashes = hashes
+	return nil
+}
+
+// GetPasswordHash returns the verifier of username, or scram.ErrUserNotFound
+// if the file has no entry for it.
+func (p *FileHashProvider) GetPasswordHash(_ context.Context, username, _ string) (*scram.ScramHash, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	hash, ok := p.hashes[username]
+	if !ok {
+		return nil, scram.ErrUserNotFound
+	}
+	return hash, nil
+}
+
+// loadCredentials loads the credentials file at path.
+func loadCredentials(path string) (map[string]*scram.ScramHash, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credentials file: %w", err)
+	}
+	defer f.Close()
+
+	hashes, err := parseCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("credentials file %s: %w", path, err)
+	}
+	return hashes, nil
+}
+
+// parseCredentials parses the contents of a credentials file.
+func parseCredentials(r io.Reader) (map[string]*scram.ScramHash, error) {
+	hashes := make(map[string]*scram.ScramHash)
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		username, rest, err := parseQuoted(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: user name: %w", lineNum, err)
+		}
+		verifier, rest, err := parseQuoted(strings.TrimLeft(rest, " \t"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: verifier: %w", lineNum, err)
+		}
+		if strings.TrimSpace(rest) != "" {
+			return nil, fmt.Errorf("line %d: unexpected text after verifier", lineNum)
+		}
+
+		if !scram.IsScramSHA256Hash(verifier) {
+			return nil, fmt.Errorf("line %d: user %q: only SCRAM-SHA-256 verifiers are accepted", lineNum, username)
+		}
+		hash, err := scram.ParseScramSHA256Hash(verifier)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: user %q: %w", lineNum, username, err)
+		}
+		if _, dup := hashes[username]; dup {
+			return nil, fmt.Errorf("line %d: duplicate entry for user %q", lineNum, username)
+		}
+		hashes[username] = hash
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// parseQuoted parses a double-quoted field at the start of s, in which a
+// doubled quote stands for a literal one. It returns the field's value and
+// the remainder of s.
+func parseQuoted(s string) (string, string, error) {
+	if s == "" || s[0] != '"' {
+		return "", "", errors.New("expected a double-quoted field")
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != '"' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '"' {
+			b.WriteByte('"')
+			i++
+			continue
+		}
+		return b.String(), s[i+1:], nil
+	}
+	return "", "", errors.New("unterminated double-quoted field")
+}
+
+// Ensure FileHashProvider implements scram.PasswordHashProvider.
+var _ scram.PasswordHashProvider = (*FileHashProvider)(nil)
@@ -0,0 +1,141 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/scram"
+)
+
+func writeCredentialsFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "userlist.txt")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestFileHashProvider(t *testing.T) {
+	alice, err := scram.NewScramSHA256Hash("alice-secret", scram.DefaultIterationCount)
+	require.NoError(t, err)
+	quoted, err := scram.NewScramSHA256Hash("quoted-secret", scram.DefaultIterationCount)
+	require.NoError(t, err)
+
+	path := writeCredentialsFile(t, strings.Join([]string{
+		"# gateway users",
+		`"alice" "` + alice.Encode() + `"`,
+		"",
+		`; legacy comment`,
+		`  "say ""hi"""   "` + quoted.Encode() + `"  `,
+	}, "\n"))
+
+	provider, err := NewFileHashProvider(path)
+	require.NoError(t, err)
+
+	got, err := provider.GetPasswordHash(context.Background(), "alice", "anydb")
+	require.NoError(t, err)
+	assert.Equal(t, alice, got)
+
+	got, err = provider.GetPasswordHash(context.Background(), `say "hi"`, "postgres")
+	require.NoError(t, err)
+	assert.Equal(t, quoted, got)
+
+	_, err = provider.GetPasswordHash(context.Background(), "bob", "postgres")
+	assert.ErrorIs(t, err, scram.ErrUserNotFound)
+}
+
+func TestFileHashProviderReload(t *testing.T) {
+	alice, err := scram.NewScramSHA256Hash("alice-secret", scram.DefaultIterationCount)
+	require.NoError(t, err)
+	bob, err := scram.NewScramSHA256Hash("bob-secret", scram.DefaultIterationCount)
+	require.NoError(t, err)
+
+	path := writeCredentialsFile(t, `"alice" "`+alice.Encode()+`"`)
+	provider, err := NewFileHashProvider(path)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`"bob" "`+bob.Encode()+`"`), 0o600))
+	require.NoError(t, provider.Reload())
+	_, err = provider.GetPasswordHash(context.Background(), "alice", "postgres")
+	assert.ErrorIs(t, err, scram.ErrUserNotFound)
+	got, err := provider.GetPasswordHash(context.Background(), "bob", "postgres")
+	require.NoError(t, err)
+	assert.Equal(t, bob, got)
+
+	// An invalid file keeps the credentials loaded before.
+	require.NoError(t, os.WriteFile(path, []byte(`"bob" "md5abc"`), 0o600))
+	assert.Error(t, provider.Reload())
+	got, err = provider.GetPasswordHash(context.Background(), "bob", "postgres")
+	require.NoError(t, err)
+	assert.Equal(t, bob, got)
+}
+
+func TestFileHashProviderAuthenticates(t *testing.T) {
+	hash, err := scram.NewScramSHA256Hash("pencil", scram.DefaultIterationCount)
+	require.NoError(t, err)
+	provider, err := NewFileHashProvider(writeCredentialsFile(t, `"user" "`+hash.Encode()+`"`))
+	require.NoError(t, err)
+
+	auth := scram.NewScramAuthenticator(provider, "postgres")
+	auth.StartAuthentication()
+	client := scram.NewSCRAMClientWithPassword("user", "pencil")
+
+	clientFirst, err := client.ClientFirstMessage()
+	require.NoError(t, err)
+	serverFirst, err := auth.HandleClientFirst(context.Background(), clientFirst, "user")
+	require.NoError(t, err)
+	clientFinal, err := client.ProcessServerFirst(serverFirst)
+	require.NoError(t, err)
+	serverFinal, err := auth.HandleClientFinal(clientFinal)
+	require.NoError(t, err)
+	require.NoError(t, client.VerifyServerFinal(serverFinal))
+	assert.True(t, auth.IsAuthenticated())
+}
+
+func TestFileHashProviderRejectsInvalidFiles(t *testing.T) {
+	hash, err := scram.NewScramSHA256Hash("secret", scram.DefaultIterationCount)
+	require.NoError(t, err)
+	verifier := hash.Encode()
+
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{name: "plaintext password", contents: `"alice" "secret"`, wantErr: "only SCRAM-SHA-256"},
+		{name: "md5 password", contents: `"alice" "md5bb41a296aab6baccb36ff243a562abff"`, wantErr: "only SCRAM-SHA-256"},
+		{name: "malformed verifier", contents: `"alice" "SCRAM-SHA-256$4096:bad"`, wantErr: "line 1"},
+		{name: "unquoted user", contents: `alice "` + verifier + `"`, wantErr: "expected a double-quoted field"},
+		{name: "unterminated quote", contents: `"alice "` + verifier, wantErr: "line 1"},
+		{name: "trailing text", contents: `"alice" "` + verifier + `" extra`, wantErr: "unexpected text"},
+		{name: "duplicate user", contents: `"alice" "` + verifier + "\"\n\"alice\" \"" + verifier + `"`, wantErr: "line 2: duplicate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFileHashProvider(writeCredentialsFile(t, tt.contents))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	_, err = NewFileHashProvider(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
@@ -17,10 +17,14 @@ package multigateway
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/multigres/multigres/go/common/topoclient"
@@ -34,6 +38,11 @@ import (
 
 // CellPoolerDiscovery is a discovery service that watches for multipoolers
 // in a single cell using topology watches and maintains a list of available poolers.
+//
+// Queries are routed from this list alone. When the watch breaks, e.g.
+// because the topology server is unreachable, the last poolers seen keep
+// serving until the watch is established again, and Staleness tells for
+// how long they have not been updated.
 type CellPoolerDiscovery struct {
 	// Configuration
 	topoStore topoclient.Store
@@ -44,13 +53,27 @@ type CellPoolerDiscovery struct {
 	ctx        context.Context
 	cancelFunc context.CancelFunc
 	wg         sync.WaitGroup
+	// refresh asks the watch to be established again
+	refresh chan struct{}
+
+	// onPrimaryChange, if set, is told when a shard loses its primary or
+	// gets a new one
+	onPrimaryChange PrimaryListener
 
 	// State
 	mu          sync.Mutex
 	poolers     map[string]*topoclient.MultiPoolerInfo // pooler ID -> pooler info
 	lastRefresh time.Time
+	// staleSince is when the watch broke, or the zero time while the watch
+	// is up to date
+	staleSince time.Time
 }
 
+// PrimaryListener is told whether a shard has a primary when a change of
+// the poolers gives the shard a new primary or takes it away, as during a
+// failover.
+type PrimaryListener func(tableGroup, shard string, hasPrimary bool)
+
 // NewCellPoolerDiscovery creates a new pooler discovery service for a single cell.
 func NewCellPoolerDiscovery(ctx context.Context, topoStore topoclient.Store, cell string, logger *slog.Logger) *CellPoolerDiscovery {
 	discoveryCtx, cancel := context.WithCancel(ctx)
@@ -61,7 +84,9 @@ func NewCellPoolerDiscovery(ctx context.Context, topoStore topoclient.Store, cel
 		logger:     logger.With("cell", cell),
 		ctx:        discoveryCtx,
 		cancelFunc: cancel,
+		refresh:    make(chan struct{}, 1),
 		poolers:    make(map[string]*topoclient.MultiPoolerInfo),
+		staleSince: time.Now(),
 	}
 }
 
@@ -84,6 +109,8 @@ func (pd *CellPoolerDiscovery) Start() {
 
 			// Establish watch and process changes
 			func() {
+				defer pd.markStale()
+
 				// Get connection for the cell
 				conn, err := pd.topoStore.ConnForCell(pd.ctx, pd.cell)
 				if err != nil {
@@ -122,6 +149,10 @@ func (pd *CellPoolerDiscovery) Start() {
 						// Process the change - this handles both updates and deletions.
 						// Deletions come as events with Err set to NoNode error.
 						pd.processPoolerChange(watchData)
+					case <-pd.refresh:
+						pd.logger.Info("Refreshing pooler discovery")
+						r.Reset()
+						return
 					}
 				}
 			}()
@@ -129,6 +160,36 @@ func (pd *CellPoolerDiscovery) Start() {
 	})
 }
 
+// Refresh establishes the watch again, reading all the poolers of the cell
+// anew. While the topology server is unreachable, the watch is established
+// at the next attempt instead.
+func (pd *CellPoolerDiscovery) Refresh() {
+	select {
+	case pd.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// markStale records that the watch broke, unless it already had.
+func (pd *CellPoolerDiscovery) markStale() {
+	pd.mu.Lock()
+	defer pd.mu.Unlock()
+	if pd.staleSince.IsZero() {
+		pd.staleSince = time.Now()
+	}
+}
+
+// Staleness returns how long the poolers have not been updated from a
+// working watch, or 0 while the watch is up to date.
+func (pd *CellPoolerDiscovery) Staleness() time.Duration {
+	pd.mu.Lock()
+	defer pd.mu.Unlock()
+	if pd.staleSince.IsZero() {
+		return 0
+	}
+	return time.Since(pd.staleSince)
+}
+
 // Stop stops the discovery service.
 func (pd *CellPoolerDiscovery) Stop() {
 	pd.cancelFunc()
@@ -140,7 +201,9 @@ func (pd *CellPoolerDiscovery) processInitialPoolers(initial []*topoclient.Watch
 	pd.mu.Lock()
 	defer pd.mu.Unlock()
 
-	// Clear existing poolers
+	// Replace the poolers kept from before the watch was established
+	// again, telling of the shards whose primary changed meanwhile
+	previous := pd.poolers
 	pd.poolers = make(map[string]*topoclient.MultiPoolerInfo)
 
 	// Process initial pooler data
@@ -170,7 +233,12 @@ func (pd *CellPoolerDiscovery) processInitialPoolers(initial []*topoclient.Watch
 		}
 	}
 
+	if !pd.lastRefresh.IsZero() {
+		pd.notifyPrimaryChanges(previous)
+	}
+
 	pd.lastRefresh = time.Now()
+	pd.staleSince = time.Time{}
 	pd.logger.Info("Initial pooler discovery completed",
 		"cell", pd.cell,
 		"pooler_count", len(pd.poolers))
@@ -189,8 +257,11 @@ func (pd *CellPoolerDiscovery) processPoolerChange(watchData *topoclient.WatchDa
 			if strings.HasSuffix(watchData.Path, "/Pooler") {
 				poolerID := pd.extractPoolerIDFromPath(watchData.Path)
 				if poolerID != "" {
-					if _, existed := pd.poolers[poolerID]; existed {
+					if removed, existed := pd.poolers[poolerID]; existed {
 						delete(pd.poolers, poolerID)
+						if removed.Type == clustermetadatapb.PoolerType_PRIMARY && !pd.hasPrimary(removed.TableGroup, removed.Shard) {
+							pd.notifyPrimaryChange(removed.TableGroup, removed.Shard, false)
+						}
 						pd.lastRefresh = time.Now()
 						pd.logger.Info("Pooler removed",
 							"id", poolerID,
@@ -228,10 +299,20 @@ func (pd *CellPoolerDiscovery) processPoolerChange(watchData *topoclient.WatchDa
 	}
 
 	// Check if this is a new pooler
-	_, existed := pd.poolers[poolerID]
+	previous, existed := pd.poolers[poolerID]
 	pd.poolers[poolerID] = pooler
 	pd.lastRefresh = time.Now()
 
+	// A new primary ends a failover; a demoted one starts it, unless the
+	// new primary is already known.
+	wasPrimary := existed && previous.Type == clustermetadatapb.PoolerType_PRIMARY
+	switch {
+	case pooler.Type == clustermetadatapb.PoolerType_PRIMARY && !wasPrimary:
+		pd.notifyPrimaryChange(pooler.TableGroup, pooler.Shard, true)
+	case pooler.Type != clustermetadatapb.PoolerType_PRIMARY && wasPrimary && !pd.hasPrimary(pooler.TableGroup, pooler.Shard):
+		pd.notifyPrimaryChange(pooler.TableGroup, pooler.Shard, false)
+	}
+
 	if !existed {
 		pd.logger.Info("New pooler discovered",
 			"id", poolerID,
@@ -253,6 +334,52 @@ func (pd *CellPoolerDiscovery) processPoolerChange(watchData *topoclient.WatchDa
 	}
 }
 
+// hasPrimary returns true if a shard has a primary in the cell.
+// Caller must hold pd.mu.
+func (pd *CellPoolerDiscovery) hasPrimary(tableGroup, shard string) bool {
+	for _, pooler := range pd.poolers {
+		if pooler.Type == clustermetadatapb.PoolerType_PRIMARY && pooler.TableGroup == tableGroup && pooler.Shard == shard {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyPrimaryChanges tells the primary listener of the shards that have
+// a primary now but had none among the previous poolers, or the reverse.
+// Caller must hold pd.mu.
+func (pd *CellPoolerDiscovery) notifyPrimaryChanges(previous map[string]*topoclient.MultiPoolerInfo) {
+	type shardKey struct{ tableGroup, shard string }
+	primaries := func(poolers map[string]*topoclient.MultiPoolerInfo) map[shardKey]bool {
+		shards := make(map[shardKey]bool)
+		for _, pooler := range poolers {
+			if pooler.Type == clustermetadatapb.PoolerType_PRIMARY {
+				shards[shardKey{pooler.TableGroup, pooler.Shard}] = true
+			}
+		}
+		return shards
+	}
+	before, after := primaries(previous), primaries(pd.poolers)
+	for key := range after {
+		if !before[key] {
+			pd.notifyPrimaryChange(key.tableGroup, key.shard, true)
+		}
+	}
+	for key := range before {
+		if !after[key] {
+			pd.notifyPrimaryChange(key.tableGroup, key.shard, false)
+		}
+	}
+}
+
+// notifyPrimaryChange tells the primary listener whether a shard has a
+// primary. Caller must hold pd.mu.
+func (pd *CellPoolerDiscovery) notifyPrimaryChange(tableGroup, shard string, hasPrimary bool) {
+	if pd.onPrimaryChange != nil {
+		pd.onPrimaryChange(tableGroup, shard, hasPrimary)
+	}
+}
+
 // GetPoolersForAdmin returns a list of all discovered poolers in this cell.
 // This is intended for admin/status pages, not the hot query path.
 // Poolers are sorted by name for consistent display order.
@@ -341,6 +468,39 @@ func (pd *CellPoolerDiscovery) GetPooler(target *query.Target) *clustermetadatap
 	return nil
 }
 
+// GetPoolers returns all poolers matching the target specification, sorted
+// by pooler ID. Unlike GetPooler, an empty tablegroup matches any tablegroup.
+func (pd *CellPoolerDiscovery) GetPoolers(target *query.Target) []*clustermetadatapb.MultiPooler {
+	pd.mu.Lock()
+	defer pd.mu.Unlock()
+
+	targetType := target.PoolerType
+	if targetType == clustermetadatapb.PoolerType_UNKNOWN {
+		targetType = clustermetadatapb.PoolerType_PRIMARY
+	}
+
+	poolerIDs := make([]string, 0, len(pd.poolers))
+	for id, pooler := range pd.poolers {
+		if pooler.Type != targetType {
+			continue
+		}
+		if target.TableGroup != "" && pooler.TableGroup != target.TableGroup {
+			continue
+		}
+		if target.Shard != "" && pooler.Shard != target.Shard {
+			continue
+		}
+		poolerIDs = append(poolerIDs, id)
+	}
+	sort.Strings(poolerIDs)
+
+	poolers := make([]*clustermetadatapb.MultiPooler, 0, len(poolerIDs))
+	for _, id := range poolerIDs {
+		poolers = append(poolers, proto.Clone(pd.poolers[id].MultiPooler).(*clustermetadatapb.MultiPooler))
+	}
+	return poolers
+}
+
 // LastRefresh returns the timestamp of the last successful refresh.
 func (pd *CellPoolerDiscovery) LastRefresh() time.Time {
 	pd.mu.Lock()
@@ -420,6 +580,33 @@ func (pd *CellPoolerDiscovery) Cell() string {
 	return pd.cell
 }
 
+// CellFallback selects the cells reads go to when the local cell has no
+// replica to serve them.
+type CellFallback string
+
+const (
+	// CellFallbackAny sends reads to the replicas of the cells of the
+	// region of the local cell, then to those of any other cell.
+	CellFallbackAny CellFallback = "any"
+
+	// CellFallbackRegion sends reads to the replicas of the cells of the
+	// region of the local cell only. A local cell with no region has no
+	// other cell to fall back to.
+	CellFallbackRegion CellFallback = "region"
+
+	// CellFallbackNone sends reads to the replicas of the local cell only.
+	CellFallbackNone CellFallback = "none"
+)
+
+// ParseCellFallback parses the name of a cell fallback.
+func ParseCellFallback(name string) (CellFallback, error) {
+	switch fallback := CellFallback(strings.ToLower(name)); fallback {
+	case CellFallbackAny, CellFallbackRegion, CellFallbackNone:
+		return fallback, nil
+	}
+	return "", fmt.Errorf("invalid cell fallback %q: must be %q, %q or %q", name, CellFallbackAny, CellFallbackRegion, CellFallbackNone)
+}
+
 // GlobalPoolerDiscovery orchestrates multiple CellPoolerDiscovery instances,
 // one per cell. It watches for cell changes from global etcd and creates/removes
 // cell watchers as cells appear/disappear.
@@ -433,10 +620,20 @@ type GlobalPoolerDiscovery struct {
 	ctx        context.Context
 	cancelFunc context.CancelFunc
 	wg         sync.WaitGroup
+	// refresh asks the cells to be listed again
+	refresh chan struct{}
+
+	// cellFallback selects the cells of the replicas reads go to, besides
+	// the local cell
+	cellFallback CellFallback
 
 	// State
 	mu           sync.Mutex
 	cellWatchers map[string]*CellPoolerDiscovery // cell name -> cell watcher
+	regions      map[string]string               // cell name -> region
+
+	// primaryListener is told of the changes of primaries of all cells
+	primaryListener atomic.Pointer[PrimaryListener]
 }
 
 // NewGlobalPoolerDiscovery creates a new global pooler discovery service.
@@ -451,10 +648,19 @@ func NewGlobalPoolerDiscovery(ctx context.Context, topoStore topoclient.Store, l
 		logger:       logger,
 		ctx:          discoveryCtx,
 		cancelFunc:   cancel,
+		refresh:      make(chan struct{}, 1),
+		cellFallback: CellFallbackAny,
 		cellWatchers: make(map[string]*CellPoolerDiscovery),
+		regions:      make(map[string]string),
 	}
 }
 
+// SetCellFallback sets the cells of the replicas reads go to when the
+// local cell has none to serve them. It must be called before Start.
+func (gd *GlobalPoolerDiscovery) SetCellFallback(fallback CellFallback) {
+	gd.cellFallback = fallback
+}
+
 // Start begins the discovery process by watching for cells and starting
 // a CellPoolerDiscovery for each cell.
 func (gd *GlobalPoolerDiscovery) Start() {
@@ -484,6 +690,18 @@ func (gd *GlobalPoolerDiscovery) Start() {
 
 				gd.logger.Info("Discovered cells", "cells", cells)
 
+				// Read the region of each cell, keeping the one read
+				// before if the cell cannot be read
+				regions := make(map[string]string, len(cells))
+				for _, cell := range cells {
+					info, err := gd.topoStore.GetCell(gd.ctx, cell)
+					if err != nil {
+						gd.logger.Warn("Failed to get cell", "cell", cell, "error", err)
+						continue
+					}
+					regions[cell] = info.GetRegion()
+				}
+
 				// Start watchers for each cell
 				gd.mu.Lock()
 				for _, cell := range cells {
@@ -491,6 +709,7 @@ func (gd *GlobalPoolerDiscovery) Start() {
 						gd.startCellWatcher(cell)
 					}
 				}
+				maps.Copy(gd.regions, regions)
 				gd.mu.Unlock()
 
 				// Reset backoff after stable for 30s
@@ -500,8 +719,13 @@ func (gd *GlobalPoolerDiscovery) Start() {
 				defer resetTimer.Stop()
 
 				// TODO: Watch for cell changes (cells being added/removed)
-				// For now, just wait for context cancellation
-				<-gd.ctx.Done()
+				// For now, cells are listed again on refresh only
+				select {
+				case <-gd.ctx.Done():
+				case <-gd.refresh:
+					gd.logger.Info("Refreshing global pooler discovery")
+					r.Reset()
+				}
 			}()
 		}
 	})
@@ -513,10 +737,51 @@ func (gd *GlobalPoolerDiscovery) startCellWatcher(cell string) {
 	gd.logger.Info("Starting cell watcher", "cell", cell)
 
 	cellWatcher := NewCellPoolerDiscovery(gd.ctx, gd.topoStore, cell, gd.logger)
+	cellWatcher.onPrimaryChange = gd.primaryChanged
 	gd.cellWatchers[cell] = cellWatcher
 	cellWatcher.Start()
 }
 
+// Refresh lists the cells again, starting the watchers of new cells, and
+// establishes the watches of all cells again.
+func (gd *GlobalPoolerDiscovery) Refresh() {
+	gd.mu.Lock()
+	for _, watcher := range gd.cellWatchers {
+		watcher.Refresh()
+	}
+	gd.mu.Unlock()
+
+	select {
+	case gd.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// CellStaleness returns the staleness of the poolers of each cell.
+func (gd *GlobalPoolerDiscovery) CellStaleness() map[string]time.Duration {
+	gd.mu.Lock()
+	defer gd.mu.Unlock()
+
+	stalenesses := make(map[string]time.Duration, len(gd.cellWatchers))
+	for cell, watcher := range gd.cellWatchers {
+		stalenesses[cell] = watcher.Staleness()
+	}
+	return stalenesses
+}
+
+// SetPrimaryListener sets the listener told when a shard loses its primary
+// or gets a new one, in any cell.
+func (gd *GlobalPoolerDiscovery) SetPrimaryListener(listener PrimaryListener) {
+	gd.primaryListener.Store(&listener)
+}
+
+// primaryChanged forwards a change of primary of a cell to the listener.
+func (gd *GlobalPoolerDiscovery) primaryChanged(tableGroup, shard string, hasPrimary bool) {
+	if listener := gd.primaryListener.Load(); listener != nil && *listener != nil {
+		(*listener)(tableGroup, shard, hasPrimary)
+	}
+}
+
 // Stop stops the global discovery service and all cell watchers.
 func (gd *GlobalPoolerDiscovery) Stop() {
 	gd.cancelFunc()
@@ -532,7 +797,8 @@ func (gd *GlobalPoolerDiscovery) Stop() {
 }
 
 // GetPooler returns a pooler matching the target specification.
-// It searches across all cells, preferring the local cell for replicas.
+// It searches across all cells, preferring the local cell, then the other
+// cells of its region, for replicas, as far as the cell fallback allows.
 // For primaries, it will return a primary from any cell.
 func (gd *GlobalPoolerDiscovery) GetPooler(target *query.Target) *clustermetadatapb.MultiPooler {
 	gd.mu.Lock()
@@ -544,18 +810,8 @@ func (gd *GlobalPoolerDiscovery) GetPooler(target *query.Target) *clustermetadat
 		targetType = clustermetadatapb.PoolerType_PRIMARY
 	}
 
-	// For replicas, try local cell first
-	if targetType != clustermetadatapb.PoolerType_PRIMARY {
-		if localWatcher, exists := gd.cellWatchers[gd.localCell]; exists {
-			if pooler := localWatcher.GetPooler(target); pooler != nil {
-				return pooler
-			}
-		}
-	}
-
-	// Search all cells
-	for _, watcher := range gd.cellWatchers {
-		if pooler := watcher.GetPooler(target); pooler != nil {
+	for _, cell := range gd.cellsFor(targetType) {
+		if pooler := gd.cellWatchers[cell].GetPooler(target); pooler != nil {
 			return pooler
 		}
 	}
@@ -567,6 +823,62 @@ func (gd *GlobalPoolerDiscovery) GetPooler(target *query.Target) *clustermetadat
 	return nil
 }
 
+// GetPoolers returns all poolers matching the target specification across
+// all cells. Replicas of the local cell come first, then those of the other
+// cells of its region, then those of the remaining cells, as far as the cell
+// fallback allows, each in cell name order. An empty tablegroup matches any
+// tablegroup.
+func (gd *GlobalPoolerDiscovery) GetPoolers(target *query.Target) []*clustermetadatapb.MultiPooler {
+	gd.mu.Lock()
+	defer gd.mu.Unlock()
+
+	targetType := target.PoolerType
+	if targetType == clustermetadatapb.PoolerType_UNKNOWN {
+		targetType = clustermetadatapb.PoolerType_PRIMARY
+	}
+
+	var poolers []*clustermetadatapb.MultiPooler
+	for _, cell := range gd.cellsFor(targetType) {
+		poolers = append(poolers, gd.cellWatchers[cell].GetPoolers(target)...)
+	}
+	return poolers
+}
+
+// cellsFor returns the cells to look for poolers of a type in, in order of
+// preference. A primary is looked for in every cell; replicas in the local
+// cell, then in the other cells of its region, then in the remaining cells,
+// as far as the cell fallback allows. Caller must hold gd.mu.
+func (gd *GlobalPoolerDiscovery) cellsFor(poolerType clustermetadatapb.PoolerType) []string {
+	cells := make([]string, 0, len(gd.cellWatchers))
+	for cell := range gd.cellWatchers {
+		cells = append(cells, cell)
+	}
+	sort.Strings(cells)
+	if poolerType == clustermetadatapb.PoolerType_PRIMARY {
+		return cells
+	}
+
+	localRegion := gd.regions[gd.localCell]
+	var local, region, others []string
+	for _, cell := range cells {
+		switch {
+		case cell == gd.localCell:
+			local = append(local, cell)
+		case localRegion != "" && gd.regions[cell] == localRegion:
+			region = append(region, cell)
+		default:
+			others = append(others, cell)
+		}
+	}
+	switch gd.cellFallback {
+	case CellFallbackNone:
+		return local
+	case CellFallbackRegion:
+		return append(local, region...)
+	}
+	return slices.Concat(local, region, others)
+}
+
 // PoolerCount returns the total number of discovered poolers across all cells.
 func (gd *GlobalPoolerDiscovery) PoolerCount() int {
 	gd.mu.Lock()
@@ -583,7 +895,10 @@ func (gd *GlobalPoolerDiscovery) PoolerCount() int {
 type CellStatusInfo struct {
 	Cell        string
 	LastRefresh time.Time
-	Poolers     []*clustermetadatapb.MultiPooler
+	// Staleness is how long the poolers have not been updated, or 0 while
+	// the watch is up to date
+	Staleness time.Duration
+	Poolers   []*clustermetadatapb.MultiPooler
 }
 
 // GetCellStatusesForAdmin returns status information for each cell.
@@ -606,6 +921,7 @@ func (gd *GlobalPoolerDiscovery) GetCellStatusesForAdmin() []CellStatusInfo {
 		statuses = append(statuses, CellStatusInfo{
 			Cell:        watcher.Cell(),
 			LastRefresh: watcher.LastRefresh(),
+			Staleness:   watcher.Staleness(),
 			Poolers:     watcher.GetPoolersForAdmin(),
 		})
 	}
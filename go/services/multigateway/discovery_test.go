@@ -16,7 +16,11 @@ package multigateway
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -431,6 +435,77 @@ func TestPoolerDiscovery_ReconnectsAfterWatchClosed(t *testing.T) {
 	assert.Contains(t, names, "pooler2")
 }
 
+func TestPoolerDiscovery_ServesLastKnownPoolersWhileTopoDown(t *testing.T) {
+	ctx := context.Background()
+	store, factory := memorytopo.NewServerAndFactory(ctx, "test-cell")
+	defer store.Close()
+	logger := slog.Default()
+
+	pooler1 := createTestPooler("pooler1", "test-cell", "host1", "db1", "shard1", clustermetadatapb.PoolerType_PRIMARY)
+	require.NoError(t, store.CreateMultiPooler(ctx, pooler1))
+
+	pd := NewCellPoolerDiscovery(ctx, store, "test-cell", logger)
+	assert.Positive(t, pd.Staleness(), "no poolers were read yet")
+	pd.Start()
+	defer pd.Stop()
+	waitForPoolerCount(t, pd, 1)
+	assert.Zero(t, pd.Staleness())
+
+	// The topology becomes unreachable and the watch breaks.
+	factory.SetError(errors.New("topo down"))
+	factory.CloseWatches("test-cell", "poolers")
+	waitForCondition(t, func() bool { return pd.Staleness() > 0 }, "Expected the poolers to become stale")
+
+	// The last poolers seen still serve.
+	target := &query.Target{TableGroup: constants.DefaultTableGroup, Shard: "shard1"}
+	require.NotNil(t, pd.GetPooler(target))
+
+	// The topology comes back and the watch is up to date again.
+	factory.SetError(nil)
+	pooler2 := createTestPooler("pooler2", "test-cell", "host2", "db1", "shard1", clustermetadatapb.PoolerType_REPLICA)
+	require.NoError(t, store.CreateMultiPooler(ctx, pooler2))
+	waitForPoolerCount(t, pd, 2)
+	waitForCondition(t, func() bool { return pd.Staleness() == 0 }, "Expected the poolers to be up to date")
+}
+
+func TestPoolerDiscovery_Refresh(t *testing.T) {
+	ctx := context.Background()
+	store, _ := memorytopo.NewServerAndFactory(ctx, "test-cell")
+	defer store.Close()
+	logger := slog.Default()
+
+	pooler1 := createTestPooler("pooler1", "test-cell", "host1", "db1", "shard1", clustermetadatapb.PoolerType_PRIMARY)
+	require.NoError(t, store.CreateMultiPooler(ctx, pooler1))
+
+	pd := NewCellPoolerDiscovery(ctx, store, "test-cell", logger)
+	var mu sync.Mutex
+	var events []string
+	pd.onPrimaryChange = func(tableGroup, shard string, hasPrimary bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, fmt.Sprintf("%s/%s:%t", tableGroup, shard, hasPrimary))
+	}
+	pd.Start()
+	defer pd.Stop()
+	waitForPoolerCount(t, pd, 1)
+	firstRefresh := pd.LastRefresh()
+
+	// A pooler the watch missed is dropped by the refresh, and its shard
+	// is told it lost its primary.
+	pd.mu.Lock()
+	pd.poolers["test-cell/ghost"] = &topoclient.MultiPoolerInfo{
+		MultiPooler: createTestPooler("ghost", "test-cell", "host9", "db1", "shard9", clustermetadatapb.PoolerType_PRIMARY),
+	}
+	pd.mu.Unlock()
+
+	pd.Refresh()
+	waitForCondition(t, func() bool { return pd.LastRefresh().After(firstRefresh) && pd.PoolerCount() == 1 },
+		"Expected the refresh to read the poolers again")
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{constants.DefaultTableGroup + "/shard9:false"}, events)
+}
+
 // GlobalPoolerDiscovery tests
 
 // waitForGlobalPoolerCount waits for the GlobalPoolerDiscovery to reach the expected pooler count.
@@ -509,6 +584,53 @@ func TestGlobalPoolerDiscovery_CellAffinityForReplicas(t *testing.T) {
 	assert.Equal(t, "local-replica", pooler.Id.Name, "Should prefer local cell for replicas")
 }
 
+func TestGlobalPoolerDiscovery_GetPoolers(t *testing.T) {
+	ctx := context.Background()
+	store, _ := memorytopo.NewServerAndFactory(ctx, "zone1", "zone2")
+	defer store.Close()
+	logger := slog.Default()
+
+	for _, pooler := range []*clustermetadatapb.MultiPooler{
+		createTestPooler("primary", "zone1", "host1", "db1", "shard1", clustermetadatapb.PoolerType_PRIMARY),
+		createTestPooler("replica-a", "zone1", "host2", "db1", "shard1", clustermetadatapb.PoolerType_REPLICA),
+		createTestPooler("replica-b", "zone2", "host3", "db1", "shard1", clustermetadatapb.PoolerType_REPLICA),
+		createTestPooler("replica-c", "zone2", "host4", "db1", "shard2", clustermetadatapb.PoolerType_REPLICA),
+	} {
+		require.NoError(t, store.CreateMultiPooler(ctx, pooler))
+	}
+
+	// zone2 is the local cell, so its replicas come first.
+	gd := NewGlobalPoolerDiscovery(ctx, store, "zone2", logger)
+	gd.Start()
+	defer gd.Stop()
+
+	waitForGlobalPoolerCount(t, gd, 4)
+
+	names := func(poolers []*clustermetadatapb.MultiPooler) []string {
+		var names []string
+		for _, pooler := range poolers {
+			names = append(names, pooler.Id.Name)
+		}
+		return names
+	}
+
+	// An empty tablegroup matches all replicas.
+	replicas := gd.GetPoolers(&query.Target{PoolerType: clustermetadatapb.PoolerType_REPLICA})
+	assert.Equal(t, []string{"replica-b", "replica-c", "replica-a"}, names(replicas))
+
+	shard1 := gd.GetPoolers(&query.Target{
+		TableGroup: constants.DefaultTableGroup,
+		Shard:      "shard1",
+		PoolerType: clustermetadatapb.PoolerType_REPLICA,
+	})
+	assert.Equal(t, []string{"replica-b", "replica-a"}, names(shard1))
+
+	primaries := gd.GetPoolers(&query.Target{TableGroup: constants.DefaultTableGroup})
+	assert.Equal(t, []string{"primary"}, names(primaries))
+
+	assert.Empty(t, gd.GetPoolers(&query.Target{TableGroup: "other", PoolerType: clustermetadatapb.PoolerType_REPLICA}))
+}
+
 func TestGlobalPoolerDiscovery_CrossCellPrimary(t *testing.T) {
 	ctx := context.Background()
 	store, _ := memorytopo.NewServerAndFactory(ctx, "zone1", "zone2")
@@ -612,6 +734,52 @@ func TestPoolerDiscovery_PoolerDeletion(t *testing.T) {
 	assert.Equal(t, "pooler2", poolers[0].Id.Name)
 }
 
+// TestPoolerDiscovery_NotifiesPrimaryChanges tests that the primary listener
+// is told when a shard loses its primary, and when a replica is promoted.
+func TestPoolerDiscovery_NotifiesPrimaryChanges(t *testing.T) {
+	ctx := context.Background()
+	store, _ := memorytopo.NewServerAndFactory(ctx, "test-cell")
+	defer store.Close()
+	logger := slog.Default()
+
+	pd := NewCellPoolerDiscovery(ctx, store, "test-cell", logger)
+	var mu sync.Mutex
+	var events []string
+	pd.onPrimaryChange = func(tableGroup, shard string, hasPrimary bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, fmt.Sprintf("%s/%s:%t", tableGroup, shard, hasPrimary))
+	}
+	eventsSoFar := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(events)
+	}
+
+	primary := createTestPooler("pooler1", "test-cell", "host1", "db1", "shard1", clustermetadatapb.PoolerType_PRIMARY)
+	replica := createTestPooler("pooler2", "test-cell", "host2", "db1", "shard1", clustermetadatapb.PoolerType_REPLICA)
+	require.NoError(t, store.CreateMultiPooler(ctx, primary))
+	require.NoError(t, store.CreateMultiPooler(ctx, replica))
+
+	pd.Start()
+	defer pd.Stop()
+	waitForPoolerCount(t, pd, 2)
+	assert.Empty(t, eventsSoFar(), "the initial poolers are not changes")
+
+	// The primary goes away, then the replica is promoted.
+	require.NoError(t, store.UnregisterMultiPooler(ctx, primary.Id))
+	waitForPoolerCount(t, pd, 1)
+	_, err := store.UpdateMultiPoolerFields(ctx, replica.Id, func(mp *clustermetadatapb.MultiPooler) error {
+		mp.Type = clustermetadatapb.PoolerType_PRIMARY
+		return nil
+	})
+	require.NoError(t, err)
+
+	tg := constants.DefaultTableGroup
+	waitForCondition(t, func() bool { return len(eventsSoFar()) == 2 }, "Expected two primary changes")
+	assert.Equal(t, []string{tg + "/shard1:false", tg + "/shard1:true"}, eventsSoFar())
+}
+
 // TestPoolerDiscovery_NewPrimaryEvictsOldPrimary tests that when a new PRIMARY
 // pooler is discovered for the same TableGroup/Shard, any existing PRIMARY for
 // that TableGroup/Shard is evicted from the discovery cache.
@@ -780,3 +948,103 @@ func TestCellPoolerDiscovery_ExtractPoolerIDFromPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGlobalPoolerDiscovery_Refresh(t *testing.T) {
+	ctx := context.Background()
+	store, _ := memorytopo.NewServerAndFactory(ctx, "cell1", "cell2")
+	defer store.Close()
+	logger := slog.Default()
+
+	require.NoError(t, store.CreateMultiPooler(ctx, createTestPooler("pooler1", "cell2", "host1", "db1", "shard1", clustermetadatapb.PoolerType_PRIMARY)))
+
+	gd := NewGlobalPoolerDiscovery(ctx, store, "cell1", logger)
+	gd.Start()
+	defer gd.Stop()
+	waitForGlobalPoolerCount(t, gd, 1)
+	assert.Equal(t, map[string]time.Duration{"cell1": 0, "cell2": 0}, gd.CellStaleness())
+
+	// A pooler the watch missed is dropped by the refresh.
+	gd.mu.Lock()
+	cell2 := gd.cellWatchers["cell2"]
+	gd.mu.Unlock()
+	cell2.mu.Lock()
+	cell2.poolers["cell2/ghost"] = &topoclient.MultiPoolerInfo{
+		MultiPooler: createTestPooler("ghost", "cell2", "host9", "db1", "shard9", clustermetadatapb.PoolerType_PRIMARY),
+	}
+	cell2.mu.Unlock()
+	waitForGlobalPoolerCount(t, gd, 2)
+
+	gd.Refresh()
+	waitForGlobalPoolerCount(t, gd, 1)
+}
+
+func TestGlobalPoolerDiscovery_CellFallback(t *testing.T) {
+	ctx := context.Background()
+	store, _ := memorytopo.NewServerAndFactory(ctx, "us-east-1a", "us-east-1b", "us-west-1a")
+	defer store.Close()
+	logger := slog.Default()
+
+	for cell, region := range map[string]string{"us-east-1a": "us-east", "us-east-1b": "us-east", "us-west-1a": "us-west"} {
+		require.NoError(t, store.UpdateCellFields(ctx, cell, func(ci *clustermetadatapb.Cell) error {
+			ci.Region = region
+			return nil
+		}))
+	}
+	for _, pooler := range []*clustermetadatapb.MultiPooler{
+		createTestPooler("primary", "us-west-1a", "host1", "db1", "shard1", clustermetadatapb.PoolerType_PRIMARY),
+		createTestPooler("replica-east", "us-east-1b", "host2", "db1", "shard1", clustermetadatapb.PoolerType_REPLICA),
+		createTestPooler("replica-west", "us-west-1a", "host3", "db1", "shard1", clustermetadatapb.PoolerType_REPLICA),
+	} {
+		require.NoError(t, store.CreateMultiPooler(ctx, pooler))
+	}
+
+	names := func(poolers []*clustermetadatapb.MultiPooler) []string {
+		var names []string
+		for _, pooler := range poolers {
+			names = append(names, pooler.Id.Name)
+		}
+		return names
+	}
+	replicas := &query.Target{TableGroup: constants.DefaultTableGroup, PoolerType: clustermetadatapb.PoolerType_REPLICA}
+	primary := &query.Target{TableGroup: constants.DefaultTableGroup, PoolerType: clustermetadatapb.PoolerType_PRIMARY}
+
+	tests := []struct {
+		fallback CellFallback
+		replicas []string
+	}{
+		{CellFallbackAny, []string{"replica-east", "replica-west"}},
+		{CellFallbackRegion, []string{"replica-east"}},
+		{CellFallbackNone, nil},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.fallback), func(t *testing.T) {
+			// The local cell has no replica of its own.
+			gd := NewGlobalPoolerDiscovery(ctx, store, "us-east-1a", logger)
+			gd.SetCellFallback(tt.fallback)
+			gd.Start()
+			defer gd.Stop()
+			waitForGlobalPoolerCount(t, gd, 3)
+
+			assert.Equal(t, tt.replicas, names(gd.GetPoolers(replicas)))
+			if len(tt.replicas) > 0 {
+				require.NotNil(t, gd.GetPooler(replicas))
+				assert.Equal(t, tt.replicas[0], gd.GetPooler(replicas).Id.Name)
+			} else {
+				assert.Nil(t, gd.GetPooler(replicas))
+			}
+
+			// The primary is found in any cell.
+			require.NotNil(t, gd.GetPooler(primary))
+			assert.Equal(t, "primary", gd.GetPooler(primary).Id.Name)
+		})
+	}
+}
+
+func TestParseCellFallback(t *testing.T) {
+	for _, name := range []string{"any", "region", "NONE"} {
+		_, err := ParseCellFallback(name)
+		assert.NoError(t, err, name)
+	}
+	_, err := ParseCellFallback("nearest")
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// AdvisoryLockUsage describes how a statement uses session-level advisory
+// locks. Transaction-level advisory locks (pg_advisory_xact_lock and
+// friends) are released at the end of the transaction and are not tracked.
+type AdvisoryLockUsage struct {
+	// Acquires is set if the statement may take session-level advisory locks.
+	Acquires bool
+	// Releases is set if the statement may release session-level advisory locks.
+	Releases bool
+}
+
+// Any returns true if the statement uses session-level advisory locks.
+func (u AdvisoryLockUsage) Any() bool {
+	return u.Acquires || u.Releases
+}
+
+// DetectAdvisoryLocks finds the calls to the session-level advisory lock
+// functions in stmt. Calls hidden in functions or DO blocks are not seen.
+func DetectAdvisoryLocks(stmt ast.Stmt) AdvisoryLockUsage {
+	var usage AdvisoryLockUsage
+	if stmt == nil {
+		return usage
+	}
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		fc, ok := cursor.Node().(*ast.FuncCall)
+		if !ok {
+			return true
+		}
+		switch catalogFuncName(fc) {
+		case "pg_advisory_lock", "pg_advisory_lock_shared",
+			"pg_try_advisory_lock", "pg_try_advisory_lock_shared":
+			usage.Acquires = true
+		case "pg_advisory_unlock", "pg_advisory_unlock_shared", "pg_advisory_unlock_all":
+			usage.Releases = true
+		}
+		return true
+	}, nil)
+	return usage
+}
+
+// catalogFuncName returns the lower-cased name of the called function
+// if it is unqualified or qualified with pg_catalog, and "" otherwise.
+func catalogFuncName(fc *ast.FuncCall) string {
+	if fc.Funcname == nil {
+		return ""
+	}
+	var parts []string
+	for _, item := range fc.Funcname.Items {
+		s, ok := item.(*ast.String)
+		if !ok {
+			return ""
+		}
+		parts = append(parts, strings.ToLower(s.SVal))
+	}
+	switch {
+	case len(parts) == 1:
+		return parts[0]
+	case len(parts) == 2 && parts[0] == "pg_catalog":
+		return parts[1]
+	default:
+		return ""
+	}
+}
+
+// ExecuteWithAdvisoryLocks runs execute, a statement with the given advisory
+// lock usage, keeping the session pinned to its reserved connection while
+// it may hold session-level advisory locks.
+//
+// The session is pinned before a statement that takes locks. After a
+// statement that releases locks, or one that failed (and may have taken
+// some of its locks anyway), the pin is dropped if no lock is left.
+func ExecuteWithAdvisoryLocks(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage AdvisoryLockUsage,
+	execute func() error,
+) error {
+	if usage.Acquires {
+		if err := exec.PinForAdvisoryLocks(ctx, conn, tableGroup, shard, state); err != nil {
+			return err
+		}
+	}
+
+	err := execute()
+
+	if usage.Releases || err != nil {
+		// If the check fails, the session conservatively stays pinned.
+		_ = exec.UnpinIfNoAdvisoryLocks(ctx, conn, tableGroup, shard, state)
+	}
+	return err
+}
+
+// AdvisoryLockRoute is a Route for statements that take or release
+// session-level advisory locks. Pooled connections are shared between
+// sessions, so a lock taken on one would be held by whichever session
+// used the connection next; the statement runs on a reserved connection
+// pinned to the session instead.
+type AdvisoryLockRoute struct {
+	TableGroup string
+	Shard      string
+	Query      string
+	Usage      AdvisoryLockUsage
+}
+
+// NewAdvisoryLockRoute creates a new AdvisoryLockRoute primitive.
+func NewAdvisoryLockRoute(tableGroup, shard, query string, usage AdvisoryLockUsage) *AdvisoryLockRoute {
+	return &AdvisoryLockRoute{
+		TableGroup: tableGroup,
+		Shard:      shard,
+		Query:      query,
+		Usage:      usage,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *AdvisoryLockRoute) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return ExecuteWithAdvisoryLocks(ctx, exec, conn, r.TableGroup, r.Shard, state, r.Usage, func() error {
+		return exec.StreamExecute(ctx, conn, r.TableGroup, r.Shard, r.Query, state, callback)
+	})
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *AdvisoryLockRoute) GetTableGroup() string {
+	return r.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (r *AdvisoryLockRoute) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *AdvisoryLockRoute) String() string {
+	return fmt.Sprintf("AdvisoryLockRoute(tablegroup=%s, acquires=%t, releases=%t, query=%s)",
+		r.TableGroup, r.Usage.Acquires, r.Usage.Releases, r.Query)
+}
+
+// Ensure AdvisoryLockRoute implements Primitive interface.
+var _ Primitive = (*AdvisoryLockRoute)(nil)
@@ -0,0 +1,104 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestDetectAdvisoryLocks(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected AdvisoryLockUsage
+	}{
+		{"SELECT 1", AdvisoryLockUsage{}},
+		{"SELECT pg_advisory_lock(1)", AdvisoryLockUsage{Acquires: true}},
+		{"SELECT pg_catalog.pg_try_advisory_lock_shared(1, 2)", AdvisoryLockUsage{Acquires: true}},
+		{"SELECT PG_ADVISORY_LOCK(1)", AdvisoryLockUsage{Acquires: true}},
+		{"SELECT id FROM jobs WHERE pg_try_advisory_lock(id) LIMIT 1", AdvisoryLockUsage{Acquires: true}},
+		{"SELECT pg_advisory_unlock(1)", AdvisoryLockUsage{Releases: true}},
+		{"SELECT pg_advisory_unlock_all()", AdvisoryLockUsage{Releases: true}},
+		{"SELECT pg_advisory_unlock(1), pg_advisory_lock(2)", AdvisoryLockUsage{Acquires: true, Releases: true}},
+		// Transaction-level locks end with the transaction.
+		{"SELECT pg_advisory_xact_lock(1)", AdvisoryLockUsage{}},
+		// Functions of the same name in other schemas are not the built-ins.
+		{"SELECT myschema.pg_advisory_lock(1)", AdvisoryLockUsage{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmts, err := parser.ParseSQL(tt.sql)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			assert.Equal(t, tt.expected, DetectAdvisoryLocks(stmts[0]))
+		})
+	}
+}
+
+func TestExecuteWithAdvisoryLocks(t *testing.T) {
+	state := &handler.MultiGatewayConnectionState{}
+
+	t.Run("acquire pins before executing", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithAdvisoryLocks(context.Background(), mockExec, nil, "tg", "", state,
+			AdvisoryLockUsage{Acquires: true},
+			func() error {
+				assert.True(t, mockExec.pinCalled, "session must be pinned before the lock is taken")
+				return nil
+			})
+		require.NoError(t, err)
+		assert.False(t, mockExec.unpinCalled)
+	})
+
+	t.Run("failed acquire checks whether locks are held", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithAdvisoryLocks(context.Background(), mockExec, nil, "tg", "", state,
+			AdvisoryLockUsage{Acquires: true},
+			func() error { return errors.New("canceling statement due to lock timeout") })
+		require.ErrorContains(t, err, "lock timeout")
+		assert.True(t, mockExec.unpinCalled)
+	})
+
+	t.Run("pin failure skips execution", func(t *testing.T) {
+		mockExec := &mockIExecute{pinErr: errors.New("no pooler")}
+		executed := false
+		err := ExecuteWithAdvisoryLocks(context.Background(), mockExec, nil, "tg", "", state,
+			AdvisoryLockUsage{Acquires: true},
+			func() error {
+				executed = true
+				return nil
+			})
+		require.ErrorContains(t, err, "no pooler")
+		assert.False(t, executed)
+	})
+
+	t.Run("release unpins after executing", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithAdvisoryLocks(context.Background(), mockExec, nil, "tg", "", state,
+			AdvisoryLockUsage{Releases: true},
+			func() error { return nil })
+		require.NoError(t, err)
+		assert.False(t, mockExec.pinCalled)
+		assert.True(t, mockExec.unpinCalled)
+	})
+}
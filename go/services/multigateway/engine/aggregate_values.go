@@ -0,0 +1,241 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// Arithmetic on the text values of partial aggregates. The results are
+// formatted as PostgreSQL would output the aggregate computed on a single
+// server.
+
+// combineValues combines acc, the value of an aggregate combined so far,
+// with a partial value v of a field. The minimum and maximum of text values
+// are compared by collation when set.
+func combineValues(kind AggregateKind, field *query.Field, acc, v sqltypes.Value, collation sqltypes.TextCompare) (sqltypes.Value, error) {
+	if kind == AggregateAny {
+		return acc, nil
+	}
+	if acc.IsNull() {
+		return v, nil
+	}
+	if v.IsNull() {
+		return acc, nil
+	}
+	switch kind {
+	case AggregateCount, AggregateSum, AggregateAvg:
+		return addValues(field, acc, v)
+	case AggregateMin, AggregateMax:
+		compare, err := sqltypes.NewCollatedRowComparator([]*query.Field{field}, []sqltypes.OrderByColumn{{Column: 0}}, []sqltypes.TextCompare{collation})
+		if err != nil {
+			return nil, err
+		}
+		c := compare(&sqltypes.Row{Values: []sqltypes.Value{v}}, &sqltypes.Row{Values: []sqltypes.Value{acc}})
+		if (kind == AggregateMin && c < 0) || (kind == AggregateMax && c > 0) {
+			return v, nil
+		}
+		return acc, nil
+	}
+	return acc, nil
+}
+
+// addValues adds two non-NULL values of a numeric field.
+func addValues(field *query.Field, a, b sqltypes.Value) (sqltypes.Value, error) {
+	switch ast.Oid(field.DataTypeOid) {
+	case ast.INT2OID, ast.INT4OID, ast.INT8OID:
+		x, errA := strconv.ParseInt(string(a), 10, 64)
+		y, errB := strconv.ParseInt(string(b), 10, 64)
+		if errA != nil || errB != nil {
+			return nil, fmt.Errorf("invalid integer partial aggregates %q and %q", a, b)
+		}
+		sum := x + y
+		if (x > 0 && y > 0 && sum < 0) || (x < 0 && y < 0 && sum >= 0) {
+			return nil, sqlstate.NewError(sqlstate.NumericValueOutOfRange).
+				Msg("bigint out of range").
+				Err()
+		}
+		return sqltypes.Value(strconv.FormatInt(sum, 10)), nil
+
+	case ast.NUMERICOID:
+		return sqltypes.Value(addNumerics(string(a), string(b))), nil
+
+	case ast.FLOAT4OID, ast.FLOAT8OID:
+		x, errA := strconv.ParseFloat(floatText(string(a)), 64)
+		y, errB := strconv.ParseFloat(floatText(string(b)), 64)
+		if errA != nil || errB != nil {
+			return nil, fmt.Errorf("invalid floating point partial aggregates %q and %q", a, b)
+		}
+		return sqltypes.Value(sqltypes.FormatFloat(x+y, floatBits(field))), nil
+	}
+	return nil, unsupportedAggregateType(field)
+}
+
+// averageValue divides sum, the sum of the partial sums of an average of
+// a field, by count, the number of values averaged.
+func averageValue(field *query.Field, sum sqltypes.Value, count int64) (sqltypes.Value, error) {
+	if sum.IsNull() || count == 0 {
+		return nil, nil
+	}
+	switch ast.Oid(field.DataTypeOid) {
+	case ast.INT2OID, ast.INT4OID, ast.INT8OID, ast.NUMERICOID:
+		return sqltypes.Value(divideNumeric(string(sum), count)), nil
+
+	case ast.FLOAT4OID, ast.FLOAT8OID:
+		x, err := strconv.ParseFloat(floatText(string(sum)), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid floating point partial sum %q", sum)
+		}
+		return sqltypes.Value(sqltypes.FormatFloat(x/float64(count), 64)), nil
+	}
+	return nil, unsupportedAggregateType(field)
+}
+
+// avgField returns the description of the average of the partial sums of
+// field: double precision for floating point sums, numeric otherwise.
+func avgField(field *query.Field) *query.Field {
+	oid, size := ast.NUMERICOID, int32(-1)
+	switch ast.Oid(field.DataTypeOid) {
+	case ast.FLOAT4OID, ast.FLOAT8OID:
+		oid, size = ast.FLOAT8OID, 8
+	}
+	return &query.Field{
+		Name:         field.Name,
+		Type:         oid.String(),
+		DataTypeOid:  uint32(oid),
+		DataTypeSize: size,
+		TypeModifier: -1,
+		Format:       field.Format,
+	}
+}
+
+// unsupportedAggregateType returns the error for partial aggregates of a
+// type the gateway cannot combine.
+func unsupportedAggregateType(field *query.Field) error {
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("cannot combine aggregates of type %s across shards", ast.Oid(field.DataTypeOid)).
+		Hint("Restrict the query to a single shard with its shard key.").
+		Err()
+}
+
+// floatBits returns the size in bits of a floating point field.
+func floatBits(field *query.Field) int {
+	if ast.Oid(field.DataTypeOid) == ast.FLOAT4OID {
+		return 32
+	}
+	return 64
+}
+
+// floatText converts the PostgreSQL text of a special floating point value
+// to the form strconv parses.
+func floatText(s string) string {
+	switch s {
+	case "Infinity":
+		return "+Inf"
+	case "-Infinity":
+		return "-Inf"
+	}
+	return s
+}
+
+// parseNumeric parses the text of a finite numeric, returning its value
+// and display scale.
+func parseNumeric(s string) (*big.Rat, int, bool) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, 0, false
+	}
+	scale := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = len(s) - i - 1
+	}
+	return r, scale, true
+}
+
+// addNumerics adds two numeric values.
+func addNumerics(a, b string) string {
+	switch {
+	case a == "NaN" || b == "NaN":
+		return "NaN"
+	case (a == "Infinity" && b == "-Infinity") || (a == "-Infinity" && b == "Infinity"):
+		return "NaN"
+	case a == "Infinity" || a == "-Infinity":
+		return a
+	case b == "Infinity" || b == "-Infinity":
+		return b
+	}
+	x, scaleA, okA := parseNumeric(a)
+	y, scaleB, okB := parseNumeric(b)
+	if !okA || !okB {
+		return "NaN"
+	}
+	return x.Add(x, y).FloatString(max(scaleA, scaleB))
+}
+
+// divideNumeric divides a numeric value by a positive count, with the
+// scale PostgreSQL chooses for numeric division (select_div_scale): at
+// least 16 significant digits, and no less than the scale of the dividend.
+func divideNumeric(sum string, count int64) string {
+	switch sum {
+	case "NaN", "Infinity", "-Infinity":
+		return sum
+	}
+	x, scale, ok := parseNumeric(sum)
+	if !ok {
+		return "NaN"
+	}
+	y := new(big.Rat).SetInt64(count)
+
+	weight1, first1 := numericWeight(x)
+	weight2, first2 := numericWeight(y)
+	qweight := weight1 - weight2
+	if first1 <= first2 {
+		qweight--
+	}
+	rscale := min(max(16-qweight*4, scale, 0), 1000)
+	return x.Quo(x, y).FloatString(rscale)
+}
+
+// numericWeight returns the weight of the first non-zero base-10000 digit
+// of the absolute value of r, and that digit, as PostgreSQL stores numerics.
+// Zero has weight 0 and first digit 0.
+func numericWeight(r *big.Rat) (weight, first int) {
+	if r.Sign() == 0 {
+		return 0, 0
+	}
+	abs := new(big.Rat).Abs(r)
+	base := big.NewRat(10000, 1)
+	intPart := new(big.Int).Quo(abs.Num(), abs.Denom())
+	if intPart.Sign() > 0 {
+		digits := intPart.String()
+		weight = (len(digits) - 1) / 4
+		first, _ = strconv.Atoi(digits[:len(digits)-4*weight])
+		return weight, first
+	}
+	for weight = -1; ; weight-- {
+		abs.Mul(abs, base)
+		if scaled := new(big.Int).Quo(abs.Num(), abs.Denom()); scaled.Sign() > 0 {
+			return weight, int(scaled.Int64())
+		}
+	}
+}
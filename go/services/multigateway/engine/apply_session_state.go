@@ -24,14 +24,19 @@ import (
 	"github.com/multigres/multigres/go/services/multigateway/handler"
 )
 
-// ApplySessionState updates local session state after a SET/RESET command
-// executes successfully on PostgreSQL.
+// ApplySessionState updates local session state after a SET/SET LOCAL/RESET
+// command executes successfully on PostgreSQL.
 //
 // This primitive does NOT execute queries - it only updates the local state
 // tracking. It should be composed after a Route primitive in a Sequence.
+//
+// For the parameters owned by the gateway (see handler.IsGatewayVariable),
+// which PostgreSQL never sees, it is the whole plan and reports the command
+// completion itself.
 type ApplySessionState struct {
 	VariableStmt *ast.VariableSetStmt // The SET/RESET statement from AST
 	Value        string               // Extracted value (for SET commands)
+	CommandTag   string               // Reported on completion, if set
 }
 
 // NewApplySessionState creates a new ApplySessionState primitive.
@@ -42,6 +47,21 @@ func NewApplySessionState(stmt *ast.VariableSetStmt, value string) *ApplySession
 	}
 }
 
+// NewApplyGatewayVariable creates the ApplySessionState primitive setting or
+// resetting a parameter owned by the gateway, without running on
+// PostgreSQL.
+func NewApplyGatewayVariable(stmt *ast.VariableSetStmt, value string) *ApplySessionState {
+	commandTag := "SET"
+	if stmt.Kind == ast.VAR_RESET {
+		commandTag = "RESET"
+	}
+	return &ApplySessionState{
+		VariableStmt: stmt,
+		Value:        value,
+		CommandTag:   commandTag,
+	}
+}
+
 // StreamExecute updates the local session state based on the command type.
 func (a *ApplySessionState) StreamExecute(
 	ctx context.Context,
@@ -54,21 +74,32 @@ func (a *ApplySessionState) StreamExecute(
 	// Uses AST enums directly - no wrapper types needed
 	switch a.VariableStmt.Kind {
 	case ast.VAR_SET_VALUE:
-		// SET variable = value
-		state.SetSessionVariable(a.VariableStmt.Name, a.Value)
+		if a.VariableStmt.IsLocal {
+			// SET LOCAL variable = value
+			state.SetLocalVariable(a.VariableStmt.Name, a.Value)
+		} else {
+			// SET variable = value
+			state.SetSessionVariable(a.VariableStmt.Name, a.Value)
+		}
 
-	case ast.VAR_RESET:
-		// RESET variable
-		state.ResetSessionVariable(a.VariableStmt.Name)
+	case ast.VAR_SET_DEFAULT, ast.VAR_RESET:
+		// SET variable TO DEFAULT, RESET variable
+		// SET LOCAL variable TO DEFAULT is passed through only
+		if !a.VariableStmt.IsLocal {
+			state.ResetSessionVariable(a.VariableStmt.Name)
+		}
 
 	case ast.VAR_RESET_ALL:
 		// RESET ALL
 		state.ResetAllSessionVariables()
 
-		// VAR_SET_DEFAULT, VAR_SET_CURRENT, VAR_SET_MULTI are not tracked locally
+		// VAR_SET_CURRENT and VAR_SET_MULTI are not tracked locally
 		// They are passed through to PostgreSQL only
 	}
 
+	if a.CommandTag != "" {
+		return callback(ctx, &sqltypes.Result{CommandTag: a.CommandTag})
+	}
 	// This primitive doesn't produce results - the previous Route primitive
 	// already streamed results to the callback. Just return success.
 	return nil
@@ -0,0 +1,129 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// defaultCollationQuery reads the default collation of the current
+// database: the libc locale, or the locale of the ICU or builtin provider.
+const defaultCollationQuery = "SELECT CASE WHEN datlocprovider = 'c' THEN datcollate ELSE datlocale END FROM pg_database WHERE datname = current_database()"
+
+// Collations provides the collations by which the gateway orders the text
+// values of the rows of several shards, as the shards order them. The
+// default collation of each database is read from a shard the first time
+// it is needed.
+type Collations struct {
+	tableGroup string
+	shard      string
+
+	mu       sync.Mutex
+	defaults map[string]sqltypes.TextCompare
+}
+
+// NewCollations creates the Collations reading the default collations of
+// the databases from shard of tableGroup.
+func NewCollations(tableGroup, shard string) *Collations {
+	return &Collations{
+		tableGroup: tableGroup,
+		shard:      shard,
+		defaults:   make(map[string]sqltypes.TextCompare),
+	}
+}
+
+// forOrderBy returns the collations comparing the text values of the
+// orderBy columns of fields: the collation of the COLLATE clause of the
+// column, or the default collation of the database. They are nil for the
+// other columns, and all nil when c is nil.
+func (c *Collations) forOrderBy(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	fields []*query.Field,
+	orderBy []sqltypes.OrderByColumn,
+) ([]sqltypes.TextCompare, error) {
+	if c == nil {
+		return nil, nil
+	}
+	var collations []sqltypes.TextCompare
+	for i, ob := range orderBy {
+		if ob.Column >= len(fields) || !sqltypes.IsCollatable(fields[ob.Column]) {
+			continue
+		}
+		var collation sqltypes.TextCompare
+		var err error
+		if ob.Collation != "" {
+			collation, err = sqltypes.NewCollation(ob.Collation)
+		} else {
+			collation, err = c.databaseDefault(ctx, exec, conn, state)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if collations == nil {
+			collations = make([]sqltypes.TextCompare, len(orderBy))
+		}
+		collations[i] = collation
+	}
+	return collations, nil
+}
+
+// databaseDefault returns the default collation of the database of conn.
+func (c *Collations) databaseDefault(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+) (sqltypes.TextCompare, error) {
+	database := conn.Database()
+	c.mu.Lock()
+	collation, ok := c.defaults[database]
+	c.mu.Unlock()
+	if ok {
+		return collation, nil
+	}
+
+	var name string
+	found := false
+	err := exec.StreamExecute(ctx, conn, c.tableGroup, c.shard, defaultCollationQuery, state, func(_ context.Context, result *sqltypes.Result) error {
+		if len(result.Rows) > 0 && len(result.Rows[0].Values) > 0 {
+			name = string(result.Rows[0].Values[0])
+			found = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the default collation of database %q: %w", database, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("failed to read the default collation of database %q: database not found", database)
+	}
+	if collation, err = sqltypes.NewCollation(name); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.defaults[database] = collation
+	c.mu.Unlock()
+	return collation, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// Concatenate is a primitive that returns the rows of each of its inputs in
+// turn, for a UNION ALL whose branches run on different shards. The rows
+// are streamed as the inputs return them, and described by the fields of
+// the first input; the inputs must return columns of the same types.
+type Concatenate struct {
+	// Inputs are the branches of the UNION ALL.
+	Inputs []Primitive
+}
+
+// NewConcatenate creates a new Concatenate primitive.
+func NewConcatenate(inputs []Primitive) *Concatenate {
+	return &Concatenate{Inputs: inputs}
+}
+
+// StreamExecute implements the Primitive interface.
+func (c *Concatenate) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	var fields []*query.Field
+	sentFields := false
+	rows := 0
+	var notices []*sqltypes.Notice
+	for _, input := range c.Inputs {
+		err := input.StreamExecute(ctx, exec, conn, state, func(ctx context.Context, result *sqltypes.Result) error {
+			notices = append(notices, result.Notices...)
+			if len(result.Fields) > 0 {
+				if fields == nil {
+					fields = result.Fields
+				} else if err := checkUnionFields(fields, result.Fields); err != nil {
+					return err
+				}
+			}
+			if len(result.Rows) == 0 && (sentFields || fields == nil) {
+				return nil
+			}
+			chunk := &sqltypes.Result{Rows: result.Rows}
+			if !sentFields {
+				chunk.Fields = fields
+				sentFields = true
+			}
+			rows += len(result.Rows)
+			return callback(ctx, chunk)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	final := &sqltypes.Result{CommandTag: "SELECT " + strconv.Itoa(rows), Notices: notices}
+	if !sentFields {
+		final.Fields = fields
+	}
+	return callback(ctx, final)
+}
+
+// checkUnionFields checks that a branch of a UNION returns columns of the
+// same types as the first branch: the gateway cannot convert them to a
+// common type as PostgreSQL would.
+func checkUnionFields(first, fields []*query.Field) error {
+	if len(fields) != len(first) {
+		return sqlstate.NewError(sqlstate.SyntaxError).
+			Msg("each UNION query must have the same number of columns").
+			Err()
+	}
+	for i, field := range fields {
+		if field.DataTypeOid != first[i].DataTypeOid {
+			return sqlstate.NewError(sqlstate.FeatureNotSupported).
+				Msg("UNION of columns of different types on several shards is not supported").
+				Detail("Column %d is of type %s and %s.", i+1, ast.Oid(first[i].DataTypeOid), ast.Oid(field.DataTypeOid)).
+				Hint("Cast the columns of every branch to the same type.").
+				Err()
+		}
+	}
+	return nil
+}
+
+// GetTableGroup implements the Primitive interface.
+func (c *Concatenate) GetTableGroup() string {
+	return c.Inputs[0].GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (c *Concatenate) GetQuery() string {
+	return c.Inputs[0].GetQuery()
+}
+
+// String implements the Primitive interface.
+func (c *Concatenate) String() string {
+	inputs := make([]string, len(c.Inputs))
+	for i, input := range c.Inputs {
+		inputs[i] = input.String()
+	}
+	return fmt.Sprintf("Concatenate(%s)", strings.Join(inputs, ", "))
+}
+
+// Ensure Concatenate implements Primitive interface.
+var _ Primitive = (*Concatenate)(nil)
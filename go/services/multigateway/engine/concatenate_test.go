@@ -0,0 +1,72 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestConcatenate(t *testing.T) {
+	fields := []*query.Field{field("id", ast.INT4OID)}
+	other := []*query.Field{field("user_id", ast.INT4OID)}
+	concatenate := NewConcatenate([]Primitive{
+		&stubPrimitive{results: []*sqltypes.Result{
+			{Fields: fields, Rows: textRows([]string{"1"})},
+			{Rows: textRows([]string{"2"}), CommandTag: "SELECT 2"},
+		}},
+		&stubPrimitive{results: []*sqltypes.Result{{Fields: other, CommandTag: "SELECT 0"}}},
+		&stubPrimitive{results: []*sqltypes.Result{
+			{Fields: other, Rows: textRows([]string{"1"}), CommandTag: "SELECT 1", Notices: []*sqltypes.Notice{{Message: "n"}}},
+		}},
+	})
+
+	chunks, err := streamPrimitive(t, concatenate)
+	require.NoError(t, err)
+	rows, tag := streamedRows(t, chunks, fields)
+	assert.Equal(t, textRows([]string{"1"}, []string{"2"}, []string{"1"}), rows)
+	assert.Equal(t, "SELECT 3", tag)
+	assert.Len(t, chunks[len(chunks)-1].Notices, 1)
+}
+
+func TestConcatenateMismatchedColumns(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []*query.Field
+		code   string
+	}{
+		{name: "count", fields: []*query.Field{field("a", ast.INT4OID), field("b", ast.INT4OID)}, code: sqlstate.SyntaxError},
+		{name: "type", fields: []*query.Field{field("a", ast.INT8OID)}, code: sqlstate.FeatureNotSupported},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			concatenate := NewConcatenate([]Primitive{
+				&stubPrimitive{results: []*sqltypes.Result{{Fields: []*query.Field{field("id", ast.INT4OID)}, CommandTag: "SELECT 0"}}},
+				&stubPrimitive{results: []*sqltypes.Result{{Fields: tt.fields, CommandTag: "SELECT 0"}}},
+			})
+			_, err := streamPrimitive(t, concatenate)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, tt.code, diag.Code)
+		})
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// copyFormat describes how the rows of a text or CSV COPY are encoded,
+// following the options of the COPY statement.
+type copyFormat struct {
+	csv       bool
+	delimiter byte
+	quote     byte
+	escape    byte
+	null      string
+	header    bool
+}
+
+// parseCopyFormat returns the row format of a COPY FROM STDIN statement.
+// Binary COPY has no rows the gateway can split and is rejected.
+func parseCopyFormat(stmt *ast.CopyStmt) (copyFormat, error) {
+	options := make(map[string]string)
+	if stmt.Options != nil {
+		for _, item := range stmt.Options.Items {
+			if def, ok := item.(*ast.DefElem); ok {
+				options[strings.ToLower(def.Defname)] = copyOptionValue(def.Arg)
+			}
+		}
+	}
+
+	f := copyFormat{delimiter: '\t', null: `\N`}
+	switch strings.ToLower(options["format"]) {
+	case "", "text":
+	case "csv":
+		f = copyFormat{csv: true, delimiter: ',', quote: '"', escape: '"'}
+	case "binary":
+		return copyFormat{}, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("COPY in binary format is not supported for sharded tables").
+			Err()
+	default:
+		return copyFormat{}, sqlstate.NewError(sqlstate.InvalidParameterValue).
+			Msg("COPY format \"%s\" not recognized", options["format"]).
+			Err()
+	}
+
+	var err error
+	if v, ok := options["delimiter"]; ok {
+		if f.delimiter, err = copySingleByteOption("delimiter", v); err != nil {
+			return copyFormat{}, err
+		}
+	}
+	if v, ok := options["quote"]; ok {
+		if f.quote, err = copySingleByteOption("quote", v); err != nil {
+			return copyFormat{}, err
+		}
+		f.escape = f.quote
+	}
+	if v, ok := options["escape"]; ok {
+		if f.escape, err = copySingleByteOption("escape", v); err != nil {
+			return copyFormat{}, err
+		}
+	}
+	if v, ok := options["null"]; ok {
+		f.null = v
+	}
+	if v, ok := options["header"]; ok {
+		switch strings.ToLower(v) {
+		case "false", "off", "0":
+		default:
+			f.header = true
+		}
+	}
+	return f, nil
+}
+
+// copyOptionValue returns the value of a COPY option as a string. Options
+// given without a value, such as HEADER, are true.
+func copyOptionValue(arg ast.Node) string {
+	switch v := arg.(type) {
+	case nil:
+		return "true"
+	case *ast.String:
+		return v.SVal
+	case *ast.Integer:
+		return strconv.Itoa(v.IVal)
+	case *ast.Boolean:
+		return strconv.FormatBool(v.BoolVal)
+	default:
+		return ""
+	}
+}
+
+// copySingleByteOption validates a COPY option that must be a single byte.
+func copySingleByteOption(name, value string) (byte, error) {
+	if len(value) != 1 {
+		return 0, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("COPY %s must be a single one-byte character", name).
+			Err()
+	}
+	return value[0], nil
+}
+
+// copyRowSplitter splits a stream of COPY data into rows. CopyData messages
+// need not align with rows, so a partial row is carried over to the next
+// chunk; the scan state is kept with it so each byte is only scanned once.
+type copyRowSplitter struct {
+	format copyFormat
+
+	// pending holds the start of a row whose end has not been seen yet.
+	pending []byte
+	// scanned is how much of pending has already been scanned.
+	scanned int
+	// inQuote is set while inside a quoted CSV field.
+	inQuote bool
+	// escaped is set when the last scanned byte escapes the next one.
+	escaped bool
+	// done is set once the end-of-data marker has been seen.
+	done bool
+}
+
+// write splits chunk into rows, calling fn with each complete row including
+// its line terminator. The row is only valid until fn returns.
+func (s *copyRowSplitter) write(chunk []byte, fn func(row []byte) error) error {
+	if s.done {
+		return nil
+	}
+	s.pending = append(s.pending, chunk...)
+
+	start := 0
+	for i := s.scanned; i < len(s.pending); i++ {
+		b := s.pending[i]
+		switch {
+		case s.escaped:
+			s.escaped = false
+		case !s.format.csv && b == '\\':
+			s.escaped = true
+		case s.format.csv && s.inQuote && b == s.format.escape && s.format.escape != s.format.quote:
+			s.escaped = true
+		case s.format.csv && b == s.format.quote:
+			s.inQuote = !s.inQuote
+		case b == '\n' && !s.inQuote:
+			row := s.pending[start : i+1]
+			start = i + 1
+			if isCopyEndMarker(row) {
+				s.done = true
+				s.pending = nil
+				return nil
+			}
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Keep the partial row for the next chunk.
+	s.pending = append(s.pending[:0], s.pending[start:]...)
+	s.scanned = len(s.pending)
+	return nil
+}
+
+// finish calls fn with the last row if the data did not end with a line
+// terminator.
+func (s *copyRowSplitter) finish(fn func(row []byte) error) error {
+	if s.done || len(s.pending) == 0 {
+		return nil
+	}
+	row := s.pending
+	s.pending = nil
+	if isCopyEndMarker(row) {
+		return nil
+	}
+	return fn(row)
+}
+
+// isCopyEndMarker reports whether row is the \. line that ends COPY data.
+func isCopyEndMarker(row []byte) bool {
+	row = bytes.TrimRight(row, "\r\n")
+	return len(row) == 2 && row[0] == '\\' && row[1] == '.'
+}
+
+// field returns the decoded value of the field at index in row and whether
+// it is NULL. It returns ok=false if the row has no such field.
+func (f copyFormat) field(row []byte, index int) (value []byte, isNull bool, ok bool) {
+	row = bytes.TrimSuffix(row, []byte("\n"))
+	row = bytes.TrimSuffix(row, []byte("\r"))
+
+	start, n := 0, 0
+	inQuote, escaped := false, false
+	for i := 0; i <= len(row); i++ {
+		if i < len(row) {
+			b := row[i]
+			switch {
+			case escaped:
+				escaped = false
+				continue
+			case !f.csv && b == '\\':
+				escaped = true
+				continue
+			case f.csv && inQuote && b == f.escape && f.escape != f.quote:
+				escaped = true
+				continue
+			case f.csv && b == f.quote:
+				inQuote = !inQuote
+				continue
+			case b != f.delimiter || inQuote:
+				continue
+			}
+		}
+
+		// End of a field.
+		if n == index {
+			raw := row[start:i]
+			if f.csv {
+				return f.decodeCSVField(raw)
+			}
+			if string(raw) == f.null {
+				return nil, true, true
+			}
+			return decodeCopyText(raw), false, true
+		}
+		n++
+		start = i + 1
+	}
+	return nil, false, false
+}
+
+// decodeCSVField removes the quoting of a CSV field. As in PostgreSQL, only
+// an unquoted field matching the NULL string is NULL.
+func (f copyFormat) decodeCSVField(raw []byte) ([]byte, bool, bool) {
+	if string(raw) == f.null {
+		return nil, true, true
+	}
+
+	value := make([]byte, 0, len(raw))
+	inQuote := false
+	for i := 0; i < len(raw); i++ {
+		b := raw[i]
+		switch {
+		case inQuote && b == f.escape && i+1 < len(raw) && (raw[i+1] == f.quote || raw[i+1] == f.escape) &&
+			(f.escape != f.quote || raw[i+1] == f.quote):
+			value = append(value, raw[i+1])
+			i++
+		case b == f.quote:
+			inQuote = !inQuote
+		default:
+			value = append(value, b)
+		}
+	}
+	return value, false, true
+}
+
+// decodeCopyText decodes the backslash escapes of a text-format COPY field.
+func decodeCopyText(raw []byte) []byte {
+	if bytes.IndexByte(raw, '\\') < 0 {
+		return raw
+	}
+
+	value := make([]byte, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' || i+1 == len(raw) {
+			value = append(value, raw[i])
+			continue
+		}
+		i++
+		switch c := raw[i]; c {
+		case 'b':
+			value = append(value, '\b')
+		case 'f':
+			value = append(value, '\f')
+		case 'n':
+			value = append(value, '\n')
+		case 'r':
+			value = append(value, '\r')
+		case 't':
+			value = append(value, '\t')
+		case 'v':
+			value = append(value, '\v')
+		case 'x':
+			// \x followed by one or two hex digits.
+			j := i + 1
+			for j < len(raw) && j < i+3 && isHexDigit(raw[j]) {
+				j++
+			}
+			if j == i+1 {
+				value = append(value, c)
+				continue
+			}
+			n, _ := strconv.ParseUint(string(raw[i+1:j]), 16, 8)
+			value = append(value, byte(n))
+			i = j - 1
+		default:
+			if c < '0' || c > '7' {
+				value = append(value, c)
+				continue
+			}
+			// One to three octal digits.
+			j := i + 1
+			for j < len(raw) && j < i+3 && raw[j] >= '0' && raw[j] <= '7' {
+				j++
+			}
+			n, _ := strconv.ParseUint(string(raw[i:j]), 8, 16)
+			value = append(value, byte(n))
+			i = j - 1
+		}
+	}
+	return value
+}
+
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
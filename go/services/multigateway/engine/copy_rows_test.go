@@ -0,0 +1,152 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+)
+
+func parseCopyStmt(t *testing.T, sql string) *ast.CopyStmt {
+	t.Helper()
+	stmts, err := parser.ParseSQL(sql)
+	require.NoError(t, err)
+	return stmts[0].(*ast.CopyStmt)
+}
+
+func TestParseCopyFormat(t *testing.T) {
+	tests := []struct {
+		sql     string
+		want    copyFormat
+		wantErr string
+	}{
+		{
+			sql:  "COPY t FROM STDIN",
+			want: copyFormat{delimiter: '\t', null: `\N`},
+		},
+		{
+			sql:  "COPY t FROM STDIN WITH CSV HEADER",
+			want: copyFormat{csv: true, delimiter: ',', quote: '"', escape: '"', header: true},
+		},
+		{
+			sql:  "COPY t FROM STDIN (FORMAT csv, DELIMITER ';', QUOTE '''', NULL 'NULL')",
+			want: copyFormat{csv: true, delimiter: ';', quote: '\'', escape: '\'', null: "NULL"},
+		},
+		{
+			sql:  `COPY t FROM STDIN (FORMAT csv, ESCAPE '\')`,
+			want: copyFormat{csv: true, delimiter: ',', quote: '"', escape: '\\'},
+		},
+		{
+			sql:     "COPY t FROM STDIN (FORMAT binary)",
+			wantErr: "binary format is not supported",
+		},
+		{
+			sql:     "COPY t FROM STDIN (DELIMITER '||')",
+			wantErr: "single one-byte character",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			got, err := parseCopyFormat(parseCopyStmt(t, tt.sql))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func splitCopyRows(t *testing.T, format copyFormat, chunks ...string) []string {
+	t.Helper()
+	var rows []string
+	collect := func(row []byte) error {
+		rows = append(rows, string(row))
+		return nil
+	}
+	s := &copyRowSplitter{format: format}
+	for _, chunk := range chunks {
+		require.NoError(t, s.write([]byte(chunk), collect))
+	}
+	require.NoError(t, s.finish(collect))
+	return rows
+}
+
+func TestCopyRowSplitter(t *testing.T) {
+	text := copyFormat{delimiter: '\t', null: `\N`}
+	csv := copyFormat{csv: true, delimiter: ',', quote: '"', escape: '"'}
+
+	t.Run("rows split across chunks", func(t *testing.T) {
+		rows := splitCopyRows(t, text, "1\tfo", "o\n2\tbar\n3", "\tbaz")
+		assert.Equal(t, []string{"1\tfoo\n", "2\tbar\n", "3\tbaz"}, rows)
+	})
+
+	t.Run("escaped newline in text", func(t *testing.T) {
+		rows := splitCopyRows(t, text, "1\tline\\", "\nbreak\n2\tx\n")
+		assert.Equal(t, []string{"1\tline\\\nbreak\n", "2\tx\n"}, rows)
+	})
+
+	t.Run("end-of-data marker", func(t *testing.T) {
+		rows := splitCopyRows(t, text, "1\ta\n\\.\n2\tignored\n")
+		assert.Equal(t, []string{"1\ta\n"}, rows)
+	})
+
+	t.Run("quoted newline in CSV", func(t *testing.T) {
+		rows := splitCopyRows(t, csv, "1,\"multi\n", "line\"\n2,\"say \"\"hi\"\"\"\n")
+		assert.Equal(t, []string{"1,\"multi\nline\"\n", "2,\"say \"\"hi\"\"\"\n"}, rows)
+	})
+}
+
+func TestCopyFormatField(t *testing.T) {
+	text := copyFormat{delimiter: '\t', null: `\N`}
+	csv := copyFormat{csv: true, delimiter: ',', quote: '"', escape: '"'}
+
+	tests := []struct {
+		name     string
+		format   copyFormat
+		row      string
+		index    int
+		want     string
+		wantNull bool
+		wantOK   bool
+	}{
+		{name: "text first", format: text, row: "42\tfoo\n", index: 0, want: "42", wantOK: true},
+		{name: "text last", format: text, row: "42\tfoo\r\n", index: 1, want: "foo", wantOK: true},
+		{name: "text escapes", format: text, row: "a\\tb\\\\c\\x41\\101\tfoo\n", index: 0, want: "a\tb\\cAA", wantOK: true},
+		{name: "text escaped delimiter", format: text, row: "a\\\tb\tc\n", index: 1, want: "c", wantOK: true},
+		{name: "text null", format: text, row: "\\N\tfoo\n", index: 0, wantNull: true, wantOK: true},
+		{name: "text missing", format: text, row: "42\n", index: 1},
+		{name: "csv quoted delimiter", format: csv, row: "\"a,b\",c\n", index: 0, want: "a,b", wantOK: true},
+		{name: "csv doubled quote", format: csv, row: "x,\"say \"\"hi\"\"\"\n", index: 1, want: `say "hi"`, wantOK: true},
+		{name: "csv null", format: csv, row: ",x\n", index: 0, wantNull: true, wantOK: true},
+		{name: "csv quoted empty is not null", format: csv, row: "\"\",x\n", index: 0, want: "", wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, isNull, ok := tt.format.field([]byte(tt.row), tt.index)
+			require.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantNull, isNull)
+			if tt.wantOK && !tt.wantNull {
+				assert.Equal(t, tt.want, string(value))
+			}
+		})
+	}
+}
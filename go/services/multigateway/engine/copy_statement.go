@@ -26,7 +26,8 @@ import (
 )
 
 // CopyStatement implements the Primitive interface for executing COPY statements.
-// Currently supports COPY FROM STDIN; extensible for COPY TO STDOUT and other COPY variants.
+// Supports COPY FROM STDIN and COPY TO STDOUT, streaming CopyData between the
+// client and the pooler.
 type CopyStatement struct {
 	TableGroup string
 	Query      string
@@ -43,7 +44,7 @@ func NewCopyStatement(tableGroup, query string, copyStmt *ast.CopyStmt) *CopySta
 }
 
 // StreamExecute implements the Primitive interface.
-// Orchestrates COPY operations (currently COPY FROM STDIN).
+// Orchestrates COPY FROM STDIN and COPY TO STDOUT operations.
 func (c *CopyStatement) StreamExecute(
 	ctx context.Context,
 	exec IExecute,
@@ -55,6 +56,10 @@ func (c *CopyStatement) StreamExecute(
 	// this will need to be determined from the COPY target table.
 	shard := ""
 
+	if !c.CopyStmt.IsFrom {
+		return c.copyOut(ctx, exec, conn, shard, state, callback)
+	}
+
 	// Phase 1: INITIATE - Send COPY command to pooler
 	// CopyInitiate stores reserved connection info in state.ShardStates internally
 	format, columnFormats, err := exec.CopyInitiate(ctx, conn, c.TableGroup, shard, c.Query, state, func(ctx context.Context, result *sqltypes.Result) error {
@@ -126,6 +131,43 @@ func (c *CopyStatement) StreamExecute(
 	}
 }
 
+// copyOut executes a COPY TO STDOUT, relaying the CopyOutResponse and each
+// chunk of data from the pooler to the client. Writes go through the
+// connection's buffered writer, so a client that stops reading eventually
+// blocks the stream from the pooler, which in turn stops reading from
+// PostgreSQL.
+func (c *CopyStatement) copyOut(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	// If the COPY fails midway, the handler's ErrorResponse ends the client's
+	// COPY OUT mode without a CopyDone, as in PostgreSQL.
+	return exec.CopyOut(ctx, conn, c.TableGroup, shard, c.Query, state,
+		func(ctx context.Context, format int16, columnFormats []int16) error {
+			if err := conn.WriteCopyOutResponse(format, columnFormats); err != nil {
+				return fmt.Errorf("failed to write CopyOutResponse: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context, data []byte) error {
+			if err := conn.WriteCopyData(data); err != nil {
+				return fmt.Errorf("failed to write CopyData: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context, result *sqltypes.Result) error {
+			if err := conn.WriteCopyDone(); err != nil {
+				return fmt.Errorf("failed to write CopyDone: %w", err)
+			}
+			return callback(ctx, result)
+		},
+	)
+}
+
 // GetTableGroup implements the Primitive interface.
 func (c *CopyStatement) GetTableGroup() string {
 	return c.TableGroup
@@ -142,7 +184,11 @@ func (c *CopyStatement) String() string {
 	if !c.CopyStmt.IsFrom {
 		direction = "TO STDOUT"
 	}
-	return fmt.Sprintf("CopyStatement(%s %s)", c.CopyStmt.Relation.RelName, direction)
+	source := "query"
+	if c.CopyStmt.Relation != nil {
+		source = c.CopyStmt.Relation.RelName
+	}
+	return fmt.Sprintf("CopyStatement(%s %s)", source, direction)
 }
 
 // Ensure CopyStatement implements Primitive interface.
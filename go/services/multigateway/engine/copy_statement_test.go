@@ -33,6 +33,10 @@ import (
 
 // mockIExecute is a mock implementation of IExecute for testing CopyStatement.
 type mockIExecute struct {
+	// StreamExecute behavior
+	streamResults []*sqltypes.Result
+	queries       []string
+
 	// CopyInitiate behavior
 	copyInitiateErr     error
 	copyInitiateFormat  int16
@@ -44,9 +48,41 @@ type mockIExecute struct {
 	// CopyFinalize behavior
 	copyFinalizeErr error
 
+	// CopyOut behavior
+	copyOutData   [][]byte
+	copyOutErr    error
+	copyOutResult *sqltypes.Result
+
 	// Track calls
 	copyAbortCalled atomic.Int32
 	copyAbortErr    error
+
+	// Listen/Unlisten behavior
+	listenErr       error
+	listenChannel   string
+	unlistenChannel string
+	unlistenCalled  bool
+
+	// Advisory lock behavior
+	pinErr        error
+	pinCalled     bool
+	unpinCalled   bool
+	releaseCalled bool
+
+	// Temporary table behavior
+	pinTempCalled bool
+	trackedUsage  *TempTableUsage
+
+	// Cursor behavior
+	pinCursorsCalled    bool
+	trackedCursorsUsage *CursorUsage
+
+	// Savepoint behavior
+	savepointKind ast.TransactionStmtKind
+	savepointName string
+
+	// Scatter behavior
+	scatterShards []string
 }
 
 func (m *mockIExecute) StreamExecute(
@@ -58,6 +94,12 @@ func (m *mockIExecute) StreamExecute(
 	state *handler.MultiGatewayConnectionState,
 	callback func(context.Context, *sqltypes.Result) error,
 ) error {
+	m.queries = append(m.queries, sql)
+	for _, result := range m.streamResults {
+		if err := callback(ctx, result); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -135,6 +177,218 @@ func (m *mockIExecute) CopyAbort(
 	return m.copyAbortErr
 }
 
+func (m *mockIExecute) CopyOut(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	queryStr string,
+	state *handler.MultiGatewayConnectionState,
+	onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+	onData func(ctx context.Context, data []byte) error,
+	callback func(ctx context.Context, result *sqltypes.Result) error,
+) error {
+	if err := onReady(ctx, 0, []int16{0, 0}); err != nil {
+		return err
+	}
+	for _, data := range m.copyOutData {
+		if err := onData(ctx, data); err != nil {
+			return err
+		}
+	}
+	if m.copyOutErr != nil {
+		return m.copyOutErr
+	}
+	return callback(ctx, m.copyOutResult)
+}
+
+func (m *mockIExecute) Listen(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	channel string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.listenChannel = channel
+	return m.listenErr
+}
+
+func (m *mockIExecute) Unlisten(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	channel string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.unlistenCalled = true
+	m.unlistenChannel = channel
+	return nil
+}
+
+func (m *mockIExecute) PinForAdvisoryLocks(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.pinCalled = true
+	return m.pinErr
+}
+
+func (m *mockIExecute) UnpinIfNoAdvisoryLocks(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.unpinCalled = true
+	return nil
+}
+
+func (m *mockIExecute) ReleaseAdvisoryLocks(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.releaseCalled = true
+	return nil
+}
+
+func (m *mockIExecute) PinForTempTables(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.pinTempCalled = true
+	return m.pinErr
+}
+
+func (m *mockIExecute) TrackTempTables(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage TempTableUsage,
+) error {
+	m.trackedUsage = &usage
+	return nil
+}
+
+func (m *mockIExecute) ReleaseTempTables(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	return nil
+}
+
+func (m *mockIExecute) PinForCursors(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.pinCursorsCalled = true
+	return m.pinErr
+}
+
+func (m *mockIExecute) TrackCursors(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage CursorUsage,
+) error {
+	m.trackedCursorsUsage = &usage
+	return nil
+}
+
+func (m *mockIExecute) ReleaseCursors(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	return nil
+}
+
+func (m *mockIExecute) BeginImplicitTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	return nil
+}
+
+func (m *mockIExecute) EndImplicitTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	commit bool,
+) error {
+	return nil
+}
+
+func (m *mockIExecute) EndTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	commit bool,
+	sql string,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return m.StreamExecute(ctx, conn, tableGroup, shard, sql, state, callback)
+}
+
+func (m *mockIExecute) ExecuteSavepoint(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	kind ast.TransactionStmtKind,
+	name string,
+	sql string,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	m.savepointKind = kind
+	m.savepointName = name
+	return m.StreamExecute(ctx, conn, tableGroup, shard, sql, state, callback)
+}
+
+func (m *mockIExecute) ScatterExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shards []string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	m.scatterShards = shards
+	return m.StreamExecute(ctx, conn, tableGroup, "", sql, state, callback)
+}
+
 // Helper to create a CopyStatement for testing
 func newTestCopyStatement() *CopyStatement {
 	return NewCopyStatement("test_tablegroup", "COPY t FROM STDIN", &ast.CopyStmt{
@@ -349,6 +603,71 @@ func TestCopyStatement_UnexpectedMessageType(t *testing.T) {
 	require.Equal(t, int32(1), mockExec.copyAbortCalled.Load())
 }
 
+// TestCopyStatement_CopyOut tests that COPY TO STDOUT relays the COPY
+// messages to the client, then passes the result to the callback.
+func TestCopyStatement_CopyOut(t *testing.T) {
+	mockExec := &mockIExecute{
+		copyOutData:   [][]byte{[]byte("1\tone\n"), []byte("2\ttwo\n")},
+		copyOutResult: &sqltypes.Result{CommandTag: "COPY 2", RowsAffected: 2},
+	}
+	testConn := server.NewTestConn(&bytes.Buffer{})
+	copyStmt := NewCopyStatement("test_tablegroup", "COPY t TO STDOUT", &ast.CopyStmt{
+		Relation: &ast.RangeVar{RelName: "t"},
+	})
+
+	var gotResult *sqltypes.Result
+	err := copyStmt.StreamExecute(
+		context.Background(),
+		mockExec,
+		testConn.Conn,
+		&handler.MultiGatewayConnectionState{},
+		func(ctx context.Context, result *sqltypes.Result) error {
+			gotResult = result
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "COPY 2", gotResult.CommandTag)
+	require.NoError(t, testConn.Flush())
+
+	// CopyOutResponse, two CopyData messages and CopyDone.
+	out := testConn.WriteBuf.Bytes()
+	require.Equal(t, []byte{'H', 0, 0, 0, 11, 0, 0, 2, 0, 0, 0, 0}, out[:12])
+	out = out[12:]
+	require.Equal(t, append([]byte{'d', 0, 0, 0, 10}, "1\tone\n"...), out[:11])
+	out = out[11:]
+	require.Equal(t, append([]byte{'d', 0, 0, 0, 10}, "2\ttwo\n"...), out[:11])
+	out = out[11:]
+	require.Equal(t, []byte{'c', 0, 0, 0, 4}, out)
+}
+
+// TestCopyStatement_CopyOutError tests that a COPY TO STDOUT failing midway
+// returns the error without sending CopyDone.
+func TestCopyStatement_CopyOutError(t *testing.T) {
+	mockExec := &mockIExecute{
+		copyOutData: [][]byte{[]byte("1\tone\n")},
+		copyOutErr:  errors.New("backend failed"),
+	}
+	testConn := server.NewTestConn(&bytes.Buffer{})
+	copyStmt := NewCopyStatement("test_tablegroup", "COPY t TO STDOUT", &ast.CopyStmt{
+		Relation: &ast.RangeVar{RelName: "t"},
+	})
+
+	err := copyStmt.StreamExecute(
+		context.Background(),
+		mockExec,
+		testConn.Conn,
+		&handler.MultiGatewayConnectionState{},
+		func(ctx context.Context, result *sqltypes.Result) error {
+			t.Fatal("callback should not be called")
+			return nil
+		},
+	)
+	require.ErrorContains(t, err, "backend failed")
+	require.NoError(t, testConn.Flush())
+	require.NotContains(t, testConn.WriteBuf.String(), "c\x00\x00\x00\x04")
+}
+
 // TestCopyStatement_String tests the String method.
 func TestCopyStatement_String(t *testing.T) {
 	tests := []struct {
@@ -369,14 +688,20 @@ func TestCopyStatement_String(t *testing.T) {
 			relName:  "orders",
 			expected: "CopyStatement(orders TO STDOUT)",
 		},
+		{
+			name:     "COPY query TO STDOUT",
+			isFrom:   false,
+			expected: "CopyStatement(query TO STDOUT)",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			copyStmt := NewCopyStatement("tg", "query", &ast.CopyStmt{
-				IsFrom:   tt.isFrom,
-				Relation: &ast.RangeVar{RelName: tt.relName},
-			})
+			stmt := &ast.CopyStmt{IsFrom: tt.isFrom}
+			if tt.relName != "" {
+				stmt.Relation = &ast.RangeVar{RelName: tt.relName}
+			}
+			copyStmt := NewCopyStatement("tg", "query", stmt)
 			require.Equal(t, tt.expected, copyStmt.String())
 		})
 	}
@@ -0,0 +1,162 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// CursorUsage describes how a statement opens or closes SQL cursors
+// (DECLARE CURSOR). FETCH and MOVE use a cursor without changing which
+// cursors are open, and need no tracking: they run on the session's pinned
+// connection like any other statement.
+type CursorUsage struct {
+	// Declares is the name of the cursor the statement declares, if any.
+	Declares string
+	// Holdable is set if the declared cursor is WITH HOLD, so it outlives
+	// the transaction that declared it.
+	Holdable bool
+	// Closes is the name of the cursor the statement closes, if any.
+	Closes string
+	// ClosesAll is set for CLOSE ALL.
+	ClosesAll bool
+	// EndsTransaction is set for statements ending the current transaction,
+	// which close the cursors that are not WITH HOLD.
+	EndsTransaction bool
+}
+
+// Any returns true if the statement changes the session's open cursors.
+func (u CursorUsage) Any() bool {
+	return u.Declares != "" || u.Closes != "" || u.ClosesAll || u.EndsTransaction
+}
+
+// DetectCursors finds the cursors stmt declares or closes.
+func DetectCursors(stmt ast.Stmt) CursorUsage {
+	var usage CursorUsage
+	switch s := stmt.(type) {
+	case *ast.DeclareCursorStmt:
+		usage.Declares = s.PortalName
+		usage.Holdable = s.Options&ast.CURSOR_OPT_HOLD != 0
+	case *ast.ClosePortalStmt:
+		if s.PortalName == "" {
+			usage.ClosesAll = true
+		} else {
+			usage.Closes = s.PortalName
+		}
+	case *ast.TransactionStmt:
+		switch s.Kind {
+		case ast.TRANS_STMT_COMMIT, ast.TRANS_STMT_ROLLBACK, ast.TRANS_STMT_PREPARE:
+			usage.EndsTransaction = true
+		}
+	}
+	return usage
+}
+
+// ExecuteWithCursors runs execute, a statement with the given cursor usage,
+// keeping the session pinned to its reserved connection while it has open
+// cursors.
+//
+// The session is pinned before a statement that declares a cursor, since
+// the cursor only exists on the backend that declared it. Once execute
+// succeeds the opened and closed cursors are recorded, and the pin is
+// dropped when none are left.
+func ExecuteWithCursors(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage CursorUsage,
+	execute func() error,
+) error {
+	if usage.Declares != "" {
+		if err := exec.PinForCursors(ctx, conn, tableGroup, shard, state); err != nil {
+			return err
+		}
+	}
+
+	err := execute()
+	if err != nil {
+		// A failed COMMIT or ROLLBACK still ends the transaction; any other
+		// failed statement changed nothing, but a new pin may be unneeded.
+		usage = CursorUsage{EndsTransaction: usage.EndsTransaction}
+	}
+
+	if trackErr := exec.TrackCursors(ctx, conn, tableGroup, shard, state, usage); err == nil {
+		err = trackErr
+	}
+	return err
+}
+
+// CursorRoute is a Route for statements that declare or close SQL cursors,
+// and for those ending a transaction while cursors are open. A cursor lives
+// on the backend connection that declared it, so the session is pinned to a
+// reserved connection while it has open cursors, and FETCH, MOVE and CLOSE
+// reach the right backend.
+type CursorRoute struct {
+	TableGroup string
+	Shard      string
+	Query      string
+	Usage      CursorUsage
+}
+
+// NewCursorRoute creates a new CursorRoute primitive.
+func NewCursorRoute(tableGroup, shard, query string, usage CursorUsage) *CursorRoute {
+	return &CursorRoute{
+		TableGroup: tableGroup,
+		Shard:      shard,
+		Query:      query,
+		Usage:      usage,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *CursorRoute) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return ExecuteWithCursors(ctx, exec, conn, r.TableGroup, r.Shard, state, r.Usage, func() error {
+		return exec.StreamExecute(ctx, conn, r.TableGroup, r.Shard, r.Query, state, callback)
+	})
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *CursorRoute) GetTableGroup() string {
+	return r.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (r *CursorRoute) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *CursorRoute) String() string {
+	return fmt.Sprintf("CursorRoute(tablegroup=%s, declares=%q, holdable=%t, closes=%q, closes_all=%t, ends_transaction=%t, query=%s)",
+		r.TableGroup, r.Usage.Declares, r.Usage.Holdable, r.Usage.Closes, r.Usage.ClosesAll, r.Usage.EndsTransaction, r.Query)
+}
+
+// Ensure CursorRoute implements Primitive interface.
+var _ Primitive = (*CursorRoute)(nil)
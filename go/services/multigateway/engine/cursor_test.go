@@ -0,0 +1,109 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestDetectCursors(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected CursorUsage
+	}{
+		{"SELECT 1", CursorUsage{}},
+		{"DECLARE c CURSOR FOR SELECT 1", CursorUsage{Declares: "c"}},
+		{"DECLARE c BINARY SCROLL CURSOR WITH HOLD FOR SELECT 1", CursorUsage{Declares: "c", Holdable: true}},
+		{"DECLARE c CURSOR WITHOUT HOLD FOR SELECT 1", CursorUsage{Declares: "c"}},
+		// FETCH and MOVE follow the pin and need no tracking.
+		{"FETCH 10 FROM c", CursorUsage{}},
+		{"MOVE LAST IN c", CursorUsage{}},
+		{"CLOSE c", CursorUsage{Closes: "c"}},
+		{"CLOSE ALL", CursorUsage{ClosesAll: true}},
+		{"COMMIT", CursorUsage{EndsTransaction: true}},
+		{"ROLLBACK", CursorUsage{EndsTransaction: true}},
+		{"PREPARE TRANSACTION 'tx'", CursorUsage{EndsTransaction: true}},
+		{"BEGIN", CursorUsage{}},
+		{"ROLLBACK TO SAVEPOINT s", CursorUsage{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmts, err := parser.ParseSQL(tt.sql)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			assert.Equal(t, tt.expected, DetectCursors(stmts[0]))
+		})
+	}
+}
+
+func TestExecuteWithCursors(t *testing.T) {
+	state := &handler.MultiGatewayConnectionState{}
+
+	t.Run("declare pins before executing and tracks after", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		usage := CursorUsage{Declares: "c", Holdable: true}
+		err := ExecuteWithCursors(context.Background(), mockExec, nil, "tg", "", state, usage,
+			func() error {
+				assert.True(t, mockExec.pinCursorsCalled, "session must be pinned before the cursor is declared")
+				return nil
+			})
+		require.NoError(t, err)
+		require.NotNil(t, mockExec.trackedCursorsUsage)
+		assert.Equal(t, usage, *mockExec.trackedCursorsUsage)
+	})
+
+	t.Run("failed declare tracks nothing", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithCursors(context.Background(), mockExec, nil, "tg", "", state,
+			CursorUsage{Declares: "c"},
+			func() error { return errors.New("DECLARE CURSOR can only be used in transaction blocks") })
+		require.Error(t, err)
+		require.NotNil(t, mockExec.trackedCursorsUsage)
+		assert.False(t, mockExec.trackedCursorsUsage.Any())
+	})
+
+	t.Run("failed commit still ends the transaction", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithCursors(context.Background(), mockExec, nil, "tg", "", state,
+			CursorUsage{EndsTransaction: true},
+			func() error { return errors.New("could not serialize access") })
+		require.Error(t, err)
+		assert.False(t, mockExec.pinCursorsCalled)
+		require.NotNil(t, mockExec.trackedCursorsUsage)
+		assert.Equal(t, CursorUsage{EndsTransaction: true}, *mockExec.trackedCursorsUsage)
+	})
+
+	t.Run("pin failure skips execution", func(t *testing.T) {
+		mockExec := &mockIExecute{pinErr: errors.New("no pooler")}
+		executed := false
+		err := ExecuteWithCursors(context.Background(), mockExec, nil, "tg", "", state,
+			CursorUsage{Declares: "c"},
+			func() error {
+				executed = true
+				return nil
+			})
+		require.ErrorContains(t, err, "no pooler")
+		assert.False(t, executed)
+	})
+}
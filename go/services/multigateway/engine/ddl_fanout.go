@@ -0,0 +1,195 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// DDLFailurePolicy is what a schema change on several shards does when a
+// shard fails it.
+type DDLFailurePolicy string
+
+const (
+	// DDLFailureStop leaves the shards after the failing one unchanged.
+	DDLFailureStop DDLFailurePolicy = "stop"
+	// DDLFailureContinue applies the change on the shards after the
+	// failing one, so that a single failing shard is left to fix.
+	DDLFailureContinue DDLFailurePolicy = "continue"
+)
+
+// ParseDDLFailurePolicy parses the name of a DDL failure policy.
+func ParseDDLFailurePolicy(name string) (DDLFailurePolicy, error) {
+	switch policy := DDLFailurePolicy(strings.ToLower(name)); policy {
+	case DDLFailureStop, DDLFailureContinue:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid DDL failure policy %q: must be %q or %q", name, DDLFailureStop, DDLFailureContinue)
+}
+
+// DDLFanout is a primitive that runs a schema change, such as an ALTER
+// TABLE of a sharded table, on every shard of a tablegroup, one shard after
+// the other in the order of Shards, and returns the result of the first.
+//
+// Outside a transaction block, each shard commits its change on its own,
+// since statements such as CREATE INDEX CONCURRENTLY cannot run in a
+// transaction. The outcome of each shard is recorded in the Journal, and
+// running the statement again after a failure skips the shards it was
+// applied on, so that the schema of the shards converges. Inside a
+// transaction block, the change is part of the block on every shard and
+// stops at the first failure, which aborts the block.
+type DDLFanout struct {
+	// TableGroup is the target tablegroup for this query.
+	TableGroup string
+
+	// Shards are the shards of the tablegroup, in the order the change
+	// runs on them.
+	Shards []string
+
+	// Query is the SQL query string to execute.
+	Query string
+
+	// Policy is what the change does when a shard fails it.
+	Policy DDLFailurePolicy
+
+	// Journal records the progress of the change, or is nil to run it on
+	// every shard each time.
+	Journal *DDLJournal
+}
+
+// NewDDLFanout creates a new DDLFanout primitive.
+func NewDDLFanout(tableGroup string, shards []string, query string, policy DDLFailurePolicy, journal *DDLJournal) *DDLFanout {
+	return &DDLFanout{
+		TableGroup: tableGroup,
+		Shards:     shards,
+		Query:      query,
+		Policy:     policy,
+		Journal:    journal,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (d *DDLFanout) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	journal := d.Journal
+	if state.InTransaction() {
+		journal = nil
+	}
+	var id string
+	applied := map[string]bool{}
+	if journal != nil {
+		id = ddlID(d.TableGroup, d.Query)
+		var err error
+		if applied, err = journal.applied(ctx, id); err != nil {
+			return fmt.Errorf("failed to load the progress of the schema change: %w", err)
+		}
+	}
+
+	var result *sqltypes.Result
+	var done, failed []string
+	var firstErr error
+	for _, shard := range d.Shards {
+		if applied[shard] {
+			done = append(done, shard)
+			continue
+		}
+		shardResult, err := executeQuery(ctx, exec, conn, state, d.TableGroup, shard, d.Query)
+		if journal != nil {
+			if recordErr := journal.record(ctx, id, d.Query, shard, err); recordErr != nil {
+				return fmt.Errorf("failed to record the progress of the schema change on shard %s: %w", shard, recordErr)
+			}
+		}
+		if err != nil {
+			failed = append(failed, shard)
+			if firstErr == nil {
+				firstErr = err
+			}
+			if journal == nil || d.Policy != DDLFailureContinue {
+				break
+			}
+			continue
+		}
+		done = append(done, shard)
+		if result == nil {
+			result = shardResult
+		}
+	}
+	if firstErr != nil {
+		return d.failure(firstErr, done, failed, journal != nil)
+	}
+
+	if journal != nil {
+		if err := journal.forget(ctx, id); err != nil {
+			return fmt.Errorf("failed to clear the progress of the schema change: %w", err)
+		}
+	}
+	if result == nil {
+		result = &sqltypes.Result{}
+	}
+	return callback(ctx, result)
+}
+
+// failure returns the error of a schema change that failed on some shards,
+// err being that of the first, telling the shards it was applied on.
+func (d *DDLFanout) failure(err error, done, failed []string, resumable bool) error {
+	if len(d.Shards) == 1 {
+		return err
+	}
+	progress := fmt.Sprintf("The schema change was applied on shards [%s] and failed on shards [%s].",
+		strings.Join(done, ","), strings.Join(failed, ","))
+	var diag *sqltypes.PgDiagnostic
+	if !errors.As(err, &diag) {
+		return fmt.Errorf("%s: %w", progress, err)
+	}
+	annotated := *diag
+	if annotated.Detail != "" {
+		annotated.Detail += "\n"
+	}
+	annotated.Detail += progress
+	if resumable && annotated.Hint == "" {
+		annotated.Hint = "Fix the cause and run the statement again: the shards it was applied on are skipped."
+	}
+	return &annotated
+}
+
+// GetTableGroup implements the Primitive interface.
+func (d *DDLFanout) GetTableGroup() string {
+	return d.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (d *DDLFanout) GetQuery() string {
+	return d.Query
+}
+
+// String implements the Primitive interface.
+func (d *DDLFanout) String() string {
+	return fmt.Sprintf("DDLFanout(tablegroup=%s, shards=%s, policy=%s, query=%s)", d.TableGroup, strings.Join(d.Shards, ","), d.Policy, d.Query)
+}
+
+// Ensure DDLFanout implements Primitive interface.
+var _ Primitive = (*DDLFanout)(nil)
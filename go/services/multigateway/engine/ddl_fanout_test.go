@@ -0,0 +1,190 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// ddlExecute records the shards a schema change runs on, failing it on
+// the shards of failShards.
+type ddlExecute struct {
+	mockIExecute
+	failShards map[string]bool
+	shards     []string
+}
+
+func (e *ddlExecute) StreamExecute(
+	ctx context.Context,
+	_ *server.Conn,
+	_ string,
+	shard string,
+	_ string,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	e.shards = append(e.shards, shard)
+	if e.failShards[shard] {
+		return sqlstate.NewError(sqlstate.DuplicateColumn).Msg("column \"b\" already exists").Err()
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "ALTER TABLE"})
+}
+
+// fakeDDLJournalStore keeps the progress of schema changes in memory.
+type fakeDDLJournalStore struct {
+	progress map[string]map[string]string
+	forgot   []string
+}
+
+func (s *fakeDDLJournalStore) LoadDDLProgress(_ context.Context, _, _, id string) ([]string, error) {
+	var shards []string
+	for shard, errMsg := range s.progress[id] {
+		if errMsg == "" {
+			shards = append(shards, shard)
+		}
+	}
+	return shards, nil
+}
+
+func (s *fakeDDLJournalStore) RecordDDLProgress(_ context.Context, _, _, id, _, ddlShard, errMsg string) error {
+	if s.progress == nil {
+		s.progress = make(map[string]map[string]string)
+	}
+	if s.progress[id] == nil {
+		s.progress[id] = make(map[string]string)
+	}
+	s.progress[id][ddlShard] = errMsg
+	return nil
+}
+
+func (s *fakeDDLJournalStore) ForgetDDLProgress(_ context.Context, _, _, id string) error {
+	delete(s.progress, id)
+	s.forgot = append(s.forgot, id)
+	return nil
+}
+
+func runDDLFanout(t *testing.T, fanout *DDLFanout, exec IExecute, state *handler.MultiGatewayConnectionState) ([]*sqltypes.Result, error) {
+	t.Helper()
+	var results []*sqltypes.Result
+	err := fanout.StreamExecute(context.Background(), exec, nil, state, func(_ context.Context, result *sqltypes.Result) error {
+		results = append(results, result)
+		return nil
+	})
+	return results, err
+}
+
+const alterQuery = "ALTER TABLE users ADD COLUMN b int"
+
+func TestDDLFanout(t *testing.T) {
+	shards := []string{"0", "1", "2"}
+
+	t.Run("runs on every shard in order", func(t *testing.T) {
+		store := &fakeDDLJournalStore{}
+		exec := &ddlExecute{}
+		fanout := NewDDLFanout("tg", shards, alterQuery, DDLFailureStop, NewDDLJournal(store, "tg", "0"))
+		results, err := runDDLFanout(t, fanout, exec, handler.NewMultiGatewayConnectionState())
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "ALTER TABLE", results[0].CommandTag)
+		assert.Equal(t, shards, exec.shards)
+		// The progress of a completed change is forgotten.
+		assert.Equal(t, []string{ddlID("tg", alterQuery)}, store.forgot)
+		assert.Empty(t, store.progress)
+	})
+
+	t.Run("stops at a failure and resumes", func(t *testing.T) {
+		store := &fakeDDLJournalStore{}
+		exec := &ddlExecute{failShards: map[string]bool{"1": true}}
+		fanout := NewDDLFanout("tg", shards, alterQuery, DDLFailureStop, NewDDLJournal(store, "tg", "0"))
+		_, err := runDDLFanout(t, fanout, exec, handler.NewMultiGatewayConnectionState())
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag)
+		assert.Equal(t, sqlstate.DuplicateColumn, diag.Code)
+		assert.Equal(t, "The schema change was applied on shards [0] and failed on shards [1].", diag.Detail)
+		assert.NotEmpty(t, diag.Hint)
+		assert.Equal(t, []string{"0", "1"}, exec.shards)
+		messages := progressMessages(store)
+		require.Len(t, messages, 2)
+		assert.Empty(t, messages["0"])
+		assert.Contains(t, messages["1"], diag.Message)
+
+		// Once fixed, running it again skips the shard it was applied on.
+		exec = &ddlExecute{}
+		_, err = runDDLFanout(t, fanout, exec, handler.NewMultiGatewayConnectionState())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, exec.shards)
+		assert.Empty(t, store.progress)
+	})
+
+	t.Run("continues after a failure", func(t *testing.T) {
+		store := &fakeDDLJournalStore{}
+		exec := &ddlExecute{failShards: map[string]bool{"1": true}}
+		fanout := NewDDLFanout("tg", shards, alterQuery, DDLFailureContinue, NewDDLJournal(store, "tg", "0"))
+		_, err := runDDLFanout(t, fanout, exec, handler.NewMultiGatewayConnectionState())
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag)
+		assert.Equal(t, "The schema change was applied on shards [0,2] and failed on shards [1].", diag.Detail)
+		assert.Equal(t, shards, exec.shards)
+
+		exec = &ddlExecute{}
+		_, err = runDDLFanout(t, fanout, exec, handler.NewMultiGatewayConnectionState())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1"}, exec.shards)
+	})
+
+	t.Run("inside a transaction block", func(t *testing.T) {
+		store := &fakeDDLJournalStore{}
+		exec := &ddlExecute{failShards: map[string]bool{"1": true}}
+		fanout := NewDDLFanout("tg", shards, alterQuery, DDLFailureContinue, NewDDLJournal(store, "tg", "0"))
+		state := handler.NewMultiGatewayConnectionState()
+		state.BeginTransaction()
+		_, err := runDDLFanout(t, fanout, exec, state)
+		require.Error(t, err)
+		// The block is aborted by the failure: the change stops there and
+		// is not journaled, since it is rolled back with the block.
+		assert.Equal(t, []string{"0", "1"}, exec.shards)
+		assert.Empty(t, store.progress)
+	})
+}
+
+// progressMessages returns the error recorded for each shard of the only
+// schema change of store.
+func progressMessages(store *fakeDDLJournalStore) map[string]string {
+	for _, shards := range store.progress {
+		messages := make(map[string]string, len(shards))
+		for shard, errMsg := range shards {
+			messages[shard] = errMsg
+		}
+		return messages
+	}
+	return nil
+}
+
+func TestParseDDLFailurePolicy(t *testing.T) {
+	policy, err := ParseDDLFailurePolicy("Continue")
+	require.NoError(t, err)
+	assert.Equal(t, DDLFailureContinue, policy)
+	_, err = ParseDDLFailurePolicy("retry")
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// DDLJournalStore keeps the progress of the schema changes run on the
+// shards of a tablegroup.
+type DDLJournalStore interface {
+	// LoadDDLProgress returns the shards the schema change id was applied
+	// on, as kept on the given shard.
+	LoadDDLProgress(ctx context.Context, tableGroup, shard, id string) ([]string, error)
+
+	// RecordDDLProgress records, on the given shard, that the schema change
+	// id, running query, was applied on ddlShard, or failed there with
+	// errMsg when not empty.
+	RecordDDLProgress(ctx context.Context, tableGroup, shard, id, query, ddlShard, errMsg string) error
+
+	// ForgetDDLProgress removes the progress of the schema change id, once
+	// applied on every shard.
+	ForgetDDLProgress(ctx context.Context, tableGroup, shard, id string) error
+}
+
+// DDLJournal is the journal of the schema changes run on several shards,
+// recording the shards each was applied on so that running a change again
+// after a failure resumes it on the shards left, rather than failing on
+// those already changed. A schema change is identified by its tablegroup
+// and its text: the same statement run by several sessions at once shares
+// its progress.
+type DDLJournal struct {
+	store      DDLJournalStore
+	tableGroup string
+	shard      string
+}
+
+// NewDDLJournal creates the journal of the schema changes kept on the given
+// shard.
+func NewDDLJournal(store DDLJournalStore, tableGroup, shard string) *DDLJournal {
+	return &DDLJournal{
+		store:      store,
+		tableGroup: tableGroup,
+		shard:      shard,
+	}
+}
+
+// applied returns the shards the schema change id was applied on.
+func (j *DDLJournal) applied(ctx context.Context, id string) (map[string]bool, error) {
+	shards, err := j.store.LoadDDLProgress(ctx, j.tableGroup, j.shard, id)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[string]bool, len(shards))
+	for _, shard := range shards {
+		applied[shard] = true
+	}
+	return applied, nil
+}
+
+// record records the outcome of the schema change id on shard: applied if
+// err is nil, failed otherwise.
+func (j *DDLJournal) record(ctx context.Context, id, query, shard string, err error) error {
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+	return j.store.RecordDDLProgress(ctx, j.tableGroup, j.shard, id, query, shard, errMsg)
+}
+
+// forget removes the progress of the schema change id.
+func (j *DDLJournal) forget(ctx context.Context, id string) error {
+	return j.store.ForgetDDLProgress(ctx, j.tableGroup, j.shard, id)
+}
+
+// ddlID returns the identifier of the schema change running query on the
+// shards of tableGroup.
+func ddlID(tableGroup, query string) string {
+	sum := sha256.Sum256([]byte(tableGroup + "\x00" + query))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,348 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+const (
+	// distinctEntryOverhead approximates the memory used by an entry of
+	// the set of rows seen by a Distinct, besides its key.
+	distinctEntryOverhead = 48
+
+	// distinctSpillPartitions is the number of files the rows of a
+	// Distinct are spilled to.
+	distinctSpillPartitions = 16
+)
+
+// Distinct is a primitive that removes the duplicate rows of its input, for
+// the DISTINCT of a query on several shards or a UNION: each shard removes
+// its own duplicates, but the same row may come from several shards.
+//
+// The rows are streamed in the order of the input, keeping the first of
+// each set of duplicates, while their keys are remembered in a hash set.
+// The memory of the set counts against MaxMemory. Beyond SpillThreshold,
+// the rows not seen yet are written to temporary files partitioned by hash
+// instead, and deduplicated one partition at a time once the input ends:
+// they are then returned after the others, out of the order of the input.
+type Distinct struct {
+	// Input returns the rows to deduplicate.
+	Input Primitive
+
+	// Columns are the columns identifying duplicate rows, for DISTINCT
+	// ON. All the columns identify duplicates when empty.
+	Columns []int
+
+	// MaxMemory is the maximum number of bytes used to remember the rows
+	// seen. Zero means unlimited.
+	MaxMemory int64
+
+	// SpillThreshold is the number of bytes used to remember the rows seen
+	// beyond which the remaining rows are spilled to disk. Zero disables
+	// spilling, which must be disabled when the order of the rows matters.
+	SpillThreshold int64
+
+	// SpillDir is the directory of the spill files, the default directory
+	// for temporary files if empty.
+	SpillDir string
+}
+
+// NewDistinct creates a new Distinct primitive.
+func NewDistinct(input Primitive, columns []int) *Distinct {
+	return &Distinct{
+		Input:   input,
+		Columns: columns,
+	}
+}
+
+// distinctRun holds the state of an execution of a Distinct.
+type distinctRun struct {
+	*Distinct
+	callback func(context.Context, *sqltypes.Result) error
+
+	seen       map[string]struct{}
+	memory     int64
+	fields     []*query.Field
+	sentFields bool
+	rows       int
+	notices    []*sqltypes.Notice
+
+	// partitions are the spill files, once spilling started.
+	partitions []*os.File
+	writers    []*bufio.Writer
+}
+
+// StreamExecute implements the Primitive interface.
+func (d *Distinct) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	run := &distinctRun{Distinct: d, callback: callback, seen: make(map[string]struct{})}
+	defer run.removeSpillFiles()
+
+	err := d.Input.StreamExecute(ctx, exec, conn, state, func(ctx context.Context, result *sqltypes.Result) error {
+		if len(result.Fields) > 0 && run.fields == nil {
+			run.fields = result.Fields
+		}
+		run.notices = append(run.notices, result.Notices...)
+		var rows []*sqltypes.Row
+		for _, row := range result.Rows {
+			keep, err := run.add(row)
+			if err != nil {
+				return err
+			}
+			if keep {
+				rows = append(rows, row)
+			}
+		}
+		return run.send(ctx, rows)
+	})
+	if err != nil {
+		return err
+	}
+	if err := run.readSpilled(ctx); err != nil {
+		return err
+	}
+	return callback(ctx, &sqltypes.Result{
+		Fields:     run.unsentFields(),
+		CommandTag: "SELECT " + strconv.Itoa(run.rows),
+		Notices:    run.notices,
+	})
+}
+
+// add remembers row, returning true if it is the first of its duplicates
+// to be returned now. Once spilling, the rows not seen yet are spilled
+// instead.
+func (r *distinctRun) add(row *sqltypes.Row) (bool, error) {
+	key := r.key(row)
+	if _, ok := r.seen[key]; ok {
+		return false, nil
+	}
+	if r.partitions != nil {
+		return false, r.spill(key, row)
+	}
+	if err := r.remember(key); err != nil {
+		return false, err
+	}
+	if r.SpillThreshold > 0 && r.memory > r.SpillThreshold {
+		if err := r.createSpillFiles(); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// remember adds key to the rows seen, accounting for its memory.
+func (r *distinctRun) remember(key string) error {
+	r.seen[key] = struct{}{}
+	return r.account(key)
+}
+
+// account adds the memory used to remember key, failing the query beyond
+// MaxMemory.
+func (r *distinctRun) account(key string) error {
+	r.memory += int64(len(key)) + distinctEntryOverhead
+	if r.MaxMemory > 0 && r.memory > r.MaxMemory {
+		return sqlstate.NewError(sqlstate.ConfigurationLimitExceeded).
+			Msg("removing duplicate rows across shards exceeds the maximum size of %d bytes", r.MaxMemory).
+			Hint("Add a LIMIT clause or narrow the query, or raise --max-result-size.").
+			Err()
+	}
+	return nil
+}
+
+// key returns the key identifying the duplicates of row.
+func (r *distinctRun) key(row *sqltypes.Row) string {
+	if len(r.Columns) > 0 {
+		return groupKey(row, r.Columns)
+	}
+	columns := make([]int, len(row.Values))
+	for i := range columns {
+		columns[i] = i
+	}
+	return groupKey(row, columns)
+}
+
+// send returns rows to the client, with the fields of the rows if not sent
+// yet.
+func (r *distinctRun) send(ctx context.Context, rows []*sqltypes.Row) error {
+	if len(rows) == 0 && (r.sentFields || r.fields == nil) {
+		return nil
+	}
+	r.rows += len(rows)
+	return r.callback(ctx, &sqltypes.Result{Fields: r.unsentFields(), Rows: rows})
+}
+
+// unsentFields returns the fields of the rows the first time it is called.
+func (r *distinctRun) unsentFields() []*query.Field {
+	if r.sentFields {
+		return nil
+	}
+	r.sentFields = true
+	return r.fields
+}
+
+// createSpillFiles creates the files the rows not seen yet are spilled to.
+func (r *distinctRun) createSpillFiles() error {
+	for range distinctSpillPartitions {
+		f, err := os.CreateTemp(r.SpillDir, "multigateway-distinct-*")
+		if err != nil {
+			return fmt.Errorf("creating distinct spill file: %w", err)
+		}
+		r.partitions = append(r.partitions, f)
+		r.writers = append(r.writers, bufio.NewWriter(f))
+	}
+	return nil
+}
+
+// removeSpillFiles removes the spill files, if any.
+func (r *distinctRun) removeSpillFiles() {
+	for _, f := range r.partitions {
+		f.Close()
+		os.Remove(f.Name())
+	}
+}
+
+// spill writes row to the partition of its key.
+func (r *distinctRun) spill(key string, row *sqltypes.Row) error {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	w := r.writers[h.Sum32()%distinctSpillPartitions]
+
+	buf := binary.AppendUvarint(nil, uint64(len(row.Values)))
+	for _, value := range row.Values {
+		// NULL is written as length 0, other values as their length + 1.
+		if value.IsNull() {
+			buf = binary.AppendUvarint(buf, 0)
+			continue
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(value))+1)
+		buf = append(buf, value...)
+	}
+	if _, err := w.Write(buf); err != nil {
+		return fmt.Errorf("spilling distinct rows: %w", err)
+	}
+	return nil
+}
+
+// readSpilled returns the first of the duplicates of the spilled rows, one
+// partition at a time: duplicates are always in the same partition.
+func (r *distinctRun) readSpilled(ctx context.Context) error {
+	for i, f := range r.partitions {
+		if err := r.writers[i].Flush(); err != nil {
+			return fmt.Errorf("spilling distinct rows: %w", err)
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("reading distinct spill file: %w", err)
+		}
+
+		// The keys of the partition are forgotten after it is read.
+		spilled := make(map[string]struct{})
+		memory := r.memory
+		reader := bufio.NewReader(f)
+		var rows []*sqltypes.Row
+		for {
+			row, err := readSpilledRow(reader)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("reading distinct spill file: %w", err)
+			}
+			key := r.key(row)
+			if _, ok := spilled[key]; ok {
+				continue
+			}
+			spilled[key] = struct{}{}
+			if err := r.account(key); err != nil {
+				return err
+			}
+			rows = append(rows, row)
+		}
+		r.memory = memory
+		if err := r.send(ctx, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSpilledRow reads a row written by spill.
+func readSpilledRow(reader *bufio.Reader) (*sqltypes.Row, error) {
+	n, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	row := &sqltypes.Row{Values: make([]sqltypes.Value, n)}
+	for i := range row.Values {
+		size, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if size == 0 {
+			continue
+		}
+		value := make(sqltypes.Value, size-1)
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		row.Values[i] = value
+	}
+	return row, nil
+}
+
+// GetTableGroup implements the Primitive interface.
+func (d *Distinct) GetTableGroup() string {
+	return d.Input.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (d *Distinct) GetQuery() string {
+	return d.Input.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (d *Distinct) String() string {
+	if len(d.Columns) == 0 {
+		return fmt.Sprintf("Distinct(input=%s)", d.Input)
+	}
+	columns := make([]string, len(d.Columns))
+	for i, column := range d.Columns {
+		columns[i] = strconv.Itoa(column)
+	}
+	return fmt.Sprintf("Distinct(on=%s, input=%s)", strings.Join(columns, ","), d.Input)
+}
+
+// Ensure Distinct implements Primitive interface.
+var _ Primitive = (*Distinct)(nil)
@@ -0,0 +1,133 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// streamPrimitive runs a primitive and returns the chunks it streams.
+func streamPrimitive(t *testing.T, primitive Primitive) ([]*sqltypes.Result, error) {
+	t.Helper()
+	var chunks []*sqltypes.Result
+	err := primitive.StreamExecute(context.Background(), &mockIExecute{}, nil, handler.NewMultiGatewayConnectionState(),
+		func(_ context.Context, result *sqltypes.Result) error {
+			chunks = append(chunks, result)
+			return nil
+		})
+	return chunks, err
+}
+
+// streamedRows returns the rows of chunks, checking that the fields are
+// sent once, first, and the command tag last.
+func streamedRows(t *testing.T, chunks []*sqltypes.Result, fields []*query.Field) ([]*sqltypes.Row, string) {
+	t.Helper()
+	require.NotEmpty(t, chunks)
+	assert.Equal(t, fields, chunks[0].Fields)
+	var rows []*sqltypes.Row
+	for i, chunk := range chunks {
+		if i > 0 {
+			assert.Nil(t, chunk.Fields)
+		}
+		if i < len(chunks)-1 {
+			assert.Empty(t, chunk.CommandTag)
+		}
+		rows = append(rows, chunk.Rows...)
+	}
+	return rows, chunks[len(chunks)-1].CommandTag
+}
+
+func TestDistinct(t *testing.T) {
+	fields := []*query.Field{field("id", ast.INT4OID), field("name", ast.TEXTOID)}
+	input := &stubPrimitive{results: []*sqltypes.Result{
+		{Fields: fields, Rows: textRows([]string{"1", "a"}, []string{"2", "NULL"}, []string{"1", "a"})},
+		{Rows: textRows([]string{"2", ""}, []string{"2", "NULL"}, []string{"3", "a"})},
+	}}
+
+	t.Run("all columns", func(t *testing.T) {
+		chunks, err := streamPrimitive(t, NewDistinct(input, nil))
+		require.NoError(t, err)
+		rows, tag := streamedRows(t, chunks, fields)
+		assert.Equal(t, textRows([]string{"1", "a"}, []string{"2", "NULL"}, []string{"2", ""}, []string{"3", "a"}), rows)
+		assert.Equal(t, "SELECT 4", tag)
+	})
+
+	t.Run("distinct on", func(t *testing.T) {
+		chunks, err := streamPrimitive(t, NewDistinct(input, []int{1}))
+		require.NoError(t, err)
+		rows, tag := streamedRows(t, chunks, fields)
+		assert.Equal(t, textRows([]string{"1", "a"}, []string{"2", "NULL"}, []string{"2", ""}), rows)
+		assert.Equal(t, "SELECT 3", tag)
+	})
+
+	t.Run("memory limit", func(t *testing.T) {
+		distinct := NewDistinct(input, nil)
+		distinct.MaxMemory = 2 * distinctEntryOverhead
+		_, err := streamPrimitive(t, distinct)
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag)
+		assert.Equal(t, sqlstate.ConfigurationLimitExceeded, diag.Code)
+	})
+
+	t.Run("no rows", func(t *testing.T) {
+		chunks, err := streamPrimitive(t, NewDistinct(&stubPrimitive{results: []*sqltypes.Result{{Fields: fields}}}, nil))
+		require.NoError(t, err)
+		rows, tag := streamedRows(t, chunks, fields)
+		assert.Empty(t, rows)
+		assert.Equal(t, "SELECT 0", tag)
+	})
+}
+
+func TestDistinctSpill(t *testing.T) {
+	fields := []*query.Field{field("id", ast.INT4OID), field("name", ast.TEXTOID)}
+	var results []*sqltypes.Result
+	for range 3 {
+		results = append(results, &sqltypes.Result{
+			Fields: fields,
+			Rows: textRows(
+				[]string{"1", "a"}, []string{"2", "b"}, []string{"3", "NULL"},
+				[]string{"4", ""}, []string{"5", "e"}, []string{"6", "f"},
+			),
+		})
+	}
+	distinct := NewDistinct(&stubPrimitive{results: results}, nil)
+	distinct.SpillThreshold = 1
+	distinct.SpillDir = t.TempDir()
+
+	chunks, err := streamPrimitive(t, distinct)
+	require.NoError(t, err)
+	rows, tag := streamedRows(t, chunks, fields)
+	// The first row is returned before spilling, the others once spilled.
+	require.Len(t, rows, 6)
+	assert.Equal(t, textRows([]string{"1", "a"}), rows[:1])
+	assert.ElementsMatch(t, textRows([]string{"2", "b"}, []string{"3", "NULL"}, []string{"4", ""}, []string{"5", "e"}, []string{"6", "f"}), rows[1:])
+	assert.Equal(t, "SELECT 6", tag)
+
+	// The spill files are removed.
+	entries, err := os.ReadDir(distinct.SpillDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -20,6 +20,7 @@ package engine
 import (
 	"context"
 
+	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/sqltypes"
@@ -99,7 +100,7 @@ type IExecute interface {
 		preparedStatementInfo *preparedstatement.PreparedStatementInfo,
 	) (*query.StatementDescription, error)
 
-	// --- COPY FROM STDIN methods (called by CopyStatement primitive) ---
+	// --- COPY methods (called by CopyStatement primitive) ---
 	// These methods follow the same pattern as StreamExecute: they take tableGroup/shard
 	// and manage reserved connection state internally via state.ShardStates.
 
@@ -139,6 +140,21 @@ type IExecute interface {
 		callback func(ctx context.Context, result *sqltypes.Result) error,
 	) error
 
+	// CopyOut executes a COPY TO STDOUT operation. onReady is called with the
+	// COPY format and per-column formats before any data, onData with each chunk
+	// of data, and callback with the final result.
+	CopyOut(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		queryStr string,
+		state *handler.MultiGatewayConnectionState,
+		onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+		onData func(ctx context.Context, data []byte) error,
+		callback func(ctx context.Context, result *sqltypes.Result) error,
+	) error
+
 	// CopyAbort aborts the COPY operation via bidirectional stream.
 	// Looks up reserved connection from state.ShardStates based on tableGroup/shard.
 	CopyAbort(
@@ -148,6 +164,211 @@ type IExecute interface {
 		shard string,
 		state *handler.MultiGatewayConnectionState,
 	) error
+
+	// --- LISTEN/NOTIFY methods (called by Listen primitive) ---
+
+	// Listen subscribes the connection to notifications on channel. It returns
+	// once the pooler is listening; notifications are then sent to the client
+	// asynchronously while the connection is idle.
+	Listen(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		channel string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// Unlisten unsubscribes the connection from channel, or from all channels
+	// if channel is empty.
+	Unlisten(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		channel string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// --- Advisory lock methods (called by AdvisoryLockRoute primitive) ---
+
+	// PinForAdvisoryLocks pins the session to a reserved connection before a
+	// statement that takes session-level advisory locks. The session's
+	// current reserved connection is pinned if it has one.
+	PinForAdvisoryLocks(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// UnpinIfNoAdvisoryLocks releases the session's pinned connection once it
+	// no longer holds session-level advisory locks.
+	UnpinIfNoAdvisoryLocks(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// ReleaseAdvisoryLocks releases the session-level advisory locks of the
+	// session and its pinned connection, e.g. when the client disconnects.
+	ReleaseAdvisoryLocks(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// --- Temporary table methods (called by TempTableRoute primitive) ---
+
+	// PinForTempTables pins the session to a reserved connection before a
+	// statement that creates temporary relations. The session's current
+	// reserved connection is pinned if it has one.
+	PinForTempTables(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// TrackTempTables records the temporary relations a statement created or
+	// dropped, and releases the session's pinned connection once it has none
+	// left.
+	TrackTempTables(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+		usage TempTableUsage,
+	) error
+
+	// ReleaseTempTables drops the temporary relations of the session and
+	// releases its pinned connection, e.g. when the client disconnects.
+	ReleaseTempTables(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// --- Cursor methods (called by CursorRoute primitive) ---
+
+	// PinForCursors pins the session to a reserved connection before a
+	// statement that declares a cursor. The session's current reserved
+	// connection is pinned if it has one.
+	PinForCursors(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// TrackCursors records the cursors a statement declared or closed, and
+	// releases the session's pinned connection once it has none left.
+	TrackCursors(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+		usage CursorUsage,
+	) error
+
+	// ReleaseCursors closes the cursors of the session and releases its
+	// pinned connection, e.g. when the client disconnects.
+	ReleaseCursors(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// --- Implicit transaction methods (called by primitives writing to several shards) ---
+
+	// BeginImplicitTransaction pins the session to a reserved connection and
+	// opens a transaction on it, so that the writes of a statement to several
+	// shards outside a transaction block commit together. It is also how a
+	// shard joins the implicit transaction block of a multi-statement simple
+	// query (see MultiGatewayConnectionState.BeginImplicitTransaction).
+	BeginImplicitTransaction(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+	) error
+
+	// EndImplicitTransaction commits or rolls back the implicit transaction
+	// and unpins the session unless other session state still needs it.
+	EndImplicitTransaction(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+		commit bool,
+	) error
+
+	// --- Transaction methods (called by EndTransactionRoute primitive) ---
+
+	// EndTransaction ends the session's transaction with sql, a COMMIT or
+	// ROLLBACK. With a single shard participating in the transaction, sql
+	// runs on the given target. With several, each of them is committed or
+	// rolled back, using two-phase commit if the transaction mode asks for it.
+	// The shards that joined an implicit transaction block are then unpinned
+	// unless other session state needs them.
+	EndTransaction(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+		commit bool,
+		sql string,
+		callback func(context.Context, *sqltypes.Result) error,
+	) error
+
+	// --- Savepoint methods (called by SavepointRoute primitive) ---
+
+	// ExecuteSavepoint runs sql, a SAVEPOINT, RELEASE or ROLLBACK TO
+	// statement of the given kind, on every shard participating in the
+	// session's transaction, so that partial rollbacks apply to all of
+	// them. Outside a distributed transaction it runs on the given target.
+	ExecuteSavepoint(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shard string,
+		state *handler.MultiGatewayConnectionState,
+		kind ast.TransactionStmtKind,
+		name string,
+		sql string,
+		callback func(context.Context, *sqltypes.Result) error,
+	) error
+
+	// --- Scatter methods (called by ScatterRoute primitive) ---
+
+	// ScatterExecute executes sql on each of the given shards of the
+	// tablegroup and calls callback with the complete result of each shard,
+	// in the order of shards. Combining them is left to the caller.
+	ScatterExecute(
+		ctx context.Context,
+		conn *server.Conn,
+		tableGroup string,
+		shards []string,
+		sql string,
+		state *handler.MultiGatewayConnectionState,
+		callback func(context.Context, *sqltypes.Result) error,
+	) error
 }
 
 // Primitive is the building block of the query execution plan.
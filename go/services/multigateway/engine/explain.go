@@ -0,0 +1,243 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/constants"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// explainColumns are the columns of the result of an Explain.
+var explainColumns = []string{"operator", "tablegroup", "shards", "query", "details"}
+
+// Explain is a primitive answering EXPLAIN (MULTIGRES) from the gateway: it
+// returns the plan the gateway made for the statement, without running
+// it, as a row per primitive of the plan. The rows of the inputs of a
+// primitive follow it, their operator indented.
+type Explain struct {
+	TableGroup string
+	Query      string
+
+	// Plan is the plan of the explained statement.
+	Plan *Plan
+}
+
+// NewExplain creates a new Explain primitive.
+func NewExplain(tableGroup, query string, plan *Plan) *Explain {
+	return &Explain{
+		TableGroup: tableGroup,
+		Query:      query,
+		Plan:       plan,
+	}
+}
+
+// explainNode describes a primitive of an explained plan.
+type explainNode struct {
+	operator   string
+	tableGroup string
+	shards     []string
+	query      string
+	details    []string
+	inputs     []Primitive
+}
+
+// StreamExecute implements the Primitive interface.
+func (e *Explain) StreamExecute(
+	ctx context.Context,
+	_ IExecute,
+	_ *server.Conn,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	result := &sqltypes.Result{Fields: make([]*query.Field, len(explainColumns))}
+	for i, name := range explainColumns {
+		result.Fields[i] = textField(name)
+	}
+	e.addRows(result, e.Plan.Primitive, 0, "class="+e.Plan.Class.String())
+	result.CommandTag = "EXPLAIN"
+	return callback(ctx, result)
+}
+
+// addRows adds the row of p, then those of its inputs, to result.
+func (e *Explain) addRows(result *sqltypes.Result, p Primitive, depth int, details ...string) {
+	node := describe(p)
+	operator := node.operator
+	if depth > 0 {
+		operator = strings.Repeat("  ", depth-1) + "->  " + operator
+	}
+	details = append(details, node.details...)
+	result.Rows = append(result.Rows, &sqltypes.Row{Values: []sqltypes.Value{
+		sqltypes.Value(operator),
+		sqltypes.Value(node.tableGroup),
+		sqltypes.Value(strings.Join(node.shards, ",")),
+		sqltypes.Value(node.query),
+		sqltypes.Value(strings.Join(details, ", ")),
+	}})
+	for _, input := range node.inputs {
+		e.addRows(result, input, depth+1)
+	}
+}
+
+// describe returns the description of p: where it runs, what it runs
+// there, and how it combines the rows of its inputs.
+func describe(p Primitive) explainNode {
+	switch p := p.(type) {
+	case *Route:
+		return explainNode{operator: "Route", tableGroup: p.TableGroup, shards: []string{shardName(p.Shard)}, query: p.Query}
+	case *ScatterRoute:
+		node := explainNode{operator: "ScatterRoute", tableGroup: p.TableGroup, shards: p.Shards, query: p.Query}
+		if len(p.OrderBy) > 0 {
+			node.details = append(node.details, "merge_order_by="+formatOrderBy(p.OrderBy))
+		}
+		if p.HiddenColumns > 0 {
+			node.details = append(node.details, fmt.Sprintf("hidden_columns=%d", p.HiddenColumns))
+		}
+		if p.Lookup != nil {
+			node.details = append(node.details, fmt.Sprintf("lookup_shard=%s, lookup=%s", shardName(p.Lookup.Shard), p.Lookup.Query))
+		}
+		return node
+	case *ScatterAggregate:
+		columns := make([]string, len(p.Columns))
+		for i, col := range p.Columns {
+			columns[i] = fmt.Sprintf("%s(%d)", col.Kind, col.Column)
+		}
+		return explainNode{
+			operator: "ScatterAggregate",
+			details:  []string{fmt.Sprintf("group_by=%s, columns=%s", formatColumns(p.GroupBy), strings.Join(columns, ","))},
+			inputs:   []Primitive{p.Input},
+		}
+	case *Sort:
+		return explainNode{operator: "Sort", details: []string{"order_by=" + formatOrderBy(p.OrderBy)}, inputs: []Primitive{p.Input}}
+	case *Limit:
+		return explainNode{operator: "Limit", details: []string{fmt.Sprintf("count=%d, offset=%d", p.Count, p.Offset)}, inputs: []Primitive{p.Input}}
+	case *Distinct:
+		node := explainNode{operator: "Distinct", inputs: []Primitive{p.Input}}
+		if len(p.Columns) > 0 {
+			node.details = []string{"on=" + formatColumns(p.Columns)}
+		}
+		return node
+	case *Concatenate:
+		return explainNode{operator: "Concatenate", inputs: p.Inputs}
+	case *Sequence:
+		return explainNode{operator: "Sequence", inputs: p.Primitives}
+	case *Join:
+		return explainNode{
+			operator:   "Join",
+			tableGroup: p.TableGroup,
+			shards:     p.InnerShards,
+			query:      p.Inner.SqlString(),
+			details:    []string{fmt.Sprintf("kind=%s, outer_key=%d, inner_key=%s", p.Kind, p.OuterKey, p.InnerKey.SqlString())},
+			inputs:     []Primitive{p.Outer},
+		}
+	case *ReferenceWrite:
+		return explainNode{operator: "ReferenceWrite", tableGroup: p.TableGroup, shards: p.Shards, query: p.Query}
+	case *DDLFanout:
+		return explainNode{operator: "DDLFanout", tableGroup: p.TableGroup, shards: p.Shards, query: p.Query, details: []string{"policy=" + string(p.Policy)}}
+	case *ShardedInsert:
+		lookups := make([]string, len(p.Lookups))
+		for i, lookup := range p.Lookups {
+			lookups[i] = lookup.Table
+		}
+		node := explainNode{operator: "ShardedInsert", tableGroup: p.TableGroup, shards: p.Shards, query: p.Insert.SqlString()}
+		if p.Sequence != "" {
+			node.details = append(node.details, "sequence="+p.Sequence)
+		}
+		if len(lookups) > 0 {
+			node.details = append(node.details, fmt.Sprintf("lookups=%s, lookup_shard=%s", strings.Join(lookups, ","), shardName(p.LookupShard)))
+		}
+		return node
+	case *LookupDelete:
+		lookups := make([]string, len(p.Lookups))
+		for i, lookup := range p.Lookups {
+			lookups[i] = lookup.Table
+		}
+		return explainNode{
+			operator:   "LookupDelete",
+			tableGroup: p.TableGroup,
+			shards:     p.Shards,
+			details:    []string{fmt.Sprintf("lookups=%s, lookup_shard=%s", strings.Join(lookups, ","), shardName(p.LookupShard))},
+			inputs:     []Primitive{p.Select, p.Delete},
+		}
+	case *ShardedCopy:
+		return explainNode{operator: "ShardedCopy", tableGroup: p.TableGroup, shards: p.Shards, query: p.Query}
+	case *TempTableRoute:
+		return explainNode{operator: "TempTableRoute", tableGroup: p.TableGroup, shards: []string{shardName(p.Shard)}, query: p.Query}
+	case *CursorRoute:
+		return explainNode{operator: "CursorRoute", tableGroup: p.TableGroup, shards: []string{shardName(p.Shard)}, query: p.Query}
+	case *AdvisoryLockRoute:
+		return explainNode{operator: "AdvisoryLockRoute", tableGroup: p.TableGroup, shards: []string{shardName(p.Shard)}, query: p.Query}
+	case *TransactionControl:
+		return explainNode{operator: "TransactionControl", details: []string{"kind=" + p.Kind.String()}, inputs: []Primitive{p.Input}}
+	case *CachedRead:
+		return explainNode{
+			operator: "CachedRead",
+			details:  []string{fmt.Sprintf("ttl=%s, tables=%s", p.TTL, strings.Join(p.Tables, ","))},
+			inputs:   []Primitive{p.Input},
+		}
+	case *SequenceNextval:
+		sequences := make([]string, len(p.Columns))
+		for i, col := range p.Columns {
+			sequences[i] = col.Sequence
+		}
+		return explainNode{operator: "SequenceNextval", tableGroup: p.TableGroup, details: []string{"sequences=" + strings.Join(sequences, ",")}}
+	}
+	// The other primitives run on the shard of the session, or on the
+	// gateway alone.
+	operator, _, _ := strings.Cut(p.String(), "(")
+	return explainNode{operator: operator, tableGroup: p.GetTableGroup(), query: p.GetQuery()}
+}
+
+// shardName returns the name of shard, the default shard when empty.
+func shardName(shard string) string {
+	if shard == "" {
+		return constants.DefaultShard
+	}
+	return shard
+}
+
+// formatColumns returns columns separated by commas.
+func formatColumns(columns []int) string {
+	terms := make([]string, len(columns))
+	for i, column := range columns {
+		terms[i] = strconv.Itoa(column)
+	}
+	return strings.Join(terms, ",")
+}
+
+// GetTableGroup implements the Primitive interface.
+func (e *Explain) GetTableGroup() string {
+	return e.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (e *Explain) GetQuery() string {
+	return e.Query
+}
+
+// String implements the Primitive interface.
+func (e *Explain) String() string {
+	return fmt.Sprintf("Explain(%s)", e.Plan.Primitive)
+}
+
+// Ensure Explain implements Primitive interface.
+var _ Primitive = (*Explain)(nil)
@@ -0,0 +1,57 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestExplain(t *testing.T) {
+	route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT country, count(*) FROM users GROUP BY country")
+	route.Lookup = &ShardLookup{Shard: "-80", Query: "SELECT shard FROM users_email_lookup WHERE email = 'a'"}
+	aggregate := NewScatterAggregate(route, []int{0}, []AggregateColumn{{Kind: AggregateAny, Column: 0}, {Kind: AggregateCount, Column: 1}})
+	sorted := NewSort(aggregate, []sqltypes.OrderByColumn{{Column: 1, Desc: true}})
+	plan := NewPlan("SELECT ...", NewLimit(sorted, 10, 0))
+	plan.Class = StatementRead
+
+	result := runPrimitive(t, NewExplain("tg", "EXPLAIN (MULTIGRES) SELECT ...", plan))
+	require.Len(t, result.Fields, 5)
+	assert.Equal(t, "operator", result.Fields[0].Name)
+	assert.Equal(t, "details", result.Fields[4].Name)
+	assert.Equal(t, "EXPLAIN", result.CommandTag)
+	assert.Equal(t, textRows(
+		[]string{"Limit", "", "", "", "class=read, count=10, offset=0"},
+		[]string{"->  Sort", "", "", "", "order_by=1 DESC NULLS LAST"},
+		[]string{"  ->  ScatterAggregate", "", "", "", "group_by=0, columns=any(0),count(1)"},
+		[]string{"    ->  ScatterRoute", "tg", "-80,80-", "SELECT country, count(*) FROM users GROUP BY country",
+			"lookup_shard=-80, lookup=SELECT shard FROM users_email_lookup WHERE email = 'a'"},
+	), result.Rows)
+}
+
+func TestExplainRoute(t *testing.T) {
+	plan := NewPlan("SELECT 1", NewRoute("tg", "", "SELECT 1"))
+	result := runPrimitive(t, NewExplain("tg", "EXPLAIN (MULTIGRES) SELECT 1", plan))
+	assert.Equal(t, textRows([]string{"Route", "tg", "0-inf", "SELECT 1", "class=utility"}), result.Rows)
+
+	// Primitives without a description of their own are named.
+	plan = NewPlan("LISTEN c", NewListen("tg", "LISTEN c", "c"))
+	result = runPrimitive(t, NewExplain("tg", "EXPLAIN (MULTIGRES) LISTEN c", plan))
+	assert.Equal(t, "Listen", string(result.Rows[0].Values[0]))
+}
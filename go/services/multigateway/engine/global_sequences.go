@@ -0,0 +1,100 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultSequenceBlockSize is the number of values of a global sequence a
+// gateway allocates at once.
+const DefaultSequenceBlockSize = 1000
+
+// SequenceAllocator allocates blocks of values of global sequences.
+type SequenceAllocator interface {
+	// AllocateSequenceBlock allocates the next size values of the sequence
+	// name, kept on the given shard, and returns the first. The sequence
+	// starts at 1.
+	AllocateSequenceBlock(ctx context.Context, tableGroup, shard, name string, size int64) (int64, error)
+}
+
+// GlobalSequences hands out the values of the global sequences, which
+// generate IDs unique across the shards of a tablegroup, such as the
+// primary keys of sharded tables. The values are allocated in blocks from
+// the shard keeping the sequences, and handed out from memory until the
+// block runs out.
+//
+// The values a gateway hands out increase, but those of several gateways
+// interleave, and the values left in the blocks of a gateway that stops
+// are never used.
+type GlobalSequences struct {
+	allocator  SequenceAllocator
+	tableGroup string
+	shard      string
+	blockSize  int64
+
+	mu     sync.Mutex
+	blocks map[string]*sequenceBlock
+}
+
+// sequenceBlock holds the values of a sequence allocated and not yet handed
+// out: from next up to end, excluded.
+type sequenceBlock struct {
+	mu   sync.Mutex
+	next int64
+	end  int64
+}
+
+// NewGlobalSequences creates the global sequences kept on the given shard,
+// allocating blockSize values at once.
+func NewGlobalSequences(allocator SequenceAllocator, tableGroup, shard string, blockSize int64) *GlobalSequences {
+	return &GlobalSequences{
+		allocator:  allocator,
+		tableGroup: tableGroup,
+		shard:      shard,
+		blockSize:  max(blockSize, 1),
+		blocks:     make(map[string]*sequenceBlock),
+	}
+}
+
+// Next returns the next n values of the sequence name.
+func (s *GlobalSequences) Next(ctx context.Context, name string, n int) ([]int64, error) {
+	s.mu.Lock()
+	block, ok := s.blocks[name]
+	if !ok {
+		block = &sequenceBlock{}
+		s.blocks[name] = block
+	}
+	s.mu.Unlock()
+
+	// Allocations of a sequence are serialized, those of others are not.
+	block.mu.Lock()
+	defer block.mu.Unlock()
+	values := make([]int64, 0, n)
+	for len(values) < n {
+		if block.next == block.end {
+			size := max(s.blockSize, int64(n-len(values)))
+			first, err := s.allocator.AllocateSequenceBlock(ctx, s.tableGroup, s.shard, name, size)
+			if err != nil {
+				return nil, err
+			}
+			block.next, block.end = first, first+size
+		}
+		values = append(values, block.next)
+		block.next++
+	}
+	return values, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAllocator allocates the blocks of every sequence from a counter per
+// sequence, starting at 1.
+type fakeAllocator struct {
+	mu    sync.Mutex
+	next  map[string]int64
+	sizes []int64
+}
+
+func (a *fakeAllocator) AllocateSequenceBlock(_ context.Context, _, _, name string, size int64) (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.next == nil {
+		a.next = make(map[string]int64)
+	}
+	first := a.next[name] + 1
+	a.next[name] += size
+	a.sizes = append(a.sizes, size)
+	return first, nil
+}
+
+func TestGlobalSequencesNext(t *testing.T) {
+	allocator := &fakeAllocator{}
+	sequences := NewGlobalSequences(allocator, "tg", "", 3)
+	ctx := context.Background()
+
+	values, err := sequences.Next(ctx, "a", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, values)
+	values, err = sequences.Next(ctx, "a", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, values)
+	// A request larger than a block allocates as many values as needed.
+	values, err = sequences.Next(ctx, "a", 6)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 6, 7, 8, 9, 10}, values)
+	values, err = sequences.Next(ctx, "b", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, values)
+
+	assert.Equal(t, []int64{3, 3, 4, 3}, allocator.sizes)
+}
+
+func TestGlobalSequencesConcurrent(t *testing.T) {
+	sequences := NewGlobalSequences(&fakeAllocator{}, "tg", "", 10)
+	var mu sync.Mutex
+	seen := make(map[int64]bool)
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values, err := sequences.Next(context.Background(), "a", 7)
+			assert.NoError(t, err)
+			mu.Lock()
+			defer mu.Unlock()
+			for _, value := range values {
+				assert.False(t, seen[value], "value %d handed out twice", value)
+				seen[value] = true
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, seen, 140)
+}
@@ -0,0 +1,363 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// DefaultJoinBatchSize is the number of outer rows whose join values are
+// looked up by each probe of a Join.
+const DefaultJoinBatchSize = 500
+
+// JoinKind is the kind of a Join.
+type JoinKind int
+
+const (
+	// JoinInner returns the pairs of matching outer and inner rows.
+	JoinInner JoinKind = iota
+	// JoinLeft also returns the outer rows without matching inner rows,
+	// with NULL inner columns.
+	JoinLeft
+)
+
+// String returns the SQL name of the kind.
+func (k JoinKind) String() string {
+	if k == JoinLeft {
+		return "LEFT"
+	}
+	return "INNER"
+}
+
+// JoinColumn is an output column of a Join, taken from the outer or inner
+// rows.
+type JoinColumn struct {
+	// Inner is true for a column of the inner rows.
+	Inner bool
+
+	// Column is the column of the outer or inner rows.
+	Column int
+
+	// Name is the name of the output column.
+	Name string
+}
+
+// Join is a primitive that joins the rows of two tables on different
+// shards with a nested loop: the outer rows are read in batches, and for
+// each batch, the inner rows with the join values of the batch are looked
+// up by a probe, the inner query restricted to "key IN (values)". When the
+// inner table is sharded by its join column, each probe only runs on the
+// shards of its values.
+//
+// Join values are compared by their text, so the join columns must be of
+// the same type. The rows are returned in the order of the outer rows.
+type Join struct {
+	Kind JoinKind
+
+	// Outer returns the outer rows.
+	Outer Primitive
+
+	// OuterKey is the column of the outer rows holding the join value.
+	OuterKey int
+
+	// TableGroup is the tablegroup of the inner table.
+	TableGroup string
+
+	// InnerShards are the shards of the inner table.
+	InnerShards []string
+
+	// InnerRouter maps join values to the shards of the inner rows holding
+	// them, when the inner join column is the shard key. Probes run on
+	// every shard when nil.
+	InnerRouter ShardKeyRouter
+
+	// Inner is the query of the inner rows, without the join condition.
+	Inner *ast.SelectStmt
+
+	// InnerKey is the expression of the inner join column, which the
+	// probes restrict.
+	InnerKey ast.Node
+
+	// InnerKeyColumn is the column of the inner rows holding the join value.
+	InnerKeyColumn int
+
+	// Columns are the output columns.
+	Columns []JoinColumn
+
+	// BatchSize is the number of outer rows of each probe.
+	BatchSize int
+}
+
+// joinRun holds the state of an execution of a Join.
+type joinRun struct {
+	*Join
+	exec     IExecute
+	conn     *server.Conn
+	state    *handler.MultiGatewayConnectionState
+	callback func(context.Context, *sqltypes.Result) error
+
+	outerFields []*query.Field
+	innerFields []*query.Field
+	sentFields  bool
+	batch       []*sqltypes.Row
+	rows        int
+	notices     []*sqltypes.Notice
+}
+
+// StreamExecute implements the Primitive interface.
+func (j *Join) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	run := &joinRun{Join: j, exec: exec, conn: conn, state: state, callback: callback}
+	batchSize := j.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultJoinBatchSize
+	}
+
+	err := j.Outer.StreamExecute(ctx, exec, conn, state, func(ctx context.Context, result *sqltypes.Result) error {
+		if len(result.Fields) > 0 {
+			run.outerFields = result.Fields
+		}
+		run.notices = append(run.notices, result.Notices...)
+		for _, row := range result.Rows {
+			run.batch = append(run.batch, row)
+			if len(run.batch) >= batchSize {
+				if err := run.joinBatch(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := run.joinBatch(ctx); err != nil {
+		return err
+	}
+
+	final := &sqltypes.Result{CommandTag: "SELECT " + strconv.Itoa(run.rows), Notices: run.notices}
+	if !run.sentFields {
+		// Without outer rows, the inner fields are fetched by a probe
+		// without values.
+		if run.innerFields == nil {
+			if _, err := run.probe(ctx, j.InnerShards[0], nil); err != nil {
+				return err
+			}
+		}
+		fields, err := run.fields()
+		if err != nil {
+			return err
+		}
+		final.Fields = fields
+	}
+	return callback(ctx, final)
+}
+
+// joinBatch joins the outer rows of the batch and returns the joined rows.
+func (r *joinRun) joinBatch(ctx context.Context) error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+	batch := r.batch
+	r.batch = nil
+
+	// The distinct join values of the batch, by shard.
+	var values []string
+	seen := make(map[string]bool)
+	for _, row := range batch {
+		value := row.Values[r.OuterKey]
+		if value.IsNull() || seen[string(value)] {
+			continue
+		}
+		seen[string(value)] = true
+		values = append(values, string(value))
+	}
+	byShard := make(map[string][]string)
+	if r.InnerRouter == nil {
+		for _, shard := range r.InnerShards {
+			byShard[shard] = values
+		}
+	} else {
+		for _, value := range values {
+			shard, err := r.InnerRouter.ShardForKey([]byte(value), false)
+			if err != nil {
+				return err
+			}
+			byShard[shard] = append(byShard[shard], value)
+		}
+	}
+
+	matches := make(map[string][]*sqltypes.Row)
+	for _, shard := range r.InnerShards {
+		if len(byShard[shard]) == 0 {
+			continue
+		}
+		rows, err := r.probe(ctx, shard, byShard[shard])
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if key := row.Values[r.InnerKeyColumn]; !key.IsNull() {
+				matches[string(key)] = append(matches[string(key)], row)
+			}
+		}
+	}
+	if r.innerFields == nil {
+		// Only NULL join values: fetch the inner fields.
+		if _, err := r.probe(ctx, r.InnerShards[0], nil); err != nil {
+			return err
+		}
+	}
+
+	var joined []*sqltypes.Row
+	for _, outer := range batch {
+		var inner []*sqltypes.Row
+		if key := outer.Values[r.OuterKey]; !key.IsNull() {
+			inner = matches[string(key)]
+		}
+		for _, row := range inner {
+			joined = append(joined, r.joinRow(outer, row))
+		}
+		if len(inner) == 0 && r.Kind == JoinLeft {
+			joined = append(joined, r.joinRow(outer, nil))
+		}
+	}
+	if len(joined) == 0 && r.sentFields {
+		return nil
+	}
+	chunk := &sqltypes.Result{Rows: joined}
+	if !r.sentFields {
+		fields, err := r.fields()
+		if err != nil {
+			return err
+		}
+		chunk.Fields = fields
+		r.sentFields = true
+	}
+	r.rows += len(joined)
+	return r.callback(ctx, chunk)
+}
+
+// probe returns the inner rows of a shard with the given join values. A
+// probe without values only returns the inner fields.
+func (r *joinRun) probe(ctx context.Context, shard string, values []string) ([]*sqltypes.Row, error) {
+	list := make([]ast.Node, 0, max(len(values), 1))
+	for _, value := range values {
+		list = append(list, ast.NewA_Const(ast.NewString(value), -1))
+	}
+	if len(list) == 0 {
+		list = append(list, ast.NewA_ConstNull(-1))
+	}
+	in := ast.NewA_Expr(ast.AEXPR_IN, ast.NewNodeList(ast.NewString("=")), r.InnerKey, ast.NewNodeList(list...), -1)
+	stmt := *r.Inner
+	if stmt.WhereClause == nil {
+		stmt.WhereClause = in
+	} else {
+		stmt.WhereClause = ast.NewAndExpr(stmt.WhereClause, in)
+	}
+
+	var rows []*sqltypes.Row
+	err := r.exec.StreamExecute(ctx, r.conn, r.TableGroup, shard, stmt.SqlString(), r.state, func(_ context.Context, result *sqltypes.Result) error {
+		if len(result.Fields) > 0 {
+			r.innerFields = result.Fields
+		}
+		rows = append(rows, result.Rows...)
+		r.notices = append(r.notices, result.Notices...)
+		return nil
+	})
+	return rows, err
+}
+
+// joinRow returns the output row of an outer row and a matching inner row,
+// or nil for NULL inner columns.
+func (r *joinRun) joinRow(outer, inner *sqltypes.Row) *sqltypes.Row {
+	row := &sqltypes.Row{Values: make([]sqltypes.Value, len(r.Columns))}
+	for i, col := range r.Columns {
+		switch {
+		case !col.Inner:
+			row.Values[i] = outer.Values[col.Column]
+		case inner != nil:
+			row.Values[i] = inner.Values[col.Column]
+		}
+	}
+	return row
+}
+
+// fields returns the output fields.
+func (r *joinRun) fields() ([]*query.Field, error) {
+	fields := make([]*query.Field, len(r.Columns))
+	for i, col := range r.Columns {
+		source := r.outerFields
+		if col.Inner {
+			source = r.innerFields
+		}
+		if col.Column >= len(source) {
+			return nil, fmt.Errorf("join column %d out of range of %d fields", col.Column, len(source))
+		}
+		field := source[col.Column]
+		fields[i] = &query.Field{
+			Name:                 col.Name,
+			Type:                 field.Type,
+			TableOid:             field.TableOid,
+			TableAttributeNumber: field.TableAttributeNumber,
+			DataTypeOid:          field.DataTypeOid,
+			DataTypeSize:         field.DataTypeSize,
+			TypeModifier:         field.TypeModifier,
+			Format:               field.Format,
+		}
+	}
+	return fields, nil
+}
+
+// GetTableGroup implements the Primitive interface.
+func (j *Join) GetTableGroup() string {
+	return j.Outer.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (j *Join) GetQuery() string {
+	return j.Outer.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (j *Join) String() string {
+	columns := make([]string, len(j.Columns))
+	for i, col := range j.Columns {
+		side := "outer"
+		if col.Inner {
+			side = "inner"
+		}
+		columns[i] = fmt.Sprintf("%s(%d)", side, col.Column)
+	}
+	return fmt.Sprintf("Join(kind=%s, outer=%s, outer_key=%d, inner_shards=%s, inner=%s, inner_key=%s, columns=%s)",
+		j.Kind, j.Outer, j.OuterKey, strings.Join(j.InnerShards, ","), j.Inner.SqlString(), j.InnerKey.SqlString(), strings.Join(columns, ","))
+}
+
+// Ensure Join implements Primitive interface.
+var _ Primitive = (*Join)(nil)
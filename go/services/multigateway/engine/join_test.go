@@ -0,0 +1,169 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// probeExecute answers the probes of a Join with the inner rows of each
+// shard whose join value, the first column, is listed in the query.
+type probeExecute struct {
+	mockIExecute
+	fields []*query.Field
+	shards map[string][]*sqltypes.Row
+	probes []string
+}
+
+func (e *probeExecute) StreamExecute(
+	ctx context.Context,
+	_ *server.Conn,
+	_ string,
+	shard string,
+	sql string,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	e.probes = append(e.probes, shard+":"+sql)
+	result := &sqltypes.Result{Fields: e.fields}
+	for _, row := range e.shards[shard] {
+		if strings.Contains(sql, "'"+string(row.Values[0])+"'") {
+			result.Rows = append(result.Rows, row)
+		}
+	}
+	result.CommandTag = "SELECT " + strconv.Itoa(len(result.Rows))
+	return callback(ctx, result)
+}
+
+// shardByParity routes even keys to "-80" and odd keys to "80-".
+type shardByParity struct{}
+
+func (shardByParity) ShardForKey(key []byte, _ bool) (string, error) {
+	n, err := strconv.Atoi(string(key))
+	if err != nil {
+		return "", err
+	}
+	if n%2 == 0 {
+		return "-80", nil
+	}
+	return "80-", nil
+}
+
+// newTestJoin returns a Join of users (id, name) with the orders (user_id,
+// amount) of each user, sharded by user_id.
+func newTestJoin(t *testing.T, kind JoinKind, users ...[]string) *Join {
+	t.Helper()
+	stmts, err := parser.ParseSQL("SELECT o.user_id, o.amount FROM orders AS o WHERE o.amount > 0")
+	require.NoError(t, err)
+	inner := stmts[0].(*ast.SelectStmt)
+	return &Join{
+		Kind: kind,
+		Outer: &stubPrimitive{results: []*sqltypes.Result{{
+			Fields: []*query.Field{field("id", ast.INT4OID), field("name", ast.TEXTOID)},
+			Rows:   textRows(users...),
+		}}},
+		OuterKey:       0,
+		TableGroup:     "tg",
+		InnerShards:    []string{"-80", "80-"},
+		InnerRouter:    shardByParity{},
+		Inner:          inner,
+		InnerKey:       inner.TargetList.Items[0].(*ast.ResTarget).Val,
+		InnerKeyColumn: 0,
+		Columns: []JoinColumn{
+			{Column: 1, Name: "name"},
+			{Inner: true, Column: 1, Name: "total"},
+		},
+		BatchSize: 2,
+	}
+}
+
+func newOrdersExecute() *probeExecute {
+	return &probeExecute{
+		fields: []*query.Field{field("user_id", ast.INT4OID), field("amount", ast.INT4OID)},
+		shards: map[string][]*sqltypes.Row{
+			"-80": textRows([]string{"2", "20"}, []string{"2", "21"}),
+			"80-": textRows([]string{"1", "10"}, []string{"3", "30"}),
+		},
+	}
+}
+
+func runJoin(t *testing.T, join *Join, exec IExecute) ([]*sqltypes.Result, error) {
+	t.Helper()
+	var chunks []*sqltypes.Result
+	err := join.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(),
+		func(_ context.Context, result *sqltypes.Result) error {
+			chunks = append(chunks, result)
+			return nil
+		})
+	return chunks, err
+}
+
+func TestJoinInner(t *testing.T) {
+	exec := newOrdersExecute()
+	join := newTestJoin(t, JoinInner, []string{"1", "a"}, []string{"2", "b"}, []string{"4", "d"}, []string{"NULL", "n"}, []string{"3", "c"})
+
+	chunks, err := runJoin(t, join, exec)
+	require.NoError(t, err)
+	rows, tag := streamedRows(t, chunks, []*query.Field{field("name", ast.TEXTOID), field("total", ast.INT4OID)})
+	assert.Equal(t, textRows([]string{"a", "10"}, []string{"b", "20"}, []string{"b", "21"}, []string{"c", "30"}), rows)
+	assert.Equal(t, "SELECT 4", tag)
+	// Each batch of two outer rows is probed on the shards of its values.
+	assert.Equal(t, []string{
+		"-80:SELECT o.user_id, o.amount FROM orders AS o WHERE o.amount > 0 AND o.user_id IN ('2')",
+		"80-:SELECT o.user_id, o.amount FROM orders AS o WHERE o.amount > 0 AND o.user_id IN ('1')",
+		"-80:SELECT o.user_id, o.amount FROM orders AS o WHERE o.amount > 0 AND o.user_id IN ('4')",
+		"80-:SELECT o.user_id, o.amount FROM orders AS o WHERE o.amount > 0 AND o.user_id IN ('3')",
+	}, exec.probes)
+}
+
+func TestJoinLeft(t *testing.T) {
+	exec := newOrdersExecute()
+	join := newTestJoin(t, JoinLeft, []string{"4", "d"}, []string{"NULL", "n"}, []string{"3", "c"})
+	join.InnerRouter = nil
+
+	chunks, err := runJoin(t, join, exec)
+	require.NoError(t, err)
+	rows, tag := streamedRows(t, chunks, []*query.Field{field("name", ast.TEXTOID), field("total", ast.INT4OID)})
+	assert.Equal(t, textRows([]string{"d", "NULL"}, []string{"n", "NULL"}, []string{"c", "30"}), rows)
+	assert.Equal(t, "SELECT 3", tag)
+	// Without router, every shard is probed.
+	assert.Len(t, exec.probes, 4)
+}
+
+func TestJoinWithoutOuterRows(t *testing.T) {
+	exec := newOrdersExecute()
+	join := newTestJoin(t, JoinInner)
+
+	chunks, err := runJoin(t, join, exec)
+	require.NoError(t, err)
+	rows, tag := streamedRows(t, chunks, []*query.Field{field("name", ast.TEXTOID), field("total", ast.INT4OID)})
+	assert.Empty(t, rows)
+	assert.Equal(t, "SELECT 0", tag)
+	// The inner fields are fetched by a probe matching no rows.
+	assert.Equal(t, []string{"-80:SELECT o.user_id, o.amount FROM orders AS o WHERE o.amount > 0 AND o.user_id IN (NULL)"}, exec.probes)
+}
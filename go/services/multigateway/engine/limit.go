@@ -0,0 +1,131 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// Limit is a primitive that applies the OFFSET and LIMIT of a query to the
+// rows of its input. It is used for queries on several shards, each of
+// which returns up to Offset+Count rows: the rows skipped and kept can only
+// be chosen once the rows of all the shards are combined.
+type Limit struct {
+	// Input returns the rows to paginate, typically an ordered ScatterRoute.
+	Input Primitive
+
+	// Count is the maximum number of rows returned. Negative for no limit.
+	Count int64
+
+	// Offset is the number of rows skipped before the rows returned.
+	Offset int64
+}
+
+// NewLimit creates a new Limit primitive.
+func NewLimit(input Primitive, count, offset int64) *Limit {
+	return &Limit{
+		Input:  input,
+		Count:  count,
+		Offset: offset,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (l *Limit) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	result, err := bufferResult(ctx, l.Input, exec, conn, state, 0)
+	if err != nil {
+		return err
+	}
+
+	rows := result.Rows
+	rows = rows[min(l.Offset, int64(len(rows))):]
+	if l.Count >= 0 && l.Count < int64(len(rows)) {
+		rows = rows[:l.Count]
+	}
+	result.Rows = rows
+	result.CommandTag = "SELECT " + strconv.Itoa(len(rows))
+	return callback(ctx, result)
+}
+
+// bufferResult runs input and returns all its rows as a single result,
+// failing once they exceed maxMemory bytes unless zero.
+func bufferResult(
+	ctx context.Context,
+	input Primitive,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	maxMemory int64,
+) (*sqltypes.Result, error) {
+	buffered := &sqltypes.Result{}
+	var memory int64
+	err := input.StreamExecute(ctx, exec, conn, state, func(_ context.Context, result *sqltypes.Result) error {
+		memory += result.ByteSize()
+		if maxMemory > 0 && memory > maxMemory {
+			return bufferLimitExceeded(maxMemory)
+		}
+		if len(result.Fields) > 0 {
+			buffered.Fields = result.Fields
+		}
+		buffered.Rows = append(buffered.Rows, result.Rows...)
+		buffered.Notices = append(buffered.Notices, result.Notices...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buffered, nil
+}
+
+// bufferLimitExceeded returns the error of a query whose rows buffered in
+// the gateway exceed maxMemory bytes: SQLSTATE 53400
+// (configuration_limit_exceeded).
+func bufferLimitExceeded(maxMemory int64) error {
+	return sqlstate.NewError(sqlstate.ConfigurationLimitExceeded).
+		Msg("combining the rows of several shards exceeds the maximum size of %d bytes", maxMemory).
+		Hint("Add a LIMIT clause or narrow the query, or raise --max-result-size.").
+		Err()
+}
+
+// GetTableGroup implements the Primitive interface.
+func (l *Limit) GetTableGroup() string {
+	return l.Input.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (l *Limit) GetQuery() string {
+	return l.Input.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (l *Limit) String() string {
+	return fmt.Sprintf("Limit(count=%d, offset=%d, input=%s)", l.Count, l.Offset, l.Input)
+}
+
+// Ensure Limit implements Primitive interface.
+var _ Primitive = (*Limit)(nil)
@@ -0,0 +1,59 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestLimit(t *testing.T) {
+	fields := []*query.Field{field("id", ast.INT4OID)}
+	shards := func() []*sqltypes.Result {
+		return []*sqltypes.Result{
+			{Fields: fields, Rows: textRows([]string{"1"}, []string{"3"}, []string{"5"}), CommandTag: "SELECT 3"},
+			{Fields: fields, Rows: textRows([]string{"2"}, []string{"4"}), CommandTag: "SELECT 2"},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		count  int64
+		offset int64
+		want   []*sqltypes.Row
+	}{
+		{name: "limit", count: 2, want: textRows([]string{"1"}, []string{"2"})},
+		{name: "limit and offset", count: 2, offset: 1, want: textRows([]string{"2"}, []string{"3"})},
+		{name: "offset only", count: -1, offset: 3, want: textRows([]string{"4"}, []string{"5"})},
+		{name: "offset past the rows", count: 2, offset: 10, want: []*sqltypes.Row{}},
+		{name: "limit 0", count: 0, want: []*sqltypes.Row{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT id FROM t ORDER BY id")
+			route.OrderBy = []sqltypes.OrderByColumn{{Column: 0}}
+			result := runPrimitive(t, NewLimit(route, tt.count, tt.offset), shards()...)
+			assert.Equal(t, fields, result.Fields)
+			assert.Equal(t, tt.want, result.Rows)
+			assert.Equal(t, "SELECT "+strconv.Itoa(len(tt.want)), result.CommandTag)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// Listen implements the Primitive interface for LISTEN and UNLISTEN.
+//
+// LISTEN cannot be routed like other statements: pooled connections are
+// shared between sessions, so a LISTEN run on one would be lost as soon as
+// the connection is returned. Instead, the gateway subscribes the session
+// to the channel's notification stream from the pooler, and notifications
+// are forwarded to the client asynchronously.
+type Listen struct {
+	TableGroup string
+	Query      string
+	// Channel is the channel to listen on. For UNLISTEN, an empty channel
+	// means all channels (UNLISTEN *).
+	Channel  string
+	Unlisten bool
+}
+
+// NewListen creates a new Listen primitive for LISTEN channel.
+func NewListen(tableGroup, query, channel string) *Listen {
+	return &Listen{
+		TableGroup: tableGroup,
+		Query:      query,
+		Channel:    channel,
+	}
+}
+
+// NewUnlisten creates a new Listen primitive for UNLISTEN channel, or
+// UNLISTEN * if channel is empty.
+func NewUnlisten(tableGroup, query, channel string) *Listen {
+	return &Listen{
+		TableGroup: tableGroup,
+		Query:      query,
+		Channel:    channel,
+		Unlisten:   true,
+	}
+}
+
+// StreamExecute subscribes or unsubscribes the connection and reports the
+// command completion.
+func (l *Listen) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	// For now, shard is empty (unsharded).
+	shard := ""
+
+	if l.Unlisten {
+		if err := exec.Unlisten(ctx, conn, l.TableGroup, shard, l.Channel, state); err != nil {
+			return err
+		}
+		return callback(ctx, &sqltypes.Result{CommandTag: "UNLISTEN"})
+	}
+
+	if err := exec.Listen(ctx, conn, l.TableGroup, shard, l.Channel, state); err != nil {
+		return err
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "LISTEN"})
+}
+
+// GetTableGroup implements the Primitive interface.
+func (l *Listen) GetTableGroup() string {
+	return l.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (l *Listen) GetQuery() string {
+	return l.Query
+}
+
+// String implements the Primitive interface.
+func (l *Listen) String() string {
+	if l.Unlisten {
+		channel := l.Channel
+		if channel == "" {
+			channel = "*"
+		}
+		return fmt.Sprintf("Unlisten(%s)", channel)
+	}
+	return fmt.Sprintf("Listen(%s)", l.Channel)
+}
+
+// Ensure Listen implements Primitive interface.
+var _ Primitive = (*Listen)(nil)
@@ -0,0 +1,70 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestListen_StreamExecute(t *testing.T) {
+	mockExec := &mockIExecute{}
+	var results []*sqltypes.Result
+	callback := func(ctx context.Context, r *sqltypes.Result) error {
+		results = append(results, r)
+		return nil
+	}
+
+	listen := NewListen("tg", "LISTEN jobs", "jobs")
+	err := listen.StreamExecute(context.Background(), mockExec, nil, &handler.MultiGatewayConnectionState{}, callback)
+	require.NoError(t, err)
+	assert.Equal(t, "jobs", mockExec.listenChannel)
+	require.Len(t, results, 1)
+	assert.Equal(t, "LISTEN", results[0].CommandTag)
+
+	unlisten := NewUnlisten("tg", "UNLISTEN *", "")
+	err = unlisten.StreamExecute(context.Background(), mockExec, nil, &handler.MultiGatewayConnectionState{}, callback)
+	require.NoError(t, err)
+	assert.True(t, mockExec.unlistenCalled)
+	assert.Empty(t, mockExec.unlistenChannel)
+	require.Len(t, results, 2)
+	assert.Equal(t, "UNLISTEN", results[1].CommandTag)
+}
+
+func TestListen_StreamExecuteError(t *testing.T) {
+	mockExec := &mockIExecute{listenErr: errors.New("no pooler")}
+	called := false
+	err := NewListen("tg", "LISTEN jobs", "jobs").StreamExecute(context.Background(), mockExec, nil,
+		&handler.MultiGatewayConnectionState{},
+		func(ctx context.Context, r *sqltypes.Result) error {
+			called = true
+			return nil
+		})
+	require.ErrorContains(t, err, "no pooler")
+	assert.False(t, called, "no command completion on failure")
+}
+
+func TestListen_String(t *testing.T) {
+	assert.Equal(t, "Listen(jobs)", NewListen("tg", "", "jobs").String())
+	assert.Equal(t, "Unlisten(jobs)", NewUnlisten("tg", "", "jobs").String())
+	assert.Equal(t, "Unlisten(*)", NewUnlisten("tg", "", "").String())
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// LookupColumn is a column of a sharded table indexed by a lookup table,
+// an unsharded table mapping the values of the column to the shard key
+// values of their rows. The lookup table has a column named like the
+// indexed column and one named like the shard key.
+type LookupColumn struct {
+	// Table is the lookup table.
+	Table string
+
+	// Column is the indexed column, and KeyColumn the shard key.
+	Column    string
+	KeyColumn string
+
+	// Value and Key are the positions of the indexed column and of the
+	// shard key in the rows the entries of the lookup table are made of.
+	Value int
+	Key   int
+}
+
+// insertQuery returns the INSERT of the entries of the rows of an INSERT
+// into the lookup table, or "" if no row has a non-NULL value.
+func (c LookupColumn) insertQuery(rows []*ast.NodeList) (string, error) {
+	var entries []string
+	for i, row := range rows {
+		if c.Value >= len(row.Items) || c.Key >= len(row.Items) {
+			return "", fmt.Errorf("INSERT row %d has no column %d or %d", i, c.Value, c.Key)
+		}
+		value := row.Items[c.Value]
+		if value, ok := value.(*ast.A_Const); ok && value.Isnull {
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("(%s, %s)", value.SqlString(), row.Items[c.Key].SqlString()))
+	}
+	if len(entries) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES %s", c.Table, c.Column, c.KeyColumn, strings.Join(entries, ", ")), nil
+}
+
+// deleteQuery returns the DELETE of the entries of rows from the lookup
+// table, or "" if no row has a non-NULL value.
+func (c LookupColumn) deleteQuery(rows []*sqltypes.Row) string {
+	var entries []string
+	for _, row := range rows {
+		if c.Value >= len(row.Values) || c.Key >= len(row.Values) || row.Values[c.Value].IsNull() {
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("(%s, %s)", literal(row.Values[c.Value]), literal(row.Values[c.Key])))
+	}
+	if len(entries) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("DELETE FROM %s WHERE (%s, %s) IN (%s)", c.Table, c.Column, c.KeyColumn, strings.Join(entries, ", "))
+}
+
+// literal returns the SQL literal of a value in text form.
+func literal(value sqltypes.Value) string {
+	if value.IsNull() {
+		return "NULL"
+	}
+	return ast.QuoteStringLiteral(string(value))
+}
+
+// ShardLookup finds the shards holding the rows of a sharded table with
+// some values of a column indexed by a lookup table, by routing the shard
+// key values the lookup table maps them to.
+type ShardLookup struct {
+	// Shard is the shard of the lookup table.
+	Shard string
+
+	// Query selects the shard key values of the indexed values from the
+	// lookup table.
+	Query string
+
+	// Shards are the shards of the tablegroup, and Router maps the shard key
+	// values to them.
+	Shards []string
+	Router ShardKeyRouter
+}
+
+// NewShardLookup creates a ShardLookup of the rows with the given values
+// of the column of lookup.
+func NewShardLookup(lookup LookupColumn, values []sqltypes.Value, shard string, shards []string, router ShardKeyRouter) *ShardLookup {
+	literals := make([]string, len(values))
+	for i, value := range values {
+		literals[i] = literal(value)
+	}
+	return &ShardLookup{
+		Shard:  shard,
+		Query:  fmt.Sprintf("SELECT %s FROM %s WHERE %s IN (%s)", lookup.KeyColumn, lookup.Table, lookup.Column, strings.Join(literals, ", ")),
+		Shards: shards,
+		Router: router,
+	}
+}
+
+// findShards returns the shards of the shard key values the lookup table
+// maps the values to, in the order of Shards. When the values have no
+// entries, there are no rows to find: the first shard is returned, for the
+// statement to return its empty result.
+//
+// The lookup table is read in the session, so that it includes the entries
+// written earlier in the transaction.
+func (l *ShardLookup) findShards(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	tableGroup string,
+) ([]string, error) {
+	found := make(map[string]bool)
+	err := exec.StreamExecute(ctx, conn, tableGroup, l.Shard, l.Query, state, func(_ context.Context, result *sqltypes.Result) error {
+		for _, row := range result.Rows {
+			if len(row.Values) == 0 || row.Values[0].IsNull() {
+				continue
+			}
+			shard, err := l.Router.ShardForKey(row.Values[0], false)
+			if err != nil {
+				return err
+			}
+			found[shard] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var shards []string
+	for _, shard := range l.Shards {
+		if found[shard] {
+			shards = append(shards, shard)
+		}
+	}
+	if len(shards) == 0 && len(l.Shards) > 0 {
+		shards = l.Shards[:1]
+	}
+	return shards, nil
+}
+
+// LookupDelete is a primitive that runs a DELETE on a sharded table
+// indexed by lookup tables, and deletes the entries of the deleted rows
+// from the lookup tables: the rows are read, and locked, before the DELETE,
+// and their entries deleted after it. The DELETE and the lookup tables
+// commit together (see executeOnShards).
+type LookupDelete struct {
+	// TableGroup is the target tablegroup for this query.
+	TableGroup string
+
+	// Shards are the shards of the tablegroup, any of which the DELETE may
+	// write.
+	Shards []string
+
+	// Select returns the indexed values and the shard key of the rows the
+	// DELETE deletes, locking them.
+	Select Primitive
+
+	// Delete runs the DELETE.
+	Delete Primitive
+
+	// Lookups are the lookup indexes of the table, on LookupShard. Their
+	// Value and Key are positions in the rows of Select.
+	Lookups     []LookupColumn
+	LookupShard string
+}
+
+// StreamExecute implements the Primitive interface.
+func (d *LookupDelete) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	result := &sqltypes.Result{}
+	err := executeOnShards(ctx, exec, conn, state, d.TableGroup, d.Shards, func() error {
+		deleted, err := bufferResult(ctx, d.Select, exec, conn, state, 0)
+		if err != nil {
+			return err
+		}
+		err = d.Delete.StreamExecute(ctx, exec, conn, state, func(_ context.Context, chunk *sqltypes.Result) error {
+			appendResult(result, chunk)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, lookup := range d.Lookups {
+			sql := lookup.deleteQuery(deleted.Rows)
+			if sql == "" {
+				continue
+			}
+			if _, err := executeQuery(ctx, exec, conn, state, d.TableGroup, d.LookupShard, sql); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return callback(ctx, result)
+}
+
+// GetTableGroup implements the Primitive interface.
+func (d *LookupDelete) GetTableGroup() string {
+	return d.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (d *LookupDelete) GetQuery() string {
+	return d.Delete.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (d *LookupDelete) String() string {
+	lookups := make([]string, len(d.Lookups))
+	for i, lookup := range d.Lookups {
+		lookups[i] = lookup.Table
+	}
+	return fmt.Sprintf("LookupDelete(lookups=%v, select=%s, delete=%s)", lookups, d.Select, d.Delete)
+}
+
+// Ensure LookupDelete implements Primitive interface.
+var _ Primitive = (*LookupDelete)(nil)
@@ -0,0 +1,145 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// lookupExecute records the queries run on each shard as "shard:sql", along
+// with the implicit transactions around them, and returns the results set
+// for them.
+type lookupExecute struct {
+	writeExecute
+	results map[string]*sqltypes.Result
+}
+
+func (e *lookupExecute) StreamExecute(
+	ctx context.Context,
+	_ *server.Conn,
+	_ string,
+	shard string,
+	sql string,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	e.calls = append(e.calls, shard+":"+sql)
+	if result, ok := e.results[shard+":"+sql]; ok {
+		return callback(ctx, result)
+	}
+	return callback(ctx, &sqltypes.Result{RowsAffected: 1, CommandTag: "DELETE 1"})
+}
+
+func (e *lookupExecute) ScatterExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shards []string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	for _, shard := range shards {
+		if err := e.StreamExecute(ctx, conn, tableGroup, shard, sql, state, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var emailLookup = LookupColumn{Table: "users_email_lookup", Column: "email", KeyColumn: "id", Value: 0, Key: 1}
+
+func TestNewShardLookup(t *testing.T) {
+	lookup := NewShardLookup(emailLookup, []sqltypes.Value{sqltypes.Value("a@x"), sqltypes.Value("o'b")}, "-80", nil, nil)
+	assert.Equal(t, "SELECT id FROM users_email_lookup WHERE email IN ('a@x', 'o''b')", lookup.Query)
+}
+
+func TestScatterRouteLookup(t *testing.T) {
+	newRoute := func() *ScatterRoute {
+		route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT * FROM users WHERE email IN ('a@x', 'b@x')")
+		route.Lookup = NewShardLookup(emailLookup, []sqltypes.Value{sqltypes.Value("a@x"), sqltypes.Value("b@x")}, "-80", route.Shards, shardByParity{})
+		return route
+	}
+
+	t.Run("shards of the entries", func(t *testing.T) {
+		route := newRoute()
+		exec := &lookupExecute{results: map[string]*sqltypes.Result{
+			"-80:" + route.Lookup.Query: {Rows: textRows([]string{"3"}, []string{"5"})},
+		}}
+		var results []*sqltypes.Result
+		err := route.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(), func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"-80:" + route.Lookup.Query, "80-:" + route.Query}, exec.calls)
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		route := newRoute()
+		exec := &lookupExecute{results: map[string]*sqltypes.Result{
+			"-80:" + route.Lookup.Query: {},
+		}}
+		err := route.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(), func(context.Context, *sqltypes.Result) error {
+			return nil
+		})
+		require.NoError(t, err)
+		// The query runs on the first shard, for its empty result.
+		assert.Equal(t, []string{"-80:" + route.Lookup.Query, "-80:" + route.Query}, exec.calls)
+	})
+}
+
+func TestLookupDelete(t *testing.T) {
+	selectQuery := "SELECT email, id FROM users WHERE name = 'x' FOR UPDATE"
+	deleteQuery := "DELETE FROM users WHERE name = 'x'"
+	shards := []string{"-80", "80-"}
+	lookupDelete := &LookupDelete{
+		TableGroup:  "tg",
+		Shards:      shards,
+		Select:      NewScatterRoute("tg", shards, selectQuery),
+		Delete:      NewScatterRoute("tg", shards, deleteQuery),
+		Lookups:     []LookupColumn{emailLookup},
+		LookupShard: "-80",
+	}
+	exec := &lookupExecute{results: map[string]*sqltypes.Result{
+		"-80:" + selectQuery: {Rows: textRows([]string{"a@x", "2"}, []string{"NULL", "4"})},
+		"80-:" + selectQuery: {Rows: textRows([]string{"b@x", "3"})},
+	}}
+
+	var results []*sqltypes.Result
+	err := lookupDelete.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(), func(_ context.Context, result *sqltypes.Result) error {
+		results = append(results, result)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "DELETE 2", results[0].CommandTag)
+	assert.Equal(t, []string{
+		"BEGIN -80", "BEGIN 80-",
+		"-80:" + selectQuery, "80-:" + selectQuery,
+		"-80:" + deleteQuery, "80-:" + deleteQuery,
+		"-80:DELETE FROM users_email_lookup WHERE (email, id) IN (('a@x', '2'), ('b@x', '3'))",
+		"COMMIT -80", "COMMIT 80-",
+	}, exec.calls)
+}
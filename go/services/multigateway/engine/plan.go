@@ -17,12 +17,44 @@ package engine
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	"github.com/multigres/multigres/go/services/multigateway/handler"
 )
 
+// StatementClass is the kind of work a statement does, which decides where
+// it may run: reads can be served by replicas, the rest by primaries only.
+type StatementClass int
+
+const (
+	// StatementUtility is any statement not classified otherwise: session
+	// and transaction control, maintenance, EXPLAIN, LISTEN...
+	StatementUtility StatementClass = iota
+	// StatementRead reads data without changing it.
+	StatementRead
+	// StatementWrite changes the data of tables.
+	StatementWrite
+	// StatementDDL changes the schema or other database objects.
+	StatementDDL
+)
+
+// String returns the name of the class.
+func (c StatementClass) String() string {
+	switch c {
+	case StatementRead:
+		return "read"
+	case StatementWrite:
+		return "write"
+	case StatementDDL:
+		return "ddl"
+	default:
+		return "utility"
+	}
+}
+
 // Plan represents a query execution plan.
 // It contains the root primitive and metadata about the query.
 type Plan struct {
@@ -30,8 +62,19 @@ type Plan struct {
 	Original string
 
 	// Primitive is the root execution primitive.
-	// In Phase 1, this will always be a Route primitive.
 	Primitive Primitive
+
+	// Class is the kind of work the statement does.
+	Class StatementClass
+
+	// Tables are the tables the statement reads or writes, set when the
+	// results of reads are cached, so that writes invalidate them.
+	Tables []string
+
+	// PoolerType, unless UNKNOWN, is the type of pooler the statement
+	// reads from outside transactions, set by the routing rules of its
+	// tables whatever the session targets.
+	PoolerType clustermetadatapb.PoolerType
 }
 
 // NewPlan creates a new query plan.
@@ -43,6 +86,9 @@ func NewPlan(original string, primitive Primitive) *Plan {
 }
 
 // StreamExecute executes the plan by calling the root primitive's StreamExecute.
+// The class of the statement is available to the IExecute through ctx (see
+// StatementClassFromContext), and so is its pooler type if the plan has
+// one (see PoolerTypeFromContext).
 func (p *Plan) StreamExecute(
 	ctx context.Context,
 	exec IExecute,
@@ -50,15 +96,75 @@ func (p *Plan) StreamExecute(
 	state *handler.MultiGatewayConnectionState,
 	callback func(context.Context, *sqltypes.Result) error,
 ) error {
+	ctx = WithStatementClass(ctx, p.Class)
+	if p.PoolerType != clustermetadatapb.PoolerType_UNKNOWN {
+		ctx = WithPoolerType(ctx, p.PoolerType)
+	}
 	return p.Primitive.StreamExecute(ctx, exec, conn, state, callback)
 }
 
+// statementClassKey is the context key of the class of the statement whose
+// plan runs.
+type statementClassKey struct{}
+
+// WithStatementClass returns a copy of ctx running a statement of class.
+func WithStatementClass(ctx context.Context, class StatementClass) context.Context {
+	return context.WithValue(ctx, statementClassKey{}, class)
+}
+
+// StatementClassFromContext returns the class of the statement whose plan
+// runs in ctx, StatementUtility outside of a plan.
+func StatementClassFromContext(ctx context.Context) StatementClass {
+	class, _ := ctx.Value(statementClassKey{}).(StatementClass)
+	return class
+}
+
+// poolerTypeKey is the context key of the pooler type of the statement
+// whose plan runs.
+type poolerTypeKey struct{}
+
+// WithPoolerType returns a copy of ctx running a statement reading from
+// poolers of poolerType.
+func WithPoolerType(ctx context.Context, poolerType clustermetadatapb.PoolerType) context.Context {
+	return context.WithValue(ctx, poolerTypeKey{}, poolerType)
+}
+
+// PoolerTypeFromContext returns the pooler type of the statement whose
+// plan runs in ctx, UNKNOWN if it reads from the poolers the session
+// targets.
+func PoolerTypeFromContext(ctx context.Context) clustermetadatapb.PoolerType {
+	poolerType, _ := ctx.Value(poolerTypeKey{}).(clustermetadatapb.PoolerType)
+	return poolerType
+}
+
 // GetTableGroup returns the target tablegroup from the primitive.
 func (p *Plan) GetTableGroup() string {
 	return p.Primitive.GetTableGroup()
 }
 
+// ShardCount returns the number of shards the plan runs on, as EXPLAIN
+// (MULTIGRES) describes them. Primitives running on the shard of the
+// session, or on the gateway alone, count for none.
+func (p *Plan) ShardCount() int {
+	shards := make(map[[2]string]struct{})
+	nodes := []Primitive{p.Primitive}
+	for len(nodes) > 0 {
+		node := describe(nodes[len(nodes)-1])
+		nodes = append(nodes[:len(nodes)-1], node.inputs...)
+		for _, shard := range node.shards {
+			shards[[2]string{node.tableGroup, shard}] = struct{}{}
+		}
+	}
+	return len(shards)
+}
+
+// Type returns the type of the root primitive of the plan, as in "Route"
+// or "ScatterRoute", which tells how the statement runs.
+func (p *Plan) Type() string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", p.Primitive), "*engine.")
+}
+
 // String returns a string representation of the plan for debugging.
 func (p *Plan) String() string {
-	return fmt.Sprintf("Plan{original=%q, primitive=%s}", p.Original, p.Primitive.String())
+	return fmt.Sprintf("Plan{original=%q, class=%s, primitive=%s}", p.Original, p.Class, p.Primitive.String())
 }
@@ -0,0 +1,367 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// latencyBuckets is the number of buckets of the latency histogram of a
+// statement. Bucket i holds the durations up to 2^(i/4) microseconds, so
+// that the buckets cover up to an hour with a precision of 19%.
+const latencyBuckets = 128
+
+// QueryStatsKey identifies the statements whose statistics are aggregated:
+// those of a role on a database with the same normalized text.
+type QueryStatsKey struct {
+	User     string
+	Database string
+	// Query is the normalized text of the statements.
+	Query string
+}
+
+// QueryStatement holds the statistics of the statements of a key, as
+// pg_stat_statements does for a backend.
+type QueryStatement struct {
+	// QueryID is a hash of the normalized text of the statements.
+	QueryID  int64  `json:"queryid"`
+	User     string `json:"user"`
+	Database string `json:"database"`
+	Query    string `json:"query"`
+
+	Calls     int64         `json:"calls"`
+	TotalTime time.Duration `json:"total_time"`
+	MinTime   time.Duration `json:"min_time"`
+	MaxTime   time.Duration `json:"max_time"`
+	MeanTime  time.Duration `json:"mean_time"`
+	// P99Time is the duration that 99% of the calls did not exceed, up to
+	// the precision of the latency histogram.
+	P99Time time.Duration `json:"p99_time"`
+	// Rows is the number of rows returned or affected.
+	Rows   int64 `json:"rows"`
+	Errors int64 `json:"errors"`
+	// ShardQueries is the number of queries the calls ran on shards, and
+	// MaxShards the number of shards the widest call ran on.
+	ShardQueries int64 `json:"shard_queries"`
+	MaxShards    int64 `json:"max_shards"`
+}
+
+// queryStatsEntry accumulates the statistics of the statements of a key.
+type queryStatsEntry struct {
+	mu        sync.Mutex
+	stats     QueryStatement
+	latencies [latencyBuckets]int64
+}
+
+// QueryStats aggregates the statistics of the statements run through the
+// gateway by normalized text, like pg_stat_statements. Once it holds
+// maxSize keys, the least called are evicted to make room for new ones.
+type QueryStats struct {
+	maxSize int
+
+	mu        sync.RWMutex
+	entries   map[QueryStatsKey]*queryStatsEntry
+	evictions atomic.Int64
+}
+
+// NewQueryStats returns statistics of up to maxSize keys.
+func NewQueryStats(maxSize int) *QueryStats {
+	return &QueryStats{
+		maxSize: max(maxSize, 1),
+		entries: make(map[QueryStatsKey]*queryStatsEntry),
+	}
+}
+
+// Record adds a statement of key to the statistics: the time it took, the
+// rows it returned or affected, the shards it ran on, and whether it
+// failed.
+func (s *QueryStats) Record(key QueryStatsKey, duration time.Duration, rows int64, shards int, err error) {
+	entry := s.entry(key)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	stats := &entry.stats
+	if stats.Calls == 0 || duration < stats.MinTime {
+		stats.MinTime = duration
+	}
+	stats.MaxTime = max(stats.MaxTime, duration)
+	stats.Calls++
+	stats.TotalTime += duration
+	stats.Rows += rows
+	if err != nil {
+		stats.Errors++
+	}
+	stats.ShardQueries += int64(shards)
+	stats.MaxShards = max(stats.MaxShards, int64(shards))
+	entry.latencies[latencyBucket(duration)]++
+}
+
+// entry returns the entry of key, adding it if needed.
+func (s *QueryStats) entry(key QueryStatsKey) *queryStatsEntry {
+	s.mu.RLock()
+	entry, ok := s.entries[key]
+	s.mu.RUnlock()
+	if ok {
+		return entry
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.entries[key]; ok {
+		return entry
+	}
+	if len(s.entries) >= s.maxSize {
+		s.evictLocked()
+	}
+	entry = &queryStatsEntry{stats: QueryStatement{
+		QueryID:  queryID(key.Query),
+		User:     key.User,
+		Database: key.Database,
+		Query:    key.Query,
+	}}
+	s.entries[key] = entry
+	return entry
+}
+
+// evictLocked removes the 5% least called entries, so that the statistics
+// are not scanned for every new key once full. s.mu must be held.
+func (s *QueryStats) evictLocked() {
+	type candidate struct {
+		key   QueryStatsKey
+		calls int64
+	}
+	candidates := make([]candidate, 0, len(s.entries))
+	for key, entry := range s.entries {
+		entry.mu.Lock()
+		candidates = append(candidates, candidate{key: key, calls: entry.stats.Calls})
+		entry.mu.Unlock()
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.calls, b.calls)
+	})
+	for _, c := range candidates[:max(len(candidates)/20, 1)] {
+		delete(s.entries, c.key)
+		s.evictions.Add(1)
+	}
+}
+
+// Entries returns the statistics of every key, the most time consuming
+// first.
+func (s *QueryStats) Entries() []QueryStatement {
+	s.mu.RLock()
+	entries := make([]*queryStatsEntry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		entries = append(entries, entry)
+	}
+	s.mu.RUnlock()
+
+	statements := make([]QueryStatement, 0, len(entries))
+	for _, entry := range entries {
+		entry.mu.Lock()
+		stats := entry.stats
+		if stats.Calls > 0 {
+			stats.MeanTime = stats.TotalTime / time.Duration(stats.Calls)
+			stats.P99Time = min(percentile(entry.latencies[:], stats.Calls, 0.99), stats.MaxTime)
+		}
+		entry.mu.Unlock()
+		statements = append(statements, stats)
+	}
+	slices.SortFunc(statements, func(a, b QueryStatement) int {
+		return cmp.Or(cmp.Compare(b.TotalTime, a.TotalTime), cmp.Compare(a.Query, b.Query))
+	})
+	return statements
+}
+
+// Size returns the number of keys of the statistics.
+func (s *QueryStats) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.entries)
+}
+
+// MaxSize returns the number of keys kept before evicting the least called.
+func (s *QueryStats) MaxSize() int {
+	return s.maxSize
+}
+
+// Evictions returns the number of keys evicted to make room for new ones.
+func (s *QueryStats) Evictions() int64 {
+	return s.evictions.Load()
+}
+
+// Reset discards the statistics of every key.
+func (s *QueryStats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make(map[QueryStatsKey]*queryStatsEntry)
+}
+
+// queryID returns the hash identifying a normalized text.
+func queryID(query string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(query))
+	return int64(h.Sum64())
+}
+
+// latencyBucket returns the bucket of the latency histogram of d.
+func latencyBucket(d time.Duration) int {
+	us := float64(d) / float64(time.Microsecond)
+	if us <= 1 {
+		return 0
+	}
+	return min(int(math.Ceil(4*math.Log2(us))), latencyBuckets-1)
+}
+
+// percentile returns the upper bound of the bucket of the latency
+// histogram holding the fraction p of the calls.
+func percentile(latencies []int64, calls int64, p float64) time.Duration {
+	rank := int64(math.Ceil(p * float64(calls)))
+	var seen int64
+	for i, n := range latencies {
+		seen += n
+		if seen >= rank {
+			return time.Duration(math.Exp2(float64(i)/4) * float64(time.Microsecond))
+		}
+	}
+	return 0
+}
+
+// statStatementsColumn is a column of multigres.stat_statements.
+type statStatementsColumn struct {
+	name  string
+	oid   uint32
+	value func(*QueryStatement) string
+}
+
+// milliseconds formats d as a number of milliseconds, as pg_stat_statements
+// reports times.
+func milliseconds(d time.Duration) string {
+	return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', -1, 64)
+}
+
+// statStatementsColumns are the columns of multigres.stat_statements, named
+// after those of pg_stat_statements where they match.
+var statStatementsColumns = []statStatementsColumn{
+	{"queryid", uint32(ast.INT8OID), func(s *QueryStatement) string { return strconv.FormatInt(s.QueryID, 10) }},
+	{"usename", uint32(ast.TEXTOID), func(s *QueryStatement) string { return s.User }},
+	{"datname", uint32(ast.TEXTOID), func(s *QueryStatement) string { return s.Database }},
+	{"query", uint32(ast.TEXTOID), func(s *QueryStatement) string { return s.Query }},
+	{"calls", uint32(ast.INT8OID), func(s *QueryStatement) string { return strconv.FormatInt(s.Calls, 10) }},
+	{"total_exec_time", uint32(ast.FLOAT8OID), func(s *QueryStatement) string { return milliseconds(s.TotalTime) }},
+	{"min_exec_time", uint32(ast.FLOAT8OID), func(s *QueryStatement) string { return milliseconds(s.MinTime) }},
+	{"max_exec_time", uint32(ast.FLOAT8OID), func(s *QueryStatement) string { return milliseconds(s.MaxTime) }},
+	{"mean_exec_time", uint32(ast.FLOAT8OID), func(s *QueryStatement) string { return milliseconds(s.MeanTime) }},
+	{"p99_exec_time", uint32(ast.FLOAT8OID), func(s *QueryStatement) string { return milliseconds(s.P99Time) }},
+	{"rows", uint32(ast.INT8OID), func(s *QueryStatement) string { return strconv.FormatInt(s.Rows, 10) }},
+	{"errors", uint32(ast.INT8OID), func(s *QueryStatement) string { return strconv.FormatInt(s.Errors, 10) }},
+	{"shard_queries", uint32(ast.INT8OID), func(s *QueryStatement) string { return strconv.FormatInt(s.ShardQueries, 10) }},
+	{"max_shards", uint32(ast.INT8OID), func(s *QueryStatement) string { return strconv.FormatInt(s.MaxShards, 10) }},
+}
+
+// StatStatementsColumns returns the names of the columns of
+// multigres.stat_statements, in order.
+func StatStatementsColumns() []string {
+	names := make([]string, len(statStatementsColumns))
+	for i, column := range statStatementsColumns {
+		names[i] = column.name
+	}
+	return names
+}
+
+// StatStatementsColumn is an output column of a StatStatements.
+type StatStatementsColumn struct {
+	// Column is the index of the column in StatStatementsColumns.
+	Column int
+	// Name is the name of the output column.
+	Name string
+}
+
+// StatStatements is a primitive answering a SELECT of the virtual table
+// multigres.stat_statements from the statistics of the gateway, without
+// reaching a backend.
+type StatStatements struct {
+	Query   string
+	Stats   *QueryStats
+	Columns []StatStatementsColumn
+}
+
+// NewStatStatements creates a new StatStatements primitive.
+func NewStatStatements(query string, stats *QueryStats, columns []StatStatementsColumn) *StatStatements {
+	return &StatStatements{
+		Query:   query,
+		Stats:   stats,
+		Columns: columns,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (s *StatStatements) StreamExecute(
+	ctx context.Context,
+	_ IExecute,
+	_ *server.Conn,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	result := &sqltypes.Result{Fields: make([]*query.Field, len(s.Columns))}
+	for i, column := range s.Columns {
+		oid := statStatementsColumns[column.Column].oid
+		size := int32(8)
+		if oid == uint32(ast.TEXTOID) {
+			size = -1
+		}
+		result.Fields[i] = &query.Field{Name: column.Name, DataTypeOid: oid, DataTypeSize: size}
+	}
+	for _, statement := range s.Stats.Entries() {
+		row := &sqltypes.Row{Values: make([]sqltypes.Value, len(s.Columns))}
+		for i, column := range s.Columns {
+			row.Values[i] = sqltypes.Value(statStatementsColumns[column.Column].value(&statement))
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	result.CommandTag = "SELECT " + strconv.Itoa(len(result.Rows))
+	return callback(ctx, result)
+}
+
+// GetTableGroup implements the Primitive interface.
+func (s *StatStatements) GetTableGroup() string {
+	return ""
+}
+
+// GetQuery implements the Primitive interface.
+func (s *StatStatements) GetQuery() string {
+	return s.Query
+}
+
+// String implements the Primitive interface.
+func (s *StatStatements) String() string {
+	return fmt.Sprintf("StatStatements(columns=%d)", len(s.Columns))
+}
+
+// Ensure StatStatements implements Primitive interface.
+var _ Primitive = (*StatStatements)(nil)
@@ -0,0 +1,90 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryStatsRecord(t *testing.T) {
+	stats := NewQueryStats(10)
+	key := QueryStatsKey{User: "alice", Database: "db", Query: "SELECT * FROM t WHERE id = $1"}
+	for i := 1; i <= 100; i++ {
+		stats.Record(key, time.Duration(i)*time.Millisecond, 2, 1, nil)
+	}
+	stats.Record(key, time.Second, 0, 4, errors.New("boom"))
+	stats.Record(QueryStatsKey{User: "bob", Database: "db", Query: "SELECT 1"}, time.Millisecond, 1, 0, nil)
+
+	entries := stats.Entries()
+	require.Len(t, entries, 2)
+	s := entries[0]
+	assert.Equal(t, key.Query, s.Query)
+	assert.Equal(t, queryID(key.Query), s.QueryID)
+	assert.Equal(t, int64(101), s.Calls)
+	assert.Equal(t, 5050*time.Millisecond+time.Second, s.TotalTime)
+	assert.Equal(t, time.Millisecond, s.MinTime)
+	assert.Equal(t, time.Second, s.MaxTime)
+	assert.Equal(t, s.TotalTime/101, s.MeanTime)
+	// The 100th fastest call took 100ms, within the precision of the
+	// histogram.
+	assert.InDelta(t, float64(100*time.Millisecond), float64(s.P99Time), float64(20*time.Millisecond))
+	assert.Equal(t, int64(200), s.Rows)
+	assert.Equal(t, int64(1), s.Errors)
+	assert.Equal(t, int64(104), s.ShardQueries)
+	assert.Equal(t, int64(4), s.MaxShards)
+	assert.Equal(t, "bob", entries[1].User)
+
+	stats.Reset()
+	assert.Empty(t, stats.Entries())
+	assert.Equal(t, 0, stats.Size())
+}
+
+func TestQueryStatsEvictsLeastCalled(t *testing.T) {
+	stats := NewQueryStats(20)
+	for i := range 20 {
+		key := QueryStatsKey{Query: fmt.Sprintf("SELECT %d", i)}
+		for range i + 1 {
+			stats.Record(key, time.Millisecond, 0, 1, nil)
+		}
+	}
+	stats.Record(QueryStatsKey{Query: "SELECT new"}, time.Millisecond, 0, 1, nil)
+
+	assert.Equal(t, 20, stats.Size())
+	assert.Equal(t, int64(1), stats.Evictions())
+	for _, s := range stats.Entries() {
+		assert.NotEqual(t, "SELECT 0", s.Query)
+	}
+}
+
+func TestStatStatements(t *testing.T) {
+	stats := NewQueryStats(10)
+	stats.Record(QueryStatsKey{User: "alice", Database: "db", Query: "SELECT $1"}, 1500*time.Microsecond, 1, 1, nil)
+	primitive := NewStatStatements("SELECT usename, calls AS n, total_exec_time FROM multigres.stat_statements", stats,
+		[]StatStatementsColumn{{Column: 1, Name: "usename"}, {Column: 4, Name: "n"}, {Column: 5, Name: "total_exec_time"}})
+
+	result := runPrimitive(t, primitive)
+	require.Len(t, result.Fields, 3)
+	assert.Equal(t, "n", result.Fields[1].Name)
+	assert.Equal(t, int32(-1), result.Fields[0].DataTypeSize)
+	assert.Equal(t, int32(8), result.Fields[2].DataTypeSize)
+	assert.Equal(t, textRows([]string{"alice", "1", "1.5"}), result.Rows)
+	assert.Equal(t, "SELECT 1", result.CommandTag)
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// ShardTiming is the time a query took on a shard.
+type ShardTiming struct {
+	TableGroup string `json:"tablegroup"`
+	Shard      string `json:"shard"`
+	PoolerType string `json:"pooler_type"`
+	// Duration is the time from the call to the pooler until its last
+	// result, including the checkout of a backend connection by the
+	// pooler.
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// QueryTiming breaks down the time a query takes in the gateway: planning
+// it, waiting for its turn to run on the shards, and running on them. The
+// shards of a scatter record their timings concurrently.
+type QueryTiming struct {
+	// Start is when the gateway received the query.
+	Start time.Time
+	// Planning is the time the query took to plan, or to find its cached
+	// plan.
+	Planning time.Duration
+
+	mu        sync.Mutex
+	queueWait time.Duration
+	shards    []ShardTiming
+}
+
+// NewQueryTiming returns the timing of a query received now.
+func NewQueryTiming() *QueryTiming {
+	return &QueryTiming{Start: time.Now()}
+}
+
+// AddQueueWait adds the time a shard query waited to run: for the limit
+// of concurrent shard queries of the gateway, or while its shard failed
+// over. Does nothing if t is nil.
+func (t *QueryTiming) AddQueueWait(wait time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.queueWait += wait
+}
+
+// RecordShard records the time the query took on target, and the error it
+// failed with. Does nothing if t is nil.
+func (t *QueryTiming) RecordShard(target *query.Target, duration time.Duration, err error) {
+	if t == nil {
+		return
+	}
+	shard := ShardTiming{
+		TableGroup: target.TableGroup,
+		Shard:      target.Shard,
+		PoolerType: target.PoolerType.String(),
+		Duration:   duration,
+	}
+	if err != nil {
+		shard.Error = err.Error()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.shards = append(t.shards, shard)
+}
+
+// QueueWait returns the total time the shard queries waited to run.
+func (t *QueryTiming) QueueWait() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.queueWait
+}
+
+// Shards returns the times the query took on its shards, in the order
+// they finished. A shard retried or falling back from a replica to the
+// primary appears once per attempt.
+func (t *QueryTiming) Shards() []ShardTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]ShardTiming(nil), t.shards...)
+}
+
+// ShardCount returns the number of distinct shards the query ran on.
+func (t *QueryTiming) ShardCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	shards := make(map[[2]string]struct{}, len(t.shards))
+	for _, shard := range t.shards {
+		shards[[2]string{shard.TableGroup, shard.Shard}] = struct{}{}
+	}
+	return len(shards)
+}
+
+// queryTimingKey is the context key of the timing of the query running.
+type queryTimingKey struct{}
+
+// WithQueryTiming returns a copy of ctx recording the timing of its query
+// in t.
+func WithQueryTiming(ctx context.Context, t *QueryTiming) context.Context {
+	return context.WithValue(ctx, queryTimingKey{}, t)
+}
+
+// QueryTimingFromContext returns the timing of the query running in ctx,
+// or nil if it is not timed.
+func QueryTimingFromContext(ctx context.Context) *QueryTiming {
+	t, _ := ctx.Value(queryTimingKey{}).(*QueryTiming)
+	return t
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// ReferenceWrite is a primitive that runs a statement writing reference
+// tables, the small tables copied whole on every shard, on every shard of
+// a tablegroup. Each shard applies the same change to its copy, and the
+// result of the first shard is returned.
+//
+// The statement commits on every shard or on none (see executeOnShards),
+// so that the copies do not diverge when a shard fails it.
+type ReferenceWrite struct {
+	// TableGroup is the target tablegroup for this query.
+	TableGroup string
+
+	// Shards are the shards holding a copy of the reference tables.
+	Shards []string
+
+	// Query is the SQL query string to execute.
+	Query string
+}
+
+// NewReferenceWrite creates a new ReferenceWrite primitive.
+func NewReferenceWrite(tableGroup string, shards []string, query string) *ReferenceWrite {
+	return &ReferenceWrite{
+		TableGroup: tableGroup,
+		Shards:     shards,
+		Query:      query,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (w *ReferenceWrite) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	var result *sqltypes.Result
+	err := executeOnShards(ctx, exec, conn, state, w.TableGroup, w.Shards, func() error {
+		var err error
+		result, err = w.execute(ctx, exec, conn, state)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return callback(ctx, result)
+}
+
+// execute runs the statement on every shard and returns the result of the
+// first.
+func (w *ReferenceWrite) execute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+) (*sqltypes.Result, error) {
+	var first *sqltypes.Result
+	err := exec.ScatterExecute(ctx, conn, w.TableGroup, w.Shards, w.Query, state, func(_ context.Context, result *sqltypes.Result) error {
+		if first == nil {
+			first = result
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if first == nil {
+		first = &sqltypes.Result{}
+	}
+	return first, nil
+}
+
+// GetTableGroup implements the Primitive interface.
+func (w *ReferenceWrite) GetTableGroup() string {
+	return w.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (w *ReferenceWrite) GetQuery() string {
+	return w.Query
+}
+
+// String implements the Primitive interface.
+func (w *ReferenceWrite) String() string {
+	return fmt.Sprintf("ReferenceWrite(tablegroup=%s, shards=%s, query=%s)", w.TableGroup, strings.Join(w.Shards, ","), w.Query)
+}
+
+// Ensure ReferenceWrite implements Primitive interface.
+var _ Primitive = (*ReferenceWrite)(nil)
+
+// executeOnShards runs execute, a write on several shards of a tablegroup,
+// so that it commits on all of them or on none. Inside a transaction block,
+// the write is part of the block, whose COMMIT commits the shards together.
+// Outside one, it runs in an implicit transaction on each shard, committed
+// once execute succeeded and rolled back on every shard otherwise.
+func executeOnShards(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	tableGroup string,
+	shards []string,
+	execute func() error,
+) error {
+	if state.InTransaction() {
+		return execute()
+	}
+
+	var begun []string
+	var err error
+	for _, shard := range shards {
+		if err = exec.BeginImplicitTransaction(ctx, conn, tableGroup, shard, state); err != nil {
+			break
+		}
+		begun = append(begun, shard)
+	}
+	if err == nil {
+		err = execute()
+	}
+	// Once a shard fails to commit, the shards after it are rolled back.
+	for _, shard := range begun {
+		if endErr := exec.EndImplicitTransaction(ctx, conn, tableGroup, shard, state, err == nil); endErr != nil && err == nil {
+			err = endErr
+		}
+	}
+	return err
+}
+
+// executeQuery runs sql on a shard and returns its whole result.
+func executeQuery(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	tableGroup string,
+	shard string,
+	sql string,
+) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := exec.StreamExecute(ctx, conn, tableGroup, shard, sql, state, func(_ context.Context, chunk *sqltypes.Result) error {
+		appendResult(result, chunk)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// appendResult appends a chunk of a result streamed by a shard to result.
+func appendResult(result, chunk *sqltypes.Result) {
+	if len(chunk.Fields) > 0 {
+		result.Fields = chunk.Fields
+	}
+	result.Rows = append(result.Rows, chunk.Rows...)
+	result.Notices = append(result.Notices, chunk.Notices...)
+	result.RowsAffected += chunk.RowsAffected
+	if chunk.CommandTag != "" {
+		result.CommandTag = chunk.CommandTag
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// writeExecute records the calls of a ReferenceWrite, failing the
+// statement on the shard failShard.
+type writeExecute struct {
+	mockIExecute
+	failShard string
+	calls     []string
+}
+
+func (e *writeExecute) BeginImplicitTransaction(
+	_ context.Context,
+	_ *server.Conn,
+	_ string,
+	shard string,
+	_ *handler.MultiGatewayConnectionState,
+) error {
+	e.calls = append(e.calls, "BEGIN "+shard)
+	return nil
+}
+
+func (e *writeExecute) EndImplicitTransaction(
+	_ context.Context,
+	_ *server.Conn,
+	_ string,
+	shard string,
+	_ *handler.MultiGatewayConnectionState,
+	commit bool,
+) error {
+	if commit {
+		e.calls = append(e.calls, "COMMIT "+shard)
+	} else {
+		e.calls = append(e.calls, "ROLLBACK "+shard)
+	}
+	return nil
+}
+
+func (e *writeExecute) ScatterExecute(
+	ctx context.Context,
+	_ *server.Conn,
+	_ string,
+	shards []string,
+	sql string,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	for _, shard := range shards {
+		e.calls = append(e.calls, sql+" "+shard)
+		if shard == e.failShard {
+			return errors.New("shard failed")
+		}
+		if err := callback(ctx, &sqltypes.Result{RowsAffected: 2, CommandTag: "UPDATE 2"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runReferenceWrite(t *testing.T, exec IExecute, state *handler.MultiGatewayConnectionState) ([]*sqltypes.Result, error) {
+	t.Helper()
+	write := NewReferenceWrite("tg", []string{"-80", "80-"}, "UPDATE countries SET name = 'x'")
+	var results []*sqltypes.Result
+	err := write.StreamExecute(context.Background(), exec, nil, state, func(_ context.Context, result *sqltypes.Result) error {
+		results = append(results, result)
+		return nil
+	})
+	return results, err
+}
+
+func TestReferenceWrite(t *testing.T) {
+	t.Run("outside a transaction", func(t *testing.T) {
+		exec := &writeExecute{}
+		results, err := runReferenceWrite(t, exec, handler.NewMultiGatewayConnectionState())
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		// The shards apply the same change: a single one is reported.
+		assert.Equal(t, "UPDATE 2", results[0].CommandTag)
+		assert.Equal(t, uint64(2), results[0].RowsAffected)
+		assert.Equal(t, []string{
+			"BEGIN -80", "BEGIN 80-",
+			"UPDATE countries SET name = 'x' -80", "UPDATE countries SET name = 'x' 80-",
+			"COMMIT -80", "COMMIT 80-",
+		}, exec.calls)
+	})
+
+	t.Run("failure rolls back every shard", func(t *testing.T) {
+		exec := &writeExecute{failShard: "80-"}
+		results, err := runReferenceWrite(t, exec, handler.NewMultiGatewayConnectionState())
+		require.Error(t, err)
+		assert.Empty(t, results)
+		assert.Equal(t, []string{"ROLLBACK -80", "ROLLBACK 80-"}, exec.calls[len(exec.calls)-2:])
+	})
+
+	t.Run("inside a transaction block", func(t *testing.T) {
+		exec := &writeExecute{}
+		state := handler.NewMultiGatewayConnectionState()
+		state.BeginTransaction()
+		results, err := runReferenceWrite(t, exec, state)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"UPDATE countries SET name = 'x' -80", "UPDATE countries SET name = 'x' 80-"}, exec.calls)
+	})
+}
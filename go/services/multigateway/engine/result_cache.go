@@ -0,0 +1,355 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// ResultCache is a bounded LRU cache of the results of reads, keyed by
+// database, user, effective role, the session settings changing results
+// and query text. Cached results expire after the time to
+// live of their query, and are removed as soon as a statement routed
+// through the gateway writes one of the tables they read (see
+// InvalidateTables).
+//
+// Writes the gateway does not see, such as those of other gateways,
+// functions or triggers, are only caught up with when the results expire.
+//
+// Tables are named as the statements name them, as table or schema.table.
+// Which table an unqualified name is depends on the search_path, so a
+// write of either form invalidates the reads of the table under the other.
+type ResultCache struct {
+	mu      sync.Mutex
+	cache   map[resultCacheKey]*list.Element
+	lru     *list.List
+	maxSize int
+	// maxRows is the number of rows of the largest result cached.
+	maxRows int
+
+	// tables maps the name of each table, without its schema, to the keys
+	// of the results reading it.
+	tables map[string]map[resultCacheKey]struct{}
+	// version counts the invalidations, and invalidated holds the version
+	// of the last invalidation of each table name, without its schema, so
+	// that a result read while its tables were written is not cached.
+	version     uint64
+	invalidated map[string]uint64
+
+	now func() time.Time
+
+	// Metrics
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// resultCacheKey identifies the statements sharing a cached result.
+type resultCacheKey struct {
+	database string
+	user     string
+	query    string
+
+	// role is the effective role of the session, whose privileges and
+	// row-level security policies decide what the read returns.
+	role string
+	// searchPath, timeZone and dateStyle are the settings of the session
+	// resolving unqualified tables and formatting the rows read.
+	searchPath string
+	timeZone   string
+	dateStyle  string
+}
+
+// newResultCacheKey returns the key of the result of query for the
+// session of conn and state.
+func newResultCacheKey(conn *server.Conn, state *handler.MultiGatewayConnectionState, query string) resultCacheKey {
+	key := resultCacheKey{database: conn.Database(), user: conn.User(), query: query}
+	// SET ROLE takes precedence over SET SESSION AUTHORIZATION until it
+	// is reset with SET ROLE NONE.
+	if role, ok := state.GetSessionVariable("role"); ok && !strings.EqualFold(role, "none") {
+		key.role = role
+	} else if role, ok := state.GetSessionVariable("session_authorization"); ok {
+		key.role = role
+	}
+	key.searchPath, _ = state.GetSessionVariable("search_path")
+	key.timeZone, _ = state.GetSessionVariable("TimeZone")
+	key.dateStyle, _ = state.GetSessionVariable("DateStyle")
+	return key
+}
+
+// cachedResult is a cached result and the tables it was read from.
+type cachedResult struct {
+	key     resultCacheKey
+	result  *sqltypes.Result
+	tables  []string
+	expires time.Time
+}
+
+// NewResultCache creates a new ResultCache holding at most maxSize
+// results of at most maxRows rows each.
+func NewResultCache(maxSize, maxRows int) *ResultCache {
+	return &ResultCache{
+		cache:       make(map[resultCacheKey]*list.Element),
+		lru:         list.New(),
+		maxSize:     maxSize,
+		maxRows:     maxRows,
+		tables:      make(map[string]map[resultCacheKey]struct{}),
+		invalidated: make(map[string]uint64),
+		now:         time.Now,
+	}
+}
+
+// get returns the unexpired result cached for key, and otherwise the
+// version to cache the result read for it with.
+func (c *ResultCache) get(key resultCacheKey) (*sqltypes.Result, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.cache[key]
+	if ok {
+		entry := elem.Value.(*cachedResult)
+		if c.now().Before(entry.expires) {
+			c.lru.MoveToFront(elem)
+			c.hits++
+			return entry.result, 0, true
+		}
+		c.remove(elem)
+	}
+	c.misses++
+	return nil, c.version, false
+}
+
+// put caches the result of key, read from tables since version, for ttl.
+// Results whose tables were written since version are dropped.
+func (c *ResultCache) put(key resultCacheKey, tables []string, result *sqltypes.Result, ttl time.Duration, version uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, table := range tables {
+		if c.invalidated[relationName(table)] > version {
+			return
+		}
+	}
+	if elem, ok := c.cache[key]; ok {
+		c.remove(elem)
+	}
+	entry := &cachedResult{key: key, result: result, tables: tables, expires: c.now().Add(ttl)}
+	c.cache[key] = c.lru.PushFront(entry)
+	for _, table := range tables {
+		name := relationName(table)
+		if c.tables[name] == nil {
+			c.tables[name] = make(map[resultCacheKey]struct{})
+		}
+		c.tables[name][key] = struct{}{}
+	}
+
+	for c.lru.Len() > c.maxSize {
+		c.remove(c.lru.Back())
+		c.evictions++
+	}
+}
+
+// remove removes the cached result of elem.
+func (c *ResultCache) remove(elem *list.Element) {
+	entry := elem.Value.(*cachedResult)
+	c.lru.Remove(elem)
+	delete(c.cache, entry.key)
+	for _, table := range entry.tables {
+		name := relationName(table)
+		delete(c.tables[name], entry.key)
+		if len(c.tables[name]) == 0 {
+			delete(c.tables, name)
+		}
+	}
+}
+
+// InvalidateTables removes the results reading any of tables, which were
+// written.
+func (c *ResultCache) InvalidateTables(tables []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	for _, table := range tables {
+		name := relationName(table)
+		c.invalidated[name] = c.version
+		for key := range c.tables[name] {
+			elem := c.cache[key]
+			if slices.ContainsFunc(elem.Value.(*cachedResult).tables, func(read string) bool {
+				return mayBeSameTable(read, table)
+			}) {
+				c.remove(elem)
+			}
+		}
+	}
+}
+
+// relationName returns the name of table, given as table or schema.table,
+// without its schema.
+func relationName(table string) string {
+	return table[strings.LastIndexByte(table, '.')+1:]
+}
+
+// mayBeSameTable returns true if a and b, given as table or schema.table,
+// may name the same table: an unqualified name may be the table of any
+// schema in the search_path.
+func mayBeSameTable(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return relationName(a) == relationName(b) && (!strings.Contains(a, ".") || !strings.Contains(b, "."))
+}
+
+// InvalidateAll removes every result, after a change of the schema,
+// keeping the metrics.
+func (c *ResultCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	for table := range c.tables {
+		c.invalidated[table] = c.version
+	}
+	c.cache = make(map[resultCacheKey]*list.Element)
+	c.tables = make(map[string]map[resultCacheKey]struct{})
+	c.lru.Init()
+}
+
+// Size returns the number of cached results.
+func (c *ResultCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.cache)
+}
+
+// Hits returns the number of lookups that found a result.
+func (c *ResultCache) Hits() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits
+}
+
+// Misses returns the number of lookups that found no result.
+func (c *ResultCache) Misses() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.misses
+}
+
+// Evictions returns the number of results evicted to make room for others.
+func (c *ResultCache) Evictions() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictions
+}
+
+// CachedRead is a primitive answering a read from the result cache when it
+// holds its result, and caching the result of its input otherwise. Reads
+// in a transaction block bypass the cache, which would not see the writes
+// of the transaction.
+type CachedRead struct {
+	Query string
+	Input Primitive
+	Cache *ResultCache
+
+	// Tables are the tables the read reads, whose writes invalidate its
+	// result.
+	Tables []string
+
+	// TTL is the time the result is cached for.
+	TTL time.Duration
+}
+
+// NewCachedRead creates a new CachedRead primitive.
+func NewCachedRead(query string, input Primitive, cache *ResultCache, tables []string, ttl time.Duration) *CachedRead {
+	return &CachedRead{
+		Query:  query,
+		Input:  input,
+		Cache:  cache,
+		Tables: tables,
+		TTL:    ttl,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *CachedRead) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	if state.InTransaction() {
+		return r.Input.StreamExecute(ctx, exec, conn, state, callback)
+	}
+	key := newResultCacheKey(conn, state, r.Query)
+	result, version, ok := r.Cache.get(key)
+	if ok {
+		return callback(ctx, result)
+	}
+
+	// The rows are streamed while they are kept, up to the largest result
+	// cached.
+	buffered := &sqltypes.Result{}
+	keep := true
+	err := r.Input.StreamExecute(ctx, exec, conn, state, func(ctx context.Context, result *sqltypes.Result) error {
+		if keep {
+			if len(result.Fields) > 0 {
+				buffered.Fields = result.Fields
+			}
+			buffered.Rows = append(buffered.Rows, result.Rows...)
+			if result.CommandTag != "" {
+				buffered.CommandTag = result.CommandTag
+			}
+			keep = len(buffered.Rows) <= r.Cache.maxRows
+		}
+		return callback(ctx, result)
+	})
+	if err != nil {
+		return err
+	}
+	if keep {
+		r.Cache.put(key, r.Tables, buffered, r.TTL, version)
+	}
+	return nil
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *CachedRead) GetTableGroup() string {
+	return r.Input.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (r *CachedRead) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *CachedRead) String() string {
+	return fmt.Sprintf("CachedRead(tables=%s, ttl=%s, input=%s)", strings.Join(r.Tables, ","), r.TTL, r.Input)
+}
+
+// Ensure CachedRead implements Primitive interface.
+var _ Primitive = (*CachedRead)(nil)
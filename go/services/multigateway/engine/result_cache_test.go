@@ -0,0 +1,244 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// runCachedRead runs read on a shard returning rows, and returns the
+// result and the number of queries the shard ran.
+func runCachedRead(t *testing.T, read *CachedRead, state *handler.MultiGatewayConnectionState, rows ...[]string) (*sqltypes.Result, int) {
+	t.Helper()
+	exec := &mockIExecute{streamResults: []*sqltypes.Result{{
+		Fields:     []*query.Field{field("id", ast.INT4OID)},
+		Rows:       textRows(rows...),
+		CommandTag: "SELECT 1",
+	}}}
+	var results []*sqltypes.Result
+	err := read.StreamExecute(context.Background(), exec, server.NewTestConn(&bytes.Buffer{}).Conn, state,
+		func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	return results[0], len(exec.queries)
+}
+
+func TestCachedReadHitsCache(t *testing.T) {
+	cache := NewResultCache(10, 100)
+	read := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+	state := handler.NewMultiGatewayConnectionState()
+
+	result, queries := runCachedRead(t, read, state, []string{"1"})
+	assert.Equal(t, 1, queries)
+	assert.Equal(t, textRows([]string{"1"}), result.Rows)
+
+	result, queries = runCachedRead(t, read, state, []string{"2"})
+	assert.Equal(t, 0, queries)
+	assert.Equal(t, textRows([]string{"1"}), result.Rows)
+	assert.Equal(t, "SELECT 1", result.CommandTag)
+
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, int64(1), cache.Hits())
+	assert.Equal(t, int64(1), cache.Misses())
+	assert.Equal(t, "CachedRead(tables=t, ttl=1m0s, input=Route(tablegroup=tg, shard=0, query=SELECT id FROM t))", read.String())
+}
+
+func TestCachedReadExpires(t *testing.T) {
+	cache := NewResultCache(10, 100)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	read := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+	state := handler.NewMultiGatewayConnectionState()
+
+	runCachedRead(t, read, state, []string{"1"})
+	now = now.Add(time.Minute)
+	result, queries := runCachedRead(t, read, state, []string{"2"})
+	assert.Equal(t, 1, queries)
+	assert.Equal(t, textRows([]string{"2"}), result.Rows)
+}
+
+func TestCachedReadInvalidatedByWrites(t *testing.T) {
+	cache := NewResultCache(10, 100)
+	readT := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+	readU := NewCachedRead("SELECT id FROM u", NewRoute("tg", "0", "SELECT id FROM u"), cache, []string{"u"}, time.Minute)
+	state := handler.NewMultiGatewayConnectionState()
+
+	runCachedRead(t, readT, state, []string{"1"})
+	runCachedRead(t, readU, state, []string{"1"})
+	cache.InvalidateTables([]string{"t"})
+	assert.Equal(t, 1, cache.Size())
+
+	_, queries := runCachedRead(t, readT, state, []string{"2"})
+	assert.Equal(t, 1, queries)
+	_, queries = runCachedRead(t, readU, state, []string{"2"})
+	assert.Equal(t, 0, queries)
+
+	cache.InvalidateAll()
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestResultCacheInvalidatesSchemaTables(t *testing.T) {
+	tests := []struct {
+		name    string
+		written string
+		// removed are the tables of the reads removed, among t, app.t and
+		// other.t.
+		removed []string
+	}{
+		{name: "unqualified", written: "t", removed: []string{"t", "app.t", "other.t"}},
+		{name: "qualified", written: "app.t", removed: []string{"t", "app.t"}},
+		{name: "other table", written: "app.u", removed: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewResultCache(10, 100)
+			tables := []string{"t", "app.t", "other.t"}
+			for _, table := range tables {
+				cache.put(resultCacheKey{query: "SELECT * FROM " + table}, []string{table}, &sqltypes.Result{}, time.Minute, 0)
+			}
+
+			cache.InvalidateTables([]string{tt.written})
+			for _, table := range tables {
+				_, _, ok := cache.get(resultCacheKey{query: "SELECT * FROM " + table})
+				assert.Equal(t, !slices.Contains(tt.removed, table), ok, table)
+			}
+		})
+	}
+}
+
+func TestResultCacheDropsResultsReadDuringWrites(t *testing.T) {
+	cache := NewResultCache(10, 100)
+	key := resultCacheKey{database: "db", user: "u", query: "SELECT id FROM t"}
+
+	_, version, ok := cache.get(key)
+	require.False(t, ok)
+	// t is written while the result is read.
+	cache.InvalidateTables([]string{"t"})
+	cache.put(key, []string{"t"}, &sqltypes.Result{}, time.Minute, version)
+	assert.Equal(t, 0, cache.Size())
+
+	// Writes of other tables do not matter.
+	_, version, _ = cache.get(key)
+	cache.InvalidateTables([]string{"u"})
+	cache.put(key, []string{"t"}, &sqltypes.Result{}, time.Minute, version)
+	assert.Equal(t, 1, cache.Size())
+}
+
+func TestCachedReadSkipsLargeResults(t *testing.T) {
+	cache := NewResultCache(10, 1)
+	read := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+	state := handler.NewMultiGatewayConnectionState()
+
+	result, _ := runCachedRead(t, read, state, []string{"1"}, []string{"2"})
+	assert.Len(t, result.Rows, 2)
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestCachedReadBypassedInTransaction(t *testing.T) {
+	cache := NewResultCache(10, 100)
+	read := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+	state := handler.NewMultiGatewayConnectionState()
+	runCachedRead(t, read, state, []string{"1"})
+
+	state.BeginTransaction()
+	result, queries := runCachedRead(t, read, state, []string{"2"})
+	assert.Equal(t, 1, queries)
+	assert.Equal(t, textRows([]string{"2"}), result.Rows)
+	assert.Equal(t, int64(1), cache.Misses())
+}
+
+func TestCachedReadKeyedBySessionSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		set  func(state *handler.MultiGatewayConnectionState)
+	}{
+		{"role", func(state *handler.MultiGatewayConnectionState) { state.SetSessionVariable("role", "reader") }},
+		{"session authorization", func(state *handler.MultiGatewayConnectionState) {
+			state.SetSessionVariable("session_authorization", "reader")
+		}},
+		{"search_path", func(state *handler.MultiGatewayConnectionState) { state.SetSessionVariable("search_path", "other") }},
+		{"TimeZone", func(state *handler.MultiGatewayConnectionState) { state.SetSessionVariable("timezone", "UTC") }},
+		{"DateStyle", func(state *handler.MultiGatewayConnectionState) { state.SetSessionVariable("datestyle", "SQL, DMY") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewResultCache(10, 100)
+			read := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+			runCachedRead(t, read, handler.NewMultiGatewayConnectionState(), []string{"1"})
+
+			state := handler.NewMultiGatewayConnectionState()
+			tt.set(state)
+			result, queries := runCachedRead(t, read, state, []string{"2"})
+			assert.Equal(t, 1, queries)
+			assert.Equal(t, textRows([]string{"2"}), result.Rows)
+
+			// Sessions with the same settings share the result.
+			result, queries = runCachedRead(t, read, state, []string{"3"})
+			assert.Equal(t, 0, queries)
+			assert.Equal(t, textRows([]string{"2"}), result.Rows)
+			assert.Equal(t, 2, cache.Size())
+		})
+	}
+}
+
+func TestCachedReadRoleNone(t *testing.T) {
+	cache := NewResultCache(10, 100)
+	read := NewCachedRead("SELECT id FROM t", NewRoute("tg", "0", "SELECT id FROM t"), cache, []string{"t"}, time.Minute)
+	runCachedRead(t, read, handler.NewMultiGatewayConnectionState(), []string{"1"})
+
+	// SET ROLE NONE goes back to the session user.
+	state := handler.NewMultiGatewayConnectionState()
+	state.SetSessionVariable("role", "none")
+	result, queries := runCachedRead(t, read, state, []string{"2"})
+	assert.Equal(t, 0, queries)
+	assert.Equal(t, textRows([]string{"1"}), result.Rows)
+}
+
+func TestResultCacheEvicts(t *testing.T) {
+	cache := NewResultCache(2, 100)
+	put := func(query string) {
+		key := resultCacheKey{database: "db", query: query}
+		_, version, _ := cache.get(key)
+		cache.put(key, []string{"t"}, &sqltypes.Result{}, time.Minute, version)
+	}
+
+	put("a")
+	put("b")
+	put("c") // evicts a, the least recently used
+	_, _, ok := cache.get(resultCacheKey{database: "db", query: "a"})
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, int64(1), cache.Evictions())
+
+	// Evicted results are no longer indexed by their tables.
+	cache.InvalidateTables([]string{"t"})
+	assert.Equal(t, 0, cache.Size())
+}
@@ -59,7 +59,7 @@ func (r *Route) StreamExecute(
 	// Execute the query through the execution interface
 	// This will call ScatterConn in Phase 2+, or a stub/mock in testing
 	return exec.StreamExecute(
-		conn.Context(),
+		ctx,
 		conn,
 		r.TableGroup,
 		r.Shard,
@@ -81,6 +81,9 @@ func (r *Route) GetQuery() string {
 
 // String returns a description of the route for debugging.
 func (r *Route) String() string {
+	if r.Shard != "" {
+		return fmt.Sprintf("Route(tablegroup=%s, shard=%s, query=%s)", r.TableGroup, r.Shard, r.Query)
+	}
 	return fmt.Sprintf("Route(tablegroup=%s, query=%s)", r.TableGroup, r.Query)
 }
 
@@ -0,0 +1,87 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// IsSavepointStmt returns true for the transaction control statements acting
+// on savepoints: SAVEPOINT, RELEASE and ROLLBACK TO.
+func IsSavepointStmt(kind ast.TransactionStmtKind) bool {
+	switch kind {
+	case ast.TRANS_STMT_SAVEPOINT, ast.TRANS_STMT_RELEASE, ast.TRANS_STMT_ROLLBACK_TO:
+		return true
+	}
+	return false
+}
+
+// SavepointRoute is a Route for SAVEPOINT, RELEASE and ROLLBACK TO. In a
+// transaction spanning several shards, a savepoint must exist on each of
+// them for a partial rollback to undo the work of the whole transaction, so
+// the statement is run on every participating shard.
+type SavepointRoute struct {
+	TableGroup    string
+	Shard         string
+	Query         string
+	Kind          ast.TransactionStmtKind
+	SavepointName string
+}
+
+// NewSavepointRoute creates a new SavepointRoute primitive.
+func NewSavepointRoute(tableGroup, shard, query string, stmt *ast.TransactionStmt) *SavepointRoute {
+	return &SavepointRoute{
+		TableGroup:    tableGroup,
+		Shard:         shard,
+		Query:         query,
+		Kind:          stmt.Kind,
+		SavepointName: stmt.SavepointName,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *SavepointRoute) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return exec.ExecuteSavepoint(ctx, conn, r.TableGroup, r.Shard, state, r.Kind, r.SavepointName, r.Query, callback)
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *SavepointRoute) GetTableGroup() string {
+	return r.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (r *SavepointRoute) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *SavepointRoute) String() string {
+	return fmt.Sprintf("SavepointRoute(tablegroup=%s, kind=%s, savepoint=%s)", r.TableGroup, r.Kind, r.SavepointName)
+}
+
+// Ensure SavepointRoute implements Primitive interface.
+var _ Primitive = (*SavepointRoute)(nil)
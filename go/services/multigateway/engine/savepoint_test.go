@@ -0,0 +1,61 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestIsSavepointStmt(t *testing.T) {
+	assert.True(t, IsSavepointStmt(ast.TRANS_STMT_SAVEPOINT))
+	assert.True(t, IsSavepointStmt(ast.TRANS_STMT_RELEASE))
+	assert.True(t, IsSavepointStmt(ast.TRANS_STMT_ROLLBACK_TO))
+	assert.False(t, IsSavepointStmt(ast.TRANS_STMT_ROLLBACK))
+	assert.False(t, IsSavepointStmt(ast.TRANS_STMT_BEGIN))
+}
+
+func TestSavepointRoute(t *testing.T) {
+	tests := []struct {
+		sql  string
+		kind ast.TransactionStmtKind
+	}{
+		{sql: "SAVEPOINT s1", kind: ast.TRANS_STMT_SAVEPOINT},
+		{sql: "RELEASE SAVEPOINT s1", kind: ast.TRANS_STMT_RELEASE},
+		{sql: "ROLLBACK TO s1", kind: ast.TRANS_STMT_ROLLBACK_TO},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmts, err := parser.ParseSQL(tt.sql)
+			require.NoError(t, err)
+			stmt := stmts[0].(*ast.TransactionStmt)
+
+			exec := &mockIExecute{}
+			route := NewSavepointRoute("tg", "", tt.sql, stmt)
+			require.NoError(t, route.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(), nil))
+
+			assert.Equal(t, tt.kind, exec.savepointKind)
+			assert.Equal(t, "s1", exec.savepointName)
+			assert.Equal(t, []string{tt.sql}, exec.queries)
+		})
+	}
+}
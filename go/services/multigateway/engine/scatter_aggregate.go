@@ -0,0 +1,299 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// AggregateKind is how the partial values of an output column computed by
+// each shard combine into its final value.
+type AggregateKind int
+
+const (
+	// AggregateAny is a grouping column, or a value that is the same for
+	// all the rows of a group: the first partial value is kept.
+	AggregateAny AggregateKind = iota
+	// AggregateCount sums partial counts.
+	AggregateCount
+	// AggregateSum sums partial sums, ignoring NULLs.
+	AggregateSum
+	// AggregateMin keeps the smallest partial value.
+	AggregateMin
+	// AggregateMax keeps the largest partial value.
+	AggregateMax
+	// AggregateAvg divides the sum of partial sums by the sum of partial
+	// counts.
+	AggregateAvg
+)
+
+// String returns the name of the kind.
+func (k AggregateKind) String() string {
+	switch k {
+	case AggregateCount:
+		return "count"
+	case AggregateSum:
+		return "sum"
+	case AggregateMin:
+		return "min"
+	case AggregateMax:
+		return "max"
+	case AggregateAvg:
+		return "avg"
+	default:
+		return "any"
+	}
+}
+
+// AggregateColumn describes an output column of a ScatterAggregate.
+type AggregateColumn struct {
+	Kind AggregateKind
+
+	// Column is the column of the shard results holding the partial value.
+	Column int
+
+	// CountColumn is the column of the shard results holding the partial
+	// count of an AggregateAvg.
+	CountColumn int
+}
+
+// ScatterAggregate is a primitive that combines the partial aggregates
+// computed by each shard for a query on a sharded table: the rows of the
+// shards are regrouped by the GroupBy columns and the partial values of
+// each group combined. The shard results may hold more columns than the
+// output, to group by or to average.
+type ScatterAggregate struct {
+	// Input returns the rows of partial aggregates of every shard,
+	// typically a ScatterRoute.
+	Input Primitive
+
+	// GroupBy are the columns of the shard results grouping the rows. The
+	// output has a single row when empty.
+	GroupBy []int
+
+	// Columns are the output columns.
+	Columns []AggregateColumn
+
+	// MaxMemory is the maximum number of bytes of the partial aggregates
+	// of the shards buffered to combine them. Zero means unlimited.
+	MaxMemory int64
+
+	// Collations, when set, provides the collations comparing the text
+	// values of min and max. They are compared bytewise otherwise.
+	Collations *Collations
+}
+
+// NewScatterAggregate creates a new ScatterAggregate primitive.
+func NewScatterAggregate(input Primitive, groupBy []int, columns []AggregateColumn) *ScatterAggregate {
+	return &ScatterAggregate{
+		Input:   input,
+		GroupBy: groupBy,
+		Columns: columns,
+	}
+}
+
+// aggregateGroup holds the partial values combined so far for a group.
+type aggregateGroup struct {
+	values []sqltypes.Value
+	counts []int64
+}
+
+// StreamExecute implements the Primitive interface.
+func (a *ScatterAggregate) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	input, err := bufferResult(ctx, a.Input, exec, conn, state, a.MaxMemory)
+	if err != nil {
+		return err
+	}
+
+	// Only min and max compare values: the other columns are left out of
+	// range, so that they get no collation.
+	columns := make([]sqltypes.OrderByColumn, len(a.Columns))
+	for i, col := range a.Columns {
+		columns[i].Column = col.Column
+		if col.Kind != AggregateMin && col.Kind != AggregateMax {
+			columns[i].Column = len(input.Fields)
+		}
+	}
+	collations, err := a.Collations.forOrderBy(ctx, exec, conn, state, input.Fields, columns)
+	if err != nil {
+		return err
+	}
+
+	result, err := a.combine(input, collations)
+	if err != nil {
+		return err
+	}
+	return callback(ctx, result)
+}
+
+// combine regroups the rows of the shard results and combines the partial
+// values of each group, comparing the text values of column i by
+// collations[i] when set.
+func (a *ScatterAggregate) combine(input *sqltypes.Result, collations []sqltypes.TextCompare) (*sqltypes.Result, error) {
+	for _, col := range a.Columns {
+		if col.Column >= len(input.Fields) || (col.Kind == AggregateAvg && col.CountColumn >= len(input.Fields)) {
+			return nil, fmt.Errorf("aggregate column %d out of range of %d shard result fields", col.Column, len(input.Fields))
+		}
+	}
+
+	var groups []*aggregateGroup
+	index := make(map[string]*aggregateGroup)
+	for _, row := range input.Rows {
+		key := groupKey(row, a.GroupBy)
+		group, ok := index[key]
+		if !ok {
+			group = &aggregateGroup{
+				values: make([]sqltypes.Value, len(a.Columns)),
+				counts: make([]int64, len(a.Columns)),
+			}
+			for i, col := range a.Columns {
+				group.values[i] = row.Values[col.Column]
+			}
+			index[key] = group
+			groups = append(groups, group)
+			if err := a.countRow(group, row); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for i, col := range a.Columns {
+			var collation sqltypes.TextCompare
+			if i < len(collations) {
+				collation = collations[i]
+			}
+			value, err := combineValues(col.Kind, input.Fields[col.Column], group.values[i], row.Values[col.Column], collation)
+			if err != nil {
+				return nil, err
+			}
+			group.values[i] = value
+		}
+		if err := a.countRow(group, row); err != nil {
+			return nil, err
+		}
+	}
+	// Aggregates without grouping return a row even without input rows.
+	if len(groups) == 0 && len(a.GroupBy) == 0 {
+		group := &aggregateGroup{
+			values: make([]sqltypes.Value, len(a.Columns)),
+			counts: make([]int64, len(a.Columns)),
+		}
+		for i, col := range a.Columns {
+			if col.Kind == AggregateCount {
+				group.values[i] = sqltypes.Value("0")
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	result := &sqltypes.Result{
+		Fields:  make([]*query.Field, len(a.Columns)),
+		Rows:    make([]*sqltypes.Row, 0, len(groups)),
+		Notices: input.Notices,
+	}
+	for i, col := range a.Columns {
+		result.Fields[i] = input.Fields[col.Column]
+		if col.Kind == AggregateAvg {
+			result.Fields[i] = avgField(input.Fields[col.Column])
+		}
+	}
+	for _, group := range groups {
+		row := &sqltypes.Row{Values: make([]sqltypes.Value, len(a.Columns))}
+		for i, col := range a.Columns {
+			row.Values[i] = group.values[i]
+			if col.Kind == AggregateAvg {
+				value, err := averageValue(input.Fields[col.Column], group.values[i], group.counts[i])
+				if err != nil {
+					return nil, err
+				}
+				row.Values[i] = value
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	result.CommandTag = "SELECT " + strconv.Itoa(len(result.Rows))
+	return result, nil
+}
+
+// countRow adds the partial counts of the averages of row to group.
+func (a *ScatterAggregate) countRow(group *aggregateGroup, row *sqltypes.Row) error {
+	for i, col := range a.Columns {
+		if col.Kind != AggregateAvg {
+			continue
+		}
+		value := row.Values[col.CountColumn]
+		if value.IsNull() {
+			continue
+		}
+		count, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid partial count %q: %w", value, err)
+		}
+		group.counts[i] += count
+	}
+	return nil
+}
+
+// groupKey returns a key identifying the group of row, telling NULLs
+// apart from empty values.
+func groupKey(row *sqltypes.Row, groupBy []int) string {
+	var b strings.Builder
+	for _, col := range groupBy {
+		value := row.Values[col]
+		if value.IsNull() {
+			b.WriteString("n;")
+			continue
+		}
+		b.WriteString(strconv.Itoa(len(value)))
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	return b.String()
+}
+
+// GetTableGroup implements the Primitive interface.
+func (a *ScatterAggregate) GetTableGroup() string {
+	return a.Input.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (a *ScatterAggregate) GetQuery() string {
+	return a.Input.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (a *ScatterAggregate) String() string {
+	columns := make([]string, len(a.Columns))
+	for i, col := range a.Columns {
+		columns[i] = fmt.Sprintf("%s(%d)", col.Kind, col.Column)
+	}
+	return fmt.Sprintf("ScatterAggregate(group_by=%v, columns=%s, input=%s)", a.GroupBy, strings.Join(columns, ","), a.Input)
+}
+
+// Ensure ScatterAggregate implements Primitive interface.
+var _ Primitive = (*ScatterAggregate)(nil)
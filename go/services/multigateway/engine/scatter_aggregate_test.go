@@ -0,0 +1,206 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func field(name string, oid ast.Oid) *query.Field {
+	return &query.Field{Name: name, DataTypeOid: uint32(oid)}
+}
+
+// textRows builds rows of text values, "NULL" standing for NULL.
+func textRows(rows ...[]string) []*sqltypes.Row {
+	var out []*sqltypes.Row
+	for _, values := range rows {
+		row := &sqltypes.Row{}
+		for _, v := range values {
+			if v == "NULL" {
+				row.Values = append(row.Values, nil)
+			} else {
+				row.Values = append(row.Values, sqltypes.Value(v))
+			}
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+// runAggregate runs a ScatterAggregate over the given shard results.
+func runAggregate(t *testing.T, aggregate *ScatterAggregate, shardResults ...*sqltypes.Result) (*sqltypes.Result, error) {
+	t.Helper()
+	exec := &mockIExecute{streamResults: shardResults}
+	var results []*sqltypes.Result
+	err := aggregate.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(),
+		func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	require.Len(t, results, 1)
+	return results[0], nil
+}
+
+func TestScatterAggregateGroupBy(t *testing.T) {
+	// SELECT region, count(*), sum(amount), min(amount), max(amount), avg(amount)
+	// GROUP BY region, with the count of the average last.
+	fields := []*query.Field{
+		field("region", ast.TEXTOID), field("count", ast.INT8OID), field("sum", ast.INT8OID),
+		field("min", ast.INT4OID), field("max", ast.INT4OID), field("avg", ast.INT8OID), field("count", ast.INT8OID),
+	}
+	aggregate := NewScatterAggregate(NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT ..."), []int{0}, []AggregateColumn{
+		{Kind: AggregateAny, Column: 0},
+		{Kind: AggregateCount, Column: 1},
+		{Kind: AggregateSum, Column: 2},
+		{Kind: AggregateMin, Column: 3},
+		{Kind: AggregateMax, Column: 4},
+		{Kind: AggregateAvg, Column: 5, CountColumn: 6},
+	})
+
+	result, err := runAggregate(t, aggregate,
+		&sqltypes.Result{Fields: fields, Rows: textRows(
+			[]string{"eu", "2", "30", "10", "20", "30", "2"},
+			[]string{"us", "1", "NULL", "NULL", "NULL", "NULL", "0"},
+		)},
+		&sqltypes.Result{Fields: fields, Rows: textRows(
+			[]string{"eu", "1", "9", "9", "9", "9", "1"},
+			[]string{"NULL", "3", "3", "1", "1", "3", "3"},
+		)},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, textRows(
+		[]string{"eu", "3", "39", "9", "20", "13.0000000000000000"},
+		[]string{"us", "1", "NULL", "NULL", "NULL", "NULL"},
+		[]string{"NULL", "3", "3", "1", "1", "1.00000000000000000000"},
+	), result.Rows)
+	require.Len(t, result.Fields, 6)
+	assert.Equal(t, "avg", result.Fields[5].Name)
+	assert.Equal(t, uint32(ast.NUMERICOID), result.Fields[5].DataTypeOid)
+	assert.Equal(t, "SELECT 3", result.CommandTag)
+}
+
+func TestScatterAggregateWithoutGroups(t *testing.T) {
+	fields := []*query.Field{field("count", ast.INT8OID), field("sum", ast.NUMERICOID)}
+	aggregate := NewScatterAggregate(NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT ..."), nil, []AggregateColumn{
+		{Kind: AggregateCount, Column: 0},
+		{Kind: AggregateSum, Column: 1},
+	})
+
+	result, err := runAggregate(t, aggregate,
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"2", "1.5"})},
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"3", "-0.25"})},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, textRows([]string{"5", "1.25"}), result.Rows)
+
+	// Without rows, aggregates still return one.
+	result, err = runAggregate(t, aggregate, &sqltypes.Result{Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, textRows([]string{"0", "NULL"}), result.Rows)
+}
+
+func TestScatterAggregateUnsupportedType(t *testing.T) {
+	fields := []*query.Field{field("sum", ast.INTERVALOID)}
+	aggregate := NewScatterAggregate(NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT ..."), nil, []AggregateColumn{
+		{Kind: AggregateSum, Column: 0},
+	})
+	_, err := runAggregate(t, aggregate,
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"1 day"})},
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"2 days"})},
+	)
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+}
+
+func TestAddValues(t *testing.T) {
+	tests := []struct {
+		oid     ast.Oid
+		a, b    string
+		want    string
+		wantErr bool
+	}{
+		{ast.INT8OID, "40", "2", "42", false},
+		{ast.INT8OID, "9223372036854775807", "1", "", true},
+		{ast.NUMERICOID, "1.10", "2.005", "3.105", false},
+		{ast.NUMERICOID, "Infinity", "1", "Infinity", false},
+		{ast.NUMERICOID, "Infinity", "-Infinity", "NaN", false},
+		{ast.FLOAT8OID, "0.1", "0.2", "0.30000000000000004", false},
+		{ast.FLOAT8OID, "1e+15", "1", "1.000000000000001e+15", false},
+		{ast.FLOAT8OID, "Infinity", "1", "Infinity", false},
+		{ast.FLOAT4OID, "999999", "1", "1e+06", false},
+	}
+	for _, tt := range tests {
+		got, err := addValues(field("sum", tt.oid), sqltypes.Value(tt.a), sqltypes.Value(tt.b))
+		if tt.wantErr {
+			assert.Error(t, err, "%s + %s", tt.a, tt.b)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, string(got), "%s + %s", tt.a, tt.b)
+	}
+}
+
+func TestDivideNumeric(t *testing.T) {
+	// Expected values are those of PostgreSQL's numeric division.
+	tests := []struct {
+		sum   string
+		count int64
+		want  string
+	}{
+		{"3", 2, "1.5000000000000000"},
+		{"2", 2, "1.00000000000000000000"},
+		{"10", 3, "3.3333333333333333"},
+		{"123456", 10, "12345.600000000000"},
+		{"0.001", 2, "0.00050000000000000000"},
+		{"-7", 2, "-3.5000000000000000"},
+		{"1.123456789012345678", 1, "1.12345678901234567800"},
+		{"0", 5, "0.00000000000000000000"},
+		{"NaN", 2, "NaN"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, divideNumeric(tt.sum, tt.count), "%s / %d", tt.sum, tt.count)
+	}
+}
+
+func TestScatterAggregateMaxMemory(t *testing.T) {
+	fields := []*query.Field{field("count", ast.INT8OID)}
+	aggregate := NewScatterAggregate(NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT ..."), nil, []AggregateColumn{
+		{Kind: AggregateCount, Column: 0},
+	})
+	aggregate.MaxMemory = 1
+
+	_, err := runAggregate(t, aggregate,
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"2"})},
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"3"})},
+	)
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.ConfigurationLimitExceeded, diag.Code)
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// ScatterRoute is a primitive that sends a query to several shards of a
+// tablegroup and returns their results as one. It is used for statements on
+// sharded tables whose shard key does not narrow them down to a single
+// shard.
+//
+// The rows of the shards are concatenated in the order of the shards, or,
+// with OrderBy, merged into a single ordered sequence: each shard must then
+// return its rows ordered the same way.
+type ScatterRoute struct {
+	// TableGroup is the target tablegroup for this query.
+	TableGroup string
+
+	// Shards are the shards the query runs on.
+	Shards []string
+
+	// Query is the SQL query string to execute.
+	Query string
+
+	// OrderBy orders the merged rows. The shard results are concatenated
+	// when empty.
+	OrderBy []sqltypes.OrderByColumn
+
+	// HiddenColumns is the number of leading columns of the shard results
+	// that are only used to order the rows, and are removed from the
+	// merged result.
+	HiddenColumns int
+
+	// Lookup, when set, narrows Shards down to those holding the rows the
+	// query selects by a column indexed by a lookup table.
+	Lookup *ShardLookup
+
+	// MaxMemory is the maximum number of bytes of the shard results
+	// buffered to merge them. Zero means unlimited.
+	MaxMemory int64
+
+	// Collations, when set, provides the collations merging the text
+	// columns of OrderBy as the shards ordered them. They are merged
+	// bytewise otherwise, as by the C collation.
+	Collations *Collations
+}
+
+// NewScatterRoute creates a new ScatterRoute primitive.
+func NewScatterRoute(tableGroup string, shards []string, query string) *ScatterRoute {
+	return &ScatterRoute{
+		TableGroup: tableGroup,
+		Shards:     shards,
+		Query:      query,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *ScatterRoute) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	shards := r.Shards
+	if r.Lookup != nil {
+		var err error
+		if shards, err = r.Lookup.findShards(ctx, exec, conn, state, r.TableGroup); err != nil {
+			return err
+		}
+	}
+
+	var results []*sqltypes.Result
+	var memory int64
+	err := exec.ScatterExecute(ctx, conn, r.TableGroup, shards, r.Query, state, func(_ context.Context, result *sqltypes.Result) error {
+		memory += result.ByteSize()
+		if r.MaxMemory > 0 && memory > r.MaxMemory {
+			return bufferLimitExceeded(r.MaxMemory)
+		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var merged *sqltypes.Result
+	if len(r.OrderBy) > 0 {
+		fields := resultFields(results)
+		collations, err := r.Collations.forOrderBy(ctx, exec, conn, state, fields, r.OrderBy)
+		if err != nil {
+			return err
+		}
+		compare, err := sqltypes.NewCollatedRowComparator(fields, r.OrderBy, collations)
+		if err != nil {
+			return err
+		}
+		merged, err = sqltypes.MergeSorted(results, compare)
+	} else {
+		merged, err = sqltypes.MergeResults(results)
+	}
+	if err != nil {
+		return err
+	}
+	if r.HiddenColumns > 0 {
+		removeColumns(merged, r.HiddenColumns)
+	}
+	return callback(ctx, merged)
+}
+
+// resultFields returns the fields of the first of results describing its
+// columns.
+func resultFields(results []*sqltypes.Result) []*query.Field {
+	for _, result := range results {
+		if result != nil && len(result.Fields) > 0 {
+			return result.Fields
+		}
+	}
+	return nil
+}
+
+// removeColumns removes the first n columns of result.
+func removeColumns(result *sqltypes.Result, n int) {
+	result.Fields = result.Fields[min(n, len(result.Fields)):]
+	for _, row := range result.Rows {
+		row.Values = row.Values[min(n, len(row.Values)):]
+	}
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *ScatterRoute) GetTableGroup() string {
+	return r.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (r *ScatterRoute) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *ScatterRoute) String() string {
+	var extra string
+	if len(r.OrderBy) > 0 {
+		extra += ", order_by=" + formatOrderBy(r.OrderBy)
+	}
+	if r.Lookup != nil {
+		extra += ", lookup=" + r.Lookup.Query
+	}
+	return fmt.Sprintf("ScatterRoute(tablegroup=%s, shards=%s%s, query=%s)", r.TableGroup, strings.Join(r.Shards, ","), extra, r.Query)
+}
+
+// Ensure ScatterRoute implements Primitive interface.
+var _ Primitive = (*ScatterRoute)(nil)
@@ -0,0 +1,176 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// runPrimitive runs a primitive whose shards return shardResults, and
+// returns its single result.
+func runPrimitive(t *testing.T, primitive Primitive, shardResults ...*sqltypes.Result) *sqltypes.Result {
+	t.Helper()
+	exec := &mockIExecute{streamResults: shardResults}
+	var results []*sqltypes.Result
+	err := primitive.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(),
+		func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	return results[0]
+}
+
+func TestScatterRouteConcatenatesShards(t *testing.T) {
+	fields := []*query.Field{field("id", ast.INT4OID)}
+	route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT id FROM t")
+
+	result := runPrimitive(t, route,
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"3"}, []string{"1"}), CommandTag: "SELECT 2"},
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"2"}), CommandTag: "SELECT 1"},
+	)
+	assert.Equal(t, fields, result.Fields)
+	assert.Equal(t, textRows([]string{"3"}, []string{"1"}, []string{"2"}), result.Rows)
+	assert.Equal(t, "SELECT 3", result.CommandTag)
+}
+
+func TestScatterRouteMergesSortedShards(t *testing.T) {
+	// SELECT name FROM t ORDER BY id DESC, with id as a hidden column.
+	fields := []*query.Field{field("id", ast.INT4OID), field("name", ast.TEXTOID)}
+	route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT id, name FROM t ORDER BY id DESC")
+	route.OrderBy = []sqltypes.OrderByColumn{{Column: 0, Desc: true, NullsFirst: true}}
+	route.HiddenColumns = 1
+
+	result := runPrimitive(t, route,
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"NULL", "x"}, []string{"10", "c"}, []string{"2", "a"}), CommandTag: "SELECT 3"},
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"11", "d"}, []string{"9", "b"}), CommandTag: "SELECT 2"},
+	)
+	assert.Equal(t, fields[1:], result.Fields)
+	assert.Equal(t, textRows([]string{"x"}, []string{"d"}, []string{"c"}, []string{"b"}, []string{"a"}), result.Rows)
+	assert.Equal(t, "SELECT 5", result.CommandTag)
+	assert.Equal(t, "ScatterRoute(tablegroup=tg, shards=-80,80-, order_by=0 DESC, query=SELECT id, name FROM t ORDER BY id DESC)", route.String())
+}
+
+func TestScatterRouteMaxMemory(t *testing.T) {
+	fields := []*query.Field{field("name", ast.TEXTOID)}
+	shardResults := []*sqltypes.Result{
+		{Fields: fields, Rows: textRows([]string{"aaaaaaaaaa"})},
+		{Fields: fields, Rows: textRows([]string{"bbbbbbbbbb"})},
+	}
+	route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT name FROM t")
+	route.MaxMemory = shardResults[0].ByteSize() + 1
+
+	exec := &mockIExecute{streamResults: shardResults}
+	err := route.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(),
+		func(context.Context, *sqltypes.Result) error { return nil })
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.ConfigurationLimitExceeded, diag.Code)
+
+	route.MaxMemory = shardResults[0].ByteSize() + shardResults[1].ByteSize()
+	result := runPrimitive(t, route, shardResults...)
+	assert.Len(t, result.Rows, 2)
+}
+
+// collationExecute answers the query reading the default collation of the
+// database with collation, and the others as mockIExecute.
+type collationExecute struct {
+	*mockIExecute
+	collation string
+	reads     int
+}
+
+func (c *collationExecute) StreamExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	if sql == defaultCollationQuery {
+		c.reads++
+		return callback(ctx, &sqltypes.Result{
+			Fields: []*query.Field{field("datcollate", ast.TEXTOID)},
+			Rows:   textRows([]string{c.collation}),
+		})
+	}
+	return c.mockIExecute.StreamExecute(ctx, conn, tableGroup, shard, sql, state, callback)
+}
+
+func (c *collationExecute) ScatterExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shards []string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return c.mockIExecute.StreamExecute(ctx, conn, tableGroup, "", sql, state, callback)
+}
+
+func TestScatterRouteMergesByCollation(t *testing.T) {
+	fields := []*query.Field{field("name", ast.TEXTOID)}
+	exec := &collationExecute{
+		mockIExecute: &mockIExecute{streamResults: []*sqltypes.Result{
+			// The shards order the names by the en_US collation.
+			{Fields: fields, Rows: textRows([]string{"apple"}, []string{"Éclair"}, []string{"zebra"})},
+			{Fields: fields, Rows: textRows([]string{"Banana"}, []string{"eclair"}, []string{"Zulu"})},
+		}},
+		collation: "en_US.UTF-8",
+	}
+	route := NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT name FROM t ORDER BY name")
+	route.OrderBy = []sqltypes.OrderByColumn{{Column: 0}}
+	route.Collations = NewCollations("tg", "-80")
+
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	run := func() []*sqltypes.Row {
+		var rows []*sqltypes.Row
+		err := route.StreamExecute(context.Background(), exec, conn, handler.NewMultiGatewayConnectionState(),
+			func(_ context.Context, result *sqltypes.Result) error {
+				rows = append(rows, result.Rows...)
+				return nil
+			})
+		require.NoError(t, err)
+		return rows
+	}
+	want := textRows([]string{"apple"}, []string{"Banana"}, []string{"eclair"}, []string{"Éclair"}, []string{"zebra"}, []string{"Zulu"})
+	assert.Equal(t, want, run())
+	assert.Equal(t, want, run())
+	assert.Equal(t, 1, exec.reads, "the default collation is read once")
+
+	// A COLLATE clause overrides the default collation.
+	exec.mockIExecute.streamResults = []*sqltypes.Result{
+		{Fields: fields, Rows: textRows([]string{"Zulu"}, []string{"apple"})},
+		{Fields: fields, Rows: textRows([]string{"Banana"}, []string{"zebra"})},
+	}
+	route.OrderBy = []sqltypes.OrderByColumn{{Column: 0, Collation: "C"}}
+	assert.Equal(t, textRows([]string{"Banana"}, []string{"Zulu"}, []string{"apple"}, []string{"zebra"}), run())
+}
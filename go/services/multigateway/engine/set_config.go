@@ -0,0 +1,156 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// SetConfigCall is a call to set_config(name, value, is_local) with
+// constant arguments.
+type SetConfigCall struct {
+	Name    string
+	Value   string
+	IsLocal bool
+}
+
+// DetectSetConfig finds the set_config() calls of a SELECT without FROM
+// or WHERE clause, such as SELECT set_config('search_path', 'app', false),
+// whose arguments are all constants. Only then is it certain that each call
+// runs exactly once, with known arguments.
+func DetectSetConfig(stmt ast.Stmt) []SetConfigCall {
+	sel, ok := stmt.(*ast.SelectStmt)
+	if !ok || sel.TargetList == nil || sel.Op != ast.SETOP_NONE ||
+		sel.IntoClause != nil || sel.WhereClause != nil || sel.HavingClause != nil ||
+		(sel.FromClause != nil && sel.FromClause.Len() > 0) {
+		return nil
+	}
+	var calls []SetConfigCall
+	for _, item := range sel.TargetList.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		fc, ok := target.Val.(*ast.FuncCall)
+		if !ok || catalogFuncName(fc) != "set_config" || fc.Args == nil || fc.Args.Len() != 3 {
+			continue
+		}
+		name, nameOK := constString(fc.Args.Items[0])
+		value, valueOK := constString(fc.Args.Items[1])
+		isLocal, isLocalOK := constBool(fc.Args.Items[2])
+		if nameOK && valueOK && isLocalOK {
+			calls = append(calls, SetConfigCall{Name: name, Value: value, IsLocal: isLocal})
+		}
+	}
+	return calls
+}
+
+// constString returns the value of a string constant, possibly cast to text.
+func constString(node ast.Node) (string, bool) {
+	if tc, ok := node.(*ast.TypeCast); ok {
+		node = tc.Arg
+	}
+	c, ok := node.(*ast.A_Const)
+	if !ok || c.Isnull {
+		return "", false
+	}
+	s, ok := c.Val.(*ast.String)
+	if !ok {
+		return "", false
+	}
+	return s.SVal, true
+}
+
+// constBool returns the value of a boolean constant, either TRUE/FALSE or
+// a string PostgreSQL accepts as a boolean.
+func constBool(node ast.Node) (bool, bool) {
+	if tc, ok := node.(*ast.TypeCast); ok {
+		node = tc.Arg
+	}
+	c, ok := node.(*ast.A_Const)
+	if !ok || c.Isnull {
+		return false, false
+	}
+	switch v := c.Val.(type) {
+	case *ast.Boolean:
+		return v.BoolVal, true
+	case *ast.String:
+		switch strings.ToLower(strings.TrimSpace(v.SVal)) {
+		case "t", "true", "y", "yes", "on", "1":
+			return true, true
+		case "f", "false", "n", "no", "off", "0":
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// ApplySetConfig updates local session state after a SELECT calling
+// set_config() executes successfully on PostgreSQL, like ApplySessionState
+// does for SET.
+//
+// This primitive does NOT execute queries - it only updates the local state
+// tracking. It should be composed after a Route primitive in a Sequence.
+type ApplySetConfig struct {
+	Calls []SetConfigCall
+}
+
+// NewApplySetConfig creates a new ApplySetConfig primitive.
+func NewApplySetConfig(calls []SetConfigCall) *ApplySetConfig {
+	return &ApplySetConfig{Calls: calls}
+}
+
+// StreamExecute records the variables set by each call, in order.
+func (a *ApplySetConfig) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	for _, call := range a.Calls {
+		if call.IsLocal {
+			state.SetLocalVariable(call.Name, call.Value)
+		} else {
+			state.SetSessionVariable(call.Name, call.Value)
+		}
+	}
+	return nil
+}
+
+// GetTableGroup returns empty string as this primitive doesn't target a tablegroup.
+func (a *ApplySetConfig) GetTableGroup() string {
+	return ""
+}
+
+// GetQuery returns empty string as this primitive doesn't execute a query.
+func (a *ApplySetConfig) GetQuery() string {
+	return ""
+}
+
+// String returns a string representation for debugging.
+func (a *ApplySetConfig) String() string {
+	return fmt.Sprintf("ApplySetConfig(%v)", a.Calls)
+}
+
+// Ensure ApplySetConfig implements Primitive interface.
+var _ Primitive = (*ApplySetConfig)(nil)
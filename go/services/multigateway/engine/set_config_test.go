@@ -0,0 +1,71 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestDetectSetConfig(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected []SetConfigCall
+	}{
+		{"SELECT 1", nil},
+		{"SELECT set_config('search_path', 'app', false)",
+			[]SetConfigCall{{Name: "search_path", Value: "app"}}},
+		{"SELECT pg_catalog.set_config('app.user_id', '42', true)",
+			[]SetConfigCall{{Name: "app.user_id", Value: "42", IsLocal: true}}},
+		{"SELECT set_config('a.b', '1'::text, 'off'), set_config('a.c', '2', 't')",
+			[]SetConfigCall{{Name: "a.b", Value: "1"}, {Name: "a.c", Value: "2", IsLocal: true}}},
+		// Calls whose arguments or number of executions are unknown are not tracked.
+		{"SELECT set_config('search_path', $1, false)", nil},
+		{"SELECT set_config('search_path', 'app', false) FROM t", nil},
+		{"SELECT set_config('search_path', 'app', false) WHERE false", nil},
+		{"SELECT other.set_config('search_path', 'app', false)", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmts, err := parser.ParseSQL(tt.sql)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			assert.Equal(t, tt.expected, DetectSetConfig(stmts[0]))
+		})
+	}
+}
+
+func TestApplySetConfig(t *testing.T) {
+	state := handler.NewMultiGatewayConnectionState()
+	state.BeginTransaction()
+
+	apply := NewApplySetConfig([]SetConfigCall{
+		{Name: "search_path", Value: "app"},
+		{Name: "work_mem", Value: "64MB", IsLocal: true},
+	})
+	require.NoError(t, apply.StreamExecute(context.Background(), &mockIExecute{}, nil, state, nil))
+
+	value, ok := state.GetSessionVariable("work_mem")
+	require.True(t, ok)
+	assert.Equal(t, "64MB", value)
+	assert.Equal(t, map[string]string{"search_path": "app"}, state.GetSessionSettings())
+}
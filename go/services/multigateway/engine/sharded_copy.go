@@ -0,0 +1,364 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// shardedCopyBatchSize is the amount of row data buffered for a shard before
+// it is sent, even if the client's CopyData message has not been fully split.
+const shardedCopyBatchSize = 64 * 1024
+
+// shardedCopyQueueDepth is the number of batches that may be queued for a
+// shard before splitting waits for that shard to catch up.
+const shardedCopyQueueDepth = 4
+
+// ShardKeyRouter picks the shard a row belongs to from the value of its
+// shard key column, e.g. for the rows of a COPY or the key values a query
+// selects.
+type ShardKeyRouter interface {
+	// ShardForKey returns the shard for the decoded key value. isNull is set
+	// if the key is NULL.
+	ShardForKey(key []byte, isNull bool) (string, error)
+}
+
+// ShardedCopy implements the Primitive interface for COPY FROM STDIN into a
+// sharded table. Instead of passing the data through to a single shard, it
+// splits the text or CSV rows sent by the client, routes each row by its
+// shard key and streams the rows to a COPY on every shard in parallel.
+//
+// The per-shard COPYs are independent: if one fails after others have
+// completed, the rows loaded into the other shards stay loaded.
+type ShardedCopy struct {
+	TableGroup string
+	Query      string
+	CopyStmt   *ast.CopyStmt
+
+	// Shards are the shards of the table, all of which receive a COPY.
+	Shards []string
+	// KeyIndex is the position of the shard key among the fields of a row.
+	KeyIndex int
+	// Router maps shard key values to shards.
+	Router ShardKeyRouter
+
+	format copyFormat
+}
+
+// NewShardedCopy creates a new ShardedCopy primitive. It fails if the COPY
+// is not in a format whose rows can be split.
+func NewShardedCopy(
+	tableGroup, query string,
+	copyStmt *ast.CopyStmt,
+	shards []string,
+	keyIndex int,
+	router ShardKeyRouter,
+) (*ShardedCopy, error) {
+	if len(shards) == 0 {
+		return nil, errors.New("sharded COPY requires at least one shard")
+	}
+	if keyIndex < 0 {
+		return nil, fmt.Errorf("invalid shard key index %d", keyIndex)
+	}
+	format, err := parseCopyFormat(copyStmt)
+	if err != nil {
+		return nil, err
+	}
+	return &ShardedCopy{
+		TableGroup: tableGroup,
+		Query:      query,
+		CopyStmt:   copyStmt,
+		Shards:     shards,
+		KeyIndex:   keyIndex,
+		Router:     router,
+		format:     format,
+	}, nil
+}
+
+// shardCopyStream feeds the rows routed to one shard to its COPY.
+type shardCopyStream struct {
+	shard   string
+	batch   []byte
+	batches chan []byte
+}
+
+// StreamExecute implements the Primitive interface.
+func (c *ShardedCopy) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	// Phase 1: INITIATE - Start the COPY on every shard.
+	var format int16
+	var columnFormats []int16
+	var initiated []string
+	completed := false
+	defer func() {
+		if !completed {
+			for _, shard := range initiated {
+				_ = exec.CopyAbort(ctx, conn, c.TableGroup, shard, state)
+			}
+		}
+	}()
+	for i, shard := range c.Shards {
+		f, cf, err := exec.CopyInitiate(ctx, conn, c.TableGroup, shard, c.Query, state, func(ctx context.Context, result *sqltypes.Result) error {
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initiate COPY on shard %s: %w", shard, err)
+		}
+		initiated = append(initiated, shard)
+		if i == 0 {
+			format, columnFormats = f, cf
+		}
+	}
+
+	if err := conn.WriteCopyInResponse(format, columnFormats); err != nil {
+		return fmt.Errorf("failed to write CopyInResponse: %w", err)
+	}
+	if err := conn.Flush(); err != nil {
+		return fmt.Errorf("failed to flush CopyInResponse: %w", err)
+	}
+
+	// Phase 2: DATA - Split rows and send each shard its rows in parallel.
+	// A sender that fails records the error and closes failed, which stops
+	// splitting; the remaining senders are stopped before the COPYs are
+	// aborted.
+	sendCtx, cancelSend := context.WithCancel(ctx)
+	defer cancelSend()
+
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+		sendErr  error
+		failed   = make(chan struct{})
+	)
+	streams := make(map[string]*shardCopyStream, len(c.Shards))
+	for _, shard := range c.Shards {
+		s := &shardCopyStream{shard: shard, batches: make(chan []byte, shardedCopyQueueDepth)}
+		streams[shard] = s
+		wg.Go(func() {
+			for batch := range s.batches {
+				if err := exec.CopySendData(sendCtx, conn, c.TableGroup, s.shard, state, batch); err != nil {
+					failOnce.Do(func() {
+						sendErr = fmt.Errorf("failed to send COPY data to shard %s: %w", s.shard, err)
+						close(failed)
+					})
+					return
+				}
+			}
+		})
+	}
+	sendersClosed := false
+	closeSenders := func() {
+		if !sendersClosed {
+			sendersClosed = true
+			for _, s := range streams {
+				close(s.batches)
+			}
+		}
+		wg.Wait()
+	}
+	defer func() {
+		cancelSend()
+		closeSenders()
+	}()
+
+	dispatch := func(s *shardCopyStream) error {
+		if len(s.batch) == 0 {
+			return nil
+		}
+		select {
+		case s.batches <- s.batch:
+			s.batch = nil
+			return nil
+		case <-failed:
+			return sendErr
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	dispatchAll := func() error {
+		for _, s := range streams {
+			if err := dispatch(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rowNum := 0
+	splitter := &copyRowSplitter{format: c.format}
+	routeRow := func(row []byte) error {
+		rowNum++
+		if c.format.header && rowNum == 1 {
+			// Every shard's COPY expects the header line.
+			for _, s := range streams {
+				s.batch = append(s.batch, row...)
+			}
+			return nil
+		}
+
+		key, isNull, ok := c.format.field(row, c.KeyIndex)
+		if !ok {
+			return sqlstate.NewError(sqlstate.BadCopyFileFormat).
+				Msg("missing data for shard key column in line %d", rowNum).
+				Err()
+		}
+		shard, err := c.Router.ShardForKey(key, isNull)
+		if err != nil {
+			return err
+		}
+		s, ok := streams[shard]
+		if !ok {
+			return fmt.Errorf("shard key in line %d maps to unknown shard %q", rowNum, shard)
+		}
+		s.batch = append(s.batch, row...)
+		if len(s.batch) >= shardedCopyBatchSize {
+			return dispatch(s)
+		}
+		return nil
+	}
+
+	for {
+		msgType, err := conn.ReadMessageType()
+		if err != nil {
+			return fmt.Errorf("failed to read message: %w", err)
+		}
+
+		length, err := conn.ReadMessageLength()
+		if err != nil {
+			return fmt.Errorf("failed to read message length: %w", err)
+		}
+
+		switch msgType {
+		case protocol.MsgCopyData:
+			data, err := conn.ReadCopyDataMessage(length)
+			if err != nil {
+				return err
+			}
+			if err := splitter.write(data, routeRow); err != nil {
+				return err
+			}
+			if err := dispatchAll(); err != nil {
+				return err
+			}
+
+		case protocol.MsgCopyDone:
+			if err := conn.ReadCopyDoneMessage(length); err != nil {
+				return err
+			}
+			if err := splitter.finish(routeRow); err != nil {
+				return err
+			}
+			if err := dispatchAll(); err != nil {
+				return err
+			}
+			closeSenders()
+			if sendErr != nil {
+				return sendErr
+			}
+
+			// Phase 3: DONE - Finalize every shard and report the total.
+			completed = true
+			return c.finalize(ctx, exec, conn, state, callback)
+
+		case protocol.MsgCopyFail:
+			errMsg, err := conn.ReadCopyFailMessage(length)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("COPY failed: %s", errMsg)
+
+		default:
+			return fmt.Errorf("unexpected message type during COPY: %c", msgType)
+		}
+	}
+}
+
+// finalize completes the COPY on every shard in parallel and passes the
+// total number of rows copied to callback.
+func (c *ShardedCopy) finalize(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		total uint64
+		errs  []error
+	)
+	for _, shard := range c.Shards {
+		wg.Go(func() {
+			err := exec.CopyFinalize(ctx, conn, c.TableGroup, shard, state, nil, func(ctx context.Context, result *sqltypes.Result) error {
+				mu.Lock()
+				defer mu.Unlock()
+				total += result.RowsAffected
+				return nil
+			})
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("shard %s: %w", shard, err))
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return callback(ctx, &sqltypes.Result{
+		CommandTag:   fmt.Sprintf("COPY %d", total),
+		RowsAffected: total,
+	})
+}
+
+// GetTableGroup implements the Primitive interface.
+func (c *ShardedCopy) GetTableGroup() string {
+	return c.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (c *ShardedCopy) GetQuery() string {
+	return c.Query
+}
+
+// String implements the Primitive interface.
+func (c *ShardedCopy) String() string {
+	source := "query"
+	if c.CopyStmt.Relation != nil {
+		source = c.CopyStmt.Relation.RelName
+	}
+	return fmt.Sprintf("ShardedCopy(%s FROM STDIN, %d shards)", source, len(c.Shards))
+}
+
+// Ensure ShardedCopy implements Primitive interface.
+var _ Primitive = (*ShardedCopy)(nil)
@@ -0,0 +1,200 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// shardRecordingExec is an IExecute that records the COPY data sent to
+// each shard.
+type shardRecordingExec struct {
+	mockIExecute
+
+	mu        sync.Mutex
+	data      map[string]*bytes.Buffer
+	aborted   []string
+	sendErrOn string
+}
+
+func newShardRecordingExec() *shardRecordingExec {
+	return &shardRecordingExec{data: make(map[string]*bytes.Buffer)}
+}
+
+func (m *shardRecordingExec) CopyInitiate(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	queryStr string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(ctx context.Context, result *sqltypes.Result) error,
+) (int16, []int16, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[shard] = &bytes.Buffer{}
+	return 0, []int16{0, 0}, nil
+}
+
+func (m *shardRecordingExec) CopySendData(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	data []byte,
+) error {
+	if shard == m.sendErrOn {
+		return errors.New("shard unavailable")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[shard].Write(data)
+	return nil
+}
+
+func (m *shardRecordingExec) CopyFinalize(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	finalData []byte,
+	callback func(ctx context.Context, result *sqltypes.Result) error,
+) error {
+	m.mu.Lock()
+	rows := strings.Count(m.data[shard].String(), "\n")
+	m.mu.Unlock()
+	return callback(ctx, &sqltypes.Result{RowsAffected: uint64(rows)})
+}
+
+func (m *shardRecordingExec) CopyAbort(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.aborted = append(m.aborted, shard)
+	return nil
+}
+
+// modRouter routes integer keys to shard "<key mod n>" and NULL keys to "0".
+type modRouter struct{ n int }
+
+func (r modRouter) ShardForKey(key []byte, isNull bool) (string, error) {
+	if isNull {
+		return "0", nil
+	}
+	k, err := strconv.Atoi(string(key))
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(k % r.n), nil
+}
+
+func runShardedCopy(t *testing.T, exec IExecute, sql string, chunks ...string) (*sqltypes.Result, error) {
+	t.Helper()
+	copyStmt, err := NewShardedCopy("tg", sql, parseCopyStmt(t, sql), []string{"0", "1"}, 0, modRouter{n: 2})
+	require.NoError(t, err)
+
+	readBuf := &bytes.Buffer{}
+	for _, chunk := range chunks {
+		server.WriteCopyDataMessage(readBuf, []byte(chunk))
+	}
+	server.WriteCopyDoneMessage(readBuf)
+
+	var result *sqltypes.Result
+	err = copyStmt.StreamExecute(
+		context.Background(),
+		exec,
+		server.NewTestConn(readBuf).Conn,
+		&handler.MultiGatewayConnectionState{},
+		func(ctx context.Context, r *sqltypes.Result) error {
+			result = r
+			return nil
+		},
+	)
+	return result, err
+}
+
+func TestShardedCopy_RoutesRows(t *testing.T) {
+	exec := newShardRecordingExec()
+	result, err := runShardedCopy(t, exec, "COPY t FROM STDIN",
+		"1\tone\n2\ttwo\n3\tth", "ree\n4\tfour\n\\N\tnull\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, "COPY 5", result.CommandTag)
+	assert.Equal(t, uint64(5), result.RowsAffected)
+	assert.Equal(t, "2\ttwo\n4\tfour\n\\N\tnull\n", exec.data["0"].String())
+	assert.Equal(t, "1\tone\n3\tthree\n", exec.data["1"].String())
+	assert.Empty(t, exec.aborted)
+}
+
+func TestShardedCopy_CSVHeaderSentToEveryShard(t *testing.T) {
+	exec := newShardRecordingExec()
+	_, err := runShardedCopy(t, exec, "COPY t FROM STDIN WITH CSV HEADER",
+		"id,name\n1,\"a\nb\"\n2,c\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, "id,name\n2,c\n", exec.data["0"].String())
+	assert.Equal(t, "id,name\n1,\"a\nb\"\n", exec.data["1"].String())
+}
+
+func TestShardedCopy_SendErrorAbortsEveryShard(t *testing.T) {
+	exec := newShardRecordingExec()
+	exec.sendErrOn = "1"
+	_, err := runShardedCopy(t, exec, "COPY t FROM STDIN", "1\tone\n2\ttwo\n")
+	require.ErrorContains(t, err, "shard unavailable")
+	assert.ElementsMatch(t, []string{"0", "1"}, exec.aborted)
+}
+
+func TestShardedCopy_MissingKey(t *testing.T) {
+	exec := newShardRecordingExec()
+	copyStmt, err := NewShardedCopy("tg", "COPY t FROM STDIN", parseCopyStmt(t, "COPY t FROM STDIN"),
+		[]string{"0", "1"}, 1, modRouter{n: 2})
+	require.NoError(t, err)
+
+	readBuf := &bytes.Buffer{}
+	server.WriteCopyDataMessage(readBuf, []byte("1\n"))
+	server.WriteCopyDoneMessage(readBuf)
+
+	err = copyStmt.StreamExecute(context.Background(), exec, server.NewTestConn(readBuf).Conn,
+		&handler.MultiGatewayConnectionState{},
+		func(ctx context.Context, r *sqltypes.Result) error { return nil })
+	require.ErrorContains(t, err, "missing data for shard key column in line 1")
+	assert.ElementsMatch(t, []string{"0", "1"}, exec.aborted)
+}
+
+func TestNewShardedCopy_RejectsBinary(t *testing.T) {
+	sql := "COPY t FROM STDIN (FORMAT binary)"
+	_, err := NewShardedCopy("tg", sql, parseCopyStmt(t, sql), []string{"0"}, 0, modRouter{n: 1})
+	require.ErrorContains(t, err, "binary format is not supported")
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// ShardedInsert is a primitive that runs an INSERT into a sharded table
+// whose rows the gateway must process: the values of a column taken from a
+// global sequence are set in the rows, which are then sent to the shards of
+// their shard key values, with an INSERT per shard, and the entries of the
+// rows are added to the lookup tables indexing the table. An INSERT spanning
+// several shards commits on all of them or on none (see executeOnShards).
+type ShardedInsert struct {
+	// TableGroup is the target tablegroup for this query.
+	TableGroup string
+
+	// Shards are the shards of the tablegroup, in the order the INSERTs of
+	// the shards run.
+	Shards []string
+
+	// Insert is the INSERT, with VALUES rows.
+	Insert *ast.InsertStmt
+
+	// Column is the position in the rows of the column whose values are
+	// generated, or -1.
+	Column int
+
+	// Generated tells, for each row, whether its value of Column is
+	// generated, replacing the one of Insert.
+	Generated []bool
+
+	// RowShards are the shards of the rows, or "" for the rows whose shard
+	// key is the generated value, routed by Router.
+	RowShards []string
+	Router    ShardKeyRouter
+
+	// Sequences hands out the values of Sequence.
+	Sequences *GlobalSequences
+	Sequence  string
+
+	// Lookups are the lookup indexes of the table, on LookupShard, each
+	// given an entry per row with a non-NULL value of its column.
+	Lookups     []LookupColumn
+	LookupShard string
+}
+
+// StreamExecute implements the Primitive interface.
+func (g *ShardedInsert) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	queries, lookupQueries, err := g.shardQueries(ctx)
+	if err != nil {
+		return err
+	}
+	var shards []string
+	for _, shard := range g.Shards {
+		if _, ok := queries[shard]; ok {
+			shards = append(shards, shard)
+		}
+	}
+	if len(shards) == 1 && len(lookupQueries) == 0 {
+		return exec.StreamExecute(ctx, conn, g.TableGroup, shards[0], queries[shards[0]], state, callback)
+	}
+
+	involved := shards
+	if len(lookupQueries) > 0 && !slices.Contains(shards, g.LookupShard) {
+		involved = append(slices.Clone(shards), g.LookupShard)
+	}
+	var results []*sqltypes.Result
+	err = executeOnShards(ctx, exec, conn, state, g.TableGroup, involved, func() error {
+		for _, shard := range shards {
+			result, err := executeQuery(ctx, exec, conn, state, g.TableGroup, shard, queries[shard])
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		for _, sql := range lookupQueries {
+			if _, err := executeQuery(ctx, exec, conn, state, g.TableGroup, g.LookupShard, sql); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	merged, err := sqltypes.MergeResults(results)
+	if err != nil {
+		return err
+	}
+	return callback(ctx, merged)
+}
+
+// shardQueries sets the generated values in the rows of the INSERT, and
+// returns the INSERT of the rows of each shard, and the INSERTs of their
+// entries into the lookup tables.
+func (g *ShardedInsert) shardQueries(ctx context.Context) (map[string]string, []string, error) {
+	source, ok := g.Insert.SelectStmt.(*ast.SelectStmt)
+	if !ok || source.ValuesLists == nil || len(source.ValuesLists.Items) != len(g.RowShards) {
+		return nil, nil, fmt.Errorf("INSERT rows do not match the %d rows to route", len(g.RowShards))
+	}
+	var values []int64
+	if count := countTrue(g.Generated); count > 0 {
+		var err error
+		if values, err = g.Sequences.Next(ctx, g.Sequence, count); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	rows := make(map[string][]ast.Node)
+	allRows := make([]*ast.NodeList, len(source.ValuesLists.Items))
+	for i, item := range source.ValuesLists.Items {
+		row, ok := item.(*ast.NodeList)
+		if !ok {
+			return nil, nil, fmt.Errorf("INSERT row %d is not a list of values", i)
+		}
+		shard := g.RowShards[i]
+		if g.Column >= 0 && g.Generated[i] {
+			if g.Column >= len(row.Items) {
+				return nil, nil, fmt.Errorf("INSERT row %d has no column %d", i, g.Column)
+			}
+			value := values[0]
+			values = values[1:]
+			items := slices.Clone(row.Items)
+			items[g.Column] = ast.NewA_Const(ast.NewInteger(int(value)), -1)
+			row = ast.NewNodeList(items...)
+			if shard == "" {
+				var err error
+				if shard, err = g.Router.ShardForKey([]byte(strconv.FormatInt(value, 10)), false); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+		rows[shard] = append(rows[shard], row)
+		allRows[i] = row
+	}
+
+	queries := make(map[string]string, len(rows))
+	for shard, shardRows := range rows {
+		values := *source
+		values.ValuesLists = ast.NewNodeList(shardRows...)
+		insert := *g.Insert
+		insert.SelectStmt = &values
+		queries[shard] = insert.SqlString()
+	}
+
+	var lookupQueries []string
+	for _, lookup := range g.Lookups {
+		sql, err := lookup.insertQuery(allRows)
+		if err != nil {
+			return nil, nil, err
+		}
+		if sql != "" {
+			lookupQueries = append(lookupQueries, sql)
+		}
+	}
+	return queries, lookupQueries, nil
+}
+
+// countTrue returns the number of true values of bs.
+func countTrue(bs []bool) int {
+	count := 0
+	for _, b := range bs {
+		if b {
+			count++
+		}
+	}
+	return count
+}
+
+// GetTableGroup implements the Primitive interface.
+func (g *ShardedInsert) GetTableGroup() string {
+	return g.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (g *ShardedInsert) GetQuery() string {
+	return g.Insert.SqlString()
+}
+
+// String implements the Primitive interface.
+func (g *ShardedInsert) String() string {
+	lookups := make([]string, len(g.Lookups))
+	for i, lookup := range g.Lookups {
+		lookups[i] = lookup.Table
+	}
+	return fmt.Sprintf("ShardedInsert(tablegroup=%s, sequence=%s, column=%d, lookups=%v, query=%s)", g.TableGroup, g.Sequence, g.Column, lookups, g.Insert.SqlString())
+}
+
+// Ensure ShardedInsert implements Primitive interface.
+var _ Primitive = (*ShardedInsert)(nil)
+
+// SequenceNextval is a primitive answering a SELECT of the next values of
+// global sequences, such as SELECT nextval('users_id_seq'), from the
+// gateway, without running it on a shard.
+type SequenceNextval struct {
+	TableGroup string
+	Query      string
+
+	// Columns are the output columns, each the next value of a sequence.
+	Columns []NextvalColumn
+
+	// Sequences hands out the values of the sequences.
+	Sequences *GlobalSequences
+}
+
+// NextvalColumn is an output column of a SequenceNextval.
+type NextvalColumn struct {
+	Name     string
+	Sequence string
+}
+
+// StreamExecute implements the Primitive interface.
+func (s *SequenceNextval) StreamExecute(
+	ctx context.Context,
+	_ IExecute,
+	_ *server.Conn,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	result := &sqltypes.Result{
+		Fields:     make([]*query.Field, len(s.Columns)),
+		Rows:       []*sqltypes.Row{{Values: make([]sqltypes.Value, len(s.Columns))}},
+		CommandTag: "SELECT 1",
+	}
+	for i, col := range s.Columns {
+		values, err := s.Sequences.Next(ctx, col.Sequence, 1)
+		if err != nil {
+			return err
+		}
+		result.Fields[i] = &query.Field{
+			Name:         col.Name,
+			Type:         ast.INT8OID.String(),
+			DataTypeOid:  uint32(ast.INT8OID),
+			DataTypeSize: 8,
+			TypeModifier: -1,
+		}
+		result.Rows[0].Values[i] = sqltypes.Value(strconv.FormatInt(values[0], 10))
+	}
+	return callback(ctx, result)
+}
+
+// GetTableGroup implements the Primitive interface.
+func (s *SequenceNextval) GetTableGroup() string {
+	return s.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (s *SequenceNextval) GetQuery() string {
+	return s.Query
+}
+
+// String implements the Primitive interface.
+func (s *SequenceNextval) String() string {
+	sequences := make([]string, len(s.Columns))
+	for i, col := range s.Columns {
+		sequences[i] = col.Sequence
+	}
+	return fmt.Sprintf("SequenceNextval(sequences=%v)", sequences)
+}
+
+// Ensure SequenceNextval implements Primitive interface.
+var _ Primitive = (*SequenceNextval)(nil)
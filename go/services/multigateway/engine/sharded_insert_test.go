@@ -0,0 +1,161 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// insertExecute records the INSERTs of a ShardedInsert along with the
+// implicit transactions around them.
+type insertExecute struct {
+	writeExecute
+}
+
+func (e *insertExecute) StreamExecute(
+	ctx context.Context,
+	_ *server.Conn,
+	_ string,
+	shard string,
+	sql string,
+	_ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	e.calls = append(e.calls, shard+":"+sql)
+	return callback(ctx, &sqltypes.Result{RowsAffected: 1, CommandTag: "INSERT 0 1"})
+}
+
+// newShardedInsert returns a ShardedInsert of sql, generating the
+// values of the first column, the shard key, for every row.
+func newShardedInsert(t *testing.T, sql string) *ShardedInsert {
+	t.Helper()
+	stmts, err := parser.ParseSQL(sql)
+	require.NoError(t, err)
+	insert := stmts[0].(*ast.InsertStmt)
+	rows := len(insert.SelectStmt.(*ast.SelectStmt).ValuesLists.Items)
+	return &ShardedInsert{
+		TableGroup: "tg",
+		Shards:     []string{"-80", "80-"},
+		Insert:     insert,
+		Generated:  make([]bool, rows),
+		RowShards:  make([]string, rows),
+		Router:     shardByParity{},
+		Sequences:  NewGlobalSequences(&fakeAllocator{}, "tg", "", 100),
+		Sequence:   "users_id_seq",
+	}
+}
+
+func runShardedInsert(t *testing.T, insert *ShardedInsert, exec IExecute) *sqltypes.Result {
+	t.Helper()
+	var results []*sqltypes.Result
+	err := insert.StreamExecute(context.Background(), exec, nil, handler.NewMultiGatewayConnectionState(),
+		func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	return results[0]
+}
+
+func TestShardedInsertAcrossShards(t *testing.T) {
+	insert := newShardedInsert(t, "INSERT INTO users (id, name) VALUES (DEFAULT, 'a'), (DEFAULT, 'b'), (DEFAULT, 'c')")
+	insert.Generated = []bool{true, true, true}
+	exec := &insertExecute{}
+
+	result := runShardedInsert(t, insert, exec)
+	assert.Equal(t, "INSERT 0 2", result.CommandTag)
+	assert.Equal(t, []string{
+		"BEGIN -80", "BEGIN 80-",
+		"-80:INSERT INTO users (id, name) VALUES (2, 'b')",
+		"80-:INSERT INTO users (id, name) VALUES (1, 'a'), (3, 'c')",
+		"COMMIT -80", "COMMIT 80-",
+	}, exec.calls)
+}
+
+func TestShardedInsertSingleShard(t *testing.T) {
+	insert := newShardedInsert(t, "INSERT INTO users (id, name) VALUES (DEFAULT, 'a'), (4, 'b')")
+	// The second row keeps its value, and its shard is known.
+	insert.Generated = []bool{true, false}
+	insert.RowShards = []string{"", "80-"}
+	exec := &insertExecute{}
+
+	result := runShardedInsert(t, insert, exec)
+	assert.Equal(t, "INSERT 0 1", result.CommandTag)
+	assert.Equal(t, []string{"80-:INSERT INTO users (id, name) VALUES (1, 'a'), (4, 'b')"}, exec.calls)
+}
+
+func TestShardedInsertLookups(t *testing.T) {
+	insert := newShardedInsert(t, "INSERT INTO users (id, email) VALUES (DEFAULT, 'a@x'), (DEFAULT, NULL), (4, 'b@x')")
+	insert.Generated = []bool{true, true, false}
+	insert.RowShards = []string{"", "", "-80"}
+	insert.Lookups = []LookupColumn{{Table: "users_email_lookup", Column: "email", KeyColumn: "id", Value: 1, Key: 0}}
+	insert.LookupShard = "-80"
+	exec := &insertExecute{}
+
+	result := runShardedInsert(t, insert, exec)
+	assert.Equal(t, "INSERT 0 2", result.CommandTag)
+	// The row without an email has no entry, and the entries commit with
+	// the rows.
+	assert.Equal(t, []string{
+		"BEGIN -80", "BEGIN 80-",
+		"-80:INSERT INTO users (id, email) VALUES (2, NULL), (4, 'b@x')",
+		"80-:INSERT INTO users (id, email) VALUES (1, 'a@x')",
+		"-80:INSERT INTO users_email_lookup (email, id) VALUES ('a@x', 1), ('b@x', 4)",
+		"COMMIT -80", "COMMIT 80-",
+	}, exec.calls)
+}
+
+func TestShardedInsertLookupOnOtherShard(t *testing.T) {
+	insert := newShardedInsert(t, "INSERT INTO users (id, email) VALUES (3, 'a@x')")
+	insert.Column = -1
+	insert.RowShards = []string{"80-"}
+	insert.Lookups = []LookupColumn{{Table: "users_email_lookup", Column: "email", KeyColumn: "id", Value: 1, Key: 0}}
+	insert.LookupShard = "-80"
+	exec := &insertExecute{}
+
+	runShardedInsert(t, insert, exec)
+	assert.Equal(t, []string{
+		"BEGIN 80-", "BEGIN -80",
+		"80-:INSERT INTO users (id, email) VALUES (3, 'a@x')",
+		"-80:INSERT INTO users_email_lookup (email, id) VALUES ('a@x', 3)",
+		"COMMIT 80-", "COMMIT -80",
+	}, exec.calls)
+}
+
+func TestSequenceNextval(t *testing.T) {
+	nextval := &SequenceNextval{
+		TableGroup: "tg",
+		Query:      "SELECT nextval('s') AS a, nextval('s')",
+		Columns:    []NextvalColumn{{Name: "a", Sequence: "s"}, {Name: "nextval", Sequence: "s"}},
+		Sequences:  NewGlobalSequences(&fakeAllocator{}, "tg", "", 100),
+	}
+	result := runPrimitive(t, nextval)
+	require.Len(t, result.Fields, 2)
+	assert.Equal(t, "a", result.Fields[0].Name)
+	assert.Equal(t, uint32(ast.INT8OID), result.Fields[1].DataTypeOid)
+	assert.Equal(t, textRows([]string{"1", "2"}), result.Rows)
+	assert.Equal(t, "SELECT 1", result.CommandTag)
+}
@@ -0,0 +1,275 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/constants"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// textOid is the OID of the text type, the type of every SHOW column.
+const textOid = 25
+
+// gatewayParameter is a run-time parameter owned by the gateway. SHOW
+// answers it from the gateway's view of the session instead of asking
+// PostgreSQL, whose answer would describe the pooled backend.
+type gatewayParameter struct {
+	name        string
+	description string
+	// value returns the parameter's value for the session, or false to
+	// let PostgreSQL answer.
+	value func(s *Show, state *handler.MultiGatewayConnectionState) (string, bool)
+}
+
+// gatewayParameters are the parameters owned by the gateway, sorted by name.
+var gatewayParameters = []gatewayParameter{
+	{
+		name:        "application_name",
+		description: "Sets the application name to be reported in statistics and logs.",
+		// Pooled backends may have run other sessions' statements since the
+		// application name was applied; the session's own value is the truth.
+		value: func(_ *Show, state *handler.MultiGatewayConnectionState) (string, bool) {
+			return state.GetSessionVariable("application_name")
+		},
+	},
+	{
+		name:        handler.DebugErrorsVariable,
+		description: "Sets whether errors show the shard and the pooler they came from.",
+		value: func(_ *Show, state *handler.MultiGatewayConnectionState) (string, bool) {
+			if state.DebugErrors() {
+				return "on", true
+			}
+			return "off", true
+		},
+	},
+	{
+		name:        handler.MaxReplicationLagVariable,
+		description: "Sets the maximum replication lag of the replicas reads may run on.",
+		value: func(_ *Show, state *handler.MultiGatewayConnectionState) (string, bool) {
+			if value, ok := state.GetSessionVariable(handler.MaxReplicationLagVariable); ok {
+				return value, true
+			}
+			return "0", true
+		},
+	},
+	{
+		name:        "multigres.shard",
+		description: "Shows the shard the session's statements are routed to.",
+		value: func(s *Show, _ *handler.MultiGatewayConnectionState) (string, bool) {
+			if s.Shard == "" {
+				return constants.DefaultShard, true
+			}
+			return s.Shard, true
+		},
+	},
+	{
+		name:        "multigres.tablegroup",
+		description: "Shows the tablegroup the session's statements are routed to.",
+		value: func(s *Show, _ *handler.MultiGatewayConnectionState) (string, bool) {
+			return s.TableGroup, true
+		},
+	},
+	{
+		name:        handler.TargetVariable,
+		description: "Sets the type of pooler reads are routed to: primary or replica.",
+		value: func(_ *Show, state *handler.MultiGatewayConnectionState) (string, bool) {
+			if value, ok := state.GetSessionVariable(handler.TargetVariable); ok {
+				return value, true
+			}
+			return "primary", true
+		},
+	},
+	{
+		name:        "server_version",
+		description: "Shows the server version.",
+		value: func(*Show, *handler.MultiGatewayConnectionState) (string, bool) {
+			return server.ServerVersion, true
+		},
+	},
+	{
+		name:        "server_version_num",
+		description: "Shows the server version as an integer.",
+		value: func(*Show, *handler.MultiGatewayConnectionState) (string, bool) {
+			return server.ServerVersionNum, true
+		},
+	},
+}
+
+// findGatewayParameter returns the gateway-owned parameter with the given
+// name, or nil.
+func findGatewayParameter(name string) *gatewayParameter {
+	for i := range gatewayParameters {
+		if gatewayParameters[i].name == strings.ToLower(name) {
+			return &gatewayParameters[i]
+		}
+	}
+	return nil
+}
+
+// IsGatewayParameter returns true if SHOW name may be answered by the
+// gateway. SHOW ALL always involves the gateway, to merge in its parameters.
+func IsGatewayParameter(name string) bool {
+	return strings.EqualFold(name, "all") || findGatewayParameter(name) != nil
+}
+
+// Show answers SHOW for the parameters the gateway owns, and forwards it
+// to PostgreSQL for the others. For SHOW ALL, the backend's answer is
+// merged with the gateway's parameters.
+type Show struct {
+	TableGroup string
+	Shard      string
+	Query      string
+	// Name is the parameter to show, or "all".
+	Name string
+}
+
+// NewShow creates a new Show primitive.
+func NewShow(tableGroup, shard, query, name string) *Show {
+	return &Show{
+		TableGroup: tableGroup,
+		Shard:      shard,
+		Query:      query,
+		Name:       strings.ToLower(name),
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (s *Show) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	if s.Name == "all" {
+		return s.showAll(ctx, exec, conn, state, callback)
+	}
+	if param := findGatewayParameter(s.Name); param != nil {
+		if value, ok := param.value(s, state); ok {
+			return callback(ctx, &sqltypes.Result{
+				Fields:     []*query.Field{textField(s.Name)},
+				Rows:       []*sqltypes.Row{{Values: []sqltypes.Value{sqltypes.Value(value)}}},
+				CommandTag: "SHOW",
+			})
+		}
+	}
+	return exec.StreamExecute(ctx, conn, s.TableGroup, s.Shard, s.Query, state, callback)
+}
+
+// showAll runs SHOW ALL on PostgreSQL, then overrides the settings of the
+// gateway-owned parameters and adds those PostgreSQL does not know about.
+// The result is buffered to keep the rows sorted by name; it is only a few
+// hundred rows.
+func (s *Show) showAll(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	merged := &sqltypes.Result{}
+	err := exec.StreamExecute(ctx, conn, s.TableGroup, s.Shard, s.Query, state,
+		func(_ context.Context, result *sqltypes.Result) error {
+			if len(result.Fields) > 0 {
+				merged.Fields = result.Fields
+			}
+			merged.Rows = append(merged.Rows, result.Rows...)
+			merged.Notices = append(merged.Notices, result.Notices...)
+			if result.CommandTag != "" {
+				merged.CommandTag = result.CommandTag
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	if merged.CommandTag == "" {
+		merged.CommandTag = "SHOW"
+	}
+	if len(merged.Fields) == 0 {
+		merged.Fields = []*query.Field{textField("name"), textField("setting"), textField("description")}
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range merged.Rows {
+		if len(row.Values) < 2 {
+			continue
+		}
+		if param := findGatewayParameter(showRowName(row)); param != nil {
+			seen[param.name] = true
+			if value, ok := param.value(s, state); ok {
+				row.Values[1] = sqltypes.Value(value)
+			}
+		}
+	}
+	for _, param := range gatewayParameters {
+		if seen[param.name] {
+			continue
+		}
+		value, ok := param.value(s, state)
+		if !ok {
+			continue
+		}
+		values := []sqltypes.Value{sqltypes.Value(param.name), sqltypes.Value(value), sqltypes.Value(param.description)}
+		merged.Rows = append(merged.Rows, &sqltypes.Row{Values: values[:min(len(values), len(merged.Fields))]})
+	}
+	slices.SortStableFunc(merged.Rows, func(a, b *sqltypes.Row) int {
+		return strings.Compare(strings.ToLower(showRowName(a)), strings.ToLower(showRowName(b)))
+	})
+	return callback(ctx, merged)
+}
+
+// showRowName returns the parameter name of a SHOW ALL row.
+func showRowName(row *sqltypes.Row) string {
+	if len(row.Values) == 0 {
+		return ""
+	}
+	return string(row.Values[0])
+}
+
+// textField returns the description of a text column of a SHOW result.
+func textField(name string) *query.Field {
+	return &query.Field{
+		Name:         name,
+		DataTypeOid:  textOid,
+		DataTypeSize: -1,
+	}
+}
+
+// GetTableGroup implements the Primitive interface.
+func (s *Show) GetTableGroup() string {
+	return s.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (s *Show) GetQuery() string {
+	return s.Query
+}
+
+// String implements the Primitive interface.
+func (s *Show) String() string {
+	return fmt.Sprintf("Show(tablegroup=%s, name=%s, query=%s)", s.TableGroup, s.Name, s.Query)
+}
+
+// Ensure Show implements Primitive interface.
+var _ Primitive = (*Show)(nil)
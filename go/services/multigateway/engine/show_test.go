@@ -0,0 +1,157 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// runShow runs SHOW name and returns the results it produced.
+func runShow(t *testing.T, exec *mockIExecute, state *handler.MultiGatewayConnectionState, name string) []*sqltypes.Result {
+	t.Helper()
+	var results []*sqltypes.Result
+	show := NewShow("tg", "", "SHOW "+name, name)
+	err := show.StreamExecute(context.Background(), exec, nil, state,
+		func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	require.NoError(t, err)
+	return results
+}
+
+// showRows returns the rows of results as strings.
+func showRows(results []*sqltypes.Result) [][]string {
+	var rows [][]string
+	for _, result := range results {
+		for _, row := range result.Rows {
+			var values []string
+			for _, v := range row.Values {
+				values = append(values, string(v))
+			}
+			rows = append(rows, values)
+		}
+	}
+	return rows
+}
+
+func TestIsGatewayParameter(t *testing.T) {
+	assert.True(t, IsGatewayParameter("server_version"))
+	assert.True(t, IsGatewayParameter("Application_Name"))
+	assert.True(t, IsGatewayParameter("multigres.shard"))
+	assert.True(t, IsGatewayParameter("ALL"))
+	assert.False(t, IsGatewayParameter("search_path"))
+	assert.False(t, IsGatewayParameter("multigres.unknown"))
+}
+
+func TestShowGatewayParameter(t *testing.T) {
+	state := handler.NewMultiGatewayConnectionState()
+	backend := &mockIExecute{streamResults: []*sqltypes.Result{
+		{Rows: []*sqltypes.Row{{Values: []sqltypes.Value{sqltypes.Value("from backend")}}}},
+	}}
+
+	results := runShow(t, backend, state, "server_version")
+	require.Len(t, results, 1)
+	assert.Equal(t, "server_version", results[0].Fields[0].Name)
+	assert.Equal(t, "SHOW", results[0].CommandTag)
+	assert.Equal(t, [][]string{{server.ServerVersion}}, showRows(results))
+
+	assert.Equal(t, [][]string{{"tg"}}, showRows(runShow(t, backend, state, "multigres.tablegroup")))
+	assert.Equal(t, [][]string{{"0-inf"}}, showRows(runShow(t, backend, state, "multigres.shard")))
+}
+
+func TestShowRoutingParameters(t *testing.T) {
+	state := handler.NewMultiGatewayConnectionState()
+	backend := &mockIExecute{}
+
+	assert.Equal(t, [][]string{{"primary"}}, showRows(runShow(t, backend, state, "multigres.target")))
+	assert.Equal(t, [][]string{{"0"}}, showRows(runShow(t, backend, state, "multigres.max_replication_lag")))
+	assert.Equal(t, [][]string{{"off"}}, showRows(runShow(t, backend, state, "multigres.debug_errors")))
+
+	state.SetSessionVariable(handler.TargetVariable, "replica")
+	state.SetSessionVariable(handler.MaxReplicationLagVariable, "5s")
+	assert.Equal(t, [][]string{{"replica"}}, showRows(runShow(t, backend, state, "multigres.target")))
+	assert.Equal(t, [][]string{{"5s"}}, showRows(runShow(t, backend, state, "multigres.max_replication_lag")))
+
+	state.SetSessionVariable(handler.DebugErrorsVariable, "true")
+	assert.Equal(t, [][]string{{"on"}}, showRows(runShow(t, backend, state, "multigres.debug_errors")))
+}
+
+func TestShowApplicationName(t *testing.T) {
+	backend := &mockIExecute{streamResults: []*sqltypes.Result{
+		{Rows: []*sqltypes.Row{{Values: []sqltypes.Value{sqltypes.Value("from backend")}}}},
+	}}
+
+	// Without a value of its own, the session defers to PostgreSQL.
+	state := handler.NewMultiGatewayConnectionState()
+	assert.Equal(t, [][]string{{"from backend"}}, showRows(runShow(t, backend, state, "application_name")))
+
+	state.SetStartupParams(map[string]string{"application_name": "psql"})
+	assert.Equal(t, [][]string{{"psql"}}, showRows(runShow(t, backend, state, "application_name")))
+
+	state.SetSessionVariable("application_name", "worker")
+	assert.Equal(t, [][]string{{"worker"}}, showRows(runShow(t, backend, state, "application_name")))
+}
+
+func TestShowAll(t *testing.T) {
+	state := handler.NewMultiGatewayConnectionState()
+	state.SetSessionVariable("application_name", "worker")
+
+	row := func(name, setting string) *sqltypes.Row {
+		return &sqltypes.Row{Values: []sqltypes.Value{sqltypes.Value(name), sqltypes.Value(setting), sqltypes.Value("")}}
+	}
+	// The backend streams its answer in several chunks.
+	backend := &mockIExecute{streamResults: []*sqltypes.Result{
+		{
+			Fields: []*query.Field{textField("name"), textField("setting"), textField("description")},
+			Rows:   []*sqltypes.Row{row("application_name", "other"), row("DateStyle", "ISO, MDY")},
+		},
+		{
+			Rows:       []*sqltypes.Row{row("server_version", "17.6"), row("work_mem", "4MB")},
+			CommandTag: "SHOW",
+		},
+	}}
+
+	results := runShow(t, backend, state, "ALL")
+	require.Len(t, results, 1)
+	assert.Equal(t, "SHOW", results[0].CommandTag)
+	require.Len(t, results[0].Fields, 3)
+
+	var settings [][]string
+	for _, r := range showRows(results) {
+		settings = append(settings, r[:2])
+	}
+	assert.Equal(t, [][]string{
+		{"application_name", "worker"},
+		{"DateStyle", "ISO, MDY"},
+		{"multigres.debug_errors", "off"},
+		{"multigres.max_replication_lag", "0"},
+		{"multigres.shard", "0-inf"},
+		{"multigres.tablegroup", "tg"},
+		{"multigres.target", "primary"},
+		{"server_version", server.ServerVersion},
+		{"server_version_num", server.ServerVersionNum},
+		{"work_mem", "4MB"},
+	}, settings)
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// Sort is a primitive that orders the rows of its input in memory. It is
+// used for the ORDER BY of queries whose rows are computed by the gateway,
+// such as aggregates combined across shards.
+type Sort struct {
+	// Input returns the rows to order.
+	Input Primitive
+
+	// OrderBy are the columns ordering the rows.
+	OrderBy []sqltypes.OrderByColumn
+
+	// Collations, when set, provides the collations ordering the text
+	// columns of OrderBy. They are ordered bytewise otherwise.
+	Collations *Collations
+}
+
+// NewSort creates a new Sort primitive.
+func NewSort(input Primitive, orderBy []sqltypes.OrderByColumn) *Sort {
+	return &Sort{
+		Input:   input,
+		OrderBy: orderBy,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (s *Sort) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	result, err := bufferResult(ctx, s.Input, exec, conn, state, 0)
+	if err != nil {
+		return err
+	}
+	collations, err := s.Collations.forOrderBy(ctx, exec, conn, state, result.Fields, s.OrderBy)
+	if err != nil {
+		return err
+	}
+	compare, err := sqltypes.NewCollatedRowComparator(result.Fields, s.OrderBy, collations)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(result.Rows, func(i, j int) bool {
+		return compare(result.Rows[i], result.Rows[j]) < 0
+	})
+	result.CommandTag = "SELECT " + strconv.Itoa(len(result.Rows))
+	return callback(ctx, result)
+}
+
+// GetTableGroup implements the Primitive interface.
+func (s *Sort) GetTableGroup() string {
+	return s.Input.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (s *Sort) GetQuery() string {
+	return s.Input.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (s *Sort) String() string {
+	return fmt.Sprintf("Sort(order_by=%s, input=%s)", formatOrderBy(s.OrderBy), s.Input)
+}
+
+// formatOrderBy formats the columns ordering rows for String.
+func formatOrderBy(orderBy []sqltypes.OrderByColumn) string {
+	terms := make([]string, len(orderBy))
+	for i, ob := range orderBy {
+		terms[i] = strconv.Itoa(ob.Column)
+		if ob.Desc {
+			terms[i] += " DESC"
+		}
+		// NULLS LAST is the default for ascending order, NULLS FIRST for
+		// descending order.
+		if ob.NullsFirst && !ob.Desc {
+			terms[i] += " NULLS FIRST"
+		} else if !ob.NullsFirst && ob.Desc {
+			terms[i] += " NULLS LAST"
+		}
+	}
+	return strings.Join(terms, ",")
+}
+
+// Ensure Sort implements Primitive interface.
+var _ Primitive = (*Sort)(nil)
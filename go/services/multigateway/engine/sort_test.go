@@ -0,0 +1,43 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestSortCombinedAggregates(t *testing.T) {
+	// SELECT region, count(*) GROUP BY region ORDER BY 2 DESC, 1.
+	fields := []*query.Field{field("region", ast.TEXTOID), field("count", ast.INT8OID)}
+	aggregate := NewScatterAggregate(NewScatterRoute("tg", []string{"-80", "80-"}, "SELECT ..."), []int{0}, []AggregateColumn{
+		{Kind: AggregateAny, Column: 0},
+		{Kind: AggregateCount, Column: 1},
+	})
+	sort := NewSort(aggregate, []sqltypes.OrderByColumn{{Column: 1, Desc: true, NullsFirst: true}, {Column: 0}})
+
+	result := runPrimitive(t, sort,
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"us", "2"}, []string{"eu", "9"}, []string{"ap", "1"})},
+		&sqltypes.Result{Fields: fields, Rows: textRows([]string{"us", "7"}, []string{"ap", "3"})},
+	)
+	assert.Equal(t, textRows([]string{"eu", "9"}, []string{"us", "9"}, []string{"ap", "4"}), result.Rows)
+	assert.Equal(t, "SELECT 3", result.CommandTag)
+	assert.Equal(t, "Sort(order_by=1 DESC,0, input="+aggregate.String()+")", sort.String())
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// TempTableUsage describes how a statement creates or drops temporary
+// relations (tables and views).
+type TempTableUsage struct {
+	// Creates lists the temporary relations the statement creates that
+	// outlive the current transaction. Relations created with ON COMMIT DROP
+	// are left out: they are gone by the end of the transaction.
+	Creates []string
+	// Drops lists the relations the statement drops. They may or may not be
+	// temporary; unknown names are ignored when tracking.
+	Drops []string
+	// DiscardsAll is set for DISCARD TEMP and DISCARD ALL, which drop every
+	// temporary relation of the session.
+	DiscardsAll bool
+}
+
+// Any returns true if the statement changes the session's temporary relations.
+func (u TempTableUsage) Any() bool {
+	return len(u.Creates) > 0 || len(u.Drops) > 0 || u.DiscardsAll
+}
+
+// DetectTempTables finds the temporary relations stmt creates or drops.
+// Temporary relations created in functions or DO blocks are not seen.
+func DetectTempTables(stmt ast.Stmt) TempTableUsage {
+	var usage TempTableUsage
+	switch s := stmt.(type) {
+	case *ast.CreateStmt:
+		if s.OnCommit != ast.ONCOMMIT_DROP {
+			usage.Creates = appendTempRelation(usage.Creates, s.Relation)
+		}
+	case *ast.CreateTableAsStmt:
+		usage.Creates = appendTempInto(usage.Creates, s.Into)
+	case *ast.SelectStmt:
+		usage.Creates = appendTempInto(usage.Creates, s.IntoClause)
+	case *ast.ViewStmt:
+		usage.Creates = appendTempRelation(usage.Creates, s.View)
+	case *ast.DropStmt:
+		switch s.RemoveType {
+		case ast.OBJECT_TABLE, ast.OBJECT_VIEW:
+			usage.Drops = droppedTempCandidates(s.Objects)
+		}
+	case *ast.DiscardStmt:
+		usage.DiscardsAll = s.Target == ast.DISCARD_TEMP || s.Target == ast.DISCARD_ALL
+	}
+	return usage
+}
+
+// appendTempInto appends the target of a SELECT INTO or CREATE TABLE AS
+// if it is a temporary table that outlives the transaction.
+func appendTempInto(names []string, into *ast.IntoClause) []string {
+	if into == nil || into.OnCommit == ast.ONCOMMIT_DROP {
+		return names
+	}
+	return appendTempRelation(names, into.Rel)
+}
+
+// appendTempRelation appends the name of rel if it is temporary, either
+// explicitly or because it is created in the pg_temp schema.
+func appendTempRelation(names []string, rel *ast.RangeVar) []string {
+	if rel == nil {
+		return names
+	}
+	if rel.RelPersistence != ast.RELPERSISTENCE_TEMP && !isTempSchema(rel.SchemaName) {
+		return names
+	}
+	return append(names, rel.RelName)
+}
+
+// droppedTempCandidates returns the names of the dropped relations that may
+// be temporary: unqualified names and names qualified with pg_temp.
+func droppedTempCandidates(objects *ast.NodeList) []string {
+	if objects == nil {
+		return nil
+	}
+	var names []string
+	for _, item := range objects.Items {
+		list, ok := item.(*ast.NodeList)
+		if !ok || len(list.Items) == 0 || len(list.Items) > 2 {
+			continue
+		}
+		var parts []string
+		for _, part := range list.Items {
+			s, ok := part.(*ast.String)
+			if !ok {
+				break
+			}
+			parts = append(parts, s.SVal)
+		}
+		switch {
+		case len(parts) == 1:
+			names = append(names, parts[0])
+		case len(parts) == 2 && isTempSchema(parts[0]):
+			names = append(names, parts[1])
+		}
+	}
+	return names
+}
+
+// isTempSchema returns true for pg_temp, the alias of the session's
+// temporary schema.
+func isTempSchema(schema string) bool {
+	return strings.EqualFold(schema, "pg_temp")
+}
+
+// ExecuteWithTempTables runs execute, a statement with the given temporary
+// relation usage, keeping the session pinned to its reserved connection for
+// as long as it has temporary relations.
+//
+// The session is pinned before a statement that creates temporary relations,
+// since they only exist on the backend that created them. Once execute
+// succeeds the created and dropped relations are recorded, and the pin is
+// dropped when none are left.
+func ExecuteWithTempTables(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage TempTableUsage,
+	execute func() error,
+) error {
+	if len(usage.Creates) > 0 {
+		if err := exec.PinForTempTables(ctx, conn, tableGroup, shard, state); err != nil {
+			return err
+		}
+	}
+
+	err := execute()
+	if err != nil {
+		// Nothing was created or dropped, but a new pin may be unneeded.
+		usage = TempTableUsage{}
+	}
+
+	if trackErr := exec.TrackTempTables(ctx, conn, tableGroup, shard, state, usage); err == nil {
+		err = trackErr
+	}
+	return err
+}
+
+// TempTableRoute is a Route for statements that create or drop temporary
+// relations. Temporary relations live on the backend connection that
+// created them, so the session is pinned to a reserved connection while
+// it has any; otherwise a later statement could land on another backend
+// and fail with "relation does not exist".
+type TempTableRoute struct {
+	TableGroup string
+	Shard      string
+	Query      string
+	Usage      TempTableUsage
+}
+
+// NewTempTableRoute creates a new TempTableRoute primitive.
+func NewTempTableRoute(tableGroup, shard, query string, usage TempTableUsage) *TempTableRoute {
+	return &TempTableRoute{
+		TableGroup: tableGroup,
+		Shard:      shard,
+		Query:      query,
+		Usage:      usage,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *TempTableRoute) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return ExecuteWithTempTables(ctx, exec, conn, r.TableGroup, r.Shard, state, r.Usage, func() error {
+		return exec.StreamExecute(ctx, conn, r.TableGroup, r.Shard, r.Query, state, callback)
+	})
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *TempTableRoute) GetTableGroup() string {
+	return r.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (r *TempTableRoute) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *TempTableRoute) String() string {
+	return fmt.Sprintf("TempTableRoute(tablegroup=%s, creates=%v, drops=%v, discards=%t, query=%s)",
+		r.TableGroup, r.Usage.Creates, r.Usage.Drops, r.Usage.DiscardsAll, r.Query)
+}
+
+// Ensure TempTableRoute implements Primitive interface.
+var _ Primitive = (*TempTableRoute)(nil)
@@ -0,0 +1,112 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestDetectTempTables(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected TempTableUsage
+	}{
+		{"SELECT 1", TempTableUsage{}},
+		{"CREATE TABLE t (a int)", TempTableUsage{}},
+		{"CREATE TEMP TABLE t (a int)", TempTableUsage{Creates: []string{"t"}}},
+		{"CREATE TEMPORARY TABLE IF NOT EXISTS t (a int)", TempTableUsage{Creates: []string{"t"}}},
+		{"CREATE TABLE pg_temp.t (a int)", TempTableUsage{Creates: []string{"t"}}},
+		{"CREATE TEMP TABLE t (a int) ON COMMIT DELETE ROWS", TempTableUsage{Creates: []string{"t"}}},
+		// ON COMMIT DROP tables do not outlive the transaction.
+		{"CREATE TEMP TABLE t (a int) ON COMMIT DROP", TempTableUsage{}},
+		{"CREATE TEMP TABLE t AS SELECT 1", TempTableUsage{Creates: []string{"t"}}},
+		{"SELECT 1 INTO TEMP t", TempTableUsage{Creates: []string{"t"}}},
+		{"CREATE TEMP VIEW v AS SELECT 1", TempTableUsage{Creates: []string{"v"}}},
+		{"DROP TABLE a, pg_temp.b, s.c", TempTableUsage{Drops: []string{"a", "b"}}},
+		{"DROP VIEW IF EXISTS v", TempTableUsage{Drops: []string{"v"}}},
+		{"DROP INDEX i", TempTableUsage{}},
+		{"DISCARD TEMP", TempTableUsage{DiscardsAll: true}},
+		{"DISCARD ALL", TempTableUsage{DiscardsAll: true}},
+		{"DISCARD PLANS", TempTableUsage{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmts, err := parser.ParseSQL(tt.sql)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			assert.Equal(t, tt.expected, DetectTempTables(stmts[0]))
+		})
+	}
+}
+
+func TestExecuteWithTempTables(t *testing.T) {
+	state := &handler.MultiGatewayConnectionState{}
+
+	t.Run("create pins before executing and tracks after", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		usage := TempTableUsage{Creates: []string{"t"}}
+		err := ExecuteWithTempTables(context.Background(), mockExec, nil, "tg", "", state, usage,
+			func() error {
+				assert.True(t, mockExec.pinTempCalled, "session must be pinned before the table is created")
+				assert.Nil(t, mockExec.trackedUsage)
+				return nil
+			})
+		require.NoError(t, err)
+		require.NotNil(t, mockExec.trackedUsage)
+		assert.Equal(t, usage, *mockExec.trackedUsage)
+	})
+
+	t.Run("failed statement tracks nothing", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithTempTables(context.Background(), mockExec, nil, "tg", "", state,
+			TempTableUsage{Creates: []string{"t"}},
+			func() error { return errors.New(`relation "t" already exists`) })
+		require.ErrorContains(t, err, "already exists")
+		require.NotNil(t, mockExec.trackedUsage)
+		assert.False(t, mockExec.trackedUsage.Any())
+	})
+
+	t.Run("pin failure skips execution", func(t *testing.T) {
+		mockExec := &mockIExecute{pinErr: errors.New("no pooler")}
+		executed := false
+		err := ExecuteWithTempTables(context.Background(), mockExec, nil, "tg", "", state,
+			TempTableUsage{Creates: []string{"t"}},
+			func() error {
+				executed = true
+				return nil
+			})
+		require.ErrorContains(t, err, "no pooler")
+		assert.False(t, executed)
+	})
+
+	t.Run("drop does not pin", func(t *testing.T) {
+		mockExec := &mockIExecute{}
+		err := ExecuteWithTempTables(context.Background(), mockExec, nil, "tg", "", state,
+			TempTableUsage{Drops: []string{"t"}},
+			func() error { return nil })
+		require.NoError(t, err)
+		assert.False(t, mockExec.pinTempCalled)
+		require.NotNil(t, mockExec.trackedUsage)
+	})
+}
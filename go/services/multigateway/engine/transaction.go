@@ -0,0 +1,262 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// TransactionControl runs a transaction control statement (BEGIN, COMMIT,
+// ROLLBACK, SAVEPOINT, ...) through its Input primitive, then records the
+// transaction boundary in the session state, so that the session variables
+// changed in a transaction that rolls back are reverted.
+//
+// Unlike a Sequence, the boundary is also recorded when the statement fails
+// where PostgreSQL still ends the transaction: a failed COMMIT rolls back.
+// A COMMIT of a failed transaction block rolls back as well.
+type TransactionControl struct {
+	Kind          ast.TransactionStmtKind
+	SavepointName string
+	// Options are the transaction modes of a BEGIN or START TRANSACTION.
+	Options *ast.NodeList
+	Input   Primitive
+}
+
+// NewTransactionControl creates a new TransactionControl primitive.
+func NewTransactionControl(stmt *ast.TransactionStmt, input Primitive) *TransactionControl {
+	return &TransactionControl{
+		Kind:          stmt.Kind,
+		SavepointName: stmt.SavepointName,
+		Options:       stmt.Options,
+		Input:         input,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (t *TransactionControl) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return ExecuteTransactionControl(state, t.Kind, t.SavepointName, t.Options,
+		func() error {
+			return t.Input.StreamExecute(ctx, exec, conn, state, callback)
+		},
+		func() error {
+			return NewRollbackRoute(t.Input.GetTableGroup(), "").StreamExecute(ctx, exec, conn, state, callback)
+		})
+}
+
+// ExecuteTransactionControl runs execute, a transaction control statement of
+// the given kind, then records the transaction boundary in the session state.
+//
+// A COMMIT of a failed transaction block runs rollback instead, as PostgreSQL
+// does: the block may have been failed by the gateway, without the backend
+// knowing that the transaction must not commit.
+//
+// A BEGIN opening a read-only transaction in a session targeting replicas
+// marks the block as running on replicas before it runs, so that the BEGIN
+// and every statement of the block go there (see CanRunOnReplica).
+func ExecuteTransactionControl(
+	state *handler.MultiGatewayConnectionState,
+	kind ast.TransactionStmtKind,
+	savepointName string,
+	options *ast.NodeList,
+	execute func() error,
+	rollback func() error,
+) error {
+	if kind == ast.TRANS_STMT_COMMIT && state.TransactionStatus() == handler.TransactionFailed {
+		execute = rollback
+	}
+	opening := (kind == ast.TRANS_STMT_BEGIN || kind == ast.TRANS_STMT_START) && !state.InTransaction()
+	if opening && state.TargetsReplicas() && CanRunOnReplica(state, options) {
+		state.SetReplicaTransaction(true)
+	}
+	err := execute()
+
+	switch kind {
+	case ast.TRANS_STMT_BEGIN, ast.TRANS_STMT_START:
+		if err == nil {
+			state.BeginTransaction()
+		} else if opening {
+			state.SetReplicaTransaction(false)
+		}
+	case ast.TRANS_STMT_COMMIT, ast.TRANS_STMT_PREPARE:
+		// A failed COMMIT or PREPARE TRANSACTION rolls back. Once prepared,
+		// the transaction is detached from the session, which keeps its
+		// variables as if it had committed.
+		state.EndTransaction(err == nil)
+	case ast.TRANS_STMT_ROLLBACK:
+		state.EndTransaction(false)
+	case ast.TRANS_STMT_SAVEPOINT:
+		if err == nil {
+			state.Savepoint(savepointName)
+		}
+	case ast.TRANS_STMT_ROLLBACK_TO:
+		if err == nil {
+			state.RollbackToSavepoint(savepointName)
+		}
+	case ast.TRANS_STMT_RELEASE:
+		if err == nil {
+			state.ReleaseSavepoint(savepointName)
+		}
+	}
+	return err
+}
+
+// CanRunOnReplica returns true if the transaction a BEGIN or START
+// TRANSACTION with the given transaction modes opens may run on a replica:
+// it is read-only, with READ ONLY or by the session's
+// default_transaction_read_only, and not serializable, which hot standbys
+// refuse.
+func CanRunOnReplica(state *handler.MultiGatewayConnectionState, options *ast.NodeList) bool {
+	readOnly := sessionBool(state, "default_transaction_read_only")
+	isolation, _ := state.GetSessionVariable("default_transaction_isolation")
+	if options != nil {
+		for _, item := range options.Items {
+			option, ok := item.(*ast.DefElem)
+			if !ok {
+				continue
+			}
+			switch option.Defname {
+			case "transaction_read_only":
+				if b, ok := option.Arg.(*ast.Boolean); ok {
+					readOnly = b.BoolVal
+				}
+			case "transaction_isolation":
+				if s, ok := option.Arg.(*ast.String); ok {
+					isolation = s.SVal
+				}
+			}
+		}
+	}
+	return readOnly && !strings.EqualFold(strings.TrimSpace(isolation), "serializable")
+}
+
+// sessionBool returns the value of a boolean parameter of the session, or
+// false if it is not set or not a boolean.
+func sessionBool(state *handler.MultiGatewayConnectionState, name string) bool {
+	value, ok := state.GetSessionVariable(name)
+	if !ok {
+		return false
+	}
+	b, _ := constBool(&ast.A_Const{Val: ast.NewString(value)})
+	return b
+}
+
+// NewRollbackRoute creates the primitive rolling back the session's
+// transaction in place of a COMMIT of a failed transaction block. It closes
+// the cursors of the transaction, as the COMMIT would have.
+func NewRollbackRoute(tableGroup, shard string) *EndTransactionRoute {
+	return NewEndTransactionRoute(tableGroup, shard, "ROLLBACK", false)
+}
+
+// NewTransactionStmtRoute creates the primitive running a transaction
+// control statement: an EndTransactionRoute for COMMIT and ROLLBACK, a
+// SavepointRoute for the statements acting on savepoints, a CursorRoute
+// for PREPARE TRANSACTION, which closes cursors, and a Route otherwise.
+func NewTransactionStmtRoute(tableGroup, shard, sql string, stmt *ast.TransactionStmt) Primitive {
+	switch {
+	case stmt.Kind == ast.TRANS_STMT_COMMIT:
+		return NewEndTransactionRoute(tableGroup, shard, sql, true)
+	case stmt.Kind == ast.TRANS_STMT_ROLLBACK:
+		return NewEndTransactionRoute(tableGroup, shard, sql, false)
+	case IsSavepointStmt(stmt.Kind):
+		return NewSavepointRoute(tableGroup, shard, sql, stmt)
+	}
+	if usage := DetectCursors(stmt); usage.Any() {
+		return NewCursorRoute(tableGroup, shard, sql, usage)
+	}
+	return NewRoute(tableGroup, shard, sql)
+}
+
+// EndTransactionRoute runs a COMMIT or ROLLBACK, on every shard
+// participating in the session's transaction. Ending the transaction closes
+// its cursors, as a CursorRoute would.
+type EndTransactionRoute struct {
+	TableGroup string
+	Shard      string
+	Query      string
+	Commit     bool
+}
+
+// NewEndTransactionRoute creates a new EndTransactionRoute primitive.
+func NewEndTransactionRoute(tableGroup, shard, query string, commit bool) *EndTransactionRoute {
+	return &EndTransactionRoute{
+		TableGroup: tableGroup,
+		Shard:      shard,
+		Query:      query,
+		Commit:     commit,
+	}
+}
+
+// StreamExecute implements the Primitive interface.
+func (r *EndTransactionRoute) StreamExecute(
+	ctx context.Context,
+	exec IExecute,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	return ExecuteWithCursors(ctx, exec, conn, r.TableGroup, r.Shard, state, CursorUsage{EndsTransaction: true},
+		func() error {
+			return exec.EndTransaction(ctx, conn, r.TableGroup, r.Shard, state, r.Commit, r.Query, callback)
+		})
+}
+
+// GetTableGroup implements the Primitive interface.
+func (r *EndTransactionRoute) GetTableGroup() string {
+	return r.TableGroup
+}
+
+// GetQuery implements the Primitive interface.
+func (r *EndTransactionRoute) GetQuery() string {
+	return r.Query
+}
+
+// String implements the Primitive interface.
+func (r *EndTransactionRoute) String() string {
+	return fmt.Sprintf("EndTransactionRoute(tablegroup=%s, commit=%t, query=%s)", r.TableGroup, r.Commit, r.Query)
+}
+
+// Ensure EndTransactionRoute implements Primitive interface.
+var _ Primitive = (*EndTransactionRoute)(nil)
+
+// GetTableGroup implements the Primitive interface.
+func (t *TransactionControl) GetTableGroup() string {
+	return t.Input.GetTableGroup()
+}
+
+// GetQuery implements the Primitive interface.
+func (t *TransactionControl) GetQuery() string {
+	return t.Input.GetQuery()
+}
+
+// String implements the Primitive interface.
+func (t *TransactionControl) String() string {
+	return fmt.Sprintf("TransactionControl(kind=%s, input=%s)", t.Kind, t.Input.String())
+}
+
+// Ensure TransactionControl implements Primitive interface.
+var _ Primitive = (*TransactionControl)(nil)
@@ -0,0 +1,191 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// stubPrimitive is a Primitive whose execution streams results, then
+// returns err.
+type stubPrimitive struct {
+	results []*sqltypes.Result
+	err     error
+}
+
+func (s *stubPrimitive) StreamExecute(
+	ctx context.Context, _ IExecute, _ *server.Conn, _ *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	for _, result := range s.results {
+		if err := callback(ctx, result); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+func (s *stubPrimitive) GetTableGroup() string { return "tg" }
+func (s *stubPrimitive) GetQuery() string      { return "" }
+func (s *stubPrimitive) String() string        { return "stub" }
+
+// runTransactionStmt runs sql, a transaction control statement, as a
+// TransactionControl over an input failing with err.
+func runTransactionStmt(t *testing.T, err error, state *handler.MultiGatewayConnectionState, sql string) error {
+	t.Helper()
+	stmts, parseErr := parser.ParseSQL(sql)
+	require.NoError(t, parseErr)
+	require.Len(t, stmts, 1)
+	stmt, ok := stmts[0].(*ast.TransactionStmt)
+	require.True(t, ok)
+	tc := NewTransactionControl(stmt, &stubPrimitive{err: err})
+	return tc.StreamExecute(context.Background(), &mockIExecute{}, nil, state, nil)
+}
+
+func TestTransactionControl(t *testing.T) {
+	t.Run("rollback reverts variables set in the transaction", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		state.SetSessionVariable("search_path", "before")
+
+		require.NoError(t, runTransactionStmt(t, nil, state, "BEGIN"))
+		state.SetSessionVariable("search_path", "during")
+		require.NoError(t, runTransactionStmt(t, nil, state, "ROLLBACK"))
+
+		assert.Equal(t, map[string]string{"search_path": "before"}, state.GetSessionSettings())
+	})
+
+	t.Run("commit keeps variables set in the transaction", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		require.NoError(t, runTransactionStmt(t, nil, state, "START TRANSACTION"))
+		state.SetSessionVariable("search_path", "during")
+		require.NoError(t, runTransactionStmt(t, nil, state, "COMMIT"))
+
+		assert.Equal(t, map[string]string{"search_path": "during"}, state.GetSessionSettings())
+	})
+
+	t.Run("failed commit rolls back", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		require.NoError(t, runTransactionStmt(t, nil, state, "BEGIN"))
+		state.SetSessionVariable("search_path", "during")
+
+		require.Error(t, runTransactionStmt(t, errors.New("deferred constraint violated"), state, "COMMIT"))
+
+		assert.Nil(t, state.GetSessionSettings())
+	})
+
+	t.Run("rollback to savepoint", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		require.NoError(t, runTransactionStmt(t, nil, state, "BEGIN"))
+		state.SetSessionVariable("search_path", "a")
+		require.NoError(t, runTransactionStmt(t, nil, state, "SAVEPOINT s"))
+		state.SetSessionVariable("search_path", "b")
+		require.NoError(t, runTransactionStmt(t, nil, state, "ROLLBACK TO SAVEPOINT s"))
+
+		value, _ := state.GetSessionVariable("search_path")
+		assert.Equal(t, "a", value)
+	})
+
+	t.Run("failed begin does not start a transaction", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		require.Error(t, runTransactionStmt(t, errors.New("connection lost"), state, "BEGIN"))
+
+		// SET LOCAL outside a transaction block has no effect.
+		state.SetLocalVariable("search_path", "local")
+		_, found := state.GetSessionVariable("search_path")
+		assert.False(t, found)
+	})
+
+	t.Run("commit of a failed transaction block rolls back", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		require.NoError(t, runTransactionStmt(t, nil, state, "BEGIN"))
+		state.SetSessionVariable("search_path", "during")
+		state.FailTransaction()
+
+		stmts, err := parser.ParseSQL("COMMIT")
+		require.NoError(t, err)
+		exec := &mockIExecute{}
+		// The input would fail if the COMMIT reached it.
+		tc := NewTransactionControl(stmts[0].(*ast.TransactionStmt), &stubPrimitive{err: errors.New("unexpected commit")})
+		require.NoError(t, tc.StreamExecute(context.Background(), exec, nil, state, nil))
+
+		assert.Equal(t, []string{"ROLLBACK"}, exec.queries)
+		require.NotNil(t, exec.trackedCursorsUsage)
+		assert.True(t, exec.trackedCursorsUsage.EndsTransaction)
+		assert.Equal(t, handler.TransactionIdle, state.TransactionStatus())
+		assert.Nil(t, state.GetSessionSettings())
+	})
+
+	t.Run("rollback to savepoint recovers a failed transaction block", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		require.NoError(t, runTransactionStmt(t, nil, state, "BEGIN"))
+		require.NoError(t, runTransactionStmt(t, nil, state, "SAVEPOINT s"))
+		state.FailTransaction()
+		assert.Equal(t, handler.TransactionFailed, state.TransactionStatus())
+
+		require.NoError(t, runTransactionStmt(t, nil, state, "ROLLBACK TO s"))
+		assert.Equal(t, handler.TransactionInBlock, state.TransactionStatus())
+	})
+}
+
+func TestTransactionControl_ReplicaTransaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		variables map[string]string
+		sql       string
+		want      bool
+	}{
+		{name: "read only", target: "replica", sql: "BEGIN READ ONLY", want: true},
+		{name: "read only start", target: "replica", sql: "START TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY", want: true},
+		{name: "session default", target: "replica", variables: map[string]string{"default_transaction_read_only": "on"}, sql: "BEGIN", want: true},
+		{name: "read write overrides default", target: "replica", variables: map[string]string{"default_transaction_read_only": "on"}, sql: "BEGIN READ WRITE"},
+		{name: "read write", target: "replica", sql: "BEGIN"},
+		{name: "serializable", target: "replica", sql: "BEGIN ISOLATION LEVEL SERIALIZABLE READ ONLY"},
+		{name: "serializable default", target: "replica", variables: map[string]string{"default_transaction_isolation": "serializable"}, sql: "BEGIN READ ONLY"},
+		{name: "targeting primary", target: "primary", sql: "BEGIN READ ONLY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := handler.NewMultiGatewayConnectionState()
+			state.SetSessionVariable(handler.TargetVariable, tt.target)
+			for name, value := range tt.variables {
+				state.SetSessionVariable(name, value)
+			}
+			require.NoError(t, runTransactionStmt(t, nil, state, tt.sql))
+			assert.Equal(t, tt.want, state.ReplicaTransaction())
+
+			require.NoError(t, runTransactionStmt(t, nil, state, "COMMIT"))
+			assert.False(t, state.ReplicaTransaction())
+		})
+	}
+
+	t.Run("failed begin", func(t *testing.T) {
+		state := handler.NewMultiGatewayConnectionState()
+		state.SetSessionVariable(handler.TargetVariable, "replica")
+		require.Error(t, runTransactionStmt(t, errors.New("no replica"), state, "BEGIN READ ONLY"))
+		assert.False(t, state.ReplicaTransaction())
+	})
+}
@@ -16,16 +16,24 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/common/preparedstatement"
+	"github.com/multigres/multigres/go/common/queryservice"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	"github.com/multigres/multigres/go/pb/query"
 	"github.com/multigres/multigres/go/services/multigateway/engine"
 	"github.com/multigres/multigres/go/services/multigateway/handler"
 	"github.com/multigres/multigres/go/services/multigateway/planner"
+	"github.com/multigres/multigres/go/tools/telemetry"
 )
 
 const (
@@ -44,19 +52,159 @@ type Executor struct {
 	planner *planner.Planner
 	exec    engine.IExecute
 	logger  *slog.Logger
+
+	// maxResultSize is the maximum number of bytes a single query may
+	// accumulate in the gateway. Zero means unlimited.
+	maxResultSize int64
+
+	// resultCache holds the results of the reads opting in to caching, or
+	// is nil if results are not cached.
+	resultCache *engine.ResultCache
+
+	// metrics records the queries, if set.
+	metrics *Metrics
+
+	// slowQueries keeps the queries exceeding its threshold, or is nil if
+	// they are not logged.
+	slowQueries *SlowQueryLog
+
+	// queryStats aggregates the statistics of the queries by normalized
+	// text, or is nil if none are kept.
+	queryStats *engine.QueryStats
+
+	// throttler sheds statements while the backends are overloaded, or is
+	// nil if none are shed.
+	throttler *Throttler
+
+	// passthrough relays the rows of the plans routed to a single shard to
+	// the client without decoding them.
+	passthrough bool
 }
 
 // NewExecutor creates a new executor instance.
 // The IExecute parameter provides the execution backend (typically ScatterConn).
 // This dependency injection pattern makes testing much easier.
-func NewExecutor(exec engine.IExecute, logger *slog.Logger) *Executor {
+// maxResultSize caps the bytes of the rows of several shards a single query
+// may buffer in the gateway (0 = unlimited); the rows streamed from a
+// single shard are not buffered, and not limited.
+func NewExecutor(exec engine.IExecute, logger *slog.Logger, maxResultSize int64) *Executor {
+	p := planner.NewPlanner(DefaultTableGroup, logger)
+	p.SetMemoryLimits(maxResultSize, 0)
 	return &Executor{
-		planner: planner.NewPlanner(DefaultTableGroup, logger),
-		exec:    exec,
-		logger:  logger,
+		planner:       p,
+		exec:          exec,
+		logger:        logger,
+		maxResultSize: maxResultSize,
 	}
 }
 
+// SetPlanCache sets the cache of the plans of the simple queries, or
+// disables caching if cache is nil.
+func (e *Executor) SetPlanCache(cache *planner.PlanCache) {
+	e.planner.SetPlanCache(cache)
+}
+
+// SetMetrics sets the metrics the executor records its queries in.
+func (e *Executor) SetMetrics(metrics *Metrics) {
+	e.metrics = metrics
+}
+
+// SetSlowQueryLog sets the log of the slow queries, or stops logging them
+// if log is nil.
+func (e *Executor) SetSlowQueryLog(log *SlowQueryLog) {
+	e.slowQueries = log
+}
+
+// SetQueryStats sets the statistics the queries are aggregated in, also
+// answering SELECTs of multigres.stat_statements, or stops keeping them if
+// stats is nil.
+func (e *Executor) SetQueryStats(stats *engine.QueryStats) {
+	e.queryStats = stats
+	e.planner.SetQueryStats(stats)
+}
+
+// SetThrottler sets the throttler shedding statements while the backends
+// are overloaded, or disables throttling if throttler is nil.
+func (e *Executor) SetThrottler(throttler *Throttler) {
+	e.throttler = throttler
+}
+
+// SetPassthrough sets whether the rows of simple queries whose plan is a
+// single Route are relayed to the client as the pooler packs them (see
+// queryservice.WithPassthrough), saving their decoding in the gateway.
+func (e *Executor) SetPassthrough(enabled bool) {
+	e.passthrough = enabled
+}
+
+// SetSpillThreshold sets the number of bytes beyond which the duplicate
+// rows of queries on several shards are removed on disk rather than in
+// memory, or disables spilling if zero.
+func (e *Executor) SetSpillThreshold(spillThreshold int64) {
+	e.planner.SetMemoryLimits(e.maxResultSize, spillThreshold)
+}
+
+// SetShardingSchema sets how the tables of the default tablegroup are
+// sharded (see planner.Planner.SetShardingSchema).
+func (e *Executor) SetShardingSchema(schema *planner.ShardingSchema) {
+	e.planner.SetShardingSchema(schema)
+}
+
+// Shards returns the shards of the default tablegroup in the sharding
+// schema (see planner.Planner.Shards).
+func (e *Executor) Shards() []string {
+	return e.planner.Shards()
+}
+
+// SetRoutingRules sets the routing rules of the tables of database (see
+// planner.Planner.SetRoutingRules).
+func (e *Executor) SetRoutingRules(database string, rules *clustermetadatapb.RoutingRules) {
+	e.planner.SetRoutingRules(database, rules)
+}
+
+// SetTables replaces the tables of database known to the planner (see
+// planner.Planner.SetTables).
+func (e *Executor) SetTables(database string, tables planner.TableDefinitions) {
+	e.planner.SetTables(database, tables)
+}
+
+// UpdateTables changes the tables of database known to the planner (see
+// planner.Planner.UpdateTables).
+func (e *Executor) UpdateTables(database string, changed planner.TableDefinitions, dropped []string) {
+	e.planner.UpdateTables(database, changed, dropped)
+}
+
+// TableColumns returns the columns of a table of database known to the
+// planner (see planner.Planner.TableColumns).
+func (e *Executor) TableColumns(database, schema, table string) ([]string, bool) {
+	return e.planner.TableColumns(database, schema, table)
+}
+
+// SetGlobalSequences sets the global sequences generating the columns of
+// sharded tables.
+func (e *Executor) SetGlobalSequences(sequences *engine.GlobalSequences) {
+	e.planner.SetGlobalSequences(sequences)
+}
+
+// SetDDLJournal sets the journal recording the progress of the schema
+// changes of sharded tables, and what they do when a shard fails them.
+func (e *Executor) SetDDLJournal(journal *engine.DDLJournal, policy engine.DDLFailurePolicy) {
+	e.planner.SetDDLJournal(journal, policy)
+}
+
+// SetResultCache sets the cache of the results of reads, or disables
+// caching if cache is nil (see planner.Planner.SetResultCache). The writes
+// run through the executor invalidate the results of the tables they write.
+func (e *Executor) SetResultCache(cache *engine.ResultCache, ttl time.Duration, tables map[string]time.Duration) {
+	e.resultCache = cache
+	e.planner.SetResultCache(cache, ttl, tables)
+}
+
+// TableShards returns the shards holding the table name, in the order
+// schema changes run on them.
+func (e *Executor) TableShards(name string) []string {
+	return e.planner.TableShards(name)
+}
+
 // StreamExecute executes a query and streams results back via the callback function.
 // This method will eventually route queries to multipooler via gRPC.
 //
@@ -76,8 +224,18 @@ func (e *Executor) StreamExecute(
 		"database", conn.Database(),
 		"connection_id", conn.ConnectionID())
 
+	if err := state.CheckTransactionNotFailed(astStmt); err != nil {
+		return err
+	}
+
 	// Step 1: Plan the query (now with AST for better analysis)
+	ctx, timing := e.startTiming(ctx)
+	_, span := telemetry.Tracer().Start(ctx, "multigateway/plan")
 	plan, err := e.planner.Plan(queryStr, astStmt, conn)
+	telemetry.EndSpan(span, err)
+	if timing != nil {
+		timing.Planning = time.Since(timing.Start)
+	}
 	if err != nil {
 		e.logger.ErrorContext(ctx, "query planning failed",
 			"query", queryStr,
@@ -90,8 +248,74 @@ func (e *Executor) StreamExecute(
 		"tablegroup", plan.GetTableGroup())
 
 	// Step 2: Execute the plan
+	return e.executePlan(ctx, conn, state, queryStr, plan, callback)
+}
+
+// StreamExecuteCached executes a query string from the plan cached for its
+// normalized text, without parsing or planning it. It returns false, having
+// run nothing, if no plan is cached; the query must then be parsed and run
+// with StreamExecute or StreamExecuteMulti.
+func (e *Executor) StreamExecuteCached(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	queryStr string,
+	callback func(ctx context.Context, res *sqltypes.Result) error,
+) (bool, error) {
+	ctx, timing := e.startTiming(ctx)
+	plan, astStmt, ok := e.planner.CachedPlan(queryStr, conn)
+	if !ok {
+		return false, nil
+	}
+	if timing != nil {
+		timing.Planning = time.Since(timing.Start)
+	}
+
+	e.logger.DebugContext(ctx, "executing cached plan",
+		"query", queryStr,
+		"plan", plan.String(),
+		"connection_id", conn.ConnectionID())
+
+	if err := state.CheckTransactionNotFailed(astStmt); err != nil {
+		return true, err
+	}
+	return true, e.executePlan(ctx, conn, state, queryStr, plan, callback)
+}
+
+// executePlan executes the plan of a statement. The plans of the statements
+// changing the schema invalidate the cached plans of the database.
+func (e *Executor) executePlan(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	queryStr string,
+	plan *engine.Plan,
+	callback func(ctx context.Context, res *sqltypes.Result) error,
+) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("plan_type", plan.Type()),
+		attribute.String("tablegroup", plan.GetTableGroup()))
+	if err := e.throttler.check(plan.Class, state); err != nil {
+		e.metrics.recordThrottled(ctx, conn.Database())
+		return err
+	}
+
+	// The results of a plan that is a single Route go to the client as they
+	// are, so their rows need not be decoded.
+	if _, ok := plan.Primitive.(*engine.Route); ok && e.passthrough {
+		ctx = queryservice.WithPassthrough(ctx)
+	}
+
 	// Pass the IExecute implementation to the plan, which will pass it to the primitive
-	err = plan.StreamExecute(ctx, e.exec, conn, state, callback)
+	start := time.Now()
+	var rows int64
+	err := plan.StreamExecute(ctx, e.exec, conn, state, countRows(callback, &rows))
+	e.metrics.recordQuery(ctx, plan.Type(), conn.Database(), plan.GetTableGroup(), time.Since(start), err)
+	e.endTiming(ctx, conn, queryStr, plan.Type(), rows, err)
+	if plan.Class == engine.StatementDDL {
+		e.planner.InvalidateDatabase(conn.Database())
+	}
+	e.invalidateResults(state, plan.Class, plan.Tables)
 	if err != nil {
 		e.logger.ErrorContext(ctx, "query execution failed",
 			"query", queryStr,
@@ -107,6 +331,75 @@ func (e *Executor) StreamExecute(
 	return nil
 }
 
+// StreamExecuteMulti executes the statements of a simple query message that
+// holds several, in order, stopping at the first failure. Each statement is
+// planned and routed on its own, and produces its own results.
+//
+// As in PostgreSQL, the statements run in an implicit transaction, so a
+// failure rolls back the ones before it. This is left to the statements when
+// the session is already in a transaction block, or when they include
+// transaction control statements of their own.
+func (e *Executor) StreamExecuteMulti(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	queryStr string,
+	stmts []*ast.RawStmt,
+	callback func(ctx context.Context, res *sqltypes.Result) error,
+) error {
+	for i, stmt := range stmts {
+		if stmt.StmtLocation < 0 || stmt.StmtLen < 0 || stmt.StmtLocation+stmt.StmtLen > len(queryStr) {
+			return fmt.Errorf("statement %d at %d of length %d is out of range of the %d byte query",
+				i+1, stmt.StmtLocation, stmt.StmtLen, len(queryStr))
+		}
+	}
+
+	implicit := !state.InTransaction() && !hasTransactionControl(stmts)
+	if implicit {
+		// Like an explicit transaction block, each shard joins the implicit
+		// one when a statement first reaches it.
+		state.BeginImplicitTransaction()
+	}
+
+	var err error
+	for _, stmt := range stmts {
+		sql := queryStr[stmt.StmtLocation : stmt.StmtLocation+stmt.StmtLen]
+		if err = e.StreamExecute(ctx, conn, state, sql, stmt.Stmt, callback); err != nil {
+			break
+		}
+	}
+
+	if implicit {
+		// It then ends on every shard that joined, as a COMMIT or ROLLBACK
+		// would, with two-phase commit if the transaction mode asks for it.
+		if len(state.TransactionParticipants()) > 0 {
+			sql := "ROLLBACK"
+			if err == nil {
+				sql = "COMMIT"
+			}
+			endErr := e.exec.EndTransaction(ctx, conn, e.planner.GetDefaultTableGroup(), "", state, err == nil, sql,
+				func(context.Context, *sqltypes.Result) error { return nil })
+			if err == nil {
+				err = endErr
+			}
+		}
+		state.EndTransaction(err == nil)
+		e.invalidateResults(state, engine.StatementUtility, nil)
+	}
+	return err
+}
+
+// hasTransactionControl returns true if any of stmts is a transaction
+// control statement (BEGIN, COMMIT, SAVEPOINT, ...).
+func hasTransactionControl(stmts []*ast.RawStmt) bool {
+	for _, stmt := range stmts {
+		if stmt.Stmt.NodeTag() == ast.T_TransactionStmt {
+			return true
+		}
+	}
+	return false
+}
+
 // PortalStreamExecute executes a portal and streams results back via the callback function.
 func (e *Executor) PortalStreamExecute(
 	ctx context.Context,
@@ -115,7 +408,7 @@ func (e *Executor) PortalStreamExecute(
 	portalInfo *preparedstatement.PortalInfo,
 	maxRows int32,
 	callback func(ctx context.Context, res *sqltypes.Result) error,
-) error {
+) (err error) {
 	e.logger.DebugContext(ctx, "executing portal",
 		"portal", portalInfo.Portal.Name,
 		"max_rows", maxRows,
@@ -123,12 +416,186 @@ func (e *Executor) PortalStreamExecute(
 		"database", conn.Database(),
 		"connection_id", conn.ConnectionID())
 
-	// TODO: We will need to plan the query to find wether it can
-	// be served by a single shard or not. For now, since we only
-	// support unsharded, we don't have to do much.
-	// We just send the query to the default table group.
+	if err := state.CheckTransactionNotFailed(portalInfo.AST()); err != nil {
+		return err
+	}
+	ctx, timing := e.startTiming(ctx)
+	var rows int64
+	callback = countRows(callback, &rows)
+	defer func() { e.endTiming(ctx, conn, portalInfo.PreparedStatement.Query, "Portal", rows, err) }()
+	// Portals bypass the planner, so the statement is classified here for
+	// the routing of reads to replicas.
+	class := planner.Classify(portalInfo.AST())
+	ctx = engine.WithStatementClass(ctx, class)
+	if err := e.throttler.check(class, state); err != nil {
+		e.metrics.recordThrottled(ctx, conn.Database())
+		return err
+	}
+	// They also take the shard and target directives of their statement
+	// here (see handler.QueryDirectives).
+	directives, err := handler.ParseQueryDirectives(portalInfo.PreparedStatement.Query)
+	if err != nil {
+		return err
+	}
+	if directives.Target != clustermetadatapb.PoolerType_UNKNOWN {
+		ctx = engine.WithPoolerType(ctx, directives.Target)
+	}
+
+	// The multipooler binds the portal on the backend with the result
+	// formats of the client's Bind, so the rows come back in the formats
+	// the client requested and are passed through as they are.
+	tableGroup := e.planner.GetDefaultTableGroup()
+	if e.resultCache != nil {
+		// Writes invalidate the cached results of the tables they write.
+		stmt := portalInfo.AST()
+		defer e.invalidateResults(state, planner.Classify(stmt), planner.QualifiedTables(stmt))
+	}
+	if stmt, ok := portalInfo.AST().(*ast.TransactionStmt); ok {
+		// Transaction control statements may need to reach every shard of
+		// the transaction; they take no parameters, so the query runs as is.
+		route := engine.NewTransactionStmtRoute(tableGroup, "", portalInfo.PreparedStatement.Query, stmt)
+		return engine.ExecuteTransactionControl(state, stmt.Kind, stmt.SavepointName, stmt.Options,
+			func() error {
+				return route.StreamExecute(ctx, e.exec, conn, state, callback)
+			},
+			func() error {
+				return engine.NewRollbackRoute(tableGroup, "").StreamExecute(ctx, e.exec, conn, state, callback)
+			})
+	}
+	execute := func() error {
+		return e.exec.PortalStreamExecute(ctx, tableGroup, directives.Shard, conn, state, portalInfo, maxRows, callback)
+	}
+
+	// Portals bypass the planner, so session-level advisory locks,
+	// temporary relations and cursors are detected here.
+	if usage := engine.DetectAdvisoryLocks(portalInfo.AST()); usage.Any() {
+		return engine.ExecuteWithAdvisoryLocks(ctx, e.exec, conn, tableGroup, directives.Shard, state, usage, execute)
+	}
+	if usage := engine.DetectTempTables(portalInfo.AST()); usage.Any() {
+		return engine.ExecuteWithTempTables(ctx, e.exec, conn, tableGroup, directives.Shard, state, usage, execute)
+	}
+	if usage := engine.DetectCursors(portalInfo.AST()); usage.Any() {
+		return engine.ExecuteWithCursors(ctx, e.exec, conn, tableGroup, directives.Shard, state, usage, execute)
+	}
+
+	shard := directives.Shard
+	if shard == "" {
+		// The statement is planned with the values of its parameters, like
+		// a simple query, to find the shard it runs on.
+		stmt, complete := boundStatement(portalInfo)
+		plan, err := e.planner.Plan(stmt.SqlString(), stmt, conn)
+		if timing != nil {
+			timing.Planning = time.Since(timing.Start)
+		}
+		if err != nil {
+			return err
+		}
+		route, ok := portalRoute(plan)
+		switch {
+		case ok:
+			tableGroup, shard = route.TableGroup, route.Shard
+		case complete && maxRows == 0:
+			// Plans that do not run the statement as it is on a single
+			// shard run the bound statement, as a simple query would.
+			return e.executeBoundPortal(ctx, conn, state, portalInfo, plan, callback)
+		case e.planner.ReferencesShardedTables(stmt):
+			return errPortalOnSeveralShards(complete)
+		default:
+			shard = e.planner.UnshardedShard()
+		}
+	}
+	return e.exec.PortalStreamExecute(ctx, tableGroup, shard, conn, state, portalInfo, maxRows, callback)
+}
+
+// startTiming starts timing a query received now, if slow queries are
+// logged or statistics kept. Returns the context recording the timing of
+// the query, and the timing, or ctx and nil if the query is not timed.
+func (e *Executor) startTiming(ctx context.Context) (context.Context, *engine.QueryTiming) {
+	if e.slowQueries == nil && e.queryStats == nil {
+		return ctx, nil
+	}
+	timing := engine.NewQueryTiming()
+	return engine.WithQueryTiming(ctx, timing), timing
+}
+
+// endTiming adds the query timed in ctx, which returned or affected rows,
+// to the statistics, and logs it if it took longer than the threshold of
+// the slow query log.
+func (e *Executor) endTiming(ctx context.Context, conn *server.Conn, queryStr, planType string, rows int64, err error) {
+	timing := engine.QueryTimingFromContext(ctx)
+	if timing == nil {
+		return
+	}
+	duration := time.Since(timing.Start)
+	if e.queryStats != nil {
+		fingerprint := planner.NormalizeSQL(queryStr)
+		if fingerprint == "" {
+			fingerprint = queryStr
+		}
+		key := engine.QueryStatsKey{User: conn.User(), Database: conn.Database(), Query: fingerprint}
+		e.queryStats.Record(key, duration, rows, timing.ShardCount(), err)
+	}
+	if e.slowQueries == nil || duration < e.slowQueries.Threshold() {
+		return
+	}
+	slow := SlowQuery{
+		Time:         timing.Start,
+		ConnectionID: conn.ConnectionID(),
+		User:         conn.User(),
+		Database:     conn.Database(),
+		Query:        queryStr,
+		PlanType:     planType,
+		Duration:     duration,
+		Planning:     timing.Planning,
+		QueueWait:    timing.QueueWait(),
+		Shards:       timing.Shards(),
+	}
+	if err != nil {
+		slow.Error = err.Error()
+	}
+	e.slowQueries.add(slow)
+	e.logger.WarnContext(ctx, "slow query",
+		"query", queryStr,
+		"connection_id", slow.ConnectionID,
+		"duration", slow.Duration,
+		"planning", slow.Planning,
+		"queue_wait", slow.QueueWait,
+		"shards", len(slow.Shards))
+}
+
+// countRows returns callback, adding the rows of the results it passes on,
+// returned or affected, to rows.
+func countRows(callback func(ctx context.Context, res *sqltypes.Result) error, rows *int64) func(ctx context.Context, res *sqltypes.Result) error {
+	return func(ctx context.Context, res *sqltypes.Result) error {
+		if res != nil {
+			*rows += int64(len(res.Rows)+len(res.RawRows)) + int64(res.RowsAffected)
+		}
+		return callback(ctx, res)
+	}
+}
 
-	return e.exec.PortalStreamExecute(ctx, e.planner.GetDefaultTableGroup(), "", conn, state, portalInfo, maxRows, callback)
+// invalidateResults removes the cached results of the tables written by a
+// statement of class, whether it succeeded or not. The tables written in a
+// transaction block are invalidated again once it ends, since the results
+// read by other sessions until then do not hold after it commits.
+func (e *Executor) invalidateResults(state *handler.MultiGatewayConnectionState, class engine.StatementClass, tables []string) {
+	if e.resultCache == nil {
+		return
+	}
+	switch class {
+	case engine.StatementDDL:
+		e.resultCache.InvalidateAll()
+	case engine.StatementWrite:
+		e.resultCache.InvalidateTables(tables)
+		if state.InTransaction() {
+			state.RecordWrittenTables(tables...)
+		}
+	}
+	if !state.InTransaction() {
+		if written := state.TakeWrittenTables(); len(written) > 0 {
+			e.resultCache.InvalidateTables(written)
+		}
+	}
 }
 
 // Describe returns metadata about a prepared statement or portal.
@@ -144,12 +611,51 @@ func (e *Executor) Describe(
 		"database", conn.Database(),
 		"connection_id", conn.ConnectionID())
 
-	// TODO: We will need to plan the query to find wether it can
-	// be served by a single shard or not. For now, since we only
-	// support unsharded, we don't have to do much.
-	// We just send the query to the default table group.
+	// The tables of the statement have the same columns on every shard, so
+	// any shard describes it.
+	return e.exec.Describe(ctx, e.planner.GetDefaultTableGroup(), e.planner.UnshardedShard(), conn, state, portalInfo, preparedStatementInfo)
+}
 
-	return e.exec.Describe(ctx, e.planner.GetDefaultTableGroup(), "", conn, state, portalInfo, preparedStatementInfo)
+// ReleaseConnection rolls back the open transaction of a closing
+// connection, as PostgreSQL does when a session ends, so that its locks are
+// released at once. It then stops its LISTENs and releases its
+// session-level advisory locks, temporary relations and cursors.
+func (e *Executor) ReleaseConnection(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+) {
+	tableGroup := e.planner.GetDefaultTableGroup()
+	if state.InTransaction() {
+		rollback := engine.NewRollbackRoute(tableGroup, "")
+		if err := rollback.StreamExecute(ctx, e.exec, conn, state, func(context.Context, *sqltypes.Result) error {
+			return nil
+		}); err != nil {
+			e.logger.WarnContext(ctx, "failed to roll back the transaction of closing connection",
+				"connection_id", conn.ConnectionID(),
+				"error", err)
+		}
+	}
+	if err := e.exec.Unlisten(ctx, conn, tableGroup, "", "", state); err != nil {
+		e.logger.WarnContext(ctx, "failed to release LISTENs of closing connection",
+			"connection_id", conn.ConnectionID(),
+			"error", err)
+	}
+	if err := e.exec.ReleaseAdvisoryLocks(ctx, conn, tableGroup, "", state); err != nil {
+		e.logger.WarnContext(ctx, "failed to release advisory locks of closing connection",
+			"connection_id", conn.ConnectionID(),
+			"error", err)
+	}
+	if err := e.exec.ReleaseTempTables(ctx, conn, tableGroup, "", state); err != nil {
+		e.logger.WarnContext(ctx, "failed to release temporary tables of closing connection",
+			"connection_id", conn.ConnectionID(),
+			"error", err)
+	}
+	if err := e.exec.ReleaseCursors(ctx, conn, tableGroup, "", state); err != nil {
+		e.logger.WarnContext(ctx, "failed to release cursors of closing connection",
+			"connection_id", conn.ConnectionID(),
+			"error", err)
+	}
 }
 
 // Ensure Executor implements handler.Executor interface.
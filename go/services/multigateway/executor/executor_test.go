@@ -0,0 +1,42 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestStreamExecuteMultiRejectsStatementsOutOfRange(t *testing.T) {
+	e := &Executor{}
+	state := handler.NewMultiGatewayConnectionState()
+	stmts := []*ast.RawStmt{
+		ast.NewRawStmt(nil, 0, 8),
+		ast.NewRawStmt(nil, 10, 9),
+	}
+
+	err := e.StreamExecuteMulti(context.Background(), nil, state, "SELECT 1; SELECT 2", stmts,
+		func(context.Context, *sqltypes.Result) error { return nil })
+	require.EqualError(t, err, "statement 2 at 10 of length 9 is out of range of the 18 byte query")
+	// Nothing ran, so no implicit transaction was begun.
+	assert.False(t, state.InTransaction())
+}
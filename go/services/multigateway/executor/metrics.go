@@ -0,0 +1,93 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/tools/telemetry"
+)
+
+// Metrics holds the OpenTelemetry metrics of the queries run by the
+// executor. The number of queries is the count of the histogram.
+type Metrics struct {
+	meter         metric.Meter
+	queryDuration metric.Float64Histogram
+	throttled     metric.Int64Counter
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the queries. A metric
+// that fails to initialize uses a noop implementation, and the error is
+// returned along with the usable Metrics instance.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multigateway/executor"),
+	}
+
+	var errs []error
+	var err error
+	m.queryDuration, err = m.meter.Float64Histogram(
+		"multigateway.query.duration",
+		metric.WithDescription("Duration of the queries by plan type and outcome"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		m.queryDuration = noop.Float64Histogram{}
+		errs = append(errs, fmt.Errorf("multigateway.query.duration histogram: %w", err))
+	}
+	m.throttled, err = m.meter.Int64Counter(
+		"multigateway.query.throttled",
+		metric.WithDescription("Statements shed by the throttler while the backends are overloaded"),
+		metric.WithUnit("{statement}"),
+	)
+	if err != nil {
+		m.throttled = noop.Int64Counter{}
+		errs = append(errs, fmt.Errorf("multigateway.query.throttled counter: %w", err))
+	}
+	return m, errors.Join(errs...)
+}
+
+// recordQuery records a query run from a plan of the given type, and
+// whether it succeeded.
+func (m *Metrics) recordQuery(ctx context.Context, planType, database, tableGroup string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	m.queryDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("plan_type", planType),
+		attribute.String(telemetry.AttrDatabase, database),
+		attribute.String(telemetry.AttrTableGroup, tableGroup),
+		attribute.String("status", status)))
+}
+
+// recordThrottled records a statement shed by the throttler.
+func (m *Metrics) recordThrottled(ctx context.Context, database string) {
+	if m == nil {
+		return
+	}
+	m.throttled.Add(ctx, 1, metric.WithAttributes(attribute.String(telemetry.AttrDatabase, database)))
+}
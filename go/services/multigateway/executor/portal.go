@@ -0,0 +1,178 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/preparedstatement"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// boundStatement returns a copy of the statement of a portal with its
+// parameters replaced by their bound values, cast to the types the
+// parameters were prepared with, as a simple query would give them. The
+// statement of the portal itself is shared, and left unchanged.
+//
+// Values bound in the binary format of a type with no known text form are
+// left as parameters, and complete is false.
+func boundStatement(portalInfo *preparedstatement.PortalInfo) (stmt ast.Stmt, complete bool) {
+	portal := portalInfo.Portal
+	params := sqltypes.ParamsFromProto(portal.ParamLengths, portal.ParamValues)
+	paramTypes := portalInfo.PreparedStatement.GetParamTypes()
+
+	complete = true
+	stmt = ast.CloneNode(portalInfo.AST()).(ast.Stmt)
+	stmt = ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		ref, ok := cursor.Node().(*ast.ParamRef)
+		if !ok {
+			return true
+		}
+		i := ref.Number - 1
+		if i < 0 || i >= len(params) {
+			complete = false
+			return false
+		}
+		var oid uint32
+		if i < len(paramTypes) {
+			oid = paramTypes[i]
+		}
+		value := params[i]
+		if value != nil && sqltypes.ResolveFormat(portal.ParamFormats, i) == sqltypes.FormatBinary {
+			text, err := sqltypes.BinaryToText(oid, value)
+			if err != nil || oid == uint32(ast.InvalidOid) {
+				complete = false
+				return false
+			}
+			value = text
+		}
+		cursor.Replace(boundValue(value, oid))
+		return false
+	}, nil).(ast.Stmt)
+	return stmt, complete
+}
+
+// boundValue returns the constant of a parameter value in text form, or
+// NULL, cast to the type of the parameter when it is known.
+func boundValue(value sqltypes.Value, oid uint32) ast.Node {
+	constant := ast.NewA_ConstNull(-1)
+	if value != nil {
+		constant = ast.NewA_Const(ast.NewString(string(value)), -1)
+	}
+	name := strings.ToLower(ast.Oid(oid).String())
+	// bpchar is deparsed as char, which is char(1); the constant is left
+	// for PostgreSQL to resolve like an unknown parameter.
+	if name == "" || ast.Oid(oid) == ast.BPCHAROID {
+		return constant
+	}
+	return ast.NewTypeCast(constant, ast.NewTypeName([]string{"pg_catalog", name}), -1)
+}
+
+// portalRoute returns the Route of the plan of a portal's bound statement,
+// if the plan runs the statement as it is on a single shard, so that the
+// portal can run there instead.
+func portalRoute(plan *engine.Plan) (*engine.Route, bool) {
+	primitive := plan.Primitive
+	if read, ok := primitive.(*engine.CachedRead); ok {
+		primitive = read.Input
+	}
+	route, ok := primitive.(*engine.Route)
+	if !ok || route.Query != plan.Original {
+		return nil, false
+	}
+	return route, true
+}
+
+// executeBoundPortal executes the plan of the bound statement of a portal,
+// the statement running with its parameters written in as constants rather
+// than as the prepared statement. The rows, read in text form, are
+// converted to the result formats of the portal.
+func (e *Executor) executeBoundPortal(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	portalInfo *preparedstatement.PortalInfo,
+	plan *engine.Plan,
+	callback func(ctx context.Context, res *sqltypes.Result) error,
+) error {
+	e.logger.DebugContext(ctx, "executing portal as its bound statement",
+		"portal", portalInfo.Portal.Name,
+		"plan", plan.String())
+
+	err := plan.StreamExecute(ctx, e.exec, conn, state, convertResultFormats(callback, portalInfo.Portal.ResultFormats))
+	if plan.Class == engine.StatementDDL {
+		e.planner.InvalidateDatabase(conn.Database())
+	}
+	return err
+}
+
+// convertResultFormats returns a callback passing the results to callback
+// with their rows converted to formats, the result formats of a Bind. The
+// results are left unchanged, as they may be shared, such as those of the
+// result cache.
+func convertResultFormats(
+	callback func(ctx context.Context, res *sqltypes.Result) error,
+	formats []int32,
+) func(ctx context.Context, res *sqltypes.Result) error {
+	if !slices.Contains(formats, int32(sqltypes.FormatBinary)) {
+		return callback
+	}
+	// The fields describe the rows of the results that follow them.
+	var fields []*query.Field
+	return func(ctx context.Context, res *sqltypes.Result) error {
+		if len(res.Fields) > 0 {
+			fields = res.Fields
+		}
+		converted := *res
+		converted.Fields = fields
+		converted.Rows = make([]*sqltypes.Row, len(res.Rows))
+		for i, row := range res.Rows {
+			converted.Rows[i] = &sqltypes.Row{Values: slices.Clone(row.Values)}
+		}
+		if err := converted.ConvertFormats(formats); err != nil {
+			return err
+		}
+		if len(res.Fields) == 0 {
+			converted.Fields = nil
+		}
+		return callback(ctx, &converted)
+	}
+}
+
+// errPortalOnSeveralShards returns the error of a portal of a statement on
+// sharded tables that does not run on a single shard, and cannot run as
+// its bound statement: its parameters are not all bound, or bound in a
+// binary format with no known text form, or it is executed with a row
+// limit.
+func errPortalOnSeveralShards(complete bool) error {
+	if !complete {
+		return sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("parameters of prepared statements on several shards must have a text form").
+			Hint("Bind the parameters in text format, or restrict every sharded table to one shard key value.").
+			Err()
+	}
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("prepared statements on several shards cannot be executed with a row limit").
+		Hint("Execute the portal without a row limit, or restrict every sharded table to one shard key value.").
+		Err()
+}
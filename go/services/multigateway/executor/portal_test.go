@@ -0,0 +1,266 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"log/slog"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/preparedstatement"
+	"github.com/multigres/multigres/go/common/protoutil"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
+)
+
+// evenOddRouter sends even integer keys to shard "-80", the others to
+// "80-".
+type evenOddRouter struct{}
+
+func (evenOddRouter) ShardForKey(key []byte, isNull bool) (string, error) {
+	n, err := strconv.Atoi(string(key))
+	if err != nil || isNull {
+		return "", err
+	}
+	if n%2 == 0 {
+		return "-80", nil
+	}
+	return "80-", nil
+}
+
+// portalExec records where the portals and queries it is given run.
+type portalExec struct {
+	engine.IExecute
+	executed []string
+}
+
+func (p *portalExec) PortalStreamExecute(_ context.Context, tableGroup, shard string, _ *server.Conn, _ *handler.MultiGatewayConnectionState,
+	portalInfo *preparedstatement.PortalInfo, _ int32, _ func(context.Context, *sqltypes.Result) error,
+) error {
+	p.executed = append(p.executed, tableGroup+"/"+shard+": portal "+portalInfo.Portal.Name)
+	return nil
+}
+
+func (p *portalExec) Describe(_ context.Context, tableGroup, shard string, _ *server.Conn, _ *handler.MultiGatewayConnectionState,
+	_ *preparedstatement.PortalInfo, _ *preparedstatement.PreparedStatementInfo,
+) (*query.StatementDescription, error) {
+	p.executed = append(p.executed, tableGroup+"/"+shard+": describe")
+	return &query.StatementDescription{}, nil
+}
+
+func (p *portalExec) ScatterExecute(_ context.Context, _ *server.Conn, tableGroup string, shards []string, sql string,
+	_ *handler.MultiGatewayConnectionState, callback func(context.Context, *sqltypes.Result) error,
+) error {
+	p.executed = append(p.executed, tableGroup+"/"+strings.Join(shards, ",")+": "+sql)
+	for _, shard := range shards {
+		err := callback(context.Background(), &sqltypes.Result{
+			Fields:     []*query.Field{{Name: "id", DataTypeOid: uint32(ast.INT4OID)}},
+			Rows:       []*sqltypes.Row{{Values: []sqltypes.Value{[]byte(strconv.Itoa(len(shard)))}}},
+			CommandTag: "SELECT 1",
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newPortal returns the portal of sql bound to params, in the given
+// formats of the given types, returning its rows in resultFormats.
+func newPortal(t *testing.T, sql string, paramTypes []uint32, params [][]byte, formats []int16, resultFormats ...int16) *preparedstatement.PortalInfo {
+	t.Helper()
+	psi, err := preparedstatement.NewPreparedStatementInfo(&query.PreparedStatement{Name: "s", Query: sql, ParamTypes: paramTypes})
+	require.NoError(t, err)
+	return preparedstatement.NewPortalInfo(psi, protoutil.NewPortal("p", "s", params, formats, resultFormats))
+}
+
+// newShardedExecutor returns an executor of a tablegroup with shards "-80"
+// and "80-", sharding the table users by id.
+func newShardedExecutor(exec engine.IExecute) *Executor {
+	e := NewExecutor(exec, slog.Default(), 0)
+	e.SetShardingSchema(&planner.ShardingSchema{
+		Shards: []string{"-80", "80-"},
+		Tables: map[string]string{"users": "id"},
+		Router: evenOddRouter{},
+	})
+	return e
+}
+
+func int4Binary(n int32) []byte {
+	return binary.BigEndian.AppendUint32(nil, uint32(n))
+}
+
+func TestBoundStatement(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		paramTypes []uint32
+		params     [][]byte
+		formats    []int16
+		expected   string
+		complete   bool
+	}{
+		{
+			name:     "untyped text parameters",
+			query:    "SELECT * FROM users WHERE id = $1 AND name = $2",
+			params:   [][]byte{[]byte("4"), []byte("it's")},
+			expected: "SELECT * FROM users WHERE id = '4' AND name = 'it''s'",
+			complete: true,
+		},
+		{
+			name:       "typed parameters",
+			query:      "SELECT $1, $2",
+			paramTypes: []uint32{uint32(ast.INT4OID), uint32(ast.DATEOID)},
+			params:     [][]byte{[]byte("4"), []byte("2026-01-02")},
+			expected:   "SELECT CAST('4' AS INT), CAST('2026-01-02' AS DATE)",
+			complete:   true,
+		},
+		{
+			name:       "binary parameters",
+			query:      "SELECT $1",
+			paramTypes: []uint32{uint32(ast.INT4OID)},
+			params:     [][]byte{int4Binary(-7)},
+			formats:    []int16{1},
+			expected:   "SELECT CAST('-7' AS INT)",
+			complete:   true,
+		},
+		{
+			name:       "NULL",
+			query:      "SELECT $1",
+			paramTypes: []uint32{uint32(ast.TEXTOID)},
+			params:     [][]byte{nil},
+			expected:   "SELECT CAST(NULL AS TEXT)",
+			complete:   true,
+		},
+		{
+			name:     "binary parameters of unknown types",
+			query:    "SELECT $1, $2",
+			params:   [][]byte{[]byte("a"), {0, 1}},
+			formats:  []int16{0, 1},
+			expected: "SELECT 'a', $2",
+		},
+		{
+			name:     "missing parameters",
+			query:    "SELECT $1, $2",
+			params:   [][]byte{[]byte("a")},
+			expected: "SELECT 'a', $2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			portalInfo := newPortal(t, tt.query, tt.paramTypes, tt.params, tt.formats)
+			original := portalInfo.AST().SqlString()
+
+			stmt, complete := boundStatement(portalInfo)
+			assert.Equal(t, tt.expected, stmt.SqlString())
+			assert.Equal(t, tt.complete, complete)
+			// The statement of the portal is shared with the other portals
+			// of the prepared statement.
+			assert.Equal(t, original, portalInfo.AST().SqlString())
+		})
+	}
+}
+
+func TestPortalStreamExecuteRoutesByShardKey(t *testing.T) {
+	exec := &portalExec{}
+	e := newShardedExecutor(exec)
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := handler.NewMultiGatewayConnectionState()
+	run := func(portalInfo *preparedstatement.PortalInfo) error {
+		return e.PortalStreamExecute(context.Background(), conn, state, portalInfo, 0,
+			func(context.Context, *sqltypes.Result) error { return nil })
+	}
+
+	sql := "SELECT * FROM users WHERE id = $1"
+	require.NoError(t, run(newPortal(t, sql, nil, [][]byte{[]byte("4")}, nil)))
+	require.NoError(t, run(newPortal(t, sql, []uint32{uint32(ast.INT4OID)}, [][]byte{int4Binary(3)}, []int16{1})))
+	// Statements on no sharded table run on the first shard.
+	require.NoError(t, run(newPortal(t, "SELECT $1", nil, [][]byte{[]byte("a")}, nil)))
+	assert.Equal(t, []string{"default/-80: portal p", "default/80-: portal p", "default/-80: portal p"}, exec.executed)
+
+	// Any shard describes the statements.
+	_, err := e.Describe(context.Background(), conn, state, newPortal(t, sql, nil, [][]byte{[]byte("3")}, nil), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "default/-80: describe", exec.executed[3])
+}
+
+func TestPortalStreamExecuteRunsBoundStatement(t *testing.T) {
+	exec := &portalExec{}
+	e := newShardedExecutor(exec)
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := handler.NewMultiGatewayConnectionState()
+	run := func(portalInfo *preparedstatement.PortalInfo, maxRows int32) ([]*sqltypes.Result, error) {
+		var results []*sqltypes.Result
+		err := e.PortalStreamExecute(context.Background(), conn, state, portalInfo, maxRows,
+			func(_ context.Context, result *sqltypes.Result) error {
+				results = append(results, result)
+				return nil
+			})
+		return results, err
+	}
+
+	// The statement runs on every shard with its parameters written in, and
+	// its rows are returned in the formats of the portal.
+	sql := "SELECT id FROM users WHERE id > $1"
+	results, err := run(newPortal(t, sql, []uint32{uint32(ast.INT4OID)}, [][]byte{int4Binary(4)}, []int16{1}, 1), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default/-80,80-: SELECT id FROM users WHERE id > CAST('4' AS INT)"}, exec.executed)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(sqltypes.FormatBinary), results[0].Fields[0].Format)
+	assert.Equal(t, []*sqltypes.Row{{Values: []sqltypes.Value{int4Binary(3)}}, {Values: []sqltypes.Value{int4Binary(3)}}}, results[0].Rows)
+
+	tests := []struct {
+		name    string
+		portal  *preparedstatement.PortalInfo
+		maxRows int32
+		message string
+	}{
+		{
+			name:    "parameters without a text form",
+			portal:  newPortal(t, sql, nil, [][]byte{{0, 1}}, []int16{1}),
+			message: "parameters of prepared statements on several shards must have a text form",
+		},
+		{
+			name:    "row limit",
+			portal:  newPortal(t, sql, nil, [][]byte{[]byte("4")}, nil),
+			maxRows: 10,
+			message: "prepared statements on several shards cannot be executed with a row limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := run(tt.portal, tt.maxRows)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+			assert.Equal(t, tt.message, diag.Message)
+			assert.Len(t, exec.executed, 1)
+		})
+	}
+}
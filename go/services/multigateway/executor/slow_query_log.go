@@ -0,0 +1,90 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// SlowQuery is a query that took longer than the threshold of the slow
+// query log, with the time it spent in each layer: the planning and queue
+// wait are spent in the gateway, the shard times mostly in the poolers and
+// their backends.
+type SlowQuery struct {
+	Time         time.Time `json:"time"`
+	ConnectionID uint32    `json:"connection_id"`
+	User         string    `json:"user"`
+	Database     string    `json:"database"`
+	Query        string    `json:"query"`
+	PlanType     string    `json:"plan_type"`
+	// Duration is the time from the receipt of the query until its last
+	// result reached the client.
+	Duration  time.Duration        `json:"duration"`
+	Planning  time.Duration        `json:"planning"`
+	QueueWait time.Duration        `json:"queue_wait"`
+	Shards    []engine.ShardTiming `json:"shards"`
+	Error     string               `json:"error,omitempty"`
+}
+
+// SlowQueryLog keeps the most recent queries taking longer than a
+// threshold.
+type SlowQueryLog struct {
+	threshold time.Duration
+
+	mu      sync.Mutex
+	entries []SlowQuery
+	next    int
+	full    bool
+}
+
+// NewSlowQueryLog returns a log keeping the last size queries taking
+// longer than threshold.
+func NewSlowQueryLog(threshold time.Duration, size int) *SlowQueryLog {
+	return &SlowQueryLog{threshold: max(threshold, 0), entries: make([]SlowQuery, max(size, 1))}
+}
+
+// Threshold returns the duration beyond which queries are logged.
+func (l *SlowQueryLog) Threshold() time.Duration {
+	return l.threshold
+}
+
+// add keeps q, dropping the oldest query if the log is full.
+func (l *SlowQueryLog) add(q SlowQuery) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries[l.next] = q
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Entries returns the queries of the log, the most recent first.
+func (l *SlowQueryLog) Entries() []SlowQuery {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := l.next
+	if l.full {
+		n = len(l.entries)
+	}
+	entries := make([]SlowQuery, 0, n)
+	for i := 1; i <= n; i++ {
+		entries = append(entries, l.entries[(l.next-i+len(l.entries))%len(l.entries)])
+	}
+	return entries
+}
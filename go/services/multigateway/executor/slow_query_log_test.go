@@ -0,0 +1,36 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	log := NewSlowQueryLog(time.Second, 2)
+	assert.Equal(t, time.Second, log.Threshold())
+	assert.Empty(t, log.Entries())
+
+	log.add(SlowQuery{Query: "SELECT 1"})
+	assert.Equal(t, []SlowQuery{{Query: "SELECT 1"}}, log.Entries())
+
+	// The oldest query is dropped once the log is full.
+	log.add(SlowQuery{Query: "SELECT 2"})
+	log.add(SlowQuery{Query: "SELECT 3"})
+	assert.Equal(t, []SlowQuery{{Query: "SELECT 3"}, {Query: "SELECT 2"}}, log.Entries())
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// throttleSampleInterval is how often the throttler samples the replication
+// lag and the queue wait.
+const throttleSampleInterval = 100 * time.Millisecond
+
+// ThrottleOptions configure the throttler shedding statements while the
+// backends are overloaded.
+type ThrottleOptions struct {
+	// MaxReplicationLag is the replication lag of the replicas beyond which
+	// writes are shed, or 0 to not throttle on replication lag.
+	MaxReplicationLag time.Duration
+	// MaxQueueWait is the average time shard queries wait for their turn
+	// beyond which reads and writes are shed, or 0 to not throttle on it.
+	MaxQueueWait time.Duration
+	// ReplicationLag returns the largest replication lag of the replicas.
+	ReplicationLag func() time.Duration
+	// QueueWait returns the average time shard queries recently waited for
+	// their turn.
+	QueueWait func() time.Duration
+}
+
+// Throttler sheds statements while the replication lag of the replicas or
+// the time shard queries wait for their turn exceed their thresholds. It
+// adapts to the overload: from none of the statements at a threshold, it
+// sheds a growing share of them, up to all of them at twice the threshold.
+// Shed statements fail with query_canceled, and may be retried. Statements
+// of transaction blocks and utility statements are never shed, so that the
+// transactions already open finish.
+type Throttler struct {
+	opts ThrottleOptions
+
+	mu      sync.Mutex
+	sampled time.Time
+	lag     time.Duration
+	wait    time.Duration
+
+	// random returns a number in [0, 1) if set, for tests.
+	random func() float64
+}
+
+// NewThrottler returns a throttler shedding statements beyond the
+// thresholds of opts.
+func NewThrottler(opts ThrottleOptions) *Throttler {
+	return &Throttler{opts: opts}
+}
+
+// sample returns the replication lag and the queue wait, sampled at most
+// once per throttleSampleInterval.
+func (t *Throttler) sample() (lag, wait time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now := time.Now(); now.Sub(t.sampled) >= throttleSampleInterval {
+		t.sampled = now
+		t.lag, t.wait = 0, 0
+		if t.opts.MaxReplicationLag > 0 && t.opts.ReplicationLag != nil {
+			t.lag = t.opts.ReplicationLag()
+		}
+		if t.opts.MaxQueueWait > 0 && t.opts.QueueWait != nil {
+			t.wait = t.opts.QueueWait()
+		}
+	}
+	return t.lag, t.wait
+}
+
+// check returns the error a statement of class run by the session of state
+// is shed with, or nil if it runs. Does nothing if t is nil.
+func (t *Throttler) check(class engine.StatementClass, state *handler.MultiGatewayConnectionState) error {
+	if t == nil || class == engine.StatementUtility || state.InTransaction() {
+		return nil
+	}
+	lag, wait := t.sample()
+	if class != engine.StatementRead && t.shed(lag, t.opts.MaxReplicationLag) {
+		return errThrottled("replication lag of the replicas", lag, t.opts.MaxReplicationLag, "throttle-max-replication-lag")
+	}
+	if t.shed(wait, t.opts.MaxQueueWait) {
+		return errThrottled("queue wait of the shard queries", wait, t.opts.MaxQueueWait, "throttle-max-queue-wait")
+	}
+	return nil
+}
+
+// shed returns true if a statement is shed for a signal at value, beyond
+// threshold by a share that grows from 0 at threshold to 1 at twice it.
+func (t *Throttler) shed(value, threshold time.Duration) bool {
+	if threshold <= 0 || value <= threshold {
+		return false
+	}
+	random := rand.Float64
+	if t.random != nil {
+		random = t.random
+	}
+	return random() < float64(value-threshold)/float64(threshold)
+}
+
+// errThrottled returns the error of a statement shed for the named signal
+// at value, beyond threshold as set by flag.
+func errThrottled(signal string, value, threshold time.Duration, flag string) error {
+	return sqlstate.NewError(sqlstate.QueryCanceled).
+		Msg("canceling statement due to gateway throttling").
+		Detail("The %s is %s, beyond the %s of --%s.", signal, value.Round(time.Millisecond), threshold, flag).
+		Hint("Retry the statement after a short delay.").
+		Err()
+}
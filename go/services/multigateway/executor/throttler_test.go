@@ -0,0 +1,75 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func TestThrottler(t *testing.T) {
+	lag, wait := time.Duration(0), time.Duration(0)
+	throttler := NewThrottler(ThrottleOptions{
+		MaxReplicationLag: 10 * time.Second,
+		MaxQueueWait:      100 * time.Millisecond,
+		ReplicationLag:    func() time.Duration { return lag },
+		QueueWait:         func() time.Duration { return wait },
+	})
+	throttler.random = func() float64 { return 0.5 }
+	state := handler.NewMultiGatewayConnectionState()
+	check := func(class engine.StatementClass) error {
+		throttler.sampled = time.Time{}
+		return throttler.check(class, state)
+	}
+
+	require.NoError(t, check(engine.StatementWrite))
+
+	// Below half the way to twice the threshold, no statement is shed.
+	lag = 14 * time.Second
+	require.NoError(t, check(engine.StatementWrite))
+
+	// Beyond it, writes are shed but reads run.
+	lag = 16 * time.Second
+	err := check(engine.StatementWrite)
+	var diag *sqltypes.PgDiagnostic
+	require.True(t, errors.As(err, &diag))
+	assert.Equal(t, sqlstate.QueryCanceled, diag.Code)
+	assert.Contains(t, diag.Detail, "--throttle-max-replication-lag")
+	require.NoError(t, check(engine.StatementRead))
+	require.NoError(t, check(engine.StatementUtility))
+
+	// The queue wait sheds reads too.
+	lag, wait = 0, 200*time.Millisecond
+	err = check(engine.StatementRead)
+	require.True(t, errors.As(err, &diag))
+	assert.Contains(t, diag.Detail, "--throttle-max-queue-wait")
+
+	// Statements of transaction blocks are never shed.
+	state.BeginTransaction()
+	require.NoError(t, check(engine.StatementWrite))
+
+	// A nil throttler sheds nothing.
+	var none *Throttler
+	require.NoError(t, none.check(engine.StatementWrite, handler.NewMultiGatewayConnectionState()))
+}
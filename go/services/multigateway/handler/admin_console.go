@@ -0,0 +1,636 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"cmp"
+	"context"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// AdminConsoleDatabase is the virtual database of the admin console, named
+// as PgBouncer names it so that its tooling works unchanged.
+const AdminConsoleDatabase = "pgbouncer"
+
+// drainPollInterval is how often PAUSE checks whether the statements of
+// the databases paused are done.
+const drainPollInterval = 10 * time.Millisecond
+
+// AdminConsole configures the admin console: connections of the allowed
+// roles to the virtual pgbouncer database get the PgBouncer admin commands
+// instead of reaching a backend.
+type AdminConsole struct {
+	// Users are the roles allowed to connect to the console. Without any,
+	// the console is disabled and pgbouncer is an ordinary database.
+	Users []string
+	// Reload is called by RELOAD to reload the configuration of the
+	// gateway. It may be nil.
+	Reload func() error
+}
+
+// adminConsole is the state of the admin console of a handler.
+type adminConsole struct {
+	AdminConsole
+	// gate holds the statements of the databases paused.
+	gate pauseGate
+	// stats are the statement statistics per database.
+	stats consoleStats
+}
+
+// SetAdminConsole enables the admin console for console.Users. It must be
+// called before the handler serves connections.
+func (h *MultiGatewayHandler) SetAdminConsole(console AdminConsole) {
+	h.console.AdminConsole = console
+}
+
+// isAdminConsole reports whether conn is connected to the admin console.
+func (h *MultiGatewayHandler) isAdminConsole(conn *server.Conn) bool {
+	return len(h.console.Users) > 0 && conn.Database() == AdminConsoleDatabase
+}
+
+// admitAdminConsole rejects a connection to the admin console of a role
+// that is not allowed to use it.
+func (h *MultiGatewayHandler) admitAdminConsole(conn *server.Conn) error {
+	if slices.Contains(h.console.Users, conn.User()) {
+		return nil
+	}
+	return sqlstate.NewError(sqlstate.InsufficientPrivilege).
+		Severity("FATAL").
+		Msg("permission denied for the admin console").
+		Detail("Role \"%s\" is not an admin console user.", conn.User()).
+		Hint("Add the role to --admin-console-users of the gateway.").
+		Err()
+}
+
+// waitResumed holds a statement of the session of conn while its database
+// is paused, unless the session is in a transaction block, which must be
+// able to finish for PAUSE to complete.
+func (h *MultiGatewayHandler) waitResumed(ctx context.Context, conn *server.Conn, st *MultiGatewayConnectionState) error {
+	if !h.console.gate.isPaused() || st.InTransaction() {
+		return nil
+	}
+	waited, err := h.console.gate.wait(ctx, conn.ConnectionID(), conn.Database())
+	if waited > 0 {
+		h.console.stats.database(conn.Database()).waitTime.Add(waited.Microseconds())
+	}
+	return err
+}
+
+// endQuery records that the statement of the session of conn is done, in
+// the statistics of its database.
+func (h *MultiGatewayHandler) endQuery(conn *server.Conn, st *MultiGatewayConnectionState) {
+	queryTime, xactTime, xactDone := st.endQuery()
+	if len(h.console.Users) == 0 {
+		return
+	}
+	stats := h.console.stats.database(conn.Database())
+	stats.queryCount.Add(1)
+	stats.queryTime.Add(queryTime.Microseconds())
+	if xactDone {
+		stats.xactCount.Add(1)
+		stats.xactTime.Add(xactTime.Microseconds())
+	}
+}
+
+// handleAdminCommand runs a command of the admin console. Like PgBouncer,
+// the console takes one command per query, without parameters.
+func (h *MultiGatewayHandler) handleAdminCommand(ctx context.Context, conn *server.Conn, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	words := strings.Fields(strings.TrimSuffix(strings.TrimSpace(queryStr), ";"))
+	if len(words) == 0 {
+		return callback(ctx, nil)
+	}
+	command, args := strings.ToUpper(words[0]), words[1:]
+	h.logger.InfoContext(ctx, "admin console command", "command", queryStr, "user", conn.User(), "connection_id", conn.ConnectionID())
+
+	if command == "SHOW" {
+		if len(args) != 1 {
+			return errInvalidAdminCommand(queryStr)
+		}
+		var result *sqltypes.Result
+		switch strings.ToUpper(args[0]) {
+		case "POOLS":
+			result = h.showPools()
+		case "STATS":
+			result = h.showStats()
+		case "CLIENTS":
+			result = h.showClients()
+		case "SERVERS":
+			result = h.showServers()
+		default:
+			return errInvalidAdminCommand(queryStr)
+		}
+		return callback(ctx, result)
+	}
+
+	if len(args) > 1 {
+		return errInvalidAdminCommand(queryStr)
+	}
+	database := ""
+	if len(args) == 1 {
+		database = strings.Trim(args[0], `"`)
+	}
+	var err error
+	switch command {
+	case "PAUSE":
+		err = h.pause(ctx, database)
+	case "RESUME":
+		err = h.console.gate.resume(database)
+	case "RELOAD":
+		if database != "" {
+			return errInvalidAdminCommand(queryStr)
+		}
+		if h.console.Reload != nil {
+			err = h.console.Reload()
+		}
+	case "KILL":
+		err = h.kill(database)
+	default:
+		return errInvalidAdminCommand(queryStr)
+	}
+	if err != nil {
+		return err
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: command})
+}
+
+// errInvalidAdminCommand returns the error of a query the admin console
+// does not know.
+func errInvalidAdminCommand(queryStr string) error {
+	return sqlstate.NewError(sqlstate.SyntaxError).
+		Msg("invalid admin console command \"%s\"", strings.TrimSpace(queryStr)).
+		Hint("The admin console supports SHOW POOLS, SHOW STATS, SHOW CLIENTS, SHOW SERVERS, PAUSE, RESUME, RELOAD and KILL.").
+		Err()
+}
+
+// pause pauses database, or all databases if it is empty, then waits until
+// none of their sessions runs a statement or is in a transaction block. If
+// the wait is cancelled, the databases stay paused.
+func (h *MultiGatewayHandler) pause(ctx context.Context, database string) error {
+	if database == AdminConsoleDatabase {
+		return errAdminConsoleDatabase("PAUSE")
+	}
+	if err := h.console.gate.pause(database); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for !h.drained(database) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// drained reports whether no session of database, or of any database if
+// it is empty, runs a statement or is in a transaction block.
+func (h *MultiGatewayHandler) drained(database string) bool {
+	for _, session := range h.Sessions() {
+		if session.Database == AdminConsoleDatabase || (database != "" && session.Database != database) {
+			continue
+		}
+		if session.State != "idle" {
+			return false
+		}
+	}
+	return true
+}
+
+// kill pauses database and closes the connections of its sessions. New
+// statements of the database wait until RESUME.
+func (h *MultiGatewayHandler) kill(database string) error {
+	switch database {
+	case "":
+		return sqlstate.NewError(sqlstate.SyntaxError).
+			Msg("KILL requires a database").
+			Err()
+	case AdminConsoleDatabase:
+		return errAdminConsoleDatabase("KILL")
+	}
+	// A database already paused is killed all the same.
+	_ = h.console.gate.pause(database)
+	for _, conn := range h.sessions.list() {
+		if conn.Database() == database {
+			h.TerminateSession(conn.ConnectionID())
+		}
+	}
+	return nil
+}
+
+// errAdminConsoleDatabase returns the error of a command that cannot apply
+// to the admin console itself.
+func errAdminConsoleDatabase(command string) error {
+	return sqlstate.NewError(sqlstate.ObjectNotInPrerequisiteState).
+		Msg("%s cannot apply to the admin console", command).
+		Err()
+}
+
+// showPools returns the result of SHOW POOLS: the client sessions and the
+// backend connections reserved for them, per database and role. Backend
+// connections that are not reserved are pooled by the poolers, so sv_idle
+// and sv_used are always 0.
+func (h *MultiGatewayHandler) showPools() *sqltypes.Result {
+	type poolKey struct{ database, user string }
+	type pool struct {
+		clActive, clWaiting, svActive int64
+		maxWait                       time.Duration
+	}
+	pools := make(map[poolKey]*pool)
+	now := time.Now()
+	for _, session := range h.Sessions() {
+		key := poolKey{session.Database, session.User}
+		p := pools[key]
+		if p == nil {
+			p = &pool{}
+			pools[key] = p
+		}
+		if since, ok := h.console.gate.waitingSince(session.ConnectionID); ok {
+			p.clWaiting++
+			p.maxWait = max(p.maxWait, now.Sub(since))
+		} else {
+			p.clActive++
+		}
+		p.svActive += int64(session.ReservedConnections)
+	}
+
+	keys := make([]poolKey, 0, len(pools))
+	for key := range pools {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b poolKey) int {
+		return cmp.Or(cmp.Compare(a.database, b.database), cmp.Compare(a.user, b.user))
+	})
+
+	result := consoleResult("database", "user", "cl_active:int", "cl_waiting:int", "sv_active:int",
+		"sv_idle:int", "sv_used:int", "maxwait:int", "maxwait_us:int", "pool_mode")
+	for _, key := range keys {
+		p := pools[key]
+		maxWait, maxWaitUs := splitSeconds(p.maxWait)
+		result.Rows = append(result.Rows, &sqltypes.Row{Values: []sqltypes.Value{
+			sqltypes.NewText(key.database),
+			sqltypes.NewText(key.user),
+			sqltypes.NewInt64(p.clActive),
+			sqltypes.NewInt64(p.clWaiting),
+			sqltypes.NewInt64(p.svActive),
+			sqltypes.NewInt64(0),
+			sqltypes.NewInt64(0),
+			sqltypes.NewInt64(maxWait),
+			sqltypes.NewInt64(maxWaitUs),
+			sqltypes.NewText("transaction"),
+		}})
+	}
+	return result
+}
+
+// showStats returns the result of SHOW STATS: the statements and
+// transactions run per database since the gateway started, with their
+// times in microseconds.
+func (h *MultiGatewayHandler) showStats() *sqltypes.Result {
+	result := consoleResult("database", "total_xact_count:int", "total_query_count:int",
+		"total_xact_time:int", "total_query_time:int", "total_wait_time:int", "avg_xact_time:int", "avg_query_time:int")
+	for _, entry := range h.console.stats.snapshot() {
+		result.Rows = append(result.Rows, &sqltypes.Row{Values: []sqltypes.Value{
+			sqltypes.NewText(entry.database),
+			sqltypes.NewInt64(entry.xactCount),
+			sqltypes.NewInt64(entry.queryCount),
+			sqltypes.NewInt64(entry.xactTime),
+			sqltypes.NewInt64(entry.queryTime),
+			sqltypes.NewInt64(entry.waitTime),
+			sqltypes.NewInt64(average(entry.xactTime, entry.xactCount)),
+			sqltypes.NewInt64(average(entry.queryTime, entry.queryCount)),
+		}})
+	}
+	return result
+}
+
+// showClients returns the result of SHOW CLIENTS: the client sessions,
+// "waiting" while PAUSE holds their statement and "active" otherwise.
+func (h *MultiGatewayHandler) showClients() *sqltypes.Result {
+	result := consoleResult("type", "user", "database", "state", "addr", "port:int", "connect_time",
+		"request_time", "wait:int", "wait_us:int", "ptr", "application_name")
+	now := time.Now()
+	for _, session := range h.Sessions() {
+		state, wait := "active", time.Duration(0)
+		if since, ok := h.console.gate.waitingSince(session.ConnectionID); ok {
+			state, wait = "waiting", now.Sub(since)
+		}
+		requestTime := session.QueryStart
+		if requestTime.IsZero() {
+			requestTime = session.ConnectedAt
+		}
+		addr, port := splitAddr(session.RemoteAddr)
+		waitSeconds, waitUs := splitSeconds(wait)
+		result.Rows = append(result.Rows, &sqltypes.Row{Values: []sqltypes.Value{
+			sqltypes.NewText("C"),
+			sqltypes.NewText(session.User),
+			sqltypes.NewText(session.Database),
+			sqltypes.NewText(state),
+			sqltypes.NewText(addr),
+			sqltypes.NewInt64(port),
+			sqltypes.NewText(formatConsoleTime(session.ConnectedAt)),
+			sqltypes.NewText(formatConsoleTime(requestTime)),
+			sqltypes.NewInt64(waitSeconds),
+			sqltypes.NewInt64(waitUs),
+			sqltypes.NewText(strconv.FormatUint(uint64(session.ConnectionID), 16)),
+			sqltypes.NewText(session.ApplicationName),
+		}})
+	}
+	return result
+}
+
+// showServers returns the result of SHOW SERVERS: the backend connections
+// reserved for the client sessions, linked to them. addr is the pooler
+// the connection is reserved on.
+func (h *MultiGatewayHandler) showServers() *sqltypes.Result {
+	result := consoleResult("type", "user", "database", "state", "addr", "tablegroup", "shard",
+		"request_time", "ptr", "link", "application_name")
+	conns := h.sessions.list()
+	slices.SortFunc(conns, func(a, b *server.Conn) int {
+		return cmp.Compare(a.ConnectionID(), b.ConnectionID())
+	})
+	for _, conn := range conns {
+		st, ok := conn.GetConnectionState().(*MultiGatewayConnectionState)
+		if !ok {
+			continue
+		}
+		_, _, requestTime := st.activity()
+		applicationName := st.GetSessionSettings()["application_name"]
+		for _, rc := range st.reservedConnections() {
+			addr := ""
+			if rc.poolerID != nil {
+				addr = topoclient.MultiPoolerIDString(rc.poolerID)
+			}
+			result.Rows = append(result.Rows, &sqltypes.Row{Values: []sqltypes.Value{
+				sqltypes.NewText("S"),
+				sqltypes.NewText(conn.User()),
+				sqltypes.NewText(conn.Database()),
+				sqltypes.NewText("active"),
+				sqltypes.NewText(addr),
+				sqltypes.NewText(rc.target.GetTableGroup()),
+				sqltypes.NewText(rc.target.GetShard()),
+				sqltypes.NewText(formatConsoleTime(requestTime)),
+				sqltypes.NewText(strconv.FormatInt(rc.id, 16)),
+				sqltypes.NewText(strconv.FormatUint(uint64(conn.ConnectionID()), 16)),
+				sqltypes.NewText(applicationName),
+			}})
+		}
+	}
+	return result
+}
+
+// consoleResult returns an empty SHOW result with the given columns, text
+// unless their name ends with ":int".
+func consoleResult(columns ...string) *sqltypes.Result {
+	fields := make([]*query.Field, len(columns))
+	for i, column := range columns {
+		oid := ast.TEXTOID
+		if name, ok := strings.CutSuffix(column, ":int"); ok {
+			column, oid = name, ast.INT8OID
+		}
+		fields[i] = &query.Field{Name: column, DataTypeOid: uint32(oid), DataTypeSize: -1}
+	}
+	return &sqltypes.Result{Fields: fields, CommandTag: "SHOW"}
+}
+
+// formatConsoleTime formats t as PgBouncer does, or returns "" if it is
+// zero.
+func formatConsoleTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05 MST")
+}
+
+// splitSeconds splits d in whole seconds and the microseconds left, as
+// PgBouncer reports waits.
+func splitSeconds(d time.Duration) (seconds, micros int64) {
+	us := d.Microseconds()
+	return us / 1e6, us % 1e6
+}
+
+// splitAddr splits a remote address in its host and port, the port being
+// 0 if it has none.
+func splitAddr(addr string) (string, int64) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr, 0
+	}
+	n, _ := strconv.ParseInt(port, 10, 64)
+	return host, n
+}
+
+// average returns total divided by count, or 0 without any.
+func average(total, count int64) int64 {
+	if count == 0 {
+		return 0
+	}
+	return total / count
+}
+
+// pauseGate holds the statements of the databases paused by PAUSE, until
+// RESUME.
+type pauseGate struct {
+	// paused is set while any database is paused, so that statements are
+	// not serialized on mu otherwise.
+	paused atomic.Bool
+
+	mu sync.Mutex
+	// all is set while all databases are paused.
+	all       bool
+	databases map[string]bool
+	// resumed is closed, and replaced, on RESUME.
+	resumed chan struct{}
+	// waiting holds since when the statements held wait, by connection ID.
+	waiting map[uint32]time.Time
+}
+
+// isPaused reports whether any database is paused.
+func (g *pauseGate) isPaused() bool {
+	return g.paused.Load()
+}
+
+// pause pauses database, or all databases if it is empty.
+func (g *pauseGate) pause(database string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.all || g.databases[database] {
+		return sqlstate.NewError(sqlstate.ObjectNotInPrerequisiteState).
+			Msg("already paused").
+			Err()
+	}
+	if database == "" {
+		g.all = true
+	} else {
+		if g.databases == nil {
+			g.databases = make(map[string]bool)
+		}
+		g.databases[database] = true
+	}
+	g.paused.Store(true)
+	return nil
+}
+
+// resume resumes database, or all databases if it is empty, releasing the
+// statements held.
+func (g *pauseGate) resume(database string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case database == "" && !g.all && len(g.databases) == 0:
+		return sqlstate.NewError(sqlstate.ObjectNotInPrerequisiteState).
+			Msg("not paused").
+			Err()
+	case database == "":
+		g.all = false
+		g.databases = nil
+	case g.all:
+		return sqlstate.NewError(sqlstate.ObjectNotInPrerequisiteState).
+			Msg("all databases are paused").
+			Hint("Use RESUME without a database to resume them.").
+			Err()
+	case !g.databases[database]:
+		return sqlstate.NewError(sqlstate.ObjectNotInPrerequisiteState).
+			Msg("database \"%s\" is not paused", database).
+			Err()
+	default:
+		delete(g.databases, database)
+	}
+	g.paused.Store(g.all || len(g.databases) > 0)
+	if g.resumed != nil {
+		close(g.resumed)
+		g.resumed = nil
+	}
+	return nil
+}
+
+// wait blocks the statement of the connection connectionID while database
+// is paused, or until ctx is done. It returns how long it waited.
+func (g *pauseGate) wait(ctx context.Context, connectionID uint32, database string) (time.Duration, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.all && !g.databases[database] {
+		return 0, nil
+	}
+
+	start := time.Now()
+	if g.waiting == nil {
+		g.waiting = make(map[uint32]time.Time)
+	}
+	g.waiting[connectionID] = start
+	defer delete(g.waiting, connectionID)
+
+	for g.all || g.databases[database] {
+		if g.resumed == nil {
+			g.resumed = make(chan struct{})
+		}
+		resumed := g.resumed
+		g.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			g.mu.Lock()
+			return time.Since(start), ctx.Err()
+		case <-resumed:
+		}
+		g.mu.Lock()
+	}
+	return time.Since(start), nil
+}
+
+// waitingSince returns since when the statement of the connection
+// connectionID waits, and false if it does not.
+func (g *pauseGate) waitingSince(connectionID uint32) (time.Time, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	since, ok := g.waiting[connectionID]
+	return since, ok
+}
+
+// databaseStats are the statement statistics of a database, with times in
+// microseconds.
+type databaseStats struct {
+	xactCount, queryCount         atomic.Int64
+	xactTime, queryTime, waitTime atomic.Int64
+}
+
+// databaseStatsEntry is a snapshot of the statistics of a database.
+type databaseStatsEntry struct {
+	database                      string
+	xactCount, queryCount         int64
+	xactTime, queryTime, waitTime int64
+}
+
+// consoleStats are the statement statistics of the databases.
+type consoleStats struct {
+	mu        sync.RWMutex
+	databases map[string]*databaseStats
+}
+
+// database returns the statistics of the named database.
+func (s *consoleStats) database(name string) *databaseStats {
+	s.mu.RLock()
+	stats := s.databases[name]
+	s.mu.RUnlock()
+	if stats != nil {
+		return stats
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.databases == nil {
+		s.databases = make(map[string]*databaseStats)
+	}
+	if stats = s.databases[name]; stats == nil {
+		stats = &databaseStats{}
+		s.databases[name] = stats
+	}
+	return stats
+}
+
+// snapshot returns the statistics of the databases, ordered by name.
+func (s *consoleStats) snapshot() []databaseStatsEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make([]databaseStatsEntry, 0, len(s.databases))
+	for name, stats := range s.databases {
+		entries = append(entries, databaseStatsEntry{
+			database:   name,
+			xactCount:  stats.xactCount.Load(),
+			queryCount: stats.queryCount.Load(),
+			xactTime:   stats.xactTime.Load(),
+			queryTime:  stats.queryTime.Load(),
+			waitTime:   stats.waitTime.Load(),
+		})
+	}
+	slices.SortFunc(entries, func(a, b databaseStatsEntry) int {
+		return cmp.Compare(a.database, b.database)
+	})
+	return entries
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// newConsoleTestConn returns a connection of user to database.
+func newConsoleTestConn(id uint32, user, database string) *server.Conn {
+	tc := server.NewTestConn(&bytes.Buffer{})
+	tc.SetConnectionID(id)
+	tc.SetUser(user, database)
+	return tc.Conn
+}
+
+// runQuery runs queryStr on conn and returns its last result.
+func runQuery(ctx context.Context, h *MultiGatewayHandler, conn *server.Conn, queryStr string) (*sqltypes.Result, error) {
+	var last *sqltypes.Result
+	err := h.HandleQuery(ctx, conn, queryStr, func(_ context.Context, result *sqltypes.Result) error {
+		last = result
+		return nil
+	})
+	return last, err
+}
+
+// columnValues returns the values of the named column of result as text.
+func columnValues(t *testing.T, result *sqltypes.Result, name string) []string {
+	t.Helper()
+	for i, field := range result.Fields {
+		if field.Name == name {
+			values := make([]string, len(result.Rows))
+			for j, row := range result.Rows {
+				values[j] = string(row.Values[i])
+			}
+			return values
+		}
+	}
+	require.Failf(t, "missing column", "column %q not in the result", name)
+	return nil
+}
+
+func newConsoleTestHandler(reload func() error) *MultiGatewayHandler {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	h.SetAdminConsole(AdminConsole{Users: []string{"admin"}, Reload: reload})
+	return h
+}
+
+func TestAdminConsoleConnection(t *testing.T) {
+	ctx := context.Background()
+
+	// Disabled, pgbouncer is an ordinary database.
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	conn := newConsoleTestConn(1, "admin", AdminConsoleDatabase)
+	require.NoError(t, h.HandleConnectionStart(ctx, conn))
+	result, err := runQuery(ctx, h, conn, "SHOW POOLS")
+	require.NoError(t, err)
+	assert.Equal(t, "column1", result.Fields[0].Name)
+
+	h = newConsoleTestHandler(nil)
+	h.SetConnectionLimits(ConnectionLimits{MaxPerUser: 1})
+	require.NoError(t, h.HandleConnectionStart(ctx, newConsoleTestConn(1, "admin", "db1")))
+	// The console is not subject to the connection limits.
+	require.NoError(t, h.HandleConnectionStart(ctx, conn))
+
+	err = h.HandleConnectionStart(ctx, newConsoleTestConn(2, "app", AdminConsoleDatabase))
+	var diag *sqltypes.PgDiagnostic
+	require.True(t, errors.As(err, &diag))
+	assert.Equal(t, sqlstate.InsufficientPrivilege, diag.Code)
+	assert.Equal(t, "FATAL", diag.Severity)
+
+	err = h.HandleParse(ctx, conn, "", "SHOW POOLS", nil)
+	require.True(t, errors.As(err, &diag))
+	assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+}
+
+func TestAdminConsoleCommands(t *testing.T) {
+	ctx := context.Background()
+	reloads := 0
+	h := newConsoleTestHandler(func() error {
+		reloads++
+		return nil
+	})
+	admin := newConsoleTestConn(1, "admin", AdminConsoleDatabase)
+
+	result, err := runQuery(ctx, h, admin, "reload;")
+	require.NoError(t, err)
+	assert.Equal(t, "RELOAD", result.CommandTag)
+	assert.Equal(t, 1, reloads)
+
+	for _, command := range []string{"SHOW HELP", "SELECT 1", "SHOW POOLS extra", "RELOAD db1", "KILL", "PAUSE pgbouncer"} {
+		_, err := runQuery(ctx, h, admin, command)
+		assert.Error(t, err, command)
+	}
+
+	// An empty query gets an empty query response.
+	result, err = runQuery(ctx, h, admin, " ; ")
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestAdminConsoleShow(t *testing.T) {
+	ctx := context.Background()
+	h := newConsoleTestHandler(nil)
+	admin := newConsoleTestConn(1, "admin", AdminConsoleDatabase)
+	app := newConsoleTestConn(2, "app", "db1")
+
+	_, err := runQuery(ctx, h, app, "SELECT 1")
+	require.NoError(t, err)
+	h.getConnectionState(app).StoreReservedConnection(
+		&query.Target{TableGroup: "default", Shard: "0-inf"},
+		queryservice.ReservedState{
+			ReservedConnectionId: 26,
+			PoolerID:             &clustermetadata.ID{Component: clustermetadata.ID_MULTIPOOLER, Cell: "zone1", Name: "pooler-1"},
+		})
+
+	pools, err := runQuery(ctx, h, admin, "SHOW POOLS")
+	require.NoError(t, err)
+	assert.Equal(t, "SHOW", pools.CommandTag)
+	assert.Equal(t, []string{"db1", AdminConsoleDatabase}, columnValues(t, pools, "database"))
+	assert.Equal(t, []string{"app", "admin"}, columnValues(t, pools, "user"))
+	assert.Equal(t, []string{"1", "0"}, columnValues(t, pools, "sv_active"))
+	assert.Equal(t, []string{"1", "1"}, columnValues(t, pools, "cl_active"))
+
+	stats, err := runQuery(ctx, h, admin, "show stats")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db1"}, columnValues(t, stats, "database"))
+	assert.Equal(t, []string{"1"}, columnValues(t, stats, "total_query_count"))
+	assert.Equal(t, []string{"1"}, columnValues(t, stats, "total_xact_count"))
+
+	clients, err := runQuery(ctx, h, admin, "SHOW CLIENTS")
+	require.NoError(t, err)
+	assert.Equal(t, []string{AdminConsoleDatabase, "db1"}, columnValues(t, clients, "database"))
+	assert.Equal(t, []string{"active", "active"}, columnValues(t, clients, "state"))
+	assert.Equal(t, []string{"1", "2"}, columnValues(t, clients, "ptr"))
+
+	servers, err := runQuery(ctx, h, admin, "SHOW SERVERS")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db1"}, columnValues(t, servers, "database"))
+	assert.Equal(t, []string{"1a"}, columnValues(t, servers, "ptr"))
+	assert.Equal(t, []string{"2"}, columnValues(t, servers, "link"))
+	assert.Equal(t, []string{"0-inf"}, columnValues(t, servers, "shard"))
+	assert.Contains(t, columnValues(t, servers, "addr")[0], "pooler-1")
+}
+
+func TestAdminConsoleStatsTransactions(t *testing.T) {
+	ctx := context.Background()
+	h := newConsoleTestHandler(nil)
+	app := newConsoleTestConn(2, "app", "db1")
+	st := h.getConnectionState(app)
+
+	_, err := runQuery(ctx, h, app, "SELECT 1")
+	require.NoError(t, err)
+	st.BeginTransaction()
+	_, err = runQuery(ctx, h, app, "SELECT 1")
+	require.NoError(t, err)
+	st.EndTransaction(true)
+	_, err = runQuery(ctx, h, app, "SELECT 1")
+	require.NoError(t, err)
+
+	entries := h.console.stats.snapshot()
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(3), entries[0].queryCount)
+	// The statement run in the block ended no transaction; the one after
+	// it ended the block.
+	assert.Equal(t, int64(2), entries[0].xactCount)
+}
+
+func TestAdminConsolePauseResume(t *testing.T) {
+	ctx := context.Background()
+	h := newConsoleTestHandler(nil)
+	admin := newConsoleTestConn(1, "admin", AdminConsoleDatabase)
+	app := newConsoleTestConn(2, "app", "db1")
+	other := newConsoleTestConn(3, "app", "db2")
+	h.getConnectionState(app)
+
+	result, err := runQuery(ctx, h, admin, "PAUSE db1")
+	require.NoError(t, err)
+	assert.Equal(t, "PAUSE", result.CommandTag)
+	_, err = runQuery(ctx, h, admin, "PAUSE db1")
+	assert.Error(t, err, "already paused")
+
+	// Other databases are not paused.
+	_, err = runQuery(ctx, h, other, "SELECT 1")
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := runQuery(ctx, h, app, "SELECT 1")
+		done <- err
+	}()
+	require.Eventually(t, func() bool {
+		clients, err := runQuery(ctx, h, admin, "SHOW CLIENTS")
+		require.NoError(t, err)
+		return columnValues(t, clients, "state")[1] == "waiting"
+	}, 5*time.Second, 5*time.Millisecond)
+	pools, err := runQuery(ctx, h, admin, "SHOW POOLS")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "0", "0"}, columnValues(t, pools, "cl_waiting"))
+
+	_, err = runQuery(ctx, h, admin, "RESUME db2")
+	assert.Error(t, err, "not paused")
+	result, err = runQuery(ctx, h, admin, "RESUME db1")
+	require.NoError(t, err)
+	assert.Equal(t, "RESUME", result.CommandTag)
+	require.NoError(t, <-done)
+
+	_, err = runQuery(ctx, h, admin, "RESUME")
+	assert.Error(t, err, "nothing paused")
+}
+
+func TestAdminConsolePauseWaitsForTransactions(t *testing.T) {
+	ctx := context.Background()
+	h := newConsoleTestHandler(nil)
+	admin := newConsoleTestConn(1, "admin", AdminConsoleDatabase)
+	app := newConsoleTestConn(2, "app", "db1")
+	st := h.getConnectionState(app)
+	st.BeginTransaction()
+
+	paused := make(chan error, 1)
+	go func() {
+		_, err := runQuery(ctx, h, admin, "PAUSE")
+		paused <- err
+	}()
+
+	// The transaction block still runs its statements.
+	require.Eventually(t, h.console.gate.isPaused, 5*time.Second, time.Millisecond)
+	_, err := runQuery(ctx, h, app, "SELECT 1")
+	require.NoError(t, err)
+	select {
+	case <-paused:
+		require.Fail(t, "PAUSE returned with a transaction open")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	st.EndTransaction(true)
+	require.NoError(t, <-paused)
+
+	// Statements outside a transaction block wait until cancelled.
+	cancelCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = runQuery(cancelCtx, h, app, "SELECT 1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, waiting := h.console.gate.waitingSince(app.ConnectionID())
+	assert.False(t, waiting)
+}
+
+func TestAdminConsoleKill(t *testing.T) {
+	ctx := context.Background()
+	h := newConsoleTestHandler(nil)
+	admin := newConsoleTestConn(1, "admin", AdminConsoleDatabase)
+	h.getConnectionState(newConsoleTestConn(2, "app", "db1"))
+	h.getConnectionState(newConsoleTestConn(3, "app", "db2"))
+
+	result, err := runQuery(ctx, h, admin, "KILL db1")
+	require.NoError(t, err)
+	assert.Equal(t, "KILL", result.CommandTag)
+	assert.True(t, h.console.gate.isPaused(), "a killed database waits for RESUME")
+	_, err = runQuery(ctx, h, admin, "RESUME db1")
+	require.NoError(t, err)
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// ConnectionLimits limits the number of client connections the gateway
+// admits per role and per database, so that one tenant cannot take all the
+// connections of a gateway shared by several. Every open connection counts,
+// whether it is running a query, waiting for a backend connection or idle.
+// A limit of 0 means no limit.
+type ConnectionLimits struct {
+	// MaxPerUser is the limit of roles without their own in Users.
+	MaxPerUser int
+	// MaxPerDatabase is the limit of databases without their own in
+	// Databases.
+	MaxPerDatabase int
+	// Users are the limits of specific roles.
+	Users map[string]int
+	// Databases are the limits of specific databases.
+	Databases map[string]int
+}
+
+// ParseConnectionLimits parses limits given as "name=limit" entries, as in
+// "app=100".
+func ParseConnectionLimits(specs []string) (map[string]int, error) {
+	limits := make(map[string]int, len(specs))
+	for _, spec := range specs {
+		name, value, found := strings.Cut(strings.TrimSpace(spec), "=")
+		if name == "" || !found {
+			return nil, fmt.Errorf("invalid connection limit %q: expected name=limit", spec)
+		}
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid connection limit %q: the limit must be a non-negative integer", spec)
+		}
+		limits[name] = limit
+	}
+	return limits, nil
+}
+
+// connectionLimiter counts the connections admitted per role and per
+// database against ConnectionLimits.
+type connectionLimiter struct {
+	mu     sync.Mutex
+	limits ConnectionLimits
+	// users and databases count the admitted connections.
+	users     map[string]int
+	databases map[string]int
+	// admitted holds the connections counted, by connection ID, so that
+	// only those are released when they close.
+	admitted map[uint32]struct{}
+}
+
+// setLimits replaces the limits. Connections already admitted stay open
+// even if they are over the new limits.
+func (l *connectionLimiter) setLimits(limits ConnectionLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits = limits
+}
+
+// admit counts conn, or returns the too_many_connections error PostgreSQL
+// reports if its role or database is at its limit.
+func (l *connectionLimiter) admit(conn *server.Conn) error {
+	user, database := conn.User(), conn.Database()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limit, flag := connectionLimit(l.limits.Users, user, "user-connection-limits", l.limits.MaxPerUser, "max-connections-per-user")
+	if limit > 0 && l.users[user] >= limit {
+		return errTooManyConnections("role", user, limit, flag)
+	}
+	limit, flag = connectionLimit(l.limits.Databases, database, "database-connection-limits", l.limits.MaxPerDatabase, "max-connections-per-database")
+	if limit > 0 && l.databases[database] >= limit {
+		return errTooManyConnections("database", database, limit, flag)
+	}
+
+	if l.admitted == nil {
+		l.users = make(map[string]int)
+		l.databases = make(map[string]int)
+		l.admitted = make(map[uint32]struct{})
+	}
+	l.users[user]++
+	l.databases[database]++
+	l.admitted[conn.ConnectionID()] = struct{}{}
+	return nil
+}
+
+// release uncounts conn if it was admitted.
+func (l *connectionLimiter) release(conn *server.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.admitted[conn.ConnectionID()]; !ok {
+		return
+	}
+	delete(l.admitted, conn.ConnectionID())
+	user, database := conn.User(), conn.Database()
+	if l.users[user]--; l.users[user] == 0 {
+		delete(l.users, user)
+	}
+	if l.databases[database]--; l.databases[database] == 0 {
+		delete(l.databases, database)
+	}
+}
+
+// connectionLimit returns the limit of name, from limits if it has its own
+// or otherwise the default, along with the flag that sets it.
+func connectionLimit(limits map[string]int, name, flag string, def int, defFlag string) (int, string) {
+	if limit, ok := limits[name]; ok {
+		return limit, flag
+	}
+	return def, defFlag
+}
+
+// errTooManyConnections returns the error a connection over the limit of
+// the named role or database is rejected with.
+func errTooManyConnections(kind, name string, limit int, flag string) error {
+	return sqlstate.NewError(sqlstate.TooManyConnections).
+		Severity("FATAL").
+		Msg("too many connections for %s \"%s\"", kind, name).
+		Detail("The %s is limited to %d connections.", kind, limit).
+		Hint("Close idle connections, or raise --%s of the gateway.", flag).
+		Err()
+}
+
+// SetConnectionLimits limits the number of client connections admitted per
+// role and per database. Connections over a limit are rejected once
+// authenticated with a too_many_connections error.
+func (h *MultiGatewayHandler) SetConnectionLimits(limits ConnectionLimits) {
+	h.limiter.setLimits(limits)
+}
+
+// HandleConnectionStart admits a newly authenticated connection if its role
+// and database are within their connection limits. Connections to the admin
+// console are not limited, only restricted to its users.
+func (h *MultiGatewayHandler) HandleConnectionStart(ctx context.Context, conn *server.Conn) error {
+	if h.isAdminConsole(conn) {
+		return h.admitAdminConsole(conn)
+	}
+	return h.limiter.admit(conn)
+}
+
+// Ensure MultiGatewayHandler enforces connection limits at startup.
+var _ server.ConnectionStartHandler = (*MultiGatewayHandler)(nil)
@@ -0,0 +1,115 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestParseConnectionLimits(t *testing.T) {
+	limits, err := ParseConnectionLimits([]string{"app=10", " reports=0 "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"app": 10, "reports": 0}, limits)
+
+	for _, spec := range []string{"app", "=10", "app=", "app=-1", "app=ten"} {
+		_, err := ParseConnectionLimits([]string{spec})
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestHandlerConnectionLimits(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	h.SetConnectionLimits(ConnectionLimits{
+		MaxPerUser:     2,
+		MaxPerDatabase: 3,
+		Users:          map[string]int{"admin": 0},
+		Databases:      map[string]int{"small": 1},
+	})
+
+	var nextID uint32
+	connect := func(user, database string) (*server.Conn, error) {
+		nextID++
+		tc := server.NewTestConn(&bytes.Buffer{})
+		tc.SetConnectionID(nextID)
+		tc.SetUser(user, database)
+		return tc.Conn, h.HandleConnectionStart(context.Background(), tc.Conn)
+	}
+	requireTooManyConnections := func(t *testing.T, err error, message, hint string) {
+		t.Helper()
+		var diag *sqltypes.PgDiagnostic
+		require.True(t, errors.As(err, &diag), "expected a PgDiagnostic, got %v", err)
+		assert.Equal(t, sqlstate.TooManyConnections, diag.Code)
+		assert.Equal(t, "FATAL", diag.Severity)
+		assert.Equal(t, message, diag.Message)
+		assert.Contains(t, diag.Hint, hint)
+	}
+
+	// The per-user default applies to app.
+	app1, err := connect("app", "db")
+	require.NoError(t, err)
+	_, err = connect("app", "db")
+	require.NoError(t, err)
+	rejected, err := connect("app", "db")
+	requireTooManyConnections(t, err, `too many connections for role "app"`, "--max-connections-per-user")
+
+	// Closing a rejected connection does not free a slot.
+	h.HandleConnectionClose(rejected)
+	_, err = connect("app", "db")
+	requireTooManyConnections(t, err, `too many connections for role "app"`, "--max-connections-per-user")
+
+	// The per-database default applies across roles.
+	_, err = connect("other", "db")
+	require.NoError(t, err)
+	_, err = connect("other", "db")
+	requireTooManyConnections(t, err, `too many connections for database "db"`, "--max-connections-per-database")
+
+	// Closing an admitted connection frees its slots.
+	h.HandleConnectionClose(app1)
+	_, err = connect("app", "db")
+	require.NoError(t, err)
+
+	// Specific limits override the defaults, and 0 means no limit.
+	for range 3 {
+		_, err = connect("admin", "admindb")
+		require.NoError(t, err)
+	}
+	_, err = connect("app", "small")
+	requireTooManyConnections(t, err, `too many connections for role "app"`, "--max-connections-per-user")
+	_, err = connect("other", "small")
+	require.NoError(t, err)
+	_, err = connect("admin", "small")
+	requireTooManyConnections(t, err, `too many connections for database "small"`, "--database-connection-limits")
+}
+
+func TestHandlerConnectionLimitsUnset(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	for i := range 10 {
+		tc := server.NewTestConn(&bytes.Buffer{})
+		tc.SetConnectionID(uint32(i))
+		tc.SetUser("app", "db")
+		require.NoError(t, h.HandleConnectionStart(context.Background(), tc.Conn))
+	}
+}
@@ -16,8 +16,11 @@ package handler
 
 import (
 	"maps"
+	"slices"
 	"sync"
+	"time"
 
+	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/protoutil"
 	"github.com/multigres/multigres/go/common/queryservice"
@@ -44,11 +47,38 @@ type MultiGatewayConnectionState struct {
 	// It keeps track of any reserved connections on each Shard currently open.
 	ShardStates []*ShardState
 
-	// SessionSettings stores session variables set via SET commands.
-	// These settings are propagated to multipooler to ensure the correct
-	// pooled connection (with matching settings) is reused.
-	// Map keys are variable names, values are the string representation.
-	SessionSettings map[string]string
+	// Variables tracks the session's run-time parameters: those from the
+	// startup message and those changed with SET, RESET and set_config().
+	// The merged settings are propagated to multipooler to ensure the
+	// correct pooled connection (with matching settings) is reused.
+	Variables *SessionVariables
+
+	// txnFailed is set when a statement fails inside a transaction block,
+	// until the block ends or rolls back to a savepoint (see
+	// TransactionStatus).
+	txnFailed bool
+
+	// replicaTransaction is set while a transaction block runs on replicas
+	// (see SetReplicaTransaction).
+	replicaTransaction bool
+
+	// implicitTransaction is set while the statements of a multi-statement
+	// simple query run in an implicit transaction block (see
+	// BeginImplicitTransaction).
+	implicitTransaction bool
+
+	// writtenTables holds the tables written in the transaction block,
+	// whose cached results are invalidated again once it ends.
+	writtenTables map[string]struct{}
+
+	// query is the statement running, or the last one run, and queryStart
+	// when it started. active is set while it runs.
+	query      string
+	queryStart time.Time
+	active     bool
+	// xactStart is when the first statement of the transaction the session
+	// is in started, or zero between transactions.
+	xactStart time.Time
 }
 
 type ShardState struct {
@@ -62,13 +92,69 @@ type ShardState struct {
 
 	// ReservedConnectionId is the connection ID of the reserved connection being held.
 	ReservedConnectionId int64
+
+	// HoldsAdvisoryLocks is set while the session may hold session-level
+	// advisory locks on the reserved connection. The connection is then
+	// pinned to the session until the locks are released, so that pooling
+	// does not silently drop them.
+	HoldsAdvisoryLocks bool
+
+	// TempTables holds the names of the temporary relations the session has
+	// created on the reserved connection. They only exist on that backend,
+	// so the connection is pinned to the session while any are left.
+	TempTables map[string]struct{}
+
+	// Cursors holds the SQL cursors open on the reserved connection, mapped
+	// to whether they are WITH HOLD. Like temporary relations, they only
+	// exist on that backend and keep the connection pinned to the session.
+	Cursors map[string]bool
+
+	// SessionPinned is set while the multipooler has the reserved connection
+	// pinned to the session, i.e. between ReserveConnection and
+	// ReleaseReservedConnection.
+	SessionPinned bool
+
+	// Variables holds the session variables in effect on the reserved
+	// connection: those the pooler applied when reserving it, updated as
+	// the gateway replays the ones changed since. The pooler ignores the
+	// settings sent with statements for a reserved connection.
+	Variables *VariablesSnapshot
+
+	// ImplicitTransaction is set while the statements of a multi-statement
+	// simple query run on the reserved connection in an implicit
+	// transaction, which keeps the connection pinned until it ends.
+	ImplicitTransaction bool
+
+	// Savepoints holds the savepoints established on the reserved
+	// connection in the current transaction, oldest first. A shard that
+	// joined the transaction after a savepoint lacks it.
+	Savepoints []string
+}
+
+// HasSavepoint returns true if the savepoint is established on the
+// reserved connection.
+func (ss *ShardState) HasSavepoint(name string) bool {
+	return slices.Contains(ss.Savepoints, name)
+}
+
+// NeedsPin returns true if session state lives on the reserved connection,
+// advisory locks, temporary relations, cursors or an implicit transaction,
+// so it must stay pinned.
+func (ss *ShardState) NeedsPin() bool {
+	return ss.HoldsAdvisoryLocks || len(ss.TempTables) > 0 || len(ss.Cursors) > 0 || ss.ImplicitTransaction
+}
+
+// IsPinned returns true if the reserved connection is pinned to the session.
+func (ss *ShardState) IsPinned() bool {
+	return ss.SessionPinned || ss.NeedsPin()
 }
 
 // NewMultiGatewayConnectionState creates a new MultiGatewayConnectionState.
 func NewMultiGatewayConnectionState() *MultiGatewayConnectionState {
 	return &MultiGatewayConnectionState{
-		mu:      sync.Mutex{},
-		Portals: make(map[string]*preparedstatement.PortalInfo),
+		mu:        sync.Mutex{},
+		Portals:   make(map[string]*preparedstatement.PortalInfo),
+		Variables: NewSessionVariables(nil),
 	}
 }
 
@@ -118,6 +204,11 @@ func (m *MultiGatewayConnectionState) StoreReservedConnection(target *query.Targ
 	defer m.mu.Unlock()
 	for _, ss := range m.ShardStates {
 		if protoutil.TargetEquals(ss.Target, target) {
+			if ss.ReservedConnectionId != int64(rs.ReservedConnectionId) {
+				// The pooler applied the session's settings to the new
+				// connection when reserving it.
+				ss.Variables = m.variables().Snapshot()
+			}
 			ss.PoolerID = rs.PoolerID
 			ss.ReservedConnectionId = int64(rs.ReservedConnectionId)
 			return
@@ -126,16 +217,21 @@ func (m *MultiGatewayConnectionState) StoreReservedConnection(target *query.Targ
 	ss := NewShardState(target)
 	ss.PoolerID = rs.PoolerID
 	ss.ReservedConnectionId = int64(rs.ReservedConnectionId)
+	ss.Variables = m.variables().Snapshot()
 	m.ShardStates = append(m.ShardStates, ss)
 }
 
 // ClearReservedConnection removes a reserved connection for a given target.
 // This should be called when a reserved connection is released (e.g., after COPY completes).
+// A connection pinned to the session is kept.
 func (m *MultiGatewayConnectionState) ClearReservedConnection(target *query.Target) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	for i, ss := range m.ShardStates {
 		if protoutil.TargetEquals(ss.Target, target) {
+			if ss.IsPinned() {
+				return
+			}
 			// Remove by swapping with last element and truncating
 			lastIdx := len(m.ShardStates) - 1
 			if i != lastIdx {
@@ -147,70 +243,549 @@ func (m *MultiGatewayConnectionState) ClearReservedConnection(target *query.Targ
 	}
 }
 
+// beginQuery records that the session runs query, until endQuery.
+func (m *MultiGatewayConnectionState) beginQuery(query string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.query = query
+	m.queryStart = time.Now()
+	m.active = true
+	if m.xactStart.IsZero() {
+		m.xactStart = m.queryStart
+	}
+}
+
+// endQuery records that the query of the session is done. It returns how
+// long the query ran and, if the session is then outside a transaction
+// block, how long the transaction that ended took; xactDone is false if
+// the transaction is still open.
+func (m *MultiGatewayConnectionState) endQuery() (queryTime, xactTime time.Duration, xactDone bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	m.active = false
+	queryTime = now.Sub(m.queryStart)
+	if m.transactionStatus() != TransactionIdle {
+		return queryTime, 0, false
+	}
+	xactTime = now.Sub(m.xactStart)
+	m.xactStart = time.Time{}
+	return queryTime, xactTime, true
+}
+
+// activity returns the state of the session as pg_stat_activity names it,
+// and the statement running or last run, with when it started.
+func (m *MultiGatewayConnectionState) activity() (state, query string, start time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch {
+	case m.active:
+		state = "active"
+	case m.transactionStatus() == TransactionInBlock:
+		state = "idle in transaction"
+	case m.transactionStatus() == TransactionFailed:
+		state = "idle in transaction (aborted)"
+	default:
+		state = "idle"
+	}
+	return state, m.query, m.queryStart
+}
+
+// reservedConnectionCount returns the number of reserved connections the
+// session holds.
+func (m *MultiGatewayConnectionState) reservedConnectionCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, ss := range m.ShardStates {
+		if ss.ReservedConnectionId != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// reservedConnection is a backend connection reserved for a session.
+type reservedConnection struct {
+	target   *query.Target
+	poolerID *clustermetadata.ID
+	id       int64
+}
+
+// reservedConnections returns the reserved connections the session holds.
+func (m *MultiGatewayConnectionState) reservedConnections() []reservedConnection {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var conns []reservedConnection
+	for _, ss := range m.ShardStates {
+		if ss.ReservedConnectionId != 0 {
+			conns = append(conns, reservedConnection{target: ss.Target, poolerID: ss.PoolerID, id: ss.ReservedConnectionId})
+		}
+	}
+	return conns
+}
+
+// SetHoldsAdvisoryLocks records whether the session may hold session-level
+// advisory locks on the reserved connection for a given target.
+// The reserved connection must have been stored first.
+func (m *MultiGatewayConnectionState) SetHoldsAdvisoryLocks(target *query.Target, held bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			ss.HoldsAdvisoryLocks = held
+			return
+		}
+	}
+}
+
+// HoldsAdvisoryLocks returns true if the session may hold session-level
+// advisory locks on the given target.
+func (m *MultiGatewayConnectionState) HoldsAdvisoryLocks(target *query.Target) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			return ss.HoldsAdvisoryLocks
+		}
+	}
+	return false
+}
+
+// SetSessionPinned records whether the multipooler has the reserved
+// connection for a given target pinned to the session.
+// The reserved connection must have been stored first.
+func (m *MultiGatewayConnectionState) SetSessionPinned(target *query.Target, pinned bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			ss.SessionPinned = pinned
+			return
+		}
+	}
+}
+
+// SetImplicitTransaction records whether an implicit transaction is open on
+// the reserved connection for a given target.
+// The reserved connection must have been stored first.
+func (m *MultiGatewayConnectionState) SetImplicitTransaction(target *query.Target, open bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			ss.ImplicitTransaction = open
+			return
+		}
+	}
+}
+
+// AddTempTables records temporary relations created on the reserved
+// connection for a given target. The reserved connection must have been
+// stored first.
+func (m *MultiGatewayConnectionState) AddTempTables(target *query.Target, names ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			if ss.TempTables == nil {
+				ss.TempTables = make(map[string]struct{})
+			}
+			for _, name := range names {
+				ss.TempTables[name] = struct{}{}
+			}
+			return
+		}
+	}
+}
+
+// DropTempTables forgets dropped temporary relations for a given target.
+// Names that are not known temporary relations are ignored.
+func (m *MultiGatewayConnectionState) DropTempTables(target *query.Target, names ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			for _, name := range names {
+				delete(ss.TempTables, name)
+			}
+			return
+		}
+	}
+}
+
+// ClearTempTables forgets all temporary relations for a given target, e.g.
+// after DISCARD TEMP or when their backend is gone.
+func (m *MultiGatewayConnectionState) ClearTempTables(target *query.Target) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			ss.TempTables = nil
+			return
+		}
+	}
+}
+
+// GetTempTables returns the sorted names of the temporary relations the
+// session has on the given target.
+func (m *MultiGatewayConnectionState) GetTempTables(target *query.Target) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			return slices.Sorted(maps.Keys(ss.TempTables))
+		}
+	}
+	return nil
+}
+
+// AddCursor records a cursor declared on the reserved connection for a given
+// target. The reserved connection must have been stored first.
+func (m *MultiGatewayConnectionState) AddCursor(target *query.Target, name string, holdable bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			if ss.Cursors == nil {
+				ss.Cursors = make(map[string]bool)
+			}
+			ss.Cursors[name] = holdable
+			return
+		}
+	}
+}
+
+// CloseCursor forgets a closed cursor for a given target.
+func (m *MultiGatewayConnectionState) CloseCursor(target *query.Target, name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			delete(ss.Cursors, name)
+			return
+		}
+	}
+}
+
+// CloseCursors forgets the cursors for a given target: all of them, or only
+// those that are not WITH HOLD, which end with their transaction.
+func (m *MultiGatewayConnectionState) CloseCursors(target *query.Target, includeHoldable bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			maps.DeleteFunc(ss.Cursors, func(_ string, holdable bool) bool {
+				return includeHoldable || !holdable
+			})
+			return
+		}
+	}
+}
+
+// GetCursors returns the sorted names of the cursors the session has open
+// on the given target.
+func (m *MultiGatewayConnectionState) GetCursors(target *query.Target) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			return slices.Sorted(maps.Keys(ss.Cursors))
+		}
+	}
+	return nil
+}
+
+// IsPinned returns true if the session's reserved connection for the given
+// target is pinned to it.
+func (m *MultiGatewayConnectionState) IsPinned(target *query.Target) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			return ss.IsPinned()
+		}
+	}
+	return false
+}
+
+// SetStartupParams seeds the session's run-time parameters with the
+// parameters of the client's startup message, discarding any tracked changes.
+func (m *MultiGatewayConnectionState) SetStartupParams(params map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Variables = NewSessionVariables(params)
+}
+
 // SetSessionVariable sets a session variable (from SET command).
 // The variable name and value are stored to be propagated to multipooler.
 func (m *MultiGatewayConnectionState) SetSessionVariable(name, value string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.SessionSettings == nil {
-		m.SessionSettings = make(map[string]string)
-	}
-	m.SessionSettings[name] = value
+	m.variables().Set(name, value)
+}
+
+// SetLocalVariable sets a variable until the end of the current
+// transaction (from SET LOCAL command).
+func (m *MultiGatewayConnectionState) SetLocalVariable(name, value string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().SetLocal(name, value)
 }
 
-// ResetSessionVariable removes a session variable (from RESET command).
+// ResetSessionVariable returns a session variable to its startup value
+// (from RESET command).
 func (m *MultiGatewayConnectionState) ResetSessionVariable(name string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.SessionSettings != nil {
-		delete(m.SessionSettings, name)
-	}
+	m.variables().Reset(name)
 }
 
-// ResetAllSessionVariables clears all session variables (from RESET ALL command).
+// ResetAllSessionVariables returns all session variables to their startup
+// values (from RESET ALL command).
 func (m *MultiGatewayConnectionState) ResetAllSessionVariables() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.SessionSettings = make(map[string]string)
+	m.variables().ResetAll()
 }
 
-// GetSessionSettings returns a copy of the current session settings.
-// Returns nil if no settings have been set.
-// The copy prevents external mutation of the internal state.
+// GetSessionSettings returns the settings to apply on a pooled connection
+// before running a statement of this session: the startup parameters
+// merged with the variables set since. Returns nil if there are none.
+// The result is a copy, so it can be modified freely.
 func (m *MultiGatewayConnectionState) GetSessionSettings() map[string]string {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if len(m.SessionSettings) == 0 {
-		return nil
-	}
-	// Return copy to prevent external mutation
-	settings := make(map[string]string, len(m.SessionSettings))
-	maps.Copy(settings, m.SessionSettings)
-	return settings
+	return m.variables().Settings()
 }
 
-// GetSessionVariable returns the value of a specific session variable.
+// GetSessionVariable returns the current value of a specific session
+// variable, including values set with SET LOCAL.
 // Returns (value, true) if exists, ("", false) if not.
 func (m *MultiGatewayConnectionState) GetSessionVariable(name string) (string, bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.SessionSettings == nil {
-		return "", false
-	}
-	value, exists := m.SessionSettings[name]
-	return value, exists
+	return m.variables().Get(name)
 }
 
-// RestoreSessionSettings replaces the current session settings with a new map.
-// Used for rolling back RESET ALL failures.
+// RestoreSessionSettings replaces the variables set during the session,
+// as returned by SessionVariables.SessionLayer.
 func (m *MultiGatewayConnectionState) RestoreSessionSettings(settings map[string]string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if settings == nil {
-		m.SessionSettings = nil
-	} else {
-		// Make a copy to prevent external mutation
-		m.SessionSettings = make(map[string]string, len(settings))
-		maps.Copy(m.SessionSettings, settings)
+	m.variables().RestoreSessionLayer(settings)
+}
+
+// SetSessionLabel sets the label appended to the application_name of the
+// session's backend connections, so that they can be told apart there.
+func (m *MultiGatewayConnectionState) SetSessionLabel(label string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().SetLabel(label)
+}
+
+// SnapshotVariables returns a snapshot of the session's variables, e.g. to
+// hand the session over to another connection state with RestoreVariables.
+func (m *MultiGatewayConnectionState) SnapshotVariables() *VariablesSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.variables().Snapshot()
+}
+
+// RestoreVariables replaces the session's startup parameters and variables
+// with those of a snapshot. Reserved connections catch up before their next
+// statement (see ShardState.Variables).
+func (m *MultiGatewayConnectionState) RestoreVariables(snapshot *VariablesSnapshot) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().Restore(snapshot)
+}
+
+// SetShardVariables records the variables in effect on the reserved
+// connection for a given target. The reserved connection must have been
+// stored first.
+func (m *MultiGatewayConnectionState) SetShardVariables(target *query.Target, snapshot *VariablesSnapshot) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if protoutil.TargetEquals(ss.Target, target) {
+			ss.Variables = snapshot
+			return
+		}
+	}
+}
+
+// BeginTransaction records the start of a transaction block.
+func (m *MultiGatewayConnectionState) BeginTransaction() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.variables().InTransaction() {
+		m.clearShardSavepoints()
+	}
+	m.variables().BeginTransaction()
+}
+
+// BeginImplicitTransaction records the start of the implicit transaction
+// block of a multi-statement simple query. Like an explicit one, each shard
+// joins it when a statement first reaches it, and it ends on every shard
+// that joined.
+func (m *MultiGatewayConnectionState) BeginImplicitTransaction() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.variables().InTransaction() {
+		m.clearShardSavepoints()
+	}
+	m.variables().BeginTransaction()
+	m.implicitTransaction = true
+}
+
+// InImplicitTransaction returns true inside the implicit transaction block
+// of a multi-statement simple query.
+func (m *MultiGatewayConnectionState) InImplicitTransaction() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.implicitTransaction
+}
+
+// InTransaction returns true inside a transaction block.
+func (m *MultiGatewayConnectionState) InTransaction() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.variables().InTransaction()
+}
+
+// EndTransaction records the end of the transaction block; variables set
+// during it are reverted unless it committed. A failed block cannot commit:
+// PostgreSQL rolls it back on COMMIT.
+func (m *MultiGatewayConnectionState) EndTransaction(commit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().EndTransaction(commit && !m.txnFailed)
+	m.txnFailed = false
+	m.replicaTransaction = false
+	m.implicitTransaction = false
+	m.clearShardSavepoints()
+}
+
+// RecordWrittenTables records tables as written in the transaction block.
+func (m *MultiGatewayConnectionState) RecordWrittenTables(tables ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.writtenTables == nil {
+		m.writtenTables = make(map[string]struct{})
+	}
+	for _, table := range tables {
+		m.writtenTables[table] = struct{}{}
+	}
+}
+
+// TakeWrittenTables returns the tables recorded as written, forgetting
+// them.
+func (m *MultiGatewayConnectionState) TakeWrittenTables() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tables := make([]string, 0, len(m.writtenTables))
+	for table := range m.writtenTables {
+		tables = append(tables, table)
+	}
+	m.writtenTables = nil
+	return tables
+}
+
+// TransactionParticipants returns the targets of the shards participating
+// in the current transaction block: those the session holds a reserved
+// connection to, on which the statements of the block run. It returns nil
+// outside a transaction block.
+func (m *MultiGatewayConnectionState) TransactionParticipants() []*query.Target {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.variables().InTransaction() {
+		return nil
+	}
+	var targets []*query.Target
+	for _, ss := range m.ShardStates {
+		if ss.ReservedConnectionId != 0 {
+			targets = append(targets, ss.Target)
+		}
+	}
+	return targets
+}
+
+// RecordShardSavepoint records a SAVEPOINT, RELEASE or ROLLBACK TO that
+// succeeded on the reserved connection for a given target. As in
+// PostgreSQL, RELEASE and ROLLBACK TO act on the newest savepoint with the
+// name, and discard the savepoints established after it.
+func (m *MultiGatewayConnectionState) RecordShardSavepoint(target *query.Target, kind ast.TransactionStmtKind, name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ss := range m.ShardStates {
+		if !protoutil.TargetEquals(ss.Target, target) {
+			continue
+		}
+		switch kind {
+		case ast.TRANS_STMT_SAVEPOINT:
+			ss.Savepoints = append(ss.Savepoints, name)
+		case ast.TRANS_STMT_ROLLBACK_TO:
+			if i := lastSavepointIndex(ss.Savepoints, name); i >= 0 {
+				ss.Savepoints = ss.Savepoints[:i+1]
+			}
+		case ast.TRANS_STMT_RELEASE:
+			if i := lastSavepointIndex(ss.Savepoints, name); i >= 0 {
+				ss.Savepoints = ss.Savepoints[:i]
+			}
+		}
+		return
+	}
+}
+
+// lastSavepointIndex returns the index of the newest savepoint with the given
+// name, or -1.
+func lastSavepointIndex(savepoints []string, name string) int {
+	for i := len(savepoints) - 1; i >= 0; i-- {
+		if savepoints[i] == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// clearShardSavepoints forgets the savepoints of every shard, at the
+// boundaries of a transaction block. The caller must hold the mutex.
+func (m *MultiGatewayConnectionState) clearShardSavepoints() {
+	for _, ss := range m.ShardStates {
+		ss.Savepoints = nil
+	}
+}
+
+// Savepoint records a savepoint in the current transaction.
+func (m *MultiGatewayConnectionState) Savepoint(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().Savepoint(name)
+}
+
+// RollbackToSavepoint reverts the variables set after a savepoint. This
+// recovers a failed transaction block.
+func (m *MultiGatewayConnectionState) RollbackToSavepoint(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().RollbackToSavepoint(name)
+	m.txnFailed = false
+}
+
+// ReleaseSavepoint forgets a savepoint, keeping the variables set after it.
+func (m *MultiGatewayConnectionState) ReleaseSavepoint(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.variables().ReleaseSavepoint(name)
+}
+
+// variables returns the session's variables, creating them for a state
+// that was not made by NewMultiGatewayConnectionState. The caller must hold
+// the mutex.
+func (m *MultiGatewayConnectionState) variables() *SessionVariables {
+	if m.Variables == nil {
+		m.Variables = NewSessionVariables(nil)
 	}
+	return m.Variables
 }
@@ -20,9 +20,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/protoutil"
+	"github.com/multigres/multigres/go/common/queryservice"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
 )
 
 func TestNewMultiGatewayConnectionState(t *testing.T) {
@@ -195,3 +198,122 @@ func TestMultiGatewayConnectionState_PortalInfoIntegrity(t *testing.T) {
 	retrievedParams := sqltypes.ParamsFromProto(retrieved.ParamLengths, retrieved.ParamValues)
 	require.Equal(t, params, retrievedParams)
 }
+
+func TestMultiGatewayConnectionState_AdvisoryLocksPinReservedConnection(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	target := &query.Target{TableGroup: "default"}
+
+	// Nothing to mark before a reserved connection is stored.
+	state.SetHoldsAdvisoryLocks(target, true)
+	require.False(t, state.HoldsAdvisoryLocks(target))
+
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 7})
+	state.SetHoldsAdvisoryLocks(target, true)
+	require.True(t, state.HoldsAdvisoryLocks(target))
+
+	// A connection holding advisory locks survives the end of a COPY.
+	state.ClearReservedConnection(target)
+	ss := state.GetMatchingShardState(target)
+	require.NotNil(t, ss)
+	require.Equal(t, int64(7), ss.ReservedConnectionId)
+
+	state.SetHoldsAdvisoryLocks(target, false)
+	state.ClearReservedConnection(target)
+	require.Nil(t, state.GetMatchingShardState(target))
+}
+
+func TestMultiGatewayConnectionState_TempTablesPinReservedConnection(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	target := &query.Target{TableGroup: "default"}
+
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 7})
+	state.AddTempTables(target, "t2", "t1")
+	require.True(t, state.IsPinned(target))
+	require.Equal(t, []string{"t1", "t2"}, state.GetTempTables(target))
+
+	// Dropping an unknown relation changes nothing.
+	state.DropTempTables(target, "t1", "permanent")
+	require.Equal(t, []string{"t2"}, state.GetTempTables(target))
+
+	// A connection with temporary tables survives the end of a COPY.
+	state.ClearReservedConnection(target)
+	require.NotNil(t, state.GetMatchingShardState(target))
+
+	state.ClearTempTables(target)
+	require.False(t, state.IsPinned(target))
+	state.ClearReservedConnection(target)
+	require.Nil(t, state.GetMatchingShardState(target))
+}
+
+func TestMultiGatewayConnectionState_CursorsPinReservedConnection(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	target := &query.Target{TableGroup: "default"}
+
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 7})
+	state.AddCursor(target, "held", true)
+	state.AddCursor(target, "txn", false)
+	require.True(t, state.IsPinned(target))
+	require.Equal(t, []string{"held", "txn"}, state.GetCursors(target))
+
+	// The end of the transaction closes the cursors that are not WITH HOLD.
+	state.CloseCursors(target, false)
+	require.Equal(t, []string{"held"}, state.GetCursors(target))
+
+	state.CloseCursor(target, "held")
+	require.False(t, state.IsPinned(target))
+
+	state.AddCursor(target, "a", true)
+	state.CloseCursors(target, true)
+	require.Empty(t, state.GetCursors(target))
+}
+
+func TestMultiGatewayConnectionState_TransactionParticipants(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	shard0 := &query.Target{TableGroup: "tg", Shard: "0"}
+	shard1 := &query.Target{TableGroup: "tg", Shard: "1"}
+	state.StoreReservedConnection(shard0, queryservice.ReservedState{ReservedConnectionId: 1})
+
+	// Outside a transaction block nothing participates.
+	require.Nil(t, state.TransactionParticipants())
+
+	state.BeginTransaction()
+	state.StoreReservedConnection(shard1, queryservice.ReservedState{ReservedConnectionId: 2})
+	require.Equal(t, []*query.Target{shard0, shard1}, state.TransactionParticipants())
+}
+
+func TestMultiGatewayConnectionState_ShardSavepoints(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	target := &query.Target{TableGroup: "tg", Shard: "0"}
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 1})
+	state.BeginTransaction()
+	ss := state.GetMatchingShardState(target)
+
+	for _, name := range []string{"a", "b", "a", "c"} {
+		state.RecordShardSavepoint(target, ast.TRANS_STMT_SAVEPOINT, name)
+	}
+	require.Equal(t, []string{"a", "b", "a", "c"}, ss.Savepoints)
+
+	// ROLLBACK TO keeps the newest savepoint with the name.
+	state.RecordShardSavepoint(target, ast.TRANS_STMT_ROLLBACK_TO, "a")
+	require.Equal(t, []string{"a", "b", "a"}, ss.Savepoints)
+
+	// RELEASE discards it along with the ones after it.
+	state.RecordShardSavepoint(target, ast.TRANS_STMT_RELEASE, "b")
+	require.Equal(t, []string{"a"}, ss.Savepoints)
+	require.True(t, ss.HasSavepoint("a"))
+	require.False(t, ss.HasSavepoint("b"))
+
+	// The savepoints end with the transaction.
+	state.EndTransaction(true)
+	require.Empty(t, ss.Savepoints)
+}
+
+func TestMultiGatewayConnectionState_WrittenTables(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	require.Empty(t, state.TakeWrittenTables())
+
+	state.RecordWrittenTables("users", "orders")
+	state.RecordWrittenTables("users")
+	require.ElementsMatch(t, []string{"users", "orders"}, state.TakeWrittenTables())
+	require.Empty(t, state.TakeWrittenTables())
+}
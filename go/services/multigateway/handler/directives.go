@@ -0,0 +1,133 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// queryDirectivesComment is the comment a statement gives the gateway
+// directives in, such as /*+ multigres: shard=-80 timeout=5s */.
+var queryDirectivesComment = regexp.MustCompile(`(?is)/\*\+\s*multigres\s*:(.*?)\*/`)
+
+// QueryDirectives are the settings a statement gives the gateway for itself
+// alone, in a comment that PostgreSQL ignores:
+//
+//	/*+ multigres: shard=-80 scatter=off timeout=5s target=replica cache=30s */
+//
+// The directives are separated by spaces or commas. A query string holding
+// several statements gives its directives to all of them.
+type QueryDirectives struct {
+	// Shard is the shard of the default tablegroup the statement runs on,
+	// whatever its shard key values, or empty to route it by them.
+	Shard string
+
+	// NoScatter fails the statement, rather than running it, if it would
+	// run on several shards (scatter=off).
+	NoScatter bool
+
+	// Timeout replaces the statement timeout of the session if HasTimeout;
+	// zero disables it.
+	Timeout    time.Duration
+	HasTimeout bool
+
+	// Target, unless UNKNOWN, is the type of pooler the statement reads
+	// from outside transactions, as TargetVariable sets for the session.
+	Target clustermetadatapb.PoolerType
+
+	// Cache caches the result of the read for CacheTTL, or for the time of
+	// the result cache if zero, like the multigres_cache hint.
+	Cache    bool
+	CacheTTL time.Duration
+}
+
+// HasQueryDirectives returns true if sql gives the gateway directives.
+func HasQueryDirectives(sql string) bool {
+	return strings.Contains(sql, "/*+") && queryDirectivesComment.MatchString(sql)
+}
+
+// ParseQueryDirectives returns the directives sql gives the gateway, or the
+// zero QueryDirectives if it gives none.
+func ParseQueryDirectives(sql string) (QueryDirectives, error) {
+	var d QueryDirectives
+	if !strings.Contains(sql, "/*+") {
+		return d, nil
+	}
+	for _, match := range queryDirectivesComment.FindAllStringSubmatch(sql, -1) {
+		items := strings.FieldsFunc(match[1], func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+		for _, item := range items {
+			if err := d.set(item); err != nil {
+				return QueryDirectives{}, err
+			}
+		}
+	}
+	return d, nil
+}
+
+// set applies a directive, given as name=value or as a bare name.
+func (d *QueryDirectives) set(item string) error {
+	name, value, hasValue := strings.Cut(item, "=")
+	ok := false
+	switch strings.ToLower(name) {
+	case "shard":
+		d.Shard, ok = value, value != ""
+	case "scatter":
+		var allowed bool
+		allowed, ok = parseDirectiveBool(value, hasValue)
+		d.NoScatter = !allowed
+	case "timeout":
+		d.Timeout, ok = parseTimeSetting(value)
+		ok = ok && hasValue && d.Timeout >= 0
+		d.HasTimeout = true
+	case "target":
+		d.Target, ok = ParseTarget(value)
+	case "cache":
+		d.Cache, ok = true, true
+		if hasValue {
+			d.CacheTTL, ok = parseTimeSetting(value)
+			ok = ok && d.CacheTTL > 0
+		}
+	}
+	if !ok {
+		return sqlstate.NewError(sqlstate.InvalidParameterValue).
+			Msg("invalid multigres directive \"%s\"", item).
+			Hint("Give directives such as /*+ multigres: shard=-80 scatter=off timeout=5s target=replica cache=30s */.").
+			Err()
+	}
+	return nil
+}
+
+// parseDirectiveBool parses the value of a Boolean directive, true when
+// omitted, spelled like PostgreSQL accepts them.
+func parseDirectiveBool(value string, hasValue bool) (bool, bool) {
+	if !hasValue {
+		return true, true
+	}
+	switch strings.ToLower(value) {
+	case "true", "on", "yes", "1":
+		return true, true
+	case "false", "off", "no", "0":
+		return false, true
+	}
+	return false, false
+}
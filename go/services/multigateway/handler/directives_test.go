@@ -0,0 +1,82 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestParseQueryDirectives(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want QueryDirectives
+	}{
+		{"SELECT * FROM t", QueryDirectives{}},
+		{"SELECT /*+ multigres_cache */ * FROM t", QueryDirectives{}},
+		{
+			"SELECT /*+ multigres: shard=-80, scatter=off timeout=5s target=replica cache=30s */ * FROM t",
+			QueryDirectives{
+				Shard:      "-80",
+				NoScatter:  true,
+				Timeout:    5 * time.Second,
+				HasTimeout: true,
+				Target:     clustermetadatapb.PoolerType_REPLICA,
+				Cache:      true,
+				CacheTTL:   30 * time.Second,
+			},
+		},
+		{
+			"/*+ MULTIGRES: cache */ SELECT 1 /*+ multigres: timeout=250 scatter */",
+			QueryDirectives{Cache: true, Timeout: 250 * time.Millisecond, HasTimeout: true},
+		},
+		{"/*+ multigres:\n  target=primary\n*/ SELECT 1", QueryDirectives{Target: clustermetadatapb.PoolerType_PRIMARY}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			assert.Equal(t, tt.want != (QueryDirectives{}), HasQueryDirectives(tt.sql))
+			d, err := ParseQueryDirectives(tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d)
+		})
+	}
+}
+
+func TestParseQueryDirectivesInvalid(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT /*+ multigres: shard */ 1",
+		"SELECT /*+ multigres: scatter=maybe */ 1",
+		"SELECT /*+ multigres: timeout=soon */ 1",
+		"SELECT /*+ multigres: timeout */ 1",
+		"SELECT /*+ multigres: target=standby */ 1",
+		"SELECT /*+ multigres: cache=0 */ 1",
+		"SELECT /*+ multigres: fast */ 1",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := ParseQueryDirectives(sql)
+			var diag *sqltypes.PgDiagnostic
+			require.True(t, errors.As(err, &diag), "expected a PgDiagnostic, got %v", err)
+			assert.Equal(t, sqlstate.InvalidParameterValue, diag.Code)
+		})
+	}
+}
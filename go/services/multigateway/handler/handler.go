@@ -20,13 +20,20 @@ import (
 	"fmt"
 	"log/slog"
 
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/common/parser"
 	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/protoutil"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/tools/telemetry"
 )
 
 // Executor defines the interface for query execution.
@@ -34,12 +41,25 @@ type Executor interface {
 	// StreamExecute is used to run the provided query in streaming mode.
 	StreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, astStmt ast.Stmt, callback func(ctx context.Context, result *sqltypes.Result) error) error
 
+	// StreamExecuteCached is used to run a query string from the plan cached
+	// for it, without parsing it. It returns false, having run nothing, if no
+	// plan is cached.
+	StreamExecuteCached(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) (bool, error)
+
+	// StreamExecuteMulti is used to run the statements of a query string
+	// holding several, located in queryStr by stmts, in streaming mode.
+	StreamExecuteMulti(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, stmts []*ast.RawStmt, callback func(ctx context.Context, result *sqltypes.Result) error) error
+
 	// PortalStreamExecute is used to execute a Portal that was previously created.
 	PortalStreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, portalInfo *preparedstatement.PortalInfo, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) error
 
 	// Describe returns metadata about a prepared statement or portal.
 	// The options should contain PreparedStatement or Portal information and the reserved connection ID.
 	Describe(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, portalInfo *preparedstatement.PortalInfo, preparedStatementInfo *preparedstatement.PreparedStatementInfo) (*query.StatementDescription, error)
+
+	// ReleaseConnection releases what the executor holds on behalf of a
+	// closing connection, such as its open transaction and LISTENs.
+	ReleaseConnection(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState)
 }
 
 // MultiGatewayHandler implements the pgprotocol Handler interface for multigateway.
@@ -48,15 +68,32 @@ type MultiGatewayHandler struct {
 	executor Executor
 	logger   *slog.Logger
 	psc      *preparedstatement.Consolidator
+
+	// sessions tracks the open client connections.
+	sessions sessionRegistry
+	// sessionLabelPrefix, if set, labels the backend connections of each
+	// session (see SetSessionLabelPrefix).
+	sessionLabelPrefix string
+	// limiter enforces the connection limits (see SetConnectionLimits).
+	limiter connectionLimiter
+	// queryLimiter enforces the query limits (see SetQueryLimits).
+	queryLimiter queryLimiter
+	// timeouts are the session timeouts enforced by the gateway (see
+	// SetSessionTimeouts).
+	timeouts SessionTimeouts
+	// console is the admin console (see SetAdminConsole).
+	console adminConsole
 }
 
 // NewMultiGatewayHandler creates a new PostgreSQL protocol handler.
 func NewMultiGatewayHandler(executor Executor, logger *slog.Logger) *MultiGatewayHandler {
-	return &MultiGatewayHandler{
+	h := &MultiGatewayHandler{
 		executor: executor,
 		logger:   logger.With("component", "multigateway_handler"),
 		psc:      preparedstatement.NewConsolidator(),
 	}
+	h.queryLimiter.logger = h.logger
+	return h
 }
 
 // Consolidator returns the prepared statement consolidator.
@@ -66,29 +103,73 @@ func (h *MultiGatewayHandler) Consolidator() *preparedstatement.Consolidator {
 
 // HandleQuery processes a simple query protocol message ('Q').
 // Routes the query to an appropriate multipooler instance and streams results back.
-func (h *MultiGatewayHandler) HandleQuery(ctx context.Context, conn *server.Conn, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+func (h *MultiGatewayHandler) HandleQuery(ctx context.Context, conn *server.Conn, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) (err error) {
 	h.logger.DebugContext(ctx, "handling query", "query", queryStr, "user", conn.User(), "database", conn.Database())
+	ctx, span := startSpan(ctx, "multigateway/query", conn)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	st := h.getConnectionState(conn)
+	if h.isAdminConsole(conn) {
+		return h.handleAdminCommand(ctx, conn, queryStr, callback)
+	}
+
+	release, err := h.acquireQuery(conn, st)
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, cancel := h.withStatementTimeout(ctx, conn, queryStr)
+	defer cancel()
+	if err := h.waitResumed(ctx, conn, st); err != nil {
+		return statementError(ctx, err)
+	}
+	st.beginQuery(queryStr)
+	defer h.endQuery(conn, st)
 
-	asts, err := parser.ParseSQL(queryStr)
+	err = statementError(ctx, h.handleQuery(ctx, conn, queryStr, callback))
+	h.failTransactionOnError(conn, err)
+	h.reportTransactionStatus(conn)
+	return clientError(st, err)
+}
+
+// handleQuery parses a simple query message and runs its statements.
+// Queries with a cached plan run without being parsed.
+func (h *MultiGatewayHandler) handleQuery(ctx context.Context, conn *server.Conn, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	st := h.getConnectionState(conn)
+	if ok, err := h.executor.StreamExecuteCached(ctx, conn, st, queryStr, callback); ok {
+		return err
+	}
+
+	stmts, err := parser.ParseRawSQL(queryStr)
 	if err != nil {
 		return err
 	}
 
 	// Handle empty query (e.g., just a semicolon or whitespace).
 	// Call callback with nil to signal empty query response.
-	if len(asts) == 0 {
+	if len(stmts) == 0 {
 		return callback(ctx, nil)
 	}
-	st := h.getConnectionState(conn)
 
-	for _, astStmt := range asts {
+	if len(stmts) == 1 {
 		// Route the query through the executor which will eventually call multipooler
-		err = h.executor.StreamExecute(ctx, conn, st, queryStr, astStmt, callback)
-		if err != nil {
-			return err
-		}
+		return h.executor.StreamExecute(ctx, conn, st, queryStr, stmts[0].Stmt, callback)
 	}
-	return nil
+
+	// Several statements are split and routed one by one.
+	return h.executor.StreamExecuteMulti(ctx, conn, st, queryStr, stmts, callback)
+}
+
+// startSpan starts the span of a message of a client, whose spans are
+// the children of it down to the backend.
+func startSpan(ctx context.Context, name string, conn *server.Conn) (context.Context, trace.Span) {
+	return telemetry.Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBNamespace(conn.Database()),
+			attribute.Int64("connection_id", int64(conn.ConnectionID())),
+		))
 }
 
 // getConnectionState retrieves and typecasts the connection state for this handler.
@@ -97,7 +178,10 @@ func (h *MultiGatewayHandler) getConnectionState(conn *server.Conn) *MultiGatewa
 	state := conn.GetConnectionState()
 	if state == nil {
 		newState := NewMultiGatewayConnectionState()
+		newState.SetStartupParams(conn.StartupParams())
+		newState.SetSessionLabel(h.sessionLabel(conn))
 		conn.SetConnectionState(newState)
+		h.sessions.add(conn)
 		return newState
 	}
 	return state.(*MultiGatewayConnectionState)
@@ -112,8 +196,14 @@ func (h *MultiGatewayHandler) HandleParse(ctx context.Context, conn *server.Conn
 	if queryStr == "" {
 		return errors.New("query string cannot be empty")
 	}
+	if h.isAdminConsole(conn) {
+		return sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("the admin console only supports the simple query protocol").
+			Err()
+	}
 
 	_, err := h.psc.AddPreparedStatement(conn.ConnectionID(), name, queryStr, paramTypes)
+	h.failTransactionOnError(conn, err)
 	return err
 }
 
@@ -125,12 +215,20 @@ func (h *MultiGatewayHandler) HandleBind(ctx context.Context, conn *server.Conn,
 	// Get the prepared statement to verify it exists.
 	psi := h.psc.GetPreparedStatementInfo(conn.ConnectionID(), stmtName)
 	if psi == nil {
-		return fmt.Errorf("prepared statement \"%s\" does not exist", stmtName)
+		return h.failTransactionOnError(conn, errStatementNotFound(stmtName))
 	}
 
 	// Get the connection state.
 	state := h.getConnectionState(conn)
 
+	// A named portal must be closed before its name is reused; the unnamed
+	// portal is replaced, as in PostgreSQL.
+	if portalName != "" && state.GetPortalInfo(portalName) != nil {
+		return h.failTransactionOnError(conn, sqlstate.NewError(sqlstate.DuplicateCursor).
+			Msg("portal \"%s\" already exists", portalName).
+			Err())
+	}
+
 	// Create portal using protoutil helper.
 	portal := protoutil.NewPortal(portalName, psi.Name, params, paramFormats, resultFormats)
 	state.StorePortalInfo(portal, psi)
@@ -140,8 +238,10 @@ func (h *MultiGatewayHandler) HandleBind(ctx context.Context, conn *server.Conn,
 
 // HandleExecute processes an Execute message ('E') for the extended query protocol.
 // Executes the specified portal's query with bound parameters and streams results via callback.
-func (h *MultiGatewayHandler) HandleExecute(ctx context.Context, conn *server.Conn, portalName string, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+func (h *MultiGatewayHandler) HandleExecute(ctx context.Context, conn *server.Conn, portalName string, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) (err error) {
 	h.logger.DebugContext(ctx, "execute", "portal", portalName, "max_rows", maxRows)
+	ctx, span := startSpan(ctx, "multigateway/execute", conn)
+	defer func() { telemetry.EndSpan(span, err) }()
 
 	// Get the connection state.
 	state := h.getConnectionState(conn)
@@ -149,10 +249,24 @@ func (h *MultiGatewayHandler) HandleExecute(ctx context.Context, conn *server.Co
 	// Get the portal.
 	portalInfo := state.GetPortalInfo(portalName)
 	if portalInfo == nil {
-		return fmt.Errorf("portal \"%s\" does not exist", portalName)
+		return h.failTransactionOnError(conn, errPortalNotFound(portalName))
 	}
 
-	return h.executor.PortalStreamExecute(ctx, conn, state, portalInfo, maxRows, callback)
+	release, err := h.acquireQuery(conn, state)
+	if err != nil {
+		return h.failTransactionOnError(conn, err)
+	}
+	defer release()
+	ctx, cancel := h.withStatementTimeout(ctx, conn, portalInfo.Query)
+	defer cancel()
+	if err := h.waitResumed(ctx, conn, state); err != nil {
+		return statementError(ctx, err)
+	}
+	state.beginQuery(portalInfo.Query)
+	defer h.endQuery(conn, state)
+
+	err = statementError(ctx, h.executor.PortalStreamExecute(ctx, conn, state, portalInfo, maxRows, callback))
+	return clientError(state, h.failTransactionOnError(conn, err))
 }
 
 // HandleDescribe processes a Describe message ('D').
@@ -160,6 +274,12 @@ func (h *MultiGatewayHandler) HandleExecute(ctx context.Context, conn *server.Co
 func (h *MultiGatewayHandler) HandleDescribe(ctx context.Context, conn *server.Conn, typ byte, name string) (*query.StatementDescription, error) {
 	h.logger.DebugContext(ctx, "describe", "type", string(typ), "name", name)
 
+	desc, err := h.handleDescribe(ctx, conn, typ, name)
+	return desc, clientError(h.getConnectionState(conn), h.failTransactionOnError(conn, err))
+}
+
+// handleDescribe describes a prepared statement or a portal.
+func (h *MultiGatewayHandler) handleDescribe(ctx context.Context, conn *server.Conn, typ byte, name string) (*query.StatementDescription, error) {
 	// Get the connection state.
 	state := h.getConnectionState(conn)
 
@@ -167,7 +287,7 @@ func (h *MultiGatewayHandler) HandleDescribe(ctx context.Context, conn *server.C
 	case 'S': // Describe prepared statement
 		stmt := h.psc.GetPreparedStatementInfo(conn.ConnectionID(), name)
 		if stmt == nil {
-			return nil, fmt.Errorf("prepared statement \"%s\" does not exist", name)
+			return nil, errStatementNotFound(name)
 		}
 
 		// Call executor to get description from multipooler
@@ -176,7 +296,7 @@ func (h *MultiGatewayHandler) HandleDescribe(ctx context.Context, conn *server.C
 	case 'P': // Describe portal
 		portalInfo := state.GetPortalInfo(name)
 		if portalInfo == nil {
-			return nil, fmt.Errorf("portal \"%s\" does not exist", name)
+			return nil, errPortalNotFound(name)
 		}
 
 		// Call executor to get description from multipooler
@@ -211,9 +331,63 @@ func (h *MultiGatewayHandler) HandleClose(ctx context.Context, conn *server.Conn
 func (h *MultiGatewayHandler) HandleSync(ctx context.Context, conn *server.Conn) error {
 	h.logger.DebugContext(ctx, "sync")
 
-	// TODO: Handle transaction state
+	h.reportTransactionStatus(conn)
 	return nil
 }
 
+// failTransactionOnError records the failure of a message in the session's
+// transaction status, and returns err.
+func (h *MultiGatewayHandler) failTransactionOnError(conn *server.Conn, err error) error {
+	if err != nil {
+		h.getConnectionState(conn).FailTransaction()
+	}
+	return err
+}
+
+// clientError returns err as the client receives it: PostgreSQL errors
+// show the shard and the pooler they came from if the session set
+// multigres.debug_errors, and are left as PostgreSQL sent them otherwise.
+func clientError(state *MultiGatewayConnectionState, err error) error {
+	if err == nil || !state.DebugErrors() {
+		return err
+	}
+	return mterrors.DebugPg(err)
+}
+
+// reportTransactionStatus sets the transaction status the next
+// ReadyForQuery reports to the client, and how long the client may then
+// stay idle in a transaction block.
+func (h *MultiGatewayHandler) reportTransactionStatus(conn *server.Conn) {
+	conn.SetTxnStatus(byte(h.getConnectionState(conn).TransactionStatus()))
+	conn.SetIdleInTransactionTimeout(h.idleInTransactionTimeout(conn))
+}
+
+// HandleConnectionClose releases the prepared statements of a closing
+// connection from the consolidator, and the executor resources it holds.
+func (h *MultiGatewayHandler) HandleConnectionClose(conn *server.Conn) {
+	h.psc.RemoveConnection(conn.ConnectionID())
+	h.executor.ReleaseConnection(context.Background(), conn, h.getConnectionState(conn))
+	h.sessions.remove(conn)
+	h.limiter.release(conn)
+}
+
+// errStatementNotFound returns the error PostgreSQL reports for an unknown
+// prepared statement.
+func errStatementNotFound(name string) error {
+	return sqlstate.NewError(sqlstate.InvalidSQLStatementName).
+		Msg("prepared statement \"%s\" does not exist", name).
+		Err()
+}
+
+// errPortalNotFound returns the error PostgreSQL reports for an unknown portal.
+func errPortalNotFound(name string) error {
+	return sqlstate.NewError(sqlstate.InvalidCursorName).
+		Msg("portal \"%s\" does not exist", name).
+		Err()
+}
+
 // Ensure MultiGatewayHandler implements server.Handler interface.
 var _ server.Handler = (*MultiGatewayHandler)(nil)
+
+// Ensure MultiGatewayHandler releases per-connection state on close.
+var _ server.ConnectionCloseHandler = (*MultiGatewayHandler)(nil)
@@ -25,11 +25,18 @@ import (
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	"github.com/multigres/multigres/go/pb/query"
 )
 
 // mockExecutor is a mock implementation of the Executor interface for testing.
-type mockExecutor struct{}
+type mockExecutor struct {
+	released bool
+	// statements records the statements passed to StreamExecuteMulti.
+	statements []string
+	// cached holds the query strings StreamExecuteCached has a plan for.
+	cached map[string]bool
+}
 
 func (m *mockExecutor) StreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, astStmt ast.Stmt, callback func(ctx context.Context, result *sqltypes.Result) error) error {
 	// Return a simple test result
@@ -38,13 +45,31 @@ func (m *mockExecutor) StreamExecute(ctx context.Context, conn *server.Conn, sta
 			{Name: "column1", Type: "int4"},
 		},
 		Rows: []*sqltypes.Row{
-			{Values: []sqltypes.Value{[]byte("1")}},
+			sqltypes.NewRowBuilder(nil).Int64(1).MustBuild(),
 		},
 		CommandTag:   "SELECT 1",
 		RowsAffected: 1,
 	})
 }
 
+func (m *mockExecutor) StreamExecuteCached(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, callback func(ctx context.Context, result *sqltypes.Result) error) (bool, error) {
+	if !m.cached[queryStr] {
+		return false, nil
+	}
+	return true, callback(ctx, &sqltypes.Result{CommandTag: "SELECT 0"})
+}
+
+func (m *mockExecutor) StreamExecuteMulti(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, stmts []*ast.RawStmt, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	for _, stmt := range stmts {
+		sql := queryStr[stmt.StmtLocation : stmt.StmtLocation+stmt.StmtLen]
+		m.statements = append(m.statements, sql)
+		if err := m.StreamExecute(ctx, conn, state, sql, stmt.Stmt, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mockExecutor) PortalStreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, portalInfo *preparedstatement.PortalInfo, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) error {
 	// Return a simple test result
 	return callback(ctx, &sqltypes.Result{
@@ -52,7 +77,7 @@ func (m *mockExecutor) PortalStreamExecute(ctx context.Context, conn *server.Con
 			{Name: "column1", Type: "int4"},
 		},
 		Rows: []*sqltypes.Row{
-			{Values: []sqltypes.Value{[]byte("1")}},
+			sqltypes.NewRowBuilder(nil).Int64(1).MustBuild(),
 		},
 		CommandTag:   "SELECT 1",
 		RowsAffected: 1,
@@ -68,6 +93,10 @@ func (m *mockExecutor) Describe(ctx context.Context, conn *server.Conn, state *M
 	}, nil
 }
 
+func (m *mockExecutor) ReleaseConnection(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState) {
+	m.released = true
+}
+
 // TestHandleQueryEmptyQuery tests that empty queries are handled correctly.
 func TestHandleQueryEmptyQuery(t *testing.T) {
 	logger := slog.Default()
@@ -164,6 +193,46 @@ func TestHandleQueryNonEmpty(t *testing.T) {
 	require.NotNil(t, receivedResult, "HandleQuery() callback should receive non-nil result for valid query")
 }
 
+// TestHandleQueryMultiStatement tests that a query holding several
+// statements is split, with one result per statement.
+func TestHandleQueryMultiStatement(t *testing.T) {
+	logger := slog.Default()
+	executor := &mockExecutor{}
+	handler := NewMultiGatewayHandler(executor, logger)
+
+	conn := &server.Conn{}
+
+	results := 0
+	err := handler.HandleQuery(context.Background(), conn, "SELECT 1; SELECT ';' ;\nSELECT 3;;", func(ctx context.Context, result *sqltypes.Result) error {
+		results++
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"SELECT 1", "SELECT ';'", "SELECT 3"}, executor.statements)
+	require.Equal(t, 3, results)
+}
+
+// TestHandleQueryCachedPlan tests that a query with a cached plan runs
+// without being parsed.
+func TestHandleQueryCachedPlan(t *testing.T) {
+	logger := slog.Default()
+	// The query would fail to parse.
+	executor := &mockExecutor{cached: map[string]bool{"SELECT FROM WHERE": true}}
+	handler := NewMultiGatewayHandler(executor, logger)
+
+	conn := &server.Conn{}
+
+	var tags []string
+	err := handler.HandleQuery(context.Background(), conn, "SELECT FROM WHERE", func(ctx context.Context, result *sqltypes.Result) error {
+		tags = append(tags, result.CommandTag)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"SELECT 0"}, tags)
+}
+
 // TestPreparedStatementHandling tests the full lifecycle of prepared statements:
 // parse, describe, close, and error cases.
 func TestPreparedStatementHandling(t *testing.T) {
@@ -341,3 +410,57 @@ func TestConnectionStateIsolation(t *testing.T) {
 	_, err = handler.HandleDescribe(ctx, conn2, 'P', "portal1")
 	require.Error(t, err)
 }
+
+// TestExtendedQueryErrors tests that extended query errors carry the
+// SQLSTATE codes PostgreSQL uses, and that names are reused as PostgreSQL
+// allows.
+func TestExtendedQueryErrors(t *testing.T) {
+	handler := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	conn := &server.Conn{}
+	ctx := context.Background()
+
+	requireSQLState := func(t *testing.T, err error, code string) {
+		t.Helper()
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag)
+		require.Equal(t, code, diag.Code)
+	}
+
+	requireSQLState(t, handler.HandleBind(ctx, conn, "", "missing", nil, nil, nil), sqlstate.InvalidSQLStatementName)
+	_, err := handler.HandleDescribe(ctx, conn, 'S', "missing")
+	requireSQLState(t, err, sqlstate.InvalidSQLStatementName)
+	_, err = handler.HandleDescribe(ctx, conn, 'P', "missing")
+	requireSQLState(t, err, sqlstate.InvalidCursorName)
+	err = handler.HandleExecute(ctx, conn, "missing", 0, func(ctx context.Context, r *sqltypes.Result) error { return nil })
+	requireSQLState(t, err, sqlstate.InvalidCursorName)
+
+	// A named statement cannot be parsed twice; the unnamed one is replaced.
+	require.NoError(t, handler.HandleParse(ctx, conn, "stmt1", "SELECT 1", nil))
+	requireSQLState(t, handler.HandleParse(ctx, conn, "stmt1", "SELECT 2", nil), sqlstate.DuplicatePreparedStatement)
+	require.NoError(t, handler.HandleParse(ctx, conn, "", "SELECT 1", nil))
+	require.NoError(t, handler.HandleParse(ctx, conn, "", "SELECT 2", nil))
+
+	// Likewise for portals.
+	require.NoError(t, handler.HandleBind(ctx, conn, "portal1", "stmt1", nil, nil, nil))
+	requireSQLState(t, handler.HandleBind(ctx, conn, "portal1", "stmt1", nil, nil, nil), sqlstate.DuplicateCursor)
+	require.NoError(t, handler.HandleBind(ctx, conn, "", "stmt1", nil, nil, nil))
+	require.NoError(t, handler.HandleBind(ctx, conn, "", "", nil, nil, nil))
+	require.Equal(t, "SELECT 2", handler.getConnectionState(conn).GetPortalInfo("").Query)
+}
+
+// TestHandleConnectionClose tests that closing a connection releases its
+// prepared statements and its executor resources.
+func TestHandleConnectionClose(t *testing.T) {
+	executor := &mockExecutor{}
+	handler := NewMultiGatewayHandler(executor, slog.Default())
+	conn := &server.Conn{}
+	ctx := context.Background()
+
+	require.NoError(t, handler.HandleParse(ctx, conn, "stmt1", "SELECT 1", nil))
+	require.Equal(t, 1, handler.Consolidator().Stats().UniqueStatements)
+
+	handler.HandleConnectionClose(conn)
+	require.Equal(t, 0, handler.Consolidator().Stats().UniqueStatements)
+	require.Equal(t, 0, handler.Consolidator().Stats().ConnectionCount)
+	require.True(t, executor.released)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Metrics holds the OpenTelemetry metrics of the client sessions.
+type Metrics struct {
+	meter    metric.Meter
+	sessions Sessions
+}
+
+// Sessions wraps an Int64ObservableGauge for observing the open client
+// sessions per application_name.
+// Use the Inst() method to get the underlying gauge for callback registration.
+type Sessions struct {
+	metric.Int64ObservableGauge
+}
+
+// Inst returns the underlying metric instrument for callback registration.
+func (m Sessions) Inst() metric.Int64ObservableGauge {
+	return m.Int64ObservableGauge
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the client sessions.
+// A metric that fails to initialize uses a noop implementation, and the
+// error is returned along with the usable Metrics instance. Use
+// RegisterSessionsCallback() to report the sessions.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multigateway/handler"),
+	}
+
+	sessionsGauge, err := m.meter.Int64ObservableGauge(
+		"multigateway.sessions",
+		metric.WithDescription("Current number of client sessions by application name"),
+		metric.WithUnit("{session}"),
+	)
+	if err != nil {
+		m.sessions = Sessions{noop.Int64ObservableGauge{}}
+		return m, fmt.Errorf("multigateway.sessions gauge: %w", err)
+	}
+	m.sessions = Sessions{sessionsGauge}
+	return m, nil
+}
+
+// RegisterSessionsCallback registers a callback for the sessions observable
+// gauge. The getter returns the number of sessions per application_name;
+// it is called periodically. Returns an error if registration fails.
+func (m *Metrics) RegisterSessionsCallback(getter func() map[string]int) error {
+	if getter == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			for name, count := range getter() {
+				observer.ObserveInt64(m.sessions.Inst(), int64(count),
+					metric.WithAttributes(attribute.String("application_name", name)))
+			}
+			return nil
+		},
+		m.sessions.Inst(),
+	)
+	return err
+}
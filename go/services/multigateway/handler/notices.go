@@ -0,0 +1,80 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"strings"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// messageLevels ranks the levels of client_min_messages, and the
+// severities of the notices they let through, from the lowest. PostgreSQL
+// reports every debug level as DEBUG, which is ranked as DEBUG1, the
+// highest of them. INFO is not ranked: it is always sent.
+var messageLevels = map[string]int{
+	"debug5":  1,
+	"debug4":  2,
+	"debug3":  3,
+	"debug2":  4,
+	"debug1":  5,
+	"debug":   5,
+	"log":     6,
+	"notice":  7,
+	"warning": 8,
+	"error":   9,
+}
+
+// defaultClientMinMessages is the default of client_min_messages.
+const defaultClientMinMessages = "notice"
+
+// HandleNotice drops the notices below the session's client_min_messages,
+// as PostgreSQL does. The backends already drop theirs, since the setting
+// is replayed on them, but not the notices raised by the gateway itself,
+// such as the warnings of partial scatter results.
+func (h *MultiGatewayHandler) HandleNotice(conn *server.Conn, notice *sqltypes.Notice) *sqltypes.Notice {
+	minLevel, ok := h.getConnectionState(conn).GetSessionVariable("client_min_messages")
+	if !ok {
+		minLevel = defaultClientMinMessages
+	}
+	if !sendsNotice(minLevel, notice) {
+		return nil
+	}
+	return notice
+}
+
+// Ensure MultiGatewayHandler filters the notices sent to clients.
+var _ server.NoticeHandler = (*MultiGatewayHandler)(nil)
+
+// sendsNotice returns true if notice is sent to a client whose
+// client_min_messages is minLevel. Notices of unknown severity are sent,
+// and an invalid level, which only set_config can give, is taken as the
+// default.
+func sendsNotice(minLevel string, notice *sqltypes.Notice) bool {
+	severity := notice.SeverityNonLocalized
+	if severity == "" {
+		severity = notice.Severity
+	}
+	rank, ok := messageLevels[strings.ToLower(severity)]
+	if !ok {
+		return true
+	}
+	minRank, ok := messageLevels[strings.ToLower(strings.TrimSpace(minLevel))]
+	if !ok {
+		minRank = messageLevels[defaultClientMinMessages]
+	}
+	return rank >= minRank
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestSendsNotice(t *testing.T) {
+	tests := []struct {
+		name     string
+		minLevel string
+		notice   *sqltypes.Notice
+		want     bool
+	}{
+		{"notice at notice", "notice", &sqltypes.Notice{SeverityNonLocalized: "NOTICE"}, true},
+		{"warning at notice", "notice", &sqltypes.Notice{SeverityNonLocalized: "WARNING"}, true},
+		{"notice at warning", "warning", &sqltypes.Notice{SeverityNonLocalized: "NOTICE"}, false},
+		{"debug at notice", "notice", &sqltypes.Notice{SeverityNonLocalized: "DEBUG"}, false},
+		{"debug at debug1", "DEBUG1", &sqltypes.Notice{SeverityNonLocalized: "DEBUG"}, true},
+		{"log at debug5", "debug5", &sqltypes.Notice{SeverityNonLocalized: "LOG"}, true},
+		{"info at error", "error", &sqltypes.Notice{SeverityNonLocalized: "INFO"}, true},
+		{"unknown severity", "error", &sqltypes.Notice{SeverityNonLocalized: "HINT"}, true},
+		{"localized severity", "warning", &sqltypes.Notice{Severity: "notice"}, false},
+		{"invalid level", "bogus", &sqltypes.Notice{SeverityNonLocalized: "LOG"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sendsNotice(tt.minLevel, tt.notice))
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// QueryLimits limits the rate and the concurrency of the queries of each
+// role and database, so that one tenant cannot take all the backends of a
+// gateway shared by several. Each query message, or each Execute of the
+// extended protocol, counts as one query. The queries of a transaction
+// block are not limited, so that the transactions already open finish. A
+// limit of 0 means no limit.
+type QueryLimits struct {
+	// MaxQPSPerUser is the queries per second of the roles without their
+	// own in Users. Up to a second of queries may run in a burst.
+	MaxQPSPerUser float64
+	// MaxQPSPerDatabase is the queries per second of the databases without
+	// their own in Databases.
+	MaxQPSPerDatabase float64
+	// MaxConcurrentPerUser is the number of queries the roles without their
+	// own in Users run at the same time.
+	MaxConcurrentPerUser int
+	// MaxConcurrentPerDatabase is the number of queries the databases
+	// without their own in Databases run at the same time.
+	MaxConcurrentPerDatabase int
+	// Users are the limits of specific roles.
+	Users map[string]QueryLimit
+	// Databases are the limits of specific databases.
+	Databases map[string]QueryLimit
+}
+
+// QueryLimit is the limit of the queries of a role or a database.
+type QueryLimit struct {
+	// QPS is the number of queries per second.
+	QPS float64
+	// Concurrent is the number of queries running at the same time.
+	Concurrent int
+}
+
+// ParseQueryLimits parses limits given as "name=qps:concurrent" entries, as
+// in "app=100:10".
+func ParseQueryLimits(specs []string) (map[string]QueryLimit, error) {
+	limits := make(map[string]QueryLimit, len(specs))
+	for _, spec := range specs {
+		name, value, found := strings.Cut(strings.TrimSpace(spec), "=")
+		qps, concurrent, hasConcurrent := strings.Cut(value, ":")
+		if name == "" || !found || !hasConcurrent {
+			return nil, fmt.Errorf("invalid query limit %q: expected name=qps:concurrent", spec)
+		}
+		var limit QueryLimit
+		var err error
+		if limit.QPS, err = strconv.ParseFloat(qps, 64); err != nil || limit.QPS < 0 {
+			return nil, fmt.Errorf("invalid query limit %q: the queries per second must be a non-negative number", spec)
+		}
+		if limit.Concurrent, err = strconv.Atoi(concurrent); err != nil || limit.Concurrent < 0 {
+			return nil, fmt.Errorf("invalid query limit %q: the concurrent queries must be a non-negative integer", spec)
+		}
+		limits[name] = limit
+	}
+	return limits, nil
+}
+
+// queryLimitKey identifies the role or database whose queries are counted.
+type queryLimitKey struct {
+	kind string
+	name string
+}
+
+// scopedQueryLimit is the limit of the queries of a role or database, with
+// the flags setting it.
+type scopedQueryLimit struct {
+	QueryLimit
+	key            queryLimitKey
+	qpsFlag        string
+	concurrentFlag string
+}
+
+// tokenBucket holds the tokens the queries of a role or database take, one
+// each, refilled at the queries per second of its limit.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// queryLimiter counts the queries of each role and database against
+// QueryLimits.
+type queryLimiter struct {
+	mu      sync.Mutex
+	limits  QueryLimits
+	buckets map[queryLimitKey]*tokenBucket
+	running map[queryLimitKey]int
+	// logger logs the rejected queries with the flag of their limit, if
+	// set.
+	logger *slog.Logger
+	// now returns the current time if set, for tests.
+	now func() time.Time
+}
+
+// setLimits replaces the limits. The queries already running finish.
+func (l *queryLimiter) setLimits(limits QueryLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits = limits
+	l.buckets = nil
+}
+
+// scopedLimits returns the limits of the role and the database of conn,
+// their own or otherwise the defaults.
+func (l *queryLimiter) scopedLimits(conn *server.Conn) []scopedQueryLimit {
+	user := scopedQueryLimit{
+		QueryLimit:     QueryLimit{QPS: l.limits.MaxQPSPerUser, Concurrent: l.limits.MaxConcurrentPerUser},
+		key:            queryLimitKey{kind: "role", name: conn.User()},
+		qpsFlag:        "max-qps-per-user",
+		concurrentFlag: "max-concurrent-queries-per-user",
+	}
+	if limit, ok := l.limits.Users[user.key.name]; ok {
+		user.QueryLimit, user.qpsFlag, user.concurrentFlag = limit, "user-query-limits", "user-query-limits"
+	}
+	database := scopedQueryLimit{
+		QueryLimit:     QueryLimit{QPS: l.limits.MaxQPSPerDatabase, Concurrent: l.limits.MaxConcurrentPerDatabase},
+		key:            queryLimitKey{kind: "database", name: conn.Database()},
+		qpsFlag:        "max-qps-per-database",
+		concurrentFlag: "max-concurrent-queries-per-database",
+	}
+	if limit, ok := l.limits.Databases[database.key.name]; ok {
+		database.QueryLimit, database.qpsFlag, database.concurrentFlag = limit, "database-query-limits", "database-query-limits"
+	}
+	return []scopedQueryLimit{user, database}
+}
+
+// acquire counts a query of the role and database of conn, or returns the
+// error it is rejected with if either is at one of its limits. The query is
+// counted as running until release is called.
+func (l *queryLimiter) acquire(conn *server.Conn) (release func(), err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limits := l.scopedLimits(conn)
+	if limits[0].QueryLimit == (QueryLimit{}) && limits[1].QueryLimit == (QueryLimit{}) {
+		return func() {}, nil
+	}
+
+	for _, limit := range limits {
+		if limit.Concurrent > 0 && l.running[limit.key] >= limit.Concurrent {
+			return nil, l.reject(limit.key, fmt.Sprintf("%d concurrent queries", limit.Concurrent), limit.concurrentFlag)
+		}
+	}
+	// A query rejected by one bucket takes no token from the other.
+	now := time.Now()
+	if l.now != nil {
+		now = l.now()
+	}
+	buckets := make([]*tokenBucket, 0, len(limits))
+	for _, limit := range limits {
+		if bucket := l.refill(limit, now); bucket != nil {
+			if bucket.tokens < 1 {
+				return nil, l.reject(limit.key, fmt.Sprintf("%g queries per second", limit.QPS), limit.qpsFlag)
+			}
+			buckets = append(buckets, bucket)
+		}
+	}
+	for _, bucket := range buckets {
+		bucket.tokens--
+	}
+
+	if l.running == nil {
+		l.running = make(map[queryLimitKey]int)
+	}
+	for _, limit := range limits {
+		l.running[limit.key]++
+	}
+	return sync.OnceFunc(func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for _, limit := range limits {
+			if l.running[limit.key]--; l.running[limit.key] == 0 {
+				delete(l.running, limit.key)
+			}
+		}
+	}), nil
+}
+
+// refill returns the token bucket of the queries of limit, refilled for the
+// time elapsed since its last query, or nil if they have no rate limit.
+func (l *queryLimiter) refill(limit scopedQueryLimit, now time.Time) *tokenBucket {
+	if limit.QPS <= 0 {
+		return nil
+	}
+	// A second of queries may run in a burst, and at least one query.
+	burst := max(limit.QPS, 1)
+	if l.buckets == nil {
+		l.buckets = make(map[queryLimitKey]*tokenBucket)
+	}
+	bucket, ok := l.buckets[limit.key]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, last: now}
+		l.buckets[limit.key] = bucket
+	}
+	bucket.tokens = min(bucket.tokens+now.Sub(bucket.last).Seconds()*limit.QPS, burst)
+	bucket.last = now
+	return bucket
+}
+
+// reject returns the error a query over the limit of the role or database of
+// key is rejected with, and logs the flag setting the limit, which is not
+// shown to the client.
+func (l *queryLimiter) reject(key queryLimitKey, limit, flag string) error {
+	if l.logger != nil {
+		l.logger.Warn("query rejected by query limit", key.kind, key.name, "limit", limit, "flag", "--"+flag)
+	}
+	return errTooManyQueries(key, limit)
+}
+
+// errTooManyQueries returns the error a query over the limit of the role or
+// database of key is rejected with.
+func errTooManyQueries(key queryLimitKey, limit string) error {
+	return sqlstate.NewError(sqlstate.ConfigurationLimitExceeded).
+		Msg("too many queries for %s \"%s\"", key.kind, key.name).
+		Detail("The %s is limited to %s.", key.kind, limit).
+		Hint("Retry the query later.").
+		Err()
+}
+
+// SetQueryLimits limits the rate and the concurrency of the queries of each
+// role and database. Queries over a limit are rejected with a
+// configuration_limit_exceeded error.
+func (h *MultiGatewayHandler) SetQueryLimits(limits QueryLimits) {
+	h.queryLimiter.setLimits(limits)
+}
+
+// acquireQuery counts a query of conn against the query limits, unless the
+// session is in a transaction block. The query is counted as running until
+// release is called.
+func (h *MultiGatewayHandler) acquireQuery(conn *server.Conn, st *MultiGatewayConnectionState) (release func(), err error) {
+	if st.InTransaction() {
+		return func() {}, nil
+	}
+	return h.queryLimiter.acquire(conn)
+}
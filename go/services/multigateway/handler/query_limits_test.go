@@ -0,0 +1,152 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestParseQueryLimits(t *testing.T) {
+	limits, err := ParseQueryLimits([]string{"app=100:10", " reports=0.5:0 "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]QueryLimit{
+		"app":     {QPS: 100, Concurrent: 10},
+		"reports": {QPS: 0.5},
+	}, limits)
+
+	for _, spec := range []string{"app", "app=100", "=1:1", "app=:1", "app=1:", "app=-1:1", "app=1:-1", "app=fast:1"} {
+		_, err := ParseQueryLimits([]string{spec})
+		assert.Error(t, err, spec)
+	}
+}
+
+// requireTooManyQueries checks that err rejects a query over a limit, and
+// that the flag of the limit is logged rather than shown to the client.
+func requireTooManyQueries(t *testing.T, err error, logs *bytes.Buffer, message, flag string) {
+	t.Helper()
+	var diag *sqltypes.PgDiagnostic
+	require.True(t, errors.As(err, &diag), "expected a PgDiagnostic, got %v", err)
+	assert.Equal(t, sqlstate.ConfigurationLimitExceeded, diag.Code)
+	assert.Equal(t, message, diag.Message)
+	assert.Equal(t, "Retry the query later.", diag.Hint)
+	assert.NotContains(t, diag.Detail, flag)
+	assert.Contains(t, logs.String(), "flag="+flag)
+}
+
+// newLoggingQueryLimiter returns a queryLimiter logging to the returned
+// buffer.
+func newLoggingQueryLimiter() (*queryLimiter, *bytes.Buffer) {
+	var logs bytes.Buffer
+	return &queryLimiter{logger: slog.New(slog.NewTextHandler(&logs, nil))}, &logs
+}
+
+func TestQueryLimiterRate(t *testing.T) {
+	now := time.Now()
+	l, logs := newLoggingQueryLimiter()
+	l.now = func() time.Time { return now }
+	l.setLimits(QueryLimits{
+		MaxQPSPerUser:     2,
+		MaxQPSPerDatabase: 100,
+		Users:             map[string]QueryLimit{"admin": {}},
+	})
+	tc := server.NewTestConn(&bytes.Buffer{})
+	tc.SetUser("app", "db")
+
+	// A second of queries runs in a burst.
+	for range 2 {
+		release, err := l.acquire(tc.Conn)
+		require.NoError(t, err)
+		release()
+	}
+	_, err := l.acquire(tc.Conn)
+	requireTooManyQueries(t, err, logs, `too many queries for role "app"`, "--max-qps-per-user")
+
+	// The bucket refills at the rate of the limit.
+	now = now.Add(500 * time.Millisecond)
+	release, err := l.acquire(tc.Conn)
+	require.NoError(t, err)
+	release()
+	_, err = l.acquire(tc.Conn)
+	require.Error(t, err)
+
+	// Roles with their own limits are not limited by the default.
+	tc.SetUser("admin", "db")
+	for range 10 {
+		release, err := l.acquire(tc.Conn)
+		require.NoError(t, err)
+		release()
+	}
+}
+
+func TestQueryLimiterConcurrency(t *testing.T) {
+	l, logs := newLoggingQueryLimiter()
+	l.setLimits(QueryLimits{
+		MaxConcurrentPerUser: 2,
+		Databases:            map[string]QueryLimit{"small": {Concurrent: 1}},
+	})
+	app := server.NewTestConn(&bytes.Buffer{})
+	app.SetUser("app", "db")
+	small := server.NewTestConn(&bytes.Buffer{})
+	small.SetUser("other", "small")
+
+	release1, err := l.acquire(app.Conn)
+	require.NoError(t, err)
+	_, err = l.acquire(app.Conn)
+	require.NoError(t, err)
+	_, err = l.acquire(app.Conn)
+	requireTooManyQueries(t, err, logs, `too many queries for role "app"`, "--max-concurrent-queries-per-user")
+
+	// A finished query frees its slot, once.
+	release1()
+	release1()
+	_, err = l.acquire(app.Conn)
+	require.NoError(t, err)
+	_, err = l.acquire(app.Conn)
+	require.Error(t, err)
+
+	_, err = l.acquire(small.Conn)
+	require.NoError(t, err)
+	_, err = l.acquire(small.Conn)
+	requireTooManyQueries(t, err, logs, `too many queries for database "small"`, "--database-query-limits")
+}
+
+func TestHandlerQueryLimits(t *testing.T) {
+	var logs bytes.Buffer
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.New(slog.NewTextHandler(&logs, nil)))
+	h.SetQueryLimits(QueryLimits{MaxQPSPerDatabase: 1})
+	tc := server.NewTestConn(&bytes.Buffer{})
+	tc.SetUser("app", "db")
+	callback := func(context.Context, *sqltypes.Result) error { return nil }
+
+	require.NoError(t, h.HandleQuery(context.Background(), tc.Conn, "SELECT 1", callback))
+	err := h.HandleQuery(context.Background(), tc.Conn, "SELECT 1", callback)
+	requireTooManyQueries(t, err, &logs, `too many queries for database "db"`, "--max-qps-per-database")
+
+	// The queries of a transaction block are not limited.
+	h.getConnectionState(tc.Conn).BeginTransaction()
+	require.NoError(t, h.HandleQuery(context.Background(), tc.Conn, "SELECT 1", callback))
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"strings"
+	"time"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+// Parameters owned by the gateway, which choose where the statements of a
+// session run and how their errors are reported. They are set like any
+// run-time parameter, with SET, SET LOCAL, RESET or in the startup
+// parameters, but are never sent to PostgreSQL.
+const (
+	// TargetVariable selects the poolers the reads of the session run on:
+	// "primary" (the default) or "replica".
+	TargetVariable = "multigres.target"
+	// MaxReplicationLagVariable is the replication lag beyond which a
+	// replica refuses the reads of the session, which then run on the
+	// primary. It is a time setting, in milliseconds without a unit; 0
+	// (the default) sets no limit.
+	MaxReplicationLagVariable = "multigres.max_replication_lag"
+	// DebugErrorsVariable is a Boolean which, when on, appends to the
+	// detail of the errors returned by PostgreSQL the shard and the pooler
+	// they came from. It is off by default, leaving the errors as
+	// PostgreSQL sent them.
+	DebugErrorsVariable = "multigres.debug_errors"
+)
+
+// TargetValues are the values TargetVariable accepts.
+var TargetValues = []string{"primary", "replica"}
+
+// IsGatewayVariable returns true if name is a parameter owned by the
+// gateway rather than PostgreSQL.
+func IsGatewayVariable(name string) bool {
+	switch normalizeVariableName(name) {
+	case TargetVariable, MaxReplicationLagVariable, DebugErrorsVariable:
+		return true
+	}
+	return false
+}
+
+// ParseTarget parses a value of TargetVariable into the type of pooler
+// reads run on. It reports false for other values.
+func ParseTarget(value string) (clustermetadatapb.PoolerType, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "primary":
+		return clustermetadatapb.PoolerType_PRIMARY, true
+	case "replica":
+		return clustermetadatapb.PoolerType_REPLICA, true
+	}
+	return clustermetadatapb.PoolerType_UNKNOWN, false
+}
+
+// ParseMaxReplicationLag parses a value of MaxReplicationLagVariable, as in
+// "500", "5s" or "1 min". It reports false for values that are not a
+// non-negative time.
+func ParseMaxReplicationLag(value string) (time.Duration, bool) {
+	return parseTimeSetting(value)
+}
+
+// ParseDebugErrors parses a value of DebugErrorsVariable. It reports false
+// for values that are not a Boolean.
+func ParseDebugErrors(value string) (bool, bool) {
+	return parseDirectiveBool(strings.TrimSpace(value), true)
+}
+
+// TargetsReplicas returns true if the session sends its reads to replicas
+// (multigres.target = 'replica'). An invalid value, which only set_config
+// can give, targets the primary.
+func (m *MultiGatewayConnectionState) TargetsReplicas() bool {
+	value, ok := m.GetSessionVariable(TargetVariable)
+	if !ok {
+		return false
+	}
+	target, _ := ParseTarget(value)
+	return target == clustermetadatapb.PoolerType_REPLICA
+}
+
+// MaxReplicationLag returns the replication lag beyond which a replica must
+// refuse the reads of the session, or 0 for no limit.
+func (m *MultiGatewayConnectionState) MaxReplicationLag() time.Duration {
+	value, ok := m.GetSessionVariable(MaxReplicationLagVariable)
+	if !ok {
+		return 0
+	}
+	lag, _ := ParseMaxReplicationLag(value)
+	return lag
+}
+
+// DebugErrors returns true if the session asked for the routing context of
+// its errors (multigres.debug_errors = on).
+func (m *MultiGatewayConnectionState) DebugErrors() bool {
+	value, ok := m.GetSessionVariable(DebugErrorsVariable)
+	if !ok {
+		return false
+	}
+	debug, _ := ParseDebugErrors(value)
+	return debug
+}
+
+// SetReplicaTransaction records whether the transaction block being opened
+// runs on replicas, as a read-only transaction of a session targeting
+// replicas does. It is cleared when the block ends.
+func (m *MultiGatewayConnectionState) SetReplicaTransaction(onReplica bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.replicaTransaction = onReplica
+}
+
+// ReplicaTransaction returns true while the session's transaction block,
+// from its BEGIN to its end, runs on replicas.
+func (m *MultiGatewayConnectionState) ReplicaTransaction() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.replicaTransaction
+}
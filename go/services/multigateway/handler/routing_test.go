@@ -0,0 +1,91 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		value string
+		want  clustermetadatapb.PoolerType
+		ok    bool
+	}{
+		{"primary", clustermetadatapb.PoolerType_PRIMARY, true},
+		{"replica", clustermetadatapb.PoolerType_REPLICA, true},
+		{" Replica ", clustermetadatapb.PoolerType_REPLICA, true},
+		{"drained", clustermetadatapb.PoolerType_UNKNOWN, false},
+		{"", clustermetadatapb.PoolerType_UNKNOWN, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := ParseTarget(tt.value)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestIsGatewayVariable(t *testing.T) {
+	assert.True(t, IsGatewayVariable("multigres.target"))
+	assert.True(t, IsGatewayVariable("Multigres.Max_Replication_Lag"))
+	assert.True(t, IsGatewayVariable("multigres.debug_errors"))
+	assert.False(t, IsGatewayVariable("search_path"))
+	assert.False(t, IsGatewayVariable("multigres.other"))
+}
+
+func TestConnectionState_Routing(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	assert.False(t, state.TargetsReplicas())
+	assert.Zero(t, state.MaxReplicationLag())
+
+	state.SetStartupParams(map[string]string{"options": "-c multigres.target=replica"})
+	assert.True(t, state.TargetsReplicas())
+
+	state.SetSessionVariable(MaxReplicationLagVariable, "5s")
+	assert.Equal(t, 5*time.Second, state.MaxReplicationLag())
+	state.SetSessionVariable(MaxReplicationLagVariable, "bogus")
+	assert.Zero(t, state.MaxReplicationLag())
+
+	assert.False(t, state.DebugErrors())
+	state.SetSessionVariable(DebugErrorsVariable, "on")
+	assert.True(t, state.DebugErrors())
+	state.SetSessionVariable(DebugErrorsVariable, "bogus")
+	assert.False(t, state.DebugErrors())
+
+	state.SetSessionVariable(TargetVariable, "primary")
+	assert.False(t, state.TargetsReplicas())
+	state.ResetSessionVariable(TargetVariable)
+	assert.True(t, state.TargetsReplicas(), "RESET returns to the startup value")
+
+	// Gateway parameters are never replayed on backends.
+	state.SetSessionVariable("search_path", "app")
+	assert.Equal(t, map[string]string{"search_path": "app"}, state.GetSessionSettings())
+}
+
+func TestConnectionState_ReplicaTransaction(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	state.SetReplicaTransaction(true)
+	state.BeginTransaction()
+	assert.True(t, state.ReplicaTransaction())
+	state.EndTransaction(true)
+	assert.False(t, state.ReplicaTransaction())
+}
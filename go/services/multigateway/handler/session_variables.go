@@ -0,0 +1,467 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+)
+
+// SessionVariables tracks the run-time parameters (GUCs) of a client session
+// the way PostgreSQL resolves them, in three layers:
+//
+//   - startup: the parameters of the startup message, including the -c and
+//     --name=value switches of the options parameter. RESET returns to these.
+//   - session: the values set with SET and set_config(..., false).
+//   - local: the values set with SET LOCAL and set_config(..., true), which
+//     last until the end of the current transaction.
+//
+// Session-layer changes made in a transaction block are undone if the
+// transaction, or the savepoint they were made after, is rolled back.
+//
+// Pooled backends are shared between sessions, so the merged startup and
+// session layers are replayed on whichever backend runs the next statement
+// (see Settings). The local layer is not replayed: it only lives within a
+// transaction, which runs on a single backend.
+//
+// SessionVariables is not safe for concurrent use; MultiGatewayConnectionState
+// guards it with its mutex.
+type SessionVariables struct {
+	startup map[string]string
+	session map[string]string
+	local   map[string]string
+
+	// label identifies the session on its backend connections; it is
+	// appended to the application_name they get (see Settings).
+	label string
+
+	// inTransaction is set between BEGIN and the end of the transaction.
+	inTransaction bool
+
+	// snapshots holds the session and local layers as they were at BEGIN,
+	// followed by one entry per open savepoint.
+	snapshots []variablesSnapshot
+}
+
+// variablesSnapshot is the state of the session and local layers at the
+// start of a transaction or savepoint, restored when it is rolled back.
+type variablesSnapshot struct {
+	savepoint string
+	session   map[string]string
+	local     map[string]string
+}
+
+// ignoredStartupParams are the startup parameters that are not run-time
+// parameters, or that cannot be changed on an open backend connection.
+var ignoredStartupParams = map[string]bool{
+	"user":        true,
+	"database":    true,
+	"replication": true,
+	"options":     true,
+}
+
+// NewSessionVariables creates the variables of a session that started with
+// the given startup parameters.
+func NewSessionVariables(startupParams map[string]string) *SessionVariables {
+	startup := make(map[string]string)
+	for name, value := range startupParams {
+		name = normalizeVariableName(name)
+		// Protocol extensions (_pq_.*) are negotiated, not set.
+		if ignoredStartupParams[name] || strings.HasPrefix(name, "_pq_.") {
+			continue
+		}
+		startup[name] = value
+	}
+	// Switches in options override the parameters sent on their own, as
+	// they are processed last by PostgreSQL.
+	maps.Copy(startup, parseStartupOptions(startupParams["options"]))
+	return &SessionVariables{startup: startup}
+}
+
+// parseStartupOptions extracts the run-time parameters from the options
+// startup parameter: "-c name=value", "-cname=value" and "--name=value".
+// Other switches are ignored.
+func parseStartupOptions(options string) map[string]string {
+	vars := make(map[string]string)
+	args := splitStartupOptions(options)
+	for i := 0; i < len(args); i++ {
+		var setting string
+		switch arg := args[i]; {
+		case arg == "-c":
+			if i+1 == len(args) {
+				continue
+			}
+			i++
+			setting = args[i]
+		case strings.HasPrefix(arg, "-c"):
+			setting = arg[2:]
+		case strings.HasPrefix(arg, "--"):
+			setting = arg[2:]
+		default:
+			continue
+		}
+		name, value, ok := strings.Cut(setting, "=")
+		if !ok || name == "" {
+			continue
+		}
+		// As in PostgreSQL, dashes in names stand for underscores.
+		vars[normalizeVariableName(strings.ReplaceAll(name, "-", "_"))] = value
+	}
+	return vars
+}
+
+// splitStartupOptions splits options on whitespace; a backslash makes the
+// next character literal, so that values can contain spaces.
+func splitStartupOptions(options string) []string {
+	var args []string
+	var current strings.Builder
+	inArg, escaped := false, false
+	for _, r := range options {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			inArg, escaped = true, true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args
+}
+
+// normalizeVariableName returns the canonical form of a parameter name.
+// Parameter names are case-insensitive in PostgreSQL.
+func normalizeVariableName(name string) string {
+	return strings.ToLower(name)
+}
+
+// Set sets a parameter for the rest of the session (SET).
+func (v *SessionVariables) Set(name, value string) {
+	name = normalizeVariableName(name)
+	if v.session == nil {
+		v.session = make(map[string]string)
+	}
+	v.session[name] = value
+	// A later SET overrides SET LOCAL for the rest of the transaction.
+	delete(v.local, name)
+}
+
+// SetLocal sets a parameter until the end of the current transaction
+// (SET LOCAL). Outside a transaction block it has no effect.
+func (v *SessionVariables) SetLocal(name, value string) {
+	if !v.inTransaction {
+		return
+	}
+	if v.local == nil {
+		v.local = make(map[string]string)
+	}
+	v.local[normalizeVariableName(name)] = value
+}
+
+// Reset returns a parameter to its startup value, or to the server default
+// if it was not set at startup (RESET).
+func (v *SessionVariables) Reset(name string) {
+	name = normalizeVariableName(name)
+	delete(v.session, name)
+	delete(v.local, name)
+}
+
+// ResetAll returns every parameter to its startup value (RESET ALL).
+func (v *SessionVariables) ResetAll() {
+	v.session = nil
+	v.local = nil
+}
+
+// Get returns the current value of a parameter, if the session has set it
+// or it was given at startup.
+func (v *SessionVariables) Get(name string) (string, bool) {
+	name = normalizeVariableName(name)
+	if value, ok := v.local[name]; ok {
+		return value, true
+	}
+	if value, ok := v.session[name]; ok {
+		return value, true
+	}
+	value, ok := v.startup[name]
+	return value, ok
+}
+
+// Settings returns the parameters to replay on a backend connection before
+// running a statement of the session: the startup layer merged with the
+// session layer, with the session label appended to application_name.
+// The parameters owned by the gateway are left out (see IsGatewayVariable).
+// Returns nil if there are none.
+func (v *SessionVariables) Settings() map[string]string {
+	if len(v.startup) == 0 && len(v.session) == 0 && v.label == "" {
+		return nil
+	}
+	settings := make(map[string]string, len(v.startup)+len(v.session)+1)
+	maps.Copy(settings, v.startup)
+	maps.Copy(settings, v.session)
+	maps.DeleteFunc(settings, func(name, _ string) bool { return IsGatewayVariable(name) })
+	if len(settings) == 0 && v.label == "" {
+		return nil
+	}
+	if v.label != "" {
+		settings["application_name"] = labelApplicationName(settings["application_name"], v.label)
+	}
+	return settings
+}
+
+// SetLabel sets the label identifying the session on its backend
+// connections, or removes it if empty.
+func (v *SessionVariables) SetLabel(label string) {
+	v.label = label
+}
+
+// maxApplicationNameLen is the longest application_name PostgreSQL keeps
+// (NAMEDATALEN - 1 bytes); longer names are truncated.
+const maxApplicationNameLen = 63
+
+// labelApplicationName appends a session label to an application name, as
+// in "psql (multigres:42)". The name is shortened if needed so that the
+// label survives PostgreSQL's truncation.
+func labelApplicationName(name, label string) string {
+	if name == "" {
+		return label
+	}
+	suffix := " (" + label + ")"
+	if room := maxApplicationNameLen - len(suffix); len(name) > room {
+		if room <= 0 {
+			return label
+		}
+		// Cut on a character boundary.
+		for room > 0 && !utf8.RuneStart(name[room]) {
+			room--
+		}
+		name = name[:room]
+	}
+	return name + suffix
+}
+
+// ApplicationNameLabel returns the session label of a backend
+// application_name set by labelApplicationName. For an unlabeled name, it
+// returns the name itself, which matches no session label.
+func ApplicationNameLabel(name string) string {
+	if strings.HasSuffix(name, ")") {
+		if i := strings.LastIndex(name, " ("); i >= 0 {
+			return name[i+len(" (") : len(name)-1]
+		}
+	}
+	return name
+}
+
+// SessionLayer returns a copy of the values set during the session,
+// without the startup parameters.
+func (v *SessionVariables) SessionLayer() map[string]string {
+	return maps.Clone(v.session)
+}
+
+// RestoreSessionLayer replaces the values set during the session.
+func (v *SessionVariables) RestoreSessionLayer(session map[string]string) {
+	v.session = maps.Clone(session)
+}
+
+// InTransaction returns true inside a transaction block.
+func (v *SessionVariables) InTransaction() bool {
+	return v.inTransaction
+}
+
+// BeginTransaction records the start of a transaction block (BEGIN).
+func (v *SessionVariables) BeginTransaction() {
+	if v.inTransaction {
+		// PostgreSQL only warns about a nested BEGIN.
+		return
+	}
+	v.inTransaction = true
+	v.snapshots = []variablesSnapshot{v.snapshot("")}
+}
+
+// EndTransaction records the end of the transaction block. If it did not
+// commit, the session-layer changes made during it are undone. The local
+// layer is dropped either way.
+func (v *SessionVariables) EndTransaction(commit bool) {
+	if !commit && len(v.snapshots) > 0 {
+		v.session = v.snapshots[0].session
+	}
+	v.local = nil
+	v.snapshots = nil
+	v.inTransaction = false
+}
+
+// Savepoint records a savepoint (SAVEPOINT).
+func (v *SessionVariables) Savepoint(name string) {
+	if !v.inTransaction {
+		return
+	}
+	v.snapshots = append(v.snapshots, v.snapshot(name))
+}
+
+// RollbackToSavepoint undoes the changes made after a savepoint, which
+// stays open (ROLLBACK TO SAVEPOINT).
+func (v *SessionVariables) RollbackToSavepoint(name string) {
+	i := v.findSavepoint(name)
+	if i < 0 {
+		return
+	}
+	v.session = maps.Clone(v.snapshots[i].session)
+	v.local = maps.Clone(v.snapshots[i].local)
+	v.snapshots = v.snapshots[:i+1]
+}
+
+// ReleaseSavepoint forgets a savepoint and the ones after it, keeping their
+// changes (RELEASE SAVEPOINT).
+func (v *SessionVariables) ReleaseSavepoint(name string) {
+	if i := v.findSavepoint(name); i >= 0 {
+		v.snapshots = v.snapshots[:i]
+	}
+}
+
+// findSavepoint returns the index in snapshots of the most recent savepoint
+// with the given name, or -1.
+func (v *SessionVariables) findSavepoint(name string) int {
+	for i := len(v.snapshots) - 1; i > 0; i-- {
+		if v.snapshots[i].savepoint == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (v *SessionVariables) snapshot(savepoint string) variablesSnapshot {
+	return variablesSnapshot{
+		savepoint: savepoint,
+		session:   maps.Clone(v.session),
+		local:     maps.Clone(v.local),
+	}
+}
+
+// VariablesSnapshot is a point-in-time copy of the startup and session
+// layers of a session's variables. It is immutable, so it can be handed to
+// another session or kept to compare against later.
+//
+// The local layer and the transaction state are not part of a snapshot:
+// they do not outlive the transaction, which stays on its backend.
+type VariablesSnapshot struct {
+	startup map[string]string
+	session map[string]string
+	label   string
+}
+
+// Snapshot returns a copy of the startup and session layers.
+func (v *SessionVariables) Snapshot() *VariablesSnapshot {
+	return &VariablesSnapshot{
+		startup: maps.Clone(v.startup),
+		session: maps.Clone(v.session),
+		label:   v.label,
+	}
+}
+
+// Restore replaces the startup and session layers with those of a snapshot.
+// The session keeps its own label.
+func (v *SessionVariables) Restore(snapshot *VariablesSnapshot) {
+	if snapshot == nil {
+		v.startup, v.session = nil, nil
+		return
+	}
+	v.startup = maps.Clone(snapshot.startup)
+	v.session = maps.Clone(snapshot.session)
+}
+
+// Settings returns the parameters a backend connection has once the
+// snapshot is applied to it, as SessionVariables.Settings does.
+func (s *VariablesSnapshot) Settings() map[string]string {
+	if s == nil {
+		return nil
+	}
+	vars := SessionVariables{startup: s.startup, session: s.session, label: s.label}
+	return vars.Settings()
+}
+
+// VariablesDiff is what changes on a backend connection going from one
+// snapshot to another.
+type VariablesDiff struct {
+	// Set holds the parameters with a new value.
+	Set map[string]string
+	// Reset holds the parameters that go back to the server default, sorted.
+	Reset []string
+}
+
+// DiffVariables returns the changes to make to a backend connection with
+// the settings of from to give it those of to. A nil snapshot stands for a
+// connection with no settings.
+func DiffVariables(from, to *VariablesSnapshot) VariablesDiff {
+	fromSettings, toSettings := from.Settings(), to.Settings()
+	var diff VariablesDiff
+	for name, value := range toSettings {
+		if old, ok := fromSettings[name]; ok && old == value {
+			continue
+		}
+		if diff.Set == nil {
+			diff.Set = make(map[string]string)
+		}
+		diff.Set[name] = value
+	}
+	for name := range fromSettings {
+		if _, ok := toSettings[name]; !ok {
+			diff.Reset = append(diff.Reset, name)
+		}
+	}
+	slices.Sort(diff.Reset)
+	return diff
+}
+
+// IsEmpty returns true if there is nothing to change.
+func (d VariablesDiff) IsEmpty() bool {
+	return len(d.Set) == 0 && len(d.Reset) == 0
+}
+
+// Query returns the SET and RESET statements that apply the diff, in name
+// order, or "" if it is empty.
+func (d VariablesDiff) Query() string {
+	stmts := make([]string, 0, len(d.Set)+len(d.Reset))
+	for _, name := range slices.Sorted(maps.Keys(d.Set)) {
+		stmts = append(stmts, "SET SESSION "+ast.QuoteQualifiedIdentifier(name)+" = "+quoteVariableValue(d.Set[name]))
+	}
+	for _, name := range d.Reset {
+		stmts = append(stmts, "RESET "+ast.QuoteQualifiedIdentifier(name))
+	}
+	return strings.Join(stmts, "; ")
+}
+
+// quoteVariableValue returns value as a string literal. An escape string is
+// used when it has backslashes, so that it reads the same whatever the
+// connection's standard_conforming_strings.
+func quoteVariableValue(value string) string {
+	if !strings.Contains(value, `\`) {
+		return ast.QuoteStringLiteral(value)
+	}
+	return "E" + ast.QuoteStringLiteral(strings.ReplaceAll(value, `\`, `\\`))
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestNewSessionVariables(t *testing.T) {
+	vars := NewSessionVariables(map[string]string{
+		"user":             "alice",
+		"database":         "app",
+		"replication":      "false",
+		"_pq_.extension":   "x",
+		"Application_Name": "psql",
+		"DateStyle":        "ISO, MDY",
+		"options":          `-c search_path=app -cstatement_timeout=5s --work-mem=64MB -c application_name=my\ app -X`,
+	})
+
+	assert.Equal(t, map[string]string{
+		"application_name":  "my app",
+		"datestyle":         "ISO, MDY",
+		"search_path":       "app",
+		"statement_timeout": "5s",
+		"work_mem":          "64MB",
+	}, vars.Settings())
+}
+
+func TestNewSessionVariablesEmpty(t *testing.T) {
+	vars := NewSessionVariables(map[string]string{"user": "alice", "database": "app"})
+	assert.Nil(t, vars.Settings())
+
+	_, ok := vars.Get("search_path")
+	assert.False(t, ok)
+}
+
+func TestSessionVariablesLayers(t *testing.T) {
+	vars := NewSessionVariables(map[string]string{"application_name": "psql"})
+
+	vars.Set("SEARCH_PATH", "app")
+	vars.Set("application_name", "worker")
+	assert.Equal(t, map[string]string{"application_name": "worker", "search_path": "app"}, vars.Settings())
+
+	// RESET returns to the startup value, or to the server default.
+	vars.Reset("application_name")
+	vars.Reset("search_path")
+	assert.Equal(t, map[string]string{"application_name": "psql"}, vars.Settings())
+
+	vars.Set("search_path", "app")
+	vars.Set("application_name", "worker")
+	vars.ResetAll()
+	assert.Equal(t, map[string]string{"application_name": "psql"}, vars.Settings())
+	assert.Empty(t, vars.SessionLayer())
+}
+
+func TestSessionVariablesSetLocal(t *testing.T) {
+	vars := NewSessionVariables(nil)
+
+	// SET LOCAL outside a transaction block has no effect.
+	vars.SetLocal("search_path", "app")
+	_, ok := vars.Get("search_path")
+	assert.False(t, ok)
+
+	vars.BeginTransaction()
+	vars.Set("search_path", "session")
+	vars.SetLocal("search_path", "local")
+
+	value, ok := vars.Get("search_path")
+	require.True(t, ok)
+	assert.Equal(t, "local", value)
+	// The local layer is not replayed on other backends.
+	assert.Equal(t, map[string]string{"search_path": "session"}, vars.Settings())
+
+	vars.EndTransaction(true)
+	value, _ = vars.Get("search_path")
+	assert.Equal(t, "session", value)
+}
+
+func TestSessionVariablesSetOverridesSetLocal(t *testing.T) {
+	vars := NewSessionVariables(nil)
+	vars.BeginTransaction()
+	vars.SetLocal("work_mem", "1MB")
+	vars.Set("work_mem", "2MB")
+
+	value, _ := vars.Get("work_mem")
+	assert.Equal(t, "2MB", value)
+}
+
+func TestSessionVariablesTransactions(t *testing.T) {
+	t.Run("rollback reverts session changes", func(t *testing.T) {
+		vars := NewSessionVariables(map[string]string{"application_name": "psql"})
+		vars.Set("search_path", "before")
+
+		vars.BeginTransaction()
+		vars.Set("search_path", "during")
+		vars.Reset("application_name")
+		vars.Set("work_mem", "64MB")
+		vars.EndTransaction(false)
+
+		assert.Equal(t, map[string]string{"application_name": "psql", "search_path": "before"}, vars.Settings())
+		assert.False(t, vars.InTransaction())
+	})
+
+	t.Run("commit keeps session changes", func(t *testing.T) {
+		vars := NewSessionVariables(nil)
+		vars.BeginTransaction()
+		vars.Set("search_path", "during")
+		vars.EndTransaction(true)
+
+		assert.Equal(t, map[string]string{"search_path": "during"}, vars.Settings())
+	})
+
+	t.Run("rollback outside a transaction block changes nothing", func(t *testing.T) {
+		vars := NewSessionVariables(nil)
+		vars.Set("search_path", "app")
+		vars.EndTransaction(false)
+
+		assert.Equal(t, map[string]string{"search_path": "app"}, vars.Settings())
+	})
+
+	t.Run("nested begin keeps the outer snapshot", func(t *testing.T) {
+		vars := NewSessionVariables(nil)
+		vars.BeginTransaction()
+		vars.Set("search_path", "during")
+		vars.BeginTransaction()
+		vars.EndTransaction(false)
+
+		assert.Nil(t, vars.Settings())
+	})
+}
+
+func TestSessionVariablesSavepoints(t *testing.T) {
+	vars := NewSessionVariables(nil)
+	vars.BeginTransaction()
+	vars.Set("search_path", "a")
+
+	vars.Savepoint("s1")
+	vars.Set("search_path", "b")
+	vars.SetLocal("work_mem", "1MB")
+
+	vars.Savepoint("s2")
+	vars.Set("search_path", "c")
+
+	vars.RollbackToSavepoint("s1")
+	value, _ := vars.Get("search_path")
+	assert.Equal(t, "a", value)
+	_, ok := vars.Get("work_mem")
+	assert.False(t, ok)
+
+	// The savepoint stays open after ROLLBACK TO.
+	vars.Set("search_path", "d")
+	vars.RollbackToSavepoint("s1")
+	value, _ = vars.Get("search_path")
+	assert.Equal(t, "a", value)
+
+	// s2 was discarded by the rollback to s1.
+	vars.Set("search_path", "e")
+	vars.RollbackToSavepoint("s2")
+	value, _ = vars.Get("search_path")
+	assert.Equal(t, "e", value)
+
+	// RELEASE keeps the changes, but the transaction can still roll back.
+	vars.ReleaseSavepoint("s1")
+	vars.RollbackToSavepoint("s1")
+	value, _ = vars.Get("search_path")
+	assert.Equal(t, "e", value)
+
+	vars.EndTransaction(false)
+	assert.Nil(t, vars.Settings())
+}
+
+func TestMultiGatewayConnectionState_SessionVariables(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	state.SetStartupParams(map[string]string{"user": "alice", "TimeZone": "UTC"})
+
+	state.SetSessionVariable("search_path", "app")
+	assert.Equal(t, map[string]string{"timezone": "UTC", "search_path": "app"}, state.GetSessionSettings())
+
+	state.BeginTransaction()
+	state.SetLocalVariable("timezone", "Europe/Paris")
+	value, ok := state.GetSessionVariable("TimeZone")
+	require.True(t, ok)
+	assert.Equal(t, "Europe/Paris", value)
+	state.EndTransaction(true)
+
+	value, _ = state.GetSessionVariable("timezone")
+	assert.Equal(t, "UTC", value)
+
+	state.ResetAllSessionVariables()
+	assert.Equal(t, map[string]string{"timezone": "UTC"}, state.GetSessionSettings())
+}
+
+func TestSessionVariablesSnapshotRestore(t *testing.T) {
+	vars := NewSessionVariables(map[string]string{"application_name": "psql"})
+	vars.Set("search_path", "app")
+	snapshot := vars.Snapshot()
+
+	// The snapshot does not follow later changes.
+	vars.Set("search_path", "other")
+	assert.Equal(t, map[string]string{"application_name": "psql", "search_path": "app"}, snapshot.Settings())
+
+	other := NewSessionVariables(map[string]string{"timezone": "UTC"})
+	other.Restore(snapshot)
+	assert.Equal(t, map[string]string{"application_name": "psql", "search_path": "app"}, other.Settings())
+
+	// RESET returns to the restored startup value.
+	other.Set("application_name", "worker")
+	other.Reset("application_name")
+	value, _ := other.Get("application_name")
+	assert.Equal(t, "psql", value)
+}
+
+func TestDiffVariables(t *testing.T) {
+	from := NewSessionVariables(map[string]string{"application_name": "psql"})
+	from.Set("search_path", "app")
+	from.Set("work_mem", "64MB")
+
+	to := NewSessionVariables(map[string]string{"application_name": "psql"})
+	to.Set("search_path", "other")
+	to.Set("app.tenant", `it's a\path`)
+
+	diff := DiffVariables(from.Snapshot(), to.Snapshot())
+	assert.Equal(t, map[string]string{"app.tenant": `it's a\path`, "search_path": "other"}, diff.Set)
+	assert.Equal(t, []string{"work_mem"}, diff.Reset)
+	assert.Equal(t, `SET SESSION app.tenant = E'it''s a\\path'; SET SESSION search_path = 'other'; RESET work_mem`, diff.Query())
+
+	assert.True(t, DiffVariables(to.Snapshot(), to.Snapshot()).IsEmpty())
+	assert.Equal(t, []string{"application_name", "search_path", "work_mem"}, DiffVariables(from.Snapshot(), nil).Reset)
+}
+
+func TestMultiGatewayConnectionState_ShardVariables(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	state.SetSessionVariable("search_path", "app")
+
+	target := &query.Target{TableGroup: "tg", Shard: "0"}
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 1})
+	ss := state.GetMatchingShardState(target)
+	require.NotNil(t, ss)
+	assert.True(t, DiffVariables(ss.Variables, state.SnapshotVariables()).IsEmpty())
+
+	state.SetSessionVariable("search_path", "other")
+	diff := DiffVariables(ss.Variables, state.SnapshotVariables())
+	assert.Equal(t, map[string]string{"search_path": "other"}, diff.Set)
+
+	state.SetShardVariables(target, state.SnapshotVariables())
+	assert.True(t, DiffVariables(ss.Variables, state.SnapshotVariables()).IsEmpty())
+}
+
+func TestSessionVariablesLabel(t *testing.T) {
+	vars := NewSessionVariables(nil)
+	vars.SetLabel("multigres:7")
+	assert.Equal(t, map[string]string{"application_name": "multigres:7"}, vars.Settings())
+
+	vars.Set("application_name", "psql")
+	assert.Equal(t, map[string]string{"application_name": "psql (multigres:7)"}, vars.Settings())
+
+	// The session sees its own name.
+	value, _ := vars.Get("application_name")
+	assert.Equal(t, "psql", value)
+
+	// Long names are shortened so that the label is not truncated.
+	vars.Set("application_name", strings.Repeat("é", 40))
+	name := vars.Settings()["application_name"]
+	assert.LessOrEqual(t, len(name), maxApplicationNameLen)
+	assert.True(t, strings.HasSuffix(name, "é (multigres:7)"))
+	assert.True(t, utf8.ValidString(name))
+}
+
+func TestApplicationNameLabel(t *testing.T) {
+	assert.Equal(t, "multigres:7", ApplicationNameLabel(labelApplicationName("psql (1)", "multigres:7")))
+	assert.Equal(t, "multigres:7", ApplicationNameLabel(labelApplicationName("", "multigres:7")))
+	assert.Equal(t, "multigres:7", ApplicationNameLabel(labelApplicationName(strings.Repeat("x", 80), "multigres:7")))
+	assert.Equal(t, "psql", ApplicationNameLabel("psql"))
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// SessionInfo describes a client session for the sessions admin page.
+type SessionInfo struct {
+	ConnectionID uint32 `json:"connection_id"`
+	User         string `json:"user"`
+	Database     string `json:"database"`
+	RemoteAddr   string `json:"remote_addr"`
+	// ApplicationName is the application_name set by the client.
+	ApplicationName string `json:"application_name"`
+	// BackendApplicationName is the application_name of the session's
+	// backend connections, labeled to identify the session.
+	BackendApplicationName string `json:"backend_application_name"`
+	InTransaction          bool   `json:"in_transaction"`
+	// ReservedConnections is the number of backend connections reserved
+	// for the session, one per shard at most.
+	ReservedConnections int `json:"reserved_connections"`
+	// State is what the session does, as in pg_stat_activity: "active",
+	// "idle", "idle in transaction" or "idle in transaction (aborted)".
+	State string `json:"state"`
+	// Query is the statement running, or the last one run if idle, and
+	// QueryStart when it started.
+	Query      string    `json:"query"`
+	QueryStart time.Time `json:"query_start"`
+	// ConnectedAt is when the client connected, Age how long ago.
+	ConnectedAt time.Time     `json:"connected_at"`
+	Age         time.Duration `json:"age"`
+}
+
+// sessionRegistry tracks the open client connections of a handler.
+type sessionRegistry struct {
+	mu    sync.Mutex
+	conns map[uint32]*server.Conn
+}
+
+func (r *sessionRegistry) add(conn *server.Conn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conns == nil {
+		r.conns = make(map[uint32]*server.Conn)
+	}
+	r.conns[conn.ConnectionID()] = conn
+}
+
+func (r *sessionRegistry) remove(conn *server.Conn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.conns, conn.ConnectionID())
+}
+
+func (r *sessionRegistry) get(connectionID uint32) (*server.Conn, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conn, ok := r.conns[connectionID]
+	return conn, ok
+}
+
+func (r *sessionRegistry) list() []*server.Conn {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conns := make([]*server.Conn, 0, len(r.conns))
+	for _, conn := range r.conns {
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+// SetSessionLabelPrefix enables labeling the backend connections of each
+// new session: the prefix followed by the connection ID is appended to the
+// application_name they get, as in "psql (multigres:42)", so that backend
+// load can be attributed to the client session.
+//
+// Labeled sessions never share connection settings, so pooled connections
+// are reset more often when switching sessions. An empty prefix disables
+// labeling.
+func (h *MultiGatewayHandler) SetSessionLabelPrefix(prefix string) {
+	h.sessionLabelPrefix = prefix
+}
+
+// sessionLabel returns the label of the backend connections of a session,
+// or "" if labeling is disabled.
+func (h *MultiGatewayHandler) sessionLabel(conn *server.Conn) string {
+	if h.sessionLabelPrefix == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%d", h.sessionLabelPrefix, conn.ConnectionID())
+}
+
+// Sessions returns the open client sessions, ordered by connection ID.
+func (h *MultiGatewayHandler) Sessions() []SessionInfo {
+	conns := h.sessions.list()
+	now := time.Now()
+	sessions := make([]SessionInfo, 0, len(conns))
+	for _, conn := range conns {
+		state, ok := conn.GetConnectionState().(*MultiGatewayConnectionState)
+		if !ok {
+			continue
+		}
+		info := SessionInfo{
+			ConnectionID: conn.ConnectionID(),
+			User:         conn.User(),
+			Database:     conn.Database(),
+		}
+		if addr := conn.RemoteAddr(); addr != nil {
+			info.RemoteAddr = addr.String()
+		}
+		info.ApplicationName, _ = state.GetSessionVariable("application_name")
+		info.BackendApplicationName = state.GetSessionSettings()["application_name"]
+		info.InTransaction = state.InTransaction()
+		info.ReservedConnections = state.reservedConnectionCount()
+		info.State, info.Query, info.QueryStart = state.activity()
+		info.ConnectedAt = conn.ConnectedAt()
+		info.Age = now.Sub(info.ConnectedAt)
+		sessions = append(sessions, info)
+	}
+	slices.SortFunc(sessions, func(a, b SessionInfo) int {
+		return cmp.Compare(a.ConnectionID, b.ConnectionID)
+	})
+	return sessions
+}
+
+// CancelSession cancels the query the session of connectionID runs, as a
+// cancel request from its client would. It reports whether the session
+// exists, whether or not it was running a query.
+func (h *MultiGatewayHandler) CancelSession(connectionID uint32) bool {
+	conn, ok := h.sessions.get(connectionID)
+	if !ok {
+		return false
+	}
+	conn.CancelQuery()
+	return true
+}
+
+// TerminateSession closes the client connection of connectionID, rolling
+// back its transaction and releasing its backend connections. It reports
+// whether the session exists.
+func (h *MultiGatewayHandler) TerminateSession(connectionID uint32) bool {
+	conn, ok := h.sessions.get(connectionID)
+	if !ok {
+		return false
+	}
+	h.logger.Info("terminating session", "connection_id", connectionID, "user", conn.User(), "database", conn.Database())
+	conn.Terminate()
+	return true
+}
+
+// ApplicationNameCounts returns the number of open sessions per client
+// application_name; sessions without one are counted under "".
+func (h *MultiGatewayHandler) ApplicationNameCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, conn := range h.sessions.list() {
+		state, ok := conn.GetConnectionState().(*MultiGatewayConnectionState)
+		if !ok {
+			continue
+		}
+		name, _ := state.GetSessionVariable("application_name")
+		counts[name]++
+	}
+	return counts
+}
+
+// SessionTransaction describes a session in a transaction block.
+type SessionTransaction struct {
+	Conn *server.Conn
+	// Label identifies the session on its backend connections.
+	Label string
+	// Participants are the shards the transaction runs on.
+	Participants []*query.Target
+}
+
+// Transactions returns the sessions in a transaction block on at least one
+// shard, ordered by connection ID. Sessions can only be told apart on the
+// backends if they are labeled (see SetSessionLabelPrefix), so it returns
+// nil otherwise.
+func (h *MultiGatewayHandler) Transactions() []SessionTransaction {
+	if h.sessionLabelPrefix == "" {
+		return nil
+	}
+	var txns []SessionTransaction
+	for _, conn := range h.sessions.list() {
+		state, ok := conn.GetConnectionState().(*MultiGatewayConnectionState)
+		if !ok {
+			continue
+		}
+		participants := state.TransactionParticipants()
+		if len(participants) == 0 {
+			continue
+		}
+		txns = append(txns, SessionTransaction{
+			Conn:         conn,
+			Label:        h.sessionLabel(conn),
+			Participants: participants,
+		})
+	}
+	slices.SortFunc(txns, func(a, b SessionTransaction) int {
+		return cmp.Compare(a.Conn.ConnectionID(), b.Conn.ConnectionID())
+	})
+	return txns
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+func TestHandlerSessions(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	h.SetSessionLabelPrefix("multigres:gw1:")
+
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	err := h.HandleQuery(context.Background(), conn, "SELECT 1", func(context.Context, *sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(t, err)
+	h.getConnectionState(conn).SetSessionVariable("application_name", "worker")
+
+	sessions := h.Sessions()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "worker", sessions[0].ApplicationName)
+	assert.Equal(t, "worker (multigres:gw1:0)", sessions[0].BackendApplicationName)
+	assert.Equal(t, map[string]int{"worker": 1}, h.ApplicationNameCounts())
+
+	h.HandleConnectionClose(conn)
+	assert.Empty(t, h.Sessions())
+}
+
+func TestHandlerSessionsActivity(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	err := h.HandleQuery(context.Background(), conn, "SELECT 1", func(context.Context, *sqltypes.Result) error {
+		sessions := h.Sessions()
+		require.Len(t, sessions, 1)
+		assert.Equal(t, "active", sessions[0].State)
+		assert.Equal(t, "SELECT 1", sessions[0].Query)
+		assert.False(t, sessions[0].QueryStart.IsZero())
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Once done, the last query is still shown.
+	sessions := h.Sessions()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "idle", sessions[0].State)
+	assert.Equal(t, "SELECT 1", sessions[0].Query)
+
+	h.getConnectionState(conn).BeginTransaction()
+	assert.Equal(t, "idle in transaction", h.Sessions()[0].State)
+	h.getConnectionState(conn).FailTransaction()
+	assert.Equal(t, "idle in transaction (aborted)", h.Sessions()[0].State)
+}
+
+func TestHandlerCancelSession(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	tc := server.NewTestConn(&bytes.Buffer{})
+	tc.SetConnectionID(7)
+	h.getConnectionState(tc.Conn)
+
+	ctx, endQuery := tc.BeginQuery()
+	defer endQuery()
+	assert.False(t, h.CancelSession(8), "unknown session")
+	require.NoError(t, ctx.Err())
+
+	assert.True(t, h.CancelSession(7))
+	assert.Error(t, ctx.Err())
+
+	assert.False(t, h.TerminateSession(8), "unknown session")
+	assert.True(t, h.TerminateSession(7))
+}
+
+func TestHandlerSessionsUnlabeled(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := h.getConnectionState(conn)
+	assert.Nil(t, state.GetSessionSettings())
+	assert.Equal(t, map[string]int{"": 1}, h.ApplicationNameCounts())
+}
+
+func TestHandlerTransactions(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := h.getConnectionState(conn)
+	target := &query.Target{TableGroup: "tg", Shard: "0"}
+	state.BeginTransaction()
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 1})
+
+	// Unlabeled sessions cannot be identified on the backends.
+	assert.Nil(t, h.Transactions())
+
+	h.SetSessionLabelPrefix("multigres:gw1:")
+	txns := h.Transactions()
+	require.Len(t, txns, 1)
+	assert.Equal(t, "multigres:gw1:0", txns[0].Label)
+	assert.Equal(t, []*query.Target{target}, txns[0].Participants)
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// SessionTimeouts are the timeouts the gateway enforces on client sessions,
+// whether or not the backend enforces its own, so that a runaway statement
+// or an abandoned transaction cannot hold backend connections and locks
+// indefinitely. A session that sets statement_timeout or
+// idle_in_transaction_session_timeout itself, with SET or in its startup
+// parameters, gets its own value instead. A timeout of 0 disables it.
+type SessionTimeouts struct {
+	// StatementTimeout is how long a statement may run before it is
+	// cancelled.
+	StatementTimeout time.Duration
+	// IdleInTransactionSessionTimeout is how long a session may stay idle
+	// in a transaction block before it is terminated.
+	IdleInTransactionSessionTimeout time.Duration
+	// UserStatementTimeouts are the statement timeouts of specific roles.
+	UserStatementTimeouts map[string]time.Duration
+	// UserIdleInTransactionSessionTimeouts are the idle-in-transaction
+	// timeouts of specific roles.
+	UserIdleInTransactionSessionTimeouts map[string]time.Duration
+}
+
+// errStatementTimeout is the cause of a statement context cancelled by the
+// statement timeout.
+var errStatementTimeout = errors.New("statement timeout")
+
+// ParseSessionTimeouts parses timeouts given as "name=duration" entries, as
+// in "reporting=5m".
+func ParseSessionTimeouts(specs []string) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration, len(specs))
+	for _, spec := range specs {
+		name, value, found := strings.Cut(strings.TrimSpace(spec), "=")
+		if name == "" || !found {
+			return nil, fmt.Errorf("invalid timeout %q: expected name=duration", spec)
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid timeout %q: the timeout must be a non-negative duration", spec)
+		}
+		timeouts[name] = timeout
+	}
+	return timeouts, nil
+}
+
+// SetSessionTimeouts sets the timeouts the gateway enforces on client
+// sessions. It must be called before the handler serves connections.
+func (h *MultiGatewayHandler) SetSessionTimeouts(timeouts SessionTimeouts) {
+	h.timeouts = timeouts
+}
+
+// withStatementTimeout returns the context to run the statements of sql
+// on conn in, cancelled with errStatementTimeout once the statement timeout
+// of the session, or the one of the timeout directive of sql, expires.
+// Cancelling the context cancels the statement on the backend.
+func (h *MultiGatewayHandler) withStatementTimeout(ctx context.Context, conn *server.Conn, sql string) (context.Context, context.CancelFunc) {
+	timeout := h.sessionTimeout(conn, "statement_timeout", h.timeouts.UserStatementTimeouts, h.timeouts.StatementTimeout)
+	// Invalid directives fail the statement when it is planned.
+	if directives, err := ParseQueryDirectives(sql); err == nil && directives.HasTimeout {
+		timeout = directives.Timeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeoutCause(ctx, timeout, errStatementTimeout)
+}
+
+// statementError returns the error to report for a statement that failed
+// with err in ctx: the error PostgreSQL reports for a statement cancelled
+// by its statement timeout, whatever error the cancellation surfaced as.
+func statementError(ctx context.Context, err error) error {
+	if err != nil && errors.Is(context.Cause(ctx), errStatementTimeout) {
+		return sqlstate.NewError(sqlstate.QueryCanceled).
+			Msg("canceling statement due to statement timeout").
+			Err()
+	}
+	return err
+}
+
+// idleInTransactionTimeout returns how long the session of conn may stay
+// idle in a transaction block.
+func (h *MultiGatewayHandler) idleInTransactionTimeout(conn *server.Conn) time.Duration {
+	return h.sessionTimeout(conn, "idle_in_transaction_session_timeout",
+		h.timeouts.UserIdleInTransactionSessionTimeouts, h.timeouts.IdleInTransactionSessionTimeout)
+}
+
+// sessionTimeout returns the timeout the session of conn gets from the
+// named setting if it set it, or otherwise the timeout of its role or the
+// default.
+func (h *MultiGatewayHandler) sessionTimeout(conn *server.Conn, setting string, users map[string]time.Duration, def time.Duration) time.Duration {
+	if value, ok := h.getConnectionState(conn).GetSessionVariable(setting); ok {
+		if timeout, ok := parseTimeSetting(value); ok {
+			return timeout
+		}
+	}
+	if timeout, ok := users[conn.User()]; ok {
+		return timeout
+	}
+	return def
+}
+
+// timeSettingUnits are the units of time settings, by the names PostgreSQL
+// accepts. Values without a unit are in milliseconds.
+var timeSettingUnits = map[string]time.Duration{
+	"":    time.Millisecond,
+	"us":  time.Microsecond,
+	"ms":  time.Millisecond,
+	"s":   time.Second,
+	"min": time.Minute,
+	"h":   time.Hour,
+	"d":   24 * time.Hour,
+}
+
+// parseTimeSetting parses the value of a time setting such as
+// statement_timeout, as in "5000", "5s" or "1.5 min". It reports false for
+// values PostgreSQL would reject.
+func parseTimeSetting(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	number, unit := value, ""
+	if i := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	}); i >= 0 {
+		number, unit = value[:i], strings.TrimSpace(value[i:])
+	}
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, false
+	}
+	scale, ok := timeSettingUnits[unit]
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(n * float64(scale)), true
+}
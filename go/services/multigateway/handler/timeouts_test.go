@@ -0,0 +1,141 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// blockingExecutor is an executor whose statements run until cancelled.
+type blockingExecutor struct {
+	mockExecutor
+}
+
+func (m *blockingExecutor) StreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, astStmt ast.Stmt, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestParseTimeSetting(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"5000", 5 * time.Second, true},
+		{"0", 0, true},
+		{"250ms", 250 * time.Millisecond, true},
+		{"5s", 5 * time.Second, true},
+		{"1.5 min", 90 * time.Second, true},
+		{"2h", 2 * time.Hour, true},
+		{"1d", 24 * time.Hour, true},
+		{"100us", 100 * time.Microsecond, true},
+		{"5 seconds", 0, false},
+		{"", 0, false},
+		{"-1", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseTimeSetting(tt.value)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+}
+
+func TestParseSessionTimeouts(t *testing.T) {
+	timeouts, err := ParseSessionTimeouts([]string{"reporting=5m", " app=0s "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"reporting": 5 * time.Minute, "app": 0}, timeouts)
+
+	for _, spec := range []string{"reporting", "=5m", "reporting=", "reporting=5", "reporting=-1s"} {
+		_, err := ParseSessionTimeouts([]string{spec})
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestHandlerStatementTimeout(t *testing.T) {
+	h := NewMultiGatewayHandler(&blockingExecutor{}, slog.Default())
+	h.SetSessionTimeouts(SessionTimeouts{StatementTimeout: 10 * time.Millisecond})
+
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	err := h.HandleQuery(context.Background(), conn, "SELECT pg_sleep(10)", func(context.Context, *sqltypes.Result) error {
+		return nil
+	})
+	var diag *sqltypes.PgDiagnostic
+	require.True(t, errors.As(err, &diag), "expected a PgDiagnostic, got %v", err)
+	assert.Equal(t, sqlstate.QueryCanceled, diag.Code)
+	assert.Equal(t, "canceling statement due to statement timeout", diag.Message)
+}
+
+func TestHandlerSessionTimeouts(t *testing.T) {
+	h := NewMultiGatewayHandler(&mockExecutor{}, slog.Default())
+	h.SetSessionTimeouts(SessionTimeouts{
+		StatementTimeout:                     time.Minute,
+		IdleInTransactionSessionTimeout:      time.Hour,
+		UserStatementTimeouts:                map[string]time.Duration{"reporting": 10 * time.Minute},
+		UserIdleInTransactionSessionTimeouts: map[string]time.Duration{"reporting": 0},
+	})
+	statementTimeoutOf := func(conn *server.Conn, sql string) time.Duration {
+		ctx, cancel := h.withStatementTimeout(context.Background(), conn, sql)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return 0
+		}
+		return time.Until(deadline).Round(time.Minute)
+	}
+	statementTimeout := func(conn *server.Conn) time.Duration {
+		return statementTimeoutOf(conn, "SELECT 1")
+	}
+
+	// The defaults apply to sessions of roles without their own.
+	tc := server.NewTestConn(&bytes.Buffer{})
+	tc.SetUser("app", "db")
+	assert.Equal(t, time.Minute, statementTimeout(tc.Conn))
+	assert.Equal(t, time.Hour, h.idleInTransactionTimeout(tc.Conn))
+
+	// The timeouts of a role override the defaults.
+	tc = server.NewTestConn(&bytes.Buffer{})
+	tc.SetUser("reporting", "db")
+	assert.Equal(t, 10*time.Minute, statementTimeout(tc.Conn))
+	assert.Zero(t, h.idleInTransactionTimeout(tc.Conn))
+
+	// The settings of the session override both, unless invalid.
+	state := h.getConnectionState(tc.Conn)
+	state.SetSessionVariable("statement_timeout", "2min")
+	state.SetSessionVariable("idle_in_transaction_session_timeout", "30s")
+	assert.Equal(t, 2*time.Minute, statementTimeout(tc.Conn))
+	assert.Equal(t, 30*time.Second, h.idleInTransactionTimeout(tc.Conn))
+	state.SetSessionVariable("statement_timeout", "0")
+	assert.Zero(t, statementTimeout(tc.Conn))
+	state.SetSessionVariable("statement_timeout", "soon")
+	assert.Equal(t, 10*time.Minute, statementTimeout(tc.Conn))
+
+	// The timeout directive of a statement overrides them all.
+	assert.Equal(t, 3*time.Minute, statementTimeoutOf(tc.Conn, "SELECT /*+ multigres: timeout=3min */ 1"))
+	assert.Zero(t, statementTimeoutOf(tc.Conn, "/*+ multigres: timeout=0 */ SELECT 1"))
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// TransactionStatus is the transaction state of a session, as reported to
+// the client in ReadyForQuery.
+//
+// The pooler does not report the status of the backend, so the gateway
+// derives it from the transaction control statements of the session and
+// the outcome of its statements:
+//
+//	Idle     --BEGIN-->              InBlock
+//	InBlock  --error-->              Failed
+//	Failed   --ROLLBACK TO-->        InBlock
+//	InBlock, Failed --COMMIT/ROLLBACK--> Idle
+type TransactionStatus byte
+
+const (
+	// TransactionIdle is outside a transaction block.
+	TransactionIdle TransactionStatus = protocol.TxnStatusIdle
+	// TransactionInBlock is inside a transaction block.
+	TransactionInBlock TransactionStatus = protocol.TxnStatusInBlock
+	// TransactionFailed is inside a transaction block that failed: only
+	// statements ending it, or rolling back to a savepoint, are accepted.
+	TransactionFailed TransactionStatus = protocol.TxnStatusFailed
+)
+
+// String returns the name of the status.
+func (s TransactionStatus) String() string {
+	switch s {
+	case TransactionIdle:
+		return "idle"
+	case TransactionInBlock:
+		return "in transaction"
+	case TransactionFailed:
+		return "failed transaction"
+	default:
+		return "unknown"
+	}
+}
+
+// TransactionStatus returns the transaction state of the session.
+func (m *MultiGatewayConnectionState) TransactionStatus() TransactionStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.transactionStatus()
+}
+
+// transactionStatus returns the transaction state of the session. The
+// caller must hold the mutex.
+func (m *MultiGatewayConnectionState) transactionStatus() TransactionStatus {
+	switch {
+	case !m.variables().InTransaction():
+		return TransactionIdle
+	case m.txnFailed:
+		return TransactionFailed
+	default:
+		return TransactionInBlock
+	}
+}
+
+// FailTransaction records that a statement failed. Inside a transaction
+// block, the block is then failed until it ends; outside one, the failed
+// statement had its own transaction and nothing changes.
+func (m *MultiGatewayConnectionState) FailTransaction() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.variables().InTransaction() {
+		m.txnFailed = true
+	}
+}
+
+// CheckTransactionNotFailed returns the error PostgreSQL reports for stmt
+// in a failed transaction block, unless stmt ends the block or rolls back
+// to a savepoint. It returns nil outside a failed block.
+func (m *MultiGatewayConnectionState) CheckTransactionNotFailed(stmt ast.Stmt) error {
+	if m.TransactionStatus() != TransactionFailed || isTransactionExitStmt(stmt) {
+		return nil
+	}
+	return sqlstate.NewError(sqlstate.InFailedSQLTransaction).
+		Msg("current transaction is aborted, commands ignored until end of transaction block").
+		Err()
+}
+
+// isTransactionExitStmt returns true for the statements accepted in a
+// failed transaction block, as PostgreSQL's IsTransactionExitStmt.
+func isTransactionExitStmt(stmt ast.Stmt) bool {
+	ts, ok := stmt.(*ast.TransactionStmt)
+	if !ok {
+		return false
+	}
+	switch ts.Kind {
+	case ast.TRANS_STMT_COMMIT, ast.TRANS_STMT_PREPARE, ast.TRANS_STMT_ROLLBACK, ast.TRANS_STMT_ROLLBACK_TO:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/preparedstatement"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func parseStmt(t *testing.T, sql string) ast.Stmt {
+	t.Helper()
+	stmts, err := parser.ParseSQL(sql)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	return stmts[0]
+}
+
+func TestTransactionStatus(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	assert.Equal(t, TransactionIdle, state.TransactionStatus())
+
+	// A failure outside a transaction block changes nothing.
+	state.FailTransaction()
+	assert.Equal(t, TransactionIdle, state.TransactionStatus())
+
+	state.BeginTransaction()
+	assert.Equal(t, TransactionInBlock, state.TransactionStatus())
+
+	state.Savepoint("s")
+	state.FailTransaction()
+	assert.Equal(t, TransactionFailed, state.TransactionStatus())
+
+	state.RollbackToSavepoint("s")
+	assert.Equal(t, TransactionInBlock, state.TransactionStatus())
+
+	state.FailTransaction()
+	state.EndTransaction(false)
+	assert.Equal(t, TransactionIdle, state.TransactionStatus())
+
+	// The next transaction block starts afresh.
+	state.BeginTransaction()
+	assert.Equal(t, TransactionInBlock, state.TransactionStatus())
+}
+
+func TestTransactionStatusFailedCommitKeepsNoVariables(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	state.BeginTransaction()
+	state.SetSessionVariable("search_path", "during")
+	state.FailTransaction()
+
+	// A failed transaction block rolls back, even when committed.
+	state.EndTransaction(true)
+	assert.Nil(t, state.GetSessionSettings())
+}
+
+func TestCheckTransactionNotFailed(t *testing.T) {
+	state := NewMultiGatewayConnectionState()
+	require.NoError(t, state.CheckTransactionNotFailed(parseStmt(t, "SELECT 1")))
+
+	state.BeginTransaction()
+	state.FailTransaction()
+
+	err := state.CheckTransactionNotFailed(parseStmt(t, "SELECT 1"))
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.InFailedSQLTransaction, diag.Code)
+
+	require.Error(t, state.CheckTransactionNotFailed(parseStmt(t, "SAVEPOINT s")))
+	require.Error(t, state.CheckTransactionNotFailed(parseStmt(t, "BEGIN")))
+	for _, sql := range []string{"COMMIT", "END", "ROLLBACK", "ABORT", "ROLLBACK TO s", "PREPARE TRANSACTION 'x'"} {
+		assert.NoError(t, state.CheckTransactionNotFailed(parseStmt(t, sql)), sql)
+	}
+}
+
+// txnExecutor is an Executor recording the transaction boundaries of the
+// statements it runs, and failing those that select from missing.
+type txnExecutor struct {
+	mockExecutor
+}
+
+func (m *txnExecutor) StreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, queryStr string, astStmt ast.Stmt, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	if err := state.CheckTransactionNotFailed(astStmt); err != nil {
+		return err
+	}
+	switch queryStr {
+	case "BEGIN":
+		state.BeginTransaction()
+	case "ROLLBACK":
+		state.EndTransaction(false)
+	case "SELECT * FROM missing":
+		return errors.New("relation \"missing\" does not exist")
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: queryStr})
+}
+
+func (m *txnExecutor) PortalStreamExecute(ctx context.Context, conn *server.Conn, state *MultiGatewayConnectionState, portalInfo *preparedstatement.PortalInfo, maxRows int32, callback func(ctx context.Context, result *sqltypes.Result) error) error {
+	return errors.New("portal failed")
+}
+
+func TestHandlerReportsTransactionStatus(t *testing.T) {
+	h := NewMultiGatewayHandler(&txnExecutor{}, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	ctx := context.Background()
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+
+	require.NoError(t, h.HandleQuery(ctx, conn, "BEGIN", discard))
+	assert.Equal(t, byte(protocol.TxnStatusInBlock), conn.TxnStatus())
+
+	require.Error(t, h.HandleQuery(ctx, conn, "SELECT * FROM missing", discard))
+	assert.Equal(t, byte(protocol.TxnStatusFailed), conn.TxnStatus())
+
+	require.Error(t, h.HandleQuery(ctx, conn, "SELECT 1", discard))
+	assert.Equal(t, byte(protocol.TxnStatusFailed), conn.TxnStatus())
+
+	require.NoError(t, h.HandleQuery(ctx, conn, "ROLLBACK", discard))
+	assert.Equal(t, byte(protocol.TxnStatusIdle), conn.TxnStatus())
+
+	// Errors of the extended protocol are reported at the next Sync.
+	require.NoError(t, h.HandleQuery(ctx, conn, "BEGIN", discard))
+	require.NoError(t, h.HandleParse(ctx, conn, "", "SELECT 1", nil))
+	require.NoError(t, h.HandleBind(ctx, conn, "", "", nil, nil, nil))
+	require.Error(t, h.HandleExecute(ctx, conn, "", 0, discard))
+	assert.Equal(t, byte(protocol.TxnStatusInBlock), conn.TxnStatus())
+	require.NoError(t, h.HandleSync(ctx, conn))
+	assert.Equal(t, byte(protocol.TxnStatusFailed), conn.TxnStatus())
+}
@@ -20,25 +20,40 @@ package multigateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/multigres/multigres/go/common/constants"
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/pgprotocol/protocol"
+	"github.com/multigres/multigres/go/common/pgprotocol/scram"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/rpcclient"
 	"github.com/multigres/multigres/go/common/servenv"
 	"github.com/multigres/multigres/go/common/servenv/toporeg"
 	"github.com/multigres/multigres/go/common/topoclient"
 	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
 	"github.com/multigres/multigres/go/services/multigateway/auth"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
 	"github.com/multigres/multigres/go/services/multigateway/executor"
 	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/onlineddl"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
 	"github.com/multigres/multigres/go/services/multigateway/poolergateway"
 	"github.com/multigres/multigres/go/services/multigateway/scatterconn"
 	"github.com/multigres/multigres/go/tools/viperutil"
 )
 
+// twoPCCheckTimeout bounds how long startup waits for the shards to be
+// reachable, to check that they can prepare transactions, when two-phase
+// commit is enabled.
+const twoPCCheckTimeout = 10 * time.Second
+
 type MultiGateway struct {
 	cell viperutil.Value[string]
 	// serviceID string
@@ -47,8 +62,197 @@ type MultiGateway struct {
 	pgPort viperutil.Value[int]
 	// pgBindAddress is the address to bind the PostgreSQL listener to
 	pgBindAddress viperutil.Value[string]
+	// maxResultSize is the maximum number of bytes of shard rows a single query may buffer (0 = unlimited)
+	maxResultSize viperutil.Value[int64]
+	// planCacheSize is the maximum number of cached query plans (0 = no cache)
+	planCacheSize viperutil.Value[int]
+	// spillThreshold is the number of bytes beyond which duplicate rows are removed on disk (0 = never)
+	spillThreshold viperutil.Value[int64]
+	// shardingSchemaFile, if set, is the YAML sharding schema of the
+	// default tablegroup
+	shardingSchemaFile viperutil.Value[string]
+	// shardingSchemaDatabase, if set, is the database whose sharding schema
+	// in the topology shards the default tablegroup
+	shardingSchemaDatabase viperutil.Value[string]
+	// sequenceBlockSize is the number of values of a global sequence allocated at once
+	sequenceBlockSize viperutil.Value[int64]
+	// pgTraceMessages logs every PostgreSQL protocol message at debug level
+	pgTraceMessages viperutil.Value[bool]
+	// authCredentialsFile, if set, is a file of SCRAM verifiers used to
+	// authenticate clients instead of the backend's roles
+	authCredentialsFile viperutil.Value[string]
+	// pgTLSCertFile and pgTLSKeyFile enable TLS on the PostgreSQL listener
+	pgTLSCertFile viperutil.Value[string]
+	pgTLSKeyFile  viperutil.Value[string]
+	// pgTLSClientCAFile verifies client certificates against these CAs
+	pgTLSClientCAFile viperutil.Value[string]
+	// pgTLSCertIdentMapFile maps client certificate identities to roles,
+	// allowing passwordless certificate authentication
+	pgTLSCertIdentMapFile viperutil.Value[string]
+	// labelBackendSessions appends a session identifier to the
+	// application_name of backend connections
+	labelBackendSessions viperutil.Value[bool]
+	// transactionMode selects how transactions spanning several shards commit
+	transactionMode viperutil.Value[string]
+	// twoPCAbandonAge is the age after which a two-phase commit left
+	// unfinished is resolved by recovery
+	twoPCAbandonAge viperutil.Value[time.Duration]
+	// stopRecovery stops the recovery of two-phase commits
+	stopRecovery context.CancelFunc
+	// deadlockDetectionInterval is the interval between checks for
+	// deadlocks spanning shards, or 0, the default, to disable the
+	// detection; it needs labelBackendSessions
+	deadlockDetectionInterval viperutil.Value[time.Duration]
+	// stopDeadlockDetection stops the detection of deadlocks spanning shards
+	stopDeadlockDetection context.CancelFunc
+	// scatterMaxShardsPerQuery is the number of shards a query runs on at
+	// the same time, or 0 for all of them
+	scatterMaxShardsPerQuery viperutil.Value[int]
+	// scatterMaxShards is the number of shard queries all sessions run at
+	// the same time, or 0 for no limit
+	scatterMaxShards viperutil.Value[int64]
+	// scatterShardTimeout bounds the time each shard of a query takes
+	scatterShardTimeout viperutil.Value[time.Duration]
+	// scatterPartialResults returns the rows of the shards that answered
+	// when others fail a read
+	scatterPartialResults viperutil.Value[bool]
+	// retryMaxAttempts is the number of times a read failing on a shard
+	// with a transient error runs, including the first
+	retryMaxAttempts viperutil.Value[int]
+	// retryInitialBackoff and retryMaxBackoff bound the waits between
+	// the attempts of a read
+	retryInitialBackoff viperutil.Value[time.Duration]
+	retryMaxBackoff     viperutil.Value[time.Duration]
+	// retryBudget and retryBudgetRatio bound the retries of reads
+	retryBudget      viperutil.Value[float64]
+	retryBudgetRatio viperutil.Value[float64]
+	// failoverBufferWindow is the longest time statements are held while
+	// the primary of their shard fails over, or 0 to not hold them
+	failoverBufferWindow viperutil.Value[time.Duration]
+	// failoverBufferSize is the number of statements held at once
+	failoverBufferSize viperutil.Value[int]
+	// poolerGRPCMaxMessageSize is the largest message exchanged with the
+	// poolers, or 0 for grpc-max-message-size
+	poolerGRPCMaxMessageSize viperutil.Value[int]
+	// poolerGRPCCompression is the compression of the messages exchanged
+	// with the poolers
+	poolerGRPCCompression viperutil.Value[string]
+	// poolerGRPCKeepaliveTime is how long a connection to a pooler stays
+	// idle before it is pinged, or 0 to not ping
+	poolerGRPCKeepaliveTime viperutil.Value[time.Duration]
+	// poolerGRPCKeepaliveTimeout is how long the answer to a ping is waited
+	// for
+	poolerGRPCKeepaliveTimeout viperutil.Value[time.Duration]
+	// poolerGRPCConnections is the number of gRPC connections to each
+	// pooler
+	poolerGRPCConnections viperutil.Value[int]
+	// replicaHealthInterval is how often replicas report their health, or
+	// 0 to send reads to any replica
+	replicaHealthInterval viperutil.Value[time.Duration]
+	// schemaTrackingInterval is how often the primary pooler checks its
+	// catalog for changes of the tables, or 0 to not track them
+	schemaTrackingInterval viperutil.Value[time.Duration]
+	// cellFallback selects the cells of the replicas reads go to when the
+	// local cell has none to serve them
+	cellFallback viperutil.Value[string]
+	// maxReplicaLag is the replication lag beyond which a replica gets no
+	// reads, or 0 for no limit
+	maxReplicaLag viperutil.Value[time.Duration]
+	// ddlFailurePolicy is what a schema change of sharded tables does
+	// when a shard fails it
+	ddlFailurePolicy viperutil.Value[string]
+	// onlineDDLCheckInterval is the interval between checks of the queue
+	// of online schema changes, or 0 to not run them
+	onlineDDLCheckInterval viperutil.Value[time.Duration]
+	// onlineDDLBatchSize is the number of rows copied at a time by an
+	// online schema change
+	onlineDDLBatchSize viperutil.Value[int]
+	// onlineDDLCutoverLockTimeout bounds the wait for the lock swapping
+	// the tables of an online schema change
+	onlineDDLCutoverLockTimeout viperutil.Value[time.Duration]
+	// stopOnlineDDL stops running online schema changes
+	stopOnlineDDL context.CancelFunc
+	// resultCacheSize is the number of results of reads cached, or 0 to
+	// not cache them
+	resultCacheSize viperutil.Value[int]
+	// resultCacheMaxRows is the number of rows of the largest result cached
+	resultCacheMaxRows viperutil.Value[int]
+	// resultCacheTTL is the time results are cached for by default
+	resultCacheTTL viperutil.Value[time.Duration]
+	// resultCacheTables are the tables whose reads are cached without a
+	// hint, as table or table=ttl
+	resultCacheTables viperutil.Value[[]string]
+	// maxConnectionsPerUser is the number of client connections admitted
+	// per role, or 0 for no limit
+	maxConnectionsPerUser viperutil.Value[int]
+	// maxConnectionsPerDatabase is the number of client connections
+	// admitted per database, or 0 for no limit
+	maxConnectionsPerDatabase viperutil.Value[int]
+	// userConnectionLimits are the limits of specific roles, as role=limit
+	userConnectionLimits viperutil.Value[[]string]
+	// databaseConnectionLimits are the limits of specific databases, as
+	// database=limit
+	databaseConnectionLimits viperutil.Value[[]string]
+	// maxQPSPerUser is the queries per second of each role, or 0 for no
+	// limit
+	maxQPSPerUser viperutil.Value[float64]
+	// maxQPSPerDatabase is the queries per second of each database, or 0
+	// for no limit
+	maxQPSPerDatabase viperutil.Value[float64]
+	// maxConcurrentQueriesPerUser is the number of queries each role runs
+	// at the same time, or 0 for no limit
+	maxConcurrentQueriesPerUser viperutil.Value[int]
+	// maxConcurrentQueriesPerDatabase is the number of queries each
+	// database runs at the same time, or 0 for no limit
+	maxConcurrentQueriesPerDatabase viperutil.Value[int]
+	// userQueryLimits are the query limits of specific roles, as
+	// role=qps:concurrent
+	userQueryLimits viperutil.Value[[]string]
+	// databaseQueryLimits are the query limits of specific databases, as
+	// database=qps:concurrent
+	databaseQueryLimits viperutil.Value[[]string]
+	// throttleMaxReplicationLag is the replication lag beyond which writes
+	// are shed, or 0 to not throttle on it
+	throttleMaxReplicationLag viperutil.Value[time.Duration]
+	// throttleMaxQueueWait is the average queue wait of the shard queries
+	// beyond which statements are shed, or 0 to not throttle on it
+	throttleMaxQueueWait viperutil.Value[time.Duration]
+	// queryPassthrough relays the rows of single-shard simple queries to
+	// the client without decoding them
+	queryPassthrough viperutil.Value[bool]
+	// statementTimeout is how long a statement may run, or 0 for no limit
+	statementTimeout viperutil.Value[time.Duration]
+	// idleInTransactionSessionTimeout is how long a session may stay idle
+	// in a transaction block, or 0 for no limit
+	idleInTransactionSessionTimeout viperutil.Value[time.Duration]
+	// userStatementTimeouts are the statement timeouts of specific roles,
+	// as role=duration
+	userStatementTimeouts viperutil.Value[[]string]
+	// userIdleInTransactionSessionTimeouts are the idle-in-transaction
+	// timeouts of specific roles, as role=duration
+	userIdleInTransactionSessionTimeouts viperutil.Value[[]string]
+	// slowQueryThreshold is the duration beyond which queries are kept in
+	// the slow query log, or 0 to not log them
+	slowQueryThreshold viperutil.Value[time.Duration]
+	// slowQueryLogSize is the number of slow queries kept
+	slowQueryLogSize viperutil.Value[int]
+	// slowQueries keeps the slow queries, or is nil if disabled
+	slowQueries *executor.SlowQueryLog
+	// queryStatsSize is the number of normalized queries whose statistics
+	// are kept, or 0 to keep none
+	queryStatsSize viperutil.Value[int]
+	// queryStats holds the statistics of the queries, or is nil if disabled
+	queryStats *engine.QueryStats
+	// adminAPITokenFile, if set, is a file holding the bearer token of the
+	// admin HTTP API, which is only served then
+	adminAPITokenFile viperutil.Value[string]
+	// adminConsoleUsers are the roles allowed to use the admin console of
+	// the pgbouncer database, which is only served then
+	adminConsoleUsers viperutil.Value[[]string]
 	// poolerDiscovery handles discovery of multipoolers across all cells
 	poolerDiscovery *GlobalPoolerDiscovery
+	// routingRules hands the routing rules of the databases to the executor
+	routingRules *RoutingRulesWatcher
 	// poolerGateway manages connections to poolers
 	poolerGateway *poolergateway.PoolerGateway
 	// grpcServer is the grpc server
@@ -61,10 +265,14 @@ type MultiGateway struct {
 	scatterConn *scatterconn.ScatterConn
 	// executor handles query execution and routing
 	executor *executor.Executor
+	// planCache holds the plans of the executor, or is nil if disabled
+	planCache *planner.PlanCache
 	// senv is the serving environment
 	senv *servenv.ServEnv
 	// topoConfig holds topology configuration
-	topoConfig   *topoclient.TopoConfig
+	topoConfig *topoclient.TopoConfig
+	// connConfig holds the TLS configuration of the connections to the poolers
+	connConfig   *rpcclient.ConnConfig
 	ts           topoclient.Store
 	tr           *toporeg.TopoReg
 	serverStatus Status
@@ -97,9 +305,406 @@ func NewMultiGateway() *MultiGateway {
 			Dynamic:  false,
 			EnvVars:  []string{"MT_PG_BIND_ADDRESS"},
 		}),
+		maxResultSize: viperutil.Configure(reg, "max-result-size", viperutil.Options[int64]{
+			Default:  0,
+			FlagName: "max-result-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_RESULT_SIZE"},
+		}),
+		planCacheSize: viperutil.Configure(reg, "plan-cache-size", viperutil.Options[int]{
+			Default:  5000,
+			FlagName: "plan-cache-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_PLAN_CACHE_SIZE"},
+		}),
+		spillThreshold: viperutil.Configure(reg, "spill-threshold", viperutil.Options[int64]{
+			Default:  64 * 1024 * 1024,
+			FlagName: "spill-threshold",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SPILL_THRESHOLD"},
+		}),
+		shardingSchemaFile: viperutil.Configure(reg, "sharding-schema-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "sharding-schema-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SHARDING_SCHEMA_FILE"},
+		}),
+		shardingSchemaDatabase: viperutil.Configure(reg, "sharding-schema-database", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "sharding-schema-database",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SHARDING_SCHEMA_DATABASE"},
+		}),
+		sequenceBlockSize: viperutil.Configure(reg, "sequence-block-size", viperutil.Options[int64]{
+			Default:  engine.DefaultSequenceBlockSize,
+			FlagName: "sequence-block-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SEQUENCE_BLOCK_SIZE"},
+		}),
+		pgTraceMessages: viperutil.Configure(reg, "pg-trace-messages", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "pg-trace-messages",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_PG_TRACE_MESSAGES"},
+		}),
+		authCredentialsFile: viperutil.Configure(reg, "auth-credentials-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "auth-credentials-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_AUTH_CREDENTIALS_FILE"},
+		}),
+		pgTLSCertFile: viperutil.Configure(reg, "pg-tls-cert-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "pg-tls-cert-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_PG_TLS_CERT_FILE"},
+		}),
+		pgTLSKeyFile: viperutil.Configure(reg, "pg-tls-key-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "pg-tls-key-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_PG_TLS_KEY_FILE"},
+		}),
+		pgTLSClientCAFile: viperutil.Configure(reg, "pg-tls-client-ca-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "pg-tls-client-ca-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_PG_TLS_CLIENT_CA_FILE"},
+		}),
+		pgTLSCertIdentMapFile: viperutil.Configure(reg, "pg-tls-cert-ident-map-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "pg-tls-cert-ident-map-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_PG_TLS_CERT_IDENT_MAP_FILE"},
+		}),
+		labelBackendSessions: viperutil.Configure(reg, "label-backend-sessions", viperutil.Options[bool]{
+			Default:  true,
+			FlagName: "label-backend-sessions",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_LABEL_BACKEND_SESSIONS"},
+		}),
+		transactionMode: viperutil.Configure(reg, "transaction-mode", viperutil.Options[string]{
+			Default:  string(scatterconn.TransactionModeMulti),
+			FlagName: "transaction-mode",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_TRANSACTION_MODE"},
+		}),
+		twoPCAbandonAge: viperutil.Configure(reg, "twopc-abandon-age", viperutil.Options[time.Duration]{
+			Default:  time.Minute,
+			FlagName: "twopc-abandon-age",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_TWOPC_ABANDON_AGE"},
+		}),
+		deadlockDetectionInterval: viperutil.Configure(reg, "deadlock-detection-interval", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "deadlock-detection-interval",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_DEADLOCK_DETECTION_INTERVAL"},
+		}),
+		scatterMaxShardsPerQuery: viperutil.Configure(reg, "scatter-max-shards-per-query", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "scatter-max-shards-per-query",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SCATTER_MAX_SHARDS_PER_QUERY"},
+		}),
+		scatterMaxShards: viperutil.Configure(reg, "scatter-max-shards", viperutil.Options[int64]{
+			Default:  0,
+			FlagName: "scatter-max-shards",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SCATTER_MAX_SHARDS"},
+		}),
+		scatterShardTimeout: viperutil.Configure(reg, "scatter-shard-timeout", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "scatter-shard-timeout",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SCATTER_SHARD_TIMEOUT"},
+		}),
+		scatterPartialResults: viperutil.Configure(reg, "scatter-partial-results", viperutil.Options[bool]{
+			Default:  false,
+			FlagName: "scatter-partial-results",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SCATTER_PARTIAL_RESULTS"},
+		}),
+		retryMaxAttempts: viperutil.Configure(reg, "retry-max-attempts", viperutil.Options[int]{
+			Default:  3,
+			FlagName: "retry-max-attempts",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RETRY_MAX_ATTEMPTS"},
+		}),
+		retryInitialBackoff: viperutil.Configure(reg, "retry-initial-backoff", viperutil.Options[time.Duration]{
+			Default:  10 * time.Millisecond,
+			FlagName: "retry-initial-backoff",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RETRY_INITIAL_BACKOFF"},
+		}),
+		retryMaxBackoff: viperutil.Configure(reg, "retry-max-backoff", viperutil.Options[time.Duration]{
+			Default:  time.Second,
+			FlagName: "retry-max-backoff",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RETRY_MAX_BACKOFF"},
+		}),
+		retryBudget: viperutil.Configure(reg, "retry-budget", viperutil.Options[float64]{
+			Default:  100,
+			FlagName: "retry-budget",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RETRY_BUDGET"},
+		}),
+		retryBudgetRatio: viperutil.Configure(reg, "retry-budget-ratio", viperutil.Options[float64]{
+			Default:  0.1,
+			FlagName: "retry-budget-ratio",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RETRY_BUDGET_RATIO"},
+		}),
+		failoverBufferWindow: viperutil.Configure(reg, "failover-buffer-window", viperutil.Options[time.Duration]{
+			Default:  10 * time.Second,
+			FlagName: "failover-buffer-window",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_FAILOVER_BUFFER_WINDOW"},
+		}),
+		failoverBufferSize: viperutil.Configure(reg, "failover-buffer-size", viperutil.Options[int]{
+			Default:  1000,
+			FlagName: "failover-buffer-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_FAILOVER_BUFFER_SIZE"},
+		}),
+		poolerGRPCMaxMessageSize: viperutil.Configure(reg, "pooler-grpc-max-message-size", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "pooler-grpc-max-message-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_POOLER_GRPC_MAX_MESSAGE_SIZE"},
+		}),
+		poolerGRPCCompression: viperutil.Configure(reg, "pooler-grpc-compression", viperutil.Options[string]{
+			Default:  poolergateway.CompressionNone,
+			FlagName: "pooler-grpc-compression",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_POOLER_GRPC_COMPRESSION"},
+		}),
+		poolerGRPCKeepaliveTime: viperutil.Configure(reg, "pooler-grpc-keepalive-time", viperutil.Options[time.Duration]{
+			Default:  30 * time.Second,
+			FlagName: "pooler-grpc-keepalive-time",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_POOLER_GRPC_KEEPALIVE_TIME"},
+		}),
+		poolerGRPCKeepaliveTimeout: viperutil.Configure(reg, "pooler-grpc-keepalive-timeout", viperutil.Options[time.Duration]{
+			Default:  10 * time.Second,
+			FlagName: "pooler-grpc-keepalive-timeout",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_POOLER_GRPC_KEEPALIVE_TIMEOUT"},
+		}),
+		poolerGRPCConnections: viperutil.Configure(reg, "pooler-grpc-connections", viperutil.Options[int]{
+			Default:  1,
+			FlagName: "pooler-grpc-connections",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_POOLER_GRPC_CONNECTIONS"},
+		}),
+		replicaHealthInterval: viperutil.Configure(reg, "replica-health-interval", viperutil.Options[time.Duration]{
+			Default:  time.Second,
+			FlagName: "replica-health-interval",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_REPLICA_HEALTH_INTERVAL"},
+		}),
+		schemaTrackingInterval: viperutil.Configure(reg, "schema-tracking-interval", viperutil.Options[time.Duration]{
+			Default:  5 * time.Second,
+			FlagName: "schema-tracking-interval",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SCHEMA_TRACKING_INTERVAL"},
+		}),
+		cellFallback: viperutil.Configure(reg, "cell-fallback", viperutil.Options[string]{
+			Default:  string(CellFallbackAny),
+			FlagName: "cell-fallback",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_CELL_FALLBACK"},
+		}),
+		maxReplicaLag: viperutil.Configure(reg, "max-replica-lag", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "max-replica-lag",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_REPLICA_LAG"},
+		}),
+		ddlFailurePolicy: viperutil.Configure(reg, "ddl-failure-policy", viperutil.Options[string]{
+			Default:  string(engine.DDLFailureStop),
+			FlagName: "ddl-failure-policy",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_DDL_FAILURE_POLICY"},
+		}),
+		onlineDDLCheckInterval: viperutil.Configure(reg, "online-ddl-check-interval", viperutil.Options[time.Duration]{
+			Default:  10 * time.Second,
+			FlagName: "online-ddl-check-interval",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_ONLINE_DDL_CHECK_INTERVAL"},
+		}),
+		onlineDDLBatchSize: viperutil.Configure(reg, "online-ddl-batch-size", viperutil.Options[int]{
+			Default:  onlineddl.DefaultOptions.BatchSize,
+			FlagName: "online-ddl-batch-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_ONLINE_DDL_BATCH_SIZE"},
+		}),
+		onlineDDLCutoverLockTimeout: viperutil.Configure(reg, "online-ddl-cutover-lock-timeout", viperutil.Options[time.Duration]{
+			Default:  onlineddl.DefaultOptions.CutoverLockTimeout,
+			FlagName: "online-ddl-cutover-lock-timeout",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_ONLINE_DDL_CUTOVER_LOCK_TIMEOUT"},
+		}),
+		resultCacheSize: viperutil.Configure(reg, "result-cache-size", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "result-cache-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RESULT_CACHE_SIZE"},
+		}),
+		resultCacheMaxRows: viperutil.Configure(reg, "result-cache-max-rows", viperutil.Options[int]{
+			Default:  1000,
+			FlagName: "result-cache-max-rows",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RESULT_CACHE_MAX_ROWS"},
+		}),
+		resultCacheTTL: viperutil.Configure(reg, "result-cache-ttl", viperutil.Options[time.Duration]{
+			Default:  10 * time.Second,
+			FlagName: "result-cache-ttl",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RESULT_CACHE_TTL"},
+		}),
+		resultCacheTables: viperutil.Configure(reg, "result-cache-tables", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "result-cache-tables",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_RESULT_CACHE_TABLES"},
+		}),
+		maxConnectionsPerUser: viperutil.Configure(reg, "max-connections-per-user", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "max-connections-per-user",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_CONNECTIONS_PER_USER"},
+		}),
+		maxConnectionsPerDatabase: viperutil.Configure(reg, "max-connections-per-database", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "max-connections-per-database",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_CONNECTIONS_PER_DATABASE"},
+		}),
+		userConnectionLimits: viperutil.Configure(reg, "user-connection-limits", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "user-connection-limits",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_USER_CONNECTION_LIMITS"},
+		}),
+		databaseConnectionLimits: viperutil.Configure(reg, "database-connection-limits", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "database-connection-limits",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_DATABASE_CONNECTION_LIMITS"},
+		}),
+		maxQPSPerUser: viperutil.Configure(reg, "max-qps-per-user", viperutil.Options[float64]{
+			Default:  0,
+			FlagName: "max-qps-per-user",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_QPS_PER_USER"},
+		}),
+		maxQPSPerDatabase: viperutil.Configure(reg, "max-qps-per-database", viperutil.Options[float64]{
+			Default:  0,
+			FlagName: "max-qps-per-database",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_QPS_PER_DATABASE"},
+		}),
+		maxConcurrentQueriesPerUser: viperutil.Configure(reg, "max-concurrent-queries-per-user", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "max-concurrent-queries-per-user",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_CONCURRENT_QUERIES_PER_USER"},
+		}),
+		maxConcurrentQueriesPerDatabase: viperutil.Configure(reg, "max-concurrent-queries-per-database", viperutil.Options[int]{
+			Default:  0,
+			FlagName: "max-concurrent-queries-per-database",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_MAX_CONCURRENT_QUERIES_PER_DATABASE"},
+		}),
+		userQueryLimits: viperutil.Configure(reg, "user-query-limits", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "user-query-limits",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_USER_QUERY_LIMITS"},
+		}),
+		databaseQueryLimits: viperutil.Configure(reg, "database-query-limits", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "database-query-limits",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_DATABASE_QUERY_LIMITS"},
+		}),
+		throttleMaxReplicationLag: viperutil.Configure(reg, "throttle-max-replication-lag", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "throttle-max-replication-lag",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_THROTTLE_MAX_REPLICATION_LAG"},
+		}),
+		throttleMaxQueueWait: viperutil.Configure(reg, "throttle-max-queue-wait", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "throttle-max-queue-wait",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_THROTTLE_MAX_QUEUE_WAIT"},
+		}),
+		queryPassthrough: viperutil.Configure(reg, "query-passthrough", viperutil.Options[bool]{
+			Default:  true,
+			FlagName: "query-passthrough",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_QUERY_PASSTHROUGH"},
+		}),
+		statementTimeout: viperutil.Configure(reg, "statement-timeout", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "statement-timeout",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_STATEMENT_TIMEOUT"},
+		}),
+		idleInTransactionSessionTimeout: viperutil.Configure(reg, "idle-in-transaction-session-timeout", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "idle-in-transaction-session-timeout",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_IDLE_IN_TRANSACTION_SESSION_TIMEOUT"},
+		}),
+		userStatementTimeouts: viperutil.Configure(reg, "user-statement-timeouts", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "user-statement-timeouts",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_USER_STATEMENT_TIMEOUTS"},
+		}),
+		userIdleInTransactionSessionTimeouts: viperutil.Configure(reg, "user-idle-in-transaction-session-timeouts", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "user-idle-in-transaction-session-timeouts",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_USER_IDLE_IN_TRANSACTION_SESSION_TIMEOUTS"},
+		}),
+		slowQueryThreshold: viperutil.Configure(reg, "slow-query-threshold", viperutil.Options[time.Duration]{
+			Default:  0,
+			FlagName: "slow-query-threshold",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SLOW_QUERY_THRESHOLD"},
+		}),
+		slowQueryLogSize: viperutil.Configure(reg, "slow-query-log-size", viperutil.Options[int]{
+			Default:  100,
+			FlagName: "slow-query-log-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_SLOW_QUERY_LOG_SIZE"},
+		}),
+		queryStatsSize: viperutil.Configure(reg, "query-stats-size", viperutil.Options[int]{
+			Default:  5000,
+			FlagName: "query-stats-size",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_QUERY_STATS_SIZE"},
+		}),
+		adminAPITokenFile: viperutil.Configure(reg, "admin-api-token-file", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "admin-api-token-file",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_ADMIN_API_TOKEN_FILE"},
+		}),
+		adminConsoleUsers: viperutil.Configure(reg, "admin-console-users", viperutil.Options[[]string]{
+			Default:  []string{},
+			FlagName: "admin-console-users",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_ADMIN_CONSOLE_USERS"},
+		}),
 		grpcServer: servenv.NewGrpcServer(reg),
 		senv:       servenv.NewServEnv(reg),
 		topoConfig: topoclient.NewTopoConfig(reg),
+		connConfig: rpcclient.NewConnConfig(reg),
 		serverStatus: Status{
 			Title: "Multigateway",
 			Links: []Link{
@@ -107,6 +712,7 @@ func NewMultiGateway() *MultiGateway {
 				{"Live", "URL for liveness check", "/live"},
 				{"Ready", "URL for readiness check", "/ready"},
 				{"Consolidator", "Prepared statement consolidator stats", "/debug/consolidator"},
+				{"Sessions", "Open client sessions", "/debug/sessions"},
 			},
 		},
 	}
@@ -129,15 +735,148 @@ func (mg *MultiGateway) RegisterFlags(fs *pflag.FlagSet) {
 	fs.String("service-id", mg.serviceID.Default(), "optional service ID (if empty, a random ID will be generated)")
 	fs.Int("pg-port", mg.pgPort.Default(), "PostgreSQL protocol listen port")
 	fs.String("pg-bind-address", mg.pgBindAddress.Default(), "address to bind the PostgreSQL listener to")
+	fs.Int64("max-result-size", mg.maxResultSize.Default(), "maximum number of bytes of the rows of several shards a single query may buffer in the gateway to merge, aggregate or deduplicate them; the rows of single-shard queries are streamed and not limited (0 = unlimited)")
+	fs.Int64("spill-threshold", mg.spillThreshold.Default(), "number of bytes beyond which the duplicate rows of a DISTINCT or UNION on several shards are removed in temporary files rather than in memory (0 = never spill)")
+	fs.String("sharding-schema-file", mg.shardingSchemaFile.Default(), "path to the YAML sharding schema of the default tablegroup: its shards, sharding function, sharded tables with their shard key, reference tables, sequences and lookup indexes (empty = unsharded)")
+	fs.String("sharding-schema-database", mg.shardingSchemaDatabase.Default(), "database whose sharding schema in the topology, as applied by 'multigres topo applyshardingschema', shards the default tablegroup, read at startup (ignored with --sharding-schema-file)")
+	fs.Int64("sequence-block-size", mg.sequenceBlockSize.Default(), "number of values of a global sequence, generating the IDs of sharded tables, a gateway allocates at once; the values left unused when the gateway stops are skipped")
+	fs.Int("plan-cache-size", mg.planCacheSize.Default(), "maximum number of query plans cached by normalized query text, so that repeated simple queries are neither parsed nor planned again (0 = no cache)")
+	fs.Bool("pg-trace-messages", mg.pgTraceMessages.Default(), "log every PostgreSQL protocol message sent and received at debug level, for diagnosing client driver compatibility")
+	fs.String("auth-credentials-file", mg.authCredentialsFile.Default(), "path to a file of SCRAM-SHA-256 verifiers in PgBouncer auth_file format, used to authenticate clients instead of the backend's roles")
+	fs.String("pg-tls-cert-file", mg.pgTLSCertFile.Default(), "path to the PEM server certificate; enables TLS on the PostgreSQL listener")
+	fs.String("pg-tls-key-file", mg.pgTLSKeyFile.Default(), "path to the PEM private key of the server certificate")
+	fs.String("pg-tls-client-ca-file", mg.pgTLSClientCAFile.Default(), "path to PEM CA certificates that client certificates must be signed by, when presented")
+	fs.String("pg-tls-cert-ident-map-file", mg.pgTLSCertIdentMapFile.Default(), "path to a pg_ident.conf-style map of client certificate identities (CN or SAN) to roles; clients with a mapped certificate connect without a password")
+	fs.Bool("label-backend-sessions", mg.labelBackendSessions.Default(), "append a session identifier to the application_name of backend connections, so that backend load can be attributed to client sessions; sessions then never share pooled connection settings")
+	fs.String("transaction-mode", mg.transactionMode.Default(), "how transactions spanning several shards commit: multi (one shard after the other) or twopc (two-phase commit, all or nothing; needs max_prepared_transactions above zero on every shard)")
+	fs.Duration("twopc-abandon-age", mg.twoPCAbandonAge.Default(), "age after which a two-phase commit left unfinished, e.g. by a gateway crash, is resolved from the transaction log; must be well above the time a commit takes")
+	fs.Duration("deadlock-detection-interval", mg.deadlockDetectionInterval.Default(), "interval between checks for deadlocks among transactions spanning shards, which PostgreSQL cannot detect; requires --label-backend-sessions, since the detector finds the gateway sessions of backend transactions by their labels. Each gateway only detects the deadlocks among its own sessions: a cycle involving a session of another gateway is left to statement_timeout (0 = no detection)")
+	fs.Int("scatter-max-shards-per-query", mg.scatterMaxShardsPerQuery.Default(), "number of shards a query spanning several shards runs on at the same time (0 = all of them)")
+	fs.Int64("scatter-max-shards", mg.scatterMaxShards.Default(), "number of shard queries the queries spanning several shards of all sessions run at the same time, protecting the shards from fan-out storms (0 = unlimited)")
+	fs.Duration("scatter-shard-timeout", mg.scatterShardTimeout.Default(), "time each shard of a query spanning several shards may take before the query fails, or the shard is left out with scatter-partial-results (0 = no limit)")
+	fs.Bool("scatter-partial-results", mg.scatterPartialResults.Default(), "return the rows of the shards that answered, with a warning naming the others, when some shards fail a read spanning several shards outside a transaction block")
+	fs.Int("retry-max-attempts", mg.retryMaxAttempts.Default(), "number of times a read outside a transaction block runs on a shard failing with a transient error, such as a connection reset or a failover, including the first (1 = no retries)")
+	fs.Duration("retry-initial-backoff", mg.retryInitialBackoff.Default(), "wait before the first retry of a read, doubled for each of the next ones")
+	fs.Duration("retry-max-backoff", mg.retryMaxBackoff.Default(), "longest wait between two attempts of a read")
+	fs.Float64("retry-budget", mg.retryBudget.Default(), "number of retries of reads the gateway may run in a burst (0 = no budget)")
+	fs.Float64("retry-budget-ratio", mg.retryBudgetRatio.Default(), "retries of reads the budget earns for each read run, e.g. 0.1 for one retry every ten reads")
+	fs.Duration("failover-buffer-window", mg.failoverBufferWindow.Default(), "longest time statements are held while the primary of their shard fails over, then replayed against the new primary, so that planned failovers do not surface as errors (0 = no buffering)")
+	fs.Int("failover-buffer-size", mg.failoverBufferSize.Default(), "number of statements held at once during failovers; the statements beyond it fail without waiting")
+	fs.Int("pooler-grpc-max-message-size", mg.poolerGRPCMaxMessageSize.Default(), "largest gRPC message in bytes the gateway sends to or receives from the poolers, whose grpc-max-message-size must allow it too (0 = grpc-max-message-size)")
+	fs.String("pooler-grpc-compression", mg.poolerGRPCCompression.Default(), "compression of the gRPC messages exchanged with the poolers: none or gzip, which trades CPU for network on large results")
+	fs.Duration("pooler-grpc-keepalive-time", mg.poolerGRPCKeepaliveTime.Default(), "time a gRPC connection to a pooler stays idle before the gateway pings the pooler, to detect dead connections; must not be shorter than the grpc-server-keepalive-enforcement-policy-min-time of the poolers (0 = no pings)")
+	fs.Duration("pooler-grpc-keepalive-timeout", mg.poolerGRPCKeepaliveTimeout.Default(), "time the gateway waits for a pooler to answer a ping before closing the gRPC connection")
+	fs.Int("pooler-grpc-connections", mg.poolerGRPCConnections.Default(), "number of gRPC connections to each pooler the queries are spread over, rather than multiplexing all their streams over one connection")
+	fs.Duration("replica-health-interval", mg.replicaHealthInterval.Default(), "how often each replica pooler reports its health and replication lag to the gateway; reads only go to replicas that serve and report in time (0 = no health check, reads go to any replica)")
+	fs.Duration("schema-tracking-interval", mg.schemaTrackingInterval.Default(), "how often the primary pooler of the default tablegroup checks its catalog for changes of the tables, streamed to the planner, which invalidates the cached plans on the changed tables (0 = schema not tracked)")
+	fs.String("cell-fallback", mg.cellFallback.Default(), "cells of the replicas reads go to when the gateway's cell has none to serve them: any (the cells of its region first, then any other), region (the cells of its region only, as set in the cell's topology record) or none (fail the read)")
+	fs.Duration("max-replica-lag", mg.maxReplicaLag.Default(), "replication lag beyond which a replica gets no reads, which go to another replica or the primary instead; requires replica-health-interval (0 = no limit)")
+	fs.String("ddl-failure-policy", mg.ddlFailurePolicy.Default(), "what a schema change of sharded tables, run on one shard after the other, does when a shard fails it: stop (leave the next shards unchanged) or continue (change them); running it again resumes it on the shards left")
+	fs.Duration("online-ddl-check-interval", mg.onlineDDLCheckInterval.Default(), "interval between checks of the queue of online schema changes submitted with 'multigres migration submit' (0 = do not run them on this gateway)")
+	fs.Int("online-ddl-batch-size", mg.onlineDDLBatchSize.Default(), "number of rows an online schema change copies to the new table at a time")
+	fs.Duration("online-ddl-cutover-lock-timeout", mg.onlineDDLCutoverLockTimeout.Default(), "longest wait for the lock swapping the tables of an online schema change; the swap is retried after catching up again")
+	fs.Int("result-cache-size", mg.resultCacheSize.Default(), "number of results of reads cached by the gateway, for the reads with the hint /*+ multigres_cache */ or of the tables of result-cache-tables (0 = no caching)")
+	fs.Int("result-cache-max-rows", mg.resultCacheMaxRows.Default(), "number of rows of the largest result cached; larger results are not cached")
+	fs.Duration("result-cache-ttl", mg.resultCacheTTL.Default(), "time results are cached for when neither the hint nor result-cache-tables gives one; writes through the gateway invalidate them earlier")
+	fs.StringSlice("result-cache-tables", mg.resultCacheTables.Default(), "tables whose reads are cached without a hint, as table or table=ttl, where table is schema.table for the reads qualifying it; reads of several tables are cached only if all are listed, and reads calling functions whose results may change, such as now() or random(), are not")
+	fs.Int("max-connections-per-user", mg.maxConnectionsPerUser.Default(), "number of client connections the gateway admits per role, counting idle ones and those waiting for a backend connection; connections beyond it fail with too_many_connections (0 = no limit)")
+	fs.Int("max-connections-per-database", mg.maxConnectionsPerDatabase.Default(), "number of client connections the gateway admits per database, counted as for max-connections-per-user (0 = no limit)")
+	fs.StringSlice("user-connection-limits", mg.userConnectionLimits.Default(), "limits of specific roles overriding max-connections-per-user, as role=limit (0 = no limit)")
+	fs.StringSlice("database-connection-limits", mg.databaseConnectionLimits.Default(), "limits of specific databases overriding max-connections-per-database, as database=limit (0 = no limit)")
+	fs.Float64("max-qps-per-user", mg.maxQPSPerUser.Default(), "queries per second the gateway runs per role, in bursts of up to a second of queries; queries beyond it fail with configuration_limit_exceeded, except those of transaction blocks (0 = no limit)")
+	fs.Float64("max-qps-per-database", mg.maxQPSPerDatabase.Default(), "queries per second the gateway runs per database, limited as for max-qps-per-user (0 = no limit)")
+	fs.Int("max-concurrent-queries-per-user", mg.maxConcurrentQueriesPerUser.Default(), "number of queries the gateway runs at the same time per role; queries beyond it fail with configuration_limit_exceeded, except those of transaction blocks (0 = no limit)")
+	fs.Int("max-concurrent-queries-per-database", mg.maxConcurrentQueriesPerDatabase.Default(), "number of queries the gateway runs at the same time per database, limited as for max-concurrent-queries-per-user (0 = no limit)")
+	fs.StringSlice("user-query-limits", mg.userQueryLimits.Default(), "query limits of specific roles overriding max-qps-per-user and max-concurrent-queries-per-user, as role=qps:concurrent (0 = no limit)")
+	fs.StringSlice("database-query-limits", mg.databaseQueryLimits.Default(), "query limits of specific databases overriding max-qps-per-database and max-concurrent-queries-per-database, as database=qps:concurrent (0 = no limit)")
+	fs.Duration("throttle-max-replication-lag", mg.throttleMaxReplicationLag.Default(), "replication lag of the replicas, as reported to the health check, beyond which the gateway sheds a growing share of the writes outside transaction blocks, all of them at twice the lag, with query_canceled (0 = no throttling)")
+	fs.Duration("throttle-max-queue-wait", mg.throttleMaxQueueWait.Default(), "average time the shard queries wait for their turn within scatter-max-shards beyond which the gateway sheds a growing share of the reads and writes outside transaction blocks, all of them at twice the wait, with query_canceled (0 = no throttling)")
+	fs.Bool("query-passthrough", mg.queryPassthrough.Default(), "relay the rows of simple queries routed to a single shard to the client as the pooler packs them, without decoding them in the gateway")
+	fs.Duration("statement-timeout", mg.statementTimeout.Default(), "time a statement may run before the gateway cancels it on the backend, whatever the backend's statement_timeout; sessions setting statement_timeout use their own value (0 = no limit)")
+	fs.Duration("idle-in-transaction-session-timeout", mg.idleInTransactionSessionTimeout.Default(), "time a session may stay idle in a transaction block before the gateway rolls the transaction back and closes the session; sessions setting idle_in_transaction_session_timeout use their own value (0 = no limit)")
+	fs.StringSlice("user-statement-timeouts", mg.userStatementTimeouts.Default(), "statement timeouts of specific roles overriding statement-timeout, as role=duration (0 = no limit)")
+	fs.StringSlice("user-idle-in-transaction-session-timeouts", mg.userIdleInTransactionSessionTimeouts.Default(), "idle-in-transaction timeouts of specific roles overriding idle-in-transaction-session-timeout, as role=duration (0 = no limit)")
+	fs.Duration("slow-query-threshold", mg.slowQueryThreshold.Default(), "duration beyond which a query is logged and kept in the slow query log of the admin API, with the time it spent planning, waiting to run and on each shard (0 = slow queries not logged)")
+	fs.Int("slow-query-log-size", mg.slowQueryLogSize.Default(), "number of the most recent slow queries kept for the admin API")
+	fs.Int("query-stats-size", mg.queryStatsSize.Default(), "number of normalized queries, per role and database, whose statistics the gateway keeps, as pg_stat_statements does, served by the admin API and the virtual table multigres.stat_statements; the least called are evicted beyond it (0 = no statistics)")
+	fs.String("admin-api-token-file", mg.adminAPITokenFile.Default(), "path to a file holding the bearer token of the admin HTTP API, served under "+adminAPIPrefix+" on the HTTP port to list the sessions, pools, cached plans, slow queries, query statistics and poolers and to cancel or terminate sessions (empty = API not served)")
+	fs.StringSlice("admin-console-users", mg.adminConsoleUsers.Default(), "roles allowed to connect to the virtual "+handler.AdminConsoleDatabase+" database, an admin console taking PgBouncer's SHOW POOLS, SHOW STATS, SHOW CLIENTS, SHOW SERVERS, PAUSE, RESUME, RELOAD and KILL commands (empty = console disabled)")
 	viperutil.BindFlags(fs,
 		mg.cell,
 		mg.serviceID,
 		mg.pgPort,
 		mg.pgBindAddress,
+		mg.maxResultSize,
+		mg.planCacheSize,
+		mg.spillThreshold,
+		mg.shardingSchemaFile,
+		mg.shardingSchemaDatabase,
+		mg.sequenceBlockSize,
+		mg.pgTraceMessages,
+		mg.authCredentialsFile,
+		mg.pgTLSCertFile,
+		mg.pgTLSKeyFile,
+		mg.pgTLSClientCAFile,
+		mg.pgTLSCertIdentMapFile,
+		mg.labelBackendSessions,
+		mg.transactionMode,
+		mg.twoPCAbandonAge,
+		mg.deadlockDetectionInterval,
+		mg.scatterMaxShardsPerQuery,
+		mg.scatterMaxShards,
+		mg.scatterShardTimeout,
+		mg.scatterPartialResults,
+		mg.retryMaxAttempts,
+		mg.retryInitialBackoff,
+		mg.retryMaxBackoff,
+		mg.retryBudget,
+		mg.retryBudgetRatio,
+		mg.failoverBufferWindow,
+		mg.failoverBufferSize,
+		mg.poolerGRPCMaxMessageSize,
+		mg.poolerGRPCCompression,
+		mg.poolerGRPCKeepaliveTime,
+		mg.poolerGRPCKeepaliveTimeout,
+		mg.poolerGRPCConnections,
+		mg.replicaHealthInterval,
+		mg.schemaTrackingInterval,
+		mg.cellFallback,
+		mg.maxReplicaLag,
+		mg.ddlFailurePolicy,
+		mg.onlineDDLCheckInterval,
+		mg.onlineDDLBatchSize,
+		mg.onlineDDLCutoverLockTimeout,
+		mg.resultCacheSize,
+		mg.resultCacheMaxRows,
+		mg.resultCacheTTL,
+		mg.resultCacheTables,
+		mg.maxConnectionsPerUser,
+		mg.maxConnectionsPerDatabase,
+		mg.userConnectionLimits,
+		mg.databaseConnectionLimits,
+		mg.maxQPSPerUser,
+		mg.maxQPSPerDatabase,
+		mg.maxConcurrentQueriesPerUser,
+		mg.maxConcurrentQueriesPerDatabase,
+		mg.userQueryLimits,
+		mg.databaseQueryLimits,
+		mg.throttleMaxReplicationLag,
+		mg.throttleMaxQueueWait,
+		mg.queryPassthrough,
+		mg.statementTimeout,
+		mg.idleInTransactionSessionTimeout,
+		mg.userStatementTimeouts,
+		mg.userIdleInTransactionSessionTimeouts,
+		mg.slowQueryThreshold,
+		mg.slowQueryLogSize,
+		mg.queryStatsSize,
+		mg.adminAPITokenFile,
+		mg.adminConsoleUsers,
 	)
 	mg.senv.RegisterFlags(fs)
 	mg.grpcServer.RegisterFlags(fs)
 	mg.topoConfig.RegisterFlags(fs)
+	mg.connConfig.RegisterFlags(fs)
 }
 
 // Init initializes the multigateway. If any services fail to start,
@@ -171,32 +910,270 @@ func (mg *MultiGateway) Init() error {
 	mg.serverStatus.ServiceID = mg.serviceID.Get()
 
 	// Start pooler discovery (watches all cells)
+	cellFallback, err := ParseCellFallback(mg.cellFallback.Get())
+	if err != nil {
+		return err
+	}
 	mg.poolerDiscovery = NewGlobalPoolerDiscovery(context.TODO(), mg.ts, mg.cell.Get(), logger)
+	mg.poolerDiscovery.SetCellFallback(cellFallback)
 	mg.poolerDiscovery.Start()
 	logger.Info("Global pooler discovery started", "local_cell", mg.cell.Get())
+	discoveryMetrics, err := NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize pooler discovery metrics", "error", err)
+	}
+	if err := discoveryMetrics.RegisterStalenessCallback(mg.poolerDiscovery); err != nil {
+		logger.Error("failed to monitor pooler discovery", "error", err)
+	}
 
 	// Initialize PoolerGateway for managing pooler connections
 	mg.poolerGateway = poolergateway.NewPoolerGateway(mg.poolerDiscovery, logger)
+	poolerCreds, err := mg.connConfig.TransportCredentials()
+	if err != nil {
+		return err
+	}
+	if err := mg.poolerGateway.SetClientOptions(poolergateway.ClientOptions{
+		MaxMessageSize:       mg.poolerGRPCMaxMessageSize.Get(),
+		Compression:          mg.poolerGRPCCompression.Get(),
+		KeepaliveTime:        mg.poolerGRPCKeepaliveTime.Get(),
+		KeepaliveTimeout:     mg.poolerGRPCKeepaliveTimeout.Get(),
+		ConnectionsPerPooler: mg.poolerGRPCConnections.Get(),
+		TransportCredentials: poolerCreds,
+	}); err != nil {
+		return err
+	}
+	gatewayMetrics, err := poolergateway.NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize pooler gateway metrics", "error", err)
+	}
+	mg.poolerGateway.SetMetrics(gatewayMetrics)
+	if err := gatewayMetrics.RegisterConnectionCallback(mg.poolerGateway); err != nil {
+		logger.Error("failed to monitor pooler connections", "error", err)
+	}
+	if interval := mg.replicaHealthInterval.Get(); interval > 0 {
+		mg.poolerGateway.StartHealthCheck(poolergateway.HealthCheckOptions{
+			Interval:          interval,
+			MaxReplicationLag: mg.maxReplicaLag.Get(),
+		})
+		if err := gatewayMetrics.RegisterReplicaHealthCallback(mg.poolerGateway); err != nil {
+			logger.Error("failed to monitor replica health", "error", err)
+		}
+	}
 
 	// Initialize ScatterConn for query coordination
 	mg.scatterConn = scatterconn.NewScatterConn(mg.poolerGateway, logger)
+	txMode, err := scatterconn.ParseTransactionMode(mg.transactionMode.Get())
+	if err != nil {
+		return err
+	}
+	mg.scatterConn.SetTransactionMode(txMode)
+	mg.scatterConn.SetScatterOptions(scatterconn.ScatterOptions{
+		MaxShardsPerQuery: mg.scatterMaxShardsPerQuery.Get(),
+		MaxShards:         mg.scatterMaxShards.Get(),
+		ShardTimeout:      mg.scatterShardTimeout.Get(),
+		PartialResults:    mg.scatterPartialResults.Get(),
+	})
+	mg.scatterConn.SetRetryOptions(scatterconn.RetryOptions{
+		RetryPolicy: mterrors.RetryPolicy{
+			MaxAttempts:    mg.retryMaxAttempts.Get(),
+			InitialBackoff: mg.retryInitialBackoff.Get(),
+			MaxBackoff:     mg.retryMaxBackoff.Get(),
+		},
+		Budget:      mg.retryBudget.Get(),
+		BudgetRatio: mg.retryBudgetRatio.Get(),
+	})
+	mg.scatterConn.SetBufferOptions(scatterconn.BufferOptions{
+		Window: mg.failoverBufferWindow.Get(),
+		Size:   mg.failoverBufferSize.Get(),
+	})
+	mg.poolerDiscovery.SetPrimaryListener(mg.scatterConn.PrimaryChanged)
+	scatterMetrics, err := scatterconn.NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize retry metrics", "error", err)
+	}
+	mg.scatterConn.SetMetrics(scatterMetrics)
+	if err := scatterMetrics.RegisterRetryBudgetCallback(mg.scatterConn); err != nil {
+		logger.Error("failed to monitor retry budget", "error", err)
+	}
 
 	// Initialize the executor for query routing
 	// Pass ScatterConn as the IExecute implementation
-	mg.executor = executor.NewExecutor(mg.scatterConn, logger)
+	if err := mg.initExecutor(mg.scatterConn, logger); err != nil {
+		return err
+	}
+	if txMode == scatterconn.TransactionModeTwoPC {
+		// Every commit across shards would fail to prepare on a shard that
+		// cannot prepare transactions.
+		checkCtx, cancel := context.WithTimeout(context.TODO(), twoPCCheckTimeout)
+		err := mg.scatterConn.CheckTwoPC(checkCtx, executor.DefaultTableGroup, mg.executor.Shards())
+		cancel()
+		if err != nil {
+			return err
+		}
+		// Two-phase commits are logged on the default shard of the tablegroup.
+		var recoveryCtx context.Context
+		recoveryCtx, mg.stopRecovery = context.WithCancel(context.Background())
+		abandonAge := mg.twoPCAbandonAge.Get()
+		go mg.scatterConn.RunTransactionRecovery(recoveryCtx, executor.DefaultTableGroup, abandonAge/2, abandonAge)
+		logger.Info("Two-phase commit enabled", "abandon_age", abandonAge)
+	}
+	maxLag, maxWait := mg.throttleMaxReplicationLag.Get(), mg.throttleMaxQueueWait.Get()
+	if maxLag > 0 || maxWait > 0 {
+		mg.executor.SetThrottler(executor.NewThrottler(executor.ThrottleOptions{
+			MaxReplicationLag: maxLag,
+			MaxQueueWait:      maxWait,
+			ReplicationLag:    mg.poolerGateway.MaxReplicationLag,
+			QueueWait:         mg.scatterConn.FanoutWait,
+		}))
+		logger.Info("Throttling statements", "max_replication_lag", maxLag, "max_queue_wait", maxWait)
+	}
+	mg.executor.SetPassthrough(mg.queryPassthrough.Get())
+	mg.routingRules = NewRoutingRulesWatcher(context.TODO(), mg.ts, mg.executor.SetRoutingRules, logger)
+	mg.routingRules.Start()
+	if interval := mg.schemaTrackingInterval.Get(); interval > 0 {
+		mg.poolerGateway.StartSchemaTracking(executor.DefaultTableGroup, interval, mg.updateTables)
+	}
+
+	// Create hash provider for SCRAM authentication: a credentials file if
+	// configured, otherwise the backend's roles via the pooler gateway.
+	var hashProvider scram.PasswordHashProvider
+	var credentials *auth.FileHashProvider
+	if path := mg.authCredentialsFile.Get(); path != "" {
+		credentials, err = auth.NewFileHashProvider(path)
+		if err != nil {
+			return fmt.Errorf("failed to load auth credentials: %w", err)
+		}
+		hashProvider = credentials
+		logger.Info("Authenticating clients from credentials file", "path", path)
+	} else {
+		hashProvider = auth.NewPoolerHashProvider(&poolerSystemDiscovererAdapter{pg: mg.poolerGateway})
+	}
 
-	// Create hash provider for SCRAM authentication using the pooler gateway
-	hashProvider := auth.NewPoolerHashProvider(&poolerSystemDiscovererAdapter{pg: mg.poolerGateway})
+	tlsConfig, err := newServerTLSConfig(mg.pgTLSCertFile.Get(), mg.pgTLSKeyFile.Get(), mg.pgTLSClientCAFile.Get())
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+	var certAuthProvider server.CertAuthProvider
+	if path := mg.pgTLSCertIdentMapFile.Get(); path != "" {
+		if tlsConfig == nil || tlsConfig.ClientCAs == nil {
+			return errors.New("pg-tls-cert-ident-map-file requires pg-tls-client-ca-file")
+		}
+		certAuthProvider, err = auth.NewCertIdentMap(path)
+		if err != nil {
+			return fmt.Errorf("failed to load certificate ident map: %w", err)
+		}
+		logger.Info("Authenticating clients by TLS certificate", "ident_map", path)
+	}
+	if mg.deadlockDetectionInterval.Get() > 0 && !mg.labelBackendSessions.Get() {
+		return errors.New("deadlock-detection-interval requires label-backend-sessions")
+	}
+
+	userConnectionLimits, err := handler.ParseConnectionLimits(mg.userConnectionLimits.Get())
+	if err != nil {
+		return fmt.Errorf("invalid user-connection-limits: %w", err)
+	}
+	databaseConnectionLimits, err := handler.ParseConnectionLimits(mg.databaseConnectionLimits.Get())
+	if err != nil {
+		return fmt.Errorf("invalid database-connection-limits: %w", err)
+	}
+	userQueryLimits, err := handler.ParseQueryLimits(mg.userQueryLimits.Get())
+	if err != nil {
+		return fmt.Errorf("invalid user-query-limits: %w", err)
+	}
+	databaseQueryLimits, err := handler.ParseQueryLimits(mg.databaseQueryLimits.Get())
+	if err != nil {
+		return fmt.Errorf("invalid database-query-limits: %w", err)
+	}
+	userStatementTimeouts, err := handler.ParseSessionTimeouts(mg.userStatementTimeouts.Get())
+	if err != nil {
+		return fmt.Errorf("invalid user-statement-timeouts: %w", err)
+	}
+	userIdleInTransactionSessionTimeouts, err := handler.ParseSessionTimeouts(mg.userIdleInTransactionSessionTimeouts.Get())
+	if err != nil {
+		return fmt.Errorf("invalid user-idle-in-transaction-session-timeouts: %w", err)
+	}
 
 	// Create and start PostgreSQL protocol listener
 	mg.pgHandler = handler.NewMultiGatewayHandler(mg.executor, logger)
-	pgAddr := fmt.Sprintf("%s:%d", mg.pgBindAddress.Get(), mg.pgPort.Get())
-	mg.pgListener, err = server.NewListener(server.ListenerConfig{
-		Address:      pgAddr,
-		Handler:      mg.pgHandler,
-		HashProvider: hashProvider,
-		Logger:       logger,
+	mg.pgHandler.SetConnectionLimits(handler.ConnectionLimits{
+		MaxPerUser:     mg.maxConnectionsPerUser.Get(),
+		MaxPerDatabase: mg.maxConnectionsPerDatabase.Get(),
+		Users:          userConnectionLimits,
+		Databases:      databaseConnectionLimits,
+	})
+	mg.pgHandler.SetQueryLimits(handler.QueryLimits{
+		MaxQPSPerUser:            mg.maxQPSPerUser.Get(),
+		MaxQPSPerDatabase:        mg.maxQPSPerDatabase.Get(),
+		MaxConcurrentPerUser:     mg.maxConcurrentQueriesPerUser.Get(),
+		MaxConcurrentPerDatabase: mg.maxConcurrentQueriesPerDatabase.Get(),
+		Users:                    userQueryLimits,
+		Databases:                databaseQueryLimits,
 	})
+	mg.pgHandler.SetSessionTimeouts(handler.SessionTimeouts{
+		StatementTimeout:                     mg.statementTimeout.Get(),
+		IdleInTransactionSessionTimeout:      mg.idleInTransactionSessionTimeout.Get(),
+		UserStatementTimeouts:                userStatementTimeouts,
+		UserIdleInTransactionSessionTimeouts: userIdleInTransactionSessionTimeouts,
+	})
+	if users := mg.adminConsoleUsers.Get(); len(users) > 0 {
+		mg.pgHandler.SetAdminConsole(handler.AdminConsole{
+			Users: users,
+			// RELOAD reads the credentials file and the poolers of the
+			// topology again.
+			Reload: func() error {
+				if credentials != nil {
+					if err := credentials.Reload(); err != nil {
+						return fmt.Errorf("failed to reload auth credentials: %w", err)
+					}
+				}
+				mg.poolerDiscovery.Refresh()
+				logger.Info("Configuration reloaded from the admin console")
+				return nil
+			},
+		})
+		logger.Info("Admin console enabled", "database", handler.AdminConsoleDatabase, "users", users)
+	}
+	if mg.labelBackendSessions.Get() {
+		mg.pgHandler.SetSessionLabelPrefix("multigres:" + serviceID + ":")
+	}
+	if interval := mg.deadlockDetectionInterval.Get(); interval > 0 {
+		var detectionCtx context.Context
+		detectionCtx, mg.stopDeadlockDetection = context.WithCancel(context.Background())
+		go scatterconn.NewDeadlockDetector(mg.scatterConn, mg.pgHandler.Transactions).Run(detectionCtx, interval)
+	}
+	if interval := mg.onlineDDLCheckInterval.Get(); interval > 0 {
+		// The queue of online schema changes is kept on the shard of the
+		// unsharded tables.
+		opts := onlineddl.DefaultOptions
+		opts.BatchSize = mg.onlineDDLBatchSize.Get()
+		opts.CutoverLockTimeout = mg.onlineDDLCutoverLockTimeout.Get()
+		migrator := onlineddl.NewMigrator(mg.scatterConn, executor.DefaultTableGroup, "", mg.executor.TableShards, serviceID, opts, logger)
+		var migrationCtx context.Context
+		migrationCtx, mg.stopOnlineDDL = context.WithCancel(context.Background())
+		go migrator.Run(migrationCtx, interval)
+	}
+	handlerMetrics, err := handler.NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize session metrics", "error", err)
+	}
+	if err := handlerMetrics.RegisterSessionsCallback(mg.pgHandler.ApplicationNameCounts); err != nil {
+		logger.Error("failed to monitor sessions", "error", err)
+	}
+	pgAddr := fmt.Sprintf("%s:%d", mg.pgBindAddress.Get(), mg.pgPort.Get())
+	listenerConfig := server.ListenerConfig{
+		Address:          pgAddr,
+		Handler:          mg.pgHandler,
+		HashProvider:     hashProvider,
+		TLSConfig:        tlsConfig,
+		CertAuthProvider: certAuthProvider,
+		Logger:           logger,
+	}
+	if mg.pgTraceMessages.Get() {
+		listenerConfig.NewMessageTracer = func(connectionID uint32) protocol.MessageTracer {
+			return protocol.NewLogTracer(logger.With("connection_id", connectionID), protocol.Backend)
+		}
+	}
+	mg.pgListener, err = server.NewListener(listenerConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create PostgreSQL listener on port %d: %w", mg.pgPort.Get(), err)
 	}
@@ -236,6 +1213,16 @@ func (mg *MultiGateway) Init() error {
 	mg.senv.HTTPHandleFunc("/", mg.handleIndex)
 	mg.senv.HTTPHandleFunc("/ready", mg.handleReady)
 	mg.senv.HTTPHandleFunc("/debug/consolidator", mg.handleConsolidatorDebug)
+	mg.senv.HTTPHandleFunc("/debug/sessions", mg.handleSessionsDebug)
+	mg.senv.HTTPHandleFunc("/debug/topo/refresh", mg.handleTopoRefresh)
+	if path := mg.adminAPITokenFile.Get(); path != "" {
+		token, err := loadAdminAPIToken(path)
+		if err != nil {
+			return err
+		}
+		mg.senv.HTTPHandle(adminAPIPrefix, newAdminAPI(token, mg.pgHandler, mg.planCache, mg.slowQueries, mg.queryStats, mg.poolStats, mg.cellStatuses))
+		logger.Info("Serving the admin API", "path", adminAPIPrefix)
+	}
 
 	mg.senv.OnClose(func() {
 		mg.Shutdown()
@@ -243,6 +1230,129 @@ func (mg *MultiGateway) Init() error {
 	return nil
 }
 
+// executorBackend is what the executor runs the queries through, and keeps
+// the global sequences and the DDL journal in: the ScatterConn.
+type executorBackend interface {
+	engine.IExecute
+	engine.SequenceAllocator
+	engine.DDLJournalStore
+}
+
+// initExecutor creates the executor running the queries through backend,
+// with the sharding schema, caches and limits of the flags.
+func (mg *MultiGateway) initExecutor(backend executorBackend, logger *slog.Logger) error {
+	mg.executor = executor.NewExecutor(backend, logger, mg.maxResultSize.Get())
+	mg.executor.SetSpillThreshold(mg.spillThreshold.Get())
+	executorMetrics, err := executor.NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize query metrics", "error", err)
+	}
+	mg.executor.SetMetrics(executorMetrics)
+	if threshold := mg.slowQueryThreshold.Get(); threshold > 0 {
+		mg.slowQueries = executor.NewSlowQueryLog(threshold, mg.slowQueryLogSize.Get())
+		mg.executor.SetSlowQueryLog(mg.slowQueries)
+	}
+	if size := mg.queryStatsSize.Get(); size > 0 {
+		mg.queryStats = engine.NewQueryStats(size)
+		mg.executor.SetQueryStats(mg.queryStats)
+	}
+	if path := mg.shardingSchemaFile.Get(); path != "" {
+		schema, err := planner.LoadShardingSchema(path)
+		if err != nil {
+			return err
+		}
+		mg.executor.SetShardingSchema(schema)
+		logger.Info("Sharding the default tablegroup", "path", path, "shards", schema.Shards, "tables", len(schema.Tables))
+	} else if database := mg.shardingSchemaDatabase.Get(); database != "" {
+		schema, err := mg.loadTopoShardingSchema(context.TODO(), database)
+		if err != nil {
+			return err
+		}
+		if schema == nil {
+			logger.Warn("Database has no sharding schema, the default tablegroup is not sharded", "database", database)
+		} else {
+			mg.executor.SetShardingSchema(schema)
+			logger.Info("Sharding the default tablegroup", "database", database, "shards", schema.Shards, "tables", len(schema.Tables))
+		}
+	}
+	// The global sequences are kept on the shard of the unsharded tables.
+	mg.executor.SetGlobalSequences(engine.NewGlobalSequences(backend, executor.DefaultTableGroup, "", mg.sequenceBlockSize.Get()))
+	// The DDL journal is kept on the shard of the unsharded tables.
+	ddlPolicy, err := engine.ParseDDLFailurePolicy(mg.ddlFailurePolicy.Get())
+	if err != nil {
+		return err
+	}
+	mg.executor.SetDDLJournal(engine.NewDDLJournal(backend, executor.DefaultTableGroup, ""), ddlPolicy)
+	// The plan and result caches share the planner metrics.
+	plannerMetrics, err := planner.NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize planner metrics", "error", err)
+	}
+	if size := mg.planCacheSize.Get(); size > 0 {
+		mg.planCache = planner.NewPlanCache(size)
+		mg.executor.SetPlanCache(mg.planCache)
+		if err := plannerMetrics.RegisterPlanCacheCallback(mg.planCache); err != nil {
+			logger.Error("failed to monitor plan cache", "error", err)
+		}
+	}
+	if size := mg.resultCacheSize.Get(); size > 0 {
+		ttl := mg.resultCacheTTL.Get()
+		tables, err := planner.ParseResultCacheTables(mg.resultCacheTables.Get(), ttl)
+		if err != nil {
+			return err
+		}
+		resultCache := engine.NewResultCache(size, mg.resultCacheMaxRows.Get())
+		mg.executor.SetResultCache(resultCache, ttl, tables)
+		if err := plannerMetrics.RegisterResultCacheCallback(resultCache); err != nil {
+			logger.Error("failed to monitor result cache", "error", err)
+		}
+	}
+	return nil
+}
+
+// updateTables hands a change of the schema of the tables of database,
+// streamed by its primary pooler, to the planner.
+func (mg *MultiGateway) updateTables(database string, change *multipoolerpb.StreamSchemaResponse) {
+	tables := make(planner.TableDefinitions, len(change.Tables))
+	for _, table := range change.Tables {
+		columns := make([]string, len(table.Columns))
+		for i, column := range table.Columns {
+			columns[i] = column.Name
+		}
+		tables[table.Schema+"."+table.Name] = columns
+	}
+	if change.Full {
+		mg.executor.SetTables(database, tables)
+		return
+	}
+	dropped := make([]string, len(change.DroppedTables))
+	for i, table := range change.DroppedTables {
+		dropped[i] = table.Schema + "." + table.Name
+	}
+	mg.executor.UpdateTables(database, tables, dropped)
+}
+
+// loadTopoShardingSchema reads the sharding schema of database from the
+// topology, or returns nil if it has none.
+func (mg *MultiGateway) loadTopoShardingSchema(ctx context.Context, database string) (*planner.ShardingSchema, error) {
+	stored, err := mg.ts.GetShardingSchema(ctx, database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the sharding schema of database '%s': %w", database, err)
+	}
+	if stored.Version == 0 {
+		return nil, nil
+	}
+	config, err := planner.ParseShardingSchemaConfig([]byte(stored.Document))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the sharding schema of database '%s': %w", database, err)
+	}
+	schema, err := config.Build()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sharding schema of database '%s': %w", database, err)
+	}
+	return schema, nil
+}
+
 func (mg *MultiGateway) RunDefault() error {
 	return mg.senv.RunDefault(mg.grpcServer)
 }
@@ -263,6 +1373,16 @@ func (mg *MultiGateway) Shutdown() {
 		}
 	}
 
+	if mg.stopRecovery != nil {
+		mg.stopRecovery()
+	}
+	if mg.stopDeadlockDetection != nil {
+		mg.stopDeadlockDetection()
+	}
+	if mg.stopOnlineDDL != nil {
+		mg.stopOnlineDDL()
+	}
+
 	// Close pooler gateway connections
 	if mg.poolerGateway != nil {
 		if err := mg.poolerGateway.Close(context.TODO()); err != nil {
@@ -272,6 +1392,10 @@ func (mg *MultiGateway) Shutdown() {
 		}
 	}
 
+	if mg.routingRules != nil {
+		mg.routingRules.Stop()
+	}
+
 	// Stop pooler discovery
 	if mg.poolerDiscovery != nil {
 		mg.poolerDiscovery.Stop()
@@ -0,0 +1,167 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/sharding"
+)
+
+// recordingBackend records the shards the queries run on.
+type recordingBackend struct {
+	executorBackend
+	shards []string
+}
+
+func (b *recordingBackend) StreamExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	b.shards = append(b.shards, shard)
+	return callback(ctx, &sqltypes.Result{})
+}
+
+// TestInitExecutor_ShardingSchemaFile checks that the sharding schema of
+// the sharding-schema-file flag routes the queries of the gateway by shard
+// key.
+func TestInitExecutor_ShardingSchemaFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+shards: ["-80", "80-"]
+tables:
+  users: {column: id}
+`), 0o600))
+
+	mg := NewMultiGateway()
+	fs := pflag.NewFlagSet("multigateway", pflag.ContinueOnError)
+	mg.RegisterFlags(fs)
+	require.NoError(t, fs.Parse([]string{"--sharding-schema-file", path}))
+
+	backend := &recordingBackend{}
+	require.NoError(t, mg.initExecutor(backend, slog.Default()))
+	assert.Equal(t, []string{"-80", "80-"}, mg.Executor().TableShards("users"))
+
+	hash, err := sharding.New("hash", []string{"-80", "80-"}, nil)
+	require.NoError(t, err)
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := handler.NewMultiGatewayConnectionState()
+	for _, key := range []string{"1", "2", "3", "4"} {
+		want, err := hash.ShardForKey([]byte(key), false)
+		require.NoError(t, err)
+
+		sql := "SELECT * FROM users WHERE id = " + key
+		stmts, err := parser.ParseSQL(sql)
+		require.NoError(t, err)
+		backend.shards = nil
+		err = mg.Executor().StreamExecute(context.Background(), conn, state, sql, stmts[0],
+			func(context.Context, *sqltypes.Result) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, []string{want}, backend.shards, sql)
+	}
+}
+
+func TestInitExecutor_InvalidShardingSchemaFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("shards: []\n"), 0o600))
+
+	mg := NewMultiGateway()
+	fs := pflag.NewFlagSet("multigateway", pflag.ContinueOnError)
+	mg.RegisterFlags(fs)
+	require.NoError(t, fs.Parse([]string{"--sharding-schema-file", path}))
+
+	err := mg.initExecutor(&recordingBackend{}, slog.Default())
+	assert.ErrorContains(t, err, "invalid sharding schema")
+}
+
+// TestInitExecutor_ShardingSchemaDatabase checks that the sharding schema
+// of a database in the topology shards the default tablegroup.
+func TestInitExecutor_ShardingSchemaDatabase(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	mg := NewMultiGateway()
+	mg.ts = ts
+	fs := pflag.NewFlagSet("multigateway", pflag.ContinueOnError)
+	mg.RegisterFlags(fs)
+	require.NoError(t, fs.Parse([]string{"--sharding-schema-database", "db1"}))
+
+	// A database without sharding schema is not sharded.
+	require.NoError(t, mg.initExecutor(&recordingBackend{}, slog.Default()))
+	assert.Equal(t, []string{""}, mg.Executor().TableShards("users"))
+
+	_, err := ts.SaveShardingSchema(ctx, "db1", &clustermetadatapb.ShardingSchema{Document: `
+shards: ["-80", "80-"]
+tables:
+  users: {column: id}
+`})
+	require.NoError(t, err)
+	require.NoError(t, mg.initExecutor(&recordingBackend{}, slog.Default()))
+	assert.Equal(t, []string{"-80", "80-"}, mg.Executor().TableShards("users"))
+
+	_, err = ts.SaveShardingSchema(ctx, "db1", &clustermetadatapb.ShardingSchema{Document: "shards: []\n", Version: 1})
+	require.NoError(t, err)
+	err = mg.initExecutor(&recordingBackend{}, slog.Default())
+	assert.ErrorContains(t, err, "invalid sharding schema of database 'db1'")
+}
+
+// TestUpdateTables checks that the schema streamed by the primary pooler
+// reaches the planner.
+func TestUpdateTables(t *testing.T) {
+	mg := NewMultiGateway()
+	fs := pflag.NewFlagSet("multigateway", pflag.ContinueOnError)
+	mg.RegisterFlags(fs)
+	require.NoError(t, mg.initExecutor(&recordingBackend{}, slog.Default()))
+
+	users := &multipoolerpb.TableSchema{Schema: "public", Name: "users", Columns: []*multipoolerpb.ColumnSchema{
+		{Name: "id", Type: "bigint"},
+		{Name: "name", Type: "text"},
+	}}
+	orders := &multipoolerpb.TableSchema{Schema: "sales", Name: "orders", Columns: []*multipoolerpb.ColumnSchema{
+		{Name: "id", Type: "bigint"},
+	}}
+	mg.updateTables("db1", &multipoolerpb.StreamSchemaResponse{Full: true, Tables: []*multipoolerpb.TableSchema{users, orders}})
+	columns, ok := mg.Executor().TableColumns("db1", "public", "users")
+	require.True(t, ok)
+	assert.Equal(t, []string{"id", "name"}, columns)
+
+	mg.updateTables("db1", &multipoolerpb.StreamSchemaResponse{DroppedTables: []*multipoolerpb.TableSchema{{Schema: "sales", Name: "orders"}}})
+	_, ok = mg.Executor().TableColumns("db1", "sales", "orders")
+	assert.False(t, ok)
+	_, ok = mg.Executor().TableColumns("db1", "public", "users")
+	assert.True(t, ok)
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Metrics holds the OpenTelemetry metrics of the discovery of the poolers.
+type Metrics struct {
+	meter     metric.Meter
+	staleness metric.Float64ObservableGauge
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the discovery of the
+// poolers. A metric that fails to initialize uses a noop implementation,
+// and the error is returned along with the usable Metrics instance. Use
+// RegisterStalenessCallback() to report the staleness of the poolers.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multigateway"),
+	}
+
+	var err error
+	m.staleness, err = m.meter.Float64ObservableGauge(
+		"multigateway.topo.staleness",
+		metric.WithDescription("Time the poolers of each cell have been routed to without updates from the topology, or 0 while its watch is up to date"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		m.staleness = noop.Float64ObservableGauge{}
+		return m, fmt.Errorf("multigateway.topo.staleness gauge: %w", err)
+	}
+	return m, nil
+}
+
+// RegisterStalenessCallback registers a callback observing the staleness
+// of the poolers of each cell discovered by gd. Returns an error if
+// registration fails.
+func (m *Metrics) RegisterStalenessCallback(gd *GlobalPoolerDiscovery) error {
+	if gd == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			for cell, staleness := range gd.CellStaleness() {
+				observer.ObserveFloat64(m.staleness, staleness.Seconds(),
+					metric.WithAttributes(attribute.String("cell", cell)))
+			}
+			return nil
+		},
+		m.staleness,
+	)
+	return err
+}
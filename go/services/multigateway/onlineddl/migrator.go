@@ -0,0 +1,341 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlineddl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// ShardExecutor runs statements on the primary of a shard, outside any
+// session.
+type ShardExecutor interface {
+	ExecuteOnPrimary(ctx context.Context, tableGroup, shard, sql string) (*sqltypes.Result, error)
+}
+
+// Options configures how a Migrator runs schema changes.
+type Options struct {
+	// BatchSize is the number of rows copied at once, and the number of
+	// logged rows left below which the tables are swapped.
+	BatchSize int
+
+	// CutoverLockTimeout bounds the wait for the lock of the table when
+	// swapping the tables, so that a busy table does not block its
+	// queries behind the swap. The swap is retried CutoverAttempts times.
+	CutoverLockTimeout time.Duration
+	CutoverAttempts    int
+
+	// StaleAfter is the time after which a running change whose progress
+	// was not reported, because its gateway stopped, is taken over.
+	StaleAfter time.Duration
+
+	// PauseCheckInterval is the interval between the checks of a paused
+	// change for its resumption.
+	PauseCheckInterval time.Duration
+}
+
+// DefaultOptions are the options of a Migrator unless configured.
+var DefaultOptions = Options{
+	BatchSize:          1000,
+	CutoverLockTimeout: 2 * time.Second,
+	CutoverAttempts:    5,
+	StaleAfter:         time.Minute,
+	PauseCheckInterval: time.Second,
+}
+
+// Migrator runs the schema changes of the queue kept on a shard, one at a
+// time. Several gateways may run a Migrator on the same queue: each change
+// is claimed by one of them.
+type Migrator struct {
+	exec       ShardExecutor
+	tableGroup string
+	queueShard string
+	// shards returns the shards holding a table, in the order the changes
+	// run on them.
+	shards func(table string) []string
+	// owner identifies the gateway in the changes it claims.
+	owner  string
+	opts   Options
+	logger *slog.Logger
+}
+
+// NewMigrator creates a Migrator for the queue kept on queueShard of
+// tableGroup, identifying itself as owner.
+func NewMigrator(exec ShardExecutor, tableGroup, queueShard string, shards func(table string) []string, owner string, opts Options, logger *slog.Logger) *Migrator {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultOptions.BatchSize
+	}
+	if opts.CutoverAttempts <= 0 {
+		opts.CutoverAttempts = DefaultOptions.CutoverAttempts
+	}
+	if opts.StaleAfter <= 0 {
+		opts.StaleAfter = DefaultOptions.StaleAfter
+	}
+	if opts.PauseCheckInterval <= 0 {
+		opts.PauseCheckInterval = DefaultOptions.PauseCheckInterval
+	}
+	return &Migrator{
+		exec:       exec,
+		tableGroup: tableGroup,
+		queueShard: queueShard,
+		shards:     shards,
+		owner:      owner,
+		opts:       opts,
+		logger:     logger,
+	}
+}
+
+// Run runs the changes of the queue, checking it for new ones every
+// interval, until ctx is done.
+func (m *Migrator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				ran, err := m.RunNext(ctx)
+				if err != nil {
+					m.logger.WarnContext(ctx, "online schema change failed", "error", err)
+				}
+				if !ran || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// claimedMigration is the progress of a change claimed by the Migrator.
+type claimedMigration struct {
+	Migration
+	shardsDone map[string]bool
+	rowsCopied int64
+	paused     bool
+}
+
+// errStopped reports that a change was cancelled or taken over by another
+// gateway while it ran.
+var errStopped = errors.New("schema change stopped")
+
+// RunNext claims the next change of the queue, if any, and runs it. It
+// returns whether a change was claimed, and the error of the change if it
+// failed.
+func (m *Migrator) RunNext(ctx context.Context) (bool, error) {
+	mig, err := m.claim(ctx)
+	if err != nil || mig == nil {
+		return false, err
+	}
+	m.logger.InfoContext(ctx, "running online schema change", "id", mig.ID, "ddl", mig.DDL)
+
+	err = m.run(ctx, mig)
+	switch {
+	case errors.Is(err, errStopped):
+		m.logger.InfoContext(ctx, "online schema change stopped", "id", mig.ID)
+		return true, nil
+	case err != nil:
+		if ctx.Err() != nil {
+			// The gateway stops: the change is taken over once stale.
+			return true, err
+		}
+		if failErr := m.finish(ctx, mig, StateFailed, err.Error()); failErr != nil {
+			return true, errors.Join(err, failErr)
+		}
+		return true, fmt.Errorf("schema change %s: %w", mig.ID, err)
+	}
+	if err := m.finish(ctx, mig, StateComplete, ""); err != nil {
+		return true, err
+	}
+	m.logger.InfoContext(ctx, "online schema change complete", "id", mig.ID, "rows_copied", mig.rowsCopied)
+	return true, nil
+}
+
+// run runs a claimed change on each shard of its table it has yet to
+// complete on.
+func (m *Migrator) run(ctx context.Context, mig *claimedMigration) error {
+	alter, err := parseAlterTable(mig.DDL)
+	if err != nil {
+		return err
+	}
+	for _, shard := range m.shards(mig.Table) {
+		if mig.shardsDone[shard] {
+			continue
+		}
+		s := &shardMigration{m: m, mig: mig, alter: alter, shard: shard}
+		if err := s.run(ctx); err != nil {
+			if errors.Is(err, errStopped) {
+				return err
+			}
+			return fmt.Errorf("shard %s: %w", shard, err)
+		}
+		if err := m.queue(ctx, fmt.Sprintf(
+			"UPDATE %s SET shards_done = array_append(shards_done, %s), updated_at = now() WHERE id = %s",
+			MigrationsTable, ast.QuoteStringLiteral(shard), ast.QuoteStringLiteral(mig.ID))); err != nil {
+			return err
+		}
+		mig.shardsDone[shard] = true
+	}
+	return nil
+}
+
+// claim claims the oldest queued change that is not paused, or a running
+// change whose gateway stopped reporting its progress, and returns it, or
+// nil if there are none.
+func (m *Migrator) claim(ctx context.Context) (*claimedMigration, error) {
+	result, err := m.exec.ExecuteOnPrimary(ctx, m.tableGroup, m.queueShard, fmt.Sprintf(
+		"UPDATE %[1]s SET state = '%[2]s', owner = %[3]s, updated_at = now() WHERE id = ("+
+			"SELECT id FROM %[1]s WHERE (state = '%[4]s' AND NOT paused) OR "+
+			"(state = '%[2]s' AND updated_at < now() - make_interval(secs => %[5]d)) "+
+			"ORDER BY submitted_at LIMIT 1 FOR UPDATE SKIP LOCKED) "+
+			"RETURNING id, ddl, table_name, array_to_string(shards_done, ','), rows_copied, paused, max_rows_per_second",
+		MigrationsTable, StateRunning, ast.QuoteStringLiteral(m.owner), StateQueued, int64(m.opts.StaleAfter.Seconds())))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim a schema change: %w", err)
+	}
+	if len(result.Rows) == 0 {
+		return nil, nil
+	}
+	row := result.Rows[0]
+	if len(row.Values) != 7 {
+		return nil, errors.New("failed to claim a schema change: unexpected result")
+	}
+	mig := &claimedMigration{
+		Migration: Migration{
+			ID:    string(row.Values[0]),
+			DDL:   string(row.Values[1]),
+			Table: string(row.Values[2]),
+		},
+		shardsDone: make(map[string]bool),
+		paused:     isTrue(row.Values[5]),
+	}
+	if done := string(row.Values[3]); done != "" {
+		for _, shard := range strings.Split(done, ",") {
+			mig.shardsDone[shard] = true
+		}
+	}
+	if mig.rowsCopied, err = strconv.ParseInt(string(row.Values[4]), 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to claim a schema change: %w", err)
+	}
+	if mig.MaxRowsPerSecond, err = strconv.ParseInt(string(row.Values[6]), 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to claim a schema change: %w", err)
+	}
+	return mig, nil
+}
+
+// report records the progress of a running change, on shard, and reads
+// back whether it is paused and its throttle. It returns errStopped if the
+// change was cancelled or taken over.
+func (m *Migrator) report(ctx context.Context, mig *claimedMigration, shard string) error {
+	result, err := m.exec.ExecuteOnPrimary(ctx, m.tableGroup, m.queueShard, fmt.Sprintf(
+		"UPDATE %s SET current_shard = %s, rows_copied = %d, updated_at = now() "+
+			"WHERE id = %s AND state = '%s' AND owner = %s RETURNING paused, max_rows_per_second",
+		MigrationsTable, ast.QuoteStringLiteral(shard), mig.rowsCopied,
+		ast.QuoteStringLiteral(mig.ID), StateRunning, ast.QuoteStringLiteral(m.owner)))
+	if err != nil {
+		return fmt.Errorf("failed to report the progress of the schema change: %w", err)
+	}
+	if len(result.Rows) == 0 {
+		return errStopped
+	}
+	row := result.Rows[0]
+	if len(row.Values) != 2 {
+		return errors.New("failed to report the progress of the schema change: unexpected result")
+	}
+	mig.paused = isTrue(row.Values[0])
+	if mig.MaxRowsPerSecond, err = strconv.ParseInt(string(row.Values[1]), 10, 64); err != nil {
+		return fmt.Errorf("failed to report the progress of the schema change: %w", err)
+	}
+	return nil
+}
+
+// checkpoint reports the progress of a running change, and waits while it
+// is paused.
+func (m *Migrator) checkpoint(ctx context.Context, mig *claimedMigration, shard string) error {
+	for {
+		if err := m.report(ctx, mig, shard); err != nil {
+			return err
+		}
+		if !mig.paused {
+			return nil
+		}
+		if err := sleep(ctx, m.opts.PauseCheckInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// finish records the final state of a change claimed by the Migrator.
+func (m *Migrator) finish(ctx context.Context, mig *claimedMigration, state State, errMsg string) error {
+	errValue := "NULL"
+	if errMsg != "" {
+		errValue = ast.QuoteStringLiteral(errMsg)
+	}
+	return m.queue(ctx, fmt.Sprintf(
+		"UPDATE %s SET state = '%s', error = %s, current_shard = NULL, rows_copied = %d, updated_at = now() "+
+			"WHERE id = %s AND state = '%s' AND owner = %s",
+		MigrationsTable, state, errValue, mig.rowsCopied,
+		ast.QuoteStringLiteral(mig.ID), StateRunning, ast.QuoteStringLiteral(m.owner)))
+}
+
+// queue runs a statement on the queue.
+func (m *Migrator) queue(ctx context.Context, sql string) error {
+	if _, err := m.exec.ExecuteOnPrimary(ctx, m.tableGroup, m.queueShard, sql); err != nil {
+		return fmt.Errorf("failed to update the schema change queue: %w", err)
+	}
+	return nil
+}
+
+// state returns the state of the change id.
+func (m *Migrator) state(ctx context.Context, id string) (State, error) {
+	result, err := m.exec.ExecuteOnPrimary(ctx, m.tableGroup, m.queueShard, fmt.Sprintf(
+		"SELECT state FROM %s WHERE id = %s", MigrationsTable, ast.QuoteStringLiteral(id)))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the schema change queue: %w", err)
+	}
+	if len(result.Rows) == 0 || len(result.Rows[0].Values) == 0 {
+		return "", fmt.Errorf("schema change %s not found", id)
+	}
+	return State(result.Rows[0].Values[0]), nil
+}
+
+// isTrue returns true if value is a true boolean in text form.
+func isTrue(value sqltypes.Value) bool {
+	return string(value) == "t" || string(value) == "true"
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
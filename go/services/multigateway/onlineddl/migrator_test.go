@@ -0,0 +1,259 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlineddl
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+// fakeShards plays the queue and the shards of a schema change of the
+// table users, with columns id and name, of a single batch of rows per
+// shard.
+type fakeShards struct {
+	mu         sync.Mutex
+	statements []string
+
+	// queued is the change returned by the first claim.
+	queued []sqltypes.Value
+	// paused is the number of reports answering that the change is
+	// paused.
+	paused int
+	// stopAfter is the number of reports after which the change is
+	// cancelled, or 0.
+	stopAfter int
+	reports   int
+	// failPrefix fails the statements starting with it.
+	failPrefix string
+
+	copied map[string]bool
+}
+
+func row(values ...string) *sqltypes.Row {
+	r := &sqltypes.Row{Values: make([]sqltypes.Value, len(values))}
+	for i, value := range values {
+		if value != "NULL" {
+			r.Values[i] = sqltypes.Value(value)
+		}
+	}
+	return r
+}
+
+func rows(rs ...*sqltypes.Row) *sqltypes.Result {
+	return &sqltypes.Result{Rows: rs}
+}
+
+func (f *fakeShards) ExecuteOnPrimary(_ context.Context, _, shard, sql string) (*sqltypes.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statements = append(f.statements, shard+":"+sql)
+	if f.failPrefix != "" && strings.HasPrefix(sql, f.failPrefix) {
+		return nil, errors.New("statement failed")
+	}
+	switch {
+	case strings.HasPrefix(sql, "UPDATE multigres.schema_migrations SET state = 'running'"):
+		if f.queued == nil {
+			return rows(), nil
+		}
+		claimed := &sqltypes.Row{Values: f.queued}
+		f.queued = nil
+		return rows(claimed), nil
+	case strings.HasPrefix(sql, "UPDATE multigres.schema_migrations SET current_shard"):
+		f.reports++
+		if f.stopAfter > 0 && f.reports > f.stopAfter {
+			return rows(), nil
+		}
+		if f.paused > 0 {
+			f.paused--
+			return rows(row("t", "0")), nil
+		}
+		return rows(row("f", "0")), nil
+	case strings.HasPrefix(sql, "SELECT state FROM multigres.schema_migrations"):
+		return rows(row("cancelled")), nil
+	case strings.HasPrefix(sql, "SELECT n.nspname"):
+		return rows(row("public", "users")), nil
+	case strings.HasPrefix(sql, "SELECT to_regclass('public._mg_"):
+		return rows(row("f")), nil
+	case strings.HasPrefix(sql, "SELECT to_regclass("):
+		return rows(row("t")), nil
+	case strings.HasPrefix(sql, "SELECT (SELECT count(*)"):
+		return rows(row("0", "0")), nil
+	case strings.HasPrefix(sql, "SELECT a.attname FROM pg_index"):
+		return rows(row("id")), nil
+	case strings.HasPrefix(sql, "SELECT attname FROM pg_attribute WHERE attrelid = 'public._mg_"):
+		return rows(row("id"), row("name"), row("b")), nil
+	case strings.HasPrefix(sql, "SELECT attname FROM pg_attribute"):
+		return rows(row("id"), row("name")), nil
+	case strings.HasPrefix(sql, "WITH batch AS"):
+		if f.copied[shard] {
+			return rows(), nil
+		}
+		f.copied[shard] = true
+		return rows(row("2", "2")), nil
+	case strings.HasPrefix(sql, "SELECT max(_mg_seq)"):
+		return rows(row("NULL", "0")), nil
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// executed returns the statements run on shard starting with prefix.
+func (f *fakeShards) executed(shard, prefix string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var matching []string
+	for _, statement := range f.statements {
+		if strings.HasPrefix(statement, shard+":"+prefix) {
+			matching = append(matching, strings.TrimPrefix(statement, shard+":"))
+		}
+	}
+	return matching
+}
+
+func newFakeShards() *fakeShards {
+	return &fakeShards{
+		queued: []sqltypes.Value{
+			sqltypes.Value("0a1b2c3d-4e5f-6789-abcd-ef0123456789"),
+			sqltypes.Value("ALTER TABLE users ADD COLUMN b int"),
+			sqltypes.Value("users"), sqltypes.Value(""), sqltypes.Value("0"), sqltypes.Value("f"), sqltypes.Value("0"),
+		},
+		copied: make(map[string]bool),
+	}
+}
+
+func newTestMigrator(f *fakeShards) *Migrator {
+	shards := func(string) []string { return []string{"0", "1"} }
+	return NewMigrator(f, "tg", "q", shards, "gateway-1", Options{BatchSize: 10, PauseCheckInterval: time.Millisecond}, slog.Default())
+}
+
+func TestMigratorRunsChange(t *testing.T) {
+	f := newFakeShards()
+	f.paused = 1
+	m := newTestMigrator(f)
+
+	ran, err := m.RunNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, ran)
+
+	for _, shard := range []string{"0", "1"} {
+		assert.Equal(t, []string{"CREATE TABLE public._mg_0a1b2c3d4e5f_new (LIKE public.users INCLUDING ALL)"},
+			f.executed(shard, "CREATE TABLE public._mg_0a1b2c3d4e5f_new"))
+		assert.Equal(t, []string{"ALTER TABLE public._mg_0a1b2c3d4e5f_new ADD COLUMN b INT"},
+			f.executed(shard, "ALTER TABLE public._mg_0a1b2c3d4e5f_new ADD"))
+		assert.Len(t, f.executed(shard, "CREATE TRIGGER _mg_0a1b2c3d4e5f_capture AFTER INSERT OR UPDATE OR DELETE ON public.users"), 1)
+		// The new column is left out of the copy.
+		assert.Contains(t, f.executed(shard, "WITH batch AS")[0], "SELECT id, name FROM public.users")
+		assert.Len(t, f.executed(shard, "DO $mg$"), 1)
+	}
+	assert.Equal(t, []string{
+		"UPDATE multigres.schema_migrations SET shards_done = array_append(shards_done, '0'), updated_at = now() WHERE id = '0a1b2c3d-4e5f-6789-abcd-ef0123456789'",
+		"UPDATE multigres.schema_migrations SET shards_done = array_append(shards_done, '1'), updated_at = now() WHERE id = '0a1b2c3d-4e5f-6789-abcd-ef0123456789'",
+	}, f.executed("q", "UPDATE multigres.schema_migrations SET shards_done"))
+	complete := f.executed("q", "UPDATE multigres.schema_migrations SET state = 'complete'")
+	require.Len(t, complete, 1)
+	assert.Contains(t, complete[0], "rows_copied = 4")
+
+	// The queue is empty.
+	ran, err = m.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.False(t, ran)
+}
+
+func TestMigratorSkipsShardsDone(t *testing.T) {
+	f := newFakeShards()
+	f.queued[3] = sqltypes.Value("0")
+	m := newTestMigrator(f)
+
+	_, err := m.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, f.executed("0", ""))
+	assert.Len(t, f.executed("1", "DO $mg$"), 1)
+}
+
+func TestMigratorCancel(t *testing.T) {
+	f := newFakeShards()
+	f.stopAfter = 1
+	m := newTestMigrator(f)
+
+	ran, err := m.RunNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, ran)
+	assert.Empty(t, f.executed("0", "DO $mg$"))
+	// The shadow objects are dropped before the change, and again once it
+	// is cancelled.
+	assert.Equal(t, []string{
+		"DROP TABLE IF EXISTS public._mg_0a1b2c3d4e5f_new, public._mg_0a1b2c3d4e5f_log",
+		"DROP TABLE IF EXISTS public._mg_0a1b2c3d4e5f_new, public._mg_0a1b2c3d4e5f_log",
+	}, f.executed("0", "DROP TABLE"))
+	assert.Empty(t, f.executed("q", "UPDATE multigres.schema_migrations SET state = 'failed'"))
+	assert.Empty(t, f.executed("1", ""))
+}
+
+func TestMigratorFailure(t *testing.T) {
+	f := newFakeShards()
+	f.failPrefix = "ALTER TABLE public._mg_0a1b2c3d4e5f_new ADD"
+	m := newTestMigrator(f)
+
+	ran, err := m.RunNext(context.Background())
+	require.Error(t, err)
+	require.True(t, ran)
+	assert.Contains(t, err.Error(), "shard 0: statement failed")
+	failed := f.executed("q", "UPDATE multigres.schema_migrations SET state = 'failed'")
+	require.Len(t, failed, 1)
+	assert.Contains(t, failed[0], "error = 'shard 0: statement failed'")
+	// Dropped before the change, and again after the failure.
+	assert.Len(t, f.executed("0", "DROP TABLE IF EXISTS"), 2)
+	assert.Empty(t, f.executed("1", ""))
+}
+
+func TestMigratorResumesSwappedShard(t *testing.T) {
+	f := newFakeShards()
+	m := newTestMigrator(f)
+	// The old table of shard 0 exists: a previous attempt swapped the
+	// tables before recording it.
+	exec := &swappedShard{fakeShards: f, shard: "0"}
+	m.exec = exec
+
+	_, err := m.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, f.executed("0", "CREATE TABLE"))
+	assert.Len(t, f.executed("1", "DO $mg$"), 1)
+	assert.True(t, slices.ContainsFunc(f.executed("q", "UPDATE multigres.schema_migrations SET shards_done"), func(s string) bool {
+		return strings.Contains(s, "'0'")
+	}))
+}
+
+// swappedShard is a fakeShards whose shard has the old table of the change.
+type swappedShard struct {
+	*fakeShards
+	shard string
+}
+
+func (s *swappedShard) ExecuteOnPrimary(ctx context.Context, tableGroup, shard, sql string) (*sqltypes.Result, error) {
+	if shard == s.shard && strings.HasPrefix(sql, "SELECT to_regclass('public._mg_0a1b2c3d4e5f_old')") {
+		return rows(row("t")), nil
+	}
+	return s.fakeShards.ExecuteOnPrimary(ctx, tableGroup, shard, sql)
+}
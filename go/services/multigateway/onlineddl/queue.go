@@ -0,0 +1,185 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package onlineddl runs schema changes of large tables without locking
+// them for the duration of the change.
+//
+// A schema change, an ALTER TABLE, is submitted to a queue kept in the
+// multigres sidecar schema of the shard of the unsharded tables. A gateway
+// claims the next change of the queue and runs it on each shard of the
+// table, one after the other, the way gh-ost and pg_repack do:
+//
+//  1. An empty shadow table is created like the table, and the change is
+//     applied to it.
+//  2. A trigger logs the primary keys of the rows written in the table
+//     from then on.
+//  3. The rows of the table are copied to the shadow table in batches, in
+//     primary key order, at the rate the change is throttled to.
+//  4. The rows logged by the trigger are copied again, until few are left.
+//  5. The table is locked, the last logged rows copied, and the tables
+//     swapped by renaming them, in a single transaction.
+//
+// The table is locked only for the swap, which gives up after a lock
+// timeout and is retried. The original table is kept, renamed, until
+// dropped by the operator. A change can be throttled, paused, resumed and
+// cancelled while it runs, by updating its entry in the queue, which the
+// gateway running it checks between batches; the multigres CLI does so.
+//
+// The table must have a primary key, kept by the change, and no identity
+// column; it must not be referenced by foreign keys. Rows removed by
+// TRUNCATE while the change runs are not seen.
+package onlineddl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/parser/ast"
+)
+
+// MigrationsTable is the table of the multigres sidecar schema holding the
+// queue of schema changes.
+const MigrationsTable = "multigres.schema_migrations"
+
+// State is the state of a schema change in the queue.
+type State string
+
+const (
+	// StateQueued is the state of a change waiting to run.
+	StateQueued State = "queued"
+	// StateRunning is the state of a change a gateway runs.
+	StateRunning State = "running"
+	// StateComplete is the state of a change applied on every shard.
+	StateComplete State = "complete"
+	// StateFailed is the state of a change that failed on a shard. The
+	// shards it completed on keep it.
+	StateFailed State = "failed"
+	// StateCancelled is the state of a change cancelled by the operator.
+	// The shards it completed on keep it.
+	StateCancelled State = "cancelled"
+)
+
+// Migration is a schema change of the queue.
+type Migration struct {
+	// ID identifies the change, a UUID.
+	ID string
+
+	// DDL is the ALTER TABLE statement.
+	DDL string
+
+	// Table is the name of the table the change alters.
+	Table string
+
+	// MaxRowsPerSecond throttles the copy of the rows, or is 0 to copy
+	// them as fast as possible.
+	MaxRowsPerSecond int64
+}
+
+// NewMigration creates the schema change running ddl, which must be a
+// single ALTER TABLE statement.
+func NewMigration(ddl string, maxRowsPerSecond int64) (*Migration, error) {
+	alter, err := parseAlterTable(ddl)
+	if err != nil {
+		return nil, err
+	}
+	if maxRowsPerSecond < 0 {
+		return nil, fmt.Errorf("invalid throttle %d: must not be negative", maxRowsPerSecond)
+	}
+	return &Migration{
+		ID:               uuid.New().String(),
+		DDL:              ddl,
+		Table:            alter.Relation.RelName,
+		MaxRowsPerSecond: maxRowsPerSecond,
+	}, nil
+}
+
+// parseAlterTable parses the ALTER TABLE statement of a schema change.
+func parseAlterTable(ddl string) (*ast.AlterTableStmt, error) {
+	stmts, err := parser.ParseSQL(ddl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema change: %w", err)
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("invalid schema change: expected a single statement, got %d", len(stmts))
+	}
+	alter, ok := stmts[0].(*ast.AlterTableStmt)
+	if !ok || alter.Objtype != ast.OBJECT_TABLE || alter.Relation == nil {
+		return nil, fmt.Errorf("invalid schema change: only ALTER TABLE statements run online")
+	}
+	return alter, nil
+}
+
+// SubmitQuery returns the statement adding m to the queue.
+func SubmitQuery(m *Migration) string {
+	return fmt.Sprintf("INSERT INTO %s (id, table_name, ddl, state, max_rows_per_second) VALUES (%s, %s, %s, '%s', %d)",
+		MigrationsTable, ast.QuoteStringLiteral(m.ID), ast.QuoteStringLiteral(m.Table), ast.QuoteStringLiteral(m.DDL),
+		StateQueued, m.MaxRowsPerSecond)
+}
+
+// ListQuery returns the statement listing the changes of the queue, or the
+// change id if not empty, with the columns id, table_name, state, paused,
+// max_rows_per_second, shards_done (separated by commas), current_shard,
+// rows_copied, error, submitted_at and updated_at.
+func ListQuery(id string) string {
+	var where string
+	if id != "" {
+		where = " WHERE id = " + ast.QuoteStringLiteral(id)
+	}
+	return "SELECT id, table_name, state, paused, max_rows_per_second, array_to_string(shards_done, ','), " +
+		"coalesce(current_shard, ''), rows_copied, coalesce(error, ''), submitted_at, updated_at FROM " +
+		MigrationsTable + where + " ORDER BY submitted_at"
+}
+
+// pending is the condition on the changes that have yet to finish.
+var pending = fmt.Sprintf("state IN ('%s', '%s')", StateQueued, StateRunning)
+
+// PauseQuery returns the statement pausing the change id: a queued change
+// is not started, a running one stops copying rows until resumed.
+func PauseQuery(id string) string {
+	return fmt.Sprintf("UPDATE %s SET paused = true, updated_at = now() WHERE id = %s AND %s",
+		MigrationsTable, ast.QuoteStringLiteral(id), pending)
+}
+
+// ResumeQuery returns the statement resuming the paused change id.
+func ResumeQuery(id string) string {
+	return fmt.Sprintf("UPDATE %s SET paused = false, updated_at = now() WHERE id = %s AND %s",
+		MigrationsTable, ast.QuoteStringLiteral(id), pending)
+}
+
+// CancelQuery returns the statement cancelling the change id. A running
+// change stops at its next batch, and its shadow tables are dropped.
+func CancelQuery(id string) string {
+	return fmt.Sprintf("UPDATE %s SET state = '%s', updated_at = now() WHERE id = %s AND %s",
+		MigrationsTable, StateCancelled, ast.QuoteStringLiteral(id), pending)
+}
+
+// ThrottleQuery returns the statement throttling the copy of the rows of
+// the change id to maxRowsPerSecond, or unthrottling it if 0.
+func ThrottleQuery(id string, maxRowsPerSecond int64) string {
+	return fmt.Sprintf("UPDATE %s SET max_rows_per_second = %d, updated_at = now() WHERE id = %s AND %s",
+		MigrationsTable, maxRowsPerSecond, ast.QuoteStringLiteral(id), pending)
+}
+
+// shortID returns the part of the identifier of a change naming the
+// tables it creates.
+func shortID(id string) string {
+	short := strings.ReplaceAll(id, "-", "")
+	if len(short) > 12 {
+		short = short[:12]
+	}
+	return short
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMigration(t *testing.T) {
+	m, err := NewMigration("ALTER TABLE public.users ADD COLUMN b int", 500)
+	require.NoError(t, err)
+	assert.Equal(t, "users", m.Table)
+	assert.Equal(t, int64(500), m.MaxRowsPerSecond)
+	assert.Len(t, m.ID, 36)
+
+	for _, ddl := range []string{
+		"CREATE INDEX i ON users (b)",
+		"ALTER TABLE users ADD COLUMN b int; ALTER TABLE users ADD COLUMN c int",
+		"ALTER INDEX i RENAME TO j",
+		"ALTER TABLE users ADD COLUMN",
+	} {
+		_, err := NewMigration(ddl, 0)
+		assert.Error(t, err, ddl)
+	}
+	_, err = NewMigration("ALTER TABLE users ADD COLUMN b int", -1)
+	assert.Error(t, err)
+}
+
+func TestQueueQueries(t *testing.T) {
+	m := &Migration{ID: "abc", DDL: "ALTER TABLE users ADD COLUMN b text DEFAULT 'x'", Table: "users", MaxRowsPerSecond: 10}
+	assert.Equal(t,
+		"INSERT INTO multigres.schema_migrations (id, table_name, ddl, state, max_rows_per_second) "+
+			"VALUES ('abc', 'users', 'ALTER TABLE users ADD COLUMN b text DEFAULT ''x''', 'queued', 10)",
+		SubmitQuery(m))
+	assert.Equal(t,
+		"UPDATE multigres.schema_migrations SET paused = true, updated_at = now() WHERE id = 'abc' AND state IN ('queued', 'running')",
+		PauseQuery("abc"))
+	assert.Equal(t,
+		"UPDATE multigres.schema_migrations SET paused = false, updated_at = now() WHERE id = 'abc' AND state IN ('queued', 'running')",
+		ResumeQuery("abc"))
+	assert.Equal(t,
+		"UPDATE multigres.schema_migrations SET state = 'cancelled', updated_at = now() WHERE id = 'abc' AND state IN ('queued', 'running')",
+		CancelQuery("abc"))
+	assert.Equal(t,
+		"UPDATE multigres.schema_migrations SET max_rows_per_second = 0, updated_at = now() WHERE id = 'abc' AND state IN ('queued', 'running')",
+		ThrottleQuery("abc", 0))
+	assert.Contains(t, ListQuery("abc"), "WHERE id = 'abc'")
+	assert.NotContains(t, ListQuery(""), "WHERE")
+}
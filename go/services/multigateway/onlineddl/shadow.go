@@ -0,0 +1,525 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlineddl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// seqColumn is the column of the log table ordering the logged rows.
+const seqColumn = "_mg_seq"
+
+// shardMigration runs a schema change on a shard.
+type shardMigration struct {
+	m     *Migrator
+	mig   *claimedMigration
+	alter *ast.AlterTableStmt
+	shard string
+
+	// schema and table name the table, shadow, log, old and capture the
+	// objects created by the change, all in schema.
+	schema, table             string
+	shadow, log, old, capture string
+
+	// keys are the primary key columns of the table, columns the columns
+	// of the table kept by the change, excluding generated columns, and
+	// updated those of them that are not keys.
+	keys, columns, updated []string
+}
+
+// run runs the change on the shard: copies the rows of the table into a
+// shadow table with the change applied, then swaps the tables. Objects
+// left by a previous attempt are dropped first. The shadow objects are
+// dropped if the change fails or is cancelled.
+func (s *shardMigration) run(ctx context.Context) error {
+	if err := s.m.checkpoint(ctx, s.mig, s.shard); err != nil {
+		return s.stopped(ctx, err)
+	}
+	if err := s.resolve(ctx); err != nil {
+		return err
+	}
+	// A previous attempt may have swapped the tables before stopping.
+	swapped, err := s.exists(ctx, s.old)
+	if err != nil || swapped {
+		return err
+	}
+	if err := s.cleanup(ctx); err != nil {
+		return err
+	}
+
+	err = s.migrate(ctx)
+	if err == nil {
+		return nil
+	}
+	// The objects are left to the gateway taking over a change, or, if the
+	// gateway stops, to the one that will.
+	err = s.stopped(ctx, err)
+	if errors.Is(err, errCancelled) || (ctx.Err() == nil && !errors.Is(err, errStopped)) {
+		if cleanupErr := s.cleanup(ctx); cleanupErr != nil {
+			s.m.logger.WarnContext(ctx, "failed to drop the shadow tables of a schema change",
+				"id", s.mig.ID, "shard", s.shard, "error", cleanupErr)
+		}
+	}
+	if errors.Is(err, errCancelled) {
+		return errStopped
+	}
+	return err
+}
+
+// errCancelled reports that a change was cancelled while it ran.
+var errCancelled = fmt.Errorf("%w: cancelled", errStopped)
+
+// stopped returns errCancelled rather than errStopped if the change was
+// cancelled, as opposed to taken over.
+func (s *shardMigration) stopped(ctx context.Context, err error) error {
+	if !errors.Is(err, errStopped) {
+		return err
+	}
+	state, stateErr := s.m.state(ctx, s.mig.ID)
+	if stateErr == nil && state == StateCancelled {
+		return errCancelled
+	}
+	return err
+}
+
+// migrate creates the shadow table, copies the rows into it, and swaps
+// the tables.
+func (s *shardMigration) migrate(ctx context.Context) error {
+	if err := s.createShadow(ctx); err != nil {
+		return err
+	}
+	if err := s.copyRows(ctx); err != nil {
+		return err
+	}
+	for attempt := 1; ; attempt++ {
+		if err := s.catchUp(ctx); err != nil {
+			return err
+		}
+		err := s.exec(ctx, s.cutoverQuery())
+		if err == nil {
+			return nil
+		}
+		var diag *sqltypes.PgDiagnostic
+		if !errors.As(err, &diag) || diag.Code != sqlstate.LockNotAvailable || attempt >= s.m.opts.CutoverAttempts {
+			return fmt.Errorf("failed to swap the tables: %w", err)
+		}
+		s.m.logger.InfoContext(ctx, "table busy, retrying the swap of a schema change",
+			"id", s.mig.ID, "shard", s.shard, "attempt", attempt)
+	}
+}
+
+// resolve finds the schema of the table, and names the objects of the
+// change.
+func (s *shardMigration) resolve(ctx context.Context) error {
+	name := qualified(s.alter.Relation.SchemaName, s.alter.Relation.RelName)
+	result, err := s.query(ctx, fmt.Sprintf(
+		"SELECT n.nspname, c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace WHERE c.oid = to_regclass(%s)",
+		ast.QuoteStringLiteral(name)))
+	if err != nil {
+		return err
+	}
+	if len(result.Rows) == 0 || len(result.Rows[0].Values) != 2 {
+		return fmt.Errorf("table %s does not exist", name)
+	}
+	s.schema, s.table = string(result.Rows[0].Values[0]), string(result.Rows[0].Values[1])
+	prefix := "_mg_" + shortID(s.mig.ID) + "_"
+	s.shadow, s.log, s.old, s.capture = prefix+"new", prefix+"log", prefix+"old", prefix+"capture"
+	return nil
+}
+
+// createShadow checks that the change can run online, then creates the
+// shadow table with the change applied and the log of the rows written
+// in the table.
+func (s *shardMigration) createShadow(ctx context.Context) error {
+	if err := s.check(ctx); err != nil {
+		return err
+	}
+	var err error
+	if s.keys, err = s.primaryKey(ctx, s.table); err != nil {
+		return err
+	}
+	if len(s.keys) == 0 {
+		return fmt.Errorf("table %s has no primary key", s.table)
+	}
+
+	if err := s.exec(ctx, fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING ALL)", s.name(s.shadow), s.name(s.table))); err != nil {
+		return err
+	}
+	alter := *s.alter
+	relation := *s.alter.Relation
+	relation.SchemaName, relation.RelName = s.schema, s.shadow
+	alter.Relation = &relation
+	if err := s.exec(ctx, alter.SqlString()); err != nil {
+		return err
+	}
+
+	shadowKeys, err := s.primaryKey(ctx, s.shadow)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(shadowKeys, s.keys) {
+		return errors.New("online schema changes cannot change the primary key")
+	}
+	columns, err := s.tableColumns(ctx, s.table)
+	if err != nil {
+		return err
+	}
+	shadowColumns, err := s.tableColumns(ctx, s.shadow)
+	if err != nil {
+		return err
+	}
+	s.columns, s.updated = nil, nil
+	for _, column := range shadowColumns {
+		if !slices.Contains(columns, column) {
+			continue
+		}
+		s.columns = append(s.columns, column)
+		if !slices.Contains(s.keys, column) {
+			s.updated = append(s.updated, column)
+		}
+	}
+
+	if err := s.exec(ctx, fmt.Sprintf("CREATE TABLE %s AS SELECT %s FROM %s WITH NO DATA",
+		s.name(s.log), quoteList(s.keys), s.name(s.table))); err != nil {
+		return err
+	}
+	if err := s.exec(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s bigserial PRIMARY KEY", s.name(s.log), seqColumn)); err != nil {
+		return err
+	}
+	if err := s.exec(ctx, s.captureFunctionQuery()); err != nil {
+		return err
+	}
+	return s.exec(ctx, fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE FUNCTION %s()",
+		ast.QuoteIdentifier(s.capture), s.name(s.table), s.name(s.capture)))
+}
+
+// check fails if the table has identity columns, whose sequences the
+// shadow table would not share, or is referenced by foreign keys, which
+// would keep referencing the original table.
+func (s *shardMigration) check(ctx context.Context) error {
+	result, err := s.query(ctx, fmt.Sprintf(
+		"SELECT (SELECT count(*) FROM pg_attribute WHERE attrelid = %[1]s::regclass AND attidentity <> ''), "+
+			"(SELECT count(*) FROM pg_constraint WHERE confrelid = %[1]s::regclass AND contype = 'f')",
+		ast.QuoteStringLiteral(s.name(s.table))))
+	if err != nil {
+		return err
+	}
+	if len(result.Rows) != 1 || len(result.Rows[0].Values) != 2 {
+		return errors.New("failed to check the table: unexpected result")
+	}
+	if string(result.Rows[0].Values[0]) != "0" {
+		return fmt.Errorf("table %s has identity columns, which online schema changes do not support", s.table)
+	}
+	if string(result.Rows[0].Values[1]) != "0" {
+		return fmt.Errorf("table %s is referenced by foreign keys, which online schema changes do not support", s.table)
+	}
+	return nil
+}
+
+// primaryKey returns the primary key columns of a table of the schema.
+func (s *shardMigration) primaryKey(ctx context.Context, table string) ([]string, error) {
+	result, err := s.query(ctx, fmt.Sprintf(
+		"SELECT a.attname FROM pg_index i JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey) "+
+			"WHERE i.indrelid = %s::regclass AND i.indisprimary ORDER BY array_position(i.indkey::int2[], a.attnum)",
+		ast.QuoteStringLiteral(s.name(table))))
+	if err != nil {
+		return nil, err
+	}
+	return firstColumn(result), nil
+}
+
+// tableColumns returns the columns of a table of the schema, in order,
+// excluding generated columns.
+func (s *shardMigration) tableColumns(ctx context.Context, table string) ([]string, error) {
+	result, err := s.query(ctx, fmt.Sprintf(
+		"SELECT attname FROM pg_attribute WHERE attrelid = %s::regclass AND attnum > 0 AND NOT attisdropped AND attgenerated = '' ORDER BY attnum",
+		ast.QuoteStringLiteral(s.name(table))))
+	if err != nil {
+		return nil, err
+	}
+	return firstColumn(result), nil
+}
+
+// captureFunctionQuery returns the statement creating the trigger function
+// logging the primary keys of the rows written in the table: the old and
+// the new key of an UPDATE, which may change it.
+func (s *shardMigration) captureFunctionQuery() string {
+	columns := quoteList(s.keys)
+	values := func(record string) string {
+		parts := make([]string, len(s.keys))
+		for i, key := range s.keys {
+			parts[i] = record + "." + ast.QuoteIdentifier(key)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return fmt.Sprintf("CREATE FUNCTION %[1]s() RETURNS trigger LANGUAGE plpgsql AS $mg$\n"+
+		"BEGIN\n"+
+		"  IF TG_OP IN ('UPDATE', 'DELETE') THEN INSERT INTO %[2]s (%[3]s) VALUES (%[4]s); END IF;\n"+
+		"  IF TG_OP IN ('INSERT', 'UPDATE') THEN INSERT INTO %[2]s (%[3]s) VALUES (%[5]s); END IF;\n"+
+		"  RETURN NULL;\n"+
+		"END\n"+
+		"$mg$",
+		s.name(s.capture), s.name(s.log), columns, values("OLD"), values("NEW"))
+}
+
+// copyRows copies the rows of the table into the shadow table in batches,
+// in primary key order, reporting the progress after each batch and
+// sleeping after it as long as the throttle of the change requires.
+func (s *shardMigration) copyRows(ctx context.Context) error {
+	var last []string
+	for {
+		result, err := s.query(ctx, s.copyBatchQuery(last))
+		if err != nil {
+			return err
+		}
+		if len(result.Rows) == 0 {
+			return nil
+		}
+		row := result.Rows[0]
+		if len(row.Values) != len(s.keys)+1 {
+			return errors.New("failed to copy rows: unexpected result")
+		}
+		copied, err := strconv.ParseInt(string(row.Values[0]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to copy rows: %w", err)
+		}
+		last = make([]string, len(s.keys))
+		for i := range s.keys {
+			last[i] = string(row.Values[i+1])
+		}
+		s.mig.rowsCopied += copied
+
+		if err := s.m.checkpoint(ctx, s.mig, s.shard); err != nil {
+			return err
+		}
+		if rate := s.mig.MaxRowsPerSecond; rate > 0 {
+			if err := sleep(ctx, time.Duration(float64(copied)/float64(rate)*float64(time.Second))); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// copyBatchQuery returns the statement copying the batch of rows following
+// the key last, or the first batch if nil, and returning the number of
+// rows copied and the last key of the batch, in text form. It returns no
+// rows once every row is copied.
+func (s *shardMigration) copyBatchQuery(last []string) string {
+	keys := quoteList(s.keys)
+	var where string
+	if last != nil {
+		values := make([]string, len(last))
+		for i, value := range last {
+			values[i] = ast.QuoteStringLiteral(value)
+		}
+		where = fmt.Sprintf(" WHERE (%s) > (%s)", keys, strings.Join(values, ", "))
+	}
+	texts := make([]string, len(s.keys))
+	descending := make([]string, len(s.keys))
+	for i, key := range s.keys {
+		texts[i] = ast.QuoteIdentifier(key) + "::text"
+		descending[i] = ast.QuoteIdentifier(key) + " DESC"
+	}
+	columns := quoteList(s.columns)
+	return fmt.Sprintf("WITH batch AS (SELECT %[1]s FROM %[2]s%[3]s ORDER BY %[4]s LIMIT %[5]d), "+
+		"copied AS (INSERT INTO %[6]s (%[1]s) SELECT %[1]s FROM batch ON CONFLICT DO NOTHING) "+
+		"SELECT count(*) OVER (), %[7]s FROM batch ORDER BY %[8]s LIMIT 1",
+		columns, s.name(s.table), where, keys, s.m.opts.BatchSize, s.name(s.shadow),
+		strings.Join(texts, ", "), strings.Join(descending, ", "))
+}
+
+// catchUp copies the rows logged by the trigger again, a batch at a time,
+// until fewer than a batch are left.
+func (s *shardMigration) catchUp(ctx context.Context) error {
+	for {
+		result, err := s.query(ctx, fmt.Sprintf(
+			"SELECT max(%[1]s), count(*) FROM (SELECT %[1]s FROM %[2]s ORDER BY %[1]s LIMIT %[3]d) AS pending",
+			seqColumn, s.name(s.log), s.m.opts.BatchSize))
+		if err != nil {
+			return err
+		}
+		if len(result.Rows) != 1 || len(result.Rows[0].Values) != 2 {
+			return errors.New("failed to read the logged rows: unexpected result")
+		}
+		row := result.Rows[0]
+		if row.Values[0].IsNull() {
+			return nil
+		}
+		for _, sql := range s.applyLogQueries(string(row.Values[0])) {
+			if err := s.exec(ctx, sql); err != nil {
+				return err
+			}
+		}
+		if err := s.m.checkpoint(ctx, s.mig, s.shard); err != nil {
+			return err
+		}
+		if count, err := strconv.Atoi(string(row.Values[1])); err != nil || count < s.m.opts.BatchSize {
+			return err
+		}
+	}
+}
+
+// applyLogQueries returns the statements copying the rows logged up to
+// the sequence number upTo, or all of them if empty, into the shadow
+// table: the rows still in the table are upserted, the others deleted,
+// and their entries removed from the log. Each statement can run again.
+func (s *shardMigration) applyLogQueries(upTo string) []string {
+	var bound string
+	if upTo != "" {
+		bound = fmt.Sprintf(" WHERE %s <= %s", seqColumn, upTo)
+	}
+	keys := quoteList(s.keys)
+	logged := fmt.Sprintf("SELECT %s FROM %s%s", keys, s.name(s.log), bound)
+	columns := quoteList(s.columns)
+
+	conflict := "DO NOTHING"
+	if len(s.updated) > 0 {
+		sets := make([]string, len(s.updated))
+		for i, column := range s.updated {
+			sets[i] = fmt.Sprintf("%[1]s = EXCLUDED.%[1]s", ast.QuoteIdentifier(column))
+		}
+		conflict = "DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+	matches := make([]string, len(s.keys))
+	shadowKeys := make([]string, len(s.keys))
+	for i, key := range s.keys {
+		matches[i] = fmt.Sprintf("o.%[1]s = s.%[1]s", ast.QuoteIdentifier(key))
+		shadowKeys[i] = "s." + ast.QuoteIdentifier(key)
+	}
+	return []string{
+		fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s WHERE (%s) IN (%s) ON CONFLICT (%s) %s",
+			s.name(s.shadow), columns, columns, s.name(s.table), keys, logged, keys, conflict),
+		fmt.Sprintf("DELETE FROM %s AS s WHERE (%s) IN (%s) AND NOT EXISTS (SELECT 1 FROM %s AS o WHERE %s)",
+			s.name(s.shadow), strings.Join(shadowKeys, ", "), logged, s.name(s.table), strings.Join(matches, " AND ")),
+		fmt.Sprintf("DELETE FROM %s%s", s.name(s.log), bound),
+	}
+}
+
+// cutoverQuery returns the block swapping the tables, in a single
+// transaction: the table is locked, giving up after the lock timeout, the
+// last logged rows copied, the table renamed to the old name and the
+// shadow table to its name, and the capture objects dropped. The
+// sequences of the serial columns are moved to the new table, so that
+// dropping the old one does not drop them.
+func (s *shardMigration) cutoverQuery() string {
+	var b strings.Builder
+	b.WriteString("DO $mg$\nDECLARE r record;\nBEGIN\n")
+	fmt.Fprintf(&b, "  PERFORM set_config('lock_timeout', '%dms', true);\n", s.m.opts.CutoverLockTimeout.Milliseconds())
+	fmt.Fprintf(&b, "  LOCK TABLE %s IN ACCESS EXCLUSIVE MODE;\n", s.name(s.table))
+	for _, sql := range s.applyLogQueries("") {
+		fmt.Fprintf(&b, "  %s;\n", sql)
+	}
+	fmt.Fprintf(&b, "  DROP TRIGGER %s ON %s;\n", ast.QuoteIdentifier(s.capture), s.name(s.table))
+	fmt.Fprintf(&b, "  ALTER TABLE %s RENAME TO %s;\n", s.name(s.table), ast.QuoteIdentifier(s.old))
+	fmt.Fprintf(&b, "  ALTER TABLE %s RENAME TO %s;\n", s.name(s.shadow), ast.QuoteIdentifier(s.table))
+	fmt.Fprintf(&b, "  DROP FUNCTION %s();\n", s.name(s.capture))
+	fmt.Fprintf(&b, "  DROP TABLE %s;\n", s.name(s.log))
+	fmt.Fprintf(&b, "  FOR r IN SELECT d.objid::regclass AS seq, a.attname FROM pg_depend d "+
+		"JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid "+
+		"WHERE d.classid = 'pg_class'::regclass AND d.refobjid = %s::regclass AND d.deptype = 'a' "+
+		"AND a.attname IN (SELECT attname FROM pg_attribute WHERE attrelid = %s::regclass AND NOT attisdropped) LOOP\n",
+		ast.QuoteStringLiteral(s.name(s.old)), ast.QuoteStringLiteral(s.name(s.table)))
+	fmt.Fprintf(&b, "    EXECUTE format('ALTER SEQUENCE %%s OWNED BY %%s.%%I', r.seq, %s, r.attname);\n",
+		ast.QuoteStringLiteral(s.name(s.table)))
+	b.WriteString("  END LOOP;\nEND\n$mg$")
+	return b.String()
+}
+
+// cleanup drops the objects created by the change on the shard, but the
+// old table.
+func (s *shardMigration) cleanup(ctx context.Context) error {
+	tableExists, err := s.exists(ctx, s.table)
+	if err != nil {
+		return err
+	}
+	var statements []string
+	if tableExists {
+		statements = append(statements, fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", ast.QuoteIdentifier(s.capture), s.name(s.table)))
+	}
+	statements = append(statements,
+		fmt.Sprintf("DROP FUNCTION IF EXISTS %s()", s.name(s.capture)),
+		fmt.Sprintf("DROP TABLE IF EXISTS %s, %s", s.name(s.shadow), s.name(s.log)))
+	for _, sql := range statements {
+		if err := s.exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exists returns true if a table of the schema exists.
+func (s *shardMigration) exists(ctx context.Context, table string) (bool, error) {
+	result, err := s.query(ctx, fmt.Sprintf("SELECT to_regclass(%s) IS NOT NULL", ast.QuoteStringLiteral(s.name(table))))
+	if err != nil {
+		return false, err
+	}
+	return len(result.Rows) == 1 && len(result.Rows[0].Values) == 1 && isTrue(result.Rows[0].Values[0]), nil
+}
+
+// name returns the qualified and quoted name of an object of the schema.
+func (s *shardMigration) name(object string) string {
+	return qualified(s.schema, object)
+}
+
+// query runs a statement on the shard and returns its result.
+func (s *shardMigration) query(ctx context.Context, sql string) (*sqltypes.Result, error) {
+	return s.m.exec.ExecuteOnPrimary(ctx, s.m.tableGroup, s.shard, sql)
+}
+
+// exec runs a statement on the shard.
+func (s *shardMigration) exec(ctx context.Context, sql string) error {
+	_, err := s.query(ctx, sql)
+	return err
+}
+
+// qualified returns the quoted name of object, qualified by schema if not
+// empty.
+func qualified(schema, object string) string {
+	if schema == "" {
+		return ast.QuoteIdentifier(object)
+	}
+	return ast.QuoteIdentifier(schema) + "." + ast.QuoteIdentifier(object)
+}
+
+// quoteList returns the quoted names of columns, separated by commas.
+func quoteList(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = ast.QuoteIdentifier(column)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// firstColumn returns the values of the first column of the rows of
+// result.
+func firstColumn(result *sqltypes.Result) []string {
+	values := make([]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		if len(row.Values) > 0 {
+			values = append(values, string(row.Values[0]))
+		}
+	}
+	return values
+}
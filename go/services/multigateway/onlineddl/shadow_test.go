@@ -0,0 +1,99 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlineddl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestShardMigration(keys, columns, updated []string) *shardMigration {
+	m := &Migrator{opts: Options{BatchSize: 100, CutoverLockTimeout: 2 * time.Second}}
+	return &shardMigration{
+		m:       m,
+		schema:  "public",
+		table:   "users",
+		shadow:  "_mg_abc_new",
+		log:     "_mg_abc_log",
+		old:     "_mg_abc_old",
+		capture: "_mg_abc_capture",
+		keys:    keys,
+		columns: columns,
+		updated: updated,
+	}
+}
+
+func TestCopyBatchQuery(t *testing.T) {
+	s := newTestShardMigration([]string{"id"}, []string{"id", "name"}, []string{"name"})
+	assert.Equal(t,
+		"WITH batch AS (SELECT id, name FROM public.users ORDER BY id LIMIT 100), "+
+			"copied AS (INSERT INTO public._mg_abc_new (id, name) SELECT id, name FROM batch ON CONFLICT DO NOTHING) "+
+			"SELECT count(*) OVER (), id::text FROM batch ORDER BY id DESC LIMIT 1",
+		s.copyBatchQuery(nil))
+
+	s = newTestShardMigration([]string{"tenant", "id"}, []string{"tenant", "id", "name"}, []string{"name"})
+	assert.Equal(t,
+		"WITH batch AS (SELECT tenant, id, name FROM public.users WHERE (tenant, id) > ('a''b', '7') ORDER BY tenant, id LIMIT 100), "+
+			"copied AS (INSERT INTO public._mg_abc_new (tenant, id, name) SELECT tenant, id, name FROM batch ON CONFLICT DO NOTHING) "+
+			"SELECT count(*) OVER (), tenant::text, id::text FROM batch ORDER BY tenant DESC, id DESC LIMIT 1",
+		s.copyBatchQuery([]string{"a'b", "7"}))
+}
+
+func TestApplyLogQueries(t *testing.T) {
+	s := newTestShardMigration([]string{"id"}, []string{"id", "name"}, []string{"name"})
+	assert.Equal(t, []string{
+		"INSERT INTO public._mg_abc_new (id, name) SELECT id, name FROM public.users " +
+			"WHERE (id) IN (SELECT id FROM public._mg_abc_log WHERE _mg_seq <= 42) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name",
+		"DELETE FROM public._mg_abc_new AS s WHERE (s.id) IN (SELECT id FROM public._mg_abc_log WHERE _mg_seq <= 42) " +
+			"AND NOT EXISTS (SELECT 1 FROM public.users AS o WHERE o.id = s.id)",
+		"DELETE FROM public._mg_abc_log WHERE _mg_seq <= 42",
+	}, s.applyLogQueries("42"))
+
+	// Tables of keys only have nothing to update.
+	s = newTestShardMigration([]string{"id"}, []string{"id"}, nil)
+	assert.Contains(t, s.applyLogQueries("")[0], "ON CONFLICT (id) DO NOTHING")
+	assert.Equal(t, "DELETE FROM public._mg_abc_log", s.applyLogQueries("")[2])
+}
+
+func TestCaptureFunctionQuery(t *testing.T) {
+	s := newTestShardMigration([]string{"tenant", "id"}, nil, nil)
+	assert.Equal(t, "CREATE FUNCTION public._mg_abc_capture() RETURNS trigger LANGUAGE plpgsql AS $mg$\n"+
+		"BEGIN\n"+
+		"  IF TG_OP IN ('UPDATE', 'DELETE') THEN INSERT INTO public._mg_abc_log (tenant, id) VALUES (OLD.tenant, OLD.id); END IF;\n"+
+		"  IF TG_OP IN ('INSERT', 'UPDATE') THEN INSERT INTO public._mg_abc_log (tenant, id) VALUES (NEW.tenant, NEW.id); END IF;\n"+
+		"  RETURN NULL;\n"+
+		"END\n"+
+		"$mg$", s.captureFunctionQuery())
+}
+
+func TestCutoverQuery(t *testing.T) {
+	s := newTestShardMigration([]string{"id"}, []string{"id", "name"}, []string{"name"})
+	sql := s.cutoverQuery()
+	for _, part := range []string{
+		"PERFORM set_config('lock_timeout', '2000ms', true);\n  LOCK TABLE public.users IN ACCESS EXCLUSIVE MODE;\n",
+		"  DELETE FROM public._mg_abc_log;\n" +
+			"  DROP TRIGGER _mg_abc_capture ON public.users;\n" +
+			"  ALTER TABLE public.users RENAME TO _mg_abc_old;\n" +
+			"  ALTER TABLE public._mg_abc_new RENAME TO users;\n" +
+			"  DROP FUNCTION public._mg_abc_capture();\n" +
+			"  DROP TABLE public._mg_abc_log;\n",
+		"d.refobjid = 'public._mg_abc_old'::regclass",
+		"EXECUTE format('ALTER SEQUENCE %s OWNED BY %s.%I', r.seq, 'public.users', r.attname);",
+	} {
+		assert.Contains(t, sql, part)
+	}
+}
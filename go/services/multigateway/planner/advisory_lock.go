@@ -0,0 +1,30 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planAdvisoryLock plans statements that take or release session-level
+// advisory locks, e.g. SELECT pg_advisory_lock(1). They are routed like
+// any other statement, but on a reserved connection pinned to the session
+// for as long as it holds locks.
+func (p *Planner) planAdvisoryLock(sql string, usage engine.AdvisoryLockUsage) (*engine.Plan, error) {
+	route := engine.NewAdvisoryLockRoute(p.defaultTableGroup, "", sql, usage)
+	plan := engine.NewPlan(sql, route)
+	p.logger.Debug("created advisory lock plan", "plan", plan.String())
+	return plan, nil
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// pushedAggregates are the aggregates computed partially by each shard and
+// combined by the gateway.
+var pushedAggregates = map[string]engine.AggregateKind{
+	"count": engine.AggregateCount,
+	"sum":   engine.AggregateSum,
+	"min":   engine.AggregateMin,
+	"max":   engine.AggregateMax,
+	"avg":   engine.AggregateAvg,
+}
+
+// otherAggregates are the built-in aggregates that cannot be combined
+// across shards.
+var otherAggregates = map[string]bool{
+	"array_agg": true, "string_agg": true, "json_agg": true, "jsonb_agg": true,
+	"json_object_agg": true, "jsonb_object_agg": true, "xmlagg": true,
+	"bool_and": true, "bool_or": true, "every": true,
+	"bit_and": true, "bit_or": true, "bit_xor": true,
+	"stddev": true, "stddev_pop": true, "stddev_samp": true,
+	"variance": true, "var_pop": true, "var_samp": true,
+	"corr": true, "covar_pop": true, "covar_samp": true,
+	"regr_avgx": true, "regr_avgy": true, "regr_count": true, "regr_intercept": true,
+	"regr_r2": true, "regr_slope": true, "regr_sxx": true, "regr_sxy": true, "regr_syy": true,
+	"mode": true, "percentile_cont": true, "percentile_disc": true,
+	"rank": true, "dense_rank": true, "percent_rank": true, "cume_dist": true,
+	"any_value": true, "range_agg": true, "range_intersect_agg": true,
+}
+
+// isAggregateQuery returns true if a SELECT groups its rows or computes
+// aggregates in its target list.
+func isAggregateQuery(stmt *ast.SelectStmt) bool {
+	if stmt.Op != ast.SETOP_NONE {
+		return false
+	}
+	if stmt.GroupClause != nil && len(stmt.GroupClause.Items) > 0 {
+		return true
+	}
+	return stmt.TargetList != nil && containsAggregate(stmt.TargetList)
+}
+
+// planScatterAggregate creates the plan of an aggregate SELECT on several
+// shards: each shard computes the aggregates of its rows, grouped like the
+// statement, and the gateway combines them. An average is computed by the
+// shards as a sum and a count.
+//
+// Only count, sum, min, max and avg, without DISTINCT, FILTER or ORDER BY,
+// are combined, and only as whole output columns. Aggregates the gateway
+// does not recognize, such as user-defined ones, are taken for values of
+// the groups. The combined rows are ordered and paginated by the gateway,
+// only by output columns. The shards are narrowed down by lookup when set.
+func (p *Planner) planScatterAggregate(sql string, stmt *ast.SelectStmt, shards []string, lookup *engine.ShardLookup) (*engine.Plan, error) {
+	if err := checkScatterAggregate(stmt); err != nil {
+		return nil, err
+	}
+
+	targets := make([]*ast.ResTarget, 0, len(stmt.TargetList.Items))
+	for _, item := range stmt.TargetList.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok {
+			return nil, unsupportedScatterAggregate("its target list")
+		}
+		targets = append(targets, target)
+	}
+
+	shardTargets := make([]ast.Node, len(targets))
+	var hidden []ast.Node
+	columns := make([]engine.AggregateColumn, len(targets))
+	for i, target := range targets {
+		shardTargets[i] = target
+		columns[i] = engine.AggregateColumn{Kind: engine.AggregateAny, Column: i}
+
+		if ref, ok := target.Val.(*ast.ColumnRef); ok && isStarRef(ref) {
+			return nil, unsupportedScatterAggregate("SELECT *")
+		}
+		call, ok := target.Val.(*ast.FuncCall)
+		if !ok || !isAggregateCall(call) {
+			if containsAggregate(target.Val) {
+				return nil, unsupportedScatterAggregate("expressions on aggregates")
+			}
+			continue
+		}
+		kind, pushed := pushedAggregates[funcName(call)]
+		if !pushed || call.AggDistinct || call.AggFilter != nil || call.AggOrder != nil || call.FuncVariadic {
+			return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+				Msg("aggregate %s on several shards is not supported", call.SqlString()).
+				Hint("Only count, sum, min, max and avg without DISTINCT, FILTER or ORDER BY are combined across shards.").
+				Err()
+		}
+		columns[i].Kind = kind
+		if kind == engine.AggregateAvg {
+			// The shards return the sum and the count of their values.
+			name := target.Name
+			if name == "" {
+				name = "avg"
+			}
+			shardTargets[i] = ast.NewResTarget(name, renamedCall(call, "sum"))
+			columns[i].CountColumn = len(targets) + len(hidden)
+			hidden = append(hidden, ast.NewResTarget("", renamedCall(call, "count")))
+		}
+	}
+
+	var groupBy []int
+	if stmt.GroupClause != nil {
+		for _, item := range stmt.GroupClause.Items {
+			column, ok := groupColumn(item, targets)
+			if !ok {
+				column = len(targets) + len(hidden)
+				hidden = append(hidden, ast.NewResTarget("", item))
+			}
+			groupBy = append(groupBy, column)
+		}
+	}
+
+	keys, err := sortKeys(stmt.SortClause, targets)
+	if err != nil {
+		return nil, err
+	}
+	var orderBy []sqltypes.OrderByColumn
+	for _, key := range keys {
+		if key.target < 0 {
+			return nil, unsupportedScatterAggregate("ORDER BY expressions missing from the target list")
+		}
+		orderBy = append(orderBy, sqltypes.OrderByColumn{Column: key.target, Desc: key.desc, NullsFirst: key.nullsFirst, Collation: key.collation})
+	}
+	count, offset, err := limitValues(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	// The shards return all their groups, unordered.
+	shardStmt := *stmt
+	shardStmt.TargetList = ast.NewNodeList(append(shardTargets, hidden...)...)
+	shardStmt.SortClause = nil
+	shardStmt.LimitCount = nil
+	shardStmt.LimitOffset = nil
+	route := p.newScatterRoute(p.defaultTableGroup, shards, shardStmt.SqlString())
+	route.Lookup = lookup
+
+	aggregate := engine.NewScatterAggregate(route, groupBy, columns)
+	aggregate.MaxMemory = p.maxMemory
+	aggregate.Collations = p.collations
+	var primitive engine.Primitive = aggregate
+	if len(orderBy) > 0 {
+		primitive = p.newSort(primitive, orderBy)
+	}
+	return engine.NewPlan(sql, withLimit(primitive, count, offset)), nil
+}
+
+// checkScatterAggregate rejects the clauses of an aggregate SELECT that
+// cannot be applied to the combined rows.
+func checkScatterAggregate(stmt *ast.SelectStmt) error {
+	switch {
+	case stmt.TargetList == nil:
+		return unsupportedScatterAggregate("an empty target list")
+	case stmt.HavingClause != nil:
+		return unsupportedScatterAggregate("HAVING")
+	case stmt.DistinctClause != nil:
+		return unsupportedScatterAggregate("DISTINCT")
+	case stmt.GroupDistinct:
+		return unsupportedScatterAggregate("GROUP BY DISTINCT")
+	}
+	if stmt.GroupClause != nil {
+		for _, item := range stmt.GroupClause.Items {
+			if _, ok := item.(*ast.GroupingSet); ok {
+				return unsupportedScatterAggregate("grouping sets")
+			}
+		}
+	}
+	return nil
+}
+
+// unsupportedScatterAggregate returns the error for an aggregate SELECT on
+// several shards using a feature the gateway cannot combine.
+func unsupportedScatterAggregate(feature string) error {
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("aggregate queries on several shards with %s are not supported", feature).
+		Hint("Restrict the query to a single shard with its shard key.").
+		Err()
+}
+
+// groupColumn returns the output column a GROUP BY item refers to: by
+// position, by output name, or by being the same expression.
+func groupColumn(item ast.Node, targets []*ast.ResTarget) (int, bool) {
+	if c, ok := item.(*ast.A_Const); ok {
+		if position, ok := c.Val.(*ast.Integer); ok && position.IVal >= 1 && position.IVal <= len(targets) {
+			return position.IVal - 1, true
+		}
+		return 0, false
+	}
+	text := item.SqlString()
+	for i, target := range targets {
+		if target.Val != nil && target.Val.SqlString() == text {
+			return i, true
+		}
+	}
+	if ref, ok := item.(*ast.ColumnRef); ok && ref.Fields != nil && len(ref.Fields.Items) == 1 {
+		if name, ok := ref.Fields.Items[0].(*ast.String); ok {
+			for i, target := range targets {
+				if target.Name != "" && target.Name == name.SVal {
+					return i, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// containsAggregate returns true if node calls an aggregate outside of
+// subqueries.
+func containsAggregate(node ast.Node) bool {
+	found := false
+	ast.Rewrite(node, func(cursor *ast.Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *ast.SubLink:
+			return false
+		case *ast.FuncCall:
+			if isAggregateCall(n) {
+				found = true
+			}
+		}
+		return !found
+	}, nil)
+	return found
+}
+
+// isAggregateCall returns true if call is a call of a built-in aggregate,
+// or has the syntax of an aggregate call. Window functions are not
+// aggregates.
+func isAggregateCall(call *ast.FuncCall) bool {
+	if call.Over != nil {
+		return false
+	}
+	if call.AggStar || call.AggDistinct || call.AggFilter != nil || call.AggOrder != nil || call.AggWithinGroup {
+		return true
+	}
+	name := funcName(call)
+	_, pushed := pushedAggregates[name]
+	return pushed || otherAggregates[name]
+}
+
+// funcName returns the unqualified name of the function of call, in lower
+// case.
+func funcName(call *ast.FuncCall) string {
+	if call.Funcname == nil || len(call.Funcname.Items) == 0 {
+		return ""
+	}
+	name, ok := call.Funcname.Items[len(call.Funcname.Items)-1].(*ast.String)
+	if !ok {
+		return ""
+	}
+	return strings.ToLower(name.SVal)
+}
+
+// renamedCall returns a copy of call calling the function name instead.
+func renamedCall(call *ast.FuncCall, name string) *ast.FuncCall {
+	renamed := *call
+	renamed.Funcname = ast.NewNodeList(ast.NewString(name))
+	return &renamed
+}
+
+// isStarRef returns true if ref is * or table.*.
+func isStarRef(ref *ast.ColumnRef) bool {
+	if ref.Fields == nil || len(ref.Fields.Items) == 0 {
+		return false
+	}
+	_, ok := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.A_Star)
+	return ok
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanScatterAggregate(t *testing.T) {
+	tests := []struct {
+		sql        string
+		shardQuery string
+		groupBy    []int
+		columns    []engine.AggregateColumn
+	}{
+		{
+			sql:        "SELECT count(*), sum(amount) AS total FROM users",
+			shardQuery: "SELECT COUNT(*), SUM(amount) AS total FROM users",
+			columns: []engine.AggregateColumn{
+				{Kind: engine.AggregateCount, Column: 0},
+				{Kind: engine.AggregateSum, Column: 1},
+			},
+		},
+		{
+			sql:        "SELECT region, avg(amount), max(amount) FROM users GROUP BY region",
+			shardQuery: "SELECT region, SUM(amount) AS avg, MAX(amount), COUNT(amount) FROM users GROUP BY region",
+			groupBy:    []int{0},
+			columns: []engine.AggregateColumn{
+				{Kind: engine.AggregateAny, Column: 0},
+				{Kind: engine.AggregateAvg, Column: 1, CountColumn: 3},
+				{Kind: engine.AggregateMax, Column: 2},
+			},
+		},
+		{
+			sql:        "SELECT min(amount) FROM users WHERE id > 10 GROUP BY region, 1",
+			shardQuery: "SELECT MIN(amount), region FROM users WHERE id > 10 GROUP BY region, 1",
+			groupBy:    []int{1, 0},
+			columns: []engine.AggregateColumn{
+				{Kind: engine.AggregateMin, Column: 0},
+			},
+		},
+		{
+			sql:        "SELECT lower(region) AS r FROM users GROUP BY r",
+			shardQuery: "SELECT lower(region) AS r FROM users GROUP BY r",
+			groupBy:    []int{0},
+			columns: []engine.AggregateColumn{
+				{Kind: engine.AggregateAny, Column: 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			aggregate, ok := plan.Primitive.(*engine.ScatterAggregate)
+			require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+			route, ok := aggregate.Input.(*engine.ScatterRoute)
+			require.True(t, ok)
+			assert.Equal(t, []string{"-80", "80-"}, route.Shards)
+			assert.Equal(t, tt.shardQuery, route.Query)
+			assert.Equal(t, tt.groupBy, aggregate.GroupBy)
+			assert.Equal(t, tt.columns, aggregate.Columns)
+		})
+	}
+}
+
+func TestPlanScatterAggregateOrderByLimit(t *testing.T) {
+	plan, err := planSharded(t, "SELECT region, count(*) AS n FROM users GROUP BY region ORDER BY n DESC, 1 LIMIT 3 OFFSET 1")
+	require.NoError(t, err)
+	limit, ok := plan.Primitive.(*engine.Limit)
+	require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+	assert.Equal(t, int64(3), limit.Count)
+	assert.Equal(t, int64(1), limit.Offset)
+	sort, ok := limit.Input.(*engine.Sort)
+	require.True(t, ok)
+	assert.Equal(t, []sqltypes.OrderByColumn{{Column: 1, Desc: true, NullsFirst: true}, {Column: 0}}, sort.OrderBy)
+	aggregate, ok := sort.Input.(*engine.ScatterAggregate)
+	require.True(t, ok)
+	// The shards return all their groups.
+	assert.Equal(t, "SELECT region, COUNT(*) AS n FROM users GROUP BY region", aggregate.Input.GetQuery())
+}
+
+func TestPlanScatterAggregateSingleShard(t *testing.T) {
+	// Aggregates on a single shard run there as is.
+	plan, err := planSharded(t, "SELECT count(*) FROM users WHERE id = 2 HAVING count(*) > 1")
+	require.NoError(t, err)
+	_, ok := plan.Primitive.(*engine.Route)
+	assert.True(t, ok)
+}
+
+func TestPlanScatterAggregateUnsupported(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT count(DISTINCT region) FROM users",
+		"SELECT string_agg(region, ',') FROM users",
+		"SELECT sum(amount) + 1 FROM users",
+		"SELECT region, count(*) FROM users GROUP BY region HAVING count(*) > 1",
+		"SELECT region FROM users GROUP BY region ORDER BY count(*)",
+		"SELECT region, count(*) FROM users GROUP BY region LIMIT $1",
+		"SELECT * FROM users GROUP BY id",
+		"SELECT region, count(*) FROM users GROUP BY ROLLUP (region)",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planSharded(t, sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
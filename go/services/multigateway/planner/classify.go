@@ -0,0 +1,82 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// Classify returns the kind of work stmt does.
+//
+// A SELECT is a read unless it creates a table (SELECT INTO) or locks rows
+// (FOR UPDATE/SHARE), which require the primary. Data-modifying statements
+// nested in a WITH clause of a SELECT, or hidden in the functions it calls,
+// are not seen.
+func Classify(stmt ast.Stmt) engine.StatementClass {
+	switch s := stmt.(type) {
+	case *ast.SelectStmt:
+		return classifySelect(s)
+	case *ast.CopyStmt:
+		if s.IsFrom {
+			return engine.StatementWrite
+		}
+		return engine.StatementRead
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.MergeStmt,
+		*ast.TruncateStmt, *ast.RefreshMatViewStmt:
+		return engine.StatementWrite
+	}
+
+	switch stmt.NodeTag() {
+	case ast.T_CreateStmt, ast.T_CreateTableAsStmt, ast.T_CreateSchemaStmt, ast.T_CreateSeqStmt,
+		ast.T_CreateDomainStmt, ast.T_CreateEnumStmt, ast.T_CreateRangeStmt, ast.T_CompositeTypeStmt,
+		ast.T_CreateFunctionStmt, ast.T_CreateTriggerStmt, ast.T_CreateEventTrigStmt, ast.T_CreatePolicyStmt,
+		ast.T_CreateExtensionStmt, ast.T_CreateStatsStmt, ast.T_CreateCastStmt, ast.T_CreateConversionStmt,
+		ast.T_CreateTransformStmt, ast.T_CreatePLangStmt, ast.T_CreateOpClassStmt, ast.T_CreateOpFamilyStmt,
+		ast.T_CreateAmStmt, ast.T_CreateForeignServerStmt, ast.T_CreateForeignTableStmt, ast.T_CreateFdwStmt,
+		ast.T_CreateUserMappingStmt, ast.T_CreatePublicationStmt, ast.T_CreateSubscriptionStmt,
+		ast.T_CreateTableSpaceStmt, ast.T_CreatedbStmt, ast.T_CreateRoleStmt, ast.T_CreateAssertionStmt,
+		ast.T_IndexStmt, ast.T_ViewStmt, ast.T_DefineStmt, ast.T_RuleStmt,
+		ast.T_AlterStmt, ast.T_AlterTableStmt, ast.T_AlterTableMoveAllStmt, ast.T_AlterDomainStmt,
+		ast.T_AlterSeqStmt, ast.T_AlterEnumStmt, ast.T_AlterTypeStmt, ast.T_AlterCompositeTypeStmt,
+		ast.T_AlterFunctionStmt, ast.T_AlterExtensionStmt, ast.T_AlterExtensionContentsStmt,
+		ast.T_AlterEventTrigStmt, ast.T_AlterPolicyStmt, ast.T_AlterStatsStmt, ast.T_AlterOpFamilyStmt,
+		ast.T_AlterOperatorStmt, ast.T_AlterCollationStmt, ast.T_AlterFdwStmt, ast.T_AlterForeignServerStmt,
+		ast.T_AlterUserMappingStmt, ast.T_AlterPublicationStmt, ast.T_AlterSubscriptionStmt,
+		ast.T_AlterTableSpaceStmt, ast.T_AlterDatabaseStmt, ast.T_AlterDatabaseSetStmt,
+		ast.T_AlterDatabaseRefreshCollStmt, ast.T_AlterRoleStmt, ast.T_AlterRoleSetStmt,
+		ast.T_AlterDefaultPrivilegesStmt, ast.T_AlterOwnerStmt, ast.T_AlterObjectSchemaStmt,
+		ast.T_AlterObjectDependsStmt, ast.T_AlterTSConfigurationStmt, ast.T_AlterTSDictionaryStmt,
+		ast.T_ReplicaIdentityStmt, ast.T_RenameStmt, ast.T_CommentStmt, ast.T_SecLabelStmt,
+		ast.T_DropStmt, ast.T_DropOwnedStmt, ast.T_DropRoleStmt, ast.T_DropTableSpaceStmt,
+		ast.T_DropUserMappingStmt, ast.T_DropdbStmt, ast.T_ReassignOwnedStmt,
+		ast.T_GrantStmt, ast.T_GrantRoleStmt, ast.T_ImportForeignSchemaStmt:
+		return engine.StatementDDL
+	}
+	return engine.StatementUtility
+}
+
+// classifySelect classifies a SELECT, including set operations.
+func classifySelect(stmt *ast.SelectStmt) engine.StatementClass {
+	if stmt.IntoClause != nil || (stmt.LockingClause != nil && len(stmt.LockingClause.Items) > 0) {
+		return engine.StatementWrite
+	}
+	for _, arg := range []*ast.SelectStmt{stmt.Larg, stmt.Rarg} {
+		if arg != nil && classifySelect(arg) == engine.StatementWrite {
+			return engine.StatementWrite
+		}
+	}
+	return engine.StatementRead
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		sql   string
+		class engine.StatementClass
+	}{
+		{"SELECT * FROM t", engine.StatementRead},
+		{"SELECT 1 UNION SELECT 2", engine.StatementRead},
+		{"VALUES (1)", engine.StatementRead},
+		{"COPY t TO STDOUT", engine.StatementRead},
+		{"SELECT * FROM t FOR UPDATE", engine.StatementWrite},
+		{"SELECT * INTO t2 FROM t", engine.StatementWrite},
+		{"INSERT INTO t VALUES (1)", engine.StatementWrite},
+		{"UPDATE t SET a = 1", engine.StatementWrite},
+		{"DELETE FROM t", engine.StatementWrite},
+		{"TRUNCATE t", engine.StatementWrite},
+		{"COPY t FROM STDIN", engine.StatementWrite},
+		{"CREATE TABLE t (a int)", engine.StatementDDL},
+		{"ALTER TABLE t ADD COLUMN b int", engine.StatementDDL},
+		{"DROP TABLE t", engine.StatementDDL},
+		{"CREATE INDEX i ON t (a)", engine.StatementDDL},
+		{"GRANT SELECT ON t TO u", engine.StatementDDL},
+		{"SET search_path = app", engine.StatementUtility},
+		{"BEGIN", engine.StatementUtility},
+		{"VACUUM t", engine.StatementUtility},
+		{"EXPLAIN SELECT 1", engine.StatementUtility},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmts, err := parser.ParseSQL(tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.class, Classify(stmts[0]))
+		})
+	}
+}
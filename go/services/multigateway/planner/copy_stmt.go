@@ -15,29 +15,36 @@
 package planner
 
 import (
-	"errors"
-
 	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	"github.com/multigres/multigres/go/services/multigateway/engine"
 )
 
 // planCopyStmt plans COPY commands.
-// Supports COPY FROM STDIN (streaming), COPY FROM/TO file (pass-through).
-// Rejects COPY FROM/TO PROGRAM for security. COPY TO STDOUT not yet supported.
+// Supports COPY FROM STDIN and COPY TO STDOUT (streaming), COPY FROM/TO file
+// (pass-through). Rejects COPY FROM/TO PROGRAM for security.
 func (p *Planner) planCopyStmt(
 	sql string,
 	stmt *ast.CopyStmt,
 ) (*engine.Plan, error) {
 	// SECURITY: Reject COPY FROM/TO PROGRAM (arbitrary command execution)
 	if stmt.IsProgram {
-		return nil, errors.New("COPY with PROGRAM not supported for security reasons")
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("COPY with PROGRAM not supported for security reasons").
+			Err()
 	}
 
 	// Decision tree based on IsFrom and Filename
 	if stmt.IsFrom {
 		// COPY FROM ...
 		if stmt.Filename == "" {
-			// COPY FROM STDIN - requires CopyStatement primitive (streaming)
+			// COPY FROM STDIN - requires CopyStatement primitive (streaming),
+			// or ShardedCopy splitting the rows of a sharded table by shard key
+			if p.shardingSchema != nil && stmt.Relation != nil {
+				if _, ok := p.shardingSchema.Tables[stmt.Relation.RelName]; ok {
+					return p.planShardedCopy(sql, stmt)
+				}
+			}
 			p.logger.Debug("planning COPY FROM STDIN command",
 				"query", sql,
 				"tablegroup", p.defaultTableGroup)
@@ -64,8 +71,15 @@ func (p *Planner) planCopyStmt(
 	} else {
 		// COPY TO ...
 		if stmt.Filename == "" {
-			// COPY TO STDOUT - not yet supported
-			return nil, errors.New("COPY TO STDOUT not yet supported")
+			// COPY TO STDOUT - requires CopyStatement primitive (streaming)
+			p.logger.Debug("planning COPY TO STDOUT command",
+				"query", sql,
+				"tablegroup", p.defaultTableGroup)
+
+			copyPrimitive := engine.NewCopyStatement(p.defaultTableGroup, sql, stmt)
+			plan := engine.NewPlan(sql, copyPrimitive)
+			p.logger.Debug("created COPY TO STDOUT plan", "plan", plan.String())
+			return plan, nil
 		} else {
 			// COPY TO file - simple Route (PostgreSQL writes server-side file)
 			// TODO(multigateway): Future enhancement - similar to FROM file,
@@ -83,3 +97,33 @@ func (p *Planner) planCopyStmt(
 		}
 	}
 }
+
+// planShardedCopy plans a COPY FROM STDIN into a sharded table, sending each
+// row to the shard of its shard key. The COPY must list the columns of the
+// rows, so that the shard key is found among them.
+func (p *Planner) planShardedCopy(sql string, stmt *ast.CopyStmt) (*engine.Plan, error) {
+	table := stmt.Relation.RelName
+	column := p.shardingSchema.Tables[table]
+	keyIndex := -1
+	if stmt.Attlist != nil {
+		for i, item := range stmt.Attlist.Items {
+			if name, ok := item.(*ast.String); ok && name.SVal == column {
+				keyIndex = i
+				break
+			}
+		}
+	}
+	if keyIndex < 0 {
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("COPY into sharded table %q must list its shard key column %q", table, column).
+			Err()
+	}
+
+	copyPrimitive, err := engine.NewShardedCopy(p.defaultTableGroup, sql, stmt, p.shardingSchema.Shards, keyIndex, p.router(table))
+	if err != nil {
+		return nil, err
+	}
+	plan := engine.NewPlan(sql, copyPrimitive)
+	p.logger.Debug("created sharded COPY FROM STDIN plan", "plan", plan.String())
+	return plan, nil
+}
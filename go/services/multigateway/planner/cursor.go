@@ -0,0 +1,31 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planCursor plans statements that declare or close SQL cursors, and those
+// ending a transaction, which close the cursors that are not WITH HOLD.
+// They are routed like any other statement, but the session is pinned to its
+// reserved connection while it has open cursors. FETCH and MOVE need no
+// plan of their own: they follow the pin.
+func (p *Planner) planCursor(sql string, usage engine.CursorUsage) (*engine.Plan, error) {
+	route := engine.NewCursorRoute(p.defaultTableGroup, "", sql, usage)
+	plan := engine.NewPlan(sql, route)
+	p.logger.Debug("created cursor plan", "plan", plan.String())
+	return plan, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planDDL creates the plan of a schema change of sharded or reference
+// tables, such as an ALTER TABLE, a CREATE INDEX or a DROP TABLE, as a
+// DDLFanout applying it on every shard so that their schemas converge. It
+// returns nil for other statements, such as those of unsharded tables,
+// which run on the first shard, and those creating temporary relations,
+// which only exist in the session.
+func (p *Planner) planDDL(sql string, stmt ast.Stmt) *engine.Plan {
+	if p.shardingSchema == nil || Classify(stmt) != engine.StatementDDL {
+		return nil
+	}
+	if len(engine.DetectTempTables(stmt).Creates) > 0 || !p.changesDistributedTable(stmt) {
+		return nil
+	}
+	policy := p.ddlFailurePolicy
+	if policy == "" {
+		policy = engine.DDLFailureStop
+	}
+	return engine.NewPlan(sql, engine.NewDDLFanout(p.defaultTableGroup, p.shardingSchema.Shards, sql, policy, p.ddlJournal))
+}
+
+// changesDistributedTable returns true if a schema change names a sharded
+// or reference table, the tables existing on every shard.
+func (p *Planner) changesDistributedTable(stmt ast.Stmt) bool {
+	if drop, ok := stmt.(*ast.DropStmt); ok {
+		return p.dropsDistributedTable(drop)
+	}
+	found := false
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		if rel, ok := cursor.Node().(*ast.RangeVar); ok && p.isDistributedTable(rel.RelName) {
+			found = true
+		}
+		return !found
+	}, nil)
+	return found
+}
+
+// dropsDistributedTable returns true if a DROP TABLE drops a sharded or
+// reference table.
+func (p *Planner) dropsDistributedTable(drop *ast.DropStmt) bool {
+	if drop.RemoveType != ast.OBJECT_TABLE || drop.Objects == nil {
+		return false
+	}
+	for _, item := range drop.Objects.Items {
+		names, ok := item.(*ast.NodeList)
+		if !ok || len(names.Items) == 0 {
+			continue
+		}
+		// The last name is the table's, possibly qualified by its schema.
+		if name, ok := names.Items[len(names.Items)-1].(*ast.String); ok && p.isDistributedTable(name.SVal) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDistributedTable returns true if name is a sharded or a reference
+// table of the sharding schema.
+func (p *Planner) isDistributedTable(name string) bool {
+	_, sharded := p.shardingSchema.Tables[name]
+	return sharded || p.shardingSchema.ReferenceTables[name]
+}
+
+// TableShards returns the shards holding the table name: every shard for a
+// sharded or reference table, the shard of the unsharded tables otherwise.
+func (p *Planner) TableShards(name string) []string {
+	schema := p.shardingSchema
+	switch {
+	case schema == nil:
+		return []string{""}
+	case p.isDistributedTable(name):
+		return schema.Shards
+	default:
+		return schema.Shards[:1]
+	}
+}
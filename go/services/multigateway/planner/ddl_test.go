@@ -0,0 +1,72 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanDDL(t *testing.T) {
+	tests := []struct {
+		sql  string
+		plan string
+	}{
+		// Schema changes of sharded and reference tables run on every shard.
+		{"ALTER TABLE users ADD COLUMN b int", "DDLFanout(tablegroup=tg, shards=-80,80-, policy=stop, query=ALTER TABLE users ADD COLUMN b int)"},
+		{"CREATE INDEX users_b ON users (b)", "DDLFanout(tablegroup=tg, shards=-80,80-, policy=stop, query=CREATE INDEX users_b ON users (b))"},
+		{"CREATE TABLE users (id int PRIMARY KEY)", "DDLFanout(tablegroup=tg, shards=-80,80-, policy=stop, query=CREATE TABLE users (id int PRIMARY KEY))"},
+		{"ALTER TABLE countries ADD COLUMN b int", "DDLFanout(tablegroup=tg, shards=-80,80-, policy=stop, query=ALTER TABLE countries ADD COLUMN b int)"},
+		{"DROP TABLE public.orders", "DDLFanout(tablegroup=tg, shards=-80,80-, policy=stop, query=DROP TABLE public.orders)"},
+		{"DROP TABLE notes, users", "DDLFanout(tablegroup=tg, shards=-80,80-, policy=stop, query=DROP TABLE notes, users)"},
+		// Those of unsharded tables run on the first shard.
+		{"ALTER TABLE notes ADD COLUMN b int", "Route(tablegroup=tg, shard=-80, query=ALTER TABLE notes ADD COLUMN b int)"},
+		{"CREATE TEMP TABLE users (id int)", "TempTableRoute(tablegroup=tg, creates=[users], drops=[], discards=false, query=CREATE TEMP TABLE users (id int))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plan, plan.Primitive.String())
+			assert.Equal(t, engine.StatementDDL, plan.Class)
+		})
+	}
+}
+
+func TestPlanDDLJournal(t *testing.T) {
+	p := shardedPlanner()
+	journal := engine.NewDDLJournal(nil, "tg", "")
+	p.SetDDLJournal(journal, engine.DDLFailureContinue)
+	plan, err := planWith(t, p, "ALTER TABLE users ADD COLUMN b int")
+	require.NoError(t, err)
+	fanout, ok := plan.Primitive.(*engine.DDLFanout)
+	require.True(t, ok)
+	assert.Equal(t, engine.DDLFailureContinue, fanout.Policy)
+	assert.Same(t, journal, fanout.Journal)
+}
+
+func TestTableShards(t *testing.T) {
+	p := shardedPlanner()
+	assert.Equal(t, []string{"-80", "80-"}, p.TableShards("users"))
+	assert.Equal(t, []string{"-80", "80-"}, p.TableShards("countries"))
+	assert.Equal(t, []string{"-80"}, p.TableShards("notes"))
+
+	assert.Equal(t, []string{""}, NewPlanner("tg", slog.Default()).TableShards("users"))
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"slices"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// applyDirectives applies the directives of a statement to its plan: the
+// type of pooler it reads from, and whether it may run on several shards.
+// The shard and cache directives choose the plan itself (see planOnShard
+// and resultCacheTTLOf), and the timeout directive is applied by the
+// handler.
+func applyDirectives(directives handler.QueryDirectives, plan *engine.Plan) error {
+	if directives.Target != clustermetadatapb.PoolerType_UNKNOWN {
+		plan.PoolerType = directives.Target
+	}
+	if directives.NoScatter {
+		if shards := plan.ShardCount(); shards > 1 {
+			return sqlstate.NewError(sqlstate.FeatureNotSupported).
+				Msg("statement would run on %d shards, but its directives do not allow it to scatter", shards).
+				Hint("Filter the statement by its shard key, or give the shard directive.").
+				Err()
+		}
+	}
+	return nil
+}
+
+// planOnShard plans a statement giving the shard directive, which runs on
+// that shard of the default tablegroup as is.
+func (p *Planner) planOnShard(sql, shard string) (*engine.Plan, error) {
+	if p.shardingSchema == nil || !slices.Contains(p.shardingSchema.Shards, shard) {
+		return nil, sqlstate.NewError(sqlstate.InvalidParameterValue).
+			Msg("shard \"%s\" of the shard directive is not a shard of tablegroup %s", shard, p.defaultTableGroup).
+			Err()
+	}
+	return engine.NewPlan(sql, engine.NewRoute(p.defaultTableGroup, shard, sql)), nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanDirectives(t *testing.T) {
+	tests := []struct {
+		sql        string
+		plan       string
+		poolerType clustermetadatapb.PoolerType
+	}{
+		{
+			"SELECT /*+ multigres: shard=80- */ * FROM users",
+			"Route(tablegroup=tg, shard=80-, query=SELECT /*+ multigres: shard=80- */ * FROM users)",
+			clustermetadatapb.PoolerType_UNKNOWN,
+		},
+		{
+			"SELECT /*+ multigres: scatter=off target=replica */ * FROM users WHERE id = 4",
+			"Route(tablegroup=tg, shard=-80, query=SELECT /*+ multigres: scatter=off target=replica */ * FROM users WHERE id = 4)",
+			clustermetadatapb.PoolerType_REPLICA,
+		},
+		{
+			"/*+ multigres: target=primary */ SELECT * FROM users",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, query=/*+ multigres: target=primary */ SELECT * FROM users)",
+			clustermetadatapb.PoolerType_PRIMARY,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plan, plan.Primitive.String())
+			assert.Equal(t, tt.poolerType, plan.PoolerType)
+		})
+	}
+}
+
+func TestPlanDirectivesErrors(t *testing.T) {
+	tests := []struct {
+		sql  string
+		code string
+	}{
+		{"SELECT /*+ multigres: scatter=off */ * FROM users", sqlstate.FeatureNotSupported},
+		{"SELECT /*+ multigres: scatter=off */ count(*) FROM users", sqlstate.FeatureNotSupported},
+		{"SELECT /*+ multigres: scatter=off */ * FROM users WHERE id = 4 UNION ALL SELECT * FROM users WHERE id = 3", sqlstate.FeatureNotSupported},
+		{"SELECT /*+ multigres: shard=c0- */ * FROM users", sqlstate.InvalidParameterValue},
+		{"SELECT /*+ multigres: target=nearest */ 1", sqlstate.InvalidParameterValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			_, err := planSharded(t, tt.sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, tt.code, diag.Code)
+		})
+	}
+}
+
+func TestPlanDirectivesBypassPlanCache(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	p.SetPlanCache(NewPlanCache(10))
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	planAndCache(t, p, "SELECT /*+ multigres: target=replica */ * FROM t WHERE a = 1")
+	_, _, ok := p.CachedPlan("SELECT * FROM t WHERE a = 2", conn)
+	assert.False(t, ok)
+
+	planAndCache(t, p, "SELECT * FROM t WHERE a = 1")
+	_, _, ok = p.CachedPlan("SELECT /*+ multigres: target=replica */ * FROM t WHERE a = 2", conn)
+	assert.False(t, ok)
+}
+
+func TestPlanCacheDirective(t *testing.T) {
+	p := newResultCachePlanner(nil)
+
+	plan := planAndCache(t, p, "SELECT /*+ multigres: cache */ * FROM t")
+	require.IsType(t, &engine.CachedRead{}, plan.Primitive)
+	assert.Equal(t, 10*time.Second, plan.Primitive.(*engine.CachedRead).TTL)
+
+	plan = planAndCache(t, p, "SELECT /*+ multigres: cache=2min */ * FROM t")
+	require.IsType(t, &engine.CachedRead{}, plan.Primitive)
+	assert.Equal(t, 2*time.Minute, plan.Primitive.(*engine.CachedRead).TTL)
+}
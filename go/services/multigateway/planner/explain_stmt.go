@@ -0,0 +1,110 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// explainOption is the EXPLAIN option asking for the plan of the gateway
+// instead of PostgreSQL's.
+const explainOption = "multigres"
+
+// planExplainStmt plans EXPLAIN. EXPLAIN (MULTIGRES) is answered by the
+// gateway with the plan it makes for the statement: the shards it runs on,
+// the SQL sent to them and how their rows are combined. Other EXPLAINs are
+// passed through to PostgreSQL.
+func (p *Planner) planExplainStmt(sql string, stmt *ast.ExplainStmt, conn *server.Conn) (*engine.Plan, error) {
+	multigres, err := explainsGatewayPlan(stmt)
+	if err != nil {
+		return nil, err
+	}
+	explained, ok := stmt.Query.(ast.Stmt)
+	if !multigres || !ok {
+		return p.planDefault(sql, stmt, conn)
+	}
+
+	inner, err := p.planStmt(explained.SqlString(), explained, conn)
+	if err != nil {
+		return nil, err
+	}
+	inner.Class = Classify(explained)
+	// The hint opting in to the result cache is a comment of sql.
+	if inner, err = p.cacheResults(sql, explained, inner); err != nil {
+		return nil, err
+	}
+	plan := engine.NewPlan(sql, engine.NewExplain(p.defaultTableGroup, sql, inner))
+	p.logger.Debug("created EXPLAIN plan", "plan", plan.String())
+	return plan, nil
+}
+
+// explainsGatewayPlan returns true if EXPLAIN is given the MULTIGRES
+// option, which cannot be combined with the options of PostgreSQL.
+func explainsGatewayPlan(stmt *ast.ExplainStmt) (bool, error) {
+	if stmt.Options == nil {
+		return false, nil
+	}
+	multigres, others := false, false
+	for _, item := range stmt.Options.Items {
+		option, ok := item.(*ast.DefElem)
+		if !ok {
+			continue
+		}
+		if !strings.EqualFold(option.Defname, explainOption) {
+			others = true
+			continue
+		}
+		value, ok := booleanOption(option.Arg)
+		if !ok {
+			return false, sqlstate.NewError(sqlstate.InvalidParameterValue).
+				Msg("EXPLAIN option MULTIGRES requires a Boolean value").
+				Err()
+		}
+		multigres = value
+	}
+	if multigres && others {
+		return false, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("EXPLAIN option MULTIGRES cannot be combined with other options").
+			Hint("Run EXPLAIN without MULTIGRES for the plans of the shards.").
+			Err()
+	}
+	return multigres, nil
+}
+
+// booleanOption returns the value of a Boolean option, true when omitted,
+// spelled like PostgreSQL accepts them.
+func booleanOption(arg ast.Node) (bool, bool) {
+	switch arg := arg.(type) {
+	case nil:
+		return true, true
+	case *ast.Boolean:
+		return arg.BoolVal, true
+	case *ast.Integer:
+		return arg.IVal != 0, arg.IVal == 0 || arg.IVal == 1
+	case *ast.String:
+		switch strings.ToLower(arg.SVal) {
+		case "true", "on", "yes":
+			return true, true
+		case "false", "off", "no":
+			return false, true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanExplainMultigres(t *testing.T) {
+	tests := []struct {
+		sql   string
+		plan  string
+		class engine.StatementClass
+	}{
+		{
+			"EXPLAIN (MULTIGRES) SELECT * FROM users WHERE id = 4",
+			"Route(tablegroup=tg, shard=-80, query=SELECT * FROM users WHERE id = 4)",
+			engine.StatementRead,
+		},
+		{
+			"EXPLAIN (multigres on) SELECT * FROM users ORDER BY id LIMIT 2",
+			"Limit(count=2, offset=0, input=ScatterRoute(tablegroup=tg, shards=-80,80-, order_by=0, query=SELECT id, * FROM users ORDER BY id LIMIT 2))",
+			engine.StatementRead,
+		},
+		{
+			"EXPLAIN (MULTIGRES true) DELETE FROM users",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, query=DELETE FROM users)",
+			engine.StatementWrite,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			explain, ok := plan.Primitive.(*engine.Explain)
+			require.True(t, ok, "got %s", plan.Primitive)
+			assert.Equal(t, engine.StatementUtility, plan.Class)
+			assert.Equal(t, tt.plan, explain.Plan.Primitive.String())
+			assert.Equal(t, tt.class, explain.Plan.Class)
+		})
+	}
+}
+
+func TestPlanExplainPassThrough(t *testing.T) {
+	for _, sql := range []string{
+		"EXPLAIN SELECT * FROM users WHERE id = 4",
+		"EXPLAIN (ANALYZE) SELECT * FROM users WHERE id = 4",
+		"EXPLAIN (MULTIGRES off) SELECT * FROM users WHERE id = 4",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			plan, err := planWith(t, NewPlanner("tg", slog.Default()), sql)
+			require.NoError(t, err)
+			assert.Equal(t, "Route(tablegroup=tg, query="+sql+")", plan.Primitive.String())
+		})
+	}
+}
+
+func TestPlanExplainInvalidOptions(t *testing.T) {
+	tests := []struct {
+		sql  string
+		code string
+	}{
+		{"EXPLAIN (MULTIGRES, ANALYZE) SELECT 1", sqlstate.FeatureNotSupported},
+		{"EXPLAIN (MULTIGRES maybe) SELECT 1", sqlstate.InvalidParameterValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			_, err := planSharded(t, tt.sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, tt.code, diag.Code)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"slices"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planShardedInsert creates the plan of an INSERT into a sharded table as a
+// ShardedInsert, when the gateway must process its rows: when some of them
+// leave out a column generated by a global sequence, or set it to DEFAULT
+// or to the nextval() of the sequence, and when the table has lookup
+// indexes, whose entries are added with the rows. It returns nil for the
+// other INSERTs, and for those insertShards rejects.
+func (p *Planner) planShardedInsert(sql string, stmt *ast.InsertStmt) (*engine.Plan, error) {
+	schema := p.shardingSchema
+	table := stmt.Relation.RelName
+	sequence, hasSequence := schema.Sequences[table]
+	hasSequence = hasSequence && p.sequences != nil
+	indexes := schema.LookupIndexes[table]
+	if (!hasSequence && len(indexes) == 0) || stmt.Cols == nil {
+		return nil, nil
+	}
+	source, ok := stmt.SelectStmt.(*ast.SelectStmt)
+	if !ok || source.ValuesLists == nil {
+		return nil, nil
+	}
+	if len(indexes) > 0 && stmt.OnConflictClause != nil {
+		return nil, unsupportedLookupWrite(table, "INSERT with ON CONFLICT")
+	}
+
+	insert := *stmt
+	rows := source.ValuesLists.Items
+	generated := make([]bool, len(rows))
+	column := -1
+	if hasSequence {
+		column = insertColumn(stmt.Cols, sequence.Column)
+		if column < 0 {
+			// The column is added to the rows, with the values generated.
+			column = len(stmt.Cols.Items)
+			insert.Cols = ast.NewNodeList(append(slices.Clone(stmt.Cols.Items), ast.NewResTarget(sequence.Column, nil))...)
+			withColumn := make([]ast.Node, len(rows))
+			for i, item := range rows {
+				row, ok := item.(*ast.NodeList)
+				if !ok {
+					return nil, nil
+				}
+				withColumn[i] = ast.NewNodeList(append(slices.Clone(row.Items), ast.NewSetToDefault(0, -1, 0))...)
+				generated[i] = true
+			}
+			values := *source
+			values.ValuesLists = ast.NewNodeList(withColumn...)
+			insert.SelectStmt = &values
+			rows = withColumn
+		} else {
+			for i, item := range rows {
+				if row, ok := item.(*ast.NodeList); ok && column < len(row.Items) {
+					generated[i] = isGeneratedValue(row.Items[column], sequence.Sequence)
+				}
+			}
+		}
+	}
+
+	key := insertColumn(insert.Cols, schema.Tables[table])
+	if key < 0 {
+		return nil, nil
+	}
+	var lookups []engine.LookupColumn
+	for _, index := range indexes {
+		value := insertColumn(insert.Cols, index.Column)
+		if value < 0 {
+			// The rows leave the column NULL: they have no entries.
+			continue
+		}
+		for _, item := range rows {
+			if row, ok := item.(*ast.NodeList); ok && value < len(row.Items) {
+				if _, ok := row.Items[value].(*ast.SetToDefault); ok {
+					return nil, unsupportedLookupWrite(table, "DEFAULT for indexed column \""+index.Column+"\"")
+				}
+			}
+		}
+		lookup := p.lookupColumn(table, index)
+		lookup.Value, lookup.Key = value, key
+		lookups = append(lookups, lookup)
+	}
+	if !slices.Contains(generated, true) && len(lookups) == 0 {
+		return nil, nil
+	}
+
+	rowShards := make([]string, len(rows))
+	for i, item := range rows {
+		if generated[i] && key == column {
+			continue
+		}
+		row, ok := item.(*ast.NodeList)
+		if !ok || key >= len(row.Items) {
+			return nil, nil
+		}
+		value, ok := constantValue(row.Items[key])
+		if !ok {
+			return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+				Msg("shard key %q of an INSERT must be a constant", schema.Tables[table]).
+				Err()
+		}
+		shards, err := p.shardsForValues(table, []keyValue{value})
+		if err != nil {
+			return nil, err
+		}
+		rowShards[i] = shards[0]
+	}
+
+	shardedInsert := &engine.ShardedInsert{
+		TableGroup:  p.defaultTableGroup,
+		Shards:      schema.Shards,
+		Insert:      &insert,
+		Column:      column,
+		Generated:   generated,
+		RowShards:   rowShards,
+		Router:      p.router(table),
+		Lookups:     lookups,
+		LookupShard: schema.Shards[0],
+	}
+	if column >= 0 {
+		shardedInsert.Sequences = p.sequences
+		shardedInsert.Sequence = sequence.Sequence
+	}
+	return engine.NewPlan(sql, shardedInsert), nil
+}
+
+// insertColumn returns the position of a column in the column list of an
+// INSERT, or -1.
+func insertColumn(cols *ast.NodeList, name string) int {
+	if cols == nil {
+		return -1
+	}
+	for i, item := range cols.Items {
+		if col, ok := item.(*ast.ResTarget); ok && col.Name == name {
+			return i
+		}
+	}
+	return -1
+}
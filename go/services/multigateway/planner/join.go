@@ -0,0 +1,362 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// joinSide is a table of a join across shards, with what its query reads.
+type joinSide struct {
+	rel       *ast.RangeVar
+	qualifier string
+
+	// key is the join column.
+	key *ast.ColumnRef
+
+	// quals are the conditions restricting the rows of the table alone.
+	quals []ast.Node
+
+	// columns are the columns the query returns, and index their positions
+	// by text.
+	columns []ast.Node
+	index   map[string]int
+}
+
+// newJoinSide returns the side of a join reading rel.
+func newJoinSide(rel *ast.RangeVar) *joinSide {
+	qualifier := rel.RelName
+	if rel.Alias != nil && rel.Alias.AliasName != "" {
+		qualifier = rel.Alias.AliasName
+	}
+	return &joinSide{rel: rel, qualifier: qualifier, index: make(map[string]int)}
+}
+
+// column returns the position of a column in the rows of the side, adding
+// it to the columns returned if needed.
+func (s *joinSide) column(ref *ast.ColumnRef) int {
+	text := ref.SqlString()
+	if i, ok := s.index[text]; ok {
+		return i
+	}
+	s.index[text] = len(s.columns)
+	s.columns = append(s.columns, ast.NewResTarget("", ref))
+	return len(s.columns) - 1
+}
+
+// query returns the query of the rows of the side, built from stmt, the
+// statement of the join.
+func (s *joinSide) query(stmt *ast.SelectStmt) *ast.SelectStmt {
+	query := *stmt
+	query.TargetList = ast.NewNodeList(s.columns...)
+	query.FromClause = ast.NewNodeList(s.rel)
+	query.WhereClause = nil
+	for _, qual := range s.quals {
+		if query.WhereClause == nil {
+			query.WhereClause = qual
+		} else {
+			query.WhereClause = ast.NewAndExpr(query.WhereClause, qual)
+		}
+	}
+	query.SortClause = nil
+	query.LimitCount = nil
+	query.LimitOffset = nil
+	return &query
+}
+
+// joinedTables returns the two sharded tables a SELECT joins, with an
+// explicit INNER or LEFT JOIN or in its FROM list, and the condition of an
+// explicit join.
+func (p *Planner) joinedTables(stmt *ast.SelectStmt) (left, right *ast.RangeVar, kind engine.JoinKind, on ast.Node, ok bool) {
+	if stmt.Op != ast.SETOP_NONE || stmt.FromClause == nil {
+		return nil, nil, 0, nil, false
+	}
+	items := stmt.FromClause.Items
+	switch {
+	case len(items) == 2:
+		left, _ = items[0].(*ast.RangeVar)
+		right, _ = items[1].(*ast.RangeVar)
+	case len(items) == 1:
+		join, isJoin := items[0].(*ast.JoinExpr)
+		if !isJoin || join.IsNatural || join.UsingClause != nil || join.Alias != nil {
+			return nil, nil, 0, nil, false
+		}
+		switch join.Jointype {
+		case ast.JOIN_INNER:
+			kind = engine.JoinInner
+		case ast.JOIN_LEFT:
+			kind = engine.JoinLeft
+		default:
+			return nil, nil, 0, nil, false
+		}
+		left, _ = join.Larg.(*ast.RangeVar)
+		right, _ = join.Rarg.(*ast.RangeVar)
+		on = join.Quals
+	}
+	if left == nil || right == nil {
+		return nil, nil, 0, nil, false
+	}
+	_, leftSharded := p.shardingSchema.Tables[left.RelName]
+	_, rightSharded := p.shardingSchema.Tables[right.RelName]
+	if !leftSharded || !rightSharded {
+		return nil, nil, 0, nil, false
+	}
+	return left, right, kind, on, true
+}
+
+// planJoin creates the plan of a SELECT joining two sharded tables that
+// cannot run on a single shard, as a Join: the rows of one table, the
+// outer side, are read by a query of their own, and the rows of the other,
+// the inner side, looked up by their join values. The inner side is the
+// table whose join column is its shard key if possible, so that the
+// lookups only run on the shards of the values. The ORDER BY, LIMIT and
+// OFFSET of the statement are applied by the gateway to the joined rows.
+//
+// The tables must be joined by a single equality of their columns, and
+// the other conditions each restrict a single table. The columns must be
+// qualified by their table, and the output columns must be columns of the
+// tables.
+func (p *Planner) planJoin(sql string, stmt *ast.SelectStmt) (*engine.Plan, error) {
+	left, right, kind, on, _ := p.joinedTables(stmt)
+	switch {
+	case stmt.WithClause != nil:
+		return nil, unsupportedJoin("WITH")
+	case stmt.GroupClause != nil && len(stmt.GroupClause.Items) > 0, stmt.HavingClause != nil:
+		return nil, unsupportedJoin("GROUP BY or HAVING")
+	case stmt.DistinctClause != nil:
+		return nil, unsupportedJoin("DISTINCT")
+	case stmt.WindowClause != nil && len(stmt.WindowClause.Items) > 0:
+		return nil, unsupportedJoin("WINDOW")
+	case stmt.LockingClause != nil && len(stmt.LockingClause.Items) > 0:
+		return nil, unsupportedJoin("FOR UPDATE or FOR SHARE")
+	case stmt.IntoClause != nil:
+		return nil, unsupportedJoin("INTO")
+	case containsSubLink(stmt):
+		return nil, unsupportedJoin("subqueries")
+	}
+
+	sides := joinSides{newJoinSide(left), newJoinSide(right)}
+	if sides[0].qualifier == sides[1].qualifier {
+		return nil, unsupportedJoin("a table joined with itself without an alias")
+	}
+
+	// Each condition restricts a table, or is the join condition.
+	addQual := func(term ast.Node, inOn bool) error {
+		refs, err := sides.refs(term)
+		if err != nil {
+			return err
+		}
+		switch {
+		case refs[0] && refs[1]:
+			l, r, isEq := columnEquality(term)
+			if !isEq || sides[0].key != nil || (kind == engine.JoinLeft && !inOn) {
+				return unsupportedJoin("conditions other than one equality of columns of both tables")
+			}
+			if sides.sideOf(l) == 1 {
+				l, r = r, l
+			}
+			sides[0].key, sides[1].key = l, r
+		case refs[1] || (!refs[0] && kind == engine.JoinLeft && inOn):
+			if kind == engine.JoinLeft && !inOn {
+				return unsupportedJoin("WHERE conditions on the right table of a LEFT JOIN")
+			}
+			sides[1].quals = append(sides[1].quals, term)
+		default:
+			if kind == engine.JoinLeft && inOn {
+				return unsupportedJoin("ON conditions on the left table of a LEFT JOIN")
+			}
+			sides[0].quals = append(sides[0].quals, term)
+		}
+		return nil
+	}
+	for _, term := range conjuncts(on) {
+		if err := addQual(term, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, term := range conjuncts(stmt.WhereClause) {
+		if err := addQual(term, false); err != nil {
+			return nil, err
+		}
+	}
+	if sides[0].key == nil {
+		return nil, unsupportedJoin("no equality of columns of both tables")
+	}
+
+	outer, inner := 0, 1
+	if kind == engine.JoinInner && !p.keyedBy(sides[1]) && p.keyedBy(sides[0]) {
+		outer, inner = 1, 0
+	}
+
+	targets := selectTargets(stmt)
+	columns := make([]engine.JoinColumn, len(targets))
+	for i, target := range targets {
+		ref, ok := target.Val.(*ast.ColumnRef)
+		if !ok || isStarRef(ref) {
+			return nil, unsupportedJoin("output columns other than columns of the tables")
+		}
+		side, err := sides.side(ref)
+		if err != nil {
+			return nil, err
+		}
+		name := target.Name
+		if name == "" {
+			name = ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.String).SVal
+		}
+		columns[i] = engine.JoinColumn{Inner: side == inner, Column: sides[side].column(ref), Name: name}
+	}
+	outerKey := sides[outer].column(sides[outer].key)
+	innerKeyColumn := sides[inner].column(sides[inner].key)
+
+	keys, err := sortKeys(stmt.SortClause, targets)
+	if err != nil {
+		return nil, err
+	}
+	var orderBy []sqltypes.OrderByColumn
+	for _, key := range keys {
+		if key.target < 0 {
+			return nil, unsupportedJoin("ORDER BY expressions missing from the target list")
+		}
+		orderBy = append(orderBy, sqltypes.OrderByColumn{Column: key.target, Desc: key.desc, NullsFirst: key.nullsFirst, Collation: key.collation})
+	}
+	count, offset, err := limitValues(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	outerStmt := sides[outer].query(stmt)
+	outerPlan, err := p.planSharded(outerStmt.SqlString(), outerStmt)
+	if err != nil {
+		return nil, err
+	}
+	join := &engine.Join{
+		Kind:           kind,
+		Outer:          outerPlan.Primitive,
+		OuterKey:       outerKey,
+		TableGroup:     p.defaultTableGroup,
+		InnerShards:    p.shardingSchema.Shards,
+		Inner:          sides[inner].query(stmt),
+		InnerKey:       sides[inner].key,
+		InnerKeyColumn: innerKeyColumn,
+		Columns:        columns,
+		BatchSize:      engine.DefaultJoinBatchSize,
+	}
+	if p.keyedBy(sides[inner]) {
+		join.InnerRouter = p.router(sides[inner].rel.RelName)
+	}
+
+	var primitive engine.Primitive = join
+	if len(orderBy) > 0 {
+		primitive = p.newSort(primitive, orderBy)
+	}
+	return engine.NewPlan(sql, withLimit(primitive, count, offset)), nil
+}
+
+// keyedBy returns true if a side of a join is joined by its shard key.
+func (p *Planner) keyedBy(side *joinSide) bool {
+	name, ok := columnName(side.key)
+	return ok && name == p.shardingSchema.Tables[side.rel.RelName]
+}
+
+// joinSides are the outer and inner sides of a join.
+type joinSides [2]*joinSide
+
+// side returns the side of the table a column is qualified with.
+func (s joinSides) side(ref *ast.ColumnRef) (int, error) {
+	if i := s.sideOf(ref); i >= 0 {
+		return i, nil
+	}
+	return 0, unsupportedJoin("columns not qualified with their table")
+}
+
+// sideOf returns the side of the table a column is qualified with, or -1.
+func (s joinSides) sideOf(ref *ast.ColumnRef) int {
+	if ref.Fields == nil || len(ref.Fields.Items) != 2 {
+		return -1
+	}
+	qualifier, ok := ref.Fields.Items[0].(*ast.String)
+	if !ok {
+		return -1
+	}
+	if _, ok := ref.Fields.Items[1].(*ast.String); !ok {
+		return -1
+	}
+	for i, side := range s {
+		if side.qualifier == qualifier.SVal {
+			return i
+		}
+	}
+	return -1
+}
+
+// refs returns which sides the columns of an expression belong to.
+func (s joinSides) refs(node ast.Node) ([2]bool, error) {
+	var refs [2]bool
+	var err error
+	ast.Rewrite(node, func(cursor *ast.Cursor) bool {
+		ref, ok := cursor.Node().(*ast.ColumnRef)
+		if !ok || err != nil {
+			return err == nil
+		}
+		var side int
+		side, err = s.side(ref)
+		refs[side] = true
+		return err == nil
+	}, nil)
+	return refs, err
+}
+
+// columnEquality returns the columns of a condition "column = column".
+func columnEquality(node ast.Node) (*ast.ColumnRef, *ast.ColumnRef, bool) {
+	expr, ok := node.(*ast.A_Expr)
+	if !ok || expr.Kind != ast.AEXPR_OP || operatorName(expr) != "=" {
+		return nil, nil, false
+	}
+	l, okL := expr.Lexpr.(*ast.ColumnRef)
+	r, okR := expr.Rexpr.(*ast.ColumnRef)
+	return l, r, okL && okR
+}
+
+// columnName returns the name of a column, without its qualifier.
+func columnName(ref *ast.ColumnRef) (string, bool) {
+	if ref == nil || ref.Fields == nil || len(ref.Fields.Items) == 0 {
+		return "", false
+	}
+	name, ok := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.String)
+	if !ok {
+		return "", false
+	}
+	return name.SVal, true
+}
+
+// containsSubLink returns true if node has a subquery.
+func containsSubLink(node ast.Node) bool {
+	found := false
+	ast.Rewrite(node, func(cursor *ast.Cursor) bool {
+		if _, ok := cursor.Node().(*ast.SubLink); ok {
+			found = true
+		}
+		return !found
+	}, nil)
+	return found
+}
+
+// unsupportedJoin returns the error for a join of sharded tables across
+// shards using a feature the gateway cannot apply to the joined rows.
+func unsupportedJoin(feature string) error {
+	return unsupportedScatterSelect("joins of sharded tables with " + feature)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanJoin(t *testing.T) {
+	t.Run("inner join probes the shard key", func(t *testing.T) {
+		plan, err := planSharded(t, "SELECT o.total, u.name FROM users u JOIN orders o ON o.user_id = u.id WHERE u.name = 'a' AND o.total > 10")
+		require.NoError(t, err)
+		join, ok := plan.Primitive.(*engine.Join)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, engine.JoinInner, join.Kind)
+		// The lookups of orders by their shard key run on the shards of the
+		// keys.
+		assert.Equal(t, "ScatterRoute(tablegroup=tg, shards=-80,80-, query=SELECT u.name, u.id FROM users AS u WHERE u.name = 'a')", join.Outer.String())
+		assert.Equal(t, 1, join.OuterKey)
+		assert.Equal(t, "SELECT o.total, o.user_id FROM orders AS o WHERE o.total > 10", join.Inner.SqlString())
+		assert.Equal(t, "o.user_id", join.InnerKey.SqlString())
+		assert.Equal(t, 1, join.InnerKeyColumn)
+		assert.NotNil(t, join.InnerRouter)
+		assert.Equal(t, []string{"-80", "80-"}, join.InnerShards)
+		assert.Equal(t, []engine.JoinColumn{
+			{Inner: true, Column: 0, Name: "total"},
+			{Column: 0, Name: "name"},
+		}, join.Columns)
+	})
+
+	t.Run("inner join swaps to probe the shard key", func(t *testing.T) {
+		plan, err := planSharded(t, "SELECT users.name AS n FROM users, orders WHERE users.id = orders.id AND orders.user_id = 3")
+		require.NoError(t, err)
+		join, ok := plan.Primitive.(*engine.Join)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, "Route(tablegroup=tg, shard=80-, query=SELECT orders.id FROM orders WHERE orders.user_id = 3)", join.Outer.String())
+		assert.Equal(t, "SELECT users.name, users.id FROM users", join.Inner.SqlString())
+		assert.NotNil(t, join.InnerRouter)
+		assert.Equal(t, []engine.JoinColumn{{Inner: true, Column: 0, Name: "n"}}, join.Columns)
+	})
+
+	t.Run("left join ordered and limited", func(t *testing.T) {
+		plan, err := planSharded(t, "SELECT u.id, o.id FROM users u LEFT JOIN orders o ON o.user_id = u.id AND o.total > 10 ORDER BY 2 DESC LIMIT 5")
+		require.NoError(t, err)
+		limit, ok := plan.Primitive.(*engine.Limit)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, int64(5), limit.Count)
+		sort, ok := limit.Input.(*engine.Sort)
+		require.True(t, ok)
+		assert.Equal(t, []sqltypes.OrderByColumn{{Column: 1, Desc: true, NullsFirst: true}}, sort.OrderBy)
+		join, ok := sort.Input.(*engine.Join)
+		require.True(t, ok)
+		assert.Equal(t, engine.JoinLeft, join.Kind)
+		assert.Equal(t, "SELECT u.id FROM users AS u", join.Outer.GetQuery())
+		assert.Equal(t, 0, join.OuterKey)
+		assert.Equal(t, "SELECT o.id, o.user_id FROM orders AS o WHERE o.total > 10", join.Inner.SqlString())
+	})
+
+	t.Run("join without the shard key probes every shard", func(t *testing.T) {
+		plan, err := planSharded(t, "SELECT u.name, o.total FROM users u JOIN orders o ON o.id = u.name")
+		require.NoError(t, err)
+		join, ok := plan.Primitive.(*engine.Join)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Nil(t, join.InnerRouter)
+		assert.Equal(t, "o.id", join.InnerKey.SqlString())
+	})
+}
+
+func TestPlanJoinUnsupported(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT * FROM users JOIN orders ON orders.user_id = users.id",
+		"SELECT name FROM users JOIN orders ON orders.user_id = users.id",
+		"SELECT count(*) FROM users JOIN orders ON orders.user_id = users.id",
+		"SELECT users.name FROM users JOIN orders ON orders.user_id > users.id",
+		"SELECT users.name FROM users JOIN orders ON orders.user_id = users.id AND orders.id = users.id",
+		"SELECT users.name FROM users LEFT JOIN orders ON orders.user_id = users.id AND users.name = 'a'",
+		"SELECT users.name FROM users LEFT JOIN orders ON orders.user_id = users.id WHERE orders.total > 1",
+		"SELECT DISTINCT users.name FROM users JOIN orders ON orders.user_id = users.id",
+		"SELECT users.name FROM users JOIN orders ON orders.user_id = users.id ORDER BY orders.total",
+		"SELECT users.name FROM users JOIN orders ON orders.user_id = users.id WHERE users.id IN (SELECT 1)",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planSharded(t, sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
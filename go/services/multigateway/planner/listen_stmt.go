@@ -0,0 +1,43 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planListenStmt plans LISTEN commands. LISTEN is handled by the gateway,
+// which subscribes the session to the channel's notification stream instead
+// of running the LISTEN on a pooled connection.
+func (p *Planner) planListenStmt(sql string, stmt *ast.ListenStmt) (*engine.Plan, error) {
+	listen := engine.NewListen(p.defaultTableGroup, sql, stmt.Conditionname)
+	plan := engine.NewPlan(sql, listen)
+	p.logger.Debug("created LISTEN plan", "plan", plan.String())
+	return plan, nil
+}
+
+// planUnlistenStmt plans UNLISTEN commands.
+func (p *Planner) planUnlistenStmt(sql string, stmt *ast.UnlistenStmt) (*engine.Plan, error) {
+	channel := stmt.Conditionname
+	if channel == "*" {
+		// UNLISTEN * stops listening on all channels.
+		channel = ""
+	}
+	unlisten := engine.NewUnlisten(p.defaultTableGroup, sql, channel)
+	plan := engine.NewPlan(sql, unlisten)
+	p.logger.Debug("created UNLISTEN plan", "plan", plan.String())
+	return plan, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// lookupColumn returns the description of a lookup index of a sharded
+// table for the engine.
+func (p *Planner) lookupColumn(table string, index LookupIndex) engine.LookupColumn {
+	return engine.LookupColumn{
+		Table:     index.Table,
+		Column:    index.Column,
+		KeyColumn: p.shardingSchema.Tables[table],
+	}
+}
+
+// shardLookup returns the ShardLookup finding the shards of the rows a
+// statement on a single sharded table selects by a column with a lookup
+// index: its qualification must compare the column with constants. It
+// returns nil if no lookup index applies.
+func (p *Planner) shardLookup(stmt ast.Stmt, refs []*ast.RangeVar) *engine.ShardLookup {
+	if len(refs) != 1 {
+		return nil
+	}
+	if s, ok := stmt.(*ast.SelectStmt); ok && s.Op != ast.SETOP_NONE {
+		return nil
+	}
+	targets := p.topLevelTargets(stmt)
+	if len(targets) != 1 {
+		return nil
+	}
+	target := targets[0]
+	schema := p.shardingSchema
+	for _, index := range schema.LookupIndexes[target.rel.RelName] {
+		keys, ok := keyValuesOf(target.where, target.rel, index.Column)
+		if !ok {
+			continue
+		}
+		values := make([]sqltypes.Value, len(keys))
+		for i, key := range keys {
+			if !key.isNull {
+				values[i] = sqltypes.Value(key.text)
+			}
+		}
+		lookup := p.lookupColumn(target.rel.RelName, index)
+		return engine.NewShardLookup(lookup, values, schema.Shards[0], schema.Shards, p.router(target.rel.RelName))
+	}
+	return nil
+}
+
+// planLookupDelete creates the plan of a DELETE on a sharded table with
+// lookup indexes, run by primitive: a LookupDelete reading the indexed
+// values of the rows before deleting them, to delete their entries from
+// the lookup tables.
+func (p *Planner) planLookupDelete(sql string, stmt *ast.DeleteStmt, primitive engine.Primitive) (*engine.Plan, error) {
+	table := stmt.Relation.RelName
+	switch {
+	case stmt.UsingClause != nil && len(stmt.UsingClause.Items) > 0:
+		return nil, unsupportedLookupWrite(table, "DELETE with USING")
+	case stmt.WithClause != nil:
+		return nil, unsupportedLookupWrite(table, "DELETE with WITH")
+	}
+	if _, ok := stmt.WhereClause.(*ast.CurrentOfExpr); ok {
+		return nil, unsupportedLookupWrite(table, "DELETE WHERE CURRENT OF")
+	}
+
+	qualifier := table
+	if stmt.Relation.Alias != nil && stmt.Relation.Alias.AliasName != "" {
+		qualifier = stmt.Relation.Alias.AliasName
+	}
+	indexes := p.shardingSchema.LookupIndexes[table]
+	columns := make([]string, 0, len(indexes)+1)
+	lookups := make([]engine.LookupColumn, len(indexes))
+	for i, index := range indexes {
+		columns = append(columns, qualifier+"."+index.Column)
+		lookups[i] = p.lookupColumn(table, index)
+		lookups[i].Value, lookups[i].Key = i, len(indexes)
+	}
+	columns = append(columns, qualifier+"."+p.shardingSchema.Tables[table])
+
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), stmt.Relation.SqlString())
+	if stmt.WhereClause != nil {
+		query += " WHERE " + stmt.WhereClause.SqlString()
+	}
+	query += " FOR UPDATE"
+
+	var read engine.Primitive
+	switch route := primitive.(type) {
+	case *engine.Route:
+		read = engine.NewRoute(route.TableGroup, route.Shard, query)
+	case *engine.ScatterRoute:
+		scatter := p.newScatterRoute(route.TableGroup, route.Shards, query)
+		scatter.Lookup = route.Lookup
+		read = scatter
+	default:
+		return nil, fmt.Errorf("unexpected primitive %s for a DELETE", primitive)
+	}
+	return engine.NewPlan(sql, &engine.LookupDelete{
+		TableGroup:  p.defaultTableGroup,
+		Shards:      p.shardingSchema.Shards,
+		Select:      read,
+		Delete:      primitive,
+		Lookups:     lookups,
+		LookupShard: p.shardingSchema.Shards[0],
+	}), nil
+}
+
+// checkLookupNotUpdated rejects an UPDATE setting a column of a sharded
+// table indexed by a lookup table, whose entries would no longer match the
+// rows.
+func (p *Planner) checkLookupNotUpdated(stmt *ast.UpdateStmt) error {
+	indexes := p.shardingSchema.LookupIndexes[stmt.Relation.RelName]
+	if len(indexes) == 0 || stmt.TargetList == nil {
+		return nil
+	}
+	for _, item := range stmt.TargetList.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		for _, index := range indexes {
+			if target.Name == index.Column {
+				return unsupportedLookupWrite(stmt.Relation.RelName, "UPDATE of indexed column \""+index.Column+"\"")
+			}
+		}
+	}
+	return nil
+}
+
+// unsupportedLookupWrite returns the error for a write of a sharded table
+// with lookup indexes whose entries the gateway cannot maintain.
+func unsupportedLookupWrite(table, feature string) error {
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("%s on sharded table %q with lookup indexes is not supported", feature, table).
+		Hint("Change indexed values by deleting the rows and inserting them again.").
+		Err()
+}
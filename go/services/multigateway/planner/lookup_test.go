@@ -0,0 +1,135 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// lookupPlanner returns a sharded planner whose users are indexed by email.
+func lookupPlanner() *Planner {
+	p := shardedPlanner()
+	p.shardingSchema.LookupIndexes = map[string][]LookupIndex{
+		"users": {{Column: "email", Table: "users_email_lookup"}},
+	}
+	return p
+}
+
+func TestPlanLookupReads(t *testing.T) {
+	const lookup = "lookup=SELECT id FROM users_email_lookup WHERE email IN ('a@x')"
+	tests := []struct {
+		sql  string
+		plan string
+	}{
+		{
+			"SELECT * FROM users WHERE email = 'a@x'",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, " + lookup + ", query=SELECT * FROM users WHERE email = 'a@x')",
+		},
+		{
+			"SELECT name FROM users u WHERE u.email IN ('a@x', 'b@x') ORDER BY name",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, order_by=0, lookup=SELECT id FROM users_email_lookup WHERE email IN ('a@x', 'b@x'), query=SELECT name FROM users u WHERE u.email IN ('a@x', 'b@x') ORDER BY name)",
+		},
+		{
+			"SELECT count(*) FROM users WHERE email = 'a@x'",
+			"ScatterAggregate(group_by=[], columns=count(0), input=ScatterRoute(tablegroup=tg, shards=-80,80-, " + lookup + ", query=SELECT COUNT(*) FROM users WHERE email = 'a@x'))",
+		},
+		{
+			"UPDATE users SET name = 'b' WHERE email = 'a@x'",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, " + lookup + ", query=UPDATE users SET name = 'b' WHERE email = 'a@x')",
+		},
+		// The shard key selects the shard without the lookup table.
+		{
+			"SELECT * FROM users WHERE id = 2 AND email = 'a@x'",
+			"Route(tablegroup=tg, shard=-80, query=SELECT * FROM users WHERE id = 2 AND email = 'a@x')",
+		},
+		// Other comparisons of the indexed column read every shard.
+		{
+			"SELECT * FROM users WHERE email LIKE 'a%'",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, query=SELECT * FROM users WHERE email LIKE 'a%')",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planWith(t, lookupPlanner(), tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plan, plan.Primitive.String())
+		})
+	}
+}
+
+func TestPlanLookupInsert(t *testing.T) {
+	t.Run("entries added", func(t *testing.T) {
+		plan, err := planWith(t, lookupPlanner(), "INSERT INTO users (id, email) VALUES (2, 'a@x'), (4, 'b@x')")
+		require.NoError(t, err)
+		insert, ok := plan.Primitive.(*engine.ShardedInsert)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, -1, insert.Column)
+		assert.Equal(t, []string{"-80", "-80"}, insert.RowShards)
+		assert.Equal(t, []engine.LookupColumn{{Table: "users_email_lookup", Column: "email", KeyColumn: "id", Value: 1, Key: 0}}, insert.Lookups)
+		assert.Equal(t, "-80", insert.LookupShard)
+	})
+
+	t.Run("indexed column left out", func(t *testing.T) {
+		plan, err := planWith(t, lookupPlanner(), "INSERT INTO users (id, name) VALUES (2, 'a')")
+		require.NoError(t, err)
+		assert.Equal(t, "Route(tablegroup=tg, shard=-80, query=INSERT INTO users (id, name) VALUES (2, 'a'))", plan.Primitive.String())
+	})
+}
+
+func TestPlanLookupDelete(t *testing.T) {
+	t.Run("by indexed value", func(t *testing.T) {
+		plan, err := planWith(t, lookupPlanner(), "DELETE FROM users WHERE email = 'a@x'")
+		require.NoError(t, err)
+		lookupDelete, ok := plan.Primitive.(*engine.LookupDelete)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		read, ok := lookupDelete.Select.(*engine.ScatterRoute)
+		require.True(t, ok, "unexpected primitive %s", lookupDelete.Select)
+		assert.Equal(t, "SELECT users.email, users.id FROM users WHERE email = 'a@x' FOR UPDATE", read.Query)
+		assert.NotNil(t, read.Lookup)
+		assert.Equal(t, []engine.LookupColumn{{Table: "users_email_lookup", Column: "email", KeyColumn: "id", Value: 0, Key: 1}}, lookupDelete.Lookups)
+	})
+
+	t.Run("by shard key", func(t *testing.T) {
+		plan, err := planWith(t, lookupPlanner(), "DELETE FROM users u WHERE u.id = 3 RETURNING u.name")
+		require.NoError(t, err)
+		lookupDelete, ok := plan.Primitive.(*engine.LookupDelete)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, "Route(tablegroup=tg, shard=80-, query=SELECT u.email, u.id FROM users AS u WHERE u.id = 3 FOR UPDATE)", lookupDelete.Select.String())
+		assert.Equal(t, "Route(tablegroup=tg, shard=80-, query=DELETE FROM users u WHERE u.id = 3 RETURNING u.name)", lookupDelete.Delete.String())
+	})
+}
+
+func TestPlanLookupWritesUnsupported(t *testing.T) {
+	for _, sql := range []string{
+		"UPDATE users SET email = 'b@x' WHERE id = 2",
+		"INSERT INTO users (id, email) VALUES (2, 'a@x') ON CONFLICT DO NOTHING",
+		"INSERT INTO users (id, email) VALUES (2, DEFAULT)",
+		"DELETE FROM users USING orders WHERE orders.user_id = users.id AND users.id = 2",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planWith(t, lookupPlanner(), sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// Metrics holds the OpenTelemetry metrics of the plan cache and of the
+// result cache. The hit rates are hits / (hits + misses).
+type Metrics struct {
+	meter     metric.Meter
+	hits      metric.Int64ObservableCounter
+	misses    metric.Int64ObservableCounter
+	evictions metric.Int64ObservableCounter
+	size      metric.Int64ObservableGauge
+
+	resultHits      metric.Int64ObservableCounter
+	resultMisses    metric.Int64ObservableCounter
+	resultEvictions metric.Int64ObservableCounter
+	resultSize      metric.Int64ObservableGauge
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the plan and result
+// caches. A metric that fails to initialize uses a noop implementation, and
+// the errors are returned along with the usable Metrics instance. Use
+// RegisterPlanCacheCallback() and RegisterResultCacheCallback() to report
+// a cache.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multigateway/planner"),
+	}
+
+	var errs []error
+	counter := func(name, description, unit string) metric.Int64ObservableCounter {
+		c, err := m.meter.Int64ObservableCounter(name,
+			metric.WithDescription(description),
+			metric.WithUnit(unit),
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s counter: %w", name, err))
+			return noop.Int64ObservableCounter{}
+		}
+		return c
+	}
+	m.hits = counter("multigateway.plan_cache.hits", "Number of plan cache lookups that found a plan", "{lookup}")
+	m.misses = counter("multigateway.plan_cache.misses", "Number of plan cache lookups that found no plan", "{lookup}")
+	m.evictions = counter("multigateway.plan_cache.evictions", "Number of plans evicted from the plan cache", "{plan}")
+	m.resultHits = counter("multigateway.result_cache.hits", "Number of result cache lookups that found a result", "{lookup}")
+	m.resultMisses = counter("multigateway.result_cache.misses", "Number of result cache lookups that found no result", "{lookup}")
+	m.resultEvictions = counter("multigateway.result_cache.evictions", "Number of results evicted from the result cache", "{result}")
+
+	gauge := func(name, description, unit string) metric.Int64ObservableGauge {
+		g, err := m.meter.Int64ObservableGauge(name,
+			metric.WithDescription(description),
+			metric.WithUnit(unit),
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s gauge: %w", name, err))
+			return noop.Int64ObservableGauge{}
+		}
+		return g
+	}
+	m.size = gauge("multigateway.plan_cache.size", "Current number of cached plans", "{plan}")
+	m.resultSize = gauge("multigateway.result_cache.size", "Current number of cached results", "{result}")
+
+	return m, errors.Join(errs...)
+}
+
+// RegisterPlanCacheCallback registers a callback observing the metrics of
+// cache. Returns an error if registration fails.
+func (m *Metrics) RegisterPlanCacheCallback(cache *PlanCache) error {
+	if cache == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			observer.ObserveInt64(m.hits, cache.Hits())
+			observer.ObserveInt64(m.misses, cache.Misses())
+			observer.ObserveInt64(m.evictions, cache.Evictions())
+			observer.ObserveInt64(m.size, int64(cache.Size()))
+			return nil
+		},
+		m.hits, m.misses, m.evictions, m.size,
+	)
+	return err
+}
+
+// RegisterResultCacheCallback registers a callback observing the metrics
+// of cache. Returns an error if registration fails.
+func (m *Metrics) RegisterResultCacheCallback(cache *engine.ResultCache) error {
+	if cache == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			observer.ObserveInt64(m.resultHits, cache.Hits())
+			observer.ObserveInt64(m.resultMisses, cache.Misses())
+			observer.ObserveInt64(m.resultEvictions, cache.Evictions())
+			observer.ObserveInt64(m.resultSize, int64(cache.Size()))
+			return nil
+		},
+		m.resultHits, m.resultMisses, m.resultEvictions, m.resultSize,
+	)
+	return err
+}
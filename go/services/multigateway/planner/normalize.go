@@ -0,0 +1,68 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser"
+)
+
+// constantPlaceholder replaces the constants of a normalized statement.
+const constantPlaceholder = "?"
+
+// NormalizeSQL returns the text of sql with its constants replaced by a
+// placeholder, keywords and unquoted identifiers folded to lower case, and
+// comments and whitespace dropped, so that statements differing only in
+// their constants or layout have the same normalized text. It only scans
+// sql, which is much cheaper than parsing it. It returns "" for sql without
+// tokens or that cannot be scanned.
+func NormalizeSQL(sql string) string {
+	lexer := parser.NewLexer(sql)
+	var b strings.Builder
+	for {
+		token := lexer.NextToken()
+		switch token.Type {
+		case parser.EOF:
+			return b.String()
+		case parser.INVALID:
+			return ""
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		switch {
+		case token.IsStringLiteral(), token.IsNumericLiteral(), token.IsBitStringLiteral():
+			b.WriteString(constantPlaceholder)
+		case strings.ContainsRune(token.Text, '"'):
+			// Quoted identifiers are case sensitive.
+			b.WriteString(token.Text)
+		default:
+			b.WriteString(foldASCII(token.Text))
+		}
+	}
+}
+
+// foldASCII folds the ASCII letters of s to lower case, as PostgreSQL
+// does for unquoted identifiers in multibyte encodings.
+func foldASCII(s string) string {
+	return strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, s)
+}
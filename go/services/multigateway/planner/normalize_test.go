@@ -0,0 +1,42 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{"SELECT * FROM users WHERE id = 42", "select * from users where id = ?"},
+		{"select *\n  from Users -- comment\n where ID = 'x''y'", "select * from users where id = ?"},
+		{"SELECT 1.5, -2, E'a\\'b', $$d$$, B'101', X'1f' /* c */", "select ? , - ? , ? , ? , ? , ?"},
+		{`SELECT "Id" FROM "Users"`, `select "Id" from "Users"`},
+		{"SELECT $1::int", "select $1 :: int"},
+		{"SELECT 1; SELECT 2", "select ? ; select ?"},
+		{"", ""},
+		{"  -- only a comment", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeSQL(tt.sql))
+		})
+	}
+}
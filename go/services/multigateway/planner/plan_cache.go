@@ -0,0 +1,233 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// PlanCache is a bounded LRU cache of query plans, keyed by database and
+// normalized query text (see NormalizeSQL). It lets repeated statements
+// skip parsing and planning.
+//
+// Only plans that hold for every statement of the same normalized text are
+// cached: routes whose target does not depend on the constants of the
+// statement. The statements on sharded tables, routed by their shard key
+// values, are planned every time.
+type PlanCache struct {
+	mu      sync.Mutex
+	cache   map[planCacheKey]*list.Element
+	lru     *list.List
+	maxSize int
+
+	// Metrics
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// planCacheKey identifies the statements sharing a cached plan.
+type planCacheKey struct {
+	database string
+	query    string
+}
+
+// cachedPlan holds what is needed to run a statement of a normalized text
+// without parsing or planning it.
+type cachedPlan struct {
+	key planCacheKey
+
+	// stmt is the statement the plan was created for, which must not be
+	// modified. It differs from the statements sharing the plan only by
+	// its constants.
+	stmt ast.Stmt
+
+	class      engine.StatementClass
+	tableGroup string
+	shard      string
+	tables     []string
+	poolerType clustermetadatapb.PoolerType
+
+	// referenced are the tables the statement refers to, whose changes
+	// invalidate the plan.
+	referenced []string
+}
+
+// plan returns the plan of sql, a statement with the normalized text of
+// the entry.
+func (c *cachedPlan) plan(sql string) *engine.Plan {
+	plan := engine.NewPlan(sql, engine.NewRoute(c.tableGroup, c.shard, sql))
+	plan.Class = c.class
+	plan.Tables = c.tables
+	plan.PoolerType = c.poolerType
+	return plan
+}
+
+// NewPlanCache creates a new PlanCache holding at most maxSize plans.
+// The maxSize must be > 0; the caller is responsible for providing a valid size.
+func NewPlanCache(maxSize int) *PlanCache {
+	return &PlanCache{
+		cache:   make(map[planCacheKey]*list.Element),
+		lru:     list.New(),
+		maxSize: maxSize,
+	}
+}
+
+// get returns the plan cached for key.
+func (c *PlanCache) get(key planCacheKey) (*cachedPlan, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.cache[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	c.hits++
+	return elem.Value.(*cachedPlan), true
+}
+
+// put caches entry, evicting the least recently used plans beyond maxSize.
+func (c *PlanCache) put(entry *cachedPlan) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.cache[entry.key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.cache[entry.key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.maxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.cache, oldest.Value.(*cachedPlan).key)
+		c.evictions++
+	}
+}
+
+// InvalidateDatabase removes the plans of the statements run in database,
+// whose schema changed.
+func (c *PlanCache) InvalidateDatabase(database string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if key := elem.Value.(*cachedPlan).key; key.database == database {
+			c.lru.Remove(elem)
+			delete(c.cache, key)
+		}
+		elem = next
+	}
+}
+
+// InvalidateTables removes the plans of the statements run in database
+// on any of tables, given by name, whose schema changed.
+func (c *PlanCache) InvalidateTables(database string, tables []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*cachedPlan)
+		if entry.key.database == database && slices.ContainsFunc(entry.referenced, func(table string) bool {
+			return slices.Contains(tables, table)
+		}) {
+			c.lru.Remove(elem)
+			delete(c.cache, entry.key)
+		}
+		elem = next
+	}
+}
+
+// InvalidateAll removes every plan, keeping the metrics.
+func (c *PlanCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache = make(map[planCacheKey]*list.Element)
+	c.lru.Init()
+}
+
+// PlanCacheEntry describes a cached plan for the admin API.
+type PlanCacheEntry struct {
+	Database   string   `json:"database"`
+	Query      string   `json:"query"`
+	TableGroup string   `json:"table_group"`
+	Shard      string   `json:"shard"`
+	PoolerType string   `json:"pooler_type"`
+	Tables     []string `json:"tables"`
+}
+
+// Entries returns the cached plans, the most recently used first.
+func (c *PlanCache) Entries() []PlanCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]PlanCacheEntry, 0, c.lru.Len())
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		entry := elem.Value.(*cachedPlan)
+		entries = append(entries, PlanCacheEntry{
+			Database:   entry.key.database,
+			Query:      entry.key.query,
+			TableGroup: entry.tableGroup,
+			Shard:      entry.shard,
+			PoolerType: entry.poolerType.String(),
+			Tables:     entry.referenced,
+		})
+	}
+	return entries
+}
+
+// Size returns the number of cached plans.
+func (c *PlanCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.cache)
+}
+
+// MaxSize returns the maximum number of plans that can be cached.
+func (c *PlanCache) MaxSize() int {
+	return c.maxSize
+}
+
+// Hits returns the number of lookups that found a plan.
+func (c *PlanCache) Hits() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits
+}
+
+// Misses returns the number of lookups that found no plan.
+func (c *PlanCache) Misses() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.misses
+}
+
+// Evictions returns the number of plans evicted to make room for others.
+func (c *PlanCache) Evictions() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictions
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanCacheLRU(t *testing.T) {
+	cache := NewPlanCache(2)
+	put := func(query string) {
+		cache.put(&cachedPlan{key: planCacheKey{database: "db", query: query}})
+	}
+	has := func(query string) bool {
+		_, ok := cache.get(planCacheKey{database: "db", query: query})
+		return ok
+	}
+
+	put("a")
+	put("b")
+	assert.True(t, has("a"))
+	put("c") // evicts b, the least recently used
+	assert.False(t, has("b"))
+	assert.True(t, has("a"))
+	assert.True(t, has("c"))
+
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, int64(3), cache.Hits())
+	assert.Equal(t, int64(1), cache.Misses())
+	assert.Equal(t, int64(1), cache.Evictions())
+}
+
+func TestPlanCacheInvalidateDatabase(t *testing.T) {
+	cache := NewPlanCache(10)
+	for _, key := range []planCacheKey{{"db1", "a"}, {"db2", "a"}, {"db1", "b"}} {
+		cache.put(&cachedPlan{key: key})
+	}
+
+	cache.InvalidateDatabase("db1")
+	assert.Equal(t, 1, cache.Size())
+	_, ok := cache.get(planCacheKey{"db2", "a"})
+	assert.True(t, ok)
+
+	cache.InvalidateAll()
+	assert.Equal(t, 0, cache.Size())
+	assert.Equal(t, int64(1), cache.Hits())
+}
+
+func TestPlanCacheEntries(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	cache := NewPlanCache(10)
+	p.SetPlanCache(cache)
+
+	planAndCache(t, p, "SELECT * FROM t WHERE a = 1")
+	planAndCache(t, p, "SELECT * FROM u WHERE a = 1")
+
+	entries := cache.Entries()
+	require.Len(t, entries, 2)
+	// The most recently used first.
+	assert.Equal(t, []string{"u"}, entries[0].Tables)
+	assert.Equal(t, "tg", entries[1].TableGroup)
+	assert.Equal(t, []string{"t"}, entries[1].Tables)
+	assert.Equal(t, "select * from t where a = ?", entries[1].Query)
+}
+
+// planAndCache plans sql with p, as the executor does on a cache miss.
+func planAndCache(t *testing.T, p *Planner, sql string) *engine.Plan {
+	t.Helper()
+	stmts, err := parser.ParseSQL(sql)
+	require.NoError(t, err)
+	plan, err := p.Plan(sql, stmts[0], server.NewTestConn(&bytes.Buffer{}).Conn)
+	require.NoError(t, err)
+	return plan
+}
+
+func TestPlannerCachedPlan(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	p.SetPlanCache(NewPlanCache(10))
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	_, _, ok := p.CachedPlan("SELECT * FROM t WHERE a = 1", conn)
+	assert.False(t, ok)
+
+	planAndCache(t, p, "SELECT * FROM t WHERE a = 1")
+	plan, stmt, ok := p.CachedPlan("select * from t where a = 2", conn)
+	require.True(t, ok)
+	assert.Equal(t, engine.StatementRead, plan.Class)
+	assert.NotNil(t, stmt)
+	route, ok := plan.Primitive.(*engine.Route)
+	require.True(t, ok)
+	assert.Equal(t, "tg", route.TableGroup)
+	assert.Equal(t, "select * from t where a = 2", route.Query)
+
+	// Plans that are not plain routes are not cached.
+	planAndCache(t, p, "SET search_path = app")
+	_, _, ok = p.CachedPlan("SET search_path = other", conn)
+	assert.False(t, ok)
+
+	p.InvalidateDatabase(conn.Database())
+	_, _, ok = p.CachedPlan("SELECT * FROM t WHERE a = 1", conn)
+	assert.False(t, ok)
+}
+
+func TestPlannerCachedPlanSharded(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	p.SetPlanCache(NewPlanCache(10))
+	planAndCache(t, p, "SELECT * FROM users WHERE id = 1")
+	p.SetShardingSchema(&ShardingSchema{
+		Shards: []string{"-80", "80-"},
+		Tables: map[string]string{"users": "id"},
+		Router: evenOddRouter{},
+	})
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	// Changing the sharding schema invalidates the plans.
+	_, _, ok := p.CachedPlan("SELECT * FROM users WHERE id = 1", conn)
+	assert.False(t, ok)
+
+	// Statements on sharded tables are routed by their constants.
+	plan := planAndCache(t, p, "SELECT * FROM users WHERE id = 1")
+	assert.Equal(t, "80-", plan.Primitive.(*engine.Route).Shard)
+	_, _, ok = p.CachedPlan("SELECT * FROM users WHERE id = 2", conn)
+	assert.False(t, ok)
+
+	planAndCache(t, p, "SELECT * FROM settings WHERE name = 'a'")
+	plan, _, ok = p.CachedPlan("SELECT * FROM settings WHERE name = 'b'", conn)
+	require.True(t, ok)
+	assert.Equal(t, "-80", plan.Primitive.(*engine.Route).Shard)
+}
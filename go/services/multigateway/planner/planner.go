@@ -18,10 +18,13 @@ package planner
 
 import (
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
 )
 
 // Planner is responsible for creating query execution plans.
@@ -30,6 +33,65 @@ type Planner struct {
 	// For Phase 1, all queries are routed to this tablegroup.
 	defaultTableGroup string
 
+	// shardingSchema describes the sharded tables of the default
+	// tablegroup, or is nil if it is not sharded.
+	shardingSchema *ShardingSchema
+
+	// collations provides the collations by which the gateway orders the
+	// text of the rows of several shards, or is nil without sharding.
+	collations *engine.Collations
+
+	// cache holds the plans of the statements already planned, or is nil
+	// if plans are not cached.
+	cache *PlanCache
+
+	// maxMemory is the maximum number of bytes a query may use in the
+	// gateway to buffer the rows of several shards, or to remove their
+	// duplicates. Zero means unlimited.
+	maxMemory int64
+
+	// spillThreshold is the number of bytes beyond which duplicate rows
+	// are removed on disk rather than in memory. Zero disables spilling.
+	spillThreshold int64
+
+	// sequences hands out the values of the global sequences generating
+	// the columns of sharded tables, or is nil if there are none.
+	sequences *engine.GlobalSequences
+
+	// ddlJournal records the progress of the schema changes of sharded
+	// tables, or is nil if they are not journaled.
+	ddlJournal *engine.DDLJournal
+
+	// ddlFailurePolicy is what a schema change of sharded tables does when
+	// a shard fails it, stopping if empty.
+	ddlFailurePolicy engine.DDLFailurePolicy
+
+	// resultCache holds the results of the reads opting in to caching, or
+	// is nil if results are not cached.
+	resultCache *engine.ResultCache
+
+	// resultCacheTTL is the time results are cached for when the hint of
+	// a read gives none.
+	resultCacheTTL time.Duration
+
+	// resultCacheTables maps the tables whose reads are cached without a
+	// hint to the time their results are cached for.
+	resultCacheTables map[string]time.Duration
+
+	// queryStats holds the statement statistics of the gateway, answering
+	// SELECTs of multigres.stat_statements, or is nil if none are kept.
+	queryStats *engine.QueryStats
+
+	// routingRules holds the routing rules of the tables of each
+	// database, changed while statements are planned.
+	routingMu    sync.RWMutex
+	routingRules map[string]*routingRuleSet
+
+	// tables holds the columns of the tables of each database, as tracked
+	// by the poolers, changed while statements are planned.
+	tablesMu sync.RWMutex
+	tables   map[string]TableDefinitions
+
 	logger *slog.Logger
 }
 
@@ -45,16 +107,27 @@ func NewPlanner(defaultTableGroup string, logger *slog.Logger) *Planner {
 //
 // The planner analyzes the AST to determine query type and creates
 // appropriate primitives. Uses PostgreSQL's utility.c dispatch pattern
-// with switch on NodeTag for extensibility.
+// with switch on NodeTag for extensibility. The plan records the class of
+// the statement (see Classify). Plans that hold for every statement of
+// the same normalized text are added to the plan cache (see CachedPlan).
+// The directives of the statement (see handler.QueryDirectives) choose
+// its shard, its pooler type, and whether it may scatter or be cached.
 //
 // Supported statement types:
-// - VariableSetStmt: SET/RESET commands → Sequence[Route, ApplySessionState]
-// - Regular queries: Route only
-//
-// Future phases will add more statement handlers for:
-// - TransactionStmt: BEGIN/COMMIT/ROLLBACK
-// - SelectStmt: Query optimization and sharding
-// - InsertStmt/UpdateStmt/DeleteStmt: Write operations
+// - VariableSetStmt: SET/SET LOCAL/RESET commands → Sequence[Route, ApplySessionState]
+// - VariableShowStmt: SHOW of gateway-owned parameters and SHOW ALL → Show
+// - TransactionStmt: BEGIN/COMMIT/ROLLBACK/SAVEPOINT → TransactionControl
+// - ExplainStmt: EXPLAIN (MULTIGRES) → Explain, answered by the gateway
+// - CopyStmt: COPY commands → CopyStatement or Route
+// - ListenStmt/UnlistenStmt: LISTEN/UNLISTEN → Listen
+// - Statements using session-level advisory locks → AdvisoryLockRoute
+// - Schema changes of sharded or reference tables → DDLFanout
+// - Statements creating or dropping temporary relations → TempTableRoute
+// - DECLARE/CLOSE → CursorRoute
+// - SELECT set_config(...) → Sequence[Route, ApplySetConfig]
+// - SELECT of multigres.stat_statements → StatStatements, answered by the gateway
+// - Regular queries: Route, or by shard key with a sharding schema → Route, ScatterRoute, Concatenate or Join
+// - Regular queries on tables with routing rules (see SetRoutingRules) → the same, on the tables and target of the rules
 func (p *Planner) Plan(
 	sql string,
 	stmt ast.Stmt,
@@ -67,30 +140,212 @@ func (p *Planner) Plan(
 		"default_tablegroup", p.defaultTableGroup,
 		"statement_type", stmt.NodeTag())
 
+	directives, err := handler.ParseQueryDirectives(sql)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := p.planStmt(sql, stmt, conn)
+	if err != nil {
+		return nil, err
+	}
+	plan.Class = Classify(stmt)
+	if err := applyDirectives(directives, plan); err != nil {
+		return nil, err
+	}
+	if plan, err = p.cacheResults(sql, stmt, plan); err != nil {
+		return nil, err
+	}
+	// The directives of a statement are not part of its normalized text.
+	if p.cache != nil && !handler.HasQueryDirectives(sql) {
+		if entry := p.cacheablePlan(stmt, plan, conn.Database()); entry != nil {
+			p.cache.put(entry)
+		}
+	}
+	return plan, nil
+}
+
+// CachedPlan returns the cached plan of sql, a query string holding a
+// single statement, along with the statement the plan was created for,
+// which only differs from that of sql by its constants and must not be
+// modified. It returns false if no plan is cached for the normalized text
+// of sql, which must then be parsed and planned.
+func (p *Planner) CachedPlan(sql string, conn *server.Conn) (*engine.Plan, ast.Stmt, bool) {
+	if p.cache == nil || p.hasResultCacheHint(sql) || handler.HasQueryDirectives(sql) {
+		return nil, nil, false
+	}
+	query := NormalizeSQL(sql)
+	if query == "" {
+		return nil, nil, false
+	}
+	entry, ok := p.cache.get(planCacheKey{database: conn.Database(), query: query})
+	if !ok {
+		return nil, nil, false
+	}
+	return entry.plan(sql), entry.stmt, true
+}
+
+// cacheablePlan returns the cache entry of the plan of stmt, if it holds
+// for every statement with the same normalized text: a route to a shard
+// that does not depend on the constants of the statement.
+func (p *Planner) cacheablePlan(stmt ast.Stmt, plan *engine.Plan, database string) *cachedPlan {
+	route, ok := plan.Primitive.(*engine.Route)
+	if !ok || route.Query != plan.Original {
+		return nil
+	}
+	// Statements on sharded tables are routed by their shard key values.
+	if p.ReferencesShardedTables(stmt) {
+		return nil
+	}
+	query := NormalizeSQL(plan.Original)
+	if query == "" {
+		return nil
+	}
+	return &cachedPlan{
+		key:        planCacheKey{database: database, query: query},
+		stmt:       stmt,
+		class:      plan.Class,
+		tableGroup: route.TableGroup,
+		shard:      route.Shard,
+		tables:     plan.Tables,
+		poolerType: plan.PoolerType,
+		referenced: ReferencedTables(stmt),
+	}
+}
+
+// SetPlanCache sets the cache of the plans of the planner, or disables
+// caching if cache is nil.
+func (p *Planner) SetPlanCache(cache *PlanCache) {
+	p.cache = cache
+}
+
+// SetMemoryLimits sets the memory the queries on several shards may use
+// to buffer and merge the rows of the shards and to remove duplicate rows,
+// failing beyond maxMemory bytes, and spilling duplicate removal to disk
+// beyond spillThreshold bytes. Zero disables either limit.
+func (p *Planner) SetMemoryLimits(maxMemory, spillThreshold int64) {
+	p.maxMemory = maxMemory
+	p.spillThreshold = spillThreshold
+}
+
+// SetGlobalSequences sets the global sequences generating the columns of
+// the sharded tables listed in the sharding schema.
+func (p *Planner) SetGlobalSequences(sequences *engine.GlobalSequences) {
+	p.sequences = sequences
+}
+
+// SetDDLJournal sets the journal recording the progress of the schema
+// changes of sharded tables, and what they do when a shard fails them.
+func (p *Planner) SetDDLJournal(journal *engine.DDLJournal, policy engine.DDLFailurePolicy) {
+	p.ddlJournal = journal
+	p.ddlFailurePolicy = policy
+}
+
+// InvalidateDatabase removes the cached plans of the statements run in
+// database, after a change of its schema.
+func (p *Planner) InvalidateDatabase(database string) {
+	if p.cache != nil {
+		p.cache.InvalidateDatabase(database)
+	}
+}
+
+// planStmt creates the execution plan of a statement by its type.
+func (p *Planner) planStmt(
+	sql string,
+	stmt ast.Stmt,
+	conn *server.Conn,
+) (*engine.Plan, error) {
 	// Dispatch to appropriate planner function based on statement type
 	// This follows PostgreSQL's utility.c pattern with switch on node tag
 	switch stmt.NodeTag() {
 	case ast.T_VariableSetStmt:
 		return p.planVariableSetStmt(sql, stmt.(*ast.VariableSetStmt), conn)
 
+	case ast.T_VariableShowStmt:
+		return p.planShowStmt(sql, stmt.(*ast.VariableShowStmt), conn)
+
 	case ast.T_CopyStmt:
 		return p.planCopyStmt(sql, stmt.(*ast.CopyStmt))
 
-	// Future: Add more statement types here
-	// case ast.T_TransactionStmt:
-	//     return p.planTransactionStmt(sql, stmt.(*ast.TransactionStmt), conn)
-	// case ast.T_SelectStmt:
-	//     return p.planSelectStmt(sql, stmt.(*ast.SelectStmt), conn)
+	case ast.T_ListenStmt:
+		return p.planListenStmt(sql, stmt.(*ast.ListenStmt))
+
+	case ast.T_UnlistenStmt:
+		return p.planUnlistenStmt(sql, stmt.(*ast.UnlistenStmt))
+
+	case ast.T_TransactionStmt:
+		return p.planTransactionStmt(sql, stmt.(*ast.TransactionStmt))
+
+	case ast.T_ExplainStmt:
+		return p.planExplainStmt(sql, stmt.(*ast.ExplainStmt), conn)
 
 	default:
-		// Default: simple route to PostgreSQL
-		return p.planDefault(sql, conn)
+		// The statement statistics are a virtual table of the gateway.
+		if isStatStatements(stmt) {
+			return p.planStatStatements(sql, stmt.(*ast.SelectStmt))
+		}
+
+		// Statements taking or releasing session-level advisory locks
+		// must run on a connection pinned to the session.
+		if usage := engine.DetectAdvisoryLocks(stmt); usage.Any() {
+			return p.planAdvisoryLock(sql, usage)
+		}
+
+		// Schema changes of sharded tables run on every shard.
+		if plan := p.planDDL(sql, stmt); plan != nil {
+			return plan, nil
+		}
+
+		// Temporary relations only exist on the backend that created them.
+		if usage := engine.DetectTempTables(stmt); usage.Any() {
+			return p.planTempTable(sql, usage)
+		}
+
+		// Cursors only exist on the backend that declared them.
+		if usage := engine.DetectCursors(stmt); usage.Any() {
+			return p.planCursor(sql, usage)
+		}
+
+		// set_config() changes session variables like SET does.
+		if calls := engine.DetectSetConfig(stmt); len(calls) > 0 {
+			return p.planSetConfig(sql, calls)
+		}
+
+		// Default: route to PostgreSQL
+		return p.planDefault(sql, stmt, conn)
 	}
 }
 
-// planDefault creates a simple route plan for queries without special handling.
-// This is the fallback for most SQL statements.
-func (p *Planner) planDefault(sql string, conn *server.Conn) (*engine.Plan, error) {
+// planDefault creates a route plan for queries without special handling.
+// This is the fallback for most SQL statements. The statements giving the
+// shard directive run on that shard, and those on tables with routing
+// rules follow them (see routingTargetOf).
+func (p *Planner) planDefault(sql string, stmt ast.Stmt, conn *server.Conn) (*engine.Plan, error) {
+	// Plan validated the directives.
+	if directives, _ := handler.ParseQueryDirectives(sql); directives.Shard != "" {
+		return p.planOnShard(sql, directives.Shard)
+	}
+	target, err := p.routingTargetOf(stmt, conn.Database())
+	if err != nil {
+		return nil, err
+	}
+	if target != nil {
+		return p.planRouted(sql, stmt, target)
+	}
+	if p.shardingSchema != nil {
+		// The shard key of an INSERT without a column list is found by
+		// the columns of its table.
+		if insert, ok := stmt.(*ast.InsertStmt); ok {
+			if listed := p.withColumnList(insert, conn.Database()); listed != nil {
+				plan, err := p.planSharded(listed.SqlString(), listed)
+				if err != nil {
+					return nil, err
+				}
+				plan.Original = sql
+				return plan, nil
+			}
+		}
+		return p.planSharded(sql, stmt)
+	}
 	route := engine.NewRoute(p.defaultTableGroup, "", sql)
 	plan := engine.NewPlan(sql, route)
 
@@ -104,6 +359,9 @@ func (p *Planner) planDefault(sql string, conn *server.Conn) (*engine.Plan, erro
 // This allows dynamic configuration changes.
 func (p *Planner) SetDefaultTableGroup(tableGroup string) {
 	p.defaultTableGroup = tableGroup
+	if p.cache != nil {
+		p.cache.InvalidateAll()
+	}
 	p.logger.Info("default tablegroup updated", "tablegroup", tableGroup)
 }
 
@@ -0,0 +1,73 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// writesReferenceTable returns true if stmt inserts, updates, deletes or
+// truncates rows of a reference table, at its top level or in a WITH
+// clause.
+func (p *Planner) writesReferenceTable(stmt ast.Stmt) bool {
+	if len(p.shardingSchema.ReferenceTables) == 0 {
+		return false
+	}
+	found := false
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		var rels []*ast.RangeVar
+		switch n := cursor.Node().(type) {
+		case *ast.InsertStmt:
+			rels = append(rels, n.Relation)
+		case *ast.UpdateStmt:
+			rels = append(rels, n.Relation)
+		case *ast.DeleteStmt:
+			rels = append(rels, n.Relation)
+		case *ast.MergeStmt:
+			rels = append(rels, n.Relation)
+		case *ast.TruncateStmt:
+			if n.Relations != nil {
+				for _, item := range n.Relations.Items {
+					if rel, ok := item.(*ast.RangeVar); ok {
+						rels = append(rels, rel)
+					}
+				}
+			}
+		}
+		for _, rel := range rels {
+			if rel != nil && p.shardingSchema.ReferenceTables[rel.RelName] {
+				found = true
+			}
+		}
+		return !found
+	}, nil)
+	return found
+}
+
+// planReferenceWrite creates the plan of a statement writing reference
+// tables: a ReferenceWrite applying it to the copy of every shard. The
+// statement must not read sharded tables, whose rows differ from shard to
+// shard and would leave the copies different.
+func (p *Planner) planReferenceWrite(sql string, stmt ast.Stmt) (*engine.Plan, error) {
+	if len(p.shardedRefs(stmt)) > 0 {
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("statements writing reference tables cannot read or write sharded tables").
+			Hint("Write the reference tables and the sharded tables with separate statements.").
+			Err()
+	}
+	return engine.NewPlan(sql, engine.NewReferenceWrite(p.defaultTableGroup, p.shardingSchema.Shards, sql)), nil
+}
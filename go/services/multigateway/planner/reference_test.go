@@ -0,0 +1,89 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestPlanReferenceTables(t *testing.T) {
+	tests := []struct {
+		sql  string
+		plan string
+	}{
+		// Reads run on a single shard, and joins run on the shards of the
+		// sharded table.
+		{"SELECT * FROM countries", "Route(tablegroup=tg, shard=-80, query=SELECT * FROM countries)"},
+		{
+			"SELECT * FROM users JOIN countries ON countries.code = users.country WHERE users.id = 3",
+			"Route(tablegroup=tg, shard=80-, query=SELECT * FROM users JOIN countries ON countries.code = users.country WHERE users.id = 3)",
+		},
+		{
+			"SELECT * FROM users JOIN countries ON countries.code = users.country",
+			"ScatterRoute(tablegroup=tg, shards=-80,80-, query=SELECT * FROM users JOIN countries ON countries.code = users.country)",
+		},
+		{
+			"UPDATE users SET country = 'fr' FROM countries WHERE users.id = 2",
+			"Route(tablegroup=tg, shard=-80, query=UPDATE users SET country = 'fr' FROM countries WHERE users.id = 2)",
+		},
+		// Writes run on every shard.
+		{"INSERT INTO countries VALUES ('fr')", "ReferenceWrite(tablegroup=tg, shards=-80,80-, query=INSERT INTO countries VALUES ('fr'))"},
+		{"UPDATE countries SET name = 'France' WHERE code = 'fr'", "ReferenceWrite(tablegroup=tg, shards=-80,80-, query=UPDATE countries SET name = 'France' WHERE code = 'fr')"},
+		{"TRUNCATE countries", "ReferenceWrite(tablegroup=tg, shards=-80,80-, query=TRUNCATE countries)"},
+		{
+			"WITH d AS (DELETE FROM countries RETURNING code) SELECT count(*) FROM d",
+			"ReferenceWrite(tablegroup=tg, shards=-80,80-, query=WITH d AS (DELETE FROM countries RETURNING code) SELECT count(*) FROM d)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plan, plan.Primitive.String())
+		})
+	}
+}
+
+func TestPlanReferenceWriteReadingShardedTables(t *testing.T) {
+	for _, sql := range []string{
+		"INSERT INTO countries SELECT country FROM users",
+		"DELETE FROM countries WHERE code IN (SELECT country FROM users)",
+		"WITH d AS (DELETE FROM countries RETURNING code) UPDATE users SET country = NULL FROM d WHERE users.country = d.code",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planSharded(t, sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
+
+func TestReferenceWritesNotCached(t *testing.T) {
+	p := shardedPlanner()
+	p.SetPlanCache(NewPlanCache(10))
+	_, err := planWith(t, p, "DELETE FROM countries WHERE code = 'fr'")
+	require.NoError(t, err)
+	_, err = planWith(t, p, "SELECT * FROM countries WHERE code = 'fr'")
+	require.NoError(t, err)
+	// Only the read is cached.
+	assert.Equal(t, 1, p.cache.Size())
+}
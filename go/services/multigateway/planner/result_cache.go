@@ -0,0 +1,239 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// resultCacheHint is the comment a read opts in to the result cache with,
+// optionally giving the time its result is cached for, such as
+// /*+ multigres_cache(30s) */.
+var resultCacheHint = regexp.MustCompile(`(?i)/\*\+\s*multigres_cache(?:\(\s*([^)\s]*)\s*\))?\s*\*/`)
+
+// SetResultCache sets the cache of the results of reads, or disables
+// caching if cache is nil. The reads giving the hint /*+ multigres_cache */
+// or the cache directive are cached for ttl, or the time they give. The reads of tables
+// listed in tables alone are cached without a hint, for the shortest time
+// of their tables, unless they call functions whose results may change
+// between calls. Tables are listed as table or schema.table, as the reads
+// name them.
+func (p *Planner) SetResultCache(cache *engine.ResultCache, ttl time.Duration, tables map[string]time.Duration) {
+	p.resultCache = cache
+	p.resultCacheTTL = ttl
+	p.resultCacheTables = tables
+}
+
+// hasResultCacheHint returns true if results are cached and sql gives the
+// hint opting in to the cache, which the plan cache ignores. The cache
+// directive (see handler.QueryDirectives) bypasses the plan cache like
+// every directive.
+func (p *Planner) hasResultCacheHint(sql string) bool {
+	return p.resultCache != nil && resultCacheHint.MatchString(sql)
+}
+
+// cacheResults returns plan reading from the result cache if the statement
+// is a read opting in to it, and records the tables the statement reads or
+// writes in the plan, for writes to invalidate the results read from them.
+func (p *Planner) cacheResults(sql string, stmt ast.Stmt, plan *engine.Plan) (*engine.Plan, error) {
+	if p.resultCache == nil {
+		return plan, nil
+	}
+	plan.Tables = QualifiedTables(stmt)
+	if plan.Class != engine.StatementRead || !isCacheableRead(stmt) || len(plan.Tables) == 0 {
+		return plan, nil
+	}
+	ttl, ok, err := p.resultCacheTTLOf(sql, stmt, plan.Tables)
+	if err != nil || !ok {
+		return plan, err
+	}
+	cached := engine.NewPlan(sql, engine.NewCachedRead(sql, plan.Primitive, p.resultCache, plan.Tables, ttl))
+	cached.Class = plan.Class
+	cached.Tables = plan.Tables
+	return cached, nil
+}
+
+// resultCacheTTLOf returns the time the result of stmt, a read of tables,
+// is cached for, or false if it is not cached.
+func (p *Planner) resultCacheTTLOf(sql string, stmt ast.Stmt, tables []string) (time.Duration, bool, error) {
+	if directives, _ := handler.ParseQueryDirectives(sql); directives.Cache {
+		if directives.CacheTTL > 0 {
+			return directives.CacheTTL, true, nil
+		}
+		return p.resultCacheTTL, true, nil
+	}
+	if match := resultCacheHint.FindStringSubmatch(sql); match != nil {
+		if match[1] == "" {
+			return p.resultCacheTTL, true, nil
+		}
+		ttl, err := time.ParseDuration(match[1])
+		if err != nil || ttl <= 0 {
+			return 0, false, sqlstate.NewError(sqlstate.InvalidParameterValue).
+				Msg("invalid time to live %q in the multigres_cache hint", match[1]).
+				Hint("Give a positive duration, such as /*+ multigres_cache(30s) */.").
+				Err()
+		}
+		return ttl, true, nil
+	}
+	if !callsDeterministicFunctions(stmt) {
+		return 0, false, nil
+	}
+	var ttl time.Duration
+	for _, table := range tables {
+		tableTTL, ok := p.resultCacheTables[table]
+		if !ok {
+			return 0, false, nil
+		}
+		if ttl == 0 || tableTTL < ttl {
+			ttl = tableTTL
+		}
+	}
+	return ttl, true, nil
+}
+
+// isCacheableRead returns true if stmt is a SELECT that writes nothing,
+// even in its WITH clause.
+func isCacheableRead(stmt ast.Stmt) bool {
+	if _, ok := stmt.(*ast.SelectStmt); !ok {
+		return false
+	}
+	writes := false
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		switch cursor.Node().(type) {
+		case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.MergeStmt:
+			writes = true
+		}
+		return !writes
+	}, nil)
+	return !writes
+}
+
+// deterministicFunctions are the built-in functions whose results only
+// depend on their arguments and the session settings keying the result
+// cache.
+var deterministicFunctions = map[string]bool{
+	// Aggregates.
+	"count": true, "sum": true, "avg": true, "min": true, "max": true,
+	"bool_and": true, "bool_or": true, "every": true, "string_agg": true, "array_agg": true,
+	// Mathematical functions.
+	"abs": true, "ceil": true, "ceiling": true, "floor": true, "round": true, "trunc": true, "mod": true,
+	// String functions.
+	"lower": true, "upper": true, "length": true, "char_length": true, "character_length": true,
+	"substr": true, "substring": true, "trim": true, "btrim": true, "ltrim": true, "rtrim": true,
+	"concat": true, "concat_ws": true, "replace": true, "left": true, "right": true,
+	"lpad": true, "rpad": true, "strpos": true, "position": true, "split_part": true,
+}
+
+// callsDeterministicFunctions returns true if stmt only calls the built-in
+// functions of deterministicFunctions, so that it returns the same rows
+// until its tables are written: reads calling functions such as now(),
+// random() or nextval() are not cached without a hint.
+func callsDeterministicFunctions(stmt ast.Stmt) bool {
+	deterministic := true
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *ast.FuncCall:
+			deterministic = deterministicFunctions[builtinFuncName(n)]
+		case *ast.SQLValueFunction, *ast.RangeTableSample:
+			// CURRENT_TIMESTAMP, CURRENT_USER, ..., and TABLESAMPLE.
+			deterministic = false
+		}
+		return deterministic
+	}, nil)
+	return deterministic
+}
+
+// builtinFuncName returns the lower-cased name of the function of call if
+// it is unqualified or qualified with pg_catalog, and "" otherwise.
+func builtinFuncName(call *ast.FuncCall) string {
+	if call.Funcname == nil {
+		return ""
+	}
+	switch len(call.Funcname.Items) {
+	case 1:
+		return funcName(call)
+	case 2:
+		if schema, ok := call.Funcname.Items[0].(*ast.String); ok && strings.EqualFold(schema.SVal, "pg_catalog") {
+			return funcName(call)
+		}
+	}
+	return ""
+}
+
+// ReferencedTables returns the names of the tables stmt reads or writes,
+// sorted, leaving out its common table expressions.
+func ReferencedTables(stmt ast.Stmt) []string {
+	return referencedTables(stmt, false)
+}
+
+// QualifiedTables returns the tables stmt reads or writes like
+// ReferencedTables, as schema.table for those it qualifies with a schema.
+func QualifiedTables(stmt ast.Stmt) []string {
+	return referencedTables(stmt, true)
+}
+
+func referencedTables(stmt ast.Stmt, qualified bool) []string {
+	var tables, ctes []string
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *ast.RangeVar:
+			if qualified && n.SchemaName != "" {
+				// Common table expressions are never qualified.
+				tables = append(tables, n.SchemaName+"."+n.RelName)
+			} else {
+				tables = append(tables, n.RelName)
+			}
+		case *ast.CommonTableExpr:
+			ctes = append(ctes, n.Ctename)
+		}
+		return true
+	}, nil)
+	tables = slices.DeleteFunc(tables, func(table string) bool {
+		return slices.Contains(ctes, table)
+	})
+	slices.Sort(tables)
+	return slices.Compact(tables)
+}
+
+// ParseResultCacheTables parses the tables whose reads are cached without
+// a hint, each given as table or table=ttl, cached for ttl when not given,
+// where table may be qualified as schema.table.
+func ParseResultCacheTables(specs []string, ttl time.Duration) (map[string]time.Duration, error) {
+	tables := make(map[string]time.Duration, len(specs))
+	for _, spec := range specs {
+		table, value, found := strings.Cut(strings.TrimSpace(spec), "=")
+		if table == "" {
+			return nil, fmt.Errorf("invalid result cache table %q: missing table name", spec)
+		}
+		tableTTL := ttl
+		if found {
+			var err error
+			if tableTTL, err = time.ParseDuration(value); err != nil || tableTTL <= 0 {
+				return nil, fmt.Errorf("invalid result cache table %q: the time to live must be a positive duration", spec)
+			}
+		}
+		tables[table] = tableTTL
+	}
+	return tables, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// newResultCachePlanner returns a planner caching the results of reads for
+// 10s by default, and of reads of tables without a hint.
+func newResultCachePlanner(tables map[string]time.Duration) *Planner {
+	p := NewPlanner("tg", slog.Default())
+	p.SetResultCache(engine.NewResultCache(10, 100), 10*time.Second, tables)
+	return p
+}
+
+func TestPlanCachedReadWithHint(t *testing.T) {
+	p := newResultCachePlanner(nil)
+
+	plan := planAndCache(t, p, "SELECT /*+ multigres_cache */ * FROM t JOIN u ON t.id = u.id")
+	read, ok := plan.Primitive.(*engine.CachedRead)
+	require.True(t, ok)
+	assert.Equal(t, []string{"t", "u"}, read.Tables)
+	assert.Equal(t, 10*time.Second, read.TTL)
+	assert.Equal(t, engine.StatementRead, plan.Class)
+
+	plan = planAndCache(t, p, "SELECT /*+ MULTIGRES_CACHE( 1m ) */ * FROM t")
+	require.IsType(t, &engine.CachedRead{}, plan.Primitive)
+	assert.Equal(t, time.Minute, plan.Primitive.(*engine.CachedRead).TTL)
+
+	// Reads without a hint, or of no table, are not cached.
+	plan = planAndCache(t, p, "SELECT * FROM t")
+	assert.IsType(t, &engine.Route{}, plan.Primitive)
+	plan = planAndCache(t, p, "SELECT /*+ multigres_cache */ 1")
+	assert.IsType(t, &engine.Route{}, plan.Primitive)
+}
+
+func TestPlanCachedReadInvalidHint(t *testing.T) {
+	p := newResultCachePlanner(nil)
+	for _, sql := range []string{
+		"SELECT /*+ multigres_cache(soon) */ * FROM t",
+		"SELECT /*+ multigres_cache(-1s) */ * FROM t",
+	} {
+		stmts, err := parser.ParseSQL(sql)
+		require.NoError(t, err)
+		_, err = p.Plan(sql, stmts[0], server.NewTestConn(&bytes.Buffer{}).Conn)
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag, sql)
+		assert.Equal(t, "22023", diag.Code)
+	}
+}
+
+func TestPlanCachedReadOfTables(t *testing.T) {
+	p := newResultCachePlanner(map[string]time.Duration{"t": time.Minute, "u": 5 * time.Second})
+
+	plan := planAndCache(t, p, "SELECT * FROM t")
+	require.IsType(t, &engine.CachedRead{}, plan.Primitive)
+	assert.Equal(t, time.Minute, plan.Primitive.(*engine.CachedRead).TTL)
+
+	// Reads of several tables are cached for the shortest time.
+	plan = planAndCache(t, p, "SELECT * FROM t JOIN u ON t.id = u.id")
+	require.IsType(t, &engine.CachedRead{}, plan.Primitive)
+	assert.Equal(t, 5*time.Second, plan.Primitive.(*engine.CachedRead).TTL)
+
+	// Reads of unlisted tables are not cached.
+	plan = planAndCache(t, p, "SELECT * FROM t JOIN v ON t.id = v.id")
+	assert.IsType(t, &engine.Route{}, plan.Primitive)
+
+	// Nor are writes, even in a SELECT, which record the tables they write.
+	plan = planAndCache(t, p, "WITH n AS (INSERT INTO t VALUES (1) RETURNING *) SELECT * FROM n")
+	assert.IsType(t, &engine.Route{}, plan.Primitive)
+	assert.Equal(t, []string{"t"}, plan.Tables)
+	plan = planAndCache(t, p, "UPDATE u SET a = 1")
+	assert.IsType(t, &engine.Route{}, plan.Primitive)
+	assert.Equal(t, []string{"u"}, plan.Tables)
+}
+
+func TestPlanCachedReadOfTablesCallingFunctions(t *testing.T) {
+	p := newResultCachePlanner(map[string]time.Duration{"t": time.Minute})
+
+	for _, sql := range []string{
+		"SELECT count(*), max(a) FROM t",
+		"SELECT lower(name), pg_catalog.upper(name) FROM t WHERE abs(a) > 1",
+	} {
+		plan := planAndCache(t, p, sql)
+		assert.IsType(t, &engine.CachedRead{}, plan.Primitive, sql)
+	}
+	// Reads calling functions whose results may change are only cached
+	// with a hint.
+	for _, sql := range []string{
+		"SELECT now(), * FROM t",
+		"SELECT * FROM t ORDER BY random()",
+		"SELECT nextval('s'), a FROM t",
+		"SELECT * FROM t WHERE created < CURRENT_TIMESTAMP",
+		"SELECT app.lower(name) FROM t",
+		"SELECT * FROM t WHERE a IN (SELECT f(a) FROM t)",
+	} {
+		plan := planAndCache(t, p, sql)
+		assert.IsType(t, &engine.Route{}, plan.Primitive, sql)
+	}
+	plan := planAndCache(t, p, "SELECT /*+ multigres_cache */ now(), * FROM t")
+	assert.IsType(t, &engine.CachedRead{}, plan.Primitive)
+}
+
+func TestPlanCachedReadOfSchemaTables(t *testing.T) {
+	p := newResultCachePlanner(map[string]time.Duration{"app.t": time.Minute, "u": time.Minute})
+
+	plan := planAndCache(t, p, "SELECT * FROM app.t")
+	require.IsType(t, &engine.CachedRead{}, plan.Primitive)
+	assert.Equal(t, []string{"app.t"}, plan.Tables)
+	plan = planAndCache(t, p, "SELECT * FROM u")
+	assert.IsType(t, &engine.CachedRead{}, plan.Primitive)
+
+	// Tables are listed as the reads name them.
+	for _, sql := range []string{"SELECT * FROM t", "SELECT * FROM other.t", "SELECT * FROM app.u"} {
+		plan := planAndCache(t, p, sql)
+		assert.IsType(t, &engine.Route{}, plan.Primitive, sql)
+	}
+}
+
+func TestPlannerCachedPlanSkipsResultCacheHint(t *testing.T) {
+	p := newResultCachePlanner(nil)
+	p.SetPlanCache(NewPlanCache(10))
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	planAndCache(t, p, "SELECT * FROM t WHERE a = 1")
+	_, _, ok := p.CachedPlan("SELECT /*+ multigres_cache */ * FROM t WHERE a = 2", conn)
+	assert.False(t, ok)
+	plan, _, ok := p.CachedPlan("SELECT * FROM t WHERE a = 2", conn)
+	require.True(t, ok)
+	assert.Equal(t, []string{"t"}, plan.Tables)
+}
+
+func TestReferencedTables(t *testing.T) {
+	stmts, err := parser.ParseSQL("WITH recent AS (SELECT * FROM orders) SELECT * FROM recent JOIN users ON true JOIN orders ON true")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders", "users"}, ReferencedTables(stmts[0]))
+}
+
+func TestQualifiedTables(t *testing.T) {
+	stmts, err := parser.ParseSQL("WITH recent AS (SELECT * FROM app.orders) SELECT * FROM recent JOIN users ON true JOIN app.users ON true")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.orders", "app.users", "users"}, QualifiedTables(stmts[0]))
+	assert.Equal(t, []string{"orders", "users"}, ReferencedTables(stmts[0]))
+}
+
+func TestParseResultCacheTables(t *testing.T) {
+	tables, err := ParseResultCacheTables([]string{"t", " u=1m "}, 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"t": 10 * time.Second, "u": time.Minute}, tables)
+
+	for _, spec := range []string{"=1m", "t=", "t=soon", "t=0s"} {
+		_, err := ParseResultCacheTables([]string{spec}, time.Second)
+		assert.Error(t, err, spec)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// SetRoutingRules sets the routing rules of the tables of database,
+// replacing its previous rules, and invalidates its cached plans.
+func (p *Planner) SetRoutingRules(database string, rules *clustermetadatapb.RoutingRules) {
+	set := &routingRuleSet{
+		version: rules.GetVersion(),
+		rules:   make(map[string]*clustermetadatapb.RoutingRule, len(rules.GetRules())),
+	}
+	for _, rule := range rules.GetRules() {
+		set.rules[rule.FromTable] = rule
+	}
+	p.routingMu.Lock()
+	if p.routingRules == nil {
+		p.routingRules = make(map[string]*routingRuleSet)
+	}
+	p.routingRules[database] = set
+	p.routingMu.Unlock()
+
+	p.InvalidateDatabase(database)
+	p.logger.Info("routing rules updated", "database", database, "version", set.version, "rules", len(set.rules))
+}
+
+// routingRuleSet holds the routing rules of a database by table.
+type routingRuleSet struct {
+	version int64
+	rules   map[string]*clustermetadatapb.RoutingRule
+}
+
+// routingTarget is where the routing rules of the tables of a statement
+// send it.
+type routingTarget struct {
+	// renames maps the references to rename to their new table.
+	renames map[*ast.RangeVar]string
+
+	// tableGroup and shard are where the statement goes, or empty to
+	// route it as without the rules.
+	tableGroup string
+	shard      string
+
+	// poolerType is the type of pooler the statement reads from, or
+	// UNKNOWN to read from the poolers the session targets.
+	poolerType clustermetadatapb.PoolerType
+}
+
+// routingTargetOf returns where the routing rules of database send stmt,
+// or nil if none of its tables has a rule. Only SELECT, INSERT, UPDATE and
+// DELETE statements follow the rules.
+//
+// A table is matched by its name as the statement gives it, schema
+// qualified or not. The tables moved by their rules must all go to the
+// same tablegroup and shard, and a statement reading tables that moved
+// along with tables that did not is refused. The statement reads from the
+// primary if the rule of any of its tables says so.
+func (p *Planner) routingTargetOf(stmt ast.Stmt, database string) (*routingTarget, error) {
+	switch stmt.(type) {
+	case *ast.SelectStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+	default:
+		return nil, nil
+	}
+	p.routingMu.RLock()
+	set := p.routingRules[database]
+	p.routingMu.RUnlock()
+	if set == nil || len(set.rules) == 0 {
+		return nil, nil
+	}
+
+	var refs []*ast.RangeVar
+	var ctes []string
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *ast.RangeVar:
+			refs = append(refs, n)
+		case *ast.CommonTableExpr:
+			ctes = append(ctes, n.Ctename)
+		}
+		return true
+	}, nil)
+
+	var target *routingTarget
+	var moved, stayed []string
+	for _, ref := range refs {
+		name := ref.RelName
+		if ref.SchemaName != "" {
+			name = ref.SchemaName + "." + name
+		} else if slices.Contains(ctes, name) {
+			continue
+		}
+		rule, ok := set.rules[name]
+		if !ok || rule.Tablegroup == "" {
+			stayed = append(stayed, name)
+		}
+		if !ok {
+			continue
+		}
+		if target == nil {
+			target = &routingTarget{}
+		}
+		if rule.ToTable != "" {
+			if target.renames == nil {
+				target.renames = make(map[*ast.RangeVar]string)
+			}
+			target.renames[ref] = rule.ToTable
+		}
+		if rule.Tablegroup != "" {
+			if len(moved) > 0 && (rule.Tablegroup != target.tableGroup || rule.Shard != target.shard) {
+				return nil, unsupportedRouting("tables %s and %s are routed to different targets", moved[0], name)
+			}
+			target.tableGroup = rule.Tablegroup
+			target.shard = rule.Shard
+			moved = append(moved, name)
+		}
+		switch {
+		case rule.PoolerType == clustermetadatapb.PoolerType_PRIMARY:
+			target.poolerType = rule.PoolerType
+		case rule.PoolerType == clustermetadatapb.PoolerType_REPLICA && target.poolerType == clustermetadatapb.PoolerType_UNKNOWN:
+			target.poolerType = rule.PoolerType
+		}
+	}
+	if len(moved) > 0 && len(stayed) > 0 {
+		return nil, unsupportedRouting("table %s is routed to tablegroup %s, but table %s is not", moved[0], target.tableGroup, stayed[0])
+	}
+	return target, nil
+}
+
+// planRouted creates the plan of stmt, whose tables have routing rules
+// sending it to target. The tables are renamed, keeping their name as
+// the alias of the references without one, and the statement is routed to
+// the target tablegroup and shard or planned as without the rules.
+func (p *Planner) planRouted(sql string, stmt ast.Stmt, target *routingTarget) (*engine.Plan, error) {
+	query := sql
+	if len(target.renames) > 0 {
+		for ref, table := range target.renames {
+			if ref.Alias == nil {
+				ref.Alias = ast.NewAlias(ref.RelName, nil)
+			}
+			if schema, name, ok := strings.Cut(table, "."); ok {
+				ref.SchemaName, ref.RelName = schema, name
+			} else {
+				ref.RelName = table
+			}
+		}
+		query = stmt.SqlString()
+	}
+
+	var plan *engine.Plan
+	switch {
+	case target.tableGroup != "":
+		plan = engine.NewPlan(sql, engine.NewRoute(target.tableGroup, target.shard, query))
+	case p.shardingSchema != nil:
+		var err error
+		if plan, err = p.planSharded(query, stmt); err != nil {
+			return nil, err
+		}
+		plan.Original = sql
+	default:
+		plan = engine.NewPlan(sql, engine.NewRoute(p.defaultTableGroup, "", query))
+	}
+	plan.PoolerType = target.poolerType
+
+	p.logger.Debug("created routed plan",
+		"plan", plan.String(),
+		"query", query,
+		"tablegroup", target.tableGroup,
+		"shard", target.shard,
+		"pooler_type", target.poolerType)
+	return plan, nil
+}
+
+// unsupportedRouting returns the error of a statement the routing rules
+// of its tables cannot route.
+func unsupportedRouting(format string, args ...any) error {
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("statement not supported by the routing rules: "+format, args...).
+		Err()
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// routedPlanner returns a planner of tablegroup tg with the routing rules
+// of the test connections' database.
+func routedPlanner(rules ...*clustermetadatapb.RoutingRule) *Planner {
+	p := NewPlanner("tg", slog.Default())
+	p.SetRoutingRules("", &clustermetadatapb.RoutingRules{Rules: rules, Version: 1})
+	return p
+}
+
+func TestPlanRoutingRules(t *testing.T) {
+	p := routedPlanner(
+		&clustermetadatapb.RoutingRule{FromTable: "orders", ToTable: "orders_v2"},
+		&clustermetadatapb.RoutingRule{FromTable: "sales.events", ToTable: "archive.events"},
+		&clustermetadatapb.RoutingRule{FromTable: "logs", Tablegroup: "archive", Shard: "0-inf"},
+		&clustermetadatapb.RoutingRule{FromTable: "audit", Tablegroup: "archive", Shard: "0-inf", ToTable: "audit_2025"},
+		&clustermetadatapb.RoutingRule{FromTable: "reports", PoolerType: clustermetadatapb.PoolerType_REPLICA},
+		&clustermetadatapb.RoutingRule{FromTable: "balances", PoolerType: clustermetadatapb.PoolerType_PRIMARY},
+	)
+
+	tests := []struct {
+		sql        string
+		tableGroup string
+		shard      string
+		query      string
+		poolerType clustermetadatapb.PoolerType
+	}{
+		{
+			sql:        "SELECT orders.id FROM orders WHERE id = 1",
+			tableGroup: "tg",
+			query:      "SELECT orders.id FROM orders_v2 AS orders WHERE id = 1",
+		},
+		{
+			sql:        "SELECT o.id FROM orders o",
+			tableGroup: "tg",
+			query:      "SELECT o.id FROM orders_v2 AS o",
+		},
+		{
+			sql:        "INSERT INTO orders (id) VALUES (1)",
+			tableGroup: "tg",
+			query:      "INSERT INTO orders_v2 AS orders (id) VALUES (1)",
+		},
+		{
+			sql:        "SELECT * FROM sales.events",
+			tableGroup: "tg",
+			query:      "SELECT * FROM archive.events AS events",
+		},
+		{
+			// The rules of unqualified names do not apply to other schemas.
+			sql:        "SELECT * FROM other.orders",
+			tableGroup: "tg",
+			query:      "SELECT * FROM other.orders",
+		},
+		{
+			sql:        "SELECT * FROM logs WHERE day = '2025-01-01'",
+			tableGroup: "archive",
+			shard:      "0-inf",
+			query:      "SELECT * FROM logs WHERE day = '2025-01-01'",
+		},
+		{
+			sql:        "SELECT * FROM logs JOIN audit USING (id)",
+			tableGroup: "archive",
+			shard:      "0-inf",
+			query:      "SELECT * FROM logs INNER JOIN audit_2025 AS audit USING (id)",
+		},
+		{
+			sql:        "SELECT * FROM reports",
+			tableGroup: "tg",
+			query:      "SELECT * FROM reports",
+			poolerType: clustermetadatapb.PoolerType_REPLICA,
+		},
+		{
+			// The primary wins over replicas.
+			sql:        "SELECT * FROM reports JOIN balances USING (id)",
+			tableGroup: "tg",
+			query:      "SELECT * FROM reports JOIN balances USING (id)",
+			poolerType: clustermetadatapb.PoolerType_PRIMARY,
+		},
+		{
+			// Common table expressions are not tables.
+			sql:        "WITH orders AS (SELECT 1) SELECT * FROM orders",
+			tableGroup: "tg",
+			query:      "WITH orders AS (SELECT 1) SELECT * FROM orders",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planWith(t, p, tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.sql, plan.Original)
+			assert.Equal(t, tt.poolerType, plan.PoolerType)
+			route, ok := plan.Primitive.(*engine.Route)
+			require.True(t, ok, "expected a Route, got %T", plan.Primitive)
+			assert.Equal(t, tt.tableGroup, route.TableGroup)
+			assert.Equal(t, tt.shard, route.Shard)
+			assert.Equal(t, tt.query, route.Query)
+		})
+	}
+}
+
+func TestPlanRoutingRulesUnsupported(t *testing.T) {
+	p := routedPlanner(
+		&clustermetadatapb.RoutingRule{FromTable: "logs", Tablegroup: "archive"},
+		&clustermetadatapb.RoutingRule{FromTable: "audit", Tablegroup: "audit"},
+	)
+	for _, sql := range []string{
+		"SELECT * FROM logs JOIN audit USING (id)",
+		"SELECT * FROM logs JOIN users USING (id)",
+		"INSERT INTO logs SELECT * FROM users",
+	} {
+		_, err := planWith(t, p, sql)
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag, sql)
+		assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code, sql)
+	}
+}
+
+func TestPlanRoutingRulesCache(t *testing.T) {
+	p := routedPlanner(&clustermetadatapb.RoutingRule{FromTable: "reports", PoolerType: clustermetadatapb.PoolerType_REPLICA})
+	p.SetPlanCache(NewPlanCache(10))
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	plan, err := planWith(t, p, "SELECT * FROM reports WHERE id = 1")
+	require.NoError(t, err)
+	require.Equal(t, clustermetadatapb.PoolerType_REPLICA, plan.PoolerType)
+
+	// The cached plan keeps the pooler type of the rule.
+	plan, _, ok := p.CachedPlan("SELECT * FROM reports WHERE id = 2", conn)
+	require.True(t, ok)
+	assert.Equal(t, clustermetadatapb.PoolerType_REPLICA, plan.PoolerType)
+
+	// New rules invalidate the cached plans of the database.
+	p.SetRoutingRules("", &clustermetadatapb.RoutingRules{Version: 2})
+	_, _, ok = p.CachedPlan("SELECT * FROM reports WHERE id = 2", conn)
+	assert.False(t, ok)
+
+	stmts, err := parser.ParseSQL("SELECT * FROM reports WHERE id = 3")
+	require.NoError(t, err)
+	plan, err = p.Plan("SELECT * FROM reports WHERE id = 3", stmts[0], conn)
+	require.NoError(t, err)
+	assert.Equal(t, clustermetadatapb.PoolerType_UNKNOWN, plan.PoolerType)
+}
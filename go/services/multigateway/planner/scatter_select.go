@@ -0,0 +1,361 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"math"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// sortKey is an ORDER BY item of a SELECT on several shards, ordering the
+// rows either by an output column or by an expression the shards must
+// return in a hidden column.
+type sortKey struct {
+	// target is the index of the output column, or -1.
+	target int
+	// hidden is the expression of the hidden column, when target is -1.
+	hidden ast.Node
+
+	desc       bool
+	nullsFirst bool
+	// collation is the collation of a COLLATE clause of the key.
+	collation string
+}
+
+// planScatterSelect creates the plan of a SELECT on several shards that
+// aggregates nothing. The shards return their rows in the order of the
+// statement, which the gateway merges, and LIMIT n OFFSET m runs on each
+// shard as LIMIT n+m: the OFFSET and LIMIT are applied by the gateway to
+// the merged rows.
+//
+// The expressions the statement is ordered by that are not output columns
+// are returned by the shards in leading hidden columns. The duplicates of
+// a DISTINCT returned by different shards are removed by the gateway. The
+// shards are narrowed down by lookup when set.
+func (p *Planner) planScatterSelect(sql string, stmt *ast.SelectStmt, shards []string, lookup *engine.ShardLookup) (*engine.Plan, error) {
+	hasSort := stmt.SortClause != nil && len(stmt.SortClause.Items) > 0
+	if !hasSort && stmt.LimitCount == nil && stmt.LimitOffset == nil && stmt.DistinctClause == nil {
+		route := p.newScatterRoute(p.defaultTableGroup, shards, sql)
+		route.Lookup = lookup
+		return engine.NewPlan(sql, route), nil
+	}
+
+	targets := selectTargets(stmt)
+	keys, err := sortKeys(stmt.SortClause, targets)
+	if err != nil {
+		return nil, err
+	}
+	count, offset, err := limitValues(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	var hidden []ast.Node
+	for _, key := range keys {
+		if key.target < 0 {
+			hidden = append(hidden, ast.NewResTarget("", key.hidden))
+		}
+	}
+	if len(hidden) > 0 && stmt.DistinctClause != nil {
+		return nil, unsupportedScatterSelect("DISTINCT ordered by expressions missing from the target list")
+	}
+	var orderBy []sqltypes.OrderByColumn
+	hiddenColumn := 0
+	for _, key := range keys {
+		column := sqltypes.OrderByColumn{Column: hiddenColumn, Desc: key.desc, NullsFirst: key.nullsFirst, Collation: key.collation}
+		if key.target >= 0 {
+			column.Column = len(hidden) + key.target
+		} else {
+			hiddenColumn++
+		}
+		orderBy = append(orderBy, column)
+	}
+
+	query := sql
+	if len(hidden) > 0 || stmt.LimitOffset != nil {
+		shardStmt := *stmt
+		if len(hidden) > 0 {
+			shardStmt.TargetList = ast.NewNodeList(append(hidden, stmt.TargetList.Items...)...)
+			shardStmt.SortClause = shiftedSortClause(stmt.SortClause, len(hidden))
+		}
+		shardStmt.LimitOffset = nil
+		shardStmt.LimitCount = nil
+		if count >= 0 {
+			if count > math.MaxInt64-offset {
+				return nil, unsupportedScatterSelect("LIMIT and OFFSET adding up beyond the range of bigint")
+			}
+			shardStmt.LimitCount = ast.NewA_Const(ast.NewInteger(int(count+offset)), -1)
+		}
+		query = shardStmt.SqlString()
+	}
+
+	route := p.newScatterRoute(p.defaultTableGroup, shards, query)
+	route.Lookup = lookup
+	route.OrderBy = orderBy
+	route.HiddenColumns = len(hidden)
+	var primitive engine.Primitive = route
+	if stmt.DistinctClause != nil {
+		columns, err := distinctColumns(stmt.DistinctClause, targets)
+		if err != nil {
+			return nil, err
+		}
+		// Spilling would lose the order of the merged rows.
+		primitive = p.newDistinct(route, columns, len(orderBy) == 0)
+	}
+	return engine.NewPlan(sql, withLimit(primitive, count, offset)), nil
+}
+
+// newScatterRoute returns a ScatterRoute of query on shards, buffering the
+// rows of the shards within the memory limit of the planner, and merging
+// text by the collations of the databases.
+func (p *Planner) newScatterRoute(tableGroup string, shards []string, query string) *engine.ScatterRoute {
+	route := engine.NewScatterRoute(tableGroup, shards, query)
+	route.MaxMemory = p.maxMemory
+	route.Collations = p.collations
+	return route
+}
+
+// newSort returns a Sort of the rows of input, ordering text by the
+// collations of the databases.
+func (p *Planner) newSort(input engine.Primitive, orderBy []sqltypes.OrderByColumn) *engine.Sort {
+	sort := engine.NewSort(input, orderBy)
+	sort.Collations = p.collations
+	return sort
+}
+
+// newDistinct returns a Distinct removing the duplicates of the rows of
+// input, within the memory limits of the planner. Spilling to disk is only
+// allowed if the order of the rows does not matter.
+func (p *Planner) newDistinct(input engine.Primitive, columns []int, spill bool) *engine.Distinct {
+	distinct := engine.NewDistinct(input, columns)
+	distinct.MaxMemory = p.maxMemory
+	if spill {
+		distinct.SpillThreshold = p.spillThreshold
+	}
+	return distinct
+}
+
+// selectTargets returns the target list of a SELECT.
+func selectTargets(stmt *ast.SelectStmt) []*ast.ResTarget {
+	var targets []*ast.ResTarget
+	if stmt.TargetList != nil {
+		for _, item := range stmt.TargetList.Items {
+			if target, ok := item.(*ast.ResTarget); ok {
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets
+}
+
+// knownTargets returns the number of output columns known before a query
+// runs: those before the first *, if any.
+func knownTargets(targets []*ast.ResTarget) int {
+	for i, target := range targets {
+		if ref, ok := target.Val.(*ast.ColumnRef); ok && isStarRef(ref) {
+			return i
+		}
+	}
+	return len(targets)
+}
+
+// distinctColumns returns the output columns of the expressions of a
+// DISTINCT ON, or nil for a DISTINCT on whole rows.
+func distinctColumns(distinctClause *ast.NodeList, targets []*ast.ResTarget) ([]int, error) {
+	known := knownTargets(targets)
+	var columns []int
+	for _, item := range distinctClause.Items {
+		column, ok, err := outputColumn(item, targets, known)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || column >= known {
+			return nil, unsupportedScatterSelect("DISTINCT ON expressions missing from the target list")
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// withLimit returns input, paginated by a LIMIT count, negative for none,
+// and an OFFSET.
+func withLimit(input engine.Primitive, count, offset int64) engine.Primitive {
+	if count < 0 && offset == 0 {
+		return input
+	}
+	return engine.NewLimit(input, count, offset)
+}
+
+// sortKeys returns the keys ordering the rows of a SELECT with the given
+// targets. An ORDER BY item refers to an output column by position, by
+// output name, or by being the same expression; any other expression is
+// returned as a hidden column.
+//
+// The output columns after a * are unknown until the query runs: items
+// referring to them by name or expression are returned as hidden columns
+// too, and by position are not supported.
+func sortKeys(sortClause *ast.NodeList, targets []*ast.ResTarget) ([]sortKey, error) {
+	if sortClause == nil {
+		return nil, nil
+	}
+	known := knownTargets(targets)
+	keys := make([]sortKey, 0, len(sortClause.Items))
+	for _, item := range sortClause.Items {
+		sortBy, ok := item.(*ast.SortBy)
+		if !ok {
+			return nil, unsupportedScatterSelect("its ORDER BY clause")
+		}
+		if sortBy.SortbyDir == ast.SORTBY_USING {
+			return nil, unsupportedScatterSelect("ORDER BY USING")
+		}
+		key := sortKey{target: -1, hidden: sortBy.Node, desc: sortBy.SortbyDir == ast.SORTBY_DESC}
+		if collate, ok := sortBy.Node.(*ast.CollateClause); ok && collate.Collname != nil && len(collate.Collname.Items) > 0 {
+			// The last part of a qualified name, such as pg_catalog."C".
+			if name, ok := collate.Collname.Items[len(collate.Collname.Items)-1].(*ast.String); ok {
+				key.collation = name.SVal
+			}
+		}
+		key.nullsFirst = key.desc
+		switch sortBy.SortbyNulls {
+		case ast.SORTBY_NULLS_FIRST:
+			key.nullsFirst = true
+		case ast.SORTBY_NULLS_LAST:
+			key.nullsFirst = false
+		}
+
+		column, ok, err := outputColumn(sortBy.Node, targets, known)
+		if err != nil {
+			return nil, err
+		}
+		if ok && column < known {
+			key.target = column
+		} else if ok {
+			key.hidden = targets[column].Val
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// outputColumn returns the output column an ORDER BY or DISTINCT ON item
+// refers to. Unlike in GROUP BY, a bare name refers to an output name
+// before an input column. Positions must be among the known output columns.
+func outputColumn(item ast.Node, targets []*ast.ResTarget, known int) (int, bool, error) {
+	if c, ok := item.(*ast.A_Const); ok {
+		if position, ok := c.Val.(*ast.Integer); ok {
+			if position.IVal < 1 || position.IVal > known {
+				return 0, false, unsupportedScatterSelect("positions past a * or the target list")
+			}
+			return position.IVal - 1, true, nil
+		}
+		return 0, false, nil
+	}
+	if ref, ok := item.(*ast.ColumnRef); ok && ref.Fields != nil && len(ref.Fields.Items) == 1 {
+		if name, ok := ref.Fields.Items[0].(*ast.String); ok {
+			for i, target := range targets {
+				if target.Name != "" && target.Name == name.SVal {
+					return i, true, nil
+				}
+			}
+		}
+	}
+	column, ok := groupColumn(item, targets)
+	return column, ok, nil
+}
+
+// shiftedSortClause returns a copy of sortClause whose ORDER BY positions
+// are shifted by n, for a target list with n more leading columns.
+func shiftedSortClause(sortClause *ast.NodeList, n int) *ast.NodeList {
+	items := make([]ast.Node, len(sortClause.Items))
+	for i, item := range sortClause.Items {
+		items[i] = item
+		sortBy, ok := item.(*ast.SortBy)
+		if !ok {
+			continue
+		}
+		if c, ok := sortBy.Node.(*ast.A_Const); ok {
+			if position, ok := c.Val.(*ast.Integer); ok {
+				shifted := *sortBy
+				shifted.Node = ast.NewA_Const(ast.NewInteger(position.IVal+n), -1)
+				items[i] = &shifted
+			}
+		}
+	}
+	return ast.NewNodeList(items...)
+}
+
+// limitValues returns the LIMIT of stmt, or -1 without limit, and its
+// OFFSET. Both must be integer constants.
+func limitValues(stmt *ast.SelectStmt) (count, offset int64, err error) {
+	if stmt.LimitOption == ast.LIMIT_OPTION_WITH_TIES {
+		return 0, 0, unsupportedScatterSelect("FETCH FIRST WITH TIES")
+	}
+	count = -1
+	if stmt.LimitCount != nil {
+		value, isNull, ok := integerConstant(stmt.LimitCount)
+		if !ok {
+			return 0, 0, unsupportedScatterSelect("a LIMIT that is not an integer constant")
+		}
+		// LIMIT ALL and LIMIT NULL do not limit the rows.
+		if !isNull {
+			count = value
+		}
+	}
+	if stmt.LimitOffset != nil {
+		value, isNull, ok := integerConstant(stmt.LimitOffset)
+		if !ok {
+			return 0, 0, unsupportedScatterSelect("an OFFSET that is not an integer constant")
+		}
+		if !isNull {
+			offset = value
+		}
+	}
+	return count, offset, nil
+}
+
+// integerConstant returns the value of a non-negative integer constant,
+// or isNull for NULL and ALL.
+func integerConstant(node ast.Node) (value int64, isNull bool, ok bool) {
+	c, ok := node.(*ast.A_Const)
+	if !ok {
+		return 0, false, false
+	}
+	if c.Isnull {
+		return 0, true, true
+	}
+	switch v := c.Val.(type) {
+	case *ast.Null:
+		return 0, true, true
+	case *ast.Integer:
+		if v.IVal < 0 {
+			return 0, false, false
+		}
+		return int64(v.IVal), false, true
+	}
+	return 0, false, false
+}
+
+// unsupportedScatterSelect returns the error for a SELECT on several shards
+// using a feature the gateway cannot apply to the merged rows.
+func unsupportedScatterSelect(feature string) error {
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("queries on several shards with %s are not supported", feature).
+		Hint("Restrict the query to a single shard with its shard key.").
+		Err()
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanScatterSelect(t *testing.T) {
+	tests := []struct {
+		sql        string
+		shardQuery string
+		orderBy    []sqltypes.OrderByColumn
+		hidden     int
+		count      int64
+		offset     int64
+		limited    bool
+	}{
+		{
+			sql:        "SELECT id, name FROM users ORDER BY name DESC, 1 NULLS FIRST",
+			shardQuery: "SELECT id, name FROM users ORDER BY name DESC, 1 NULLS FIRST",
+			orderBy:    []sqltypes.OrderByColumn{{Column: 1, Desc: true, NullsFirst: true}, {Column: 0, NullsFirst: true}},
+		},
+		{
+			sql:        "SELECT name AS id, id AS name FROM users ORDER BY id",
+			shardQuery: "SELECT name AS id, id AS name FROM users ORDER BY id",
+			orderBy:    []sqltypes.OrderByColumn{{Column: 0}},
+		},
+		{
+			sql:        "SELECT name FROM users ORDER BY id LIMIT 10",
+			shardQuery: "SELECT id, name FROM users ORDER BY id LIMIT 10",
+			orderBy:    []sqltypes.OrderByColumn{{Column: 0}},
+			hidden:     1,
+			count:      10,
+			limited:    true,
+		},
+		{
+			sql:        "SELECT * FROM users ORDER BY id DESC NULLS LAST",
+			shardQuery: "SELECT id, * FROM users ORDER BY id DESC NULLS LAST",
+			orderBy:    []sqltypes.OrderByColumn{{Column: 0, Desc: true}},
+			hidden:     1,
+		},
+		{
+			sql:        "SELECT *, lower(name) AS l FROM users ORDER BY l, id LIMIT 10 OFFSET 20",
+			shardQuery: "SELECT lower(name), id, *, lower(name) AS l FROM users ORDER BY l, id LIMIT 30",
+			orderBy:    []sqltypes.OrderByColumn{{Column: 0}, {Column: 1}},
+			hidden:     2,
+			count:      10,
+			offset:     20,
+			limited:    true,
+		},
+		{
+			sql:        "SELECT name FROM users ORDER BY id, 1 OFFSET 5",
+			shardQuery: "SELECT id, name FROM users ORDER BY id, 2",
+			orderBy:    []sqltypes.OrderByColumn{{Column: 0}, {Column: 1}},
+			hidden:     1,
+			count:      -1,
+			offset:     5,
+			limited:    true,
+		},
+		{
+			sql:        "SELECT id FROM users LIMIT ALL",
+			shardQuery: "SELECT id FROM users LIMIT ALL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			primitive := plan.Primitive
+			if tt.limited {
+				limit, ok := primitive.(*engine.Limit)
+				require.True(t, ok, "unexpected primitive %s", primitive)
+				assert.Equal(t, tt.count, limit.Count)
+				assert.Equal(t, tt.offset, limit.Offset)
+				primitive = limit.Input
+			}
+			route, ok := primitive.(*engine.ScatterRoute)
+			require.True(t, ok, "unexpected primitive %s", primitive)
+			assert.Equal(t, tt.shardQuery, route.Query)
+			assert.Equal(t, tt.orderBy, route.OrderBy)
+			assert.Equal(t, tt.hidden, route.HiddenColumns)
+		})
+	}
+}
+
+func TestPlanScatterSelectDistinct(t *testing.T) {
+	p := shardedPlanner()
+	p.SetMemoryLimits(1000, 100)
+
+	t.Run("unordered", func(t *testing.T) {
+		plan, err := planWith(t, p, "SELECT DISTINCT name FROM users")
+		require.NoError(t, err)
+		distinct, ok := plan.Primitive.(*engine.Distinct)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Nil(t, distinct.Columns)
+		assert.Equal(t, int64(1000), distinct.MaxMemory)
+		assert.Equal(t, int64(100), distinct.SpillThreshold)
+		assert.Equal(t, "SELECT DISTINCT name FROM users", distinct.Input.GetQuery())
+	})
+
+	t.Run("distinct on, ordered and limited", func(t *testing.T) {
+		plan, err := planWith(t, p, "SELECT DISTINCT ON (region) region, name AS n FROM users ORDER BY region, n LIMIT 5 OFFSET 5")
+		require.NoError(t, err)
+		limit, ok := plan.Primitive.(*engine.Limit)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		distinct, ok := limit.Input.(*engine.Distinct)
+		require.True(t, ok)
+		assert.Equal(t, []int{0}, distinct.Columns)
+		// The rows must stay in order.
+		assert.Zero(t, distinct.SpillThreshold)
+		route, ok := distinct.Input.(*engine.ScatterRoute)
+		require.True(t, ok)
+		assert.Equal(t, "SELECT DISTINCT ON (region) region, name AS n FROM users ORDER BY region, n LIMIT 10", route.Query)
+		assert.Equal(t, []sqltypes.OrderByColumn{{Column: 0}, {Column: 1}}, route.OrderBy)
+		assert.Equal(t, int64(1000), route.MaxMemory)
+	})
+}
+
+func TestPlanScatterSelectUnsupported(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT * FROM users ORDER BY 2",
+		"SELECT id FROM users ORDER BY id USING <",
+		"SELECT id FROM users ORDER BY id FETCH FIRST 3 ROWS WITH TIES",
+		"SELECT id FROM users LIMIT $1",
+		"SELECT id FROM users LIMIT 2 + 1",
+		"SELECT DISTINCT name FROM users ORDER BY id",
+		"SELECT DISTINCT ON (id) name FROM users ORDER BY id",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planSharded(t, sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
+
+func TestPlanScatterSelectCollation(t *testing.T) {
+	plan, err := planSharded(t, `SELECT name FROM users ORDER BY name COLLATE "C", region`)
+	require.NoError(t, err)
+	route, ok := plan.Primitive.(*engine.ScatterRoute)
+	require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+	assert.NotNil(t, route.Collations, "text is merged by the collations of the database")
+	assert.Equal(t, []sqltypes.OrderByColumn{{Column: 0, Collation: "C"}, {Column: 1}}, route.OrderBy)
+}
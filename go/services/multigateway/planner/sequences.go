@@ -0,0 +1,107 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planNextval creates the plan of a SELECT of the next values of global
+// sequences, such as SELECT nextval('users_id_seq'), as a SequenceNextval
+// answered by the gateway. It returns nil for other statements.
+func (p *Planner) planNextval(sql string, stmt ast.Stmt) *engine.Plan {
+	s, ok := stmt.(*ast.SelectStmt)
+	if !ok || p.sequences == nil || len(p.shardingSchema.Sequences) == 0 || !onlyTargetList(s) {
+		return nil
+	}
+	var columns []engine.NextvalColumn
+	for _, item := range s.TargetList.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok {
+			return nil
+		}
+		sequence, ok := nextvalSequence(target.Val)
+		if !ok || !p.isGlobalSequence(sequence) {
+			return nil
+		}
+		name := target.Name
+		if name == "" {
+			name = "nextval"
+		}
+		columns = append(columns, engine.NextvalColumn{Name: name, Sequence: sequence})
+	}
+	return engine.NewPlan(sql, &engine.SequenceNextval{
+		TableGroup: p.defaultTableGroup,
+		Query:      sql,
+		Columns:    columns,
+		Sequences:  p.sequences,
+	})
+}
+
+// isGlobalSequence returns true if name is a global sequence of the
+// sharding schema.
+func (p *Planner) isGlobalSequence(name string) bool {
+	for _, sequence := range p.shardingSchema.Sequences {
+		if sequence.Sequence == name {
+			return true
+		}
+	}
+	return false
+}
+
+// onlyTargetList returns true if a SELECT has a target list and no other
+// clause.
+func onlyTargetList(s *ast.SelectStmt) bool {
+	return s.Op == ast.SETOP_NONE && s.TargetList != nil && len(s.TargetList.Items) > 0 &&
+		(s.FromClause == nil || len(s.FromClause.Items) == 0) &&
+		s.WhereClause == nil && s.GroupClause == nil && s.HavingClause == nil &&
+		s.WindowClause == nil && s.DistinctClause == nil && s.SortClause == nil &&
+		s.LimitCount == nil && s.LimitOffset == nil && s.LockingClause == nil &&
+		s.WithClause == nil && s.IntoClause == nil && s.ValuesLists == nil
+}
+
+// isGeneratedValue returns true if the value of a generated column in an
+// INSERT row is to be generated: DEFAULT, or the nextval() of its
+// sequence.
+func isGeneratedValue(node ast.Node, sequence string) bool {
+	if _, ok := node.(*ast.SetToDefault); ok {
+		return true
+	}
+	name, ok := nextvalSequence(node)
+	return ok && name == sequence
+}
+
+// nextvalSequence returns the name of the sequence of a call of nextval()
+// with a constant name, optionally cast to regclass.
+func nextvalSequence(node ast.Node) (string, bool) {
+	call, ok := node.(*ast.FuncCall)
+	if !ok || funcName(call) != "nextval" || call.Args == nil || len(call.Args.Items) != 1 {
+		return "", false
+	}
+	arg := call.Args.Items[0]
+	if cast, ok := arg.(*ast.TypeCast); ok {
+		arg = cast.Arg
+	}
+	c, ok := arg.(*ast.A_Const)
+	if !ok {
+		return "", false
+	}
+	name, ok := c.Val.(*ast.String)
+	if !ok {
+		return "", false
+	}
+	return name.SVal, true
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// sequencePlanner returns a sharded planner whose users and orders take
+// their IDs from global sequences.
+func sequencePlanner() *Planner {
+	p := shardedPlanner()
+	p.shardingSchema.Sequences = map[string]TableSequence{
+		"users":  {Column: "id", Sequence: "users_id_seq"},
+		"orders": {Column: "id", Sequence: "orders_id_seq"},
+	}
+	p.SetGlobalSequences(engine.NewGlobalSequences(nil, "tg", "", engine.DefaultSequenceBlockSize))
+	return p
+}
+
+func TestPlanGeneratedInsert(t *testing.T) {
+	t.Run("column left out", func(t *testing.T) {
+		plan, err := planWith(t, sequencePlanner(), "INSERT INTO users (name) VALUES ('a'), ('b') RETURNING id")
+		require.NoError(t, err)
+		insert, ok := plan.Primitive.(*engine.ShardedInsert)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, "users_id_seq", insert.Sequence)
+		assert.Equal(t, 1, insert.Column)
+		assert.Equal(t, []bool{true, true}, insert.Generated)
+		// The shards of the rows depend on their generated shard key.
+		assert.Equal(t, []string{"", ""}, insert.RowShards)
+		assert.Equal(t, "INSERT INTO users (name, id) VALUES ('a', DEFAULT), ('b', DEFAULT) RETURNING id", insert.Insert.SqlString())
+	})
+
+	t.Run("DEFAULT and nextval", func(t *testing.T) {
+		plan, err := planWith(t, sequencePlanner(), "INSERT INTO users (id, name) VALUES (DEFAULT, 'a'), (4, 'b'), (nextval('users_id_seq'), 'c')")
+		require.NoError(t, err)
+		insert, ok := plan.Primitive.(*engine.ShardedInsert)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, 0, insert.Column)
+		assert.Equal(t, []bool{true, false, true}, insert.Generated)
+		assert.Equal(t, []string{"", "-80", ""}, insert.RowShards)
+	})
+
+	t.Run("generated column other than the shard key", func(t *testing.T) {
+		plan, err := planWith(t, sequencePlanner(), "INSERT INTO orders (user_id, total) VALUES (3, 10)")
+		require.NoError(t, err)
+		insert, ok := plan.Primitive.(*engine.ShardedInsert)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, 2, insert.Column)
+		assert.Equal(t, []string{"80-"}, insert.RowShards)
+	})
+
+	t.Run("values set", func(t *testing.T) {
+		plan, err := planWith(t, sequencePlanner(), "INSERT INTO users (id, name) VALUES (2, 'a')")
+		require.NoError(t, err)
+		assert.Equal(t, "Route(tablegroup=tg, shard=-80, query=INSERT INTO users (id, name) VALUES (2, 'a'))", plan.Primitive.String())
+	})
+
+	t.Run("shard key not constant", func(t *testing.T) {
+		_, err := planWith(t, sequencePlanner(), "INSERT INTO orders (user_id) VALUES (owner('x'))")
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag)
+		assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+	})
+}
+
+func TestPlanNextval(t *testing.T) {
+	plan, err := planWith(t, sequencePlanner(), "SELECT nextval('users_id_seq'), nextval('orders_id_seq'::regclass) AS o")
+	require.NoError(t, err)
+	nextval, ok := plan.Primitive.(*engine.SequenceNextval)
+	require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+	assert.Equal(t, []engine.NextvalColumn{
+		{Name: "nextval", Sequence: "users_id_seq"},
+		{Name: "o", Sequence: "orders_id_seq"},
+	}, nextval.Columns)
+
+	// Other sequences, and nextval() in queries reading tables, run on the
+	// shards.
+	for _, sql := range []string{
+		"SELECT nextval('other_seq')",
+		"SELECT nextval('users_id_seq') FROM users",
+	} {
+		plan, err := planWith(t, sequencePlanner(), sql)
+		require.NoError(t, err)
+		assert.NotContains(t, plan.Primitive.String(), "SequenceNextval", sql)
+	}
+}
@@ -0,0 +1,569 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/sharding"
+)
+
+// ShardingSchema describes how the tables of the default tablegroup are
+// distributed across its shards.
+type ShardingSchema struct {
+	// Shards are the shards of the tablegroup.
+	Shards []string
+
+	// Tables maps the name of each sharded table to its shard key column.
+	// Tables not listed are unsharded; statements that reference no sharded
+	// table run on the first shard.
+	Tables map[string]string
+
+	// ReferenceTables are the small tables copied whole on every shard,
+	// such as lookup lists. They are read from a single shard, joined with
+	// the sharded tables on the shards of these, and written on every
+	// shard.
+	ReferenceTables map[string]bool
+
+	// Sequences maps the name of each sharded table with a column generated
+	// by a global sequence to that column. The values are generated when
+	// an INSERT leaves the column out or sets it to DEFAULT or to the
+	// nextval() of the sequence.
+	Sequences map[string]TableSequence
+
+	// LookupIndexes maps the name of sharded tables to their columns indexed
+	// by lookup tables. A statement selecting rows by an indexed value
+	// rather than by the shard key runs on the shards the lookup table maps
+	// the value to. The lookup tables are unsharded, and their entries are
+	// added by the INSERTs of the rows and deleted by their DELETEs, in the
+	// same transaction.
+	LookupIndexes map[string][]LookupIndex
+
+	// Router maps shard key values to shards, such as a
+	// sharding.ShardingFunction.
+	Router engine.ShardKeyRouter
+
+	// Functions maps the name of sharded tables placed by their own
+	// sharding function, rather than by Router, to that function. See the
+	// sharding package for the built-in functions.
+	Functions map[string]sharding.ShardingFunction
+}
+
+// TableSequence is a column of a sharded table whose values are generated
+// by a global sequence of the gateway, unique across shards.
+type TableSequence struct {
+	Column   string
+	Sequence string
+}
+
+// LookupIndex is a column of a sharded table indexed by a lookup table: an
+// unsharded table with columns named like the indexed column and the shard
+// key, mapping the values of the column to the shard key values of their
+// rows.
+type LookupIndex struct {
+	Column string
+	Table  string
+}
+
+// SetShardingSchema sets how the tables of the default tablegroup are
+// sharded. Without a sharding schema, every statement is routed to the
+// tablegroup as a whole. The cached plans are invalidated.
+func (p *Planner) SetShardingSchema(schema *ShardingSchema) {
+	p.shardingSchema = schema
+	p.collations = nil
+	if schema != nil {
+		p.collations = engine.NewCollations(p.defaultTableGroup, schema.Shards[0])
+	}
+	if p.cache != nil {
+		p.cache.InvalidateAll()
+	}
+}
+
+// keyValue is a constant compared with a shard key column, in text form.
+type keyValue struct {
+	text   string
+	isNull bool
+}
+
+// shardedTarget is a sharded table read or written by a statement at its
+// top level, with the qualification restricting its rows.
+type shardedTarget struct {
+	rel   *ast.RangeVar
+	where ast.Node
+}
+
+// planSharded creates the plan of a statement on the sharded default
+// tablegroup: a Route to a single shard when the shard key predicates of the
+// statement select one, a ScatterRoute to every shard otherwise. The rows a
+// SELECT returns from several shards are merged in its order and
+// paginated, after combining the partial aggregates of the shards for an
+// aggregate SELECT. The branches of a UNION not restricted to a single
+// shard are planned separately, and the rows of two sharded tables joined
+// on different shards are joined by the gateway. The statements writing
+// reference tables run on every shard. The values of global sequences are
+// generated by the gateway. The rows selected by a column with a lookup
+// index are found on the shards of the lookup table entries.
+func (p *Planner) planSharded(sql string, stmt ast.Stmt) (*engine.Plan, error) {
+	if p.writesReferenceTable(stmt) {
+		return p.planReferenceWrite(sql, stmt)
+	}
+	if plan := p.planNextval(sql, stmt); plan != nil {
+		return plan, nil
+	}
+	schema := p.shardingSchema
+	refs := p.shardedRefs(stmt)
+	if len(refs) == 0 {
+		return engine.NewPlan(sql, engine.NewRoute(p.defaultTableGroup, schema.Shards[0], sql)), nil
+	}
+
+	var shards []string
+	var err error
+	if insert, ok := stmt.(*ast.InsertStmt); ok {
+		if plan, err := p.planShardedInsert(sql, insert); plan != nil || err != nil {
+			return plan, err
+		}
+		shards, err = p.insertShards(insert)
+	} else {
+		shards, err = p.queryShards(stmt, refs)
+	}
+	if err != nil {
+		// The branches of a UNION, and the tables of a join, may run on
+		// different shards.
+		if select_, ok := stmt.(*ast.SelectStmt); ok {
+			if select_.Op != ast.SETOP_NONE {
+				return p.planUnion(sql, select_)
+			}
+			if _, _, _, _, ok := p.joinedTables(select_); ok {
+				return p.planJoin(sql, select_)
+			}
+		}
+		return nil, err
+	}
+	var lookup *engine.ShardLookup
+	if len(shards) > 1 {
+		lookup = p.shardLookup(stmt, refs)
+		if select_, ok := stmt.(*ast.SelectStmt); ok && select_.Op == ast.SETOP_NONE {
+			if isAggregateQuery(select_) {
+				return p.planScatterAggregate(sql, select_, shards, lookup)
+			}
+			return p.planScatterSelect(sql, select_, shards, lookup)
+		}
+	}
+
+	var primitive engine.Primitive
+	if len(shards) == 1 {
+		primitive = engine.NewRoute(p.defaultTableGroup, shards[0], sql)
+	} else {
+		route := p.newScatterRoute(p.defaultTableGroup, shards, sql)
+		route.Lookup = lookup
+		primitive = route
+	}
+	if delete, ok := stmt.(*ast.DeleteStmt); ok && len(schema.LookupIndexes[delete.Relation.RelName]) > 0 {
+		return p.planLookupDelete(sql, delete, primitive)
+	}
+	return engine.NewPlan(sql, primitive), nil
+}
+
+// queryShards returns the shards a SELECT, UPDATE or DELETE must run on.
+//
+// A statement reading a single sharded table runs on the shards its shard
+// key predicates select, or on every shard. A statement combining several
+// sharded tables, with a join, a subquery or a set operation, must be
+// restricted to one and the same shard by each of them: the rows of the
+// tables on other shards would otherwise be missed.
+func (p *Planner) queryShards(stmt ast.Stmt, refs []*ast.RangeVar) ([]string, error) {
+	if update, ok := stmt.(*ast.UpdateStmt); ok {
+		if err := p.checkKeyNotUpdated(update); err != nil {
+			return nil, err
+		}
+		if err := p.checkLookupNotUpdated(update); err != nil {
+			return nil, err
+		}
+	}
+
+	targets := p.topLevelTargets(stmt)
+	combined := len(refs) > 1
+	if select_, ok := stmt.(*ast.SelectStmt); ok && select_.Op != ast.SETOP_NONE {
+		combined = true
+	}
+	if !combined {
+		if len(targets) == 0 {
+			// The sharded table is read in a subquery.
+			return p.shardingSchema.Shards, nil
+		}
+		return p.targetShards(targets[0])
+	}
+
+	single := len(targets) == len(refs)
+	var shard string
+	for _, target := range targets {
+		shards, err := p.targetShards(target)
+		if err != nil {
+			return nil, err
+		}
+		if len(shards) != 1 || (shard != "" && shards[0] != shard) {
+			single = false
+			break
+		}
+		shard = shards[0]
+	}
+	if !single || shard == "" {
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("statements combining several sharded tables must target a single shard").
+			Hint("Restrict every sharded table to the same shard key value.").
+			Err()
+	}
+	return []string{shard}, nil
+}
+
+// targetShards returns the shards holding the rows of a sharded table that
+// a qualification selects: those of its shard key values if it compares the
+// key with constants, every shard otherwise.
+func (p *Planner) targetShards(target shardedTarget) ([]string, error) {
+	column := p.shardingSchema.Tables[target.rel.RelName]
+	values, ok := keyValuesOf(target.where, target.rel, column)
+	if !ok {
+		return p.shardingSchema.Shards, nil
+	}
+	return p.shardsForValues(target.rel.RelName, values)
+}
+
+// insertShards returns the shard an INSERT must run on. All the rows of
+// the INSERT must go to the same shard.
+func (p *Planner) insertShards(stmt *ast.InsertStmt) ([]string, error) {
+	column := p.shardingSchema.Tables[stmt.Relation.RelName]
+	keyIndex := insertColumn(stmt.Cols, column)
+	if keyIndex < 0 {
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("INSERT into sharded table %q must list its shard key column %q", stmt.Relation.RelName, column).
+			Err()
+	}
+
+	source, ok := stmt.SelectStmt.(*ast.SelectStmt)
+	if !ok || source.ValuesLists == nil {
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("INSERT into sharded table %q must use VALUES", stmt.Relation.RelName).
+			Err()
+	}
+	var values []keyValue
+	for _, item := range source.ValuesLists.Items {
+		row, ok := item.(*ast.NodeList)
+		if !ok || keyIndex >= len(row.Items) {
+			continue
+		}
+		value, ok := constantValue(row.Items[keyIndex])
+		if !ok {
+			return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+				Msg("shard key %q of an INSERT must be a constant", column).
+				Err()
+		}
+		values = append(values, value)
+	}
+
+	shards, err := p.shardsForValues(stmt.Relation.RelName, values)
+	if err != nil {
+		return nil, err
+	}
+	if len(shards) != 1 {
+		return nil, sqlstate.NewError(sqlstate.FeatureNotSupported).
+			Msg("INSERT of rows belonging to several shards is not supported").
+			Hint("Insert the rows of each shard with a separate statement.").
+			Err()
+	}
+	return shards, nil
+}
+
+// checkKeyNotUpdated rejects an UPDATE setting the shard key of a sharded
+// table, which would leave the row on the wrong shard.
+func (p *Planner) checkKeyNotUpdated(stmt *ast.UpdateStmt) error {
+	column, ok := p.shardingSchema.Tables[stmt.Relation.RelName]
+	if !ok || stmt.TargetList == nil {
+		return nil
+	}
+	for _, item := range stmt.TargetList.Items {
+		if target, ok := item.(*ast.ResTarget); ok && target.Name == column {
+			return sqlstate.NewError(sqlstate.FeatureNotSupported).
+				Msg("cannot update shard key column %q of sharded table %q", column, stmt.Relation.RelName).
+				Err()
+		}
+	}
+	return nil
+}
+
+// router returns what maps the shard key values of a sharded table to
+// shards: its sharding function, or the Router of the sharding schema.
+func (p *Planner) router(table string) engine.ShardKeyRouter {
+	if f, ok := p.shardingSchema.Functions[table]; ok {
+		return f
+	}
+	return p.shardingSchema.Router
+}
+
+// shardsForValues returns the shards of the given shard key values of a
+// sharded table, in the order of the tablegroup's shards.
+func (p *Planner) shardsForValues(table string, values []keyValue) ([]string, error) {
+	router := p.router(table)
+	var shards []string
+	for _, value := range values {
+		shard, err := router.ShardForKey([]byte(value.text), value.isNull)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(shards, shard) {
+			shards = append(shards, shard)
+		}
+	}
+	slices.SortFunc(shards, func(a, b string) int {
+		return slices.Index(p.shardingSchema.Shards, a) - slices.Index(p.shardingSchema.Shards, b)
+	})
+	return shards, nil
+}
+
+// UnshardedShard returns the shard of the default tablegroup running the
+// statements that reference no sharded table: the first shard with a
+// sharding schema, and otherwise "", the tablegroup as a whole.
+func (p *Planner) UnshardedShard() string {
+	if p.shardingSchema == nil {
+		return ""
+	}
+	return p.shardingSchema.Shards[0]
+}
+
+// Shards returns the shards of the default tablegroup in the sharding
+// schema, or nil without a sharding schema.
+func (p *Planner) Shards() []string {
+	if p.shardingSchema == nil {
+		return nil
+	}
+	return p.shardingSchema.Shards
+}
+
+// ReferencesShardedTables returns true if stmt reads or writes a sharded
+// table of the default tablegroup, anywhere in the statement.
+func (p *Planner) ReferencesShardedTables(stmt ast.Stmt) bool {
+	return p.shardingSchema != nil && len(p.shardedRefs(stmt)) > 0
+}
+
+// shardedRefs returns the references to sharded tables anywhere in stmt.
+func (p *Planner) shardedRefs(stmt ast.Stmt) []*ast.RangeVar {
+	var refs []*ast.RangeVar
+	ast.Rewrite(stmt, func(cursor *ast.Cursor) bool {
+		rel, ok := cursor.Node().(*ast.RangeVar)
+		if !ok {
+			return true
+		}
+		if _, sharded := p.shardingSchema.Tables[rel.RelName]; sharded {
+			refs = append(refs, rel)
+		}
+		return true
+	}, nil)
+	return refs
+}
+
+// topLevelTargets returns the sharded tables stmt reads or writes directly:
+// the relations in the FROM clauses of a SELECT and of the branches of its
+// set operations, joined or not, or the target of an UPDATE or DELETE.
+// Tables read in subqueries are left out.
+func (p *Planner) topLevelTargets(stmt ast.Stmt) []shardedTarget {
+	var targets []shardedTarget
+	add := func(rel *ast.RangeVar, where ast.Node) {
+		if _, sharded := p.shardingSchema.Tables[rel.RelName]; sharded {
+			targets = append(targets, shardedTarget{rel: rel, where: where})
+		}
+	}
+
+	// The WHERE clause restricts the tables of the joins in FROM as well:
+	// it drops the rows a join extends with NULLs for them.
+	var addFrom func(item ast.Node, where ast.Node)
+	addFrom = func(item ast.Node, where ast.Node) {
+		switch n := item.(type) {
+		case *ast.RangeVar:
+			add(n, where)
+		case *ast.JoinExpr:
+			addFrom(n.Larg, where)
+			addFrom(n.Rarg, where)
+		}
+	}
+
+	var addSelect func(s *ast.SelectStmt)
+	addSelect = func(s *ast.SelectStmt) {
+		if s == nil {
+			return
+		}
+		if s.Op != ast.SETOP_NONE {
+			addSelect(s.Larg)
+			addSelect(s.Rarg)
+			return
+		}
+		if s.FromClause == nil {
+			return
+		}
+		for _, item := range s.FromClause.Items {
+			addFrom(item, s.WhereClause)
+		}
+	}
+
+	switch s := stmt.(type) {
+	case *ast.SelectStmt:
+		addSelect(s)
+	case *ast.UpdateStmt:
+		add(s.Relation, s.WhereClause)
+	case *ast.DeleteStmt:
+		add(s.Relation, s.WhereClause)
+	}
+	return targets
+}
+
+// keyValuesOf returns the shard key values a qualification restricts a
+// relation to: it must be a conjunction with a term "key = constant" or
+// "key IN (constants)". ok is false if the qualification does not restrict
+// the key.
+func keyValuesOf(where ast.Node, rel *ast.RangeVar, column string) (values []keyValue, ok bool) {
+	for _, term := range conjuncts(where) {
+		expr, isExpr := term.(*ast.A_Expr)
+		if !isExpr || operatorName(expr) != "=" {
+			continue
+		}
+		switch expr.Kind {
+		case ast.AEXPR_OP:
+			for _, pair := range [][2]ast.Node{{expr.Lexpr, expr.Rexpr}, {expr.Rexpr, expr.Lexpr}} {
+				if !isKeyColumn(pair[0], rel, column) {
+					continue
+				}
+				if value, isConst := constantValue(pair[1]); isConst {
+					return []keyValue{value}, true
+				}
+			}
+		case ast.AEXPR_IN:
+			list, isList := expr.Rexpr.(*ast.NodeList)
+			if !isKeyColumn(expr.Lexpr, rel, column) || !isList {
+				continue
+			}
+			values = values[:0]
+			for _, item := range list.Items {
+				value, isConst := constantValue(item)
+				if !isConst {
+					values = nil
+					break
+				}
+				values = append(values, value)
+			}
+			if values != nil {
+				return values, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// conjuncts returns the terms of a conjunction, or the node itself.
+func conjuncts(node ast.Node) []ast.Node {
+	if node == nil {
+		return nil
+	}
+	expr, ok := node.(*ast.BoolExpr)
+	if !ok || expr.Boolop != ast.AND_EXPR || expr.Args == nil {
+		return []ast.Node{node}
+	}
+	var terms []ast.Node
+	for _, arg := range expr.Args.Items {
+		terms = append(terms, conjuncts(arg)...)
+	}
+	return terms
+}
+
+// operatorName returns the name of an unqualified operator, or "".
+func operatorName(expr *ast.A_Expr) string {
+	if expr.Name == nil || len(expr.Name.Items) != 1 {
+		return ""
+	}
+	name, ok := expr.Name.Items[0].(*ast.String)
+	if !ok {
+		return ""
+	}
+	return name.SVal
+}
+
+// isKeyColumn returns true if node references the key column of rel,
+// unqualified or qualified with the relation's name or alias.
+func isKeyColumn(node ast.Node, rel *ast.RangeVar, column string) bool {
+	ref, ok := node.(*ast.ColumnRef)
+	if !ok || ref.Fields == nil {
+		return false
+	}
+	var parts []string
+	for _, field := range ref.Fields.Items {
+		s, ok := field.(*ast.String)
+		if !ok {
+			return false
+		}
+		parts = append(parts, s.SVal)
+	}
+	switch len(parts) {
+	case 1:
+		return parts[0] == column
+	case 2:
+		qualifier := rel.RelName
+		if rel.Alias != nil && rel.Alias.AliasName != "" {
+			qualifier = rel.Alias.AliasName
+		}
+		return parts[0] == qualifier && parts[1] == column
+	}
+	return false
+}
+
+// constantValue returns the text form of a constant, optionally cast or
+// negated.
+func constantValue(node ast.Node) (keyValue, bool) {
+	switch n := node.(type) {
+	case *ast.TypeCast:
+		return constantValue(n.Arg)
+	case *ast.A_Expr:
+		// A negative number is parsed as a unary minus.
+		if n.Kind != ast.AEXPR_OP || n.Lexpr != nil || operatorName(n) != "-" {
+			return keyValue{}, false
+		}
+		value, ok := constantValue(n.Rexpr)
+		if !ok || value.isNull {
+			return keyValue{}, false
+		}
+		if _, err := strconv.ParseFloat(value.text, 64); err != nil {
+			return keyValue{}, false
+		}
+		return keyValue{text: "-" + value.text}, true
+	case *ast.A_Const:
+		if n.Isnull {
+			return keyValue{isNull: true}, true
+		}
+		switch v := n.Val.(type) {
+		case *ast.Integer:
+			return keyValue{text: strconv.Itoa(v.IVal)}, true
+		case *ast.Float:
+			return keyValue{text: v.FVal}, true
+		case *ast.String:
+			return keyValue{text: v.SVal}, true
+		case *ast.Boolean:
+			if v.BoolVal {
+				return keyValue{text: "t"}, true
+			}
+			return keyValue{text: "f"}, true
+		}
+	}
+	return keyValue{}, false
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/multigres/multigres/go/services/multigateway/sharding"
+)
+
+// ShardingSchemaConfig is the YAML document describing the sharding schema
+// of the default tablegroup, such as:
+//
+//	shards: ["-80", "80-"]
+//	function: hash
+//	tables:
+//	  users: {column: id}
+//	  events: {column: day, function: range, params: {boundaries: "20260101"}}
+//	reference_tables: [countries]
+//	sequences:
+//	  users: {column: id, sequence: users_id_seq}
+//	lookup_indexes:
+//	  users: [{column: email, table: users_email_lookup}]
+type ShardingSchemaConfig struct {
+	// Shards are the shards of the tablegroup, the first one holding the
+	// unsharded tables.
+	Shards []string `yaml:"shards"`
+
+	// Function and Params are the sharding function placing the rows of
+	// the sharded tables without their own, hash by default.
+	Function string            `yaml:"function"`
+	Params   map[string]string `yaml:"params"`
+
+	Tables          map[string]ShardedTableConfig `yaml:"tables"`
+	ReferenceTables []string                      `yaml:"reference_tables"`
+	Sequences       map[string]TableSequence      `yaml:"sequences"`
+	LookupIndexes   map[string][]LookupIndex      `yaml:"lookup_indexes"`
+}
+
+// ShardedTableConfig is a sharded table of a ShardingSchemaConfig: its
+// shard key column, and the sharding function placing its rows if it is
+// not the one of the schema.
+type ShardedTableConfig struct {
+	Column   string            `yaml:"column"`
+	Function string            `yaml:"function"`
+	Params   map[string]string `yaml:"params"`
+}
+
+// LoadShardingSchema reads the ShardingSchemaConfig of the file at path and
+// creates the sharding schema it describes.
+func LoadShardingSchema(path string) (*ShardingSchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sharding schema: %w", err)
+	}
+	config, err := ParseShardingSchemaConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sharding schema %s: %w", path, err)
+	}
+	schema, err := config.Build()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sharding schema %s: %w", path, err)
+	}
+	return schema, nil
+}
+
+// ParseShardingSchemaConfig parses the YAML document of a
+// ShardingSchemaConfig.
+func ParseShardingSchemaConfig(data []byte) (*ShardingSchemaConfig, error) {
+	var config ShardingSchemaConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Build creates the sharding schema described by the config, checking
+// that its sharding functions exist for its shards, that its reference
+// tables are not sharded and that its sequences and lookup indexes are on
+// sharded tables.
+func (c *ShardingSchemaConfig) Build() (*ShardingSchema, error) {
+	if len(c.Shards) == 0 {
+		return nil, errors.New("no shards")
+	}
+	function := c.Function
+	if function == "" {
+		function = "hash"
+	}
+	router, err := sharding.New(function, c.Shards, c.Params)
+	if err != nil {
+		return nil, err
+	}
+	schema := &ShardingSchema{
+		Shards:          c.Shards,
+		Tables:          make(map[string]string, len(c.Tables)),
+		ReferenceTables: make(map[string]bool, len(c.ReferenceTables)),
+		Sequences:       c.Sequences,
+		LookupIndexes:   c.LookupIndexes,
+		Router:          router,
+	}
+	for name, table := range c.Tables {
+		if table.Column == "" {
+			return nil, fmt.Errorf("table %s: no shard key column", name)
+		}
+		schema.Tables[name] = table.Column
+		if table.Function == "" {
+			continue
+		}
+		f, err := sharding.New(table.Function, c.Shards, table.Params)
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", name, err)
+		}
+		if schema.Functions == nil {
+			schema.Functions = make(map[string]sharding.ShardingFunction)
+		}
+		schema.Functions[name] = f
+	}
+	for _, name := range c.ReferenceTables {
+		if _, ok := schema.Tables[name]; ok {
+			return nil, fmt.Errorf("table %s is both sharded and a reference table", name)
+		}
+		schema.ReferenceTables[name] = true
+	}
+	for name, seq := range c.Sequences {
+		if _, ok := schema.Tables[name]; !ok {
+			return nil, fmt.Errorf("sequence of table %s: not a sharded table", name)
+		}
+		if seq.Column == "" || seq.Sequence == "" {
+			return nil, fmt.Errorf("sequence of table %s: column and sequence are required", name)
+		}
+	}
+	for name, indexes := range c.LookupIndexes {
+		if _, ok := schema.Tables[name]; !ok {
+			return nil, fmt.Errorf("lookup index of table %s: not a sharded table", name)
+		}
+		for _, index := range indexes {
+			if index.Column == "" || index.Table == "" {
+				return nil, fmt.Errorf("lookup index of table %s: column and table are required", name)
+			}
+		}
+	}
+	return schema, nil
+}
+
+// TableDefinitionsQuery reads the columns of the tables of a database, as
+// rows of table and column names, for its TableDefinitions.
+const TableDefinitionsQuery = "SELECT table_name, column_name FROM information_schema.columns WHERE table_schema NOT IN ('pg_catalog', 'information_schema') ORDER BY table_name, ordinal_position"
+
+// TableDefinitions maps the tables of a database to their columns.
+type TableDefinitions map[string][]string
+
+// hasColumn returns true if table exists and has column.
+func (d TableDefinitions) hasColumn(table, column string) bool {
+	return slices.Contains(d[table], column)
+}
+
+// Validate checks that the config describes a valid sharding schema (see
+// Build) of the tables of a database: that its sharded tables have their
+// shard key column, that its reference tables exist, that the columns of
+// its sequences exist, and that its lookup indexes are on existing columns
+// and their lookup tables have the indexed and shard key columns. It
+// returns all the problems found.
+func (c *ShardingSchemaConfig) Validate(tables TableDefinitions) error {
+	if _, err := c.Build(); err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(c.Tables)) {
+		column := c.Tables[name].Column
+		switch {
+		case tables[name] == nil:
+			errs = append(errs, fmt.Errorf("sharded table %s does not exist", name))
+		case !tables.hasColumn(name, column):
+			errs = append(errs, fmt.Errorf("sharded table %s has no shard key column %s", name, column))
+		}
+	}
+	for _, name := range c.ReferenceTables {
+		if tables[name] == nil {
+			errs = append(errs, fmt.Errorf("reference table %s does not exist", name))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Sequences)) {
+		seq := c.Sequences[name]
+		if tables[name] != nil && !tables.hasColumn(name, seq.Column) {
+			errs = append(errs, fmt.Errorf("sequence %s: table %s has no column %s", seq.Sequence, name, seq.Column))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.LookupIndexes)) {
+		key := c.Tables[name].Column
+		for _, index := range c.LookupIndexes[name] {
+			if tables[name] != nil && !tables.hasColumn(name, index.Column) {
+				errs = append(errs, fmt.Errorf("lookup index of table %s: no column %s", name, index.Column))
+			}
+			switch {
+			case tables[index.Table] == nil:
+				errs = append(errs, fmt.Errorf("lookup index of table %s: lookup table %s does not exist", name, index.Table))
+			case !tables.hasColumn(index.Table, index.Column) || !tables.hasColumn(index.Table, key):
+				errs = append(errs, fmt.Errorf("lookup index of table %s: lookup table %s must have the columns %s and %s", name, index.Table, index.Column, key))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
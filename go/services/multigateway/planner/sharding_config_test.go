@@ -0,0 +1,138 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadShardingSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+shards: ["-80", "80-"]
+tables:
+  users: {column: id}
+  events: {column: day, function: range, params: {boundaries: "20260101"}}
+reference_tables: [countries]
+sequences:
+  users: {column: id, sequence: users_id_seq}
+lookup_indexes:
+  users: [{column: email, table: users_email_lookup}]
+`), 0o600))
+
+	schema, err := LoadShardingSchema(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-80", "80-"}, schema.Shards)
+	assert.Equal(t, map[string]string{"users": "id", "events": "day"}, schema.Tables)
+	assert.Equal(t, map[string]bool{"countries": true}, schema.ReferenceTables)
+	assert.Equal(t, TableSequence{Column: "id", Sequence: "users_id_seq"}, schema.Sequences["users"])
+	assert.Equal(t, []LookupIndex{{Column: "email", Table: "users_email_lookup"}}, schema.LookupIndexes["users"])
+	require.NotNil(t, schema.Router, "hash is the default function")
+	require.Contains(t, schema.Functions, "events")
+	shard, err := schema.Functions["events"].ShardForKey([]byte("20270101"), false)
+	require.NoError(t, err)
+	assert.Equal(t, "80-", shard)
+	assert.NotContains(t, schema.Functions, "users")
+
+	_, err = LoadShardingSchema(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read sharding schema")
+}
+
+func TestShardingSchemaConfigBuildErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ShardingSchemaConfig
+		err    string
+	}{
+		{"no shards", ShardingSchemaConfig{}, "no shards"},
+		{"unknown function", ShardingSchemaConfig{Shards: []string{"-"}, Function: "nope"}, "sharding function 'nope' not found"},
+		{"no column", ShardingSchemaConfig{
+			Shards: []string{"-"},
+			Tables: map[string]ShardedTableConfig{"users": {}},
+		}, "no shard key column"},
+		{"sharded reference table", ShardingSchemaConfig{
+			Shards:          []string{"-"},
+			Tables:          map[string]ShardedTableConfig{"users": {Column: "id"}},
+			ReferenceTables: []string{"users"},
+		}, "both sharded and a reference table"},
+		{"sequence of unsharded table", ShardingSchemaConfig{
+			Shards:    []string{"-"},
+			Sequences: map[string]TableSequence{"users": {Column: "id", Sequence: "s"}},
+		}, "not a sharded table"},
+		{"lookup index without table", ShardingSchemaConfig{
+			Shards:        []string{"-"},
+			Tables:        map[string]ShardedTableConfig{"users": {Column: "id"}},
+			LookupIndexes: map[string][]LookupIndex{"users": {{Column: "email"}}},
+		}, "column and table are required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.config.Build()
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestShardingSchemaConfigValidate(t *testing.T) {
+	config, err := ParseShardingSchemaConfig([]byte(`
+shards: ["-80", "80-"]
+tables:
+  users: {column: id}
+  orders: {column: user_id}
+reference_tables: [countries]
+sequences:
+  users: {column: id, sequence: users_id_seq}
+lookup_indexes:
+  users: [{column: email, table: users_email_lookup}]
+`))
+	require.NoError(t, err)
+
+	tables := TableDefinitions{
+		"users":              {"id", "email", "name"},
+		"orders":             {"id", "user_id"},
+		"countries":          {"code", "name"},
+		"users_email_lookup": {"email", "id"},
+	}
+	require.NoError(t, config.Validate(tables))
+
+	tables = TableDefinitions{
+		"users":              {"uid", "name"},
+		"users_email_lookup": {"email"},
+	}
+	err = config.Validate(tables)
+	require.Error(t, err)
+	for _, problem := range []string{
+		"sharded table orders does not exist",
+		"sharded table users has no shard key column id",
+		"reference table countries does not exist",
+		"sequence users_id_seq: table users has no column id",
+		"lookup index of table users: no column email",
+		"lookup table users_email_lookup must have the columns email and id",
+	} {
+		assert.ErrorContains(t, err, problem)
+	}
+
+	// An invalid schema is refused before looking at the tables.
+	config.Shards = nil
+	assert.ErrorContains(t, config.Validate(tables), "no shards")
+
+	_, err = ParseShardingSchemaConfig([]byte("shards: {"))
+	assert.Error(t, err)
+}
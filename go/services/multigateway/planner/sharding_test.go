@@ -0,0 +1,207 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"bytes"
+	"log/slog"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/sharding"
+)
+
+// evenOddRouter sends even integer keys to shard "-80", the others to
+// "80-".
+type evenOddRouter struct{}
+
+func (evenOddRouter) ShardForKey(key []byte, isNull bool) (string, error) {
+	if isNull {
+		return "-80", nil
+	}
+	n, err := strconv.Atoi(string(key))
+	if err != nil {
+		return "", err
+	}
+	if n%2 == 0 {
+		return "-80", nil
+	}
+	return "80-", nil
+}
+
+// shardedPlanner returns a planner of a tablegroup with shards "-80" and
+// "80-", sharding the tables users by id and orders by user_id.
+func shardedPlanner() *Planner {
+	p := NewPlanner("tg", slog.Default())
+	p.SetShardingSchema(&ShardingSchema{
+		Shards:          []string{"-80", "80-"},
+		Tables:          map[string]string{"users": "id", "orders": "user_id"},
+		ReferenceTables: map[string]bool{"countries": true},
+		Router:          evenOddRouter{},
+	})
+	return p
+}
+
+func planSharded(t *testing.T, sql string) (*engine.Plan, error) {
+	t.Helper()
+	return planWith(t, shardedPlanner(), sql)
+}
+
+func planWith(t *testing.T, p *Planner, sql string) (*engine.Plan, error) {
+	t.Helper()
+	stmts, err := parser.ParseSQL(sql)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	return p.Plan(sql, stmts[0], server.NewTestConn(&bytes.Buffer{}).Conn)
+}
+
+func TestPlanSharded(t *testing.T) {
+	tests := []struct {
+		sql    string
+		shards []string
+	}{
+		{"SELECT * FROM users WHERE id = 4", []string{"-80"}},
+		{"SELECT * FROM users u WHERE u.id = -3 AND name = 'a'", []string{"80-"}},
+		{"SELECT * FROM users WHERE 7 = id", []string{"80-"}},
+		{"SELECT * FROM users WHERE id = '2'::int", []string{"-80"}},
+		{"SELECT * FROM users WHERE id IN (1, 3)", []string{"80-"}},
+		{"SELECT * FROM users WHERE id IN (1, 2)", []string{"-80", "80-"}},
+		{"SELECT * FROM users WHERE id = 1 OR id = 2", []string{"-80", "80-"}},
+		{"SELECT * FROM users WHERE id > 1", []string{"-80", "80-"}},
+		{"SELECT * FROM (SELECT * FROM users) s", []string{"-80", "80-"}},
+		{"UPDATE users SET name = 'b' WHERE id = 5", []string{"80-"}},
+		{"DELETE FROM users", []string{"-80", "80-"}},
+		{"INSERT INTO users (name, id) VALUES ('a', 2), ('b', 4)", []string{"-80"}},
+		{"SELECT * FROM users, orders WHERE users.id = 2 AND orders.user_id = 2", []string{"-80"}},
+		{"SELECT * FROM users JOIN orders ON orders.user_id = users.id WHERE users.id = 2 AND orders.user_id = 2", []string{"-80"}},
+		{"SELECT 1 FROM users WHERE id = 1 UNION SELECT 1 FROM orders WHERE user_id = 3", []string{"80-"}},
+		// Statements without sharded tables run on the first shard.
+		{"SELECT * FROM settings", []string{"-80"}},
+		{"SELECT now()", []string{"-80"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planSharded(t, tt.sql)
+			require.NoError(t, err)
+			switch primitive := plan.Primitive.(type) {
+			case *engine.Route:
+				assert.Equal(t, tt.shards, []string{primitive.Shard})
+			case *engine.ScatterRoute:
+				assert.Equal(t, tt.shards, primitive.Shards)
+			default:
+				t.Fatalf("unexpected primitive %s", plan.Primitive)
+			}
+		})
+	}
+}
+
+func TestPlanShardedUnsupported(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT * FROM users JOIN orders ON orders.user_id = users.id",
+		"SELECT * FROM users, orders WHERE users.id = 2 AND orders.user_id = 3",
+		"SELECT 1 FROM users INTERSECT SELECT 1 FROM orders",
+		"INSERT INTO users (id) VALUES (1), (2)",
+		"INSERT INTO users VALUES (1)",
+		"INSERT INTO users (id) SELECT user_id FROM orders",
+		"UPDATE users SET id = 2 WHERE id = 1",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planSharded(t, sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
+
+func TestPlanUnsharded(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	sql := "SELECT * FROM users WHERE id = 4"
+	stmts, err := parser.ParseSQL(sql)
+	require.NoError(t, err)
+
+	plan, err := p.Plan(sql, stmts[0], server.NewTestConn(&bytes.Buffer{}).Conn)
+	require.NoError(t, err)
+	route, ok := plan.Primitive.(*engine.Route)
+	require.True(t, ok)
+	assert.Equal(t, "", route.Shard)
+	assert.Equal(t, engine.StatementRead, plan.Class)
+}
+
+func TestPlanShardingFunctions(t *testing.T) {
+	p := shardedPlanner()
+	orders, err := sharding.New("range", p.shardingSchema.Shards, map[string]string{"boundaries": "100"})
+	require.NoError(t, err)
+	p.shardingSchema.Functions = map[string]sharding.ShardingFunction{"orders": orders}
+
+	tests := []struct {
+		sql   string
+		shard string
+	}{
+		{"SELECT * FROM orders WHERE user_id = 51", "-80"},
+		{"SELECT * FROM orders WHERE user_id = 150", "80-"},
+		{"INSERT INTO orders (user_id) VALUES (150), (151)", "80-"},
+		// The other tables keep the router of the schema.
+		{"SELECT * FROM users WHERE id = 51", "80-"},
+		{"SELECT * FROM users, orders WHERE users.id = 2 AND orders.user_id = 2", "-80"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planWith(t, p, tt.sql)
+			require.NoError(t, err)
+			route, ok := plan.Primitive.(*engine.Route)
+			require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+			assert.Equal(t, tt.shard, route.Shard)
+		})
+	}
+
+	t.Run("rows of a join on different shards", func(t *testing.T) {
+		plan, err := planWith(t, p, "SELECT users.name, orders.total FROM users JOIN orders ON orders.user_id = users.id WHERE users.id = 150")
+		require.NoError(t, err)
+		join, ok := plan.Primitive.(*engine.Join)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		// The orders of the users are looked up on their shards.
+		assert.Equal(t, orders, join.InnerRouter)
+	})
+}
+
+func TestPlanShardedCopy(t *testing.T) {
+	plan, err := planSharded(t, "COPY users (name, id) FROM STDIN")
+	require.NoError(t, err)
+	copyPrimitive, ok := plan.Primitive.(*engine.ShardedCopy)
+	require.True(t, ok, "got %T", plan.Primitive)
+	assert.Equal(t, []string{"-80", "80-"}, copyPrimitive.Shards)
+	assert.Equal(t, 1, copyPrimitive.KeyIndex)
+	assert.Equal(t, evenOddRouter{}, copyPrimitive.Router)
+
+	// The rows of unsharded tables are copied as they are.
+	plan, err = planSharded(t, "COPY settings FROM STDIN")
+	require.NoError(t, err)
+	assert.IsType(t, &engine.CopyStatement{}, plan.Primitive)
+
+	_, err = planSharded(t, "COPY users FROM STDIN")
+	assert.ErrorContains(t, err, `must list its shard key column "id"`)
+
+	_, err = planSharded(t, "COPY users (id) FROM STDIN WITH (FORMAT binary)")
+	assert.Error(t, err, "binary rows cannot be split")
+}
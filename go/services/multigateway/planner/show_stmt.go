@@ -0,0 +1,38 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planShowStmt plans SHOW commands. Parameters owned by the gateway, and
+// SHOW ALL, go through a Show primitive answering from the session state;
+// the others are passed through to PostgreSQL.
+func (p *Planner) planShowStmt(
+	sql string,
+	stmt *ast.VariableShowStmt,
+	conn *server.Conn,
+) (*engine.Plan, error) {
+	if !engine.IsGatewayParameter(stmt.Name) {
+		return p.planDefault(sql, stmt, conn)
+	}
+
+	plan := engine.NewPlan(sql, engine.NewShow(p.defaultTableGroup, "", sql, stmt.Name))
+	p.logger.Debug("created SHOW plan", "plan", plan.String())
+	return plan, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"slices"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// statStatementsSchema and statStatementsTable name the virtual table of
+// the statement statistics of the gateway.
+const (
+	statStatementsSchema = "multigres"
+	statStatementsTable  = "stat_statements"
+)
+
+// SetQueryStats sets the statement statistics answering SELECTs of
+// multigres.stat_statements, or nil if the gateway keeps none.
+func (p *Planner) SetQueryStats(stats *engine.QueryStats) {
+	p.queryStats = stats
+}
+
+// isStatStatements returns true if stmt is a SELECT of
+// multigres.stat_statements alone.
+func isStatStatements(stmt ast.Stmt) bool {
+	sel, ok := stmt.(*ast.SelectStmt)
+	if !ok || sel.FromClause == nil || len(sel.FromClause.Items) != 1 {
+		return false
+	}
+	rv, ok := sel.FromClause.Items[0].(*ast.RangeVar)
+	return ok && rv.SchemaName == statStatementsSchema && rv.RelName == statStatementsTable
+}
+
+// planStatStatements plans a SELECT of multigres.stat_statements, answered
+// by the gateway from its statement statistics. It may select columns by
+// name, order them and limit them; filtering and expressions are left to
+// the client.
+func (p *Planner) planStatStatements(sql string, stmt *ast.SelectStmt) (*engine.Plan, error) {
+	if p.queryStats == nil {
+		return nil, sqlstate.NewError(sqlstate.ObjectNotInPrerequisiteState).
+			Msg("%s.%s is not available", statStatementsSchema, statStatementsTable).
+			Hint("Set --query-stats-size of the gateway above 0 to keep statement statistics.").
+			Err()
+	}
+	if stmt.WhereClause != nil || stmt.GroupClause != nil || stmt.HavingClause != nil ||
+		stmt.DistinctClause != nil || stmt.WithClause != nil || stmt.LockingClause != nil {
+		return nil, unsupportedStatStatements("WHERE, GROUP BY, DISTINCT, WITH or FOR UPDATE")
+	}
+
+	names := engine.StatStatementsColumns()
+	var columns []engine.StatStatementsColumn
+	for _, target := range selectTargets(stmt) {
+		ref, ok := target.Val.(*ast.ColumnRef)
+		if !ok {
+			return nil, unsupportedStatStatements("expressions")
+		}
+		if isStarRef(ref) {
+			for i, name := range names {
+				columns = append(columns, engine.StatStatementsColumn{Column: i, Name: name})
+			}
+			continue
+		}
+		name, ok := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.String)
+		if !ok {
+			return nil, unsupportedStatStatements("expressions")
+		}
+		column := slices.Index(names, name.SVal)
+		if column < 0 {
+			return nil, sqlstate.NewError(sqlstate.UndefinedColumn).
+				Msg("column \"%s\" does not exist", name.SVal).Err()
+		}
+		output := target.Name
+		if output == "" {
+			output = name.SVal
+		}
+		columns = append(columns, engine.StatStatementsColumn{Column: column, Name: output})
+	}
+
+	orderBy, err := statStatementsOrderBy(stmt.SortClause, columns)
+	if err != nil {
+		return nil, err
+	}
+	count, offset, err := limitValues(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	var primitive engine.Primitive = engine.NewStatStatements(sql, p.queryStats, columns)
+	if len(orderBy) > 0 {
+		// Text is ordered bytewise, without asking a backend for the
+		// collations of the database.
+		primitive = engine.NewSort(primitive, orderBy)
+	}
+	return engine.NewPlan(sql, withLimit(primitive, count, offset)), nil
+}
+
+// statStatementsOrderBy returns the order of the rows of a SELECT of
+// multigres.stat_statements: by the position or the name of output
+// columns.
+func statStatementsOrderBy(sortClause *ast.NodeList, columns []engine.StatStatementsColumn) ([]sqltypes.OrderByColumn, error) {
+	if sortClause == nil {
+		return nil, nil
+	}
+	orderBy := make([]sqltypes.OrderByColumn, 0, len(sortClause.Items))
+	for _, item := range sortClause.Items {
+		sortBy, ok := item.(*ast.SortBy)
+		if !ok || sortBy.SortbyDir == ast.SORTBY_USING {
+			return nil, unsupportedStatStatements("its ORDER BY clause")
+		}
+		column := -1
+		switch node := sortBy.Node.(type) {
+		case *ast.A_Const:
+			if position, ok := node.Val.(*ast.Integer); ok && position.IVal >= 1 && position.IVal <= len(columns) {
+				column = position.IVal - 1
+			}
+		case *ast.ColumnRef:
+			if name, ok := node.Fields.Items[len(node.Fields.Items)-1].(*ast.String); ok {
+				column = slices.IndexFunc(columns, func(c engine.StatStatementsColumn) bool {
+					return c.Name == name.SVal
+				})
+			}
+		}
+		if column < 0 {
+			return nil, unsupportedStatStatements("an ORDER BY item that is not an output column")
+		}
+		desc := sortBy.SortbyDir == ast.SORTBY_DESC
+		nullsFirst := desc
+		switch sortBy.SortbyNulls {
+		case ast.SORTBY_NULLS_FIRST:
+			nullsFirst = true
+		case ast.SORTBY_NULLS_LAST:
+			nullsFirst = false
+		}
+		orderBy = append(orderBy, sqltypes.OrderByColumn{Column: column, Desc: desc, NullsFirst: nullsFirst})
+	}
+	return orderBy, nil
+}
+
+// unsupportedStatStatements returns the error for a SELECT of
+// multigres.stat_statements using a feature the gateway does not apply.
+func unsupportedStatStatements(feature string) error {
+	return sqlstate.NewError(sqlstate.FeatureNotSupported).
+		Msg("queries on %s.%s with %s are not supported", statStatementsSchema, statStatementsTable, feature).
+		Hint("Select its columns, ordered and limited, and filter them in the client.").
+		Err()
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func statStatementsPlanner() *Planner {
+	p := NewPlanner("tg", slog.Default())
+	p.SetQueryStats(engine.NewQueryStats(10))
+	return p
+}
+
+func TestPlanStatStatements(t *testing.T) {
+	tests := []struct {
+		sql     string
+		plan    string
+		columns []engine.StatStatementsColumn
+	}{
+		{
+			"SELECT query, calls AS n FROM multigres.stat_statements",
+			"StatStatements(columns=2)",
+			[]engine.StatStatementsColumn{{Column: 3, Name: "query"}, {Column: 4, Name: "n"}},
+		},
+		{
+			"SELECT s.query, mean_exec_time FROM multigres.stat_statements s ORDER BY mean_exec_time DESC LIMIT 5",
+			"Limit(count=5, offset=0, input=Sort(order_by=1 DESC, input=StatStatements(columns=2)))",
+			[]engine.StatStatementsColumn{{Column: 3, Name: "query"}, {Column: 8, Name: "mean_exec_time"}},
+		},
+		{
+			"SELECT calls, query FROM multigres.stat_statements ORDER BY 2",
+			"Sort(order_by=1, input=StatStatements(columns=2))",
+			[]engine.StatStatementsColumn{{Column: 4, Name: "calls"}, {Column: 3, Name: "query"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planWith(t, statStatementsPlanner(), tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plan, plan.Primitive.String())
+			primitive := plan.Primitive
+			for {
+				switch p := primitive.(type) {
+				case *engine.Limit:
+					primitive = p.Input
+					continue
+				case *engine.Sort:
+					primitive = p.Input
+					continue
+				}
+				break
+			}
+			stats, ok := primitive.(*engine.StatStatements)
+			require.True(t, ok, "got %s", primitive)
+			assert.Equal(t, tt.columns, stats.Columns)
+		})
+	}
+}
+
+func TestPlanStatStatementsStar(t *testing.T) {
+	plan, err := planWith(t, statStatementsPlanner(), "SELECT * FROM multigres.stat_statements")
+	require.NoError(t, err)
+	stats, ok := plan.Primitive.(*engine.StatStatements)
+	require.True(t, ok, "got %s", plan.Primitive)
+	require.Len(t, stats.Columns, len(engine.StatStatementsColumns()))
+	assert.Equal(t, "queryid", stats.Columns[0].Name)
+}
+
+func TestPlanStatStatementsErrors(t *testing.T) {
+	tests := []struct {
+		sql     string
+		planner *Planner
+		code    string
+	}{
+		{"SELECT * FROM multigres.stat_statements", NewPlanner("tg", slog.Default()), sqlstate.ObjectNotInPrerequisiteState},
+		{"SELECT * FROM multigres.stat_statements WHERE calls > 1", statStatementsPlanner(), sqlstate.FeatureNotSupported},
+		{"SELECT calls + 1 FROM multigres.stat_statements", statStatementsPlanner(), sqlstate.FeatureNotSupported},
+		{"SELECT query FROM multigres.stat_statements ORDER BY calls", statStatementsPlanner(), sqlstate.FeatureNotSupported},
+		{"SELECT nope FROM multigres.stat_statements", statStatementsPlanner(), sqlstate.UndefinedColumn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			_, err := planWith(t, tt.planner, tt.sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, tt.code, diag.Code)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+)
+
+// defaultSchema is the schema of the tables named without one.
+const defaultSchema = "public"
+
+// SetTables replaces the tables of database known to the planner by
+// tables, keyed by their name qualified by their schema, and invalidates
+// the cached plans of the statements on the tables that changed.
+func (p *Planner) SetTables(database string, tables TableDefinitions) {
+	p.tablesMu.Lock()
+	current := p.tables[database]
+	var changed []string
+	for name, columns := range tables {
+		if previous, ok := current[name]; !ok || !slices.Equal(previous, columns) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range current {
+		if _, ok := tables[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	if p.tables == nil {
+		p.tables = make(map[string]TableDefinitions)
+	}
+	p.tables[database] = maps.Clone(tables)
+	p.tablesMu.Unlock()
+
+	p.invalidateTables(database, changed)
+	p.logger.Info("tables set", "database", database, "tables", len(tables), "changed", len(changed))
+}
+
+// UpdateTables changes the tables of database known to the planner: the
+// tables of changed are added or replaced, and those of dropped removed,
+// all keyed by their name qualified by their schema. The cached plans of
+// the statements on these tables are invalidated.
+func (p *Planner) UpdateTables(database string, changed TableDefinitions, dropped []string) {
+	p.tablesMu.Lock()
+	if p.tables == nil {
+		p.tables = make(map[string]TableDefinitions)
+	}
+	tables := maps.Clone(p.tables[database])
+	if tables == nil {
+		tables = make(TableDefinitions)
+	}
+	maps.Copy(tables, changed)
+	for _, name := range dropped {
+		delete(tables, name)
+	}
+	p.tables[database] = tables
+	p.tablesMu.Unlock()
+
+	p.invalidateTables(database, append(slices.Collect(maps.Keys(changed)), dropped...))
+	p.logger.Info("tables updated", "database", database, "changed", len(changed), "dropped", len(dropped))
+}
+
+// TableColumns returns the columns of a table of database, in order, and
+// false if the planner does not know the table. A table named without a
+// schema is looked up in the public schema.
+func (p *Planner) TableColumns(database, schema, table string) ([]string, bool) {
+	if schema == "" {
+		schema = defaultSchema
+	}
+	p.tablesMu.RLock()
+	defer p.tablesMu.RUnlock()
+	columns, ok := p.tables[database][schema+"."+table]
+	return columns, ok
+}
+
+// invalidateTables removes the cached plans of the statements of database
+// on tables, given by their qualified names.
+func (p *Planner) invalidateTables(database string, tables []string) {
+	if p.cache == nil || len(tables) == 0 {
+		return
+	}
+	// The cached plans only know the tables of their statements by name.
+	names := make([]string, len(tables))
+	for i, table := range tables {
+		_, names[i], _ = strings.Cut(table, ".")
+	}
+	p.cache.InvalidateTables(database, names)
+}
+
+// withColumnList returns a copy of stmt, an INSERT of VALUES without a
+// column list, listing the columns of its table the VALUES give, or nil if
+// the planner does not know them.
+func (p *Planner) withColumnList(stmt *ast.InsertStmt, database string) *ast.InsertStmt {
+	if stmt.Cols != nil || stmt.Relation == nil {
+		return nil
+	}
+	source, ok := stmt.SelectStmt.(*ast.SelectStmt)
+	if !ok || source.ValuesLists == nil || len(source.ValuesLists.Items) == 0 {
+		return nil
+	}
+	row, ok := source.ValuesLists.Items[0].(*ast.NodeList)
+	if !ok {
+		return nil
+	}
+	columns, ok := p.TableColumns(database, stmt.Relation.SchemaName, stmt.Relation.RelName)
+	if !ok || len(row.Items) > len(columns) {
+		return nil
+	}
+
+	cols := make([]ast.Node, len(row.Items))
+	for i := range cols {
+		cols[i] = ast.NewResTarget(columns[i], nil)
+	}
+	listed := *stmt
+	listed.Cols = ast.NewNodeList(cols...)
+	return &listed
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlannerTables(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	p.SetTables("db1", TableDefinitions{
+		"public.users": {"id", "name"},
+		"sales.orders": {"id", "user_id"},
+	})
+
+	columns, ok := p.TableColumns("db1", "", "users")
+	require.True(t, ok)
+	assert.Equal(t, []string{"id", "name"}, columns)
+	_, ok = p.TableColumns("db1", "", "orders")
+	assert.False(t, ok)
+	_, ok = p.TableColumns("db2", "public", "users")
+	assert.False(t, ok)
+
+	p.UpdateTables("db1", TableDefinitions{"public.users": {"id", "name", "email"}}, []string{"sales.orders"})
+	columns, _ = p.TableColumns("db1", "public", "users")
+	assert.Equal(t, []string{"id", "name", "email"}, columns)
+	_, ok = p.TableColumns("db1", "sales", "orders")
+	assert.False(t, ok)
+
+	// A full set of tables replaces those known.
+	p.SetTables("db1", TableDefinitions{"public.events": {"id"}})
+	_, ok = p.TableColumns("db1", "public", "users")
+	assert.False(t, ok)
+}
+
+func TestPlannerTablesInvalidateCachedPlans(t *testing.T) {
+	p := NewPlanner("tg", slog.Default())
+	p.SetPlanCache(NewPlanCache(10))
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	p.SetTables(conn.Database(), TableDefinitions{"public.users": {"id"}, "public.orders": {"id"}})
+
+	planAndCache(t, p, "SELECT * FROM users WHERE id = 1")
+	planAndCache(t, p, "SELECT * FROM orders WHERE id = 1")
+
+	// Only the plans of the statements on the changed tables go.
+	p.UpdateTables(conn.Database(), TableDefinitions{"public.users": {"id", "name"}}, nil)
+	_, _, ok := p.CachedPlan("SELECT * FROM users WHERE id = 2", conn)
+	assert.False(t, ok)
+	_, _, ok = p.CachedPlan("SELECT * FROM orders WHERE id = 2", conn)
+	assert.True(t, ok)
+
+	// Setting the same tables again changes nothing.
+	planAndCache(t, p, "SELECT * FROM users WHERE id = 1")
+	p.SetTables(conn.Database(), TableDefinitions{"public.users": {"id", "name"}, "public.orders": {"id"}})
+	_, _, ok = p.CachedPlan("SELECT * FROM users WHERE id = 2", conn)
+	assert.True(t, ok)
+
+	p.SetTables(conn.Database(), TableDefinitions{"public.users": {"id", "name"}})
+	_, _, ok = p.CachedPlan("SELECT * FROM orders WHERE id = 2", conn)
+	assert.False(t, ok)
+}
+
+func TestPlanShardedInsertWithoutColumnList(t *testing.T) {
+	p := shardedPlanner()
+
+	// Without the columns of the table, the shard key cannot be found.
+	_, err := planWith(t, p, "INSERT INTO users VALUES (4, 'a')")
+	assert.ErrorContains(t, err, `must list its shard key column "id"`)
+
+	p.SetTables("", TableDefinitions{"public.users": {"id", "name", "email"}})
+	plan, err := planWith(t, p, "INSERT INTO users VALUES (4, 'a')")
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO users VALUES (4, 'a')", plan.Original)
+	route, ok := plan.Primitive.(*engine.Route)
+	require.True(t, ok, "got %T", plan.Primitive)
+	assert.Equal(t, "-80", route.Shard)
+	assert.Equal(t, "INSERT INTO users (id, name) VALUES (4, 'a')", route.Query)
+}
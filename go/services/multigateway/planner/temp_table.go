@@ -0,0 +1,30 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planTempTable plans statements that create or drop temporary relations,
+// e.g. CREATE TEMP TABLE or DISCARD TEMP. They are routed like any other
+// statement, but the session is pinned to its reserved connection while it
+// has temporary relations.
+func (p *Planner) planTempTable(sql string, usage engine.TempTableUsage) (*engine.Plan, error) {
+	route := engine.NewTempTableRoute(p.defaultTableGroup, "", sql, usage)
+	plan := engine.NewPlan(sql, route)
+	p.logger.Debug("created temp table plan", "plan", plan.String())
+	return plan, nil
+}
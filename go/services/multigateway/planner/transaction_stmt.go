@@ -0,0 +1,32 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planTransactionStmt plans transaction control statements. They run on
+// every shard of the transaction where needed (COMMIT, ROLLBACK and the
+// statements acting on savepoints), close cursors if they end the
+// transaction, and the transaction boundary is then recorded so that
+// session variables follow the transaction's outcome.
+func (p *Planner) planTransactionStmt(sql string, stmt *ast.TransactionStmt) (*engine.Plan, error) {
+	input := engine.NewTransactionStmtRoute(p.defaultTableGroup, "", sql, stmt)
+	plan := engine.NewPlan(sql, engine.NewTransactionControl(stmt, input))
+	p.logger.Debug("created transaction control plan", "plan", plan.String())
+	return plan, nil
+}
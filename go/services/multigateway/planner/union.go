@@ -0,0 +1,103 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// planUnion creates the plan of a UNION whose branches do not all run on
+// the same shard: each branch is planned on its own, the rows of the
+// branches are concatenated for UNION ALL, and their duplicates removed by
+// the gateway for UNION. The ORDER BY, LIMIT and OFFSET of the UNION are
+// applied by the gateway to the combined rows.
+//
+// INTERSECT and EXCEPT, and UNION with a WITH clause shared by its
+// branches, are not supported.
+func (p *Planner) planUnion(sql string, stmt *ast.SelectStmt) (*engine.Plan, error) {
+	if stmt.WithClause != nil {
+		return nil, unsupportedScatterSelect("WITH on a UNION of branches on different shards")
+	}
+	primitive, err := p.planSetOperand(stmt)
+	if err != nil {
+		return nil, err
+	}
+	return engine.NewPlan(sql, primitive), nil
+}
+
+// planSetOperand returns the primitive of an operand of a set operation:
+// a SELECT, planned like a statement of its own, or a nested UNION.
+func (p *Planner) planSetOperand(stmt *ast.SelectStmt) (engine.Primitive, error) {
+	if stmt.Op == ast.SETOP_NONE {
+		plan, err := p.planSharded(stmt.SqlString(), stmt)
+		if err != nil {
+			return nil, err
+		}
+		return plan.Primitive, nil
+	}
+	if stmt.Op != ast.SETOP_UNION {
+		return nil, unsupportedScatterSelect(stmt.Op.String() + " of branches on different shards")
+	}
+
+	var inputs []engine.Primitive
+	for _, operand := range []*ast.SelectStmt{stmt.Larg, stmt.Rarg} {
+		input, err := p.planSetOperand(operand)
+		if err != nil {
+			return nil, err
+		}
+		// Nested UNION ALLs concatenate all their branches at once.
+		if concatenate, ok := input.(*engine.Concatenate); ok {
+			inputs = append(inputs, concatenate.Inputs...)
+			continue
+		}
+		inputs = append(inputs, input)
+	}
+	var primitive engine.Primitive = engine.NewConcatenate(inputs)
+	if !stmt.All {
+		// The rows are ordered after, if at all.
+		primitive = p.newDistinct(primitive, nil, true)
+	}
+
+	keys, err := sortKeys(stmt.SortClause, selectTargets(leftmostSelect(stmt)))
+	if err != nil {
+		return nil, err
+	}
+	var orderBy []sqltypes.OrderByColumn
+	for _, key := range keys {
+		if key.target < 0 {
+			return nil, unsupportedScatterSelect("ORDER BY on a UNION by expressions missing from the target list")
+		}
+		orderBy = append(orderBy, sqltypes.OrderByColumn{Column: key.target, Desc: key.desc, NullsFirst: key.nullsFirst, Collation: key.collation})
+	}
+	if len(orderBy) > 0 {
+		primitive = p.newSort(primitive, orderBy)
+	}
+	count, offset, err := limitValues(stmt)
+	if err != nil {
+		return nil, err
+	}
+	return withLimit(primitive, count, offset), nil
+}
+
+// leftmostSelect returns the first SELECT of a set operation, which names
+// its output columns.
+func leftmostSelect(stmt *ast.SelectStmt) *ast.SelectStmt {
+	for stmt.Op != ast.SETOP_NONE {
+		stmt = stmt.Larg
+	}
+	return stmt
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+func TestPlanUnion(t *testing.T) {
+	t.Run("union all", func(t *testing.T) {
+		plan, err := planSharded(t, "SELECT id FROM users UNION ALL SELECT user_id FROM orders WHERE user_id = 3 UNION ALL SELECT 0")
+		require.NoError(t, err)
+		concatenate, ok := plan.Primitive.(*engine.Concatenate)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		require.Len(t, concatenate.Inputs, 3)
+		assert.Equal(t, "ScatterRoute(tablegroup=tg, shards=-80,80-, query=SELECT id FROM users)", concatenate.Inputs[0].String())
+		assert.Equal(t, "Route(tablegroup=tg, shard=80-, query=SELECT user_id FROM orders WHERE user_id = 3)", concatenate.Inputs[1].String())
+		assert.Equal(t, "Route(tablegroup=tg, shard=-80, query=SELECT 0)", concatenate.Inputs[2].String())
+	})
+
+	t.Run("union ordered and limited", func(t *testing.T) {
+		p := shardedPlanner()
+		p.SetMemoryLimits(1000, 100)
+		plan, err := planWith(t, p, "SELECT id AS n FROM users WHERE id = 1 UNION SELECT user_id FROM orders WHERE user_id = 2 ORDER BY n DESC LIMIT 3")
+		require.NoError(t, err)
+		limit, ok := plan.Primitive.(*engine.Limit)
+		require.True(t, ok, "unexpected primitive %s", plan.Primitive)
+		assert.Equal(t, int64(3), limit.Count)
+		sort, ok := limit.Input.(*engine.Sort)
+		require.True(t, ok)
+		assert.Equal(t, []sqltypes.OrderByColumn{{Column: 0, Desc: true, NullsFirst: true}}, sort.OrderBy)
+		distinct, ok := sort.Input.(*engine.Distinct)
+		require.True(t, ok)
+		// The rows are sorted after their duplicates are removed.
+		assert.Equal(t, int64(100), distinct.SpillThreshold)
+		assert.Equal(t, int64(1000), distinct.MaxMemory)
+		concatenate, ok := distinct.Input.(*engine.Concatenate)
+		require.True(t, ok)
+		require.Len(t, concatenate.Inputs, 2)
+		assert.Equal(t, "SELECT id AS n FROM users WHERE id = 1", concatenate.Inputs[0].GetQuery())
+		assert.Equal(t, "SELECT user_id FROM orders WHERE user_id = 2", concatenate.Inputs[1].GetQuery())
+	})
+
+	t.Run("same shard", func(t *testing.T) {
+		plan, err := planSharded(t, "SELECT id FROM users WHERE id = 2 UNION SELECT user_id FROM orders WHERE user_id = 4")
+		require.NoError(t, err)
+		_, ok := plan.Primitive.(*engine.Route)
+		assert.True(t, ok, "unexpected primitive %s", plan.Primitive)
+	})
+}
+
+func TestPlanUnionUnsupported(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT id FROM users EXCEPT SELECT user_id FROM orders",
+		"WITH o AS (SELECT 1) SELECT id FROM users UNION SELECT * FROM o",
+		"SELECT id FROM users UNION SELECT user_id FROM orders ORDER BY id + 1",
+		"SELECT id FROM users UNION SELECT users.id FROM users JOIN orders ON true",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planSharded(t, sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.FeatureNotSupported, diag.Code)
+		})
+	}
+}
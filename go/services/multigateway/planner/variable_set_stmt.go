@@ -20,31 +20,26 @@ import (
 
 	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
 )
 
-// planVariableSetStmt plans SET/RESET commands.
+// planVariableSetStmt plans SET/SET LOCAL/RESET commands.
 // Creates a sequence that executes on PostgreSQL first, then updates local state.
 func (p *Planner) planVariableSetStmt(
 	sql string,
 	stmt *ast.VariableSetStmt,
 	conn *server.Conn,
 ) (*engine.Plan, error) {
-	// Just pass through to PostgreSQL
-	if stmt.IsLocal {
-		p.logger.Debug("SET LOCAL detected, passing through",
-			"variable", stmt.Name)
-		return p.planDefault(sql, conn)
-	}
-
-	// Only track VAR_SET_VALUE, VAR_RESET, VAR_RESET_ALL
-	// Other kinds (DEFAULT, CURRENT, MULTI) are passed through
+	// Only track VAR_SET_VALUE, VAR_SET_DEFAULT, VAR_RESET, VAR_RESET_ALL
+	// Other kinds (CURRENT, MULTI) are passed through
 	switch stmt.Kind {
-	case ast.VAR_SET_VALUE, ast.VAR_RESET, ast.VAR_RESET_ALL:
+	case ast.VAR_SET_VALUE, ast.VAR_SET_DEFAULT, ast.VAR_RESET, ast.VAR_RESET_ALL:
 		// These are tracked locally
 	default:
-		// VAR_SET_DEFAULT, VAR_SET_CURRENT, VAR_SET_MULTI - pass through
-		return p.planDefault(sql, conn)
+		// VAR_SET_CURRENT, VAR_SET_MULTI - pass through
+		return p.planDefault(sql, stmt, conn)
 	}
 
 	// Extract value for SET commands
@@ -53,9 +48,14 @@ func (p *Planner) planVariableSetStmt(
 		value = extractVariableValue(stmt.Args)
 	}
 
+	if stmt.Kind != ast.VAR_RESET_ALL && handler.IsGatewayVariable(stmt.Name) {
+		return p.planGatewayVariableSet(sql, stmt, value)
+	}
+
 	// SET/RESET command: Execute on PostgreSQL, then update local state
 	p.logger.Debug("planning SET/RESET command",
 		"kind", stmt.Kind,
+		"local", stmt.IsLocal,
 		"variable", stmt.Name,
 		"value", value)
 
@@ -73,6 +73,48 @@ func (p *Planner) planVariableSetStmt(
 	return plan, nil
 }
 
+// planGatewayVariableSet plans a SET or RESET of a parameter owned by the
+// gateway. PostgreSQL does not know it, so the value is checked here and
+// only the session state is updated.
+func (p *Planner) planGatewayVariableSet(sql string, stmt *ast.VariableSetStmt, value string) (*engine.Plan, error) {
+	if stmt.Kind == ast.VAR_SET_VALUE {
+		if err := checkGatewayVariable(stmt.Name, value); err != nil {
+			return nil, err
+		}
+	}
+	plan := engine.NewPlan(sql, engine.NewApplyGatewayVariable(stmt, value))
+	p.logger.Debug("created gateway parameter plan", "plan", plan.String())
+	return plan, nil
+}
+
+// checkGatewayVariable returns the error PostgreSQL would give for an
+// invalid value of a parameter owned by the gateway.
+func checkGatewayVariable(name, value string) error {
+	name = strings.ToLower(name)
+	switch name {
+	case handler.TargetVariable:
+		if _, ok := handler.ParseTarget(value); !ok {
+			return sqlstate.NewError(sqlstate.InvalidParameterValue).
+				Msg("invalid value for parameter \"%s\": \"%s\"", name, value).
+				Hint("Available values: %s.", strings.Join(handler.TargetValues, ", ")).
+				Err()
+		}
+	case handler.MaxReplicationLagVariable:
+		if _, ok := handler.ParseMaxReplicationLag(value); !ok {
+			return sqlstate.NewError(sqlstate.InvalidParameterValue).
+				Msg("invalid value for parameter \"%s\": \"%s\"", name, value).
+				Err()
+		}
+	case handler.DebugErrorsVariable:
+		if _, ok := handler.ParseDebugErrors(value); !ok {
+			return sqlstate.NewError(sqlstate.InvalidParameterValue).
+				Msg("parameter \"%s\" requires a Boolean value", name).
+				Err()
+		}
+	}
+	return nil
+}
+
 // extractVariableValue converts AST NodeList arguments to a string value.
 // Handles: single values, multiple values, integers, strings, etc.
 func extractVariableValue(args *ast.NodeList) string {
@@ -120,3 +162,14 @@ func extractConstValue(aConst *ast.A_Const) string {
 		return aConst.SqlString()
 	}
 }
+
+// planSetConfig plans a SELECT calling set_config() with constant arguments.
+// Like SET, it executes on PostgreSQL first, then updates local state.
+func (p *Planner) planSetConfig(sql string, calls []engine.SetConfigCall) (*engine.Plan, error) {
+	route := engine.NewRoute(p.defaultTableGroup, "", sql)
+	seq := engine.NewSequence([]engine.Primitive{route, engine.NewApplySetConfig(calls)})
+
+	plan := engine.NewPlan(sql, seq)
+	p.logger.Debug("created set_config plan", "plan", plan.String())
+	return plan, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planner
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func TestPlanVariableSet_GatewayVariables(t *testing.T) {
+	tests := []struct {
+		sql  string
+		plan string
+	}{
+		{"SET multigres.target = 'replica'", "ApplySessionState(SET multigres.target = 'replica')"},
+		{"SET LOCAL multigres.target TO replica", "ApplySessionState(SET LOCAL multigres.target = 'replica')"},
+		{"SET multigres.max_replication_lag = '5s'", "ApplySessionState(SET multigres.max_replication_lag = '5s')"},
+		{"SET multigres.debug_errors = on", "ApplySessionState(SET multigres.debug_errors = on)"},
+		{"RESET multigres.target", "ApplySessionState(RESET multigres.target)"},
+		{"SET search_path = app", "Sequence[Route(tablegroup=tg, query=SET search_path = app), ApplySessionState(SET SCHEMA 'app')]"},
+		{"RESET ALL", "Sequence[Route(tablegroup=tg, query=RESET ALL), ApplySessionState(RESET ALL)]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			plan, err := planWith(t, NewPlanner("tg", slog.Default()), tt.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plan, plan.Primitive.String())
+		})
+	}
+}
+
+func TestPlanVariableSet_InvalidGatewayVariable(t *testing.T) {
+	for _, sql := range []string{
+		"SET multigres.target = 'drained'",
+		"SET multigres.max_replication_lag = 'soon'",
+		"SET multigres.debug_errors = 'maybe'",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := planWith(t, NewPlanner("tg", slog.Default()), sql)
+			var diag *sqltypes.PgDiagnostic
+			require.ErrorAs(t, err, &diag)
+			assert.Equal(t, sqlstate.InvalidParameterValue, diag.Code)
+		})
+	}
+}
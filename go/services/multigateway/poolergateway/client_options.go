@@ -0,0 +1,120 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/multigres/multigres/go/tools/grpccommon"
+)
+
+// Compressions of the messages between the gateway and the poolers.
+const (
+	CompressionNone = "none"
+	CompressionGzip = gzip.Name
+)
+
+// ClientOptions tune the gRPC connections of the gateway to the poolers.
+type ClientOptions struct {
+	// MaxMessageSize is the size of the largest message the gateway sends
+	// to or receives from a pooler, or 0 for --grpc-max-message-size. The
+	// poolers limit the messages they take likewise.
+	MaxMessageSize int
+
+	// Compression is the compression of the messages, CompressionNone or
+	// CompressionGzip. The poolers answer with the compression of the
+	// requests.
+	Compression string
+
+	// KeepaliveTime is how long a connection stays idle before the gateway
+	// pings the pooler, or 0 to not ping. It must not be shorter than the
+	// keepalive enforcement policy of the poolers.
+	KeepaliveTime time.Duration
+
+	// KeepaliveTimeout is how long the gateway waits for the answer to a
+	// ping before closing the connection, or 0 for the 20s of gRPC.
+	KeepaliveTimeout time.Duration
+
+	// ConnectionsPerPooler is the number of gRPC connections to each pooler
+	// the queries are spread over, so that their streams are not all
+	// multiplexed over a single connection. 0 means 1.
+	ConnectionsPerPooler int
+
+	// TransportCredentials secure the connections, e.g. with mutual TLS,
+	// or nil to connect in plain text.
+	TransportCredentials credentials.TransportCredentials
+}
+
+// validate returns an error if the options are not usable.
+func (o ClientOptions) validate() error {
+	switch o.Compression {
+	case "", CompressionNone, CompressionGzip:
+	default:
+		return fmt.Errorf("unsupported pooler gRPC compression %q: use %s or %s", o.Compression, CompressionNone, CompressionGzip)
+	}
+	if o.MaxMessageSize < 0 {
+		return fmt.Errorf("invalid pooler gRPC max message size %d: must not be negative", o.MaxMessageSize)
+	}
+	if o.KeepaliveTime < 0 || o.KeepaliveTimeout < 0 {
+		return fmt.Errorf("invalid pooler gRPC keepalive %v/%v: must not be negative", o.KeepaliveTime, o.KeepaliveTimeout)
+	}
+	if o.ConnectionsPerPooler < 0 {
+		return fmt.Errorf("invalid pooler gRPC connections %d: must not be negative", o.ConnectionsPerPooler)
+	}
+	return nil
+}
+
+// connections returns the number of gRPC connections to each pooler.
+func (o ClientOptions) connections() int {
+	return max(o.ConnectionsPerPooler, 1)
+}
+
+// dialOptions returns the gRPC dial options of the connections to the
+// poolers.
+func (o ClientOptions) dialOptions() []grpc.DialOption {
+	maxSize := o.MaxMessageSize
+	if maxSize == 0 {
+		maxSize = grpccommon.MaxMessageSize()
+	}
+	callOptions := []grpc.CallOption{
+		grpc.MaxCallRecvMsgSize(maxSize),
+		grpc.MaxCallSendMsgSize(maxSize),
+	}
+	if o.Compression == CompressionGzip {
+		callOptions = append(callOptions, grpc.UseCompressor(gzip.Name))
+	}
+	creds := o.TransportCredentials
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(callOptions...),
+	}
+	if o.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    o.KeepaliveTime,
+			Timeout: o.KeepaliveTimeout,
+		}))
+	}
+	return opts
+}
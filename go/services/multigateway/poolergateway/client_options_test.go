@@ -0,0 +1,79 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestClientOptionsValidate(t *testing.T) {
+	valid := []ClientOptions{
+		{},
+		{Compression: CompressionNone, ConnectionsPerPooler: 4},
+		{Compression: CompressionGzip, MaxMessageSize: 64 << 20, KeepaliveTime: 30 * time.Second, KeepaliveTimeout: 10 * time.Second},
+	}
+	for _, opts := range valid {
+		assert.NoError(t, opts.validate(), "%+v", opts)
+	}
+	invalid := []ClientOptions{
+		{Compression: "zstd"},
+		{MaxMessageSize: -1},
+		{KeepaliveTime: -time.Second},
+		{ConnectionsPerPooler: -1},
+	}
+	for _, opts := range invalid {
+		assert.Error(t, opts.validate(), "%+v", opts)
+	}
+
+	assert.Equal(t, 1, ClientOptions{}.connections())
+	assert.Equal(t, 3, ClientOptions{ConnectionsPerPooler: 3}.connections())
+}
+
+func TestPoolerGatewayConnectionsPerPooler(t *testing.T) {
+	pg := NewPoolerGateway(nil, slog.Default())
+	require.Error(t, pg.SetClientOptions(ClientOptions{Compression: "zstd"}))
+	require.NoError(t, pg.SetClientOptions(ClientOptions{Compression: CompressionGzip, ConnectionsPerPooler: 3}))
+
+	pooler := &clustermetadatapb.MultiPooler{
+		Id:       &clustermetadatapb.ID{Cell: "zone1", Name: "pooler1"},
+		Hostname: "localhost",
+		PortMap:  map[string]int32{"grpc": 1},
+	}
+	// The connections are created without being dialed.
+	_, err := pg.getOrCreateGRPCConn(context.Background(), pooler)
+	require.NoError(t, err)
+	_, err = pg.getOrCreateGRPCConn(context.Background(), pooler)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pg.ConnectionCount())
+	assert.Equal(t, 3, pg.GRPCConnectionCount())
+
+	// The calls take the connections in turn.
+	qs := pg.connections[topoclient.MultiPoolerIDString(pooler.Id)].queryService.(*grpcQueryService)
+	first := qs.client()
+	assert.NotSame(t, first, qs.client())
+	qs.client()
+	assert.Same(t, first, qs.client())
+
+	require.NoError(t, pg.Close(context.Background()))
+}
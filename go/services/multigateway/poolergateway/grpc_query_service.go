@@ -20,24 +20,34 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 	"sync"
+	"sync/atomic"
 
+	"github.com/multigres/multigres/go/common/mterrors"
 	"github.com/multigres/multigres/go/common/queryservice"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	"github.com/multigres/multigres/go/pb/multipoolerservice"
 	"github.com/multigres/multigres/go/pb/query"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // grpcQueryService implements queryservice.QueryService using gRPC to communicate with a multipooler instance.
 // This is a private implementation used internally by PoolerGateway.
 type grpcQueryService struct {
-	// conn is the gRPC connection to the multipooler
-	conn *grpc.ClientConn
+	// conns are the gRPC connections to the multipooler
+	conns []*grpc.ClientConn
 
-	// client is the generated gRPC client
-	client multipoolerservice.MultiPoolerServiceClient
+	// clients are the generated gRPC clients of conns, used in turn
+	clients []multipoolerservice.MultiPoolerServiceClient
+	next    atomic.Uint64
+
+	// metrics records the calls, if set
+	metrics *Metrics
 
 	// logger for debugging
 	logger *slog.Logger
@@ -52,16 +62,62 @@ type grpcQueryService struct {
 	copyStreams map[uint64]multipoolerservice.MultiPoolerService_CopyBidiExecuteClient
 }
 
+// poolerError returns the error of a call to the pooler: the PostgreSQL
+// error it carries, if any, with its original diagnostic. It also turns the
+// RESOURCE_EXHAUSTED status the pooler returns when no connection of its
+// pools became available in time into the too_many_connections error
+// PostgreSQL returns in that case.
+//
+// gRPC also fails a message larger than its maximum size with
+// RESOURCE_EXHAUSTED, which is returned as program_limit_exceeded instead.
+//
+// PostgreSQL errors are annotated with the shard of target and this pooler
+// (see mterrors.AnnotatePg).
+func (g *grpcQueryService) poolerError(ctx context.Context, target *query.Target, err error) error {
+	return mterrors.AnnotatePg(g.pgError(ctx, err), target.GetShard(), g.poolerID)
+}
+
+// pgError returns the PostgreSQL error of a call to the pooler (see
+// poolerError).
+func (g *grpcQueryService) pgError(ctx context.Context, err error) error {
+	if pgErr := mterrors.PgErrorFromGRPC(err); pgErr != nil {
+		return pgErr
+	}
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.ResourceExhausted {
+		return err
+	}
+	if strings.Contains(s.Message(), "larger than max") {
+		g.metrics.recordOversizedMessage(ctx, g.poolerID)
+		return sqlstate.NewError(sqlstate.ProgramLimitExceeded).
+			Msg("message between the gateway and the pooler is too large").
+			Detail("%s", s.Message()).
+			Hint("Raise --pooler-grpc-max-message-size of the gateway and --grpc-max-message-size of the pooler, or select fewer or smaller rows.").
+			Err()
+	}
+	return sqlstate.NewError(sqlstate.TooManyConnections).
+		Msg("no connection available on the pooler").
+		Detail("%s", s.Message()).
+		Hint("Retry later, or raise the connection pool capacity or --connpool-max-wait of the pooler.").
+		Err()
+}
+
 // newGRPCQueryService creates a new QueryService that uses gRPC to communicate
 // with a multipooler instance.
 func newGRPCQueryService(
-	conn *grpc.ClientConn,
+	conns []*grpc.ClientConn,
 	poolerID string,
 	logger *slog.Logger,
+	metrics *Metrics,
 ) queryservice.QueryService {
+	clients := make([]multipoolerservice.MultiPoolerServiceClient, len(conns))
+	for i, conn := range conns {
+		clients[i] = multipoolerservice.NewMultiPoolerServiceClient(conn)
+	}
 	return &grpcQueryService{
-		conn:        conn,
-		client:      multipoolerservice.NewMultiPoolerServiceClient(conn),
+		conns:       conns,
+		clients:     clients,
+		metrics:     metrics,
 		logger:      logger,
 		poolerID:    poolerID,
 		copyStreams: make(map[uint64]multipoolerservice.MultiPoolerService_CopyBidiExecuteClient),
@@ -92,12 +148,13 @@ func (g *grpcQueryService) StreamExecute(
 	}
 
 	// Call the gRPC StreamExecute
-	stream, err := g.client.StreamExecute(ctx, req)
+	stream, err := g.client().StreamExecute(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to start stream execute: %w", err)
+		return fmt.Errorf("failed to start stream execute: %w", g.poolerError(ctx, target, err))
 	}
 
 	// Stream results back via callback
+	passthrough := queryservice.PassthroughFromContext(ctx)
 	for {
 		response, err := stream.Recv()
 		if errors.Is(err, io.EOF) {
@@ -106,7 +163,7 @@ func (g *grpcQueryService) StreamExecute(
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("stream receive error: %w", err)
+			return fmt.Errorf("stream receive error: %w", g.poolerError(ctx, target, err))
 		}
 
 		// Extract result from response
@@ -115,8 +172,14 @@ func (g *grpcQueryService) StreamExecute(
 			continue
 		}
 
-		// Convert proto result to sqltypes (preserves NULL vs empty string)
-		result := sqltypes.ResultFromProto(response.Result)
+		// Convert proto result to sqltypes (preserves NULL vs empty string).
+		// Results only relayed to the client keep their rows packed.
+		var result *sqltypes.Result
+		if passthrough {
+			result = sqltypes.RawResultFromProto(response.Result)
+		} else {
+			result = sqltypes.ResultFromProto(response.Result)
+		}
 
 		// Call the callback with the result
 		if err := callback(ctx, result); err != nil {
@@ -132,29 +195,34 @@ func (g *grpcQueryService) StreamExecute(
 // ExecuteQuery implements queryservice.QueryService.
 // This should be used sparingly only when we know the result set is small,
 // otherwise StreamExecute should be used.
+//
+// The query runs over the StreamExecute RPC rather than the unary
+// ExecuteQuery one, whose response carries the whole result in a single
+// message and fails once it exceeds --grpc-max-message-size. The pooler
+// streams the rows in chunks bounded by its batch size, gathered here into
+// the first result set of the query, as the unary RPC returns.
 func (g *grpcQueryService) ExecuteQuery(ctx context.Context, target *query.Target, sql string, options *query.ExecuteOptions) (*sqltypes.Result, error) {
-	g.logger.DebugContext(ctx, "Executing query",
-		"pooler_id", g.poolerID,
-		"tablegroup", target.TableGroup,
-		"shard", target.Shard,
-		"pooler_type", target.PoolerType.String(),
-		"query", sql)
-
-	// Create the request
-	req := &multipoolerservice.ExecuteQueryRequest{
-		Query:   sql,
-		Target:  target,
-		Options: options,
-		// TODO: Add caller_id when we have authentication
-	}
-
-	// Call the gRPC ExecuteQuery
-	res, err := g.client.ExecuteQuery(ctx, req)
+	result := &sqltypes.Result{}
+	complete := false
+	err := g.StreamExecute(ctx, target, sql, options, func(_ context.Context, chunk *sqltypes.Result) error {
+		// The result sets of the statements after the first are dropped.
+		if complete {
+			return nil
+		}
+		if len(chunk.Fields) > 0 {
+			result.Fields = chunk.Fields
+		}
+		result.Rows = append(result.Rows, chunk.Rows...)
+		result.Notices = append(result.Notices, chunk.Notices...)
+		result.RowsAffected += chunk.RowsAffected
+		result.CommandTag = chunk.CommandTag
+		complete = chunk.CommandTag != ""
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	// Convert proto result to sqltypes (preserves NULL vs empty string)
-	return sqltypes.ResultFromProto(res.GetResult()), nil
+	return result, nil
 }
 
 // PortalStreamExecute executes a portal (bound prepared statement) and streams results back via callback.
@@ -184,9 +252,9 @@ func (g *grpcQueryService) PortalStreamExecute(
 	}
 
 	// Call the gRPC PortalStreamExecute
-	stream, err := g.client.PortalStreamExecute(ctx, req)
+	stream, err := g.client().PortalStreamExecute(ctx, req)
 	if err != nil {
-		return queryservice.ReservedState{}, fmt.Errorf("failed to start portal stream execute: %w", err)
+		return queryservice.ReservedState{}, fmt.Errorf("failed to start portal stream execute: %w", g.poolerError(ctx, target, err))
 	}
 
 	var reservedState queryservice.ReservedState
@@ -200,7 +268,7 @@ func (g *grpcQueryService) PortalStreamExecute(
 			return reservedState, nil
 		}
 		if err != nil {
-			return reservedState, fmt.Errorf("portal stream receive error: %w", err)
+			return reservedState, fmt.Errorf("portal stream receive error: %w", g.poolerError(ctx, target, err))
 		}
 
 		// Extract reserved state if present
@@ -256,9 +324,9 @@ func (g *grpcQueryService) Describe(
 	}
 
 	// Call the gRPC Describe
-	response, err := g.client.Describe(ctx, req)
+	response, err := g.client().Describe(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("describe failed: %w", err)
+		return nil, fmt.Errorf("describe failed: %w", g.poolerError(ctx, target, err))
 	}
 
 	g.logger.DebugContext(ctx, "describe completed successfully", "pooler_id", g.poolerID)
@@ -268,10 +336,17 @@ func (g *grpcQueryService) Describe(
 // Close closes the gRPC connection.
 func (g *grpcQueryService) Close(ctx context.Context) error {
 	g.logger.DebugContext(ctx, "closing gRPC query service", "pooler_id", g.poolerID)
-	if g.conn != nil {
-		return g.conn.Close()
+	var errs []error
+	for _, conn := range g.conns {
+		errs = append(errs, conn.Close())
 	}
-	return nil
+	return errors.Join(errs...)
+}
+
+// client returns the gRPC client of the next call, taking the connections
+// in turn.
+func (g *grpcQueryService) client() multipoolerservice.MultiPoolerServiceClient {
+	return g.clients[(g.next.Add(1)-1)%uint64(len(g.clients))]
 }
 
 // CopyReady initiates a COPY FROM STDIN operation and returns format information.
@@ -290,7 +365,7 @@ func (g *grpcQueryService) CopyReady(
 		"query", copyQuery)
 
 	// Start the bidirectional stream
-	stream, err := g.client.CopyBidiExecute(ctx)
+	stream, err := g.client().CopyBidiExecute(ctx)
 	if err != nil {
 		return 0, nil, queryservice.ReservedState{}, fmt.Errorf("failed to start bidirectional execute stream: %w", err)
 	}
@@ -531,5 +606,197 @@ func (g *grpcQueryService) CopyAbort(
 	return nil
 }
 
+// CopyOut executes a COPY TO STDOUT operation over a bidirectional stream.
+// It sends INITIATE with copy_out set, then receives READY, DATA (repeated)
+// and RESULT. If a callback fails, the stream is cancelled, which cancels the
+// COPY on the pooler.
+func (g *grpcQueryService) CopyOut(
+	ctx context.Context,
+	target *query.Target,
+	copyQuery string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+	onData func(ctx context.Context, data []byte) error,
+) (*sqltypes.Result, error) {
+	g.logger.DebugContext(ctx, "executing COPY TO STDOUT",
+		"pooler_id", g.poolerID,
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"pooler_type", target.PoolerType.String(),
+		"query", copyQuery)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := g.client().CopyBidiExecute(streamCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start bidirectional execute stream: %w", err)
+	}
+
+	initiateReq := &multipoolerservice.CopyBidiExecuteRequest{
+		Phase:   multipoolerservice.CopyBidiExecuteRequest_INITIATE,
+		Query:   copyQuery,
+		Target:  target,
+		Options: options,
+		CopyOut: true,
+	}
+	if err := stream.Send(initiateReq); err != nil {
+		return nil, fmt.Errorf("failed to send INITIATE: %w", err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("failed to close send: %w", err)
+	}
+
+	ready := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		switch resp.Phase {
+		case multipoolerservice.CopyBidiExecuteResponse_READY:
+			columnFormats := make([]int16, len(resp.ColumnFormats))
+			for i, f := range resp.ColumnFormats {
+				columnFormats[i] = int16(f)
+			}
+			if err := onReady(ctx, int16(resp.Format), columnFormats); err != nil {
+				return nil, err
+			}
+			ready = true
+
+		case multipoolerservice.CopyBidiExecuteResponse_DATA:
+			if !ready {
+				return nil, errors.New("received COPY data before READY")
+			}
+			if err := onData(ctx, resp.Data); err != nil {
+				return nil, err
+			}
+
+		case multipoolerservice.CopyBidiExecuteResponse_RESULT:
+			g.logger.DebugContext(ctx, "COPY TO STDOUT completed", "pooler_id", g.poolerID)
+			return sqltypes.ResultFromProto(resp.Result), nil
+
+		case multipoolerservice.CopyBidiExecuteResponse_ERROR:
+			return nil, fmt.Errorf("COPY TO STDOUT failed: %s", resp.Error)
+
+		default:
+			return nil, fmt.Errorf("unexpected response phase during COPY TO STDOUT: %v", resp.Phase)
+		}
+	}
+}
+
+// StreamNotifications listens on a LISTEN/NOTIFY channel over a server stream.
+// The first response carries no notification and signals that the pooler is
+// listening; every later response carries one notification.
+func (g *grpcQueryService) StreamNotifications(
+	ctx context.Context,
+	target *query.Target,
+	channel string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context) error,
+	callback func(ctx context.Context, notification *query.Notification) error,
+) error {
+	g.logger.DebugContext(ctx, "streaming notifications",
+		"pooler_id", g.poolerID,
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"channel", channel)
+
+	req := &multipoolerservice.StreamNotificationsRequest{
+		Target:  target,
+		Options: options,
+		Channel: channel,
+		// TODO: Add caller_id when we have authentication
+	}
+
+	stream, err := g.client().StreamNotifications(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to start notification stream: %w", err)
+	}
+
+	ready := false
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return errors.New("notification stream ended unexpectedly")
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("notification stream receive error: %w", err)
+		}
+
+		if !ready {
+			ready = true
+			if err := onReady(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if resp.Notification == nil {
+			g.logger.WarnContext(ctx, "received notification response without notification", "pooler_id", g.poolerID)
+			continue
+		}
+		if err := callback(ctx, resp.Notification); err != nil {
+			return err
+		}
+	}
+}
+
+// ReserveConnection pins a reserved connection to the client session.
+func (g *grpcQueryService) ReserveConnection(
+	ctx context.Context,
+	target *query.Target,
+	options *query.ExecuteOptions,
+) (queryservice.ReservedState, error) {
+	g.logger.DebugContext(ctx, "reserving connection",
+		"pooler_id", g.poolerID,
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"reserved_connection_id", options.GetReservedConnectionId())
+
+	req := &multipoolerservice.ReserveConnectionRequest{
+		Target:  target,
+		Options: options,
+		// TODO: Add caller_id when we have authentication
+	}
+
+	response, err := g.client().ReserveConnection(ctx, req)
+	if err != nil {
+		return queryservice.ReservedState{}, fmt.Errorf("reserve connection failed: %w", g.poolerError(ctx, target, err))
+	}
+
+	return queryservice.ReservedState{
+		ReservedConnectionId: response.ReservedConnectionId,
+		PoolerID:             response.PoolerId,
+	}, nil
+}
+
+// ReleaseReservedConnection unpins a reserved connection from the client session.
+func (g *grpcQueryService) ReleaseReservedConnection(
+	ctx context.Context,
+	target *query.Target,
+	options *query.ExecuteOptions,
+) error {
+	g.logger.DebugContext(ctx, "releasing reserved connection",
+		"pooler_id", g.poolerID,
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"reserved_connection_id", options.GetReservedConnectionId())
+
+	req := &multipoolerservice.ReleaseReservedConnectionRequest{
+		Target:  target,
+		Options: options,
+		// TODO: Add caller_id when we have authentication
+	}
+
+	if _, err := g.client().ReleaseReservedConnection(ctx, req); err != nil {
+		return fmt.Errorf("release reserved connection failed: %w", err)
+	}
+	return nil
+}
+
 // Ensure grpcQueryService implements queryservice.QueryService
 var _ queryservice.QueryService = (*grpcQueryService)(nil)
@@ -17,14 +17,21 @@ package poolergateway
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
 	"github.com/multigres/multigres/go/pb/multipoolerservice"
 	"github.com/multigres/multigres/go/pb/query"
 )
@@ -35,15 +42,19 @@ type mockBidiStream struct {
 	sendErr      error
 	recvErr      error
 	recvResponse *multipoolerservice.CopyBidiExecuteResponse
+	// recvResponses, if set, are returned in order before recvResponse.
+	recvResponses []*multipoolerservice.CopyBidiExecuteResponse
 
 	// Track calls for verification
 	closeSendCalled atomic.Bool
 	recvCalled      atomic.Bool
 	sendCalled      atomic.Bool
+	sent            []*multipoolerservice.CopyBidiExecuteRequest
 }
 
 func (m *mockBidiStream) Send(req *multipoolerservice.CopyBidiExecuteRequest) error {
 	m.sendCalled.Store(true)
+	m.sent = append(m.sent, req)
 	return m.sendErr
 }
 
@@ -52,6 +63,11 @@ func (m *mockBidiStream) Recv() (*multipoolerservice.CopyBidiExecuteResponse, er
 	if m.recvErr != nil {
 		return nil, m.recvErr
 	}
+	if len(m.recvResponses) > 0 {
+		resp := m.recvResponses[0]
+		m.recvResponses = m.recvResponses[1:]
+		return resp, nil
+	}
 	return m.recvResponse, nil
 }
 
@@ -70,11 +86,100 @@ func (m *mockBidiStream) RecvMsg(msg any) error        { return nil }
 // Ensure mockBidiStream implements the interface
 var _ grpc.BidiStreamingClient[multipoolerservice.CopyBidiExecuteRequest, multipoolerservice.CopyBidiExecuteResponse] = (*mockBidiStream)(nil)
 
+// mockNotificationStream is a mock implementation of the StreamNotifications
+// client stream. It returns its responses in order, then recvErr (io.EOF if
+// unset).
+type mockNotificationStream struct {
+	grpc.ClientStream
+	responses []*multipoolerservice.StreamNotificationsResponse
+	recvErr   error
+}
+
+func (m *mockNotificationStream) Recv() (*multipoolerservice.StreamNotificationsResponse, error) {
+	if len(m.responses) > 0 {
+		resp := m.responses[0]
+		m.responses = m.responses[1:]
+		return resp, nil
+	}
+	if m.recvErr != nil {
+		return nil, m.recvErr
+	}
+	return nil, io.EOF
+}
+
+// mockHealthStream is a mock implementation of the StreamHealth client
+// stream. It returns the reports sent on its channel until the stream is
+// cancelled.
+type mockHealthStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	reports chan *multipoolerservice.StreamHealthResponse
+}
+
+func (m *mockHealthStream) Recv() (*multipoolerservice.StreamHealthResponse, error) {
+	select {
+	case report := <-m.reports:
+		return report, nil
+	case <-m.ctx.Done():
+		return nil, m.ctx.Err()
+	}
+}
+
+// mockExecuteStream is a mock implementation of the StreamExecute client
+// stream. It returns its responses in order, then io.EOF.
+type mockExecuteStream struct {
+	grpc.ClientStream
+	responses []*multipoolerservice.StreamExecuteResponse
+}
+
+func (m *mockExecuteStream) Recv() (*multipoolerservice.StreamExecuteResponse, error) {
+	if len(m.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := m.responses[0]
+	m.responses = m.responses[1:]
+	return resp, nil
+}
+
+// mockSchemaStream is a mock implementation of the StreamSchema client
+// stream. It returns the changes sent on its channel until the stream is
+// cancelled.
+type mockSchemaStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	changes chan *multipoolerservice.StreamSchemaResponse
+}
+
+func (m *mockSchemaStream) Recv() (*multipoolerservice.StreamSchemaResponse, error) {
+	select {
+	case change := <-m.changes:
+		return change, nil
+	case <-m.ctx.Done():
+		return nil, m.ctx.Err()
+	}
+}
+
 // mockMultiPoolerServiceClient is a mock implementation of MultiPoolerServiceClient.
 type mockMultiPoolerServiceClient struct {
 	// CopyBidiExecute behavior
 	bidiStream    *mockBidiStream
 	bidiStreamErr error
+
+	// StreamNotifications behavior
+	notificationStream *mockNotificationStream
+	notificationReq    *multipoolerservice.StreamNotificationsRequest
+
+	// StreamHealth behavior
+	healthReports chan *multipoolerservice.StreamHealthResponse
+
+	// StreamSchema behavior
+	schemaChanges chan *multipoolerservice.StreamSchemaResponse
+
+	// StreamExecute behavior
+	executeStream *mockExecuteStream
+
+	// StreamExecute and ReserveConnection behavior
+	callErr error
 }
 
 func (m *mockMultiPoolerServiceClient) CopyBidiExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[multipoolerservice.CopyBidiExecuteRequest, multipoolerservice.CopyBidiExecuteResponse], error) {
@@ -86,11 +191,14 @@ func (m *mockMultiPoolerServiceClient) CopyBidiExecute(ctx context.Context, opts
 
 // Other methods not used in CopyReady tests
 func (m *mockMultiPoolerServiceClient) ExecuteQuery(ctx context.Context, in *multipoolerservice.ExecuteQueryRequest, opts ...grpc.CallOption) (*multipoolerservice.ExecuteQueryResponse, error) {
-	return nil, nil
+	return nil, m.callErr
 }
 
 func (m *mockMultiPoolerServiceClient) StreamExecute(ctx context.Context, in *multipoolerservice.StreamExecuteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[multipoolerservice.StreamExecuteResponse], error) {
-	return nil, nil
+	if m.callErr != nil {
+		return nil, m.callErr
+	}
+	return m.executeStream, nil
 }
 
 func (m *mockMultiPoolerServiceClient) PortalStreamExecute(ctx context.Context, in *multipoolerservice.PortalStreamExecuteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[multipoolerservice.PortalStreamExecuteResponse], error) {
@@ -105,13 +213,40 @@ func (m *mockMultiPoolerServiceClient) GetAuthCredentials(ctx context.Context, i
 	return nil, nil
 }
 
+func (m *mockMultiPoolerServiceClient) StreamNotifications(ctx context.Context, in *multipoolerservice.StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[multipoolerservice.StreamNotificationsResponse], error) {
+	m.notificationReq = in
+	return m.notificationStream, nil
+}
+
+func (m *mockMultiPoolerServiceClient) ReserveConnection(ctx context.Context, in *multipoolerservice.ReserveConnectionRequest, opts ...grpc.CallOption) (*multipoolerservice.ReserveConnectionResponse, error) {
+	return nil, m.callErr
+}
+
+func (m *mockMultiPoolerServiceClient) ReleaseReservedConnection(ctx context.Context, in *multipoolerservice.ReleaseReservedConnectionRequest, opts ...grpc.CallOption) (*multipoolerservice.ReleaseReservedConnectionResponse, error) {
+	return nil, nil
+}
+
+func (m *mockMultiPoolerServiceClient) StreamHealth(ctx context.Context, in *multipoolerservice.StreamHealthRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[multipoolerservice.StreamHealthResponse], error) {
+	if m.callErr != nil {
+		return nil, m.callErr
+	}
+	return &mockHealthStream{ctx: ctx, reports: m.healthReports}, nil
+}
+
+func (m *mockMultiPoolerServiceClient) StreamSchema(ctx context.Context, in *multipoolerservice.StreamSchemaRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[multipoolerservice.StreamSchemaResponse], error) {
+	if m.callErr != nil {
+		return nil, m.callErr
+	}
+	return &mockSchemaStream{ctx: ctx, changes: m.schemaChanges}, nil
+}
+
 // Ensure mockMultiPoolerServiceClient implements the interface
 var _ multipoolerservice.MultiPoolerServiceClient = (*mockMultiPoolerServiceClient)(nil)
 
 // newTestGRPCQueryService creates a grpcQueryService with a mock client for testing.
 func newTestGRPCQueryService(client multipoolerservice.MultiPoolerServiceClient) *grpcQueryService {
 	return &grpcQueryService{
-		client:      client,
+		clients:     []multipoolerservice.MultiPoolerServiceClient{client},
 		logger:      slog.Default(),
 		poolerID:    "test-pooler",
 		copyStreams: make(map[uint64]multipoolerservice.MultiPoolerService_CopyBidiExecuteClient),
@@ -294,3 +429,229 @@ func TestCopyReady_Success(t *testing.T) {
 	_, exists := svc.copyStreams[12345]
 	require.True(t, exists, "Stream should be stored in copyStreams with reserved connection ID")
 }
+
+// TestCopyOut_Success tests that CopyOut sends a single INITIATE with
+// copy_out set and relays READY, DATA and RESULT to the callbacks.
+func TestCopyOut_Success(t *testing.T) {
+	mockStream := &mockBidiStream{
+		recvResponses: []*multipoolerservice.CopyBidiExecuteResponse{
+			{Phase: multipoolerservice.CopyBidiExecuteResponse_READY, Format: 1, ColumnFormats: []int32{1, 1}},
+			{Phase: multipoolerservice.CopyBidiExecuteResponse_DATA, Data: []byte("chunk1")},
+			{Phase: multipoolerservice.CopyBidiExecuteResponse_DATA, Data: []byte("chunk2")},
+			{Phase: multipoolerservice.CopyBidiExecuteResponse_RESULT, Result: &query.QueryResult{CommandTag: "COPY 2", RowsAffected: 2}},
+		},
+	}
+	svc := newTestGRPCQueryService(&mockMultiPoolerServiceClient{bidiStream: mockStream})
+
+	var format int16
+	var columnFormats []int16
+	var chunks []string
+	result, err := svc.CopyOut(
+		context.Background(),
+		&query.Target{TableGroup: "test"},
+		"COPY t TO STDOUT (FORMAT binary)",
+		&query.ExecuteOptions{},
+		func(ctx context.Context, f int16, cf []int16) error {
+			format, columnFormats = f, cf
+			return nil
+		},
+		func(ctx context.Context, data []byte) error {
+			chunks = append(chunks, string(data))
+			return nil
+		},
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, "COPY 2", result.CommandTag)
+	require.Equal(t, int16(1), format)
+	require.Equal(t, []int16{1, 1}, columnFormats)
+	require.Equal(t, []string{"chunk1", "chunk2"}, chunks)
+
+	require.Len(t, mockStream.sent, 1)
+	require.Equal(t, multipoolerservice.CopyBidiExecuteRequest_INITIATE, mockStream.sent[0].Phase)
+	require.True(t, mockStream.sent[0].CopyOut)
+	require.True(t, mockStream.closeSendCalled.Load(), "CloseSend should be called after INITIATE")
+
+	// COPY TO STDOUT keeps no stream between calls.
+	require.Empty(t, svc.copyStreams)
+}
+
+// TestCopyOut_CallbackError tests that CopyOut stops at the first callback error.
+func TestCopyOut_CallbackError(t *testing.T) {
+	mockStream := &mockBidiStream{
+		recvResponses: []*multipoolerservice.CopyBidiExecuteResponse{
+			{Phase: multipoolerservice.CopyBidiExecuteResponse_READY},
+			{Phase: multipoolerservice.CopyBidiExecuteResponse_DATA, Data: []byte("chunk1")},
+		},
+	}
+	svc := newTestGRPCQueryService(&mockMultiPoolerServiceClient{bidiStream: mockStream})
+
+	writeErr := errors.New("client went away")
+	_, err := svc.CopyOut(
+		context.Background(),
+		&query.Target{TableGroup: "test"},
+		"COPY t TO STDOUT",
+		&query.ExecuteOptions{},
+		func(ctx context.Context, f int16, cf []int16) error { return nil },
+		func(ctx context.Context, data []byte) error { return writeErr },
+	)
+	require.ErrorIs(t, err, writeErr)
+}
+
+// TestCopyOut_DataBeforeReady tests that CopyOut rejects a stream that does
+// not start with READY.
+func TestCopyOut_DataBeforeReady(t *testing.T) {
+	mockStream := &mockBidiStream{
+		recvResponse: &multipoolerservice.CopyBidiExecuteResponse{
+			Phase: multipoolerservice.CopyBidiExecuteResponse_DATA,
+			Data:  []byte("chunk1"),
+		},
+	}
+	svc := newTestGRPCQueryService(&mockMultiPoolerServiceClient{bidiStream: mockStream})
+
+	_, err := svc.CopyOut(
+		context.Background(),
+		&query.Target{TableGroup: "test"},
+		"COPY t TO STDOUT",
+		&query.ExecuteOptions{},
+		func(ctx context.Context, f int16, cf []int16) error { return nil },
+		func(ctx context.Context, data []byte) error { return nil },
+	)
+	require.ErrorContains(t, err, "before READY")
+}
+
+// TestStreamNotifications_Success tests that the first response signals
+// readiness and the following ones are passed to the callback.
+func TestStreamNotifications_Success(t *testing.T) {
+	client := &mockMultiPoolerServiceClient{
+		notificationStream: &mockNotificationStream{
+			responses: []*multipoolerservice.StreamNotificationsResponse{
+				{},
+				{Notification: &query.Notification{Pid: 7, Channel: "jobs", Payload: "one"}},
+				{Notification: &query.Notification{Pid: 7, Channel: "jobs", Payload: "two"}},
+			},
+		},
+	}
+	svc := newTestGRPCQueryService(client)
+
+	ready := false
+	var payloads []string
+	err := svc.StreamNotifications(
+		context.Background(),
+		&query.Target{TableGroup: "test"},
+		"jobs",
+		&query.ExecuteOptions{},
+		func(ctx context.Context) error {
+			ready = true
+			return nil
+		},
+		func(ctx context.Context, n *query.Notification) error {
+			require.True(t, ready, "notifications must follow readiness")
+			payloads = append(payloads, n.Payload)
+			return nil
+		},
+	)
+
+	// The stream only ends when the pooler goes away.
+	require.ErrorContains(t, err, "ended unexpectedly")
+	require.True(t, ready)
+	require.Equal(t, []string{"one", "two"}, payloads)
+	require.Equal(t, "jobs", client.notificationReq.Channel)
+}
+
+// TestStreamNotifications_Cancelled tests that cancelling the context ends
+// the stream without an error.
+func TestStreamNotifications_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client := &mockMultiPoolerServiceClient{
+		notificationStream: &mockNotificationStream{recvErr: context.Canceled},
+	}
+	svc := newTestGRPCQueryService(client)
+
+	err := svc.StreamNotifications(ctx, &query.Target{TableGroup: "test"}, "jobs", &query.ExecuteOptions{},
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context, n *query.Notification) error { return nil },
+	)
+	require.NoError(t, err)
+}
+
+// TestPoolerError_ResourceExhausted tests that a pooler out of connections is
+// reported to the client as too_many_connections.
+func TestPoolerError_ResourceExhausted(t *testing.T) {
+	client := &mockMultiPoolerServiceClient{
+		callErr: status.Error(codes.ResourceExhausted, "timed out waiting for a connection for user app"),
+	}
+	qs := newTestGRPCQueryService(client)
+	target := &query.Target{TableGroup: "default", Shard: "0"}
+
+	_, err := qs.ExecuteQuery(context.Background(), target, "SELECT 1", nil)
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	require.Equal(t, sqlstate.TooManyConnections, diag.Code)
+	require.Contains(t, diag.Detail, "timed out waiting for a connection for user app")
+
+	_, err = qs.ReserveConnection(context.Background(), target, &query.ExecuteOptions{})
+	require.ErrorAs(t, err, &diag)
+	require.Equal(t, sqlstate.TooManyConnections, diag.Code)
+
+	// Messages over the size limit are reported as such.
+	client.callErr = status.Error(codes.ResourceExhausted, "grpc: received message larger than max (20000000 vs. 16777216)")
+	_, err = qs.ExecuteQuery(context.Background(), target, "SELECT 1", nil)
+	require.ErrorAs(t, err, &diag)
+	require.Equal(t, sqlstate.ProgramLimitExceeded, diag.Code)
+	require.Contains(t, diag.Hint, "--pooler-grpc-max-message-size")
+
+	// A PostgreSQL error keeps the diagnostic the pooler sent, and the code
+	// it was sent with.
+	client.callErr = mterrors.ToGRPC(sqlstate.NewError(sqlstate.TooManyConnections).
+		Msg("sorry, too many clients already").Detail("max_connections is 100").Err())
+	_, err = qs.ExecuteQuery(context.Background(), target, "SELECT 1", nil)
+	require.ErrorAs(t, err, &diag)
+	require.Equal(t, sqlstate.TooManyConnections, diag.Code)
+	require.Equal(t, "sorry, too many clients already", diag.Message)
+	require.Equal(t, "max_connections is 100", diag.Detail)
+	var withCode mterrors.ErrorWithCode
+	require.ErrorAs(t, err, &withCode)
+	require.Equal(t, mtrpcpb.Code_RESOURCE_EXHAUSTED, withCode.ErrorCode())
+	annotation := mterrors.PgAnnotationOf(err)
+	require.NotNil(t, annotation)
+	require.Equal(t, "0", annotation.Shard())
+	require.Equal(t, "test-pooler", annotation.Tablet())
+
+	// Other errors are returned unchanged.
+	client.callErr = status.Error(codes.Unavailable, "pooler is shutting down")
+	_, err = qs.ExecuteQuery(context.Background(), target, "SELECT 1", nil)
+	require.False(t, errors.As(err, &diag))
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// TestExecuteQuery_GathersStreamedChunks tests that ExecuteQuery gathers
+// the chunks the pooler streams into the first result set of the query.
+func TestExecuteQuery_GathersStreamedChunks(t *testing.T) {
+	row := func(value string) *query.Row {
+		return (&sqltypes.Row{Values: []sqltypes.Value{sqltypes.Value(value)}}).ToProto()
+	}
+	fields := []*query.Field{{Name: "id", DataTypeOid: 23}}
+	client := &mockMultiPoolerServiceClient{executeStream: &mockExecuteStream{responses: []*multipoolerservice.StreamExecuteResponse{
+		{Result: &query.QueryResult{Fields: fields, Rows: []*query.Row{row("1"), row("2")}}},
+		{},
+		{Result: &query.QueryResult{Rows: []*query.Row{row("3")}, CommandTag: "SELECT 3"}},
+		// The result set of a second statement is dropped.
+		{Result: &query.QueryResult{Fields: fields, Rows: []*query.Row{row("4")}, CommandTag: "SELECT 1"}},
+	}}}
+	qs := newTestGRPCQueryService(client)
+
+	result, err := qs.ExecuteQuery(context.Background(), &query.Target{TableGroup: "default"}, "SELECT id FROM t; SELECT 4", nil)
+	require.NoError(t, err)
+	require.Equal(t, fields, result.Fields)
+	require.Equal(t, "SELECT 3", result.CommandTag)
+	require.Len(t, result.Rows, 3)
+	require.Equal(t, "3", string(result.Rows[2].Values[0]))
+
+	// A query without results returns an empty one.
+	client.executeStream = &mockExecuteStream{}
+	result, err = qs.ExecuteQuery(context.Background(), &query.Target{TableGroup: "default"}, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, &sqltypes.Result{}, result)
+}
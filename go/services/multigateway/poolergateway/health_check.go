@@ -0,0 +1,304 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/tools/retry"
+)
+
+// staleHealthReports is the number of report intervals after which a
+// replica that stopped reporting its health is considered unhealthy.
+const staleHealthReports = 3
+
+// maxHealthRetryDelay is the longest wait before restarting a broken health
+// stream, unless the report interval is longer.
+const maxHealthRetryDelay = 30 * time.Second
+
+// HealthCheckOptions configure the health check of the replica poolers.
+type HealthCheckOptions struct {
+	// Interval is how often each replica reports its health, and how often
+	// the replicas found by discovery are checked for changes.
+	Interval time.Duration
+	// MaxReplicationLag is the replication lag beyond which a replica gets
+	// no reads, or 0 to not exclude replicas for their lag.
+	MaxReplicationLag time.Duration
+}
+
+// ReplicaHealth is the last health reported by a replica pooler.
+type ReplicaHealth struct {
+	Pooler *clustermetadatapb.MultiPooler
+	// Serving is true if the pooler serves queries.
+	Serving bool
+	// ServingStatus is the serving state of the pooler.
+	ServingStatus clustermetadatapb.PoolerServingStatus
+	// QueryServiceError tells why the query service of the pooler is not
+	// ready to serve queries, and is empty if it is.
+	QueryServiceError string
+	// ReplicationLag is how far behind its primary the replica replays,
+	// valid if ReplicationLagError is empty.
+	ReplicationLag time.Duration
+	// ReplicationLagError tells why the replication lag is unknown.
+	ReplicationLagError string
+	// LastReport is when the replica last reported its health, or zero if
+	// it did not since its health stream (re)started.
+	LastReport time.Time
+	// Healthy is true if the replica gets reads.
+	Healthy bool
+}
+
+// healthCheck streams the health of the replica poolers found by discovery,
+// and tells which of them may serve reads.
+type healthCheck struct {
+	discovery PoolerDiscovery
+	// dial returns the service client of a pooler
+	dial   func(context.Context, *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error)
+	logger *slog.Logger
+	opts   HealthCheckOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	replicas map[string]*replicaHealth // pooler ID -> health
+}
+
+// replicaHealth is the health stream of a replica pooler. Its fields are
+// protected by healthCheck.mu.
+type replicaHealth struct {
+	ReplicaHealth
+	cancel context.CancelFunc
+}
+
+func newHealthCheck(
+	discovery PoolerDiscovery,
+	dial func(context.Context, *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error),
+	logger *slog.Logger,
+	opts HealthCheckOptions,
+) *healthCheck {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &healthCheck{
+		discovery: discovery,
+		dial:      dial,
+		logger:    logger,
+		opts:      opts,
+		ctx:       ctx,
+		cancel:    cancel,
+		replicas:  make(map[string]*replicaHealth),
+	}
+}
+
+// StartHealthCheck streams the health of the replica poolers found by
+// discovery, so that reads are only routed to replicas that serve and, with
+// opts.MaxReplicationLag, do not lag further behind their primary. Without
+// it, reads go to any replica. It must be called before the gateway serves
+// queries.
+func (pg *PoolerGateway) StartHealthCheck(opts HealthCheckOptions) {
+	pg.health = newHealthCheck(pg.discovery, pg.getServiceClient, pg.logger, opts)
+	pg.health.start()
+}
+
+// ReplicaHealth returns the health of the replica poolers, or nil if the
+// health check is not running.
+func (pg *PoolerGateway) ReplicaHealth() []ReplicaHealth {
+	if pg.health == nil {
+		return nil
+	}
+	return pg.health.snapshot()
+}
+
+// MaxReplicationLag returns the largest replication lag of the replicas
+// that serve and know their lag, or 0 if the health check is not running.
+func (pg *PoolerGateway) MaxReplicationLag() time.Duration {
+	var lag time.Duration
+	for _, replica := range pg.ReplicaHealth() {
+		if replica.Serving && replica.ReplicationLagError == "" && !replica.LastReport.IsZero() {
+			lag = max(lag, replica.ReplicationLag)
+		}
+	}
+	return lag
+}
+
+// start reconciles the health streams with discovery right away, then
+// once per interval until stop.
+func (hc *healthCheck) start() {
+	hc.wg.Go(func() {
+		ticker := time.NewTicker(hc.opts.Interval)
+		defer ticker.Stop()
+		for {
+			hc.reconcile()
+			select {
+			case <-hc.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// stop ends all health streams.
+func (hc *healthCheck) stop() {
+	hc.cancel()
+	hc.wg.Wait()
+}
+
+// reconcile starts a health stream for each replica discovered since the
+// last call, and ends those of the replicas gone.
+func (hc *healthCheck) reconcile() {
+	poolers := hc.discovery.GetPoolers(&query.Target{PoolerType: clustermetadatapb.PoolerType_REPLICA})
+
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if hc.ctx.Err() != nil {
+		return
+	}
+
+	found := make(map[string]bool, len(poolers))
+	for _, pooler := range poolers {
+		poolerID := topoclient.MultiPoolerIDString(pooler.Id)
+		found[poolerID] = true
+		if rh, ok := hc.replicas[poolerID]; ok {
+			rh.Pooler = pooler
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(hc.ctx)
+		rh := &replicaHealth{
+			ReplicaHealth: ReplicaHealth{Pooler: pooler},
+			cancel:        cancel,
+		}
+		hc.replicas[poolerID] = rh
+		hc.wg.Go(func() {
+			hc.stream(ctx, poolerID, rh)
+		})
+	}
+
+	for poolerID, rh := range hc.replicas {
+		if !found[poolerID] {
+			rh.cancel()
+			delete(hc.replicas, poolerID)
+		}
+	}
+}
+
+// stream receives the health reports of a replica until ctx is cancelled,
+// restarting the health stream when it breaks.
+func (hc *healthCheck) stream(ctx context.Context, poolerID string, rh *replicaHealth) {
+	r := retry.New(hc.opts.Interval, max(hc.opts.Interval, maxHealthRetryDelay))
+	for _, err := range r.Attempts(ctx) {
+		if err != nil {
+			return
+		}
+
+		err := hc.receive(ctx, rh, r.Reset)
+		hc.mu.Lock()
+		rh.LastReport = time.Time{}
+		hc.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		hc.logger.DebugContext(ctx, "replica health stream broke", "pooler_id", poolerID, "error", err)
+	}
+}
+
+// receive records the health reports of a replica until its health stream
+// breaks. onReport is called for each report.
+func (hc *healthCheck) receive(ctx context.Context, rh *replicaHealth, onReport func()) error {
+	hc.mu.Lock()
+	pooler := rh.Pooler
+	hc.mu.Unlock()
+
+	client, err := hc.dial(ctx, pooler)
+	if err != nil {
+		return err
+	}
+	stream, err := client.StreamHealth(ctx, &multipoolerpb.StreamHealthRequest{
+		IntervalMs: uint64(hc.opts.Interval.Milliseconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		report, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		onReport()
+
+		hc.mu.Lock()
+		rh.LastReport = time.Now()
+		rh.Serving = report.Serving
+		rh.ServingStatus = report.ServingStatus
+		rh.QueryServiceError = report.QueryServiceError
+		rh.ReplicationLag = time.Duration(report.ReplicationLagMs) * time.Millisecond
+		rh.ReplicationLagError = report.ReplicationLagError
+		hc.mu.Unlock()
+	}
+}
+
+// healthy reports whether a replica may serve reads. Caller must hold hc.mu.
+func (hc *healthCheck) healthy(rh *replicaHealth, now time.Time) bool {
+	if rh.LastReport.IsZero() || now.Sub(rh.LastReport) > staleHealthReports*hc.opts.Interval {
+		return false
+	}
+	if !rh.Serving || rh.QueryServiceError != "" {
+		return false
+	}
+	if hc.opts.MaxReplicationLag == 0 {
+		return true
+	}
+	return rh.ReplicationLagError == "" && rh.ReplicationLag <= hc.opts.MaxReplicationLag
+}
+
+// pick returns the first of the candidate replicas that is healthy, or nil
+// if none is.
+func (hc *healthCheck) pick(candidates []*clustermetadatapb.MultiPooler) *clustermetadatapb.MultiPooler {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	now := time.Now()
+	for _, pooler := range candidates {
+		rh, ok := hc.replicas[topoclient.MultiPoolerIDString(pooler.Id)]
+		if ok && hc.healthy(rh, now) {
+			return pooler
+		}
+	}
+	return nil
+}
+
+// snapshot returns the health of all replicas.
+func (hc *healthCheck) snapshot() []ReplicaHealth {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	now := time.Now()
+	health := make([]ReplicaHealth, 0, len(hc.replicas))
+	for _, rh := range hc.replicas {
+		h := rh.ReplicaHealth
+		h.Healthy = hc.healthy(rh, now)
+		health = append(health, h)
+	}
+	return health
+}
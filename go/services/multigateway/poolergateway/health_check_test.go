@@ -0,0 +1,167 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// fakeDiscovery is a PoolerDiscovery returning a fixed list of poolers.
+type fakeDiscovery struct {
+	poolers []*clustermetadatapb.MultiPooler
+}
+
+func (d *fakeDiscovery) GetPooler(target *query.Target) *clustermetadatapb.MultiPooler {
+	if poolers := d.GetPoolers(target); len(poolers) > 0 {
+		return poolers[0]
+	}
+	return nil
+}
+
+func (d *fakeDiscovery) GetPoolers(target *query.Target) []*clustermetadatapb.MultiPooler {
+	var poolers []*clustermetadatapb.MultiPooler
+	for _, pooler := range d.poolers {
+		if pooler.Type == target.PoolerType {
+			poolers = append(poolers, pooler)
+		}
+	}
+	return poolers
+}
+
+func (d *fakeDiscovery) PoolerCount() int {
+	return len(d.poolers)
+}
+
+func testReplica(name string) *clustermetadatapb.MultiPooler {
+	return &clustermetadatapb.MultiPooler{
+		Id: &clustermetadatapb.ID{
+			Component: clustermetadatapb.ID_MULTIPOOLER,
+			Cell:      "zone1",
+			Name:      name,
+		},
+		TableGroup: "default",
+		Shard:      "0-inf",
+		Type:       clustermetadatapb.PoolerType_REPLICA,
+	}
+}
+
+func TestHealthCheck_Healthy(t *testing.T) {
+	hc := newHealthCheck(&fakeDiscovery{}, nil, slog.Default(), HealthCheckOptions{
+		Interval:          time.Second,
+		MaxReplicationLag: 10 * time.Second,
+	})
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		health  ReplicaHealth
+		healthy bool
+	}{
+		{"no report yet", ReplicaHealth{Serving: true}, false},
+		{"within max lag", ReplicaHealth{Serving: true, LastReport: now, ReplicationLag: 10 * time.Second}, true},
+		{"beyond max lag", ReplicaHealth{Serving: true, LastReport: now, ReplicationLag: 11 * time.Second}, false},
+		{"unknown lag", ReplicaHealth{Serving: true, LastReport: now, ReplicationLagError: "no heartbeat yet"}, false},
+		{"not serving", ReplicaHealth{LastReport: now}, false},
+		{"query service not ready", ReplicaHealth{Serving: true, LastReport: now, QueryServiceError: "executor not initialized"}, false},
+		{"stale report", ReplicaHealth{Serving: true, LastReport: now.Add(-4 * time.Second)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.healthy, hc.healthy(&replicaHealth{ReplicaHealth: tt.health}, now))
+		})
+	}
+
+	// Without a max lag, the lag does not matter.
+	hc.opts.MaxReplicationLag = 0
+	lagging := &replicaHealth{ReplicaHealth: ReplicaHealth{
+		Serving:             true,
+		LastReport:          now,
+		ReplicationLagError: "no heartbeat yet",
+	}}
+	assert.True(t, hc.healthy(lagging, now))
+}
+
+func TestHealthCheck_ExcludesLaggingReplicas(t *testing.T) {
+	replicaA, replicaB := testReplica("replica-a"), testReplica("replica-b")
+	clients := map[string]*mockMultiPoolerServiceClient{
+		"replica-a": {healthReports: make(chan *multipoolerpb.StreamHealthResponse)},
+		"replica-b": {healthReports: make(chan *multipoolerpb.StreamHealthResponse)},
+	}
+	dial := func(ctx context.Context, pooler *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error) {
+		return clients[pooler.Id.Name], nil
+	}
+	hc := newHealthCheck(&fakeDiscovery{poolers: []*clustermetadatapb.MultiPooler{replicaA, replicaB}}, dial, slog.Default(), HealthCheckOptions{
+		Interval:          time.Hour,
+		MaxReplicationLag: time.Second,
+	})
+	hc.start()
+	defer hc.stop()
+
+	candidates := []*clustermetadatapb.MultiPooler{replicaA, replicaB}
+	assert.Nil(t, hc.pick(candidates), "replicas are unhealthy until they report")
+
+	clients["replica-a"].healthReports <- &multipoolerpb.StreamHealthResponse{Serving: true, ReplicationLagMs: 5000}
+	clients["replica-b"].healthReports <- &multipoolerpb.StreamHealthResponse{Serving: true, ReplicationLagMs: 100}
+	require.Eventually(t, func() bool {
+		pooler := hc.pick(candidates)
+		return pooler != nil && pooler.Id.Name == "replica-b"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Once replica-a catches up, it is preferred again.
+	clients["replica-a"].healthReports <- &multipoolerpb.StreamHealthResponse{Serving: true, ReplicationLagMs: 200}
+	require.Eventually(t, func() bool {
+		return hc.pick(candidates).Id.Name == "replica-a"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	health := hc.snapshot()
+	require.Len(t, health, 2)
+	for _, h := range health {
+		assert.True(t, h.Healthy, h.Pooler.Id.Name)
+	}
+
+	// A replica stopping to serve is excluded as soon as it reports it.
+	clients["replica-a"].healthReports <- &multipoolerpb.StreamHealthResponse{
+		ServingStatus: clustermetadatapb.PoolerServingStatus_NOT_SERVING,
+	}
+	require.Eventually(t, func() bool {
+		return hc.pick(candidates).Id.Name == "replica-b"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPoolerGateway_NoHealthyReplica(t *testing.T) {
+	replica := testReplica("replica-a")
+	discovery := &fakeDiscovery{poolers: []*clustermetadatapb.MultiPooler{replica}}
+	pg := NewPoolerGateway(discovery, slog.Default())
+	pg.health = newHealthCheck(discovery, nil, slog.Default(), HealthCheckOptions{Interval: time.Second})
+
+	_, err := pg.getQueryServiceForTarget(context.Background(), &query.Target{
+		TableGroup: "default",
+		PoolerType: clustermetadatapb.PoolerType_REPLICA,
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNoPooler))
+	assert.Contains(t, err.Error(), "none of 1 replicas is healthy")
+}
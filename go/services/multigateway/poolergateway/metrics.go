@@ -0,0 +1,154 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/tools/telemetry"
+)
+
+// Metrics holds the OpenTelemetry metrics of the health of the replica
+// poolers and of the gRPC connections to the poolers.
+type Metrics struct {
+	meter       metric.Meter
+	lag         metric.Float64ObservableGauge
+	healthy     metric.Int64ObservableGauge
+	connections metric.Int64ObservableGauge
+	oversized   metric.Int64Counter
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the health of the
+// replicas. A metric that fails to initialize uses a noop implementation,
+// and the errors are returned along with the usable Metrics instance. Use
+// RegisterReplicaHealthCallback() to report the health.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multigateway/poolergateway"),
+	}
+
+	var errs []error
+	lag, err := m.meter.Float64ObservableGauge(
+		"multigateway.replica.lag",
+		metric.WithDescription("Replication lag last reported by each replica pooler"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multigateway.replica.lag gauge: %w", err))
+		lag = noop.Float64ObservableGauge{}
+	}
+	m.lag = lag
+
+	healthy, err := m.meter.Int64ObservableGauge(
+		"multigateway.replica.healthy",
+		metric.WithDescription("Whether each replica pooler gets reads (1) or is excluded for being unreachable, not serving or lagging (0)"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multigateway.replica.healthy gauge: %w", err))
+		healthy = noop.Int64ObservableGauge{}
+	}
+	m.healthy = healthy
+
+	connections, err := m.meter.Int64ObservableGauge(
+		"multigateway.pooler.grpc_connections",
+		metric.WithDescription("Number of gRPC connections of the gateway to the poolers"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multigateway.pooler.grpc_connections gauge: %w", err))
+		connections = noop.Int64ObservableGauge{}
+	}
+	m.connections = connections
+
+	oversized, err := m.meter.Int64Counter(
+		"multigateway.pooler.oversized_messages",
+		metric.WithDescription("Calls to the poolers failed by a message larger than the maximum gRPC message size"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multigateway.pooler.oversized_messages counter: %w", err))
+		oversized = noop.Int64Counter{}
+	}
+	m.oversized = oversized
+
+	return m, errors.Join(errs...)
+}
+
+// RegisterReplicaHealthCallback registers a callback observing the health
+// of the replicas checked by pg. Returns an error if registration fails.
+func (m *Metrics) RegisterReplicaHealthCallback(pg *PoolerGateway) error {
+	if pg == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			for _, h := range pg.ReplicaHealth() {
+				attrs := metric.WithAttributes(
+					attribute.String("pooler_id", topoclient.MultiPoolerIDString(h.Pooler.Id)),
+					attribute.String(telemetry.AttrCell, h.Pooler.Id.GetCell()),
+					attribute.String(telemetry.AttrDatabase, h.Pooler.Database),
+					attribute.String(telemetry.AttrTableGroup, h.Pooler.TableGroup),
+					attribute.String(telemetry.AttrShard, h.Pooler.Shard))
+				healthy := int64(0)
+				if h.Healthy {
+					healthy = 1
+				}
+				observer.ObserveInt64(m.healthy, healthy, attrs)
+				if !h.LastReport.IsZero() && h.ReplicationLagError == "" {
+					observer.ObserveFloat64(m.lag, h.ReplicationLag.Seconds(), attrs)
+				}
+			}
+			return nil
+		},
+		m.lag,
+		m.healthy,
+	)
+	return err
+}
+
+// RegisterConnectionCallback registers a callback observing the number of
+// gRPC connections of pg to the poolers. Returns an error if registration
+// fails.
+func (m *Metrics) RegisterConnectionCallback(pg *PoolerGateway) error {
+	if pg == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			observer.ObserveInt64(m.connections, int64(pg.GRPCConnectionCount()))
+			return nil
+		},
+		m.connections,
+	)
+	return err
+}
+
+// recordOversizedMessage records a call to the pooler of poolerID failed
+// by a message larger than the maximum gRPC message size.
+func (m *Metrics) recordOversizedMessage(ctx context.Context, poolerID string) {
+	if m == nil {
+		return
+	}
+	m.oversized.Add(ctx, 1, metric.WithAttributes(attribute.String("pooler_id", poolerID)))
+}
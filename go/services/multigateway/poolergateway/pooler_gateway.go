@@ -24,6 +24,7 @@ package poolergateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -39,9 +40,11 @@ import (
 	"github.com/multigres/multigres/go/tools/grpccommon"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+// ErrNoPooler is returned when discovery knows no pooler matching a target.
+var ErrNoPooler = errors.New("no pooler found")
+
 // PoolerDiscovery is the interface for discovering multipooler instances.
 // This abstracts the PoolerDiscovery implementation for easier testing.
 type PoolerDiscovery interface {
@@ -50,6 +53,10 @@ type PoolerDiscovery interface {
 	// Returns nil if no matching pooler is found.
 	GetPooler(target *query.Target) *clustermetadatapb.MultiPooler
 
+	// GetPoolers returns all poolers matching the target specification, the
+	// preferred ones first. An empty tablegroup matches any tablegroup.
+	GetPoolers(target *query.Target) []*clustermetadatapb.MultiPooler
+
 	// PoolerCount returns the total number of discovered poolers.
 	PoolerCount() int
 }
@@ -72,6 +79,20 @@ type PoolerGateway struct {
 	// logger for debugging
 	logger *slog.Logger
 
+	// health tracks the health of the replica poolers, or is nil when
+	// reads may go to any replica
+	health *healthCheck
+
+	// schema streams the schema of the tables to a listener, or is nil
+	// when it is not tracked
+	schema *schemaTracker
+
+	// clientOptions tune the gRPC connections to the poolers
+	clientOptions ClientOptions
+
+	// metrics records the gRPC connections and calls, if set
+	metrics *Metrics
+
 	// connections maintains gRPC connections to poolers
 	// Key is pooler ID (hostname:port)
 	mu          sync.Mutex
@@ -83,8 +104,8 @@ type poolerConnection struct {
 	// poolerInfo contains the pooler metadata
 	poolerInfo *topoclient.MultiPoolerInfo
 
-	// conn is the gRPC connection
-	conn *grpc.ClientConn
+	// conns are the gRPC connections, ClientOptions.ConnectionsPerPooler
+	conns []*grpc.ClientConn
 
 	// queryService is the QueryService implementation for query execution
 	queryService queryservice.QueryService
@@ -108,6 +129,22 @@ func NewPoolerGateway(
 	}
 }
 
+// SetClientOptions tunes the gRPC connections to the poolers. It must be
+// called before the first connection is made.
+func (pg *PoolerGateway) SetClientOptions(opts ClientOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	pg.clientOptions = opts
+	return nil
+}
+
+// SetMetrics sets the metrics recording the gRPC calls to the poolers. It
+// must be called before the first connection is made.
+func (pg *PoolerGateway) SetMetrics(metrics *Metrics) {
+	pg.metrics = metrics
+}
+
 // QueryServiceByID implements Gateway.
 func (pg *PoolerGateway) QueryServiceByID(ctx context.Context, id *clustermetadatapb.ID, target *query.Target) (queryservice.QueryService, error) {
 	// TODO: IMPLEMENT queryservicebyid
@@ -144,10 +181,20 @@ func (pg *PoolerGateway) StreamExecute(
 }
 
 func (pg *PoolerGateway) getQueryServiceForTarget(ctx context.Context, target *query.Target) (queryservice.QueryService, error) {
-	pooler := pg.discovery.GetPooler(target)
+	var pooler *clustermetadatapb.MultiPooler
+	if pg.health != nil && target.PoolerType == clustermetadatapb.PoolerType_REPLICA {
+		candidates := pg.discovery.GetPoolers(target)
+		pooler = pg.health.pick(candidates)
+		if pooler == nil && len(candidates) > 0 {
+			return nil, fmt.Errorf("%w for target: tablegroup=%s, shard=%s, type=%s: none of %d replicas is healthy",
+				ErrNoPooler, target.TableGroup, target.Shard, target.PoolerType.String(), len(candidates))
+		}
+	} else {
+		pooler = pg.discovery.GetPooler(target)
+	}
 	if pooler == nil {
-		return nil, fmt.Errorf("no pooler found for target: tablegroup=%s, shard=%s, type=%s",
-			target.TableGroup, target.Shard, target.PoolerType.String())
+		return nil, fmt.Errorf("%w for target: tablegroup=%s, shard=%s, type=%s",
+			ErrNoPooler, target.TableGroup, target.Shard, target.PoolerType.String())
 	}
 
 	poolerID := topoclient.MultiPoolerIDString(pooler.Id)
@@ -195,7 +242,7 @@ func (pg *PoolerGateway) getOrCreateGRPCConn(
 	if conn, ok := pg.connections[poolerID]; ok {
 		pg.mu.Unlock()
 		conn.lastUsed = time.Now()
-		return conn.conn, nil
+		return conn.conns[0], nil
 	}
 	pg.mu.Unlock()
 
@@ -206,7 +253,7 @@ func (pg *PoolerGateway) getOrCreateGRPCConn(
 	// Double-check after acquiring write lock
 	if conn, ok := pg.connections[poolerID]; ok {
 		conn.lastUsed = time.Now()
-		return conn.conn, nil
+		return conn.conns[0], nil
 	}
 
 	// Create new gRPC connection
@@ -217,25 +264,32 @@ func (pg *PoolerGateway) getOrCreateGRPCConn(
 		"pooler_id", poolerID,
 		"addr", addr)
 
-	// Create gRPC connection (non-blocking in newer gRPC)
-	conn, err := grpccommon.NewClient(addr,
-		grpccommon.WithAttributes(rpcclient.PoolerSpanAttributes(pooler.Id)...),
-		grpccommon.WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create client for pooler %s at %s: %w", poolerID, addr, err)
+	// Create gRPC connections (non-blocking in newer gRPC)
+	conns := make([]*grpc.ClientConn, 0, pg.clientOptions.connections())
+	for range pg.clientOptions.connections() {
+		conn, err := grpccommon.NewClient(addr,
+			grpccommon.WithAttributes(rpcclient.PoolerSpanAttributes(pooler.Id)...),
+			grpccommon.WithDialOptions(pg.clientOptions.dialOptions()...),
+		)
+		if err != nil {
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+			return nil, fmt.Errorf("failed to create client for pooler %s at %s: %w", poolerID, addr, err)
+		}
+		conns = append(conns, conn)
 	}
 
-	// Create QueryService for the connection
-	queryService := newGRPCQueryService(conn, poolerID, pg.logger)
+	// Create QueryService for the connections
+	queryService := newGRPCQueryService(conns, poolerID, pg.logger, pg.metrics)
 
 	// Create service client for admin operations
-	serviceClient := multipoolerpb.NewMultiPoolerServiceClient(conn)
+	serviceClient := multipoolerpb.NewMultiPoolerServiceClient(conns[0])
 
 	// Store connection
 	pg.connections[poolerID] = &poolerConnection{
 		poolerInfo:    poolerInfo,
-		conn:          conn,
+		conns:         conns,
 		queryService:  queryService,
 		serviceClient: serviceClient,
 		lastUsed:      time.Now(),
@@ -245,7 +299,7 @@ func (pg *PoolerGateway) getOrCreateGRPCConn(
 		"pooler_id", poolerID,
 		"addr", addr)
 
-	return conn, nil
+	return conns[0], nil
 }
 
 // getOrCreateConnection returns an existing QueryService or creates a new one.
@@ -307,8 +361,16 @@ func (pg *PoolerGateway) Describe(
 }
 
 // Close implements queryservice.QueryService.
-// It closes all connections to poolers.
+// It stops the health check and the schema tracking, and closes all
+// connections to poolers.
 func (pg *PoolerGateway) Close(ctx context.Context) error {
+	if pg.health != nil {
+		pg.health.stop()
+	}
+	if pg.schema != nil {
+		pg.schema.stop()
+	}
+
 	pg.mu.Lock()
 	defer pg.mu.Unlock()
 
@@ -358,6 +420,11 @@ func (pg *PoolerGateway) getSystemServiceClient(ctx context.Context, database st
 		return nil, fmt.Errorf("no pooler found for database %q", database)
 	}
 
+	return pg.getServiceClient(ctx, pooler)
+}
+
+// getServiceClient returns the MultiPoolerServiceClient of a pooler.
+func (pg *PoolerGateway) getServiceClient(ctx context.Context, pooler *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error) {
 	poolerID := topoclient.MultiPoolerIDString(pooler.Id)
 
 	// Get or create the gRPC connection (this also caches the service client)
@@ -389,6 +456,26 @@ func (pg *PoolerGateway) Stats() map[string]any {
 	}
 }
 
+// ConnectionCount returns the number of poolers the gateway has a gRPC
+// connection to.
+func (pg *PoolerGateway) ConnectionCount() int {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+	return len(pg.connections)
+}
+
+// GRPCConnectionCount returns the number of gRPC connections of the gateway
+// to the poolers, ClientOptions.ConnectionsPerPooler per pooler.
+func (pg *PoolerGateway) GRPCConnectionCount() int {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+	count := 0
+	for _, conn := range pg.connections {
+		count += len(conn.conns)
+	}
+	return count
+}
+
 // CopyReady implements queryservice.QueryService.
 // It initiates a COPY FROM STDIN operation and returns format information.
 func (pg *PoolerGateway) CopyReady(
@@ -443,6 +530,80 @@ func (pg *PoolerGateway) CopyFinalize(
 	return qs.CopyFinalize(ctx, target, finalData, options)
 }
 
+// CopyOut implements queryservice.QueryService.
+// It executes a COPY TO STDOUT operation and streams its data.
+func (pg *PoolerGateway) CopyOut(
+	ctx context.Context,
+	target *query.Target,
+	copyQuery string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+	onData func(ctx context.Context, data []byte) error,
+) (*sqltypes.Result, error) {
+	// Get a pooler matching the target
+	qs, err := pg.getQueryServiceForTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delegate to the pooler's QueryService
+	return qs.CopyOut(ctx, target, copyQuery, options, onReady, onData)
+}
+
+// StreamNotifications implements queryservice.QueryService.
+// It listens on a LISTEN/NOTIFY channel on a pooler matching the target.
+func (pg *PoolerGateway) StreamNotifications(
+	ctx context.Context,
+	target *query.Target,
+	channel string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context) error,
+	callback func(ctx context.Context, notification *query.Notification) error,
+) error {
+	// Get a pooler matching the target
+	qs, err := pg.getQueryServiceForTarget(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	// Delegate to the pooler's QueryService
+	return qs.StreamNotifications(ctx, target, channel, options, onReady, callback)
+}
+
+// ReserveConnection implements queryservice.QueryService.
+// It pins a reserved connection to the client session on a pooler matching the target.
+func (pg *PoolerGateway) ReserveConnection(
+	ctx context.Context,
+	target *query.Target,
+	options *query.ExecuteOptions,
+) (queryservice.ReservedState, error) {
+	// Get a pooler matching the target
+	qs, err := pg.getQueryServiceForTarget(ctx, target)
+	if err != nil {
+		return queryservice.ReservedState{}, err
+	}
+
+	// Delegate to the pooler's QueryService
+	return qs.ReserveConnection(ctx, target, options)
+}
+
+// ReleaseReservedConnection implements queryservice.QueryService.
+// It unpins a reserved connection from the client session.
+func (pg *PoolerGateway) ReleaseReservedConnection(
+	ctx context.Context,
+	target *query.Target,
+	options *query.ExecuteOptions,
+) error {
+	// Get a pooler matching the target
+	qs, err := pg.getQueryServiceForTarget(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	// Delegate to the pooler's QueryService
+	return qs.ReleaseReservedConnection(ctx, target, options)
+}
+
 // CopyAbort implements queryservice.QueryService.
 // It aborts a COPY operation.
 func (pg *PoolerGateway) CopyAbort(
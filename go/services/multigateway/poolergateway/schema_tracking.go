@@ -0,0 +1,160 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/tools/retry"
+)
+
+// SchemaListener is given the changes of the schema of the tables of a
+// database, starting with all of its tables, marked full.
+type SchemaListener func(database string, change *multipoolerpb.StreamSchemaResponse)
+
+// schemaTracker streams the schema of the tables from the primary pooler
+// of a tablegroup, following it when it changes.
+type schemaTracker struct {
+	discovery PoolerDiscovery
+	// dial returns the service client of a pooler
+	dial       func(context.Context, *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error)
+	logger     *slog.Logger
+	tableGroup string
+	interval   time.Duration
+	listener   SchemaListener
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newSchemaTracker(
+	discovery PoolerDiscovery,
+	dial func(context.Context, *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error),
+	logger *slog.Logger,
+	tableGroup string,
+	interval time.Duration,
+	listener SchemaListener,
+) *schemaTracker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &schemaTracker{
+		discovery:  discovery,
+		dial:       dial,
+		logger:     logger,
+		tableGroup: tableGroup,
+		interval:   interval,
+		listener:   listener,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// StartSchemaTracking streams the schema of the tables of the databases
+// from the primary pooler of tableGroup, which checks its catalog once per
+// interval, and hands its changes to listener. When the primary changes,
+// or its stream breaks, a new stream starts, with all the tables again.
+func (pg *PoolerGateway) StartSchemaTracking(tableGroup string, interval time.Duration, listener SchemaListener) {
+	pg.schema = newSchemaTracker(pg.discovery, pg.getServiceClient, pg.logger, tableGroup, interval, listener)
+	pg.schema.start()
+}
+
+// start streams the schema until stop.
+func (st *schemaTracker) start() {
+	st.wg.Go(func() {
+		r := retry.New(st.interval, max(st.interval, maxHealthRetryDelay))
+		for _, err := range r.Attempts(st.ctx) {
+			if err != nil {
+				return
+			}
+			pooler := st.primary()
+			if pooler == nil {
+				continue
+			}
+			err := st.receive(pooler, r.Reset)
+			if st.ctx.Err() != nil {
+				return
+			}
+			st.logger.DebugContext(st.ctx, "schema stream broke", "pooler_id", topoclient.MultiPoolerIDString(pooler.Id), "error", err)
+		}
+	})
+}
+
+// stop ends the schema stream.
+func (st *schemaTracker) stop() {
+	st.cancel()
+	st.wg.Wait()
+}
+
+// primary returns the primary pooler of the tablegroup, or nil if none
+// was discovered.
+func (st *schemaTracker) primary() *clustermetadatapb.MultiPooler {
+	return st.discovery.GetPooler(&query.Target{
+		TableGroup: st.tableGroup,
+		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
+	})
+}
+
+// receive hands the schema changes streamed by pooler to the listener
+// until its stream breaks, or another pooler becomes the primary.
+// onChange is called for each change.
+func (st *schemaTracker) receive(pooler *clustermetadatapb.MultiPooler, onChange func()) error {
+	ctx, cancel := context.WithCancel(st.ctx)
+	defer cancel()
+
+	client, err := st.dial(ctx, pooler)
+	if err != nil {
+		return err
+	}
+	stream, err := client.StreamSchema(ctx, &multipoolerpb.StreamSchemaRequest{
+		IntervalMs: uint64(st.interval.Milliseconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	// The stream ends when the pooler is no longer the primary.
+	poolerID := topoclient.MultiPoolerIDString(pooler.Id)
+	go func() {
+		ticker := time.NewTicker(st.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if primary := st.primary(); primary == nil || topoclient.MultiPoolerIDString(primary.Id) != poolerID {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	for {
+		change, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		onChange()
+		st.listener(pooler.Database, change)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poolergateway
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolerpb "github.com/multigres/multigres/go/pb/multipoolerservice"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// switchingDiscovery is a PoolerDiscovery whose poolers change while it
+// is used.
+type switchingDiscovery struct {
+	mu sync.Mutex
+	fakeDiscovery
+}
+
+func (d *switchingDiscovery) GetPooler(target *query.Target) *clustermetadatapb.MultiPooler {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.fakeDiscovery.GetPooler(target)
+}
+
+func (d *switchingDiscovery) setPoolers(poolers ...*clustermetadatapb.MultiPooler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.poolers = poolers
+}
+
+func testPrimary(name string) *clustermetadatapb.MultiPooler {
+	pooler := testReplica(name)
+	pooler.Type = clustermetadatapb.PoolerType_PRIMARY
+	pooler.Database = "db1"
+	return pooler
+}
+
+func TestSchemaTracker_FollowsPrimary(t *testing.T) {
+	clients := map[string]*mockMultiPoolerServiceClient{
+		"primary-a": {schemaChanges: make(chan *multipoolerpb.StreamSchemaResponse)},
+		"primary-b": {schemaChanges: make(chan *multipoolerpb.StreamSchemaResponse)},
+	}
+	dial := func(ctx context.Context, pooler *clustermetadatapb.MultiPooler) (multipoolerpb.MultiPoolerServiceClient, error) {
+		return clients[pooler.Id.Name], nil
+	}
+	discovery := &switchingDiscovery{}
+	discovery.setPoolers(testPrimary("primary-a"))
+
+	var mu sync.Mutex
+	var received []*multipoolerpb.StreamSchemaResponse
+	st := newSchemaTracker(discovery, dial, slog.Default(), "default", 10*time.Millisecond, func(database string, change *multipoolerpb.StreamSchemaResponse) {
+		assert.Equal(t, "db1", database)
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, change)
+	})
+	st.start()
+	defer st.stop()
+
+	users := &multipoolerpb.TableSchema{Schema: "public", Name: "users"}
+	clients["primary-a"].schemaChanges <- &multipoolerpb.StreamSchemaResponse{Full: true, Tables: []*multipoolerpb.TableSchema{users}}
+	clients["primary-a"].schemaChanges <- &multipoolerpb.StreamSchemaResponse{DroppedTables: []*multipoolerpb.TableSchema{users}}
+
+	// After a failover, the new primary streams the schema from the start.
+	discovery.setPoolers(testPrimary("primary-b"))
+	select {
+	case clients["primary-b"].schemaChanges <- &multipoolerpb.StreamSchemaResponse{Full: true}:
+	case <-time.After(5 * time.Second):
+		t.Fatal("schema stream did not move to the new primary")
+	}
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.True(t, received[0].Full)
+	assert.Len(t, received[1].DroppedTables, 1)
+	assert.True(t, received[2].Full)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multigres/multigres/go/common/topoclient"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/tools/retry"
+)
+
+// RoutingRulesListener is given the routing rules of a database whenever
+// they change, and once when they are first read.
+type RoutingRulesListener func(database string, rules *clustermetadatapb.RoutingRules)
+
+// RoutingRulesWatcher watches the routing rules of all the databases in
+// the global topology, handing their changes to a listener. When the watch
+// breaks, the last rules seen stay in force until it is established again.
+type RoutingRulesWatcher struct {
+	topoStore topoclient.Store
+	listener  RoutingRulesListener
+	logger    *slog.Logger
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+
+	// versions are the versions of the rules last given to the listener,
+	// by database
+	versions map[string]int64
+}
+
+// NewRoutingRulesWatcher creates a watcher of the routing rules handing
+// them to listener.
+func NewRoutingRulesWatcher(ctx context.Context, topoStore topoclient.Store, listener RoutingRulesListener, logger *slog.Logger) *RoutingRulesWatcher {
+	watcherCtx, cancel := context.WithCancel(ctx)
+	return &RoutingRulesWatcher{
+		topoStore:  topoStore,
+		listener:   listener,
+		logger:     logger,
+		ctx:        watcherCtx,
+		cancelFunc: cancel,
+		versions:   make(map[string]int64),
+	}
+}
+
+// Start begins watching the routing rules.
+func (w *RoutingRulesWatcher) Start() {
+	w.wg.Go(func() {
+		r := retry.New(100*time.Millisecond, 30*time.Second)
+		for attempt, err := range r.Attempts(w.ctx) {
+			if err != nil {
+				// Context cancelled
+				return
+			}
+			if attempt > 0 {
+				w.logger.Info("Restarting routing rules watch")
+			}
+
+			func() {
+				conn, err := w.topoStore.ConnForCell(w.ctx, topoclient.GlobalCell)
+				if err != nil {
+					w.logger.Error("Failed to get connection for the global topology", "error", err)
+					return
+				}
+				initial, changes, err := conn.WatchRecursive(w.ctx, topoclient.DatabasesPath)
+				if err != nil {
+					w.logger.Error("Failed to start recursive watch on databases", "path", topoclient.DatabasesPath, "error", err)
+					return
+				}
+				for _, watchData := range initial {
+					w.processChange(watchData)
+				}
+
+				// Reset backoff after watch has been stable for 30s
+				resetTimer := time.AfterFunc(30*time.Second, func() {
+					r.Reset()
+				})
+				defer resetTimer.Stop()
+
+				for {
+					select {
+					case <-w.ctx.Done():
+						return
+					case watchData, ok := <-changes:
+						if !ok {
+							w.logger.Info("Routing rules watch channel closed, will reconnect")
+							return
+						}
+						w.processChange(watchData)
+					}
+				}
+			}()
+		}
+	})
+}
+
+// Stop stops watching the routing rules.
+func (w *RoutingRulesWatcher) Stop() {
+	w.cancelFunc()
+	w.wg.Wait()
+}
+
+// processChange hands the routing rules of a watch event to the listener,
+// if it is a change of the rules of a database. The other files of the
+// databases are ignored, and removed rules are handed as no rules.
+func (w *RoutingRulesWatcher) processChange(watchData *topoclient.WatchDataRecursive) {
+	database, ok := routingRulesDatabase(watchData.Path)
+	if !ok {
+		return
+	}
+	rules := &clustermetadatapb.RoutingRules{}
+	switch {
+	case watchData.Err == nil:
+		if err := proto.Unmarshal(watchData.Contents, rules); err != nil {
+			w.logger.Error("Failed to parse routing rules", "database", database, "error", err)
+			return
+		}
+	case errors.Is(watchData.Err, &topoclient.TopoError{Code: topoclient.NoNode}):
+		// The rules were removed.
+	default:
+		// The watch broke, which closes the channel.
+		return
+	}
+	if version, seen := w.versions[database]; seen && version == rules.Version {
+		return
+	}
+	w.versions[database] = rules.Version
+	w.logger.Info("Routing rules changed", "database", database, "version", rules.Version)
+	w.listener(database, rules)
+}
+
+// routingRulesDatabase returns the database whose routing rules are at
+// filePath, a path of the watch of the databases, and false if filePath
+// is not that of routing rules.
+func routingRulesDatabase(filePath string) (string, bool) {
+	if path.Base(filePath) != topoclient.RoutingRulesFile {
+		return "", false
+	}
+	dir := path.Dir(filePath)
+	if path.Base(path.Dir(dir)) != topoclient.DatabasesPath {
+		return "", false
+	}
+	return path.Base(dir), true
+}
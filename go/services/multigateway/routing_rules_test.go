@@ -0,0 +1,100 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	"github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+)
+
+func TestRoutingRulesWatcher(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	defer ts.Close()
+
+	rules := &clustermetadatapb.RoutingRules{Rules: []*clustermetadatapb.RoutingRule{{FromTable: "orders", ToTable: "orders_v2"}}}
+	_, err := ts.SaveRoutingRules(ctx, "db1", rules)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	seen := make(map[string]*clustermetadatapb.RoutingRules)
+	versionOf := func(database string) int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		if rules, ok := seen[database]; ok {
+			return rules.Version
+		}
+		return -1
+	}
+	watcher := NewRoutingRulesWatcher(ctx, ts, func(database string, rules *clustermetadatapb.RoutingRules) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen[database] = rules
+	}, slog.Default())
+	watcher.Start()
+	defer watcher.Stop()
+
+	// The rules already saved are read first.
+	waitForCondition(t, func() bool { return versionOf("db1") == 1 }, "rules of db1 not read")
+
+	// Then their changes, and the rules of other databases.
+	_, err = ts.SaveRoutingRules(ctx, "db2", &clustermetadatapb.RoutingRules{Rules: []*clustermetadatapb.RoutingRule{
+		{FromTable: "logs", Tablegroup: "archive"},
+	}})
+	require.NoError(t, err)
+	waitForCondition(t, func() bool { return versionOf("db2") == 1 }, "rules of db2 not read")
+
+	_, err = ts.SaveRoutingRules(ctx, "db1", &clustermetadatapb.RoutingRules{Version: 1})
+	require.NoError(t, err)
+	waitForCondition(t, func() bool { return versionOf("db1") == 2 }, "change of the rules of db1 not read")
+
+	// The other files of the databases are ignored.
+	_, err = ts.AcquirePrimaryLease(ctx, types.ShardKey{Database: "db1", TableGroup: "default", Shard: "0-inf"}, &clustermetadatapb.ID{Name: "mp1"}, 1, testTimeout)
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Empty(t, seen["db1"].Rules)
+	assert.Equal(t, "archive", seen["db2"].Rules[0].Tablegroup)
+	assert.Len(t, seen, 2)
+}
+
+func TestRoutingRulesDatabase(t *testing.T) {
+	database, ok := routingRulesDatabase("databases/db1/RoutingRules")
+	assert.True(t, ok)
+	assert.Equal(t, "db1", database)
+
+	database, ok = routingRulesDatabase("/multigres/global/databases/db1/RoutingRules")
+	assert.True(t, ok)
+	assert.Equal(t, "db1", database)
+
+	for _, path := range []string{
+		"databases/db1/Database",
+		"databases/db1/default/0-inf/PrimaryLease",
+		"databases/db1/default/RoutingRules",
+	} {
+		_, ok := routingRulesDatabase(path)
+		assert.False(t, ok, path)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// BufferOptions configures the buffering of the statements of the shards
+// whose primary is failing over.
+type BufferOptions struct {
+	// Window is the longest time the statements of a shard are held during
+	// a failover, after which they run whether the failover ended or not.
+	// Zero disables the buffering.
+	Window time.Duration
+
+	// Size is the number of statements held at once across all shards;
+	// the statements beyond it run, and fail, without waiting.
+	Size int
+}
+
+// shardKey identifies a shard of a tablegroup.
+type shardKey struct {
+	tableGroup string
+	shard      string
+}
+
+// failover is a failover in progress on a shard: done is closed when the
+// shard has a new primary or the buffering window ends.
+type failover struct {
+	done  chan struct{}
+	timer *time.Timer
+}
+
+// failoverBuffer holds the statements of the shards failing over until
+// they have a new primary, so that planned failovers do not surface as
+// errors to the clients.
+type failoverBuffer struct {
+	opts BufferOptions
+
+	mu        sync.Mutex
+	failovers map[shardKey]*failover
+	held      int
+}
+
+func newFailoverBuffer(opts BufferOptions) *failoverBuffer {
+	return &failoverBuffer{opts: opts, failovers: make(map[shardKey]*failover)}
+}
+
+// start begins buffering the statements of a shard, unless it already is.
+func (b *failoverBuffer) start(key shardKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.failovers[key]; ok {
+		return
+	}
+	f := &failover{done: make(chan struct{})}
+	f.timer = time.AfterFunc(b.opts.Window, func() { b.end(key, f) })
+	b.failovers[key] = f
+}
+
+// end releases the statements held for a shard. If f is set, the failover
+// ends only if it is still the one in progress.
+func (b *failoverBuffer) end(key shardKey, f *failover) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	current, ok := b.failovers[key]
+	if !ok || (f != nil && current != f) {
+		return
+	}
+	delete(b.failovers, key)
+	current.timer.Stop()
+	close(current.done)
+}
+
+// wait holds a statement of a shard while it fails over, and returns
+// whether it was held. A statement is not held when the buffer is full.
+// The time held counts as queue wait of the query.
+func (b *failoverBuffer) wait(ctx context.Context, key shardKey) (bool, error) {
+	b.mu.Lock()
+	f, ok := b.failovers[key]
+	if !ok || b.held >= b.opts.Size {
+		b.mu.Unlock()
+		return false, nil
+	}
+	b.held++
+	b.mu.Unlock()
+	start := time.Now()
+	defer func() {
+		engine.QueryTimingFromContext(ctx).AddQueueWait(time.Since(start))
+		b.mu.Lock()
+		b.held--
+		b.mu.Unlock()
+	}()
+
+	select {
+	case <-f.done:
+		return true, nil
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
+// buffering returns true if the statements of the shard are being held.
+func (b *failoverBuffer) buffering(key shardKey) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.failovers[key]
+	return ok
+}
+
+// SetBufferOptions sets how the statements of shards failing over are
+// held.
+func (sc *ScatterConn) SetBufferOptions(opts BufferOptions) {
+	sc.buffer = nil
+	if opts.Window > 0 && opts.Size > 0 {
+		sc.buffer = newFailoverBuffer(opts)
+	}
+}
+
+// PrimaryChanged is told by the discovery of the poolers whether a shard
+// has a primary: the statements of a shard that lost its primary are held
+// until a new one is found.
+func (sc *ScatterConn) PrimaryChanged(tableGroup, shard string, hasPrimary bool) {
+	if sc.buffer == nil {
+		return
+	}
+	key := shardKey{tableGroup: tableGroup, shard: shard}
+	if hasPrimary {
+		sc.buffer.end(key, nil)
+		return
+	}
+	sc.logger.Info("buffering statements during failover", "tablegroup", tableGroup, "shard", shard)
+	sc.buffer.start(key)
+}
+
+// withBuffering runs attempt on a shard once it is not failing over. If it
+// fails because the shard is failing over, the shard starts buffering and
+// attempt runs again once the failover ends, if replayable, when set,
+// allows it. Statements bound to a reserved connection are not buffered:
+// the connection does not survive the failover. Nor are statements routed
+// to replicas, which do not wait for the primary.
+func (sc *ScatterConn) withBuffering(
+	ctx context.Context,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	replayable func() bool,
+	attempt func() error,
+) error {
+	if sc.buffer == nil || sc.onReservedConnection(tableGroup, shard, state) ||
+		sc.routesToReplica(ctx, tableGroup, shard, state) {
+		return attempt()
+	}
+	key := shardKey{tableGroup: tableGroup, shard: shard}
+	if _, err := sc.buffer.wait(ctx, key); err != nil {
+		return err
+	}
+	err := attempt()
+	if !mterrors.IsFailover(err) || (replayable != nil && !replayable()) {
+		return err
+	}
+	if !sc.buffer.buffering(key) {
+		sc.logger.InfoContext(ctx, "buffering statements during failover",
+			"tablegroup", tableGroup,
+			"shard", shard,
+			"error", err)
+	}
+	sc.buffer.start(key)
+	held, waitErr := sc.buffer.wait(ctx, key)
+	if waitErr != nil || !held {
+		return err
+	}
+	return attempt()
+}
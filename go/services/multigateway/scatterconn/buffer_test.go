@@ -0,0 +1,148 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// bufferingConn returns a ScatterConn buffering the statements of shards
+// failing over for up to window.
+func bufferingConn(gateway *fakeShardGateway, window time.Duration, size int) *ScatterConn {
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetBufferOptions(BufferOptions{Window: window, Size: size})
+	return sc
+}
+
+// streamAsync runs sql on shard 0 in the background, and returns the
+// channel of its error.
+func streamAsync(sc *ScatterConn, sql string, state *handler.MultiGatewayConnectionState) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := stream(engine.WithStatementClass(context.Background(), engine.StatementWrite), sc, sql, state)
+		done <- err
+	}()
+	return done
+}
+
+func TestBufferHoldsStatementsDuringFailover(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := bufferingConn(gateway, time.Minute, 10)
+	sc.PrimaryChanged("tg", "0", false)
+
+	done := streamAsync(sc, "INSERT INTO t VALUES (1)", handler.NewMultiGatewayConnectionState())
+	select {
+	case err := <-done:
+		t.Fatalf("statement ran during the failover: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	assert.Empty(t, gateway.executed)
+
+	sc.PrimaryChanged("tg", "0", true)
+	require.NoError(t, <-done)
+	assert.Equal(t, []string{"0:INSERT INTO t VALUES (1)"}, gateway.executed)
+}
+
+func TestBufferReplaysStatementRejectedByFailover(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard: "0",
+		failErr:   mterrors.New(mtrpcpb.Code_CLUSTER_EVENT, "primary is demoting"),
+		failTimes: 1,
+	}
+	sc := bufferingConn(gateway, time.Minute, 10)
+
+	done := streamAsync(sc, "INSERT INTO t VALUES (1)", handler.NewMultiGatewayConnectionState())
+	require.Eventually(t, func() bool { return sc.buffer.buffering(shardKey{"tg", "0"}) }, time.Second, time.Millisecond)
+
+	sc.PrimaryChanged("tg", "0", true)
+	require.NoError(t, <-done)
+	assert.Len(t, gateway.executed, 2)
+}
+
+func TestBufferIgnoresReadOnlyTransactionErrors(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard: "0",
+		failErr:   sqlstate.NewError(sqlstate.ReadOnlySQLTransaction).Msg("cannot execute INSERT in a read-only transaction").Err(),
+	}
+	sc := bufferingConn(gateway, time.Minute, 10)
+
+	// A write in the client's own read-only transaction fails at once.
+	err := <-streamAsync(sc, "INSERT INTO t VALUES (1)", handler.NewMultiGatewayConnectionState())
+	require.Error(t, err)
+	assert.Len(t, gateway.executed, 1)
+	assert.False(t, sc.buffer.buffering(shardKey{"tg", "0"}))
+}
+
+func TestBufferWindowEnds(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard: "0",
+		failErr:   mterrors.New(mtrpcpb.Code_CLUSTER_EVENT, "primary is demoting"),
+	}
+	sc := bufferingConn(gateway, 10*time.Millisecond, 10)
+
+	// The failover outlasts the window: the replay fails too.
+	err := <-streamAsync(sc, "INSERT INTO t VALUES (1)", handler.NewMultiGatewayConnectionState())
+	assert.True(t, mterrors.IsFailover(err))
+	assert.Len(t, gateway.executed, 2)
+	assert.False(t, sc.buffer.buffering(shardKey{"tg", "0"}))
+}
+
+func TestBufferSkipsReservedConnections(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := bufferingConn(gateway, time.Minute, 10)
+	sc.PrimaryChanged("tg", "0", false)
+
+	state := handler.NewMultiGatewayConnectionState()
+	target := &query.Target{TableGroup: "tg", PoolerType: clustermetadatapb.PoolerType_PRIMARY, Shard: "0"}
+	state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: 1})
+
+	require.NoError(t, <-streamAsync(sc, "INSERT INTO t VALUES (1)", state))
+	assert.Len(t, gateway.executed, 1)
+}
+
+func TestBufferFull(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := bufferingConn(gateway, time.Minute, 1)
+	sc.PrimaryChanged("tg", "0", false)
+
+	held := streamAsync(sc, "INSERT INTO t VALUES (1)", handler.NewMultiGatewayConnectionState())
+	require.Eventually(t, func() bool {
+		sc.buffer.mu.Lock()
+		defer sc.buffer.mu.Unlock()
+		return sc.buffer.held == 1
+	}, time.Second, time.Millisecond)
+
+	// The buffer is full: the next statement runs without waiting.
+	require.NoError(t, <-streamAsync(sc, "INSERT INTO t VALUES (2)", handler.NewMultiGatewayConnectionState()))
+	assert.Equal(t, []string{"0:INSERT INTO t VALUES (2)"}, gateway.executed)
+
+	sc.PrimaryChanged("tg", "0", true)
+	require.NoError(t, <-held)
+}
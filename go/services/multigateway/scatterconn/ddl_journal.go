@@ -0,0 +1,83 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+)
+
+// ddlJournalTable is the table of the multigres sidecar schema holding the
+// progress of the schema changes run on several shards: a row per shard a
+// change ran on, in the 'done' or 'failed' state. The rows of a change are
+// deleted once it is applied on every shard.
+const ddlJournalTable = "multigres.ddl_journal"
+
+const (
+	ddlStateDone   = "done"
+	ddlStateFailed = "failed"
+)
+
+// LoadDDLProgress implements engine.DDLJournalStore.
+func (sc *ScatterConn) LoadDDLProgress(ctx context.Context, tableGroup, shard, id string) ([]string, error) {
+	sql := fmt.Sprintf("SELECT shard FROM %s WHERE ddl_id = %s AND state = '%s'",
+		ddlJournalTable, ast.QuoteStringLiteral(id), ddlStateDone)
+	result, err := sc.gateway.ExecuteQuery(ctx, primaryTarget(tableGroup, shard), sql, &query.ExecuteOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the DDL journal: %w", err)
+	}
+	shards := make([]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		if len(row.Values) != 1 {
+			return nil, fmt.Errorf("failed to read the DDL journal: unexpected result")
+		}
+		shards = append(shards, string(row.Values[0]))
+	}
+	return shards, nil
+}
+
+// RecordDDLProgress implements engine.DDLJournalStore. The outcome is
+// recorded outside the session's transaction, so that it survives the
+// failure of the session.
+func (sc *ScatterConn) RecordDDLProgress(ctx context.Context, tableGroup, shard, id, ddl, ddlShard, errMsg string) error {
+	state, errValue := ddlStateDone, "NULL"
+	if errMsg != "" {
+		state, errValue = ddlStateFailed, ast.QuoteStringLiteral(errMsg)
+	}
+	sql := fmt.Sprintf(
+		"INSERT INTO %[1]s (ddl_id, shard, query, state, error) VALUES (%[2]s, %[3]s, %[4]s, '%[5]s', %[6]s) "+
+			"ON CONFLICT (ddl_id, shard) DO UPDATE SET state = EXCLUDED.state, error = EXCLUDED.error, updated_at = now()",
+		ddlJournalTable, ast.QuoteStringLiteral(id), ast.QuoteStringLiteral(ddlShard), ast.QuoteStringLiteral(ddl), state, errValue)
+	if _, err := sc.gateway.ExecuteQuery(ctx, primaryTarget(tableGroup, shard), sql, &query.ExecuteOptions{}); err != nil {
+		return fmt.Errorf("failed to write the DDL journal: %w", err)
+	}
+	return nil
+}
+
+// ForgetDDLProgress implements engine.DDLJournalStore.
+func (sc *ScatterConn) ForgetDDLProgress(ctx context.Context, tableGroup, shard, id string) error {
+	sql := fmt.Sprintf("DELETE FROM %s WHERE ddl_id = %s", ddlJournalTable, ast.QuoteStringLiteral(id))
+	if _, err := sc.gateway.ExecuteQuery(ctx, primaryTarget(tableGroup, shard), sql, &query.ExecuteOptions{}); err != nil {
+		return fmt.Errorf("failed to clear the DDL journal: %w", err)
+	}
+	return nil
+}
+
+// Ensure ScatterConn implements engine.DDLJournalStore interface.
+var _ engine.DDLJournalStore = (*ScatterConn)(nil)
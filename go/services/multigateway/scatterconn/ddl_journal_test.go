@@ -0,0 +1,60 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestDDLJournal(t *testing.T) {
+	gateway := &fakeShardGateway{queryResult: &sqltypes.Result{Rows: []*sqltypes.Row{
+		sqltypes.NewRowBuilder(nil).Int64(1).MustBuild(),
+		sqltypes.NewRowBuilder(nil).Int64(2).MustBuild(),
+	}}}
+	sc := NewScatterConn(gateway, slog.Default())
+	ctx := context.Background()
+
+	shards, err := sc.LoadDDLProgress(ctx, "tg", "0", "abc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, shards)
+	require.NoError(t, sc.RecordDDLProgress(ctx, "tg", "0", "abc", "ALTER TABLE t ADD b int", "3", ""))
+	require.NoError(t, sc.RecordDDLProgress(ctx, "tg", "0", "abc", "ALTER TABLE t ADD b int", "4", "it's broken"))
+	require.NoError(t, sc.ForgetDDLProgress(ctx, "tg", "0", "abc"))
+
+	assert.Equal(t, []string{
+		"0:SELECT shard FROM multigres.ddl_journal WHERE ddl_id = 'abc' AND state = 'done'",
+		"0:INSERT INTO multigres.ddl_journal (ddl_id, shard, query, state, error) VALUES ('abc', '3', 'ALTER TABLE t ADD b int', 'done', NULL) " +
+			"ON CONFLICT (ddl_id, shard) DO UPDATE SET state = EXCLUDED.state, error = EXCLUDED.error, updated_at = now()",
+		"0:INSERT INTO multigres.ddl_journal (ddl_id, shard, query, state, error) VALUES ('abc', '4', 'ALTER TABLE t ADD b int', 'failed', 'it''s broken') " +
+			"ON CONFLICT (ddl_id, shard) DO UPDATE SET state = EXCLUDED.state, error = EXCLUDED.error, updated_at = now()",
+		"0:DELETE FROM multigres.ddl_journal WHERE ddl_id = 'abc'",
+	}, gateway.executed)
+}
+
+func TestDDLJournalFailure(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "0", failPrefix: "INSERT"}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	err := sc.RecordDDLProgress(context.Background(), "tg", "0", "abc", "ALTER TABLE t ADD b int", "1", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to write the DDL journal")
+}
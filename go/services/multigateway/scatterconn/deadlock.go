@@ -0,0 +1,270 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// Distributed deadlock detection
+//
+// PostgreSQL detects deadlocks among the backends of a server, but not
+// those spanning shards: a session waiting on shard 0 for a lock held by a
+// second session, which waits on shard 1 for a lock held by the first, hang
+// until statement_timeout.
+//
+// The DeadlockDetector periodically samples the lock waits of the shards
+// participating in the open transactions of the gateway's sessions, builds
+// the graph of sessions waiting for each other, and aborts the query of one
+// session of each cycle spanning several shards with a deadlock_detected
+// error, as PostgreSQL does for the victim of a deadlock.
+//
+// Backends are mapped to sessions by the label in their application_name,
+// so sessions must be labeled. The shards are not sampled at the same
+// instant, so a cycle is only acted upon once seen by two checks in a row.
+//
+// Each gateway only knows its own sessions: the backends of the sessions of
+// other gateways are ignored, so a cycle through a session of another
+// gateway is not detected, and lasts until statement_timeout.
+
+// lockWaitsQuery returns, for each backend waiting for a lock, the
+// application_name of the backends blocking it.
+const lockWaitsQuery = "SELECT waiter.application_name, blocker.application_name " +
+	"FROM pg_stat_activity AS waiter " +
+	"CROSS JOIN LATERAL unnest(pg_blocking_pids(waiter.pid)) AS blocking(pid) " +
+	"JOIN pg_stat_activity AS blocker ON blocker.pid = blocking.pid " +
+	"WHERE waiter.wait_event_type = 'Lock'"
+
+// DeadlockDetector detects and resolves the deadlocks among sessions spanning
+// several shards.
+type DeadlockDetector struct {
+	sc *ScatterConn
+	// transactions returns the sessions in a transaction block.
+	transactions func() []handler.SessionTransaction
+	// suspected holds the keys of the cycles found by the previous check.
+	suspected map[string]bool
+}
+
+// NewDeadlockDetector creates a detector for the sessions returned by
+// transactions, which must be labeled (see
+// handler.MultiGatewayHandler.Transactions).
+func NewDeadlockDetector(sc *ScatterConn, transactions func() []handler.SessionTransaction) *DeadlockDetector {
+	return &DeadlockDetector{
+		sc:           sc,
+		transactions: transactions,
+	}
+}
+
+// Run calls Check every interval until ctx is done.
+func (d *DeadlockDetector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.Check(ctx); err != nil {
+				d.sc.logger.WarnContext(ctx, "deadlock detection failed", "error", err)
+			}
+		}
+	}
+}
+
+// waitEdge records that a session waits for another on a shard.
+type waitEdge struct {
+	waiter, blocker int
+	shard           *query.Target
+}
+
+// Check samples the lock waits of the shards of the open transactions, and
+// aborts a victim of each cycle spanning several shards that the previous
+// check also found. Shards that cannot be sampled are skipped, and their
+// errors returned.
+func (d *DeadlockDetector) Check(ctx context.Context) error {
+	txns := d.transactions()
+	if !slices.ContainsFunc(txns, func(txn handler.SessionTransaction) bool { return len(txn.Participants) > 1 }) {
+		d.suspected = nil
+		return nil
+	}
+
+	sessions := make(map[string]int, len(txns))
+	var shards []*query.Target
+	for i, txn := range txns {
+		sessions[txn.Label] = i
+		for _, target := range txn.Participants {
+			if !slices.ContainsFunc(shards, func(s *query.Target) bool {
+				return s.TableGroup == target.TableGroup && s.Shard == target.Shard
+			}) {
+				shards = append(shards, target)
+			}
+		}
+	}
+
+	var edges []waitEdge
+	var errs []error
+	for _, shard := range shards {
+		result, err := d.sc.gateway.ExecuteQuery(ctx, primaryTarget(shard.TableGroup, shard.Shard), lockWaitsQuery, &query.ExecuteOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("shard %s/%s: %w", shard.TableGroup, shard.Shard, err))
+			continue
+		}
+		for _, row := range result.Rows {
+			if len(row.Values) < 2 {
+				continue
+			}
+			waiter, ok := sessions[handler.ApplicationNameLabel(string(row.Values[0]))]
+			if !ok {
+				continue
+			}
+			blocker, ok := sessions[handler.ApplicationNameLabel(string(row.Values[1]))]
+			if !ok || blocker == waiter {
+				continue
+			}
+			edges = append(edges, waitEdge{waiter: waiter, blocker: blocker, shard: shard})
+		}
+	}
+
+	suspected := make(map[string]bool)
+	removed := make(map[int]bool)
+	for {
+		cycle := findWaitCycle(len(txns), edges, removed)
+		if cycle == nil {
+			break
+		}
+		for _, edge := range cycle {
+			removed[edge.waiter] = true
+		}
+		// PostgreSQL resolves the deadlocks within a shard.
+		if !spansShards(cycle) {
+			continue
+		}
+		key := cycleKey(txns, cycle)
+		suspected[key] = true
+		if d.suspected[key] {
+			d.abortVictim(ctx, txns, cycle)
+		}
+	}
+	d.suspected = suspected
+	return errors.Join(errs...)
+}
+
+// abortVictim aborts the query of the session of the cycle that connected
+// last, releasing the locks its transaction holds once rolled back.
+func (d *DeadlockDetector) abortVictim(ctx context.Context, txns []handler.SessionTransaction, cycle []waitEdge) {
+	victim := cycle[0].waiter
+	for _, edge := range cycle {
+		if txns[edge.waiter].Conn.ConnectionID() > txns[victim].Conn.ConnectionID() {
+			victim = edge.waiter
+		}
+	}
+
+	var detail strings.Builder
+	for i, edge := range cycle {
+		if i > 0 {
+			detail.WriteString("\n")
+		}
+		fmt.Fprintf(&detail, "Session %d waits for session %d on shard %s/%s.",
+			txns[edge.waiter].Conn.ConnectionID(), txns[edge.blocker].Conn.ConnectionID(),
+			edge.shard.TableGroup, edge.shard.Shard)
+	}
+	err := sqlstate.NewError(sqlstate.DeadlockDetected).
+		Msg("deadlock detected").
+		Detail("%s", detail.String()).
+		Err()
+	if txns[victim].Conn.AbortQuery(err) {
+		d.sc.logger.WarnContext(ctx, "aborted distributed deadlock victim",
+			"connection_id", txns[victim].Conn.ConnectionID(),
+			"cycle", detail.String())
+	}
+}
+
+// findWaitCycle returns the edges of a cycle of the wait-for graph among
+// the sessions not removed, or nil if there is none.
+func findWaitCycle(sessions int, edges []waitEdge, removed map[int]bool) []waitEdge {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	color := make([]int, sessions)
+	var path []waitEdge
+
+	var visit func(session int) []waitEdge
+	visit = func(session int) []waitEdge {
+		color[session] = onPath
+		for _, edge := range edges {
+			if edge.waiter != session || removed[edge.blocker] {
+				continue
+			}
+			switch color[edge.blocker] {
+			case onPath:
+				// The cycle starts at the edge leaving the blocker.
+				path = append(path, edge)
+				for i, e := range path {
+					if e.waiter == edge.blocker {
+						return slices.Clone(path[i:])
+					}
+				}
+			case unvisited:
+				path = append(path, edge)
+				if cycle := visit(edge.blocker); cycle != nil {
+					return cycle
+				}
+				path = path[:len(path)-1]
+			}
+		}
+		color[session] = done
+		return nil
+	}
+
+	for session := range sessions {
+		if color[session] == unvisited && !removed[session] {
+			if cycle := visit(session); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// spansShards returns true if the waits of a cycle are on several shards.
+func spansShards(cycle []waitEdge) bool {
+	for _, edge := range cycle[1:] {
+		if edge.shard.TableGroup != cycle[0].shard.TableGroup || edge.shard.Shard != cycle[0].shard.Shard {
+			return true
+		}
+	}
+	return false
+}
+
+// cycleKey identifies a cycle by the labels of its sessions.
+func cycleKey(txns []handler.SessionTransaction, cycle []waitEdge) string {
+	labels := make([]string, len(cycle))
+	for i, edge := range cycle {
+		labels[i] = txns[edge.waiter].Label
+	}
+	slices.Sort(labels)
+	return strings.Join(labels, ",")
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// lockWaits returns the result of lockWaitsQuery for waits between pairs
+// of backend application names.
+func lockWaits(pairs ...[2]string) *sqltypes.Result {
+	result := &sqltypes.Result{}
+	row := sqltypes.NewRowBuilder(nil)
+	for _, pair := range pairs {
+		result.Rows = append(result.Rows, row.Text(pair[0]).Text(pair[1]).MustBuild())
+	}
+	return result
+}
+
+// newDeadlockTest returns sessions 1 and 2, each running a query in a
+// transaction on shards 0 and 1, and the contexts of their queries.
+func newDeadlockTest(t *testing.T) ([]handler.SessionTransaction, []context.Context) {
+	var txns []handler.SessionTransaction
+	var queries []context.Context
+	for _, id := range []uint32{1, 2} {
+		conn := server.NewTestConn(&bytes.Buffer{})
+		conn.SetConnectionID(id)
+		ctx, endQuery := conn.BeginQuery()
+		t.Cleanup(endQuery)
+		queries = append(queries, ctx)
+		txns = append(txns, handler.SessionTransaction{
+			Conn:         conn.Conn,
+			Label:        fmt.Sprintf("multigres:gw:%d", id),
+			Participants: []*query.Target{primaryTarget("tg", "0"), primaryTarget("tg", "1")},
+		})
+	}
+	return txns, queries
+}
+
+func TestDeadlockDetectorAbortsVictim(t *testing.T) {
+	txns, queries := newDeadlockTest(t)
+	gateway := &fakeShardGateway{shardResults: map[string]*sqltypes.Result{
+		"0": lockWaits([2]string{"psql (multigres:gw:1)", "multigres:gw:2"}),
+		"1": lockWaits([2]string{"multigres:gw:2", "psql (multigres:gw:1)"}, [2]string{"other", "multigres:gw:2"}),
+	}}
+	sc := NewScatterConn(gateway, slog.Default())
+	detector := NewDeadlockDetector(sc, func() []handler.SessionTransaction { return txns })
+
+	// A cycle is only acted upon once seen by two checks in a row.
+	require.NoError(t, detector.Check(context.Background()))
+	assert.Equal(t, []string{"0:" + lockWaitsQuery, "1:" + lockWaitsQuery}, gateway.executed)
+	require.NoError(t, queries[0].Err())
+	require.NoError(t, queries[1].Err())
+
+	require.NoError(t, detector.Check(context.Background()))
+	require.NoError(t, queries[0].Err())
+	// The session that connected last is the victim.
+	require.Error(t, queries[1].Err())
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, context.Cause(queries[1]), &diag)
+	assert.Equal(t, sqlstate.DeadlockDetected, diag.Code)
+	assert.Equal(t, "Session 1 waits for session 2 on shard tg/0.\nSession 2 waits for session 1 on shard tg/1.", diag.Detail)
+}
+
+func TestDeadlockDetectorWaitsForConfirmation(t *testing.T) {
+	txns, queries := newDeadlockTest(t)
+	gateway := &fakeShardGateway{shardResults: map[string]*sqltypes.Result{
+		"0": lockWaits([2]string{"multigres:gw:1", "multigres:gw:2"}),
+		"1": lockWaits([2]string{"multigres:gw:2", "multigres:gw:1"}),
+	}}
+	sc := NewScatterConn(gateway, slog.Default())
+	detector := NewDeadlockDetector(sc, func() []handler.SessionTransaction { return txns })
+
+	require.NoError(t, detector.Check(context.Background()))
+	// The wait on shard 1 was over by the second check.
+	gateway.shardResults["1"] = lockWaits()
+	require.NoError(t, detector.Check(context.Background()))
+	gateway.shardResults["1"] = lockWaits([2]string{"multigres:gw:2", "multigres:gw:1"})
+	require.NoError(t, detector.Check(context.Background()))
+
+	for _, ctx := range queries {
+		assert.NoError(t, ctx.Err())
+	}
+}
+
+func TestDeadlockDetectorIgnoresSingleShardCycles(t *testing.T) {
+	txns, queries := newDeadlockTest(t)
+	gateway := &fakeShardGateway{
+		failShard: "1",
+		shardResults: map[string]*sqltypes.Result{
+			// PostgreSQL resolves this one.
+			"0": lockWaits([2]string{"multigres:gw:1", "multigres:gw:2"}, [2]string{"multigres:gw:2", "multigres:gw:1"}),
+		},
+	}
+	sc := NewScatterConn(gateway, slog.Default())
+	detector := NewDeadlockDetector(sc, func() []handler.SessionTransaction { return txns })
+
+	// Shards that cannot be sampled are reported.
+	assert.Error(t, detector.Check(context.Background()))
+	assert.Error(t, detector.Check(context.Background()))
+	for _, ctx := range queries {
+		assert.NoError(t, ctx.Err())
+	}
+}
+
+func TestDeadlockDetectorIgnoresOtherGateways(t *testing.T) {
+	txns, queries := newDeadlockTest(t)
+	// Session 1 and a session of another gateway wait for each other.
+	gateway := &fakeShardGateway{shardResults: map[string]*sqltypes.Result{
+		"0": lockWaits([2]string{"multigres:gw:1", "multigres:gw2:7"}),
+		"1": lockWaits([2]string{"multigres:gw2:7", "multigres:gw:1"}),
+	}}
+	sc := NewScatterConn(gateway, slog.Default())
+	detector := NewDeadlockDetector(sc, func() []handler.SessionTransaction { return txns })
+
+	// Only the sessions of the gateway are known, so the cycle is not seen.
+	require.NoError(t, detector.Check(context.Background()))
+	require.NoError(t, detector.Check(context.Background()))
+	for _, ctx := range queries {
+		assert.NoError(t, ctx.Err())
+	}
+}
+
+func TestFindWaitCycle(t *testing.T) {
+	shard := primaryTarget("tg", "0")
+	edges := []waitEdge{
+		{waiter: 0, blocker: 1, shard: shard},
+		{waiter: 1, blocker: 2, shard: shard},
+		{waiter: 2, blocker: 1, shard: shard},
+		{waiter: 3, blocker: 0, shard: shard},
+	}
+	assert.Equal(t, []waitEdge{edges[1], edges[2]}, findWaitCycle(4, edges, map[int]bool{}))
+	assert.Nil(t, findWaitCycle(4, edges, map[int]bool{2: true}))
+}
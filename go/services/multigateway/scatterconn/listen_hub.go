@@ -0,0 +1,243 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// relistenDelay is the time to wait before restarting a notification stream
+// that was lost, e.g. because its pooler went away.
+const relistenDelay = time.Second
+
+// notificationListener receives the notifications of the channels it
+// listens on. It is implemented by *server.Conn.
+type notificationListener interface {
+	SendNotification(pid uint32, channel, payload string) error
+}
+
+// listenKey identifies a channel on a shard.
+type listenKey struct {
+	tableGroup string
+	shard      string
+	channel    string
+}
+
+// listenStream is the notification stream of one channel on one shard,
+// shared by all the client connections listening on it.
+type listenStream struct {
+	listeners map[notificationListener]struct{}
+	cancel    context.CancelFunc
+
+	// ready is closed once the pooler is listening, or once the stream
+	// failed to start, in which case err is set.
+	ready   chan struct{}
+	isReady bool
+	err     error
+}
+
+// listenHub multiplexes the LISTENs of all client connections over one
+// notification stream per shard and channel. Streams are started by the
+// first listener and stopped when the last one leaves; a stream that is
+// lost afterwards is restarted, so listeners keep their LISTENs across
+// pooler restarts.
+type listenHub struct {
+	logger *slog.Logger
+	qs     queryservice.QueryService
+
+	mu      sync.Mutex
+	streams map[listenKey]*listenStream
+	// listening records the channels each listener listens on.
+	listening map[notificationListener]map[listenKey]struct{}
+}
+
+func newListenHub(qs queryservice.QueryService, logger *slog.Logger) *listenHub {
+	return &listenHub{
+		logger:    logger,
+		qs:        qs,
+		streams:   make(map[listenKey]*listenStream),
+		listening: make(map[notificationListener]map[listenKey]struct{}),
+	}
+}
+
+// listen subscribes l to the channel on target. It returns once the pooler
+// is listening, so no notification raised after listen returns is missed.
+// Listening again on a channel is a no-op, as in PostgreSQL.
+func (h *listenHub) listen(
+	ctx context.Context,
+	target *query.Target,
+	channel string,
+	options *query.ExecuteOptions,
+	l notificationListener,
+) error {
+	key := listenKey{tableGroup: target.TableGroup, shard: target.Shard, channel: channel}
+
+	h.mu.Lock()
+	if _, ok := h.listening[l][key]; ok {
+		h.mu.Unlock()
+		return nil
+	}
+	st := h.streams[key]
+	if st == nil {
+		streamCtx, cancel := context.WithCancel(context.Background())
+		st = &listenStream{
+			listeners: make(map[notificationListener]struct{}),
+			cancel:    cancel,
+			ready:     make(chan struct{}),
+		}
+		h.streams[key] = st
+		go h.run(streamCtx, key, st, target, options)
+	}
+	st.listeners[l] = struct{}{}
+	if h.listening[l] == nil {
+		h.listening[l] = make(map[listenKey]struct{})
+	}
+	h.listening[l][key] = struct{}{}
+	h.mu.Unlock()
+
+	select {
+	case <-st.ready:
+	case <-ctx.Done():
+		h.remove(l, key)
+		return ctx.Err()
+	}
+	if st.err != nil {
+		h.remove(l, key)
+		return st.err
+	}
+	return nil
+}
+
+// unlisten unsubscribes l from the channel on the given shard, or from all
+// of its channels on the shard if channel is empty.
+func (h *listenHub) unlisten(tableGroup, shard, channel string, l notificationListener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key := range h.listening[l] {
+		if key.tableGroup == tableGroup && key.shard == shard && (channel == "" || key.channel == channel) {
+			h.removeLocked(l, key)
+		}
+	}
+}
+
+// remove unsubscribes l from the channel identified by key.
+func (h *listenHub) remove(l notificationListener, key listenKey) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.removeLocked(l, key)
+}
+
+// removeLocked unsubscribes l from key and stops the stream once it has no
+// listeners left. h.mu must be held.
+func (h *listenHub) removeLocked(l notificationListener, key listenKey) {
+	if keys := h.listening[l]; keys != nil {
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(h.listening, l)
+		}
+	}
+	st := h.streams[key]
+	if st == nil {
+		return
+	}
+	delete(st.listeners, l)
+	if len(st.listeners) == 0 {
+		st.cancel()
+		delete(h.streams, key)
+	}
+}
+
+// run keeps the notification stream of a channel open until it is
+// cancelled, dispatching the notifications it receives to the listeners.
+func (h *listenHub) run(ctx context.Context, key listenKey, st *listenStream, target *query.Target, options *query.ExecuteOptions) {
+	for {
+		err := h.qs.StreamNotifications(ctx, target, key.channel, options,
+			func(ctx context.Context) error {
+				h.markReady(st, nil)
+				return nil
+			},
+			func(ctx context.Context, notification *query.Notification) error {
+				h.dispatch(st, notification)
+				return nil
+			},
+		)
+		if ctx.Err() != nil {
+			return
+		}
+
+		h.mu.Lock()
+		started := st.isReady
+		h.mu.Unlock()
+		if !started {
+			// Nobody has been told the LISTEN succeeded; fail it.
+			h.markReady(st, err)
+			st.cancel()
+			h.mu.Lock()
+			if h.streams[key] == st {
+				delete(h.streams, key)
+			}
+			h.mu.Unlock()
+			return
+		}
+
+		h.logger.WarnContext(ctx, "notification stream lost, listening again",
+			"tablegroup", key.tableGroup,
+			"shard", key.shard,
+			"channel", key.channel,
+			"error", err)
+		t := time.NewTimer(relistenDelay)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return
+		}
+	}
+}
+
+// markReady wakes up the listeners waiting for the stream to start.
+func (h *listenHub) markReady(st *listenStream, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !st.isReady {
+		st.isReady = true
+		st.err = err
+		close(st.ready)
+	}
+}
+
+// dispatch sends a notification to the listeners of a stream.
+func (h *listenHub) dispatch(st *listenStream, notification *query.Notification) {
+	h.mu.Lock()
+	listeners := make([]notificationListener, 0, len(st.listeners))
+	for l := range st.listeners {
+		listeners = append(listeners, l)
+	}
+	h.mu.Unlock()
+
+	for _, l := range listeners {
+		if err := l.SendNotification(notification.Pid, notification.Channel, notification.Payload); err != nil {
+			h.logger.Warn("failed to send notification to client",
+				"channel", notification.Channel,
+				"error", err)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// fakeNotificationService serves notification streams. Each stream is
+// registered under its channel so tests can push notifications or break it.
+type fakeNotificationService struct {
+	queryservice.QueryService
+
+	// failStart makes streams fail before becoming ready.
+	failStart error
+
+	mu      sync.Mutex
+	streams map[string]*fakeNotificationStream
+	started int
+}
+
+type fakeNotificationStream struct {
+	notify chan *query.Notification
+	broken chan struct{}
+	ended  chan struct{}
+}
+
+func newFakeNotificationService() *fakeNotificationService {
+	return &fakeNotificationService{streams: make(map[string]*fakeNotificationStream)}
+}
+
+func (f *fakeNotificationService) StreamNotifications(
+	ctx context.Context,
+	target *query.Target,
+	channel string,
+	options *query.ExecuteOptions,
+	onReady func(ctx context.Context) error,
+	callback func(ctx context.Context, notification *query.Notification) error,
+) error {
+	if f.failStart != nil {
+		return f.failStart
+	}
+	st := &fakeNotificationStream{
+		notify: make(chan *query.Notification, 16),
+		broken: make(chan struct{}),
+		ended:  make(chan struct{}),
+	}
+	defer close(st.ended)
+	f.mu.Lock()
+	f.streams[channel] = st
+	f.started++
+	f.mu.Unlock()
+
+	if err := onReady(ctx); err != nil {
+		return err
+	}
+	for {
+		select {
+		case n := <-st.notify:
+			if err := callback(ctx, n); err != nil {
+				return err
+			}
+		case <-st.broken:
+			return errors.New("pooler went away")
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (f *fakeNotificationService) stream(channel string) *fakeNotificationStream {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.streams[channel]
+}
+
+func (f *fakeNotificationService) startCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.started
+}
+
+// fakeListener records the notifications sent to it.
+type fakeListener struct {
+	notifications chan string
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{notifications: make(chan string, 16)}
+}
+
+func (l *fakeListener) SendNotification(pid uint32, channel, payload string) error {
+	l.notifications <- payload
+	return nil
+}
+
+func (l *fakeListener) receive(t *testing.T) string {
+	t.Helper()
+	select {
+	case payload := <-l.notifications:
+		return payload
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for notification")
+		return ""
+	}
+}
+
+var testTarget = &query.Target{TableGroup: "default"}
+
+func TestListenHubSharesStream(t *testing.T) {
+	qs := newFakeNotificationService()
+	hub := newListenHub(qs, slog.Default())
+	l1, l2 := newFakeListener(), newFakeListener()
+
+	require.NoError(t, hub.listen(context.Background(), testTarget, "jobs", nil, l1))
+	require.NoError(t, hub.listen(context.Background(), testTarget, "jobs", nil, l2))
+	// Listening twice is a no-op.
+	require.NoError(t, hub.listen(context.Background(), testTarget, "jobs", nil, l1))
+	assert.Equal(t, 1, qs.startCount())
+
+	qs.stream("jobs").notify <- &query.Notification{Channel: "jobs", Payload: "run"}
+	assert.Equal(t, "run", l1.receive(t))
+	assert.Equal(t, "run", l2.receive(t))
+	assert.Empty(t, l1.notifications)
+}
+
+func TestListenHubStopsStreamAfterLastListener(t *testing.T) {
+	qs := newFakeNotificationService()
+	hub := newListenHub(qs, slog.Default())
+	l1, l2 := newFakeListener(), newFakeListener()
+
+	require.NoError(t, hub.listen(context.Background(), testTarget, "jobs", nil, l1))
+	require.NoError(t, hub.listen(context.Background(), testTarget, "jobs", nil, l2))
+	st := qs.stream("jobs")
+
+	hub.unlisten("default", "", "jobs", l1)
+	select {
+	case <-st.ended:
+		require.FailNow(t, "stream stopped while it still has a listener")
+	default:
+	}
+
+	// An empty channel unlistens from all channels.
+	hub.unlisten("default", "", "", l2)
+	select {
+	case <-st.ended:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "stream not stopped after the last listener left")
+	}
+}
+
+func TestListenHubRestartsLostStream(t *testing.T) {
+	qs := newFakeNotificationService()
+	hub := newListenHub(qs, slog.Default())
+	l := newFakeListener()
+
+	require.NoError(t, hub.listen(context.Background(), testTarget, "jobs", nil, l))
+	first := qs.stream("jobs")
+	close(first.broken)
+
+	require.Eventually(t, func() bool {
+		return qs.startCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	qs.stream("jobs").notify <- &query.Notification{Channel: "jobs", Payload: "after restart"}
+	assert.Equal(t, "after restart", l.receive(t))
+
+	hub.unlisten("default", "", "", l)
+}
+
+func TestListenHubListenFails(t *testing.T) {
+	qs := newFakeNotificationService()
+	qs.failStart = errors.New("no pooler available")
+	hub := newListenHub(qs, slog.Default())
+
+	err := hub.listen(context.Background(), testTarget, "jobs", nil, newFakeListener())
+	require.ErrorContains(t, err, "no pooler available")
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	assert.Empty(t, hub.streams)
+	assert.Empty(t, hub.listening)
+}
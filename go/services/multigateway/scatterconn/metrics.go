@@ -0,0 +1,151 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/tools/telemetry"
+)
+
+// Metrics holds the OpenTelemetry metrics of the queries run on the
+// shards, and of the retries of reads failing on a shard with a transient
+// error.
+type Metrics struct {
+	meter         metric.Meter
+	retries       metric.Int64Counter
+	rejected      metric.Int64Counter
+	budget        metric.Float64ObservableGauge
+	shardDuration metric.Float64Histogram
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the shard queries and
+// the retries of reads.
+// A metric that fails to initialize uses a noop implementation, and the
+// errors are returned along with the usable Metrics instance. Use
+// RegisterRetryBudgetCallback() to report the retry budget.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multigateway/scatterconn"),
+	}
+
+	var errs []error
+	counter := func(name, description, unit string) metric.Int64Counter {
+		c, err := m.meter.Int64Counter(name,
+			metric.WithDescription(description),
+			metric.WithUnit(unit),
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s counter: %w", name, err))
+			return noop.Int64Counter{}
+		}
+		return c
+	}
+	m.retries = counter("multigateway.retries", "Number of reads retried on a shard after a transient error, by outcome", "{read}")
+	m.rejected = counter("multigateway.retries.rejected", "Number of retries of reads denied by an exhausted retry budget", "{retry}")
+
+	budget, err := m.meter.Float64ObservableGauge(
+		"multigateway.retries.budget",
+		metric.WithDescription("Current number of retries left in the retry budget"),
+		metric.WithUnit("{retry}"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multigateway.retries.budget gauge: %w", err))
+		budget = noop.Float64ObservableGauge{}
+	}
+	m.budget = budget
+
+	shardDuration, err := m.meter.Float64Histogram(
+		"multigateway.shard_query.duration",
+		metric.WithDescription("Duration of the queries run on a shard, by pooler type and outcome"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multigateway.shard_query.duration histogram: %w", err))
+		shardDuration = noop.Float64Histogram{}
+	}
+	m.shardDuration = shardDuration
+
+	return m, errors.Join(errs...)
+}
+
+// RegisterRetryBudgetCallback registers a callback observing the retry
+// budget of sc. Returns an error if registration fails.
+func (m *Metrics) RegisterRetryBudgetCallback(sc *ScatterConn) error {
+	if sc == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			observer.ObserveFloat64(m.budget, sc.RetryBudget())
+			return nil
+		},
+		m.budget,
+	)
+	return err
+}
+
+// recordRetry records a read retried on a shard, and whether it
+// eventually succeeded.
+func (m *Metrics) recordRetry(ctx context.Context, tableGroup, shard string, succeeded bool) {
+	if m == nil {
+		return
+	}
+	outcome := "failed"
+	if succeeded {
+		outcome = "succeeded"
+	}
+	m.retries.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("tablegroup", tableGroup),
+		attribute.String("shard", shard),
+		attribute.String("outcome", outcome)))
+}
+
+// recordRetryRejected records a retry denied by the retry budget.
+func (m *Metrics) recordRetryRejected(ctx context.Context, tableGroup, shard string) {
+	if m == nil {
+		return
+	}
+	m.rejected.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("tablegroup", tableGroup),
+		attribute.String("shard", shard)))
+}
+
+// recordShardQuery records a query run on target, and whether it
+// succeeded.
+func (m *Metrics) recordShardQuery(ctx context.Context, database string, target *query.Target, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	m.shardDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String(telemetry.AttrDatabase, database),
+		attribute.String(telemetry.AttrTableGroup, target.TableGroup),
+		attribute.String(telemetry.AttrShard, target.Shard),
+		attribute.String(telemetry.AttrTabletType, target.PoolerType.String()),
+		attribute.String("status", status)))
+}
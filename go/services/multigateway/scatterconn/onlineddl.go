@@ -0,0 +1,32 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/onlineddl"
+)
+
+// ExecuteOnPrimary implements onlineddl.ShardExecutor. The statement runs
+// on a pooled connection of the primary of the shard, outside any session.
+func (sc *ScatterConn) ExecuteOnPrimary(ctx context.Context, tableGroup, shard, sql string) (*sqltypes.Result, error) {
+	return sc.gateway.ExecuteQuery(ctx, primaryTarget(tableGroup, shard), sql, &query.ExecuteOptions{})
+}
+
+// Ensure ScatterConn implements onlineddl.ShardExecutor interface.
+var _ onlineddl.ShardExecutor = (*ScatterConn)(nil)
@@ -0,0 +1,43 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestExecuteOnPrimary(t *testing.T) {
+	gateway := &fakeShardGateway{queryResult: &sqltypes.Result{Rows: []*sqltypes.Row{
+		sqltypes.NewRowBuilder(nil).Text("t").MustBuild(),
+	}}}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	result, err := sc.ExecuteOnPrimary(context.Background(), "tg", "1", "SELECT to_regclass('t') IS NOT NULL")
+	require.NoError(t, err)
+	assert.Len(t, result.Rows, 1)
+	assert.Equal(t, []string{"1:SELECT to_regclass('t') IS NOT NULL"}, gateway.executed)
+
+	gateway = &fakeShardGateway{failShard: "1", failPrefix: "DROP"}
+	sc = NewScatterConn(gateway, slog.Default())
+	_, err = sc.ExecuteOnPrimary(context.Background(), "tg", "1", "DROP TABLE t")
+	require.Error(t, err)
+}
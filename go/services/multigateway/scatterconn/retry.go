@@ -0,0 +1,189 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// RetryOptions configures the replays of reads failing on a shard with a
+// transient error (see mterrors.IsRetryable).
+type RetryOptions struct {
+	// RetryPolicy bounds the runs of a read on a shard and the waits
+	// between them.
+	mterrors.RetryPolicy
+
+	// Budget is the number of retries the gateway may run in a burst, and
+	// BudgetRatio the retries it earns for each read it runs, so that the
+	// retries of a failing shard do not multiply its load. Without budget,
+	// the retries are only bounded by MaxAttempts.
+	Budget      float64
+	BudgetRatio float64
+}
+
+// retryBudget is a bucket of retry tokens: each retried read takes a
+// token, and each read run returns a fraction of one.
+type retryBudget struct {
+	mu     sync.Mutex
+	tokens float64
+	max    float64
+	ratio  float64
+}
+
+func newRetryBudget(max, ratio float64) *retryBudget {
+	return &retryBudget{tokens: max, max: max, ratio: ratio}
+}
+
+// deposit earns the tokens of a read.
+func (b *retryBudget) deposit() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.tokens+b.ratio, b.max)
+}
+
+// withdraw takes the token of a retry, and returns false if none is left.
+func (b *retryBudget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// available returns the tokens left.
+func (b *retryBudget) available() float64 {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens
+}
+
+// SetRetryOptions sets how reads failing on a shard with a transient error
+// are replayed.
+func (sc *ScatterConn) SetRetryOptions(opts RetryOptions) {
+	sc.retry = opts
+	sc.retryBudget = nil
+	if opts.Budget > 0 {
+		sc.retryBudget = newRetryBudget(opts.Budget, opts.BudgetRatio)
+	}
+}
+
+// SetMetrics sets the metrics the ScatterConn records its retries in.
+func (sc *ScatterConn) SetMetrics(metrics *Metrics) {
+	sc.metrics = metrics
+}
+
+// RetryBudget returns the retries left in the retry budget.
+func (sc *ScatterConn) RetryBudget() float64 {
+	return sc.retryBudget.available()
+}
+
+// canRetry returns true if the statement run by ctx on a shard of
+// tableGroup may be replayed: a read, outside a transaction block and off
+// any reserved connection, whose replay changes nothing. Replays are
+// routed anew, to the primary the shard has after a failover.
+func (sc *ScatterConn) canRetry(
+	ctx context.Context,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) bool {
+	return sc.retry.Enabled() && engine.StatementClassFromContext(ctx) == engine.StatementRead &&
+		!state.InTransaction() && !sc.onReservedConnection(tableGroup, shard, state)
+}
+
+// onReservedConnection returns true if the statements of the session on a
+// shard of tableGroup run on a reserved connection.
+func (sc *ScatterConn) onReservedConnection(tableGroup, shard string, state *handler.MultiGatewayConnectionState) bool {
+	ss := state.GetMatchingShardState(&query.Target{
+		TableGroup: tableGroup,
+		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
+		Shard:      shard,
+	})
+	return ss != nil && ss.ReservedConnectionId != 0
+}
+
+// withRetries runs attempt, and runs it again after the transient errors
+// of a read, with exponential backoff, as long as the attempts and the
+// retry budget last and replayable, if set, allows it: a read that
+// streamed rows to the client cannot be replayed.
+func (sc *ScatterConn) withRetries(
+	ctx context.Context,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	replayable func() bool,
+	attempt func() error,
+) error {
+	if !sc.canRetry(ctx, tableGroup, shard, state) {
+		return attempt()
+	}
+	sc.retryBudget.deposit()
+
+	for attempts := 1; ; attempts++ {
+		err := attempt()
+		if err == nil {
+			if attempts > 1 {
+				sc.metrics.recordRetry(ctx, tableGroup, shard, true)
+			}
+			return nil
+		}
+		mterrors.SetPgAttempts(err, attempts)
+		if !sc.retry.ShouldRetry(attempts, err) ||
+			(replayable != nil && !replayable()) || ctx.Err() != nil {
+			if attempts > 1 {
+				sc.metrics.recordRetry(ctx, tableGroup, shard, false)
+			}
+			return err
+		}
+		if !sc.retryBudget.withdraw() {
+			sc.metrics.recordRetryRejected(ctx, tableGroup, shard)
+			return err
+		}
+		backoff := sc.retry.Backoff(attempts)
+		sc.logger.WarnContext(ctx, "retrying read after transient shard error",
+			"tablegroup", tableGroup,
+			"shard", shard,
+			"attempt", attempts,
+			"backoff", backoff,
+			"error", err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
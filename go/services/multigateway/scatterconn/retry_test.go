@@ -0,0 +1,178 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// retryingConn returns a ScatterConn retrying reads up to three attempts
+// on gateway.
+func retryingConn(gateway *fakeShardGateway, budget float64) *ScatterConn {
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetRetryOptions(RetryOptions{
+		RetryPolicy: mterrors.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		},
+		Budget:      budget,
+		BudgetRatio: 0.1,
+	})
+	return sc
+}
+
+// stream runs sql on shard 0 and returns the results reaching the
+// callback.
+func stream(ctx context.Context, sc *ScatterConn, sql string, state *handler.MultiGatewayConnectionState) ([]*sqltypes.Result, error) {
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	var results []*sqltypes.Result
+	err := sc.StreamExecute(ctx, conn, "tg", "0", sql, state, func(_ context.Context, result *sqltypes.Result) error {
+		results = append(results, result)
+		return nil
+	})
+	return results, err
+}
+
+func TestStreamExecuteRetriesReads(t *testing.T) {
+	read := engine.WithStatementClass(context.Background(), engine.StatementRead)
+	unavailable := mterrors.New(mtrpcpb.Code_UNAVAILABLE, "connection reset by peer")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		failErr   error
+		failTimes int
+		executed  int
+		wantErr   bool
+	}{
+		{name: "recovers", ctx: read, failErr: unavailable, failTimes: 2, executed: 3},
+		{name: "gives up", ctx: read, failErr: unavailable, failTimes: 5, executed: 3, wantErr: true},
+		{name: "permanent error", ctx: read, failErr: sqlstate.NewError(sqlstate.UniqueViolation).Msg("duplicate key").Err(), failTimes: 1, executed: 1, wantErr: true},
+		{name: "write", ctx: engine.WithStatementClass(context.Background(), engine.StatementWrite), failErr: unavailable, failTimes: 1, executed: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := &fakeShardGateway{failShard: "0", failErr: tt.failErr, failTimes: tt.failTimes}
+			sc := retryingConn(gateway, 0)
+
+			results, err := stream(tt.ctx, sc, "SELECT 1", handler.NewMultiGatewayConnectionState())
+			assert.Len(t, gateway.executed, tt.executed)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Empty(t, results)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, results, 1)
+		})
+	}
+}
+
+func TestStreamExecuteDoesNotRetryInTransaction(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "0", failErr: mterrors.New(mtrpcpb.Code_UNAVAILABLE, "reset"), failTimes: 1}
+	sc := retryingConn(gateway, 0)
+	state := handler.NewMultiGatewayConnectionState()
+	state.BeginTransaction()
+
+	_, err := stream(engine.WithStatementClass(context.Background(), engine.StatementRead), sc, "SELECT 1", state)
+	require.Error(t, err)
+	assert.Len(t, gateway.executed, 1)
+}
+
+func TestStreamExecuteDoesNotReplayStreamedRows(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard:       "0",
+		failErr:         mterrors.New(mtrpcpb.Code_UNAVAILABLE, "reset"),
+		failAfterStream: true,
+		streamResults:   map[string][]*sqltypes.Result{"0": {{Rows: []*sqltypes.Row{textRow("1")}}}},
+	}
+	sc := retryingConn(gateway, 0)
+
+	results, err := stream(engine.WithStatementClass(context.Background(), engine.StatementRead), sc, "SELECT 1", handler.NewMultiGatewayConnectionState())
+	require.Error(t, err)
+	assert.Len(t, results, 1)
+	assert.Len(t, gateway.executed, 1)
+}
+
+func TestScatterExecuteRetriesShard(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard:       "1",
+		failErr:         mterrors.New(mtrpcpb.Code_UNAVAILABLE, "reset"),
+		failTimes:       1,
+		failAfterStream: true,
+		streamResults: map[string][]*sqltypes.Result{
+			"0": {{Rows: []*sqltypes.Row{textRow("1")}}},
+			"1": {{Rows: []*sqltypes.Row{textRow("2")}}},
+			"2": {{Rows: []*sqltypes.Row{textRow("3")}}},
+		},
+	}
+	sc := retryingConn(gateway, 0)
+
+	// The rows of the failed attempt of shard 1 are dropped.
+	results, err := scatter(engine.WithStatementClass(context.Background(), engine.StatementRead), sc, "SELECT id FROM t", handler.NewMultiGatewayConnectionState())
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, []*sqltypes.Row{textRow("2")}, results[1].Rows)
+	assert.Len(t, gateway.executed, 4)
+}
+
+func TestRetryBudget(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "0", failErr: mterrors.New(mtrpcpb.Code_UNAVAILABLE, "reset")}
+	sc := retryingConn(gateway, 1)
+	read := engine.WithStatementClass(context.Background(), engine.StatementRead)
+
+	// The first read takes the only retry of the budget.
+	_, err := stream(read, sc, "SELECT 1", handler.NewMultiGatewayConnectionState())
+	require.Error(t, err)
+	assert.Len(t, gateway.executed, 2)
+	assert.InDelta(t, 0, sc.RetryBudget(), 0.01)
+
+	// The next one earns a tenth of a retry, not enough to retry.
+	_, err = stream(read, sc, "SELECT 1", handler.NewMultiGatewayConnectionState())
+	require.Error(t, err)
+	assert.Len(t, gateway.executed, 3)
+	assert.InDelta(t, 0.1, sc.RetryBudget(), 0.01)
+}
+
+func TestRetryBudgetTokens(t *testing.T) {
+	b := newRetryBudget(2, 0.5)
+	assert.True(t, b.withdraw())
+	assert.True(t, b.withdraw())
+	assert.False(t, b.withdraw())
+	b.deposit()
+	assert.False(t, b.withdraw())
+	b.deposit()
+	assert.True(t, b.withdraw())
+	for range 10 {
+		b.deposit()
+	}
+	assert.InDelta(t, 2, b.available(), 0.001)
+}
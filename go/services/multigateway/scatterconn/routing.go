@@ -0,0 +1,131 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/poolergateway"
+)
+
+// routeTarget returns the target the statement run by ctx on a shard of
+// tableGroup goes to (see routesToReplica).
+func (sc *ScatterConn) routeTarget(
+	ctx context.Context,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) *query.Target {
+	poolerType := clustermetadatapb.PoolerType_PRIMARY
+	if sc.routesToReplica(ctx, tableGroup, shard, state) {
+		poolerType = clustermetadatapb.PoolerType_REPLICA
+	}
+	return &query.Target{
+		TableGroup: tableGroup,
+		PoolerType: poolerType,
+		Shard:      shard,
+	}
+}
+
+// routesToReplica returns true if the statement run by ctx on a shard of
+// tableGroup goes to a replica: a statement of a transaction block running
+// on replicas, or a read outside a transaction block of a session targeting
+// replicas. Sessions whose statements run on a reserved connection of the
+// primary, e.g. to keep temporary tables or cursors, stay there. Outside
+// of those, the pooler type of the routing rules of the tables read wins
+// over the one the session targets (see engine.PoolerTypeFromContext).
+func (sc *ScatterConn) routesToReplica(
+	ctx context.Context,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) bool {
+	if sc.onReservedConnection(tableGroup, shard, state) {
+		return false
+	}
+	if state.ReplicaTransaction() {
+		return true
+	}
+	switch engine.PoolerTypeFromContext(ctx) {
+	case clustermetadatapb.PoolerType_PRIMARY:
+		return false
+	case clustermetadatapb.PoolerType_REPLICA:
+		return !state.InTransaction() && engine.StatementClassFromContext(ctx) == engine.StatementRead
+	}
+	return state.TargetsReplicas() && !state.InTransaction() &&
+		engine.StatementClassFromContext(ctx) == engine.StatementRead
+}
+
+// maxReplicationLagMs returns the maximum replication lag, in
+// milliseconds, of the replica serving a statement of the session on
+// target, or 0 for no limit.
+func maxReplicationLagMs(target *query.Target, state *handler.MultiGatewayConnectionState) uint64 {
+	if target.PoolerType != clustermetadatapb.PoolerType_REPLICA {
+		return 0
+	}
+	return uint64(state.MaxReplicationLag().Milliseconds())
+}
+
+// withReplicaFallback runs attempt on target. If target is a replica that
+// cannot serve the statement and nothing was streamed yet, attempt runs
+// again on the primary (see canFallBackToPrimary).
+func (sc *ScatterConn) withReplicaFallback(
+	ctx context.Context,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+	attempt func(*query.Target, func(context.Context, *sqltypes.Result) error) error,
+) error {
+	if target.PoolerType != clustermetadatapb.PoolerType_REPLICA {
+		return attempt(target, callback)
+	}
+	streamed := false
+	err := attempt(target, func(ctx context.Context, result *sqltypes.Result) error {
+		streamed = true
+		return callback(ctx, result)
+	})
+	if err == nil || streamed || !canFallBackToPrimary(target, state, err) {
+		return err
+	}
+	sc.logger.DebugContext(ctx, "replica cannot serve statement, running it on the primary",
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"error", err)
+	// A transaction block whose BEGIN fell back runs on the primary.
+	state.SetReplicaTransaction(false)
+	return attempt(primaryTarget(target.TableGroup, target.Shard), callback)
+}
+
+// canFallBackToPrimary returns true if a statement that failed with err on
+// target, a replica, may run on the primary instead: there is no replica,
+// the replica lags further behind than the session allows, or it failed
+// transiently. Statements of a transaction block already open on a replica,
+// or bound to a reserved connection there, cannot move.
+func canFallBackToPrimary(target *query.Target, state *handler.MultiGatewayConnectionState, err error) bool {
+	if state.InTransaction() {
+		return false
+	}
+	if ss := state.GetMatchingShardState(target); ss != nil && ss.ReservedConnectionId != 0 {
+		return false
+	}
+	return errors.Is(err, poolergateway.ErrNoPooler) || mterrors.IsReplicaLagging(err) || mterrors.IsRetryable(err)
+}
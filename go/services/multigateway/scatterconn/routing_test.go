@@ -0,0 +1,215 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/poolergateway"
+)
+
+// routingGateway records the pooler type and maximum replication lag of
+// the statements it runs, failing those sent to replicas with replicaErr.
+type routingGateway struct {
+	queryservice.QueryService
+
+	replicaErr error
+	executed   []string
+	maxLags    []uint64
+}
+
+func (g *routingGateway) QueryServiceByID(context.Context, *clustermetadatapb.ID, *query.Target) (queryservice.QueryService, error) {
+	return g, nil
+}
+
+func (g *routingGateway) StreamExecute(
+	ctx context.Context,
+	target *query.Target,
+	sql string,
+	options *query.ExecuteOptions,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	g.executed = append(g.executed, fmt.Sprintf("%s:%s", target.PoolerType, sql))
+	g.maxLags = append(g.maxLags, options.GetMaxReplicationLagMs())
+	if target.PoolerType == clustermetadatapb.PoolerType_REPLICA && g.replicaErr != nil {
+		return g.replicaErr
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "SELECT 1"})
+}
+
+func replicaState() *handler.MultiGatewayConnectionState {
+	state := handler.NewMultiGatewayConnectionState()
+	state.SetSessionVariable(handler.TargetVariable, "replica")
+	return state
+}
+
+func TestStreamExecute_Routing(t *testing.T) {
+	read := engine.WithStatementClass(context.Background(), engine.StatementRead)
+	write := engine.WithStatementClass(context.Background(), engine.StatementWrite)
+	ruledReplica := engine.WithPoolerType(read, clustermetadatapb.PoolerType_REPLICA)
+	ruledPrimary := engine.WithPoolerType(read, clustermetadatapb.PoolerType_PRIMARY)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		state func() *handler.MultiGatewayConnectionState
+		want  string
+	}{
+		{name: "read targeting primary", ctx: read, state: handler.NewMultiGatewayConnectionState, want: "PRIMARY:SELECT 1"},
+		{name: "read targeting replicas", ctx: read, state: replicaState, want: "REPLICA:SELECT 1"},
+		{name: "write targeting replicas", ctx: write, state: replicaState, want: "PRIMARY:SELECT 1"},
+		{name: "unclassified", ctx: context.Background(), state: replicaState, want: "PRIMARY:SELECT 1"},
+		{name: "read ruled to replicas", ctx: ruledReplica, state: handler.NewMultiGatewayConnectionState, want: "REPLICA:SELECT 1"},
+		{name: "read ruled to the primary", ctx: ruledPrimary, state: replicaState, want: "PRIMARY:SELECT 1"},
+		{
+			name:  "write ruled to replicas",
+			ctx:   engine.WithPoolerType(write, clustermetadatapb.PoolerType_REPLICA),
+			state: handler.NewMultiGatewayConnectionState,
+			want:  "PRIMARY:SELECT 1",
+		},
+		{
+			name: "read ruled to replicas in a transaction",
+			ctx:  ruledReplica,
+			state: func() *handler.MultiGatewayConnectionState {
+				state := handler.NewMultiGatewayConnectionState()
+				state.BeginTransaction()
+				return state
+			},
+			want: "PRIMARY:SELECT 1",
+		},
+		{
+			name: "read in a read-write transaction",
+			ctx:  read,
+			state: func() *handler.MultiGatewayConnectionState {
+				state := replicaState()
+				state.BeginTransaction()
+				return state
+			},
+			want: "PRIMARY:SELECT 1",
+		},
+		{
+			name: "write in a replica transaction",
+			ctx:  write,
+			state: func() *handler.MultiGatewayConnectionState {
+				state := replicaState()
+				state.SetReplicaTransaction(true)
+				state.BeginTransaction()
+				return state
+			},
+			want: "REPLICA:SELECT 1",
+		},
+		{
+			name: "read with a reserved connection on the primary",
+			ctx:  read,
+			state: func() *handler.MultiGatewayConnectionState {
+				state := replicaState()
+				state.StoreReservedConnection(primaryTarget("tg", "0"), queryservice.ReservedState{ReservedConnectionId: 7})
+				return state
+			},
+			want: "PRIMARY:SELECT 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := &routingGateway{}
+			sc := NewScatterConn(gateway, slog.Default())
+			_, err := stream(tt.ctx, sc, "SELECT 1", tt.state())
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.want}, gateway.executed)
+		})
+	}
+}
+
+func TestStreamExecute_MaxReplicationLag(t *testing.T) {
+	gateway := &routingGateway{}
+	sc := NewScatterConn(gateway, slog.Default())
+	state := replicaState()
+	state.SetSessionVariable(handler.MaxReplicationLagVariable, "1.5s")
+
+	_, err := stream(engine.WithStatementClass(context.Background(), engine.StatementRead), sc, "SELECT 1", state)
+	require.NoError(t, err)
+	_, err = stream(engine.WithStatementClass(context.Background(), engine.StatementWrite), sc, "SELECT 1", state)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1500, 0}, gateway.maxLags)
+}
+
+func TestStreamExecute_ReplicaFallback(t *testing.T) {
+	read := engine.WithStatementClass(context.Background(), engine.StatementRead)
+	tests := []struct {
+		name       string
+		replicaErr error
+		fallback   bool
+	}{
+		{name: "no replica", replicaErr: fmt.Errorf("%w for target: tablegroup=tg", poolergateway.ErrNoPooler), fallback: true},
+		{name: "lagging", replicaErr: mterrors.MT13003("replication lag 10s exceeds the maximum of 1s"), fallback: true},
+		{name: "unavailable", replicaErr: mterrors.New(mtrpcpb.Code_UNAVAILABLE, "connection reset"), fallback: true},
+		{name: "query error", replicaErr: fmt.Errorf("relation does not exist")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := &routingGateway{replicaErr: tt.replicaErr}
+			sc := NewScatterConn(gateway, slog.Default())
+			results, err := stream(read, sc, "SELECT 1", replicaState())
+			if !tt.fallback {
+				require.Error(t, err)
+				assert.Equal(t, []string{"REPLICA:SELECT 1"}, gateway.executed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, results, 1)
+			assert.Equal(t, []string{"REPLICA:SELECT 1", "PRIMARY:SELECT 1"}, gateway.executed)
+		})
+	}
+}
+
+func TestStreamExecute_ReplicaTransactionFallback(t *testing.T) {
+	gateway := &routingGateway{replicaErr: fmt.Errorf("%w for target: tablegroup=tg", poolergateway.ErrNoPooler)}
+	sc := NewScatterConn(gateway, slog.Default())
+	state := replicaState()
+
+	// The BEGIN of a replica transaction falls back, and so does the block.
+	state.SetReplicaTransaction(true)
+	_, err := stream(context.Background(), sc, "BEGIN READ ONLY", state)
+	require.NoError(t, err)
+	assert.False(t, state.ReplicaTransaction())
+	state.BeginTransaction()
+
+	_, err = stream(engine.WithStatementClass(context.Background(), engine.StatementRead), sc, "SELECT 1", state)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"REPLICA:BEGIN READ ONLY", "PRIMARY:BEGIN READ ONLY", "PRIMARY:SELECT 1"}, gateway.executed)
+
+	// Statements of a block open on a replica do not move.
+	gateway.executed = nil
+	state = replicaState()
+	state.SetReplicaTransaction(true)
+	state.BeginTransaction()
+	_, err = stream(context.Background(), sc, "SELECT 1", state)
+	require.Error(t, err)
+	assert.Equal(t, []string{"REPLICA:SELECT 1"}, gateway.executed)
+}
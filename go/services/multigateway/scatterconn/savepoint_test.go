@@ -0,0 +1,269 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// fakeShardGateway records the statements run on each shard, and fails
+// those run on failShard (or on any shard when "*") that start with
+// failPrefix. A SELECT run with ExecuteQuery returns the result of its
+// shard in shardResults, or else queryResult. StreamExecute streams the
+// chunks of its shard in streamResults, or else a SAVEPOINT command tag.
+type fakeShardGateway struct {
+	queryservice.QueryService
+
+	failShard     string
+	failPrefix    string
+	queryResult   *sqltypes.Result
+	shardResults  map[string]*sqltypes.Result
+	streamResults map[string][]*sqltypes.Result
+
+	// failErr is the error of the failing statements, failTimes, if set,
+	// the number of times they fail before succeeding, and failAfterStream
+	// fails streamed statements after their chunks.
+	failErr         error
+	failTimes       int
+	failAfterStream bool
+	failed          int
+
+	// blockShard is a shard whose streamed queries wait to be canceled,
+	// and delay is how long the others take.
+	blockShard string
+	delay      time.Duration
+
+	mu          sync.Mutex
+	executed    []string
+	inFlight    int
+	maxInFlight int
+}
+
+func (f *fakeShardGateway) run(target *query.Target, sql string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.executed = append(f.executed, fmt.Sprintf("%s:%s", target.Shard, sql))
+	if (f.failShard == "*" || f.failShard != "" && target.Shard == f.failShard) && strings.HasPrefix(sql, f.failPrefix) {
+		if f.failTimes > 0 && f.failed >= f.failTimes {
+			return nil
+		}
+		f.failed++
+		if f.failErr != nil {
+			return f.failErr
+		}
+		return errors.New("shard unavailable")
+	}
+	return nil
+}
+
+func (f *fakeShardGateway) QueryServiceByID(context.Context, *clustermetadatapb.ID, *query.Target) (queryservice.QueryService, error) {
+	return f, nil
+}
+
+func (f *fakeShardGateway) StreamExecute(
+	ctx context.Context,
+	target *query.Target,
+	sql string,
+	options *query.ExecuteOptions,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	if f.failAfterStream {
+		for _, chunk := range f.streamResults[target.Shard] {
+			if err := callback(ctx, chunk); err != nil {
+				return err
+			}
+		}
+		return f.run(target, sql)
+	}
+	if err := f.run(target, sql); err != nil {
+		return err
+	}
+	if f.blockShard != "" && target.Shard == f.blockShard {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if f.delay > 0 {
+		f.mu.Lock()
+		f.inFlight++
+		f.maxInFlight = max(f.maxInFlight, f.inFlight)
+		f.mu.Unlock()
+		time.Sleep(f.delay)
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}
+	if chunks, ok := f.streamResults[target.Shard]; ok {
+		for _, chunk := range chunks {
+			if err := callback(ctx, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "SAVEPOINT"})
+}
+
+func (f *fakeShardGateway) ReserveConnection(_ context.Context, target *query.Target, _ *query.ExecuteOptions) (queryservice.ReservedState, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.executed = append(f.executed, target.Shard+":<reserve>")
+	return queryservice.ReservedState{ReservedConnectionId: uint64(len(f.executed))}, nil
+}
+
+func (f *fakeShardGateway) ReleaseReservedConnection(_ context.Context, target *query.Target, _ *query.ExecuteOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.executed = append(f.executed, target.Shard+":<release>")
+	return nil
+}
+
+func (f *fakeShardGateway) ExecuteQuery(
+	ctx context.Context,
+	target *query.Target,
+	sql string,
+	options *query.ExecuteOptions,
+) (*sqltypes.Result, error) {
+	if err := f.run(target, sql); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(sql, "SELECT") && !strings.Contains(sql, " RETURNING ") {
+		return &sqltypes.Result{}, nil
+	}
+	if result, ok := f.shardResults[target.Shard]; ok {
+		return result, nil
+	}
+	if f.queryResult != nil {
+		return f.queryResult, nil
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// newSavepointTest returns a session in a transaction spanning the given
+// shards, each with a reserved connection.
+func newSavepointTest(shards ...string) (*handler.MultiGatewayConnectionState, []*query.Target) {
+	state := handler.NewMultiGatewayConnectionState()
+	state.BeginTransaction()
+	var targets []*query.Target
+	for i, shard := range shards {
+		target := primaryTarget("tg", shard)
+		state.StoreReservedConnection(target, queryservice.ReservedState{ReservedConnectionId: uint64(i + 1)})
+		targets = append(targets, target)
+	}
+	return state, targets
+}
+
+func TestExecuteSavepointFansOut(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := NewScatterConn(gateway, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state, targets := newSavepointTest("0", "1")
+
+	results := 0
+	countResults := func(context.Context, *sqltypes.Result) error {
+		results++
+		return nil
+	}
+	require.NoError(t, sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_SAVEPOINT, "s1", "SAVEPOINT s1", countResults))
+
+	assert.Equal(t, []string{"0:SAVEPOINT s1", "1:SAVEPOINT s1"}, gateway.executed)
+	// The client sees a single CommandComplete.
+	assert.Equal(t, 1, results)
+	for _, target := range targets {
+		assert.True(t, state.GetMatchingShardState(target).HasSavepoint("s1"))
+	}
+
+	gateway.executed = nil
+	require.NoError(t, sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_RELEASE, "s1", "RELEASE s1", countResults))
+	assert.Equal(t, []string{"0:RELEASE s1", "1:RELEASE s1"}, gateway.executed)
+	for _, target := range targets {
+		assert.False(t, state.GetMatchingShardState(target).HasSavepoint("s1"))
+	}
+}
+
+func TestExecuteSavepointLateShard(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := NewScatterConn(gateway, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state, targets := newSavepointTest("0")
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+
+	require.NoError(t, sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_SAVEPOINT, "s1", "SAVEPOINT s1", discard))
+
+	// Shard 1 joins the transaction after the savepoint.
+	late := primaryTarget("tg", "1")
+	state.StoreReservedConnection(late, queryservice.ReservedState{ReservedConnectionId: 2})
+
+	gateway.executed = nil
+	err := sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_ROLLBACK_TO, "s1", "ROLLBACK TO s1", discard)
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.InvalidSavepointSpecification, diag.Code)
+	assert.Empty(t, gateway.executed)
+
+	// RELEASE only runs where the savepoint exists.
+	require.NoError(t, sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_RELEASE, "s1", "RELEASE s1", discard))
+	assert.Equal(t, []string{"0:RELEASE s1"}, gateway.executed)
+	assert.False(t, state.GetMatchingShardState(targets[0]).HasSavepoint("s1"))
+}
+
+func TestExecuteSavepointStopsAtFailure(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "1"}
+	sc := NewScatterConn(gateway, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state, targets := newSavepointTest("0", "1", "2")
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+
+	require.Error(t, sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_SAVEPOINT, "s1", "SAVEPOINT s1", discard))
+	assert.Equal(t, []string{"0:SAVEPOINT s1", "1:SAVEPOINT s1"}, gateway.executed)
+	assert.True(t, state.GetMatchingShardState(targets[0]).HasSavepoint("s1"))
+	assert.False(t, state.GetMatchingShardState(targets[1]).HasSavepoint("s1"))
+}
+
+func TestExecuteSavepointOutsideDistributedTransaction(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := NewScatterConn(gateway, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := handler.NewMultiGatewayConnectionState()
+
+	require.NoError(t, sc.ExecuteSavepoint(context.Background(), conn, "tg", "", state,
+		ast.TRANS_STMT_SAVEPOINT, "s1", "SAVEPOINT s1", func(context.Context, *sqltypes.Result) error { return nil }))
+	assert.Equal(t, []string{":SAVEPOINT s1"}, gateway.executed)
+}
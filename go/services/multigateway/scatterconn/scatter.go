@@ -0,0 +1,286 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/tools/telemetry"
+)
+
+// ScatterOptions configures how the queries running on several shards fan
+// out.
+type ScatterOptions struct {
+	// MaxShardsPerQuery is the number of shards a query runs on at the
+	// same time. Zero runs it on all of them at once.
+	MaxShardsPerQuery int
+
+	// MaxShards is the number of shard queries the scatters of all the
+	// sessions of the gateway run at the same time. Zero leaves it
+	// unlimited.
+	MaxShards int64
+
+	// ShardTimeout bounds the time the query of each shard takes, within
+	// the statement timeout. Zero leaves it to the statement timeout.
+	ShardTimeout time.Duration
+
+	// PartialResults returns the results of the shards that succeeded when
+	// others fail, with a warning naming the failed shards, instead of
+	// failing the query. It only applies to reads outside transaction
+	// blocks: the other statements fail as a whole.
+	PartialResults bool
+}
+
+// SetScatterOptions sets how the queries running on several shards fan out.
+func (sc *ScatterConn) SetScatterOptions(opts ScatterOptions) {
+	sc.scatter = opts
+	sc.fanout = nil
+	if opts.MaxShards > 0 {
+		sc.fanout = semaphore.NewWeighted(opts.MaxShards)
+	}
+}
+
+// FanoutWait returns the average time the shard queries of the scatters
+// waited for their turn within ScatterOptions.MaxShards, over the last
+// seconds, or 0 without that limit.
+func (sc *ScatterConn) FanoutWait() time.Duration {
+	return sc.fanoutWait.average()
+}
+
+// fanoutWaitInterval is the interval over which the waits of the shard
+// queries are averaged.
+const fanoutWaitInterval = time.Second
+
+// waitAverage averages the waits added over the current interval and the
+// previous one, so that it falls back to 0 once nothing waits.
+type waitAverage struct {
+	mu          sync.Mutex
+	start       time.Time
+	total, prev time.Duration
+	n, prevN    int64
+}
+
+// add adds a wait to the average.
+func (w *waitAverage) add(wait time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rotate(time.Now())
+	w.total += wait
+	w.n++
+}
+
+// average returns the average of the waits added over the current and the
+// previous intervals.
+func (w *waitAverage) average() time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rotate(time.Now())
+	if w.n+w.prevN == 0 {
+		return 0
+	}
+	return (w.total + w.prev) / time.Duration(w.n+w.prevN)
+}
+
+// rotate starts a new interval if the current one is over at now.
+func (w *waitAverage) rotate(now time.Time) {
+	elapsed := now.Sub(w.start)
+	if elapsed < fanoutWaitInterval {
+		return
+	}
+	w.prev, w.prevN = w.total, w.n
+	if elapsed >= 2*fanoutWaitInterval {
+		w.prev, w.prevN = 0, 0
+	}
+	w.total, w.n = 0, 0
+	w.start = now
+}
+
+// ScatterExecute implements engine.IExecute. The shards run concurrently,
+// within the limits of the ScatterOptions, and the result of each is
+// buffered so that it reaches callback whole, in the order of the shards.
+// The first failure cancels the shards still running and fails the query,
+// unless partial results are allowed.
+func (sc *ScatterConn) ScatterExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shards []string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) (err error) {
+	ctx, span := telemetry.Tracer().Start(ctx, "scatterconn/scatter", trace.WithAttributes(
+		attribute.String("tablegroup", tableGroup),
+		attribute.Int("shard_count", len(shards))))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	partial := sc.scatter.PartialResults && !state.InTransaction() &&
+		engine.StatementClassFromContext(ctx) == engine.StatementRead
+	scatterCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*sqltypes.Result, len(shards))
+	errs := make([]error, len(shards))
+	var mu sync.Mutex
+	var firstErr error
+
+	var g errgroup.Group
+	if limit := sc.scatter.MaxShardsPerQuery; limit > 0 {
+		g.SetLimit(limit)
+	}
+	for i, shard := range shards {
+		g.Go(func() error {
+			// The shards waiting for their turn after a failure do not run.
+			if err := scatterCtx.Err(); err != nil {
+				errs[i] = err
+				return nil
+			}
+			results[i], errs[i] = sc.executeShard(scatterCtx, conn, tableGroup, shard, sql, state)
+			if errs[i] != nil && !partial {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = errs[i]
+				}
+				mu.Unlock()
+				cancel()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+
+	var failed []string
+	var failures []string
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, shards[i])
+			failures = append(failures, fmt.Sprintf("shard %s: %v", shards[i], err))
+		}
+	}
+	if len(failed) == len(shards) && len(shards) > 0 {
+		return errs[0]
+	}
+	if len(failed) > 0 {
+		sc.logger.WarnContext(ctx, "returning partial results of a scatter query",
+			"tablegroup", tableGroup,
+			"failed_shards", failed,
+			"query", sql)
+		warning := sqlstate.NewNotice("WARNING", sqlstate.Warning).
+			Msg("results are missing the rows of shards %s, which failed", strings.Join(failed, ", ")).
+			Detail("%s", strings.Join(failures, "\n")).
+			Build().ToNotice()
+		for _, result := range results {
+			if result != nil {
+				result.Notices = append(result.Notices, warning)
+				break
+			}
+		}
+	}
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		if err := callback(ctx, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeShard runs the query of a scatter on one of its shards, within the
+// limit of concurrent shard queries of the gateway and the shard timeout,
+// holding it while the shard fails over and retrying reads after transient
+// errors, and returns its whole result.
+func (sc *ScatterConn) executeShard(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+) (*sqltypes.Result, error) {
+	if fanout := sc.fanout; fanout != nil {
+		start := time.Now()
+		err := fanout.Acquire(ctx, 1)
+		wait := time.Since(start)
+		engine.QueryTimingFromContext(ctx).AddQueueWait(wait)
+		sc.fanoutWait.add(wait)
+		if err != nil {
+			return nil, err
+		}
+		defer fanout.Release(1)
+	}
+	shardCtx := ctx
+	if timeout := sc.scatter.ShardTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		shardCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// The result is buffered, so that a failed attempt is replayed whole.
+	var shardResult *sqltypes.Result
+	err := sc.withRetries(shardCtx, tableGroup, shard, state, nil, func() error {
+		return sc.withBuffering(shardCtx, tableGroup, shard, state, nil, func() error {
+			shardResult = &sqltypes.Result{}
+			return sc.streamExecute(shardCtx, conn, tableGroup, shard, sql, state, func(_ context.Context, result *sqltypes.Result) error {
+				appendResult(shardResult, result)
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		if ctx.Err() == nil && errors.Is(shardCtx.Err(), context.DeadlineExceeded) {
+			return nil, sqlstate.NewError(sqlstate.QueryCanceled).
+				Msg("canceling statement on shard %s due to shard timeout", shard).
+				Detail("The shard did not answer within %v.", sc.scatter.ShardTimeout).
+				Err()
+		}
+		return nil, err
+	}
+	return shardResult, nil
+}
+
+// appendResult adds a chunk of the streamed result of a shard to the
+// result accumulated for it.
+func appendResult(acc, chunk *sqltypes.Result) {
+	if len(chunk.Fields) > 0 {
+		acc.Fields = chunk.Fields
+	}
+	acc.Rows = append(acc.Rows, chunk.Rows...)
+	acc.Notices = append(acc.Notices, chunk.Notices...)
+	acc.RowsAffected += chunk.RowsAffected
+	if chunk.CommandTag != "" {
+		acc.CommandTag = chunk.CommandTag
+	}
+}
@@ -26,16 +26,25 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
 	"github.com/multigres/multigres/go/common/pgprotocol/server"
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/queryservice"
 	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
 	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
 	"github.com/multigres/multigres/go/pb/query"
 	"github.com/multigres/multigres/go/services/multigateway/engine"
 	"github.com/multigres/multigres/go/services/multigateway/handler"
 	"github.com/multigres/multigres/go/services/multigateway/poolergateway"
+	"github.com/multigres/multigres/go/tools/telemetry"
 )
 
 // ScatterConn coordinates query execution across multiple multipooler instances.
@@ -45,6 +54,28 @@ type ScatterConn struct {
 
 	// gateway is used for executing queries (typically a PoolerGateway)
 	gateway poolergateway.Gateway
+
+	// listens shares notification streams between LISTENing connections
+	listens *listenHub
+
+	// txMode selects how transactions spanning several shards commit
+	txMode TransactionMode
+
+	// scatter configures how queries fan out to several shards, and
+	// fanout bounds the shard queries of all scatters, if set.
+	scatter ScatterOptions
+	fanout  *semaphore.Weighted
+	// fanoutWait averages the time shard queries wait for fanout.
+	fanoutWait waitAverage
+
+	// retry configures the replays of reads after transient shard errors,
+	// within retryBudget if set, recorded in metrics if set.
+	retry       RetryOptions
+	retryBudget *retryBudget
+	metrics     *Metrics
+
+	// buffer holds the statements of shards failing over, if set.
+	buffer *failoverBuffer
 }
 
 // NewScatterConn creates a new ScatterConn instance.
@@ -52,6 +83,8 @@ func NewScatterConn(gateway poolergateway.Gateway, logger *slog.Logger) *Scatter
 	return &ScatterConn{
 		logger:  logger,
 		gateway: gateway,
+		listens: newListenHub(gateway, logger),
+		txMode:  TransactionModeMulti,
 	}
 }
 
@@ -61,6 +94,9 @@ func NewScatterConn(gateway poolergateway.Gateway, logger *slog.Logger) *Scatter
 // - Uses PoolerGateway to select matching pooler
 // - Executes query via gRPC to the pooler
 // - Streams actual results back via callback
+// - Holds the query while the shard fails over (see BufferOptions)
+// - Retries reads failing with a transient error before any result is
+// streamed (see RetryOptions)
 func (sc *ScatterConn) StreamExecute(
 	ctx context.Context,
 	conn *server.Conn,
@@ -69,6 +105,28 @@ func (sc *ScatterConn) StreamExecute(
 	sql string,
 	state *handler.MultiGatewayConnectionState,
 	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	streamed := false
+	replayable := func() bool { return !streamed }
+	return sc.withRetries(ctx, tableGroup, shard, state, replayable, func() error {
+		return sc.withBuffering(ctx, tableGroup, shard, state, replayable, func() error {
+			return sc.streamExecute(ctx, conn, tableGroup, shard, sql, state, func(ctx context.Context, result *sqltypes.Result) error {
+				streamed = true
+				return callback(ctx, result)
+			})
+		})
+	})
+}
+
+// streamExecute runs a query once on a shard and streams its results.
+func (sc *ScatterConn) streamExecute(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
 ) error {
 	sc.logger.DebugContext(ctx, "scatter conn executing query",
 		"tablegroup", tableGroup,
@@ -78,31 +136,62 @@ func (sc *ScatterConn) StreamExecute(
 		"database", conn.Database(),
 		"connection_id", conn.ConnectionID())
 
-	// Create target for routing
-	// TODO: Add query analysis to determine if this is a read or write query
-	// For now, always route to PRIMARY (safe default)
-	target := &query.Target{
-		TableGroup: tableGroup,
-		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
-		Shard:      shard,
-	}
+	// Reads go to a replica if the session targets replicas (see
+	// routeTarget), and to the primary if the replica cannot serve them.
+	target := sc.routeTarget(ctx, tableGroup, shard, state)
+	return sc.withReplicaFallback(ctx, target, state, callback,
+		func(target *query.Target, callback func(context.Context, *sqltypes.Result) error) error {
+			ctx, span := startShardSpan(ctx, target)
+			err := sc.streamExecuteOn(ctx, conn, target, sql, state, callback)
+			telemetry.EndSpan(span, err)
+			return err
+		})
+}
+
+// startShardSpan starts the span of a query on target, whose gRPC call to
+// the pooler is its child.
+func startShardSpan(ctx context.Context, target *query.Target) (context.Context, trace.Span) {
+	return telemetry.Tracer().Start(ctx, "scatterconn/shard",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("tablegroup", target.TableGroup),
+			attribute.String("shard", target.Shard),
+			attribute.String("pooler_type", target.PoolerType.String()),
+		))
+}
 
+// streamExecuteOn runs a query once on target and streams its results.
+func (sc *ScatterConn) streamExecuteOn(
+	ctx context.Context,
+	conn *server.Conn,
+	target *query.Target,
+	sql string,
+	state *handler.MultiGatewayConnectionState,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	tableGroup, shard := target.TableGroup, target.Shard
 	eo := &query.ExecuteOptions{
-		User:            conn.User(),
-		SessionSettings: state.GetSessionSettings(),
+		User:                conn.User(),
+		SessionSettings:     state.GetSessionSettings(),
+		MaxReplicationLagMs: maxReplicationLagMs(target, state),
 	}
 
 	var qs queryservice.QueryService = sc.gateway
 	var err error
 
+	// A shard joins the implicit transaction of a multi-statement query
+	// when a statement first reaches it.
+	if err := sc.joinImplicitTransaction(ctx, conn, target, state); err != nil {
+		return err
+	}
+
 	ss := state.GetMatchingShardState(target)
 	// If we have a reserved connection, we have to ensure
 	// we are routing the query to the pooler where we got the reserved
 	// connection from. If a reparent happened, then we will get an error
 	// back.
 	if ss != nil && ss.ReservedConnectionId != 0 {
-		eo.ReservedConnectionId = uint64(ss.ReservedConnectionId)
-		qs, err = sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+		qs, err = sc.useReservedConnection(ctx, target, state, ss, eo)
 	}
 	if err != nil {
 		return err
@@ -115,8 +204,12 @@ func (sc *ScatterConn) StreamExecute(
 		"shard", shard,
 		"pooler_type", target.PoolerType.String())
 
-	if err := qs.StreamExecute(ctx, target, sql, eo, callback); err != nil {
-		return fmt.Errorf("query execution failed: %w", err)
+	start := time.Now()
+	err = qs.StreamExecute(ctx, target, sql, eo, callback)
+	sc.metrics.recordShardQuery(ctx, conn.Database(), target, time.Since(start), err)
+	engine.QueryTimingFromContext(ctx).RecordShard(target, time.Since(start), err)
+	if err != nil {
+		return sc.checkPinnedConnectionLost(ctx, target, state, ss, fmt.Errorf("query execution failed: %w", err))
 	}
 
 	sc.logger.DebugContext(ctx, "query execution completed successfully",
@@ -128,6 +221,7 @@ func (sc *ScatterConn) StreamExecute(
 
 // PortalStreamExecute executes a portal (bound prepared statement) and streams results.
 // This is the implementation of engine.IExecute.PortalStreamExecute().
+// The portal is held while the shard fails over (see BufferOptions).
 func (sc *ScatterConn) PortalStreamExecute(
 	ctx context.Context,
 	tableGroup string,
@@ -137,6 +231,27 @@ func (sc *ScatterConn) PortalStreamExecute(
 	portalInfo *preparedstatement.PortalInfo,
 	maxRows int32,
 	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	streamed := false
+	return sc.withBuffering(ctx, tableGroup, shard, state, func() bool { return !streamed }, func() error {
+		return sc.portalStreamExecute(ctx, tableGroup, shard, conn, state, portalInfo, maxRows, func(ctx context.Context, result *sqltypes.Result) error {
+			streamed = true
+			return callback(ctx, result)
+		})
+	})
+}
+
+// portalStreamExecute executes a portal once on a shard and streams its
+// results.
+func (sc *ScatterConn) portalStreamExecute(
+	ctx context.Context,
+	tableGroup string,
+	shard string,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	portalInfo *preparedstatement.PortalInfo,
+	maxRows int32,
+	callback func(context.Context, *sqltypes.Result) error,
 ) error {
 	sc.logger.DebugContext(ctx, "scatter conn executing portal",
 		"tablegroup", tableGroup,
@@ -147,17 +262,33 @@ func (sc *ScatterConn) PortalStreamExecute(
 		"database", conn.Database(),
 		"connection_id", conn.ConnectionID())
 
-	// Create target for routing
-	target := &query.Target{
-		TableGroup: tableGroup,
-		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
-		Shard:      shard,
-	}
+	target := sc.routeTarget(ctx, tableGroup, shard, state)
+	return sc.withReplicaFallback(ctx, target, state, callback,
+		func(target *query.Target, callback func(context.Context, *sqltypes.Result) error) error {
+			ctx, span := startShardSpan(ctx, target)
+			err := sc.portalStreamExecuteOn(ctx, target, conn, state, portalInfo, maxRows, callback)
+			telemetry.EndSpan(span, err)
+			return err
+		})
+}
 
+// portalStreamExecuteOn executes a portal once on target and streams its
+// results.
+func (sc *ScatterConn) portalStreamExecuteOn(
+	ctx context.Context,
+	target *query.Target,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	portalInfo *preparedstatement.PortalInfo,
+	maxRows int32,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	tableGroup, shard := target.TableGroup, target.Shard
 	eo := &query.ExecuteOptions{
-		User:            conn.User(),
-		MaxRows:         uint64(maxRows),
-		SessionSettings: state.GetSessionSettings(),
+		User:                conn.User(),
+		MaxRows:             uint64(maxRows),
+		SessionSettings:     state.GetSessionSettings(),
+		MaxReplicationLagMs: maxReplicationLagMs(target, state),
 	}
 
 	var qs queryservice.QueryService = sc.gateway
@@ -169,8 +300,7 @@ func (sc *ScatterConn) PortalStreamExecute(
 	// connection from. If a reparent happened, then we will get an error
 	// back.
 	if ss != nil && ss.ReservedConnectionId != 0 {
-		eo.ReservedConnectionId = uint64(ss.ReservedConnectionId)
-		qs, err = sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+		qs, err = sc.useReservedConnection(ctx, target, state, ss, eo)
 	}
 	if err != nil {
 		return err
@@ -184,9 +314,12 @@ func (sc *ScatterConn) PortalStreamExecute(
 		"pooler_type", target.PoolerType.String())
 
 	// Use the query from the prepared statement
+	start := time.Now()
 	reservedState, err := qs.PortalStreamExecute(ctx, target, portalInfo.PreparedStatementInfo.PreparedStatement, portalInfo.Portal, eo, callback)
+	sc.metrics.recordShardQuery(ctx, conn.Database(), target, time.Since(start), err)
+	engine.QueryTimingFromContext(ctx).RecordShard(target, time.Since(start), err)
 	if err != nil {
-		return fmt.Errorf("portal execution failed: %w", err)
+		return sc.checkPinnedConnectionLost(ctx, target, state, ss, fmt.Errorf("portal execution failed: %w", err))
 	}
 	state.StoreReservedConnection(target, reservedState)
 
@@ -243,8 +376,7 @@ func (sc *ScatterConn) Describe(
 	ss := state.GetMatchingShardState(target)
 	// If we have a reserved connection, use it
 	if ss != nil && ss.ReservedConnectionId != 0 {
-		eo.ReservedConnectionId = uint64(ss.ReservedConnectionId)
-		qs, err = sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+		qs, err = sc.useReservedConnection(ctx, target, state, ss, eo)
 	}
 	if err != nil {
 		return nil, err
@@ -268,7 +400,7 @@ func (sc *ScatterConn) Describe(
 	return description, nil
 }
 
-// --- COPY FROM STDIN methods ---
+// --- COPY methods ---
 
 // CopyInitiate initiates a COPY FROM STDIN operation using bidirectional streaming.
 // Stores reserved connection info in state.ShardStates for the given tableGroup/shard.
@@ -302,8 +434,21 @@ func (sc *ScatterConn) CopyInitiate(
 		SessionSettings: state.GetSessionSettings(),
 	}
 
+	var qs queryservice.QueryService = sc.gateway
+	var err error
+
+	// Run the COPY on the session's reserved connection if it has one, e.g.
+	// because it is pinned for advisory locks.
+	ss := state.GetMatchingShardState(target)
+	if ss != nil && ss.ReservedConnectionId != 0 {
+		qs, err = sc.useReservedConnection(ctx, target, state, ss, execOptions)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
 	// Call CopyReady on gateway to initiate the COPY and get format info
-	format, columnFormats, reservedState, err := sc.gateway.CopyReady(ctx, target, queryStr, execOptions)
+	format, columnFormats, reservedState, err := qs.CopyReady(ctx, target, queryStr, execOptions)
 	if err != nil {
 		return 0, nil, fmt.Errorf("failed to initiate COPY: %w", err)
 	}
@@ -350,7 +495,6 @@ func (sc *ScatterConn) CopySendData(
 	// Build options with reserved connection ID
 	copyOptions := &query.ExecuteOptions{
 		User:                 conn.User(),
-		SessionSettings:      state.GetSessionSettings(),
 		ReservedConnectionId: uint64(ss.ReservedConnectionId),
 	}
 
@@ -396,7 +540,6 @@ func (sc *ScatterConn) CopyFinalize(
 	// Build options with reserved connection ID
 	copyOptions := &query.ExecuteOptions{
 		User:                 conn.User(),
-		SessionSettings:      state.GetSessionSettings(),
 		ReservedConnectionId: uint64(ss.ReservedConnectionId),
 	}
 
@@ -426,6 +569,63 @@ func (sc *ScatterConn) CopyFinalize(
 	return nil
 }
 
+// CopyOut executes a COPY TO STDOUT operation, streaming its data via onData.
+// Unlike COPY FROM STDIN, the whole operation happens in one call; it runs on
+// the reserved connection of the session if there is one (e.g. in a transaction).
+func (sc *ScatterConn) CopyOut(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	queryStr string,
+	state *handler.MultiGatewayConnectionState,
+	onReady func(ctx context.Context, format int16, columnFormats []int16) error,
+	onData func(ctx context.Context, data []byte) error,
+	callback func(ctx context.Context, result *sqltypes.Result) error,
+) error {
+	sc.logger.DebugContext(ctx, "executing COPY TO STDOUT",
+		"query", queryStr,
+		"tablegroup", tableGroup,
+		"shard", shard,
+		"user", conn.User(),
+		"database", conn.Database())
+
+	// Create target for routing - COPY always goes to PRIMARY
+	target := &query.Target{
+		TableGroup: tableGroup,
+		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
+		Shard:      shard,
+	}
+
+	eo := &query.ExecuteOptions{
+		User:            conn.User(),
+		SessionSettings: state.GetSessionSettings(),
+	}
+
+	var qs queryservice.QueryService = sc.gateway
+	var err error
+
+	// As in StreamExecute, a reserved connection pins the COPY to its pooler.
+	ss := state.GetMatchingShardState(target)
+	if ss != nil && ss.ReservedConnectionId != 0 {
+		qs, err = sc.useReservedConnection(ctx, target, state, ss, eo)
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := qs.CopyOut(ctx, target, queryStr, eo, onReady, onData)
+	if err != nil {
+		return fmt.Errorf("COPY TO STDOUT failed: %w", err)
+	}
+
+	sc.logger.DebugContext(ctx, "COPY TO STDOUT completed successfully",
+		"command_tag", result.CommandTag,
+		"rows_affected", result.RowsAffected)
+
+	return callback(ctx, result)
+}
+
 // CopyAbort aborts the COPY operation via bidirectional stream.
 // Looks up reserved connection from state.ShardStates based on tableGroup/shard.
 func (sc *ScatterConn) CopyAbort(
@@ -457,7 +657,6 @@ func (sc *ScatterConn) CopyAbort(
 	// Build options with reserved connection ID
 	copyOptions := &query.ExecuteOptions{
 		User:                 conn.User(),
-		SessionSettings:      state.GetSessionSettings(),
 		ReservedConnectionId: uint64(ss.ReservedConnectionId),
 	}
 
@@ -474,6 +673,661 @@ func (sc *ScatterConn) CopyAbort(
 	return nil
 }
 
+// Listen subscribes the connection to notifications on channel.
+// Connections listening on the same channel share a single notification
+// stream from the pooler, so LISTEN does not pin a backend connection.
+func (sc *ScatterConn) Listen(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	channel string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	sc.logger.DebugContext(ctx, "listening on channel",
+		"channel", channel,
+		"tablegroup", tableGroup,
+		"shard", shard,
+		"connection_id", conn.ConnectionID())
+
+	// Notifications are raised on the primary.
+	target := &query.Target{
+		TableGroup: tableGroup,
+		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
+		Shard:      shard,
+	}
+	eo := &query.ExecuteOptions{
+		User: conn.User(),
+	}
+
+	if err := sc.listens.listen(ctx, target, channel, eo, conn); err != nil {
+		return fmt.Errorf("LISTEN failed: %w", err)
+	}
+	return nil
+}
+
+// Unlisten unsubscribes the connection from channel, or from all channels
+// if channel is empty.
+func (sc *ScatterConn) Unlisten(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	channel string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	sc.logger.DebugContext(ctx, "unlistening",
+		"channel", channel,
+		"tablegroup", tableGroup,
+		"shard", shard,
+		"connection_id", conn.ConnectionID())
+
+	sc.listens.unlisten(tableGroup, shard, channel, conn)
+	return nil
+}
+
+// --- Session pinning ---
+
+// primaryTarget returns the PRIMARY target for a tablegroup and shard.
+func primaryTarget(tableGroup, shard string) *query.Target {
+	return &query.Target{
+		TableGroup: tableGroup,
+		PoolerType: clustermetadatapb.PoolerType_PRIMARY,
+		Shard:      shard,
+	}
+}
+
+// pinSession pins the session to a reserved connection on target, so that
+// session state living on the backend is not lost to pooling. The session's
+// current reserved connection is pinned if it has one.
+func (sc *ScatterConn) pinSession(
+	ctx context.Context,
+	conn *server.Conn,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	reason string,
+) error {
+	ss := state.GetMatchingShardState(target)
+	if ss != nil && ss.SessionPinned {
+		return nil
+	}
+
+	eo := &query.ExecuteOptions{
+		User:            conn.User(),
+		SessionSettings: state.GetSessionSettings(),
+	}
+
+	var qs queryservice.QueryService = sc.gateway
+	var err error
+
+	// Pin the reserved connection the session already has, if any.
+	if ss != nil && ss.ReservedConnectionId != 0 {
+		qs, err = sc.useReservedConnection(ctx, target, state, ss, eo)
+	}
+	if err != nil {
+		return err
+	}
+
+	reservedState, err := qs.ReserveConnection(ctx, target, eo)
+	if err != nil {
+		return fmt.Errorf("failed to reserve connection for %s: %w", reason, err)
+	}
+	state.StoreReservedConnection(target, reservedState)
+	state.SetSessionPinned(target, true)
+
+	sc.logger.DebugContext(ctx, "session pinned to reserved connection",
+		"reason", reason,
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"reserved_connection_id", reservedState.ReservedConnectionId,
+		"connection_id", conn.ConnectionID())
+	return nil
+}
+
+// unpinSessionIfUnneeded releases the session's pinned connection on target
+// once no session state lives on it anymore. The pooler releases advisory
+// locks, temporary relations and cursors left on the connection.
+func (sc *ScatterConn) unpinSessionIfUnneeded(
+	ctx context.Context,
+	conn *server.Conn,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	ss := state.GetMatchingShardState(target)
+	if ss == nil || !ss.SessionPinned || ss.NeedsPin() {
+		return nil
+	}
+	reservedConnID := ss.ReservedConnectionId
+
+	// The pooler unpins the connection even if it keeps it reserved for
+	// something else, so the session is unpinned either way.
+	state.SetSessionPinned(target, false)
+	state.ClearReservedConnection(target)
+
+	qs, err := sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+	if err != nil {
+		return err
+	}
+	eo := &query.ExecuteOptions{
+		User:                 conn.User(),
+		ReservedConnectionId: uint64(reservedConnID),
+	}
+	if err := qs.ReleaseReservedConnection(ctx, target, eo); err != nil {
+		return fmt.Errorf("failed to release pinned connection: %w", err)
+	}
+
+	sc.logger.DebugContext(ctx, "session unpinned from reserved connection",
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"reserved_connection_id", reservedConnID,
+		"connection_id", conn.ConnectionID())
+	return nil
+}
+
+// useReservedConnection points eo to the session's reserved connection to
+// target and returns the query service of the pooler holding it. The pooler
+// ignores the settings sent for a reserved connection, so they are dropped;
+// the variables changed since the connection last caught up are replayed on
+// it instead.
+func (sc *ScatterConn) useReservedConnection(
+	ctx context.Context,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	ss *handler.ShardState,
+	eo *query.ExecuteOptions,
+) (queryservice.QueryService, error) {
+	eo.ReservedConnectionId = uint64(ss.ReservedConnectionId)
+	eo.SessionSettings = nil
+	qs, err := sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+	if err != nil {
+		return nil, err
+	}
+	sc.replayChangedVariables(ctx, qs, target, state, ss, eo)
+	return qs, nil
+}
+
+// replayChangedVariables applies to the session's reserved connection to
+// target the variables changed since it last caught up, e.g. by statements
+// routed elsewhere or by RestoreVariables. Only the difference is sent.
+//
+// Inside a transaction block the replay waits for the block to end, since a
+// rollback would undo it on the backend. A failed replay is retried before
+// the next statement, rather than failing this one: it may be the ROLLBACK
+// of a failed transaction.
+func (sc *ScatterConn) replayChangedVariables(
+	ctx context.Context,
+	qs queryservice.QueryService,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	ss *handler.ShardState,
+	eo *query.ExecuteOptions,
+) {
+	if state.InTransaction() {
+		return
+	}
+	current := state.SnapshotVariables()
+	diff := handler.DiffVariables(ss.Variables, current)
+	if diff.IsEmpty() {
+		return
+	}
+	if _, err := qs.ExecuteQuery(ctx, target, diff.Query(), eo); err != nil {
+		sc.logger.WarnContext(ctx, "failed to replay session variables on reserved connection",
+			"reserved_connection_id", ss.ReservedConnectionId,
+			"error", err)
+		return
+	}
+	state.SetShardVariables(target, current)
+}
+
+// isReservedConnectionLost returns true if err reports that the session's
+// reserved connection no longer exists on the multipooler, e.g. because its
+// backend died. Errors lose their type crossing gRPC, so the message the
+// multipooler returns is matched.
+func isReservedConnectionLost(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "reserved connection") && strings.Contains(msg, "not found")
+}
+
+// checkPinnedConnectionLost turns the failure of a statement on a pinned
+// connection holding temporary relations or cursors into a clear error if
+// the connection is gone, instead of the "relation does not exist" or
+// "cursor does not exist" errors the following statements would get. The
+// session is unpinned so it can carry on.
+func (sc *ScatterConn) checkPinnedConnectionLost(
+	ctx context.Context,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	ss *handler.ShardState,
+	err error,
+) error {
+	if ss == nil || (len(ss.TempTables) == 0 && len(ss.Cursors) == 0) || !isReservedConnectionLost(err) {
+		return err
+	}
+	tempTables := state.GetTempTables(target)
+	cursors := state.GetCursors(target)
+
+	state.ClearTempTables(target)
+	state.CloseCursors(target, true)
+	state.SetHoldsAdvisoryLocks(target, false)
+	state.SetSessionPinned(target, false)
+	state.ClearReservedConnection(target)
+
+	sc.logger.WarnContext(ctx, "pinned connection holding session state was lost",
+		"tablegroup", target.TableGroup,
+		"shard", target.Shard,
+		"reserved_connection_id", ss.ReservedConnectionId,
+		"temp_tables", tempTables,
+		"cursors", cursors,
+		"error", err)
+
+	var lost []string
+	if len(tempTables) > 0 {
+		lost = append(lost, "temporary tables "+strings.Join(tempTables, ", "))
+	}
+	if len(cursors) > 0 {
+		lost = append(lost, "cursors "+strings.Join(cursors, ", "))
+	}
+	return sqlstate.NewError(sqlstate.ConnectionFailure).
+		Msg("backend connection holding the session's temporary tables or cursors was lost").
+		Detail("The session's %s no longer exist.", strings.Join(lost, " and ")).
+		Hint("Recreate them to continue.").
+		Err()
+}
+
+// --- Advisory lock methods ---
+
+// advisoryLocksHeldQuery reports whether the backend holds advisory locks.
+const advisoryLocksHeldQuery = "SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_locks " +
+	"WHERE locktype = 'advisory' AND pid = pg_catalog.pg_backend_pid())"
+
+// PinForAdvisoryLocks pins the session to a reserved connection before a
+// statement that takes session-level advisory locks.
+func (sc *ScatterConn) PinForAdvisoryLocks(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	if state.HoldsAdvisoryLocks(target) {
+		return nil
+	}
+	if err := sc.pinSession(ctx, conn, target, state, "advisory locks"); err != nil {
+		return err
+	}
+	state.SetHoldsAdvisoryLocks(target, true)
+	return nil
+}
+
+// UnpinIfNoAdvisoryLocks releases the session's pinned connection once it no
+// longer holds session-level advisory locks.
+func (sc *ScatterConn) UnpinIfNoAdvisoryLocks(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	ss := state.GetMatchingShardState(target)
+	if ss == nil || !ss.HoldsAdvisoryLocks {
+		return nil
+	}
+
+	qs, err := sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+	if err != nil {
+		return err
+	}
+	eo := &query.ExecuteOptions{
+		User:                 conn.User(),
+		ReservedConnectionId: uint64(ss.ReservedConnectionId),
+	}
+	result, err := qs.ExecuteQuery(ctx, target, advisoryLocksHeldQuery, eo)
+	if err != nil {
+		sc.logger.WarnContext(ctx, "failed to check advisory locks, keeping session pinned",
+			"reserved_connection_id", ss.ReservedConnectionId,
+			"error", err)
+		return err
+	}
+	if len(result.Rows) != 1 || len(result.Rows[0].Values) != 1 {
+		return fmt.Errorf("unexpected result checking advisory locks: %d rows", len(result.Rows))
+	}
+	if string(result.Rows[0].Values[0]) != "f" {
+		return nil
+	}
+
+	return sc.ReleaseAdvisoryLocks(ctx, conn, tableGroup, shard, state)
+}
+
+// ReleaseAdvisoryLocks releases the session-level advisory locks of the
+// session and unpins its reserved connection unless temporary relations
+// still need it.
+func (sc *ScatterConn) ReleaseAdvisoryLocks(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	ss := state.GetMatchingShardState(target)
+	if ss == nil || !ss.HoldsAdvisoryLocks {
+		return nil
+	}
+	state.SetHoldsAdvisoryLocks(target, false)
+
+	if !ss.NeedsPin() {
+		// Unpinning releases the locks.
+		return sc.unpinSessionIfUnneeded(ctx, conn, target, state)
+	}
+
+	// The connection stays pinned for temporary relations; release the
+	// locks on it directly.
+	qs, err := sc.gateway.QueryServiceByID(ctx, ss.PoolerID, target)
+	if err != nil {
+		return err
+	}
+	eo := &query.ExecuteOptions{
+		User:                 conn.User(),
+		ReservedConnectionId: uint64(ss.ReservedConnectionId),
+	}
+	if _, err := qs.ExecuteQuery(ctx, target, "SELECT pg_catalog.pg_advisory_unlock_all()", eo); err != nil {
+		return fmt.Errorf("failed to release advisory locks: %w", err)
+	}
+	return nil
+}
+
+// --- Temporary table methods ---
+
+// PinForTempTables pins the session to a reserved connection before a
+// statement that creates temporary relations.
+func (sc *ScatterConn) PinForTempTables(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	return sc.pinSession(ctx, conn, primaryTarget(tableGroup, shard), state, "temporary tables")
+}
+
+// TrackTempTables records the temporary relations a statement created or
+// dropped, and unpins the session once it has none left.
+func (sc *ScatterConn) TrackTempTables(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage engine.TempTableUsage,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	ss := state.GetMatchingShardState(target)
+	if ss == nil || ss.ReservedConnectionId == 0 {
+		// Temporary relations dropped on a pooled connection were not the
+		// session's.
+		return nil
+	}
+
+	switch {
+	case usage.DiscardsAll:
+		state.ClearTempTables(target)
+	case ss.SessionPinned:
+		state.DropTempTables(target, usage.Drops...)
+		state.AddTempTables(target, usage.Creates...)
+	}
+
+	return sc.unpinSessionIfUnneeded(ctx, conn, target, state)
+}
+
+// ReleaseTempTables forgets the temporary relations of the session and
+// unpins its reserved connection; the pooler drops the relations.
+func (sc *ScatterConn) ReleaseTempTables(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	state.ClearTempTables(target)
+	return sc.unpinSessionIfUnneeded(ctx, conn, target, state)
+}
+
+// --- Cursor methods ---
+
+// PinForCursors pins the session to a reserved connection before a
+// statement that declares a cursor.
+func (sc *ScatterConn) PinForCursors(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	return sc.pinSession(ctx, conn, primaryTarget(tableGroup, shard), state, "cursors")
+}
+
+// TrackCursors records the cursors a statement declared or closed, and
+// unpins the session once it has none left.
+func (sc *ScatterConn) TrackCursors(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	usage engine.CursorUsage,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	ss := state.GetMatchingShardState(target)
+	if ss == nil || ss.ReservedConnectionId == 0 {
+		// Cursors closed on a pooled connection were not the session's.
+		return nil
+	}
+
+	switch {
+	case usage.ClosesAll:
+		state.CloseCursors(target, true)
+	case usage.Closes != "":
+		state.CloseCursor(target, usage.Closes)
+	case usage.EndsTransaction:
+		state.CloseCursors(target, false)
+	case usage.Declares != "" && ss.SessionPinned:
+		state.AddCursor(target, usage.Declares, usage.Holdable)
+	}
+
+	return sc.unpinSessionIfUnneeded(ctx, conn, target, state)
+}
+
+// ReleaseCursors forgets the cursors of the session and unpins its reserved
+// connection; the pooler closes the cursors.
+func (sc *ScatterConn) ReleaseCursors(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	state.CloseCursors(target, true)
+	return sc.unpinSessionIfUnneeded(ctx, conn, target, state)
+}
+
+// --- Implicit transaction methods ---
+
+// BeginImplicitTransaction pins the session to a reserved connection and
+// runs BEGIN on it, before statements that must commit together.
+func (sc *ScatterConn) BeginImplicitTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	if err := sc.pinSession(ctx, conn, target, state, "implicit transaction"); err != nil {
+		return err
+	}
+	state.SetImplicitTransaction(target, true)
+
+	if err := sc.runTransactionControl(ctx, conn, tableGroup, shard, state, "BEGIN"); err != nil {
+		state.SetImplicitTransaction(target, false)
+		if unpinErr := sc.unpinSessionIfUnneeded(ctx, conn, target, state); unpinErr != nil {
+			sc.logger.WarnContext(ctx, "failed to unpin session after failed BEGIN", "error", unpinErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// EndImplicitTransaction runs COMMIT or ROLLBACK on the session's pinned
+// connection after the statements of a multi-statement query, and unpins
+// the session unless other session state still needs the connection.
+func (sc *ScatterConn) EndImplicitTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	commit bool,
+) error {
+	target := primaryTarget(tableGroup, shard)
+	ss := state.GetMatchingShardState(target)
+	if ss == nil || !ss.ImplicitTransaction {
+		return nil
+	}
+
+	query := "ROLLBACK"
+	if commit {
+		query = "COMMIT"
+	}
+	err := sc.runTransactionControl(ctx, conn, tableGroup, shard, state, query)
+	state.SetImplicitTransaction(target, false)
+
+	if unpinErr := sc.unpinSessionIfUnneeded(ctx, conn, target, state); err == nil {
+		err = unpinErr
+	}
+	return err
+}
+
+// joinImplicitTransaction opens the implicit transaction block of the
+// session on target, unless it is not in one or target already joined it.
+func (sc *ScatterConn) joinImplicitTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	target *query.Target,
+	state *handler.MultiGatewayConnectionState,
+) error {
+	if !state.InImplicitTransaction() {
+		return nil
+	}
+	if ss := state.GetMatchingShardState(target); ss != nil && ss.ImplicitTransaction {
+		return nil
+	}
+	return sc.BeginImplicitTransaction(ctx, conn, target.TableGroup, target.Shard, state)
+}
+
+// releaseImplicitTransaction forgets the implicit transaction of the
+// participants once it ended, and unpins the session from them unless
+// other session state still needs their connection.
+func (sc *ScatterConn) releaseImplicitTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	participants []*query.Target,
+) error {
+	var firstErr error
+	for _, target := range participants {
+		ss := state.GetMatchingShardState(target)
+		if ss == nil || !ss.ImplicitTransaction {
+			continue
+		}
+		state.SetImplicitTransaction(target, false)
+		if err := sc.unpinSessionIfUnneeded(ctx, conn, target, state); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// runTransactionControl runs a transaction control statement the gateway
+// issues on its own; its results are not the client's.
+func (sc *ScatterConn) runTransactionControl(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	query string,
+) error {
+	return sc.StreamExecute(ctx, conn, tableGroup, shard, query, state,
+		func(context.Context, *sqltypes.Result) error { return nil })
+}
+
+// --- Savepoint methods ---
+
+// ExecuteSavepoint runs a SAVEPOINT, RELEASE or ROLLBACK TO on every shard
+// participating in the session's transaction, in the order they joined it,
+// and stops at the first failure. The client gets the results of the first
+// shard only; the others run the same statement.
+//
+// A shard that joined the transaction after a savepoint lacks it. RELEASE
+// skips such shards, since the work they did all comes after the savepoint
+// and is kept. ROLLBACK TO fails instead without running anywhere, since
+// their work could not be undone.
+func (sc *ScatterConn) ExecuteSavepoint(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	kind ast.TransactionStmtKind,
+	name string,
+	sql string,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	participants := state.TransactionParticipants()
+	if len(participants) == 0 {
+		return sc.StreamExecute(ctx, conn, tableGroup, shard, sql, state, callback)
+	}
+
+	if kind != ast.TRANS_STMT_SAVEPOINT {
+		var established, missing []*query.Target
+		for _, target := range participants {
+			if ss := state.GetMatchingShardState(target); ss != nil && ss.HasSavepoint(name) {
+				established = append(established, target)
+			} else {
+				missing = append(missing, target)
+			}
+		}
+		// If no shard knows the savepoint, the gateway may not have seen it
+		// being established; PostgreSQL reports whether it exists.
+		if len(established) > 0 && len(missing) > 0 {
+			if kind == ast.TRANS_STMT_ROLLBACK_TO {
+				return sqlstate.NewError(sqlstate.InvalidSavepointSpecification).
+					Msg("cannot roll back to savepoint \"%s\"", name).
+					Detail("Shard %s/%s joined the transaction after the savepoint was established.", missing[0].TableGroup, missing[0].Shard).
+					Hint("Roll back the whole transaction instead.").
+					Err()
+			}
+			participants = established
+		}
+	}
+
+	for i, target := range participants {
+		shardCallback := callback
+		if i > 0 {
+			shardCallback = func(context.Context, *sqltypes.Result) error { return nil }
+		}
+		if err := sc.StreamExecute(ctx, conn, target.TableGroup, target.Shard, sql, state, shardCallback); err != nil {
+			return err
+		}
+		state.RecordShardSavepoint(target, kind, name)
+	}
+	return nil
+}
+
 // Ensure ScatterConn implements engine.IExecute interface.
 // This will be checked at compile time.
 var _ engine.IExecute = (*ScatterConn)(nil)
@@ -0,0 +1,206 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/engine"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+func textRow(value string) *sqltypes.Row {
+	return sqltypes.NewRowBuilder(nil).Text(value).MustBuild()
+}
+
+func TestScatterExecuteBuffersShards(t *testing.T) {
+	fields := []*query.Field{{Name: "id", DataTypeOid: 23}}
+	gateway := &fakeShardGateway{streamResults: map[string][]*sqltypes.Result{
+		"0": {
+			{Fields: fields, Rows: []*sqltypes.Row{textRow("1")}},
+			{Rows: []*sqltypes.Row{textRow("2")}, CommandTag: "SELECT 2"},
+		},
+		"1": {
+			{Fields: fields, Rows: []*sqltypes.Row{textRow("3")}, CommandTag: "SELECT 1"},
+		},
+	}}
+	sc := NewScatterConn(gateway, slog.Default())
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	var results []*sqltypes.Result
+	err := sc.ScatterExecute(context.Background(), conn, "tg", []string{"0", "1"}, "SELECT id FROM t",
+		handler.NewMultiGatewayConnectionState(), func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"0:SELECT id FROM t", "1:SELECT id FROM t"}, gateway.executed)
+	require.Len(t, results, 2)
+	assert.Equal(t, fields, results[0].Fields)
+	assert.Equal(t, []*sqltypes.Row{textRow("1"), textRow("2")}, results[0].Rows)
+	assert.Equal(t, "SELECT 2", results[0].CommandTag)
+	assert.Equal(t, fields, results[1].Fields)
+	assert.Equal(t, []*sqltypes.Row{textRow("3")}, results[1].Rows)
+	assert.Equal(t, "SELECT 1", results[1].CommandTag)
+}
+
+func TestScatterExecuteStopsAtFailure(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "0"}
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetScatterOptions(ScatterOptions{MaxShardsPerQuery: 1})
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+	called := false
+	err := sc.ScatterExecute(context.Background(), conn, "tg", []string{"0", "1"}, "DELETE FROM t",
+		handler.NewMultiGatewayConnectionState(), func(context.Context, *sqltypes.Result) error {
+			called = true
+			return nil
+		})
+	require.Error(t, err)
+	assert.False(t, called)
+	assert.Equal(t, []string{"0:DELETE FROM t"}, gateway.executed)
+}
+
+// scatter runs sql on shards 0, 1 and 2 and returns the results reaching
+// the callback.
+func scatter(ctx context.Context, sc *ScatterConn, sql string, state *handler.MultiGatewayConnectionState) ([]*sqltypes.Result, error) {
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	var results []*sqltypes.Result
+	err := sc.ScatterExecute(ctx, conn, "tg", []string{"0", "1", "2"}, sql, state,
+		func(_ context.Context, result *sqltypes.Result) error {
+			results = append(results, result)
+			return nil
+		})
+	return results, err
+}
+
+func TestScatterExecuteCancelsOnFailure(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "0", blockShard: "1"}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	_, err := scatter(context.Background(), sc, "SELECT id FROM t", handler.NewMultiGatewayConnectionState())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, context.Canceled)
+}
+
+func TestScatterExecuteLimitsConcurrency(t *testing.T) {
+	tests := []struct {
+		name string
+		opts ScatterOptions
+		max  int
+	}{
+		{name: "per query", opts: ScatterOptions{MaxShardsPerQuery: 2}, max: 2},
+		{name: "gateway", opts: ScatterOptions{MaxShards: 1}, max: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := &fakeShardGateway{delay: 10 * time.Millisecond}
+			sc := NewScatterConn(gateway, slog.Default())
+			sc.SetScatterOptions(tt.opts)
+
+			results, err := scatter(context.Background(), sc, "SELECT id FROM t", handler.NewMultiGatewayConnectionState())
+			require.NoError(t, err)
+			assert.Len(t, results, 3)
+			assert.LessOrEqual(t, gateway.maxInFlight, tt.max)
+		})
+	}
+}
+
+func TestScatterExecuteShardTimeout(t *testing.T) {
+	gateway := &fakeShardGateway{blockShard: "1"}
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetScatterOptions(ScatterOptions{ShardTimeout: 10 * time.Millisecond})
+
+	_, err := scatter(context.Background(), sc, "SELECT id FROM t", handler.NewMultiGatewayConnectionState())
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.QueryCanceled, diag.Code)
+	assert.Contains(t, diag.Message, "shard 1")
+}
+
+func TestScatterExecutePartialResults(t *testing.T) {
+	read := engine.WithStatementClass(context.Background(), engine.StatementRead)
+	inTransaction := handler.NewMultiGatewayConnectionState()
+	inTransaction.BeginTransaction()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		state   *handler.MultiGatewayConnectionState
+		failed  string
+		partial bool
+	}{
+		{name: "read", ctx: read, state: handler.NewMultiGatewayConnectionState(), failed: "1", partial: true},
+		{name: "write", ctx: engine.WithStatementClass(context.Background(), engine.StatementWrite), state: handler.NewMultiGatewayConnectionState(), failed: "1"},
+		{name: "read in transaction", ctx: read, state: inTransaction, failed: "1"},
+		{name: "all shards fail", ctx: read, state: handler.NewMultiGatewayConnectionState(), failed: "*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := &fakeShardGateway{failShard: tt.failed}
+			sc := NewScatterConn(gateway, slog.Default())
+			sc.SetScatterOptions(ScatterOptions{PartialResults: true})
+
+			results, err := scatter(tt.ctx, sc, "SELECT id FROM t", tt.state)
+			if !tt.partial {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			require.Len(t, results[0].Notices, 1)
+			assert.Equal(t, "WARNING", results[0].Notices[0].Severity)
+			assert.Contains(t, results[0].Notices[0].Message, "shards 1")
+			assert.Empty(t, results[1].Notices)
+		})
+	}
+}
+
+func TestScatterExecuteRecordsShardTimings(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "1", delay: 5 * time.Millisecond}
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetScatterOptions(ScatterOptions{PartialResults: true, MaxShards: 1})
+	timing := engine.NewQueryTiming()
+	ctx := engine.WithQueryTiming(engine.WithStatementClass(context.Background(), engine.StatementRead), timing)
+
+	_, err := scatter(ctx, sc, "SELECT 1", handler.NewMultiGatewayConnectionState())
+	require.NoError(t, err)
+
+	shards := timing.Shards()
+	require.Len(t, shards, 3)
+	failed := 0
+	for _, shard := range shards {
+		assert.Equal(t, "tg", shard.TableGroup)
+		if shard.Error != "" {
+			assert.Equal(t, "1", shard.Shard)
+			failed++
+		}
+	}
+	assert.Equal(t, 1, failed)
+	// The shards wait for each other to run, one at a time.
+	assert.Positive(t, timing.QueueWait())
+}
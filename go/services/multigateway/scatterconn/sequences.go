@@ -0,0 +1,52 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/pb/query"
+)
+
+// sequencesTable is the table of the multigres sidecar schema holding the
+// next value to allocate of each global sequence.
+const sequencesTable = "multigres.sequences"
+
+// AllocateSequenceBlock implements engine.SequenceAllocator. The block is
+// allocated by a single statement on the given shard, which creates the
+// sequence on first use, outside the session's transaction so that the
+// values are never handed out twice even if the transaction rolls back.
+func (sc *ScatterConn) AllocateSequenceBlock(ctx context.Context, tableGroup, shard, name string, size int64) (int64, error) {
+	sql := fmt.Sprintf(
+		"INSERT INTO %[1]s AS s (name, next_value) VALUES (%[2]s, %[3]d) "+
+			"ON CONFLICT (name) DO UPDATE SET next_value = s.next_value + %[4]d "+
+			"RETURNING next_value - %[4]d",
+		sequencesTable, ast.QuoteStringLiteral(name), size+1, size)
+	result, err := sc.gateway.ExecuteQuery(ctx, primaryTarget(tableGroup, shard), sql, &query.ExecuteOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate values of sequence %q: %w", name, err)
+	}
+	if len(result.Rows) != 1 || len(result.Rows[0].Values) != 1 {
+		return 0, fmt.Errorf("failed to allocate values of sequence %q: unexpected result", name)
+	}
+	first, err := strconv.ParseInt(string(result.Rows[0].Values[0]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate values of sequence %q: %w", name, err)
+	}
+	return first, nil
+}
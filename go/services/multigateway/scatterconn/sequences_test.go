@@ -0,0 +1,50 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+)
+
+func TestAllocateSequenceBlock(t *testing.T) {
+	gateway := &fakeShardGateway{queryResult: &sqltypes.Result{Rows: []*sqltypes.Row{
+		sqltypes.NewRowBuilder(nil).Int64(1001).MustBuild(),
+	}}}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	first, err := sc.AllocateSequenceBlock(context.Background(), "tg", "0", "users_id_seq", 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), first)
+	assert.Equal(t, []string{
+		"0:INSERT INTO multigres.sequences AS s (name, next_value) VALUES ('users_id_seq', 101) " +
+			"ON CONFLICT (name) DO UPDATE SET next_value = s.next_value + 100 RETURNING next_value - 100",
+	}, gateway.executed)
+}
+
+func TestAllocateSequenceBlockFailure(t *testing.T) {
+	gateway := &fakeShardGateway{failShard: "0", failPrefix: "INSERT"}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	_, err := sc.AllocateSequenceBlock(context.Background(), "tg", "0", "users_id_seq", 100)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to allocate values of sequence "users_id_seq"`)
+}
@@ -0,0 +1,480 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/multigres/multigres/go/common/parser/ast"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// TransactionMode selects how a transaction spanning several shards
+// commits.
+type TransactionMode string
+
+const (
+	// TransactionModeMulti commits the shards one after the other. If a
+	// shard fails to commit, the shards after it are rolled back, but the
+	// ones before it stay committed.
+	TransactionModeMulti TransactionMode = "multi"
+
+	// TransactionModeTwoPC commits the shards with two-phase commit: the
+	// transaction is prepared on every shard before being committed on any,
+	// so it commits everywhere or nowhere. The outcome is recorded in the
+	// transaction log of the default shard of the tablegroup, from which
+	// transactions left in doubt by a gateway crash are resolved.
+	TransactionModeTwoPC TransactionMode = "twopc"
+)
+
+// ParseTransactionMode parses the name of a transaction mode.
+func ParseTransactionMode(name string) (TransactionMode, error) {
+	switch mode := TransactionMode(strings.ToLower(name)); mode {
+	case TransactionModeMulti, TransactionModeTwoPC:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid transaction mode %q: must be %q or %q", name, TransactionModeMulti, TransactionModeTwoPC)
+}
+
+// SetTransactionMode sets how transactions spanning several shards commit.
+func (sc *ScatterConn) SetTransactionMode(mode TransactionMode) {
+	sc.txMode = mode
+}
+
+// transactionLogTable is the table of the multigres sidecar schema holding
+// the two-phase commits coordinated by a shard. A transaction is logged in
+// the 'prepare' state before being prepared anywhere, and moves to the
+// 'commit' state once prepared everywhere: that update is the commit point.
+// A transaction that fails before its commit point moves to the 'abort'
+// state before being rolled back anywhere, so that it can no longer commit.
+// The entry is deleted once the outcome is applied on every participant.
+const transactionLogTable = "multigres.transaction_log"
+
+const (
+	transactionStatePrepare = "prepare"
+	transactionStateCommit  = "commit"
+	transactionStateAbort   = "abort"
+)
+
+// gidPrefix starts the global identifiers of the transactions prepared by
+// the gateway, telling them apart from those prepared by clients.
+const gidPrefix = "multigres:"
+
+// participant identifies a shard of a two-phase commit in the transaction
+// log.
+type participant struct {
+	TableGroup string `json:"tablegroup"`
+	Shard      string `json:"shard"`
+}
+
+func (p participant) target() *query.Target {
+	return primaryTarget(p.TableGroup, p.Shard)
+}
+
+// coordinatorTarget returns the shard holding the transaction log of the
+// two-phase commits of tableGroup: its default shard, the one holding its
+// unsharded tables. Every gateway logs there, whichever shards take part,
+// so that recovery has a single log to read.
+func coordinatorTarget(tableGroup string) *query.Target {
+	return primaryTarget(tableGroup, "")
+}
+
+// EndTransaction implements engine.IExecute.
+func (sc *ScatterConn) EndTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	commit bool,
+	sql string,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	participants := state.TransactionParticipants()
+	err := sc.endTransaction(ctx, conn, tableGroup, shard, state, participants, commit, sql, callback)
+	if releaseErr := sc.releaseImplicitTransaction(ctx, conn, state, participants); err == nil {
+		err = releaseErr
+	}
+	return err
+}
+
+// endTransaction ends the transaction on the participants.
+func (sc *ScatterConn) endTransaction(
+	ctx context.Context,
+	conn *server.Conn,
+	tableGroup string,
+	shard string,
+	state *handler.MultiGatewayConnectionState,
+	participants []*query.Target,
+	commit bool,
+	sql string,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	switch len(participants) {
+	case 0:
+		return sc.StreamExecute(ctx, conn, tableGroup, shard, sql, state, callback)
+	case 1:
+		return sc.StreamExecute(ctx, conn, participants[0].TableGroup, participants[0].Shard, sql, state, callback)
+	}
+	if !commit {
+		return sc.rollbackParticipants(ctx, conn, state, participants, callback)
+	}
+	if sc.txMode == TransactionModeTwoPC {
+		return sc.commitTwoPC(ctx, conn, coordinatorTarget(tableGroup), state, participants, callback)
+	}
+	return sc.commitParticipants(ctx, conn, state, participants, callback)
+}
+
+// rollbackParticipants rolls back the transaction on every participant,
+// even if some fail, and returns the first failure.
+func (sc *ScatterConn) rollbackParticipants(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	participants []*query.Target,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	var firstErr error
+	for _, target := range participants {
+		if err := sc.runTransactionControl(ctx, conn, target.TableGroup, target.Shard, state, "ROLLBACK"); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "ROLLBACK"})
+}
+
+// commitParticipants commits the transaction on the participants one after
+// the other. Once a shard fails to commit, the remaining ones are rolled
+// back; the ones already committed cannot be.
+func (sc *ScatterConn) commitParticipants(
+	ctx context.Context,
+	conn *server.Conn,
+	state *handler.MultiGatewayConnectionState,
+	participants []*query.Target,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	for i, target := range participants {
+		if err := sc.runTransactionControl(ctx, conn, target.TableGroup, target.Shard, state, "COMMIT"); err != nil {
+			if i > 0 {
+				sc.logger.ErrorContext(ctx, "transaction partially committed",
+					"committed_shards", i,
+					"failed_tablegroup", target.TableGroup,
+					"failed_shard", target.Shard,
+					"error", err)
+			}
+			for _, rest := range participants[i+1:] {
+				if rbErr := sc.runTransactionControl(ctx, conn, rest.TableGroup, rest.Shard, state, "ROLLBACK"); rbErr != nil {
+					sc.logger.WarnContext(ctx, "failed to roll back shard after failed commit",
+						"tablegroup", rest.TableGroup, "shard", rest.Shard, "error", rbErr)
+				}
+			}
+			return err
+		}
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "COMMIT"})
+}
+
+// commitTwoPC commits the transaction on the participants with two-phase
+// commit, logged on the coordinator shard.
+//
+// Once the transaction is logged as committed, it is reported as committed
+// even if COMMIT PREPARED fails on some shard: RecoverTransactions finishes
+// it there later.
+func (sc *ScatterConn) commitTwoPC(
+	ctx context.Context,
+	conn *server.Conn,
+	coordinator *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	participants []*query.Target,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	gid := gidPrefix + uuid.NewString()
+	logged := make([]participant, len(participants))
+	for i, target := range participants {
+		logged[i] = participant{TableGroup: target.TableGroup, Shard: target.Shard}
+	}
+	participantsJSON, err := json.Marshal(logged)
+	if err != nil {
+		return err
+	}
+
+	// Log the participants before preparing anywhere, so that recovery can
+	// find every prepared transaction.
+	if err := sc.execTransactionLog(ctx, coordinator, fmt.Sprintf(
+		"INSERT INTO %s (gid, state, participants) VALUES (%s, %s, %s)",
+		transactionLogTable, ast.QuoteStringLiteral(gid), ast.QuoteStringLiteral(transactionStatePrepare),
+		ast.QuoteStringLiteral(string(participantsJSON)))); err != nil {
+		_ = sc.rollbackParticipants(ctx, conn, state, participants,
+			func(context.Context, *sqltypes.Result) error { return nil })
+		return fmt.Errorf("failed to log distributed transaction: %w", err)
+	}
+
+	prepared := 0
+	for _, target := range participants {
+		if err := sc.runTransactionControl(ctx, conn, target.TableGroup, target.Shard, state, "PREPARE TRANSACTION "+ast.QuoteStringLiteral(gid)); err != nil {
+			// The shard that failed to prepare may still have the
+			// transaction open, so it is rolled back with the others.
+			sc.abortTwoPC(ctx, conn, coordinator, state, gid, participants[:prepared], participants[prepared:])
+			return err
+		}
+		prepared++
+	}
+
+	// The commit point.
+	if err := sc.execTransactionLog(ctx, coordinator, fmt.Sprintf(
+		"UPDATE %s SET state = %s WHERE gid = %s",
+		transactionLogTable, ast.QuoteStringLiteral(transactionStateCommit), ast.QuoteStringLiteral(gid))); err != nil {
+		committed, settleErr := sc.settleCommitPoint(ctx, coordinator, gid)
+		switch {
+		case settleErr != nil:
+			sc.logger.ErrorContext(ctx, "distributed transaction left in doubt, leaving it prepared for recovery",
+				"gid", gid, "error", err, "settle_error", settleErr)
+			return fmt.Errorf("failed to log distributed transaction commit: %w", err)
+		case !committed:
+			sc.abortTwoPC(ctx, conn, coordinator, state, gid, participants, nil)
+			return fmt.Errorf("failed to log distributed transaction commit: %w", err)
+		}
+		sc.logger.WarnContext(ctx, "distributed transaction commit was logged despite an error", "gid", gid, "error", err)
+	}
+
+	resolved := true
+	for _, target := range participants {
+		if err := sc.runTransactionControl(ctx, conn, target.TableGroup, target.Shard, state, "COMMIT PREPARED "+ast.QuoteStringLiteral(gid)); err != nil {
+			sc.logger.WarnContext(ctx, "failed to commit prepared transaction, leaving it to recovery",
+				"gid", gid, "tablegroup", target.TableGroup, "shard", target.Shard, "error", err)
+			resolved = false
+		}
+	}
+	if resolved {
+		sc.forgetTransaction(ctx, coordinator, gid)
+	}
+	return callback(ctx, &sqltypes.Result{CommandTag: "COMMIT"})
+}
+
+// settleCommitPoint settles the outcome of a two-phase commit whose commit
+// point failed: the update may have been applied all the same, e.g. if the
+// connection was lost once it committed. The state of the transaction is
+// read back from the log, and a transaction not logged as committed is
+// logged as aborted, so that it cannot commit once rolled back anywhere.
+// It returns whether the transaction committed, or an error if its outcome
+// is unknown, in which case the participants must be left prepared for
+// recovery to resolve.
+func (sc *ScatterConn) settleCommitPoint(ctx context.Context, coordinator *query.Target, gid string) (committed bool, err error) {
+	result, err := sc.gateway.ExecuteQuery(ctx, coordinator, fmt.Sprintf(
+		"SELECT state FROM %s WHERE gid = %s", transactionLogTable, ast.QuoteStringLiteral(gid)), &query.ExecuteOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to read distributed transaction state: %w", err)
+	}
+	if len(result.Rows) != 1 || len(result.Rows[0].Values) == 0 {
+		return false, errors.New("distributed transaction is missing from the transaction log")
+	}
+	if string(result.Rows[0].Values[0]) == transactionStateCommit {
+		return true, nil
+	}
+
+	// The update is conditional, in case the commit point is still being
+	// applied: it then waits for it, and updates nothing.
+	result, err = sc.gateway.ExecuteQuery(ctx, coordinator, fmt.Sprintf(
+		"UPDATE %s SET state = %s WHERE gid = %s AND state = %s RETURNING gid",
+		transactionLogTable, ast.QuoteStringLiteral(transactionStateAbort), ast.QuoteStringLiteral(gid),
+		ast.QuoteStringLiteral(transactionStatePrepare)), &query.ExecuteOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to log distributed transaction abort: %w", err)
+	}
+	if len(result.Rows) != 1 {
+		return false, errors.New("distributed transaction state changed while being aborted")
+	}
+	return false, nil
+}
+
+// abortTwoPC rolls back a two-phase commit that failed before its commit
+// point: the prepared participants with ROLLBACK PREPARED, the others with
+// ROLLBACK. The log entry is kept if a prepared transaction is left over,
+// so that recovery rolls it back.
+func (sc *ScatterConn) abortTwoPC(
+	ctx context.Context,
+	conn *server.Conn,
+	coordinator *query.Target,
+	state *handler.MultiGatewayConnectionState,
+	gid string,
+	prepared []*query.Target,
+	unprepared []*query.Target,
+) {
+	resolved := true
+	for _, target := range prepared {
+		if err := sc.runTransactionControl(ctx, conn, target.TableGroup, target.Shard, state, "ROLLBACK PREPARED "+ast.QuoteStringLiteral(gid)); err != nil {
+			sc.logger.WarnContext(ctx, "failed to roll back prepared transaction, leaving it to recovery",
+				"gid", gid, "tablegroup", target.TableGroup, "shard", target.Shard, "error", err)
+			resolved = false
+		}
+	}
+	for _, target := range unprepared {
+		if err := sc.runTransactionControl(ctx, conn, target.TableGroup, target.Shard, state, "ROLLBACK"); err != nil {
+			sc.logger.WarnContext(ctx, "failed to roll back shard after failed prepare",
+				"tablegroup", target.TableGroup, "shard", target.Shard, "error", err)
+		}
+	}
+	if resolved {
+		sc.forgetTransaction(ctx, coordinator, gid)
+	}
+}
+
+// forgetTransaction deletes the log entry of a resolved two-phase commit.
+// A failure only leaves work for recovery.
+func (sc *ScatterConn) forgetTransaction(ctx context.Context, coordinator *query.Target, gid string) {
+	if err := sc.execTransactionLog(ctx, coordinator, fmt.Sprintf(
+		"DELETE FROM %s WHERE gid = %s", transactionLogTable, ast.QuoteStringLiteral(gid))); err != nil {
+		sc.logger.WarnContext(ctx, "failed to delete resolved distributed transaction", "gid", gid, "error", err)
+	}
+}
+
+// execTransactionLog runs sql on the transaction log of the coordinator
+// shard, outside the session's transaction so that it commits on its own,
+// and as the pooler's default user, since clients may not write to the
+// sidecar schema.
+func (sc *ScatterConn) execTransactionLog(ctx context.Context, coordinator *query.Target, sql string) error {
+	_, err := sc.gateway.ExecuteQuery(ctx, coordinator, sql, &query.ExecuteOptions{})
+	return err
+}
+
+// RecoverTransactions resolves the two-phase commits logged on the default
+// shard of tableGroup more than abandonAge ago, which the gateway that
+// started them did not finish, e.g. because it crashed: those logged as
+// committed are committed on every participant, the others are rolled back.
+// Participants where the transaction is already resolved are skipped.
+//
+// abandonAge must be well above the time a commit takes, or a commit in
+// progress could be rolled back under its gateway.
+func (sc *ScatterConn) RecoverTransactions(ctx context.Context, tableGroup string, abandonAge time.Duration) error {
+	coordinator := coordinatorTarget(tableGroup)
+	result, err := sc.gateway.ExecuteQuery(ctx, coordinator, fmt.Sprintf(
+		"SELECT gid, state, participants FROM %s WHERE created_at < now() - make_interval(secs => %d) ORDER BY created_at",
+		transactionLogTable, int64(abandonAge.Seconds())), &query.ExecuteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to read transaction log: %w", err)
+	}
+
+	var errs []error
+	for _, row := range result.Rows {
+		if len(row.Values) < 3 {
+			continue
+		}
+		gid, txState := string(row.Values[0]), string(row.Values[1])
+		var participants []participant
+		if err := json.Unmarshal(row.Values[2], &participants); err != nil {
+			errs = append(errs, fmt.Errorf("transaction %s: invalid participants: %w", gid, err))
+			continue
+		}
+
+		resolution := "ROLLBACK PREPARED "
+		if txState == transactionStateCommit {
+			resolution = "COMMIT PREPARED "
+		}
+		resolved := true
+		for _, p := range participants {
+			_, err := sc.gateway.ExecuteQuery(ctx, p.target(), resolution+ast.QuoteStringLiteral(gid), &query.ExecuteOptions{})
+			if err != nil && !isPreparedTransactionMissing(err) {
+				errs = append(errs, fmt.Errorf("transaction %s on shard %s/%s: %w", gid, p.TableGroup, p.Shard, err))
+				resolved = false
+			}
+		}
+		if !resolved {
+			continue
+		}
+		sc.logger.InfoContext(ctx, "recovered distributed transaction", "gid", gid, "state", txState)
+		if err := sc.execTransactionLog(ctx, coordinator, fmt.Sprintf(
+			"DELETE FROM %s WHERE gid = %s", transactionLogTable, ast.QuoteStringLiteral(gid))); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RunTransactionRecovery calls RecoverTransactions every interval until ctx
+// is done.
+func (sc *ScatterConn) RunTransactionRecovery(ctx context.Context, tableGroup string, interval, abandonAge time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := sc.RecoverTransactions(ctx, tableGroup, abandonAge); err != nil {
+				sc.logger.WarnContext(ctx, "distributed transaction recovery failed", "error", err)
+			}
+		}
+	}
+}
+
+// CheckTwoPC checks that the default shard of tableGroup, holding the
+// transaction log, and the given shards can prepare transactions: PREPARE
+// TRANSACTION fails unless max_prepared_transactions, which PostgreSQL only
+// reads at startup, is above zero. Shards that cannot be reached are tried
+// again until ctx is done, and then left unchecked.
+func (sc *ScatterConn) CheckTwoPC(ctx context.Context, tableGroup string, shards []string) error {
+	unchecked := append([]string{""}, shards...)
+	for {
+		var unreachable []string
+		for _, shard := range unchecked {
+			result, err := sc.gateway.ExecuteQuery(ctx, primaryTarget(tableGroup, shard),
+				"SELECT current_setting('max_prepared_transactions')", &query.ExecuteOptions{})
+			if err != nil || len(result.Rows) != 1 || len(result.Rows[0].Values) == 0 {
+				unreachable = append(unreachable, shard)
+				continue
+			}
+			if n, err := strconv.Atoi(string(result.Rows[0].Values[0])); err == nil && n == 0 {
+				return fmt.Errorf("transaction mode %q needs max_prepared_transactions above zero on every shard, but it is 0 on shard %q of tablegroup %q: "+
+					"set it in postgresql.conf and restart PostgreSQL, or use transaction mode %q",
+					TransactionModeTwoPC, shard, tableGroup, TransactionModeMulti)
+			}
+		}
+		if len(unreachable) == 0 {
+			return nil
+		}
+		unchecked = unreachable
+
+		select {
+		case <-ctx.Done():
+			sc.logger.WarnContext(ctx, "could not check that every shard can prepare transactions",
+				"tablegroup", tableGroup, "unchecked_shards", unchecked)
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// isPreparedTransactionMissing returns true if err reports that no prepared
+// transaction has the given identifier, i.e. it was already resolved or was
+// never prepared.
+func isPreparedTransactionMissing(err error) bool {
+	var diag *sqltypes.PgDiagnostic
+	return errors.As(err, &diag) && diag.Code == sqlstate.UndefinedObject
+}
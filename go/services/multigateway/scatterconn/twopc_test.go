@@ -0,0 +1,394 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scatterconn
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+)
+
+// gidPattern replaces the generated global transaction identifiers, so
+// that the statements can be compared.
+var gidPattern = regexp.MustCompile(`multigres:[0-9a-f-]{36}`)
+
+func executedStatements(gateway *fakeShardGateway) []string {
+	statements := make([]string, len(gateway.executed))
+	for i, sql := range gateway.executed {
+		statements[i] = gidPattern.ReplaceAllString(sql, "GID")
+	}
+	return statements
+}
+
+func TestParseTransactionMode(t *testing.T) {
+	mode, err := ParseTransactionMode("TwoPC")
+	require.NoError(t, err)
+	assert.Equal(t, TransactionModeTwoPC, mode)
+
+	mode, err = ParseTransactionMode("multi")
+	require.NoError(t, err)
+	assert.Equal(t, TransactionModeMulti, mode)
+
+	_, err = ParseTransactionMode("single")
+	assert.Error(t, err)
+}
+
+func TestEndTransactionSingleShard(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetTransactionMode(TransactionModeTwoPC)
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state, _ := newSavepointTest("0")
+
+	require.NoError(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT",
+		func(context.Context, *sqltypes.Result) error { return nil }))
+	assert.Equal(t, []string{"0:COMMIT"}, executedStatements(gateway))
+}
+
+func TestEndTransactionMulti(t *testing.T) {
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+
+	t.Run("commits every shard", func(t *testing.T) {
+		gateway := &fakeShardGateway{}
+		sc := NewScatterConn(gateway, slog.Default())
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1")
+
+		var tag string
+		require.NoError(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT",
+			func(_ context.Context, result *sqltypes.Result) error {
+				tag = result.CommandTag
+				return nil
+			}))
+		assert.Equal(t, []string{"0:COMMIT", "1:COMMIT"}, executedStatements(gateway))
+		assert.Equal(t, "COMMIT", tag)
+	})
+
+	t.Run("rolls back the shards after a failed commit", func(t *testing.T) {
+		gateway := &fakeShardGateway{failShard: "1"}
+		sc := NewScatterConn(gateway, slog.Default())
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1", "2")
+
+		require.Error(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT", discard))
+		assert.Equal(t, []string{"0:COMMIT", "1:COMMIT", "2:ROLLBACK"}, executedStatements(gateway))
+	})
+
+	t.Run("rollback reaches every shard", func(t *testing.T) {
+		gateway := &fakeShardGateway{failShard: "0"}
+		sc := NewScatterConn(gateway, slog.Default())
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1")
+
+		require.Error(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, false, "ROLLBACK", discard))
+		assert.Equal(t, []string{"0:ROLLBACK", "1:ROLLBACK"}, executedStatements(gateway))
+	})
+}
+
+func TestEndTransactionTwoPC(t *testing.T) {
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+
+	t.Run("commit", func(t *testing.T) {
+		gateway := &fakeShardGateway{}
+		sc := NewScatterConn(gateway, slog.Default())
+		sc.SetTransactionMode(TransactionModeTwoPC)
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1")
+
+		require.NoError(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT", discard))
+		assert.Equal(t, []string{
+			`:INSERT INTO multigres.transaction_log (gid, state, participants) VALUES ('GID', 'prepare', '[{"tablegroup":"tg","shard":"0"},{"tablegroup":"tg","shard":"1"}]')`,
+			"0:PREPARE TRANSACTION 'GID'",
+			"1:PREPARE TRANSACTION 'GID'",
+			":UPDATE multigres.transaction_log SET state = 'commit' WHERE gid = 'GID'",
+			"0:COMMIT PREPARED 'GID'",
+			"1:COMMIT PREPARED 'GID'",
+			":DELETE FROM multigres.transaction_log WHERE gid = 'GID'",
+		}, executedStatements(gateway))
+	})
+
+	t.Run("failed prepare rolls back", func(t *testing.T) {
+		gateway := &fakeShardGateway{failShard: "1", failPrefix: "PREPARE"}
+		sc := NewScatterConn(gateway, slog.Default())
+		sc.SetTransactionMode(TransactionModeTwoPC)
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1", "2")
+
+		require.Error(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT", discard))
+		assert.Equal(t, []string{
+			`:INSERT INTO multigres.transaction_log (gid, state, participants) VALUES ('GID', 'prepare', '[{"tablegroup":"tg","shard":"0"},{"tablegroup":"tg","shard":"1"},{"tablegroup":"tg","shard":"2"}]')`,
+			"0:PREPARE TRANSACTION 'GID'",
+			"1:PREPARE TRANSACTION 'GID'",
+			"0:ROLLBACK PREPARED 'GID'",
+			"1:ROLLBACK",
+			"2:ROLLBACK",
+			":DELETE FROM multigres.transaction_log WHERE gid = 'GID'",
+		}, executedStatements(gateway))
+	})
+
+	t.Run("failed commit prepared is left to recovery", func(t *testing.T) {
+		gateway := &fakeShardGateway{failShard: "1", failPrefix: "COMMIT PREPARED"}
+		sc := NewScatterConn(gateway, slog.Default())
+		sc.SetTransactionMode(TransactionModeTwoPC)
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1")
+
+		// The transaction is committed once logged as such.
+		require.NoError(t, sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT", discard))
+		statements := executedStatements(gateway)
+		assert.Equal(t, "1:COMMIT PREPARED 'GID'", statements[len(statements)-1])
+	})
+}
+
+// transactionLogGateway keeps the transaction log of the coordinator shard
+// in memory. The commit point fails if failCommitPoint is set, after being
+// applied if applied is set too, as when the connection is lost once it
+// committed; the reads of the log fail if failRead is set.
+type transactionLogGateway struct {
+	*fakeShardGateway
+
+	state           string
+	failCommitPoint bool
+	applied         bool
+	failRead        bool
+}
+
+var logStatePattern = regexp.MustCompile(`SET state = '(\w+)'`)
+
+func (g *transactionLogGateway) ExecuteQuery(
+	ctx context.Context,
+	target *query.Target,
+	sql string,
+	options *query.ExecuteOptions,
+) (*sqltypes.Result, error) {
+	if target.Shard != "" {
+		return g.fakeShardGateway.ExecuteQuery(ctx, target, sql, options)
+	}
+	_ = g.run(target, sql)
+	switch {
+	case strings.HasPrefix(sql, "INSERT"):
+		g.state = transactionStatePrepare
+	case strings.HasPrefix(sql, "SELECT"):
+		if g.failRead {
+			return nil, errors.New("connection lost")
+		}
+		return &sqltypes.Result{Rows: []*sqltypes.Row{sqltypes.NewRowBuilder(nil).Text(g.state).MustBuild()}}, nil
+	case strings.HasPrefix(sql, "UPDATE"):
+		state := logStatePattern.FindStringSubmatch(sql)[1]
+		if state == transactionStateCommit && g.failCommitPoint {
+			if g.applied {
+				g.state = state
+			}
+			return nil, errors.New("connection lost")
+		}
+		if strings.Contains(sql, "AND state = 'prepare'") && g.state != transactionStatePrepare {
+			return &sqltypes.Result{}, nil
+		}
+		g.state = state
+		return &sqltypes.Result{Rows: []*sqltypes.Row{sqltypes.NewRowBuilder(nil).Text("gid").MustBuild()}}, nil
+	case strings.HasPrefix(sql, "DELETE"):
+		g.state = ""
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func TestEndTransactionTwoPCFailedCommitPoint(t *testing.T) {
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+	endTransaction := func(gateway *transactionLogGateway) error {
+		sc := NewScatterConn(gateway, slog.Default())
+		sc.SetTransactionMode(TransactionModeTwoPC)
+		conn := server.NewTestConn(&bytes.Buffer{}).Conn
+		state, _ := newSavepointTest("0", "1")
+		return sc.EndTransaction(context.Background(), conn, "tg", "", state, true, "COMMIT", discard)
+	}
+	prepared := []string{
+		`:INSERT INTO multigres.transaction_log (gid, state, participants) VALUES ('GID', 'prepare', '[{"tablegroup":"tg","shard":"0"},{"tablegroup":"tg","shard":"1"}]')`,
+		"0:PREPARE TRANSACTION 'GID'",
+		"1:PREPARE TRANSACTION 'GID'",
+		":UPDATE multigres.transaction_log SET state = 'commit' WHERE gid = 'GID'",
+		":SELECT state FROM multigres.transaction_log WHERE gid = 'GID'",
+	}
+
+	t.Run("applied commit point commits", func(t *testing.T) {
+		gateway := &transactionLogGateway{fakeShardGateway: &fakeShardGateway{}, failCommitPoint: true, applied: true}
+
+		require.NoError(t, endTransaction(gateway))
+		assert.Equal(t, append(prepared,
+			"0:COMMIT PREPARED 'GID'",
+			"1:COMMIT PREPARED 'GID'",
+			":DELETE FROM multigres.transaction_log WHERE gid = 'GID'",
+		), executedStatements(gateway.fakeShardGateway))
+	})
+
+	t.Run("commit point not applied aborts before rolling back", func(t *testing.T) {
+		gateway := &transactionLogGateway{fakeShardGateway: &fakeShardGateway{}, failCommitPoint: true}
+
+		require.Error(t, endTransaction(gateway))
+		assert.Equal(t, append(prepared,
+			":UPDATE multigres.transaction_log SET state = 'abort' WHERE gid = 'GID' AND state = 'prepare' RETURNING gid",
+			"0:ROLLBACK PREPARED 'GID'",
+			"1:ROLLBACK PREPARED 'GID'",
+			":DELETE FROM multigres.transaction_log WHERE gid = 'GID'",
+		), executedStatements(gateway.fakeShardGateway))
+	})
+
+	t.Run("unknown outcome is left prepared", func(t *testing.T) {
+		gateway := &transactionLogGateway{fakeShardGateway: &fakeShardGateway{}, failCommitPoint: true, applied: true, failRead: true}
+
+		require.Error(t, endTransaction(gateway))
+		assert.Equal(t, prepared, executedStatements(gateway.fakeShardGateway))
+		// Recovery commits it everywhere.
+		assert.Equal(t, transactionStateCommit, gateway.state)
+	})
+}
+
+func TestCheckTwoPC(t *testing.T) {
+	setting := func(value string) *sqltypes.Result {
+		return &sqltypes.Result{Rows: []*sqltypes.Row{sqltypes.NewRowBuilder(nil).Text(value).MustBuild()}}
+	}
+
+	t.Run("prepared transactions enabled", func(t *testing.T) {
+		gateway := &fakeShardGateway{queryResult: setting("60")}
+		sc := NewScatterConn(gateway, slog.Default())
+
+		require.NoError(t, sc.CheckTwoPC(context.Background(), "tg", []string{"0", "1"}))
+		assert.Equal(t, []string{
+			":SELECT current_setting('max_prepared_transactions')",
+			"0:SELECT current_setting('max_prepared_transactions')",
+			"1:SELECT current_setting('max_prepared_transactions')",
+		}, gateway.executed)
+	})
+
+	t.Run("prepared transactions disabled", func(t *testing.T) {
+		gateway := &fakeShardGateway{queryResult: setting("60"), shardResults: map[string]*sqltypes.Result{"1": setting("0")}}
+		sc := NewScatterConn(gateway, slog.Default())
+
+		err := sc.CheckTwoPC(context.Background(), "tg", []string{"0", "1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `needs max_prepared_transactions above zero on every shard, but it is 0 on shard "1"`)
+	})
+
+	t.Run("unreachable shards are left unchecked", func(t *testing.T) {
+		gateway := &fakeShardGateway{queryResult: setting("60"), failShard: "1"}
+		sc := NewScatterConn(gateway, slog.Default())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.NoError(t, sc.CheckTwoPC(ctx, "tg", []string{"0", "1"}))
+	})
+}
+
+func TestImplicitTransactionSpansShards(t *testing.T) {
+	gateway := &fakeShardGateway{}
+	sc := NewScatterConn(gateway, slog.Default())
+	sc.SetTransactionMode(TransactionModeTwoPC)
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := handler.NewMultiGatewayConnectionState()
+	state.BeginImplicitTransaction()
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+
+	ctx := context.Background()
+	require.NoError(t, sc.StreamExecute(ctx, conn, "tg", "0", "INSERT 1", state, discard))
+	require.NoError(t, sc.StreamExecute(ctx, conn, "tg", "1", "INSERT 2", state, discard))
+	require.NoError(t, sc.StreamExecute(ctx, conn, "tg", "0", "INSERT 3", state, discard))
+	assert.Equal(t, []string{
+		"0:<reserve>", "0:BEGIN", "0:INSERT 1",
+		"1:<reserve>", "1:BEGIN", "1:INSERT 2",
+		"0:INSERT 3",
+	}, gateway.executed)
+
+	// The block ends on both shards, as an explicit transaction would.
+	gateway.executed = nil
+	require.NoError(t, sc.EndTransaction(ctx, conn, "tg", "", state, true, "COMMIT", discard))
+	state.EndTransaction(true)
+	statements := executedStatements(gateway)
+	assert.Contains(t, statements, "0:PREPARE TRANSACTION 'GID'")
+	assert.Contains(t, statements, "1:PREPARE TRANSACTION 'GID'")
+	assert.Equal(t, []string{"0:<release>", "1:<release>"}, statements[len(statements)-2:])
+	assert.False(t, state.InImplicitTransaction())
+	assert.Empty(t, state.ShardStates)
+}
+
+// transactionLogRow returns the row of the transaction log of gid, in
+// state, on shards 0 and 1 of tg.
+func transactionLogRow(gid, state string) *sqltypes.Row {
+	return sqltypes.NewRowBuilder(nil).Text(gid).Text(state).
+		Text(`[{"tablegroup":"tg","shard":"0"},{"tablegroup":"tg","shard":"1"}]`).MustBuild()
+}
+
+func TestRecoverTransactions(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard:  "1",
+		failPrefix: "ROLLBACK PREPARED",
+		queryResult: &sqltypes.Result{Rows: []*sqltypes.Row{
+			transactionLogRow("multigres:a", "commit"),
+			transactionLogRow("multigres:b", "prepare"),
+		}},
+	}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	err := sc.RecoverTransactions(context.Background(), "tg", time.Minute)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction multigres:b on shard tg/1")
+	assert.Equal(t, []string{
+		":SELECT gid, state, participants FROM multigres.transaction_log WHERE created_at < now() - make_interval(secs => 60) ORDER BY created_at",
+		"0:COMMIT PREPARED 'multigres:a'",
+		"1:COMMIT PREPARED 'multigres:a'",
+		":DELETE FROM multigres.transaction_log WHERE gid = 'multigres:a'",
+		"0:ROLLBACK PREPARED 'multigres:b'",
+		"1:ROLLBACK PREPARED 'multigres:b'",
+	}, gateway.executed)
+}
+
+func TestRecoverTransactionsSkipsResolved(t *testing.T) {
+	gateway := &fakeShardGateway{
+		failShard:  "1",
+		failPrefix: "COMMIT PREPARED",
+		failErr: sqlstate.NewError(sqlstate.UndefinedObject).
+			Msg(`prepared transaction with identifier "multigres:a" does not exist`).Build(),
+		queryResult: &sqltypes.Result{Rows: []*sqltypes.Row{
+			transactionLogRow("multigres:a", "commit"),
+		}},
+	}
+	sc := NewScatterConn(gateway, slog.Default())
+
+	require.NoError(t, sc.RecoverTransactions(context.Background(), "tg", time.Minute))
+	assert.Equal(t, ":DELETE FROM multigres.transaction_log WHERE gid = 'multigres:a'", gateway.executed[len(gateway.executed)-1])
+}
+
+func TestIsPreparedTransactionMissing(t *testing.T) {
+	missing := sqlstate.NewError(sqlstate.UndefinedObject).
+		Msg(`prepared transaction with identifier "multigres:a" does not exist`).Build()
+	assert.True(t, isPreparedTransactionMissing(missing))
+	assert.True(t, isPreparedTransactionMissing(fmt.Errorf("shard 0: %w", missing)))
+	// The message alone does not tell.
+	assert.False(t, isPreparedTransactionMissing(errors.New(`ERROR: prepared transaction with identifier "multigres:a" does not exist`)))
+	assert.False(t, isPreparedTransactionMissing(errors.New("shard unavailable")))
+}
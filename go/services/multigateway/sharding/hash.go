@@ -0,0 +1,176 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterFactory("hash", newHash)
+	RegisterFactory("consistent_hash", newConsistentHash)
+}
+
+// keyspaceID returns the 64-bit hash of a key: the first bytes of its
+// SHA-256, spread evenly even for short keys.
+func keyspaceID(key []byte) uint64 {
+	sum := sha256.Sum256(key)
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// keyRange is the range of keyspace IDs of a shard, start inclusive and end
+// exclusive, as big-endian byte prefixes. Empty bounds are unbounded.
+type keyRange struct {
+	shard      string
+	start, end []byte
+}
+
+// parseKeyRange parses the key range of a shard name, such as "-80",
+// "40-80" or "80-", made of the hex prefixes of its bounds.
+func parseKeyRange(shard string) (keyRange, error) {
+	start, end, ok := strings.Cut(shard, "-")
+	if !ok {
+		return keyRange{}, fmt.Errorf("shard %q is not a key range such as \"-80\" or \"80-\"", shard)
+	}
+	startBytes, err := hex.DecodeString(start)
+	if err != nil {
+		return keyRange{}, fmt.Errorf("invalid start of key range %q: %w", shard, err)
+	}
+	endBytes, err := hex.DecodeString(end)
+	if err != nil {
+		return keyRange{}, fmt.Errorf("invalid end of key range %q: %w", shard, err)
+	}
+	return keyRange{shard: shard, start: startBytes, end: endBytes}, nil
+}
+
+// contains returns true if the big-endian keyspace ID id is in the range.
+func (r keyRange) contains(id []byte) bool {
+	return bytes.Compare(id, r.start) >= 0 && (len(r.end) == 0 || bytes.Compare(id, r.end) < 0)
+}
+
+// hashFunction is the hash sharding function: the shards are key ranges
+// covering every keyspace ID.
+type hashFunction struct {
+	ranges []keyRange
+	first  string
+}
+
+// newHash creates the hash sharding function. The key ranges of the shards
+// must cover every keyspace ID without overlapping. It has no parameters.
+func newHash(shards []string, _ map[string]string) (ShardingFunction, error) {
+	ranges := make([]keyRange, len(shards))
+	for i, shard := range shards {
+		r, err := parseKeyRange(shard)
+		if err != nil {
+			return nil, err
+		}
+		ranges[i] = r
+	}
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b keyRange) int { return bytes.Compare(a.start, b.start) })
+	for i, r := range sorted {
+		if i == 0 && len(r.start) > 0 {
+			return nil, fmt.Errorf("key ranges start at %x, not at the first keyspace ID", r.start)
+		}
+		if i > 0 && !bytes.Equal(sorted[i-1].end, r.start) {
+			return nil, fmt.Errorf("key ranges %q and %q are not contiguous", sorted[i-1].shard, r.shard)
+		}
+		if i == len(sorted)-1 && len(r.end) > 0 {
+			return nil, fmt.Errorf("key ranges end at %x, not at the last keyspace ID", r.end)
+		}
+	}
+	return &hashFunction{ranges: sorted, first: shards[0]}, nil
+}
+
+// ShardForKey implements the ShardingFunction interface.
+func (f *hashFunction) ShardForKey(key []byte, isNull bool) (string, error) {
+	if isNull {
+		return f.first, nil
+	}
+	id := binary.BigEndian.AppendUint64(nil, keyspaceID(key))
+	for _, r := range f.ranges {
+		if r.contains(id) {
+			return r.shard, nil
+		}
+	}
+	return "", fmt.Errorf("no shard for keyspace ID %x", id)
+}
+
+// defaultRingPoints is the number of points of each shard on the ring of
+// the consistent_hash sharding function.
+const defaultRingPoints = 100
+
+// ringPoint is a point of a shard on the ring of hashes.
+type ringPoint struct {
+	hash  uint64
+	shard string
+}
+
+// consistentHashFunction is the consistent_hash sharding function: a key
+// belongs to the shard of the first point of the ring at or after its
+// hash.
+type consistentHashFunction struct {
+	ring  []ringPoint
+	first string
+}
+
+// newConsistentHash creates the consistent_hash sharding function. The
+// "points" parameter is the number of points of each shard on the ring,
+// 100 by default: more points balance the keys better.
+func newConsistentHash(shards []string, params map[string]string) (ShardingFunction, error) {
+	points := defaultRingPoints
+	if value, ok := params["points"]; ok {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid number of points %q: must be a positive integer", value)
+		}
+		points = n
+	}
+	ring := make([]ringPoint, 0, len(shards)*points)
+	for _, shard := range shards {
+		for i := range points {
+			ring = append(ring, ringPoint{hash: keyspaceID([]byte(shard + "#" + strconv.Itoa(i))), shard: shard})
+		}
+	}
+	slices.SortFunc(ring, func(a, b ringPoint) int {
+		if a.hash != b.hash {
+			return cmp.Compare(a.hash, b.hash)
+		}
+		return strings.Compare(a.shard, b.shard)
+	})
+	return &consistentHashFunction{ring: ring, first: shards[0]}, nil
+}
+
+// ShardForKey implements the ShardingFunction interface.
+func (f *consistentHashFunction) ShardForKey(key []byte, isNull bool) (string, error) {
+	if isNull {
+		return f.first, nil
+	}
+	h := keyspaceID(key)
+	i := sort.Search(len(f.ring), func(i int) bool { return f.ring[i].hash >= h })
+	if i == len(f.ring) {
+		i = 0
+	}
+	return f.ring[i].shard, nil
+}
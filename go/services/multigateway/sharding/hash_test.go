@@ -0,0 +1,102 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	f, err := New("hash", []string{"80-", "-80"}, nil)
+	require.NoError(t, err)
+
+	counts := make(map[string]int)
+	for i := range 1000 {
+		key := []byte(strconv.Itoa(i))
+		shard, err := f.ShardForKey(key, false)
+		require.NoError(t, err)
+		counts[shard]++
+
+		// The shard is that of the first byte of the keyspace ID.
+		want := "-80"
+		if keyspaceID(key) >= 1<<63 {
+			want = "80-"
+		}
+		assert.Equal(t, want, shard, "key %s", key)
+	}
+	assert.InDelta(t, 500, counts["-80"], 60)
+
+	shard, err := f.ShardForKey(nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, "80-", shard, "NULL keys belong to the first shard")
+}
+
+func TestHashKeyRanges(t *testing.T) {
+	for _, shards := range [][]string{
+		{"-40", "40-80", "80-c0", "c0-"},
+		{"-"},
+	} {
+		_, err := New("hash", shards, nil)
+		assert.NoError(t, err, "%v", shards)
+	}
+
+	for _, shards := range [][]string{
+		{"0"},
+		{"-80", "90-"},
+		{"-80", "80-c0"},
+		{"10-80", "80-"},
+		{"-zz", "zz-"},
+	} {
+		_, err := New("hash", shards, nil)
+		assert.Error(t, err, "%v", shards)
+	}
+}
+
+func TestConsistentHash(t *testing.T) {
+	keys := make([][]byte, 1000)
+	for i := range keys {
+		keys[i] = []byte(strconv.Itoa(i))
+	}
+	placement := func(shards ...string) map[string]string {
+		f, err := New("consistent_hash", shards, map[string]string{"points": "200"})
+		require.NoError(t, err)
+		placed := make(map[string]string)
+		for _, key := range keys {
+			shard, err := f.ShardForKey(key, false)
+			require.NoError(t, err)
+			placed[string(key)] = shard
+		}
+		return placed
+	}
+
+	before := placement("a", "b", "c")
+	after := placement("a", "b", "c", "d")
+	moved := 0
+	for key, shard := range after {
+		if shard != before[key] {
+			// Keys only move to the new shard.
+			assert.Equal(t, "d", shard)
+			moved++
+		}
+	}
+	assert.InDelta(t, 250, moved, 100)
+
+	_, err := New("consistent_hash", []string{"a"}, map[string]string{"points": "0"})
+	assert.Error(t, err)
+}
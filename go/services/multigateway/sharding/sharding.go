@@ -0,0 +1,92 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sharding provides the sharding functions placing the rows of
+// sharded tables on the shards of their tablegroup, from the values of
+// their shard key.
+//
+// The built-in functions are:
+//
+//   - hash: hashes the key onto a keyspace ID, and picks the shard whose key
+//     range, given by its name such as "-80" or "40-80", contains it.
+//   - consistent_hash: hashes the key onto a ring of points of the shards,
+//     so that adding a shard moves only the keys of the points it takes.
+//   - range: splits the key values at the boundaries given by the
+//     "boundaries" parameter, compared as integers or as text.
+//   - list: places the key values listed by the parameter named after each
+//     shard on that shard, and the others on the "default" shard.
+//
+// All of them place NULL keys on the first shard. Other functions are
+// plugged in with RegisterFactory, from the init function of a package
+// linked into the gateway.
+package sharding
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+)
+
+// ShardingFunction maps the shard key values of a sharded table to the
+// shards of its tablegroup. The keys are the text form of the values. A
+// ShardingFunction is an engine.ShardKeyRouter, and is set per table in the
+// sharding schema of the planner.
+type ShardingFunction interface {
+	// ShardForKey returns the shard of a key value. isNull is set if the
+	// key is NULL.
+	ShardForKey(key []byte, isNull bool) (string, error)
+}
+
+// Factory creates a ShardingFunction distributing the keys across shards,
+// the shards of a tablegroup, as configured by params.
+type Factory func(shards []string, params map[string]string) (ShardingFunction, error)
+
+// factories contains the registered factories of sharding functions.
+var factories = make(map[string]Factory)
+
+// RegisterFactory registers the Factory of a sharding function. If a
+// function with that name already exists, it will log.Fatal and exit. Call
+// this function in the 'init' function of the package implementing it.
+func RegisterFactory(name string, factory Factory) {
+	if factories[name] != nil {
+		log.Fatalf("Duplicate sharding.Factory registration for %v", name)
+	}
+	factories[name] = factory
+}
+
+// AvailableFunctions returns a sorted list of the registered sharding
+// functions.
+func AvailableFunctions() []string {
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// New creates the sharding function registered as name, distributing the
+// keys across shards as configured by params.
+func New(name string, shards []string, params map[string]string) (ShardingFunction, error) {
+	factory, ok := factories[name]
+	if !ok {
+		return nil, fmt.Errorf("sharding function '%s' not found. Available functions: %s", name, strings.Join(AvailableFunctions(), ", "))
+	}
+	if len(shards) == 0 {
+		return nil, errors.New("sharding functions need at least one shard")
+	}
+	return factory(shards, params)
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// firstShard is a custom sharding function placing every key on the first
+// shard.
+type firstShard struct {
+	shard string
+}
+
+func (f firstShard) ShardForKey([]byte, bool) (string, error) {
+	return f.shard, nil
+}
+
+func TestRegisterFactory(t *testing.T) {
+	RegisterFactory("test_first", func(shards []string, _ map[string]string) (ShardingFunction, error) {
+		return firstShard{shard: shards[0]}, nil
+	})
+	t.Cleanup(func() { delete(factories, "test_first") })
+
+	assert.Equal(t, []string{"consistent_hash", "hash", "list", "range", "test_first"}, AvailableFunctions())
+	f, err := New("test_first", []string{"a", "b"}, nil)
+	require.NoError(t, err)
+	shard, err := f.ShardForKey([]byte("1"), false)
+	require.NoError(t, err)
+	assert.Equal(t, "a", shard)
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New("modulo", []string{"-80", "80-"}, nil)
+	assert.ErrorContains(t, err, "sharding function 'modulo' not found. Available functions: consistent_hash, hash, list, range")
+
+	_, err = New("hash", nil, nil)
+	assert.ErrorContains(t, err, "at least one shard")
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+func init() {
+	RegisterFactory("range", newRange)
+	RegisterFactory("list", newList)
+}
+
+// rangeFunction is the range sharding function: shard i holds the keys from
+// boundary i-1, inclusive, to boundary i, exclusive.
+type rangeFunction struct {
+	shards     []string
+	boundaries []string
+	integer    bool
+}
+
+// newRange creates the range sharding function. The "boundaries" parameter
+// lists, separated by commas and in ascending order, the first key of each
+// shard but the first. The "type" parameter is "integer", the default, to
+// compare the keys as 64-bit integers, or "text" to compare them byte by
+// byte.
+func newRange(shards []string, params map[string]string) (ShardingFunction, error) {
+	f := &rangeFunction{shards: shards, integer: true}
+	switch params["type"] {
+	case "", "integer":
+	case "text":
+		f.integer = false
+	default:
+		return nil, fmt.Errorf("invalid range type %q: must be integer or text", params["type"])
+	}
+	if value := params["boundaries"]; value != "" {
+		f.boundaries = strings.Split(value, ",")
+	}
+	if len(f.boundaries) != len(shards)-1 {
+		return nil, fmt.Errorf("range sharding of %d shards needs %d boundaries, got %d", len(shards), len(shards)-1, len(f.boundaries))
+	}
+	for i, boundary := range f.boundaries {
+		if f.integer {
+			if _, err := strconv.ParseInt(boundary, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid integer boundary %q", boundary)
+			}
+		}
+		if i > 0 {
+			if c, _ := f.compare(f.boundaries[i-1], boundary); c >= 0 {
+				return nil, fmt.Errorf("boundaries %q and %q are not in ascending order", f.boundaries[i-1], boundary)
+			}
+		}
+	}
+	return f, nil
+}
+
+// compare compares a key with a boundary like strings.Compare.
+func (f *rangeFunction) compare(key, boundary string) (int, error) {
+	if !f.integer {
+		return strings.Compare(key, boundary), nil
+	}
+	k, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		return 0, sqlstate.NewError(sqlstate.InvalidTextRepresentation).
+			Msg("invalid integer shard key %q", key).
+			Err()
+	}
+	b, _ := strconv.ParseInt(boundary, 10, 64)
+	switch {
+	case k < b:
+		return -1, nil
+	case k > b:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// ShardForKey implements the ShardingFunction interface.
+func (f *rangeFunction) ShardForKey(key []byte, isNull bool) (string, error) {
+	if isNull {
+		return f.shards[0], nil
+	}
+	for i, boundary := range f.boundaries {
+		c, err := f.compare(string(key), boundary)
+		if err != nil {
+			return "", err
+		}
+		if c < 0 {
+			return f.shards[i], nil
+		}
+	}
+	return f.shards[len(f.shards)-1], nil
+}
+
+// listFunction is the list sharding function.
+type listFunction struct {
+	shards       map[string]string
+	defaultShard string
+	first        string
+}
+
+// newList creates the list sharding function. The parameter named after a
+// shard lists its keys, separated by commas. The "default" parameter names
+// the shard of the keys not listed, which are rejected without it.
+func newList(shards []string, params map[string]string) (ShardingFunction, error) {
+	f := &listFunction{shards: make(map[string]string), first: shards[0]}
+	for name, value := range params {
+		if name == "default" {
+			if !slices.Contains(shards, value) {
+				return nil, fmt.Errorf("default shard %q is not a shard of the tablegroup", value)
+			}
+			f.defaultShard = value
+			continue
+		}
+		if !slices.Contains(shards, name) {
+			return nil, fmt.Errorf("list parameter %q is not a shard of the tablegroup", name)
+		}
+		for _, key := range strings.Split(value, ",") {
+			if other, ok := f.shards[key]; ok && other != name {
+				return nil, fmt.Errorf("key %q is listed for shards %q and %q", key, other, name)
+			}
+			f.shards[key] = name
+		}
+	}
+	return f, nil
+}
+
+// ShardForKey implements the ShardingFunction interface.
+func (f *listFunction) ShardForKey(key []byte, isNull bool) (string, error) {
+	if isNull {
+		return f.first, nil
+	}
+	if shard, ok := f.shards[string(key)]; ok {
+		return shard, nil
+	}
+	if f.defaultShard != "" {
+		return f.defaultShard, nil
+	}
+	return "", sqlstate.NewError(sqlstate.CheckViolation).
+		Msg("shard key %q is not listed for any shard", key).
+		Err()
+}
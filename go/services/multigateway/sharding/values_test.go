@@ -0,0 +1,93 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/sqltypes"
+	"github.com/multigres/multigres/go/common/sqltypes/sqlstate"
+)
+
+// shardsOf returns the shards f places keys on.
+func shardsOf(t *testing.T, f ShardingFunction, keys ...string) []string {
+	t.Helper()
+	shards := make([]string, len(keys))
+	for i, key := range keys {
+		shard, err := f.ShardForKey([]byte(key), false)
+		require.NoError(t, err)
+		shards[i] = shard
+	}
+	return shards
+}
+
+func TestRange(t *testing.T) {
+	t.Run("integer", func(t *testing.T) {
+		f, err := New("range", []string{"a", "b", "c"}, map[string]string{"boundaries": "100,1000"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "a", "b", "b", "c"}, shardsOf(t, f, "-5", "99", "100", "999", "1000"))
+
+		_, err = f.ShardForKey([]byte("x"), false)
+		var diag *sqltypes.PgDiagnostic
+		require.ErrorAs(t, err, &diag)
+		assert.Equal(t, sqlstate.InvalidTextRepresentation, diag.Code)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		f, err := New("range", []string{"a", "b"}, map[string]string{"boundaries": "m", "type": "text"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "b"}, shardsOf(t, f, "alice", "m", "zoe"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, params := range []map[string]string{
+			{"boundaries": "100"},
+			{"boundaries": "100,10,1000"},
+			{"boundaries": "100,x"},
+			{"boundaries": "100,1000", "type": "date"},
+		} {
+			_, err := New("range", []string{"a", "b", "c"}, params)
+			assert.Error(t, err, "%v", params)
+		}
+	})
+}
+
+func TestList(t *testing.T) {
+	params := map[string]string{"eu": "fr,de", "us": "us,ca"}
+	f, err := New("list", []string{"eu", "us", "other"}, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu", "eu", "us"}, shardsOf(t, f, "fr", "de", "ca"))
+	_, err = f.ShardForKey([]byte("jp"), false)
+	var diag *sqltypes.PgDiagnostic
+	require.ErrorAs(t, err, &diag)
+	assert.Equal(t, sqlstate.CheckViolation, diag.Code)
+
+	params["default"] = "other"
+	f, err = New("list", []string{"eu", "us", "other"}, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other"}, shardsOf(t, f, "jp"))
+
+	for _, params := range []map[string]string{
+		{"asia": "jp"},
+		{"eu": "fr", "us": "fr"},
+		{"default": "asia"},
+	} {
+		_, err := New("list", []string{"eu", "us"}, params)
+		assert.Error(t, err, "%v", params)
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/multigres/multigres/go/common/preparedstatement"
 	"github.com/multigres/multigres/go/common/web"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
 )
 
 // PoolerStatus represents the status of a multipooler instance.
@@ -39,6 +40,7 @@ type PoolerStatus struct {
 type CellStatus struct {
 	Cell        string         `json:"cell"`
 	LastRefresh time.Time      `json:"last_refresh"`
+	Staleness   time.Duration  `json:"staleness"`
 	Poolers     []PoolerStatus `json:"poolers"`
 }
 
@@ -68,7 +70,7 @@ type Status struct {
 // handleIndex serves the index page
 func (mg *MultiGateway) handleIndex(w http.ResponseWriter, r *http.Request) {
 	ts := mg.ts.Status()
-	cellStatuses := mg.poolerDiscovery.GetCellStatusesForAdmin()
+	cells := mg.cellStatuses()
 
 	mg.serverStatus.mu.Lock()
 	defer mg.serverStatus.mu.Unlock()
@@ -76,11 +78,24 @@ func (mg *MultiGateway) handleIndex(w http.ResponseWriter, r *http.Request) {
 	mg.serverStatus.LocalCell = mg.cell.Get()
 	mg.serverStatus.ServiceID = mg.serviceID.Get()
 	mg.serverStatus.TopoStatus = ts
-	mg.serverStatus.Cells = make([]CellStatus, 0, len(cellStatuses))
+	mg.serverStatus.Cells = cells
+
+	err := web.Templates.ExecuteTemplate(w, "gateway_index.html", &mg.serverStatus)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// cellStatuses returns the poolers discovered in each cell.
+func (mg *MultiGateway) cellStatuses() []CellStatus {
+	cellStatuses := mg.poolerDiscovery.GetCellStatusesForAdmin()
+	cells := make([]CellStatus, 0, len(cellStatuses))
 	for _, cs := range cellStatuses {
 		cellStatus := CellStatus{
 			Cell:        cs.Cell,
 			LastRefresh: cs.LastRefresh,
+			Staleness:   cs.Staleness,
 			Poolers:     make([]PoolerStatus, 0, len(cs.Poolers)),
 		}
 		for _, pooler := range cs.Poolers {
@@ -90,13 +105,17 @@ func (mg *MultiGateway) handleIndex(w http.ResponseWriter, r *http.Request) {
 				Type:     pooler.GetType().String(),
 			})
 		}
-		mg.serverStatus.Cells = append(mg.serverStatus.Cells, cellStatus)
+		cells = append(cells, cellStatus)
 	}
+	return cells
+}
 
-	err := web.Templates.ExecuteTemplate(w, "gateway_index.html", &mg.serverStatus)
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to execute template: %v", err), http.StatusInternalServerError)
-		return
+// poolStats returns the connections of the gateway to the poolers.
+func (mg *MultiGateway) poolStats() PoolStats {
+	return PoolStats{
+		PoolerConnections: mg.poolerGateway.ConnectionCount(),
+		PoolersDiscovered: mg.poolerDiscovery.PoolerCount(),
+		Replicas:          replicaStatuses(mg.poolerGateway.ReplicaHealth()),
 	}
 }
 
@@ -115,6 +134,18 @@ func (mg *MultiGateway) handleReady(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleTopoRefresh lists the cells and reads their poolers from the
+// topology again, for when the cached poolers are suspected to be wrong.
+func (mg *MultiGateway) handleTopoRefresh(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	mg.poolerDiscovery.Refresh()
+	w.WriteHeader(http.StatusAccepted)
+}
+
 // ConsolidatorDebugStatus contains data for the consolidator debug page.
 type ConsolidatorDebugStatus struct {
 	Title string
@@ -143,3 +174,32 @@ func (mg *MultiGateway) handleConsolidatorDebug(w http.ResponseWriter, r *http.R
 		return
 	}
 }
+
+// SessionsDebugStatus contains data for the sessions debug page.
+type SessionsDebugStatus struct {
+	Title    string
+	Sessions []handler.SessionInfo
+}
+
+// handleSessionsDebug serves the open client sessions page.
+func (mg *MultiGateway) handleSessionsDebug(w http.ResponseWriter, r *http.Request) {
+	sessions := mg.pgHandler.Sessions()
+
+	// Check if JSON format is requested
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(sessions); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	status := SessionsDebugStatus{
+		Title:    "Sessions",
+		Sessions: sessions,
+	}
+	if err := web.Templates.ExecuteTemplate(w, "sessions_debug.html", &status); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServerTLSConfig(t *testing.T) {
+	config, err := newServerTLSConfig("", "", "")
+	require.NoError(t, err)
+	assert.Nil(t, config, "TLS is disabled without a certificate")
+
+	_, err = newServerTLSConfig("server.crt", "", "")
+	assert.ErrorContains(t, err, "must be set together")
+
+	_, err = newServerTLSConfig("", "", "ca.crt")
+	assert.ErrorContains(t, err, "requires pg-tls-cert-file")
+
+	_, err = newServerTLSConfig("missing.crt", "missing.key", "")
+	assert.ErrorContains(t, err, "failed to load server certificate")
+}
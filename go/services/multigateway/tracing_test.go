@@ -0,0 +1,150 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multigateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/executor"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/planner"
+	"github.com/multigres/multigres/go/services/multigateway/scatterconn"
+	"github.com/multigres/multigres/go/tools/telemetry"
+)
+
+// tracedShards is a gateway answering the queries of every shard but
+// failShard with a single row.
+type tracedShards struct {
+	queryservice.QueryService
+
+	failShard string
+}
+
+func (s *tracedShards) QueryServiceByID(context.Context, *clustermetadatapb.ID, *query.Target) (queryservice.QueryService, error) {
+	return s, nil
+}
+
+func (s *tracedShards) StreamExecute(
+	ctx context.Context,
+	target *query.Target,
+	_ string,
+	_ *query.ExecuteOptions,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	if target.Shard == s.failShard {
+		return errors.New("shard unavailable")
+	}
+	return callback(ctx, &sqltypes.Result{
+		Fields:     []*query.Field{{Name: "id", DataTypeOid: 23}},
+		Rows:       []*sqltypes.Row{sqltypes.NewRowBuilder(nil).Int64(1).MustBuild()},
+		CommandTag: "SELECT 1",
+	})
+}
+
+// spansByName indexes the ended spans by name, keeping every span of a name.
+func spansByName(spans []sdktrace.ReadOnlySpan) map[string][]sdktrace.ReadOnlySpan {
+	byName := map[string][]sdktrace.ReadOnlySpan{}
+	for _, span := range spans {
+		byName[span.Name()] = append(byName[span.Name()], span)
+	}
+	return byName
+}
+
+func TestQuerySpans(t *testing.T) {
+	schema, err := planner.ParseShardingSchemaConfig([]byte(`
+shards: ["-80", "80-"]
+tables:
+  users:
+    column: id
+`))
+	require.NoError(t, err)
+	sharding, err := schema.Build()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		failShard string
+	}{
+		{name: "scatter"},
+		{name: "failed shard", failShard: "80-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := telemetry.SetupSpanRecorder(t)
+			sc := scatterconn.NewScatterConn(&tracedShards{failShard: tt.failShard}, slog.Default())
+			exec := executor.NewExecutor(sc, slog.Default(), 0)
+			exec.SetShardingSchema(sharding)
+			h := handler.NewMultiGatewayHandler(exec, slog.Default())
+			conn := server.NewTestConn(&bytes.Buffer{}).Conn
+
+			err := h.HandleQuery(t.Context(), conn, "SELECT * FROM users", func(context.Context, *sqltypes.Result) error {
+				return nil
+			})
+			if tt.failShard != "" {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			spans := spansByName(recorder.Ended())
+			require.Len(t, spans["multigateway/query"], 1)
+			require.Len(t, spans["multigateway/plan"], 1)
+			require.Len(t, spans["scatterconn/scatter"], 1)
+			// A failed shard may cancel the other before it starts.
+			require.NotEmpty(t, spans["scatterconn/shard"])
+			root := spans["multigateway/query"][0]
+			scatter := spans["scatterconn/scatter"][0]
+			assert.False(t, root.Parent().IsValid())
+			assert.Equal(t, root.SpanContext().SpanID(), spans["multigateway/plan"][0].Parent().SpanID())
+			assert.Equal(t, root.SpanContext().SpanID(), scatter.Parent().SpanID())
+			for _, shard := range spans["scatterconn/shard"] {
+				assert.Equal(t, scatter.SpanContext().SpanID(), shard.Parent().SpanID())
+				assert.Equal(t, root.SpanContext().TraceID(), shard.SpanContext().TraceID())
+			}
+
+			if tt.failShard == "" {
+				assert.Len(t, spans["scatterconn/shard"], 2)
+				for _, span := range recorder.Ended() {
+					assert.Equal(t, codes.Unset, span.Status().Code, span.Name())
+				}
+				return
+			}
+			assert.Equal(t, codes.Error, root.Status().Code)
+			assert.Equal(t, codes.Error, scatter.Status().Code)
+			failed := 0
+			for _, shard := range spans["scatterconn/shard"] {
+				if shard.Status().Code == codes.Error {
+					failed++
+					assert.Contains(t, shard.Status().Description, "shard unavailable")
+				}
+			}
+			assert.Equal(t, 1, failed)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	commontypes "github.com/multigres/multigres/go/common/types"
+)
+
+// RunStatus represents the possible outcomes of a scheduled backup.
+type RunStatus string
+
+const (
+	RunStatusSuccess RunStatus = "success"
+	RunStatusFailure RunStatus = "failure"
+)
+
+// Metrics holds the OpenTelemetry metrics of the backup scheduler.
+type Metrics struct {
+	meter          metric.Meter
+	runDuration    RunDuration
+	lastSuccessAge LastSuccessAge
+	stale          Stale
+}
+
+// RunDuration wraps a Float64Histogram for recording scheduled backup durations.
+type RunDuration struct {
+	metric.Float64Histogram
+}
+
+// Record records the duration of a scheduled backup of a shard.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - val: How long the backup took (in seconds)
+//   - shardKey: The shard that was backed up
+//   - status: The backup outcome (success or failure)
+func (m RunDuration) Record(ctx context.Context, val float64, shardKey commontypes.ShardKey, status RunStatus) {
+	m.Float64Histogram.Record(ctx, val,
+		metric.WithAttributes(
+			append(shardAttributes(shardKey), attribute.String("status", string(status)))...,
+		))
+}
+
+// LastSuccessAge wraps a Float64ObservableGauge for observing the time since
+// the last good backup of each shard.
+type LastSuccessAge struct {
+	metric.Float64ObservableGauge
+}
+
+// Inst returns the underlying metric instrument for callback registration.
+func (m LastSuccessAge) Inst() metric.Float64ObservableGauge {
+	return m.Float64ObservableGauge
+}
+
+// Stale wraps an Int64ObservableGauge for observing which shards have no
+// good backup within the alert threshold.
+type Stale struct {
+	metric.Int64ObservableGauge
+}
+
+// Inst returns the underlying metric instrument for callback registration.
+func (m Stale) Inst() metric.Int64ObservableGauge {
+	return m.Int64ObservableGauge
+}
+
+// NewMetrics initializes OpenTelemetry metrics for the backup scheduler.
+// Individual metrics that fail to initialize will use noop implementations and be included
+// in the returned error.
+func NewMetrics() (*Metrics, error) {
+	m := &Metrics{
+		meter: otel.Meter("github.com/multigres/multigres/go/services/multiorch/backup"),
+	}
+
+	var errs []error
+
+	runDurationHistogram, err := m.meter.Float64Histogram(
+		"multiorch.backup.run.duration",
+		metric.WithDescription("Duration of scheduled backups"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multiorch.backup.run.duration histogram: %w", err))
+		m.runDuration = RunDuration{noop.Float64Histogram{}}
+	} else {
+		m.runDuration = RunDuration{runDurationHistogram}
+	}
+
+	lastSuccessAgeGauge, err := m.meter.Float64ObservableGauge(
+		"multiorch.backup.last_success.age",
+		metric.WithDescription("Time since the last good backup of the shard"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multiorch.backup.last_success.age gauge: %w", err))
+		m.lastSuccessAge = LastSuccessAge{noop.Float64ObservableGauge{}}
+	} else {
+		m.lastSuccessAge = LastSuccessAge{lastSuccessAgeGauge}
+	}
+
+	staleGauge, err := m.meter.Int64ObservableGauge(
+		"multiorch.backup.stale",
+		metric.WithDescription("Whether the shard has no good backup within the backup-max-age alert threshold"),
+		metric.WithUnit("{shard}"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multiorch.backup.stale gauge: %w", err))
+		m.stale = Stale{noop.Int64ObservableGauge{}}
+	} else {
+		m.stale = Stale{staleGauge}
+	}
+
+	if len(errs) > 0 {
+		return m, errors.Join(errs...)
+	}
+	return m, nil
+}
+
+// RegisterShardStatusCallback registers a callback observing the backup
+// age and staleness of every shard returned by the getter.
+// Returns an error if callback registration fails.
+func (m *Metrics) RegisterShardStatusCallback(getter func() []ShardStatus) error {
+	if getter == nil {
+		return nil
+	}
+	_, err := m.meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			for _, status := range getter() {
+				attrs := metric.WithAttributes(shardAttributes(status.ShardKey)...)
+				if !status.LastGoodBackup.IsZero() {
+					observer.ObserveFloat64(m.lastSuccessAge.Inst(), status.Age.Seconds(), attrs)
+				}
+				var stale int64
+				if status.Stale {
+					stale = 1
+				}
+				observer.ObserveInt64(m.stale.Inst(), stale, attrs)
+			}
+			return nil
+		},
+		m.lastSuccessAge.Inst(),
+		m.stale.Inst(),
+	)
+	return err
+}
+
+func shardAttributes(shardKey commontypes.ShardKey) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("db.namespace", shardKey.Database),
+		attribute.String("tablegroup", shardKey.TableGroup),
+		attribute.String("shard", shardKey.Shard),
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: "", wantErr: "expected 5 fields"},
+		{expr: "0 2 * *", wantErr: "expected 5 fields"},
+		{expr: "60 * * * *", wantErr: "minute: value 60 out of range"},
+		{expr: "0 24 * * *", wantErr: "hour: value 24 out of range"},
+		{expr: "0 0 0 * *", wantErr: "day of month: value 0 out of range"},
+		{expr: "0 0 * 13 *", wantErr: "month: value 13 out of range"},
+		{expr: "0 0 * * 8", wantErr: "day of week: value 8 out of range"},
+		{expr: "*/0 * * * *", wantErr: "invalid step"},
+		{expr: "5-1 * * * *", wantErr: "invalid range"},
+		{expr: "a * * * *", wantErr: "invalid value"},
+		{expr: "@yearly", wantErr: "expected 5 fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseSchedule(tt.expr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// A Friday
+	base := time.Date(2026, 10, 16, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			from: base,
+			want: time.Date(2026, 10, 16, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "daily later today",
+			expr: "0 22 * * *",
+			from: base,
+			want: time.Date(2026, 10, 16, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daily already passed",
+			expr: "@daily",
+			from: base,
+			want: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "strictly after a matching time",
+			expr: "30 10 * * *",
+			from: time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC),
+			want: time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "steps",
+			expr: "*/20 */6 * * *",
+			from: base,
+			want: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "list and range",
+			expr: "15,45 9-11 * * *",
+			from: base,
+			want: time.Date(2026, 10, 16, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "weekday range skips the weekend",
+			expr: "0 1 * * 1-5",
+			from: base,
+			want: time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 3 * * 7",
+			from: base,
+			want: time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monthly rolls over the year",
+			expr: "0 0 1 1 *",
+			from: base,
+			want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 20 * 0",
+			from: base,
+			want: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "never matches",
+			expr: "0 0 30 2 *",
+			from: base,
+			want: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(tt.from))
+		})
+	}
+}
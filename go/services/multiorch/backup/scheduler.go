@@ -0,0 +1,522 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup schedules backups of the shards watched by multiorch and
+// reports how recent their last good backup is.
+//
+// Backups are taken on a healthy replica of each shard, never on the
+// primary, so that they don't add load to it. A shard whose last good
+// backup is older than the backup-max-age threshold is reported as stale.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multigres/multigres/go/common/rpcclient"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiorchdatapb "github.com/multigres/multigres/go/pb/multiorchdata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+	"github.com/multigres/multigres/go/services/multiorch/config"
+	"github.com/multigres/multigres/go/services/multiorch/store"
+	"github.com/multigres/multigres/go/tools/timer"
+)
+
+// Scheduler takes the scheduled backups of the shards in the pooler store
+// and tracks their outcome per shard.
+type Scheduler struct {
+	config      *config.Config
+	logger      *slog.Logger
+	rpcClient   rpcclient.MultiPoolerClient
+	poolerStore *store.PoolerHealthStore
+	metrics     *Metrics
+	runner      *timer.PeriodicRunner
+
+	// now is overridden in tests.
+	now func() time.Time
+
+	mu     sync.Mutex // protects shards
+	shards map[commontypes.ShardKey]*shardState
+
+	shutdownCtx context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+}
+
+// shardState is the backup state of one shard.
+type shardState struct {
+	// schedule is the expression nextRun was computed from.
+	schedule string
+	nextRun  time.Time
+	running  bool
+
+	// catalogLoaded is set once the last good backup has been read from
+	// the backup repository, which happens once per shard.
+	catalogLoaded bool
+
+	lastGoodBackup      time.Time
+	lastBackupID        string
+	lastFailure         time.Time
+	lastError           string
+	consecutiveFailures int
+	successes           int
+	failures            int
+	stale               bool
+}
+
+// ShardStatus is a snapshot of the backup state of a shard.
+type ShardStatus struct {
+	ShardKey            commontypes.ShardKey
+	Schedule            string
+	NextRun             time.Time
+	Running             bool
+	LastGoodBackup      time.Time
+	LastBackupID        string
+	Age                 time.Duration
+	Stale               bool
+	Successes           int
+	Failures            int
+	ConsecutiveFailures int
+	LastFailure         time.Time
+	LastError           string
+}
+
+// NewScheduler creates a backup scheduler for the poolers in the store.
+func NewScheduler(
+	config *config.Config,
+	logger *slog.Logger,
+	rpcClient rpcclient.MultiPoolerClient,
+	poolerStore *store.PoolerHealthStore,
+) *Scheduler {
+	ctx, cancel := context.WithCancel(context.TODO())
+	s := &Scheduler{
+		config:      config,
+		logger:      logger,
+		rpcClient:   rpcClient,
+		poolerStore: poolerStore,
+		runner:      timer.NewPeriodicRunner(ctx, config.GetBackupCheckInterval()),
+		now:         time.Now,
+		shards:      make(map[commontypes.ShardKey]*shardState),
+		shutdownCtx: ctx,
+		cancel:      cancel,
+	}
+
+	var err error
+	s.metrics, err = NewMetrics()
+	if err != nil {
+		logger.Error("failed to initialize backup metrics", "error", err)
+	}
+	if err := s.metrics.RegisterShardStatusCallback(s.Status); err != nil {
+		logger.Error("failed to register backup status callback", "error", err)
+	}
+	return s
+}
+
+// Start starts checking the backup schedules periodically.
+func (s *Scheduler) Start() {
+	s.logger.Info("starting backup scheduler",
+		"backup_schedule", s.config.GetBackupSchedule(),
+		"backup_shard_schedules", s.config.GetBackupShardSchedules(),
+		"backup_type", s.config.GetBackupType(),
+		"backup_check_interval", s.config.GetBackupCheckInterval(),
+		"backup_max_age", s.config.GetBackupMaxAge(),
+	)
+	s.runner.Start(func(ctx context.Context) {
+		s.runCycle(ctx)
+	}, nil)
+}
+
+// Stop stops the scheduler and waits for running backups to be cancelled.
+func (s *Scheduler) Stop() {
+	s.logger.Info("stopping backup scheduler")
+	s.cancel()
+	s.runner.Stop()
+	s.wg.Wait()
+}
+
+// runCycle starts the backups that are due and refreshes the health of
+// every shard.
+func (s *Scheduler) runCycle(ctx context.Context) {
+	now := s.now()
+	schedules := s.loadSchedules()
+	shards := s.shardPoolers()
+
+	var toLoad, toBackup []commontypes.ShardKey
+
+	s.mu.Lock()
+	for key := range s.shards {
+		if _, ok := shards[key]; !ok {
+			delete(s.shards, key)
+		}
+	}
+	for key := range shards {
+		st, ok := s.shards[key]
+		if !ok {
+			st = &shardState{}
+			s.shards[key] = st
+		}
+		if !st.catalogLoaded {
+			toLoad = append(toLoad, key)
+		}
+
+		expr, schedule := schedules.forShard(key)
+		if schedule == nil {
+			st.schedule = ""
+			st.nextRun = time.Time{}
+			continue
+		}
+		if expr != st.schedule {
+			st.schedule = expr
+			st.nextRun = schedule.Next(now)
+		}
+		if st.running || now.Before(st.nextRun) {
+			continue
+		}
+		st.nextRun = schedule.Next(now)
+		st.running = true
+		toBackup = append(toBackup, key)
+	}
+	s.mu.Unlock()
+
+	for _, key := range toLoad {
+		s.loadLastGoodBackup(ctx, key, shards[key])
+	}
+
+	for _, key := range toBackup {
+		poolers := shards[key]
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.runBackup(key, poolers)
+		}()
+	}
+
+	s.updateStaleness(now)
+}
+
+// shardPoolers groups the poolers of the store by shard.
+func (s *Scheduler) shardPoolers() map[commontypes.ShardKey][]*multiorchdatapb.PoolerHealthState {
+	shards := make(map[commontypes.ShardKey][]*multiorchdatapb.PoolerHealthState)
+	s.poolerStore.Range(func(_ string, pooler *multiorchdatapb.PoolerHealthState) bool {
+		if pooler == nil || pooler.MultiPooler == nil || pooler.MultiPooler.Id == nil {
+			return true
+		}
+		key := commontypes.ShardKey{
+			Database:   pooler.MultiPooler.Database,
+			TableGroup: pooler.MultiPooler.TableGroup,
+			Shard:      pooler.MultiPooler.Shard,
+		}
+		shards[key] = append(shards[key], pooler)
+		return true
+	})
+	return shards
+}
+
+// loadLastGoodBackup reads the most recent complete backup of a shard from
+// its backup repository, so that the age of the last good backup is known
+// before the scheduler takes one itself.
+func (s *Scheduler) loadLastGoodBackup(ctx context.Context, key commontypes.ShardKey, poolers []*multiorchdatapb.PoolerHealthState) {
+	for _, pooler := range poolers {
+		if !pooler.IsLastCheckValid {
+			continue
+		}
+		resp, err := s.rpcClient.GetBackups(ctx, pooler.MultiPooler, &multipoolermanagerdatapb.GetBackupsRequest{})
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to list backups of shard",
+				"shard_key", key.String(),
+				"pooler", pooler.MultiPooler.Id.Name,
+				"error", err,
+			)
+			continue
+		}
+
+		var latest *multipoolermanagerdatapb.BackupMetadata
+		for _, b := range resp.Backups {
+			if b.Status != multipoolermanagerdatapb.BackupMetadata_COMPLETE || b.CompletedAt == nil {
+				continue
+			}
+			if latest == nil || b.CompletedAt.AsTime().After(latest.CompletedAt.AsTime()) {
+				latest = b
+			}
+		}
+
+		s.recordCatalog(key, latest)
+		return
+	}
+}
+
+// recordCatalog records that the backup repository of a shard has been
+// read, along with its most recent complete backup if any.
+func (s *Scheduler) recordCatalog(key commontypes.ShardKey, latest *multipoolermanagerdatapb.BackupMetadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.shards[key]
+	if !ok {
+		return
+	}
+	st.catalogLoaded = true
+	if latest != nil && latest.CompletedAt.AsTime().After(st.lastGoodBackup) {
+		st.lastGoodBackup = latest.CompletedAt.AsTime()
+		st.lastBackupID = latest.BackupId
+	}
+}
+
+// runBackup takes a backup of the shard on one of its replicas.
+func (s *Scheduler) runBackup(key commontypes.ShardKey, poolers []*multiorchdatapb.PoolerHealthState) {
+	ctx, cancel := context.WithTimeout(s.shutdownCtx, s.config.GetBackupTimeout())
+	defer cancel()
+
+	start := s.now()
+	backupID, err := s.backupOnReplica(ctx, key, poolers)
+	end := s.now()
+
+	status := RunStatusSuccess
+	if err != nil {
+		status = RunStatusFailure
+	}
+	s.metrics.runDuration.Record(ctx, end.Sub(start).Seconds(), key, status)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.shards[key]
+	if !ok {
+		st = &shardState{}
+		s.shards[key] = st
+	}
+	st.running = false
+	if err != nil {
+		st.failures++
+		st.consecutiveFailures++
+		st.lastFailure = end
+		st.lastError = err.Error()
+		s.logger.Error("scheduled backup failed",
+			"shard_key", key.String(),
+			"consecutive_failures", st.consecutiveFailures,
+			"error", err,
+		)
+		return
+	}
+	st.successes++
+	st.consecutiveFailures = 0
+	st.lastGoodBackup = end
+	st.lastBackupID = backupID
+	s.logger.Info("scheduled backup completed",
+		"shard_key", key.String(),
+		"backup_id", backupID,
+		"duration", end.Sub(start),
+	)
+}
+
+func (s *Scheduler) backupOnReplica(ctx context.Context, key commontypes.ShardKey, poolers []*multiorchdatapb.PoolerHealthState) (string, error) {
+	replica := selectBackupReplica(poolers)
+	if replica == nil {
+		return "", fmt.Errorf("no healthy replica available to back up shard %s", key)
+	}
+
+	s.logger.InfoContext(ctx, "starting scheduled backup",
+		"shard_key", key.String(),
+		"pooler", replica.MultiPooler.Id.Name,
+		"backup_type", s.config.GetBackupType(),
+	)
+	resp, err := s.rpcClient.Backup(ctx, replica.MultiPooler, &multipoolermanagerdatapb.BackupRequest{
+		ForcePrimary: false,
+		Type:         s.config.GetBackupType(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("backup on %s failed: %w", replica.MultiPooler.Id.Name, err)
+	}
+	return resp.BackupId, nil
+}
+
+// selectBackupReplica returns the healthy, replicating replica with the
+// least replication lag, or nil if there is none.
+func selectBackupReplica(poolers []*multiorchdatapb.PoolerHealthState) *multiorchdatapb.PoolerHealthState {
+	var best *multiorchdatapb.PoolerHealthState
+	for _, pooler := range poolers {
+		if !pooler.IsLastCheckValid || !pooler.IsPostgresRunning ||
+			pooler.PoolerType != clustermetadatapb.PoolerType_REPLICA ||
+			pooler.ReplicationStatus == nil {
+			continue
+		}
+		if best == nil || pooler.ReplicationStatus.Lag.AsDuration() < best.ReplicationStatus.Lag.AsDuration() {
+			best = pooler
+		}
+	}
+	return best
+}
+
+// updateStaleness flags the shards whose last good backup is older than
+// the alert threshold, logging when a shard becomes stale or recovers.
+func (s *Scheduler) updateStaleness(now time.Time) {
+	maxAge := s.config.GetBackupMaxAge()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, st := range s.shards {
+		stale := isStale(st, now, maxAge)
+		if stale == st.stale {
+			continue
+		}
+		st.stale = stale
+		if stale {
+			s.logger.Warn("shard has no good backup within the alert threshold",
+				"shard_key", key.String(),
+				"last_good_backup", st.lastGoodBackup,
+				"backup_max_age", maxAge,
+			)
+		} else {
+			s.logger.Info("shard has a good backup within the alert threshold again",
+				"shard_key", key.String(),
+				"last_good_backup", st.lastGoodBackup,
+			)
+		}
+	}
+}
+
+// isStale returns true if the shard has no good backup within maxAge. A
+// shard whose catalog has not been read yet is not considered stale.
+func isStale(st *shardState, now time.Time, maxAge time.Duration) bool {
+	if maxAge <= 0 || (!st.catalogLoaded && st.lastGoodBackup.IsZero()) {
+		return false
+	}
+	return st.lastGoodBackup.IsZero() || now.Sub(st.lastGoodBackup) > maxAge
+}
+
+// Status returns the backup state of every shard, sorted by shard.
+func (s *Scheduler) Status() []ShardStatus {
+	now := s.now()
+	maxAge := s.config.GetBackupMaxAge()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]ShardStatus, 0, len(s.shards))
+	for key, st := range s.shards {
+		status := ShardStatus{
+			ShardKey:            key,
+			Schedule:            st.schedule,
+			NextRun:             st.nextRun,
+			Running:             st.running,
+			LastGoodBackup:      st.lastGoodBackup,
+			LastBackupID:        st.lastBackupID,
+			Stale:               isStale(st, now, maxAge),
+			Successes:           st.successes,
+			Failures:            st.failures,
+			ConsecutiveFailures: st.consecutiveFailures,
+			LastFailure:         st.lastFailure,
+			LastError:           st.lastError,
+		}
+		if !st.lastGoodBackup.IsZero() {
+			status.Age = now.Sub(st.lastGoodBackup)
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ShardKey.String() < statuses[j].ShardKey.String()
+	})
+	return statuses
+}
+
+// schedules holds the parsed backup schedules: the default one and the
+// per-target overrides.
+type schedules struct {
+	defaultExpr string
+	defaultSch  *Schedule
+	overrides   []scheduleOverride
+}
+
+type scheduleOverride struct {
+	target   config.WatchTarget
+	expr     string
+	schedule *Schedule
+}
+
+// loadSchedules parses the configured schedules. Invalid schedules are
+// logged and ignored.
+func (s *Scheduler) loadSchedules() schedules {
+	var result schedules
+	if expr := s.config.GetBackupSchedule(); expr != "" {
+		schedule, err := ParseSchedule(expr)
+		if err != nil {
+			s.logger.Error("ignoring invalid backup schedule", "error", err)
+		} else {
+			result.defaultExpr = expr
+			result.defaultSch = schedule
+		}
+	}
+
+	for _, entry := range s.config.GetBackupShardSchedules() {
+		override, err := parseScheduleOverride(entry)
+		if err != nil {
+			s.logger.Error("ignoring invalid backup shard schedule", "entry", entry, "error", err)
+			continue
+		}
+		result.overrides = append(result.overrides, override)
+	}
+	return result
+}
+
+// parseScheduleOverride parses a "target=schedule" entry, where target is
+// a database, database/tablegroup or database/tablegroup/shard.
+func parseScheduleOverride(entry string) (scheduleOverride, error) {
+	targetStr, expr, ok := strings.Cut(entry, "=")
+	if !ok {
+		return scheduleOverride{}, fmt.Errorf("invalid backup shard schedule %q: expected target=schedule", entry)
+	}
+	target, err := config.ParseShardWatchTarget(strings.TrimSpace(targetStr))
+	if err != nil {
+		return scheduleOverride{}, fmt.Errorf("invalid backup shard schedule %q: %w", entry, err)
+	}
+	schedule, err := ParseSchedule(expr)
+	if err != nil {
+		return scheduleOverride{}, err
+	}
+	return scheduleOverride{target: target, expr: strings.TrimSpace(expr), schedule: schedule}, nil
+}
+
+// forShard returns the schedule of a shard: the most specific override
+// matching it, or the default schedule. It returns a nil schedule if the
+// shard has no scheduled backups.
+func (s schedules) forShard(key commontypes.ShardKey) (string, *Schedule) {
+	var best *scheduleOverride
+	for i := range s.overrides {
+		o := &s.overrides[i]
+		if !o.target.MatchesShard(key.Database, key.TableGroup, key.Shard) {
+			continue
+		}
+		if best == nil || specificity(o.target) > specificity(best.target) {
+			best = o
+		}
+	}
+	if best != nil {
+		return best.expr, best.schedule
+	}
+	return s.defaultExpr, s.defaultSch
+}
+
+func specificity(t config.WatchTarget) int {
+	switch {
+	case t.Shard != "":
+		return 3
+	case t.TableGroup != "":
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/multigres/multigres/go/common/rpcclient"
+	"github.com/multigres/multigres/go/common/topoclient"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiorchdatapb "github.com/multigres/multigres/go/pb/multiorchdata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+	"github.com/multigres/multigres/go/services/multiorch/config"
+	"github.com/multigres/multigres/go/services/multiorch/store"
+)
+
+var testShard = commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "0"}
+
+func newTestPooler(name string, poolerType clustermetadatapb.PoolerType, lag time.Duration) *multiorchdatapb.PoolerHealthState {
+	pooler := &multiorchdatapb.PoolerHealthState{
+		MultiPooler: &clustermetadatapb.MultiPooler{
+			Id: &clustermetadatapb.ID{
+				Component: clustermetadatapb.ID_MULTIPOOLER,
+				Cell:      "cell1",
+				Name:      name,
+			},
+			Database:   testShard.Database,
+			TableGroup: testShard.TableGroup,
+			Shard:      testShard.Shard,
+			Type:       poolerType,
+		},
+		IsLastCheckValid:  true,
+		IsPostgresRunning: true,
+		PoolerType:        poolerType,
+	}
+	if poolerType == clustermetadatapb.PoolerType_REPLICA {
+		pooler.ReplicationStatus = &multipoolermanagerdatapb.StandbyReplicationStatus{
+			Lag: durationpb.New(lag),
+		}
+	}
+	return pooler
+}
+
+func newTestScheduler(t *testing.T, client *rpcclient.FakeClient, poolers []*multiorchdatapb.PoolerHealthState, opts ...func(*config.Config)) (*Scheduler, *time.Time) {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	poolerStore := store.NewPoolerHealthStore()
+	for _, p := range poolers {
+		poolerStore.Set(topoclient.MultiPoolerIDString(p.MultiPooler.Id), p)
+	}
+	s := NewScheduler(config.NewTestConfig(opts...), logger, client, poolerStore)
+	t.Cleanup(s.Stop)
+
+	now := time.Date(2026, 10, 16, 1, 59, 30, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestScheduler_RunsBackupOnReplica(t *testing.T) {
+	client := rpcclient.NewFakeClient()
+	primary := newTestPooler("primary", clustermetadatapb.PoolerType_PRIMARY, 0)
+	laggingReplica := newTestPooler("replica-lagging", clustermetadatapb.PoolerType_REPLICA, 10*time.Second)
+	replica := newTestPooler("replica", clustermetadatapb.PoolerType_REPLICA, 100*time.Millisecond)
+	client.BackupResponses[topoclient.MultiPoolerIDString(replica.MultiPooler.Id)] = &multipoolermanagerdatapb.BackupResponse{
+		BackupId: "20261016-020000F",
+	}
+
+	s, now := newTestScheduler(t, client, []*multiorchdatapb.PoolerHealthState{primary, laggingReplica, replica},
+		config.WithBackupSchedule("0 2 * * *"))
+	ctx := t.Context()
+
+	// Before the scheduled time only the backup catalog is read
+	s.runCycle(ctx)
+	s.wg.Wait()
+	status := s.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, testShard, status[0].ShardKey)
+	assert.Equal(t, "0 2 * * *", status[0].Schedule)
+	assert.Equal(t, time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC), status[0].NextRun)
+	assert.Zero(t, status[0].Successes)
+	assert.NotContains(t, client.GetCallLog(), "Backup("+topoclient.MultiPoolerIDString(replica.MultiPooler.Id)+")")
+
+	*now = time.Date(2026, 10, 16, 2, 0, 10, 0, time.UTC)
+	s.runCycle(ctx)
+	s.wg.Wait()
+
+	assert.Contains(t, client.GetCallLog(), "Backup("+topoclient.MultiPoolerIDString(replica.MultiPooler.Id)+")")
+	assert.NotContains(t, client.GetCallLog(), "Backup("+topoclient.MultiPoolerIDString(primary.MultiPooler.Id)+")")
+	status = s.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, 1, status[0].Successes)
+	assert.Equal(t, "20261016-020000F", status[0].LastBackupID)
+	assert.Equal(t, *now, status[0].LastGoodBackup)
+	assert.Equal(t, time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC), status[0].NextRun)
+	assert.False(t, status[0].Stale)
+}
+
+func TestScheduler_RecordsFailures(t *testing.T) {
+	t.Run("no healthy replica", func(t *testing.T) {
+		client := rpcclient.NewFakeClient()
+		primary := newTestPooler("primary", clustermetadatapb.PoolerType_PRIMARY, 0)
+		replica := newTestPooler("replica", clustermetadatapb.PoolerType_REPLICA, 0)
+		replica.IsLastCheckValid = false
+
+		s, now := newTestScheduler(t, client, []*multiorchdatapb.PoolerHealthState{primary, replica},
+			config.WithBackupSchedule("@hourly"))
+		*now = time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC)
+		s.runCycle(t.Context())
+		*now = now.Add(time.Hour)
+		s.runCycle(t.Context())
+		s.wg.Wait()
+
+		status := s.Status()
+		require.Len(t, status, 1)
+		assert.Equal(t, 1, status[0].Failures)
+		assert.Equal(t, 1, status[0].ConsecutiveFailures)
+		assert.Contains(t, status[0].LastError, "no healthy replica available to back up shard db1/tg1/0")
+		assert.NotContains(t, client.GetCallLog(), "Backup("+topoclient.MultiPoolerIDString(primary.MultiPooler.Id)+")")
+	})
+
+	t.Run("backup error", func(t *testing.T) {
+		client := rpcclient.NewFakeClient()
+		replica := newTestPooler("replica", clustermetadatapb.PoolerType_REPLICA, 0)
+		client.Errors[topoclient.MultiPoolerIDString(replica.MultiPooler.Id)] = errors.New("pgbackrest failed")
+
+		s, now := newTestScheduler(t, client, []*multiorchdatapb.PoolerHealthState{replica},
+			config.WithBackupSchedule("@hourly"))
+		s.runCycle(t.Context())
+		*now = now.Add(time.Hour)
+		s.runCycle(t.Context())
+		s.wg.Wait()
+
+		status := s.Status()
+		require.Len(t, status, 1)
+		assert.Equal(t, 1, status[0].Failures)
+		assert.Zero(t, status[0].Successes)
+		assert.Contains(t, status[0].LastError, "pgbackrest failed")
+	})
+}
+
+func TestScheduler_Staleness(t *testing.T) {
+	client := rpcclient.NewFakeClient()
+	replica := newTestPooler("replica", clustermetadatapb.PoolerType_REPLICA, 0)
+	lastBackup := time.Date(2026, 10, 14, 1, 0, 0, 0, time.UTC)
+	client.GetBackupsResponses[topoclient.MultiPoolerIDString(replica.MultiPooler.Id)] = &multipoolermanagerdatapb.GetBackupsResponse{
+		Backups: []*multipoolermanagerdatapb.BackupMetadata{
+			{BackupId: "20261013-010000F", Status: multipoolermanagerdatapb.BackupMetadata_COMPLETE, CompletedAt: timestamppb.New(lastBackup.Add(-24 * time.Hour))},
+			{BackupId: "20261014-010000F", Status: multipoolermanagerdatapb.BackupMetadata_COMPLETE, CompletedAt: timestamppb.New(lastBackup)},
+			{BackupId: "20261015-010000F", Status: multipoolermanagerdatapb.BackupMetadata_INCOMPLETE},
+		},
+	}
+
+	// No schedule: the health of existing backups is still reported
+	s, now := newTestScheduler(t, client, []*multiorchdatapb.PoolerHealthState{replica},
+		config.WithBackupMaxAge(36*time.Hour))
+	s.runCycle(t.Context())
+
+	status := s.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, "20261014-010000F", status[0].LastBackupID)
+	assert.Equal(t, now.Sub(lastBackup), status[0].Age)
+	assert.True(t, status[0].Stale)
+	assert.True(t, status[0].NextRun.IsZero())
+
+	s.config = config.NewTestConfig(config.WithBackupMaxAge(72 * time.Hour))
+	assert.False(t, s.Status()[0].Stale)
+
+	s.config = config.NewTestConfig(config.WithBackupMaxAge(0))
+	assert.False(t, s.Status()[0].Stale)
+}
+
+func TestSchedules_ForShard(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	cfg := config.NewTestConfig(
+		config.WithBackupSchedule("0 2 * * *"),
+		config.WithBackupShardSchedules([]string{
+			"db1=0 3 * * *",
+			"db1/tg1/0=0 */6 * * *",
+			"db2/tg1=@hourly",
+			"invalid",
+			"db3=not a schedule",
+		}),
+	)
+	s := NewScheduler(cfg, logger, rpcclient.NewFakeClient(), store.NewPoolerHealthStore())
+	defer s.Stop()
+	schedules := s.loadSchedules()
+	require.Len(t, schedules.overrides, 3)
+
+	tests := []struct {
+		shard commontypes.ShardKey
+		want  string
+	}{
+		{shard: commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "0"}, want: "0 */6 * * *"},
+		{shard: commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "1"}, want: "0 3 * * *"},
+		{shard: commontypes.ShardKey{Database: "db2", TableGroup: "tg1", Shard: "0"}, want: "@hourly"},
+		{shard: commontypes.ShardKey{Database: "db3", TableGroup: "tg1", Shard: "0"}, want: "0 2 * * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.shard.String(), func(t *testing.T) {
+			expr, schedule := schedules.forShard(tt.shard)
+			assert.Equal(t, tt.want, expr)
+			assert.NotNil(t, schedule)
+		})
+	}
+}
@@ -157,6 +157,12 @@ type Config struct {
 	recoveryCycleInterval               viperutil.Value[time.Duration]
 	primaryFailoverGracePeriodBase      viperutil.Value[time.Duration]
 	primaryFailoverGracePeriodMaxJitter viperutil.Value[time.Duration]
+	backupSchedule                      viperutil.Value[string]
+	backupShardSchedules                viperutil.Value[[]string]
+	backupType                          viperutil.Value[string]
+	backupCheckInterval                 viperutil.Value[time.Duration]
+	backupTimeout                       viperutil.Value[time.Duration]
+	backupMaxAge                        viperutil.Value[time.Duration]
 }
 
 // Constants
@@ -234,6 +240,41 @@ func NewConfig(reg *viperutil.Registry) *Config {
 			Dynamic:  true,
 			EnvVars:  []string{"MT_PRIMARY_FAILOVER_GRACE_PERIOD_MAX_JITTER"},
 		}),
+		backupSchedule: viperutil.Configure(reg, "backup-schedule", viperutil.Options[string]{
+			Default:  "",
+			FlagName: "backup-schedule",
+			Dynamic:  true,
+			EnvVars:  []string{"MT_BACKUP_SCHEDULE"},
+		}),
+		backupShardSchedules: viperutil.Configure(reg, "backup-shard-schedules", viperutil.Options[[]string]{
+			FlagName: "backup-shard-schedules",
+			Dynamic:  true,
+			EnvVars:  []string{"MT_BACKUP_SHARD_SCHEDULES"},
+		}),
+		backupType: viperutil.Configure(reg, "backup-type", viperutil.Options[string]{
+			Default:  "full",
+			FlagName: "backup-type",
+			Dynamic:  true,
+			EnvVars:  []string{"MT_BACKUP_TYPE"},
+		}),
+		backupCheckInterval: viperutil.Configure(reg, "backup-check-interval", viperutil.Options[time.Duration]{
+			Default:  1 * time.Minute,
+			FlagName: "backup-check-interval",
+			Dynamic:  false,
+			EnvVars:  []string{"MT_BACKUP_CHECK_INTERVAL"},
+		}),
+		backupTimeout: viperutil.Configure(reg, "backup-timeout", viperutil.Options[time.Duration]{
+			Default:  4 * time.Hour,
+			FlagName: "backup-timeout",
+			Dynamic:  true,
+			EnvVars:  []string{"MT_BACKUP_TIMEOUT"},
+		}),
+		backupMaxAge: viperutil.Configure(reg, "backup-max-age", viperutil.Options[time.Duration]{
+			Default:  36 * time.Hour,
+			FlagName: "backup-max-age",
+			Dynamic:  true,
+			EnvVars:  []string{"MT_BACKUP_MAX_AGE"},
+		}),
 	}
 }
 
@@ -283,6 +324,30 @@ func (c *Config) GetPrimaryFailoverGracePeriodMaxJitter() time.Duration {
 	return c.primaryFailoverGracePeriodMaxJitter.Get()
 }
 
+func (c *Config) GetBackupSchedule() string {
+	return c.backupSchedule.Get()
+}
+
+func (c *Config) GetBackupShardSchedules() []string {
+	return c.backupShardSchedules.Get()
+}
+
+func (c *Config) GetBackupType() string {
+	return c.backupType.Get()
+}
+
+func (c *Config) GetBackupCheckInterval() time.Duration {
+	return c.backupCheckInterval.Get()
+}
+
+func (c *Config) GetBackupTimeout() time.Duration {
+	return c.backupTimeout.Get()
+}
+
+func (c *Config) GetBackupMaxAge() time.Duration {
+	return c.backupMaxAge.Get()
+}
+
 // Defaults for flags (used in RegisterFlags)
 
 func (c *Config) DefaultCell() string {
@@ -329,6 +394,30 @@ func (c *Config) DefaultPrimaryFailoverGracePeriodMaxJitter() time.Duration {
 	return c.primaryFailoverGracePeriodMaxJitter.Default()
 }
 
+func (c *Config) DefaultBackupSchedule() string {
+	return c.backupSchedule.Default()
+}
+
+func (c *Config) DefaultBackupShardSchedules() []string {
+	return c.backupShardSchedules.Default()
+}
+
+func (c *Config) DefaultBackupType() string {
+	return c.backupType.Default()
+}
+
+func (c *Config) DefaultBackupCheckInterval() time.Duration {
+	return c.backupCheckInterval.Default()
+}
+
+func (c *Config) DefaultBackupTimeout() time.Duration {
+	return c.backupTimeout.Default()
+}
+
+func (c *Config) DefaultBackupMaxAge() time.Duration {
+	return c.backupMaxAge.Default()
+}
+
 // RegisterFlags registers the config flags with pflag.
 func (c *Config) RegisterFlags(fs *pflag.FlagSet) {
 	fs.String("cell", c.DefaultCell(), "cell to use")
@@ -342,6 +431,12 @@ func (c *Config) RegisterFlags(fs *pflag.FlagSet) {
 	fs.Duration("recovery-cycle-interval", c.DefaultRecoveryCycleInterval(), "interval between recovery cycles")
 	fs.Duration("primary-failover-grace-period-base", c.DefaultPrimaryFailoverGracePeriodBase(), "base grace period before executing primary failover")
 	fs.Duration("primary-failover-grace-period-max-jitter", c.DefaultPrimaryFailoverGracePeriodMaxJitter(), "max jitter added to primary failover grace period")
+	fs.String("backup-schedule", c.DefaultBackupSchedule(), "cron schedule of backups of every watched shard, e.g. \"0 2 * * *\" (empty disables scheduled backups)")
+	fs.StringSlice("backup-shard-schedules", c.DefaultBackupShardSchedules(), "list of target=schedule backup schedules overriding backup-schedule, e.g. \"db/tg/0=0 */6 * * *\"")
+	fs.String("backup-type", c.DefaultBackupType(), "type of scheduled backups: full, differential or incremental")
+	fs.Duration("backup-check-interval", c.DefaultBackupCheckInterval(), "interval between checks of the backup schedules")
+	fs.Duration("backup-timeout", c.DefaultBackupTimeout(), "timeout of a scheduled backup")
+	fs.Duration("backup-max-age", c.DefaultBackupMaxAge(), "age of the last good backup of a shard above which it is reported as stale (0 disables)")
 	viperutil.BindFlags(fs,
 		c.cell,
 		c.serviceID,
@@ -353,7 +448,13 @@ func (c *Config) RegisterFlags(fs *pflag.FlagSet) {
 		c.healthCheckWorkers,
 		c.recoveryCycleInterval,
 		c.primaryFailoverGracePeriodBase,
-		c.primaryFailoverGracePeriodMaxJitter)
+		c.primaryFailoverGracePeriodMaxJitter,
+		c.backupSchedule,
+		c.backupShardSchedules,
+		c.backupType,
+		c.backupCheckInterval,
+		c.backupTimeout,
+		c.backupMaxAge)
 }
 
 // Test helper functions
@@ -436,3 +537,24 @@ func WithPrimaryFailoverGracePeriodMaxJitter(d time.Duration) func(*Config) {
 		cfg.primaryFailoverGracePeriodMaxJitter.Set(d)
 	}
 }
+
+// WithBackupSchedule sets the backup schedule for testing.
+func WithBackupSchedule(schedule string) func(*Config) {
+	return func(cfg *Config) {
+		cfg.backupSchedule.Set(schedule)
+	}
+}
+
+// WithBackupShardSchedules sets the per-target backup schedules for testing.
+func WithBackupShardSchedules(schedules []string) func(*Config) {
+	return func(cfg *Config) {
+		cfg.backupShardSchedules.Set(schedules)
+	}
+}
+
+// WithBackupMaxAge sets the backup age alert threshold for testing.
+func WithBackupMaxAge(d time.Duration) func(*Config) {
+	return func(cfg *Config) {
+		cfg.backupMaxAge.Set(d)
+	}
+}
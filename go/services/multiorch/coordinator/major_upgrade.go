@@ -0,0 +1,247 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	multiorchdatapb "github.com/multigres/multigres/go/pb/multiorchdata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+// UpgradeShardResult describes a major version upgrade of a shard.
+type UpgradeShardResult struct {
+	// OldVersion and NewVersion are the major versions of the primary
+	// before and after the upgrade.
+	OldVersion string
+	NewVersion string
+}
+
+// UpgradeShard upgrades the PostgreSQL major version of every pooler of a
+// shard to the binaries in newBinDir.
+//
+// Standbys cannot be upgraded by pg_upgrade, and physical replication does
+// not work across major versions, so the shard is upgraded primary first:
+//
+//  1. The primary is upgraded in place with pg_upgrade --link, restarted
+//     and analyzed. The shard has no primary while pg_upgrade runs.
+//  2. Each replica is cloned again from the upgraded primary. Its old data
+//     directory is kept until the upgrade is finished.
+//
+// The old data directories are kept until FinishShardUpgrade is called, so
+// that the upgrade can be rolled back. Poolers already on the new version
+// are skipped, which makes a failed upgrade safe to retry. A replica that
+// fails to upgrade does not stop the others; the error lists all of them.
+//
+// With checkOnly, every pooler only checks that it can be upgraded, and no
+// PostgreSQL server is stopped.
+func (c *Coordinator) UpgradeShard(ctx context.Context, shardKey commontypes.ShardKey, cohort []*multiorchdatapb.PoolerHealthState, newBinDir string, checkOnly bool) (*UpgradeShardResult, error) {
+	c.logger.InfoContext(ctx, "Starting major version upgrade",
+		"shard_key", shardKey.String(),
+		"cohort_size", len(cohort),
+		"new_bin_dir", newBinDir,
+		"check_only", checkOnly)
+
+	if newBinDir == "" {
+		return nil, mterrors.New(mtrpcpb.Code_INVALID_ARGUMENT, "new_bin_dir is required")
+	}
+	primary, replicas, err := upgradePoolers(cohort)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, unlock, err := c.topoStore.LockShard(ctx, shardKey, "major version upgrade")
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to lock shard")
+	}
+	defer func() {
+		var unlockErr error
+		unlock(&unlockErr)
+		if unlockErr != nil {
+			c.logger.WarnContext(ctx, "Failed to release shard lock",
+				"shard_key", shardKey.String(),
+				"error", unlockErr)
+		}
+	}()
+
+	// Step 1: the primary. With checkOnly, a failing check does not stop
+	// the replicas from being checked as well.
+	resp, err := c.rpcClient.UpgradePostgres(ctx, primary.MultiPooler, &multipoolermanagerdatapb.UpgradePostgresRequest{
+		NewBinDir: newBinDir,
+		CheckOnly: checkOnly,
+	})
+	if err != nil {
+		if !checkOnly {
+			return nil, mterrors.Wrapf(err, "failed to upgrade primary %s", primary.MultiPooler.Id.Name)
+		}
+		c.logger.WarnContext(ctx, "Primary cannot be upgraded",
+			"pooler", primary.MultiPooler.Id.Name,
+			"error", err)
+	} else {
+		c.logger.InfoContext(ctx, "Primary upgraded",
+			"pooler", primary.MultiPooler.Id.Name,
+			"old_version", resp.OldVersion,
+			"new_version", resp.NewVersion,
+			"check_only", checkOnly)
+	}
+	failed := map[string]error{}
+	if err != nil {
+		failed[primary.MultiPooler.Id.Name] = err
+	}
+
+	// Step 2: the replicas, cloned from the upgraded primary.
+	for _, replica := range replicas {
+		replicaResp, err := c.rpcClient.UpgradePostgres(ctx, replica.MultiPooler, &multipoolermanagerdatapb.UpgradePostgresRequest{
+			NewBinDir: newBinDir,
+			CheckOnly: checkOnly,
+			Source:    primary.MultiPooler,
+		})
+		if err != nil {
+			c.logger.WarnContext(ctx, "Failed to upgrade replica",
+				"pooler", replica.MultiPooler.Id.Name,
+				"check_only", checkOnly,
+				"error", err)
+			failed[replica.MultiPooler.Id.Name] = err
+			continue
+		}
+		c.logger.InfoContext(ctx, "Replica upgraded",
+			"pooler", replica.MultiPooler.Id.Name,
+			"old_version", replicaResp.OldVersion,
+			"new_version", replicaResp.NewVersion,
+			"check_only", checkOnly)
+	}
+	if err := failedPoolersError(failed, "upgrade"); err != nil {
+		return nil, err
+	}
+
+	c.logger.InfoContext(ctx, "Major version upgrade completed",
+		"shard_key", shardKey.String(),
+		"check_only", checkOnly)
+	result := &UpgradeShardResult{}
+	if resp != nil {
+		result.OldVersion = resp.OldVersion
+		result.NewVersion = resp.NewVersion
+	}
+	return result, nil
+}
+
+// FinishShardUpgrade ends the last major version upgrade of a shard. It
+// either removes the old data directories of every pooler or, with
+// rollback, restores them.
+//
+// The primary is rolled back first, and a failure stops the rollback: the
+// replicas would otherwise return to the old version without a primary
+// to replicate from. A primary that already accepted writes on the new
+// version cannot be rolled back, since pg_upgrade --link shares its files
+// with the old data directory.
+func (c *Coordinator) FinishShardUpgrade(ctx context.Context, shardKey commontypes.ShardKey, cohort []*multiorchdatapb.PoolerHealthState, rollback bool) error {
+	c.logger.InfoContext(ctx, "Finishing major version upgrade",
+		"shard_key", shardKey.String(),
+		"cohort_size", len(cohort),
+		"rollback", rollback)
+
+	primary, replicas, err := upgradePoolers(cohort)
+	if err != nil {
+		return err
+	}
+
+	ctx, unlock, err := c.topoStore.LockShard(ctx, shardKey, "finish major version upgrade")
+	if err != nil {
+		return mterrors.Wrap(err, "failed to lock shard")
+	}
+	defer func() {
+		var unlockErr error
+		unlock(&unlockErr)
+		if unlockErr != nil {
+			c.logger.WarnContext(ctx, "Failed to release shard lock",
+				"shard_key", shardKey.String(),
+				"error", unlockErr)
+		}
+	}()
+
+	req := &multipoolermanagerdatapb.FinishPostgresUpgradeRequest{Rollback: rollback}
+	failed := map[string]error{}
+	if _, err := c.rpcClient.FinishPostgresUpgrade(ctx, primary.MultiPooler, req); err != nil {
+		if rollback {
+			return mterrors.Wrapf(err, "failed to roll back primary %s", primary.MultiPooler.Id.Name)
+		}
+		failed[primary.MultiPooler.Id.Name] = err
+	}
+	for _, replica := range replicas {
+		if _, err := c.rpcClient.FinishPostgresUpgrade(ctx, replica.MultiPooler, req); err != nil {
+			c.logger.WarnContext(ctx, "Failed to finish upgrade of replica",
+				"pooler", replica.MultiPooler.Id.Name,
+				"rollback", rollback,
+				"error", err)
+			failed[replica.MultiPooler.Id.Name] = err
+		}
+	}
+
+	action := "finalize"
+	if rollback {
+		action = "roll back"
+	}
+	if err := failedPoolersError(failed, action); err != nil {
+		return err
+	}
+
+	c.logger.InfoContext(ctx, "Major version upgrade finished",
+		"shard_key", shardKey.String(),
+		"rollback", rollback)
+	return nil
+}
+
+// upgradePoolers splits the cohort into its primary and its replicas.
+func upgradePoolers(cohort []*multiorchdatapb.PoolerHealthState) (*multiorchdatapb.PoolerHealthState, []*multiorchdatapb.PoolerHealthState, error) {
+	var primary *multiorchdatapb.PoolerHealthState
+	var replicas []*multiorchdatapb.PoolerHealthState
+	for _, pooler := range cohort {
+		if pooler.MultiPooler.Type != clustermetadatapb.PoolerType_PRIMARY {
+			replicas = append(replicas, pooler)
+			continue
+		}
+		if primary != nil {
+			return nil, nil, mterrors.Errorf(mtrpcpb.Code_FAILED_PRECONDITION,
+				"shard has more than one primary: %s and %s",
+				primary.MultiPooler.Id.Name, pooler.MultiPooler.Id.Name)
+		}
+		primary = pooler
+	}
+	if primary == nil {
+		return nil, nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+			"shard has no primary to upgrade")
+	}
+	return primary, replicas, nil
+}
+
+// failedPoolersError returns an error naming the poolers that failed the
+// action, with the first of their errors as its cause.
+func failedPoolersError(failed map[string]error, action string) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(failed))
+	for name := range failed {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return mterrors.Wrapf(failed[names[0]], "failed to %s poolers %s", action, strings.Join(names, ", "))
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+func TestUpgradeShard(t *testing.T) {
+	ctx := context.Background()
+	shardKey := commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "0"}
+
+	t.Run("upgrades the primary then clones the replicas", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		fakeClient.UpgradePostgresResponses["multipooler-zone1-mp1"] = &multipoolermanagerdatapb.UpgradePostgresResponse{
+			OldVersion: "16",
+			NewVersion: "17",
+		}
+
+		result, err := c.UpgradeShard(ctx, shardKey, cohort, "/usr/lib/postgresql/17/bin", false)
+		require.NoError(t, err)
+		require.Equal(t, "16", result.OldVersion)
+		require.Equal(t, "17", result.NewVersion)
+
+		calls := fakeClient.GetCallLog()
+		primary := slices.Index(calls, "UpgradePostgres(multipooler-zone1-mp1)")
+		require.NotEqual(t, -1, primary)
+		require.Less(t, primary, slices.Index(calls, "UpgradePostgres(multipooler-zone1-mp2)"))
+		require.Less(t, primary, slices.Index(calls, "UpgradePostgres(multipooler-zone1-mp3)"))
+
+		primaryReq := fakeClient.UpgradePostgresRequests["multipooler-zone1-mp1"]
+		require.Equal(t, "/usr/lib/postgresql/17/bin", primaryReq.NewBinDir)
+		require.Nil(t, primaryReq.Source)
+		for _, name := range []string{"multipooler-zone1-mp2", "multipooler-zone1-mp3"} {
+			req := fakeClient.UpgradePostgresRequests[name]
+			require.False(t, req.CheckOnly)
+			require.Equal(t, "mp1", req.Source.Id.Name)
+		}
+	})
+
+	t.Run("check only checks every pooler", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		fakeClient.Errors["multipooler-zone1-mp1"] = errors.New("incompatible extension")
+
+		_, err := c.UpgradeShard(ctx, shardKey, cohort, "/usr/lib/postgresql/17/bin", true)
+		require.ErrorContains(t, err, "failed to upgrade poolers mp1")
+		require.ErrorContains(t, err, "incompatible extension")
+
+		for _, name := range []string{"multipooler-zone1-mp1", "multipooler-zone1-mp2", "multipooler-zone1-mp3"} {
+			require.True(t, fakeClient.UpgradePostgresRequests[name].CheckOnly, name)
+		}
+	})
+
+	t.Run("failed primary stops the upgrade", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		fakeClient.Errors["multipooler-zone1-mp1"] = errors.New("pg_upgrade failed")
+
+		_, err := c.UpgradeShard(ctx, shardKey, cohort, "/usr/lib/postgresql/17/bin", false)
+		require.ErrorContains(t, err, "failed to upgrade primary mp1")
+		require.NotContains(t, fakeClient.GetCallLog(), "UpgradePostgres(multipooler-zone1-mp2)")
+	})
+
+	t.Run("failed replica does not stop the others", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		fakeClient.Errors["multipooler-zone1-mp2"] = errors.New("pg_basebackup failed")
+
+		_, err := c.UpgradeShard(ctx, shardKey, cohort, "/usr/lib/postgresql/17/bin", false)
+		require.ErrorContains(t, err, "failed to upgrade poolers mp2")
+		require.Contains(t, fakeClient.GetCallLog(), "UpgradePostgres(multipooler-zone1-mp3)")
+	})
+
+	t.Run("requires new bin dir", func(t *testing.T) {
+		c, _, _, cohort := setupPlannedReparent(t)
+
+		_, err := c.UpgradeShard(ctx, shardKey, cohort, "", false)
+		require.ErrorContains(t, err, "new_bin_dir is required")
+	})
+
+	t.Run("requires a primary", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		cohort[0].MultiPooler.Type = clustermetadatapb.PoolerType_REPLICA
+
+		_, err := c.UpgradeShard(ctx, shardKey, cohort, "/usr/lib/postgresql/17/bin", false)
+		require.ErrorContains(t, err, "shard has no primary to upgrade")
+		require.Empty(t, fakeClient.UpgradePostgresRequests)
+	})
+}
+
+func TestFinishShardUpgrade(t *testing.T) {
+	ctx := context.Background()
+	shardKey := commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "0"}
+
+	t.Run("finalizes every pooler", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		fakeClient.Errors["multipooler-zone1-mp1"] = errors.New("no upgrade to finalize")
+
+		err := c.FinishShardUpgrade(ctx, shardKey, cohort, false)
+		require.ErrorContains(t, err, "failed to finalize poolers mp1")
+		calls := fakeClient.GetCallLog()
+		require.Contains(t, calls, "FinishPostgresUpgrade(multipooler-zone1-mp2)")
+		require.Contains(t, calls, "FinishPostgresUpgrade(multipooler-zone1-mp3)")
+	})
+
+	t.Run("rolls back the primary first", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+
+		require.NoError(t, c.FinishShardUpgrade(ctx, shardKey, cohort, true))
+		calls := fakeClient.GetCallLog()
+		primary := slices.Index(calls, "FinishPostgresUpgrade(multipooler-zone1-mp1)")
+		require.NotEqual(t, -1, primary)
+		require.Less(t, primary, slices.Index(calls, "FinishPostgresUpgrade(multipooler-zone1-mp2)"))
+	})
+
+	t.Run("failed primary rollback stops the rollback", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+		fakeClient.Errors["multipooler-zone1-mp1"] = errors.New("upgraded cluster was started")
+
+		err := c.FinishShardUpgrade(ctx, shardKey, cohort, true)
+		require.ErrorContains(t, err, "failed to roll back primary mp1")
+		require.NotContains(t, fakeClient.GetCallLog(), "FinishPostgresUpgrade(multipooler-zone1-mp2)")
+	})
+}
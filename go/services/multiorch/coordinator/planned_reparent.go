@@ -0,0 +1,356 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/multigres/multigres/go/common/mterrors"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	consensusdatapb "github.com/multigres/multigres/go/pb/consensusdata"
+	mtrpcpb "github.com/multigres/multigres/go/pb/mtrpc"
+	multiorchdatapb "github.com/multigres/multigres/go/pb/multiorchdata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+const (
+	// PlannedReparentReason is the reason recorded in the leadership
+	// history of a primary appointed by a planned reparent.
+	PlannedReparentReason = "planned_reparent"
+
+	// defaultDrainTimeout is how long the primary waits for its write
+	// transactions to finish when no drain timeout is given.
+	defaultDrainTimeout = 5 * time.Second
+)
+
+// PlannedReparentResult describes a completed planned reparent.
+type PlannedReparentResult struct {
+	// PreviousPrimary is the pooler that was the primary.
+	PreviousPrimary *multiorchdatapb.PoolerHealthState
+	// NewPrimary is the pooler promoted in its place.
+	NewPrimary *multiorchdatapb.PoolerHealthState
+	// Term is the consensus term of the new primary.
+	Term int64
+}
+
+// PlannedReparent moves the primary of a shard to one of its replicas while
+// both are healthy, e.g. before maintenance of the primary's host.
+//
+// Unlike AppointLeader, which recovers a shard whose primary failed, the
+// current primary takes part in the switchover so that no committed
+// transaction is lost and clients see as few errors as possible:
+//
+//  1. The primary is marked as a REPLICA in the topology, so that the
+//     gateways start buffering the statements of the shard.
+//  2. The primary is demoted: it turns read-only, drains its write
+//     transactions, checkpoints and shuts down, returning its final LSN.
+//  3. The new primary replays the WAL up to that LSN.
+//  4. The replicas are recruited under a new term and the new primary is
+//     promoted. Marking it as the PRIMARY in the topology ends the
+//     buffering in the gateways.
+//  5. The old primary is rewound if needed and restarted as a replica of
+//     the new primary.
+//
+// newPrimary selects the replica to promote; when nil, the most advanced
+// healthy replica is chosen. The shard lock is held throughout, so that the
+// recovery engine does not act on the shard meanwhile. If the operation
+// fails after the primary was demoted, the shard is left without a primary
+// and the recovery engine appoints one once the lock is released.
+func (c *Coordinator) PlannedReparent(ctx context.Context, shardKey commontypes.ShardKey, cohort []*multiorchdatapb.PoolerHealthState, newPrimary *clustermetadatapb.ID, drainTimeout time.Duration) (*PlannedReparentResult, error) {
+	c.logger.InfoContext(ctx, "Starting planned reparent",
+		"shard_key", shardKey.String(),
+		"cohort_size", len(cohort))
+
+	if len(cohort) == 0 {
+		return nil, mterrors.Errorf(mtrpcpb.Code_INVALID_ARGUMENT, "cohort is empty for shard %s", shardKey)
+	}
+	if drainTimeout <= 0 {
+		drainTimeout = defaultDrainTimeout
+	}
+
+	ctx, unlock, err := c.topoStore.LockShard(ctx, shardKey, "planned reparent")
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to lock shard")
+	}
+	defer func() {
+		var unlockErr error
+		unlock(&unlockErr)
+		if unlockErr != nil {
+			c.logger.WarnContext(ctx, "Failed to release shard lock",
+				"shard_key", shardKey.String(),
+				"error", unlockErr)
+		}
+	}()
+
+	primary, candidate, err := c.plannedReparentPoolers(ctx, cohort, newPrimary)
+	if err != nil {
+		return nil, err
+	}
+
+	var replicas []*multiorchdatapb.PoolerHealthState
+	for _, pooler := range cohort {
+		if pooler != primary {
+			replicas = append(replicas, pooler)
+		}
+	}
+
+	quorumRule, err := c.LoadQuorumRule(ctx, cohort, shardKey.Database)
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to load durability policy")
+	}
+
+	// The old primary is not recruited under the new term, so the replicas
+	// must satisfy the quorum on their own. Check it before the primary is
+	// demoted, while giving up leaves the shard untouched.
+	if err := c.ValidateQuorum(quorumRule, cohort, replicas); err != nil {
+		return nil, mterrors.Wrapf(err, "replicas of shard %s cannot form a quorum without the primary", shardKey)
+	}
+
+	maxTerm, err := c.currentMaxTerm(ctx, cohort)
+	if err != nil {
+		return nil, err
+	}
+	term := maxTerm + 1
+
+	c.logger.InfoContext(ctx, "Reparenting shard",
+		"shard_key", shardKey.String(),
+		"primary", primary.MultiPooler.Id.Name,
+		"new_primary", candidate.MultiPooler.Id.Name,
+		"term", term)
+
+	// Step 1: start buffering in the gateways.
+	if err := c.setPoolerType(ctx, primary, clustermetadatapb.PoolerType_REPLICA); err != nil {
+		return nil, mterrors.Wrap(err, "failed to mark primary as a replica")
+	}
+
+	// Step 2: demote the primary.
+	demoteResp, err := c.rpcClient.EmergencyDemote(ctx, primary.MultiPooler, &multipoolermanagerdatapb.EmergencyDemoteRequest{
+		ConsensusTerm: term,
+		DrainTimeout:  durationpb.New(drainTimeout),
+	})
+	if err != nil {
+		// The primary may still be serving: give it back to the gateways.
+		if restoreErr := c.setPoolerType(ctx, primary, clustermetadatapb.PoolerType_PRIMARY); restoreErr != nil {
+			c.logger.WarnContext(ctx, "Failed to restore primary type",
+				"pooler", primary.MultiPooler.Id.Name,
+				"error", restoreErr)
+		}
+		return nil, mterrors.Wrapf(err, "failed to demote primary %s", primary.MultiPooler.Id.Name)
+	}
+
+	c.logger.InfoContext(ctx, "Primary demoted",
+		"pooler", primary.MultiPooler.Id.Name,
+		"lsn", demoteResp.LsnPosition,
+		"connections_terminated", demoteResp.ConnectionsTerminated)
+
+	// Step 3: wait for the new primary to catch up.
+	if demoteResp.LsnPosition != "" {
+		waitReq := &multipoolermanagerdatapb.WaitForLSNRequest{TargetLsn: demoteResp.LsnPosition}
+		if _, err := c.rpcClient.WaitForLSN(ctx, candidate.MultiPooler, waitReq); err != nil {
+			return nil, mterrors.Wrapf(err, "new primary %s failed to replay WAL to %s",
+				candidate.MultiPooler.Id.Name, demoteResp.LsnPosition)
+		}
+	}
+
+	// Step 4: promote the new primary.
+	recruited, err := c.recruitNodes(ctx, replicas, term, candidate, consensusdatapb.BeginTermAction_BEGIN_TERM_ACTION_REVOKE)
+	if err != nil {
+		return nil, mterrors.Wrap(err, "failed to recruit poolers")
+	}
+	if err := c.ValidateQuorum(quorumRule, cohort, recruited); err != nil {
+		return nil, mterrors.Wrapf(err, "quorum validation failed for shard %s", shardKey)
+	}
+
+	var standbys []*multiorchdatapb.PoolerHealthState
+	candidateRecruited := false
+	for _, pooler := range recruited {
+		if pooler == candidate {
+			candidateRecruited = true
+			continue
+		}
+		standbys = append(standbys, pooler)
+	}
+	if !candidateRecruited {
+		return nil, mterrors.Errorf(mtrpcpb.Code_UNAVAILABLE,
+			"new primary %s did not accept term %d", candidate.MultiPooler.Id.Name, term)
+	}
+
+	if err := c.Propagate(ctx, candidate, standbys, term, quorumRule, PlannedReparentReason, cohort, recruited); err != nil {
+		return nil, mterrors.Wrap(err, "Propagate failed")
+	}
+	if err := c.EstablishLeader(ctx, candidate, term); err != nil {
+		return nil, mterrors.Wrap(err, "EstablishLeader failed")
+	}
+	if err := c.updateTopology(ctx, candidate, standbys); err != nil {
+		c.logger.WarnContext(ctx, "Failed to update topology",
+			"shard_key", shardKey.String(),
+			"error", err)
+	}
+
+	// Step 5: rewire the old primary. The new primary is serving by now, so
+	// a failure here is left to the recovery engine.
+	c.rejoinAsReplica(ctx, primary, candidate, term)
+
+	c.logger.InfoContext(ctx, "Planned reparent complete",
+		"shard_key", shardKey.String(),
+		"previous_primary", primary.MultiPooler.Id.Name,
+		"new_primary", candidate.MultiPooler.Id.Name,
+		"term", term)
+
+	return &PlannedReparentResult{
+		PreviousPrimary: primary,
+		NewPrimary:      candidate,
+		Term:            term,
+	}, nil
+}
+
+// plannedReparentPoolers returns the primary of the cohort and the replica
+// to promote in its place.
+func (c *Coordinator) plannedReparentPoolers(ctx context.Context, cohort []*multiorchdatapb.PoolerHealthState, newPrimary *clustermetadatapb.ID) (*multiorchdatapb.PoolerHealthState, *multiorchdatapb.PoolerHealthState, error) {
+	var primary *multiorchdatapb.PoolerHealthState
+	var replicas []*multiorchdatapb.PoolerHealthState
+	for _, pooler := range cohort {
+		if pooler.MultiPooler.Type != clustermetadatapb.PoolerType_PRIMARY {
+			replicas = append(replicas, pooler)
+			continue
+		}
+		if primary != nil {
+			return nil, nil, mterrors.Errorf(mtrpcpb.Code_FAILED_PRECONDITION,
+				"shard has more than one primary: %s and %s",
+				primary.MultiPooler.Id.Name, pooler.MultiPooler.Id.Name)
+		}
+		primary = pooler
+	}
+	if primary == nil {
+		return nil, nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+			"shard has no primary to reparent from")
+	}
+
+	status, err := c.rpcClient.ConsensusStatus(ctx, primary.MultiPooler, &consensusdatapb.StatusRequest{})
+	if err != nil {
+		return nil, nil, mterrors.Wrapf(err, "primary %s is unreachable", primary.MultiPooler.Id.Name)
+	}
+	if status.Role != "primary" {
+		return nil, nil, mterrors.Errorf(mtrpcpb.Code_FAILED_PRECONDITION,
+			"pooler %s is not running as a primary (role %q)", primary.MultiPooler.Id.Name, status.Role)
+	}
+
+	var candidate *multiorchdatapb.PoolerHealthState
+	if newPrimary != nil {
+		if sameID(primary.MultiPooler.Id, newPrimary) {
+			return nil, nil, mterrors.Errorf(mtrpcpb.Code_INVALID_ARGUMENT,
+				"pooler %s is already the primary", newPrimary.Name)
+		}
+		for _, pooler := range replicas {
+			if sameID(pooler.MultiPooler.Id, newPrimary) {
+				candidate = pooler
+				break
+			}
+		}
+		if candidate == nil {
+			return nil, nil, mterrors.Errorf(mtrpcpb.Code_NOT_FOUND,
+				"pooler %s is not a replica of the shard", newPrimary.Name)
+		}
+	} else {
+		if len(replicas) == 0 {
+			return nil, nil, mterrors.New(mtrpcpb.Code_FAILED_PRECONDITION,
+				"shard has no replica to promote")
+		}
+		candidate, err = c.selectCandidate(ctx, replicas)
+		if err != nil {
+			return nil, nil, mterrors.Wrap(err, "failed to select new primary")
+		}
+	}
+
+	status, err = c.rpcClient.ConsensusStatus(ctx, candidate.MultiPooler, &consensusdatapb.StatusRequest{})
+	if err != nil {
+		return nil, nil, mterrors.Wrapf(err, "new primary %s is unreachable", candidate.MultiPooler.Id.Name)
+	}
+	if !status.IsHealthy {
+		return nil, nil, mterrors.Errorf(mtrpcpb.Code_UNAVAILABLE,
+			"new primary %s is not healthy", candidate.MultiPooler.Id.Name)
+	}
+
+	return primary, candidate, nil
+}
+
+// currentMaxTerm returns the highest consensus term of the cohort. Unlike
+// discoverMaxTerm, it asks the poolers rather than relying on health
+// checks, which callers outside of the recovery engine do not have.
+func (c *Coordinator) currentMaxTerm(ctx context.Context, cohort []*multiorchdatapb.PoolerHealthState) (int64, error) {
+	var maxTerm int64
+	for _, pooler := range cohort {
+		status, err := c.rpcClient.ConsensusStatus(ctx, pooler.MultiPooler, &consensusdatapb.StatusRequest{})
+		if err != nil {
+			c.logger.WarnContext(ctx, "Failed to get status from pooler",
+				"pooler", pooler.MultiPooler.Id.Name,
+				"error", err)
+			continue
+		}
+		maxTerm = max(maxTerm, status.CurrentTerm)
+	}
+	if maxTerm == 0 {
+		return 0, mterrors.Errorf(mtrpcpb.Code_FAILED_PRECONDITION,
+			"no poolers in cohort have initialized consensus term - cannot discover max term")
+	}
+	return maxTerm, nil
+}
+
+// rejoinAsReplica restarts a demoted primary as a replica of the new
+// primary. Failures are logged: the recovery engine repairs the pooler.
+func (c *Coordinator) rejoinAsReplica(ctx context.Context, pooler, newPrimary *multiorchdatapb.PoolerHealthState, term int64) {
+	resp, err := c.rpcClient.DemoteStalePrimary(ctx, pooler.MultiPooler, &multipoolermanagerdatapb.DemoteStalePrimaryRequest{
+		Source:        newPrimary.MultiPooler,
+		ConsensusTerm: term,
+	})
+	if err != nil {
+		c.logger.WarnContext(ctx, "Failed to restart old primary as a replica",
+			"pooler", pooler.MultiPooler.Id.Name,
+			"error", err)
+		return
+	}
+
+	// The demotion disabled the monitoring of postgres, which a replica
+	// needs to be restarted if it stops.
+	if _, err := c.rpcClient.SetMonitor(ctx, pooler.MultiPooler, &multipoolermanagerdatapb.SetMonitorRequest{Enabled: true}); err != nil {
+		c.logger.WarnContext(ctx, "Failed to enable postgres monitoring on old primary",
+			"pooler", pooler.MultiPooler.Id.Name,
+			"error", err)
+	}
+
+	c.logger.InfoContext(ctx, "Old primary restarted as a replica",
+		"pooler", pooler.MultiPooler.Id.Name,
+		"rewind_performed", resp.RewindPerformed,
+		"lsn_position", resp.LsnPosition)
+}
+
+// setPoolerType sets the type of a pooler in the topology.
+func (c *Coordinator) setPoolerType(ctx context.Context, pooler *multiorchdatapb.PoolerHealthState, poolerType clustermetadatapb.PoolerType) error {
+	_, err := c.topoStore.UpdateMultiPoolerFields(ctx, pooler.MultiPooler.Id, func(mp *clustermetadatapb.MultiPooler) error {
+		mp.Type = poolerType
+		return nil
+	})
+	return err
+}
+
+// sameID returns true if id designates the pooler of poolerID. The cell of
+// id may be left empty when pooler names are unique across cells.
+func sameID(poolerID, id *clustermetadatapb.ID) bool {
+	return poolerID.GetName() == id.GetName() && (id.GetCell() == "" || poolerID.GetCell() == id.GetCell())
+}
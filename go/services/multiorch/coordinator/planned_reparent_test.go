@@ -0,0 +1,159 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/common/rpcclient"
+	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/common/topoclient/memorytopo"
+	commontypes "github.com/multigres/multigres/go/common/types"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	multiorchdatapb "github.com/multigres/multigres/go/pb/multiorchdata"
+	multipoolermanagerdatapb "github.com/multigres/multigres/go/pb/multipoolermanagerdata"
+)
+
+// setupPlannedReparent creates a shard whose primary is mp1 and whose
+// replicas are mp2 and mp3, both in the topology and in the fake client.
+func setupPlannedReparent(t *testing.T) (*Coordinator, *rpcclient.FakeClient, topoclient.Store, []*multiorchdatapb.PoolerHealthState) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "zone1")
+	t.Cleanup(func() { ts.Close() })
+
+	fakeClient := rpcclient.NewFakeClient()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	coordID := &clustermetadatapb.ID{
+		Component: clustermetadatapb.ID_MULTIORCH,
+		Cell:      "zone1",
+		Name:      "test-coordinator",
+	}
+	c := NewCoordinator(coordID, ts, fakeClient, logger)
+
+	cohort := []*multiorchdatapb.PoolerHealthState{
+		createMockNode(fakeClient, "mp1", 5, "0/3000000", true, "primary"),
+		createMockNode(fakeClient, "mp2", 5, "0/2000000", true, "standby"),
+		createMockNode(fakeClient, "mp3", 5, "0/2800000", true, "standby"),
+	}
+	for i, pooler := range cohort {
+		pooler.MultiPooler.Database = "db1"
+		pooler.MultiPooler.TableGroup = "tg1"
+		pooler.MultiPooler.Shard = "0"
+		pooler.MultiPooler.Type = clustermetadatapb.PoolerType_REPLICA
+		if i == 0 {
+			pooler.MultiPooler.Type = clustermetadatapb.PoolerType_PRIMARY
+		}
+		require.NoError(t, ts.CreateMultiPooler(ctx, pooler.MultiPooler))
+	}
+	fakeClient.EmergencyDemoteResponses["multipooler-zone1-mp1"] = &multipoolermanagerdatapb.EmergencyDemoteResponse{
+		LsnPosition: "0/3000000",
+	}
+
+	return c, fakeClient, ts, cohort
+}
+
+// poolerType returns the type of a pooler of zone1 in the topology.
+func poolerType(t *testing.T, ts topoclient.Store, name string) clustermetadatapb.PoolerType {
+	info, err := ts.GetMultiPooler(context.Background(), &clustermetadatapb.ID{
+		Component: clustermetadatapb.ID_MULTIPOOLER,
+		Cell:      "zone1",
+		Name:      name,
+	})
+	require.NoError(t, err)
+	return info.Type
+}
+
+func TestPlannedReparent(t *testing.T) {
+	ctx := context.Background()
+	shardKey := commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "0"}
+
+	t.Run("promotes the chosen replica", func(t *testing.T) {
+		c, fakeClient, ts, cohort := setupPlannedReparent(t)
+
+		result, err := c.PlannedReparent(ctx, shardKey, cohort, &clustermetadatapb.ID{Name: "mp2"}, 0)
+		require.NoError(t, err)
+		require.Equal(t, "mp1", result.PreviousPrimary.MultiPooler.Id.Name)
+		require.Equal(t, "mp2", result.NewPrimary.MultiPooler.Id.Name)
+		require.Equal(t, int64(6), result.Term)
+
+		// The primary is demoted before the new primary catches up and is
+		// promoted, and rejoins as a replica last.
+		calls := fakeClient.GetCallLog()
+		demote := slices.Index(calls, "EmergencyDemote(multipooler-zone1-mp1)")
+		wait := slices.Index(calls, "WaitForLSN(multipooler-zone1-mp2)")
+		promote := slices.Index(calls, "Promote(multipooler-zone1-mp2)")
+		rejoin := slices.Index(calls, "DemoteStalePrimary(multipooler-zone1-mp1)")
+		require.NotEqual(t, -1, demote)
+		require.Less(t, demote, wait)
+		require.Less(t, wait, promote)
+		require.Less(t, promote, rejoin)
+		require.NotContains(t, calls, "BeginTerm(multipooler-zone1-mp1)")
+
+		promoteReq := fakeClient.PromoteRequests["multipooler-zone1-mp2"]
+		require.Equal(t, PlannedReparentReason, promoteReq.Reason)
+		require.Equal(t, int64(6), promoteReq.ConsensusTerm)
+
+		require.Equal(t, clustermetadatapb.PoolerType_REPLICA, poolerType(t, ts, "mp1"))
+		require.Equal(t, clustermetadatapb.PoolerType_PRIMARY, poolerType(t, ts, "mp2"))
+		require.Equal(t, clustermetadatapb.PoolerType_REPLICA, poolerType(t, ts, "mp3"))
+	})
+
+	t.Run("promotes the most advanced replica by default", func(t *testing.T) {
+		c, _, ts, cohort := setupPlannedReparent(t)
+
+		result, err := c.PlannedReparent(ctx, shardKey, cohort, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, "mp3", result.NewPrimary.MultiPooler.Id.Name)
+		require.Equal(t, clustermetadatapb.PoolerType_PRIMARY, poolerType(t, ts, "mp3"))
+	})
+
+	t.Run("rejects the primary or an unknown pooler", func(t *testing.T) {
+		c, fakeClient, _, cohort := setupPlannedReparent(t)
+
+		_, err := c.PlannedReparent(ctx, shardKey, cohort, &clustermetadatapb.ID{Name: "mp1"}, 0)
+		require.ErrorContains(t, err, "already the primary")
+
+		_, err = c.PlannedReparent(ctx, shardKey, cohort, &clustermetadatapb.ID{Cell: "zone2", Name: "mp2"}, 0)
+		require.ErrorContains(t, err, "not a replica of the shard")
+
+		require.NotContains(t, fakeClient.GetCallLog(), "EmergencyDemote(multipooler-zone1-mp1)")
+	})
+
+	t.Run("keeps the primary when the replicas cannot form a quorum", func(t *testing.T) {
+		c, fakeClient, ts, cohort := setupPlannedReparent(t)
+
+		_, err := c.PlannedReparent(ctx, shardKey, cohort[:2], nil, 0)
+		require.ErrorContains(t, err, "cannot form a quorum without the primary")
+		require.NotContains(t, fakeClient.GetCallLog(), "EmergencyDemote(multipooler-zone1-mp1)")
+		require.Equal(t, clustermetadatapb.PoolerType_PRIMARY, poolerType(t, ts, "mp1"))
+	})
+
+	t.Run("keeps the primary when the new primary is unhealthy", func(t *testing.T) {
+		c, fakeClient, ts, cohort := setupPlannedReparent(t)
+		fakeClient.Errors["multipooler-zone1-mp2"] = errors.New("connection refused")
+
+		_, err := c.PlannedReparent(ctx, shardKey, cohort, &clustermetadatapb.ID{Name: "mp2"}, 0)
+		require.ErrorContains(t, err, "new primary mp2 is unreachable")
+		require.NotContains(t, fakeClient.GetCallLog(), "EmergencyDemote(multipooler-zone1-mp1)")
+		require.Equal(t, clustermetadatapb.PoolerType_PRIMARY, poolerType(t, ts, "mp1"))
+	})
+}
@@ -22,12 +22,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
 
 	"github.com/multigres/multigres/go/common/constants"
 	"github.com/multigres/multigres/go/common/rpcclient"
 	"github.com/multigres/multigres/go/common/servenv"
 	"github.com/multigres/multigres/go/common/servenv/toporeg"
 	"github.com/multigres/multigres/go/common/topoclient"
+	"github.com/multigres/multigres/go/services/multiorch/backup"
 	"github.com/multigres/multigres/go/services/multiorch/config"
 	"github.com/multigres/multigres/go/services/multiorch/coordinator"
 	"github.com/multigres/multigres/go/services/multiorch/recovery"
@@ -56,6 +58,7 @@ type MultiOrch struct {
 	// Orchestration components
 	cfg            *config.Config
 	recoveryEngine *recovery.Engine
+	backupSched    *backup.Scheduler
 }
 
 func (mo *MultiOrch) CobraPreRunE(cmd *cobra.Command) error {
@@ -159,7 +162,11 @@ func (mo *MultiOrch) Init() error {
 	mo.senv.HTTPHandleFunc("/ready", mo.handleReady)
 
 	// Create RPC client for recovery engine health checks
-	rpcClient := rpcclient.NewMultiPoolerClient(maxPoolerConnections)
+	creds, err := mo.connConfig.TransportCredentials()
+	if err != nil {
+		return err
+	}
+	rpcClient := rpcclient.NewMultiPoolerClient(maxPoolerConnections, grpc.WithTransportCredentials(creds))
 
 	// Create coordinator for consensus operations
 	coord := coordinator.NewCoordinator(multiorch.Id, mo.ts, rpcClient, logger)
@@ -178,6 +185,10 @@ func (mo *MultiOrch) Init() error {
 		return fmt.Errorf("failed to start recovery engine: %w", err)
 	}
 
+	// Take scheduled backups of the shards discovered by the recovery engine
+	mo.backupSched = backup.NewScheduler(mo.cfg, logger, rpcClient, mo.recoveryEngine.PoolerStore())
+	mo.backupSched.Start()
+
 	mo.senv.OnClose(func() {
 		mo.Shutdown()
 	})
@@ -186,6 +197,9 @@ func (mo *MultiOrch) Init() error {
 
 func (mo *MultiOrch) Shutdown() {
 	mo.senv.GetLogger().Info("multiorch shutting down")
+	if mo.backupSched != nil {
+		mo.backupSched.Stop()
+	}
 	if mo.recoveryEngine != nil {
 		mo.recoveryEngine.Stop()
 	}
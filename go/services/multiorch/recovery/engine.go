@@ -335,6 +335,12 @@ func NewEngine(
 // SetConfigReloader sets the function to reload configuration dynamically.
 // The reloader function should return raw string targets (e.g., from viper).
 // Only shardWatchTargets can be reloaded; intervals require a restart.
+// PoolerStore returns the store of poolers discovered by the engine, kept
+// up to date by its health checks.
+func (re *Engine) PoolerStore() *store.PoolerHealthStore {
+	return re.poolerStore
+}
+
 func (re *Engine) SetConfigReloader(reloader func() []string) {
 	re.reloadConfig = reloader
 }
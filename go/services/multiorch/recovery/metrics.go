@@ -24,6 +24,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/multigres/multigres/go/tools/telemetry"
 )
 
 // PoolerPollStatus represents the possible status values for a pooler health check poll.
@@ -50,6 +52,7 @@ type Metrics struct {
 	recoveryActionDuration         RecoveryActionDuration
 	errorsTotal                    ErrorsTotal
 	detectedProblems               DetectedProblems
+	failovers                      Failovers
 }
 
 // PoolerStoreSize wraps an Int64ObservableGauge for observing pooler store size.
@@ -201,6 +204,30 @@ func (m ErrorsTotal) Add(ctx context.Context, source string, attrs ...attribute.
 		))
 }
 
+// Failovers wraps an Int64Counter for counting the failovers of the
+// primaries of the shards.
+type Failovers struct {
+	metric.Int64Counter
+}
+
+// Add increments the failover counter of a shard.
+//
+// Parameters:
+//   - ctx: Context for the metric recording
+//   - status: Whether a new primary was appointed (success or failure)
+//   - database: The database name
+//   - tablegroup: The table group name
+//   - shard: The shard identifier
+func (m Failovers) Add(ctx context.Context, status RecoveryActionStatus, database, tablegroup, shard string) {
+	m.Int64Counter.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.String("status", string(status)),
+			attribute.String(telemetry.AttrDatabase, database),
+			attribute.String(telemetry.AttrTableGroup, tablegroup),
+			attribute.String(telemetry.AttrShard, shard),
+		))
+}
+
 // DetectedProblems wraps an Int64ObservableGauge for observing detected problems by type.
 // Use the Inst() method to get the underlying gauge for callback registration.
 type DetectedProblems struct {
@@ -318,6 +345,19 @@ func NewMetrics() (*Metrics, error) {
 		m.detectedProblems = DetectedProblems{detectedProblemsGauge}
 	}
 
+	// Counter for failovers
+	failoversCounter, err := m.meter.Int64Counter(
+		"multiorch.recovery.failovers",
+		metric.WithDescription("Total number of failovers of the primaries of the shards"),
+		metric.WithUnit("{failover}"),
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("multiorch.recovery.failovers counter: %w", err))
+		m.failovers = Failovers{noop.Int64Counter{}}
+	} else {
+		m.failovers = Failovers{failoversCounter}
+	}
+
 	if len(errs) > 0 {
 		return m, errors.Join(errs...)
 	}
@@ -16,6 +16,7 @@ package recovery
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -118,6 +119,17 @@ func (re *Engine) processShardProblems(ctx context.Context, shardKey commontypes
 		"problem_count", len(problems),
 	)
 
+	// Leave the shard alone while another operation holds its lock, such as
+	// a planned reparent: the problems it causes are expected and resolved
+	// by the operation itself.
+	if re.shardLocked(ctx, shardKey) {
+		re.logger.InfoContext(ctx, "skipping recovery - shard is locked by another operation",
+			"shard_key", shardKey.String(),
+			"problem_count", len(problems),
+		)
+		return
+	}
+
 	// Sort by priority and apply filtering logic
 	filteredProblems := re.filterAndPrioritize(problems)
 
@@ -139,6 +151,32 @@ func (re *Engine) processShardProblems(ctx context.Context, shardKey commontypes
 	}
 }
 
+// shardLocked returns true if the lock of the shard is held. The lock is
+// only probed: it is released right away for the recovery actions that
+// take it themselves.
+func (re *Engine) shardLocked(ctx context.Context, shardKey commontypes.ShardKey) bool {
+	_, unlock, err := re.ts.TryLockShard(ctx, shardKey, "recovery lock check")
+	if errors.Is(err, &topoclient.TopoError{Code: topoclient.NodeExists}) {
+		return true
+	}
+	if err != nil {
+		re.logger.WarnContext(ctx, "failed to check shard lock",
+			"shard_key", shardKey.String(),
+			"error", err,
+		)
+		return false
+	}
+	var unlockErr error
+	unlock(&unlockErr)
+	if unlockErr != nil {
+		re.logger.WarnContext(ctx, "failed to release shard lock",
+			"shard_key", shardKey.String(),
+			"error", unlockErr,
+		)
+	}
+	return false
+}
+
 // hasPrimaryProblem checks if any of the problems indicate an unhealthy primary.
 // Shard-wide problems (e.g., PrimaryDead) imply an unhealthy primary.
 func (re *Engine) hasPrimaryProblem(problems []types.Problem) bool {
@@ -257,6 +295,7 @@ func (re *Engine) attemptRecovery(ctx context.Context, problem types.Problem) {
 			"error", err,
 		)
 		re.metrics.recoveryActionDuration.Record(ctx, durationMs, actionName, string(problem.Code), RecoveryActionStatusFailure, problem.ShardKey.Database, problem.ShardKey.Shard)
+		re.recordFailover(ctx, problem, RecoveryActionStatusFailure)
 		return
 	}
 
@@ -266,6 +305,7 @@ func (re *Engine) attemptRecovery(ctx context.Context, problem types.Problem) {
 		"pooler_id", poolerIDStr,
 	)
 	re.metrics.recoveryActionDuration.Record(ctx, durationMs, actionName, string(problem.Code), RecoveryActionStatusSuccess, problem.ShardKey.Database, problem.ShardKey.Shard)
+	re.recordFailover(ctx, problem, RecoveryActionStatusSuccess)
 
 	// Post-recovery refresh
 	// If we ran a shard-wide recovery, force health check all poolers in the shard
@@ -279,6 +319,15 @@ func (re *Engine) attemptRecovery(ctx context.Context, problem types.Problem) {
 	}
 }
 
+// recordFailover counts the recovery of a dead primary as a failover of
+// its shard.
+func (re *Engine) recordFailover(ctx context.Context, problem types.Problem, status RecoveryActionStatus) {
+	if problem.Code != types.ProblemPrimaryIsDead {
+		return
+	}
+	re.metrics.failovers.Add(ctx, status, problem.ShardKey.Database, problem.ShardKey.TableGroup, problem.ShardKey.Shard)
+}
+
 // recheckProblem force re-polls the pooler and re-runs analysis
 // to check if the problem still exists.
 //
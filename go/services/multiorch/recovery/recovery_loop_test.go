@@ -848,6 +848,55 @@ func TestProcessShardProblems_DependencyEnforcement(t *testing.T) {
 	})
 }
 
+// TestProcessShardProblems_SkipsLockedShard tests that no recovery is
+// attempted on a shard whose lock is held, e.g. by a planned reparent.
+func TestProcessShardProblems_SkipsLockedShard(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := memorytopo.NewServerAndFactory(ctx, "cell1")
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	cfg := config.NewTestConfig(config.WithCell("cell1"))
+	fakeClient := rpcclient.NewFakeClient()
+	engine := NewEngine(ts, logger, cfg, []config.WatchTarget{}, fakeClient, newTestCoordinator(ts, fakeClient, "cell1"))
+
+	recovery := &mockRecoveryAction{
+		name:     "FixReplication",
+		priority: types.PriorityHigh,
+		metadata: types.RecoveryMetadata{
+			Name:    "FixReplication",
+			Timeout: 10 * time.Second,
+		},
+	}
+	shardKey := commontypes.ShardKey{Database: "db1", TableGroup: "tg1", Shard: "0"}
+	problems := []types.Problem{{
+		Code: types.ProblemReplicaNotReplicating,
+		PoolerID: &clustermetadatapb.ID{
+			Component: clustermetadatapb.ID_MULTIPOOLER,
+			Cell:      "cell1",
+			Name:      "replica-pooler",
+		},
+		ShardKey:       shardKey,
+		Priority:       types.PriorityHigh,
+		Scope:          types.ScopePooler,
+		RecoveryAction: recovery,
+		DetectedAt:     time.Now(),
+	}}
+
+	_, unlock, err := ts.LockShard(ctx, shardKey, "planned reparent")
+	require.NoError(t, err)
+	engine.processShardProblems(ctx, shardKey, problems)
+	assert.False(t, recovery.executed, "recovery should be skipped while the shard is locked")
+	var unlockErr error
+	unlock(&unlockErr)
+	require.NoError(t, unlockErr)
+
+	// Checking the lock of an unlocked shard does not keep it.
+	engine.processShardProblems(ctx, shardKey, problems)
+	_, unlock, err = ts.TryLockShard(ctx, shardKey, "test")
+	require.NoError(t, err)
+	unlock(&unlockErr)
+	require.NoError(t, unlockErr)
+}
+
 // TestRecoveryLoop_ValidationPreventsStaleRecovery tests that the validation step
 // correctly prevents recovery when the problem no longer exists.
 func TestRecoveryLoop_ValidationPreventsStaleRecovery(t *testing.T) {
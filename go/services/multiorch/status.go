@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/multigres/multigres/go/common/web"
+	"github.com/multigres/multigres/go/services/multiorch/backup"
 )
 
 // Link represents a link on the status page.
@@ -40,6 +41,8 @@ type Status struct {
 
 	Cell string `json:"cell"`
 
+	Backups []backup.ShardStatus `json:"backups"`
+
 	Links []Link `json:"links"`
 }
 
@@ -50,6 +53,9 @@ func (mo *MultiOrch) handleIndex(w http.ResponseWriter, r *http.Request) {
 
 	mo.serverStatus.Cell = mo.cfg.GetCell()
 	mo.serverStatus.TopoStatus = mo.ts.Status()
+	if mo.backupSched != nil {
+		mo.serverStatus.Backups = mo.backupSched.Status()
+	}
 	err := web.Templates.ExecuteTemplate(w, "orch_index.html", &mo.serverStatus)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to execute template: %v", err), http.StatusInternalServerError)
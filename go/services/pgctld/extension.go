@@ -0,0 +1,157 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgctld
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// extensionNameRegexp matches the extension names that can be installed.
+var extensionNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// ValidateExtensionName returns an error if name is not a valid extension
+// name. Names are also used to find the files of the extension.
+func ValidateExtensionName(name string) error {
+	if !extensionNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid extension name %q", name)
+	}
+	return nil
+}
+
+// ExtensionControl holds the parameters of an extension control file that
+// the preflight checks need.
+type ExtensionControl struct {
+	DefaultVersion string
+	// ModulePathname is the shared library of the extension, usually
+	// $libdir/<name>, or "" for extensions written in SQL only.
+	ModulePathname string
+	Requires       []string
+}
+
+// controlLineRegexp matches a parameter of an extension control file.
+var controlLineRegexp = regexp.MustCompile(`^\s*([a-z_]+)\s*=\s*(?:'((?:[^']|'')*)'|(\S+))\s*(?:#.*)?$`)
+
+// ReadExtensionControl parses an extension control file.
+func ReadExtensionControl(file string) (*ExtensionControl, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	control := &ExtensionControl{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := controlLineRegexp.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		value := m[3]
+		if value == "" {
+			value = strings.ReplaceAll(m[2], "''", "'")
+		}
+		switch m[1] {
+		case "default_version":
+			control.DefaultVersion = value
+		case "module_pathname":
+			control.ModulePathname = value
+		case "requires":
+			for req := range strings.SplitSeq(value, ",") {
+				if req = strings.TrimSpace(req); req != "" {
+					control.Requires = append(control.Requires, req)
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return control, nil
+}
+
+// sharedLibrarySuffixes are the file name suffixes of shared libraries on
+// the platforms PostgreSQL runs on.
+var sharedLibrarySuffixes = []string{".so", ".dylib", ".dll"}
+
+// sharedLibraryFile returns the file of a shared library referenced as
+// $libdir/<name> or by an absolute path, or "" if it does not exist.
+func sharedLibraryFile(pkgLibDir, pathname string) string {
+	file := pathname
+	if rest, ok := strings.CutPrefix(pathname, "$libdir/"); ok {
+		file = filepath.Join(pkgLibDir, rest)
+	} else if !filepath.IsAbs(pathname) {
+		file = filepath.Join(pkgLibDir, pathname)
+	}
+	for _, suffix := range append([]string{""}, sharedLibrarySuffixes...) {
+		if info, err := os.Stat(file + suffix); err == nil && !info.IsDir() {
+			return file + suffix
+		}
+	}
+	return ""
+}
+
+// CheckExtensionFiles checks that the files needed to create an extension,
+// or update it to version, are installed: its control file, the scripts of
+// the version, and its shared library, as well as the control files of the
+// extensions it requires. It returns the control of the extension.
+func CheckExtensionFiles(shareDir, pkgLibDir, name, version string) (*ExtensionControl, error) {
+	if err := ValidateExtensionName(name); err != nil {
+		return nil, err
+	}
+	extensionDir := filepath.Join(shareDir, "extension")
+	control, err := ReadExtensionControl(filepath.Join(extensionDir, name+".control"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("extension %s is not available: no %s.control in %s", name, name, extensionDir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the control file of extension %s: %w", name, err)
+	}
+
+	if version != "" {
+		// Either an install script or an update script must lead to version.
+		scripts, err := filepath.Glob(filepath.Join(extensionDir, name+"--*"+version+".sql"))
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, script := range scripts {
+			base := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(script), name+"--"), ".sql")
+			if base == version || strings.HasSuffix(base, "--"+version) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("version %s of extension %s is not available: no script in %s", version, name, extensionDir)
+		}
+	}
+
+	if control.ModulePathname != "" && sharedLibraryFile(pkgLibDir, control.ModulePathname) == "" {
+		return nil, fmt.Errorf("shared library %s of extension %s not found in %s", control.ModulePathname, name, pkgLibDir)
+	}
+
+	for _, req := range control.Requires {
+		if _, err := os.Stat(filepath.Join(extensionDir, req+".control")); err != nil {
+			return nil, fmt.Errorf("extension %s requires extension %s, which is not available", name, req)
+		}
+	}
+	return control, nil
+}
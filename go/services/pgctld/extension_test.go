@@ -0,0 +1,101 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgctld
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeExtension installs the files of an extension into shareDir and
+// pkgLibDir. The shared library is left out if library is "".
+func writeExtension(t *testing.T, shareDir, pkgLibDir, name, control, library string, scripts ...string) {
+	t.Helper()
+	extensionDir := filepath.Join(shareDir, "extension")
+	require.NoError(t, os.MkdirAll(extensionDir, 0o755))
+	require.NoError(t, os.MkdirAll(pkgLibDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(extensionDir, name+".control"), []byte(control), 0o644))
+	for _, script := range scripts {
+		require.NoError(t, os.WriteFile(filepath.Join(extensionDir, script), []byte("-- script\n"), 0o644))
+	}
+	if library != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(pkgLibDir, library), []byte{}, 0o755))
+	}
+}
+
+func TestReadExtensionControl(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "postgis_topology.control")
+	require.NoError(t, os.WriteFile(file, []byte(`# postgis topology extension
+comment = 'PostGIS topology spatial types and functions'
+default_version = '3.5.0'
+module_pathname = '$libdir/postgis_topology-3'
+relocatable = false
+requires = 'postgis, plpgsql'
+trusted = true # comment
+`), 0o644))
+
+	control, err := ReadExtensionControl(file)
+	require.NoError(t, err)
+	assert.Equal(t, "3.5.0", control.DefaultVersion)
+	assert.Equal(t, "$libdir/postgis_topology-3", control.ModulePathname)
+	assert.Equal(t, []string{"postgis", "plpgsql"}, control.Requires)
+}
+
+func TestCheckExtensionFiles(t *testing.T) {
+	dir := t.TempDir()
+	shareDir := filepath.Join(dir, "share")
+	pkgLibDir := filepath.Join(dir, "lib")
+
+	writeExtension(t, shareDir, pkgLibDir, "pg_stat_statements",
+		"default_version = '1.11'\nmodule_pathname = '$libdir/pg_stat_statements'\n",
+		"pg_stat_statements.so",
+		"pg_stat_statements--1.4.sql", "pg_stat_statements--1.10--1.11.sql")
+	writeExtension(t, shareDir, pkgLibDir, "plpgsql", "default_version = '1.0'\n", "", "plpgsql--1.0.sql")
+	writeExtension(t, shareDir, pkgLibDir, "pgrouting",
+		"default_version = '3.6.0'\nmodule_pathname = '$libdir/libpgrouting-3.6'\nrequires = 'plpgsql, postgis'\n",
+		"libpgrouting-3.6.so", "pgrouting--3.6.0.sql")
+	writeExtension(t, shareDir, pkgLibDir, "pg_partman",
+		"default_version = '5.1.0'\nmodule_pathname = '$libdir/pg_partman_bgw'\n", "", "pg_partman--5.1.0.sql")
+
+	control, err := CheckExtensionFiles(shareDir, pkgLibDir, "pg_stat_statements", "")
+	require.NoError(t, err)
+	assert.Equal(t, "1.11", control.DefaultVersion)
+
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "pg_stat_statements", "1.11")
+	require.NoError(t, err, "an update script leads to 1.11")
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "pg_stat_statements", "1.4")
+	require.NoError(t, err, "an install script creates 1.4")
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "pg_stat_statements", "1.1")
+	assert.ErrorContains(t, err, "version 1.1 of extension pg_stat_statements is not available")
+
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "plpgsql", "")
+	require.NoError(t, err, "extensions without a shared library")
+
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "pg_partman", "")
+	assert.ErrorContains(t, err, "shared library $libdir/pg_partman_bgw of extension pg_partman not found")
+
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "pgrouting", "")
+	assert.ErrorContains(t, err, "requires extension postgis")
+
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "timescaledb", "")
+	assert.ErrorContains(t, err, "extension timescaledb is not available")
+
+	_, err = CheckExtensionFiles(shareDir, pkgLibDir, "../../etc/passwd", "")
+	assert.ErrorContains(t, err, "invalid extension name")
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgctld
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Pooler types the managed parameters are declared for.
+const (
+	PoolerTypePrimary = "primary"
+	PoolerTypeReplica = "replica"
+)
+
+// reservedParameters are set by pgctld or the multipooler themselves, and
+// cannot be managed.
+var reservedParameters = map[string]bool{
+	"port":                      true,
+	"listen_addresses":          true,
+	"unix_socket_directories":   true,
+	"data_directory":            true,
+	"hba_file":                  true,
+	"ident_file":                true,
+	"primary_conninfo":          true,
+	"primary_slot_name":         true,
+	"synchronous_standby_names": true,
+	"synchronous_commit":        true,
+}
+
+// parameterNameRegexp matches the names of PostgreSQL parameters, including
+// the prefixed parameters of extensions such as pg_stat_statements.max.
+var parameterNameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)?$`)
+
+// ManagedParameters declares the PostgreSQL parameters pgctld keeps in
+// postgresql.auto.conf, per pooler type.
+type ManagedParameters struct {
+	// Default applies to every pooler type.
+	Default map[string]string `yaml:"default"`
+	// Primary and Replica override Default for their pooler type.
+	Primary map[string]string `yaml:"primary"`
+	Replica map[string]string `yaml:"replica"`
+}
+
+// LoadManagedParameters reads managed parameters from a YAML file with a
+// default, primary and replica section, each mapping parameter names to
+// values.
+func LoadManagedParameters(file string) (*ManagedParameters, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read managed parameters: %w", err)
+	}
+	var params ManagedParameters
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&params); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse managed parameters %s: %w", file, err)
+	}
+	for _, section := range []map[string]string{params.Default, params.Primary, params.Replica} {
+		for name := range section {
+			if err := validateParameterName(name); err != nil {
+				return nil, fmt.Errorf("invalid managed parameters %s: %w", file, err)
+			}
+		}
+	}
+	return &params, nil
+}
+
+func validateParameterName(name string) error {
+	if !parameterNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid parameter name %q", name)
+	}
+	if reservedParameters[name] {
+		return fmt.Errorf("parameter %s is set by multigres and cannot be managed", name)
+	}
+	return nil
+}
+
+// Resolve returns the parameters of a pooler type.
+func (p *ManagedParameters) Resolve(poolerType string) (map[string]string, error) {
+	resolved := maps.Clone(p.Default)
+	if resolved == nil {
+		resolved = map[string]string{}
+	}
+	switch poolerType {
+	case PoolerTypePrimary:
+		maps.Copy(resolved, p.Primary)
+	case PoolerTypeReplica:
+		maps.Copy(resolved, p.Replica)
+	default:
+		return nil, fmt.Errorf("invalid pooler type %q, must be %s or %s", poolerType, PoolerTypePrimary, PoolerTypeReplica)
+	}
+	return resolved, nil
+}
+
+// PostgresAutoConfFile returns the location of postgresql.auto.conf, the
+// file written by ALTER SYSTEM.
+func PostgresAutoConfFile(poolerDir string) string {
+	return path.Join(PostgresDataDir(poolerDir), "postgresql.auto.conf")
+}
+
+// autoConfHeader is the header PostgreSQL writes to postgresql.auto.conf.
+const autoConfHeader = "# Do not edit this file manually!\n# It will be overwritten by the ALTER SYSTEM command.\n"
+
+// autoConfLineRegexp matches a parameter of postgresql.auto.conf. ALTER
+// SYSTEM always quotes values, doubling quotes within them.
+var autoConfLineRegexp = regexp.MustCompile(`^\s*([A-Za-z0-9_.]+)\s*=\s*'((?:[^']|'')*)'\s*$`)
+
+// ReadAutoConf returns the parameters set in a postgresql.auto.conf file. A
+// missing file has no parameters.
+func ReadAutoConf(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file, err)
+	}
+	defer f.Close()
+
+	params := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := autoConfLineRegexp.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		params[strings.ToLower(m[1])] = strings.ReplaceAll(m[2], "''", "'")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return params, nil
+}
+
+// WriteAutoConf sets and resets parameters in a postgresql.auto.conf file
+// the way ALTER SYSTEM does, keeping the other parameters. It is used while
+// PostgreSQL is stopped, when ALTER SYSTEM is not available.
+func WriteAutoConf(file string, set map[string]string, reset []string) error {
+	params, err := ReadAutoConf(file)
+	if err != nil {
+		return err
+	}
+	for _, name := range reset {
+		delete(params, name)
+	}
+	maps.Copy(params, set)
+
+	var b strings.Builder
+	b.WriteString(autoConfHeader)
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		fmt.Fprintf(&b, "%s = '%s'\n", name, strings.ReplaceAll(params[name], "'", "''"))
+	}
+	if err := os.WriteFile(file, []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", file, err)
+	}
+	return nil
+}
+
+// ParameterDrift is a managed parameter whose value in postgresql.auto.conf
+// is not the managed one, e.g. after a manual ALTER SYSTEM.
+type ParameterDrift struct {
+	Name     string
+	Expected string
+	// Actual is "" if the parameter is not set in postgresql.auto.conf.
+	Actual string
+}
+
+// DetectDrift compares the managed parameters with those of
+// postgresql.auto.conf, sorted by name.
+func DetectDrift(managed, autoConf map[string]string) []ParameterDrift {
+	var drift []ParameterDrift
+	for _, name := range slices.Sorted(maps.Keys(managed)) {
+		if actual := autoConf[name]; actual != managed[name] {
+			drift = append(drift, ParameterDrift{Name: name, Expected: managed[name], Actual: actual})
+		}
+	}
+	return drift
+}
+
+// ManagedConfigStateFile returns the location of the record of the managed
+// parameters last applied to the pooler.
+func ManagedConfigStateFile(poolerDir string) string {
+	return path.Join(poolerDir, "managed_config.json")
+}
+
+// ManagedConfigState records the managed parameters last applied to the
+// pooler, so that parameters dropped from the declaration can be reset and
+// drift can be checked without knowing the pooler type.
+type ManagedConfigState struct {
+	PoolerType string            `json:"pooler_type"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+// ReadManagedConfigState returns the managed parameters last applied to the
+// pooler, or nil if none were.
+func ReadManagedConfigState(poolerDir string) (*ManagedConfigState, error) {
+	data, err := os.ReadFile(ManagedConfigStateFile(poolerDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read managed config state: %w", err)
+	}
+	var state ManagedConfigState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse managed config state: %w", err)
+	}
+	return &state, nil
+}
+
+// WriteManagedConfigState records the managed parameters applied to the
+// pooler.
+func WriteManagedConfigState(poolerDir string, state *ManagedConfigState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal managed config state: %w", err)
+	}
+	if err := os.WriteFile(ManagedConfigStateFile(poolerDir), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write managed config state: %w", err)
+	}
+	return nil
+}
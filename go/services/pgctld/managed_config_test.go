@@ -0,0 +1,120 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgctld
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadManagedParameters(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		file := filepath.Join(dir, "managed.yaml")
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+		return file
+	}
+
+	params, err := LoadManagedParameters(write(`
+default:
+  work_mem: 64MB
+  pg_stat_statements.max: "10000"
+primary:
+  work_mem: 128MB
+replica:
+  hot_standby_feedback: "on"
+`))
+	require.NoError(t, err)
+
+	primary, err := params.Resolve(PoolerTypePrimary)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"work_mem": "128MB", "pg_stat_statements.max": "10000"}, primary)
+
+	replica, err := params.Resolve(PoolerTypeReplica)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"work_mem": "64MB", "pg_stat_statements.max": "10000", "hot_standby_feedback": "on"}, replica)
+
+	_, err = params.Resolve("rdonly")
+	assert.ErrorContains(t, err, "invalid pooler type")
+
+	params, err = LoadManagedParameters(write(""))
+	require.NoError(t, err)
+	resolved, err := params.Resolve(PoolerTypePrimary)
+	require.NoError(t, err)
+	assert.Empty(t, resolved)
+
+	_, err = LoadManagedParameters(write("default:\n  port: \"5433\"\n"))
+	assert.ErrorContains(t, err, "cannot be managed")
+
+	_, err = LoadManagedParameters(write("default:\n  \"work_mem; DROP\": 1\n"))
+	assert.ErrorContains(t, err, "invalid parameter name")
+
+	_, err = LoadManagedParameters(write("standby:\n  work_mem: 1MB\n"))
+	assert.ErrorContains(t, err, "failed to parse")
+}
+
+func TestAutoConf(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "postgresql.auto.conf")
+
+	params, err := ReadAutoConf(file)
+	require.NoError(t, err)
+	assert.Empty(t, params)
+
+	require.NoError(t, os.WriteFile(file, []byte(autoConfHeader+"work_mem = '64MB'\nshared_preload_libraries = 'pg_stat_statements'\n"), 0o600))
+	require.NoError(t, WriteAutoConf(file, map[string]string{
+		"work_mem":         "128MB",
+		"application_name": "it's",
+	}, []string{"shared_preload_libraries"}))
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, autoConfHeader+"application_name = 'it''s'\nwork_mem = '128MB'\n", string(content))
+
+	params, err = ReadAutoConf(file)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"work_mem": "128MB", "application_name": "it's"}, params)
+}
+
+func TestDetectDrift(t *testing.T) {
+	drift := DetectDrift(
+		map[string]string{"work_mem": "64MB", "max_connections": "200", "wal_level": "logical"},
+		map[string]string{"work_mem": "32MB", "wal_level": "logical", "other": "x"},
+	)
+	assert.Equal(t, []ParameterDrift{
+		{Name: "max_connections", Expected: "200", Actual: ""},
+		{Name: "work_mem", Expected: "64MB", Actual: "32MB"},
+	}, drift)
+}
+
+func TestManagedConfigState(t *testing.T) {
+	poolerDir := t.TempDir()
+
+	state, err := ReadManagedConfigState(poolerDir)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	require.NoError(t, WriteManagedConfigState(poolerDir, &ManagedConfigState{
+		PoolerType: PoolerTypeReplica,
+		Parameters: map[string]string{"work_mem": "64MB"},
+	}))
+	state, err = ReadManagedConfigState(poolerDir)
+	require.NoError(t, err)
+	assert.Equal(t, PoolerTypeReplica, state.PoolerType)
+	assert.Equal(t, map[string]string{"work_mem": "64MB"}, state.Parameters)
+}
@@ -44,9 +44,10 @@ type PostgresServerConfig struct {
 	IdentFile string // matches {{.IdentFile}} in template
 
 	// Memory settings
-	SharedBuffers      string
-	MaintenanceWorkMem string
-	WorkMem            string
+	SharedBuffers           string
+	MaintenanceWorkMem      string
+	WorkMem                 string
+	MaxPreparedTransactions int
 
 	// Worker and parallel settings
 	MaxWorkerProcesses            int
@@ -231,6 +232,12 @@ func ReadPostgresServerConfig(pgConfig *PostgresServerConfig, waitTime time.Dura
 		pgConfig.WorkMem = val
 	}
 
+	// Prepared transactions are used by two-phase commits across shards;
+	// configs generated before they were enabled leave them disabled.
+	if pgConfig.MaxPreparedTransactions, parseErr = pgConfig.lookupInt("max_prepared_transactions"); parseErr != nil {
+		pgConfig.MaxPreparedTransactions = 0 // default
+	}
+
 	// Worker and parallel settings - required in our controlled config
 	if pgConfig.MaxWorkerProcesses, parseErr = pgConfig.lookupInt("max_worker_processes"); parseErr != nil {
 		return nil, fmt.Errorf("max_worker_processes not found in config file: %w", parseErr)
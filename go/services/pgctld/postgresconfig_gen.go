@@ -86,6 +86,9 @@ func GeneratePostgresServerConfig(poolerDir string, port int, pgUser string) (*P
 	cnf.SharedBuffers = "64MB"
 	cnf.MaintenanceWorkMem = "16MB"
 	cnf.WorkMem = "1092kB"
+	// Two-phase commits across shards prepare at most one transaction per
+	// connection.
+	cnf.MaxPreparedTransactions = cnf.MaxConnections
 	cnf.MaxWorkerProcesses = 6
 	// TODO: @rafael - This setting doesn't work for local on macOS environment,
 	// so it's not matching exactly what we have in Supabase.
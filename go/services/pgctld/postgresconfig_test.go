@@ -352,6 +352,7 @@ func TestGenerateAndReadConfigRoundTrip(t *testing.T) {
 	assert.NotEmpty(t, result.SharedBuffers, "SharedBuffers should be set")
 	assert.NotEmpty(t, result.MaintenanceWorkMem, "MaintenanceWorkMem should be set")
 	assert.NotEmpty(t, result.WorkMem, "WorkMem should be set")
+	assert.Equal(t, result.MaxConnections, result.MaxPreparedTransactions, "MaxPreparedTransactions should allow a prepared transaction per connection")
 	assert.NotZero(t, result.MaxWorkerProcesses, "MaxWorkerProcesses should be set")
 	// This is an exception, the default is set to zero
 	assert.Zero(t, result.EffectiveIoConcurrency, "EffectiveIoConcurrency should be set")
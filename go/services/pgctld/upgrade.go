@@ -0,0 +1,141 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgctld
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// PostgresBinDirFile returns the location of the file recording the directory
+// of the PostgreSQL binaries that run the pooler's data directory. The file
+// is written by major version upgrades; without it, binaries are looked up
+// in PATH.
+func PostgresBinDirFile(poolerDir string) string {
+	return path.Join(poolerDir, "pg_bin_dir")
+}
+
+// PostgresBinDir returns the directory of the pooler's PostgreSQL binaries,
+// or "" if they are looked up in PATH.
+func PostgresBinDir(poolerDir string) (string, error) {
+	data, err := os.ReadFile(PostgresBinDirFile(poolerDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read PostgreSQL binary directory: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SetPostgresBinDir records the directory of the pooler's PostgreSQL
+// binaries. An empty directory goes back to looking them up in PATH.
+func SetPostgresBinDir(poolerDir, binDir string) error {
+	if binDir == "" {
+		if err := os.Remove(PostgresBinDirFile(poolerDir)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove PostgreSQL binary directory file: %w", err)
+		}
+		return nil
+	}
+	if err := os.WriteFile(PostgresBinDirFile(poolerDir), []byte(binDir+"\n"), 0o644); err != nil {
+		return fmt.Errorf("failed to write PostgreSQL binary directory file: %w", err)
+	}
+	return nil
+}
+
+// PostgresBinary returns the program to execute for a PostgreSQL server
+// binary such as pg_ctl or initdb, taking upgrades of the pooler into account.
+func PostgresBinary(poolerDir, name string) string {
+	binDir, err := PostgresBinDir(poolerDir)
+	if err != nil || binDir == "" {
+		return name
+	}
+	return filepath.Join(binDir, name)
+}
+
+// PreUpgradeDataDir returns the location the data directory is moved to by a
+// major version upgrade. It is kept as the rollback point until the upgrade
+// is finalized.
+func PreUpgradeDataDir(poolerDir string) string {
+	return path.Join(poolerDir, "pg_data.pre_upgrade")
+}
+
+// UpgradeStateFile returns the location of the state of a major version
+// upgrade that was neither finalized nor rolled back.
+func UpgradeStateFile(poolerDir string) string {
+	return path.Join(poolerDir, "pg_upgrade_state.json")
+}
+
+// UpgradeState describes a major version upgrade of the data directory that
+// can still be rolled back.
+type UpgradeState struct {
+	OldVersion string `json:"old_version"`
+	NewVersion string `json:"new_version"`
+	// OldBinDir is the binary directory before the upgrade, "" for PATH.
+	OldBinDir string `json:"old_bin_dir"`
+	NewBinDir string `json:"new_bin_dir"`
+	// Link is set when pg_upgrade hard-linked the data files into the new
+	// data directory, and Started once the upgraded cluster was started.
+	// The old cluster cannot be used anymore once both are set.
+	Link    bool `json:"link"`
+	Started bool `json:"started"`
+}
+
+// CanRollback returns true if the old data directory is still usable.
+func (s *UpgradeState) CanRollback() bool {
+	return !s.Link || !s.Started
+}
+
+// ReadUpgradeState returns the state of the pending upgrade of the pooler,
+// or nil if there is none.
+func ReadUpgradeState(poolerDir string) (*UpgradeState, error) {
+	data, err := os.ReadFile(UpgradeStateFile(poolerDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upgrade state: %w", err)
+	}
+	var state UpgradeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse upgrade state: %w", err)
+	}
+	return &state, nil
+}
+
+// WriteUpgradeState records the state of the pending upgrade of the pooler.
+func WriteUpgradeState(poolerDir string, state *UpgradeState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal upgrade state: %w", err)
+	}
+	if err := os.WriteFile(UpgradeStateFile(poolerDir), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write upgrade state: %w", err)
+	}
+	return nil
+}
+
+// RemoveUpgradeState forgets the pending upgrade of the pooler.
+func RemoveUpgradeState(poolerDir string) error {
+	if err := os.Remove(UpgradeStateFile(poolerDir)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove upgrade state: %w", err)
+	}
+	return nil
+}
@@ -162,6 +162,7 @@ timeout: 30
 		serverCmd := exec.Command("pgctld", "server",
 			"--pooler-dir", dataDir,
 			"--grpc-port", strconv.Itoa(grpcPort),
+			"--grpc-insecure",
 			"--pg-port", strconv.Itoa(pgPort),
 			"--config-file", pgctldConfigFile)
 
@@ -1107,6 +1108,7 @@ func TestOrphanDetectionWithRealPostgreSQL(t *testing.T) {
 	serverCmd := exec.Command("pgctld", "server",
 		"--pooler-dir", dataDir,
 		"--grpc-port", strconv.Itoa(grpcPort),
+		"--grpc-insecure",
 		"--pg-port", strconv.Itoa(pgPort),
 		"--config-file", pgctldConfigFile)
 
@@ -268,18 +268,47 @@ func TestMultiGateway_CopyCommands(t *testing.T) {
 		assert.Equal(t, int64(rowCount), count, "row count mismatch for large COPY")
 	})
 
+	// Test 6b: COPY TO STDOUT round trip
+	t.Run("COPY TO STDOUT", func(t *testing.T) {
+		tableName := "copy_to_stdout_test"
+		createCopyTestTable(t, conn, ctx, tableName, "id INT, name TEXT")
+
+		data := [][]any{{1, "Alice"}, {2, "Bob"}, {3, nil}}
+		executeCopyAndVerify(t, conn, ctx, tableName, []string{"id", "name"}, data)
+
+		var out strings.Builder
+		tag, err := conn.PgConn().CopyTo(ctx, &out, fmt.Sprintf("COPY %s TO STDOUT", tableName))
+		require.NoError(t, err, "COPY TO STDOUT failed")
+		assert.Equal(t, int64(3), tag.RowsAffected())
+		assert.Equal(t, "1\tAlice\n2\tBob\n3\t\\N\n", out.String())
+
+		// A query works too, and the output can be loaded back in.
+		var csv strings.Builder
+		_, err = conn.PgConn().CopyTo(ctx, &csv,
+			fmt.Sprintf("COPY (SELECT id, name FROM %s WHERE id < 3 ORDER BY id) TO STDOUT (FORMAT csv)", tableName))
+		require.NoError(t, err, "COPY (query) TO STDOUT failed")
+		assert.Equal(t, "1,Alice\n2,Bob\n", csv.String())
+
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader(csv.String()),
+			fmt.Sprintf("COPY %s FROM STDIN (FORMAT csv)", tableName))
+		require.NoError(t, err)
+		var count int64
+		require.NoError(t, conn.QueryRow(ctx, "SELECT COUNT(*) FROM "+tableName).Scan(&count))
+		assert.Equal(t, int64(5), count)
+
+		// An error from PostgreSQL is reported and the connection stays usable.
+		_, err = conn.PgConn().CopyTo(ctx, &out, "COPY copy_no_such_table TO STDOUT")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not exist")
+		require.NoError(t, conn.Ping(ctx))
+	})
+
 	// Test 7: Unsupported COPY operations
 	t.Run("unsupported COPY operations", func(t *testing.T) {
 		// Create a test table for unsupported operations
 		tableName := "copy_unsupported"
 		createCopyTestTable(t, conn, ctx, tableName, "id INT, name TEXT")
 
-		t.Run("COPY TO STDOUT fails", func(t *testing.T) {
-			_, err := conn.Exec(ctx, fmt.Sprintf("COPY %s TO STDOUT", tableName))
-			require.Error(t, err, "COPY TO STDOUT should fail")
-			assert.Contains(t, err.Error(), "not yet supported", "should mention not supported")
-		})
-
 		t.Run("COPY FROM file pass-through", func(t *testing.T) {
 			tableName := "copy_from_file_test"
 			createCopyTestTable(t, conn, ctx, tableName, "id INT, name TEXT")
@@ -97,6 +97,7 @@ func (p *ProcessInstance) startPgctld(ctx context.Context, t *testing.T) error {
 	p.Process = exec.Command(p.Binary, "server",
 		"--pooler-dir", p.DataDir,
 		"--grpc-port", strconv.Itoa(p.GrpcPort),
+		"--grpc-insecure",
 		"--pg-port", strconv.Itoa(p.PgPort),
 		"--timeout", "60",
 		"--log-output", p.LogFile)
@@ -126,6 +127,7 @@ func (p *ProcessInstance) startMultipooler(ctx context.Context, t *testing.T) er
 	socketFile := filepath.Join(p.DataDir, "pg_sockets", fmt.Sprintf(".s.PGSQL.%d", p.PgPort))
 	args := []string{
 		"--grpc-port", strconv.Itoa(p.GrpcPort),
+		"--grpc-insecure",
 		"--database", "postgres", // Required parameter
 		"--table-group", "default", // Required parameter (MVP only supports "default")
 		"--shard", "0-inf", // Required parameter (MVP only supports "0-inf")
@@ -181,6 +183,7 @@ func (p *ProcessInstance) startMultiOrch(ctx context.Context, t *testing.T) erro
 		"--topo-global-server-addresses", p.EtcdAddr,
 		"--topo-global-root", "/multigres/global",
 		"--grpc-port", strconv.Itoa(p.GrpcPort),
+		"--grpc-insecure",
 		"--http-port", strconv.Itoa(p.HttpPort),
 		"--hostname", "localhost",
 		"--bookkeeping-interval", "2s",
@@ -241,6 +244,7 @@ func (p *ProcessInstance) startMultigateway(ctx context.Context, t *testing.T) e
 		"--topo-global-server-addresses", p.EtcdAddr,
 		"--topo-global-root", p.GlobalRoot,
 		"--grpc-port", strconv.Itoa(p.GrpcPort),
+		"--grpc-insecure",
 		"--http-port", strconv.Itoa(p.HttpPort),
 		"--hostname", "localhost",
 		"--log-level", "debug",
@@ -0,0 +1,219 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endtoend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/cmd/pgctld/testutil"
+	"github.com/multigres/multigres/go/common/pgprotocol/client"
+	"github.com/multigres/multigres/go/common/pgprotocol/server"
+	"github.com/multigres/multigres/go/common/queryservice"
+	"github.com/multigres/multigres/go/common/sqltypes"
+	clustermetadatapb "github.com/multigres/multigres/go/pb/clustermetadata"
+	"github.com/multigres/multigres/go/pb/query"
+	"github.com/multigres/multigres/go/services/multigateway/handler"
+	"github.com/multigres/multigres/go/services/multigateway/scatterconn"
+	"github.com/multigres/multigres/go/test/utils"
+)
+
+// postgresShards is a gateway running the queries of each shard directly on
+// its PostgreSQL server, on a connection of its own per reserved connection.
+type postgresShards struct {
+	queryservice.QueryService
+
+	// sockets maps the shards to the socket files of their servers.
+	sockets map[string]string
+
+	mu       sync.Mutex
+	reserved map[uint64]*client.Conn
+	nextID   uint64
+}
+
+func (s *postgresShards) connect(ctx context.Context, target *query.Target) (*client.Conn, error) {
+	socket, ok := s.sockets[target.Shard]
+	if !ok {
+		return nil, fmt.Errorf("unknown shard %q", target.Shard)
+	}
+	return client.Connect(ctx, &client.Config{SocketFile: socket, User: "postgres", Database: "postgres"})
+}
+
+// conn returns the reserved connection of options, or a new connection,
+// closed by release.
+func (s *postgresShards) conn(ctx context.Context, target *query.Target, options *query.ExecuteOptions) (conn *client.Conn, release func(), err error) {
+	if id := options.GetReservedConnectionId(); id != 0 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		conn, ok := s.reserved[id]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown reserved connection %d", id)
+		}
+		return conn, func() {}, nil
+	}
+	conn, err = s.connect(ctx, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, func() { _ = conn.Close() }, nil
+}
+
+func (s *postgresShards) QueryServiceByID(context.Context, *clustermetadatapb.ID, *query.Target) (queryservice.QueryService, error) {
+	return s, nil
+}
+
+func (s *postgresShards) ExecuteQuery(ctx context.Context, target *query.Target, sql string, options *query.ExecuteOptions) (*sqltypes.Result, error) {
+	conn, release, err := s.conn(ctx, target, options)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	results, err := conn.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	return results[len(results)-1], nil
+}
+
+func (s *postgresShards) StreamExecute(
+	ctx context.Context,
+	target *query.Target,
+	sql string,
+	options *query.ExecuteOptions,
+	callback func(context.Context, *sqltypes.Result) error,
+) error {
+	conn, release, err := s.conn(ctx, target, options)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return conn.QueryStreaming(ctx, sql, callback)
+}
+
+func (s *postgresShards) ReserveConnection(ctx context.Context, target *query.Target, _ *query.ExecuteOptions) (queryservice.ReservedState, error) {
+	conn, err := s.connect(ctx, target)
+	if err != nil {
+		return queryservice.ReservedState{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	s.reserved[s.nextID] = conn
+	return queryservice.ReservedState{ReservedConnectionId: s.nextID}, nil
+}
+
+func (s *postgresShards) ReleaseReservedConnection(_ context.Context, _ *query.Target, options *query.ExecuteOptions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conn, ok := s.reserved[options.GetReservedConnectionId()]
+	if !ok {
+		return nil
+	}
+	delete(s.reserved, options.GetReservedConnectionId())
+	return conn.Close()
+}
+
+// startPostgreSQL initializes and starts a PostgreSQL server with the
+// configuration pgctld generates, and returns its socket file.
+func startPostgreSQL(t *testing.T, poolerDir, configFile string) string {
+	t.Helper()
+	port := strconv.Itoa(utils.GetFreePort(t))
+	for _, command := range []string{"init", "start"} {
+		cmd := exec.Command("pgctld", command, "--pooler-dir", poolerDir, "--pg-port", port, "--config-file", configFile)
+		setupTestEnv(cmd)
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, "pgctld %s failed with output: %s", command, string(output))
+	}
+	t.Cleanup(func() {
+		cmd := exec.Command("pgctld", "stop", "--pooler-dir", poolerDir, "--pg-port", port, "--config-file", configFile)
+		setupTestEnv(cmd)
+		_ = cmd.Run()
+	})
+	return filepath.Join(poolerDir, "pg_sockets", ".s.PGSQL."+port)
+}
+
+// TestTwoPhaseCommitAcrossShards commits a transaction spanning two shards
+// in the twopc transaction mode, on PostgreSQL servers configured by
+// pgctld.
+func TestTwoPhaseCommitAcrossShards(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping end-to-end tests in short mode")
+	}
+	if !utils.HasPostgreSQLBinaries() {
+		t.Fatal("PostgreSQL binaries not found, make sure to install PostgreSQL and add it to the PATH")
+	}
+
+	tempDir, cleanup := testutil.TempDir(t, "twopc_e2e_test")
+	defer cleanup()
+	configFile := filepath.Join(tempDir, ".pgctld.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("log-level: info\ntimeout: 30\n"), 0o644))
+
+	shards := &postgresShards{sockets: map[string]string{}, reserved: map[uint64]*client.Conn{}}
+	for _, shard := range []string{"0", "1"} {
+		shards.sockets[shard] = startPostgreSQL(t, filepath.Join(tempDir, "shard"+shard), configFile)
+	}
+	// The transaction log is kept on the default shard.
+	shards.sockets[""] = shards.sockets["0"]
+
+	ctx := utils.WithTimeout(t, 60*time.Second)
+	run := func(shard, sql string) *sqltypes.Result {
+		t.Helper()
+		result, err := shards.ExecuteQuery(ctx, &query.Target{Shard: shard}, sql, &query.ExecuteOptions{})
+		require.NoError(t, err, sql)
+		return result
+	}
+	// The transaction log, as the multipooler creates it.
+	run("", "CREATE SCHEMA multigres")
+	run("", `CREATE TABLE multigres.transaction_log (
+		gid TEXT PRIMARY KEY,
+		state TEXT NOT NULL CHECK (state IN ('prepare', 'commit', 'abort')),
+		participants JSONB NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+	for _, shard := range []string{"0", "1"} {
+		run(shard, "CREATE TABLE accounts (id INT PRIMARY KEY, balance INT NOT NULL)")
+	}
+
+	sc := scatterconn.NewScatterConn(shards, slog.Default())
+	sc.SetTransactionMode(scatterconn.TransactionModeTwoPC)
+	require.NoError(t, sc.CheckTwoPC(ctx, "default", []string{"0", "1"}))
+
+	conn := server.NewTestConn(&bytes.Buffer{}).Conn
+	state := handler.NewMultiGatewayConnectionState()
+	state.BeginImplicitTransaction()
+	discard := func(context.Context, *sqltypes.Result) error { return nil }
+	require.NoError(t, sc.StreamExecute(ctx, conn, "default", "0", "INSERT INTO accounts VALUES (1, 100)", state, discard))
+	require.NoError(t, sc.StreamExecute(ctx, conn, "default", "1", "INSERT INTO accounts VALUES (2, 200)", state, discard))
+	require.NoError(t, sc.EndTransaction(ctx, conn, "default", "", state, true, "COMMIT", discard))
+	state.EndTransaction(true)
+
+	for _, shard := range []string{"0", "1"} {
+		assert.Len(t, run(shard, "SELECT id FROM accounts").Rows, 1, "shard %s", shard)
+		assert.Empty(t, run(shard, "SELECT gid FROM pg_prepared_xacts").Rows, "shard %s", shard)
+	}
+	assert.Empty(t, run("", "SELECT gid FROM multigres.transaction_log").Rows)
+}
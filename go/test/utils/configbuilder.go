@@ -258,3 +258,39 @@ func WithS3EnvCredentials() S3Option {
 		s3.UseEnvCredentials = true
 	}
 }
+
+// GCSBackupLocation creates a BackupLocation for GCS backups.
+// An empty keyFile uses automatic credential detection.
+func GCSBackupLocation(bucket, keyPrefix, keyFile string) *clustermetadatapb.BackupLocation {
+	return &clustermetadatapb.BackupLocation{
+		Location: &clustermetadatapb.BackupLocation_Gcs{
+			Gcs: &clustermetadatapb.GCSBackup{
+				Bucket:    bucket,
+				KeyPrefix: keyPrefix,
+				KeyFile:   keyFile,
+			},
+		},
+	}
+}
+
+// AzureBackupLocation creates a BackupLocation for Azure Blob Storage backups.
+func AzureBackupLocation(account, container string, useEnvCredentials bool) *clustermetadatapb.BackupLocation {
+	return &clustermetadatapb.BackupLocation{
+		Location: &clustermetadatapb.BackupLocation_Azure{
+			Azure: &clustermetadatapb.AzureBackup{
+				Account:           account,
+				Container:         container,
+				UseEnvCredentials: useEnvCredentials,
+			},
+		},
+	}
+}
+
+// WithBackupRetention sets the retention policy on a BackupLocation.
+func WithBackupRetention(loc *clustermetadatapb.BackupLocation, fullDays, differentialCount uint32) *clustermetadatapb.BackupLocation {
+	loc.Retention = &clustermetadatapb.BackupRetention{
+		FullDays:          fullDays,
+		DifferentialCount: differentialCount,
+	}
+	return loc
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpccommon
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CertReloadInterval is how often the certificate files are checked for
+// changes. Rotated certificates are picked up by the next handshakes, so the
+// established connections are kept instead of all being redialed at once.
+const CertReloadInterval = 10 * time.Second
+
+// TLSFiles are the PEM files of one side of a mutually authenticated gRPC
+// connection.
+type TLSFiles struct {
+	// Cert and Key are the certificate and private key presented to the
+	// peer.
+	Cert string
+	Key  string
+
+	// CA holds the CAs the certificate of the peer must be signed by. On
+	// the server, setting it requires a client certificate.
+	CA string
+
+	// CRL is an optional revocation list the certificate of the peer is
+	// checked against.
+	CRL string
+
+	// Chain holds optional intermediate certificates sent along with Cert.
+	Chain string
+
+	// ServerName is the name the certificate of the server must be valid
+	// for, instead of the host dialed. It is only used by clients.
+	ServerName string
+}
+
+// IsZero reports whether no file is set, meaning TLS is not configured.
+func (f TLSFiles) IsZero() bool {
+	return f.Cert == "" && f.Key == "" && f.CA == "" && f.CRL == "" && f.Chain == ""
+}
+
+// ServerTLSConfig returns the TLS configuration of a gRPC server. The files
+// are reloaded when they change, and a client certificate signed by CA is
+// required if CA is set.
+func ServerTLSConfig(files TLSFiles) (*tls.Config, error) {
+	if files.Cert == "" || files.Key == "" {
+		return nil, errors.New("the server certificate and key must be set together")
+	}
+	r, err := newCertReloader(files, CertReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			m := r.material()
+			config := &tls.Config{
+				Certificates: []tls.Certificate{*m.cert},
+				MinVersion:   tls.VersionTLS12,
+			}
+			if m.roots != nil {
+				config.ClientCAs = m.roots
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.VerifyPeerCertificate = func(_ [][]byte, chains [][]*x509.Certificate) error {
+					return m.checkRevoked(chains)
+				}
+			}
+			return config, nil
+		},
+	}, nil
+}
+
+// ClientTLSConfig returns the TLS configuration of a gRPC client. The files
+// are reloaded when they change. The certificate of the server is verified
+// against CA, or the system roots if CA is not set.
+func ClientTLSConfig(files TLSFiles) (*tls.Config, error) {
+	if (files.Cert == "") != (files.Key == "") {
+		return nil, errors.New("the client certificate and key must be set together")
+	}
+	r, err := newCertReloader(files, CertReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: files.ServerName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if m := r.material(); m.cert != nil {
+				return m.cert, nil
+			}
+			return &tls.Certificate{}, nil
+		},
+		// The chain is verified by VerifyConnection so that a rotated CA
+		// is used without recreating the configuration.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			m := r.material()
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("the server presented no certificate")
+			}
+			opts := x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         m.roots,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			chains, err := cs.PeerCertificates[0].Verify(opts)
+			if err != nil {
+				return err
+			}
+			return m.checkRevoked(chains)
+		},
+	}, nil
+}
+
+// ClientCredentials returns the transport credentials of a gRPC client:
+// TLS if any file is set, plain text otherwise. A server started without
+// its explicit insecure flag refuses plain text clients.
+func ClientCredentials(files TLSFiles) (credentials.TransportCredentials, error) {
+	if files.IsZero() {
+		return insecure.NewCredentials(), nil
+	}
+	config, err := ClientTLSConfig(files)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// tlsMaterial is the parsed content of the TLS files at one point in time.
+type tlsMaterial struct {
+	cert  *tls.Certificate
+	roots *x509.CertPool
+	crl   *x509.RevocationList
+}
+
+// checkRevoked returns an error if a certificate of the verified chains is
+// in the revocation list.
+func (m *tlsMaterial) checkRevoked(chains [][]*x509.Certificate) error {
+	if m.crl == nil {
+		return nil
+	}
+	for _, chain := range chains {
+		for _, cert := range chain {
+			for _, revoked := range m.crl.RevokedCertificateEntries {
+				if cert.SerialNumber.Cmp(revoked.SerialNumber) == 0 && cert.Issuer.String() == m.crl.Issuer.String() {
+					return fmt.Errorf("certificate %s is revoked", cert.Subject)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// certReloader keeps the TLS material of a set of files, and reloads it
+// when the modification time of a file changes.
+type certReloader struct {
+	files    TLSFiles
+	interval time.Duration
+
+	mu       sync.Mutex
+	current  *tlsMaterial
+	modTimes map[string]time.Time
+	checked  time.Time
+}
+
+func newCertReloader(files TLSFiles, interval time.Duration) (*certReloader, error) {
+	r := &certReloader{files: files, interval: interval}
+	m, modTimes, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.current, r.modTimes, r.checked = m, modTimes, time.Now()
+	return r, nil
+}
+
+// material returns the current TLS material, reloading it first if the
+// files changed since the last check. If the new files cannot be loaded,
+// for instance because they are being written, the previous material is
+// kept and the reload is retried at the next check.
+func (r *certReloader) material() *tlsMaterial {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < r.interval {
+		return r.current
+	}
+	r.checked = time.Now()
+	if !r.changed() {
+		return r.current
+	}
+	m, modTimes, err := r.load()
+	if err != nil {
+		slog.Warn("failed to reload the gRPC TLS files, keeping the previous ones", "error", err)
+		return r.current
+	}
+	slog.Info("reloaded the gRPC TLS files", "cert", r.files.Cert, "ca", r.files.CA)
+	r.current, r.modTimes = m, modTimes
+	return r.current
+}
+
+// changed reports whether the modification time of a file changed.
+func (r *certReloader) changed() bool {
+	for path, modTime := range r.modTimes {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// load reads and parses the files, and returns their modification times.
+func (r *certReloader) load() (*tlsMaterial, map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	read := func(path string) ([]byte, error) {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[path] = info.ModTime()
+		return os.ReadFile(path)
+	}
+
+	m := &tlsMaterial{}
+	if r.files.Cert != "" {
+		certPEM, err := read(r.files.Cert)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read certificate: %w", err)
+		}
+		keyPEM, err := read(r.files.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read private key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load certificate: %w", err)
+		}
+		if r.files.Chain != "" {
+			chainPEM, err := read(r.files.Chain)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read certificate chain: %w", err)
+			}
+			for block, rest := pem.Decode(chainPEM); block != nil; block, rest = pem.Decode(rest) {
+				if block.Type == "CERTIFICATE" {
+					cert.Certificate = append(cert.Certificate, block.Bytes)
+				}
+			}
+		}
+		m.cert = &cert
+	}
+	if r.files.CA != "" {
+		caPEM, err := read(r.files.CA)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read CA: %w", err)
+		}
+		m.roots = x509.NewCertPool()
+		if !m.roots.AppendCertsFromPEM(caPEM) {
+			return nil, nil, fmt.Errorf("no certificates found in CA file %s", r.files.CA)
+		}
+	}
+	if r.files.CRL != "" {
+		crlData, err := read(r.files.CRL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read CRL: %w", err)
+		}
+		if block, _ := pem.Decode(crlData); block != nil {
+			crlData = block.Bytes
+		}
+		m.crl, err = x509.ParseRevocationList(crlData)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse CRL: %w", err)
+		}
+	}
+	return m, modTimes, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpccommon
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multigres/multigres/go/test/utils"
+)
+
+// issue returns the PEM certificate and key of a leaf of ca for localhost
+// with the given SPIFFE ID.
+func issue(t *testing.T, ca *utils.TestCA, serial int64, spiffeID string) ([]byte, []byte) {
+	t.Helper()
+	id, err := url.Parse(spiffeID)
+	require.NoError(t, err)
+	return ca.IssuePEM(t, &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		URIs:         []*url.URL{id},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	})
+}
+
+// crl returns a PEM revocation list of ca of the given serials.
+func crl(t *testing.T, ca *utils.TestCA, serials ...int64) []byte {
+	t.Helper()
+	var entries []x509.RevocationListEntry
+	for _, serial := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now().Add(-time.Minute),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: entries,
+	}, ca.Cert, ca.Key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+// handshake runs a TLS handshake between the configurations, and returns
+// the certificates the server saw, or the error of the server.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) ([]*x509.Certificate, error) {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+
+	type result struct {
+		peers []*x509.Certificate
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		conn := tls.Server(serverConn, serverConfig)
+		err := conn.Handshake()
+		done <- result{conn.ConnectionState().PeerCertificates, err}
+		serverConn.Close()
+	}()
+	_ = tls.Client(clientConn, clientConfig).Handshake()
+	clientConn.Close()
+	r := <-done
+	return r.peers, r.err
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := utils.NewTestCA(t, "multigres test CA")
+	caFile := writeFile(t, dir, "ca.pem", ca.PEM)
+	serverCert, serverKey := issue(t, ca, 10, "spiffe://multigres/multipooler")
+	clientCert, clientKey := issue(t, ca, 11, "spiffe://multigres/multiorch")
+	server := TLSFiles{
+		Cert: writeFile(t, dir, "server.pem", serverCert),
+		Key:  writeFile(t, dir, "server.key", serverKey),
+		CA:   caFile,
+	}
+	client := TLSFiles{
+		Cert:       writeFile(t, dir, "client.pem", clientCert),
+		Key:        writeFile(t, dir, "client.key", clientKey),
+		CA:         caFile,
+		ServerName: "localhost",
+	}
+
+	serverConfig, err := ServerTLSConfig(server)
+	require.NoError(t, err)
+
+	t.Run("mutually authenticated", func(t *testing.T) {
+		clientConfig, err := ClientTLSConfig(client)
+		require.NoError(t, err)
+		peers, err := handshake(t, serverConfig, clientConfig)
+		require.NoError(t, err)
+		require.NotEmpty(t, peers)
+		assert.Equal(t, "spiffe://multigres/multiorch", peers[0].URIs[0].String())
+	})
+
+	t.Run("client without certificate", func(t *testing.T) {
+		clientConfig, err := ClientTLSConfig(TLSFiles{CA: caFile, ServerName: "localhost"})
+		require.NoError(t, err)
+		_, err = handshake(t, serverConfig, clientConfig)
+		assert.Error(t, err)
+	})
+
+	t.Run("client of another CA", func(t *testing.T) {
+		other := utils.NewTestCA(t, "other CA")
+		cert, key := issue(t, other, 12, "spiffe://other/multiorch")
+		clientConfig, err := ClientTLSConfig(TLSFiles{
+			Cert:       writeFile(t, dir, "other.pem", cert),
+			Key:        writeFile(t, dir, "other.key", key),
+			CA:         caFile,
+			ServerName: "localhost",
+		})
+		require.NoError(t, err)
+		_, err = handshake(t, serverConfig, clientConfig)
+		assert.Error(t, err)
+	})
+
+	t.Run("server name mismatch", func(t *testing.T) {
+		files := client
+		files.ServerName = "pooler.example.com"
+		clientConfig, err := ClientTLSConfig(files)
+		require.NoError(t, err)
+		_, err = handshake(t, serverConfig, clientConfig)
+		assert.Error(t, err)
+	})
+
+	t.Run("revoked client", func(t *testing.T) {
+		files := server
+		files.CRL = writeFile(t, dir, "crl.pem", crl(t, ca, 11))
+		revokingConfig, err := ServerTLSConfig(files)
+		require.NoError(t, err)
+		clientConfig, err := ClientTLSConfig(client)
+		require.NoError(t, err)
+		_, err = handshake(t, revokingConfig, clientConfig)
+		assert.ErrorContains(t, err, "revoked")
+	})
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := utils.NewTestCA(t, "multigres test CA")
+	cert, key := issue(t, ca, 10, "spiffe://multigres/multigateway")
+	files := TLSFiles{
+		Cert: writeFile(t, dir, "cert.pem", cert),
+		Key:  writeFile(t, dir, "cert.key", key),
+	}
+	r, err := newCertReloader(files, 0)
+	require.NoError(t, err)
+	first := r.material()
+	assert.Same(t, first, r.material(), "unchanged files are not reloaded")
+
+	// A half-written rotation keeps the previous certificate.
+	require.NoError(t, os.WriteFile(files.Cert, []byte("not a certificate"), 0o600))
+	require.NoError(t, os.Chtimes(files.Cert, time.Now(), time.Now().Add(time.Second)))
+	assert.Same(t, first, r.material())
+
+	rotated, rotatedKey := issue(t, ca, 20, "spiffe://multigres/multigateway")
+	require.NoError(t, os.WriteFile(files.Cert, rotated, 0o600))
+	require.NoError(t, os.WriteFile(files.Key, rotatedKey, 0o600))
+	require.NoError(t, os.Chtimes(files.Cert, time.Now(), time.Now().Add(2*time.Second)))
+	second := r.material()
+	require.NotSame(t, first, second)
+	leaf, err := x509.ParseCertificate(second.cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), leaf.SerialNumber.Int64())
+}
+
+func TestTLSConfigErrors(t *testing.T) {
+	_, err := ServerTLSConfig(TLSFiles{Cert: "server.pem"})
+	assert.ErrorContains(t, err, "must be set together")
+
+	_, err = ClientTLSConfig(TLSFiles{Key: "client.key"})
+	assert.ErrorContains(t, err, "must be set together")
+
+	_, err = ServerTLSConfig(TLSFiles{Cert: "missing.pem", Key: "missing.key"})
+	assert.ErrorContains(t, err, "failed to read certificate")
+
+	creds, err := ClientCredentials(TLSFiles{})
+	require.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+}
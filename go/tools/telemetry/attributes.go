@@ -0,0 +1,32 @@
+// Copyright 2026 Supabase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+// Attribute keys of the metrics of all services. Giving the same name to
+// the same dimension everywhere lets dashboards join and aggregate the
+// metrics of the gateways, poolers and orchestrators alike.
+const (
+	// AttrCell is the cell of a pooler or of a topology server.
+	AttrCell = "cell"
+	// AttrDatabase is the PostgreSQL database.
+	AttrDatabase = "database"
+	// AttrTableGroup is the table group of a shard.
+	AttrTableGroup = "tablegroup"
+	// AttrShard is the shard of a table group.
+	AttrShard = "shard"
+	// AttrTabletType is the type of a pooler, PRIMARY or REPLICA, named as
+	// in Vitess so that existing dashboards apply.
+	AttrTabletType = "tablet_type"
+)
@@ -52,6 +52,8 @@ import (
 	"os"
 	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	"go.opentelemetry.io/contrib/bridges/otelslog"
@@ -59,6 +61,8 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
@@ -76,8 +80,19 @@ const tracingServiceName = "github.com/multigres/multigres"
 var tracer = otel.Tracer(tracingServiceName)
 
 // Tracer returns a tracer for creating spans named github.com/multigres/multigres
+// from the current global tracer provider, so that spans go to the provider
+// set last rather than to the first one.
 func Tracer() trace.Tracer {
-	return tracer
+	return otel.Tracer(tracingServiceName)
+}
+
+// EndSpan ends span, marking it failed with err if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
 }
 
 // Telemetry holds OpenTelemetry configuration and state
@@ -87,7 +102,9 @@ type Telemetry struct {
 	tracerProvider *sdktrace.TracerProvider
 	meterProvider  *sdkmetric.MeterProvider
 	loggerProvider *sdklog.LoggerProvider
-	initialized    bool
+	// promRegistry gathers the metrics served by MetricsHandler.
+	promRegistry *prometheus.Registry
+	initialized  bool
 
 	// Test overrides (only used in tests)
 	testSpanExporter sdktrace.SpanExporter
@@ -230,7 +247,9 @@ func (t *Telemetry) initTracing(ctx context.Context, res *resource.Resource) err
 	return nil
 }
 
-// initMetrics initializes the MeterProvider with dual exporters (autoexport + Prometheus)
+// initMetrics initializes the MeterProvider with dual exporters: autoexport,
+// and Prometheus, whose metrics MetricsHandler serves whatever autoexport
+// is configured with.
 func (t *Telemetry) initMetrics(ctx context.Context, res *resource.Resource) error {
 	var metricReader sdkmetric.Reader
 	var err error
@@ -251,11 +270,20 @@ func (t *Telemetry) initMetrics(ctx context.Context, res *resource.Resource) err
 		}
 	}
 
+	// The resource attributes identifying the service are exported once,
+	// as the target_info metric, rather than as labels of every metric.
+	promRegistry := prometheus.NewRegistry()
+	promExporter, err := otelprom.New(otelprom.WithRegisterer(promRegistry))
+	if err != nil {
+		return fmt.Errorf("failed to create Prometheus exporter: %w", err)
+	}
+
 	t.meterProvider = sdkmetric.NewMeterProvider(
-		// TODO(dweitzman): Add an additional prometheus exporter that's always at /metrics for debugging
 		sdkmetric.WithResource(res),
 		sdkmetric.WithReader(metricReader), // Configured via env vars or test reader
+		sdkmetric.WithReader(promExporter),
 	)
+	t.promRegistry = promRegistry
 
 	// Set global meter provider
 	otel.SetMeterProvider(t.meterProvider)
@@ -353,6 +381,21 @@ func (t *Telemetry) GetTracerProvider() trace.TracerProvider {
 	return t.tracerProvider
 }
 
+// MetricsHandler returns an HTTP handler serving the metrics in the
+// Prometheus text format, or 404 until InitTelemetry is called.
+func (t *Telemetry) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.mu.Lock()
+		registry := t.promRegistry
+		t.mu.Unlock()
+		if registry == nil {
+			http.NotFound(w, r)
+			return
+		}
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	})
+}
+
 // GetMeterProvider returns the configured MeterProvider.
 func (t *Telemetry) GetMeterProvider() metric.MeterProvider {
 	t.mu.Lock()
@@ -401,6 +444,7 @@ func (t *Telemetry) ShutdownTelemetry(ctx context.Context) error {
 
 	// Mark as not initialized so subsequent calls are no-ops
 	t.initialized = false
+	t.promRegistry = nil
 
 	if len(errs) > 0 {
 		return fmt.Errorf("errors during telemetry shutdown: %v", errs)
@@ -17,6 +17,8 @@ package telemetry
 import (
 	"context"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
 
@@ -454,6 +457,31 @@ func TestMetrics_WithResourceAttributes(t *testing.T) {
 	assert.Equal(t, "metrics-zone-b", val.AsString())
 }
 
+func TestMetricsHandler(t *testing.T) {
+	setup := SetupTestTelemetry(t)
+	ctx := context.Background()
+
+	// Nothing is served until telemetry is initialized
+	rec := httptest.NewRecorder()
+	setup.Telemetry.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	require.NoError(t, setup.Telemetry.InitTelemetry(ctx, "test-metrics-handler"))
+	t.Cleanup(func() {
+		require.NoError(t, setup.Telemetry.ShutdownTelemetry(ctx))
+	})
+
+	counter, err := otel.Meter("test-meter").Int64Counter("test.handler.counter")
+	require.NoError(t, err)
+	counter.Add(ctx, 3, metric.WithAttributes(attribute.String(AttrShard, "0")))
+
+	rec = httptest.NewRecorder()
+	setup.Telemetry.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `test_handler_counter_total{otel_scope_name="test-meter",otel_scope_schema_url="",otel_scope_version="",shard="0"} 3`)
+	assert.Contains(t, rec.Body.String(), `service_name="test-metrics-handler"`)
+}
+
 func TestWrapSlogHandler_CompositeHandler(t *testing.T) {
 	setup := SetupTestTelemetry(t)
 	ctx := t.Context()
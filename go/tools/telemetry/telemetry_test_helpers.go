@@ -24,6 +24,7 @@ import (
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 
 	"go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
@@ -113,3 +114,14 @@ func SetupTestTelemetry(t *testing.T) *testTelemetrySetup {
 		LogProcessor: logProcessor,
 	}
 }
+
+// SetupSpanRecorder installs a global tracer provider recording every span
+// in memory for the rest of the test.
+func SetupSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	setupRestoreDefaultGlobals(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}